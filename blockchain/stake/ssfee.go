@@ -114,12 +114,49 @@ func CreateMinerSSFeeMarker(blockHeight int64) []byte {
 // enabling UTXO augmentation to reduce dust UTXOs.
 // -----------------------------------------------------------------------------
 
+// SSFeeScriptClass identifies the class of script that pays a consolidation
+// address.  It is the discriminator byte of class-tagged consolidation outputs
+// and of SSFee index keys so that addresses of different script classes that
+// share the same hash160 are never conflated.
+type SSFeeScriptClass byte
+
+const (
+	// SSFeeScriptClassP2PKH identifies a pay-to-pubkey-hash script.
+	SSFeeScriptClassP2PKH SSFeeScriptClass = 0x00
+
+	// SSFeeScriptClassP2SH identifies a pay-to-script-hash script such as
+	// the ones used by multisig consolidation addresses.
+	SSFeeScriptClassP2SH SSFeeScriptClass = 0x01
+)
+
+// String returns the script class in human-readable form.
+func (c SSFeeScriptClass) String() string {
+	switch c {
+	case SSFeeScriptClassP2PKH:
+		return "p2pkh"
+	case SSFeeScriptClassP2SH:
+		return "p2sh"
+	}
+	return fmt.Sprintf("unknown(%d)", byte(c))
+}
+
+// IsKnown returns whether or not the script class is supported.
+func (c SSFeeScriptClass) IsKnown() bool {
+	return c == SSFeeScriptClassP2PKH || c == SSFeeScriptClassP2SH
+}
+
 const (
 	// SSConsolidationOutputSize is the size of the consolidation address
 	// OP_RETURN output in vote transactions.
 	// Format: OP_RETURN + OP_DATA_22 + "SC" + hash160(20 bytes) = 24 bytes
 	SSConsolidationOutputSize = 24
 
+	// SSConsolidationTaggedOutputSize is the size of the class-tagged
+	// consolidation address OP_RETURN output in vote transactions.
+	// Format: OP_RETURN + OP_DATA_23 + "SC" + class(1 byte) +
+	// hash160(20 bytes) = 25 bytes
+	SSConsolidationTaggedOutputSize = 25
+
 	// SSConsolidationMarkerS is the first byte of the "SC" marker.
 	SSConsolidationMarkerS = 0x53 // 'S'
 
@@ -128,8 +165,56 @@ const (
 
 	// SSConsolidationOpData22 is the OP_DATA push size for consolidation output.
 	SSConsolidationOpData22 = 0x16 // 22 bytes
+
+	// SSConsolidationOpData23 is the OP_DATA push size for class-tagged
+	// consolidation output.
+	SSConsolidationOpData23 = 0x17 // 23 bytes
 )
 
+// parseSSFeeConsolidationScript returns the script class and hash160 of the
+// provided consolidation address output script along with whether or not it
+// is one.  Both the original format, which always designates a P2PKH address,
+// and the class-tagged format are recognized.  Class-tagged outputs with an
+// unknown class are not recognized.
+func parseSSFeeConsolidationScript(script []byte) (SSFeeScriptClass, []byte, bool) {
+	if len(script) < SSConsolidationOutputSize ||
+		script[0] != standalone.SSFeeOpReturn {
+
+		return 0, nil, false
+	}
+
+	switch {
+	// OP_RETURN + OP_DATA_22 + "SC" + hash160
+	case len(script) == SSConsolidationOutputSize &&
+		script[1] == SSConsolidationOpData22 &&
+		script[2] == SSConsolidationMarkerS &&
+		script[3] == SSConsolidationMarkerC:
+
+		return SSFeeScriptClassP2PKH, script[4:24], true
+
+	// OP_RETURN + OP_DATA_23 + "SC" + class + hash160
+	case len(script) == SSConsolidationTaggedOutputSize &&
+		script[1] == SSConsolidationOpData23 &&
+		script[2] == SSConsolidationMarkerS &&
+		script[3] == SSConsolidationMarkerC:
+
+		class := SSFeeScriptClass(script[4])
+		if !class.IsKnown() {
+			return 0, nil, false
+		}
+		return class, script[5:25], true
+	}
+
+	return 0, nil, false
+}
+
+// IsSSFeeConsolidationScript returns whether or not the provided script is a
+// consolidation address output script of a supported format.
+func IsSSFeeConsolidationScript(script []byte) bool {
+	_, _, ok := parseSSFeeConsolidationScript(script)
+	return ok
+}
+
 // ExtractSSFeeConsolidationAddr extracts the consolidation address hash160
 // from a vote (SSGen) transaction.
 //
@@ -145,10 +230,19 @@ const (
 // It stops before the last output if it's a treasury vote.
 //
 // Returns the 20-byte hash160 address or an error if not found or invalid.
+// See ExtractSSFeeConsolidationScript to also obtain the script class.
 func ExtractSSFeeConsolidationAddr(voteTx *wire.MsgTx) ([]byte, error) {
+	_, hash160, err := ExtractSSFeeConsolidationScript(voteTx)
+	return hash160, err
+}
+
+// ExtractSSFeeConsolidationScript extracts the script class and hash160 of
+// the consolidation address from a vote (SSGen) transaction.  Votes that use
+// the original consolidation output format always designate a P2PKH address.
+func ExtractSSFeeConsolidationScript(voteTx *wire.MsgTx) (SSFeeScriptClass, []byte, error) {
 	// Need at least 3 outputs: block ref [0], vote bits [1], and consolidation [2+]
 	if len(voteTx.TxOut) < 3 {
-		return nil, fmt.Errorf("vote transaction has too few outputs (%d, need at least 3)",
+		return 0, nil, fmt.Errorf("vote transaction has too few outputs (%d, need at least 3)",
 			len(voteTx.TxOut))
 	}
 
@@ -168,33 +262,18 @@ func ExtractSSFeeConsolidationAddr(voteTx *wire.MsgTx) ([]byte, error) {
 
 	// Scan outputs from index 2 to endIdx-1 for consolidation marker
 	for i := 2; i < endIdx; i++ {
-		script := voteTx.TxOut[i].PkScript
-
-		// Check for correct length
-		if len(script) != SSConsolidationOutputSize {
+		class, scriptHash, ok := parseSSFeeConsolidationScript(
+			voteTx.TxOut[i].PkScript)
+		if !ok {
 			continue
 		}
 
-		// Check format: OP_RETURN + OP_DATA_22 + "SC" + hash160
-		if script[0] != standalone.SSFeeOpReturn {
-			continue
-		}
-
-		if script[1] != SSConsolidationOpData22 {
-			continue
-		}
-
-		if script[2] != SSConsolidationMarkerS || script[3] != SSConsolidationMarkerC {
-			continue
-		}
-
-		// Extract and return hash160 (bytes 4-23)
 		hash160 := make([]byte, 20)
-		copy(hash160, script[4:24])
-		return hash160, nil
+		copy(hash160, scriptHash)
+		return class, hash160, nil
 	}
 
-	return nil, fmt.Errorf("vote transaction missing consolidation address output")
+	return 0, nil, fmt.Errorf("vote transaction missing consolidation address output")
 }
 
 // CreateSSFeeConsolidationOutput creates an OP_RETURN output for vote construction
@@ -211,16 +290,43 @@ func ExtractSSFeeConsolidationAddr(voteTx *wire.MsgTx) ([]byte, error) {
 // The returned TxOut has Value=0 and should be inserted into the vote transaction
 // after all reward outputs but before any treasury vote output.
 func CreateSSFeeConsolidationOutput(hash160 []byte) (*wire.TxOut, error) {
+	return CreateSSFeeConsolidationScriptOutput(SSFeeScriptClassP2PKH, hash160)
+}
+
+// CreateSSFeeConsolidationScriptOutput creates an OP_RETURN output for vote
+// construction that specifies the consolidation address of the provided script
+// class SSFee payments should be sent to.
+//
+// P2PKH addresses use the original format so the output is identical to the
+// one created by CreateSSFeeConsolidationOutput.  All other classes use the
+// class-tagged format:
+//
+//	OP_RETURN + OP_DATA_23 + "SC" + class(1 byte) + hash160(20 bytes) = 25 bytes
+func CreateSSFeeConsolidationScriptOutput(class SSFeeScriptClass, hash160 []byte) (*wire.TxOut, error) {
 	if len(hash160) != 20 {
 		return nil, fmt.Errorf("invalid hash160 length: %d (expected 20)", len(hash160))
 	}
+	if !class.IsKnown() {
+		return nil, fmt.Errorf("unsupported consolidation script class %v", class)
+	}
 
-	script := make([]byte, SSConsolidationOutputSize)
-	script[0] = standalone.SSFeeOpReturn // OP_RETURN
-	script[1] = SSConsolidationOpData22  // OP_DATA_22
-	script[2] = SSConsolidationMarkerS   // 'S'
-	script[3] = SSConsolidationMarkerC   // 'C'
-	copy(script[4:24], hash160)          // hash160 (20 bytes)
+	var script []byte
+	if class == SSFeeScriptClassP2PKH {
+		script = make([]byte, SSConsolidationOutputSize)
+		script[0] = standalone.SSFeeOpReturn // OP_RETURN
+		script[1] = SSConsolidationOpData22  // OP_DATA_22
+		script[2] = SSConsolidationMarkerS   // 'S'
+		script[3] = SSConsolidationMarkerC   // 'C'
+		copy(script[4:24], hash160)          // hash160 (20 bytes)
+	} else {
+		script = make([]byte, SSConsolidationTaggedOutputSize)
+		script[0] = standalone.SSFeeOpReturn // OP_RETURN
+		script[1] = SSConsolidationOpData23  // OP_DATA_23
+		script[2] = SSConsolidationMarkerS   // 'S'
+		script[3] = SSConsolidationMarkerC   // 'C'
+		script[4] = byte(class)              // script class
+		copy(script[5:25], hash160)          // hash160 (20 bytes)
+	}
 
 	return &wire.TxOut{
 		Value:    0,
@@ -238,8 +344,7 @@ func CreateSSFeeConsolidationOutput(hash160 []byte) (*wire.TxOut, error) {
 // SSFee UTXOs with TxTreeStake (since they're in the stake tree). This mismatch
 // causes UTXO lookup failures.
 //
-// This is the inverse of extractHash160FromPkScript in the indexers package,
-// but with the OP_SSGEN prefix prepended.
+// This is the inverse of ExtractSSFeePayScript for P2PKH addresses.
 //
 // Format: OP_SSGEN OP_DUP OP_HASH160 OP_DATA_20 <hash160> OP_EQUALVERIFY OP_CHECKSIG = 26 bytes
 //
@@ -249,21 +354,109 @@ func CreateSSFeeConsolidationOutput(hash160 []byte) (*wire.TxOut, error) {
 //	pkScript := ConsolidationAddrToPkScript(hash160)
 //	// pkScript = [0xbb, 0x76, 0xa9, 0x14, 0x1a, 0x2b, ..., 0x0b, 0x88, 0xac]
 func ConsolidationAddrToPkScript(hash160 []byte) ([]byte, error) {
+	return ConsolidationScriptToPkScript(SSFeeScriptClassP2PKH, hash160)
+}
+
+// ConsolidationScriptToPkScript converts a consolidation address of the
+// provided script class to an OP_SSGEN-tagged pkScript for SSFee outputs.
+//
+// This is the inverse of ExtractSSFeePayScript.
+//
+// Formats:
+//
+//	P2PKH: OP_SSGEN OP_DUP OP_HASH160 OP_DATA_20 <hash160> OP_EQUALVERIFY OP_CHECKSIG = 26 bytes
+//	P2SH:  OP_SSGEN OP_HASH160 OP_DATA_20 <hash160> OP_EQUAL = 24 bytes
+func ConsolidationScriptToPkScript(class SSFeeScriptClass, hash160 []byte) ([]byte, error) {
 	if len(hash160) != 20 {
 		return nil, fmt.Errorf("invalid hash160 length: %d (expected 20)", len(hash160))
 	}
 
-	// OP_SSGEN-tagged P2PKH script for stake tree outputs:
-	// OP_SSGEN OP_DUP OP_HASH160 OP_DATA_20 <hash160> OP_EQUALVERIFY OP_CHECKSIG
 	const opSSGen = 0xbb // txscript.OP_SSGEN
-	script := make([]byte, 26)
-	script[0] = opSSGen // OP_SSGEN
-	script[1] = 0x76    // OP_DUP
-	script[2] = 0xa9    // OP_HASH160
-	script[3] = 0x14    // OP_DATA_20
-	copy(script[4:24], hash160)
-	script[24] = 0x88 // OP_EQUALVERIFY
-	script[25] = 0xac // OP_CHECKSIG
-
-	return script, nil
+	switch class {
+	case SSFeeScriptClassP2PKH:
+		// OP_SSGEN-tagged P2PKH script for stake tree outputs:
+		// OP_SSGEN OP_DUP OP_HASH160 OP_DATA_20 <hash160> OP_EQUALVERIFY OP_CHECKSIG
+		script := make([]byte, ssgenP2PKHScriptLen)
+		script[0] = opSSGen // OP_SSGEN
+		script[1] = 0x76    // OP_DUP
+		script[2] = 0xa9    // OP_HASH160
+		script[3] = 0x14    // OP_DATA_20
+		copy(script[4:24], hash160)
+		script[24] = 0x88 // OP_EQUALVERIFY
+		script[25] = 0xac // OP_CHECKSIG
+		return script, nil
+
+	case SSFeeScriptClassP2SH:
+		// OP_SSGEN-tagged P2SH script for stake tree outputs:
+		// OP_SSGEN OP_HASH160 OP_DATA_20 <hash160> OP_EQUAL
+		script := make([]byte, ssgenP2SHScriptLen)
+		script[0] = opSSGen // OP_SSGEN
+		script[1] = 0xa9    // OP_HASH160
+		script[2] = 0x14    // OP_DATA_20
+		copy(script[3:23], hash160)
+		script[23] = 0x87 // OP_EQUAL
+		return script, nil
+	}
+
+	return nil, fmt.Errorf("unsupported consolidation script class %v", class)
+}
+
+const (
+	// p2pkhScriptLen is the length of a P2PKH script.
+	p2pkhScriptLen = 25
+
+	// p2shScriptLen is the length of a P2SH script.
+	p2shScriptLen = 23
+
+	// ssgenP2PKHScriptLen is the length of an OP_SSGEN-tagged P2PKH script.
+	ssgenP2PKHScriptLen = p2pkhScriptLen + 1
+
+	// ssgenP2SHScriptLen is the length of an OP_SSGEN-tagged P2SH script.
+	ssgenP2SHScriptLen = p2shScriptLen + 1
+)
+
+// ExtractSSFeePayScript extracts the script class and hash160 paid to by the
+// provided SSFee payment script.
+//
+// Supported formats:
+//
+//	P2PKH:        OP_DUP OP_HASH160 OP_DATA_20 <hash160> OP_EQUALVERIFY OP_CHECKSIG
+//	P2SH:         OP_HASH160 OP_DATA_20 <hash160> OP_EQUAL
+//	Tagged P2PKH: OP_SSGEN followed by a P2PKH script
+//	Tagged P2SH:  OP_SSGEN followed by a P2SH script
+//
+// SSFee outputs created by this software are always OP_SSGEN-tagged.
+func ExtractSSFeePayScript(pkScript []byte) (SSFeeScriptClass, []byte, error) {
+	const opSSGen = 0xbb // txscript.OP_SSGEN
+	script := pkScript
+	if len(script) == ssgenP2PKHScriptLen || len(script) == ssgenP2SHScriptLen {
+		if script[0] != opSSGen {
+			return 0, nil, fmt.Errorf("%d-byte SSFee payment script must "+
+				"start with OP_SSGEN", len(pkScript))
+		}
+		script = script[1:]
+	}
+
+	var class SSFeeScriptClass
+	var hash160 []byte
+	switch {
+	// OP_DUP OP_HASH160 OP_DATA_20 <hash160> OP_EQUALVERIFY OP_CHECKSIG
+	case len(script) == p2pkhScriptLen &&
+		script[0] == 0x76 && script[1] == 0xa9 && script[2] == 0x14 &&
+		script[23] == 0x88 && script[24] == 0xac:
+
+		class, hash160 = SSFeeScriptClassP2PKH, script[3:23]
+
+	// OP_HASH160 OP_DATA_20 <hash160> OP_EQUAL
+	case len(script) == p2shScriptLen &&
+		script[0] == 0xa9 && script[1] == 0x14 && script[22] == 0x87:
+
+		class, hash160 = SSFeeScriptClassP2SH, script[2:22]
+
+	default:
+		return 0, nil, fmt.Errorf("unsupported SSFee payment script (len=%d)",
+			len(pkScript))
+	}
+
+	return class, append([]byte(nil), hash160...), nil
 }
@@ -443,6 +443,117 @@ func TestConsolidationRoundtrip(t *testing.T) {
 	}
 }
 
+// TestConsolidationScriptClassRoundtrip tests that consolidation outputs of
+// every supported script class round trip through vote extraction and that the
+// resulting SSFee payment scripts round trip through ExtractSSFeePayScript.
+func TestConsolidationScriptClassRoundtrip(t *testing.T) {
+	testHash160 := []byte{
+		0x1a, 0x2b, 0x3c, 0x4d, 0x5e, 0x6f, 0x7a, 0x8b, 0x9c, 0x0d,
+		0x1e, 0x2f, 0x3a, 0x4b, 0x5c, 0x6d, 0x7e, 0x8f, 0x9a, 0x0b,
+	}
+
+	tests := []struct {
+		class       SSFeeScriptClass
+		wantOutLen  int
+		wantPayLen  int
+		wantOutByte byte
+	}{{
+		class:       SSFeeScriptClassP2PKH,
+		wantOutLen:  SSConsolidationOutputSize,
+		wantPayLen:  26,
+		wantOutByte: SSConsolidationOpData22,
+	}, {
+		class:       SSFeeScriptClassP2SH,
+		wantOutLen:  SSConsolidationTaggedOutputSize,
+		wantPayLen:  24,
+		wantOutByte: SSConsolidationOpData23,
+	}}
+
+	for _, test := range tests {
+		output, err := CreateSSFeeConsolidationScriptOutput(test.class,
+			testHash160)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", test.class, err)
+		}
+		if len(output.PkScript) != test.wantOutLen ||
+			output.PkScript[1] != test.wantOutByte {
+
+			t.Fatalf("%v: unexpected consolidation script %x", test.class,
+				output.PkScript)
+		}
+		if !IsSSFeeConsolidationScript(output.PkScript) {
+			t.Fatalf("%v: consolidation script not recognized", test.class)
+		}
+
+		tx := &wire.MsgTx{
+			TxOut: []*wire.TxOut{
+				{PkScript: make([]byte, 38)}, // [0] block reference
+				{PkScript: make([]byte, 4)},  // [1] vote bits
+				output,                       // [2] consolidation
+			},
+		}
+		class, hash160, err := ExtractSSFeeConsolidationScript(tx)
+		if err != nil {
+			t.Fatalf("%v: unexpected extraction error: %v", test.class, err)
+		}
+		if class != test.class || !bytes.Equal(hash160, testHash160) {
+			t.Fatalf("%v: unexpected extracted %v hash160 %x", test.class,
+				class, hash160)
+		}
+
+		payScript, err := ConsolidationScriptToPkScript(class, hash160)
+		if err != nil {
+			t.Fatalf("%v: unexpected pay script error: %v", test.class, err)
+		}
+		if len(payScript) != test.wantPayLen || payScript[0] != 0xbb {
+			t.Fatalf("%v: unexpected pay script %x", test.class, payScript)
+		}
+		class, hash160, err = ExtractSSFeePayScript(payScript)
+		if err != nil {
+			t.Fatalf("%v: unexpected pay script extraction error: %v",
+				test.class, err)
+		}
+		if class != test.class || !bytes.Equal(hash160, testHash160) {
+			t.Fatalf("%v: unexpected pay script %v hash160 %x", test.class,
+				class, hash160)
+		}
+
+		// The untagged form of the pay script is also recognized.
+		class, hash160, err = ExtractSSFeePayScript(payScript[1:])
+		if err != nil || class != test.class ||
+			!bytes.Equal(hash160, testHash160) {
+
+			t.Fatalf("%v: unexpected untagged pay script result %v %x %v",
+				test.class, class, hash160, err)
+		}
+	}
+
+	// Ensure unknown script classes are rejected when creating outputs and
+	// not recognized when tagged in vote outputs.
+	const unknown = SSFeeScriptClass(0xff)
+	if _, err := CreateSSFeeConsolidationScriptOutput(unknown, testHash160); err == nil {
+		t.Fatal("expected error creating output with unknown script class")
+	}
+	if _, err := ConsolidationScriptToPkScript(unknown, testHash160); err == nil {
+		t.Fatal("expected error creating pay script with unknown script class")
+	}
+	output, _ := CreateSSFeeConsolidationScriptOutput(SSFeeScriptClassP2SH,
+		testHash160)
+	output.PkScript[4] = byte(unknown)
+	if IsSSFeeConsolidationScript(output.PkScript) {
+		t.Fatal("consolidation script with unknown script class recognized")
+	}
+
+	// Ensure malformed pay scripts are rejected.
+	for _, script := range [][]byte{nil, make([]byte, 23), make([]byte, 24),
+		make([]byte, 25), make([]byte, 26), make([]byte, 27)} {
+
+		if _, _, err := ExtractSSFeePayScript(script); err == nil {
+			t.Fatalf("expected error for malformed pay script %x", script)
+		}
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Helper functions for building test data
 // -----------------------------------------------------------------------------
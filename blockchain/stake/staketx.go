@@ -1192,11 +1192,7 @@ func CheckSSGenVotes(tx *wire.MsgTx) ([]TreasuryVoteTuple, error) {
 		rawScript := tx.TxOut[outTxIndex].PkScript
 
 		// Skip consolidation address output (OP_RETURN + "SC" + hash160)
-		if len(rawScript) == SSConsolidationOutputSize &&
-			rawScript[0] == 0x6a && // OP_RETURN
-			rawScript[1] == SSConsolidationOpData22 &&
-			rawScript[2] == SSConsolidationMarkerS &&
-			rawScript[3] == SSConsolidationMarkerC {
+		if IsSSFeeConsolidationScript(rawScript) {
 			continue
 		}
 
@@ -47,6 +47,34 @@ var ssgenMsgTxWithConsolidation = &wire.MsgTx{
 	Expiry:   0,
 }
 
+// ssgenMsgTxWithP2SHConsolidation is a valid SSGen MsgTx with a class-tagged
+// P2SH consolidation address.
+var ssgenMsgTxWithP2SHConsolidation = &wire.MsgTx{
+	SerType: wire.TxSerializeFull,
+	Version: 1,
+	TxIn: []*wire.TxIn{
+		&ssgenTxIn0,
+		&ssgenTxIn1,
+	},
+	TxOut: []*wire.TxOut{
+		&ssgenTxOut0, // [0] block reference
+		&ssgenTxOut1, // [1] vote bits
+		&ssgenTxOut2, // [2] reward 1
+		&ssgenTxOut3, // [3] reward 2
+		func() *wire.TxOut { // [4] consolidation address
+			output, err := CreateSSFeeConsolidationScriptOutput(
+				SSFeeScriptClassP2SH, ssgenConsolidationHash160)
+			if err != nil {
+				panic("failed to create test consolidation output: " +
+					err.Error())
+			}
+			return output
+		}(),
+	},
+	LockTime: 0,
+	Expiry:   0,
+}
+
 // ssgenMsgTxWithConsolidationAtPos2 has consolidation immediately after vote bits.
 var ssgenMsgTxWithConsolidationAtPos2 = &wire.MsgTx{
 	SerType: wire.TxSerializeFull,
@@ -196,6 +224,10 @@ func TestCheckSSGenWithConsolidation(t *testing.T) {
 			name: "valid vote with consolidation and treasury vote",
 			tx:   ssgenMsgTxWithConsolidationAndTreasury,
 		},
+		{
+			name: "valid vote with P2SH consolidation",
+			tx:   ssgenMsgTxWithP2SHConsolidation,
+		},
 	}
 
 	for _, tt := range tests {
@@ -7,6 +7,7 @@ package chaincfg
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -184,8 +185,34 @@ const (
 	// VoteIDActivateSKA2 is the vote ID for activating SKA-2 coin type for use
 	// in transactions.
 	VoteIDActivateSKA2 = "activateska2"
+
+	// VoteIDInputCoinTypes is the vote ID for the agenda that enables
+	// transactions that explicitly declare the coin type of the output spent
+	// by each of their inputs.
+	//
+	// The deployment is intentionally only defined by the simulation and
+	// regression test networks until the new transaction version is ready to
+	// be voted on by the main and test networks, so it can never become active
+	// on the latter until a deployment with a start and expire time is added
+	// to their parameters.
+	VoteIDInputCoinTypes = "inputcointypes"
 )
 
+// SKAActivationVoteID returns the vote ID of the consensus deployment that
+// activates the provided SKA coin type, for example "activateska2" for SKA-2.
+// SKA-1 is active from genesis and does not require a vote.
+func SKAActivationVoteID(coinType cointype.CoinType) string {
+	return fmt.Sprintf("activateska%d", coinType)
+}
+
+// SKADeactivationVoteID returns the vote ID of the consensus deployment that
+// deactivates the provided SKA coin type, for example "deactivateska2" for
+// SKA-2.  The deactivation only takes effect for coin types whose deployment
+// with this vote ID is defined by the network parameters.
+func SKADeactivationVoteID(coinType cointype.CoinType) string {
+	return fmt.Sprintf("deactivateska%d", coinType)
+}
+
 // ConsensusDeployment defines details related to a specific consensus rule
 // change that is voted in.
 type ConsensusDeployment struct {
@@ -253,21 +280,292 @@ type SKACoinConfig struct {
 	// and can be used in transactions.
 	Active bool
 
+	// ActivationHeight is the height of the first block in which this SKA
+	// coin type is active when Active is set.  It allows the activation of
+	// a coin type to be scheduled in advance.  A value of 0 means the coin
+	// type is active from the genesis block.
+	ActivationHeight int64
+
 	// Description provides additional information about this SKA coin type.
 	Description string
 
 	// EmissionAddresses are the governance-approved addresses that will
-	// receive the emitted SKA coins for this coin type.
+	// receive the emitted SKA coins for this coin type.  Each entry is either
+	// a plain address or an output script descriptor in its canonical
+	// encoding, such as sh(multi(2,<pubkey>,<pubkey>,<pubkey>)), which
+	// allows the emission to be paid directly into multisig custody.
 	EmissionAddresses []string
 
 	// EmissionAmounts are the corresponding amounts to be sent to each
 	// address in EmissionAddresses. Must have same length as EmissionAddresses.
 	EmissionAmounts []int64
 
+	// EmissionManifestHash optionally commits to a signed emission manifest
+	// that provides the addresses and amounts of the emission instead of
+	// EmissionAddresses and EmissionAmounts.  See the field of the same name
+	// in SKAEmissionTranche for details.
+	EmissionManifestHash *chainhash.Hash
+
+	// EmissionManifestTotal is the total amount of the emission when
+	// EmissionManifestHash is set.
+	EmissionManifestTotal int64
+
 	// EmissionKey is the authorized public key for creating emission transactions
 	// for this specific SKA coin type. Only transactions signed by the corresponding
 	// private key are valid emissions.
 	EmissionKey *secp256k1.PublicKey
+
+	// DisallowExpiry, when set, requires regular transactions of this coin
+	// type to leave the Expiry field unset (zero).  This is useful for
+	// settlement-style coin types where transactions must never silently
+	// become invalid.
+	DisallowExpiry bool
+
+	// MaxExpiryDelta is the maximum number of blocks beyond the height of
+	// the block that includes a transaction of this coin type that its
+	// Expiry may be set to.  A value of 0 means no limit is imposed.  It has
+	// no effect when DisallowExpiry is set.
+	MaxExpiryDelta uint32
+
+	// DisallowSequenceLocks, when set, requires regular transactions of
+	// this coin type to disable relative lock times on all of their inputs.
+	DisallowSequenceLocks bool
+
+	// MaxBlockBytes is the maximum number of bytes a block may use for
+	// transactions of this coin type regardless of the block space the
+	// allocation would otherwise grant it, including any overflow.  Blocks
+	// that exceed it are invalid.  A value of 0 means no cap is imposed.
+	MaxBlockBytes uint32
+
+	// EmissionTranches optionally schedules the emission of this coin type
+	// in multiple phases.  When set, the tranches are emitted in order
+	// instead of the single emission described by EmissionHeight,
+	// EmissionWindow, EmissionAddresses, and EmissionAmounts, and the
+	// emission of each tranche is authorized with its own nonce that must
+	// be one greater than the nonce of the previously emitted tranche, or
+	// merely greater once SKANonceResyncHeight is reached.
+	EmissionTranches []SKAEmissionTranche
+}
+
+// SKAEmissionTranche defines a single scheduled phase of the emission of an
+// SKA coin type.
+type SKAEmissionTranche struct {
+	// EmissionHeight is the block height at which the tranche may first be
+	// emitted.
+	EmissionHeight int32
+
+	// EmissionWindow is the number of blocks after EmissionHeight during
+	// which the tranche may be emitted.  If 0, the tranche may only be
+	// emitted at the exact EmissionHeight block.
+	EmissionWindow int32
+
+	// EmissionAddresses are the governance-approved addresses that will
+	// receive the coins emitted by the tranche.  They may also be output
+	// script descriptors as described by the field of the same name in
+	// SKACoinConfig.
+	EmissionAddresses []string
+
+	// EmissionAmounts are the corresponding amounts to be sent to each
+	// address in EmissionAddresses.  Must have same length as
+	// EmissionAddresses.
+	EmissionAmounts []int64
+
+	// EmissionManifestHash optionally commits to a signed emission manifest
+	// that provides the addresses and amounts of the tranche in place of
+	// EmissionAddresses and EmissionAmounts, which must then be empty.
+	//
+	// This allows the exact distribution to be finalized closer to the
+	// emission without a node software release since consensus only pins
+	// the hash of the manifest and the total emitted by the tranche.  The
+	// manifest itself is distributed separately and must be signed by the
+	// emission key of the coin type.
+	EmissionManifestHash *chainhash.Hash
+
+	// EmissionManifestTotal is the total amount emitted by the tranche when
+	// EmissionManifestHash is set.  The amounts of the manifest must add up
+	// to it.
+	EmissionManifestTotal int64
+}
+
+// WindowEnd returns the final block height at which the tranche may be
+// emitted.
+func (t *SKAEmissionTranche) WindowEnd() int64 {
+	return int64(t.EmissionHeight) + int64(t.EmissionWindow)
+}
+
+// InWindow returns whether the tranche may be emitted at the provided block
+// height.
+func (t *SKAEmissionTranche) InWindow(height int64) bool {
+	return height >= int64(t.EmissionHeight) && height <= t.WindowEnd()
+}
+
+// HasManifest returns whether the addresses and amounts of the tranche are
+// provided by a signed emission manifest.
+func (t *SKAEmissionTranche) HasManifest() bool {
+	return t.EmissionManifestHash != nil
+}
+
+// TotalAmount returns the total amount emitted by the tranche.  It is the
+// pinned manifest total for tranches whose distribution is provided by an
+// emission manifest.
+func (t *SKAEmissionTranche) TotalAmount() int64 {
+	if t.HasManifest() {
+		return t.EmissionManifestTotal
+	}
+	var total int64
+	for _, amount := range t.EmissionAmounts {
+		total += amount
+	}
+	return total
+}
+
+// EmissionSchedule returns the emission tranches of the coin type in the
+// order they must be emitted.  Coin types without explicitly scheduled
+// tranches have a single tranche described by EmissionHeight, EmissionWindow,
+// EmissionAddresses, EmissionAmounts, and the emission manifest fields.
+func (c *SKACoinConfig) EmissionSchedule() []SKAEmissionTranche {
+	if len(c.EmissionTranches) > 0 {
+		return c.EmissionTranches
+	}
+	return []SKAEmissionTranche{{
+		EmissionHeight:        c.EmissionHeight,
+		EmissionWindow:        c.EmissionWindow,
+		EmissionAddresses:     c.EmissionAddresses,
+		EmissionAmounts:       c.EmissionAmounts,
+		EmissionManifestHash:  c.EmissionManifestHash,
+		EmissionManifestTotal: c.EmissionManifestTotal,
+	}}
+}
+
+// NextEmissionTranche returns the emission tranche of the coin type that
+// follows the provided number of already emitted tranches.  It returns nil
+// when every scheduled tranche has been emitted.
+func (c *SKACoinConfig) NextEmissionTranche(emitted uint32) *SKAEmissionTranche {
+	schedule := c.EmissionSchedule()
+	if uint64(emitted) >= uint64(len(schedule)) {
+		return nil
+	}
+	return &schedule[emitted]
+}
+
+// skaConfigHashVersion is the version of the serialization committed to by
+// SKAConfigHash.  It must be increased whenever the serialization changes.
+const skaConfigHashVersion = 12
+
+// SKAConfigHash returns a canonical hash committing to the consensus-relevant
+// configuration of every SKA coin type of the network, including the activation
+// heights, emission keys, schedules, addresses, amounts, and pinned emission
+// manifests as well as the transaction restrictions and per-block byte cap of
+// each coin type, the maturity of SKA fee outputs, the placeholder emission
+// addresses, the block space allocation version and VAR share, the delay
+// before deactivated coin types become invalid, and the activation heights of
+// the unknown coin type, emission nonce resynchronization, placeholder
+// emission, emission position, emission witness, SKA burn output, and SKA
+// sweep rules.
+//
+// Nodes with differing hashes disagree on the SKA rules and will eventually
+// fork apart, so the hash allows such nodes to be detected early.  The
+// cosmetic Name, Symbol, and Description fields are not committed to.
+func (p *Params) SKAConfigHash() chainhash.Hash {
+	var buf bytes.Buffer
+	putUint32 := func(v uint32) {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], v)
+		buf.Write(b[:])
+	}
+	putUint64 := func(v uint64) {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], v)
+		buf.Write(b[:])
+	}
+	putBool := func(v bool) {
+		if v {
+			buf.WriteByte(1)
+			return
+		}
+		buf.WriteByte(0)
+	}
+	putBytes := func(v []byte) {
+		putUint32(uint32(len(v)))
+		buf.Write(v)
+	}
+	putEmission := func(height, window int32, addrs []string, amounts []int64,
+		manifestHash *chainhash.Hash, manifestTotal int64) {
+
+		putUint32(uint32(height))
+		putUint32(uint32(window))
+		putUint32(uint32(len(addrs)))
+		for _, addr := range addrs {
+			putBytes([]byte(addr))
+		}
+		putUint32(uint32(len(amounts)))
+		for _, amount := range amounts {
+			putUint64(uint64(amount))
+		}
+		if manifestHash != nil {
+			putBytes(manifestHash[:])
+		} else {
+			putBytes(nil)
+		}
+		putUint64(uint64(manifestTotal))
+	}
+
+	putUint32(skaConfigHashVersion)
+	putUint32(uint32(p.SKACoinbaseMaturity))
+	putUint64(uint64(p.BlockAllocV2Height))
+	putUint32(p.BlockAllocVARBasisPoints)
+	putUint64(uint64(p.SKADeactivationDelay))
+	putUint64(uint64(p.UnknownCoinTypeHeight))
+	putUint64(uint64(p.SKANonceResyncHeight))
+	putUint64(uint64(p.PlaceholderEmissionHeight))
+	putUint64(uint64(p.SKAEmissionPositionHeight))
+	putUint64(uint64(p.SKAEmissionWitnessHeight))
+	putUint64(uint64(p.SKABurnOutputHeight))
+	putUint64(uint64(p.SKASweepHeight))
+	putUint32(uint32(len(p.PlaceholderEmissionAddresses)))
+	for _, addr := range p.PlaceholderEmissionAddresses {
+		putBytes([]byte(addr))
+	}
+
+	initialTypes := make([]cointype.CoinType, len(p.InitialSKATypes))
+	copy(initialTypes, p.InitialSKATypes)
+	cointype.Sort(initialTypes)
+	putUint32(uint32(len(initialTypes)))
+	for _, coinType := range initialTypes {
+		buf.WriteByte(byte(coinType))
+	}
+
+	coinTypes := cointype.SortedKeys(p.SKACoins)
+	putUint32(uint32(len(coinTypes)))
+	for _, coinType := range coinTypes {
+		config := p.SKACoins[coinType]
+		buf.WriteByte(byte(coinType))
+		buf.WriteByte(byte(config.CoinType))
+		putUint64(uint64(config.MaxSupply))
+		putBool(config.Active)
+		putUint64(uint64(config.ActivationHeight))
+		putEmission(config.EmissionHeight, config.EmissionWindow,
+			config.EmissionAddresses, config.EmissionAmounts,
+			config.EmissionManifestHash, config.EmissionManifestTotal)
+		if config.EmissionKey != nil {
+			putBytes(config.EmissionKey.SerializeCompressed())
+		} else {
+			putBytes(nil)
+		}
+		putBool(config.DisallowExpiry)
+		putUint32(config.MaxExpiryDelta)
+		putBool(config.DisallowSequenceLocks)
+		putUint32(config.MaxBlockBytes)
+		putUint32(uint32(len(config.EmissionTranches)))
+		for i := range config.EmissionTranches {
+			tranche := &config.EmissionTranches[i]
+			putEmission(tranche.EmissionHeight, tranche.EmissionWindow,
+				tranche.EmissionAddresses, tranche.EmissionAmounts,
+				tranche.EmissionManifestHash, tranche.EmissionManifestTotal)
+		}
+	}
+
+	return chainhash.HashH(buf.Bytes())
 }
 
 // DNSSeed identifies a DNS seed.
@@ -297,7 +595,9 @@ type SKAEmissionAuth struct {
 	// Signature is the ECDSA signature proving authorization
 	Signature []byte
 
-	// Nonce provides replay protection - must be unique per coin type
+	// Nonce provides replay protection - must be one greater than the nonce
+	// of the last committed emission for the coin type, or merely greater
+	// once SKANonceResyncHeight is reached
 	Nonce uint64
 
 	// CoinType specifies which SKA coin type this authorization covers (1-255)
@@ -711,10 +1011,153 @@ type Params struct {
 	// multiple SKA coin types.
 	SKACoins map[cointype.CoinType]*SKACoinConfig
 
+	// PlaceholderEmissionAddresses are the addresses, or output script
+	// descriptors, known to be placeholders or burn addresses, such as the
+	// example treasury addresses the network is configured with until the
+	// real emission addresses replace them.  SKA emissions that pay to any of
+	// them are invalid once PlaceholderEmissionHeight is reached since an
+	// emission can't be redone once it is mined.
+	PlaceholderEmissionAddresses []string
+
 	// InitialSKATypes defines which SKA coin types should be active at
 	// network genesis. Additional types can be activated later through
 	// governance or admin commands.
 	InitialSKATypes []cointype.CoinType
+
+	// BlockAllocV2Height is the height of the first block whose block space
+	// allocation is determined by version 2 of the allocation algorithm,
+	// which no longer hands the unused portion of the VAR base allocation to
+	// SKA while also leaving it allocated to VAR.  Blocks prior to it use
+	// version 1.  A value of zero means version 2 is not scheduled.
+	BlockAllocV2Height int64
+
+	// BlockAllocVARBasisPoints is the portion of the block space that is
+	// allocated to VAR before unused space is redistributed, in basis points
+	// of the allocatable space (1000 = 10%).  The remainder is split among
+	// the active SKA coin types.  A value of zero selects the standard
+	// allocation of 1000 basis points.
+	BlockAllocVARBasisPoints uint32
+
+	// UnknownCoinTypeHeight is the height of the first block in which
+	// transaction outputs that use a coin type which is neither VAR nor an
+	// SKA coin type configured for the network are invalid.  Prior to it such
+	// outputs are only non-standard.  A value of zero means the rule is not
+	// scheduled.
+	UnknownCoinTypeHeight int64
+
+	// SKANonceResyncHeight is the height of the first block in which an SKA
+	// emission may be authorized with any nonce greater than the nonce of
+	// the last committed emission of its coin type.  Prior to it the nonce
+	// must be exactly one greater.  A value of zero means the relaxed rule
+	// is not scheduled.
+	SKANonceResyncHeight int64
+
+	// PlaceholderEmissionHeight is the height of the first block in which
+	// SKA emissions that pay to one of the PlaceholderEmissionAddresses or to
+	// a burn pattern are invalid.  A value of zero means the rule is not
+	// scheduled.
+	PlaceholderEmissionHeight int64
+
+	// SKAEmissionPositionHeight is the height of the first block in which
+	// SKA emissions must be in the regular transaction tree immediately
+	// after the coinbase.  A value of zero means the rule is not scheduled.
+	SKAEmissionPositionHeight int64
+
+	// SKAEmissionWitnessHeight is the height of the first block in which the
+	// fields of SKA emissions that are not committed to by their signature
+	// must have their only valid value.  That is, the signature script must
+	// not have any data after the signature and the value in, block height,
+	// and block index of the input must be null.  A value of zero means the
+	// rule is not scheduled.
+	SKAEmissionWitnessHeight int64
+
+	// SKABurnOutputHeight is the height of the first block in which SKA burn
+	// outputs must burn a positive amount of the coin type committed to by
+	// their script.  Prior to it such outputs are only non-standard.  A value
+	// of zero means the rule is not scheduled.
+	SKABurnOutputHeight int64
+
+	// SKADeactivationDelay is the number of blocks after the vote to
+	// deactivate an SKA coin type becomes active during which new outputs of
+	// the coin type are only non-standard.  Blocks after the delay that
+	// create new outputs of the coin type are invalid.  Existing outputs
+	// remain spendable by transactions that only pay VAR.
+	SKADeactivationDelay int64
+
+	// SKASweepHeight is the height of the first block in which transactions
+	// that only create VAR outputs may only spend outputs of an SKA coin type
+	// whose deactivation vote has passed and the value of those outputs is
+	// retired rather than counted towards the VAR inputs of the transaction.
+	// A value of zero means the rule is not scheduled.
+	SKASweepHeight int64
+
+	// SKACoinbaseMaturity is the number of blocks required before SKA
+	// outputs created by coinbase-like transactions, such as the fee
+	// distributions of SSFee transactions, can be spent.  It allows the fee
+	// outputs of asset-backed SKA coin types to be recycled faster than
+	// newly mined VAR.  A value of zero selects CoinbaseMaturity.
+	SKACoinbaseMaturity uint16
+}
+
+// RejectsUnknownCoinTypes returns whether transaction outputs that use a coin
+// type which is neither VAR nor a configured SKA coin type are invalid in the
+// block at the provided height.
+func (p *Params) RejectsUnknownCoinTypes(height int64) bool {
+	return p.UnknownCoinTypeHeight != 0 && height >= p.UnknownCoinTypeHeight
+}
+
+// AllowsSKANonceResync returns whether SKA emissions in the block at the
+// provided height may skip nonces that were never committed.
+func (p *Params) AllowsSKANonceResync(height int64) bool {
+	return p.SKANonceResyncHeight != 0 && height >= p.SKANonceResyncHeight
+}
+
+// RestrictsSKASweeps returns whether transactions that only create VAR outputs
+// may only spend SKA outputs of deactivated coin types, whose value is retired,
+// in the block at the provided height.
+func (p *Params) RestrictsSKASweeps(height int64) bool {
+	return p.SKASweepHeight != 0 && height >= p.SKASweepHeight
+}
+
+// EnforcesSKAEmissionPositions returns whether SKA emissions in the block at
+// the provided height must be in the regular transaction tree immediately after
+// the coinbase.
+func (p *Params) EnforcesSKAEmissionPositions(height int64) bool {
+	return p.SKAEmissionPositionHeight != 0 &&
+		height >= p.SKAEmissionPositionHeight
+}
+
+// EnforcesSKAEmissionWitness returns whether the fields of SKA emissions in the
+// block at the provided height that are not committed to by their signature
+// must have their only valid value.
+func (p *Params) EnforcesSKAEmissionWitness(height int64) bool {
+	return p.SKAEmissionWitnessHeight != 0 &&
+		height >= p.SKAEmissionWitnessHeight
+}
+
+// EnforcesSKABurnOutputs returns whether SKA burn outputs in the block at the
+// provided height must burn a positive amount of the coin type committed to by
+// their script.
+func (p *Params) EnforcesSKABurnOutputs(height int64) bool {
+	return p.SKABurnOutputHeight != 0 && height >= p.SKABurnOutputHeight
+}
+
+// RejectsPlaceholderEmissions returns whether SKA emissions that pay to a
+// placeholder emission address or a burn pattern are invalid in the block at
+// the provided height.
+func (p *Params) RejectsPlaceholderEmissions(height int64) bool {
+	return p.PlaceholderEmissionHeight != 0 &&
+		height >= p.PlaceholderEmissionHeight
+}
+
+// CoinbaseMaturityForCoinType returns the number of blocks required before
+// outputs of the provided coin type created by coinbase-like transactions can
+// be spent.
+func (p *Params) CoinbaseMaturityForCoinType(coinType cointype.CoinType) uint16 {
+	if coinType.IsSKA() && p.SKACoinbaseMaturity != 0 {
+		return p.SKACoinbaseMaturity
+	}
+	return p.CoinbaseMaturity
 }
 
 // HDPrivKeyVersion returns the hierarchical deterministic extended private key
@@ -926,31 +1369,56 @@ func (p *Params) GetSKACoinConfig(coinType cointype.CoinType) *SKACoinConfig {
 }
 
 // IsSKACoinTypeActive returns true if the specified SKA coin type is
-// configured and active in this network.
+// configured and active in this network.  It does not consider the height the
+// coin type is activated at, so IsSKACoinTypeActiveAtHeight must be used when
+// the coin type must be active for a specific block.
 func (p *Params) IsSKACoinTypeActive(coinType cointype.CoinType) bool {
 	config := p.SKACoins[coinType]
 	return config != nil && config.Active
 }
 
-// GetActiveSKATypes returns a slice of all currently active SKA coin types.
+// IsActiveAtHeight returns whether the SKA coin type is active in the block at
+// the provided height.
+func (c *SKACoinConfig) IsActiveAtHeight(height int64) bool {
+	return c.Active && height >= c.ActivationHeight
+}
+
+// IsSKACoinTypeActiveAtHeight returns true if the specified SKA coin type is
+// configured in this network and active in the block at the provided height.
+func (p *Params) IsSKACoinTypeActiveAtHeight(coinType cointype.CoinType, height int64) bool {
+	config := p.SKACoins[coinType]
+	return config != nil && config.IsActiveAtHeight(height)
+}
+
+// GetActiveSKATypes returns a slice of all currently active SKA coin types in
+// ascending order.  Like IsSKACoinTypeActive, it does not consider the height
+// the coin types are activated at.
 func (p *Params) GetActiveSKATypes() []cointype.CoinType {
 	var active []cointype.CoinType
-	for coinType, config := range p.SKACoins {
-		if config.Active {
+	for _, coinType := range cointype.SortedKeys(p.SKACoins) {
+		if p.SKACoins[coinType].Active {
 			active = append(active, coinType)
 		}
 	}
 	return active
 }
 
-// GetAllSKATypes returns a slice of all configured SKA coin types,
-// both active and inactive.
-func (p *Params) GetAllSKATypes() []cointype.CoinType {
-	var all []cointype.CoinType
-	for coinType := range p.SKACoins {
-		all = append(all, coinType)
+// GetActiveSKATypesAtHeight returns a slice of the SKA coin types that are
+// active in the block at the provided height in ascending order.
+func (p *Params) GetActiveSKATypesAtHeight(height int64) []cointype.CoinType {
+	var active []cointype.CoinType
+	for _, coinType := range cointype.SortedKeys(p.SKACoins) {
+		if p.SKACoins[coinType].IsActiveAtHeight(height) {
+			active = append(active, coinType)
+		}
 	}
-	return all
+	return active
+}
+
+// GetAllSKATypes returns a slice of all configured SKA coin types, both active
+// and inactive, in ascending order.
+func (p *Params) GetAllSKATypes() []cointype.CoinType {
+	return cointype.SortedKeys(p.SKACoins)
 }
 
 // GetSKAEmissionKey returns the authorized emission public key for the specified
@@ -969,6 +1437,27 @@ func (p *Params) IsSKAEmissionAuthorized(coinType cointype.CoinType) bool {
 	return p.GetSKAEmissionKey(coinType) != nil
 }
 
+// placeholderEmissionKeys houses the serialized compressed development emission
+// keys the networks are configured with until keys generated by a proper key
+// ceremony replace them.  Their private keys must be treated as public
+// knowledge.
+var placeholderEmissionKeys = map[string]struct{}{
+	"02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9": {},
+	"0316e57ce5fdb617dc192576d9c860f57e7e7a95592aa32e25941731a2eb2c57d6": {},
+	"02e493dbf1c10d80f3581e4904930b1404cc6c13900ee0758474fa94abe8c4cd13": {},
+}
+
+// IsPlaceholderEmissionKey returns whether the provided emission key is one of
+// the well-known development keys that offer no protection against
+// unauthorized emissions.
+func IsPlaceholderEmissionKey(key *secp256k1.PublicKey) bool {
+	if key == nil {
+		return false
+	}
+	_, ok := placeholderEmissionKeys[hex.EncodeToString(key.SerializeCompressed())]
+	return ok
+}
+
 // CreateSKABurnScript creates a provably unspendable burn script for the
 // specified SKA coin type. The script uses OP_RETURN to make it consensus-unspendable,
 // ensuring that coins sent to this script are permanently removed from circulation.
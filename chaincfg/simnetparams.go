@@ -523,6 +523,33 @@ func SimNetParams() *Params {
 				},
 				StartTime:  0,             // Immediately available for vote
 				ExpireTime: math.MaxInt64, // Never expires
+			}, {
+				Vote: Vote{
+					Id:          VoteIDInputCoinTypes,
+					Description: "Enable transactions that declare the coin types of their inputs",
+					Mask:        0x0018, // Bits 3 and 4
+					Choices: []Choice{{
+						Id:          "abstain",
+						Description: "abstain voting for change",
+						Bits:        0x0000,
+						IsAbstain:   true,
+						IsNo:        false,
+					}, {
+						Id:          "no",
+						Description: "keep the existing consensus rules",
+						Bits:        0x0008, // Bit 3
+						IsAbstain:   false,
+						IsNo:        true,
+					}, {
+						Id:          "yes",
+						Description: "change to the new consensus rules",
+						Bits:        0x0010, // Bit 4
+						IsAbstain:   false,
+						IsNo:        false,
+					}},
+				},
+				StartTime:  0,             // Always available for vote
+				ExpireTime: math.MaxInt64, // Never expires
 			}},
 		},
 
@@ -688,6 +715,48 @@ func SimNetParams() *Params {
 
 		// Initial SKA types to activate at simnet genesis
 		InitialSKATypes: []cointype.CoinType{1}, // Only SKA-1 initially active
+
+		// Use the fixed block space allocation algorithm from the start.
+		BlockAllocV2Height: 1,
+
+		// Allocate 10% of the block space to VAR and the remaining 90% to
+		// SKA before unused space is redistributed.
+		BlockAllocVARBasisPoints: 1000,
+
+		// Reject outputs with unknown coin types from the start.
+		UnknownCoinTypeHeight: 1,
+
+		// Allow SKA emission nonces to resynchronize past gaps from the
+		// start.
+		SKANonceResyncHeight: 1,
+
+		// Reject SKA emissions paying to placeholder or burn addresses from
+		// the start.
+		PlaceholderEmissionHeight: 1,
+
+		// Require SKA emissions to immediately follow the coinbase from the
+		// start.
+		SKAEmissionPositionHeight: 1,
+
+		// Reject SKA emissions with trailing signature script data or
+		// non-null input witness fields from the start.
+		SKAEmissionWitnessHeight: 1,
+
+		// Reject zero-value SKA burns and burns of a coin type other than the
+		// one committed to by their script from the start.
+		SKABurnOutputHeight: 1,
+
+		// New outputs of a deactivated SKA coin type become invalid this many
+		// blocks after its deactivation vote becomes active.
+		SKADeactivationDelay: 64,
+
+		// Restrict SKA inputs of transactions that only create VAR outputs
+		// to sweeps of deactivated coin types from the start.
+		SKASweepHeight: 1,
+
+		// SKA fee outputs mature faster than newly mined VAR to exercise
+		// the separate maturity.
+		SKACoinbaseMaturity: 8,
 	}
 }
 
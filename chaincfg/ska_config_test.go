@@ -5,9 +5,12 @@
 package chaincfg
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
 )
 
 // TestSKACoinConfig tests the SKACoinConfig structure and its methods.
@@ -132,6 +135,52 @@ func TestParamsGetActiveSKATypes(t *testing.T) {
 	}
 }
 
+// TestParamsGetActiveSKATypesAtHeight ensures coin types with a scheduled
+// activation height are only active from that height on.
+func TestParamsGetActiveSKATypesAtHeight(t *testing.T) {
+	params := &Params{
+		SKACoins: map[cointype.CoinType]*SKACoinConfig{
+			1: {CoinType: 1, Active: true},
+			2: {CoinType: 2, Active: true, ActivationHeight: 100},
+			3: {CoinType: 3, Active: false},
+		},
+	}
+
+	tests := []struct {
+		height int64
+		want   []cointype.CoinType
+	}{
+		{height: 0, want: []cointype.CoinType{1}},
+		{height: 99, want: []cointype.CoinType{1}},
+		{height: 100, want: []cointype.CoinType{1, 2}},
+		{height: 1000, want: []cointype.CoinType{1, 2}},
+	}
+	for _, test := range tests {
+		got := params.GetActiveSKATypesAtHeight(test.height)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("height %d: got active types %v, want %v", test.height,
+				got, test.want)
+		}
+		for _, coinType := range []cointype.CoinType{1, 2, 3} {
+			want := false
+			for _, activeType := range test.want {
+				want = want || activeType == coinType
+			}
+			got := params.IsSKACoinTypeActiveAtHeight(coinType, test.height)
+			if got != want {
+				t.Errorf("height %d: coin type %d active %v, want %v",
+					test.height, coinType, got, want)
+			}
+		}
+	}
+
+	// The height agnostic accessors report every coin type with the active
+	// flag set.
+	if got, want := params.GetActiveSKATypes(), []cointype.CoinType{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got active types %v, want %v", got, want)
+	}
+}
+
 // TestParamsGetAllSKATypes tests the GetAllSKATypes method.
 func TestParamsGetAllSKATypes(t *testing.T) {
 	params := MainNetParams()
@@ -160,6 +209,45 @@ func TestParamsGetAllSKATypes(t *testing.T) {
 	if !foundSKA2 {
 		t.Error("Expected SKA-2 to be in all types list")
 	}
+
+	// The coin types must be in ascending order regardless of the map
+	// iteration order.
+	for i := 0; i < 20; i++ {
+		got := params.GetAllSKATypes()
+		for j := 1; j < len(got); j++ {
+			if got[j-1] >= got[j] {
+				t.Fatalf("SKA types not in ascending order: %v", got)
+			}
+		}
+	}
+}
+
+// TestIsPlaceholderEmissionKey ensures the development emission keys the
+// networks are configured with are identified as placeholders while other keys
+// are not.
+func TestIsPlaceholderEmissionKey(t *testing.T) {
+	for _, params := range []*Params{MainNetParams(), TestNet3Params(),
+		SimNetParams()} {
+
+		for _, coinType := range params.GetAllSKATypes() {
+			key := params.GetSKAEmissionKey(coinType)
+			if key != nil && !IsPlaceholderEmissionKey(key) {
+				t.Errorf("%s: emission key of coin type %d is not a "+
+					"placeholder", params.Name, coinType)
+			}
+		}
+	}
+
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	if IsPlaceholderEmissionKey(privKey.PubKey()) {
+		t.Error("generated key is a placeholder")
+	}
+	if IsPlaceholderEmissionKey(nil) {
+		t.Error("nil key is a placeholder")
+	}
 }
 
 // TestMainNetParamsSKAConfigs tests that MainNet parameters have correct SKA configurations.
@@ -267,3 +355,487 @@ func TestSKAConfigConsistency(t *testing.T) {
 		}
 	}
 }
+
+// TestSKAEmissionSchedule ensures the emission schedule of a coin type
+// consists of its scheduled tranches when set and a single tranche described
+// by its emission fields otherwise, and that tranches are looked up by the
+// number of tranches already emitted.
+func TestSKAEmissionSchedule(t *testing.T) {
+	single := &SKACoinConfig{
+		CoinType:          1,
+		EmissionHeight:    100,
+		EmissionWindow:    10,
+		EmissionAddresses: []string{"addr1", "addr2"},
+		EmissionAmounts:   []int64{300, 200},
+	}
+	schedule := single.EmissionSchedule()
+	if len(schedule) != 1 {
+		t.Fatalf("unexpected number of tranches: got %d, want 1",
+			len(schedule))
+	}
+	tranche := single.NextEmissionTranche(0)
+	if tranche == nil {
+		t.Fatal("no tranche after 0 emitted")
+	}
+	if tranche.EmissionHeight != 100 || tranche.WindowEnd() != 110 {
+		t.Fatalf("unexpected tranche window: got [%d, %d], want [100, 110]",
+			tranche.EmissionHeight, tranche.WindowEnd())
+	}
+	if tranche.TotalAmount() != 500 {
+		t.Fatalf("unexpected tranche amount: got %d, want 500",
+			tranche.TotalAmount())
+	}
+	if single.NextEmissionTranche(1) != nil {
+		t.Fatal("unexpected tranche after 1 emitted")
+	}
+
+	tranched := &SKACoinConfig{
+		CoinType: 2,
+		EmissionTranches: []SKAEmissionTranche{{
+			EmissionHeight:    100,
+			EmissionWindow:    10,
+			EmissionAddresses: []string{"addr1"},
+			EmissionAmounts:   []int64{100},
+		}, {
+			EmissionHeight:    200,
+			EmissionWindow:    0,
+			EmissionAddresses: []string{"addr1", "addr2"},
+			EmissionAmounts:   []int64{100, 150},
+		}},
+	}
+	if got := len(tranched.EmissionSchedule()); got != 2 {
+		t.Fatalf("unexpected number of tranches: got %d, want 2", got)
+	}
+	tranche = tranched.NextEmissionTranche(1)
+	if tranche == nil {
+		t.Fatal("no tranche after 1 emitted")
+	}
+	if tranche.TotalAmount() != 250 {
+		t.Fatalf("unexpected tranche amount: got %d, want 250",
+			tranche.TotalAmount())
+	}
+	tests := []struct {
+		height int64
+		want   bool
+	}{
+		{height: 199, want: false},
+		{height: 200, want: true},
+		{height: 201, want: false},
+	}
+	for _, test := range tests {
+		if got := tranche.InWindow(test.height); got != test.want {
+			t.Errorf("InWindow(%d): got %v, want %v", test.height, got,
+				test.want)
+		}
+	}
+	if tranched.NextEmissionTranche(2) != nil {
+		t.Fatal("unexpected tranche after 2 emitted")
+	}
+
+	// The total of tranches distributed by an emission manifest is the
+	// pinned manifest total.
+	manifested := &SKACoinConfig{
+		CoinType:              3,
+		EmissionHeight:        100,
+		EmissionManifestHash:  &chainhash.Hash{0x01},
+		EmissionManifestTotal: 1000,
+	}
+	tranche = manifested.NextEmissionTranche(0)
+	if !tranche.HasManifest() {
+		t.Fatal("tranche does not use the emission manifest")
+	}
+	if tranche.TotalAmount() != 1000 {
+		t.Fatalf("unexpected manifest tranche amount: got %d, want 1000",
+			tranche.TotalAmount())
+	}
+	if single.NextEmissionTranche(0).HasManifest() {
+		t.Fatal("tranche without manifest hash uses an emission manifest")
+	}
+}
+
+// TestSKAConfigHash ensures the SKA configuration hash is deterministic,
+// commits to the consensus-relevant SKA parameters, and ignores the cosmetic
+// ones.
+func TestSKAConfigHash(t *testing.T) {
+	mainHash := MainNetParams().SKAConfigHash()
+	if mainHash != MainNetParams().SKAConfigHash() {
+		t.Fatal("SKA configuration hash is not deterministic")
+	}
+	if mainHash == SimNetParams().SKAConfigHash() {
+		t.Fatal("SKA configuration hash matches for mainnet and simnet")
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(params *Params)
+		changes bool
+	}{{
+		name: "name, symbol, and description",
+		modify: func(params *Params) {
+			config := params.SKACoins[1]
+			config.Name = "renamed"
+			config.Symbol = "REN"
+			config.Description = "renamed coin type"
+		},
+		changes: false,
+	}, {
+		name: "emission key",
+		modify: func(params *Params) {
+			params.SKACoins[1].EmissionKey = params.SKACoins[2].EmissionKey
+		},
+		changes: true,
+	}, {
+		name: "emission amount",
+		modify: func(params *Params) {
+			params.SKACoins[1].EmissionAmounts[0]++
+		},
+		changes: true,
+	}, {
+		name: "emission height",
+		modify: func(params *Params) {
+			params.SKACoins[1].EmissionHeight++
+		},
+		changes: true,
+	}, {
+		name: "emission tranches",
+		modify: func(params *Params) {
+			params.SKACoins[1].EmissionTranches =
+				params.SKACoins[1].EmissionSchedule()
+		},
+		changes: true,
+	}, {
+		name: "emission manifest hash",
+		modify: func(params *Params) {
+			params.SKACoins[1].EmissionManifestHash = &chainhash.Hash{}
+		},
+		changes: true,
+	}, {
+		name: "emission manifest total",
+		modify: func(params *Params) {
+			params.SKACoins[1].EmissionManifestTotal++
+		},
+		changes: true,
+	}, {
+		name: "activation height",
+		modify: func(params *Params) {
+			params.SKACoins[1].ActivationHeight = 1000
+		},
+		changes: true,
+	}, {
+		name: "per-block byte cap",
+		modify: func(params *Params) {
+			params.SKACoins[1].MaxBlockBytes = 50000
+		},
+		changes: true,
+	}, {
+		name: "SKA coinbase maturity",
+		modify: func(params *Params) {
+			params.SKACoinbaseMaturity++
+		},
+		changes: true,
+	}, {
+		name: "block allocation version 2 height",
+		modify: func(params *Params) {
+			params.BlockAllocV2Height = 1000
+		},
+		changes: true,
+	}, {
+		name: "block allocation VAR basis points",
+		modify: func(params *Params) {
+			params.BlockAllocVARBasisPoints = 2000
+		},
+		changes: true,
+	}, {
+		name: "SKA deactivation delay",
+		modify: func(params *Params) {
+			params.SKADeactivationDelay++
+		},
+		changes: true,
+	}, {
+		name: "unknown coin type height",
+		modify: func(params *Params) {
+			params.UnknownCoinTypeHeight = 1000
+		},
+		changes: true,
+	}, {
+		name: "SKA nonce resync height",
+		modify: func(params *Params) {
+			params.SKANonceResyncHeight = 1000
+		},
+		changes: true,
+	}, {
+		name: "SKA sweep height",
+		modify: func(params *Params) {
+			params.SKASweepHeight = 1000
+		},
+		changes: true,
+	}, {
+		name: "SKA emission position height",
+		modify: func(params *Params) {
+			params.SKAEmissionPositionHeight = 1000
+		},
+		changes: true,
+	}, {
+		name: "SKA emission witness height",
+		modify: func(params *Params) {
+			params.SKAEmissionWitnessHeight = 1000
+		},
+		changes: true,
+	}, {
+		name: "SKA burn output height",
+		modify: func(params *Params) {
+			params.SKABurnOutputHeight = 1000
+		},
+		changes: true,
+	}, {
+		name: "placeholder emission height",
+		modify: func(params *Params) {
+			params.PlaceholderEmissionHeight = 1000
+		},
+		changes: true,
+	}, {
+		name: "placeholder emission addresses",
+		modify: func(params *Params) {
+			params.PlaceholderEmissionAddresses = []string{
+				"MsPlaceholderAddress",
+			}
+		},
+		changes: true,
+	}, {
+		name: "removed coin type",
+		modify: func(params *Params) {
+			delete(params.SKACoins, 2)
+		},
+		changes: true,
+	}}
+
+	for _, test := range tests {
+		params := MainNetParams()
+		test.modify(params)
+		changed := params.SKAConfigHash() != mainHash
+		if changed != test.changes {
+			t.Errorf("%s: hash changed %v, want %v", test.name, changed,
+				test.changes)
+		}
+	}
+}
+
+// TestCoinbaseMaturityForCoinType ensures SKA coinbase-like outputs use the
+// separate SKA coinbase maturity when one is set and VAR coinbase outputs are
+// unaffected by it.
+func TestCoinbaseMaturityForCoinType(t *testing.T) {
+	params := SimNetParams()
+	params.CoinbaseMaturity = 16
+
+	tests := []struct {
+		name        string
+		skaMaturity uint16
+		coinType    cointype.CoinType
+		want        uint16
+	}{
+		{"VAR without override", 0, cointype.CoinTypeVAR, 16},
+		{"SKA without override", 0, 1, 16},
+		{"VAR with override", 4, cointype.CoinTypeVAR, 16},
+		{"SKA with override", 4, 1, 4},
+		{"SKA with longer override", 32, 2, 32},
+	}
+
+	for _, test := range tests {
+		params.SKACoinbaseMaturity = test.skaMaturity
+		got := params.CoinbaseMaturityForCoinType(test.coinType)
+		if got != test.want {
+			t.Errorf("%s: got maturity %d, want %d", test.name, got, test.want)
+		}
+	}
+}
+
+// TestRejectsUnknownCoinTypes ensures the consensus rejection of outputs with
+// unknown coin types only applies at or after its activation height and never
+// applies when no activation height is scheduled.
+func TestRejectsUnknownCoinTypes(t *testing.T) {
+	params := SimNetParams()
+
+	tests := []struct {
+		name             string
+		activationHeight int64
+		height           int64
+		want             bool
+	}{
+		{"not scheduled", 0, 100000, false},
+		{"before activation", 100, 99, false},
+		{"at activation", 100, 100, true},
+		{"after activation", 100, 101, true},
+	}
+
+	for _, test := range tests {
+		params.UnknownCoinTypeHeight = test.activationHeight
+		got := params.RejectsUnknownCoinTypes(test.height)
+		if got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// TestAllowsSKANonceResync ensures SKA emission nonces may only skip nonces
+// that were never committed at or after the resynchronization height and never
+// when no resynchronization height is scheduled.
+func TestAllowsSKANonceResync(t *testing.T) {
+	params := SimNetParams()
+
+	tests := []struct {
+		name             string
+		activationHeight int64
+		height           int64
+		want             bool
+	}{
+		{"not scheduled", 0, 100000, false},
+		{"before activation", 100, 99, false},
+		{"at activation", 100, 100, true},
+		{"after activation", 100, 101, true},
+	}
+
+	for _, test := range tests {
+		params.SKANonceResyncHeight = test.activationHeight
+		got := params.AllowsSKANonceResync(test.height)
+		if got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// TestRejectsPlaceholderEmissions ensures SKA emissions paying to placeholder
+// or burn addresses are only rejected at or after the activation height of the
+// rule and never when no activation height is scheduled.
+func TestRejectsPlaceholderEmissions(t *testing.T) {
+	params := SimNetParams()
+
+	tests := []struct {
+		name             string
+		activationHeight int64
+		height           int64
+		want             bool
+	}{
+		{"not scheduled", 0, 100000, false},
+		{"before activation", 100, 99, false},
+		{"at activation", 100, 100, true},
+		{"after activation", 100, 101, true},
+	}
+
+	for _, test := range tests {
+		params.PlaceholderEmissionHeight = test.activationHeight
+		got := params.RejectsPlaceholderEmissions(test.height)
+		if got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// TestRestrictsSKASweeps ensures the restriction of SKA inputs of transactions
+// that only create VAR outputs to sweeps of deactivated coin types only applies
+// at or after its activation height and never when no activation height is
+// scheduled.
+func TestRestrictsSKASweeps(t *testing.T) {
+	params := SimNetParams()
+
+	tests := []struct {
+		name             string
+		activationHeight int64
+		height           int64
+		want             bool
+	}{
+		{"not scheduled", 0, 100000, false},
+		{"before activation", 100, 99, false},
+		{"at activation", 100, 100, true},
+		{"after activation", 100, 101, true},
+	}
+
+	for _, test := range tests {
+		params.SKASweepHeight = test.activationHeight
+		got := params.RestrictsSKASweeps(test.height)
+		if got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// TestEnforcesSKAEmissionPositions ensures SKA emissions are only required to
+// immediately follow the coinbase at or after the activation height of the
+// rule and never when no activation height is scheduled.
+func TestEnforcesSKAEmissionPositions(t *testing.T) {
+	params := SimNetParams()
+
+	tests := []struct {
+		name             string
+		activationHeight int64
+		height           int64
+		want             bool
+	}{
+		{"not scheduled", 0, 100000, false},
+		{"before activation", 100, 99, false},
+		{"at activation", 100, 100, true},
+		{"after activation", 100, 101, true},
+	}
+
+	for _, test := range tests {
+		params.SKAEmissionPositionHeight = test.activationHeight
+		got := params.EnforcesSKAEmissionPositions(test.height)
+		if got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// TestEnforcesSKABurnOutputs ensures SKA burn outputs are only required to burn
+// a positive amount of their committed coin type at or after the activation
+// height of the rule and never when no activation height is scheduled.
+func TestEnforcesSKABurnOutputs(t *testing.T) {
+	params := SimNetParams()
+
+	tests := []struct {
+		name             string
+		activationHeight int64
+		height           int64
+		want             bool
+	}{
+		{"not scheduled", 0, 100000, false},
+		{"before activation", 100, 99, false},
+		{"at activation", 100, 100, true},
+		{"after activation", 100, 101, true},
+	}
+
+	for _, test := range tests {
+		params.SKABurnOutputHeight = test.activationHeight
+		got := params.EnforcesSKABurnOutputs(test.height)
+		if got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// TestEnforcesSKAEmissionWitness ensures the fields of SKA emissions that are
+// not committed to by their signature are only required to have their only
+// valid value at or after the activation height of the rule and never when no
+// activation height is scheduled.
+func TestEnforcesSKAEmissionWitness(t *testing.T) {
+	params := SimNetParams()
+
+	tests := []struct {
+		name             string
+		activationHeight int64
+		height           int64
+		want             bool
+	}{
+		{"not scheduled", 0, 100000, false},
+		{"before activation", 100, 99, false},
+		{"at activation", 100, 100, true},
+		{"after activation", 100, 101, true},
+	}
+
+	for _, test := range tests {
+		params.SKAEmissionWitnessHeight = test.activationHeight
+		got := params.EnforcesSKAEmissionWitness(test.height)
+		if got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -608,6 +608,52 @@ func TestNet3Params() *Params {
 
 		// Initial SKA types to activate at network genesis
 		InitialSKATypes: []cointype.CoinType{1},
+
+		// The fixed block space allocation algorithm is not scheduled yet.
+		BlockAllocV2Height: 0,
+
+		// Allocate 10% of the block space to VAR and the remaining 90% to
+		// SKA before unused space is redistributed.
+		BlockAllocVARBasisPoints: 1000,
+
+		// Outputs with unknown coin types are only non-standard until the
+		// consensus rule that rejects them is scheduled.
+		UnknownCoinTypeHeight: 0,
+
+		// SKA emission nonces must remain contiguous until the rule that
+		// allows them to resynchronize past gaps is scheduled.
+		SKANonceResyncHeight: 0,
+
+		// SKA emissions paying to placeholder or burn addresses remain valid
+		// until the rule that rejects them is scheduled.
+		PlaceholderEmissionHeight: 0,
+
+		// SKA emissions may be anywhere in the regular transaction tree until
+		// the rule that requires them to immediately follow the coinbase is
+		// scheduled.
+		SKAEmissionPositionHeight: 0,
+
+		// SKA emissions may have trailing signature script data and
+		// non-null input witness fields until the rule that rejects them is
+		// scheduled.
+		SKAEmissionWitnessHeight: 0,
+
+		// Zero-value SKA burns and burns of a coin type other than the one
+		// committed to by their script are only non-standard until the
+		// consensus rule that rejects them is scheduled.
+		SKABurnOutputHeight: 0,
+
+		// New outputs of a deactivated SKA coin type become invalid this many
+		// blocks after its deactivation vote becomes active.
+		SKADeactivationDelay: 720, // ~1 day
+
+		// SKA inputs of transactions that only create VAR outputs count
+		// towards their VAR inputs until the rule that restricts them to
+		// sweeps of deactivated coin types is scheduled.
+		SKASweepHeight: 0,
+
+		// SKA fee outputs mature at the same rate as newly mined VAR.
+		SKACoinbaseMaturity: 0,
 	}
 }
 
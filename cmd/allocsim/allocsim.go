@@ -0,0 +1,139 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// allocsim replays historical blocks or synthetic demand traces through
+// alternative block space allocation policies and reports the distribution of
+// the number of blocks transactions of each coin type take to confirm as CSV.
+//
+// It is intended to inform discussions about changing the allocation algorithm
+// or the split of block space between VAR and SKA.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// loadDemandSource opens the source of demand specified by the configuration.
+// The returned closer must be called when the source is no longer needed.
+func loadDemandSource(cfg *config) (demandSource, io.Closer, error) {
+	if cfg.BlockFile != "" {
+		f, err := os.Open(cfg.BlockFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		return newBlockFileSource(f), f, nil
+	}
+
+	f, err := os.Open(cfg.TraceFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	source, err := newTraceSource(f)
+	if err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("%s: %w", cfg.TraceFile, err)
+	}
+	return source, f, nil
+}
+
+// simulate feeds all of the demand from the provided source to the simulators
+// and then simulates up to the provided number of additional blocks without
+// new demand to allow pending transactions to confirm.
+func simulate(source demandSource, sims []*simulator, drainBlocks int64) (int64, int64, error) {
+	firstHeight, lastHeight := int64(-1), int64(-1)
+	for {
+		height, demand, err := source.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		if firstHeight == -1 {
+			firstHeight = height
+			for _, sim := range sims {
+				sim.nextHeight = height
+			}
+		}
+		lastHeight = height
+
+		for _, sim := range sims {
+			sim.mineUntil(height)
+			sim.addDemand(height, demand)
+			sim.mineBlock()
+		}
+	}
+
+	for _, sim := range sims {
+		for i := int64(0); i < drainBlocks && sim.pending() > 0; i++ {
+			sim.mineBlock()
+		}
+	}
+	return firstHeight, lastHeight, nil
+}
+
+// run simulates the configured policies and writes the results.
+func run(cfg *config, policies []policy) error {
+	source, closer, err := loadDemandSource(cfg)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	sims := make([]*simulator, 0, len(policies))
+	for _, p := range policies {
+		sims = append(sims, newSimulator(p, cfg.BlockSize, activeNetParams, 0))
+	}
+
+	firstHeight, lastHeight, err := simulate(source, sims, cfg.DrainBlocks)
+	if err != nil {
+		return err
+	}
+	if firstHeight == -1 {
+		return errors.New("no demand to simulate")
+	}
+	fmt.Fprintf(os.Stderr, "Simulated demand for blocks %d through %d on "+
+		"%s with a max block size of %d bytes\n", firstHeight, lastHeight,
+		activeNetParams.Name, cfg.BlockSize)
+
+	out := io.Writer(os.Stdout)
+	if cfg.OutFile != "" {
+		f, err := os.Create(cfg.OutFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if cfg.Histogram {
+		return writeHistogram(out, sims)
+	}
+	return writeSummary(out, sims)
+}
+
+// realMain is the real main function for the utility.  It is necessary to work
+// around the fact that deferred functions do not run when os.Exit() is called.
+func realMain() error {
+	// Configuration errors are reported along with the usage when loading.
+	cfg, policies, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if err := run(cfg, policies); err != nil {
+		fmt.Fprintf(os.Stderr, "allocsim: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func main() {
+	if err := realMain(); err != nil {
+		os.Exit(1)
+	}
+}
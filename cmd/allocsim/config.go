@@ -0,0 +1,149 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
+)
+
+const (
+	defaultDrainBlocks = 100
+)
+
+var (
+	activeNetParams = chaincfg.MainNetParams()
+
+	// defaultPolicies are the allocation policies simulated when none are
+	// specified.
+	defaultPolicies = []string{"1:10", "2:10"}
+)
+
+// config defines the configuration options for allocsim.
+//
+// See loadConfig for details on the configuration load process.
+type config struct {
+	TestNet     bool     `long:"testnet" description:"Use the test network"`
+	SimNet      bool     `long:"simnet" description:"Use the simulation test network"`
+	BlockFile   string   `short:"i" long:"blockfile" description:"File containing historical blocks in the bootstrap format used by addblock"`
+	TraceFile   string   `short:"t" long:"trace" description:"CSV file containing a synthetic demand trace with rows of height,cointype,size[,count]"`
+	Policies    []string `short:"p" long:"policy" description:"Allocation policy to simulate in the form version:varpercent, for example 2:10 for version 2 of the algorithm with a 10% VAR / 90% SKA split -- may be specified multiple times (default: 1:10 and 2:10)"`
+	BlockSize   uint32   `long:"blocksize" description:"Maximum block size in bytes (default: the maximum block size of the network)"`
+	DrainBlocks int64    `long:"drainblocks" description:"Maximum number of blocks without new demand to simulate after the input is exhausted so pending transactions can confirm"`
+	Histogram   bool     `long:"histogram" description:"Output the full distribution of blocks to confirm instead of summary statistics"`
+	OutFile     string   `short:"o" long:"outfile" description:"File to write the CSV output to (default: stdout)"`
+}
+
+// policy describes an allocation policy to simulate.
+type policy struct {
+	version    blockalloc.AllocVersion
+	varPercent uint32
+}
+
+// String returns the policy in the form accepted on the command line.
+func (p policy) String() string {
+	return fmt.Sprintf("%d:%d", p.version, p.varPercent)
+}
+
+// parsePolicy parses an allocation policy in the form version:varpercent.
+func parsePolicy(s string) (policy, error) {
+	versionStr, percentStr, ok := strings.Cut(s, ":")
+	if !ok {
+		return policy{}, fmt.Errorf("policy %q is not in the form "+
+			"version:varpercent", s)
+	}
+	version, err := strconv.ParseUint(versionStr, 10, 8)
+	if err != nil || version < uint64(blockalloc.AllocV1) ||
+		version > uint64(blockalloc.AllocV2) {
+
+		return policy{}, fmt.Errorf("policy %q has an unknown allocation "+
+			"algorithm version", s)
+	}
+	percent, err := strconv.ParseUint(percentStr, 10, 32)
+	if err != nil || percent > 100 {
+		return policy{}, fmt.Errorf("policy %q has a VAR percentage that "+
+			"is not between 0 and 100", s)
+	}
+	return policy{
+		version:    blockalloc.AllocVersion(version),
+		varPercent: uint32(percent),
+	}, nil
+}
+
+// loadConfig initializes and parses the config using command line options.
+func loadConfig() (*config, []policy, error) {
+	// Default config.
+	cfg := config{
+		DrainBlocks: defaultDrainBlocks,
+	}
+
+	// Parse command line options.
+	parser := flags.NewParser(&cfg, flags.Default)
+	_, err := parser.Parse()
+	if err != nil {
+		var e *flags.Error
+		if !errors.As(err, &e) || e.Type != flags.ErrHelp {
+			parser.WriteHelp(os.Stderr)
+		}
+		return nil, nil, err
+	}
+
+	// usageErr prints the provided error along with the usage and returns
+	// it.
+	usageErr := func(format string, args ...interface{}) error {
+		err := fmt.Errorf("loadConfig: "+format, args...)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return err
+	}
+
+	// Multiple networks can't be selected simultaneously.
+	if cfg.TestNet && cfg.SimNet {
+		return nil, nil, usageErr("the testnet and simnet params can't be " +
+			"used together -- choose one of the two")
+	}
+	if cfg.TestNet {
+		activeNetParams = chaincfg.TestNet3Params()
+	}
+	if cfg.SimNet {
+		activeNetParams = chaincfg.SimNetParams()
+	}
+
+	// Exactly one source of demand must be provided.
+	if (cfg.BlockFile == "") == (cfg.TraceFile == "") {
+		return nil, nil, usageErr("exactly one of --blockfile and --trace " +
+			"must be specified")
+	}
+
+	if cfg.BlockSize == 0 {
+		sizes := activeNetParams.MaximumBlockSizes
+		cfg.BlockSize = uint32(sizes[len(sizes)-1])
+	}
+	if cfg.DrainBlocks < 0 {
+		return nil, nil, usageErr("the number of drain blocks may not be " +
+			"negative")
+	}
+
+	if len(cfg.Policies) == 0 {
+		cfg.Policies = defaultPolicies
+	}
+	policies := make([]policy, 0, len(cfg.Policies))
+	for _, s := range cfg.Policies {
+		p, err := parsePolicy(s)
+		if err != nil {
+			return nil, nil, usageErr("%v", err)
+		}
+		policies = append(policies, p)
+	}
+
+	return &cfg, policies, nil
+}
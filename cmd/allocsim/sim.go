@@ -0,0 +1,258 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
+)
+
+// pendingTx is a transaction waiting to be included in a simulated block.
+type pendingTx struct {
+	size    uint32
+	arrival int64
+}
+
+// confirmStats tracks the number of blocks it took the transactions of a coin
+// type to be included in a simulated block.
+type confirmStats struct {
+	// histogram maps the number of blocks to confirm to the number of
+	// transactions that took that many blocks.  A transaction that arrives
+	// right before a block and is included in it takes one block.
+	histogram map[int64]uint64
+
+	// confirmed is the total number of confirmed transactions.
+	confirmed uint64
+}
+
+// percentile returns the smallest number of blocks to confirm within which at
+// least the provided fraction of the confirmed transactions were included.
+func (s *confirmStats) percentile(fraction float64, blocks []int64) int64 {
+	target := uint64(math.Ceil(fraction * float64(s.confirmed)))
+	if target == 0 {
+		target = 1
+	}
+	var cumulative uint64
+	for _, n := range blocks {
+		cumulative += s.histogram[n]
+		if cumulative >= target {
+			return n
+		}
+	}
+	return 0
+}
+
+// simulator simulates the inclusion of transactions in blocks according to an
+// allocation policy.  Transactions of each coin type are included in the order
+// they arrive for as long as they fit in the space allocated to the coin type.
+type simulator struct {
+	policy    policy
+	allocator *blockalloc.BlockSpaceAllocator
+
+	queues       map[cointype.CoinType][]pendingTx
+	pendingBytes map[cointype.CoinType]uint32
+	stats        map[cointype.CoinType]*confirmStats
+
+	// nextHeight is the height of the next block to simulate.
+	nextHeight int64
+}
+
+// newSimulator returns a simulator for the provided policy that starts with
+// the block at the provided height.
+func newSimulator(p policy, blockSize uint32, params *chaincfg.Params, startHeight int64) *simulator {
+	allocator := blockalloc.NewBlockSpaceAllocatorWithSplit(blockSize, params,
+		p.varPercent*blockalloc.MaxBasisPoints/100).WithVersion(p.version)
+	return &simulator{
+		policy:       p,
+		allocator:    allocator,
+		queues:       make(map[cointype.CoinType][]pendingTx),
+		pendingBytes: make(map[cointype.CoinType]uint32),
+		stats:        make(map[cointype.CoinType]*confirmStats),
+		nextHeight:   startHeight,
+	}
+}
+
+// statsFor returns the confirmation stats of the provided coin type, creating
+// them when needed.
+func (s *simulator) statsFor(coinType cointype.CoinType) *confirmStats {
+	stats, ok := s.stats[coinType]
+	if !ok {
+		stats = &confirmStats{histogram: make(map[int64]uint64)}
+		s.stats[coinType] = stats
+	}
+	return stats
+}
+
+// pending returns the number of transactions waiting to be included.
+func (s *simulator) pending() int {
+	var n int
+	for _, queue := range s.queues {
+		n += len(queue)
+	}
+	return n
+}
+
+// addDemand queues the provided transactions that arrive right before the
+// block at the provided height.
+func (s *simulator) addDemand(height int64, demand []txDemand) {
+	for _, tx := range demand {
+		s.queues[tx.coinType] = append(s.queues[tx.coinType], pendingTx{
+			size:    tx.size,
+			arrival: height,
+		})
+		s.pendingBytes[tx.coinType] += tx.size
+		s.statsFor(tx.coinType)
+	}
+}
+
+// mineBlock simulates the next block by including the pending transactions of
+// each coin type in arrival order until the next one does not fit in the space
+// allocated to the coin type.
+func (s *simulator) mineBlock() {
+	height := s.nextHeight
+	s.nextHeight++
+	if s.pending() == 0 {
+		return
+	}
+
+	allocation := s.allocator.AllocateBlockSpace(s.pendingBytes)
+	for coinType, queue := range s.queues {
+		alloc := allocation.GetAllocationForCoinType(coinType)
+		if alloc == nil {
+			continue
+		}
+		stats := s.statsFor(coinType)
+		var used uint32
+		var included int
+		for _, tx := range queue {
+			if used+tx.size > alloc.FinalAllocation {
+				break
+			}
+			used += tx.size
+			included++
+			stats.histogram[height-tx.arrival+1]++
+			stats.confirmed++
+		}
+		s.queues[coinType] = queue[included:]
+		s.pendingBytes[coinType] -= used
+	}
+}
+
+// mineUntil simulates blocks until the next block to simulate is the one at the
+// provided height.
+func (s *simulator) mineUntil(height int64) {
+	for s.nextHeight < height {
+		s.mineBlock()
+	}
+}
+
+// sortedCoinTypes returns the coin types the simulator has seen demand for in
+// ascending order.
+func (s *simulator) sortedCoinTypes() []cointype.CoinType {
+	coinTypes := make([]cointype.CoinType, 0, len(s.stats))
+	for coinType := range s.stats {
+		coinTypes = append(coinTypes, coinType)
+	}
+	sort.Slice(coinTypes, func(i, j int) bool {
+		return coinTypes[i] < coinTypes[j]
+	})
+	return coinTypes
+}
+
+// writeSummary writes a CSV row with the confirmation time statistics of each
+// coin type for every simulator.
+func writeSummary(w io.Writer, sims []*simulator) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write([]string{"version", "varpercent", "cointype", "txs",
+		"confirmed", "unconfirmed", "meanblocks", "p50blocks", "p90blocks",
+		"p99blocks", "maxblocks"})
+	if err != nil {
+		return err
+	}
+	for _, sim := range sims {
+		for _, coinType := range sim.sortedCoinTypes() {
+			stats := sim.stats[coinType]
+			blocks := make([]int64, 0, len(stats.histogram))
+			var totalBlocks uint64
+			for n, count := range stats.histogram {
+				blocks = append(blocks, n)
+				totalBlocks += uint64(n) * count
+			}
+			sort.Slice(blocks, func(i, j int) bool {
+				return blocks[i] < blocks[j]
+			})
+
+			var mean float64
+			var maxBlocks int64
+			if stats.confirmed > 0 {
+				mean = float64(totalBlocks) / float64(stats.confirmed)
+				maxBlocks = blocks[len(blocks)-1]
+			}
+			unconfirmed := uint64(len(sim.queues[coinType]))
+			err := cw.Write([]string{
+				strconv.Itoa(int(sim.policy.version)),
+				strconv.Itoa(int(sim.policy.varPercent)),
+				strconv.Itoa(int(coinType)),
+				strconv.FormatUint(stats.confirmed+unconfirmed, 10),
+				strconv.FormatUint(stats.confirmed, 10),
+				strconv.FormatUint(unconfirmed, 10),
+				strconv.FormatFloat(mean, 'f', 3, 64),
+				strconv.FormatInt(stats.percentile(0.50, blocks), 10),
+				strconv.FormatInt(stats.percentile(0.90, blocks), 10),
+				strconv.FormatInt(stats.percentile(0.99, blocks), 10),
+				strconv.FormatInt(maxBlocks, 10),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeHistogram writes a CSV row with the number of transactions of each coin
+// type that took each number of blocks to confirm for every simulator.
+func writeHistogram(w io.Writer, sims []*simulator) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write([]string{"version", "varpercent", "cointype", "blocks",
+		"txs"})
+	if err != nil {
+		return err
+	}
+	for _, sim := range sims {
+		for _, coinType := range sim.sortedCoinTypes() {
+			stats := sim.stats[coinType]
+			blocks := make([]int64, 0, len(stats.histogram))
+			for n := range stats.histogram {
+				blocks = append(blocks, n)
+			}
+			sort.Slice(blocks, func(i, j int) bool {
+				return blocks[i] < blocks[j]
+			})
+			for _, n := range blocks {
+				err := cw.Write([]string{
+					strconv.Itoa(int(sim.policy.version)),
+					strconv.Itoa(int(sim.policy.varPercent)),
+					strconv.Itoa(int(coinType)),
+					strconv.FormatInt(n, 10),
+					strconv.FormatUint(stats.histogram[n], 10),
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
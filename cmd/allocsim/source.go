@@ -0,0 +1,224 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// txDemand describes a transaction that competes for block space.
+type txDemand struct {
+	coinType cointype.CoinType
+	size     uint32
+}
+
+// demandSource provides the transactions that arrive before each block in
+// ascending order of height.
+type demandSource interface {
+	// next returns the height of the next block that has transactions
+	// arriving before it along with those transactions.  It returns io.EOF
+	// when there is no more demand.
+	next() (int64, []txDemand, error)
+}
+
+// blockFileSource replays the transactions of historical blocks read from a
+// file in the bootstrap format used by addblock.  The transactions included in
+// each block are treated as arriving right before it, which makes the replay
+// a lower bound for the demand that existed at the time.
+type blockFileSource struct {
+	r          io.Reader
+	lastHeight int64
+}
+
+// newBlockFileSource returns a demand source that replays the blocks read from
+// the provided reader.
+func newBlockFileSource(r io.Reader) *blockFileSource {
+	return &blockFileSource{r: bufio.NewReader(r), lastHeight: -1}
+}
+
+// readBlock reads the next block from the file.  It returns io.EOF when there
+// are no more blocks.
+func (s *blockFileSource) readBlock() (*dcrutil.Block, error) {
+	// The block file format is:
+	//  <network> <block length> <serialized block>
+	var net uint32
+	if err := binary.Read(s.r, binary.LittleEndian, &net); err != nil {
+		return nil, err
+	}
+	if net != uint32(activeNetParams.Net) {
+		return nil, fmt.Errorf("network mismatch -- got %x, want %x", net,
+			uint32(activeNetParams.Net))
+	}
+
+	// Read the block length and ensure it is sane.
+	var blockLen uint32
+	if err := binary.Read(s.r, binary.LittleEndian, &blockLen); err != nil {
+		return nil, err
+	}
+	if blockLen > wire.MaxBlockPayload {
+		return nil, fmt.Errorf("block payload of %d bytes is larger than "+
+			"the max allowed %d bytes", blockLen, wire.MaxBlockPayload)
+	}
+
+	serializedBlock := make([]byte, blockLen)
+	if _, err := io.ReadFull(s.r, serializedBlock); err != nil {
+		return nil, err
+	}
+	return dcrutil.NewBlockFromBytes(serializedBlock)
+}
+
+// next returns the transactions of the next block in the file.  Coinbases and
+// the stake transactions created by the miner itself are excluded since they
+// do not compete for block space.
+//
+// This is part of the demandSource interface.
+func (s *blockFileSource) next() (int64, []txDemand, error) {
+	block, err := s.readBlock()
+	if err != nil {
+		return 0, nil, err
+	}
+	height := block.Height()
+	if height <= s.lastHeight {
+		return 0, nil, fmt.Errorf("block %v at height %d does not follow "+
+			"height %d", block.Hash(), height, s.lastHeight)
+	}
+	s.lastHeight = height
+
+	var demand []txDemand
+	addTx := func(tx *dcrutil.Tx) {
+		demand = append(demand, txDemand{
+			coinType: blockalloc.GetTransactionCoinType(tx),
+			size:     uint32(tx.MsgTx().SerializeSize()),
+		})
+	}
+	for i, tx := range block.Transactions() {
+		if i == 0 {
+			continue
+		}
+		addTx(tx)
+	}
+	for _, stx := range block.STransactions() {
+		switch stake.DetermineTxType(stx.MsgTx()) {
+		case stake.TxTypeTreasuryBase, stake.TxTypeSSFee:
+			continue
+		}
+		addTx(stx)
+	}
+	return height, demand, nil
+}
+
+// traceRow is a single row of a synthetic demand trace.
+type traceRow struct {
+	height int64
+	demand txDemand
+	count  uint64
+}
+
+// traceSource provides the synthetic demand described by a CSV trace where
+// each row is of the form height,cointype,size[,count] and describes count
+// transactions of the given coin type and serialized size that arrive right
+// before the block at the given height.  Rows may appear in any order, lines
+// starting with # are ignored, and an optional header row is skipped.
+type traceSource struct {
+	rows []traceRow
+}
+
+// newTraceSource returns a demand source for the trace read from the provided
+// reader.
+func newTraceSource(r io.Reader) (*traceSource, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var rows []traceRow
+	for first := true; ; first = false {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		if len(record) < 3 || len(record) > 4 {
+			return nil, fmt.Errorf("trace line %d: expected 3 or 4 fields, "+
+				"got %d", line, len(record))
+		}
+		height, err := strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			if first {
+				continue // Header row.
+			}
+			return nil, fmt.Errorf("trace line %d: invalid height: %v", line,
+				err)
+		}
+		coinType, err := strconv.ParseUint(record[1], 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("trace line %d: invalid coin type: %v",
+				line, err)
+		}
+		size, err := strconv.ParseUint(record[2], 10, 32)
+		if err != nil || size == 0 {
+			return nil, fmt.Errorf("trace line %d: invalid size %q", line,
+				record[2])
+		}
+		count := uint64(1)
+		if len(record) == 4 {
+			count, err = strconv.ParseUint(record[3], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("trace line %d: invalid count: %v",
+					line, err)
+			}
+		}
+		rows = append(rows, traceRow{
+			height: height,
+			demand: txDemand{
+				coinType: cointype.CoinType(coinType),
+				size:     uint32(size),
+			},
+			count: count,
+		})
+	}
+
+	// Keep rows for the same height in the order they appear in the trace
+	// since that is the order the transactions arrive in.
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].height < rows[j].height
+	})
+	return &traceSource{rows: rows}, nil
+}
+
+// next returns the demand of the next height present in the trace.
+//
+// This is part of the demandSource interface.
+func (s *traceSource) next() (int64, []txDemand, error) {
+	if len(s.rows) == 0 {
+		return 0, nil, io.EOF
+	}
+	height := s.rows[0].height
+	var demand []txDemand
+	for len(s.rows) > 0 && s.rows[0].height == height {
+		row := s.rows[0]
+		for i := uint64(0); i < row.count; i++ {
+			demand = append(demand, row.demand)
+		}
+		s.rows = s.rows[1:]
+	}
+	return height, demand, nil
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// auditska requests an audit of the supply of every SKA coin type from a
+// running node by way of the auditskasupply RPC and prints the resulting
+// report, optionally signed with a private key so third parties such as
+// exchanges can verify who produced it.
+//
+// The signature is a standard signed message over the compact JSON encoding
+// of the report, so it may be verified with the verifymessage RPC of any node
+// using the address and signature included in the output.
+//
+// With the snapshot option, it instead exports the unspent outputs of a coin
+// type, or the balances of the addresses they pay, as of a given block by way
+// of the getcointypesnapshot RPC as JSON or CSV for proof-of-reserve style
+// attestations.
+//
+// With the skastate option, it instead exports the unspent outputs, supply
+// totals, and emissions of every SKA coin type as of a given block along with
+// integrity hashes so nodes and services such as explorers can bootstrap
+// accurate SKA state without scanning the chain.  The verifyskastate option
+// verifies such an export offline, optionally against a signed emission
+// checkpoint at the same height.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
+	"github.com/monetarium/monetarium-node/rpcclient"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// signedMessagePrefix is the prefix prepended to messages before they are
+// hashed and signed.  It must match the prefix used by the verifymessage RPC.
+const signedMessagePrefix = "Monetarium Signed Message:\n"
+
+// auditReport is the output of the utility.  The address and signature are
+// only set when the report is signed.
+type auditReport struct {
+	Report    json.RawMessage `json:"report"`
+	Address   string          `json:"address,omitempty"`
+	Signature string          `json:"signature,omitempty"`
+}
+
+// auditResult houses the fields of the auditskasupply result that the utility
+// inspects.
+type auditResult struct {
+	Height     int64 `json:"height"`
+	Consistent bool  `json:"consistent"`
+}
+
+// loadSignKey reads the hex-encoded private key from the provided file.
+func loadSignKey(path string) (*secp256k1.PrivateKey, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err := hex.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return nil, fmt.Errorf("malformed signing key: %w", err)
+	}
+	if len(keyBytes) != secp256k1.PrivKeyBytesLen {
+		return nil, fmt.Errorf("signing key is %d bytes instead of %d",
+			len(keyBytes), secp256k1.PrivKeyBytesLen)
+	}
+	return secp256k1.PrivKeyFromBytes(keyBytes), nil
+}
+
+// signReport signs the provided report with the private key and returns the
+// pay-to-pubkey-hash address of the key along with the base64-encoded
+// signature.
+func signReport(key *secp256k1.PrivateKey, report []byte) (string, string, error) {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, signedMessagePrefix)
+	wire.WriteVarString(&buf, 0, string(report))
+	messageHash := chainhash.HashB(buf.Bytes())
+	sig := ecdsa.SignCompact(key, messageHash, true)
+
+	pkHash := stdaddr.Hash160(key.PubKey().SerializeCompressed())
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pkHash,
+		activeNetParams)
+	if err != nil {
+		return "", "", err
+	}
+	return addr.String(), base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// writeOutput calls the provided function with the file at the provided path,
+// or stdout when the path is empty, to write the output of the utility.
+func writeOutput(path string, write func(io.Writer) error) error {
+	if path == "" {
+		return write(os.Stdout)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// run requests the audit, or the snapshot or SKA state when one is requested,
+// from the node and writes the report.  It returns whether or not the supply of
+// all coin types is consistent, which is always the case for snapshots and the
+// SKA state.
+func run(ctx context.Context, cfg *config) (bool, error) {
+	if cfg.VerifySKAState != "" {
+		return true, verifySKAState(cfg)
+	}
+
+	var signKey *secp256k1.PrivateKey
+	if cfg.SignKey != "" {
+		var err error
+		signKey, err = loadSignKey(cfg.SignKey)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	connCfg := &rpcclient.ConnConfig{
+		Host:         cfg.RPCServer,
+		Endpoint:     "ws",
+		User:         cfg.RPCUser,
+		Pass:         cfg.RPCPass,
+		DisableTLS:   cfg.NoTLS,
+		HTTPPostMode: true,
+	}
+	if !cfg.NoTLS {
+		certs, err := os.ReadFile(cfg.RPCCert)
+		if err != nil {
+			return false, err
+		}
+		connCfg.Certificates = certs
+	}
+	client, err := rpcclient.New(connCfg, nil)
+	if err != nil {
+		return false, err
+	}
+	defer client.Shutdown()
+
+	if cfg.Snapshot >= 0 {
+		return true, exportSnapshot(ctx, cfg, client, signKey)
+	}
+	if cfg.SKAState {
+		return true, exportSKAState(ctx, cfg, client)
+	}
+
+	fmt.Fprintf(os.Stderr, "Auditing the SKA supply on %s, which may take "+
+		"a while...\n", activeNetParams.Name)
+	result, err := client.RawRequest(ctx, "auditskasupply", nil)
+	if err != nil {
+		return false, err
+	}
+
+	// Sign the compact encoding of the report so the exact bytes that are
+	// signed can be reproduced from the output.
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, result); err != nil {
+		return false, err
+	}
+	var audit auditResult
+	if err := json.Unmarshal(compact.Bytes(), &audit); err != nil {
+		return false, err
+	}
+	report := auditReport{Report: compact.Bytes()}
+	if signKey != nil {
+		report.Address, report.Signature, err = signReport(signKey,
+			compact.Bytes())
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = writeOutput(cfg.OutFile, func(out io.Writer) error {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	status := "consistent"
+	if !audit.Consistent {
+		status = "INCONSISTENT"
+	}
+	fmt.Fprintf(os.Stderr, "SKA supply as of height %d is %s\n",
+		audit.Height, status)
+	return audit.Consistent, nil
+}
+
+// realMain is the real main function for the utility.  It is necessary to work
+// around the fact that deferred functions do not run when os.Exit() is called.
+func realMain() error {
+	// Configuration errors are reported along with the usage when loading.
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	consistent, err := run(context.Background(), cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "auditska: %v\n", err)
+		return err
+	}
+	if !consistent && cfg.FailOnDiff {
+		return errors.New("inconsistent SKA supply")
+	}
+	return nil
+}
+
+func main() {
+	if err := realMain(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/dcrutil"
+)
+
+var (
+	monetariumHomeDir  = dcrutil.AppDataDir("monetarium", false)
+	defaultRPCCertFile = filepath.Join(monetariumHomeDir, "rpc.cert")
+	activeNetParams    = chaincfg.MainNetParams()
+)
+
+// Default RPC ports of the networks.
+const (
+	defaultMainNetRPCPort = "9109"
+	defaultTestNetRPCPort = "19109"
+	defaultSimNetRPCPort  = "19556"
+)
+
+// config defines the configuration options for auditska.
+//
+// See loadConfig for details on the configuration load process.
+type config struct {
+	TestNet    bool   `long:"testnet" description:"Use the test network"`
+	SimNet     bool   `long:"simnet" description:"Use the simulation test network"`
+	RPCServer  string `short:"s" long:"rpcserver" description:"RPC server to connect to (default: localhost on the default RPC port of the network)"`
+	RPCUser    string `short:"u" long:"rpcuser" description:"RPC username"`
+	RPCPass    string `short:"P" long:"rpcpass" default-mask:"-" description:"RPC password"`
+	RPCCert    string `short:"c" long:"rpccert" description:"RPC server certificate chain for validation"`
+	NoTLS      bool   `long:"notls" description:"Disable TLS"`
+	SignKey    string `short:"k" long:"signkey" description:"File containing the hex-encoded secp256k1 private key used to sign the report -- the report is not signed when it is not specified"`
+	OutFile    string `short:"o" long:"outfile" description:"File to write the report to (default: stdout)"`
+	FailOnDiff bool   `long:"failondiff" description:"Exit with a non-zero status when the supply of any coin type is inconsistent"`
+	Snapshot   int    `long:"snapshot" default:"-1" default-mask:"-" description:"Export a snapshot of the unspent outputs of the coin type instead of auditing the SKA supply"`
+	Height     int64  `long:"height" default:"-1" default-mask:"current tip" description:"Height of the main chain block as of which to take the snapshot"`
+	Aggregate  bool   `long:"aggregate" description:"Aggregate the snapshot into balances by address"`
+	Format     string `long:"format" default:"json" choice:"json" choice:"csv" description:"Format of the snapshot"`
+
+	SKAState       bool     `long:"skastate" description:"Export the unspent outputs, supply totals, and emissions of every SKA coin type along with their integrity hashes instead of auditing the SKA supply"`
+	VerifySKAState string   `long:"verifyskastate" description:"Verify the integrity of the SKA state exported to the file without connecting to a node"`
+	Checkpoint     string   `long:"checkpoint" description:"File containing a signed SKA emission checkpoint at the same height the emissions of the verified SKA state must match"`
+	CheckpointKeys []string `long:"checkpointkey" description:"Add a hex-encoded compressed public key trusted to sign the emission checkpoint"`
+}
+
+// loadConfig initializes and parses the config using command line options.
+func loadConfig() (*config, error) {
+	// Default config.
+	cfg := config{
+		RPCCert: defaultRPCCertFile,
+	}
+
+	// Parse command line options.
+	parser := flags.NewParser(&cfg, flags.Default)
+	_, err := parser.Parse()
+	if err != nil {
+		var e *flags.Error
+		if !errors.As(err, &e) || e.Type != flags.ErrHelp {
+			parser.WriteHelp(os.Stderr)
+		}
+		return nil, err
+	}
+
+	// usageErr prints the provided error along with the usage and returns
+	// it.
+	usageErr := func(format string, args ...interface{}) error {
+		err := fmt.Errorf("loadConfig: "+format, args...)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return err
+	}
+
+	// Multiple networks can't be selected simultaneously.
+	if cfg.TestNet && cfg.SimNet {
+		return nil, usageErr("the testnet and simnet params can't be " +
+			"used together -- choose one of the two")
+	}
+	rpcPort := defaultMainNetRPCPort
+	if cfg.TestNet {
+		activeNetParams = chaincfg.TestNet3Params()
+		rpcPort = defaultTestNetRPCPort
+	}
+	if cfg.SimNet {
+		activeNetParams = chaincfg.SimNetParams()
+		rpcPort = defaultSimNetRPCPort
+	}
+
+	// Add the default port of the network when none is specified.
+	if cfg.RPCServer == "" {
+		cfg.RPCServer = "localhost"
+	}
+	if _, _, err := net.SplitHostPort(cfg.RPCServer); err != nil {
+		cfg.RPCServer = net.JoinHostPort(cfg.RPCServer, rpcPort)
+	}
+
+	// The snapshot options only apply to snapshots.
+	if cfg.Snapshot > 255 {
+		return nil, usageErr("the snapshot coin type must be in the range "+
+			"[0, 255] -- got %d", cfg.Snapshot)
+	}
+	if cfg.Snapshot < 0 && !cfg.SKAState && (cfg.Height != -1 ||
+		cfg.Aggregate || cfg.Format != "json") {
+
+		return nil, usageErr("the height, aggregate, and format options " +
+			"require the snapshot option")
+	}
+	if cfg.Height < -1 {
+		return nil, usageErr("the snapshot height must not be negative")
+	}
+	if cfg.Format == "csv" && cfg.SignKey != "" && cfg.OutFile == "" {
+		return nil, usageErr("signed CSV snapshots require the outfile " +
+			"option since the signature is written next to it")
+	}
+	if cfg.Snapshot >= 0 && cfg.FailOnDiff {
+		return nil, usageErr("the failondiff option does not apply to " +
+			"snapshots")
+	}
+
+	// The SKA state options are exclusive with the other modes and the
+	// options that do not apply to them.
+	if cfg.SKAState && cfg.VerifySKAState != "" {
+		return nil, usageErr("the skastate and verifyskastate options " +
+			"can't be used together -- choose one of the two")
+	}
+	if (cfg.SKAState || cfg.VerifySKAState != "") && (cfg.Snapshot >= 0 ||
+		cfg.FailOnDiff || cfg.SignKey != "") {
+
+		return nil, usageErr("the snapshot, failondiff, and signkey " +
+			"options do not apply to the SKA state since it is identified " +
+			"by its hash")
+	}
+	if cfg.SKAState && (cfg.Aggregate || cfg.Format != "json") {
+		return nil, usageErr("the aggregate and format options do not " +
+			"apply to the SKA state")
+	}
+	if (cfg.Checkpoint != "" || len(cfg.CheckpointKeys) > 0) &&
+		cfg.VerifySKAState == "" {
+
+		return nil, usageErr("the checkpoint and checkpointkey options " +
+			"require the verifyskastate option")
+	}
+	if cfg.Checkpoint != "" && len(cfg.CheckpointKeys) == 0 {
+		return nil, usageErr("the checkpoint option requires at least one " +
+			"checkpointkey")
+	}
+
+	// Verifying the SKA state does not connect to a node.
+	if cfg.VerifySKAState != "" {
+		if cfg.Height != -1 || cfg.OutFile != "" {
+			return nil, usageErr("the height and outfile options do not " +
+				"apply to verifying the SKA state")
+		}
+		return &cfg, nil
+	}
+
+	if cfg.RPCUser == "" || cfg.RPCPass == "" {
+		return nil, usageErr("the RPC username and password must be " +
+			"specified")
+	}
+
+	return &cfg, nil
+}
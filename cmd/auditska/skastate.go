@@ -0,0 +1,222 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+	"github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-node/rpcclient"
+)
+
+// rawRequest issues the provided RPC with the provided parameters and decodes
+// the result into the provided value.
+func rawRequest(ctx context.Context, client *rpcclient.Client, method string, result interface{}, params ...interface{}) error {
+	rawParams := make([]json.RawMessage, 0, len(params))
+	for _, param := range params {
+		marshalled, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		rawParams = append(rawParams, marshalled)
+	}
+	rawResult, err := client.RawRequest(ctx, method, rawParams)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(rawResult, result)
+}
+
+// exportSKAState requests the unspent outputs and emissions of every SKA coin
+// type of the network as of the configured height from the node and writes the
+// resulting sealed SKA state snapshot.
+func exportSKAState(ctx context.Context, cfg *config, client *rpcclient.Client) error {
+	coinTypes := make([]uint8, 0, len(activeNetParams.SKACoins))
+	for coinType := range activeNetParams.SKACoins {
+		coinTypes = append(coinTypes, uint8(coinType))
+	}
+	sort.Slice(coinTypes, func(i, j int) bool {
+		return coinTypes[i] < coinTypes[j]
+	})
+
+	fmt.Fprintf(os.Stderr, "Exporting the state of %d SKA coin types on %s, "+
+		"which may take a while...\n", len(coinTypes), activeNetParams.Name)
+
+	// The first coin type determines the snapshot block when the current tip
+	// is requested so every coin type is taken as of the same block.
+	var height interface{}
+	if cfg.Height >= 0 {
+		height = cfg.Height
+	}
+	state := &blockchain.SKAStateSnapshot{
+		Version: blockchain.SKAStateSnapshotVersion,
+		Network: activeNetParams.Name,
+	}
+	for i, coinType := range coinTypes {
+		var snapshot types.GetCoinTypeSnapshotResult
+		err := rawRequest(ctx, client, "getcointypesnapshot", &snapshot,
+			coinType, height, false)
+		if err != nil {
+			return fmt.Errorf("failed to snapshot coin type %d: %w",
+				coinType, err)
+		}
+		if i == 0 {
+			state.Height, state.BlockHash = snapshot.Height, snapshot.BlockHash
+			height = snapshot.Height
+		}
+		if snapshot.BlockHash != state.BlockHash {
+			return fmt.Errorf("snapshot of coin type %d is of block %s "+
+				"instead of %s", coinType, snapshot.BlockHash,
+				state.BlockHash)
+		}
+
+		ct := blockchain.SKAStateSnapshotCoinType{
+			CoinType: coinType,
+			Utxos: make([]blockchain.SKAStateSnapshotUtxo, 0,
+				len(snapshot.Utxos)),
+		}
+		for _, u := range snapshot.Utxos {
+			ct.Utxos = append(ct.Utxos, blockchain.SKAStateSnapshotUtxo{
+				TxHash:       u.TxHash,
+				Tree:         u.Tree,
+				Vout:         u.Vout,
+				Height:       u.Height,
+				Amount:       u.Amount,
+				Version:      u.Version,
+				ScriptPubKey: u.ScriptPubKey,
+			})
+		}
+
+		// Only the emissions in blocks up to the snapshot block are part of
+		// its state.
+		var emissions types.GetSKAEmissionsResult
+		err = rawRequest(ctx, client, "getskaemissions", &emissions, coinType)
+		if err != nil {
+			return fmt.Errorf("failed to fetch the emissions of coin type "+
+				"%d: %w", coinType, err)
+		}
+		for _, e := range emissions.Emissions {
+			if e.Height > state.Height {
+				continue
+			}
+			var amount int64
+			for _, out := range e.Outputs {
+				atoms, err := dcrutil.NewAmount(out.Amount)
+				if err != nil {
+					return fmt.Errorf("invalid amount of emission %s: %w",
+						e.TxHash, err)
+				}
+				amount += int64(atoms)
+			}
+			ct.Emissions = append(ct.Emissions,
+				blockchain.SKAStateSnapshotEmission{
+					Height:    e.Height,
+					BlockHash: e.BlockHash,
+					TxHash:    e.TxHash,
+					Nonce:     e.Nonce,
+					Amount:    amount,
+				})
+		}
+		state.CoinTypes = append(state.CoinTypes, ct)
+	}
+
+	// The emissions are reported for the current main chain, so ensure the
+	// snapshot block is still part of it.
+	if len(coinTypes) > 0 {
+		hash, err := client.GetBlockHash(ctx, state.Height)
+		if err != nil {
+			return err
+		}
+		if hash.String() != state.BlockHash {
+			return fmt.Errorf("block %s at height %d was reorganized out of "+
+				"the main chain while exporting -- try again",
+				state.BlockHash, state.Height)
+		}
+	}
+
+	if err := state.Seal(); err != nil {
+		return err
+	}
+	err := writeOutput(cfg.OutFile, func(out io.Writer) error {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(state)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "SKA state of %d coin types as of height %d "+
+		"(block %s) has hash %s\n", len(state.CoinTypes), state.Height,
+		state.BlockHash, state.Hash)
+	return nil
+}
+
+// verifySKAState reads the SKA state snapshot at the configured path and
+// verifies its integrity, that it is for the active network, and, when an
+// emission checkpoint is configured, that its emissions match those attested
+// to by the checkpoint.  It does not require a connection to a node.
+func verifySKAState(cfg *config) error {
+	data, err := os.ReadFile(cfg.VerifySKAState)
+	if err != nil {
+		return err
+	}
+	state, err := blockchain.ParseSKAStateSnapshot(data)
+	if err != nil {
+		return err
+	}
+	if err := state.CheckParams(activeNetParams); err != nil {
+		return err
+	}
+
+	if cfg.Checkpoint != "" {
+		keys := make([]*secp256k1.PublicKey, 0, len(cfg.CheckpointKeys))
+		for _, keyHex := range cfg.CheckpointKeys {
+			keyBytes, err := hex.DecodeString(keyHex)
+			if err != nil {
+				return fmt.Errorf("malformed checkpoint key %q: %w", keyHex,
+					err)
+			}
+			key, err := secp256k1.ParsePubKey(keyBytes)
+			if err != nil {
+				return fmt.Errorf("malformed checkpoint key %q: %w", keyHex,
+					err)
+			}
+			keys = append(keys, key)
+		}
+		data, err := os.ReadFile(cfg.Checkpoint)
+		if err != nil {
+			return err
+		}
+		cp, err := blockchain.ParseEmissionCheckpoint(data)
+		if err != nil {
+			return err
+		}
+		if err := cp.Verify(keys); err != nil {
+			return err
+		}
+		if err := state.CheckEmissionCheckpoint(cp); err != nil {
+			return err
+		}
+	}
+
+	for _, ct := range state.CoinTypes {
+		fmt.Fprintf(os.Stderr, "Coin type %d: supply %d atoms in %d outputs, "+
+			"%d atoms emitted in %d emissions\n", ct.CoinType, ct.Supply,
+			len(ct.Utxos), ct.Emitted, len(ct.Emissions))
+	}
+	fmt.Fprintf(os.Stderr, "SKA state as of height %d (block %s) with hash "+
+		"%s is valid\n", state.Height, state.BlockHash, state.Hash)
+	return nil
+}
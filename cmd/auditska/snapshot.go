@@ -0,0 +1,171 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-node/rpcclient"
+)
+
+// snapshotSignature is written next to signed CSV snapshots since, unlike JSON
+// snapshots, they can't house the signature themselves.  The signature is over
+// the exact contents of the CSV file.
+type snapshotSignature struct {
+	Address   string `json:"address"`
+	Signature string `json:"signature"`
+}
+
+// snapshotParams returns the parameters of the getcointypesnapshot RPC for the
+// provided configuration.
+func snapshotParams(cfg *config) ([]json.RawMessage, error) {
+	var height interface{}
+	if cfg.Height >= 0 {
+		height = cfg.Height
+	}
+	params := make([]json.RawMessage, 0, 3)
+	for _, param := range []interface{}{cfg.Snapshot, height, cfg.Aggregate} {
+		marshalled, err := json.Marshal(param)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, marshalled)
+	}
+	return params, nil
+}
+
+// writeSnapshotCSV writes the provided snapshot as CSV.  A comment line that
+// describes the snapshot block precedes the header so the file is
+// self-contained, and it may be skipped by readers that support comments.
+func writeSnapshotCSV(w io.Writer, snapshot *types.GetCoinTypeSnapshotResult, aggregate bool) error {
+	_, err := fmt.Fprintf(w, "# cointype=%d height=%d blockhash=%s total=%d "+
+		"utxocount=%d\n", snapshot.CoinType, snapshot.Height,
+		snapshot.BlockHash, snapshot.Total, snapshot.UtxoCount)
+	if err != nil {
+		return err
+	}
+
+	itoa := func(i int64) string { return strconv.FormatInt(i, 10) }
+	cw := csv.NewWriter(w)
+	if aggregate {
+		err = cw.Write([]string{"address", "scriptpubkey", "amount", "utxos"})
+		if err != nil {
+			return err
+		}
+		for _, b := range snapshot.Balances {
+			err := cw.Write([]string{b.Address, b.ScriptPubKey,
+				itoa(b.Amount), itoa(b.Utxos)})
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		err = cw.Write([]string{"txhash", "tree", "vout", "height", "amount",
+			"version", "scriptpubkey", "address"})
+		if err != nil {
+			return err
+		}
+		for _, u := range snapshot.Utxos {
+			err := cw.Write([]string{u.TxHash, itoa(int64(u.Tree)),
+				itoa(int64(u.Vout)), itoa(u.Height), itoa(u.Amount),
+				itoa(int64(u.Version)), u.ScriptPubKey, u.Address})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// exportSnapshot requests the snapshot of the configured coin type from the
+// node and writes it in the configured format, optionally signed.
+func exportSnapshot(ctx context.Context, cfg *config, client *rpcclient.Client,
+	signKey *secp256k1.PrivateKey) error {
+
+	params, err := snapshotParams(cfg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Exporting the snapshot of coin type %d on %s, "+
+		"which may take a while...\n", cfg.Snapshot, activeNetParams.Name)
+	result, err := client.RawRequest(ctx, "getcointypesnapshot", params)
+	if err != nil {
+		return err
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, result); err != nil {
+		return err
+	}
+	var snapshot types.GetCoinTypeSnapshotResult
+	if err := json.Unmarshal(compact.Bytes(), &snapshot); err != nil {
+		return err
+	}
+
+	switch cfg.Format {
+	case "csv":
+		var buf bytes.Buffer
+		if err := writeSnapshotCSV(&buf, &snapshot, cfg.Aggregate); err != nil {
+			return err
+		}
+		err := writeOutput(cfg.OutFile, func(out io.Writer) error {
+			_, err := out.Write(buf.Bytes())
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if signKey != nil {
+			var sig snapshotSignature
+			sig.Address, sig.Signature, err = signReport(signKey, buf.Bytes())
+			if err != nil {
+				return err
+			}
+			err = writeOutput(cfg.OutFile+".sig", func(out io.Writer) error {
+				enc := json.NewEncoder(out)
+				enc.SetIndent("", "  ")
+				return enc.Encode(sig)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+	default:
+		// Sign the compact encoding of the snapshot so the exact bytes that
+		// are signed can be reproduced from the output.
+		report := auditReport{Report: compact.Bytes()}
+		if signKey != nil {
+			report.Address, report.Signature, err = signReport(signKey,
+				compact.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+		err := writeOutput(cfg.OutFile, func(out io.Writer) error {
+			enc := json.NewEncoder(out)
+			enc.SetIndent("", "  ")
+			return enc.Encode(report)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Snapshot of coin type %d as of height %d (block "+
+		"%s) holds %d atoms in %d outputs\n", snapshot.CoinType,
+		snapshot.Height, snapshot.BlockHash, snapshot.Total,
+		snapshot.UtxoCount)
+	return nil
+}
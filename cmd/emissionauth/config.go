@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/monetarium/monetarium-node/chaincfg"
+)
+
+var activeNetParams = chaincfg.MainNetParams()
+
+// config defines the configuration options for emissionauth.
+//
+// See loadConfig for details on the configuration load process.
+type config struct {
+	TestNet    bool   `long:"testnet" description:"Use the test network"`
+	SimNet     bool   `long:"simnet" description:"Use the simulation test network"`
+	RegNet     bool   `long:"regnet" description:"Use the regression test network"`
+	SignKey    string `short:"k" long:"signkey" description:"File containing the hex-encoded secp256k1 emission private key used to sign the descriptor -- the descriptor is not signed when it is not specified"`
+	AuthScript bool   `long:"authscript" description:"Output the hex-encoded emission authorization script instead of the descriptor"`
+	Manifest   bool   `long:"manifest" description:"Treat the input as an emission manifest that provides the distribution of an emission tranche instead of an emission authorization descriptor"`
+	Hash       bool   `long:"hash" description:"Output the hash of the emission manifest to pin in the chain parameters instead of the manifest -- requires --manifest"`
+	Attest     uint8  `long:"attest" description:"Treat the input as a hex-encoded challenge and output a signature over it that attests custody of the emission key of the given coin type for the attestemissionkey RPC -- requires --signkey"`
+	OutFile    string `short:"o" long:"outfile" description:"File to write the output to (default: stdout)"`
+
+	// InFile is the file containing the descriptor.  It is read from stdin
+	// when it is not specified or is "-".
+	InFile string
+}
+
+// loadConfig initializes and parses the config using command line options.
+func loadConfig() (*config, error) {
+	// Parse command line options.
+	var cfg config
+	parser := flags.NewParser(&cfg, flags.Default)
+	parser.Usage = "[OPTIONS] [descriptor-file]"
+	args, err := parser.Parse()
+	if err != nil {
+		var e *flags.Error
+		if !errors.As(err, &e) || e.Type != flags.ErrHelp {
+			parser.WriteHelp(os.Stderr)
+		}
+		return nil, err
+	}
+
+	// usageErr prints the provided error along with the usage and returns
+	// it.
+	usageErr := func(format string, args ...interface{}) error {
+		err := fmt.Errorf("loadConfig: "+format, args...)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return err
+	}
+
+	// Multiple networks can't be selected simultaneously.
+	numNets := 0
+	if cfg.TestNet {
+		numNets++
+		activeNetParams = chaincfg.TestNet3Params()
+	}
+	if cfg.SimNet {
+		numNets++
+		activeNetParams = chaincfg.SimNetParams()
+	}
+	if cfg.RegNet {
+		numNets++
+		activeNetParams = chaincfg.RegNetParams()
+	}
+	if numNets > 1 {
+		return nil, usageErr("the testnet, simnet, and regnet params " +
+			"can't be used together -- choose one of the three")
+	}
+
+	if cfg.Hash && !cfg.Manifest {
+		return nil, usageErr("the hash option requires the manifest option")
+	}
+	if cfg.AuthScript && cfg.Manifest {
+		return nil, usageErr("the authscript and manifest options can't be " +
+			"used together")
+	}
+
+	if cfg.Attest != 0 {
+		if cfg.SignKey == "" {
+			return nil, usageErr("the attest option requires the signkey " +
+				"option")
+		}
+		if cfg.Manifest || cfg.AuthScript {
+			return nil, usageErr("the attest option can't be used with the " +
+				"manifest or authscript options")
+		}
+	}
+
+	switch len(args) {
+	case 0:
+	case 1:
+		cfg.InFile = args[0]
+	default:
+		return nil, usageErr("too many arguments")
+	}
+
+	return &cfg, nil
+}
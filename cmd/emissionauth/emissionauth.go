@@ -0,0 +1,238 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// emissionauth converts SKA emission authorization descriptors to their
+// canonical form, signs them, and converts them to emission authorization
+// scripts without any connection to a node.
+//
+// It is intended to run on an air-gapped machine holding the emission private
+// key.  An unsigned descriptor, as accepted by the encodeemissionauth RPC, is
+// carried to the machine, signed with the emission key, and the resulting
+// signed descriptor is carried back and passed to the encodeemissionauth RPC
+// of an online node to obtain the emission transaction.  The descriptor is the
+// only data that crosses the gap.
+//
+// It also signs emission manifests, which provide the distribution of emission
+// tranches whose chain parameters only pin the hash of the manifest and the
+// total it emits, and outputs the hash to pin.
+//
+// Finally, it signs challenges that attest custody of the emission key, which
+// the attestemissionkey RPC verifies, so custody can be proven without
+// emitting.
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+)
+
+// loadSignKey reads the hex-encoded private key from the provided file.
+func loadSignKey(path string) (*secp256k1.PrivateKey, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err := hex.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return nil, fmt.Errorf("malformed signing key: %w", err)
+	}
+	if len(keyBytes) != secp256k1.PrivKeyBytesLen {
+		return nil, fmt.Errorf("signing key is %d bytes instead of %d",
+			len(keyBytes), secp256k1.PrivKeyBytesLen)
+	}
+	return secp256k1.PrivKeyFromBytes(keyBytes), nil
+}
+
+// readInput reads the input from the provided file or from stdin when the file
+// is not specified.
+func readInput(path string) ([]byte, error) {
+	if path == "" || path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// readDescriptor reads the descriptor from the provided file or from stdin
+// when the file is not specified.
+func readDescriptor(path string) (*blockchain.EmissionAuthDescriptor, error) {
+	data, err := readInput(path)
+	if err != nil {
+		return nil, err
+	}
+	return blockchain.ParseEmissionAuthDescriptor(data)
+}
+
+// manifestOutput reads the emission manifest, signs it when a signing key is
+// configured, and returns either the canonical manifest or its hash.  Signed
+// manifests must be signed by the emission key of their coin type on the
+// active network.
+func manifestOutput(cfg *config) (string, error) {
+	data, err := readInput(cfg.InFile)
+	if err != nil {
+		return "", err
+	}
+	manifest, err := blockchain.ParseEmissionManifest(data)
+	if err != nil {
+		return "", err
+	}
+
+	if cfg.SignKey != "" {
+		signKey, err := loadSignKey(cfg.SignKey)
+		if err != nil {
+			return "", err
+		}
+		if err := manifest.Sign(signKey); err != nil {
+			return "", err
+		}
+	}
+	if manifest.Signature != "" {
+		coinType := cointype.CoinType(manifest.CoinType)
+		config := activeNetParams.GetSKACoinConfig(coinType)
+		if config == nil || config.EmissionKey == nil {
+			return "", fmt.Errorf("no emission key configured for coin "+
+				"type %d on %s", coinType, activeNetParams.Name)
+		}
+		if err := manifest.Verify(config.EmissionKey); err != nil {
+			return "", err
+		}
+	}
+
+	if cfg.Hash {
+		return manifest.Hash().String(), nil
+	}
+	canonical, err := manifest.Canonical()
+	if err != nil {
+		return "", err
+	}
+	return string(canonical), nil
+}
+
+// attestationOutput reads the hex-encoded challenge and returns the
+// hex-encoded signature that attests custody of the emission key of the
+// configured coin type.  The signing key must be the emission key of the coin
+// type on the active network.
+func attestationOutput(cfg *config) (string, error) {
+	data, err := readInput(cfg.InFile)
+	if err != nil {
+		return "", err
+	}
+	challenge, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return "", fmt.Errorf("malformed challenge: %w", err)
+	}
+	signKey, err := loadSignKey(cfg.SignKey)
+	if err != nil {
+		return "", err
+	}
+
+	coinType := cointype.CoinType(cfg.Attest)
+	sig, err := blockchain.SignEmissionKeyAttestation(coinType, challenge,
+		signKey, activeNetParams)
+	if err != nil {
+		return "", err
+	}
+	err = blockchain.VerifyEmissionKeyAttestation(coinType, challenge, sig,
+		activeNetParams)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sig), nil
+}
+
+// descriptorOutput reads the descriptor, signs it when a signing key is
+// configured, and returns either the canonical descriptor or the
+// authorization script it describes.
+func descriptorOutput(cfg *config) (string, error) {
+	descriptor, err := readDescriptor(cfg.InFile)
+	if err != nil {
+		return "", err
+	}
+
+	if cfg.SignKey != "" {
+		signKey, err := loadSignKey(cfg.SignKey)
+		if err != nil {
+			return "", err
+		}
+		if err := descriptor.Sign(signKey, activeNetParams); err != nil {
+			return "", err
+		}
+	}
+	if descriptor.Signature != "" {
+		if err := descriptor.Verify(activeNetParams); err != nil {
+			return "", err
+		}
+	}
+
+	if cfg.AuthScript {
+		authScript, err := descriptor.AuthScript()
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(authScript), nil
+	}
+	canonical, err := descriptor.Canonical()
+	if err != nil {
+		return "", err
+	}
+	return string(canonical), nil
+}
+
+// run reads the descriptor, manifest, or challenge, signs it when a signing key
+// is configured, and writes the requested output.
+func run(cfg *config) error {
+	var output string
+	var err error
+	switch {
+	case cfg.Attest != 0:
+		output, err = attestationOutput(cfg)
+	case cfg.Manifest:
+		output, err = manifestOutput(cfg)
+	default:
+		output, err = descriptorOutput(cfg)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if cfg.OutFile != "" {
+		f, err := os.Create(cfg.OutFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	_, err = fmt.Fprintln(out, output)
+	return err
+}
+
+// realMain is the real main function for the utility.  It is necessary to work
+// around the fact that deferred functions do not run when os.Exit() is called.
+func realMain() error {
+	// Configuration errors are reported along with the usage when loading.
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if err := run(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "emissionauth: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func main() {
+	if err := realMain(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/monetarium/monetarium-node/chaincfg"
+)
+
+var activeNetParams = chaincfg.MainNetParams()
+
+// config defines the configuration options for emissionrehearsal.
+//
+// See loadConfig for details on the configuration load process.
+type config struct {
+	TestNet     bool   `long:"testnet" description:"Use the test network"`
+	SimNet      bool   `long:"simnet" description:"Use the simulation test network"`
+	RegNet      bool   `long:"regnet" description:"Use the regression test network"`
+	Replay      bool   `long:"replay" description:"Treat the input as a rehearsal record and replay it against the active network instead of recording a signed emission authorization descriptor"`
+	MainNetLike bool   `long:"mainnetlike" description:"Replace the SKA coin configuration of the active network with the one of the main network before replaying -- requires --replay"`
+	MinedTx     string `long:"minedtx" description:"File containing the hex-encoded emission transaction as mined on the active network, which must match the recorded transaction byte for byte -- not allowed with --replay"`
+	OutFile     string `short:"o" long:"outfile" description:"File to write the output to (default: stdout)"`
+
+	// InFile is the file containing the descriptor or record.  It is read
+	// from stdin when it is not specified or is "-".
+	InFile string
+}
+
+// loadConfig initializes and parses the config using command line options.
+func loadConfig() (*config, error) {
+	// Parse command line options.
+	var cfg config
+	parser := flags.NewParser(&cfg, flags.Default)
+	parser.Usage = "[OPTIONS] [descriptor-or-record-file]"
+	args, err := parser.Parse()
+	if err != nil {
+		var e *flags.Error
+		if !errors.As(err, &e) || e.Type != flags.ErrHelp {
+			parser.WriteHelp(os.Stderr)
+		}
+		return nil, err
+	}
+
+	// usageErr prints the provided error along with the usage and returns
+	// it.
+	usageErr := func(format string, args ...interface{}) error {
+		err := fmt.Errorf("loadConfig: "+format, args...)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return err
+	}
+
+	// Multiple networks can't be selected simultaneously.
+	numNets := 0
+	if cfg.TestNet {
+		numNets++
+		activeNetParams = chaincfg.TestNet3Params()
+	}
+	if cfg.SimNet {
+		numNets++
+		activeNetParams = chaincfg.SimNetParams()
+	}
+	if cfg.RegNet {
+		numNets++
+		activeNetParams = chaincfg.RegNetParams()
+	}
+	if numNets > 1 {
+		return nil, usageErr("the testnet, simnet, and regnet params " +
+			"can't be used together -- choose one of the three")
+	}
+
+	if cfg.MainNetLike && !cfg.Replay {
+		return nil, usageErr("the mainnetlike option requires the replay " +
+			"option")
+	}
+	if cfg.MinedTx != "" && cfg.Replay {
+		return nil, usageErr("the minedtx and replay options can't be used " +
+			"together")
+	}
+
+	switch len(args) {
+	case 0:
+	case 1:
+		cfg.InFile = args[0]
+	default:
+		return nil, usageErr("too many arguments")
+	}
+
+	return &cfg, nil
+}
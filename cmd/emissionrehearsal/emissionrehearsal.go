@@ -0,0 +1,182 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// emissionrehearsal records the exact bytes of the messages involved in an SKA
+// emission on one network and replays them against another, so the emission
+// procedure rehearsed on the test network can be verified byte for byte
+// against a simulation network configured like the main network before the
+// one-shot main network emission.
+//
+// Recording takes the signed emission authorization descriptor of the
+// rehearsed emission, as produced by emissionauth, and outputs a record of the
+// descriptor, the emission transaction, its prefix and hash, the emission
+// authorization script, and the message the emission signature commits to.
+// The transaction as mined on the network may optionally be provided to
+// ensure the record matches it.
+//
+// Replaying rebuilds every recorded message from the recorded descriptor with
+// the current release on the active network, optionally with the SKA coin
+// configuration of the main network, and reports any difference.  Plain
+// emission addresses are translated to the active network and the network ID
+// in the signing message is excluded from the comparison since they are the
+// only parts that are expected to differ between networks.  Any other
+// difference indicates serialization drift between the release that recorded
+// the emission and the one replaying it.
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+)
+
+// readInput reads the input from the provided file or from stdin when the file
+// is not specified.
+func readInput(path string) ([]byte, error) {
+	if path == "" || path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// recordOutput reads the signed descriptor and returns the rehearsal record of
+// the emission it describes on the active network.  The record must match the
+// mined transaction when one is configured.
+func recordOutput(cfg *config) (string, error) {
+	data, err := readInput(cfg.InFile)
+	if err != nil {
+		return "", err
+	}
+	descriptor, err := blockchain.ParseEmissionAuthDescriptor(data)
+	if err != nil {
+		return "", err
+	}
+	record, err := newRecord(descriptor, activeNetParams)
+	if err != nil {
+		return "", err
+	}
+
+	if cfg.MinedTx != "" {
+		minedTx, err := os.ReadFile(cfg.MinedTx)
+		if err != nil {
+			return "", err
+		}
+		mined := strings.ToLower(strings.TrimSpace(string(minedTx)))
+		if _, err := hex.DecodeString(mined); err != nil {
+			return "", fmt.Errorf("malformed mined transaction: %w", err)
+		}
+		if mined != record.Tx {
+			return "", fmt.Errorf("the emission transaction described by " +
+				"the descriptor does not match the mined transaction")
+		}
+	}
+
+	recordJSON, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(recordJSON), nil
+}
+
+// replayOutput reads the rehearsal record, replays it against the active
+// network, and returns the report of the replay.  An error is returned along
+// with the report when any of the rebuilt messages differs from the recorded
+// one.
+func replayOutput(cfg *config) (string, error) {
+	data, err := readInput(cfg.InFile)
+	if err != nil {
+		return "", err
+	}
+	record, err := parseRecord(data)
+	if err != nil {
+		return "", err
+	}
+
+	params := activeNetParams
+	if cfg.MainNetLike {
+		mainNetParams := chaincfg.MainNetParams()
+		params.SKACoins = mainNetParams.SKACoins
+		params.SKAMinRelayTxFee = mainNetParams.SKAMinRelayTxFee
+	}
+	checks, err := replay(record, params)
+	if err != nil {
+		return "", err
+	}
+
+	var report strings.Builder
+	fmt.Fprintf(&report, "replayed %s emission against %s\n", record.Network,
+		params.Name)
+	var numDiffs int
+	for _, check := range checks {
+		if check.diff == "" {
+			fmt.Fprintf(&report, "%s: match\n", check.name)
+			continue
+		}
+		numDiffs++
+		fmt.Fprintf(&report, "%s: MISMATCH: %s\n", check.name, check.diff)
+	}
+	if numDiffs > 0 {
+		err = fmt.Errorf("%d of %d replayed messages differ from the record",
+			numDiffs, len(checks))
+	}
+	return strings.TrimSuffix(report.String(), "\n"), err
+}
+
+// run records the signed descriptor or replays the record and writes the
+// output.  The report of a replay is written even when the replay differs
+// from the record.
+func run(cfg *config) error {
+	var output string
+	var runErr error
+	if cfg.Replay {
+		output, runErr = replayOutput(cfg)
+	} else {
+		output, runErr = recordOutput(cfg)
+	}
+	if output == "" {
+		return runErr
+	}
+
+	var out io.Writer = os.Stdout
+	if cfg.OutFile != "" {
+		f, err := os.Create(cfg.OutFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	if _, err := fmt.Fprintln(out, output); err != nil {
+		return err
+	}
+	return runErr
+}
+
+// realMain is the real main function for the utility.  It is necessary to work
+// around the fact that deferred functions do not run when os.Exit() is called.
+func realMain() error {
+	// Configuration errors are reported along with the usage when loading.
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if err := run(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "emissionrehearsal: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func main() {
+	if err := realMain(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// recordVersion is the version of the rehearsal record format.
+const recordVersion = 1
+
+// rehearsalRecord houses the exact bytes of the messages involved in an SKA
+// emission on the network it was recorded on.  All byte strings are
+// hex-encoded.
+type rehearsalRecord struct {
+	Version        uint32          `json:"version"`
+	Network        string          `json:"network"`
+	NetID          uint32          `json:"netid"`
+	Descriptor     json.RawMessage `json:"descriptor"`
+	Tx             string          `json:"tx"`
+	TxPrefix       string          `json:"txprefix"`
+	TxHash         string          `json:"txhash"`
+	AuthScript     string          `json:"authscript"`
+	SigningMessage string          `json:"signingmessage"`
+}
+
+// parseRecord decodes and validates the provided JSON-encoded rehearsal
+// record.
+func parseRecord(data []byte) (*rehearsalRecord, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var r rehearsalRecord
+	if err := dec.Decode(&r); err != nil {
+		return nil, fmt.Errorf("invalid rehearsal record: %w", err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("invalid rehearsal record: trailing data")
+	}
+	if r.Version != recordVersion {
+		return nil, fmt.Errorf("unsupported rehearsal record version %d",
+			r.Version)
+	}
+	return &r, nil
+}
+
+// newRecord returns the rehearsal record of the emission described by the
+// provided signed descriptor on the provided network.
+func newRecord(descriptor *blockchain.EmissionAuthDescriptor, chainParams *chaincfg.Params) (*rehearsalRecord, error) {
+	if err := descriptor.Verify(chainParams); err != nil {
+		return nil, err
+	}
+	canonical, err := descriptor.Canonical()
+	if err != nil {
+		return nil, err
+	}
+	auth, err := descriptor.Auth()
+	if err != nil {
+		return nil, err
+	}
+	authScript, err := descriptor.AuthScript()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := descriptor.Transaction(chainParams)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := tx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	txPrefix, err := tx.BytesPrefix()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := blockchain.EmissionSigningMessage(tx, auth, chainParams)
+	if err != nil {
+		return nil, err
+	}
+	return &rehearsalRecord{
+		Version:        recordVersion,
+		Network:        chainParams.Name,
+		NetID:          uint32(chainParams.Net),
+		Descriptor:     canonical,
+		Tx:             hex.EncodeToString(txBytes),
+		TxPrefix:       hex.EncodeToString(txPrefix),
+		TxHash:         tx.TxHash().String(),
+		AuthScript:     hex.EncodeToString(authScript),
+		SigningMessage: hex.EncodeToString(msg),
+	}, nil
+}
+
+// networkParams returns the parameters of the network with the provided name.
+func networkParams(name string) (*chaincfg.Params, error) {
+	for _, params := range []*chaincfg.Params{chaincfg.MainNetParams(),
+		chaincfg.TestNet3Params(), chaincfg.SimNetParams(),
+		chaincfg.RegNetParams()} {
+
+		if params.Name == name {
+			return params, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown network %q", name)
+}
+
+// translateEmissionAddress returns the emission address or output script
+// descriptor that pays to the same output script on the provided target
+// network as the provided one does on the provided source network.  Only
+// plain addresses are network specific, so descriptors are returned as is.
+func translateEmissionAddress(spec string, from, to *chaincfg.Params) (string, error) {
+	output, err := blockchain.ParseEmissionOutput(spec, from)
+	if err != nil {
+		return "", err
+	}
+	if output.Address == nil || output.Descriptor != output.Address.String() {
+		return output.Descriptor, nil
+	}
+	_, addrs := stdscript.ExtractAddrsV0(output.Script, to)
+	if len(addrs) != 1 {
+		return "", fmt.Errorf("emission address %s has no equivalent on %s",
+			spec, to.Name)
+	}
+	return addrs[0].String(), nil
+}
+
+// replayCheck is the result of comparing a message rebuilt while replaying a
+// record to the recorded one.
+type replayCheck struct {
+	name string
+	diff string
+}
+
+// compareBytes compares the provided rebuilt and recorded bytes and returns
+// the result of the comparison along with the offset of the first differing
+// byte, if any.
+func compareBytes(name string, got, want []byte) replayCheck {
+	if bytes.Equal(got, want) {
+		return replayCheck{name: name}
+	}
+	n := len(got)
+	if len(want) < n {
+		n = len(want)
+	}
+	offset := n
+	for i := 0; i < n; i++ {
+		if got[i] != want[i] {
+			offset = i
+			break
+		}
+	}
+	return replayCheck{
+		name: name,
+		diff: fmt.Sprintf("first difference at byte %d (rebuilt %d bytes, "+
+			"recorded %d bytes)", offset, len(got), len(want)),
+	}
+}
+
+// replay rebuilds every message of the provided record on the provided
+// network and compares them to the recorded ones.  The network ID in the
+// signing message is the only part that is expected to differ, so it is
+// excluded from the comparison.  The signature was made for the recorded
+// network, so the rebuilt transaction is not valid on the provided network.
+func replay(record *rehearsalRecord, chainParams *chaincfg.Params) ([]replayCheck, error) {
+	recordParams, err := networkParams(record.Network)
+	if err != nil {
+		return nil, err
+	}
+	if uint32(recordParams.Net) != record.NetID {
+		return nil, fmt.Errorf("recorded network ID %x does not match %s "+
+			"network ID %x", record.NetID, record.Network,
+			uint32(recordParams.Net))
+	}
+	descriptor, err := blockchain.ParseEmissionAuthDescriptor(record.Descriptor)
+	if err != nil {
+		return nil, err
+	}
+	decodeHex := func(name, s string) ([]byte, error) {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recorded %s: %w", name, err)
+		}
+		return b, nil
+	}
+	recTx, err := decodeHex("tx", record.Tx)
+	if err != nil {
+		return nil, err
+	}
+	recTxPrefix, err := decodeHex("txprefix", record.TxPrefix)
+	if err != nil {
+		return nil, err
+	}
+	recAuthScript, err := decodeHex("authscript", record.AuthScript)
+	if err != nil {
+		return nil, err
+	}
+	recMsg, err := decodeHex("signingmessage", record.SigningMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rebuild the emission on the replay network with the addresses
+	// translated to it.
+	replayed := *descriptor
+	replayed.Addresses = make([]string, 0, len(descriptor.Addresses))
+	for _, spec := range descriptor.Addresses {
+		addr, err := translateEmissionAddress(spec, recordParams, chainParams)
+		if err != nil {
+			return nil, err
+		}
+		replayed.Addresses = append(replayed.Addresses, addr)
+	}
+	auth, err := replayed.Auth()
+	if err != nil {
+		return nil, err
+	}
+	authScript, err := replayed.AuthScript()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := replayed.Transaction(chainParams)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := tx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	txPrefix, err := tx.BytesPrefix()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := blockchain.EmissionSigningMessage(tx, auth, chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// The recorded transaction must also survive a deserialization round
+	// trip unchanged.
+	var recMsgTx wire.MsgTx
+	if err := recMsgTx.FromBytes(recTx); err != nil {
+		return nil, fmt.Errorf("invalid recorded tx: %w", err)
+	}
+	roundTrip, err := recMsgTx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	// Exclude the network ID from the signing message comparison.
+	const netIDOffset = blockchain.EmissionSigningMessageNetIDOffset
+	maskNetID := func(msg []byte) []byte {
+		masked := append([]byte(nil), msg...)
+		if len(masked) >= netIDOffset+4 {
+			copy(masked[netIDOffset:netIDOffset+4], make([]byte, 4))
+		}
+		return masked
+	}
+
+	txHash := tx.TxHash()
+	checks := []replayCheck{
+		compareBytes("authscript", authScript, recAuthScript),
+		compareBytes("tx", txBytes, recTx),
+		compareBytes("txprefix", txPrefix, recTxPrefix),
+		compareBytes("txroundtrip", roundTrip, recTx),
+		compareBytes("signingmessage", maskNetID(msg), maskNetID(recMsg)),
+	}
+	hashCheck := replayCheck{name: "txhash"}
+	if !strings.EqualFold(txHash.String(), record.TxHash) {
+		hashCheck.diff = fmt.Sprintf("rebuilt %v, recorded %s", txHash,
+			record.TxHash)
+	}
+	checks = append(checks, hashCheck)
+	return checks, nil
+}
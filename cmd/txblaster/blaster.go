@@ -0,0 +1,346 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"regexp"
+	"sort"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrjson"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-node/rpcclient"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/sign"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+const (
+	// maxSigScriptSize is the maximum size of the signature script that
+	// redeems a pay-to-pubkey-hash output with a compressed public key.  It
+	// is used to estimate the size of transactions before they are signed.
+	maxSigScriptSize = 1 + 73 + 1 + 33
+
+	// maxSpendAttempts is the number of times spending an output may be
+	// rejected before it is no longer considered spendable.
+	maxSpendAttempts = 3
+
+	// defaultFeeRate is the fee rate in atoms per kB used for coin types the
+	// node does not report a minimum relay fee for.
+	defaultFeeRate = 1e4
+)
+
+// spendable is an output controlled by the signing key that is available to
+// be spent.
+type spendable struct {
+	outPoint wire.OutPoint
+	amount   int64
+	attempts int
+}
+
+// coinTypeStats houses the outcome of the transactions generated for a coin
+// type.
+type coinTypeStats struct {
+	sent       int64
+	rejected   int64
+	noInputs   int64
+	exhausted  int64
+	rejections map[string]int64
+}
+
+// blaster generates transactions that spend outputs controlled by a single
+// key back to the same key and sends them to the node.
+//
+// Each transaction spends a single output and splits it into the configured
+// number of outputs, which become spendable as soon as the transaction is
+// accepted, so the rate transactions can be generated at is not limited by
+// the rate blocks are mined at.
+type blaster struct {
+	client        *rpcclient.Client
+	privKey       []byte
+	pkScript      []byte
+	outputs       int
+	feeMultiplier float64
+	rng           *rand.Rand
+	mix           []mixEntry
+	totalWeight   uint32
+	pools         map[cointype.CoinType][]spendable
+	feeRates      map[cointype.CoinType]int64
+	stats         map[cointype.CoinType]*coinTypeStats
+}
+
+// newBlaster returns a blaster that spends outputs controlled by the provided
+// key with the given configuration.
+func newBlaster(client *rpcclient.Client, key *secp256k1.PrivateKey, cfg *config, mix []mixEntry) (*blaster, error) {
+	pkHash := stdaddr.Hash160(key.PubKey().SerializeCompressed())
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(pkHash,
+		activeNetParams)
+	if err != nil {
+		return nil, err
+	}
+	_, pkScript := addr.PaymentScript()
+
+	b := &blaster{
+		client:        client,
+		privKey:       key.Serialize(),
+		pkScript:      pkScript,
+		outputs:       cfg.Outputs,
+		feeMultiplier: cfg.FeeMultiplier,
+		rng:           rand.New(rand.NewSource(cfg.Seed)),
+		mix:           mix,
+		pools:         make(map[cointype.CoinType][]spendable),
+		feeRates:      make(map[cointype.CoinType]int64),
+		stats:         make(map[cointype.CoinType]*coinTypeStats),
+	}
+	for _, entry := range mix {
+		b.totalWeight += entry.weight
+		b.stats[entry.coinType] = &coinTypeStats{
+			rejections: make(map[string]int64),
+		}
+	}
+	return b, nil
+}
+
+// discover loads the outputs of the coin types in the mix that pay the
+// signing key and are mature enough to be spent in the next block from the
+// snapshot of the UTXO set as of the current tip.
+//
+// Since the snapshot does not identify coinbase outputs, all outputs are
+// conservatively required to have reached coinbase maturity.
+func (b *blaster) discover(ctx context.Context) (int, error) {
+	pkScriptHex := hex.EncodeToString(b.pkScript)
+	maturity := int64(activeNetParams.CoinbaseMaturity)
+	var total int
+	for _, entry := range b.mix {
+		params := []json.RawMessage{
+			json.RawMessage(fmt.Sprintf("%d", entry.coinType)),
+			json.RawMessage("null"),
+			json.RawMessage("false"),
+		}
+		result, err := b.client.RawRequest(ctx, "getcointypesnapshot", params)
+		if err != nil {
+			return 0, err
+		}
+		var snapshot types.GetCoinTypeSnapshotResult
+		if err := json.Unmarshal(result, &snapshot); err != nil {
+			return 0, err
+		}
+
+		for _, utxo := range snapshot.Utxos {
+			if utxo.ScriptPubKey != pkScriptHex ||
+				utxo.Tree != wire.TxTreeRegular ||
+				snapshot.Height+1-utxo.Height < maturity {
+
+				continue
+			}
+			hash, err := chainhash.NewHashFromStr(utxo.TxHash)
+			if err != nil {
+				return 0, err
+			}
+			outPoint := wire.OutPoint{
+				Hash:  *hash,
+				Index: utxo.Vout,
+				Tree:  utxo.Tree,
+			}
+			b.pools[entry.coinType] = append(b.pools[entry.coinType],
+				spendable{outPoint: outPoint, amount: utxo.Amount})
+		}
+		total += len(b.pools[entry.coinType])
+	}
+	return total, nil
+}
+
+// updateFeeRates updates the fee rates paid by the generated transactions to
+// the configured multiple of the current minimum relay fee of each coin type,
+// which rises while the mempool of the coin type is full.
+func (b *blaster) updateFeeRates(ctx context.Context) error {
+	result, err := b.client.RawRequest(ctx, "getcointypes", nil)
+	if err != nil {
+		return err
+	}
+	var coinTypes []types.GetCoinTypesResult
+	if err := json.Unmarshal(result, &coinTypes); err != nil {
+		return err
+	}
+	for _, entry := range b.mix {
+		b.feeRates[entry.coinType] = int64(defaultFeeRate * b.feeMultiplier)
+	}
+	for _, info := range coinTypes {
+		coinType := cointype.CoinType(info.CoinType)
+		minRelayFee, err := dcrutil.NewAmountForCoinType(info.MinRelayFee,
+			coinType)
+		if err != nil {
+			return err
+		}
+		b.feeRates[coinType] = int64(float64(minRelayFee) * b.feeMultiplier)
+	}
+	return nil
+}
+
+// pickCoinType randomly selects the coin type of the next transaction based on
+// the weights of the mix.
+func (b *blaster) pickCoinType() cointype.CoinType {
+	n := uint32(b.rng.Int63n(int64(b.totalWeight)))
+	for _, entry := range b.mix {
+		if n < entry.weight {
+			return entry.coinType
+		}
+		n -= entry.weight
+	}
+	return b.mix[len(b.mix)-1].coinType
+}
+
+// hashRegexp and numberRegexp match the hashes and numbers in rejection
+// messages so rejections for the same reason can be grouped.
+var (
+	hashRegexp   = regexp.MustCompile(`[0-9a-f]{64}`)
+	numberRegexp = regexp.MustCompile(`[0-9]+(\.[0-9]+)?`)
+)
+
+// rejectReason returns the reason the node rejected a transaction with the
+// hashes and numbers that are specific to the transaction removed.
+func rejectReason(err error) string {
+	reason := err.Error()
+	var rpcErr *dcrjson.RPCError
+	if errors.As(err, &rpcErr) {
+		reason = rpcErr.Message
+	}
+	reason = hashRegexp.ReplaceAllString(reason, "<hash>")
+	return numberRegexp.ReplaceAllString(reason, "<n>")
+}
+
+// buildTx creates a transaction that spends the provided output of the coin
+// type and splits it into outputs that pay the signing key.  It returns nil
+// when the output is not worth enough to pay the fee and create an output
+// that is not dust.
+func (b *blaster) buildTx(coinType cointype.CoinType, spend *spendable) (*wire.MsgTx, error) {
+	// Outputs are required to be worth at least the fee of a kilobyte, which
+	// comfortably exceeds the dust threshold at the same fee rate.
+	feeRate := b.feeRates[coinType]
+	minOutput := feeRate
+	numOutputs := b.outputs
+	for {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&spend.outPoint, spend.amount, nil))
+		for i := 0; i < numOutputs; i++ {
+			tx.AddTxOut(wire.NewTxOutWithCoinType(0, coinType, b.pkScript))
+		}
+		size := int64(tx.SerializeSize() + maxSigScriptSize)
+		fee := (size*feeRate + 999) / 1000
+		value := (spend.amount - fee) / int64(numOutputs)
+		if value < minOutput {
+			// Fall back to a single output when the output is not worth
+			// enough to be split.
+			if numOutputs == 1 {
+				return nil, nil
+			}
+			numOutputs = 1
+			continue
+		}
+		for i, txOut := range tx.TxOut {
+			txOut.Value = value
+			if i == numOutputs-1 {
+				txOut.Value = spend.amount - fee - value*int64(numOutputs-1)
+			}
+		}
+
+		sigScript, err := sign.SignatureScript(tx, 0, b.pkScript,
+			txscript.SigHashAll, b.privKey, dcrec.STEcdsaSecp256k1, true)
+		if err != nil {
+			return nil, err
+		}
+		tx.TxIn[0].SignatureScript = sigScript
+		return tx, nil
+	}
+}
+
+// sendNext generates a transaction of a randomly selected coin type and sends
+// it to the node.  Rejections are recorded in the statistics of the coin type
+// rather than returned since they are expected while stress testing.
+func (b *blaster) sendNext(ctx context.Context) error {
+	coinType := b.pickCoinType()
+	stats := b.stats[coinType]
+	pool := b.pools[coinType]
+	if len(pool) == 0 {
+		stats.noInputs++
+		return nil
+	}
+	spend := pool[0]
+	b.pools[coinType] = pool[1:]
+
+	tx, err := b.buildTx(coinType, &spend)
+	if err != nil {
+		return err
+	}
+	if tx == nil {
+		stats.exhausted++
+		return nil
+	}
+
+	txHash, err := b.client.SendRawTransaction(ctx, tx, false)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		stats.rejected++
+		stats.rejections[rejectReason(err)]++
+
+		// Retry spending the output later since the rejection may be due to
+		// temporary conditions such as a full mempool.
+		spend.attempts++
+		if spend.attempts < maxSpendAttempts {
+			b.pools[coinType] = append(b.pools[coinType], spend)
+		}
+		return nil
+	}
+
+	stats.sent++
+	for i, txOut := range tx.TxOut {
+		outPoint := wire.OutPoint{
+			Hash:  *txHash,
+			Index: uint32(i),
+			Tree:  wire.TxTreeRegular,
+		}
+		b.pools[coinType] = append(b.pools[coinType],
+			spendable{outPoint: outPoint, amount: txOut.Value})
+	}
+	return nil
+}
+
+// writeSummary writes the outcome of the transactions generated for each coin
+// type in the mix along with the reasons they were rejected.
+func (b *blaster) writeSummary(w io.Writer) {
+	fmt.Fprintln(w, "Summary:")
+	for _, entry := range b.mix {
+		stats := b.stats[entry.coinType]
+		fmt.Fprintf(w, "  %-8s sent %d, rejected %d, no inputs %d, "+
+			"exhausted inputs %d, spendable outputs %d\n", entry.coinType,
+			stats.sent, stats.rejected, stats.noInputs, stats.exhausted,
+			len(b.pools[entry.coinType]))
+
+		reasons := make([]string, 0, len(stats.rejections))
+		for reason := range stats.rejections {
+			reasons = append(reasons, reason)
+		}
+		sort.Slice(reasons, func(i, j int) bool {
+			return stats.rejections[reasons[i]] > stats.rejections[reasons[j]]
+		})
+		for _, reason := range reasons {
+			fmt.Fprintf(w, "    %6d  %s\n", stats.rejections[reason], reason)
+		}
+	}
+}
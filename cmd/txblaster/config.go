@@ -0,0 +1,205 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+)
+
+const (
+	defaultRate           = 10
+	defaultOutputs        = 2
+	defaultFeeMultiplier  = 1
+	defaultReportInterval = 10 * time.Second
+)
+
+var (
+	monetariumHomeDir  = dcrutil.AppDataDir("monetarium", false)
+	defaultRPCCertFile = filepath.Join(monetariumHomeDir, "rpc.cert")
+	activeNetParams    *chaincfg.Params
+
+	// defaultMix is the mix of transactions generated when none is
+	// specified.
+	defaultMix = []string{"0:1"}
+)
+
+// Default RPC ports of the supported networks.
+const (
+	defaultTestNetRPCPort = "19109"
+	defaultSimNetRPCPort  = "19556"
+)
+
+// config defines the configuration options for txblaster.
+//
+// See loadConfig for details on the configuration load process.
+type config struct {
+	TestNet        bool          `long:"testnet" description:"Use the test network"`
+	SimNet         bool          `long:"simnet" description:"Use the simulation test network"`
+	RPCServer      string        `short:"s" long:"rpcserver" description:"RPC server to connect to (default: localhost on the default RPC port of the network)"`
+	RPCUser        string        `short:"u" long:"rpcuser" description:"RPC username"`
+	RPCPass        string        `short:"P" long:"rpcpass" default-mask:"-" description:"RPC password"`
+	RPCCert        string        `short:"c" long:"rpccert" description:"RPC server certificate chain for validation"`
+	NoTLS          bool          `long:"notls" description:"Disable TLS"`
+	SignKey        string        `short:"k" long:"signkey" description:"File containing the hex-encoded secp256k1 private key that controls the outputs to spend -- mature outputs paying its pay-to-pubkey-hash address are spent"`
+	Mix            []string      `short:"m" long:"mix" description:"Coin type and relative weight of the transactions to generate in the form cointype:weight, for example 0:1 and 1:3 to generate three SKA-1 transactions for each VAR transaction -- may be specified multiple times (default: 0:1)"`
+	Rate           float64       `short:"r" long:"rate" description:"Target number of transactions to send per second"`
+	Duration       time.Duration `short:"d" long:"duration" description:"How long to send transactions (default: until interrupted)"`
+	Outputs        int           `long:"outputs" description:"Number of outputs each transaction splits its input into"`
+	FeeMultiplier  float64       `long:"feemultiplier" description:"Multiple of the current minimum relay fee of the coin type to pay"`
+	ReportInterval time.Duration `long:"reportinterval" description:"Interval between reports of the mempool and block space allocation"`
+	BlockInterval  time.Duration `long:"blockinterval" description:"Interval between blocks to mine with the generate RPC on simnet (default: do not mine blocks)"`
+	Seed           int64         `long:"seed" description:"Seed for the random selection of coin types (default: current time)"`
+}
+
+// mixEntry describes the relative weight of the transactions of a coin type in
+// the generated mix.
+type mixEntry struct {
+	coinType cointype.CoinType
+	weight   uint32
+}
+
+// parseMixEntry parses a mix entry in the form cointype:weight.
+func parseMixEntry(s string) (mixEntry, error) {
+	coinTypeStr, weightStr, ok := strings.Cut(s, ":")
+	if !ok {
+		return mixEntry{}, fmt.Errorf("mix entry %q is not in the form "+
+			"cointype:weight", s)
+	}
+	coinType, err := strconv.ParseUint(coinTypeStr, 10, 8)
+	if err != nil {
+		return mixEntry{}, fmt.Errorf("mix entry %q has a coin type that "+
+			"is not between 0 and 255", s)
+	}
+	weight, err := strconv.ParseUint(weightStr, 10, 32)
+	if err != nil || weight == 0 {
+		return mixEntry{}, fmt.Errorf("mix entry %q does not have a "+
+			"positive weight", s)
+	}
+	return mixEntry{
+		coinType: cointype.CoinType(coinType),
+		weight:   uint32(weight),
+	}, nil
+}
+
+// loadConfig initializes and parses the config using command line options.
+func loadConfig() (*config, []mixEntry, error) {
+	// Default config.
+	cfg := config{
+		RPCCert:        defaultRPCCertFile,
+		Rate:           defaultRate,
+		Outputs:        defaultOutputs,
+		FeeMultiplier:  defaultFeeMultiplier,
+		ReportInterval: defaultReportInterval,
+		Seed:           time.Now().UnixNano(),
+	}
+
+	// Parse command line options.
+	parser := flags.NewParser(&cfg, flags.Default)
+	_, err := parser.Parse()
+	if err != nil {
+		var e *flags.Error
+		if !errors.As(err, &e) || e.Type != flags.ErrHelp {
+			parser.WriteHelp(os.Stderr)
+		}
+		return nil, nil, err
+	}
+
+	// usageErr prints the provided error along with the usage and returns
+	// it.
+	usageErr := func(format string, args ...interface{}) error {
+		err := fmt.Errorf("loadConfig: "+format, args...)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return err
+	}
+
+	// Exactly one of the test networks must be selected since flooding the
+	// main network is never intended.
+	var rpcPort string
+	switch {
+	case cfg.TestNet && cfg.SimNet:
+		return nil, nil, usageErr("the testnet and simnet params can't be " +
+			"used together -- choose one of the two")
+	case cfg.TestNet:
+		activeNetParams = chaincfg.TestNet3Params()
+		rpcPort = defaultTestNetRPCPort
+	case cfg.SimNet:
+		activeNetParams = chaincfg.SimNetParams()
+		rpcPort = defaultSimNetRPCPort
+	default:
+		return nil, nil, usageErr("one of --testnet or --simnet must be " +
+			"specified")
+	}
+
+	// Add the default port of the network when none is specified.
+	if cfg.RPCServer == "" {
+		cfg.RPCServer = "localhost"
+	}
+	if _, _, err := net.SplitHostPort(cfg.RPCServer); err != nil {
+		cfg.RPCServer = net.JoinHostPort(cfg.RPCServer, rpcPort)
+	}
+	if cfg.RPCUser == "" || cfg.RPCPass == "" {
+		return nil, nil, usageErr("the RPC username and password must be " +
+			"specified")
+	}
+	if cfg.SignKey == "" {
+		return nil, nil, usageErr("the signing key must be specified")
+	}
+
+	if cfg.Rate <= 0 {
+		return nil, nil, usageErr("the rate must be positive")
+	}
+	if cfg.Duration < 0 {
+		return nil, nil, usageErr("the duration may not be negative")
+	}
+	if cfg.Outputs < 1 {
+		return nil, nil, usageErr("each transaction must have at least one " +
+			"output")
+	}
+	if cfg.FeeMultiplier < 1 {
+		return nil, nil, usageErr("the fee multiplier must be at least 1")
+	}
+	if cfg.ReportInterval <= 0 {
+		return nil, nil, usageErr("the report interval must be positive")
+	}
+	if cfg.BlockInterval < 0 {
+		return nil, nil, usageErr("the block interval may not be negative")
+	}
+	if cfg.BlockInterval > 0 && !cfg.SimNet {
+		return nil, nil, usageErr("blocks may only be mined on simnet")
+	}
+
+	if len(cfg.Mix) == 0 {
+		cfg.Mix = defaultMix
+	}
+	mix := make([]mixEntry, 0, len(cfg.Mix))
+	seen := make(map[cointype.CoinType]struct{}, len(cfg.Mix))
+	for _, s := range cfg.Mix {
+		entry, err := parseMixEntry(s)
+		if err != nil {
+			return nil, nil, usageErr("%v", err)
+		}
+		if _, ok := seen[entry.coinType]; ok {
+			return nil, nil, usageErr("coin type %d is specified more than "+
+				"once in the mix", entry.coinType)
+		}
+		seen[entry.coinType] = struct{}{}
+		mix = append(mix, entry)
+	}
+
+	return &cfg, mix, nil
+}
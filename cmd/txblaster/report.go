@@ -0,0 +1,178 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-node/rpcclient"
+)
+
+// blockUsage houses the block space used by and allocated to a coin type over
+// the blocks mined since the previous report.
+type blockUsage struct {
+	numTxns        uint32
+	totalBytes     uint32
+	allocatedBytes uint32
+}
+
+// reporter periodically reports the behavior of the mempool and the block
+// space allocation of the node while transactions are generated.
+type reporter struct {
+	client        *rpcclient.Client
+	blaster       *blaster
+	start         time.Time
+	lastHeight    int64
+	lastBlockTime time.Time
+	lastSent      map[cointype.CoinType]int64
+}
+
+// newReporter returns a reporter that reports the activity since the current
+// tip of the node.
+func newReporter(ctx context.Context, client *rpcclient.Client, b *blaster) (*reporter, error) {
+	_, height, err := client.GetBestBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &reporter{
+		client:        client,
+		blaster:       b,
+		start:         now,
+		lastHeight:    height,
+		lastBlockTime: now,
+		lastSent:      make(map[cointype.CoinType]int64),
+	}, nil
+}
+
+// blockUsage returns the block space used by and allocated to each coin type
+// over the blocks after the last reported height up to and including the
+// provided height.
+func (r *reporter) blockUsage(ctx context.Context, height int64) (map[cointype.CoinType]*blockUsage, error) {
+	usage := make(map[cointype.CoinType]*blockUsage)
+	for h := r.lastHeight + 1; h <= height; h++ {
+		hash, err := r.client.GetBlockHash(ctx, h)
+		if err != nil {
+			return nil, err
+		}
+		params := []json.RawMessage{json.RawMessage(`"` + hash.String() + `"`)}
+		result, err := r.client.RawRequest(ctx, "getblockstats", params)
+		if err != nil {
+			return nil, err
+		}
+		var stats types.GetBlockStatsResult
+		if err := json.Unmarshal(result, &stats); err != nil {
+			return nil, err
+		}
+		for _, ct := range stats.CoinTypes {
+			coinType := cointype.CoinType(ct.CoinType)
+			u := usage[coinType]
+			if u == nil {
+				u = new(blockUsage)
+				usage[coinType] = u
+			}
+			u.numTxns += ct.NumTxns
+			u.totalBytes += ct.TotalBytes
+			u.allocatedBytes += ct.AllocatedBytes
+		}
+	}
+	return usage, nil
+}
+
+// report writes the transactions sent since the previous report along with
+// the current contents of the mempool and the block space used by and
+// allocated to each coin type in the blocks mined since the previous report.
+//
+// Coin types with transactions in the mempool that were not included in any of
+// the blocks mined since the previous report are flagged as starved since that
+// is the symptom of the allocator stalling a coin type.
+func (r *reporter) report(ctx context.Context, w io.Writer) error {
+	_, height, err := r.client.GetBestBlock(ctx)
+	if err != nil {
+		return err
+	}
+	usage, err := r.blockUsage(ctx, height)
+	if err != nil {
+		return err
+	}
+	result, err := r.client.RawRequest(ctx, "getmempoolfeesinfo", nil)
+	if err != nil {
+		return err
+	}
+	var mempool types.GetMempoolFeesInfoResult
+	if err := json.Unmarshal(result, &mempool); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	newBlocks := height - r.lastHeight
+	if newBlocks > 0 {
+		r.lastBlockTime = now
+	}
+	fmt.Fprintf(w, "[%6s] height %d (+%d blocks, last %s ago), mempool %d "+
+		"txns %d bytes\n", now.Sub(r.start).Round(time.Second), height,
+		newBlocks, now.Sub(r.lastBlockTime).Round(time.Second),
+		mempool.TotalTxCount, mempool.TotalSize)
+
+	// Report the coin types in the mix along with any others that are in the
+	// mempool or were mined.
+	mempoolByCoinType := make(map[cointype.CoinType]types.MempoolCoinTypeFeeInfo)
+	for _, info := range mempool.CoinTypes {
+		mempoolByCoinType[cointype.CoinType(info.CoinType)] = info
+	}
+	seen := make(map[cointype.CoinType]struct{})
+	var coinTypes []cointype.CoinType
+	addCoinType := func(coinType cointype.CoinType) {
+		if _, ok := seen[coinType]; !ok {
+			seen[coinType] = struct{}{}
+			coinTypes = append(coinTypes, coinType)
+		}
+	}
+	for _, entry := range r.blaster.mix {
+		addCoinType(entry.coinType)
+	}
+	for coinType := range mempoolByCoinType {
+		addCoinType(coinType)
+	}
+	for coinType := range usage {
+		addCoinType(coinType)
+	}
+	sort.Slice(coinTypes, func(i, j int) bool {
+		return coinTypes[i] < coinTypes[j]
+	})
+
+	for _, coinType := range coinTypes {
+		var sent, rejected int64
+		if stats, ok := r.blaster.stats[coinType]; ok {
+			sent = stats.sent - r.lastSent[coinType]
+			rejected = stats.rejected
+			r.lastSent[coinType] = stats.sent
+		}
+		info := mempoolByCoinType[coinType]
+		u := usage[coinType]
+		if u == nil {
+			u = new(blockUsage)
+		}
+		var starved string
+		if newBlocks > 0 && info.TxCount > 0 && u.numTxns == 0 {
+			starved = " STARVED"
+		}
+		fmt.Fprintf(w, "  %-8s sent %d (%d rejected total) | mempool %d txns "+
+			"%d bytes, utilization %.1f%% | mined %d txns %d/%d bytes%s\n",
+			coinType, sent, rejected, info.TxCount, info.TotalSize,
+			info.UtilizationRate, u.numTxns, u.totalBytes, u.allocatedBytes,
+			starved)
+	}
+
+	r.lastHeight = height
+	return nil
+}
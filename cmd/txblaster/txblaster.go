@@ -0,0 +1,185 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// txblaster stress tests the multi-coin mempool and block space allocator of a
+// running simnet or testnet node by generating a configurable mix of VAR and
+// SKA transactions at a target rate and sending them by way of RPC.
+//
+// The transactions spend mature outputs that pay the pay-to-pubkey-hash
+// address of a signing key, which are found by way of the getcointypesnapshot
+// RPC, and split them into new outputs paying the same address that are
+// spent in turn without waiting for them to be mined.
+//
+// The transactions sent, the contents of the mempool, and the block space used
+// by and allocated to each coin type are reported periodically so scenarios
+// such as a coin type being starved of block space can be reproduced and
+// observed.  A summary of the rejections by reason is written on exit.
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/rpcclient"
+)
+
+// loadSignKey reads the hex-encoded secp256k1 private key from the provided
+// file.
+func loadSignKey(path string) (*secp256k1.PrivateKey, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err := hex.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return nil, fmt.Errorf("malformed signing key: %w", err)
+	}
+	if len(keyBytes) != secp256k1.PrivKeyBytesLen {
+		return nil, fmt.Errorf("signing key is %d bytes instead of %d",
+			len(keyBytes), secp256k1.PrivKeyBytesLen)
+	}
+	return secp256k1.PrivKeyFromBytes(keyBytes), nil
+}
+
+// run generates transactions until the configured duration elapses or the
+// context is canceled while periodically reporting the behavior of the node.
+func run(ctx context.Context, cfg *config, mix []mixEntry) error {
+	signKey, err := loadSignKey(cfg.SignKey)
+	if err != nil {
+		return err
+	}
+
+	connCfg := &rpcclient.ConnConfig{
+		Host:         cfg.RPCServer,
+		Endpoint:     "ws",
+		User:         cfg.RPCUser,
+		Pass:         cfg.RPCPass,
+		DisableTLS:   cfg.NoTLS,
+		HTTPPostMode: true,
+	}
+	if !cfg.NoTLS {
+		certs, err := os.ReadFile(cfg.RPCCert)
+		if err != nil {
+			return err
+		}
+		connCfg.Certificates = certs
+	}
+	client, err := rpcclient.New(connCfg, nil)
+	if err != nil {
+		return err
+	}
+	defer client.Shutdown()
+
+	b, err := newBlaster(client, signKey, cfg, mix)
+	if err != nil {
+		return err
+	}
+	numSpendable, err := b.discover(ctx)
+	if err != nil {
+		return err
+	}
+	if numSpendable == 0 {
+		return errors.New("no mature outputs of the coin types in the mix " +
+			"pay the signing key")
+	}
+	if err := b.updateFeeRates(ctx); err != nil {
+		return err
+	}
+	r, err := newReporter(ctx, client, b)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Sending %.2f transactions per second on %s with %d "+
+		"spendable outputs\n", cfg.Rate, activeNetParams.Name, numSpendable)
+
+	sendCtx := ctx
+	if cfg.Duration > 0 {
+		var cancel context.CancelFunc
+		sendCtx, cancel = context.WithTimeout(ctx, cfg.Duration)
+		defer cancel()
+	}
+
+	sendInterval := time.Duration(float64(time.Second) / cfg.Rate)
+	if sendInterval <= 0 {
+		sendInterval = 1
+	}
+	sendTicker := time.NewTicker(sendInterval)
+	defer sendTicker.Stop()
+	reportTicker := time.NewTicker(cfg.ReportInterval)
+	defer reportTicker.Stop()
+
+	// Only mine blocks when requested.  A nil channel is never ready.
+	var blockC <-chan time.Time
+	if cfg.BlockInterval > 0 {
+		blockTicker := time.NewTicker(cfg.BlockInterval)
+		defer blockTicker.Stop()
+		blockC = blockTicker.C
+	}
+
+	for {
+		select {
+		case <-sendTicker.C:
+			if err := b.sendNext(sendCtx); err != nil {
+				return err
+			}
+
+		// Errors due to the context being canceled during a request are
+		// ignored so the final report is still written.
+		case <-reportTicker.C:
+			err := b.updateFeeRates(sendCtx)
+			if err == nil {
+				err = r.report(sendCtx, os.Stdout)
+			}
+			if err != nil && sendCtx.Err() == nil {
+				return err
+			}
+
+		case <-blockC:
+			_, err := client.Generate(sendCtx, 1)
+			if err != nil && sendCtx.Err() == nil {
+				return err
+			}
+
+		case <-sendCtx.Done():
+			// Report the final state even when the context was canceled
+			// since the report is the purpose of the run.
+			finalCtx := context.WithoutCancel(ctx)
+			if err := r.report(finalCtx, os.Stdout); err != nil {
+				return err
+			}
+			b.writeSummary(os.Stdout)
+			return nil
+		}
+	}
+}
+
+func realMain() error {
+	// Configuration errors are reported along with the usage when loading.
+	cfg, mix, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := run(ctx, cfg, mix); err != nil {
+		fmt.Fprintf(os.Stderr, "txblaster: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func main() {
+	if err := realMain(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -7,6 +7,7 @@ package cointype
 import (
 	"errors"
 	"fmt"
+	"sort"
 )
 
 // CoinType represents the type of native coin in the Monetarium network.
@@ -141,6 +142,26 @@ func ValidateCoinType(ct CoinType) error {
 	return nil
 }
 
+// Sort sorts the provided coin types in ascending order, which is the canonical
+// order used whenever the order in which coin types are processed affects the
+// outcome, such as the distribution of remainders or which error is reported.
+func Sort(coinTypes []CoinType) {
+	sort.Slice(coinTypes, func(i, j int) bool {
+		return coinTypes[i] < coinTypes[j]
+	})
+}
+
+// SortedKeys returns the coin types that are keys of the provided map in
+// ascending order so the map can be iterated deterministically.
+func SortedKeys[V any](m map[CoinType]V) []CoinType {
+	coinTypes := make([]CoinType, 0, len(m))
+	for coinType := range m {
+		coinTypes = append(coinTypes, coinType)
+	}
+	Sort(coinTypes)
+	return coinTypes
+}
+
 // ErrInvalidCoinType is returned when an invalid coin type is encountered.
 var ErrInvalidCoinType = errors.New("invalid coin type")
 
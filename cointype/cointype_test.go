@@ -5,6 +5,7 @@
 package cointype
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -206,3 +207,27 @@ func TestValidateCoinType(t *testing.T) {
 		}
 	}
 }
+
+func TestSortedKeys(t *testing.T) {
+	m := map[CoinType]uint32{
+		CoinType(3):   300,
+		CoinTypeVAR:   100,
+		CoinType(255): 50,
+		CoinType(1):   200,
+	}
+	want := []CoinType{CoinTypeVAR, CoinType(1), CoinType(3), CoinType(255)}
+
+	// Map iteration order is randomized, so ensure the keys are returned in
+	// the same order every time.
+	for i := 0; i < 100; i++ {
+		if got := SortedKeys(m); !reflect.DeepEqual(got, want) {
+			t.Fatalf("SortedKeys() = %v, expected %v", got, want)
+		}
+	}
+
+	coinTypes := []CoinType{CoinType(2), CoinTypeVAR, CoinType(1)}
+	Sort(coinTypes)
+	if want := []CoinType{CoinTypeVAR, CoinType(1), CoinType(2)}; !reflect.DeepEqual(coinTypes, want) {
+		t.Fatalf("Sort() = %v, expected %v", coinTypes, want)
+	}
+}
@@ -10,9 +10,11 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -26,11 +28,19 @@ import (
 	"github.com/decred/go-socks/socks"
 	"github.com/decred/slog"
 	flags "github.com/jessevdk/go-flags"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/connmgr"
 	"github.com/monetarium/monetarium-node/database"
 	_ "github.com/monetarium/monetarium-node/database/ffldb"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
 	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+	"github.com/monetarium/monetarium-node/internal/eventsink"
+	"github.com/monetarium/monetarium-node/internal/fees"
 	"github.com/monetarium/monetarium-node/internal/mempool"
+	"github.com/monetarium/monetarium-node/internal/rpcserver"
 	"github.com/monetarium/monetarium-node/internal/version"
 	"github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
 	"github.com/monetarium/monetarium-node/sampleconfig"
@@ -50,6 +60,9 @@ const (
 	defaultUtxoCacheMaxSize = 150
 	minUtxoCacheMaxSize     = 25
 	maxUtxoCacheMaxSize     = 32768 // 32 GiB
+	maxMempoolMaxSizeMB     = 32768 // 32 GiB
+	maxMempoolChainTxns     = 1000
+	maxMempoolChainSizeKB   = 1000000 // 1 GB
 
 	// Defaults for RPC server options and policy.
 	defaultTLSCurve             = "P-256"
@@ -77,6 +90,25 @@ const (
 	blockMaxSizeMin            = 1000
 	defaultNoMiningStateSync   = false
 	defaultAllowUnsyncedMining = false
+	maxSSFeeConsolidationInt   = 8640
+
+	// Defaults for rebroadcast options.
+	defaultRebroadcastMaxUtilization = 0.95
+
+	// Defaults for SKA emission watchtower options.
+	defaultEmissionWatchLead = 288
+
+	// Defaults for SKA emission finality options.
+	defaultEmissionFinalConfs = 6
+
+	// Defaults for UTXO supply scanner options.
+	defaultSupplyScanInterval = 6 * time.Hour
+
+	// Defaults for event sink options.
+	defaultEventSinkFeeSpike = 2.0
+
+	// Defaults for block space allocation divergence options.
+	defaultAllocDivergenceMargin = 50
 
 	// Defaults for indexing options.
 	defaultTxIndex           = false
@@ -130,7 +162,7 @@ type config struct {
 	UtxoCacheMaxSize uint   `long:"utxocachemaxsize" description:"The maximum size in MiB of the utxo cache; (min: 25, max: 32768)"`
 
 	// RPC server options and policy.
-	DisableRPC           bool     `long:"norpc" description:"Disable built-in RPC server -- NOTE: The RPC server is disabled by default if no rpcuser/rpcpass or rpclimituser/rpclimitpass is specified"`
+	DisableRPC           bool     `long:"norpc" description:"Disable built-in RPC server -- NOTE: The RPC server is disabled by default if no rpcuser/rpcpass, rpclimituser/rpclimitpass, or rpcauth is specified"`
 	RPCListeners         []string `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 9109, testnet: 19109)"`
 	RPCUser              string   `short:"u" long:"rpcuser" description:"Username for RPC connections"`
 	RPCPass              string   `short:"P" long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
@@ -138,6 +170,7 @@ type config struct {
 	RPCClientCAs         string   `long:"clientcafile" description:"File containing Certificate Authorities to verify TLS client certificates; requires authtype=clientcert"`
 	RPCLimitUser         string   `long:"rpclimituser" description:"Username for limited RPC connections"`
 	RPCLimitPass         string   `long:"rpclimitpass" default-mask:"-" description:"Password for limited RPC connections"`
+	RPCAuth              []string `long:"rpcauth" default-mask:"-" description:"Add RPC credentials that are only granted the specified permission scopes in the form <user>:<pass>:<scope>[,<scope>...] -- Valid scopes: read (methods safe for limited users), admin (all methods other than SKA administrative ones), skaadmin (SKA administrative methods such as emission helpers and watch list management)"`
 	RPCCert              string   `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey               string   `long:"rpckey" description:"File containing the certificate key"`
 	TLSCurve             string   `long:"tlscurve" description:"Curve to use when generating TLS keypairs"`
@@ -175,10 +208,11 @@ type config struct {
 	Upnp           bool     `long:"upnp" description:"Use UPnP to map our listening port outside of NAT"`
 
 	// Banning options.
-	DisableBanning bool          `long:"nobanning" description:"Disable banning of misbehaving peers"`
-	BanDuration    time.Duration `long:"banduration" description:"How long to ban misbehaving peers.  Valid time units are {s, m, h}.  Minimum 1 second"`
-	BanThreshold   uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers"`
-	Whitelists     []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned (eg. 192.168.1.0/24 or ::1)"`
+	DisableBanning      bool          `long:"nobanning" description:"Disable banning of misbehaving peers"`
+	BanDuration         time.Duration `long:"banduration" description:"How long to ban misbehaving peers.  Valid time units are {s, m, h}.  Minimum 1 second"`
+	BanThreshold        uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers"`
+	NoPersistPeerPolicy bool          `long:"nopersistpeerpolicy" description:"Do not save the bans and per coin type misbehavior scores of remote hosts to disk on shutdown and restore them on startup"`
+	Whitelists          []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned (eg. 192.168.1.0/24 or ::1)"`
 
 	// Chain related options.
 	AllowOldForks  bool   `long:"allowoldforks" description:"Process forks deep in history.  Don't do this unless you know what you're doing"`
@@ -189,28 +223,106 @@ type config struct {
 	MinRelayTxFee    float64 `long:"minrelaytxfee" description:"The minimum transaction fee in VAR/kB to be considered a non-zero fee"`
 	FreeTxRelayLimit float64 `long:"limitfreerelay" description:"DEPRECATED: This behavior is no longer available and this option will be removed in a future version of the software"`
 	NoRelayPriority  bool    `long:"norelaypriority" description:"DEPRECATED: This behavior is no longer available and this option will be removed in a future version of the software"`
-	MaxOrphanTxs     int     `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
+	MaxOrphanTxs     int     `long:"maxorphantx" description:"Max number of orphan VAR transactions to keep in memory, which also limits the combined number of orphan SKA transactions.  0 to disable orphans"`
 	BlocksOnly       bool    `long:"blocksonly" description:"Do not accept transactions from remote peers"`
 	AcceptNonStd     bool    `long:"acceptnonstd" description:"Accept and relay non-standard transactions to the network regardless of the default settings for the active network"`
 	RejectNonStd     bool    `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network"`
 	AllowOldVotes    bool    `long:"allowoldvotes" description:"Enable the addition of very old votes to the mempool"`
+	NoPersistMempool bool    `long:"nopersistmempool" description:"Do not save the mempool to disk on shutdown and restore it on startup"`
+
+	// Block space utilization history.
+	UtilizationHistory int `long:"utilizationhistory" description:"Number of recent blocks whose block space utilization per coin type is kept on disk to seed the dynamic fee multipliers after a restart and for the getrecentutilization RPC.  0 to disable"`
+
+	// Orphan SKA transaction policy.
+	SKAMaxOrphanTxs int           `long:"skamaxorphantx" description:"Max number of orphan transactions of each SKA coin type to keep in memory"`
+	SKAOrphanExpiry time.Duration `long:"skaorphanexpiry" description:"How long an orphan SKA transaction may remain in memory before it expires.  Orphans of SKA coin types that have not been emitted yet expire after at most 1 minute.  Valid time units are {s, m, h}.  Minimum 1 minute"`
+
+	// Null data (OP_RETURN) relay policy.
+	MaxNullDataSize       int `long:"maxnulldatasize" description:"Max number of bytes of data a null data (OP_RETURN) output of a VAR transaction may carry to be considered standard"`
+	MaxNullDataOutputs    int `long:"maxnulldataoutputs" description:"Max number of null data (OP_RETURN) outputs a VAR transaction may have to be considered standard"`
+	SKAMaxNullDataSize    int `long:"skamaxnulldatasize" description:"Max number of bytes of data a null data (OP_RETURN) output of an SKA transaction may carry to be considered standard"`
+	SKAMaxNullDataOutputs int `long:"skamaxnulldataoutputs" description:"Max number of null data (OP_RETURN) outputs an SKA transaction may have to be considered standard"`
+
+	// Mempool expiry policy.
+	MempoolExpiry         time.Duration `long:"mempoolexpiry" description:"How long a regular VAR transaction may remain in the mempool before it expires and is evicted.  Valid time units are {s, m, h}.  Minimum 1 minute"`
+	SKAMempoolExpiry      time.Duration `long:"skamempoolexpiry" description:"How long a regular SKA transaction may remain in the mempool before it expires and is evicted.  Valid time units are {s, m, h}.  Minimum 1 minute"`
+	CoinTypeMempoolExpiry []string      `long:"cointypemempoolexpiry" description:"Override how long regular transactions of a specific coin type may remain in the mempool before they expire in the form <cointype>:<duration>.  Minimum 1 minute"`
+
+	// Mempool size limits.
+	MempoolMaxSize         int64    `long:"mempoolmaxsize" description:"Max number of megabytes of regular VAR transactions the mempool may hold before the transactions paying the lowest fee rates are evicted and the mempool minimum fee is raised.  0 to disable"`
+	SKAMempoolMaxSize      int64    `long:"skamempoolmaxsize" description:"Max number of megabytes of regular SKA transactions the mempool may hold for each SKA coin type before the transactions paying the lowest fee rates are evicted and the mempool minimum fee of the coin type is raised.  0 to disable"`
+	CoinTypeMempoolMaxSize []string `long:"cointypemempoolmaxsize" description:"Override the max number of megabytes of regular transactions of a specific coin type the mempool may hold in the form <cointype>:<MB>.  0 to disable"`
+
+	// Mempool chain limits.
+	MempoolMaxAncestors         int      `long:"mempoolmaxancestors" description:"Max number of transactions a new regular VAR transaction and its unconfirmed ancestors in the mempool may number"`
+	MempoolMaxAncestorSize      int64    `long:"mempoolmaxancestorsize" description:"Max number of kilobytes a new regular VAR transaction and its unconfirmed ancestors in the mempool may total"`
+	MempoolMaxDescendants       int      `long:"mempoolmaxdescendants" description:"Max number of transactions an unconfirmed regular VAR transaction and its unconfirmed descendants in the mempool may number"`
+	MempoolMaxDescendantSize    int64    `long:"mempoolmaxdescendantsize" description:"Max number of kilobytes an unconfirmed regular VAR transaction and its unconfirmed descendants in the mempool may total"`
+	SKAMempoolMaxAncestors      int      `long:"skamempoolmaxancestors" description:"Max number of transactions a new regular SKA transaction and its unconfirmed ancestors in the mempool may number"`
+	SKAMempoolMaxAncestorSize   int64    `long:"skamempoolmaxancestorsize" description:"Max number of kilobytes a new regular SKA transaction and its unconfirmed ancestors in the mempool may total"`
+	SKAMempoolMaxDescendants    int      `long:"skamempoolmaxdescendants" description:"Max number of transactions an unconfirmed regular SKA transaction and its unconfirmed descendants in the mempool may number"`
+	SKAMempoolMaxDescendantSize int64    `long:"skamempoolmaxdescendantsize" description:"Max number of kilobytes an unconfirmed regular SKA transaction and its unconfirmed descendants in the mempool may total"`
+	CoinTypeMempoolChainLimits  []string `long:"cointypemempoolchainlimits" description:"Override the chain limits of regular transactions of a specific coin type in the form <cointype>:<ancestors>:<ancestor kB>:<descendants>:<descendant kB>"`
+
+	// Minimum fee transaction relay rate limits.
+	SKAPeerMinFeeRelayLimit  float64  `long:"skapeerminfeerelaylimit" description:"Kilobytes per minute of regular SKA transactions paying the minimum relay fee that a single peer may relay for each SKA coin type.  0 to disable"`
+	SKAMinFeeRelayLimit      float64  `long:"skaminfeerelaylimit" description:"Kilobytes per minute of regular SKA transactions paying the minimum relay fee that all peers combined may relay for each SKA coin type.  0 to disable"`
+	CoinTypeMinFeeRelayLimit []string `long:"cointypeminfeerelaylimit" description:"Override the relay rate limits for regular transactions of a specific coin type paying the minimum relay fee in the form <cointype>:<peer kB/min>:<total kB/min>.  0 to disable"`
+
+	// Rebroadcast options.
+	RebroadcastMaxUtilization float64 `long:"rebroadcastmaxutilization" description:"Fraction of the block space allocated to a coin type in use at or above which rebroadcasts of transactions of the coin type submitted through the RPC server are postponed.  0 to disable"`
 
 	// Mining options and policy.
-	Generate            bool     `long:"generate" description:"Generate (mine) coins using the CPU"`
-	MiningAddrs         []string `long:"miningaddr" description:"Add the specified payment address to the list of addresses to use for generated blocks.  At least one address is required if the generate option is set"`
-	BlockMinSize        uint32   `long:"blockminsize" description:"DEPRECATED: This behavior is no longer available and this option will be removed in a future version of the software"`
-	BlockMaxSize        uint32   `long:"blockmaxsize" description:"Maximum block size in bytes to be used when creating a block"`
-	BlockPrioritySize   uint32   `long:"blockprioritysize" description:"DEPRECATED: This behavior is no longer available and this option will be removed in a future version of the software"`
-	MiningTimeOffset    int      `long:"miningtimeoffset" description:"Offset the mining timestamp of a block by this many seconds (positive values are in the past)"`
-	NonAggressive       bool     `long:"nonaggressive" description:"Disable mining off of the parent block of the blockchain if there aren't enough voters"`
-	NoMiningStateSync   bool     `long:"nominingstatesync" description:"Disable synchronizing the mining state with other nodes"`
-	AllowUnsyncedMining bool     `long:"allowunsyncedmining" description:"Allow block templates to be generated even when the chain is not considered synced on networks other than the main network.  This is automatically enabled when the simnet option is set.  Don't do this unless you know what you're doing"`
+	Generate            bool          `long:"generate" description:"Generate (mine) coins using the CPU"`
+	MiningAddrs         []string      `long:"miningaddr" description:"Add the specified payment address to the list of addresses to use for generated blocks.  At least one address is required if the generate option is set"`
+	BlockMinSize        uint32        `long:"blockminsize" description:"DEPRECATED: This behavior is no longer available and this option will be removed in a future version of the software"`
+	BlockMaxSize        uint32        `long:"blockmaxsize" description:"Maximum block size in bytes to be used when creating a block"`
+	BlockPrioritySize   uint32        `long:"blockprioritysize" description:"DEPRECATED: This behavior is no longer available and this option will be removed in a future version of the software"`
+	MiningTimeOffset    int           `long:"miningtimeoffset" description:"Offset the mining timestamp of a block by this many seconds (positive values are in the past)"`
+	TemplateTimeBudget  time.Duration `long:"templatetimebudget" description:"Maximum time to spend assembling a block template before delivering a partially filled template instead.  Valid time units are {ms, s, m}.  0 to disable"`
+	NonAggressive       bool          `long:"nonaggressive" description:"Disable mining off of the parent block of the blockchain if there aren't enough voters"`
+	NoMiningStateSync   bool          `long:"nominingstatesync" description:"Disable synchronizing the mining state with other nodes"`
+	AllowUnsyncedMining bool          `long:"allowunsyncedmining" description:"Allow block templates to be generated even when the chain is not considered synced on networks other than the main network.  This is automatically enabled when the simnet option is set.  Don't do this unless you know what you're doing"`
+
+	SSFeeConsolidationIntervals []string `long:"ssfeeconsolidationinterval" description:"Set the number of blocks between the heights at which generated blocks consolidate the existing SSFee UTXOs of a specific coin type instead of creating new ones in the form <cointype>:<blocks>.  Coin types without an interval are consolidated in every block"`
+
+	// SKA emission rehearsal options.
+	EmissionRehearsal     bool     `long:"emissionrehearsal" description:"Run a local coordinator that automatically creates, signs, and broadcasts the SKA emission transaction when the emission window opens for each coin type with a configured rehearsal key -- Not allowed on mainnet"`
+	EmissionRehearsalKeys []string `long:"emissionrehearsalkey" description:"Add a test emission private key used by the emission rehearsal coordinator in the form <cointype>:<hex private key>.  The key must match the emission key configured for the coin type on the active network"`
+	EmissionManifests     []string `long:"emissionmanifest" description:"Add a file containing a signed emission manifest that provides the addresses and amounts of an emission tranche whose distribution is pinned to the manifest hash by the chain parameters of the active network"`
+
+	// SKA emission watchtower options.
+	EmissionWatch         bool     `long:"emissionwatch" description:"Monitor the emission windows of all SKA coin types and raise escalating alerts when a window approaches or opens without a valid emission in the mempool"`
+	EmissionWatchLead     int64    `long:"emissionwatchlead" description:"Number of blocks before an emission window opens at which the emission watchtower first alerts about it"`
+	EmissionWatchWebhooks []string `long:"emissionwatchwebhook" description:"Add an HTTP(S) URL the emission watchtower posts a JSON alert to whenever the alert level of a coin type escalates"`
+
+	// SKA emission checkpoint options.
+	EmissionCheckpoints    []string `long:"emissioncheckpoint" description:"Add a file path or HTTP(S) URL of an operator-signed SKA emission checkpoint the emission state of the chain is verified against once the best chain reaches its height.  RPCs that report emission state return an error until every checkpoint is verified"`
+	EmissionCheckpointKeys []string `long:"emissioncheckpointkey" description:"Add a hex-encoded compressed public key trusted to sign SKA emission checkpoints"`
+
+	// SKA emission finality options.
+	EmissionFinalConfs int64 `long:"emissionfinalconfs" description:"Number of confirmations after which an SKA emission in the main chain is reported as final instead of provisional by RPCs, websocket notifications, and the event sink"`
+
+	// UTXO supply scanner options.
+	SupplyScan         bool          `long:"supplyscan" description:"Periodically scan the UTXO set in the background to verify the amounts of all unspent outputs are within the bounds of their coin type and that the supply of every SKA coin type matches the amounts emitted and burned"`
+	SupplyScanInterval time.Duration `long:"supplyscaninterval" description:"Delay between the end of a UTXO supply scan and the start of the next one.  Valid time units are {s, m, h}.  Minimum 1 minute"`
+
+	// Event sink options.
+	EventSinkURLs       []string `long:"eventsinkurl" description:"Add an HTTP(S) URL that node events such as new blocks, confirmed SKA emissions, block space allocation alerts, and per coin type fee spikes are posted to as JSON"`
+	EventSinkSecret     string   `long:"eventsinksecret" description:"Secret used to sign the body of every event sink request with HMAC-SHA256 in the X-Monetarium-Signature header"`
+	EventSinkMaxRetries int      `long:"eventsinkmaxretries" description:"Number of times delivery of an event to an event sink URL is retried with exponential backoff after the initial attempt fails"`
+	EventSinkFeeSpike   float64  `long:"eventsinkfeespike" description:"Dynamic fee multiplier of a coin type at or above which a fee spike event is posted to the event sink URLs"`
+
+	// Block space allocation divergence options.
+	AllocDivergenceMargin uint32 `long:"allocdivergencemargin" description:"Percentage by which the block space a newly connected block uses for a coin type must fall short of what the allocation policy expects given the pending mempool demand for the block to count as divergent.  Miners with repeatedly divergent blocks are logged and reported to the event sink.  Set to 0 to disable the self-check"`
 
 	// Indexing options.
 	TxIndex             bool `long:"txindex" description:"Maintain a full hash-based transaction index which makes all transactions available via the getrawtransaction RPC"`
 	DropTxIndex         bool `long:"droptxindex" description:"Deletes the hash-based transaction index from the database on start up and then exits"`
 	NoExistsAddrIndex   bool `long:"noexistsaddrindex" description:"Disable the exists address index, which tracks whether or not an address has even been used"`
 	DropExistsAddrIndex bool `long:"dropexistsaddrindex" description:"Deletes the exists address index from the database on start up and then exits"`
+	WatchOnlyIndex      bool `long:"watchonlyindex" description:"Maintain a watch-only index which tracks the unspent outputs and balances of imported addresses per coin type"`
+	DropWatchOnlyIndex  bool `long:"dropwatchonlyindex" description:"Deletes the watch-only index from the database on start up and then exits"`
 
 	// IPC options.
 	PipeRx          uint `long:"piperx" description:"File descriptor of read end pipe to enable parent -> child process communication"`
@@ -219,17 +331,26 @@ type config struct {
 	BoundAddrEvents bool `long:"boundaddrevents" description:"Send notifications with the locally bound addresses of the P2P and RPC subsystems over the TX pipe"`
 
 	// Cooked options ready for use.
-	onionlookup   func(string) ([]net.IP, error)
-	lookup        func(string) ([]net.IP, error)
-	oniondial     func(context.Context, string, string) (net.Conn, error)
-	dial          func(context.Context, string, string) (net.Conn, error)
-	miningAddrs   []stdaddr.Address
-	minRelayTxFee dcrutil.Amount
-	whitelists    []*net.IPNet
-	ipv4NetInfo   types.NetworksResult
-	ipv6NetInfo   types.NetworksResult
-	onionNetInfo  types.NetworksResult
-	params        *params
+	onionlookup            func(string) ([]net.IP, error)
+	lookup                 func(string) ([]net.IP, error)
+	oniondial              func(context.Context, string, string) (net.Conn, error)
+	dial                   func(context.Context, string, string) (net.Conn, error)
+	miningAddrs            []stdaddr.Address
+	emissionRehearsalKeys  map[cointype.CoinType]*secp256k1.PrivateKey
+	emissionManifests      map[chainhash.Hash]*blockchain.EmissionManifest
+	emissionCheckpointKeys []*secp256k1.PublicKey
+	rpcAuth                []rpcserver.AuthCredential
+	coinTypeMempoolExpiry  map[cointype.CoinType]time.Duration
+	coinTypeMinFeeLimit    map[cointype.CoinType]mempool.MinFeeRelayLimit
+	coinTypeMempoolSize    map[cointype.CoinType]int64
+	coinTypeChainLimits    map[cointype.CoinType]mempool.ChainLimits
+	ssfeeConsolidationInts map[cointype.CoinType]uint32
+	minRelayTxFee          dcrutil.Amount
+	whitelists             []*net.IPNet
+	ipv4NetInfo            types.NetworksResult
+	ipv6NetInfo            types.NetworksResult
+	onionNetInfo           types.NetworksResult
+	params                 *params
 }
 
 // serviceOptions defines the configuration options for the daemon as a service on
@@ -393,6 +514,220 @@ const (
 	normalizeInterfaceFirstAddr
 )
 
+// isHTTPURL returns whether the provided string is an absolute HTTP or HTTPS
+// URL.
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") &&
+		u.Host != ""
+}
+
+// parseEmissionRehearsalKey parses an emission rehearsal key of the form
+// <cointype>:<hex private key> into the SKA coin type and private key it
+// specifies.
+func parseEmissionRehearsalKey(keyStr string) (cointype.CoinType, *secp256k1.PrivateKey, error) {
+	ctStr, keyHex, ok := strings.Cut(keyStr, ":")
+	if !ok {
+		return 0, nil, errors.New("expected format <cointype>:<hex private key>")
+	}
+	ct, err := strconv.ParseUint(ctStr, 10, 8)
+	if err != nil || !cointype.CoinType(ct).IsSKA() {
+		return 0, nil, fmt.Errorf("coin type %q is not a valid SKA coin type",
+			ctStr)
+	}
+	keyBytes, err := hex.DecodeString(keyHex)
+	if err != nil || len(keyBytes) != secp256k1.PrivKeyBytesLen {
+		return 0, nil, fmt.Errorf("private key for coin type %d must be %d "+
+			"hex-encoded bytes", ct, secp256k1.PrivKeyBytesLen)
+	}
+	return cointype.CoinType(ct), secp256k1.PrivKeyFromBytes(keyBytes), nil
+}
+
+// loadEmissionManifest reads the signed emission manifest from the provided
+// file and ensures it is the manifest pinned by the provided chain parameters
+// for its coin type and tranche.
+func loadEmissionManifest(path string, params *chaincfg.Params) (*blockchain.EmissionManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := blockchain.ParseEmissionManifest(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := blockchain.CheckEmissionManifest(manifest, params); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// parseCoinTypeMempoolExpiry parses a mempool expiry override of the form
+// <cointype>:<duration> into the coin type and duration it specifies.
+func parseCoinTypeMempoolExpiry(expiryStr string) (cointype.CoinType, time.Duration, error) {
+	ctStr, durationStr, ok := strings.Cut(expiryStr, ":")
+	if !ok {
+		return 0, 0, errors.New("expected format <cointype>:<duration>")
+	}
+	ct, err := strconv.ParseUint(ctStr, 10, 8)
+	if err != nil || !cointype.CoinType(ct).IsValid() {
+		return 0, 0, fmt.Errorf("coin type %q is not a valid coin type", ctStr)
+	}
+	expiry, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("expiry for coin type %d is not a valid "+
+			"duration: %w", ct, err)
+	}
+	return cointype.CoinType(ct), expiry, nil
+}
+
+// parseCoinTypeMempoolMaxSize parses a mempool size limit override of the form
+// <cointype>:<MB> into the coin type and number of megabytes it specifies.
+func parseCoinTypeMempoolMaxSize(sizeStr string) (cointype.CoinType, int64, error) {
+	ctStr, mbStr, ok := strings.Cut(sizeStr, ":")
+	if !ok {
+		return 0, 0, errors.New("expected format <cointype>:<MB>")
+	}
+	ct, err := strconv.ParseUint(ctStr, 10, 8)
+	if err != nil || !cointype.CoinType(ct).IsValid() {
+		return 0, 0, fmt.Errorf("coin type %q is not a valid coin type", ctStr)
+	}
+	maxSize, err := strconv.ParseInt(mbStr, 10, 64)
+	if err != nil || maxSize < 0 || maxSize > maxMempoolMaxSizeMB {
+		return 0, 0, fmt.Errorf("size limit for coin type %d must be a "+
+			"number of megabytes between 0 and %d", ct, maxMempoolMaxSizeMB)
+	}
+	return cointype.CoinType(ct), maxSize, nil
+}
+
+// parseSSFeeConsolidationInterval parses an SSFee consolidation interval of the
+// form <cointype>:<blocks> into the coin type and number of blocks it
+// specifies.
+func parseSSFeeConsolidationInterval(intervalStr string) (cointype.CoinType, uint32, error) {
+	ctStr, blocksStr, ok := strings.Cut(intervalStr, ":")
+	if !ok {
+		return 0, 0, errors.New("expected format <cointype>:<blocks>")
+	}
+	ct, err := strconv.ParseUint(ctStr, 10, 8)
+	if err != nil || !cointype.CoinType(ct).IsValid() {
+		return 0, 0, fmt.Errorf("coin type %q is not a valid coin type", ctStr)
+	}
+	interval, err := strconv.ParseUint(blocksStr, 10, 32)
+	if err != nil || interval < 1 || interval > maxSSFeeConsolidationInt {
+		return 0, 0, fmt.Errorf("consolidation interval for coin type %d "+
+			"must be a number of blocks between 1 and %d", ct,
+			maxSSFeeConsolidationInt)
+	}
+	return cointype.CoinType(ct), uint32(interval), nil
+}
+
+// parseMempoolChainLimit parses a single mempool chain limit and ensures it
+// is between 1 and the provided maximum.
+func parseMempoolChainLimit(name, limitStr string, max int64) (int64, error) {
+	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	if err != nil || limit < 1 || limit > max {
+		return 0, fmt.Errorf("%s limit must be between 1 and %d", name, max)
+	}
+	return limit, nil
+}
+
+// parseCoinTypeMempoolChainLimits parses a mempool chain limits override of the
+// form <cointype>:<ancestors>:<ancestor kB>:<descendants>:<descendant kB> into
+// the coin type and limits it specifies.
+func parseCoinTypeMempoolChainLimits(limitsStr string) (cointype.CoinType, mempool.ChainLimits, error) {
+	var limits mempool.ChainLimits
+	parts := strings.Split(limitsStr, ":")
+	if len(parts) != 5 {
+		return 0, limits, errors.New("expected format <cointype>:" +
+			"<ancestors>:<ancestor kB>:<descendants>:<descendant kB>")
+	}
+	ct, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil || !cointype.CoinType(ct).IsValid() {
+		return 0, limits, fmt.Errorf("coin type %q is not a valid coin type",
+			parts[0])
+	}
+	ancestors, err := parseMempoolChainLimit("ancestor count", parts[1],
+		maxMempoolChainTxns)
+	if err != nil {
+		return 0, limits, err
+	}
+	ancestorSize, err := parseMempoolChainLimit("ancestor size", parts[2],
+		maxMempoolChainSizeKB)
+	if err != nil {
+		return 0, limits, err
+	}
+	descendants, err := parseMempoolChainLimit("descendant count", parts[3],
+		maxMempoolChainTxns)
+	if err != nil {
+		return 0, limits, err
+	}
+	descendantSize, err := parseMempoolChainLimit("descendant size", parts[4],
+		maxMempoolChainSizeKB)
+	if err != nil {
+		return 0, limits, err
+	}
+	limits = mempool.ChainLimits{
+		MaxAncestors:      int(ancestors),
+		MaxAncestorSize:   ancestorSize * 1000,
+		MaxDescendants:    int(descendants),
+		MaxDescendantSize: descendantSize * 1000,
+	}
+	return cointype.CoinType(ct), limits, nil
+}
+
+// parseCoinTypeMinFeeRelayLimit parses a minimum fee relay rate limit override
+// of the form <cointype>:<peer kB/min>:<total kB/min> into the coin type and
+// limits it specifies.
+func parseCoinTypeMinFeeRelayLimit(limitStr string) (cointype.CoinType, mempool.MinFeeRelayLimit, error) {
+	var limit mempool.MinFeeRelayLimit
+	parts := strings.Split(limitStr, ":")
+	if len(parts) != 3 {
+		return 0, limit, errors.New("expected format " +
+			"<cointype>:<peer kB/min>:<total kB/min>")
+	}
+	ct, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil || !cointype.CoinType(ct).IsValid() {
+		return 0, limit, fmt.Errorf("coin type %q is not a valid coin type",
+			parts[0])
+	}
+	limit.PeerKBPerMinute, err = strconv.ParseFloat(parts[1], 64)
+	if err != nil || limit.PeerKBPerMinute < 0 {
+		return 0, limit, fmt.Errorf("peer limit for coin type %d must be a "+
+			"non-negative number", ct)
+	}
+	limit.KBPerMinute, err = strconv.ParseFloat(parts[2], 64)
+	if err != nil || limit.KBPerMinute < 0 {
+		return 0, limit, fmt.Errorf("total limit for coin type %d must be a "+
+			"non-negative number", ct)
+	}
+	return cointype.CoinType(ct), limit, nil
+}
+
+// parseRPCAuth parses RPC credentials of the form
+// <user>:<pass>:<scope>[,<scope>...] into the credentials and scopes they
+// specify.  The password may contain colons since the username ends at the
+// first colon and the scopes start after the last one.
+func parseRPCAuth(authStr string) (rpcserver.AuthCredential, error) {
+	var cred rpcserver.AuthCredential
+	userEnd := strings.Index(authStr, ":")
+	scopesStart := strings.LastIndex(authStr, ":")
+	if userEnd <= 0 || scopesStart == userEnd {
+		return cred, errors.New("expected format " +
+			"<user>:<pass>:<scope>[,<scope>...]")
+	}
+	cred.User = authStr[:userEnd]
+	cred.Pass = authStr[userEnd+1 : scopesStart]
+	if cred.Pass == "" {
+		return cred, fmt.Errorf("password for user %q must not be empty",
+			cred.User)
+	}
+	scopes, err := rpcserver.ParseAuthScope(authStr[scopesStart+1:])
+	if err != nil {
+		return cred, err
+	}
+	cred.Scopes = scopes
+	return cred, nil
+}
+
 // normalizeAddresses returns a new slice with all the passed peer addresses
 // normalized with the given default port, and all duplicates removed.
 //
@@ -631,12 +966,66 @@ func loadConfig(appName string) (*config, []string, error) {
 		MaxOrphanTxs:  defaultMaxOrphanTransactions,
 		AllowOldVotes: defaultAllowOldVotes,
 
+		// Block space utilization history.
+		UtilizationHistory: fees.DefaultUtilizationHistorySize,
+
+		// Orphan SKA transaction policy.
+		SKAMaxOrphanTxs: mempool.DefaultSKAMaxOrphanTxs,
+		SKAOrphanExpiry: mempool.DefaultSKAOrphanTTL,
+
+		// Null data relay policy.
+		MaxNullDataSize:       mempool.DefaultMaxNullDataSize,
+		MaxNullDataOutputs:    mempool.DefaultMaxNullDataOutputs,
+		SKAMaxNullDataSize:    mempool.DefaultSKAMaxNullDataSize,
+		SKAMaxNullDataOutputs: mempool.DefaultSKAMaxNullDataOutputs,
+
+		// Mempool expiry policy.
+		MempoolExpiry:    mempool.DefaultMaxTxAge,
+		SKAMempoolExpiry: mempool.DefaultSKAMaxTxAge,
+
+		// Mempool size limits.
+		MempoolMaxSize:    mempool.DefaultMaxPoolSizeMB,
+		SKAMempoolMaxSize: mempool.DefaultSKAMaxPoolSizeMB,
+
+		// Mempool chain limits.
+		MempoolMaxAncestors:         mempool.DefaultMaxAncestors,
+		MempoolMaxAncestorSize:      mempool.DefaultMaxAncestorSizeKB,
+		MempoolMaxDescendants:       mempool.DefaultMaxDescendants,
+		MempoolMaxDescendantSize:    mempool.DefaultMaxDescendantSizeKB,
+		SKAMempoolMaxAncestors:      mempool.DefaultSKAMaxAncestors,
+		SKAMempoolMaxAncestorSize:   mempool.DefaultSKAMaxAncestorSizeKB,
+		SKAMempoolMaxDescendants:    mempool.DefaultSKAMaxDescendants,
+		SKAMempoolMaxDescendantSize: mempool.DefaultSKAMaxDescendantSizeKB,
+
+		// Minimum fee transaction relay rate limits.
+		SKAPeerMinFeeRelayLimit: mempool.DefaultSKAPeerMinFeeRelayLimit,
+		SKAMinFeeRelayLimit:     mempool.DefaultSKAMinFeeRelayLimit,
+
+		// Rebroadcast options.
+		RebroadcastMaxUtilization: defaultRebroadcastMaxUtilization,
+
 		// Mining options and policy.
 		Generate:            defaultGenerate,
 		BlockMaxSize:        defaultBlockMaxSize,
 		NoMiningStateSync:   defaultNoMiningStateSync,
 		AllowUnsyncedMining: defaultAllowUnsyncedMining,
 
+		// SKA emission watchtower options.
+		EmissionWatchLead: defaultEmissionWatchLead,
+
+		// SKA emission finality options.
+		EmissionFinalConfs: defaultEmissionFinalConfs,
+
+		// UTXO supply scanner options.
+		SupplyScanInterval: defaultSupplyScanInterval,
+
+		// Event sink options.
+		EventSinkMaxRetries: eventsink.DefaultMaxRetries,
+		EventSinkFeeSpike:   defaultEventSinkFeeSpike,
+
+		// Block space allocation divergence options.
+		AllocDivergenceMargin: defaultAllocDivergenceMargin,
+
 		// Indexing options.
 		TxIndex:           defaultTxIndex,
 		NoExistsAddrIndex: defaultNoExistsAddrIndex,
@@ -1038,11 +1427,51 @@ func loadConfig(appName string) (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Parse the RPC credentials with scopes and make sure neither their
+	// usernames nor passwords are shared with any other credentials.
+	usernames := make(map[string]struct{}, len(cfg.RPCAuth)+2)
+	passwords := make(map[string]struct{}, len(cfg.RPCAuth)+2)
+	for _, login := range [][2]string{{cfg.RPCUser, cfg.RPCPass},
+		{cfg.RPCLimitUser, cfg.RPCLimitPass}} {
+
+		if login[0] != "" {
+			usernames[login[0]] = struct{}{}
+		}
+		if login[1] != "" {
+			passwords[login[1]] = struct{}{}
+		}
+	}
+	cfg.rpcAuth = make([]rpcserver.AuthCredential, 0, len(cfg.RPCAuth))
+	for _, authStr := range cfg.RPCAuth {
+		cred, err := parseRPCAuth(authStr)
+		if err != nil {
+			str := "%s: the rpcauth option is invalid: %w"
+			err := fmt.Errorf(str, funcName, err)
+			return nil, nil, err
+		}
+		if _, ok := usernames[cred.User]; ok {
+			str := "%s: --rpcauth username %q is already used by other " +
+				"RPC credentials"
+			err := fmt.Errorf(str, funcName, cred.User)
+			return nil, nil, err
+		}
+		if _, ok := passwords[cred.Pass]; ok {
+			str := "%s: --rpcauth password for username %q is already " +
+				"used by other RPC credentials"
+			err := fmt.Errorf(str, funcName, cred.User)
+			return nil, nil, err
+		}
+		usernames[cred.User] = struct{}{}
+		passwords[cred.Pass] = struct{}{}
+		cfg.rpcAuth = append(cfg.rpcAuth, cred)
+	}
+
 	// The RPC server is disabled if no username or password is provided
 	// under basic user/pass authentication.
 	if cfg.RPCAuthType == authTypeBasic &&
 		(cfg.RPCUser == "" || cfg.RPCPass == "") &&
-		(cfg.RPCLimitUser == "" || cfg.RPCLimitPass == "") {
+		(cfg.RPCLimitUser == "" || cfg.RPCLimitPass == "") &&
+		len(cfg.rpcAuth) == 0 {
 		cfg.DisableRPC = true
 	}
 
@@ -1051,7 +1480,8 @@ func loadConfig(appName string) (*config, []string, error) {
 	if cfg.RPCAuthType == authTypeClientCert {
 		switch {
 		case cfg.RPCUser != "", cfg.RPCPass != "",
-			cfg.RPCLimitUser != "", cfg.RPCLimitPass != "":
+			cfg.RPCLimitUser != "", cfg.RPCLimitPass != "",
+			len(cfg.RPCAuth) != 0:
 			str := "%s: RPC usernames and passwords are not allowed " +
 				"with --authtype=clientcert"
 			err := fmt.Errorf(str, funcName)
@@ -1116,6 +1546,33 @@ func loadConfig(appName string) (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Ensure the block template time budget is not negative.
+	if cfg.TemplateTimeBudget < 0 {
+		str := "%s: the templatetimebudget option may not be negative " +
+			"-- parsed [%v]"
+		err := fmt.Errorf(str, funcName, cfg.TemplateTimeBudget)
+		return nil, nil, err
+	}
+
+	// Parse the SSFee consolidation intervals.
+	cfg.ssfeeConsolidationInts = make(map[cointype.CoinType]uint32,
+		len(cfg.SSFeeConsolidationIntervals))
+	for _, intervalStr := range cfg.SSFeeConsolidationIntervals {
+		coinType, interval, err := parseSSFeeConsolidationInterval(intervalStr)
+		if err != nil {
+			str := "%s: the ssfeeconsolidationinterval option is invalid: %w"
+			err := fmt.Errorf(str, funcName, err)
+			return nil, nil, err
+		}
+		if _, ok := cfg.ssfeeConsolidationInts[coinType]; ok {
+			str := "%s: multiple SSFee consolidation intervals specified " +
+				"for coin type %v"
+			err := fmt.Errorf(str, funcName, coinType)
+			return nil, nil, err
+		}
+		cfg.ssfeeConsolidationInts[coinType] = interval
+	}
+
 	// Limit the max orphan count to a sane value.
 	if cfg.MaxOrphanTxs < 0 {
 		str := "%s: the maxorphantx option may not be less than 0 " +
@@ -1123,6 +1580,200 @@ func loadConfig(appName string) (*config, []string, error) {
 		err := fmt.Errorf(str, funcName, cfg.MaxOrphanTxs)
 		return nil, nil, err
 	}
+	if cfg.UtilizationHistory < 0 {
+		str := "%s: the utilizationhistory option may not be less than 0 " +
+			"-- parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.UtilizationHistory)
+		return nil, nil, err
+	}
+	if cfg.SKAMaxOrphanTxs < 1 {
+		str := "%s: the skamaxorphantx option may not be less than 1 " +
+			"-- parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.SKAMaxOrphanTxs)
+		return nil, nil, err
+	}
+	if cfg.SKAOrphanExpiry < time.Minute {
+		str := "%s: the skaorphanexpiry option may not be less than %v " +
+			"-- parsed [%v]"
+		err := fmt.Errorf(str, funcName, time.Minute, cfg.SKAOrphanExpiry)
+		return nil, nil, err
+	}
+
+	// Limit the null data policy to sane values.
+	for _, opt := range []struct {
+		name string
+		size int
+	}{
+		{"maxnulldatasize", cfg.MaxNullDataSize},
+		{"skamaxnulldatasize", cfg.SKAMaxNullDataSize},
+	} {
+		if opt.size < 0 || opt.size > mempool.MaxNullDataSizeLimit {
+			str := "%s: the %s option must be in between 0 and %d " +
+				"-- parsed [%d]"
+			err := fmt.Errorf(str, funcName, opt.name,
+				mempool.MaxNullDataSizeLimit, opt.size)
+			return nil, nil, err
+		}
+	}
+	for _, opt := range []struct {
+		name       string
+		numOutputs int
+	}{
+		{"maxnulldataoutputs", cfg.MaxNullDataOutputs},
+		{"skamaxnulldataoutputs", cfg.SKAMaxNullDataOutputs},
+	} {
+		if opt.numOutputs < 1 {
+			str := "%s: the %s option may not be less than 1 -- parsed [%d]"
+			err := fmt.Errorf(str, funcName, opt.name, opt.numOutputs)
+			return nil, nil, err
+		}
+	}
+
+	// Don't allow mempool expiry durations that are too short.
+	const minMempoolExpiry = time.Minute
+	for _, opt := range []struct {
+		name   string
+		expiry time.Duration
+	}{
+		{"mempoolexpiry", cfg.MempoolExpiry},
+		{"skamempoolexpiry", cfg.SKAMempoolExpiry},
+	} {
+		if opt.expiry < minMempoolExpiry {
+			str := "%s: the %s option may not be less than %v -- parsed [%v]"
+			err := fmt.Errorf(str, funcName, opt.name, minMempoolExpiry,
+				opt.expiry)
+			return nil, nil, err
+		}
+	}
+	cfg.coinTypeMempoolExpiry = make(map[cointype.CoinType]time.Duration,
+		len(cfg.CoinTypeMempoolExpiry))
+	for _, expiryStr := range cfg.CoinTypeMempoolExpiry {
+		coinType, expiry, err := parseCoinTypeMempoolExpiry(expiryStr)
+		if err != nil {
+			str := "%s: the cointypemempoolexpiry option is invalid: %w"
+			err := fmt.Errorf(str, funcName, err)
+			return nil, nil, err
+		}
+		if expiry < minMempoolExpiry {
+			str := "%s: the cointypemempoolexpiry option for coin type %v " +
+				"may not be less than %v -- parsed [%v]"
+			err := fmt.Errorf(str, funcName, coinType, minMempoolExpiry,
+				expiry)
+			return nil, nil, err
+		}
+		if _, ok := cfg.coinTypeMempoolExpiry[coinType]; ok {
+			str := "%s: multiple mempool expiry overrides specified for " +
+				"coin type %v"
+			err := fmt.Errorf(str, funcName, coinType)
+			return nil, nil, err
+		}
+		cfg.coinTypeMempoolExpiry[coinType] = expiry
+	}
+
+	// Don't allow negative or unreasonably large mempool size limits.
+	for _, opt := range []struct {
+		name    string
+		maxSize int64
+	}{
+		{"mempoolmaxsize", cfg.MempoolMaxSize},
+		{"skamempoolmaxsize", cfg.SKAMempoolMaxSize},
+	} {
+		if opt.maxSize < 0 || opt.maxSize > maxMempoolMaxSizeMB {
+			str := "%s: the %s option must be between 0 and %d -- parsed [%d]"
+			err := fmt.Errorf(str, funcName, opt.name, maxMempoolMaxSizeMB,
+				opt.maxSize)
+			return nil, nil, err
+		}
+	}
+	cfg.coinTypeMempoolSize = make(map[cointype.CoinType]int64,
+		len(cfg.CoinTypeMempoolMaxSize))
+	for _, sizeStr := range cfg.CoinTypeMempoolMaxSize {
+		coinType, maxSize, err := parseCoinTypeMempoolMaxSize(sizeStr)
+		if err != nil {
+			str := "%s: the cointypemempoolmaxsize option is invalid: %w"
+			err := fmt.Errorf(str, funcName, err)
+			return nil, nil, err
+		}
+		if _, ok := cfg.coinTypeMempoolSize[coinType]; ok {
+			str := "%s: multiple mempool size limit overrides specified " +
+				"for coin type %v"
+			err := fmt.Errorf(str, funcName, coinType)
+			return nil, nil, err
+		}
+		cfg.coinTypeMempoolSize[coinType] = maxSize * 1e6
+	}
+
+	// Don't allow mempool chain limits that would reject every chain of
+	// transactions or are unreasonably large.
+	for _, opt := range []struct {
+		name  string
+		limit int64
+		max   int64
+	}{
+		{"mempoolmaxancestors", int64(cfg.MempoolMaxAncestors), maxMempoolChainTxns},
+		{"mempoolmaxancestorsize", cfg.MempoolMaxAncestorSize, maxMempoolChainSizeKB},
+		{"mempoolmaxdescendants", int64(cfg.MempoolMaxDescendants), maxMempoolChainTxns},
+		{"mempoolmaxdescendantsize", cfg.MempoolMaxDescendantSize, maxMempoolChainSizeKB},
+		{"skamempoolmaxancestors", int64(cfg.SKAMempoolMaxAncestors), maxMempoolChainTxns},
+		{"skamempoolmaxancestorsize", cfg.SKAMempoolMaxAncestorSize, maxMempoolChainSizeKB},
+		{"skamempoolmaxdescendants", int64(cfg.SKAMempoolMaxDescendants), maxMempoolChainTxns},
+		{"skamempoolmaxdescendantsize", cfg.SKAMempoolMaxDescendantSize, maxMempoolChainSizeKB},
+	} {
+		if opt.limit < 1 || opt.limit > opt.max {
+			str := "%s: the %s option must be between 1 and %d -- parsed [%d]"
+			err := fmt.Errorf(str, funcName, opt.name, opt.max, opt.limit)
+			return nil, nil, err
+		}
+	}
+	cfg.coinTypeChainLimits = make(map[cointype.CoinType]mempool.ChainLimits,
+		len(cfg.CoinTypeMempoolChainLimits))
+	for _, limitsStr := range cfg.CoinTypeMempoolChainLimits {
+		coinType, limits, err := parseCoinTypeMempoolChainLimits(limitsStr)
+		if err != nil {
+			str := "%s: the cointypemempoolchainlimits option is invalid: %w"
+			err := fmt.Errorf(str, funcName, err)
+			return nil, nil, err
+		}
+		if _, ok := cfg.coinTypeChainLimits[coinType]; ok {
+			str := "%s: multiple mempool chain limit overrides specified " +
+				"for coin type %v"
+			err := fmt.Errorf(str, funcName, coinType)
+			return nil, nil, err
+		}
+		cfg.coinTypeChainLimits[coinType] = limits
+	}
+
+	// Don't allow negative minimum fee relay rate limits.
+	for _, opt := range []struct {
+		name  string
+		limit float64
+	}{
+		{"skapeerminfeerelaylimit", cfg.SKAPeerMinFeeRelayLimit},
+		{"skaminfeerelaylimit", cfg.SKAMinFeeRelayLimit},
+	} {
+		if opt.limit < 0 {
+			str := "%s: the %s option may not be negative -- parsed [%v]"
+			err := fmt.Errorf(str, funcName, opt.name, opt.limit)
+			return nil, nil, err
+		}
+	}
+	cfg.coinTypeMinFeeLimit = make(map[cointype.CoinType]mempool.MinFeeRelayLimit,
+		len(cfg.CoinTypeMinFeeRelayLimit))
+	for _, limitStr := range cfg.CoinTypeMinFeeRelayLimit {
+		coinType, limit, err := parseCoinTypeMinFeeRelayLimit(limitStr)
+		if err != nil {
+			str := "%s: the cointypeminfeerelaylimit option is invalid: %w"
+			err := fmt.Errorf(str, funcName, err)
+			return nil, nil, err
+		}
+		if _, ok := cfg.coinTypeMinFeeLimit[coinType]; ok {
+			str := "%s: multiple minimum fee relay limit overrides " +
+				"specified for coin type %v"
+			err := fmt.Errorf(str, funcName, coinType)
+			return nil, nil, err
+		}
+		cfg.coinTypeMinFeeLimit[coinType] = limit
+	}
 
 	// --txindex and --droptxindex do not mix.
 	if cfg.TxIndex && cfg.DropTxIndex {
@@ -1139,6 +1790,13 @@ func loadConfig(appName string) (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// --watchonlyindex and --dropwatchonlyindex do not mix.
+	if cfg.WatchOnlyIndex && cfg.DropWatchOnlyIndex {
+		err := fmt.Errorf("%s: the --watchonlyindex and --dropwatchonlyindex "+
+			"options may not be activated at the same time", funcName)
+		return nil, nil, err
+	}
+
 	// Check mining addresses are valid and saved parsed versions.
 	cfg.miningAddrs = make([]stdaddr.Address, 0, len(cfg.MiningAddrs))
 	for _, strAddr := range cfg.MiningAddrs {
@@ -1167,6 +1825,195 @@ func loadConfig(appName string) (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Don't allow emission rehearsal on mainnet.
+	if cfg.EmissionRehearsal && cfg.params == &mainNetParams {
+		str := "%s: emissionrehearsal cannot be activated on mainnet"
+		err := fmt.Errorf(str, funcName)
+		return nil, nil, err
+	}
+
+	// Ensure emission rehearsal keys are only specified along with the
+	// emissionrehearsal flag and are valid, then save the parsed versions.
+	if len(cfg.EmissionRehearsalKeys) > 0 && !cfg.EmissionRehearsal {
+		str := "%s: emission rehearsal keys are specified, but the " +
+			"emissionrehearsal flag is not set"
+		err := fmt.Errorf(str, funcName)
+		return nil, nil, err
+	}
+	cfg.emissionRehearsalKeys = make(map[cointype.CoinType]*secp256k1.PrivateKey,
+		len(cfg.EmissionRehearsalKeys))
+	for _, keyStr := range cfg.EmissionRehearsalKeys {
+		coinType, privKey, err := parseEmissionRehearsalKey(keyStr)
+		if err != nil {
+			str := "%s: emission rehearsal key is invalid: %w"
+			err := fmt.Errorf(str, funcName, err)
+			return nil, nil, err
+		}
+		if _, ok := cfg.emissionRehearsalKeys[coinType]; ok {
+			str := "%s: multiple emission rehearsal keys specified for " +
+				"coin type %v"
+			err := fmt.Errorf(str, funcName, coinType)
+			return nil, nil, err
+		}
+		cfg.emissionRehearsalKeys[coinType] = privKey
+	}
+	if cfg.EmissionRehearsal && len(cfg.emissionRehearsalKeys) == 0 {
+		str := "%s: the emissionrehearsal flag is set, but there are no " +
+			"emission rehearsal keys specified"
+		err := fmt.Errorf(str, funcName)
+		return nil, nil, err
+	}
+
+	// Ensure the emission addresses of the active network, which may also be
+	// output script descriptors, are valid and canonically encoded.  The test
+	// network still uses placeholder emission addresses, so only warn there
+	// since its emissions are rejected during validation anyway.
+	if err := blockchain.CheckEmissionOutputs(cfg.params.Params); err != nil {
+		if !cfg.TestNet {
+			str := "%s: invalid SKA emission configuration: %w"
+			err := fmt.Errorf(str, funcName, err)
+			return nil, nil, err
+		}
+		dcrdLog.Warnf("Invalid SKA emission configuration: %v", err)
+	}
+
+	// Ensure emission manifests are only specified along with the
+	// emissionrehearsal flag and are the ones pinned by the active network,
+	// then save the parsed versions keyed by their hash.
+	if len(cfg.EmissionManifests) > 0 && !cfg.EmissionRehearsal {
+		str := "%s: emission manifests are specified, but the " +
+			"emissionrehearsal flag is not set"
+		err := fmt.Errorf(str, funcName)
+		return nil, nil, err
+	}
+	cfg.emissionManifests = make(map[chainhash.Hash]*blockchain.EmissionManifest,
+		len(cfg.EmissionManifests))
+	for _, path := range cfg.EmissionManifests {
+		path = cleanAndExpandPath(path)
+		manifest, err := loadEmissionManifest(path, cfg.params.Params)
+		if err != nil {
+			str := "%s: emission manifest %q is invalid: %w"
+			err := fmt.Errorf(str, funcName, path, err)
+			return nil, nil, err
+		}
+		cfg.emissionManifests[manifest.Hash()] = manifest
+	}
+
+	// Ensure the emission watchtower options are only specified along with
+	// the emissionwatch flag and are valid.
+	if len(cfg.EmissionWatchWebhooks) > 0 && !cfg.EmissionWatch {
+		str := "%s: emission watch webhooks are specified, but the " +
+			"emissionwatch flag is not set"
+		err := fmt.Errorf(str, funcName)
+		return nil, nil, err
+	}
+	if cfg.EmissionWatchLead < 0 {
+		str := "%s: the emissionwatchlead option may not be negative -- " +
+			"parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.EmissionWatchLead)
+		return nil, nil, err
+	}
+	for _, webhook := range cfg.EmissionWatchWebhooks {
+		if !isHTTPURL(webhook) {
+			str := "%s: emission watch webhook %q is not a valid HTTP(S) URL"
+			err := fmt.Errorf(str, funcName, webhook)
+			return nil, nil, err
+		}
+	}
+
+	// Ensure emission checkpoints are only specified along with at least one
+	// checkpoint key to verify them with and save the parsed keys.
+	if len(cfg.EmissionCheckpoints) > 0 && len(cfg.EmissionCheckpointKeys) == 0 {
+		str := "%s: emission checkpoints are specified, but no " +
+			"emissioncheckpointkey is set"
+		err := fmt.Errorf(str, funcName)
+		return nil, nil, err
+	}
+	if len(cfg.EmissionCheckpointKeys) > 0 && len(cfg.EmissionCheckpoints) == 0 {
+		str := "%s: emission checkpoint keys are specified, but no " +
+			"emissioncheckpoint is set"
+		err := fmt.Errorf(str, funcName)
+		return nil, nil, err
+	}
+	for _, keyHex := range cfg.EmissionCheckpointKeys {
+		keyBytes, err := hex.DecodeString(keyHex)
+		if err != nil || len(keyBytes) != secp256k1.PubKeyBytesLenCompressed {
+			str := "%s: emission checkpoint key %q is not a hex-encoded " +
+				"compressed public key"
+			err := fmt.Errorf(str, funcName, keyHex)
+			return nil, nil, err
+		}
+		pubKey, err := secp256k1.ParsePubKey(keyBytes)
+		if err != nil {
+			str := "%s: emission checkpoint key %q is invalid: %w"
+			err := fmt.Errorf(str, funcName, keyHex, err)
+			return nil, nil, err
+		}
+		cfg.emissionCheckpointKeys = append(cfg.emissionCheckpointKeys, pubKey)
+	}
+	for i, source := range cfg.EmissionCheckpoints {
+		if !isHTTPURL(source) {
+			cfg.EmissionCheckpoints[i] = cleanAndExpandPath(source)
+		}
+	}
+
+	// Ensure the number of confirmations for SKA emissions to be final is
+	// positive since emissions not yet in a block are never final.
+	if cfg.EmissionFinalConfs < 1 {
+		str := "%s: the emissionfinalconfs option must be positive -- " +
+			"parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.EmissionFinalConfs)
+		return nil, nil, err
+	}
+
+	// Ensure the UTXO supply scan interval is sane.
+	if cfg.SupplyScanInterval < time.Minute {
+		str := "%s: the supplyscaninterval option may not be less than 1m " +
+			"-- parsed [%v]"
+		err := fmt.Errorf(str, funcName, cfg.SupplyScanInterval)
+		return nil, nil, err
+	}
+
+	// Ensure the event sink options are only specified along with event
+	// sink URLs and are valid.
+	if len(cfg.EventSinkURLs) == 0 && cfg.EventSinkSecret != "" {
+		str := "%s: the eventsinksecret option is specified, but there are " +
+			"no event sink URLs specified"
+		err := fmt.Errorf(str, funcName)
+		return nil, nil, err
+	}
+	for _, sinkURL := range cfg.EventSinkURLs {
+		if !isHTTPURL(sinkURL) {
+			str := "%s: event sink URL %q is not a valid HTTP(S) URL"
+			err := fmt.Errorf(str, funcName, sinkURL)
+			return nil, nil, err
+		}
+	}
+	if cfg.RebroadcastMaxUtilization < 0 {
+		str := "%s: the rebroadcastmaxutilization option may not be " +
+			"negative -- parsed [%v]"
+		err := fmt.Errorf(str, funcName, cfg.RebroadcastMaxUtilization)
+		return nil, nil, err
+	}
+	if cfg.EventSinkMaxRetries < 0 {
+		str := "%s: the eventsinkmaxretries option may not be negative -- " +
+			"parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.EventSinkMaxRetries)
+		return nil, nil, err
+	}
+	if cfg.EventSinkFeeSpike <= 0 {
+		str := "%s: the eventsinkfeespike option must be positive -- " +
+			"parsed [%v]"
+		err := fmt.Errorf(str, funcName, cfg.EventSinkFeeSpike)
+		return nil, nil, err
+	}
+	if cfg.AllocDivergenceMargin >= 100 {
+		str := "%s: the allocdivergencemargin option must be less than " +
+			"100 -- parsed [%d]"
+		err := fmt.Errorf(str, funcName, cfg.AllocDivergenceMargin)
+		return nil, nil, err
+	}
+
 	// Always allow unsynchronized mining on simnet and regnet.
 	if cfg.SimNet || cfg.RegNet {
 		cfg.AllowUnsyncedMining = true
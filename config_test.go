@@ -5,11 +5,16 @@
 package main
 
 import (
+	"encoding/hex"
 	"flag"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/internal/mempool"
+	"github.com/monetarium/monetarium-node/internal/rpcserver"
 )
 
 func TestMain(m *testing.M) {
@@ -91,3 +96,200 @@ func TestAltDNSNamesWithArg(t *testing.T) {
 	}
 	os.Args = old
 }
+
+// TestParseEmissionRehearsalKey ensures emission rehearsal keys are parsed
+// into the expected coin type and private key and that malformed keys are
+// rejected.
+func TestParseEmissionRehearsalKey(t *testing.T) {
+	const keyHex = "0000000000000000000000000000000000000000000000000000000000000003"
+
+	coinType, privKey, err := parseEmissionRehearsalKey("1:" + keyHex)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coinType != 1 {
+		t.Fatalf("unexpected coin type -- got %v, want 1", coinType)
+	}
+	if got := hex.EncodeToString(privKey.Serialize()); got != keyHex {
+		t.Fatalf("unexpected private key -- got %s, want %s", got, keyHex)
+	}
+
+	invalid := []string{
+		keyHex,              // missing coin type
+		"0:" + keyHex,       // VAR is not an SKA coin type
+		"256:" + keyHex,     // coin type out of range
+		"1:" + keyHex[2:],   // short key
+		"1:zz" + keyHex[2:], // bad hex
+	}
+	for _, keyStr := range invalid {
+		if _, _, err := parseEmissionRehearsalKey(keyStr); err == nil {
+			t.Errorf("parseEmissionRehearsalKey(%q) did not fail", keyStr)
+		}
+	}
+}
+
+// TestParseCoinTypeMempoolExpiry ensures mempool expiry overrides are parsed
+// into the expected coin type and duration and that malformed overrides are
+// rejected.
+func TestParseCoinTypeMempoolExpiry(t *testing.T) {
+	coinType, expiry, err := parseCoinTypeMempoolExpiry("1:30m")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coinType != 1 {
+		t.Fatalf("unexpected coin type -- got %v, want 1", coinType)
+	}
+	if expiry != 30*time.Minute {
+		t.Fatalf("unexpected expiry -- got %v, want %v", expiry,
+			30*time.Minute)
+	}
+
+	invalid := []string{
+		"30m",     // missing coin type
+		"256:30m", // coin type out of range
+		"x:30m",   // non-numeric coin type
+		"1:30",    // missing time unit
+		"1:",      // missing duration
+	}
+	for _, expiryStr := range invalid {
+		if _, _, err := parseCoinTypeMempoolExpiry(expiryStr); err == nil {
+			t.Errorf("parseCoinTypeMempoolExpiry(%q) did not fail", expiryStr)
+		}
+	}
+}
+
+// TestParseCoinTypeMempoolMaxSize ensures mempool size limit overrides are
+// parsed into the expected coin type and number of megabytes and that
+// malformed overrides are rejected.
+func TestParseCoinTypeMempoolMaxSize(t *testing.T) {
+	coinType, maxSize, err := parseCoinTypeMempoolMaxSize("1:20")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coinType != 1 {
+		t.Fatalf("unexpected coin type -- got %v, want 1", coinType)
+	}
+	if maxSize != 20 {
+		t.Fatalf("unexpected size limit -- got %v, want 20", maxSize)
+	}
+
+	invalid := []string{
+		"20",      // missing coin type
+		"256:20",  // coin type out of range
+		"x:20",    // non-numeric coin type
+		"1:-1",    // negative size
+		"1:20MB",  // unit suffix
+		"1:99999", // size out of range
+		"1:",      // missing size
+	}
+	for _, sizeStr := range invalid {
+		if _, _, err := parseCoinTypeMempoolMaxSize(sizeStr); err == nil {
+			t.Errorf("parseCoinTypeMempoolMaxSize(%q) did not fail", sizeStr)
+		}
+	}
+}
+
+// TestParseSSFeeConsolidationInterval ensures SSFee consolidation intervals are
+// parsed into the expected coin type and number of blocks and that malformed
+// intervals are rejected.
+func TestParseSSFeeConsolidationInterval(t *testing.T) {
+	coinType, interval, err := parseSSFeeConsolidationInterval("2:12")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coinType != 2 {
+		t.Fatalf("unexpected coin type -- got %v, want 2", coinType)
+	}
+	if interval != 12 {
+		t.Fatalf("unexpected interval -- got %v, want 12", interval)
+	}
+
+	invalid := []string{
+		"12",     // missing coin type
+		"256:12", // coin type out of range
+		"x:12",   // non-numeric coin type
+		"1:0",    // zero interval
+		"1:-1",   // negative interval
+		"1:9999", // interval out of range
+		"1:",     // missing interval
+	}
+	for _, intervalStr := range invalid {
+		if _, _, err := parseSSFeeConsolidationInterval(intervalStr); err == nil {
+			t.Errorf("parseSSFeeConsolidationInterval(%q) did not fail",
+				intervalStr)
+		}
+	}
+}
+
+// TestParseCoinTypeMempoolChainLimits ensures mempool chain limit overrides are
+// parsed into the expected coin type and limits and that malformed overrides
+// are rejected.
+func TestParseCoinTypeMempoolChainLimits(t *testing.T) {
+	coinType, limits, err := parseCoinTypeMempoolChainLimits("1:5:20:6:30")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if coinType != 1 {
+		t.Fatalf("unexpected coin type -- got %v, want 1", coinType)
+	}
+	want := mempool.ChainLimits{
+		MaxAncestors:      5,
+		MaxAncestorSize:   20000,
+		MaxDescendants:    6,
+		MaxDescendantSize: 30000,
+	}
+	if limits != want {
+		t.Fatalf("unexpected limits -- got %+v, want %+v", limits, want)
+	}
+
+	invalid := []string{
+		"5:20:6:30",        // missing coin type
+		"256:5:20:6:30",    // coin type out of range
+		"x:5:20:6:30",      // non-numeric coin type
+		"1:0:20:6:30",      // zero ancestors
+		"1:5:-1:6:30",      // negative ancestor size
+		"1:5:20:1001:30",   // descendants out of range
+		"1:5:20:6:1000001", // descendant size out of range
+		"1:5:20kB:6:30",    // unit suffix
+		"1:5:20:6",         // missing descendant size
+	}
+	for _, limitsStr := range invalid {
+		_, _, err := parseCoinTypeMempoolChainLimits(limitsStr)
+		if err == nil {
+			t.Errorf("parseCoinTypeMempoolChainLimits(%q) did not fail",
+				limitsStr)
+		}
+	}
+}
+
+// TestParseRPCAuth ensures RPC credentials with scopes are parsed into the
+// expected username, password, and scopes, including passwords that contain
+// colons, and that malformed credentials are rejected.
+func TestParseRPCAuth(t *testing.T) {
+	cred, err := parseRPCAuth("emitter:p:a:ss:skaadmin,read")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := rpcserver.AuthCredential{
+		User:   "emitter",
+		Pass:   "p:a:ss",
+		Scopes: rpcserver.ScopeSKAAdmin | rpcserver.ScopeRead,
+	}
+	if cred != want {
+		t.Fatalf("unexpected credentials -- got %+v, want %+v", cred, want)
+	}
+
+	invalid := []string{
+		"user:pass",       // missing scopes
+		":pass:read",      // missing username
+		"user::read",      // empty password
+		"user:pass:",      // empty scopes
+		"user:pass:write", // unknown scope
+		"user",            // missing password and scopes
+	}
+	for _, authStr := range invalid {
+		if _, err := parseRPCAuth(authStr); err == nil {
+			t.Errorf("parseRPCAuth(%q) did not fail", authStr)
+		}
+	}
+}
@@ -126,6 +126,9 @@ Application Options:
 	                             24h0m0s)
 	    --banthreshold=          Maximum allowed ban score before disconnecting
 	                             and banning misbehaving peers (default: 100)
+	    --nopersistpeerpolicy    Do not save the bans and per coin type
+	                             misbehavior scores of remote hosts to disk on
+	                             shutdown and restore them on startup
 	    --whitelist=             Add an IP network or IP that will not be banned
 	                             (eg. 192.168.1.0/24 or ::1)
 	    --allowoldforks          Process forks deep in history.  Don't do this
@@ -145,8 +148,10 @@ Application Options:
 	    --norelaypriority        DEPRECATED: This behavior is no longer available
 	                             and this option will be removed in a future
 	                             version of the software
-	    --maxorphantx=           Max number of orphan transactions to keep in
-	                             memory (default: 100)
+	    --maxorphantx=           Max number of orphan VAR transactions to keep in
+	                             memory, which also limits the combined number
+	                             of orphan SKA transactions.  0 to disable
+	                             orphans (default: 100)
 	    --blocksonly             Do not accept transactions from remote peers
 	    --acceptnonstd           Accept and relay non-standard transactions to
 	                             the network regardless of the default settings
@@ -155,6 +160,102 @@ Application Options:
 	                             the default settings for the active network
 	    --allowoldvotes          Enable the addition of very old votes to the
 	                             mempool
+	    --nopersistmempool       Do not save the mempool to disk on shutdown and
+	                             restore it on startup
+	    --skamaxorphantx=        Max number of orphan transactions of each SKA
+	                             coin type to keep in memory (default: 25)
+	    --skaorphanexpiry=       How long an orphan SKA transaction may remain in
+	                             memory before it expires.  Orphans of SKA coin
+	                             types that have not been emitted yet expire
+	                             after at most 1 minute (default: 5m)
+	    --maxnulldatasize=       Max number of bytes of data a null data
+	                             (OP_RETURN) output of a VAR transaction may
+	                             carry to be considered standard (default: 256)
+	    --maxnulldataoutputs=    Max number of null data (OP_RETURN) outputs a
+	                             VAR transaction may have to be considered
+	                             standard (default: 4)
+	    --skamaxnulldatasize=    Max number of bytes of data a null data
+	                             (OP_RETURN) output of an SKA transaction may
+	                             carry to be considered standard (default: 1024)
+	    --skamaxnulldataoutputs= Max number of null data (OP_RETURN) outputs an
+	                             SKA transaction may have to be considered
+	                             standard (default: 4)
+	    --mempoolexpiry=         How long a regular VAR transaction may remain in
+	                             the mempool before it expires and is evicted
+	                             (default: 24h)
+	    --skamempoolexpiry=      How long a regular SKA transaction may remain in
+	                             the mempool before it expires and is evicted
+	                             (default: 2h)
+	    --cointypemempoolexpiry= Override how long regular transactions of a
+	                             specific coin type may remain in the mempool
+	                             before they expire in the form
+	                             <cointype>:<duration>
+	    --mempoolmaxsize=        Max number of megabytes of regular VAR
+	                             transactions the mempool may hold before the
+	                             transactions paying the lowest fee rates are
+	                             evicted and the mempool minimum fee is raised.
+	                             0 to disable (default: 300)
+	    --skamempoolmaxsize=     Max number of megabytes of regular SKA
+	                             transactions the mempool may hold for each SKA
+	                             coin type before the transactions paying the
+	                             lowest fee rates are evicted and the mempool
+	                             minimum fee of the coin type is raised.  0 to
+	                             disable (default: 50)
+	    --cointypemempoolmaxsize=
+	                             Override the max number of megabytes of regular
+	                             transactions of a specific coin type the
+	                             mempool may hold in the form <cointype>:<MB>
+	    --mempoolmaxancestors=   Max number of transactions a new regular VAR
+	                             transaction and its unconfirmed ancestors in
+	                             the mempool may number (default: 25)
+	    --mempoolmaxancestorsize=
+	                             Max number of kilobytes a new regular VAR
+	                             transaction and its unconfirmed ancestors in
+	                             the mempool may total (default: 101)
+	    --mempoolmaxdescendants= Max number of transactions an unconfirmed
+	                             regular VAR transaction and its unconfirmed
+	                             descendants in the mempool may number
+	                             (default: 25)
+	    --mempoolmaxdescendantsize=
+	                             Max number of kilobytes an unconfirmed regular
+	                             VAR transaction and its unconfirmed descendants
+	                             in the mempool may total (default: 101)
+	    --skamempoolmaxancestors=
+	                             Max number of transactions a new regular SKA
+	                             transaction and its unconfirmed ancestors in
+	                             the mempool may number (default: 10)
+	    --skamempoolmaxancestorsize=
+	                             Max number of kilobytes a new regular SKA
+	                             transaction and its unconfirmed ancestors in
+	                             the mempool may total (default: 40)
+	    --skamempoolmaxdescendants=
+	                             Max number of transactions an unconfirmed
+	                             regular SKA transaction and its unconfirmed
+	                             descendants in the mempool may number
+	                             (default: 10)
+	    --skamempoolmaxdescendantsize=
+	                             Max number of kilobytes an unconfirmed regular
+	                             SKA transaction and its unconfirmed descendants
+	                             in the mempool may total (default: 40)
+	    --cointypemempoolchainlimits=
+	                             Override the chain limits of regular
+	                             transactions of a specific coin type in the
+	                             form <cointype>:<ancestors>:<ancestor kB>:
+	                             <descendants>:<descendant kB>
+	    --skapeerminfeerelaylimit=
+	                             Kilobytes per minute of regular SKA
+	                             transactions paying the minimum relay fee that
+	                             a single peer may relay for each SKA coin type.
+	                             0 to disable (default: 15)
+	    --skaminfeerelaylimit=   Kilobytes per minute of regular SKA
+	                             transactions paying the minimum relay fee that
+	                             all peers combined may relay for each SKA coin
+	                             type.  0 to disable (default: 150)
+	    --cointypeminfeerelaylimit=
+	                             Override the relay rate limits for regular
+	                             transactions of a specific coin type paying the
+	                             minimum relay fee in the form
+	                             <cointype>:<peer kB/min>:<total kB/min>
 	    --generate               Generate (mine) coins using the CPU
 	    --miningaddr=            Add the specified payment address to the list
 	                             of addresses to use for generated blocks.  At
@@ -170,6 +271,16 @@ Application Options:
 	                             version of the software
 	    --miningtimeoffset=      Offset the mining timestamp of a block by this
 	                             many seconds (positive values are in the past)
+	    --templatetimebudget=    Maximum time to spend assembling a block
+	                             template before delivering a partially filled
+	                             template instead.  Valid time units are
+	                             {ms, s, m}.  0 to disable (default: 0s)
+	    --ssfeeconsolidationinterval=
+	                             Set the number of blocks between the heights at
+	                             which generated blocks consolidate the existing
+	                             SSFee UTXOs of a specific coin type instead of
+	                             creating new ones in the form
+	                             <cointype>:<blocks>
 	    --nonaggressive          Disable mining off of the parent block of the
 	                             blockchain if there aren't enough voters
 	    --nominingstatesync      Disable synchronizing the mining state with
@@ -189,6 +300,11 @@ Application Options:
 	                             whether or not an address has even been used
 	    --dropexistsaddrindex    Deletes the exists address index from the
 	                             database on start up and then exits
+	    --watchonlyindex         Maintain a watch-only index which tracks the
+	                             unspent outputs and balances of imported
+	                             addresses per coin type
+	    --dropwatchonlyindex     Deletes the watch-only index from the database
+	                             on start up and then exits
 	    --piperx=                File descriptor of read end pipe to enable
 	                             parent -> child process communication
 	    --pipetx=                File descriptor of write end pipe to enable
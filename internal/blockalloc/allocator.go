@@ -5,6 +5,10 @@
 package blockalloc
 
 import (
+	"fmt"
+	"math"
+	"sort"
+
 	"github.com/decred/slog"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/cointype"
@@ -17,31 +21,170 @@ func UseLogger(logger slog.Logger) {
 	log = logger
 }
 
+// AllocVersion identifies a version of the block space allocation algorithm.
+// Since blocks are validated against the allocation, every version is a
+// consensus rule and the version that applies to a block is selected by its
+// height via the chain parameters.
+type AllocVersion uint8
+
+const (
+	// AllocV1 is the original allocation algorithm.  It hands the unused
+	// portion of the VAR base allocation to SKA types with remaining demand
+	// while also leaving it allocated to VAR, so the final allocations may
+	// add up to more than the maximum block size.
+	AllocV1 AllocVersion = 1
+
+	// AllocV2 fixes the redistribution of the unused portion of the VAR base
+	// allocation by shrinking the VAR allocation to what it uses before the
+	// unused space is redistributed.  Space that remains unclaimed still goes
+	// to VAR, so the final allocations never exceed the maximum block size.
+	// It also computes the redistribution with integer arithmetic instead of
+	// the floating point arithmetic of AllocV1.
+	AllocV2 AllocVersion = 2
+)
+
+// AllocVersionForHeight returns the version of the block space allocation
+// algorithm that applies to the block at the provided height.
+func AllocVersionForHeight(chainParams *chaincfg.Params, height int64) AllocVersion {
+	v2Height := chainParams.BlockAllocV2Height
+	if v2Height != 0 && height >= v2Height {
+		return AllocV2
+	}
+	return AllocV1
+}
+
+// MaxBasisPoints is the number of basis points that make up the entire
+// allocatable block space.
+const MaxBasisPoints = 10000
+
+// DefaultVARBasisPoints is the standard portion of the block space allocated
+// to VAR in basis points (10%).  It is used when the chain parameters do not
+// specify an allocation.
+const DefaultVARBasisPoints = 1000
+
 // BlockSpaceAllocator manages the allocation of block space among different coin types
 // following the 10% VAR / 90% SKA proportional distribution strategy.
 type BlockSpaceAllocator struct {
 	// Maximum block size in bytes
 	maxBlockSize uint32
 
-	// VAR allocation percentage (0.10 = 10%)
-	varAllocation float64
+	// Version of the allocation algorithm
+	version AllocVersion
+
+	// Bytes reserved for the stake tree that are not allocated to any coin
+	// type
+	stakeReserve uint32
+
+	// VAR allocation in basis points (1000 = 10%).  The remainder is
+	// allocated to SKA.
+	varBasisPoints uint32
 
-	// SKA allocation percentage (0.90 = 90%)
-	skaAllocation float64
+	// Cost of the bytes of each coin type charged against its allocation
+	// by transaction size trackers.  Nil charges one unit per byte.
+	weight WeightFunc
+
+	// Height of the block space is allocated for when hasHeight is set.
+	// Only the SKA types active at the height are allocated space.
+	height    int64
+	hasHeight bool
 
 	// Chain parameters for accessing active SKA types
 	chainParams *chaincfg.Params
 }
 
-// NewBlockSpaceAllocator creates a new block space allocator with the standard
-// 10% VAR / 90% SKA allocation strategy.
+// NewBlockSpaceAllocator creates a new block space allocator with the VAR / SKA
+// split defined by the chain parameters, which is the standard 10% VAR / 90%
+// SKA split unless they specify otherwise.  It uses version 1 of the
+// allocation algorithm, so callers that allocate space for a specific block
+// must select the version that applies to it with ForHeight.
 func NewBlockSpaceAllocator(maxBlockSize uint32, chainParams *chaincfg.Params) *BlockSpaceAllocator {
+	varBasisPoints := chainParams.BlockAllocVARBasisPoints
+	if varBasisPoints == 0 {
+		varBasisPoints = DefaultVARBasisPoints
+	}
+	return NewBlockSpaceAllocatorWithSplit(maxBlockSize, chainParams,
+		varBasisPoints)
+}
+
+// NewBlockSpaceAllocatorWithSplit creates a new block space allocator that
+// allocates the provided number of basis points of the block to VAR and the
+// remainder to SKA.  It is intended for evaluating alternative splits since
+// blocks are always validated against the split defined by the chain
+// parameters.  Values above MaxBasisPoints are treated as MaxBasisPoints.
+func NewBlockSpaceAllocatorWithSplit(maxBlockSize uint32, chainParams *chaincfg.Params,
+	varBasisPoints uint32) *BlockSpaceAllocator {
+
 	return &BlockSpaceAllocator{
-		maxBlockSize:  maxBlockSize,
-		varAllocation: 0.10, // 10% for VAR
-		skaAllocation: 0.90, // 90% for SKA
-		chainParams:   chainParams,
+		maxBlockSize:   maxBlockSize,
+		version:        AllocV1,
+		varBasisPoints: min(varBasisPoints, MaxBasisPoints),
+		chainParams:    chainParams,
+	}
+}
+
+// ForHeight returns a copy of the allocator that uses the version of the
+// allocation algorithm that applies to the block at the provided height and
+// only allocates space to the SKA types that are active at that height.
+// Allocators that are not bound to a height allocate space to every SKA type
+// that is flagged active regardless of its activation height.
+func (bsa *BlockSpaceAllocator) ForHeight(height int64) *BlockSpaceAllocator {
+	allocator := bsa.WithVersion(AllocVersionForHeight(bsa.chainParams, height))
+	allocator.height = height
+	allocator.hasHeight = true
+	return allocator
+}
+
+// WithVersion returns a copy of the allocator that uses the provided version
+// of the allocation algorithm regardless of the chain parameters.
+func (bsa *BlockSpaceAllocator) WithVersion(version AllocVersion) *BlockSpaceAllocator {
+	allocator := *bsa
+	allocator.version = version
+	return &allocator
+}
+
+// Version returns the version of the allocation algorithm used by the
+// allocator.
+func (bsa *BlockSpaceAllocator) Version() AllocVersion {
+	return bsa.version
+}
+
+// WithStakeReserve returns a copy of the allocator that reserves the provided
+// number of bytes for the stake tree and only allocates the remainder of the
+// block among the coin types.  Reserves larger than the maximum block size
+// leave no space to allocate.
+//
+// Blocks are validated against the space used by the transactions in both
+// trees, so the reserve is only intended for block templates, where the votes,
+// tickets and revocations would otherwise consume space that was already
+// allocated to regular transactions.
+func (bsa *BlockSpaceAllocator) WithStakeReserve(reserve uint32) *BlockSpaceAllocator {
+	allocator := *bsa
+	allocator.stakeReserve = reserve
+	return &allocator
+}
+
+// StakeReserve returns the number of bytes the allocator reserves for the
+// stake tree.
+func (bsa *BlockSpaceAllocator) StakeReserve() uint32 {
+	return bsa.stakeReserve
+}
+
+// activeSKATypes returns the SKA types that are allocated space in ascending
+// order.
+func (bsa *BlockSpaceAllocator) activeSKATypes() []cointype.CoinType {
+	if bsa.hasHeight {
+		return bsa.chainParams.GetActiveSKATypesAtHeight(bsa.height)
+	}
+	return bsa.chainParams.GetActiveSKATypes()
+}
+
+// allocatableSpace returns the number of bytes of the block that are
+// allocated among the coin types once the stake reserve is taken out.
+func (bsa *BlockSpaceAllocator) allocatableSpace() uint32 {
+	if bsa.stakeReserve >= bsa.maxBlockSize {
+		return 0
 	}
+	return bsa.maxBlockSize - bsa.stakeReserve
 }
 
 // CoinTypeAllocation represents the space allocation for a specific coin type.
@@ -59,6 +202,7 @@ type AllocationResult struct {
 	TotalAllocated  uint32
 	TotalUsed       uint32
 	OverflowHandled uint32
+	StakeReserved   uint32 // Bytes reserved for the stake tree
 }
 
 // AllocateBlockSpace calculates the optimal block space allocation given pending
@@ -69,9 +213,28 @@ type AllocationResult struct {
 // 2. Otherwise, initial 10% VAR / 90% SKA split among active SKA types
 // 3. Redistribute unused space ONCE with 10%/90% proportional allocation
 // 4. Any remaining unused space goes to VAR
+//
+// With AllocV2, VAR's allocation is shrunk to what it uses before its unused
+// base space is redistributed in step 3.
+//
+// SKA types whose chain parameters define a per-block byte cap are never
+// allocated more than the cap, regardless of the overflow available to them.
+// Space they are unable to claim because of it is redistributed like any
+// other unused space.
+//
+// Any bytes reserved for the stake tree with WithStakeReserve are taken out of
+// the maximum block size before the algorithm runs, so only the remainder is
+// allocated.
+//
+// With AllocV2, all sizes are computed with integer arithmetic, rounding down.
+// AllocV1 keeps the floating point arithmetic it originally used to
+// redistribute unused space since blocks prior to AllocV2 were validated
+// against exactly those results.
 func (bsa *BlockSpaceAllocator) AllocateBlockSpace(pendingTxBytes map[cointype.CoinType]uint32) *AllocationResult {
+	maxBlockSize := bsa.allocatableSpace()
+	stakeReserved := bsa.maxBlockSize - maxBlockSize
 	allocations := make(map[cointype.CoinType]*CoinTypeAllocation)
-	activeSKATypes := bsa.chainParams.GetActiveSKATypes()
+	activeSKATypes := bsa.activeSKATypes()
 
 	// Initialize default allocations for all active coin types (prevents nil pointer issues)
 	varPending := pendingTxBytes[cointype.CoinTypeVAR]
@@ -102,22 +265,25 @@ func (bsa *BlockSpaceAllocator) AllocateBlockSpace(pendingTxBytes map[cointype.C
 		}
 	}
 
-	// Early exit: No SKA pending, VAR gets entire block
-	if !hasSKAPending {
-		allocations[cointype.CoinTypeVAR].BaseAllocation = bsa.maxBlockSize
-		allocations[cointype.CoinTypeVAR].FinalAllocation = bsa.maxBlockSize
-		allocations[cointype.CoinTypeVAR].UsedBytes = min(varPending, bsa.maxBlockSize)
+	// Early exit: No SKA pending, VAR gets entire block.  This is also the
+	// case when no SKA types are active at the height of the block since the
+	// pending SKA transactions can't be allocated any space.
+	if !hasSKAPending || len(activeSKATypes) == 0 {
+		allocations[cointype.CoinTypeVAR].BaseAllocation = maxBlockSize
+		allocations[cointype.CoinTypeVAR].FinalAllocation = maxBlockSize
+		allocations[cointype.CoinTypeVAR].UsedBytes = min(varPending, maxBlockSize)
 
 		return &AllocationResult{
 			Allocations:    allocations,
-			TotalAllocated: bsa.maxBlockSize,
+			TotalAllocated: maxBlockSize,
 			TotalUsed:      allocations[cointype.CoinTypeVAR].UsedBytes,
+			StakeReserved:  stakeReserved,
 		}
 	}
 
 	// Step 2: Initial 10%/90% split
-	varBase := bsa.maxBlockSize / 10
-	skaBase := bsa.maxBlockSize - varBase
+	varBase := bsa.varPortion(maxBlockSize)
+	skaBase := maxBlockSize - varBase
 
 	varUsed := min(varPending, varBase)
 	varUnused := varBase - varUsed
@@ -128,22 +294,35 @@ func (bsa *BlockSpaceAllocator) AllocateBlockSpace(pendingTxBytes map[cointype.C
 
 	skaPerType := skaBase / uint32(len(activeSKATypes))
 
+	// The base allocation of SKA types with a per-block byte cap is limited
+	// to the cap and the portion of the equal share above it is treated as
+	// unused so it is redistributed below.
 	totalSKAUnused := uint32(0)
 	for _, skaType := range activeSKATypes {
+		skaBase := min(skaPerType, bsa.maxBlockBytes(skaType))
 		skaPending := pendingTxBytes[skaType]
-		skaUsed := min(skaPending, skaPerType)
+		skaUsed := min(skaPending, skaBase)
 		totalSKAUnused += skaPerType - skaUsed
 
-		allocations[skaType].BaseAllocation = skaPerType
-		allocations[skaType].FinalAllocation = skaPerType
+		allocations[skaType].BaseAllocation = skaBase
+		allocations[skaType].FinalAllocation = skaBase
 		allocations[skaType].UsedBytes = skaUsed
 	}
 
 	// Step 3: Single redistribution of unused space
 	totalUnused := varUnused + totalSKAUnused
 
+	// VAR's unused base space is redistributed below, so it must no longer
+	// remain allocated to VAR as well.  Any of it that is not claimed by SKA
+	// is given back to VAR in step 4.
+	if bsa.version >= AllocV2 {
+		allocations[cointype.CoinTypeVAR].FinalAllocation = varUsed
+	}
+
 	if totalUnused > 0 {
-		// Calculate remaining needs for each coin type
+		// Calculate remaining needs for each coin type.  The need of SKA
+		// types with a per-block byte cap never extends beyond the cap, so
+		// they are never granted overflow space above it.
 		varNeed := int64(varPending) - int64(varUsed)
 		if varNeed < 0 {
 			varNeed = 0
@@ -153,7 +332,8 @@ func (bsa *BlockSpaceAllocator) AllocateBlockSpace(pendingTxBytes map[cointype.C
 		totalSKANeed := int64(0)
 		for _, skaType := range activeSKATypes {
 			alloc := allocations[skaType]
-			need := int64(alloc.PendingBytes) - int64(alloc.UsedBytes)
+			demand := min(alloc.PendingBytes, bsa.maxBlockBytes(skaType))
+			need := int64(demand) - int64(alloc.UsedBytes)
 			if need > 0 {
 				skaNeeds[skaType] = need
 				totalSKANeed += need
@@ -175,7 +355,7 @@ func (bsa *BlockSpaceAllocator) AllocateBlockSpace(pendingTxBytes map[cointype.C
 			skaShare = 0
 		} else {
 			// Both have needs → use 10%/90% split, but reclaim VAR's unused portion
-			varShare = uint32(float64(totalUnused) * 0.10)
+			varShare = bsa.unusedVARPortion(totalUnused)
 			skaShare = totalUnused - varShare
 		}
 
@@ -198,8 +378,8 @@ func (bsa *BlockSpaceAllocator) AllocateBlockSpace(pendingTxBytes map[cointype.C
 			for _, skaType := range activeSKATypes {
 				need := skaNeeds[skaType]
 				if need > 0 {
-					proportion := float64(need) / float64(totalSKANeed)
-					skaGets := uint32(float64(skaShare) * proportion)
+					skaGets := bsa.unusedSKAPortion(skaShare, need,
+						totalSKANeed)
 					skaGets = min(skaGets, uint32(need))
 
 					if skaGets > 0 {
@@ -233,8 +413,8 @@ func (bsa *BlockSpaceAllocator) AllocateBlockSpace(pendingTxBytes map[cointype.C
 
 		// Calculate truly unused space (what's left in the block)
 		totalLeftover := uint32(0)
-		if bsa.maxBlockSize > totalCurrentlyAllocated {
-			totalLeftover = bsa.maxBlockSize - totalCurrentlyAllocated
+		if maxBlockSize > totalCurrentlyAllocated {
+			totalLeftover = maxBlockSize - totalCurrentlyAllocated
 		}
 
 		// VAR gets all remaining unused space
@@ -257,20 +437,60 @@ func (bsa *BlockSpaceAllocator) AllocateBlockSpace(pendingTxBytes map[cointype.C
 	}
 
 	// Sanity check with warning (should never happen with correct logic)
-	if totalAllocated > bsa.maxBlockSize {
+	if totalAllocated > maxBlockSize {
 		log.Warnf("Block space allocation overflow: total=%d exceeds max=%d (diff=%d)",
-			totalAllocated, bsa.maxBlockSize, totalAllocated-bsa.maxBlockSize)
+			totalAllocated, maxBlockSize, totalAllocated-maxBlockSize)
 		// Cap to prevent issues
-		totalAllocated = bsa.maxBlockSize
+		totalAllocated = maxBlockSize
 	}
 
 	return &AllocationResult{
 		Allocations:    allocations,
 		TotalAllocated: totalAllocated,
 		TotalUsed:      totalUsed,
+		StakeReserved:  stakeReserved,
 	}
 }
 
+// varPortion returns the portion of the provided number of bytes that is
+// allocated to VAR according to the split of the allocator.
+func (bsa *BlockSpaceAllocator) varPortion(bytes uint32) uint32 {
+	return uint32(uint64(bytes) * uint64(bsa.varBasisPoints) / MaxBasisPoints)
+}
+
+// unusedVARPortion returns the portion of the provided number of unused bytes
+// that is redistributed to VAR when both VAR and SKA have remaining demand.
+func (bsa *BlockSpaceAllocator) unusedVARPortion(unused uint32) uint32 {
+	if bsa.version < AllocV2 {
+		varAllocation := float64(bsa.varBasisPoints) / MaxBasisPoints
+		return uint32(float64(unused) * varAllocation)
+	}
+	return bsa.varPortion(unused)
+}
+
+// unusedSKAPortion returns the portion of the provided number of unused bytes
+// shared among SKA types that is redistributed to an SKA type with the
+// provided remaining need given the total remaining need of all SKA types.
+func (bsa *BlockSpaceAllocator) unusedSKAPortion(skaShare uint32, need, totalNeed int64) uint32 {
+	if bsa.version < AllocV2 {
+		proportion := float64(need) / float64(totalNeed)
+		return uint32(float64(skaShare) * proportion)
+	}
+	return uint32(uint64(skaShare) * uint64(need) / uint64(totalNeed))
+}
+
+// maxBlockBytes returns the maximum number of bytes a block may use for
+// transactions of the provided SKA coin type as defined by its chain
+// parameters.  Coin types without a per-block byte cap may use the entire
+// block.
+func (bsa *BlockSpaceAllocator) maxBlockBytes(coinType cointype.CoinType) uint32 {
+	config := bsa.chainParams.GetSKACoinConfig(coinType)
+	if config == nil || config.MaxBlockBytes == 0 {
+		return math.MaxUint32
+	}
+	return config.MaxBlockBytes
+}
+
 // GetAllocationForCoinType returns the space allocation for a specific coin type.
 func (result *AllocationResult) GetAllocationForCoinType(coinType cointype.CoinType) *CoinTypeAllocation {
 	return result.Allocations[coinType]
@@ -284,6 +504,236 @@ func (result *AllocationResult) GetUtilizationPercentage() float64 {
 	return (float64(result.TotalUsed) / float64(result.TotalAllocated)) * 100.0
 }
 
+// CoinTypeUtilization describes how much of the block space allocated to a
+// coin type is used.
+type CoinTypeUtilization struct {
+	CoinType cointype.CoinType
+
+	// FinalPercentage is the used bytes as a percentage of the final
+	// allocation.
+	FinalPercentage float64
+
+	// BasePercentage is the used bytes as a percentage of the base
+	// allocation.  It exceeds 100 when the coin type uses overflow space.
+	BasePercentage float64
+}
+
+// GetCoinTypeUtilization returns the block space utilization of every coin
+// type with an allocation ordered by coin type.  Unlike the aggregate
+// utilization, it reveals coin types that fill their allocation while others
+// leave theirs empty.
+func (result *AllocationResult) GetCoinTypeUtilization() []CoinTypeUtilization {
+	coinTypes := result.sortedCoinTypes()
+	utilization := make([]CoinTypeUtilization, 0, len(coinTypes))
+	for _, coinType := range coinTypes {
+		alloc := result.Allocations[coinType]
+		utilization = append(utilization, CoinTypeUtilization{
+			CoinType:        coinType,
+			FinalPercentage: alloc.UtilizationPercentage(),
+			BasePercentage:  alloc.BaseUtilizationPercentage(),
+		})
+	}
+	return utilization
+}
+
+// sortedCoinTypes returns the coin types with an allocation in ascending
+// order.
+func (result *AllocationResult) sortedCoinTypes() []cointype.CoinType {
+	return cointype.SortedKeys(result.Allocations)
+}
+
+// UtilizationPercentage returns the bytes used by the coin type as a
+// percentage of its final allocation.  It is zero when no space is allocated
+// to the coin type.
+func (alloc *CoinTypeAllocation) UtilizationPercentage() float64 {
+	if alloc.FinalAllocation == 0 {
+		return 0.0
+	}
+	return (float64(alloc.UsedBytes) / float64(alloc.FinalAllocation)) * 100.0
+}
+
+// BaseUtilizationPercentage returns the bytes used by the coin type as a
+// percentage of its base allocation.  It exceeds 100 when the coin type uses
+// overflow space and is zero when the coin type has no base allocation.
+func (alloc *CoinTypeAllocation) BaseUtilizationPercentage() float64 {
+	if alloc.BaseAllocation == 0 {
+		return 0.0
+	}
+	return (float64(alloc.UsedBytes) / float64(alloc.BaseAllocation)) * 100.0
+}
+
+// OverflowGranted returns the number of bytes the coin type was granted on top
+// of its base allocation when the unused space was redistributed.  It is zero
+// when the final allocation did not grow beyond the base allocation.
+func (alloc *CoinTypeAllocation) OverflowGranted() uint32 {
+	if alloc.FinalAllocation <= alloc.BaseAllocation {
+		return 0
+	}
+	return alloc.FinalAllocation - alloc.BaseAllocation
+}
+
+// LogFields returns the allocation of the coin type formatted as space
+// separated key=value fields that are suitable for structured logging.
+func (alloc *CoinTypeAllocation) LogFields() string {
+	return fmt.Sprintf("coin=%s base=%d demand=%d overflow=%d final=%d "+
+		"used=%d util_final=%.1f util_base=%.1f", alloc.CoinType,
+		alloc.BaseAllocation, alloc.PendingBytes, alloc.OverflowGranted(),
+		alloc.FinalAllocation, alloc.UsedBytes, alloc.UtilizationPercentage(),
+		alloc.BaseUtilizationPercentage())
+}
+
+// LogAllocationDecision logs the provided allocation for the block at the
+// provided height at the debug level with one key=value line per coin type in
+// coin type order, so allocation decisions can be reconstructed from the log
+// alone.  The stage identifies the point of template generation the
+// allocation was made at.  Nothing is formatted unless debug logging is
+// enabled.
+func LogAllocationDecision(stage string, height int64, version AllocVersion,
+	result *AllocationResult) {
+
+	if log.Level() > slog.LevelDebug {
+		return
+	}
+
+	log.Debugf("stage=%s height=%d version=%d stake_reserved=%d "+
+		"total_allocated=%d total_used=%d", stage, height, version,
+		result.StakeReserved, result.TotalAllocated, result.TotalUsed)
+	for _, coinType := range result.sortedCoinTypes() {
+		log.Debugf("stage=%s height=%d %s", stage, height,
+			result.Allocations[coinType].LogFields())
+	}
+}
+
+// InclusionEstimate describes how a transaction competes for the block space
+// allocated to its coin type.
+type InclusionEstimate struct {
+	// BucketSize is the number of bytes the next block allocates to the coin
+	// type given the pending demand.
+	BucketSize uint32
+
+	// BytesAhead is the number of pending bytes of the same coin type that
+	// take precedence over the transaction.
+	BytesAhead uint32
+
+	// FitsNextBlock indicates whether the transaction fits in the space
+	// allocated to its coin type in the next block.
+	FitsNextBlock bool
+
+	// BlocksToConfirm is the estimated number of blocks until the transaction
+	// is included assuming the allocation does not change.  It is zero when
+	// no space is allocated to the coin type.
+	BlocksToConfirm int64
+}
+
+// EstimateInclusion estimates whether a transaction of the given coin type and
+// size fits in the space the next block allocates to its coin type when
+// bytesAhead bytes of pending transactions of the same coin type take
+// precedence over it.  The pending transaction bytes must include the
+// transaction itself.
+func (bsa *BlockSpaceAllocator) EstimateInclusion(coinType cointype.CoinType,
+	txSize, bytesAhead uint32, pendingTxBytes map[cointype.CoinType]uint32) *InclusionEstimate {
+
+	estimate := &InclusionEstimate{BytesAhead: bytesAhead}
+	alloc := bsa.AllocateBlockSpace(pendingTxBytes).GetAllocationForCoinType(coinType)
+	if alloc == nil || alloc.FinalAllocation == 0 {
+		return estimate
+	}
+
+	bucketSize := uint64(alloc.FinalAllocation)
+	needed := uint64(bytesAhead) + uint64(txSize)
+	estimate.BucketSize = alloc.FinalAllocation
+	estimate.FitsNextBlock = needed <= bucketSize
+	estimate.BlocksToConfirm = int64((needed + bucketSize - 1) / bucketSize)
+	return estimate
+}
+
+// CompetingTx describes a pending transaction that competes for the block
+// space allocated to its coin type.
+type CompetingTx struct {
+	// Size is the serialized size of the transaction in bytes.
+	Size uint32
+
+	// FeeRate is the fee rate the transaction pays in atoms per KB.
+	FeeRate int64
+}
+
+// NextBlockFeeRate returns the minimum fee rate, in atoms per KB, a transaction
+// of the given coin type and size must pay to fit in the space the next block
+// allocates to its coin type when competing against the provided pending
+// transactions of the same coin type, along with the size of that space.
+// Transactions are assumed to be selected in order of descending fee rate with
+// ties favoring the competing transactions.  The pending transaction bytes
+// must include the transaction itself.
+//
+// A fee rate of zero is returned when the transaction fits regardless of its
+// fee rate.  False is returned when no space is allocated to the coin type or
+// the transaction does not fit in it even by itself.
+func (bsa *BlockSpaceAllocator) NextBlockFeeRate(coinType cointype.CoinType,
+	txSize uint32, competing []CompetingTx,
+	pendingTxBytes map[cointype.CoinType]uint32) (int64, uint32, bool) {
+
+	alloc := bsa.AllocateBlockSpace(pendingTxBytes).GetAllocationForCoinType(coinType)
+	if alloc == nil || txSize > alloc.FinalAllocation {
+		return 0, 0, false
+	}
+	bucketSize := alloc.FinalAllocation
+
+	// Find the competing transaction with the highest fee rate that no longer
+	// fits ahead of the transaction.  The transaction must outbid it.
+	sorted := make([]CompetingTx, len(competing))
+	copy(sorted, competing)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].FeeRate > sorted[j].FeeRate
+	})
+	available := uint64(bucketSize - txSize)
+	var bytesAhead uint64
+	for _, tx := range sorted {
+		bytesAhead += uint64(tx.Size)
+		if bytesAhead > available {
+			return tx.FeeRate + 1, bucketSize, true
+		}
+	}
+	return 0, bucketSize, true
+}
+
+// CheckSpaceUsage ensures the provided number of bytes used by each coin type
+// fits within the final allocation the allocator computes for that usage and
+// that the total usage fits within the maximum block size.  It also ensures
+// SKA types never exceed the per-block byte cap defined by their chain
+// parameters.  Coin types without an allocation are otherwise not checked.
+//
+// Blocks are validated against this check, so it also allows block templates
+// to be checked before they are handed out.
+func (bsa *BlockSpaceAllocator) CheckSpaceUsage(spaceUsed map[cointype.CoinType]uint32) error {
+	// The coin types are checked in ascending order so the same violation is
+	// always reported for a given usage.
+	allocation := bsa.AllocateBlockSpace(spaceUsed)
+	for _, coinType := range cointype.SortedKeys(spaceUsed) {
+		used := spaceUsed[coinType]
+		if coinType.IsSKA() {
+			if maxBytes := bsa.maxBlockBytes(coinType); used > maxBytes {
+				return fmt.Errorf("%s transactions exceed per-block cap: "+
+					"used %d bytes > max %d bytes", coinType.String(), used,
+					maxBytes)
+			}
+		}
+		coinAlloc := allocation.GetAllocationForCoinType(coinType)
+		if coinAlloc == nil {
+			continue
+		}
+		if used > coinAlloc.FinalAllocation {
+			return fmt.Errorf("%s transactions exceed allocation: used %d "+
+				"bytes > max %d bytes", coinType.String(), used,
+				coinAlloc.FinalAllocation)
+		}
+	}
+	if allocation.TotalUsed > bsa.maxBlockSize {
+		return fmt.Errorf("block exceeds maximum size: used %d bytes > max "+
+			"%d bytes", allocation.TotalUsed, bsa.maxBlockSize)
+	}
+	return nil
+}
+
 // Helper function to return the minimum of two uint32 values.
 func min(a, b uint32) uint32 {
 	if a < b {
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockalloc
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// benchCompositions is the corpus of pending transaction mixes the allocator
+// benchmarks are run against expressed as the number of transactions of each
+// coin type.
+var benchCompositions = []struct {
+	name    string
+	numTxns map[cointype.CoinType]int
+}{{
+	name:    "VAR only",
+	numTxns: map[cointype.CoinType]int{cointype.CoinTypeVAR: 1000},
+}, {
+	name:    "balanced",
+	numTxns: map[cointype.CoinType]int{cointype.CoinTypeVAR: 500, 1: 500},
+}, {
+	name:    "SKA heavy",
+	numTxns: map[cointype.CoinType]int{cointype.CoinTypeVAR: 100, 1: 900},
+}, {
+	name: "SKA split",
+	numTxns: map[cointype.CoinType]int{cointype.CoinTypeVAR: 100, 1: 300,
+		2: 300, 3: 300},
+}}
+
+// benchTxns deterministically generates the provided number of transactions
+// of each coin type in coin type order.
+func benchTxns(numTxns map[cointype.CoinType]int) []*dcrutil.Tx {
+	var txns []*dcrutil.Tx
+	for coinType := cointype.CoinTypeVAR; coinType <= 3; coinType++ {
+		for i := 0; i < numTxns[coinType]; i++ {
+			tx := wire.NewMsgTx()
+			tx.AddTxIn(&wire.TxIn{
+				PreviousOutPoint: wire.OutPoint{Index: uint32(i)},
+				SignatureScript:  make([]byte, 108),
+				Sequence:         wire.MaxTxInSequenceNum,
+			})
+			tx.AddTxOut(&wire.TxOut{
+				Value:    1e8,
+				CoinType: coinType,
+				PkScript: make([]byte, 25),
+			})
+			txns = append(txns, dcrutil.NewTx(tx))
+		}
+	}
+	return txns
+}
+
+// BenchmarkAllocateBlockSpace benchmarks calculating the block space
+// allocation for various mixes of pending VAR and SKA transactions.
+func BenchmarkAllocateBlockSpace(b *testing.B) {
+	allocator := NewBlockSpaceAllocator(375000, mockChainParamsWithThreeSKAs())
+	for _, comp := range benchCompositions {
+		pending := make(map[cointype.CoinType]uint32)
+		for _, tx := range benchTxns(comp.numTxns) {
+			pending[GetTransactionCoinType(tx)] += uint32(tx.MsgTx().SerializeSize())
+		}
+
+		b.Run(comp.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				allocator.AllocateBlockSpace(pending)
+			}
+		})
+	}
+}
+
+// BenchmarkTransactionSizeTracker benchmarks filling a block with various
+// mixes of VAR and SKA transactions while enforcing the per-coin-type
+// allocation the same way block template generation does.
+func BenchmarkTransactionSizeTracker(b *testing.B) {
+	allocator := NewBlockSpaceAllocator(375000, mockChainParamsWithThreeSKAs())
+	for _, comp := range benchCompositions {
+		txns := benchTxns(comp.numTxns)
+
+		b.Run(comp.name, func(b *testing.B) {
+			tracker := NewTransactionSizeTracker(allocator)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				tracker.Reset()
+				for _, tx := range txns {
+					if tracker.CanAddTransaction(tx) {
+						tracker.AddTransaction(tx)
+					}
+				}
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@
 package blockalloc
 
 import (
+	"math"
+	"reflect"
 	"testing"
 
 	"github.com/monetarium/monetarium-node/chaincfg"
@@ -90,12 +92,8 @@ func TestNewBlockSpaceAllocator(t *testing.T) {
 		t.Errorf("Expected maxBlockSize 1000000, got %d", allocator.maxBlockSize)
 	}
 
-	if allocator.varAllocation != 0.10 {
-		t.Errorf("Expected varAllocation 0.10, got %f", allocator.varAllocation)
-	}
-
-	if allocator.skaAllocation != 0.90 {
-		t.Errorf("Expected skaAllocation 0.90, got %f", allocator.skaAllocation)
+	if allocator.varBasisPoints != 1000 {
+		t.Errorf("Expected varBasisPoints 1000, got %d", allocator.varBasisPoints)
 	}
 }
 
@@ -625,6 +623,50 @@ func TestUtilizationPercentage(t *testing.T) {
 	}
 }
 
+// TestCoinTypeUtilization ensures the per-coin-type utilization reports the
+// used bytes relative to both the final and base allocations in coin type
+// order and reveals a full SKA allocation next to an empty VAR allocation.
+func TestCoinTypeUtilization(t *testing.T) {
+	result := &AllocationResult{
+		Allocations: map[cointype.CoinType]*CoinTypeAllocation{
+			2: {
+				CoinType:        2,
+				BaseAllocation:  4000,
+				FinalAllocation: 6000,
+				UsedBytes:       6000,
+			},
+			cointype.CoinTypeVAR: {
+				CoinType:        cointype.CoinTypeVAR,
+				BaseAllocation:  1000,
+				FinalAllocation: 4000,
+			},
+			1: {
+				CoinType: 1,
+			},
+		},
+		TotalAllocated: 10000,
+		TotalUsed:      6000,
+	}
+
+	want := []CoinTypeUtilization{{
+		CoinType: cointype.CoinTypeVAR,
+	}, {
+		CoinType: 1,
+	}, {
+		CoinType:        2,
+		FinalPercentage: 100.0,
+		BasePercentage:  150.0,
+	}}
+	got := result.GetCoinTypeUtilization()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected utilization -- got %+v, want %+v", got, want)
+	}
+	if aggregate := result.GetUtilizationPercentage(); aggregate != 60.0 {
+		t.Fatalf("unexpected aggregate utilization -- got %f, want 60",
+			aggregate)
+	}
+}
+
 // TestEdgeCaseZeroPending tests behavior with no pending transactions.
 func TestEdgeCaseZeroPending(t *testing.T) {
 	params := mockChainParams()
@@ -1059,6 +1101,292 @@ func TestAllocationOverflowBug(t *testing.T) {
 		skaAlloc.FinalAllocation, float64(skaAlloc.FinalAllocation)/375000*100)
 }
 
+// TestAllocV2NoDoubleCountedVARSpace ensures version 2 of the allocation
+// algorithm no longer leaves the unused VAR base allocation allocated to VAR
+// after redistributing it to SKA, while version 1 retains the original
+// behavior for blocks prior to its activation.
+func TestAllocV2NoDoubleCountedVARSpace(t *testing.T) {
+	const maxBlockSize = 375000
+	params := chaincfg.MainNetParams()
+	params.BlockAllocV2Height = 1000
+	allocator := NewBlockSpaceAllocator(maxBlockSize, params)
+
+	// VAR uses only a fraction of its base allocation while SKA-1 has more
+	// demand than the entire block.
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 1000,
+		cointype.CoinType(1): 1000000,
+	}
+
+	totalFinal := func(result *AllocationResult) uint32 {
+		var total uint32
+		for _, alloc := range result.Allocations {
+			total += alloc.FinalAllocation
+		}
+		return total
+	}
+
+	v1 := allocator.ForHeight(999)
+	if v1.Version() != AllocV1 {
+		t.Fatalf("unexpected version prior to activation: %d", v1.Version())
+	}
+	v1Result := v1.AllocateBlockSpace(pending)
+	if total := totalFinal(v1Result); total <= maxBlockSize {
+		t.Fatalf("version 1 allocations unexpectedly fit the block: %d", total)
+	}
+
+	v2 := allocator.ForHeight(1000)
+	if v2.Version() != AllocV2 {
+		t.Fatalf("unexpected version after activation: %d", v2.Version())
+	}
+	v2Result := v2.AllocateBlockSpace(pending)
+	if total := totalFinal(v2Result); total != maxBlockSize {
+		t.Fatalf("version 2 allocations do not fill the block: got %d, "+
+			"want %d", total, maxBlockSize)
+	}
+	varAlloc := v2Result.GetAllocationForCoinType(cointype.CoinTypeVAR)
+	if varAlloc.FinalAllocation != 1000 || varAlloc.UsedBytes != 1000 {
+		t.Fatalf("unexpected version 2 VAR allocation: final %d, used %d",
+			varAlloc.FinalAllocation, varAlloc.UsedBytes)
+	}
+
+	// SKA-1 receives the same space with both versions.
+	v1SKA := v1Result.GetAllocationForCoinType(1).FinalAllocation
+	v2SKA := v2Result.GetAllocationForCoinType(1).FinalAllocation
+	if v1SKA != v2SKA {
+		t.Fatalf("mismatched SKA-1 allocation: version 1 %d, version 2 %d",
+			v1SKA, v2SKA)
+	}
+
+	// Version 2 is never selected when it is not scheduled.
+	params.BlockAllocV2Height = 0
+	if got := AllocVersionForHeight(params, 1e6); got != AllocV1 {
+		t.Fatalf("unexpected version when not scheduled: %d", got)
+	}
+}
+
+// TestAllocationWithSplit ensures allocators with alternative VAR / SKA splits
+// divide the block accordingly while the standard split is unchanged.
+func TestAllocationWithSplit(t *testing.T) {
+	params := mockChainParams()
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 200000,
+		cointype.CoinType(1): 200000,
+		cointype.CoinType(2): 200000,
+	}
+
+	tests := []struct {
+		name       string
+		allocator  *BlockSpaceAllocator
+		wantVAR    uint32
+		wantPerSKA uint32
+	}{{
+		name:       "standard split",
+		allocator:  NewBlockSpaceAllocator(100000, params),
+		wantVAR:    10000,
+		wantPerSKA: 45000,
+	}, {
+		name:       "explicit 10% split",
+		allocator:  NewBlockSpaceAllocatorWithSplit(100000, params, 1000),
+		wantVAR:    10000,
+		wantPerSKA: 45000,
+	}, {
+		name:       "20% split",
+		allocator:  NewBlockSpaceAllocatorWithSplit(100000, params, 2000),
+		wantVAR:    20000,
+		wantPerSKA: 40000,
+	}, {
+		name:       "12.5% split",
+		allocator:  NewBlockSpaceAllocatorWithSplit(100000, params, 1250),
+		wantVAR:    12500,
+		wantPerSKA: 43750,
+	}, {
+		name:       "split capped at 100%",
+		allocator:  NewBlockSpaceAllocatorWithSplit(100000, params, 15000),
+		wantVAR:    100000,
+		wantPerSKA: 0,
+	}}
+
+	for _, test := range tests {
+		result := test.allocator.AllocateBlockSpace(pending)
+		varAlloc := result.GetAllocationForCoinType(cointype.CoinTypeVAR)
+		if varAlloc.FinalAllocation != test.wantVAR {
+			t.Errorf("%q: unexpected VAR allocation -- got %d, want %d",
+				test.name, varAlloc.FinalAllocation, test.wantVAR)
+		}
+		for _, coinType := range []cointype.CoinType{1, 2} {
+			skaAlloc := result.GetAllocationForCoinType(coinType)
+			if skaAlloc.FinalAllocation != test.wantPerSKA {
+				t.Errorf("%q: unexpected %v allocation -- got %d, want %d",
+					test.name, coinType, skaAlloc.FinalAllocation,
+					test.wantPerSKA)
+			}
+		}
+	}
+}
+
+// TestAllocationChainParamsSplit ensures the standard allocator uses the VAR /
+// SKA split defined by the chain parameters and falls back to the default
+// split when they do not define one.
+func TestAllocationChainParamsSplit(t *testing.T) {
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 200000,
+		cointype.CoinType(1): 200000,
+		cointype.CoinType(2): 200000,
+	}
+
+	tests := []struct {
+		name           string
+		varBasisPoints uint32
+		wantVAR        uint32
+		wantPerSKA     uint32
+	}{{
+		name:           "unset uses default split",
+		varBasisPoints: 0,
+		wantVAR:        10000,
+		wantPerSKA:     45000,
+	}, {
+		name:           "25% split",
+		varBasisPoints: 2500,
+		wantVAR:        25000,
+		wantPerSKA:     37500,
+	}}
+
+	for _, test := range tests {
+		params := mockChainParams()
+		params.BlockAllocVARBasisPoints = test.varBasisPoints
+		allocator := NewBlockSpaceAllocator(100000, params)
+		result := allocator.AllocateBlockSpace(pending)
+		varAlloc := result.GetAllocationForCoinType(cointype.CoinTypeVAR)
+		if varAlloc.FinalAllocation != test.wantVAR {
+			t.Errorf("%q: unexpected VAR allocation -- got %d, want %d",
+				test.name, varAlloc.FinalAllocation, test.wantVAR)
+		}
+		for _, coinType := range []cointype.CoinType{1, 2} {
+			skaAlloc := result.GetAllocationForCoinType(coinType)
+			if skaAlloc.FinalAllocation != test.wantPerSKA {
+				t.Errorf("%q: unexpected %v allocation -- got %d, want %d",
+					test.name, coinType, skaAlloc.FinalAllocation,
+					test.wantPerSKA)
+			}
+		}
+	}
+}
+
+// TestAllocationExactProportionalShares ensures unused space is redistributed
+// among SKA types in exact proportion to their remaining demand.  The shares
+// in this scenario are whole numbers of bytes that floating point arithmetic
+// computes as slightly less than the whole number.
+func TestAllocationExactProportionalShares(t *testing.T) {
+	params := mockChainParams()
+	allocator := NewBlockSpaceAllocator(490, params).WithVersion(AllocV2)
+
+	// VAR has no demand, so its 49 byte base allocation is redistributed to
+	// the SKA types, which need 1 and 48 more bytes than their 220 byte base
+	// allocations.
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinType(1): 221,
+		cointype.CoinType(2): 268,
+	}
+	result := allocator.AllocateBlockSpace(pending)
+
+	want := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 1,
+		cointype.CoinType(1): 221,
+		cointype.CoinType(2): 268,
+	}
+	for coinType, wantFinal := range want {
+		alloc := result.GetAllocationForCoinType(coinType)
+		if alloc.FinalAllocation != wantFinal {
+			t.Errorf("unexpected %v allocation -- got %d, want %d", coinType,
+				alloc.FinalAllocation, wantFinal)
+		}
+	}
+	if result.TotalAllocated != 490 {
+		t.Errorf("unexpected total allocation -- got %d, want 490",
+			result.TotalAllocated)
+	}
+}
+
+// TestAllocV1FloatRedistribution ensures version 1 of the allocation algorithm
+// redistributes unused space with exactly the floating point arithmetic it
+// originally used, including for inputs where integer arithmetic arrives at a
+// different result, while version 2 uses integer arithmetic.
+func TestAllocV1FloatRedistribution(t *testing.T) {
+	params := mockChainParams()
+	v1 := NewBlockSpaceAllocator(375000, params).WithVersion(AllocV1)
+	v2 := v1.WithVersion(AllocV2)
+
+	// The redistribution formulas of the original version 1 algorithm.
+	origVARShare := func(unused uint32) uint32 {
+		return uint32(float64(unused) * 0.10)
+	}
+	origSKAGets := func(skaShare uint32, need, totalNeed int64) uint32 {
+		proportion := float64(need) / float64(totalNeed)
+		return uint32(float64(skaShare) * proportion)
+	}
+
+	unusedTests := []uint32{0, 1, 9, 10, 11, 99, 100, 101, 33750, 374999,
+		375000, math.MaxUint32 / 10, math.MaxUint32 - 1, math.MaxUint32}
+	for _, unused := range unusedTests {
+		if got, want := v1.unusedVARPortion(unused), origVARShare(unused); got != want {
+			t.Errorf("version 1 VAR portion of %d -- got %d, want %d",
+				unused, got, want)
+		}
+		want := uint32(uint64(unused) / 10)
+		if got := v2.unusedVARPortion(unused); got != want {
+			t.Errorf("version 2 VAR portion of %d -- got %d, want %d",
+				unused, got, want)
+		}
+	}
+
+	skaTests := []struct {
+		skaShare  uint32
+		need      int64
+		totalNeed int64
+	}{
+		{15873, 320, 624},
+		{49, 1, 49},
+		{49, 48, 49},
+		{0, 1, 1},
+		{1, 1, 3},
+		{2, 1, 3},
+		{337500, 1, 3},
+		{337500, 2, 3},
+		{337500, 337500, 337500},
+		{math.MaxUint32, 1, 2},
+		{math.MaxUint32, math.MaxUint32 - 1, math.MaxUint32},
+		{math.MaxUint32, math.MaxUint32, math.MaxUint32},
+	}
+	for _, test := range skaTests {
+		got := v1.unusedSKAPortion(test.skaShare, test.need, test.totalNeed)
+		want := origSKAGets(test.skaShare, test.need, test.totalNeed)
+		if got != want {
+			t.Errorf("version 1 SKA portion of %d for need %d of %d -- got "+
+				"%d, want %d", test.skaShare, test.need, test.totalNeed, got,
+				want)
+		}
+		want = uint32(uint64(test.skaShare) * uint64(test.need) /
+			uint64(test.totalNeed))
+		got = v2.unusedSKAPortion(test.skaShare, test.need, test.totalNeed)
+		if got != want {
+			t.Errorf("version 2 SKA portion of %d for need %d of %d -- got "+
+				"%d, want %d", test.skaShare, test.need, test.totalNeed, got,
+				want)
+		}
+	}
+
+	// The exact share of 15873 * 320 / 624 = 8140 bytes is computed as
+	// slightly less than the whole number with floating point arithmetic, so
+	// the versions must differ.
+	if got := v1.unusedSKAPortion(15873, 320, 624); got != 8139 {
+		t.Errorf("unexpected version 1 SKA portion -- got %d, want 8139", got)
+	}
+	if got := v2.unusedSKAPortion(15873, 320, 624); got != 8140 {
+		t.Errorf("unexpected version 2 SKA portion -- got %d, want 8140", got)
+	}
+}
+
 // TestVARGetsLeftoverWhenSKAHasMinimalDemand tests that VAR can claim unused SKA space.
 // This is critical for mainnet where large VAR transaction sets need to fit when SKA is idle.
 func TestVARGetsLeftoverWhenSKAHasMinimalDemand(t *testing.T) {
@@ -1106,3 +1434,504 @@ func TestVARGetsLeftoverWhenSKAHasMinimalDemand(t *testing.T) {
 	t.Logf("Total allocated: %d / 375000 (%.1f%%)",
 		totalAllocated, float64(totalAllocated)/375000*100)
 }
+
+// TestStakeReserve ensures the space reserved for the stake tree is taken out
+// of the block before the remainder is allocated among the coin types.
+func TestStakeReserve(t *testing.T) {
+	const maxBlockSize = 100000
+	const reserve = 20000
+	allocator := NewBlockSpaceAllocator(maxBlockSize, mockChainParams())
+	allocator = allocator.WithVersion(AllocV2)
+	reserved := allocator.WithStakeReserve(reserve)
+
+	// The reserve only applies to the returned copy.
+	if got := allocator.StakeReserve(); got != 0 {
+		t.Fatalf("unexpected reserve on original allocator: %d", got)
+	}
+	if got := reserved.StakeReserve(); got != reserve {
+		t.Fatalf("unexpected reserve: got %d, want %d", got, reserve)
+	}
+	if got := reserved.Version(); got != AllocV2 {
+		t.Fatalf("reserve did not retain version: %d", got)
+	}
+
+	totalFinal := func(result *AllocationResult) uint32 {
+		var total uint32
+		for _, alloc := range result.Allocations {
+			total += alloc.FinalAllocation
+		}
+		return total
+	}
+
+	// VAR receives the entire remainder when no SKA is pending.
+	result := reserved.AllocateBlockSpace(map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 1000000,
+	})
+	varAlloc := result.GetAllocationForCoinType(cointype.CoinTypeVAR)
+	if varAlloc.FinalAllocation != maxBlockSize-reserve {
+		t.Fatalf("unexpected VAR allocation: got %d, want %d",
+			varAlloc.FinalAllocation, maxBlockSize-reserve)
+	}
+	if result.StakeReserved != reserve {
+		t.Fatalf("unexpected reserved bytes: got %d, want %d",
+			result.StakeReserved, reserve)
+	}
+
+	// The split is applied to the remainder and the allocations never
+	// exceed it.
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 1000000,
+		cointype.CoinType(1): 1000000,
+	}
+	result = reserved.AllocateBlockSpace(pending)
+	varAlloc = result.GetAllocationForCoinType(cointype.CoinTypeVAR)
+	if varAlloc.BaseAllocation != (maxBlockSize-reserve)/10 {
+		t.Fatalf("unexpected VAR base allocation: got %d, want %d",
+			varAlloc.BaseAllocation, (maxBlockSize-reserve)/10)
+	}
+	if total := totalFinal(result); total > maxBlockSize-reserve {
+		t.Fatalf("allocations exceed the remainder: got %d, max %d", total,
+			maxBlockSize-reserve)
+	}
+	if result.TotalUsed > maxBlockSize-reserve {
+		t.Fatalf("usage exceeds the remainder: got %d, max %d",
+			result.TotalUsed, maxBlockSize-reserve)
+	}
+
+	// A reserve larger than the block leaves nothing to allocate.
+	result = allocator.WithStakeReserve(maxBlockSize + 1).AllocateBlockSpace(pending)
+	if total := totalFinal(result); total != 0 {
+		t.Fatalf("unexpected allocations with oversized reserve: %d", total)
+	}
+	if result.StakeReserved != maxBlockSize {
+		t.Fatalf("unexpected reserved bytes with oversized reserve: got %d, "+
+			"want %d", result.StakeReserved, maxBlockSize)
+	}
+}
+
+// TestSKAMaxBlockBytes ensures SKA types with a per-block byte cap are never
+// allocated more than the cap from either their base allocation or the
+// overflow, that the space they are unable to claim is redistributed, and that
+// blocks exceeding the cap are rejected.
+func TestSKAMaxBlockBytes(t *testing.T) {
+	const maxBlockSize = 100000
+	params := mockChainParams()
+	allocator := NewBlockSpaceAllocator(maxBlockSize, params).WithVersion(AllocV2)
+
+	tests := []struct {
+		name     string
+		maxBytes uint32
+		pending  map[cointype.CoinType]uint32
+		want     map[cointype.CoinType]uint32
+	}{{
+		name:     "cap below base allocation",
+		maxBytes: 20000,
+		pending: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 5000,
+			cointype.CoinType(1): 200000,
+			cointype.CoinType(2): 200000,
+		},
+		want: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 5000,
+			cointype.CoinType(1): 75000,
+			cointype.CoinType(2): 20000,
+		},
+	}, {
+		name:     "cap limits overflow",
+		maxBytes: 50000,
+		pending: map[cointype.CoinType]uint32{
+			cointype.CoinType(2): 200000,
+		},
+		want: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 50000,
+			cointype.CoinType(1): 0,
+			cointype.CoinType(2): 50000,
+		},
+	}, {
+		name:     "no cap",
+		maxBytes: 0,
+		pending: map[cointype.CoinType]uint32{
+			cointype.CoinType(2): 200000,
+		},
+		want: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 0,
+			cointype.CoinType(1): 0,
+			cointype.CoinType(2): 100000,
+		},
+	}}
+
+	for _, test := range tests {
+		params.SKACoins[2].MaxBlockBytes = test.maxBytes
+		result := allocator.AllocateBlockSpace(test.pending)
+		for coinType, want := range test.want {
+			alloc := result.GetAllocationForCoinType(coinType)
+			if alloc.FinalAllocation != want {
+				t.Errorf("%q: unexpected %v allocation -- got %d, want %d",
+					test.name, coinType, alloc.FinalAllocation, want)
+			}
+		}
+		if result.TotalAllocated > maxBlockSize {
+			t.Errorf("%q: allocations exceed the block -- got %d, max %d",
+				test.name, result.TotalAllocated, maxBlockSize)
+		}
+	}
+
+	// Blocks that use more than the cap are rejected even when the space
+	// would otherwise be available to the coin type.
+	params.SKACoins[2].MaxBlockBytes = 20000
+	err := allocator.CheckSpaceUsage(map[cointype.CoinType]uint32{
+		cointype.CoinType(2): 20000,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error for usage at the cap: %v", err)
+	}
+	err = allocator.CheckSpaceUsage(map[cointype.CoinType]uint32{
+		cointype.CoinType(2): 20001,
+	})
+	if err == nil {
+		t.Fatal("did not receive error for usage above the cap")
+	}
+}
+
+// TestEstimateInclusion ensures transactions are estimated to fit in the space
+// allocated to their coin type according to the pending bytes ahead of them.
+func TestEstimateInclusion(t *testing.T) {
+	allocator := NewBlockSpaceAllocator(100000, mockChainParams())
+
+	// Demand exceeds every base allocation, so there is no redistribution and
+	// each of the two active SKA types gets 45KB.
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 50000,
+		cointype.CoinType(1): 200000,
+		cointype.CoinType(2): 200000,
+	}
+
+	tests := []struct {
+		name       string
+		coinType   cointype.CoinType
+		txSize     uint32
+		bytesAhead uint32
+		wantBucket uint32
+		wantFits   bool
+		wantBlocks int64
+	}{{
+		name:       "fits at end of SKA-1 bucket",
+		coinType:   1,
+		txSize:     500,
+		bytesAhead: 44500,
+		wantBucket: 45000,
+		wantFits:   true,
+		wantBlocks: 1,
+	}, {
+		name:       "just misses SKA-1 bucket",
+		coinType:   1,
+		txSize:     500,
+		bytesAhead: 44501,
+		wantBucket: 45000,
+		wantFits:   false,
+		wantBlocks: 2,
+	}, {
+		name:       "several blocks of SKA-1 demand ahead",
+		coinType:   1,
+		txSize:     500,
+		bytesAhead: 100000,
+		wantBucket: 45000,
+		wantFits:   false,
+		wantBlocks: 3,
+	}, {
+		name:       "VAR bucket",
+		coinType:   cointype.CoinTypeVAR,
+		txSize:     250,
+		bytesAhead: 9000,
+		wantBucket: 10000,
+		wantFits:   true,
+		wantBlocks: 1,
+	}, {
+		name:       "inactive coin type has no bucket",
+		coinType:   3,
+		txSize:     250,
+		bytesAhead: 0,
+		wantBucket: 0,
+		wantFits:   false,
+		wantBlocks: 0,
+	}}
+
+	for _, test := range tests {
+		estimate := allocator.EstimateInclusion(test.coinType, test.txSize,
+			test.bytesAhead, pending)
+		if estimate.BucketSize != test.wantBucket {
+			t.Errorf("%q: unexpected bucket size -- got %d, want %d",
+				test.name, estimate.BucketSize, test.wantBucket)
+		}
+		if estimate.BytesAhead != test.bytesAhead {
+			t.Errorf("%q: unexpected bytes ahead -- got %d, want %d",
+				test.name, estimate.BytesAhead, test.bytesAhead)
+		}
+		if estimate.FitsNextBlock != test.wantFits {
+			t.Errorf("%q: unexpected fits -- got %v, want %v", test.name,
+				estimate.FitsNextBlock, test.wantFits)
+		}
+		if estimate.BlocksToConfirm != test.wantBlocks {
+			t.Errorf("%q: unexpected blocks to confirm -- got %d, want %d",
+				test.name, estimate.BlocksToConfirm, test.wantBlocks)
+		}
+	}
+}
+
+// TestNextBlockFeeRate ensures the fee rate a transaction needs to fit in the
+// space the next block allocates to its coin type outbids the competing
+// transaction with the highest fee rate that no longer fits ahead of it.
+func TestNextBlockFeeRate(t *testing.T) {
+	allocator := NewBlockSpaceAllocator(100000, mockChainParams())
+
+	// Demand exceeds every base allocation, so there is no redistribution and
+	// each of the two active SKA types gets 45KB.
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 50000,
+		cointype.CoinType(1): 200000,
+		cointype.CoinType(2): 200000,
+	}
+	competing := []CompetingTx{
+		{Size: 20000, FeeRate: 1000},
+		{Size: 20000, FeeRate: 5000},
+		{Size: 20000, FeeRate: 3000},
+		{Size: 20000, FeeRate: 2000},
+	}
+
+	tests := []struct {
+		name        string
+		coinType    cointype.CoinType
+		txSize      uint32
+		competing   []CompetingTx
+		wantFeeRate int64
+		wantBucket  uint32
+		wantOK      bool
+	}{{
+		name:        "outbid third highest fee rate",
+		coinType:    1,
+		txSize:      500,
+		competing:   competing,
+		wantFeeRate: 2001,
+		wantBucket:  45000,
+		wantOK:      true,
+	}, {
+		name:        "outbid second highest fee rate",
+		coinType:    1,
+		txSize:      5001,
+		competing:   competing,
+		wantFeeRate: 3001,
+		wantBucket:  45000,
+		wantOK:      true,
+	}, {
+		name:        "fits regardless of fee rate",
+		coinType:    1,
+		txSize:      500,
+		competing:   competing[:2],
+		wantFeeRate: 0,
+		wantBucket:  45000,
+		wantOK:      true,
+	}, {
+		name:      "larger than the bucket",
+		coinType:  1,
+		txSize:    45001,
+		competing: competing,
+	}, {
+		name:      "inactive coin type has no bucket",
+		coinType:  3,
+		txSize:    250,
+		competing: competing,
+	}}
+
+	for _, test := range tests {
+		feeRate, bucket, ok := allocator.NextBlockFeeRate(test.coinType,
+			test.txSize, test.competing, pending)
+		if ok != test.wantOK {
+			t.Errorf("%q: unexpected ok -- got %v, want %v", test.name, ok,
+				test.wantOK)
+			continue
+		}
+		if feeRate != test.wantFeeRate {
+			t.Errorf("%q: unexpected fee rate -- got %d, want %d", test.name,
+				feeRate, test.wantFeeRate)
+		}
+		if bucket != test.wantBucket {
+			t.Errorf("%q: unexpected bucket size -- got %d, want %d",
+				test.name, bucket, test.wantBucket)
+		}
+	}
+
+	// The competing transactions must not be reordered.
+	if competing[0].FeeRate != 1000 || competing[1].FeeRate != 5000 {
+		t.Fatal("competing transactions were modified")
+	}
+}
+
+// TestCheckSpaceUsage ensures usage that fits within the allocation computed
+// for it is accepted while usage that exceeds the allocation of a coin type is
+// rejected.
+func TestCheckSpaceUsage(t *testing.T) {
+	allocator := NewBlockSpaceAllocator(10000, mockChainParams())
+
+	tests := []struct {
+		name      string
+		spaceUsed map[cointype.CoinType]uint32
+		wantErr   bool
+	}{{
+		name:      "empty block",
+		spaceUsed: map[cointype.CoinType]uint32{},
+	}, {
+		name: "usage within base allocations",
+		spaceUsed: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 500,
+			1:                    4000,
+		},
+	}, {
+		name: "VAR fills block without SKA demand",
+		spaceUsed: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 10000,
+		},
+	}, {
+		name: "VAR exceeds block",
+		spaceUsed: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 10001,
+		},
+		wantErr: true,
+	}, {
+		name: "SKA exceeds space left after VAR",
+		spaceUsed: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 1000,
+			1:                    9500,
+		},
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		err := allocator.CheckSpaceUsage(test.spaceUsed)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%q: unexpected error -- got %v, want error %v",
+				test.name, err, test.wantErr)
+		}
+	}
+}
+
+// TestAllocationLogFields ensures the structured log fields of a coin type
+// allocation report the overflow granted on top of the base allocation.
+func TestAllocationLogFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		alloc CoinTypeAllocation
+		want  string
+	}{{
+		name: "overflow granted",
+		alloc: CoinTypeAllocation{
+			CoinType:        1,
+			BaseAllocation:  1000,
+			FinalAllocation: 1500,
+			PendingBytes:    2000,
+			UsedBytes:       1500,
+		},
+		want: "coin=SKA-1 base=1000 demand=2000 overflow=500 final=1500 " +
+			"used=1500 util_final=100.0 util_base=150.0",
+	}, {
+		name: "allocation shrunk to usage",
+		alloc: CoinTypeAllocation{
+			CoinType:        cointype.CoinTypeVAR,
+			BaseAllocation:  1000,
+			FinalAllocation: 200,
+			PendingBytes:    200,
+			UsedBytes:       200,
+		},
+		want: "coin=VAR base=1000 demand=200 overflow=0 final=200 used=200 " +
+			"util_final=100.0 util_base=20.0",
+	}}
+
+	for _, test := range tests {
+		if got := test.alloc.LogFields(); got != test.want {
+			t.Errorf("%q: unexpected fields -- got %q, want %q", test.name,
+				got, test.want)
+		}
+	}
+}
+
+// TestAllocationDeterminism ensures the allocation, including the distribution
+// of bytes lost to rounding, and the violation reported when checking space
+// usage do not depend on map iteration order.
+func TestAllocationDeterminism(t *testing.T) {
+	params := mockChainParamsWithThreeSKAs()
+	allocator := NewBlockSpaceAllocator(1000003, params)
+
+	// Demand that does not divide evenly between the SKA types.
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 333333,
+		1:                    700001,
+		2:                    250007,
+		3:                    123457,
+	}
+	want := allocator.AllocateBlockSpace(pending)
+	for i := 0; i < 100; i++ {
+		got := allocator.AllocateBlockSpace(pending)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("allocation differs between runs: got %+v, want %+v",
+				got, want)
+		}
+	}
+
+	// Every SKA type exceeds its per-block byte cap, so the lowest coin type
+	// must always be reported.
+	for _, config := range params.SKACoins {
+		config.MaxBlockBytes = 100
+	}
+	spaceUsed := map[cointype.CoinType]uint32{1: 1000, 2: 1000, 3: 1000}
+	const wantErr = "SKA-1 transactions exceed per-block cap: used 1000 " +
+		"bytes > max 100 bytes"
+	for i := 0; i < 100; i++ {
+		err := allocator.CheckSpaceUsage(spaceUsed)
+		if err == nil || err.Error() != wantErr {
+			t.Fatalf("unexpected error: got %v, want %q", err, wantErr)
+		}
+	}
+}
+
+// TestAllocationActivationHeight ensures allocators bound to a height only
+// allocate space to the SKA types that are active at that height.
+func TestAllocationActivationHeight(t *testing.T) {
+	params := mockChainParamsWithThreeSKAs()
+	params.SKACoins[3].ActivationHeight = 1000
+	allocator := NewBlockSpaceAllocator(1000000, params)
+
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 100000,
+		1:                    100000,
+		3:                    100000,
+	}
+	tests := []struct {
+		name      string
+		allocator *BlockSpaceAllocator
+		want      bool
+	}{
+		{"unbound", allocator, true},
+		{"before activation", allocator.ForHeight(999), false},
+		{"at activation", allocator.ForHeight(1000), true},
+	}
+	for _, test := range tests {
+		result := test.allocator.AllocateBlockSpace(pending)
+		alloc := result.GetAllocationForCoinType(3)
+		if got := alloc != nil; got != test.want {
+			t.Errorf("%s: coin type 3 allocated %v, want %v", test.name,
+				got, test.want)
+		}
+		if result.GetAllocationForCoinType(1) == nil {
+			t.Errorf("%s: coin type 1 not allocated", test.name)
+		}
+	}
+
+	// No space is allocated to SKA when none of the SKA types with pending
+	// transactions are active.
+	params.SKACoins[1].ActivationHeight = 1000
+	params.SKACoins[2].ActivationHeight = 1000
+	result := allocator.ForHeight(999).AllocateBlockSpace(pending)
+	varAlloc := result.GetAllocationForCoinType(cointype.CoinTypeVAR)
+	if varAlloc.FinalAllocation != 1000000 {
+		t.Errorf("unexpected VAR allocation %d", varAlloc.FinalAllocation)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockalloc
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+const (
+	// MinDivergenceBytes is the number of bytes the allocator must expect a
+	// block to use for a coin type before a shortfall of the coin type is
+	// considered divergent.  Small amounts of demand are commonly not yet
+	// seen by the miner of a block, so they are ignored.
+	MinDivergenceBytes = 2000
+
+	// DivergenceWindow is the number of most recent checked blocks of each
+	// miner the divergence monitor considers when determining whether or not
+	// the miner is flagged.
+	DivergenceWindow = 20
+
+	// DivergenceThreshold is the number of divergent blocks within the
+	// divergence window at which a miner is flagged.
+	DivergenceThreshold = 3
+
+	// maxDivergenceMiners is the maximum number of miners the divergence
+	// monitor tracks.  The miners that were least recently seen are evicted
+	// once it is reached since payout addresses are commonly rotated.
+	maxDivergenceMiners = 1000
+)
+
+// CoinTypeDivergence describes a coin type a block used significantly less
+// space for than the allocator would have allocated to it given the pending
+// demand the block could have included.
+type CoinTypeDivergence struct {
+	CoinType      cointype.CoinType
+	PendingBytes  uint32 // Bytes of transactions pending for the coin type
+	ExpectedBytes uint32 // Bytes the allocator expects the block to use
+	UsedBytes     uint32 // Bytes the block actually used
+}
+
+// CheckDivergence recomputes the allocation for the provided pending demand of
+// each coin type and compares the number of bytes the allocator expects a
+// block to use for each coin type, which is the lesser of its pending demand
+// and its final allocation, with the provided number of bytes a block actually
+// used.  It returns the coin types, in ascending order, whose usage falls short
+// of the expected bytes by more than the provided percentage of them.
+//
+// The consensus rules only limit how much space each coin type uses, so this
+// detects blocks that starve coin types in a way the allocation policy of the
+// node would not have, such as blocks produced by miners running a modified
+// allocation policy.  Since the pending demand of the node and the miner
+// commonly differ, a single divergent block is not necessarily meaningful.
+func (bsa *BlockSpaceAllocator) CheckDivergence(pendingBytes, spaceUsed map[cointype.CoinType]uint32, marginPct uint32) []CoinTypeDivergence {
+	allocation := bsa.AllocateBlockSpace(pendingBytes)
+
+	var divergences []CoinTypeDivergence
+	for _, coinType := range cointype.SortedKeys(pendingBytes) {
+		pending := pendingBytes[coinType]
+		expected := pending
+		if alloc := allocation.GetAllocationForCoinType(coinType); alloc != nil {
+			expected = min(pending, alloc.FinalAllocation)
+		}
+		if expected < MinDivergenceBytes {
+			continue
+		}
+		used := spaceUsed[coinType]
+		if used >= expected {
+			continue
+		}
+		shortfall := uint64(expected - used)
+		if shortfall*100 <= uint64(expected)*uint64(marginPct) {
+			continue
+		}
+		divergences = append(divergences, CoinTypeDivergence{
+			CoinType:      coinType,
+			PendingBytes:  pending,
+			ExpectedBytes: expected,
+			UsedBytes:     used,
+		})
+	}
+	return divergences
+}
+
+// MinerDivergence houses the allocation divergence statistics of a miner.
+type MinerDivergence struct {
+	Miner               string
+	Blocks              uint64 // Number of checked blocks of the miner
+	DivergentBlocks     uint64 // Number of divergent blocks of the miner
+	RecentDivergent     int    // Divergent blocks within the window
+	LastHeight          int64  // Height of the most recent checked block
+	LastDivergentHeight int64  // Height of the most recent divergent block
+	Flagged             bool   // Whether the threshold is reached
+}
+
+// DivergenceStats houses the allocation divergence statistics of all checked
+// blocks along with those of every tracked miner.
+type DivergenceStats struct {
+	BlocksChecked   uint64
+	DivergentBlocks uint64
+	Miners          []MinerDivergence
+}
+
+// minerDivergence tracks the recent blocks of a miner for the divergence
+// monitor.
+type minerDivergence struct {
+	MinerDivergence
+
+	// recent houses whether or not each of the most recent checked blocks
+	// of the miner diverged in the order they were checked.
+	recent []bool
+}
+
+// DivergenceMonitor tracks the allocation divergence of the blocks of each
+// miner so that miners whose blocks repeatedly diverge from the allocation
+// policy can be surfaced.  Miners are identified by an arbitrary string, such
+// as the address their blocks pay the work subsidy to.
+type DivergenceMonitor struct {
+	mtx             sync.Mutex
+	blocksChecked   uint64
+	divergentBlocks uint64
+	miners          map[string]*minerDivergence
+}
+
+// NewDivergenceMonitor returns a new divergence monitor without any checked
+// blocks.
+func NewDivergenceMonitor() *DivergenceMonitor {
+	return &DivergenceMonitor{
+		miners: make(map[string]*minerDivergence),
+	}
+}
+
+// Record records whether or not the block of the provided miner at the
+// provided height diverged and returns the resulting statistics of the miner
+// along with whether or not the miner became flagged as a result.  Miners are
+// flagged once DivergenceThreshold of their most recent DivergenceWindow
+// checked blocks diverged and stop being flagged once fewer of them did.
+//
+// This function is safe for concurrent access.
+func (m *DivergenceMonitor) Record(miner string, height int64, divergent bool) (MinerDivergence, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.blocksChecked++
+	if divergent {
+		m.divergentBlocks++
+	}
+
+	stats, ok := m.miners[miner]
+	if !ok {
+		if len(m.miners) >= maxDivergenceMiners {
+			m.evictLeastRecentMiner()
+		}
+		stats = &minerDivergence{
+			MinerDivergence: MinerDivergence{Miner: miner},
+		}
+		m.miners[miner] = stats
+	}
+	stats.Blocks++
+	stats.LastHeight = height
+	if divergent {
+		stats.DivergentBlocks++
+		stats.LastDivergentHeight = height
+	}
+
+	stats.recent = append(stats.recent, divergent)
+	if len(stats.recent) > DivergenceWindow {
+		stats.recent = stats.recent[len(stats.recent)-DivergenceWindow:]
+	}
+	stats.RecentDivergent = 0
+	for _, recent := range stats.recent {
+		if recent {
+			stats.RecentDivergent++
+		}
+	}
+
+	wasFlagged := stats.Flagged
+	stats.Flagged = stats.RecentDivergent >= DivergenceThreshold
+	return stats.MinerDivergence, stats.Flagged && !wasFlagged
+}
+
+// evictLeastRecentMiner removes the miner whose most recent checked block is
+// the oldest.
+//
+// This function MUST be called with the monitor lock held.
+func (m *DivergenceMonitor) evictLeastRecentMiner() {
+	var oldest *minerDivergence
+	for _, stats := range m.miners {
+		if oldest == nil || stats.LastHeight < oldest.LastHeight {
+			oldest = stats
+		}
+	}
+	if oldest != nil {
+		delete(m.miners, oldest.Miner)
+	}
+}
+
+// Stats returns the allocation divergence statistics of all checked blocks
+// along with those of every tracked miner with at least one divergent block
+// ordered by their number of recent divergent blocks and then by miner.
+//
+// This function is safe for concurrent access.
+func (m *DivergenceMonitor) Stats() *DivergenceStats {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	stats := &DivergenceStats{
+		BlocksChecked:   m.blocksChecked,
+		DivergentBlocks: m.divergentBlocks,
+	}
+	for _, miner := range m.miners {
+		if miner.DivergentBlocks > 0 {
+			stats.Miners = append(stats.Miners, miner.MinerDivergence)
+		}
+	}
+	sort.Slice(stats.Miners, func(i, j int) bool {
+		a, b := &stats.Miners[i], &stats.Miners[j]
+		if a.RecentDivergent != b.RecentDivergent {
+			return a.RecentDivergent > b.RecentDivergent
+		}
+		return a.Miner < b.Miner
+	})
+	return stats
+}
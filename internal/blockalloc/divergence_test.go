@@ -0,0 +1,141 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockalloc
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+// TestCheckDivergence ensures coin types a block used significantly less space
+// for than the allocator expects given the pending demand are detected.
+func TestCheckDivergence(t *testing.T) {
+	t.Parallel()
+
+	bsa := NewBlockSpaceAllocator(100000, mockChainParams())
+	pending := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 5000,
+		1:                    20000,
+		2:                    MinDivergenceBytes - 1,
+	}
+
+	tests := []struct {
+		name      string
+		used      map[cointype.CoinType]uint32
+		marginPct uint32
+		want      []cointype.CoinType
+	}{{
+		name: "pending demand served",
+		used: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 5000,
+			1:                    20000,
+		},
+		marginPct: 50,
+	}, {
+		name: "shortfall within margin",
+		used: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 5000,
+			1:                    15000,
+		},
+		marginPct: 50,
+	}, {
+		name: "SKA coin type starved",
+		used: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 5000,
+		},
+		marginPct: 50,
+		want:      []cointype.CoinType{1},
+	}, {
+		name:      "empty block",
+		used:      map[cointype.CoinType]uint32{},
+		marginPct: 50,
+		want:      []cointype.CoinType{cointype.CoinTypeVAR, 1},
+	}, {
+		name: "shortfall beyond zero margin",
+		used: map[cointype.CoinType]uint32{
+			cointype.CoinTypeVAR: 4999,
+			1:                    20000,
+		},
+		marginPct: 0,
+		want:      []cointype.CoinType{cointype.CoinTypeVAR},
+	}}
+
+	for _, test := range tests {
+		got := bsa.CheckDivergence(pending, test.used, test.marginPct)
+		if len(got) != len(test.want) {
+			t.Errorf("%q: unexpected divergences -- got %+v, want coin "+
+				"types %v", test.name, got, test.want)
+			continue
+		}
+		for i, divergence := range got {
+			if divergence.CoinType != test.want[i] {
+				t.Errorf("%q: unexpected divergent coin type -- got %d, "+
+					"want %d", test.name, divergence.CoinType, test.want[i])
+			}
+			if divergence.UsedBytes != test.used[divergence.CoinType] ||
+				divergence.PendingBytes != pending[divergence.CoinType] ||
+				divergence.ExpectedBytes > divergence.PendingBytes {
+
+				t.Errorf("%q: unexpected divergence %+v", test.name,
+					divergence)
+			}
+		}
+	}
+}
+
+// TestDivergenceMonitor ensures miners are flagged once enough of their recent
+// blocks diverge and stop being flagged once they no longer do.
+func TestDivergenceMonitor(t *testing.T) {
+	t.Parallel()
+
+	const miner = "miner"
+	monitor := NewDivergenceMonitor()
+	height := int64(100)
+	record := func(m string, divergent bool) (MinerDivergence, bool) {
+		height++
+		return monitor.Record(m, height, divergent)
+	}
+
+	// Ensure the miner is only newly flagged once the threshold is reached.
+	for i := 1; i <= DivergenceThreshold+1; i++ {
+		stats, flagged := record(miner, true)
+		if flagged != (i == DivergenceThreshold) {
+			t.Fatalf("unexpected newly flagged status after %d divergent "+
+				"blocks: %v", i, flagged)
+		}
+		if stats.Flagged != (i >= DivergenceThreshold) ||
+			stats.RecentDivergent != i {
+
+			t.Fatalf("unexpected stats after %d divergent blocks: %+v", i,
+				stats)
+		}
+	}
+	record("other", false)
+
+	// Ensure the miner stops being flagged once the divergent blocks leave
+	// the window.
+	var stats MinerDivergence
+	for i := 0; i < DivergenceWindow; i++ {
+		stats, _ = record(miner, false)
+	}
+	if stats.Flagged || stats.RecentDivergent != 0 ||
+		stats.DivergentBlocks != DivergenceThreshold+1 ||
+		stats.Blocks != DivergenceThreshold+1+DivergenceWindow {
+
+		t.Fatalf("unexpected stats after the window passed: %+v", stats)
+	}
+
+	// Ensure only miners with divergent blocks are reported.
+	all := monitor.Stats()
+	if all.BlocksChecked != DivergenceThreshold+2+DivergenceWindow ||
+		all.DivergentBlocks != DivergenceThreshold+1 {
+
+		t.Fatalf("unexpected totals: %+v", all)
+	}
+	if len(all.Miners) != 1 || all.Miners[0].Miner != miner {
+		t.Fatalf("unexpected miners: %+v", all.Miners)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockalloc
+
+import (
+	"sort"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+)
+
+const (
+	// DeferReasonAllocation is the reason a transaction is deferred when
+	// adding it along with its deferred ancestors would exceed the
+	// allocation of its coin type.
+	DeferReasonAllocation = "exceeds coin type allocation"
+
+	// DeferReasonParent is the reason a transaction is deferred when it
+	// spends an output of another deferred transaction in the set.
+	DeferReasonParent = "depends on deferred transaction"
+)
+
+// PackCandidate is a transaction to pack into a hypothetical block along with
+// the fee rate it pays in atoms per KB.
+type PackCandidate struct {
+	Tx      *dcrutil.Tx
+	FeeRate int64
+}
+
+// PackedTx describes whether a candidate transaction was packed into the
+// hypothetical block.
+type PackedTx struct {
+	// Tx is the candidate transaction.
+	Tx *dcrutil.Tx
+
+	// CoinType is the coin type the transaction is accounted against.
+	CoinType cointype.CoinType
+
+	// Size is the serialized size of the transaction in bytes.
+	Size uint32
+
+	// FeeRate is the fee rate the transaction pays in atoms per KB.
+	FeeRate int64
+
+	// Included indicates whether the transaction was packed into the block.
+	Included bool
+
+	// Reason is the reason the transaction was deferred.  It is empty when
+	// the transaction was included.
+	Reason string
+}
+
+// PackResult houses the outcome of packing a set of transactions into a
+// hypothetical block.
+type PackResult struct {
+	// Txns describes each candidate transaction in the order provided.
+	Txns []PackedTx
+
+	// Demand is the number of bytes of candidate transactions of each coin
+	// type.
+	Demand map[cointype.CoinType]uint32
+
+	// Allocation is the block space allocation of the packed block.
+	Allocation *AllocationResult
+
+	// TotalBytes is the serialized size of the packed block including the
+	// block header.
+	TotalBytes uint32
+}
+
+// PackTransactions packs the provided candidate transactions into a
+// hypothetical block the same way block templates are assembled: candidates
+// are considered in order of descending fee rate, with ties kept in the order
+// provided, and each is added along with its candidate ancestors only when
+// they all fit within the allocations of their coin types.  Candidates that
+// spend outputs of deferred candidates are deferred as well.
+//
+// Only the dependencies between the candidates are considered, so callers
+// must ensure any other inputs are available.
+func (bsa *BlockSpaceAllocator) PackTransactions(candidates []PackCandidate) *PackResult {
+	result := &PackResult{
+		Txns:   make([]PackedTx, len(candidates)),
+		Demand: make(map[cointype.CoinType]uint32),
+	}
+	indexByHash := make(map[chainhash.Hash]int, len(candidates))
+	for i, c := range candidates {
+		coinType := GetTransactionCoinType(c.Tx)
+		size := uint32(c.Tx.MsgTx().SerializeSize())
+		result.Txns[i] = PackedTx{
+			Tx:       c.Tx,
+			CoinType: coinType,
+			Size:     size,
+			FeeRate:  c.FeeRate,
+		}
+		result.Demand[coinType] += size
+		indexByHash[*c.Tx.Hash()] = i
+	}
+
+	order := make([]int, len(candidates))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return candidates[order[i]].FeeRate > candidates[order[j]].FeeRate
+	})
+
+	// ancestors appends the undecided candidate ancestors of the candidate
+	// with the given index to the provided bundle, parents first, and
+	// returns false when any of them was deferred.
+	decided := make([]bool, len(candidates))
+	var ancestors func(idx int, bundle []int, seen map[int]struct{}) ([]int, bool)
+	ancestors = func(idx int, bundle []int, seen map[int]struct{}) ([]int, bool) {
+		for _, txIn := range candidates[idx].Tx.MsgTx().TxIn {
+			parent, ok := indexByHash[txIn.PreviousOutPoint.Hash]
+			if !ok || parent == idx {
+				continue
+			}
+			if _, ok := seen[parent]; ok {
+				continue
+			}
+			seen[parent] = struct{}{}
+			if decided[parent] {
+				if !result.Txns[parent].Included {
+					return bundle, false
+				}
+				continue
+			}
+			var fits bool
+			bundle, fits = ancestors(parent, bundle, seen)
+			if !fits {
+				return bundle, false
+			}
+			bundle = append(bundle, parent)
+		}
+		return bundle, true
+	}
+
+	tracker := NewTransactionSizeTracker(bsa)
+	for _, idx := range order {
+		if decided[idx] {
+			continue
+		}
+		decided[idx] = true
+		bundle, ok := ancestors(idx, nil, map[int]struct{}{idx: {}})
+		if !ok {
+			result.Txns[idx].Reason = DeferReasonParent
+			continue
+		}
+		bundle = append(bundle, idx)
+		bundleTxns := make([]*dcrutil.Tx, 0, len(bundle))
+		for _, i := range bundle {
+			bundleTxns = append(bundleTxns, candidates[i].Tx)
+		}
+		if !tracker.CanAddTransactions(bundleTxns) {
+			result.Txns[idx].Reason = DeferReasonAllocation
+			continue
+		}
+		for _, i := range bundle {
+			decided[i] = true
+			result.Txns[i].Included = true
+			tracker.AddTransaction(candidates[i].Tx)
+		}
+	}
+
+	result.Allocation = tracker.GetAllocation()
+	result.TotalBytes = tracker.SerializedSize()
+	return result
+}
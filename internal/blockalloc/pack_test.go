@@ -0,0 +1,95 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockalloc
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestPackTransactions ensures transactions are packed in order of descending
+// fee rate along with their ancestors and that transactions which don't fit
+// or depend on deferred transactions are deferred.
+func TestPackTransactions(t *testing.T) {
+	// spend returns a VAR transaction that spends the first output of the
+	// provided parent.
+	spend := func(parent *dcrutil.Tx) *dcrutil.Tx {
+		tx := createMockTransaction([]cointype.CoinType{cointype.CoinTypeVAR})
+		msgTx := tx.MsgTx()
+		msgTx.TxIn[0].PreviousOutPoint.Hash = *parent.Hash()
+		msgTx.TxIn[0].PreviousOutPoint.Index = 0
+		return dcrutil.NewTx(msgTx)
+	}
+	// root returns a VAR transaction that is distinguished by the provided
+	// value.
+	root := func(value int64) *dcrutil.Tx {
+		tx := createMockTransaction([]cointype.CoinType{cointype.CoinTypeVAR})
+		msgTx := tx.MsgTx()
+		msgTx.TxOut[0].Value = value
+		return dcrutil.NewTx(msgTx)
+	}
+
+	txA, txB, txC := root(1), root(2), root(3)
+	childA, childC := spend(txA), spend(txC)
+	grandchildC := spend(childC)
+	size := uint32(txA.MsgTx().SerializeSize())
+
+	// The block only has room for three of the transactions.
+	allocator := NewBlockSpaceAllocator(3*size, mockChainParams())
+	result := allocator.PackTransactions([]PackCandidate{
+		{Tx: txA, FeeRate: 1000},
+		{Tx: txB, FeeRate: 3000},
+		{Tx: txC, FeeRate: 2000},
+		{Tx: childA, FeeRate: 5000},
+		{Tx: childC, FeeRate: 4000},
+		{Tx: grandchildC, FeeRate: 100},
+	})
+
+	// The child of A is packed first along with its parent, which leaves
+	// room for B but not for C along with its child.
+	wantResults := []struct {
+		included bool
+		reason   string
+	}{
+		{true, ""},
+		{true, ""},
+		{false, DeferReasonAllocation},
+		{true, ""},
+		{false, DeferReasonAllocation},
+		{false, DeferReasonParent},
+	}
+	if len(result.Txns) != len(wantResults) {
+		t.Fatalf("unexpected number of results: got %d, want %d",
+			len(result.Txns), len(wantResults))
+	}
+	for i, want := range wantResults {
+		got := result.Txns[i]
+		if got.Included != want.included || got.Reason != want.reason {
+			t.Errorf("tx %d: got included %v (%q), want included %v (%q)",
+				i, got.Included, got.Reason, want.included, want.reason)
+		}
+		if got.CoinType != cointype.CoinTypeVAR || got.Size != size {
+			t.Errorf("tx %d: unexpected coin type %v or size %d", i,
+				got.CoinType, got.Size)
+		}
+	}
+
+	if got := result.Demand[cointype.CoinTypeVAR]; got != 6*size {
+		t.Errorf("unexpected VAR demand: got %d, want %d", got, 6*size)
+	}
+	varAlloc := result.Allocation.GetAllocationForCoinType(cointype.CoinTypeVAR)
+	if varAlloc.UsedBytes != 3*size {
+		t.Errorf("unexpected VAR bytes used: got %d, want %d",
+			varAlloc.UsedBytes, 3*size)
+	}
+	wantTotal := uint32(wire.MaxBlockHeaderPayload+2) + 3*size
+	if result.TotalBytes != wantTotal {
+		t.Errorf("unexpected total bytes: got %d, want %d",
+			result.TotalBytes, wantTotal)
+	}
+}
@@ -5,14 +5,33 @@
 package blockalloc
 
 import (
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/blockchain/standalone"
 	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/wire"
 )
 
-// GetTransactionCoinType determines the primary coin type of a transaction
-// based on the total value of outputs for each coin type.
+// GetTransactionCoinType determines the primary coin type of a transaction.
+//
+// Transactions with a version that declares the coin types of their inputs are
+// classified by the declared coin type of their first input that spends an
+// output, which is exact since consensus ensures the declared coin types match
+// the spent outputs.  Otherwise, and for transactions without inputs that
+// spend outputs, the coin type is determined based on the total value of
+// outputs for each coin type.
 func GetTransactionCoinType(tx *dcrutil.Tx) cointype.CoinType {
 	msgTx := tx.MsgTx()
+	if msgTx.Version >= wire.TxVersionInputCoinTypes {
+		for _, txIn := range msgTx.TxIn {
+			// Inputs with a null previous output index, such as stakebase
+			// inputs, do not spend an output.
+			if txIn.PreviousOutPoint.Index != wire.MaxPrevOutIndex {
+				return txIn.CoinType
+			}
+		}
+	}
 	if len(msgTx.TxOut) == 0 {
 		return cointype.CoinTypeVAR // Default to VAR for transactions with no outputs
 	}
@@ -37,9 +56,19 @@ func GetTransactionCoinType(tx *dcrutil.Tx) cointype.CoinType {
 	return primaryCoinType
 }
 
-// TransactionSizeTracker tracks transaction sizes by coin type for block space allocation.
+// TransactionSizeTracker tracks the exact number of serialized bytes the
+// transactions added to a block consume by coin type for block space
+// allocation.
+//
+// In addition to the serialized size of each transaction, the bytes used to
+// encode the number of transactions in the regular and stake trees of the
+// block are accounted for.  Whenever adding a transaction grows the encoding
+// of the number of transactions in its tree, the additional bytes are
+// accounted against the coin type of that transaction.
 type TransactionSizeTracker struct {
 	sizesByCoinType map[cointype.CoinType]uint32
+	numRegularTxns  uint64
+	numStakeTxns    uint64
 	allocator       *BlockSpaceAllocator
 }
 
@@ -51,11 +80,39 @@ func NewTransactionSizeTracker(allocator *BlockSpaceAllocator) *TransactionSizeT
 	}
 }
 
+// isStakeTreeTx returns whether the passed transaction belongs in the stake
+// tree of a block.
+func isStakeTreeTx(tx *dcrutil.Tx) bool {
+	return stake.DetermineTxType(tx.MsgTx()) != stake.TxTypeRegular
+}
+
+// txBlockBytes returns the number of bytes adding the passed transaction to a
+// block tree that already contains the given number of transactions consumes.
+// This is the serialized size of the transaction plus any growth in the
+// encoding of the number of transactions in the tree.
+func txBlockBytes(tx *dcrutil.Tx, numTreeTxns uint64) uint32 {
+	countGrowth := wire.VarIntSerializeSize(numTreeTxns+1) -
+		wire.VarIntSerializeSize(numTreeTxns)
+	return uint32(tx.MsgTx().SerializeSize() + countGrowth)
+}
+
+// addTransaction accounts for the passed transaction in the provided sizes and
+// tree transaction counts and returns the coin type it was accounted against.
+func addTransaction(sizes map[cointype.CoinType]uint32, numRegularTxns, numStakeTxns *uint64, tx *dcrutil.Tx) cointype.CoinType {
+	numTreeTxns := numRegularTxns
+	if isStakeTreeTx(tx) {
+		numTreeTxns = numStakeTxns
+	}
+	coinType := GetTransactionCoinType(tx)
+	sizes[coinType] += txBlockBytes(tx, *numTreeTxns)
+	*numTreeTxns++
+	return coinType
+}
+
 // AddTransaction adds a transaction to the size tracking.
 func (tst *TransactionSizeTracker) AddTransaction(tx *dcrutil.Tx) {
-	coinType := GetTransactionCoinType(tx)
-	txSize := uint32(tx.MsgTx().SerializeSize())
-	tst.sizesByCoinType[coinType] += txSize
+	addTransaction(tst.sizesByCoinType, &tst.numRegularTxns,
+		&tst.numStakeTxns, tx)
 }
 
 // GetAllocation returns the current block space allocation based on tracked transaction sizes.
@@ -65,26 +122,63 @@ func (tst *TransactionSizeTracker) GetAllocation() *AllocationResult {
 
 // CanAddTransaction checks if a transaction can be added without exceeding coin type allocation.
 func (tst *TransactionSizeTracker) CanAddTransaction(tx *dcrutil.Tx) bool {
-	coinType := GetTransactionCoinType(tx)
-	txSize := uint32(tx.MsgTx().SerializeSize())
+	return tst.CanAddTransactions([]*dcrutil.Tx{tx})
+}
+
+// CanAddTransactions checks if all of the passed transactions can be added
+// together, in order, without exceeding the allocation of any of their coin
+// types.  This allows a package of transactions, such as a transaction along
+// with its unconfirmed ancestors, to be tested atomically.
+func (tst *TransactionSizeTracker) CanAddTransactions(txns []*dcrutil.Tx) bool {
+	return tst.CanAddTransactionsWithLimit(txns, nil)
+}
 
-	// Create a temporary copy of current sizes to test the addition
-	testSizes := make(map[cointype.CoinType]uint32)
+// CanAddTransactionsWithLimit is like CanAddTransactions, but none of the coin
+// types of the passed transactions may exceed the limit the provided function
+// returns for their allocation either.  This allows block templates to apply
+// a policy that is more restrictive than the allocation.  The limit is the
+// final allocation when the function is nil and is never allowed to exceed
+// it.
+func (tst *TransactionSizeTracker) CanAddTransactionsWithLimit(txns []*dcrutil.Tx,
+	limit func(alloc *CoinTypeAllocation) uint32) bool {
+
+	if len(txns) == 0 {
+		return true
+	}
+
+	// Create a temporary copy of current sizes and counts to test the
+	// addition.
+	testSizes := make(map[cointype.CoinType]uint32, len(tst.sizesByCoinType))
 	for ct, size := range tst.sizesByCoinType {
 		testSizes[ct] = size
 	}
-	testSizes[coinType] += txSize
+	numRegularTxns, numStakeTxns := tst.numRegularTxns, tst.numStakeTxns
+	coinTypes := make(map[cointype.CoinType]struct{})
+	for _, tx := range txns {
+		coinType := addTransaction(testSizes, &numRegularTxns,
+			&numStakeTxns, tx)
+		coinTypes[coinType] = struct{}{}
+	}
 
-	// Get allocation with the test transaction added
+	// Get allocation with the test transactions added and ensure none of
+	// their coin types would exceed their final allocation in terms of
+	// either bytes or weight.
 	allocation := tst.allocator.AllocateBlockSpace(testSizes)
-
-	// Check if this coin type would exceed its final allocation
-	coinAllocation := allocation.GetAllocationForCoinType(coinType)
-	if coinAllocation == nil {
-		return false
+	for coinType := range coinTypes {
+		coinAllocation := allocation.GetAllocationForCoinType(coinType)
+		if coinAllocation == nil {
+			return false
+		}
+		maxBytes := coinAllocation.FinalAllocation
+		if limit != nil {
+			maxBytes = min(maxBytes, limit(coinAllocation))
+		}
+		size := testSizes[coinType]
+		if size > maxBytes || tst.allocator.Weight(coinType, size) > maxBytes {
+			return false
+		}
 	}
-
-	return testSizes[coinType] <= coinAllocation.FinalAllocation
+	return true
 }
 
 // GetSizeForCoinType returns the current size tracked for a specific coin type.
@@ -92,7 +186,73 @@ func (tst *TransactionSizeTracker) GetSizeForCoinType(coinType cointype.CoinType
 	return tst.sizesByCoinType[coinType]
 }
 
+// SerializedSize returns the exact serialized size of a block that contains
+// the block header and all of the tracked transactions.
+func (tst *TransactionSizeTracker) SerializedSize() uint32 {
+	// The sizes tracked by coin type include the growth of the transaction
+	// count encodings, so only the encodings for empty trees remain.
+	size := uint32(wire.MaxBlockHeaderPayload + 2*wire.VarIntSerializeSize(0))
+	for _, coinTypeSize := range tst.sizesByCoinType {
+		size += coinTypeSize
+	}
+	return size
+}
+
 // Reset clears all tracked transaction sizes.
 func (tst *TransactionSizeTracker) Reset() {
 	tst.sizesByCoinType = make(map[cointype.CoinType]uint32)
+	tst.numRegularTxns = 0
+	tst.numStakeTxns = 0
+}
+
+// BlockTxCoinType returns the coin type the space used by the passed
+// transaction in a block is accounted against when validating the block.
+//
+// Unlike GetTransactionCoinType, which is used to estimate the demand of
+// pending transactions, this mirrors the accounting performed by the consensus
+// rules, so it must not change without a consensus change.
+func BlockTxCoinType(tx *wire.MsgTx, isTreasuryEnabled bool) cointype.CoinType {
+	switch {
+	case standalone.IsCoinBaseTx(tx, isTreasuryEnabled):
+		return cointype.CoinTypeVAR
+	case stake.IsTreasuryBase(tx):
+		return cointype.CoinTypeVAR
+	case wire.IsSKAEmissionTransaction(tx):
+		if len(tx.TxOut) > 0 {
+			return tx.TxOut[0].CoinType
+		}
+		return cointype.CoinTypeVAR
+	case stake.DetermineTxType(tx) == stake.TxTypeSSFee:
+		// SSFee transactions are accounted against the coin type of their
+		// first output that is not the OP_RETURN marker.
+		for _, out := range tx.TxOut {
+			if len(out.PkScript) > 0 && out.PkScript[0] == txscript.OP_RETURN {
+				continue
+			}
+			return out.CoinType
+		}
+		return cointype.CoinTypeVAR
+	}
+
+	// All outputs of regular transactions have the same coin type.
+	if len(tx.TxOut) > 0 {
+		return tx.TxOut[0].CoinType
+	}
+	return cointype.CoinTypeVAR
+}
+
+// BlockSpaceUsage returns the number of bytes used by the transactions in both
+// trees of the passed block for each coin type as accounted for by the
+// consensus rules when validating the block space allocation of the block.
+func BlockSpaceUsage(block *wire.MsgBlock, isTreasuryEnabled bool) map[cointype.CoinType]uint32 {
+	spaceUsed := make(map[cointype.CoinType]uint32)
+	for _, tx := range block.Transactions {
+		coinType := BlockTxCoinType(tx, isTreasuryEnabled)
+		spaceUsed[coinType] += uint32(tx.SerializeSize())
+	}
+	for _, tx := range block.STransactions {
+		coinType := BlockTxCoinType(tx, isTreasuryEnabled)
+		spaceUsed[coinType] += uint32(tx.SerializeSize())
+	}
+	return spaceUsed
 }
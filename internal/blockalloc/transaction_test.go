@@ -5,6 +5,7 @@
 package blockalloc
 
 import (
+	"math"
 	"testing"
 
 	"github.com/monetarium/monetarium-node/cointype"
@@ -172,6 +173,65 @@ func TestGetTransactionCoinTypeValueWeighted(t *testing.T) {
 	}
 }
 
+// TestGetTransactionCoinTypeDeclaredInputs ensures transactions that declare
+// the coin types of their inputs are classified by the declared coin types of
+// the inputs that spend outputs regardless of their outputs.
+func TestGetTransactionCoinTypeDeclaredInputs(t *testing.T) {
+	nullOutPoint := wire.OutPoint{Index: wire.MaxPrevOutIndex}
+	testCases := []struct {
+		name         string
+		version      uint16
+		inputs       []*wire.TxIn
+		expectedType cointype.CoinType
+	}{{
+		name:    "declared input coin type ignored prior to version 4",
+		version: wire.TxVersionTreasury,
+		inputs: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: 0},
+			CoinType:         2,
+		}},
+		expectedType: cointype.CoinTypeVAR,
+	}, {
+		name:    "declared input coin type",
+		version: wire.TxVersionInputCoinTypes,
+		inputs: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: 0},
+			CoinType:         2,
+		}},
+		expectedType: cointype.CoinType(2),
+	}, {
+		name:    "null inputs are skipped",
+		version: wire.TxVersionInputCoinTypes,
+		inputs: []*wire.TxIn{{
+			PreviousOutPoint: nullOutPoint,
+		}, {
+			PreviousOutPoint: wire.OutPoint{Index: 1},
+			CoinType:         1,
+		}},
+		expectedType: cointype.CoinType(1),
+	}, {
+		name:    "only null inputs fall back to outputs",
+		version: wire.TxVersionInputCoinTypes,
+		inputs: []*wire.TxIn{{
+			PreviousOutPoint: nullOutPoint,
+		}},
+		expectedType: cointype.CoinTypeVAR,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := createMockTransaction([]cointype.CoinType{cointype.CoinTypeVAR})
+			tx.MsgTx().Version = tc.version
+			tx.MsgTx().TxIn = tc.inputs
+			coinType := GetTransactionCoinType(tx)
+
+			if coinType != tc.expectedType {
+				t.Errorf("Expected coin type %d, got %d", tc.expectedType, coinType)
+			}
+		})
+	}
+}
+
 // TestTransactionSizeTracker verifies transaction size tracking functionality.
 func TestTransactionSizeTracker(t *testing.T) {
 	params := mockChainParams()
@@ -245,6 +305,90 @@ func TestCanAddTransaction(t *testing.T) {
 	}
 }
 
+// TestCanAddTransactions ensures a package of transactions is only addable
+// when all of its transactions fit together and that testing a package does
+// not modify the tracked sizes.
+func TestCanAddTransactions(t *testing.T) {
+	params := mockChainParams()
+	allocator := NewBlockSpaceAllocator(1000, params) // Small 1KB block for testing
+	tracker := NewTransactionSizeTracker(allocator)
+
+	// An empty package is always addable.
+	if !tracker.CanAddTransactions(nil) {
+		t.Error("Empty package should be addable")
+	}
+
+	// Create a package of transactions that each fit on their own, but
+	// exceed the size of the block together.
+	varTx := createMockTransaction([]cointype.CoinType{cointype.CoinTypeVAR})
+	txSize := varTx.MsgTx().SerializeSize()
+	pkg := make([]*dcrutil.Tx, 1000/txSize+1)
+	for i := range pkg {
+		pkg[i] = varTx
+	}
+	if !tracker.CanAddTransaction(varTx) {
+		t.Fatal("Single VAR transaction should be addable")
+	}
+	if !tracker.CanAddTransactions(pkg[:2]) {
+		t.Error("Small package should be addable")
+	}
+	if tracker.CanAddTransactions(pkg) {
+		t.Error("Package exceeding the block size should not be addable")
+	}
+
+	// Ensure a limit below the allocation is enforced while a limit above it
+	// does not raise the allocation.
+	limitOneTx := func(*CoinTypeAllocation) uint32 { return uint32(txSize) }
+	if !tracker.CanAddTransactionsWithLimit(pkg[:1], limitOneTx) {
+		t.Error("Package within the limit should be addable")
+	}
+	if tracker.CanAddTransactionsWithLimit(pkg[:2], limitOneTx) {
+		t.Error("Package exceeding the limit should not be addable")
+	}
+	noLimit := func(*CoinTypeAllocation) uint32 { return math.MaxUint32 }
+	if tracker.CanAddTransactionsWithLimit(pkg, noLimit) {
+		t.Error("Limit above the allocation should not raise it")
+	}
+	if size := tracker.GetSizeForCoinType(cointype.CoinTypeVAR); size != 0 {
+		t.Errorf("Testing packages modified tracked size: got %d, want 0",
+			size)
+	}
+}
+
+// TestTrackerSerializedSize ensures the size tracked for the transactions
+// matches the exact serialized size of a block containing them, including the
+// growth of the encoding of the number of transactions in each tree.
+func TestTrackerSerializedSize(t *testing.T) {
+	params := mockChainParams()
+	allocator := NewBlockSpaceAllocator(1000000, params)
+	tracker := NewTransactionSizeTracker(allocator)
+
+	// Add enough transactions to require a multi-byte encoding of the number
+	// of transactions in the regular tree.
+	var block wire.MsgBlock
+	for i := 0; i < 300; i++ {
+		coinType := cointype.CoinType(i % 3)
+		tx := createMockTransaction([]cointype.CoinType{coinType})
+		tracker.AddTransaction(tx)
+		block.Transactions = append(block.Transactions, tx.MsgTx())
+
+		got, want := tracker.SerializedSize(), uint32(block.SerializeSize())
+		if got != want {
+			t.Fatalf("Mismatched serialized size after %d txns: got %d, "+
+				"want %d", i+1, got, want)
+		}
+	}
+
+	// Ensure the tracked transaction counts are cleared by a reset.
+	tracker.Reset()
+	got, want := tracker.SerializedSize(), uint32(wire.NewMsgBlock(
+		&wire.BlockHeader{}).SerializeSize())
+	if got != want {
+		t.Fatalf("Mismatched serialized size after reset: got %d, want %d",
+			got, want)
+	}
+}
+
 // TestTrackerReset verifies the reset functionality.
 func TestTrackerReset(t *testing.T) {
 	params := mockChainParams()
@@ -268,3 +412,46 @@ func TestTrackerReset(t *testing.T) {
 		t.Error("Expected VAR size to be 0 after reset")
 	}
 }
+
+// TestBlockSpaceUsage ensures the space used by the transactions in a block is
+// accounted against the coin types the consensus rules account it against.
+func TestBlockSpaceUsage(t *testing.T) {
+	// The coinbase is always accounted against VAR.
+	coinbase := &wire.MsgTx{
+		Version: 1,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+			SignatureScript:  make([]byte, 4),
+			Sequence:         wire.MaxTxInSequenceNum,
+		}},
+		TxOut: []*wire.TxOut{{Value: 1000, PkScript: []byte{0x51}}},
+	}
+
+	// Regular transactions are accounted against the coin type of their first
+	// output regardless of the value of their outputs.
+	skaTx := createMockTransactionWithValues([]struct {
+		coinType cointype.CoinType
+		value    int64
+	}{{1, 1}, {2, 1e8}}).MsgTx()
+	varTx := createMockTransaction([]cointype.CoinType{
+		cointype.CoinTypeVAR}).MsgTx()
+
+	block := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{coinbase, skaTx, varTx},
+	}
+	got := BlockSpaceUsage(block, false)
+	want := map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: uint32(coinbase.SerializeSize() +
+			varTx.SerializeSize()),
+		1: uint32(skaTx.SerializeSize()),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("mismatched usage -- got %v, want %v", got, want)
+	}
+	for coinType, size := range want {
+		if got[coinType] != size {
+			t.Fatalf("mismatched usage for %v -- got %d, want %d", coinType,
+				got[coinType], size)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockalloc
+
+import (
+	"math"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+// UnitWeightBasisPoints is the weight multiplier, in basis points, under which
+// every byte of a coin type costs exactly one weight unit.
+const UnitWeightBasisPoints = 10000
+
+// WeightFunc returns the cost, in weight units, of the provided number of
+// serialized bytes of transactions of the given coin type.
+//
+// Block templates charge transactions their weight against the allocation of
+// their coin type and order them by the fees they pay per weight unit, so a
+// weight function allows policy to make the bytes of some coin types cost more
+// or less than others without changing the selection logic.  Blocks are always
+// validated in terms of bytes, so the weight never allows a coin type to use
+// more bytes than it is allocated.
+type WeightFunc func(coinType cointype.CoinType, size uint32) uint32
+
+// ByteWeight is the weight function under which every byte costs one weight
+// unit regardless of its coin type.  It is the weight function used unless
+// another one is provided.
+func ByteWeight(_ cointype.CoinType, size uint32) uint32 {
+	return size
+}
+
+// CoinTypeWeight returns a weight function that scales the bytes of each coin
+// type by the provided multiplier in basis points, rounding up, so a multiplier
+// of UnitWeightBasisPoints costs one weight unit per byte.  Coin types without
+// a multiplier, or with a multiplier of zero, cost one weight unit per byte.
+// Weights that would exceed the maximum uint32 value are capped at it.
+func CoinTypeWeight(multipliers map[cointype.CoinType]uint32) WeightFunc {
+	basisPoints := make(map[cointype.CoinType]uint32, len(multipliers))
+	for coinType, multiplier := range multipliers {
+		if multiplier != 0 {
+			basisPoints[coinType] = multiplier
+		}
+	}
+	return func(coinType cointype.CoinType, size uint32) uint32 {
+		multiplier, ok := basisPoints[coinType]
+		if !ok {
+			return size
+		}
+		weight := (uint64(size)*uint64(multiplier) +
+			UnitWeightBasisPoints - 1) / UnitWeightBasisPoints
+		if weight > math.MaxUint32 {
+			return math.MaxUint32
+		}
+		return uint32(weight)
+	}
+}
+
+// WithWeight returns a copy of the allocator that charges transactions tracked
+// against it with the provided weight function in addition to their bytes.  A
+// nil weight function charges every byte one weight unit.
+func (bsa *BlockSpaceAllocator) WithWeight(weight WeightFunc) *BlockSpaceAllocator {
+	allocator := *bsa
+	allocator.weight = weight
+	return &allocator
+}
+
+// Weight returns the cost, in weight units, of the provided number of bytes of
+// transactions of the given coin type according to the weight function of the
+// allocator.
+func (bsa *BlockSpaceAllocator) Weight(coinType cointype.CoinType, size uint32) uint32 {
+	if bsa.weight == nil {
+		return size
+	}
+	return bsa.weight(coinType, size)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockalloc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+)
+
+// TestCoinTypeWeight ensures coin type weight functions scale the bytes of
+// each coin type by its multiplier, rounding up, and charge one unit per byte
+// to coin types without a multiplier.
+func TestCoinTypeWeight(t *testing.T) {
+	weight := CoinTypeWeight(map[cointype.CoinType]uint32{
+		1: 2 * UnitWeightBasisPoints,
+		2: UnitWeightBasisPoints / 2,
+		3: 0,
+	})
+	tests := []struct {
+		name     string
+		coinType cointype.CoinType
+		size     uint32
+		want     uint32
+	}{
+		{"no multiplier", cointype.CoinTypeVAR, 1001, 1001},
+		{"double", 1, 1001, 2002},
+		{"half rounds up", 2, 1001, 501},
+		{"zero multiplier", 3, 1001, 1001},
+		{"capped", 1, math.MaxUint32, math.MaxUint32},
+	}
+	for _, test := range tests {
+		if got := weight(test.coinType, test.size); got != test.want {
+			t.Errorf("%s: got weight %d, want %d", test.name, got,
+				test.want)
+		}
+	}
+
+	// Allocators without a weight function charge one unit per byte.
+	allocator := NewBlockSpaceAllocator(1000, mockChainParams())
+	if got := allocator.Weight(1, 1001); got != 1001 {
+		t.Errorf("unexpected default weight: got %d, want 1001", got)
+	}
+	if got := allocator.WithWeight(weight).Weight(1, 1001); got != 2002 {
+		t.Errorf("unexpected weight: got %d, want 2002", got)
+	}
+}
+
+// TestCanAddTransactionsWeighted ensures transactions are charged their weight
+// against the allocation of their coin type while coin types with weights below
+// their bytes are still limited to their allocation in bytes.
+func TestCanAddTransactionsWeighted(t *testing.T) {
+	varTx := createMockTransaction([]cointype.CoinType{cointype.CoinTypeVAR})
+	txSize := uint32(varTx.MsgTx().SerializeSize())
+
+	// The block has room for four of the transactions.
+	blockSize := 4 * txSize
+	pkg := []*dcrutil.Tx{varTx, varTx, varTx, varTx}
+	unweighted := NewBlockSpaceAllocator(blockSize, mockChainParams())
+	if !NewTransactionSizeTracker(unweighted).CanAddTransactions(pkg) {
+		t.Fatal("Unweighted package should be addable")
+	}
+
+	// Doubling the cost of VAR bytes only leaves room for two of them.
+	double := unweighted.WithWeight(CoinTypeWeight(map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: 2 * UnitWeightBasisPoints,
+	}))
+	tracker := NewTransactionSizeTracker(double)
+	if !tracker.CanAddTransactions(pkg[:2]) {
+		t.Error("Package within the weighted allocation should be addable")
+	}
+	if tracker.CanAddTransactions(pkg[:3]) {
+		t.Error("Package exceeding the weighted allocation should not " +
+			"be addable")
+	}
+
+	// Halving the cost of VAR bytes must not allow more bytes than the
+	// allocation.
+	half := unweighted.WithWeight(CoinTypeWeight(map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: UnitWeightBasisPoints / 2,
+	}))
+	tracker = NewTransactionSizeTracker(half)
+	if !tracker.CanAddTransactions(pkg) {
+		t.Error("Package within the allocation should be addable")
+	}
+	if tracker.CanAddTransactions(append(pkg, varTx)) {
+		t.Error("Package exceeding the allocation in bytes should not be " +
+			"addable")
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// Per coin type block statistics
+// This file maintains a checkpoint of statistics about the transactions of
+// each coin type for every block in the main chain including:
+// - The number of transactions and bytes accounted against each coin type
+// - The block space allocated to each coin type
+// - The minimum, median, and maximum fee rates paid by each coin type
+
+const (
+	// blockStatsBucketName is the name of the database bucket that houses
+	// the per coin type statistics of each main chain block keyed by block
+	// hash.
+	blockStatsBucketName = "blockstats"
+
+	// blockStatsEntrySize is the size of the serialized statistics of a
+	// single coin type:
+	// [coin type:1][num txns:4][total bytes:4][allocated bytes:4]
+	// [min fee rate:8][median fee rate:8][max fee rate:8]
+	blockStatsEntrySize = 1 + 4 + 4 + 4 + 8 + 8 + 8
+)
+
+// CoinTypeBlockStats houses statistics about the transactions of a single coin
+// type in a block.
+type CoinTypeBlockStats struct {
+	// CoinType is the coin type the statistics are for.
+	CoinType cointype.CoinType
+
+	// NumTxns and TotalBytes are the number of transactions and serialized
+	// bytes in both trees of the block accounted against the coin type by
+	// the block space allocation rules.
+	NumTxns    uint32
+	TotalBytes uint32
+
+	// AllocatedBytes is the final block space allocated to the coin type for
+	// the block.
+	AllocatedBytes uint32
+
+	// MinFeeRate, MedianFeeRate, and MaxFeeRate are the fee rates in atoms
+	// per kB paid by the fee paying transactions of the coin type.  They are
+	// zero when there are no such transactions.
+	MinFeeRate    int64
+	MedianFeeRate int64
+	MaxFeeRate    int64
+}
+
+// BlockStats houses the per coin type statistics of a main chain block.
+type BlockStats struct {
+	// Height is the height of the block.
+	Height int64
+
+	// Stats are the statistics of each coin type that either has
+	// transactions in the block or is allocated space in it ordered by coin
+	// type.
+	Stats []CoinTypeBlockStats
+}
+
+// calcBlockStats returns the per coin type statistics of the provided block
+// using the provided allocator to determine the space allocated to each coin
+// type.
+//
+// The fee rates are calculated from the input values committed to by the
+// transactions of the same transactions whose fees are included in the fee
+// totals, so they are available regardless of the state of the utxo set.
+func calcBlockStats(block *dcrutil.Block, isTreasuryEnabled bool, allocator *blockalloc.BlockSpaceAllocator) *BlockStats {
+	stats := make(map[cointype.CoinType]*CoinTypeBlockStats)
+	statsFor := func(coinType cointype.CoinType) *CoinTypeBlockStats {
+		s, ok := stats[coinType]
+		if !ok {
+			s = &CoinTypeBlockStats{CoinType: coinType}
+			stats[coinType] = s
+		}
+		return s
+	}
+
+	// Account the transactions of both trees the same way the block space
+	// allocation rules do.
+	spaceUsed := make(map[cointype.CoinType]uint32)
+	accountTx := func(msgTx *wire.MsgTx) {
+		coinType := blockalloc.BlockTxCoinType(msgTx, isTreasuryEnabled)
+		size := uint32(msgTx.SerializeSize())
+		s := statsFor(coinType)
+		s.NumTxns++
+		s.TotalBytes += size
+		spaceUsed[coinType] += size
+	}
+	for _, tx := range block.Transactions() {
+		accountTx(tx.MsgTx())
+	}
+	for _, stx := range block.STransactions() {
+		accountTx(stx.MsgTx())
+	}
+
+	// Collect the fee rates of the fee paying transactions.
+	feeRates := make(map[cointype.CoinType][]int64)
+	addFeeRate := func(msgTx *wire.MsgTx) {
+		var totalIn int64
+		for _, txIn := range msgTx.TxIn {
+			totalIn += txIn.ValueIn
+		}
+		var totalOut int64
+		for _, txOut := range msgTx.TxOut {
+			totalOut += txOut.Value
+		}
+		txFee := totalIn - totalOut
+		if txFee < 0 {
+			txFee = 0
+		}
+		coinType := wire.GetPrimaryCoinType(msgTx)
+		feeRate := txFee * 1000 / int64(msgTx.SerializeSize())
+		feeRates[coinType] = append(feeRates[coinType], feeRate)
+	}
+	for _, tx := range block.Transactions()[1:] { // Skip coinbase
+		if wire.IsSKAEmissionTransaction(tx.MsgTx()) {
+			continue
+		}
+		addFeeRate(tx.MsgTx())
+	}
+	for _, stx := range block.STransactions() {
+		// Skip special stake transactions that don't pay fees.
+		switch stake.DetermineTxType(stx.MsgTx()) {
+		case stake.TxTypeSSGen, stake.TxTypeSSRtx, stake.TxTypeTreasuryBase,
+			stake.TxTypeTSpend, stake.TxTypeSSFee:
+			continue
+		}
+		addFeeRate(stx.MsgTx())
+	}
+	for coinType, rates := range feeRates {
+		sort.Slice(rates, func(i, j int) bool { return rates[i] < rates[j] })
+		s := statsFor(coinType)
+		s.MinFeeRate = rates[0]
+		s.MaxFeeRate = rates[len(rates)-1]
+		mid := len(rates) / 2
+		if len(rates)%2 == 0 {
+			s.MedianFeeRate = (rates[mid-1] + rates[mid]) / 2
+		} else {
+			s.MedianFeeRate = rates[mid]
+		}
+	}
+
+	// Include the final space allocated to every coin type.
+	allocation := allocator.AllocateBlockSpace(spaceUsed)
+	for coinType, alloc := range allocation.Allocations {
+		if alloc.FinalAllocation == 0 {
+			if _, ok := stats[coinType]; !ok {
+				continue
+			}
+		}
+		statsFor(coinType).AllocatedBytes = alloc.FinalAllocation
+	}
+
+	blockStats := &BlockStats{
+		Height: block.Height(),
+		Stats:  make([]CoinTypeBlockStats, 0, len(stats)),
+	}
+	for _, s := range stats {
+		blockStats.Stats = append(blockStats.Stats, *s)
+	}
+	sort.Slice(blockStats.Stats, func(i, j int) bool {
+		return blockStats.Stats[i].CoinType < blockStats.Stats[j].CoinType
+	})
+	return blockStats
+}
+
+// serializeBlockStats returns the serialized statistics of a block:
+// [height:4][num coin types:1][entries...]
+func serializeBlockStats(stats *BlockStats) []byte {
+	serialized := make([]byte, 5+len(stats.Stats)*blockStatsEntrySize)
+	binary.LittleEndian.PutUint32(serialized[0:4], uint32(stats.Height))
+	serialized[4] = byte(len(stats.Stats))
+	offset := 5
+	for _, s := range stats.Stats {
+		serialized[offset] = byte(s.CoinType)
+		binary.LittleEndian.PutUint32(serialized[offset+1:], s.NumTxns)
+		binary.LittleEndian.PutUint32(serialized[offset+5:], s.TotalBytes)
+		binary.LittleEndian.PutUint32(serialized[offset+9:], s.AllocatedBytes)
+		binary.LittleEndian.PutUint64(serialized[offset+13:],
+			uint64(s.MinFeeRate))
+		binary.LittleEndian.PutUint64(serialized[offset+21:],
+			uint64(s.MedianFeeRate))
+		binary.LittleEndian.PutUint64(serialized[offset+29:],
+			uint64(s.MaxFeeRate))
+		offset += blockStatsEntrySize
+	}
+	return serialized
+}
+
+// deserializeBlockStats decodes the statistics of a block from the provided
+// serialized bytes.
+func deserializeBlockStats(serialized []byte) (*BlockStats, error) {
+	if len(serialized) < 5 {
+		return nil, fmt.Errorf("invalid block stats length: %d",
+			len(serialized))
+	}
+	numStats := int(serialized[4])
+	if len(serialized) != 5+numStats*blockStatsEntrySize {
+		return nil, fmt.Errorf("invalid block stats length %d for %d coin "+
+			"types", len(serialized), numStats)
+	}
+	stats := &BlockStats{
+		Height: int64(binary.LittleEndian.Uint32(serialized[0:4])),
+		Stats:  make([]CoinTypeBlockStats, 0, numStats),
+	}
+	offset := 5
+	for i := 0; i < numStats; i++ {
+		entry := serialized[offset : offset+blockStatsEntrySize]
+		stats.Stats = append(stats.Stats, CoinTypeBlockStats{
+			CoinType:       cointype.CoinType(entry[0]),
+			NumTxns:        binary.LittleEndian.Uint32(entry[1:]),
+			TotalBytes:     binary.LittleEndian.Uint32(entry[5:]),
+			AllocatedBytes: binary.LittleEndian.Uint32(entry[9:]),
+			MinFeeRate:     int64(binary.LittleEndian.Uint64(entry[13:])),
+			MedianFeeRate:  int64(binary.LittleEndian.Uint64(entry[21:])),
+			MaxFeeRate:     int64(binary.LittleEndian.Uint64(entry[29:])),
+		})
+		offset += blockStatsEntrySize
+	}
+	return stats, nil
+}
+
+// dbFetchBlockStats uses an existing database transaction to fetch the
+// statistics of the block with the provided hash.  It returns nil when there
+// are no statistics recorded for the block.
+func dbFetchBlockStats(dbTx database.Tx, hash *chainhash.Hash) (*BlockStats, error) {
+	bucket := dbTx.Metadata().Bucket([]byte(blockStatsBucketName))
+	if bucket == nil {
+		return nil, nil
+	}
+	serialized := bucket.Get(hash[:])
+	if serialized == nil {
+		return nil, nil
+	}
+	stats, err := deserializeBlockStats(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode stats for block %v: %w",
+			hash, err)
+	}
+	return stats, nil
+}
+
+// dbPutBlockStats uses an existing database transaction to record the
+// statistics of the block with the provided hash.
+func dbPutBlockStats(dbTx database.Tx, hash *chainhash.Hash, stats *BlockStats) error {
+	bucket, err := dbTx.Metadata().CreateBucketIfNotExists(
+		[]byte(blockStatsBucketName))
+	if err != nil {
+		return fmt.Errorf("failed to create block stats bucket: %w", err)
+	}
+	err = bucket.Put(hash[:], serializeBlockStats(stats))
+	if err != nil {
+		return fmt.Errorf("failed to save stats for block %v: %w", hash, err)
+	}
+	return nil
+}
+
+// dbRemoveBlockStats uses an existing database transaction to remove the
+// statistics of the block with the provided hash.
+func dbRemoveBlockStats(dbTx database.Tx, hash *chainhash.Hash) error {
+	bucket := dbTx.Metadata().Bucket([]byte(blockStatsBucketName))
+	if bucket == nil {
+		return nil
+	}
+	if err := bucket.Delete(hash[:]); err != nil {
+		return fmt.Errorf("failed to remove stats for block %v: %w", hash,
+			err)
+	}
+	return nil
+}
+
+// FetchBlockStats returns the per coin type statistics of the main chain block
+// with the provided hash.
+//
+// The statistics recorded when the block was connected are returned when
+// available.  Otherwise, such as for blocks connected before statistics were
+// tracked, they are calculated from the block.  It returns nil for blocks that
+// are not in the main chain.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchBlockStats(hash *chainhash.Hash) (*BlockStats, error) {
+	var stats *BlockStats
+	err := b.db.View(func(dbTx database.Tx) error {
+		var err error
+		stats, err = dbFetchBlockStats(dbTx, hash)
+		return err
+	})
+	if err != nil || stats != nil {
+		return stats, err
+	}
+
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	node := b.index.LookupNode(hash)
+	if node == nil || node.parent == nil || !b.bestChain.Contains(node) {
+		return nil, nil
+	}
+	block, err := b.fetchMainChainBlockByNode(node)
+	if err != nil {
+		return nil, err
+	}
+	isTreasuryEnabled, err := b.isTreasuryAgendaActive(node.parent)
+	if err != nil {
+		return nil, err
+	}
+	allocator := blockalloc.NewBlockSpaceAllocator(
+		uint32(b.maxBlockSize(node.parent)), b.chainParams).ForHeight(
+		node.height)
+	return calcBlockStats(block, isTreasuryEnabled, allocator), nil
+}
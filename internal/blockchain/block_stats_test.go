@@ -0,0 +1,114 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestBlockStatsSerialization ensures block stats round trip through
+// serialization and that malformed serializations are rejected.
+func TestBlockStatsSerialization(t *testing.T) {
+	t.Parallel()
+
+	stats := &BlockStats{
+		Height: 1234,
+		Stats: []CoinTypeBlockStats{{
+			CoinType:       cointype.CoinTypeVAR,
+			NumTxns:        12,
+			TotalBytes:     4500,
+			AllocatedBytes: 375000,
+			MinFeeRate:     10000,
+			MedianFeeRate:  15000,
+			MaxFeeRate:     1 << 40,
+		}, {
+			CoinType:       1,
+			NumTxns:        3,
+			TotalBytes:     900,
+			AllocatedBytes: 900,
+		}},
+	}
+	serialized := serializeBlockStats(stats)
+	got, err := deserializeBlockStats(serialized)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, stats) {
+		t.Fatalf("mismatched stats -- got %+v, want %+v", got, stats)
+	}
+
+	for _, bad := range [][]byte{nil, serialized[:4], serialized[:len(serialized)-1]} {
+		if _, err := deserializeBlockStats(bad); err == nil {
+			t.Fatalf("deserializing %x did not fail", bad)
+		}
+	}
+}
+
+// TestCalcBlockStats ensures the per coin type stats of a block account the
+// transactions, bytes, allocation, and fee rates of each coin type.
+func TestCalcBlockStats(t *testing.T) {
+	t.Parallel()
+
+	newTx := func(valueIn int64, outs ...*wire.TxOut) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(&wire.TxIn{ValueIn: valueIn})
+		for _, out := range outs {
+			tx.AddTxOut(out)
+		}
+		return tx
+	}
+	coinbase := newTx(0, &wire.TxOut{Value: 1000})
+	lowTx := newTx(10000, &wire.TxOut{Value: 9900})
+	midTx := newTx(10000, &wire.TxOut{Value: 9000})
+	highTx := newTx(10000, &wire.TxOut{Value: 5000})
+	msgBlock := &wire.MsgBlock{
+		Header:       wire.BlockHeader{Height: 100},
+		Transactions: []*wire.MsgTx{coinbase, lowTx, midTx, highTx},
+	}
+	block := dcrutil.NewBlock(msgBlock)
+
+	const maxBlockSize = 393216
+	params := chaincfg.RegNetParams()
+	allocator := blockalloc.NewBlockSpaceAllocator(maxBlockSize,
+		params).ForHeight(block.Height())
+	got := calcBlockStats(block, true, allocator)
+
+	feeRate := func(tx *wire.MsgTx, fee int64) int64 {
+		return fee * 1000 / int64(tx.SerializeSize())
+	}
+	var totalBytes uint32
+	for _, tx := range msgBlock.Transactions {
+		totalBytes += uint32(tx.SerializeSize())
+	}
+	varAlloc := allocator.AllocateBlockSpace(map[cointype.CoinType]uint32{
+		cointype.CoinTypeVAR: totalBytes,
+	}).GetAllocationForCoinType(cointype.CoinTypeVAR)
+	want := &BlockStats{
+		Height: 100,
+		Stats: []CoinTypeBlockStats{{
+			CoinType:       cointype.CoinTypeVAR,
+			NumTxns:        4,
+			TotalBytes:     totalBytes,
+			AllocatedBytes: varAlloc.FinalAllocation,
+			MinFeeRate:     feeRate(lowTx, 100),
+			MedianFeeRate:  feeRate(midTx, 1000),
+			MaxFeeRate:     feeRate(highTx, 5000),
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("mismatched stats -- got %+v, want %+v", got, want)
+	}
+	if want.Stats[0].AllocatedBytes < totalBytes {
+		t.Fatalf("allocated bytes %d less than used bytes %d",
+			want.Stats[0].AllocatedBytes, totalBytes)
+	}
+}
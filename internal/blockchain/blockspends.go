@@ -0,0 +1,134 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// SpentOutput describes a transaction output spent by a block as recorded in
+// the spend journal along with the input that spent it.
+type SpentOutput struct {
+	OutPoint      wire.OutPoint
+	SpendingTx    chainhash.Hash
+	SpendingInput uint32
+	BlockHeight   int64
+	Amount        int64
+	CoinType      cointype.CoinType
+	ScriptVersion uint16
+	PkScript      []byte
+	TxType        stake.TxType
+	IsCoinBase    bool
+}
+
+// BlockSpends houses all transaction outputs spent by a block in the main
+// chain in the order the transactions of the block spend them, which is the
+// stake tree followed by the regular tree.
+//
+// The outputs spent by the regular tree are included even when the next block
+// in the main chain disapproves it, in which case they are restored to the
+// UTXO set once the next block is connected.
+type BlockSpends struct {
+	Hash        chainhash.Hash
+	Height      int64
+	Disapproved bool
+	Outputs     []SpentOutput
+}
+
+// FetchBlockSpends returns all transaction outputs spent by the main chain
+// block with the provided hash as recorded in its spend journal entry, which is
+// the undo data used to disconnect the block.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchBlockSpends(hash *chainhash.Hash) (*BlockSpends, error) {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	node := b.index.LookupNode(hash)
+	if node == nil || !b.bestChain.Contains(node) {
+		str := fmt.Sprintf("block %s is not in the main chain", hash)
+		return nil, errNotInMainChain(str)
+	}
+	spends := &BlockSpends{Hash: node.hash, Height: node.height}
+	if next := b.bestChain.Next(node); next != nil {
+		spends.Disapproved = !voteBitsApproveParent(next.voteBits)
+	}
+
+	// The genesis block does not spend anything.
+	if node.parent == nil {
+		return spends, nil
+	}
+
+	block, err := b.fetchMainChainBlockByNode(node)
+	if err != nil {
+		return nil, err
+	}
+	isTreasuryEnabled, err := b.isTreasuryAgendaActive(node.parent)
+	if err != nil {
+		return nil, err
+	}
+	var stxos []spentTxOut
+	err = b.db.View(func(dbTx database.Tx) error {
+		stxos, err = dbFetchSpendJournalEntry(dbTx, block, isTreasuryEnabled)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Match the spent txouts to the inputs that spent them in the same order
+	// they are recorded in the spend journal entry.
+	spends.Outputs = make([]SpentOutput, 0, len(stxos))
+	for _, tx := range spendJournalTxns(block.MsgBlock(), isTreasuryEnabled) {
+		// SKA emission transactions do not spend anything.
+		if wire.IsSKAEmissionTransaction(tx) {
+			continue
+		}
+
+		txHash := tx.TxHash()
+		isVote := stake.IsSSGen(tx)
+		for txInIdx, txIn := range tx.TxIn {
+			// Ignore stakebase since it has no input.
+			if isVote && txInIdx == 0 {
+				continue
+			}
+
+			stxoIdx := len(spends.Outputs)
+			if stxoIdx >= len(stxos) {
+				str := fmt.Sprintf("spend journal entry for block %s has %d "+
+					"spent txouts, which is fewer than the inputs that "+
+					"spend them", hash, len(stxos))
+				return nil, AssertError(str)
+			}
+			stxo := &stxos[stxoIdx]
+			spends.Outputs = append(spends.Outputs, SpentOutput{
+				OutPoint:      txIn.PreviousOutPoint,
+				SpendingTx:    txHash,
+				SpendingInput: uint32(txInIdx),
+				BlockHeight:   int64(stxo.blockHeight),
+				Amount:        stxo.amount,
+				CoinType:      stxo.coinType,
+				ScriptVersion: stxo.scriptVersion,
+				PkScript:      stxo.pkScript,
+				TxType:        stxo.TransactionType(),
+				IsCoinBase:    stxo.IsCoinBase(),
+			})
+		}
+	}
+	if len(spends.Outputs) != len(stxos) {
+		str := fmt.Sprintf("spend journal entry for block %s has %d spent "+
+			"txouts, but %d inputs spend them", hash, len(stxos),
+			len(spends.Outputs))
+		return nil, AssertError(str)
+	}
+
+	return spends, nil
+}
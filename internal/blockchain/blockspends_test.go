@@ -0,0 +1,123 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestFetchBlockSpends ensures the outputs spent by main chain blocks are
+// matched to the inputs that spent them, including for blocks whose regular
+// tree is disapproved by the next block.
+func TestFetchBlockSpends(t *testing.T) {
+	params := chaincfg.RegNetParams()
+	g := newChaingenHarness(t, params)
+	g.AdvanceToStakeValidationHeight()
+
+	// Create blocks that spend coinbase outputs, including one whose regular
+	// tree is disapproved by the next block.
+	const vbDisapprovePrev = 0x0000
+	const vbApprovePrev = 0x0001
+	const numBlocks = 3
+	var disapproved chainhash.Hash
+	for i := 0; i < numBlocks+1; i++ {
+		outs := g.OldestCoinbaseOuts()
+		blockName := fmt.Sprintf("bspends%d", i)
+		var mungers []func(*wire.MsgBlock)
+		if i == numBlocks {
+			disapproved = g.Tip().BlockHash()
+			mungers = append(mungers, g.ReplaceVoteBits(vbDisapprovePrev),
+				func(b *wire.MsgBlock) {
+					b.Header.VoteBits &^= vbApprovePrev
+				})
+		}
+		mungers = append(mungers, func(b *wire.MsgBlock) {
+			spend := outs[0]
+			tx := g.CreateSpendTx(&spend, dcrutil.Amount(1))
+			b.AddTransaction(tx)
+		})
+		g.NextBlock(blockName, nil, outs[1:], mungers...)
+		g.SaveTipCoinbaseOuts()
+		g.AcceptTipBlock()
+	}
+
+	tipHeight := g.chain.BestSnapshot().Height
+	for height := tipHeight - numBlocks; height <= tipHeight; height++ {
+		block, err := g.chain.BlockByHeight(height)
+		if err != nil {
+			t.Fatalf("unexpected error fetching block at height %d: %v",
+				height, err)
+		}
+		spends, err := g.chain.FetchBlockSpends(block.Hash())
+		if err != nil {
+			t.Fatalf("unexpected error fetching spends at height %d: %v",
+				height, err)
+		}
+		if spends.Hash != *block.Hash() || spends.Height != height {
+			t.Fatalf("mismatched spends block at height %d -- got %v (%d)",
+				height, spends.Hash, spends.Height)
+		}
+		wantDisapproved := spends.Hash == disapproved
+		if spends.Disapproved != wantDisapproved {
+			t.Fatalf("mismatched disapproval at height %d -- got %v, "+
+				"want %v", height, spends.Disapproved, wantDisapproved)
+		}
+
+		// Every input of the block that spends an output, which excludes
+		// the coinbase, stakebase, and null SSFee inputs, must be matched to
+		// the output it spent.
+		type spentBy struct {
+			tx    chainhash.Hash
+			input uint32
+		}
+		want := make(map[spentBy]*wire.TxIn)
+		msgBlock := block.MsgBlock()
+		txns := make([]*wire.MsgTx, 0, len(msgBlock.STransactions)+
+			len(msgBlock.Transactions)-1)
+		txns = append(txns, msgBlock.STransactions...)
+		txns = append(txns, msgBlock.Transactions[1:]...)
+		for _, tx := range txns {
+			for i, txIn := range tx.TxIn {
+				if txIn.PreviousOutPoint.Index == wire.MaxPrevOutIndex {
+					continue
+				}
+				want[spentBy{tx.TxHash(), uint32(i)}] = txIn
+			}
+		}
+		if len(spends.Outputs) != len(want) {
+			t.Fatalf("mismatched number of spent outputs at height %d -- "+
+				"got %d, want %d", height, len(spends.Outputs), len(want))
+		}
+		for _, spent := range spends.Outputs {
+			txIn, ok := want[spentBy{spent.SpendingTx, spent.SpendingInput}]
+			if !ok {
+				t.Fatalf("unexpected spending input %v:%d at height %d",
+					spent.SpendingTx, spent.SpendingInput, height)
+			}
+			if spent.OutPoint != txIn.PreviousOutPoint ||
+				spent.Amount != txIn.ValueIn ||
+				spent.BlockHeight != int64(txIn.BlockHeight) ||
+				spent.CoinType != cointype.CoinTypeVAR {
+
+				t.Fatalf("mismatched spent output for input %v:%d at "+
+					"height %d: %+v", spent.SpendingTx, spent.SpendingInput,
+					height, spent)
+			}
+		}
+	}
+
+	// Ensure requesting the spends of an unknown block fails.
+	_, err := g.chain.FetchBlockSpends(&chainhash.Hash{0x01})
+	if !isNotInMainChainErr(err) {
+		t.Fatalf("unexpected error for unknown block: %v", err)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/gcs"
 	"github.com/monetarium/monetarium-node/gcs/blockcf2"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
 	"github.com/monetarium/monetarium-node/internal/blockchain/indexers"
 	"github.com/monetarium/monetarium-node/math/uint256"
 	"github.com/monetarium/monetarium-node/txscript"
@@ -192,6 +193,20 @@ type BlockChain struct {
 	// tracking the total amount burned per coin type.
 	skaBurnState *SKABurnState
 
+	// reorgSKAEmissions tracks the SKA emissions disconnected during the
+	// chain reorganization in progress that have not been connected again
+	// on the new branch.  It is protected by the chain lock.
+	reorgSKAEmissions []SKAEmissionRecord
+
+	// validationStats houses the durations of each stage of block
+	// validation.  It has its own lock.
+	validationStats validationStats
+
+	// checkingTemplate is set while a block template is being checked so the
+	// durations of its validation stages are not recorded.  It is protected
+	// by the chain lock.
+	checkingTemplate bool
+
 	// processLock protects concurrent access to overall chain processing
 	// independent from the chain lock which is periodically released to
 	// send notifications.
@@ -678,6 +693,8 @@ func (b *BlockChain) connectBlock(node *blockNode, block, parent *dcrutil.Block,
 		node.stakeNode.MissedTickets(), node.stakeNode.FinalState())
 
 	// Atomically insert info into the database.
+	indexUpdatesStart := time.Now()
+	var connectedEmissions []SKAEmissionRecord
 	err = b.db.Update(func(dbTx database.Tx) error {
 		// Update best block state.
 		err := dbPutBestState(dbTx, state, &node.workSum)
@@ -734,6 +751,7 @@ func (b *BlockChain) connectBlock(node *blockNode, block, parent *dcrutil.Block,
 				if err != nil {
 					return err
 				}
+				connectedEmissions = emissions
 			}
 		}
 
@@ -750,6 +768,24 @@ func (b *BlockChain) connectBlock(node *blockNode, block, parent *dcrutil.Block,
 			}
 		}
 
+		// Checkpoint the fees collected per coin type in the block along with
+		// the cumulative totals.
+		err = dbPutBlockFeeTotals(dbTx, &node.hash, &node.parent.hash,
+			node.height, calcBlockFeesByCoinType(block))
+		if err != nil {
+			return err
+		}
+
+		// Checkpoint the per coin type statistics of the block.
+		allocator := blockalloc.NewBlockSpaceAllocator(
+			uint32(b.maxBlockSize(node.parent)), b.chainParams).ForHeight(
+			node.height)
+		err = dbPutBlockStats(dbTx, &node.hash, calcBlockStats(block,
+			isTreasuryEnabled, allocator))
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -776,6 +812,7 @@ func (b *BlockChain) connectBlock(node *blockNode, block, parent *dcrutil.Block,
 	if err != nil {
 		return err
 	}
+	b.recordValidationStage(VSIndexUpdates, time.Since(indexUpdatesStart))
 
 	// This node is now the end of the best chain.
 	b.bestChain.SetTip(node)
@@ -790,6 +827,13 @@ func (b *BlockChain) connectBlock(node *blockNode, block, parent *dcrutil.Block,
 	b.stateSnapshot = state
 	b.stateLock.Unlock()
 
+	// Emissions reconnected on the new branch of a reorganization are no
+	// longer rolled back.
+	if len(connectedEmissions) > 0 && len(b.reorgSKAEmissions) > 0 {
+		b.reorgSKAEmissions = removeSKAEmissionRecords(b.reorgSKAEmissions,
+			connectedEmissions)
+	}
+
 	// Conditionally log target difficulty changes at retarget intervals.  Only
 	// log when the chain believes it is current since it is very noisy during
 	// syncing otherwise.
@@ -903,6 +947,7 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block, parent *dcrutil.Blo
 		prevNode.stakeNode.ExpiringNextBlock(), prevNode.stakeNode.Winners(),
 		prevNode.stakeNode.MissedTickets(), prevNode.stakeNode.FinalState())
 
+	var disconnectedEmissions []SKAEmissionRecord
 	err = b.db.Update(func(dbTx database.Tx) error {
 		// Update best block state.
 		err := dbPutBestState(dbTx, state, &node.workSum)
@@ -934,6 +979,7 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block, parent *dcrutil.Blo
 				if err != nil {
 					return err
 				}
+				disconnectedEmissions = emissions
 			}
 		}
 
@@ -950,6 +996,18 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block, parent *dcrutil.Blo
 			}
 		}
 
+		// Remove the fee totals checkpoint of the disconnected block.
+		err = dbRemoveBlockFeeTotals(dbTx, &node.hash)
+		if err != nil {
+			return err
+		}
+
+		// Remove the statistics checkpoint of the disconnected block.
+		err = dbRemoveBlockStats(dbTx, &node.hash)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -998,6 +1056,10 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block, parent *dcrutil.Blo
 	b.stateSnapshot = state
 	b.stateLock.Unlock()
 
+	// Track the emissions rolled back by the disconnect so they can be
+	// reported once the reorganization completes.
+	b.reorgSKAEmissions = append(b.reorgSKAEmissions, disconnectedEmissions...)
+
 	// Notify the caller that the block was disconnected from the main
 	// chain.  The caller would typically want to react with actions such as
 	// updating wallets.
@@ -1006,6 +1068,7 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block, parent *dcrutil.Blo
 		Block:        block,
 		ParentBlock:  parent,
 		CheckTxFlags: checkTxFlags,
+		SKAEmissions: disconnectedEmissions,
 	})
 	b.chainLock.Lock()
 
@@ -1381,6 +1444,7 @@ func (b *BlockChain) reorganizeChain(target *blockNode) error {
 			// without understanding why!
 			b.sendNotification(NTChainReorgStarted, nil)
 			sentReorgingNtfn = true
+			b.reorgSKAEmissions = nil
 
 			defer func() {
 				// Send a notification announcing the end of the chain
@@ -1451,11 +1515,13 @@ func (b *BlockChain) reorganizeChain(target *blockNode) error {
 		// notification.  This is intentional and must not be changed without
 		// understanding why!
 		b.sendNotification(NTReorganization, &ReorganizationNtfnsData{
-			OldHash:   origTip.hash,
-			OldHeight: origTip.height,
-			NewHash:   newTip.hash,
-			NewHeight: newTip.height,
+			OldHash:                  origTip.hash,
+			OldHeight:                origTip.height,
+			NewHash:                  newTip.hash,
+			NewHeight:                newTip.height,
+			DisconnectedSKAEmissions: b.reorgSKAEmissions,
 		})
+		b.reorgSKAEmissions = nil
 
 		// Log the point where the chain forked and old and new best chain tips.
 		if fork := b.bestChain.FindFork(origTip); fork != nil {
@@ -1705,15 +1771,32 @@ func (b *BlockChain) GetSKAEmissionNonce(coinType cointype.CoinType) uint64 {
 	return b.skaEmissionState.GetNonce(coinType)
 }
 
-// HasSKAEmissionOccurred checks if an emission has already occurred for the
-// specified coin type. This is used to prevent duplicate emissions.
+// HasSKAEmissionOccurred checks if every scheduled emission tranche has
+// already been emitted for the specified coin type. This is used to prevent
+// duplicate emissions.
 //
 // This function is safe for concurrent access.
 func (b *BlockChain) HasSKAEmissionOccurred(coinType cointype.CoinType) bool {
 	if b.skaEmissionState == nil {
 		return false
 	}
-	return b.skaEmissionState.IsEmitted(coinType)
+	config, ok := b.chainParams.SKACoins[coinType]
+	if !ok {
+		return b.skaEmissionState.IsEmitted(coinType)
+	}
+	emitted := b.skaEmissionState.EmittedTranches(coinType)
+	return int(emitted) >= len(config.EmissionSchedule())
+}
+
+// SKAEmissionTranchesEmitted returns the number of scheduled emission tranches
+// that have been emitted for the specified coin type.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) SKAEmissionTranchesEmitted(coinType cointype.CoinType) uint32 {
+	if b.skaEmissionState == nil {
+		return 0
+	}
+	return b.skaEmissionState.EmittedTranches(coinType)
 }
 
 // GetSKABurnedAmount returns the total amount burned for the specified SKA coin type.
@@ -1738,6 +1821,24 @@ func (b *BlockChain) GetAllSKABurnedAmounts() map[cointype.CoinType]int64 {
 	return b.skaBurnState.GetAllBurnedAmounts()
 }
 
+// FetchSKABurns returns up to maxBurns burns of the provided SKA coin type in
+// main chain blocks at or after the provided height along with the height of
+// the first block whose individual burns are recorded.  Burns in blocks prior
+// to that height are only accounted for in the total burned amount.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchSKABurns(coinType cointype.CoinType, startHeight int64, maxBurns int) ([]SKABurnRecord, int64, error) {
+	if b.skaBurnState == nil {
+		return nil, 0, nil
+	}
+	burns, err := b.skaBurnState.FetchBurnRecords(coinType, startHeight,
+		maxBurns)
+	if err != nil {
+		return nil, 0, err
+	}
+	return burns, b.skaBurnState.JournalStartHeight(), nil
+}
+
 // maxBlockSize returns the maximum permitted block size for the block
 // AFTER the given node.
 //
@@ -2431,6 +2532,35 @@ func (q *ChainQueryerAdapter) FetchUtxoEntryDetails(outpoint wire.OutPoint) (int
 	return entry.Amount(), entry.BlockHeight(), entry.BlockIndex(), false, nil
 }
 
+// FetchUtxoEntryDetailsBatch returns the details of each of the specified
+// transaction outputs from the point of view of the main chain tip in the same
+// order as the provided outpoints.  Outputs that don't exist or are spent are
+// reported as spent with all other details zero.
+//
+// All of the outputs are loaded in a single pass over the utxo cache.
+//
+// This is part of the indexers.ChainQueryer interface.
+func (q *ChainQueryerAdapter) FetchUtxoEntryDetailsBatch(outpoints []wire.OutPoint) ([]indexers.UtxoEntryDetails, error) {
+	entries, err := q.FetchUtxoEntries(outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]indexers.UtxoEntryDetails, len(entries))
+	for i, entry := range entries {
+		if entry == nil || entry.IsSpent() {
+			details[i].Spent = true
+			continue
+		}
+		details[i] = indexers.UtxoEntryDetails{
+			Amount:      entry.Amount(),
+			BlockHeight: entry.BlockHeight(),
+			BlockIndex:  entry.BlockIndex(),
+		}
+	}
+	return details, nil
+}
+
 // isTestNet3 returns whether or not the chain instance is for version 3 of the
 // test network.
 func (b *BlockChain) isTestNet3() bool {
@@ -2643,13 +2773,19 @@ func New(ctx context.Context, config *Config) (*BlockChain, error) {
 
 	// Validate SKA emission parameters
 	// Ensure all SKA emissions happen after stake validation is active
-	for coinType, skaConfig := range params.SKACoins {
+	for _, coinType := range cointype.SortedKeys(params.SKACoins) {
+		skaConfig := params.SKACoins[coinType]
 		if skaConfig.EmissionHeight > 0 && int64(skaConfig.EmissionHeight) < params.StakeValidationHeight {
 			return nil, fmt.Errorf("SKA coin type %d emission height %d is before stake validation height %d",
 				coinType, skaConfig.EmissionHeight, params.StakeValidationHeight)
 		}
 	}
 
+	// Upgrade the on-disk format of the SKA state as needed before loading it.
+	if err := upgradeSKAState(ctx, config.DB); err != nil {
+		return nil, err
+	}
+
 	// Initialize the SKA emission state for tracking nonces and emissions.
 	// This must be done before chain state initialization as it may be
 	// referenced during block validation.
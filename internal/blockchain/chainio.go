@@ -680,6 +680,10 @@ func deserializeSpendJournalEntry(serialized []byte, txns []*wire.MsgTx) ([]spen
 			numStxos++
 			continue
 		}
+		// Skip SKA emission transactions since they have null inputs.
+		if wire.IsSKAEmissionTransaction(tx) {
+			continue
+		}
 		// Only skip null-input SSFee (creates new UTXO from scratch).
 		// Augmented SSFee (real input) spends a previous SSFee output.
 		if stake.IsSSFee(tx) {
@@ -714,6 +718,11 @@ func deserializeSpendJournalEntry(serialized []byte, txns []*wire.MsgTx) ([]spen
 		tx := txns[txIdx]
 		isVote := stake.IsSSGen(tx)
 
+		// Skip SKA emission transactions since they have null inputs.
+		if wire.IsSKAEmissionTransaction(tx) {
+			continue
+		}
+
 		// Only skip null-input SSFee (creates new UTXO from scratch).
 		// Augmented SSFee (real input) spends a previous SSFee output.
 		if stake.IsSSFee(tx) {
@@ -789,11 +798,34 @@ func serializeSpendJournalEntry(stxos []spentTxOut) ([]byte, error) {
 // the passed block since that information is required to reconstruct the spent
 // txouts.
 func dbFetchSpendJournalEntry(dbTx database.Tx, block *dcrutil.Block, isTreasuryEnabled bool) ([]spentTxOut, error) {
-	// Exclude the coinbase transaction since it can't spend anything.
 	spendBucket := dbTx.Metadata().Bucket(spendJournalBucketName)
 	serialized := spendBucket.Get(block.Hash()[:])
-	msgBlock := block.MsgBlock()
+	blockTxns := spendJournalTxns(block.MsgBlock(), isTreasuryEnabled)
+	if len(blockTxns) > 0 && len(serialized) == 0 {
+		panicf("missing spend journal data for %s", block.Hash())
+	}
+
+	stxos, err := deserializeSpendJournalEntry(serialized, blockTxns)
+	if err != nil {
+		// Ensure any deserialization errors are returned as database
+		// corruption errors.
+		if isDeserializeErr(err) {
+			str := fmt.Sprintf("corrupt spend information for %v: %v",
+				block.Hash(), err)
+			return nil, makeDbErr(database.ErrCorruption, str)
+		}
+
+		return nil, err
+	}
 
+	return stxos, nil
+}
+
+// spendJournalTxns returns the transactions of the passed block whose spent
+// txouts are recorded in its spend journal entry in the order they are
+// recorded, which is the stake tree followed by the regular tree.
+func spendJournalTxns(msgBlock *wire.MsgBlock, isTreasuryEnabled bool) []*wire.MsgTx {
+	// Exclude the coinbase transaction since it can't spend anything.
 	blockTxns := make([]*wire.MsgTx, 0, len(msgBlock.STransactions)+
 		len(msgBlock.Transactions[1:]))
 	if len(msgBlock.STransactions) > 0 && isTreasuryEnabled {
@@ -823,25 +855,7 @@ func dbFetchSpendJournalEntry(dbTx database.Tx, block *dcrutil.Block, isTreasury
 			blockTxns = append(blockTxns, v)
 		}
 	}
-	blockTxns = append(blockTxns, msgBlock.Transactions[1:]...)
-	if len(blockTxns) > 0 && len(serialized) == 0 {
-		panicf("missing spend journal data for %s", block.Hash())
-	}
-
-	stxos, err := deserializeSpendJournalEntry(serialized, blockTxns)
-	if err != nil {
-		// Ensure any deserialization errors are returned as database
-		// corruption errors.
-		if isDeserializeErr(err) {
-			str := fmt.Sprintf("corrupt spend information for %v: %v",
-				block.Hash(), err)
-			return nil, makeDbErr(database.ErrCorruption, str)
-		}
-
-		return nil, err
-	}
-
-	return stxos, nil
+	return append(blockTxns, msgBlock.Transactions[1:]...)
 }
 
 // dbPutSpendJournalEntry uses an existing database transaction to update the
@@ -742,6 +742,89 @@ func TestSpendJournalSerialization(t *testing.T) {
 	}
 }
 
+// TestSpendJournalSKAEmissions ensures spend journal entries of blocks that
+// contain SKA emissions round trip through serialization.  The emissions have
+// a null input that does not spend anything, so it must not be accounted for
+// when deserializing the entries of the other transactions.
+func TestSpendJournalSKAEmissions(t *testing.T) {
+	t.Parallel()
+
+	emissionTx := func(amount int64) *wire.MsgTx {
+		return &wire.MsgTx{
+			SerType: wire.TxSerializeFull,
+			Version: 1,
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{
+					Index: wire.MaxPrevOutIndex,
+				},
+				SignatureScript: hexToBytes("01534b4102"),
+				Sequence:        wire.MaxTxInSequenceNum,
+			}},
+			TxOut: []*wire.TxOut{{
+				Value:    amount,
+				CoinType: 1,
+				PkScript: hexToBytes("76a9142bf10bc24646c590d16b35b925cf7ad3c5aef0b288ac"),
+			}},
+		}
+	}
+	spendTx := &wire.MsgTx{
+		SerType: wire.TxSerializeFull,
+		Version: 1,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{
+				Hash: *mustParseHash("0e0c8ac0b57b7bff8461e3c9e251ee05b1d04ee5ef971" +
+					"4b6414ea4bff1939fb9"),
+				Index: 1,
+			},
+			Sequence:    wire.MaxTxInSequenceNum,
+			BlockHeight: 100,
+			BlockIndex:  1,
+			ValueIn:     4500,
+		}},
+		TxOut: []*wire.TxOut{{
+			Value:    4000,
+			CoinType: 1,
+			PkScript: hexToBytes("76a914cf71aca9293855190e270650c405395ed00dfca588ac"),
+		}},
+	}
+	blockTxns := []*wire.MsgTx{emissionTx(5000), spendTx, emissionTx(6000)}
+	entry := []spentTxOut{{
+		amount:        4500,
+		scriptVersion: 0,
+		pkScript:      hexToBytes("76a9142bf10bc24646c590d16b35b925cf7ad3c5aef0b288ac"),
+		blockHeight:   100,
+		blockIndex:    1,
+		coinType:      1,
+		packedFlags:   encodeFlags(false, false, stake.TxTypeRegular),
+	}}
+	if !wire.IsSKAEmissionTransaction(blockTxns[0]) {
+		t.Fatal("test emission is not detected as an SKA emission")
+	}
+
+	serialized, err := serializeSpendJournalEntry(entry)
+	if err != nil {
+		t.Fatalf("unexpected serialize error: %v", err)
+	}
+	gotEntry, err := deserializeSpendJournalEntry(serialized, blockTxns)
+	if err != nil {
+		t.Fatalf("unexpected deserialize error: %v", err)
+	}
+	if !reflect.DeepEqual(gotEntry, entry) {
+		t.Fatalf("mismatched entries - got %+v, want %+v", gotEntry, entry)
+	}
+
+	// Ensure a block with only emissions has no spent outputs.
+	gotEntry, err = deserializeSpendJournalEntry(nil,
+		[]*wire.MsgTx{emissionTx(5000)})
+	if err != nil {
+		t.Fatalf("unexpected deserialize error: %v", err)
+	}
+	if len(gotEntry) != 0 {
+		t.Fatalf("unexpected entries for block with only emissions: %+v",
+			gotEntry)
+	}
+}
+
 // TestSpendJournalErrors performs negative tests against deserializing spend
 // journal entries to ensure error paths work as expected.
 func TestSpendJournalErrors(t *testing.T) {
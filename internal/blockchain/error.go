@@ -247,6 +247,56 @@ const (
 	// an SKA emission transaction does not have the required authorized format.
 	ErrBadSKAEmissionScriptFormat = ErrorKind("ErrBadSKAEmissionScriptFormat")
 
+	// ErrSKAEmissionInStakeTree indicates that an SKA emission transaction
+	// was found in the stake transaction tree.
+	ErrSKAEmissionInStakeTree = ErrorKind("ErrSKAEmissionInStakeTree")
+
+	// ErrBadSKAEmissionPosition indicates that an SKA emission transaction
+	// does not immediately follow the coinbase or other SKA emission
+	// transactions in the regular transaction tree.
+	ErrBadSKAEmissionPosition = ErrorKind("ErrBadSKAEmissionPosition")
+
+	// ErrSKAExpiryNotAllowed indicates that a transaction sets an expiry
+	// even though the chain parameters disallow expiry for its coin type.
+	ErrSKAExpiryNotAllowed = ErrorKind("ErrSKAExpiryNotAllowed")
+
+	// ErrSKAExpiryTooFar indicates that a transaction sets an expiry further
+	// in the future than the chain parameters allow for its coin type.
+	ErrSKAExpiryTooFar = ErrorKind("ErrSKAExpiryTooFar")
+
+	// ErrSKASequenceLockNotAllowed indicates that a transaction enables a
+	// relative lock time on one of its inputs even though the chain
+	// parameters disallow sequence locks for its coin type.
+	ErrSKASequenceLockNotAllowed = ErrorKind("ErrSKASequenceLockNotAllowed")
+
+	// ErrSKACoinTypeDeactivated indicates that a transaction creates an
+	// output of an SKA coin type after the delay following the activation
+	// of the stakeholder vote that deactivates it has elapsed.
+	ErrSKACoinTypeDeactivated = ErrorKind("ErrSKACoinTypeDeactivated")
+
+	// ErrSKASweepNotDeactivated indicates that a transaction that only
+	// creates VAR outputs spends an output of an SKA coin type whose
+	// deactivation vote has not passed.
+	ErrSKASweepNotDeactivated = ErrorKind("ErrSKASweepNotDeactivated")
+
+	// ErrUnknownCoinType indicates that a transaction output uses a coin
+	// type that is neither VAR nor an SKA coin type configured in the chain
+	// parameters.
+	ErrUnknownCoinType = ErrorKind("ErrUnknownCoinType")
+
+	// ErrSKABurnCoinTypeMismatch indicates that an SKA burn output uses a
+	// coin type that differs from the coin type committed to by its script.
+	ErrSKABurnCoinTypeMismatch = ErrorKind("ErrSKABurnCoinTypeMismatch")
+
+	// ErrZeroSKABurn indicates that an SKA burn output does not burn a
+	// positive amount.
+	ErrZeroSKABurn = ErrorKind("ErrZeroSKABurn")
+
+	// ErrTxInCoinTypeMismatch indicates that a transaction input declares a
+	// coin type that differs from the coin type of the output it spends or,
+	// for inputs that do not spend an output, a coin type other than VAR.
+	ErrTxInCoinTypeMismatch = ErrorKind("ErrTxInCoinTypeMismatch")
+
 	// ErrBadStakebaseAmountIn indicates that the AmountIn (=subsidy) for a
 	// stakebase input was incorrect.
 	ErrBadStakebaseAmountIn = ErrorKind("ErrBadStakebaseAmountIn")
@@ -621,6 +671,11 @@ const (
 	// in a batched request.
 	ErrRequestTooLarge = ErrorKind("ErrRequestTooLarge")
 
+	// ErrIncompatibleSKAConfig indicates the best known header chain commits
+	// to consensus rules that the local SKA configuration is unable to
+	// validate.
+	ErrIncompatibleSKAConfig = ErrorKind("ErrIncompatibleSKAConfig")
+
 	// ------------------------------------------
 	// Errors related to the UTXO backend.
 	// ------------------------------------------
@@ -63,6 +63,17 @@ func TestErrorKindStringer(t *testing.T) {
 		{ErrBadSKAEmissionOutpoint, "ErrBadSKAEmissionOutpoint"},
 		{ErrBadSKAEmissionFraudProof, "ErrBadSKAEmissionFraudProof"},
 		{ErrBadSKAEmissionScriptFormat, "ErrBadSKAEmissionScriptFormat"},
+		{ErrSKAEmissionInStakeTree, "ErrSKAEmissionInStakeTree"},
+		{ErrBadSKAEmissionPosition, "ErrBadSKAEmissionPosition"},
+		{ErrSKAExpiryNotAllowed, "ErrSKAExpiryNotAllowed"},
+		{ErrSKAExpiryTooFar, "ErrSKAExpiryTooFar"},
+		{ErrSKASequenceLockNotAllowed, "ErrSKASequenceLockNotAllowed"},
+		{ErrSKACoinTypeDeactivated, "ErrSKACoinTypeDeactivated"},
+		{ErrSKASweepNotDeactivated, "ErrSKASweepNotDeactivated"},
+		{ErrUnknownCoinType, "ErrUnknownCoinType"},
+		{ErrSKABurnCoinTypeMismatch, "ErrSKABurnCoinTypeMismatch"},
+		{ErrZeroSKABurn, "ErrZeroSKABurn"},
+		{ErrTxInCoinTypeMismatch, "ErrTxInCoinTypeMismatch"},
 		{ErrBadStakebaseAmountIn, "ErrBadStakebaseAmountIn"},
 		{ErrBadStakebaseScriptLen, "ErrBadStakebaseScriptLen"},
 		{ErrBadStakebaseScrVal, "ErrBadStakebaseScrVal"},
@@ -155,6 +166,7 @@ func TestErrorKindStringer(t *testing.T) {
 		{ErrSerializeHeader, "ErrSerializeHeader"},
 		{ErrNotAnAncestor, "ErrNotAnAncestor"},
 		{ErrRequestTooLarge, "ErrRequestTooLarge"},
+		{ErrIncompatibleSKAConfig, "ErrIncompatibleSKAConfig"},
 		{ErrUtxoBackend, "ErrUtxoBackend"},
 		{ErrUtxoBackendCorruption, "ErrUtxoBackendCorruption"},
 		{ErrUtxoBackendNotOpen, "ErrUtxoBackendNotOpen"},
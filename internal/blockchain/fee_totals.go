@@ -0,0 +1,270 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// Per coin type fee totals
+// This file maintains a checkpoint of the fees collected per coin type for
+// every block in the main chain including:
+// - The fees paid by the transactions of the block
+// - The cumulative fees paid since tracking started
+// - Proper handling of chain reorganizations
+
+const (
+	// feeTotalsBucketName is the name of the database bucket that houses the
+	// fee totals of each main chain block keyed by block hash.
+	feeTotalsBucketName = "feetotals"
+
+	// feeTotalsStartKey is the meta key for the height of the first block
+	// whose fees are included in the cumulative totals.
+	feeTotalsStartKey = "__meta_startheight__"
+
+	// feeTotalsEntrySize is the size of the serialized fee totals of a single
+	// coin type: [coin type:1][fees:8][cumulative fees:8]
+	feeTotalsEntrySize = 1 + 8 + 8
+)
+
+// CoinTypeFeeTotal houses the fees of a single coin type collected in a block
+// along with the cumulative fees of the coin type up to and including the
+// block.
+type CoinTypeFeeTotal struct {
+	CoinType       cointype.CoinType
+	Fees           int64
+	CumulativeFees int64
+}
+
+// BlockFeeTotals houses the per coin type fee totals of a main chain block.
+type BlockFeeTotals struct {
+	// Height is the height of the block.
+	Height int64
+
+	// StartHeight is the height of the first block whose fees are included
+	// in the cumulative totals.  It is only greater than one for databases
+	// created before fee totals were tracked.
+	StartHeight int64
+
+	// Totals are the fee totals of each coin type that has collected fees
+	// since the start height ordered by coin type.
+	Totals []CoinTypeFeeTotal
+}
+
+// calcBlockFeesByCoinType returns the fees paid by the transactions of the
+// provided block by coin type.  The fees are calculated from the input values
+// committed to by the transactions so they are available regardless of the
+// state of the utxo set.  Coinbases and stake transactions that do not pay
+// fees are skipped.
+//
+// These are the same fees that are split between the miner and the stakers of
+// the block, prior to scaling by voter participation.
+func calcBlockFeesByCoinType(block *dcrutil.Block) wire.FeesByType {
+	totalFees := wire.NewFeesByType()
+	addTxFee := func(msgTx *wire.MsgTx) {
+		var totalIn int64
+		for _, txIn := range msgTx.TxIn {
+			totalIn += txIn.ValueIn
+		}
+		var totalOut int64
+		for _, txOut := range msgTx.TxOut {
+			totalOut += txOut.Value
+		}
+		txFee := totalIn - totalOut
+		if txFee > 0 {
+			totalFees.Add(wire.GetPrimaryCoinType(msgTx), txFee)
+		}
+	}
+
+	for _, tx := range block.Transactions()[1:] { // Skip coinbase
+		addTxFee(tx.MsgTx())
+	}
+	for _, stx := range block.STransactions() {
+		// Skip special stake transactions that don't pay fees.
+		switch stake.DetermineTxType(stx.MsgTx()) {
+		case stake.TxTypeSSGen, stake.TxTypeTreasuryBase, stake.TxTypeTSpend,
+			stake.TxTypeSSFee:
+			continue
+		}
+		addTxFee(stx.MsgTx())
+	}
+	return totalFees
+}
+
+// serializeBlockFeeTotals returns the serialized fee totals of a block:
+// [height:4][num coin types:1][entries...]
+func serializeBlockFeeTotals(totals *BlockFeeTotals) []byte {
+	serialized := make([]byte, 5+len(totals.Totals)*feeTotalsEntrySize)
+	binary.LittleEndian.PutUint32(serialized[0:4], uint32(totals.Height))
+	serialized[4] = byte(len(totals.Totals))
+	offset := 5
+	for _, total := range totals.Totals {
+		serialized[offset] = byte(total.CoinType)
+		binary.LittleEndian.PutUint64(serialized[offset+1:],
+			uint64(total.Fees))
+		binary.LittleEndian.PutUint64(serialized[offset+9:],
+			uint64(total.CumulativeFees))
+		offset += feeTotalsEntrySize
+	}
+	return serialized
+}
+
+// deserializeBlockFeeTotals decodes the fee totals of a block from the
+// provided serialized bytes.  The start height is not part of the serialized
+// totals and must be set by the caller.
+func deserializeBlockFeeTotals(serialized []byte) (*BlockFeeTotals, error) {
+	if len(serialized) < 5 {
+		return nil, fmt.Errorf("invalid fee totals length: %d",
+			len(serialized))
+	}
+	numTotals := int(serialized[4])
+	if len(serialized) != 5+numTotals*feeTotalsEntrySize {
+		return nil, fmt.Errorf("invalid fee totals length %d for %d coin "+
+			"types", len(serialized), numTotals)
+	}
+	totals := &BlockFeeTotals{
+		Height: int64(binary.LittleEndian.Uint32(serialized[0:4])),
+		Totals: make([]CoinTypeFeeTotal, 0, numTotals),
+	}
+	offset := 5
+	for i := 0; i < numTotals; i++ {
+		totals.Totals = append(totals.Totals, CoinTypeFeeTotal{
+			CoinType: cointype.CoinType(serialized[offset]),
+			Fees: int64(binary.LittleEndian.Uint64(
+				serialized[offset+1:])),
+			CumulativeFees: int64(binary.LittleEndian.Uint64(
+				serialized[offset+9:])),
+		})
+		offset += feeTotalsEntrySize
+	}
+	return totals, nil
+}
+
+// dbFetchBlockFeeTotals uses an existing database transaction to fetch the fee
+// totals of the block with the provided hash.  It returns nil when there are no
+// fee totals recorded for the block, which is the case for blocks that are not
+// in the main chain or that were connected before fee totals were tracked.
+func dbFetchBlockFeeTotals(dbTx database.Tx, hash *chainhash.Hash) (*BlockFeeTotals, error) {
+	bucket := dbTx.Metadata().Bucket([]byte(feeTotalsBucketName))
+	if bucket == nil {
+		return nil, nil
+	}
+	serialized := bucket.Get(hash[:])
+	if serialized == nil {
+		return nil, nil
+	}
+	totals, err := deserializeBlockFeeTotals(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode fee totals for block %v: %w",
+			hash, err)
+	}
+	if v := bucket.Get([]byte(feeTotalsStartKey)); len(v) == 8 {
+		totals.StartHeight = int64(binary.LittleEndian.Uint64(v))
+	}
+	return totals, nil
+}
+
+// dbPutBlockFeeTotals uses an existing database transaction to record the fee
+// totals of the block with the provided hash, height, and fees by coin type.
+// The cumulative totals build on the fee totals of the parent block.  When the
+// parent has no fee totals recorded, such as for the first block connected
+// after fee totals are first tracked, the cumulative totals start from the
+// block.
+func dbPutBlockFeeTotals(dbTx database.Tx, hash, prevHash *chainhash.Hash, height int64, fees wire.FeesByType) error {
+	bucket, err := dbTx.Metadata().CreateBucketIfNotExists(
+		[]byte(feeTotalsBucketName))
+	if err != nil {
+		return fmt.Errorf("failed to create fee totals bucket: %w", err)
+	}
+
+	parent, err := dbFetchBlockFeeTotals(dbTx, prevHash)
+	if err != nil {
+		return err
+	}
+	cumulative := make(map[cointype.CoinType]*CoinTypeFeeTotal)
+	if parent != nil {
+		for _, total := range parent.Totals {
+			cumulative[total.CoinType] = &CoinTypeFeeTotal{
+				CoinType:       total.CoinType,
+				CumulativeFees: total.CumulativeFees,
+			}
+		}
+	} else {
+		// Update the start height of the cumulative totals since fees of the
+		// blocks prior to this one are not accounted for.
+		var startHeight [8]byte
+		binary.LittleEndian.PutUint64(startHeight[:], uint64(height))
+		err := bucket.Put([]byte(feeTotalsStartKey), startHeight[:])
+		if err != nil {
+			return fmt.Errorf("failed to save fee totals start height: %w",
+				err)
+		}
+	}
+	for coinType, fee := range fees {
+		total, ok := cumulative[coinType]
+		if !ok {
+			total = &CoinTypeFeeTotal{CoinType: coinType}
+			cumulative[coinType] = total
+		}
+		total.Fees = fee
+		total.CumulativeFees += fee
+	}
+
+	totals := &BlockFeeTotals{
+		Height: height,
+		Totals: make([]CoinTypeFeeTotal, 0, len(cumulative)),
+	}
+	for _, total := range cumulative {
+		totals.Totals = append(totals.Totals, *total)
+	}
+	sort.Slice(totals.Totals, func(i, j int) bool {
+		return totals.Totals[i].CoinType < totals.Totals[j].CoinType
+	})
+	err = bucket.Put(hash[:], serializeBlockFeeTotals(totals))
+	if err != nil {
+		return fmt.Errorf("failed to save fee totals for block %v: %w", hash,
+			err)
+	}
+	return nil
+}
+
+// dbRemoveBlockFeeTotals uses an existing database transaction to remove the
+// fee totals of the block with the provided hash.
+func dbRemoveBlockFeeTotals(dbTx database.Tx, hash *chainhash.Hash) error {
+	bucket := dbTx.Metadata().Bucket([]byte(feeTotalsBucketName))
+	if bucket == nil {
+		return nil
+	}
+	if err := bucket.Delete(hash[:]); err != nil {
+		return fmt.Errorf("failed to remove fee totals for block %v: %w", hash,
+			err)
+	}
+	return nil
+}
+
+// FetchBlockFeeTotals returns the per coin type fee totals of the main chain
+// block with the provided hash.  It returns nil when there are no fee totals
+// recorded for the block, which is the case for blocks that are not in the
+// main chain or that were connected before fee totals were tracked.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchBlockFeeTotals(hash *chainhash.Hash) (*BlockFeeTotals, error) {
+	var totals *BlockFeeTotals
+	err := b.db.View(func(dbTx database.Tx) error {
+		var err error
+		totals, err = dbFetchBlockFeeTotals(dbTx, hash)
+		return err
+	})
+	return totals, err
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestBlockFeeTotalsSerialization ensures block fee totals round trip through
+// serialization and that malformed serializations are rejected.
+func TestBlockFeeTotalsSerialization(t *testing.T) {
+	t.Parallel()
+
+	totals := &BlockFeeTotals{
+		Height: 1234,
+		Totals: []CoinTypeFeeTotal{
+			{CoinType: cointype.CoinTypeVAR, Fees: 5000, CumulativeFees: 90000},
+			{CoinType: 1, Fees: 0, CumulativeFees: 1 << 40},
+		},
+	}
+	serialized := serializeBlockFeeTotals(totals)
+	got, err := deserializeBlockFeeTotals(serialized)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, totals) {
+		t.Fatalf("mismatched totals -- got %+v, want %+v", got, totals)
+	}
+
+	for _, bad := range [][]byte{nil, serialized[:4], serialized[:len(serialized)-1]} {
+		if _, err := deserializeBlockFeeTotals(bad); err == nil {
+			t.Fatalf("deserializing %x did not fail", bad)
+		}
+	}
+}
+
+// TestCalcBlockFeesByCoinType ensures the fees of a block are calculated by
+// coin type from the committed input values while skipping the coinbase.
+func TestCalcBlockFeesByCoinType(t *testing.T) {
+	t.Parallel()
+
+	newTx := func(valueIn int64, outs ...*wire.TxOut) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(&wire.TxIn{ValueIn: valueIn})
+		for _, out := range outs {
+			tx.AddTxOut(out)
+		}
+		return tx
+	}
+	coinbase := newTx(0, &wire.TxOut{Value: 1000})
+	varTx := newTx(10000, &wire.TxOut{Value: 9000})
+	skaTx := newTx(50000, &wire.TxOut{Value: 45000, CoinType: 2})
+	noFeeTx := newTx(100, &wire.TxOut{Value: 100})
+	block := dcrutil.NewBlock(&wire.MsgBlock{
+		Transactions: []*wire.MsgTx{coinbase, varTx, skaTx, noFeeTx},
+	})
+
+	got := calcBlockFeesByCoinType(block)
+	want := wire.FeesByType{cointype.CoinTypeVAR: 1000, 2: 5000}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("mismatched fees -- got %v, want %v", got, want)
+	}
+}
+
+// TestBlockFeeTotalsConnectDisconnect ensures the cumulative fee totals build
+// on the parent block and are removed when a block is disconnected.
+func TestBlockFeeTotalsConnectDisconnect(t *testing.T) {
+	t.Parallel()
+
+	db, teardown := createTestDB(t, "feetotals_connect_disconnect")
+	defer teardown()
+
+	hash1 := chainhash.Hash{0x01}
+	hash2 := chainhash.Hash{0x02}
+	hash3 := chainhash.Hash{0x03}
+	fetch := func(hash *chainhash.Hash) *BlockFeeTotals {
+		t.Helper()
+		var totals *BlockFeeTotals
+		err := db.View(func(dbTx database.Tx) error {
+			var err error
+			totals, err = dbFetchBlockFeeTotals(dbTx, hash)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("unexpected error fetching fee totals: %v", err)
+		}
+		return totals
+	}
+
+	// Connect three blocks where the first one has a parent without fee
+	// totals, such as is the case for databases that predate them.
+	err := db.Update(func(dbTx database.Tx) error {
+		err := dbPutBlockFeeTotals(dbTx, &hash1, &chainhash.Hash{}, 10,
+			wire.FeesByType{cointype.CoinTypeVAR: 100})
+		if err != nil {
+			return err
+		}
+		err = dbPutBlockFeeTotals(dbTx, &hash2, &hash1, 11,
+			wire.FeesByType{1: 700})
+		if err != nil {
+			return err
+		}
+		return dbPutBlockFeeTotals(dbTx, &hash3, &hash2, 12,
+			wire.FeesByType{cointype.CoinTypeVAR: 50, 1: 300})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error connecting fee totals: %v", err)
+	}
+
+	want := &BlockFeeTotals{
+		Height:      12,
+		StartHeight: 10,
+		Totals: []CoinTypeFeeTotal{
+			{CoinType: cointype.CoinTypeVAR, Fees: 50, CumulativeFees: 150},
+			{CoinType: 1, Fees: 300, CumulativeFees: 1000},
+		},
+	}
+	if got := fetch(&hash3); !reflect.DeepEqual(got, want) {
+		t.Fatalf("mismatched totals -- got %+v, want %+v", got, want)
+	}
+	want = &BlockFeeTotals{
+		Height:      11,
+		StartHeight: 10,
+		Totals: []CoinTypeFeeTotal{
+			{CoinType: cointype.CoinTypeVAR, Fees: 0, CumulativeFees: 100},
+			{CoinType: 1, Fees: 700, CumulativeFees: 700},
+		},
+	}
+	if got := fetch(&hash2); !reflect.DeepEqual(got, want) {
+		t.Fatalf("mismatched totals -- got %+v, want %+v", got, want)
+	}
+
+	// Disconnect the tip and ensure its totals are removed while the totals
+	// of its parent remain.
+	err = db.Update(func(dbTx database.Tx) error {
+		return dbRemoveBlockFeeTotals(dbTx, &hash3)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error disconnecting fee totals: %v", err)
+	}
+	if got := fetch(&hash3); got != nil {
+		t.Fatalf("fee totals for disconnected block still exist: %+v", got)
+	}
+	if got := fetch(&hash2); got == nil {
+		t.Fatal("fee totals for parent of disconnected block are missing")
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
@@ -80,6 +81,24 @@ type ChainQueryer interface {
 	// Returns (amount=0, height=0, index=0, spent=true) if the UTXO doesn't exist or is spent.
 	// Returns (amount>0, height>0, index>=0, spent=false) if the UTXO exists and is unspent.
 	FetchUtxoEntryDetails(outpoint wire.OutPoint) (amount int64, blockHeight int64, blockIndex uint32, spent bool, err error)
+
+	// FetchUtxoEntryDetailsBatch returns the details of each of the specified
+	// transaction outputs from the point of view of the main chain tip in the
+	// same order as the provided outpoints.  It is equivalent to calling
+	// FetchUtxoEntryDetails for every outpoint, however, all of them are
+	// resolved in a single pass so indexers that need the details of many
+	// outputs avoid repeated locking and database lookups.
+	FetchUtxoEntryDetailsBatch(outpoints []wire.OutPoint) ([]UtxoEntryDetails, error)
+}
+
+// UtxoEntryDetails houses the details of a transaction output returned by
+// FetchUtxoEntryDetailsBatch.  All other fields are zero when the output does
+// not exist or is spent.
+type UtxoEntryDetails struct {
+	Amount      int64
+	BlockHeight int64
+	BlockIndex  uint32
+	Spent       bool
 }
 
 // Indexer defines a generic interface for an indexer.
@@ -132,6 +151,31 @@ type IndexDropper interface {
 	DropIndex(context.Context, database.DB) error
 }
 
+// IndexMigration describes an in-place upgrade of the entries of an index from
+// the previous version to the version it targets.
+type IndexMigration struct {
+	// Version is the version of the index after the migration is applied.  It
+	// must be exactly one more than the version the migration upgrades from.
+	Version uint32
+
+	// Description is a short human-readable description of the migration that
+	// is logged when it is applied.
+	Description string
+
+	// Migrate performs the migration.  Since indexes can be massive, it
+	// should update the entries in multiple database transactions and must be
+	// safe to run again from the start should it be interrupted before it
+	// completes.
+	Migrate func(ctx context.Context, db database.DB) error
+}
+
+// IndexMigrator provides a method to obtain the migrations that upgrade the
+// entries of an index in place.  Indexers may implement this to avoid having
+// to be dropped and rebuilt from scratch when their format changes.
+type IndexMigrator interface {
+	Migrations() []IndexMigration
+}
+
 // AssertError identifies an error that indicates an internal code consistency
 // issue and should be treated as a critical and unrecoverable error.
 type AssertError string
@@ -218,6 +262,23 @@ func dbPutIndexerVersion(dbTx database.Tx, idxKey []byte, version uint32) error
 	return indexesBucket.Put(indexVersionKey(idxKey), serialized)
 }
 
+// dbFetchIndexerVersion uses an existing database transaction to retrieve the
+// version for the given index.  Indexes that predate versioning are treated as
+// version 1.
+func dbFetchIndexerVersion(dbTx database.Tx, idxKey []byte) (uint32, error) {
+	indexesBucket := dbTx.Metadata().Bucket(indexTipsBucketName)
+	serialized := indexesBucket.Get(indexVersionKey(idxKey))
+	if serialized == nil {
+		return 1, nil
+	}
+	if len(serialized) != 4 {
+		return 0, makeDbErr(database.ErrCorruption, fmt.Sprintf("unexpected "+
+			"version length for index %s. Got %d, expected 4", idxKey,
+			len(serialized)))
+	}
+	return byteOrder.Uint32(serialized), nil
+}
+
 // existsIndex returns whether the index keyed by idxKey exists in the database.
 func existsIndex(db database.DB, idxKey []byte) (bool, error) {
 	var exists bool
@@ -539,7 +600,12 @@ func finishDrop(ctx context.Context, indexer Indexer) error {
 	}
 
 	log.Infof("Resuming %s drop", indexer.Name())
+	return dropIndexer(ctx, indexer)
+}
 
+// dropIndexer drops the provided index from the database using the more
+// efficient method provided by the index when it implements IndexDropper.
+func dropIndexer(ctx context.Context, indexer Indexer) error {
 	switch d := indexer.(type) {
 	case IndexDropper:
 		err := d.DropIndex(ctx, indexer.DB())
@@ -598,12 +664,105 @@ func createIndex(indexer Indexer, genesisHash *chainhash.Hash) error {
 }
 
 // upgradeIndex determines if the provided index needs to be upgraded.
-// If it does it is dropped and recreated.
+//
+// Indexes that implement IndexMigrator are upgraded in place by applying each
+// of their migrations that targets a version newer than the stored version of
+// the index.  They are dropped and recreated when their migrations do not
+// provide a path to the current version.
 func upgradeIndex(ctx context.Context, indexer Indexer, genesisHash *chainhash.Hash) error {
 	if err := finishDrop(ctx, indexer); err != nil {
 		return err
 	}
-	return createIndex(indexer, genesisHash)
+	if err := createIndex(indexer, genesisHash); err != nil {
+		return err
+	}
+
+	migrator, ok := indexer.(IndexMigrator)
+	if !ok {
+		return nil
+	}
+	return migrateIndex(ctx, indexer, migrator.Migrations(), genesisHash)
+}
+
+// migrateIndex upgrades the provided index from its stored version to its
+// current version by applying the provided migrations in order.  The stored
+// version is updated after each migration completes so an interrupted upgrade
+// resumes from the first migration that has not been fully applied on the
+// next start.
+func migrateIndex(ctx context.Context, indexer Indexer, migrations []IndexMigration, genesisHash *chainhash.Hash) error {
+	var version uint32
+	err := indexer.DB().View(func(dbTx database.Tx) error {
+		var err error
+		version, err = dbFetchIndexerVersion(dbTx, indexer.Key())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Nothing to do when the index is already at the current version.
+	latest := indexer.Version()
+	if version == latest {
+		return nil
+	}
+	if version > latest {
+		str := fmt.Sprintf("the %s version %d is newer than the latest "+
+			"version %d supported by this software", indexer.Name(), version,
+			latest)
+		return indexerError(ErrUnsupportedIndexVersion, str)
+	}
+
+	// Drop and recreate the index when the migrations do not provide a
+	// contiguous path from the stored version to the current version.
+	hasPath := func() bool {
+		next := version + 1
+		for _, m := range migrations {
+			if m.Version == next {
+				next++
+			}
+		}
+		return next == latest+1
+	}
+	if !hasPath() {
+		log.Infof("No in-place upgrade of %s from version %d to %d is "+
+			"available.  Dropping it so it can be rebuilt", indexer.Name(),
+			version, latest)
+		if err := dropIndexer(ctx, indexer); err != nil {
+			return err
+		}
+		return createIndex(indexer, genesisHash)
+	}
+
+	for _, m := range migrations {
+		if m.Version <= version {
+			continue
+		}
+		if interruptRequested(ctx) {
+			return indexerError(ErrInterruptRequested, interruptMsg)
+		}
+
+		log.Infof("Upgrading %s to version %d (%s).  This might take a "+
+			"while...", indexer.Name(), m.Version, m.Description)
+		start := time.Now()
+		if err := m.Migrate(ctx, indexer.DB()); err != nil {
+			return err
+		}
+		if interruptRequested(ctx) {
+			return indexerError(ErrInterruptRequested, interruptMsg)
+		}
+
+		err := indexer.DB().Update(func(dbTx database.Tx) error {
+			return dbPutIndexerVersion(dbTx, indexer.Key(), m.Version)
+		})
+		if err != nil {
+			return err
+		}
+		version = m.Version
+		log.Infof("Upgraded %s to version %d in %v", indexer.Name(), m.Version,
+			time.Since(start).Round(time.Millisecond))
+	}
+
+	return nil
 }
 
 // maybeNotifySubscribers updates subscribers the index is synced when
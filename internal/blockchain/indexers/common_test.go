@@ -0,0 +1,183 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/database"
+)
+
+// TestMigrateIndex ensures indexes are upgraded in place by applying their
+// migrations, resume interrupted migrations, refuse versions newer than the
+// software supports, and are dropped and recreated when no migration path is
+// available.
+func TestMigrateIndex(t *testing.T) {
+	db := setupDB(t)
+
+	chain, err := newTestChain()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisHash := &chaincfg.SimNetParams().GenesisHash
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subber := NewIndexSubscriber(ctx)
+	go subber.Run(ctx)
+
+	idx, err := NewSSFeeIndex(subber, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// setVersion overrides the stored version of the index.
+	setVersion := func(version uint32) {
+		t.Helper()
+		err := db.Update(func(dbTx database.Tx) error {
+			return dbPutIndexerVersion(dbTx, idx.Key(), version)
+		})
+		if err != nil {
+			t.Fatalf("unable to set index version: %v", err)
+		}
+	}
+
+	// checkVersion ensures the stored version of the index is the expected
+	// value.
+	checkVersion := func(want uint32) {
+		t.Helper()
+		var version uint32
+		err := db.View(func(dbTx database.Tx) error {
+			var err error
+			version, err = dbFetchIndexerVersion(dbTx, idx.Key())
+			return err
+		})
+		if err != nil {
+			t.Fatalf("unable to fetch index version: %v", err)
+		}
+		if version != want {
+			t.Fatalf("mismatched index version: got %d, want %d", version,
+				want)
+		}
+	}
+
+	// Add an entry to the index that the migrations below rewrite so it is
+	// possible to tell whether or not they were applied.
+	entryKey := []byte("sfentry")
+	putEntry := func(dbTx database.Tx, value string) error {
+		return dbTx.Metadata().Bucket(ssfeeIndexKey).Put(entryKey, []byte(value))
+	}
+	fetchEntry := func() string {
+		t.Helper()
+		var value []byte
+		err := db.View(func(dbTx database.Tx) error {
+			bucket := dbTx.Metadata().Bucket(ssfeeIndexKey)
+			if bucket == nil {
+				return nil
+			}
+			value = bucket.Get(entryKey)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unable to fetch index entry: %v", err)
+		}
+		return string(value)
+	}
+	err = db.Update(func(dbTx database.Tx) error {
+		return putEntry(dbTx, "v0")
+	})
+	if err != nil {
+		t.Fatalf("unable to add index entry: %v", err)
+	}
+
+	var numRuns int
+	migrations := []IndexMigration{{
+		Version:     ssfeeIndexVersion,
+		Description: "rewrite test entry",
+		Migrate: func(ctx context.Context, db database.DB) error {
+			numRuns++
+			return db.Update(func(dbTx database.Tx) error {
+				return putEntry(dbTx, "v1")
+			})
+		},
+	}}
+
+	// Ensure an index that is already at the current version is not
+	// migrated.
+	err = migrateIndex(ctx, idx, migrations, genesisHash)
+	if err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+	if numRuns != 0 {
+		t.Fatalf("migration unexpectedly applied to current index")
+	}
+	checkVersion(ssfeeIndexVersion)
+
+	// Ensure an interrupted migration does not update the stored version so
+	// it is applied again on the next start.
+	setVersion(ssfeeIndexVersion - 1)
+	interruptCtx, interrupt := context.WithCancel(ctx)
+	interruptMigrations := []IndexMigration{{
+		Version:     ssfeeIndexVersion,
+		Description: "interrupted",
+		Migrate: func(ctx context.Context, db database.DB) error {
+			interrupt()
+			return nil
+		},
+	}}
+	err = migrateIndex(interruptCtx, idx, interruptMigrations, genesisHash)
+	if !errors.Is(err, ErrInterruptRequested) {
+		t.Fatalf("unexpected migration error: got %v, want %v", err,
+			ErrInterruptRequested)
+	}
+	checkVersion(ssfeeIndexVersion - 1)
+
+	// Ensure the migration is applied in place and the stored version is
+	// updated.
+	err = migrateIndex(ctx, idx, migrations, genesisHash)
+	if err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+	if numRuns != 1 {
+		t.Fatalf("unexpected number of migration runs: got %d, want 1",
+			numRuns)
+	}
+	if got := fetchEntry(); got != "v1" {
+		t.Fatalf("unexpected index entry: got %q, want %q", got, "v1")
+	}
+	checkVersion(ssfeeIndexVersion)
+
+	// Ensure a stored version newer than the software supports is rejected.
+	setVersion(ssfeeIndexVersion + 1)
+	err = migrateIndex(ctx, idx, migrations, genesisHash)
+	if !errors.Is(err, ErrUnsupportedIndexVersion) {
+		t.Fatalf("unexpected migration error: got %v, want %v", err,
+			ErrUnsupportedIndexVersion)
+	}
+
+	// Ensure the index is dropped and recreated at the current version when
+	// there is no migration path to it.
+	setVersion(ssfeeIndexVersion - 1)
+	err = migrateIndex(ctx, idx, nil, genesisHash)
+	if err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+	if got := fetchEntry(); got != "" {
+		t.Fatalf("unexpected index entry after drop: %q", got)
+	}
+	checkVersion(ssfeeIndexVersion)
+	tipHeight, tipHash, err := idx.Tip()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tipHeight != 0 || *tipHash != *genesisHash {
+		t.Fatalf("unexpected tip after drop: got %s (%d), want %s (0)",
+			tipHash, tipHeight, genesisHash)
+	}
+}
@@ -0,0 +1,693 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+const (
+	// emissionIndexName is the human-readable name for the index.
+	emissionIndexName = "ska emission index"
+
+	// emissionIndexVersion is the current version of the emission index.
+	//
+	// Version 2 additionally tracks the first transaction of each coin type.
+	emissionIndexVersion = 2
+
+	// emissionEntryFixedSize is the serialized size of an emission index
+	// entry excluding its outputs.
+	// Format: blockHash(32) + height(4) + txHash(32) + nonce(8) +
+	// numOutputs(2) = 78 bytes
+	emissionEntryFixedSize = 2*chainhash.HashSize + 14
+
+	// emissionOutputFixedSize is the serialized size of an emission output
+	// excluding its script.
+	// Format: index(4) + value(8) + version(2) + scriptLen(2) = 16 bytes
+	emissionOutputFixedSize = 16
+
+	// firstTxEntrySize is the serialized size of a first transaction entry.
+	// Format: blockHash(32) + height(4) + txHash(32) = 68 bytes
+	firstTxEntrySize = 2*chainhash.HashSize + 4
+
+	// emissionAuthNonceOffset is the offset of the nonce in the signature
+	// script of an SKA emission transaction after the SKA marker and the
+	// authorization version.
+	emissionAuthNonceOffset = 5
+
+	// emissionAuthVersion is the authorization version of SKA emission
+	// signature scripts that commit to a nonce.
+	emissionAuthVersion = 0x02
+)
+
+var (
+	// emissionIndexKey is the key of the emission index and the db bucket
+	// used to house it.
+	emissionIndexKey = []byte("emissionindex")
+
+	// firstTxKeyPrefix is the prefix of the keys in the emission index
+	// bucket that house the first transaction of each coin type.
+	firstTxKeyPrefix = []byte("firsttx")
+)
+
+// EmissionOutput houses an output of an SKA emission transaction tracked by
+// the emission index.
+type EmissionOutput struct {
+	Index    uint32
+	Value    int64
+	Version  uint16
+	PkScript []byte
+}
+
+// EmissionEntry houses an SKA emission of a coin type connected to the main
+// chain as tracked by the emission index.
+type EmissionEntry struct {
+	CoinType  cointype.CoinType
+	BlockHash chainhash.Hash
+	Height    int64
+	TxHash    chainhash.Hash
+	Nonce     uint64
+
+	// Outputs are the outputs of the emission transaction that pay the
+	// coin type.
+	Outputs []EmissionOutput
+}
+
+// FirstTxEntry houses the first transaction other than an SKA emission or a
+// coinbase that pays an output of a coin type in the main chain as tracked by
+// the emission index.
+type FirstTxEntry struct {
+	CoinType  cointype.CoinType
+	BlockHash chainhash.Hash
+	Height    int64
+	TxHash    chainhash.Hash
+}
+
+// EmissionIndex implements an index that tracks the SKA emission transactions
+// connected to the main chain by coin type so the emissions of a coin type can
+// be looked up without scanning the chain.  It also tracks the first regular
+// transaction of each SKA coin type so the height the coin type was first used
+// at can be looked up.
+//
+// Index Structure:
+//
+//	Key: coinType(1 byte)
+//	Value: Serialized list of the emission entries of the coin type ordered
+//	       by height
+//
+//	Key: "firsttx" + coinType(1 byte)
+//	Value: blockHash(32) + height(4) + txHash(32) of the first transaction
+//	       of the coin type
+//
+// The index is updated as blocks are connected and disconnected from the main
+// chain.
+type EmissionIndex struct {
+	// The following fields are set when the instance is created and can't
+	// be changed afterwards, so there is no need to protect them with a
+	// separate mutex.
+	db    database.DB
+	chain ChainQueryer
+	sub   *IndexSubscription
+
+	// subscribers is a map of clients that are waiting for the index to
+	// signal it has completed syncing.
+	subscribers map[chan bool]struct{}
+
+	// mtx protects concurrent access to the subscribers map.
+	mtx sync.Mutex
+
+	// cancel enables the caller to cancel long running operations.
+	cancel context.CancelFunc
+}
+
+// Ensure EmissionIndex implements the Indexer interface.
+var _ Indexer = (*EmissionIndex)(nil)
+
+// NewEmissionIndex returns a new instance of an indexer that tracks SKA
+// emission transactions by coin type.
+func NewEmissionIndex(subscriber *IndexSubscriber, db database.DB, chain ChainQueryer) (*EmissionIndex, error) {
+	idx := &EmissionIndex{
+		db:          db,
+		chain:       chain,
+		subscribers: make(map[chan bool]struct{}),
+		cancel:      subscriber.cancel,
+	}
+	sub, err := subscriber.Subscribe(idx, noPrereqs)
+	if err != nil {
+		return nil, err
+	}
+	idx.sub = sub
+	err = idx.Init(subscriber.ctx, chain.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Key returns the key of the index as a byte slice.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) Key() []byte {
+	return emissionIndexKey
+}
+
+// Name returns the human-readable name of the index.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) Name() string {
+	return emissionIndexName
+}
+
+// Version returns the current version of the index.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) Version() uint32 {
+	return emissionIndexVersion
+}
+
+// DB returns the database of the index.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) DB() database.DB {
+	return idx.db
+}
+
+// Queryer returns the chain queryer.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) Queryer() ChainQueryer {
+	return idx.chain
+}
+
+// Tip returns the current tip of the index.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) Tip() (int64, *chainhash.Hash, error) {
+	var height int64
+	var hash *chainhash.Hash
+	err := idx.db.View(func(dbTx database.Tx) error {
+		h, height32, err := dbFetchIndexerTip(dbTx, emissionIndexKey)
+		if err != nil {
+			return err
+		}
+		hash = h
+		height = int64(height32)
+		return nil
+	})
+	return height, hash, err
+}
+
+// Create is invoked when the indexer is being created.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) Create(dbTx database.Tx) error {
+	// Create the bucket that houses the index.
+	_, err := dbTx.Metadata().CreateBucketIfNotExists(emissionIndexKey)
+	return err
+}
+
+// Init is invoked when the index is being initialized.
+// This differs from the Create method in that it is called on
+// every load, including the case the index was just created.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) Init(ctx context.Context, chainParams *chaincfg.Params) error {
+	if interruptRequested(ctx) {
+		return indexerError(ErrInterruptRequested, interruptMsg)
+	}
+
+	// Finish any drops that were previously interrupted.
+	if err := finishDrop(ctx, idx); err != nil {
+		return err
+	}
+
+	// Create the initial state for the index as needed.
+	if err := createIndex(idx, &chainParams.GenesisHash); err != nil {
+		return err
+	}
+
+	// Upgrade the index in place as needed.
+	if err := upgradeIndex(ctx, idx, &chainParams.GenesisHash); err != nil {
+		return err
+	}
+
+	// Recover the emission index to the main chain if needed.
+	return recoverIndex(ctx, idx)
+}
+
+// Migrations returns the in-place upgrades of the index entries from each
+// prior version of the index.  The first transactions tracked by version 2
+// cannot be derived from the entries of version 1, so no migration is provided
+// and older versions of the index are dropped and rebuilt instead.
+//
+// This is part of the IndexMigrator interface.
+func (idx *EmissionIndex) Migrations() []IndexMigration {
+	return nil
+}
+
+// IndexSubscription returns the subscription for the index.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) IndexSubscription() *IndexSubscription {
+	return idx.sub
+}
+
+// WaitForSync subscribes clients for the next index sync update.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) WaitForSync() chan bool {
+	c := make(chan bool)
+	idx.mtx.Lock()
+	idx.subscribers[c] = struct{}{}
+	idx.mtx.Unlock()
+	return c
+}
+
+// NotifySyncSubscribers notifies all subscribers that the index has
+// completed syncing.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) NotifySyncSubscribers() {
+	idx.mtx.Lock()
+	notifySyncSubscribers(idx.subscribers)
+	idx.mtx.Unlock()
+}
+
+// ProcessNotification indexes the provided notification based on its
+// type.  This allows the index to stay synchronized with the chain.
+//
+// This is part of the Indexer interface.
+func (idx *EmissionIndex) ProcessNotification(dbTx database.Tx, ntfn *IndexNtfn) error {
+	switch ntfn.NtfnType {
+	case ConnectNtfn:
+		if err := idx.ConnectBlock(dbTx, ntfn.Block); err != nil {
+			return err
+		}
+
+	case DisconnectNtfn:
+		if err := idx.DisconnectBlock(dbTx, ntfn.Block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emissionNonce returns the nonce committed to by the authorization in the
+// provided signature script of an SKA emission transaction.  It returns one,
+// which is the nonce of the first emission of a coin type, when the script
+// does not commit to a nonce, which matches the nonce recorded in the emission
+// state of the chain.
+func emissionNonce(sigScript []byte) uint64 {
+	if len(sigScript) < emissionAuthNonceOffset+8 ||
+		sigScript[emissionAuthNonceOffset-1] != emissionAuthVersion {
+
+		return 1
+	}
+	return byteOrder.Uint64(sigScript[emissionAuthNonceOffset:])
+}
+
+// blockEmissions returns the emission entries for every coin type paid by the
+// SKA emission transactions in the provided block.
+func blockEmissions(block *dcrutil.Block) []EmissionEntry {
+	var entries []EmissionEntry
+	for _, tx := range block.Transactions() {
+		msgTx := tx.MsgTx()
+		if !wire.IsSKAEmissionTransaction(msgTx) {
+			continue
+		}
+
+		// Transactions may emit more than one coin type, so group the
+		// outputs by coin type while retaining their order.
+		nonce := emissionNonce(msgTx.TxIn[0].SignatureScript)
+		byCoinType := make(map[cointype.CoinType]int)
+		for i, txOut := range msgTx.TxOut {
+			entryIdx, ok := byCoinType[txOut.CoinType]
+			if !ok {
+				entryIdx = len(entries)
+				byCoinType[txOut.CoinType] = entryIdx
+				entries = append(entries, EmissionEntry{
+					CoinType:  txOut.CoinType,
+					BlockHash: *block.Hash(),
+					Height:    block.Height(),
+					TxHash:    *tx.Hash(),
+					Nonce:     nonce,
+				})
+			}
+			entries[entryIdx].Outputs = append(entries[entryIdx].Outputs,
+				EmissionOutput{
+					Index:    uint32(i),
+					Value:    txOut.Value,
+					Version:  txOut.Version,
+					PkScript: txOut.PkScript,
+				})
+		}
+	}
+	return entries
+}
+
+// blockFirstTxns returns the first transaction in the regular tree of the
+// provided block that pays an output of each SKA coin type, excluding the
+// coinbase and SKA emission transactions, in the order they appear.
+func blockFirstTxns(block *dcrutil.Block) []FirstTxEntry {
+	var entries []FirstTxEntry
+	var seen map[cointype.CoinType]struct{}
+	for i, tx := range block.Transactions() {
+		msgTx := tx.MsgTx()
+		if i == 0 || wire.IsSKAEmissionTransaction(msgTx) {
+			continue
+		}
+		for _, txOut := range msgTx.TxOut {
+			if !txOut.CoinType.IsSKA() {
+				continue
+			}
+			if _, ok := seen[txOut.CoinType]; ok {
+				continue
+			}
+			if seen == nil {
+				seen = make(map[cointype.CoinType]struct{})
+			}
+			seen[txOut.CoinType] = struct{}{}
+			entries = append(entries, FirstTxEntry{
+				CoinType:  txOut.CoinType,
+				BlockHash: *block.Hash(),
+				Height:    block.Height(),
+				TxHash:    *tx.Hash(),
+			})
+		}
+	}
+	return entries
+}
+
+// firstTxKey returns the key of the first transaction of the provided coin
+// type in the emission index bucket.
+func firstTxKey(coinType cointype.CoinType) []byte {
+	key := make([]byte, len(firstTxKeyPrefix)+1)
+	copy(key, firstTxKeyPrefix)
+	key[len(firstTxKeyPrefix)] = byte(coinType)
+	return key
+}
+
+// serializeFirstTxEntry serializes a first transaction entry into a byte
+// slice.  The coin type is not serialized since it is part of the key of the
+// entry.
+func serializeFirstTxEntry(entry *FirstTxEntry) []byte {
+	buf := make([]byte, firstTxEntrySize)
+	offset := copy(buf, entry.BlockHash[:])
+	byteOrder.PutUint32(buf[offset:], uint32(entry.Height))
+	offset += 4
+	copy(buf[offset:], entry.TxHash[:])
+	return buf
+}
+
+// deserializeFirstTxEntry deserializes a byte slice into a first transaction
+// entry of the provided coin type.
+//
+// See serializeFirstTxEntry for the serialization format.
+func deserializeFirstTxEntry(coinType cointype.CoinType, data []byte) (*FirstTxEntry, error) {
+	if len(data) != firstTxEntrySize {
+		return nil, fmt.Errorf("unexpected first transaction entry size "+
+			"%d (need %d)", len(data), firstTxEntrySize)
+	}
+	entry := &FirstTxEntry{CoinType: coinType}
+	offset := copy(entry.BlockHash[:], data)
+	entry.Height = int64(byteOrder.Uint32(data[offset:]))
+	offset += 4
+	copy(entry.TxHash[:], data[offset:])
+	return entry, nil
+}
+
+// serializeEmissionEntries serializes a list of emission index entries into a
+// byte slice.  The coin type is not serialized since it is the key of the
+// entries.
+//
+// Each entry is serialized as: blockHash(32) + height(4) + txHash(32) +
+// nonce(8) + numOutputs(2) + outputs, where each output is serialized as:
+// index(4) + value(8) + version(2) + scriptLen(2) + script(scriptLen)
+func serializeEmissionEntries(entries []EmissionEntry) ([]byte, error) {
+	size := 0
+	for i := range entries {
+		if len(entries[i].Outputs) > 0xffff {
+			return nil, fmt.Errorf("emission %v has %d outputs which exceeds "+
+				"the maximum of %d", entries[i].TxHash,
+				len(entries[i].Outputs), 0xffff)
+		}
+		size += emissionEntryFixedSize
+		for j := range entries[i].Outputs {
+			scriptLen := len(entries[i].Outputs[j].PkScript)
+			if scriptLen > 0xffff {
+				return nil, fmt.Errorf("emission %v output script length %d "+
+					"exceeds the maximum of %d", entries[i].TxHash,
+					scriptLen, 0xffff)
+			}
+			size += emissionOutputFixedSize + scriptLen
+		}
+	}
+
+	buf := make([]byte, size)
+	offset := 0
+	for i := range entries {
+		entry := &entries[i]
+		offset += copy(buf[offset:], entry.BlockHash[:])
+		byteOrder.PutUint32(buf[offset:], uint32(entry.Height))
+		offset += 4
+		offset += copy(buf[offset:], entry.TxHash[:])
+		byteOrder.PutUint64(buf[offset:], entry.Nonce)
+		offset += 8
+		byteOrder.PutUint16(buf[offset:], uint16(len(entry.Outputs)))
+		offset += 2
+		for j := range entry.Outputs {
+			out := &entry.Outputs[j]
+			byteOrder.PutUint32(buf[offset:], out.Index)
+			byteOrder.PutUint64(buf[offset+4:], uint64(out.Value))
+			byteOrder.PutUint16(buf[offset+12:], out.Version)
+			byteOrder.PutUint16(buf[offset+14:], uint16(len(out.PkScript)))
+			offset += emissionOutputFixedSize
+			offset += copy(buf[offset:], out.PkScript)
+		}
+	}
+
+	return buf, nil
+}
+
+// deserializeEmissionEntries deserializes a byte slice into a list of emission
+// index entries of the provided coin type.
+//
+// See serializeEmissionEntries for the serialization format.
+func deserializeEmissionEntries(coinType cointype.CoinType, data []byte) ([]EmissionEntry, error) {
+	var entries []EmissionEntry
+	for offset := 0; offset < len(data); {
+		if len(data[offset:]) < emissionEntryFixedSize {
+			return nil, fmt.Errorf("truncated emission entry at offset %d: "+
+				"%d bytes remaining (need at least %d)", offset,
+				len(data[offset:]), emissionEntryFixedSize)
+		}
+
+		entry := EmissionEntry{CoinType: coinType}
+		offset += copy(entry.BlockHash[:], data[offset:])
+		entry.Height = int64(byteOrder.Uint32(data[offset:]))
+		offset += 4
+		offset += copy(entry.TxHash[:], data[offset:offset+chainhash.HashSize])
+		entry.Nonce = byteOrder.Uint64(data[offset:])
+		offset += 8
+		numOutputs := int(byteOrder.Uint16(data[offset:]))
+		offset += 2
+
+		entry.Outputs = make([]EmissionOutput, 0, numOutputs)
+		for i := 0; i < numOutputs; i++ {
+			if len(data[offset:]) < emissionOutputFixedSize {
+				return nil, fmt.Errorf("truncated emission output at "+
+					"offset %d: %d bytes remaining (need at least %d)",
+					offset, len(data[offset:]), emissionOutputFixedSize)
+			}
+			out := EmissionOutput{
+				Index:   byteOrder.Uint32(data[offset:]),
+				Value:   int64(byteOrder.Uint64(data[offset+4:])),
+				Version: byteOrder.Uint16(data[offset+12:]),
+			}
+			scriptLen := int(byteOrder.Uint16(data[offset+14:]))
+			offset += emissionOutputFixedSize
+
+			if len(data[offset:]) < scriptLen {
+				return nil, fmt.Errorf("truncated emission output script: "+
+					"%d bytes remaining (need %d)", len(data[offset:]),
+					scriptLen)
+			}
+			out.PkScript = make([]byte, scriptLen)
+			offset += copy(out.PkScript, data[offset:offset+scriptLen])
+			entry.Outputs = append(entry.Outputs, out)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// ConnectBlock indexes all SKA emissions in the provided block by coin type.
+// This is called when a block is connected to the main chain.
+//
+// This is part of the Indexer interface implementation via ProcessNotification.
+func (idx *EmissionIndex) ConnectBlock(dbTx database.Tx, block *dcrutil.Block) error {
+	bucket := dbTx.Metadata().Bucket(emissionIndexKey)
+	if bucket == nil {
+		return fmt.Errorf("emission index bucket not found")
+	}
+
+	for _, emission := range blockEmissions(block) {
+		key := []byte{byte(emission.CoinType)}
+		entries, err := deserializeEmissionEntries(emission.CoinType,
+			bucket.Get(key))
+		if err != nil {
+			return fmt.Errorf("failed to deserialize existing entries: %w", err)
+		}
+		entries = append(entries, emission)
+		serialized, err := serializeEmissionEntries(entries)
+		if err != nil {
+			return fmt.Errorf("failed to serialize entries: %w", err)
+		}
+		if err := bucket.Put(key, serialized); err != nil {
+			return fmt.Errorf("failed to store entries: %w", err)
+		}
+
+		log.Debugf("EmissionIndex: Indexed %v emission %v in block %s "+
+			"(height %d)", emission.CoinType, emission.TxHash, block.Hash(),
+			block.Height())
+	}
+
+	// Record the first transaction of each coin type that has not been used
+	// in an earlier block.
+	for _, first := range blockFirstTxns(block) {
+		key := firstTxKey(first.CoinType)
+		if bucket.Get(key) != nil {
+			continue
+		}
+		if err := bucket.Put(key, serializeFirstTxEntry(&first)); err != nil {
+			return fmt.Errorf("failed to store first transaction: %w", err)
+		}
+	}
+
+	// Update the current index tip.
+	return dbPutIndexerTip(dbTx, emissionIndexKey, block.Hash(),
+		int32(block.Height()))
+}
+
+// DisconnectBlock removes all SKA emissions in the provided block from the
+// index.  This is called when a block is disconnected from the main chain.
+//
+// This is part of the Indexer interface implementation via ProcessNotification.
+func (idx *EmissionIndex) DisconnectBlock(dbTx database.Tx, block *dcrutil.Block) error {
+	bucket := dbTx.Metadata().Bucket(emissionIndexKey)
+	if bucket == nil {
+		return fmt.Errorf("emission index bucket not found")
+	}
+
+	for _, emission := range blockEmissions(block) {
+		key := []byte{byte(emission.CoinType)}
+		entries, err := deserializeEmissionEntries(emission.CoinType,
+			bucket.Get(key))
+		if err != nil {
+			return fmt.Errorf("failed to deserialize existing entries: %w", err)
+		}
+
+		filtered := entries[:0]
+		for _, entry := range entries {
+			if entry.TxHash != emission.TxHash {
+				filtered = append(filtered, entry)
+			}
+		}
+
+		// If no emissions remain, delete the key.
+		if len(filtered) == 0 {
+			if err := bucket.Delete(key); err != nil {
+				return fmt.Errorf("failed to delete key: %w", err)
+			}
+			continue
+		}
+		serialized, err := serializeEmissionEntries(filtered)
+		if err != nil {
+			return fmt.Errorf("failed to serialize entries: %w", err)
+		}
+		if err := bucket.Put(key, serialized); err != nil {
+			return fmt.Errorf("failed to store entries: %w", err)
+		}
+	}
+
+	// Remove the first transaction of each coin type that was first used in
+	// the block.
+	for _, first := range blockFirstTxns(block) {
+		key := firstTxKey(first.CoinType)
+		serialized := bucket.Get(key)
+		if serialized == nil {
+			continue
+		}
+		entry, err := deserializeFirstTxEntry(first.CoinType, serialized)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize first transaction: %w",
+				err)
+		}
+		if entry.BlockHash != *block.Hash() {
+			continue
+		}
+		if err := bucket.Delete(key); err != nil {
+			return fmt.Errorf("failed to delete key: %w", err)
+		}
+	}
+
+	// Update the current index tip.
+	return dbPutIndexerTip(dbTx, emissionIndexKey,
+		&block.MsgBlock().Header.PrevBlock, int32(block.Height()-1))
+}
+
+// Emissions returns the SKA emissions of the provided coin type connected to
+// the main chain as of the current index tip ordered by height.  No entries are
+// returned when the coin type has not been emitted.
+//
+// This function is safe for concurrent access.
+func (idx *EmissionIndex) Emissions(coinType cointype.CoinType) ([]EmissionEntry, error) {
+	var entries []EmissionEntry
+	err := idx.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(emissionIndexKey)
+		if bucket == nil {
+			return fmt.Errorf("emission index bucket not found")
+		}
+		var err error
+		entries, err = deserializeEmissionEntries(coinType,
+			bucket.Get([]byte{byte(coinType)}))
+		return err
+	})
+	return entries, err
+}
+
+// FirstTransaction returns the first transaction other than an SKA emission or
+// a coinbase that pays an output of the provided coin type in the main chain as
+// of the current index tip.  It returns nil when no such transaction exists.
+//
+// This function is safe for concurrent access.
+func (idx *EmissionIndex) FirstTransaction(coinType cointype.CoinType) (*FirstTxEntry, error) {
+	var entry *FirstTxEntry
+	err := idx.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(emissionIndexKey)
+		if bucket == nil {
+			return fmt.Errorf("emission index bucket not found")
+		}
+		serialized := bucket.Get(firstTxKey(coinType))
+		if serialized == nil {
+			return nil
+		}
+		var err error
+		entry, err = deserializeFirstTxEntry(coinType, serialized)
+		return err
+	})
+	return entry, err
+}
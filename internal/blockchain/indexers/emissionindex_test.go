@@ -0,0 +1,259 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"context"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// newEmissionTx returns an SKA emission transaction that commits to the
+// provided nonce and pays the provided outputs.
+func newEmissionTx(nonce uint64, outs ...*wire.TxOut) *wire.MsgTx {
+	sigScript := make([]byte, 64)
+	copy(sigScript, []byte{0x01, 0x53, 0x4b, 0x41, emissionAuthVersion})
+	binary.LittleEndian.PutUint64(sigScript[emissionAuthNonceOffset:], nonce)
+
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  sigScript,
+	})
+	for _, out := range outs {
+		tx.AddTxOut(out)
+	}
+	return tx
+}
+
+// TestSerializeDeserializeEmissionEntries ensures emission index entries round
+// trip through serialization and that truncated data is rejected.
+func TestSerializeDeserializeEmissionEntries(t *testing.T) {
+	t.Parallel()
+
+	entries := []EmissionEntry{{
+		CoinType:  1,
+		BlockHash: chainhash.Hash{0x01},
+		Height:    100,
+		TxHash:    chainhash.Hash{0x02},
+		Nonce:     1,
+		Outputs: []EmissionOutput{{
+			Index:    0,
+			Value:    5000,
+			PkScript: []byte{0x76, 0xa9},
+		}, {
+			Index:    2,
+			Value:    7000,
+			Version:  1,
+			PkScript: []byte{},
+		}},
+	}, {
+		CoinType:  1,
+		BlockHash: chainhash.Hash{0x03},
+		Height:    5000,
+		TxHash:    chainhash.Hash{0x04},
+		Nonce:     2,
+		Outputs:   []EmissionOutput{},
+	}}
+	serialized, err := serializeEmissionEntries(entries)
+	if err != nil {
+		t.Fatalf("unexpected serialize error: %v", err)
+	}
+	got, err := deserializeEmissionEntries(1, serialized)
+	if err != nil {
+		t.Fatalf("unexpected deserialize error: %v", err)
+	}
+	if !reflect.DeepEqual(got, entries) {
+		t.Fatalf("mismatched entries -- got %+v, want %+v", got, entries)
+	}
+
+	for _, bad := range [][]byte{serialized[:emissionEntryFixedSize-1],
+		serialized[:emissionEntryFixedSize+emissionOutputFixedSize-1],
+		serialized[:emissionEntryFixedSize+emissionOutputFixedSize+1]} {
+
+		if _, err := deserializeEmissionEntries(1, bad); err == nil {
+			t.Fatalf("deserializing %x did not fail", bad)
+		}
+	}
+}
+
+// newTransferTx returns a transaction that spends a regular output and pays
+// the provided outputs.
+func newTransferTx(outs ...*wire.TxOut) *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{0x01}},
+	})
+	for _, out := range outs {
+		tx.AddTxOut(out)
+	}
+	return tx
+}
+
+// TestEmissionIndexConnectDisconnect ensures emissions and the first
+// transaction of each coin type are indexed when blocks are connected and
+// removed when they are disconnected.
+func TestEmissionIndexConnectDisconnect(t *testing.T) {
+	db := setupDB(t)
+	chain, err := newTestChain()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subber := NewIndexSubscriber(ctx)
+	go subber.Run(ctx)
+
+	idx, err := NewEmissionIndex(subber, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a block with a coinbase, an emission of two coin types, and a
+	// transfer of one of them and a second block with another emission of
+	// one of them and transfers of both.
+	coinbase := wire.NewMsgTx()
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+	})
+	coinbase.AddTxOut(&wire.TxOut{Value: 1000})
+	coinbase.AddTxOut(&wire.TxOut{Value: 10, CoinType: 3})
+	transfer1 := newTransferTx(&wire.TxOut{Value: 50, CoinType: 2})
+	transfer2 := newTransferTx(&wire.TxOut{Value: 60},
+		&wire.TxOut{Value: 70, CoinType: 1})
+	transfer3 := newTransferTx(&wire.TxOut{Value: 80, CoinType: 1},
+		&wire.TxOut{Value: 90, CoinType: 2})
+	emission1 := newEmissionTx(1,
+		&wire.TxOut{Value: 100, CoinType: 1, PkScript: []byte{0x01}},
+		&wire.TxOut{Value: 200, CoinType: 2, PkScript: []byte{0x02}},
+		&wire.TxOut{Value: 300, CoinType: 1, PkScript: []byte{0x03}})
+	block1 := dcrutil.NewBlock(&wire.MsgBlock{
+		Header:       wire.BlockHeader{Height: 10},
+		Transactions: []*wire.MsgTx{coinbase, emission1, transfer1},
+	})
+	emission2 := newEmissionTx(2,
+		&wire.TxOut{Value: 400, CoinType: 1, PkScript: []byte{0x04}})
+	block2 := dcrutil.NewBlock(&wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Height:    11,
+			PrevBlock: *block1.Hash(),
+		},
+		Transactions: []*wire.MsgTx{coinbase, emission2, transfer2,
+			transfer3},
+	})
+
+	err = db.Update(func(dbTx database.Tx) error {
+		if err := idx.ConnectBlock(dbTx, block1); err != nil {
+			return err
+		}
+		return idx.ConnectBlock(dbTx, block2)
+	})
+	if err != nil {
+		t.Fatalf("unexpected connect error: %v", err)
+	}
+
+	wantSKA1 := []EmissionEntry{{
+		CoinType:  1,
+		BlockHash: *block1.Hash(),
+		Height:    10,
+		TxHash:    emission1.TxHash(),
+		Nonce:     1,
+		Outputs: []EmissionOutput{
+			{Index: 0, Value: 100, PkScript: []byte{0x01}},
+			{Index: 2, Value: 300, PkScript: []byte{0x03}},
+		},
+	}, {
+		CoinType:  1,
+		BlockHash: *block2.Hash(),
+		Height:    11,
+		TxHash:    emission2.TxHash(),
+		Nonce:     2,
+		Outputs: []EmissionOutput{
+			{Index: 0, Value: 400, PkScript: []byte{0x04}},
+		},
+	}}
+	wantSKA2 := []EmissionEntry{{
+		CoinType:  2,
+		BlockHash: *block1.Hash(),
+		Height:    10,
+		TxHash:    emission1.TxHash(),
+		Nonce:     1,
+		Outputs: []EmissionOutput{
+			{Index: 1, Value: 200, PkScript: []byte{0x02}},
+		},
+	}}
+	checkEmissions := func(coinType cointype.CoinType, want []EmissionEntry) {
+		t.Helper()
+		got, err := idx.Emissions(coinType)
+		if err != nil {
+			t.Fatalf("unexpected lookup error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("mismatched %v emissions -- got %+v, want %+v",
+				coinType, got, want)
+		}
+	}
+	checkFirstTx := func(coinType cointype.CoinType, want *FirstTxEntry) {
+		t.Helper()
+		got, err := idx.FirstTransaction(coinType)
+		if err != nil {
+			t.Fatalf("unexpected lookup error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("mismatched %v first transaction -- got %+v, want %+v",
+				coinType, got, want)
+		}
+	}
+	wantFirst1 := &FirstTxEntry{
+		CoinType:  1,
+		BlockHash: *block2.Hash(),
+		Height:    11,
+		TxHash:    transfer2.TxHash(),
+	}
+	wantFirst2 := &FirstTxEntry{
+		CoinType:  2,
+		BlockHash: *block1.Hash(),
+		Height:    10,
+		TxHash:    transfer1.TxHash(),
+	}
+	checkEmissions(1, wantSKA1)
+	checkEmissions(2, wantSKA2)
+	checkEmissions(3, nil)
+	checkFirstTx(1, wantFirst1)
+	checkFirstTx(2, wantFirst2)
+	checkFirstTx(3, nil)
+
+	// Disconnect the second block and ensure only its emission is removed.
+	err = db.Update(func(dbTx database.Tx) error {
+		return idx.DisconnectBlock(dbTx, block2)
+	})
+	if err != nil {
+		t.Fatalf("unexpected disconnect error: %v", err)
+	}
+	checkEmissions(1, wantSKA1[:1])
+	checkEmissions(2, wantSKA2)
+	checkFirstTx(1, nil)
+	checkFirstTx(2, wantFirst2)
+
+	// Disconnect the first block and ensure all emissions are removed.
+	err = db.Update(func(dbTx database.Tx) error {
+		return idx.DisconnectBlock(dbTx, block1)
+	})
+	if err != nil {
+		t.Fatalf("unexpected disconnect error: %v", err)
+	}
+	checkEmissions(1, nil)
+	checkEmissions(2, nil)
+	checkFirstTx(2, nil)
+}
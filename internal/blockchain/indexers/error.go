@@ -42,6 +42,14 @@ const (
 	// ErrBlockNotOnMainChain indicates the provided block is not on the
 	// main chain.
 	ErrBlockNotOnMainChain = ErrorKind("ErrBlockNotOnMainChain")
+
+	// ErrUnsupportedIndexVersion indicates the stored version of an index is
+	// newer than the version supported by the software.
+	ErrUnsupportedIndexVersion = ErrorKind("ErrUnsupportedIndexVersion")
+
+	// ErrAddressNotWatched indicates an address is not watched by the
+	// watch-only index.
+	ErrAddressNotWatched = ErrorKind("ErrAddressNotWatched")
 )
 
 // Error satisfies the error interface and prints human-readable errors.
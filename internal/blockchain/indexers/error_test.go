@@ -26,6 +26,8 @@ func TestErrorKindStringer(t *testing.T) {
 		{ErrFetchTip, "ErrFetchTip"},
 		{ErrMissingNotification, "ErrMissingNotification"},
 		{ErrBlockNotOnMainChain, "ErrBlockNotOnMainChain"},
+		{ErrUnsupportedIndexVersion, "ErrUnsupportedIndexVersion"},
+		{ErrAddressNotWatched, "ErrAddressNotWatched"},
 	}
 
 	for i, test := range tests {
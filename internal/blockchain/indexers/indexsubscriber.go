@@ -7,10 +7,12 @@ package indexers
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/database"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/internal/blockchain/progresslog"
@@ -39,6 +41,11 @@ var (
 	// syncUpdateInterval is the time between periodically checking indexes
 	// and notifying their synchronization subscribers if synced.
 	syncUpdateInterval = time.Millisecond * 500
+
+	// indexTimeWeight is the inverse of the weight given to the time taken to
+	// index the most recent block in the moving average used to estimate the
+	// remaining sync time of the indexes.
+	indexTimeWeight = 8
 )
 
 // IndexNtfn represents an index notification detailing a block connection
@@ -129,6 +136,35 @@ type IndexSubscriber struct {
 	ctx           context.Context
 	cancel        context.CancelFunc
 	quit          chan struct{}
+
+	// avgIndexTime is the moving average of the time taken to index a block
+	// by all subscribed indexes.  It is protected by the timing mutex.
+	timingMtx    sync.Mutex
+	avgIndexTime time.Duration
+}
+
+// IndexSyncStatus describes the sync progress of an index.
+type IndexSyncStatus struct {
+	// Name and Version are the human-readable name and the current version of
+	// the index.
+	Name    string
+	Version uint32
+
+	// TipHeight and TipHash identify the block the index is synced to.
+	TipHeight int64
+	TipHash   chainhash.Hash
+
+	// BestHeight is the height of the current best block of the main chain.
+	BestHeight int64
+
+	// Synced indicates whether or not the index is synced to the current best
+	// block of the main chain.
+	Synced bool
+
+	// EstimatedRemaining is the estimated time until the index is synced
+	// based on the time recently taken to index blocks.  It is zero when the
+	// index is synced or no blocks have been indexed yet.
+	EstimatedRemaining time.Duration
 }
 
 // NewIndexSubscriber creates a new index subscriber. It also starts the
@@ -241,6 +277,81 @@ func (s *IndexSubscriber) findLowestIndexTipHeight(queryer ChainQueryer) (int64,
 	return lowestHeight, bestHeight, nil
 }
 
+// recordIndexTime updates the moving average of the time taken to index a
+// block with the provided duration.
+func (s *IndexSubscriber) recordIndexTime(d time.Duration) {
+	s.timingMtx.Lock()
+	if s.avgIndexTime == 0 {
+		s.avgIndexTime = d
+	} else {
+		s.avgIndexTime += (d - s.avgIndexTime) / time.Duration(indexTimeWeight)
+	}
+	s.timingMtx.Unlock()
+}
+
+// SyncStatus returns the sync progress of all subscribed indexes, including
+// their dependents, ordered by name.
+//
+// This function is safe for concurrent access.
+func (s *IndexSubscriber) SyncStatus() ([]IndexSyncStatus, error) {
+	s.timingMtx.Lock()
+	avgIndexTime := s.avgIndexTime
+	s.timingMtx.Unlock()
+
+	s.mtx.Lock()
+	var idxs []Indexer
+	for _, sub := range s.subscriptions {
+		for dependent := sub; dependent != nil; dependent = dependent.dependent {
+			idxs = append(idxs, dependent.idx)
+		}
+	}
+	s.mtx.Unlock()
+
+	statuses := make([]IndexSyncStatus, 0, len(idxs))
+	for _, idx := range idxs {
+		tipHeight, tipHash, err := idx.Tip()
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to fetch index tip: %w",
+				idx.Name(), err)
+		}
+		bestHeight, bestHash := idx.Queryer().Best()
+		status := IndexSyncStatus{
+			Name:       idx.Name(),
+			Version:    idx.Version(),
+			TipHeight:  tipHeight,
+			TipHash:    *tipHash,
+			BestHeight: bestHeight,
+			Synced:     tipHeight == bestHeight && *tipHash == *bestHash,
+		}
+		if remaining := bestHeight - tipHeight; remaining > 0 {
+			status.EstimatedRemaining = time.Duration(remaining) * avgIndexTime
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses, nil
+}
+
+// WaitForSync subscribes clients for the next sync update of the subscribed
+// index with the provided name.  False is returned when there is no such
+// index.
+//
+// This function is safe for concurrent access.
+func (s *IndexSubscriber) WaitForSync(name string) (chan bool, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	for _, sub := range s.subscriptions {
+		for dependent := sub; dependent != nil; dependent = dependent.dependent {
+			if dependent.idx.Name() == name {
+				return dependent.idx.WaitForSync(), true
+			}
+		}
+	}
+	return nil, false
+}
+
 // CatchUp syncs all subscribed indexes to the main chain by connecting blocks
 // from after the lowest index tip to the current main chain tip.
 //
@@ -282,6 +393,7 @@ func (s *IndexSubscriber) CatchUp(ctx context.Context, _ database.DB, queryer Ch
 			return indexerError(ErrBlockNotOnMainChain, msg)
 		}
 
+		start := time.Now()
 		var parent *dcrutil.Block
 		if cachedParent == nil && height > 0 {
 			parentHash, err := queryer.BlockHashByHeight(height - 1)
@@ -324,6 +436,7 @@ func (s *IndexSubscriber) CatchUp(ctx context.Context, _ database.DB, queryer Ch
 		}
 
 		cachedParent = child
+		s.recordIndexTime(time.Since(start))
 
 		progressLogger.LogBlockHeight(child.MsgBlock())
 	}
@@ -369,6 +482,7 @@ func (s *IndexSubscriber) handleIndexUpdates(ctx context.Context) {
 
 		case ntfn := <-s.c:
 			// Relay the index update to subscribed indexes.
+			start := time.Now()
 			s.mtx.Lock()
 			for _, sub := range s.subscriptions {
 				err := updateIndex(ctx, sub.idx, &ntfn)
@@ -379,6 +493,9 @@ func (s *IndexSubscriber) handleIndexUpdates(ctx context.Context) {
 				}
 			}
 			s.mtx.Unlock()
+			if ntfn.NtfnType == ConnectNtfn {
+				s.recordIndexTime(time.Since(start))
+			}
 
 			if ntfn.Done != nil {
 				close(ntfn.Done)
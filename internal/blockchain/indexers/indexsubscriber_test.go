@@ -191,3 +191,87 @@ func TestIndexSubscriberAsync(t *testing.T) {
 			existsAddrIdxTipHash)
 	}
 }
+
+// TestIndexSubscriberSyncStatus ensures the index subscriber reports the sync
+// progress of its subscribed indexes.
+func TestIndexSubscriberSyncStatus(t *testing.T) {
+	db := setupDB(t)
+
+	chain, err := newTestChain()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := chaingen.MakeGenerator(chaincfg.SimNetParams())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	addBlock(t, chain, &g, "bk1")
+	bk2 := addBlock(t, chain, &g, "bk2")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subber := NewIndexSubscriber(ctx)
+	txIdx, err := NewTxIndex(subber, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	existsAddrIdx, err := NewExistsAddrIndex(subber, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = subber.CatchUp(ctx, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure all indexes are reported as synced to the current chain tip.
+	statuses, err := subber.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("expected 2 index statuses, got %d", len(statuses))
+	}
+	if statuses[0].Name > statuses[1].Name {
+		t.Fatalf("index statuses are not ordered by name: %q > %q",
+			statuses[0].Name, statuses[1].Name)
+	}
+	for _, status := range statuses {
+		if !status.Synced || status.TipHeight != bk2.Height() ||
+			status.TipHash != *bk2.Hash() ||
+			status.BestHeight != bk2.Height() ||
+			status.EstimatedRemaining != 0 {
+
+			t.Fatalf("unexpected synced status: %+v", status)
+		}
+	}
+
+	// Ensure the indexes are reported as lagging with an estimated remaining
+	// sync time once a block is connected to the chain without being indexed.
+	bk3 := addBlock(t, chain, &g, "bk3")
+	statuses, err = subber.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range statuses {
+		if status.Synced || status.TipHeight != bk2.Height() ||
+			status.BestHeight != bk3.Height() ||
+			status.EstimatedRemaining <= 0 {
+
+			t.Fatalf("unexpected lagging status: %+v", status)
+		}
+	}
+
+	// Ensure sync subscriptions are only available for subscribed indexes.
+	for _, name := range []string{txIdx.Name(), existsAddrIdx.Name()} {
+		if _, ok := subber.WaitForSync(name); !ok {
+			t.Fatalf("no sync subscription for %q", name)
+		}
+	}
+	if _, ok := subber.WaitForSync("unknown index"); ok {
+		t.Fatal("sync subscription for unknown index")
+	}
+}
@@ -5,7 +5,9 @@
 package indexers
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"sync"
 
@@ -15,7 +17,6 @@ import (
 	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/database"
 	"github.com/monetarium/monetarium-node/dcrutil"
-	"github.com/monetarium/monetarium-node/txscript"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -24,18 +25,59 @@ const (
 	ssfeeIndexName = "ssfee utxo index"
 
 	// ssfeeIndexVersion is the current version of the SSFee UTXO index.
-	ssfeeIndexVersion = 1
+	//
+	// Version 2 caches the amount, fraud proof data, and script of each
+	// output alongside its outpoint.
+	//
+	// Version 3 adds the script class discriminator to the keys so outputs
+	// paying to P2SH addresses are indexed alongside P2PKH ones.
+	//
+	// Version 4 records the consolidation events of each coin type per block.
+	ssfeeIndexVersion = 4
 
 	// ssfeeKeyPrefix is the prefix used for all SSFee index keys.
 	ssfeeKeyPrefix = "sf"
 
 	// ssfeeKeySize is the total size of an SSFee index key.
+	// Format: prefix(2) + coinType(1) + scriptClass(1) +
+	// addressHash160(20) = 24 bytes
+	ssfeeKeySize = 24
+
+	// ssfeeV2KeySize is the size of an SSFee index key prior to version 3,
+	// which did not include the script class since only P2PKH outputs were
+	// indexed.
 	// Format: prefix(2) + coinType(1) + addressHash160(20) = 23 bytes
-	ssfeeKeySize = 23
+	ssfeeV2KeySize = 23
 
 	// outpointSize is the serialized size of a wire.OutPoint.
 	// Format: hash(32) + index(4) + tree(1) = 37 bytes
 	outpointSize = 37
+
+	// ssfeeEntryFixedSize is the serialized size of an SSFee index entry
+	// excluding its script.
+	// Format: outpoint(37) + amount(8) + blockHeight(4) + blockIndex(4) +
+	// scriptLen(1) = 54 bytes
+	ssfeeEntryFixedSize = outpointSize + 17
+
+	// ssfeeConsolidationKeyPrefix is the prefix used for the keys of the
+	// consolidation events recorded by the index.
+	ssfeeConsolidationKeyPrefix = "sc"
+
+	// ssfeeConsolidationKeySize is the total size of a consolidation event
+	// key.  The height is serialized big endian so the events of a coin type
+	// are ordered by height.
+	// Format: prefix(2) + coinType(1) + height(4) = 7 bytes
+	ssfeeConsolidationKeySize = 7
+
+	// ssfeeConsolidationValueSize is the serialized size of a consolidation
+	// event.
+	// Format: outputs(4) + consolidated(4) = 8 bytes
+	ssfeeConsolidationValueSize = 8
+
+	// ssfeeConsolidationMigrationBatch is the number of blocks whose
+	// consolidation events are recorded per database transaction when
+	// upgrading the index to version 4.
+	ssfeeConsolidationMigrationBatch = 2000
 )
 
 var (
@@ -44,16 +86,50 @@ var (
 	ssfeeIndexKey = []byte("ssfeeindex")
 )
 
+// ssfeeEntry houses an SSFee output tracked by the index along with the
+// details needed to spend it so lookups do not need to consult the utxo set.
+type ssfeeEntry struct {
+	outpoint    wire.OutPoint
+	amount      int64
+	blockHeight uint32
+	blockIndex  uint32
+	pkScript    []byte
+}
+
+// SSFeeConsolidationEvent describes the SSFee outputs of a coin type created by
+// a block along with how many of them consolidated an existing SSFee output by
+// spending it rather than creating a new UTXO.  The number of SSFee UTXOs of
+// the coin type grows by the difference between the two.
+type SSFeeConsolidationEvent struct {
+	CoinType     cointype.CoinType
+	Height       int64
+	Outputs      uint32
+	Consolidated uint32
+}
+
+// Growth returns the number of SSFee UTXOs of the coin type added by the block.
+func (e *SSFeeConsolidationEvent) Growth() int64 {
+	return int64(e.Outputs) - int64(e.Consolidated)
+}
+
 // SSFeeIndex implements an index that tracks SSFee (Stake Fee) transaction outputs
-// by (coinType, address) for efficient UTXO lookup during block template generation.
+// by (coinType, scriptClass, address) for efficient UTXO lookup during block
+// template generation.
 //
 // This index enables UTXO augmentation, where SSFee transactions can reuse existing
 // UTXOs as inputs instead of creating new dust UTXOs for each fee distribution.
 //
 // Index Structure:
 //
-//	Key: "sf" + coinType(1 byte) + addressHash160(20 bytes)
-//	Value: Serialized list of OutPoint structs pointing to unspent SSFee outputs
+//	Key: "sf" + coinType(1 byte) + scriptClass(1 byte) + addressHash160(20 bytes)
+//	Value: Serialized list of entries that house the outpoint, amount, fraud
+//	       proof data, and script of each SSFee output
+//
+// The consolidation events of each block are recorded alongside them so the
+// UTXO growth from fee distribution can be monitored:
+//
+//	Key: "sc" + coinType(1 byte) + height(4 bytes)
+//	Value: outputs(4 bytes) + consolidated(4 bytes)
 //
 // The index is updated as blocks are connected and disconnected from the main chain.
 type SSFeeIndex struct {
@@ -79,7 +155,7 @@ type SSFeeIndex struct {
 var _ Indexer = (*SSFeeIndex)(nil)
 
 // NewSSFeeIndex returns a new instance of an indexer that tracks SSFee outputs
-// by (coinType, address) for efficient UTXO lookup.
+// by (coinType, scriptClass, address) for efficient UTXO lookup.
 func NewSSFeeIndex(subscriber *IndexSubscriber, db database.DB, chain ChainQueryer) (*SSFeeIndex, error) {
 	idx := &SSFeeIndex{
 		db:          db,
@@ -152,6 +228,283 @@ func (idx *SSFeeIndex) Tip() (int64, *chainhash.Hash, error) {
 	return height, hash, err
 }
 
+// Migrations returns the in-place upgrades of the index entries from each
+// prior version of the index.  Every format change of the index must be
+// accompanied by a migration here so existing databases are upgraded without
+// having to drop and rebuild the index.
+//
+// This is part of the IndexMigrator interface.
+func (idx *SSFeeIndex) Migrations() []IndexMigration {
+	return []IndexMigration{{
+		Version:     2,
+		Description: "cache output details",
+		Migrate:     idx.migrateToCachedOutputs,
+	}, {
+		Version:     3,
+		Description: "add script class to keys",
+		Migrate:     idx.migrateToScriptClassKeys,
+	}, {
+		Version:     4,
+		Description: "record consolidation events",
+		Migrate:     idx.migrateToConsolidationEvents,
+	}}
+}
+
+// migrateToCachedOutputs upgrades the index entries from version 1, which only
+// house the outpoint of each output, to version 2, which also caches the
+// amount, fraud proof data, and script of each output.  Outputs that are
+// already spent are removed since they can never be used for augmentation.
+//
+// The details of all outputs are loaded before any entries are modified and
+// the entries are then rewritten in a single database transaction, so an
+// interrupted migration is simply run again from the start.
+func (idx *SSFeeIndex) migrateToCachedOutputs(ctx context.Context, db database.DB) error {
+	type v1Entry struct {
+		key       []byte
+		outpoints []wire.OutPoint
+	}
+	var v1Entries []v1Entry
+	err := db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(ssfeeIndexKey)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			outpoints, err := deserializeOutPoints(v)
+			if err != nil {
+				return err
+			}
+			key := append([]byte(nil), k...)
+			v1Entries = append(v1Entries, v1Entry{key, outpoints})
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	// Load the details of every unspent output from the chain.  The blocks
+	// are cached since outputs of the same block are typically grouped.
+	blocks := make(map[int64]*dcrutil.Block)
+	entries := make([][]ssfeeEntry, len(v1Entries))
+	var numOutputs, numCached int
+	for i, v1 := range v1Entries {
+		if interruptRequested(ctx) {
+			return indexerError(ErrInterruptRequested, interruptMsg)
+		}
+
+		details, err := idx.chain.FetchUtxoEntryDetailsBatch(v1.outpoints)
+		if err != nil {
+			return err
+		}
+		for j, op := range v1.outpoints {
+			numOutputs++
+			if details[j].Spent {
+				continue
+			}
+			amount := details[j].Amount
+			height := details[j].BlockHeight
+			index := details[j].BlockIndex
+
+			block, ok := blocks[height]
+			if !ok {
+				hash, err := idx.chain.BlockHashByHeight(height)
+				if err != nil {
+					return err
+				}
+				block, err = idx.chain.BlockByHash(hash)
+				if err != nil {
+					return err
+				}
+				blocks[height] = block
+			}
+			txns := block.STransactions()
+			if op.Tree == wire.TxTreeRegular {
+				txns = block.Transactions()
+			}
+			if int(index) >= len(txns) || *txns[index].Hash() != op.Hash ||
+				int(op.Index) >= len(txns[index].MsgTx().TxOut) {
+
+				return fmt.Errorf("unable to locate ssfee output %v in block "+
+					"at height %d", op, height)
+			}
+
+			entries[i] = append(entries[i], ssfeeEntry{
+				outpoint:    op,
+				amount:      amount,
+				blockHeight: uint32(height),
+				blockIndex:  index,
+				pkScript:    txns[index].MsgTx().TxOut[op.Index].PkScript,
+			})
+			numCached++
+		}
+	}
+	if interruptRequested(ctx) {
+		return indexerError(ErrInterruptRequested, interruptMsg)
+	}
+
+	err = db.Update(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(ssfeeIndexKey)
+		for i, v1 := range v1Entries {
+			if len(entries[i]) == 0 {
+				if err := bucket.Delete(v1.key); err != nil {
+					return err
+				}
+				continue
+			}
+			serialized, err := serializeSSFeeEntries(entries[i])
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(v1.key, serialized); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Cached details of %d unspent output(s) and removed %d spent "+
+		"output(s) from the %s", numCached, numOutputs-numCached,
+		ssfeeIndexName)
+	return nil
+}
+
+// migrateToScriptClassKeys upgrades the index keys from version 2, which do
+// not include a script class, to version 3, which do.  Only P2PKH outputs were
+// indexed prior to version 3, so all existing entries are moved to keys with
+// the P2PKH script class.
+//
+// All keys are rewritten in a single database transaction, so an interrupted
+// migration is simply run again from the start.
+func (idx *SSFeeIndex) migrateToScriptClassKeys(ctx context.Context, db database.DB) error {
+	if interruptRequested(ctx) {
+		return indexerError(ErrInterruptRequested, interruptMsg)
+	}
+
+	var numKeys int
+	err := db.Update(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(ssfeeIndexKey)
+		if bucket == nil {
+			return nil
+		}
+
+		// Collect the keys to rewrite before modifying the bucket since it
+		// must not be modified while iterating.
+		type v2Entry struct {
+			key   []byte
+			value []byte
+		}
+		var v2Entries []v2Entry
+		err := bucket.ForEach(func(k, v []byte) error {
+			if len(k) != ssfeeV2KeySize {
+				return nil
+			}
+			v2Entries = append(v2Entries, v2Entry{
+				key:   append([]byte(nil), k...),
+				value: append([]byte(nil), v...),
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, v2 := range v2Entries {
+			coinType := cointype.CoinType(v2.key[2])
+			key, err := makeSSFeeIndexKey(coinType, stake.SSFeeScriptClassP2PKH,
+				v2.key[3:])
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(key, v2.value); err != nil {
+				return err
+			}
+			if err := bucket.Delete(v2.key); err != nil {
+				return err
+			}
+		}
+		numKeys = len(v2Entries)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Added the script class to %d key(s) of the %s", numKeys,
+		ssfeeIndexName)
+	return nil
+}
+
+// migrateToConsolidationEvents upgrades the index from version 3 to version 4
+// by recording the consolidation events of every block the index has already
+// connected.
+//
+// The events are recorded in batches of blocks per database transaction and
+// recording the events of a block overwrites any that were recorded before, so
+// an interrupted migration is simply run again from the start.
+func (idx *SSFeeIndex) migrateToConsolidationEvents(ctx context.Context, db database.DB) error {
+	var tipHeight int64
+	err := db.View(func(dbTx database.Tx) error {
+		_, height, err := dbFetchIndexerTip(dbTx, ssfeeIndexKey)
+		tipHeight = int64(height)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Blocks past the main chain tip are disconnected when the index is
+	// recovered, so there is no need to record their events.
+	if bestHeight, _ := idx.chain.Best(); tipHeight > bestHeight {
+		tipHeight = bestHeight
+	}
+
+	var numEvents int
+	for start := int64(1); start <= tipHeight; {
+		if interruptRequested(ctx) {
+			return indexerError(ErrInterruptRequested, interruptMsg)
+		}
+
+		end := start + ssfeeConsolidationMigrationBatch - 1
+		if end > tipHeight {
+			end = tipHeight
+		}
+		var events []SSFeeConsolidationEvent
+		for height := start; height <= end; height++ {
+			hash, err := idx.chain.BlockHashByHeight(height)
+			if err != nil {
+				return err
+			}
+			block, err := idx.chain.BlockByHash(hash)
+			if err != nil {
+				return err
+			}
+			events = append(events, blockSSFeeConsolidations(block)...)
+		}
+		err := db.Update(func(dbTx database.Tx) error {
+			bucket := dbTx.Metadata().Bucket(ssfeeIndexKey)
+			for i := range events {
+				if err := putSSFeeConsolidation(bucket, &events[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		numEvents += len(events)
+		start = end + 1
+	}
+
+	log.Infof("Recorded %d consolidation event(s) in the %s", numEvents,
+		ssfeeIndexName)
+	return nil
+}
+
 // Create is invoked when the indexer is being created.
 //
 // This is part of the Indexer interface.
@@ -171,11 +524,21 @@ func (idx *SSFeeIndex) Init(ctx context.Context, chainParams *chaincfg.Params) e
 		return indexerError(ErrInterruptRequested, interruptMsg)
 	}
 
+	// Finish any drops that were previously interrupted.
+	if err := finishDrop(ctx, idx); err != nil {
+		return err
+	}
+
 	// Create the initial state for the index as needed.
 	if err := createIndex(idx, &chainParams.GenesisHash); err != nil {
 		return err
 	}
 
+	// Upgrade the index in place as needed.
+	if err := upgradeIndex(ctx, idx, &chainParams.GenesisHash); err != nil {
+		return err
+	}
+
 	// Recover the ssfee index to the main chain if needed.
 	return recoverIndex(ctx, idx)
 }
@@ -227,76 +590,29 @@ func (idx *SSFeeIndex) ProcessNotification(dbTx database.Tx, ntfn *IndexNtfn) er
 	return nil
 }
 
-// makeSSFeeIndexKey creates an index key for the given coinType and address hash160.
+// makeSSFeeIndexKey creates an index key for the given coinType, script class,
+// and address hash160.
 //
-// Format: "sf" + coinType(1 byte) + addressHash160(20 bytes) = 23 bytes
-func makeSSFeeIndexKey(coinType cointype.CoinType, hash160 []byte) ([]byte, error) {
+// Format: "sf" + coinType(1 byte) + scriptClass(1 byte) +
+// addressHash160(20 bytes) = 24 bytes
+func makeSSFeeIndexKey(coinType cointype.CoinType, class stake.SSFeeScriptClass, hash160 []byte) ([]byte, error) {
 	if len(hash160) != 20 {
 		return nil, fmt.Errorf("invalid hash160 length: %d (expected 20)", len(hash160))
 	}
+	if !class.IsKnown() {
+		return nil, fmt.Errorf("unsupported script class %v", class)
+	}
 
 	key := make([]byte, ssfeeKeySize)
 	copy(key[0:2], []byte(ssfeeKeyPrefix))
 	key[2] = byte(coinType)
-	copy(key[3:23], hash160)
+	key[3] = byte(class)
+	copy(key[4:24], hash160)
 	return key, nil
 }
 
-// extractHash160FromPkScript extracts the address hash160 from a P2PKH script.
-//
-// Supports two formats:
-//
-//  1. Standard P2PKH (25 bytes):
-//     OP_DUP OP_HASH160 <20 bytes hash160> OP_EQUALVERIFY OP_CHECKSIG
-//
-//  2. OP_SSGEN-tagged P2PKH (26 bytes) - used by SSFee outputs:
-//     OP_SSGEN OP_DUP OP_HASH160 <20 bytes hash160> OP_EQUALVERIFY OP_CHECKSIG
-func extractHash160FromPkScript(pkScript []byte) ([]byte, error) {
-	const opSSGen = 0xbb // txscript.OP_SSGEN
-
-	// Check for OP_SSGEN-tagged P2PKH (26 bytes)
-	if len(pkScript) == 26 {
-		// Validate OP_SSGEN prefix
-		if pkScript[0] != opSSGen {
-			return nil, fmt.Errorf("26-byte script must start with OP_SSGEN")
-		}
-
-		// Validate P2PKH structure after OP_SSGEN
-		if pkScript[1] != txscript.OP_DUP ||
-			pkScript[2] != txscript.OP_HASH160 ||
-			pkScript[3] != txscript.OP_DATA_20 ||
-			pkScript[24] != txscript.OP_EQUALVERIFY ||
-			pkScript[25] != txscript.OP_CHECKSIG {
-			return nil, fmt.Errorf("not a valid OP_SSGEN-tagged P2PKH script")
-		}
-
-		// Extract hash160 (bytes 4-23)
-		hash160 := make([]byte, 20)
-		copy(hash160, pkScript[4:24])
-		return hash160, nil
-	}
-
-	// Check for standard P2PKH (25 bytes)
-	if len(pkScript) == 25 {
-		// Validate P2PKH script format
-		if pkScript[0] != txscript.OP_DUP ||
-			pkScript[1] != txscript.OP_HASH160 ||
-			pkScript[2] != txscript.OP_DATA_20 ||
-			pkScript[23] != txscript.OP_EQUALVERIFY ||
-			pkScript[24] != txscript.OP_CHECKSIG {
-			return nil, fmt.Errorf("not a valid P2PKH script")
-		}
-
-		// Extract hash160 (bytes 3-22)
-		hash160 := make([]byte, 20)
-		copy(hash160, pkScript[3:23])
-		return hash160, nil
-	}
-
-	return nil, fmt.Errorf("invalid P2PKH script length: %d (expected 25 or 26)", len(pkScript))
-}
-
-// serializeOutPoints serializes a list of OutPoints into a byte slice.
+// serializeOutPoints serializes a list of OutPoints into a byte slice using the
+// version 1 index entry format.
 //
 // Each OutPoint is serialized as: hash(32) + index(4) + tree(1) = 37 bytes
 func serializeOutPoints(outpoints []wire.OutPoint) []byte {
@@ -323,7 +639,8 @@ func serializeOutPoints(outpoints []wire.OutPoint) []byte {
 	return buf
 }
 
-// deserializeOutPoints deserializes a byte slice into a list of OutPoints.
+// deserializeOutPoints deserializes a byte slice that uses the version 1 index
+// entry format into a list of OutPoints.
 //
 // Each OutPoint is deserialized from: hash(32) + index(4) + tree(1) = 37 bytes
 func deserializeOutPoints(data []byte) ([]wire.OutPoint, error) {
@@ -363,12 +680,143 @@ func deserializeOutPoints(data []byte) ([]wire.OutPoint, error) {
 	return outpoints, nil
 }
 
+// serializeSSFeeEntries serializes a list of SSFee index entries into a byte
+// slice.
+//
+// Each entry is serialized as: outpoint(37) + amount(8) + blockHeight(4) +
+// blockIndex(4) + scriptLen(1) + script(scriptLen)
+func serializeSSFeeEntries(entries []ssfeeEntry) ([]byte, error) {
+	size := 0
+	for i := range entries {
+		if len(entries[i].pkScript) > 255 {
+			return nil, fmt.Errorf("ssfee output %v script length %d exceeds "+
+				"the maximum of 255", entries[i].outpoint,
+				len(entries[i].pkScript))
+		}
+		size += ssfeeEntryFixedSize + len(entries[i].pkScript)
+	}
+
+	buf := make([]byte, size)
+	offset := 0
+	for i := range entries {
+		entry := &entries[i]
+		copy(buf[offset:offset+32], entry.outpoint.Hash[:])
+		byteOrder.PutUint32(buf[offset+32:offset+36], entry.outpoint.Index)
+		buf[offset+36] = byte(entry.outpoint.Tree)
+		byteOrder.PutUint64(buf[offset+37:offset+45], uint64(entry.amount))
+		byteOrder.PutUint32(buf[offset+45:offset+49], entry.blockHeight)
+		byteOrder.PutUint32(buf[offset+49:offset+53], entry.blockIndex)
+		buf[offset+53] = byte(len(entry.pkScript))
+		offset += ssfeeEntryFixedSize
+		offset += copy(buf[offset:], entry.pkScript)
+	}
+
+	return buf, nil
+}
+
+// deserializeSSFeeEntries deserializes a byte slice into a list of SSFee index
+// entries.
+//
+// Each entry is deserialized from: outpoint(37) + amount(8) + blockHeight(4) +
+// blockIndex(4) + scriptLen(1) + script(scriptLen)
+func deserializeSSFeeEntries(data []byte) ([]ssfeeEntry, error) {
+	var entries []ssfeeEntry
+	for offset := 0; offset < len(data); {
+		if len(data[offset:]) < ssfeeEntryFixedSize {
+			return nil, fmt.Errorf("truncated ssfee entry at offset %d: %d "+
+				"bytes remaining (need at least %d)", offset,
+				len(data[offset:]), ssfeeEntryFixedSize)
+		}
+
+		var entry ssfeeEntry
+		copy(entry.outpoint.Hash[:], data[offset:offset+32])
+		entry.outpoint.Index = byteOrder.Uint32(data[offset+32 : offset+36])
+		entry.outpoint.Tree = int8(data[offset+36])
+		entry.amount = int64(byteOrder.Uint64(data[offset+37 : offset+45]))
+		entry.blockHeight = byteOrder.Uint32(data[offset+45 : offset+49])
+		entry.blockIndex = byteOrder.Uint32(data[offset+49 : offset+53])
+		scriptLen := int(data[offset+53])
+		offset += ssfeeEntryFixedSize
+
+		if len(data[offset:]) < scriptLen {
+			return nil, fmt.Errorf("truncated ssfee entry script: %d bytes "+
+				"remaining (need %d)", len(data[offset:]), scriptLen)
+		}
+		entry.pkScript = make([]byte, scriptLen)
+		offset += copy(entry.pkScript, data[offset:offset+scriptLen])
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// isSSFeeConsolidation returns whether the provided SSFee transaction
+// consolidates an existing SSFee output by spending it as opposed to creating
+// a new UTXO via a null input.
+func isSSFeeConsolidation(msgTx *wire.MsgTx) bool {
+	prevOut := &msgTx.TxIn[0].PreviousOutPoint
+	return prevOut.Index != wire.MaxPrevOutIndex || prevOut.Hash != (chainhash.Hash{})
+}
+
+// blockSSFeeConsolidations returns the consolidation events for every coin
+// type paid by the SSFee transactions in the provided block in order of their
+// first SSFee transaction.
+func blockSSFeeConsolidations(block *dcrutil.Block) []SSFeeConsolidationEvent {
+	var events []SSFeeConsolidationEvent
+	byCoinType := make(map[cointype.CoinType]int)
+	for _, stx := range block.STransactions() {
+		msgTx := stx.MsgTx()
+		if !stake.IsSSFee(msgTx) || len(msgTx.TxOut) < 2 {
+			continue
+		}
+
+		coinType := msgTx.TxOut[1].CoinType
+		eventIdx, ok := byCoinType[coinType]
+		if !ok {
+			eventIdx = len(events)
+			byCoinType[coinType] = eventIdx
+			events = append(events, SSFeeConsolidationEvent{
+				CoinType: coinType,
+				Height:   block.Height(),
+			})
+		}
+		events[eventIdx].Outputs++
+		if isSSFeeConsolidation(msgTx) {
+			events[eventIdx].Consolidated++
+		}
+	}
+	return events
+}
+
+// makeSSFeeConsolidationKey creates the key of the consolidation event of the
+// provided coin type at the provided height.
+//
+// Format: "sc" + coinType(1 byte) + height(4 bytes big endian) = 7 bytes
+func makeSSFeeConsolidationKey(coinType cointype.CoinType, height int64) []byte {
+	key := make([]byte, ssfeeConsolidationKeySize)
+	copy(key[0:2], ssfeeConsolidationKeyPrefix)
+	key[2] = byte(coinType)
+	binary.BigEndian.PutUint32(key[3:7], uint32(height))
+	return key
+}
+
+// putSSFeeConsolidation stores the provided consolidation event in the
+// provided index bucket.
+func putSSFeeConsolidation(bucket database.Bucket, event *SSFeeConsolidationEvent) error {
+	var value [ssfeeConsolidationValueSize]byte
+	byteOrder.PutUint32(value[0:4], event.Outputs)
+	byteOrder.PutUint32(value[4:8], event.Consolidated)
+	key := makeSSFeeConsolidationKey(event.CoinType, event.Height)
+	return bucket.Put(key, value[:])
+}
+
 // ConnectBlock indexes all SSFee outputs in the provided block.
 // This is called when a block is connected to the main chain.
 //
 // For each SSFee transaction in the block:
 //  1. Extract the consolidation address from output[0]
-//  2. Create index key: "sf" + coinType + addressHash160
+//  2. Create index key: "sf" + coinType + scriptClass + addressHash160
 //  3. Add the outpoint to the list for this key
 //
 // This is part of the Indexer interface implementation via ProcessNotification.
@@ -382,7 +830,7 @@ func (idx *SSFeeIndex) ConnectBlock(dbTx database.Tx, block *dcrutil.Block) erro
 	ssfeeCount := 0 // Track number of SSFee txs indexed
 
 	// Iterate through stake transactions in the block
-	for _, stx := range block.STransactions() {
+	for txIdx, stx := range block.STransactions() {
 		// Check if this is an SSFee transaction
 		if !stake.IsSSFee(stx.MsgTx()) {
 			continue
@@ -400,39 +848,49 @@ func (idx *SSFeeIndex) ConnectBlock(dbTx database.Tx, block *dcrutil.Block) erro
 		paymentOutput := stx.MsgTx().TxOut[1]
 		const paymentIndex uint32 = 1
 
-		// Extract hash160 from the payment output
-		hash160, err := extractHash160FromPkScript(paymentOutput.PkScript)
+		// Extract the script class and hash160 from the payment output
+		class, hash160, err := stake.ExtractSSFeePayScript(paymentOutput.PkScript)
 		if err != nil {
-			// Skip non-P2PKH outputs (shouldn't happen for valid SSFee)
+			// Skip unsupported script classes (shouldn't happen for valid SSFee)
 			log.Debugf("SSFeeIndex: Skipping SSFee tx %s: failed to extract hash160: %v", stx.Hash(), err)
 			continue
 		}
 
-		// Create index key for this (coinType, address)
-		key, err := makeSSFeeIndexKey(paymentOutput.CoinType, hash160)
+		// Create index key for this (coinType, scriptClass, address)
+		key, err := makeSSFeeIndexKey(paymentOutput.CoinType, class, hash160)
 		if err != nil {
 			return fmt.Errorf("failed to create index key: %w", err)
 		}
 
-		// Fetch existing outpoints for this key
+		// Fetch existing entries for this key
 		existingData := bucket.Get(key)
-		existingOutpoints, err := deserializeOutPoints(existingData)
+		existingEntries, err := deserializeSSFeeEntries(existingData)
 		if err != nil {
-			return fmt.Errorf("failed to deserialize existing outpoints: %w", err)
+			return fmt.Errorf("failed to deserialize existing entries: %w", err)
 		}
 
-		// Add this outpoint to the list
-		newOutpoint := wire.OutPoint{
-			Hash:  *stx.Hash(),
-			Index: paymentIndex,
-			Tree:  wire.TxTreeStake,
+		// Add this output to the list along with the details needed to
+		// spend it
+		newEntry := ssfeeEntry{
+			outpoint: wire.OutPoint{
+				Hash:  *stx.Hash(),
+				Index: paymentIndex,
+				Tree:  wire.TxTreeStake,
+			},
+			amount:      paymentOutput.Value,
+			blockHeight: uint32(block.Height()),
+			blockIndex:  uint32(txIdx),
+			pkScript:    paymentOutput.PkScript,
 		}
-		existingOutpoints = append(existingOutpoints, newOutpoint)
+		existingEntries = append(existingEntries, newEntry)
 
-		// Serialize and store updated outpoint list
-		updatedData := serializeOutPoints(existingOutpoints)
+		// Serialize and store updated entry list
+		updatedData, err := serializeSSFeeEntries(existingEntries)
+		if err != nil {
+			return fmt.Errorf("failed to serialize entries: %w", err)
+		}
 		if err := bucket.Put(key, updatedData); err != nil {
-			return fmt.Errorf("failed to store outpoints: %w", err)
+			return fmt.Errorf("failed to store entries: %w", err)
 		}
 	}
 
@@ -441,6 +899,14 @@ func (idx *SSFeeIndex) ConnectBlock(dbTx database.Tx, block *dcrutil.Block) erro
 			ssfeeCount, block.Hash(), block.Height())
 	}
 
+	// Record the consolidation events of the block.
+	events := blockSSFeeConsolidations(block)
+	for i := range events {
+		if err := putSSFeeConsolidation(bucket, &events[i]); err != nil {
+			return fmt.Errorf("failed to store consolidation event: %w", err)
+		}
+	}
+
 	// Update the current index tip.
 	return dbPutIndexerTip(dbTx, ssfeeIndexKey, block.Hash(), int32(block.Height()))
 }
@@ -450,7 +916,7 @@ func (idx *SSFeeIndex) ConnectBlock(dbTx database.Tx, block *dcrutil.Block) erro
 //
 // For each SSFee transaction in the block:
 //  1. Extract the consolidation address from output[0]
-//  2. Create index key: "sf" + coinType + addressHash160
+//  2. Create index key: "sf" + coinType + scriptClass + addressHash160
 //  3. Remove the outpoint from the list for this key
 //
 // This is part of the Indexer interface implementation via ProcessNotification.
@@ -478,32 +944,33 @@ func (idx *SSFeeIndex) DisconnectBlock(dbTx database.Tx, block *dcrutil.Block) e
 		paymentOutput := stx.MsgTx().TxOut[1]
 		const paymentIndex uint32 = 1
 
-		// Extract hash160 from the payment output
-		hash160, err := extractHash160FromPkScript(paymentOutput.PkScript)
+		// Extract the script class and hash160 from the payment output
+		class, hash160, err := stake.ExtractSSFeePayScript(paymentOutput.PkScript)
 		if err != nil {
-			// Skip non-P2PKH outputs
+			// Skip unsupported script classes
 			continue
 		}
 
-		// Create index key for this (coinType, address)
-		key, err := makeSSFeeIndexKey(paymentOutput.CoinType, hash160)
+		// Create index key for this (coinType, scriptClass, address)
+		key, err := makeSSFeeIndexKey(paymentOutput.CoinType, class, hash160)
 		if err != nil {
 			return fmt.Errorf("failed to create index key: %w", err)
 		}
 
-		// Fetch existing outpoints for this key
+		// Fetch existing entries for this key
 		existingData := bucket.Get(key)
-		existingOutpoints, err := deserializeOutPoints(existingData)
+		existingEntries, err := deserializeSSFeeEntries(existingData)
 		if err != nil {
-			return fmt.Errorf("failed to deserialize existing outpoints: %w", err)
+			return fmt.Errorf("failed to deserialize existing entries: %w", err)
 		}
 
-		// Remove this outpoint from the list
+		// Remove this output from the list
 		targetHash := *stx.Hash()
-		filtered := make([]wire.OutPoint, 0, len(existingOutpoints))
-		for _, op := range existingOutpoints {
+		filtered := make([]ssfeeEntry, 0, len(existingEntries))
+		for _, entry := range existingEntries {
+			op := &entry.outpoint
 			if op.Hash != targetHash || op.Index != paymentIndex {
-				filtered = append(filtered, op)
+				filtered = append(filtered, entry)
 			}
 		}
 
@@ -513,31 +980,47 @@ func (idx *SSFeeIndex) DisconnectBlock(dbTx database.Tx, block *dcrutil.Block) e
 				return fmt.Errorf("failed to delete key: %w", err)
 			}
 		} else {
-			// Serialize and store updated outpoint list
-			updatedData := serializeOutPoints(filtered)
+			// Serialize and store updated entry list
+			updatedData, err := serializeSSFeeEntries(filtered)
+			if err != nil {
+				return fmt.Errorf("failed to serialize entries: %w", err)
+			}
 			if err := bucket.Put(key, updatedData); err != nil {
-				return fmt.Errorf("failed to store outpoints: %w", err)
+				return fmt.Errorf("failed to store entries: %w", err)
 			}
 		}
 	}
 
+	// Remove the consolidation events of the block.
+	for _, event := range blockSSFeeConsolidations(block) {
+		key := makeSSFeeConsolidationKey(event.CoinType, event.Height)
+		if err := bucket.Delete(key); err != nil {
+			return fmt.Errorf("failed to delete consolidation event: %w", err)
+		}
+	}
+
 	// Update the current index tip.
 	return dbPutIndexerTip(dbTx, ssfeeIndexKey, &block.MsgBlock().Header.PrevBlock,
 		int32(block.Height()-1))
 }
 
-// LookupUTXO finds an unspent SSFee UTXO for the given (coinType, address).
+// LookupUTXO finds an SSFee UTXO for the given (coinType, scriptClass, address).
 //
 // Returns:
-//   - outpoint: The first unspent outpoint found, or nil if none exist
+//   - outpoint: The most recently created outpoint, or nil if none exist
 //   - value: The value of the UTXO
 //   - blockHeight: The block height where the UTXO was created (for fraud proofs)
 //   - blockIndex: The transaction index within the block (for fraud proofs)
 //   - error: Any error encountered during lookup
 //
+// The details are served from the index without consulting the utxo set, so
+// the caller must verify the returned output is still unspent before spending
+// it.  The most recently created output is returned since it is the one that
+// was not already spent by a later augmenting SSFee transaction.
+//
 // This is the primary query method used by block template generation to find
 // existing SSFee UTXOs for augmentation.
-func (idx *SSFeeIndex) LookupUTXO(coinType cointype.CoinType, addressHash160 []byte) (*wire.OutPoint, int64, int64, uint32, error) {
+func (idx *SSFeeIndex) LookupUTXO(coinType cointype.CoinType, class stake.SSFeeScriptClass, addressHash160 []byte) (*wire.OutPoint, int64, int64, uint32, error) {
 	var outpoint *wire.OutPoint
 	var value int64
 	var blockHeight int64
@@ -550,53 +1033,87 @@ func (idx *SSFeeIndex) LookupUTXO(coinType cointype.CoinType, addressHash160 []b
 			return fmt.Errorf("ssfee index bucket not found")
 		}
 
-		// Create index key for this (coinType, address)
-		key, err := makeSSFeeIndexKey(coinType, addressHash160)
+		// Create index key for this (coinType, scriptClass, address)
+		key, err := makeSSFeeIndexKey(coinType, class, addressHash160)
 		if err != nil {
 			return fmt.Errorf("failed to create index key: %w", err)
 		}
 
-		// Fetch outpoints for this key
+		// Fetch entries for this key
 		data := bucket.Get(key)
 		if data == nil {
 			// No UTXOs exist for this address
-			log.Debugf("SSFeeIndex: No outpoints found for key=%x (bucket.Get returned nil)", key)
+			log.Debugf("SSFeeIndex: No entries found for key=%x (bucket.Get returned nil)", key)
 			return nil
 		}
 
-		outpoints, err := deserializeOutPoints(data)
+		entries, err := deserializeSSFeeEntries(data)
 		if err != nil {
-			return fmt.Errorf("failed to deserialize outpoints: %w", err)
+			return fmt.Errorf("failed to deserialize entries: %w", err)
 		}
-
-		// Query blockchain UTXO set to find an unspent output
-		// Try each outpoint until we find an unspent one
-		for _, op := range outpoints {
-			// Fetch UTXO details including fraud proof data (block height and index)
-			amount, height, index, spent, err := idx.chain.FetchUtxoEntryDetails(op)
-			if err != nil {
-				continue
-			}
-
-			// Skip if UTXO doesn't exist or is spent
-			if spent || amount <= 0 {
-				continue
-			}
-
-			// Found valid unspent UTXO - return it with fraud proof data
-			outpoint = &op
-			value = amount
-			blockHeight = height
-			blockIndex = index
-			log.Debugf("SSFeeIndex: Selected outpoint %v with value %d (height=%d, index=%d)",
-				op, amount, height, index)
+		if len(entries) == 0 {
 			return nil
 		}
 
-		// No valid UTXO found - return nil (mining will use null input)
-		log.Debugf("SSFeeIndex: No valid unspent UTXO found among %d outpoint(s)", len(outpoints))
+		// Select the most recently created output along with its cached
+		// fraud proof data
+		entry := &entries[len(entries)-1]
+		outpoint = &entry.outpoint
+		value = entry.amount
+		blockHeight = int64(entry.blockHeight)
+		blockIndex = entry.blockIndex
+		log.Debugf("SSFeeIndex: Selected outpoint %v with value %d (height=%d, index=%d)",
+			*outpoint, value, blockHeight, blockIndex)
 		return nil
 	})
 
 	return outpoint, value, blockHeight, blockIndex, err
 }
+
+// ConsolidationEvents returns the consolidation events of the provided coin
+// type recorded for the blocks between the provided start and end heights,
+// inclusive, as of the current index tip ordered by height.  Blocks without
+// SSFee outputs of the coin type have no events.
+//
+// This function is safe for concurrent access.
+func (idx *SSFeeIndex) ConsolidationEvents(coinType cointype.CoinType, startHeight, endHeight int64) ([]SSFeeConsolidationEvent, error) {
+	if startHeight < 0 {
+		startHeight = 0
+	}
+	var events []SSFeeConsolidationEvent
+	err := idx.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(ssfeeIndexKey)
+		if bucket == nil {
+			return fmt.Errorf("ssfee index bucket not found")
+		}
+
+		prefix := makeSSFeeConsolidationKey(coinType, 0)[:3]
+		cursor := bucket.Cursor()
+		ok := cursor.Seek(makeSSFeeConsolidationKey(coinType, startHeight))
+		for ; ok; ok = cursor.Next() {
+			key := cursor.Key()
+			if len(key) != ssfeeConsolidationKeySize ||
+				!bytes.HasPrefix(key, prefix) {
+
+				break
+			}
+			height := int64(binary.BigEndian.Uint32(key[3:7]))
+			if height > endHeight {
+				break
+			}
+			value := cursor.Value()
+			if len(value) != ssfeeConsolidationValueSize {
+				return fmt.Errorf("malformed consolidation event for coin "+
+					"type %d at height %d", coinType, height)
+			}
+			events = append(events, SSFeeConsolidationEvent{
+				CoinType:     coinType,
+				Height:       height,
+				Outputs:      byteOrder.Uint32(value[0:4]),
+				Consolidated: byteOrder.Uint32(value[4:8]),
+			})
+		}
+		return nil
+	})
+	return events, err
+}
@@ -6,11 +6,19 @@ package indexers
 
 import (
 	"bytes"
+	"context"
+	"reflect"
 	"testing"
 
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/ssfee"
 	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -19,6 +27,7 @@ func TestSSFeeIndexKey(t *testing.T) {
 	tests := []struct {
 		name      string
 		coinType  cointype.CoinType
+		class     stake.SSFeeScriptClass
 		hash160   []byte
 		wantLen   int
 		wantErr   bool
@@ -28,23 +37,40 @@ func TestSSFeeIndexKey(t *testing.T) {
 			name:     "valid VAR coin type",
 			coinType: cointype.CoinTypeVAR,
 			hash160:  make([]byte, 20),
-			wantLen:  23,
+			wantLen:  24,
 			wantErr:  false,
 		},
 		{
 			name:     "valid SKA-1 coin type",
 			coinType: cointype.CoinType(1),
 			hash160:  make([]byte, 20),
-			wantLen:  23,
+			wantLen:  24,
 			wantErr:  false,
 		},
 		{
 			name:     "valid SKA-2 coin type",
 			coinType: cointype.CoinType(2),
 			hash160:  make([]byte, 20),
-			wantLen:  23,
+			wantLen:  24,
 			wantErr:  false,
 		},
+		{
+			name:     "valid P2SH script class",
+			coinType: cointype.CoinType(1),
+			class:    stake.SSFeeScriptClassP2SH,
+			hash160:  make([]byte, 20),
+			wantLen:  24,
+			wantErr:  false,
+		},
+		{
+			name:      "unsupported script class",
+			coinType:  cointype.CoinType(1),
+			class:     stake.SSFeeScriptClass(0xff),
+			hash160:   make([]byte, 20),
+			wantLen:   0,
+			wantErr:   true,
+			errSubstr: "unsupported script class",
+		},
 		{
 			name:      "invalid hash160 length (too short)",
 			coinType:  cointype.CoinTypeVAR,
@@ -73,7 +99,7 @@ func TestSSFeeIndexKey(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			key, err := makeSSFeeIndexKey(tt.coinType, tt.hash160)
+			key, err := makeSSFeeIndexKey(tt.coinType, tt.class, tt.hash160)
 
 			if tt.wantErr {
 				if err == nil {
@@ -93,7 +119,7 @@ func TestSSFeeIndexKey(t *testing.T) {
 				t.Fatalf("expected key length %d, got %d", tt.wantLen, len(key))
 			}
 
-			// Verify key format: "sf" + coinType + hash160
+			// Verify key format: "sf" + coinType + scriptClass + hash160
 			if !bytes.Equal(key[0:2], []byte("sf")) {
 				t.Fatalf("expected prefix 'sf', got %q", key[0:2])
 			}
@@ -102,97 +128,12 @@ func TestSSFeeIndexKey(t *testing.T) {
 				t.Fatalf("expected coinType %d, got %d", tt.coinType, key[2])
 			}
 
-			if !bytes.Equal(key[3:23], tt.hash160) {
-				t.Fatalf("expected hash160 %x, got %x", tt.hash160, key[3:23])
+			if key[3] != byte(tt.class) {
+				t.Fatalf("expected script class %d, got %d", tt.class, key[3])
 			}
-		})
-	}
-}
 
-// TestExtractHash160FromPkScript tests the extractHash160FromPkScript function.
-func TestExtractHash160FromPkScript(t *testing.T) {
-	// Create a valid P2PKH script
-	hash160 := []byte{
-		0x1a, 0x2b, 0x3c, 0x4d, 0x5e, 0x6f, 0x7a, 0x8b, 0x9c, 0x0d,
-		0x1e, 0x2f, 0x3a, 0x4b, 0x5c, 0x6d, 0x7e, 0x8f, 0x9a, 0x0b,
-	}
-
-	validP2PKH := make([]byte, 25)
-	validP2PKH[0] = txscript.OP_DUP
-	validP2PKH[1] = txscript.OP_HASH160
-	validP2PKH[2] = txscript.OP_DATA_20
-	copy(validP2PKH[3:23], hash160)
-	validP2PKH[23] = txscript.OP_EQUALVERIFY
-	validP2PKH[24] = txscript.OP_CHECKSIG
-
-	tests := []struct {
-		name      string
-		pkScript  []byte
-		want      []byte
-		wantErr   bool
-		errSubstr string
-	}{
-		{
-			name:     "valid P2PKH script",
-			pkScript: validP2PKH,
-			want:     hash160,
-			wantErr:  false,
-		},
-		{
-			name:      "script too short",
-			pkScript:  make([]byte, 24),
-			want:      nil,
-			wantErr:   true,
-			errSubstr: "invalid P2PKH script length",
-		},
-		{
-			name:      "script too long",
-			pkScript:  make([]byte, 27),
-			want:      nil,
-			wantErr:   true,
-			errSubstr: "invalid P2PKH script length",
-		},
-		{
-			name: "invalid opcode sequence",
-			pkScript: []byte{
-				txscript.OP_DUP, txscript.OP_HASH160, txscript.OP_DATA_20,
-				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				txscript.OP_EQUAL, // Wrong opcode (should be EQUALVERIFY)
-				txscript.OP_CHECKSIG,
-			},
-			want:      nil,
-			wantErr:   true,
-			errSubstr: "not a valid P2PKH script",
-		},
-		{
-			name:      "nil script",
-			pkScript:  nil,
-			want:      nil,
-			wantErr:   true,
-			errSubstr: "invalid P2PKH script length",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := extractHash160FromPkScript(tt.pkScript)
-
-			if tt.wantErr {
-				if err == nil {
-					t.Fatalf("expected error containing %q, got nil", tt.errSubstr)
-				}
-				if !bytes.Contains([]byte(err.Error()), []byte(tt.errSubstr)) {
-					t.Fatalf("expected error containing %q, got %q", tt.errSubstr, err.Error())
-				}
-				return
-			}
-
-			if err != nil {
-				t.Fatalf("unexpected error: %v", err)
-			}
-
-			if !bytes.Equal(got, tt.want) {
-				t.Fatalf("expected hash160 %x, got %x", tt.want, got)
+			if !bytes.Equal(key[4:24], tt.hash160) {
+				t.Fatalf("expected hash160 %x, got %x", tt.hash160, key[4:24])
 			}
 		})
 	}
@@ -336,8 +277,8 @@ func TestDeserializeOutPointsInvalid(t *testing.T) {
 	}
 }
 
-// TestSSFeeIndexKeyUniqueness tests that different (coinType, address) pairs
-// produce unique keys.
+// TestSSFeeIndexKeyUniqueness tests that different (coinType, scriptClass,
+// address) tuples produce unique keys.
 func TestSSFeeIndexKeyUniqueness(t *testing.T) {
 	hash160_1 := make([]byte, 20)
 	hash160_2 := make([]byte, 20)
@@ -345,28 +286,32 @@ func TestSSFeeIndexKeyUniqueness(t *testing.T) {
 
 	keys := make(map[string]bool)
 
+	const p2pkh, p2sh = stake.SSFeeScriptClassP2PKH, stake.SSFeeScriptClassP2SH
 	testCases := []struct {
 		coinType cointype.CoinType
+		class    stake.SSFeeScriptClass
 		hash160  []byte
 	}{
-		{cointype.CoinTypeVAR, hash160_1},
-		{cointype.CoinTypeVAR, hash160_2},
-		{cointype.CoinType(1), hash160_1},
-		{cointype.CoinType(1), hash160_2},
-		{cointype.CoinType(2), hash160_1},
-		{cointype.CoinType(2), hash160_2},
+		{cointype.CoinTypeVAR, p2pkh, hash160_1},
+		{cointype.CoinTypeVAR, p2pkh, hash160_2},
+		{cointype.CoinTypeVAR, p2sh, hash160_1},
+		{cointype.CoinType(1), p2pkh, hash160_1},
+		{cointype.CoinType(1), p2pkh, hash160_2},
+		{cointype.CoinType(1), p2sh, hash160_2},
+		{cointype.CoinType(2), p2pkh, hash160_1},
+		{cointype.CoinType(2), p2pkh, hash160_2},
 	}
 
 	for _, tc := range testCases {
-		key, err := makeSSFeeIndexKey(tc.coinType, tc.hash160)
+		key, err := makeSSFeeIndexKey(tc.coinType, tc.class, tc.hash160)
 		if err != nil {
 			t.Fatalf("unexpected error creating key: %v", err)
 		}
 
 		keyStr := string(key)
 		if keys[keyStr] {
-			t.Fatalf("duplicate key generated for coinType=%d, hash160=%x",
-				tc.coinType, tc.hash160)
+			t.Fatalf("duplicate key generated for coinType=%d, class=%v, "+
+				"hash160=%x", tc.coinType, tc.class, tc.hash160)
 		}
 		keys[keyStr] = true
 	}
@@ -377,6 +322,226 @@ func TestSSFeeIndexKeyUniqueness(t *testing.T) {
 	}
 }
 
+// TestSerializeDeserializeSSFeeEntries tests SSFee index entry serialization
+// and deserialization along with error handling for truncated data.
+func TestSerializeDeserializeSSFeeEntries(t *testing.T) {
+	p2pkh := make([]byte, 25)
+	p2pkh[0] = txscript.OP_DUP
+	ssgenP2PKH := append([]byte{0xbb}, p2pkh...)
+	entries := []ssfeeEntry{{
+		outpoint: wire.OutPoint{
+			Hash:  *newHashFromStr("000000000000000000000000000000000000000000000000000000000000000a"),
+			Index: 1,
+			Tree:  wire.TxTreeStake,
+		},
+		amount:      1e8,
+		blockHeight: 100,
+		blockIndex:  3,
+		pkScript:    p2pkh,
+	}, {
+		outpoint: wire.OutPoint{
+			Hash:  *newHashFromStr("000000000000000000000000000000000000000000000000000000000000000b"),
+			Index: 1,
+			Tree:  wire.TxTreeStake,
+		},
+		amount:      5e7,
+		blockHeight: 200,
+		blockIndex:  7,
+		pkScript:    ssgenP2PKH,
+	}}
+
+	serialized, err := serializeSSFeeEntries(entries)
+	if err != nil {
+		t.Fatalf("serialization failed: %v", err)
+	}
+	wantLen := 2*ssfeeEntryFixedSize + len(p2pkh) + len(ssgenP2PKH)
+	if len(serialized) != wantLen {
+		t.Fatalf("expected serialized length %d, got %d", wantLen,
+			len(serialized))
+	}
+
+	deserialized, err := deserializeSSFeeEntries(serialized)
+	if err != nil {
+		t.Fatalf("deserialization failed: %v", err)
+	}
+	if len(deserialized) != len(entries) {
+		t.Fatalf("expected %d entries, got %d", len(entries), len(deserialized))
+	}
+	for i, want := range entries {
+		got := deserialized[i]
+		if got.outpoint != want.outpoint {
+			t.Fatalf("entry[%d]: expected outpoint %v, got %v", i,
+				want.outpoint, got.outpoint)
+		}
+		if got.amount != want.amount || got.blockHeight != want.blockHeight ||
+			got.blockIndex != want.blockIndex {
+
+			t.Fatalf("entry[%d]: expected details (%d, %d, %d), got "+
+				"(%d, %d, %d)", i, want.amount, want.blockHeight,
+				want.blockIndex, got.amount, got.blockHeight, got.blockIndex)
+		}
+		if !bytes.Equal(got.pkScript, want.pkScript) {
+			t.Fatalf("entry[%d]: expected script %x, got %x", i,
+				want.pkScript, got.pkScript)
+		}
+	}
+
+	// Ensure truncated entries and scripts are rejected.
+	for _, truncLen := range []int{ssfeeEntryFixedSize - 1,
+		ssfeeEntryFixedSize + len(p2pkh) - 1} {
+
+		_, err := deserializeSSFeeEntries(serialized[:truncLen])
+		if err == nil || !bytes.Contains([]byte(err.Error()), []byte("truncated")) {
+			t.Fatalf("expected truncation error for length %d, got %v",
+				truncLen, err)
+		}
+	}
+
+	// Ensure scripts that do not fit the length prefix are rejected.
+	_, err = serializeSSFeeEntries([]ssfeeEntry{{pkScript: make([]byte, 256)}})
+	if err == nil {
+		t.Fatal("expected error serializing oversized script")
+	}
+}
+
+// TestSSFeeIndexMigrateToCachedOutputs ensures the migration to cached output
+// details removes outputs that are no longer unspent.
+func TestSSFeeIndexMigrateToCachedOutputs(t *testing.T) {
+	db := setupDB(t)
+
+	// The test chain reports every output as spent.
+	chain, err := newTestChain()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subber := NewIndexSubscriber(ctx)
+	go subber.Run(ctx)
+
+	idx, err := NewSSFeeIndex(subber, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := makeSSFeeIndexKey(cointype.CoinType(1),
+		stake.SSFeeScriptClassP2PKH, make([]byte, 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v1Data := serializeOutPoints([]wire.OutPoint{{
+		Hash:  *newHashFromStr("000000000000000000000000000000000000000000000000000000000000000a"),
+		Index: 1,
+		Tree:  wire.TxTreeStake,
+	}})
+	err = db.Update(func(dbTx database.Tx) error {
+		return dbTx.Metadata().Bucket(ssfeeIndexKey).Put(key, v1Data)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := idx.migrateToCachedOutputs(ctx, db); err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+
+	outpoint, _, _, _, err := idx.LookupUTXO(cointype.CoinType(1),
+		stake.SSFeeScriptClassP2PKH, make([]byte, 20))
+	if err != nil {
+		t.Fatalf("unexpected lookup error: %v", err)
+	}
+	if outpoint != nil {
+		t.Fatalf("spent output %v was not removed by the migration", outpoint)
+	}
+}
+
+// TestSSFeeIndexMigrateToScriptClassKeys ensures the migration to keys with a
+// script class moves the entries of version 2 keys to keys with the P2PKH
+// script class.
+func TestSSFeeIndexMigrateToScriptClassKeys(t *testing.T) {
+	db := setupDB(t)
+
+	chain, err := newTestChain()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subber := NewIndexSubscriber(ctx)
+	go subber.Run(ctx)
+
+	idx, err := NewSSFeeIndex(subber, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash160 := make([]byte, 20)
+	hash160[0] = 0x01
+	v2Key := append([]byte(ssfeeKeyPrefix), 1)
+	v2Key = append(v2Key, hash160...)
+	entry := ssfeeEntry{
+		outpoint: wire.OutPoint{
+			Hash:  *newHashFromStr("000000000000000000000000000000000000000000000000000000000000000a"),
+			Index: 1,
+			Tree:  wire.TxTreeStake,
+		},
+		amount:      1e8,
+		blockHeight: 100,
+		blockIndex:  3,
+		pkScript:    make([]byte, 26),
+	}
+	v2Data, err := serializeSSFeeEntries([]ssfeeEntry{entry})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Update(func(dbTx database.Tx) error {
+		return dbTx.Metadata().Bucket(ssfeeIndexKey).Put(v2Key, v2Data)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := idx.migrateToScriptClassKeys(ctx, db); err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+
+	// Ensure the version 2 key was removed and the entry is found under the
+	// P2PKH script class only.
+	err = db.View(func(dbTx database.Tx) error {
+		if dbTx.Metadata().Bucket(ssfeeIndexKey).Get(v2Key) != nil {
+			t.Fatal("version 2 key was not removed by the migration")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	outpoint, value, height, index, err := idx.LookupUTXO(cointype.CoinType(1),
+		stake.SSFeeScriptClassP2PKH, hash160)
+	if err != nil {
+		t.Fatalf("unexpected lookup error: %v", err)
+	}
+	if outpoint == nil || *outpoint != entry.outpoint ||
+		value != entry.amount || height != int64(entry.blockHeight) ||
+		index != entry.blockIndex {
+
+		t.Fatalf("unexpected migrated entry: got (%v, %d, %d, %d)", outpoint,
+			value, height, index)
+	}
+	outpoint, _, _, _, err = idx.LookupUTXO(cointype.CoinType(1),
+		stake.SSFeeScriptClassP2SH, hash160)
+	if err != nil {
+		t.Fatalf("unexpected lookup error: %v", err)
+	}
+	if outpoint != nil {
+		t.Fatalf("unexpected P2SH entry %v after migration", outpoint)
+	}
+}
+
 // newHashFromStr converts a hex string to a chainhash.Hash.
 // Panics if the string is not a valid hash.
 func newHashFromStr(hexStr string) *chainhash.Hash {
@@ -386,3 +551,164 @@ func newHashFromStr(hexStr string) *chainhash.Hash {
 	}
 	return hash
 }
+
+// newSSFeeTestBlock returns a block at the provided height that builds on the
+// provided parent and houses the provided SSFee transactions.
+func newSSFeeTestBlock(t *testing.T, parent *dcrutil.Block, ssfeeTxns ...*dcrutil.Tx) *dcrutil.Block {
+	t.Helper()
+
+	coinbase := wire.NewMsgTx()
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+	})
+	coinbase.AddTxOut(&wire.TxOut{Value: 1000})
+	msgBlock := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Height:    uint32(parent.Height() + 1),
+			PrevBlock: *parent.Hash(),
+		},
+		Transactions: []*wire.MsgTx{coinbase},
+	}
+	for _, tx := range ssfeeTxns {
+		if !stake.IsSSFee(tx.MsgTx()) {
+			t.Fatalf("transaction %v is not an SSFee transaction", tx.Hash())
+		}
+		msgBlock.STransactions = append(msgBlock.STransactions, tx.MsgTx())
+	}
+	return dcrutil.NewBlock(msgBlock)
+}
+
+// newSSFeeTestAddr returns a P2PKH address for the provided hash160 byte.
+func newSSFeeTestAddr(t *testing.T, b byte) stdaddr.Address {
+	t.Helper()
+
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		bytes.Repeat([]byte{b}, 20), chaincfg.SimNetParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+// TestSSFeeIndexConsolidationEvents ensures the consolidation events of each
+// coin type are recorded when blocks are connected, removed when they are
+// disconnected, and recorded for blocks connected prior to version 4 by the
+// migration.
+func TestSSFeeIndexConsolidationEvents(t *testing.T) {
+	db := setupDB(t)
+	chain, err := newTestChain()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subber := NewIndexSubscriber(ctx)
+	go subber.Run(ctx)
+
+	idx, err := NewSSFeeIndex(subber, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first block creates new SSFee outputs for two addresses in coin
+	// type 1 and one address in coin type 2.
+	addrA, addrB := newSSFeeTestAddr(t, 0xaa), newSSFeeTestAddr(t, 0xbb)
+	genesis, err := chain.BlockByHash(&chain.ChainParams().GenesisHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newMinerTx := func(coinType cointype.CoinType, addr stdaddr.Address, height int64, src *ssfee.AugmentSource) *dcrutil.Tx {
+		t.Helper()
+		tx, err := ssfee.NewMinerTx(coinType, 1000, addr, height, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx
+	}
+	block1 := newSSFeeTestBlock(t, genesis, newMinerTx(1, addrA, 1, nil),
+		newMinerTx(1, addrB, 1, nil), newMinerTx(2, addrA, 1, nil))
+	err = db.Update(func(dbTx database.Tx) error {
+		return idx.ConnectBlock(dbTx, block1)
+	})
+	if err != nil {
+		t.Fatalf("unexpected connect error: %v", err)
+	}
+
+	// The second block consolidates the output of the first address in coin
+	// type 1 found in the index.
+	src := &ssfee.AugmentSource{Index: idx}
+	consolidation := newMinerTx(1, addrA, 2, src)
+	if !isSSFeeConsolidation(consolidation.MsgTx()) {
+		t.Fatal("SSFee transaction does not consolidate the indexed output")
+	}
+	block2 := newSSFeeTestBlock(t, block1, consolidation)
+	err = db.Update(func(dbTx database.Tx) error {
+		return idx.ConnectBlock(dbTx, block2)
+	})
+	if err != nil {
+		t.Fatalf("unexpected connect error: %v", err)
+	}
+
+	checkEvents := func(coinType cointype.CoinType, start, end int64, want []SSFeeConsolidationEvent) {
+		t.Helper()
+		got, err := idx.ConsolidationEvents(coinType, start, end)
+		if err != nil {
+			t.Fatalf("unexpected consolidation events error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("unexpected consolidation events for coin type %d "+
+				"between heights %d and %d: got %+v, want %+v", coinType,
+				start, end, got, want)
+		}
+	}
+	event1 := SSFeeConsolidationEvent{CoinType: 1, Height: 1, Outputs: 2}
+	event2 := SSFeeConsolidationEvent{CoinType: 1, Height: 2, Outputs: 1,
+		Consolidated: 1}
+	eventSKA2 := SSFeeConsolidationEvent{CoinType: 2, Height: 1, Outputs: 1}
+	checkEvents(1, 0, 100, []SSFeeConsolidationEvent{event1, event2})
+	checkEvents(1, 2, 2, []SSFeeConsolidationEvent{event2})
+	checkEvents(2, 0, 100, []SSFeeConsolidationEvent{eventSKA2})
+	checkEvents(3, 0, 100, nil)
+	if growth := event1.Growth() + event2.Growth(); growth != 2 {
+		t.Fatalf("unexpected UTXO growth: got %d, want 2", growth)
+	}
+
+	// Disconnecting the second block removes its events only.
+	err = db.Update(func(dbTx database.Tx) error {
+		return idx.DisconnectBlock(dbTx, block2)
+	})
+	if err != nil {
+		t.Fatalf("unexpected disconnect error: %v", err)
+	}
+	checkEvents(1, 0, 100, []SSFeeConsolidationEvent{event1})
+
+	// Remove the recorded events and ensure the migration records them again
+	// for the blocks the index has connected.
+	if err := chain.AddBlock(block1); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.AddBlock(block2); err != nil {
+		t.Fatal(err)
+	}
+	err = db.Update(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(ssfeeIndexKey)
+		for _, event := range []SSFeeConsolidationEvent{event1, eventSKA2} {
+			key := makeSSFeeConsolidationKey(event.CoinType, event.Height)
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		return dbPutIndexerTip(dbTx, ssfeeIndexKey, block2.Hash(), 2)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEvents(1, 0, 100, nil)
+	if err := idx.migrateToConsolidationEvents(ctx, db); err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+	checkEvents(1, 0, 100, []SSFeeConsolidationEvent{event1, event2})
+	checkEvents(2, 0, 100, []SSFeeConsolidationEvent{eventSKA2})
+}
@@ -268,6 +268,16 @@ func (tc *testChain) FetchUtxoEntryDetails(outpoint wire.OutPoint) (int64, int64
 	return 0, 0, 0, true, nil
 }
 
+// FetchUtxoEntryDetailsBatch implements the ChainQueryer interface.
+func (tc *testChain) FetchUtxoEntryDetailsBatch(outpoints []wire.OutPoint) ([]UtxoEntryDetails, error) {
+	// Mock implementation: Report every output as spent.
+	details := make([]UtxoEntryDetails, len(outpoints))
+	for i := range details {
+		details[i].Spent = true
+	}
+	return details, nil
+}
+
 // notifyAndWait sends the provided notification and waits for done signal
 // with a one second timeout.
 func notifyAndWait(t *testing.T, subber *IndexSubscriber, ntfn *IndexNtfn) {
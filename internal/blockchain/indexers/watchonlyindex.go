@@ -0,0 +1,942 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+const (
+	// watchOnlyIndexName is the human-readable name for the index.
+	watchOnlyIndexName = "watch-only index"
+
+	// watchOnlyIndexVersion is the current version of the watch-only index.
+	watchOnlyIndexVersion = 1
+
+	// watchKeySize is the number of bytes a watch key consumes in the index.
+	// It consists of the address key followed by 1 byte coin type.
+	watchKeySize = addrKeySize + 1
+
+	// watchOnlyAddrKeySize is the size of the key of a watched address entry.
+	// Format: prefix(1) + watchKey(22) = 23 bytes
+	watchOnlyAddrKeySize = 1 + watchKeySize
+
+	// watchOnlyOutputKeySize is the size of the key of a tracked output entry.
+	// Format: prefix(1) + hash(32) + index(4) + tree(1) = 38 bytes
+	watchOnlyOutputKeySize = 1 + outpointSize
+
+	// watchOnlyOutputFixedSize is the serialized size of a tracked output
+	// entry excluding its script.
+	// Format: watchKey(22) + amount(8) + blockHeight(4) + blockIndex(4) +
+	// spentHeight(4) = 42 bytes
+	watchOnlyOutputFixedSize = watchKeySize + 20
+
+	// watchOnlyAddrPrefix is the prefix of the keys of watched address
+	// entries.
+	watchOnlyAddrPrefix = 'w'
+
+	// watchOnlyOutputPrefix is the prefix of the keys of tracked output
+	// entries.
+	watchOnlyOutputPrefix = 'u'
+
+	// watchOnlyRescanRetryInterval is the amount of time to wait before
+	// retrying the rescan of an imported address when the index is not in
+	// sync with the main chain.
+	watchOnlyRescanRetryInterval = 100 * time.Millisecond
+)
+
+var (
+	// watchOnlyIndexKey is the key of the watch-only index and the db bucket
+	// used to house it.
+	watchOnlyIndexKey = []byte("watchonlyindex")
+
+	// errWatchOnlyRescanStale is returned when the main chain or the index
+	// tip changed while rescanning the blocks for an imported address.
+	errWatchOnlyRescanStale = errors.New("watch-only rescan is stale")
+)
+
+// watchKey identifies an address of a specific coin type in the watch-only
+// index.
+type watchKey [watchKeySize]byte
+
+// makeWatchKey returns the watch key for the provided address and coin type.
+// An error is returned for unsupported address types.
+func makeWatchKey(addr stdaddr.Address, coinType cointype.CoinType) (watchKey, error) {
+	addrKey, err := addrToKey(addr)
+	if err != nil {
+		return watchKey{}, err
+	}
+	var key watchKey
+	copy(key[:], addrKey[:])
+	key[addrKeySize] = byte(coinType)
+	return key, nil
+}
+
+// watchOnlyAddrKey returns the key of the watched address entry for the
+// provided watch key.
+func watchOnlyAddrKey(key watchKey) []byte {
+	k := make([]byte, watchOnlyAddrKeySize)
+	k[0] = watchOnlyAddrPrefix
+	copy(k[1:], key[:])
+	return k
+}
+
+// watchOnlyOutputKey returns the key of the tracked output entry for the
+// provided outpoint.
+func watchOnlyOutputKey(op *wire.OutPoint) []byte {
+	k := make([]byte, watchOnlyOutputKeySize)
+	k[0] = watchOnlyOutputPrefix
+	copy(k[1:33], op.Hash[:])
+	byteOrder.PutUint32(k[33:37], op.Index)
+	k[37] = byte(op.Tree)
+	return k
+}
+
+// watchOnlyEntry houses an output paying to a watched address along with the
+// details needed to report and spend it.
+type watchOnlyEntry struct {
+	key         watchKey
+	amount      int64
+	blockHeight uint32
+	blockIndex  uint32
+	spentHeight uint32 // zero when unspent
+	pkScript    []byte
+}
+
+// serializeWatchOnlyEntry serializes the provided tracked output entry.
+//
+// The entry is serialized as: watchKey(22) + amount(8) + blockHeight(4) +
+// blockIndex(4) + spentHeight(4) + script(remaining bytes)
+func serializeWatchOnlyEntry(entry *watchOnlyEntry) []byte {
+	buf := make([]byte, watchOnlyOutputFixedSize+len(entry.pkScript))
+	copy(buf[0:watchKeySize], entry.key[:])
+	offset := watchKeySize
+	byteOrder.PutUint64(buf[offset:offset+8], uint64(entry.amount))
+	byteOrder.PutUint32(buf[offset+8:offset+12], entry.blockHeight)
+	byteOrder.PutUint32(buf[offset+12:offset+16], entry.blockIndex)
+	byteOrder.PutUint32(buf[offset+16:offset+20], entry.spentHeight)
+	copy(buf[watchOnlyOutputFixedSize:], entry.pkScript)
+	return buf
+}
+
+// deserializeWatchOnlyEntry deserializes a tracked output entry.
+func deserializeWatchOnlyEntry(data []byte) (*watchOnlyEntry, error) {
+	if len(data) < watchOnlyOutputFixedSize {
+		return nil, fmt.Errorf("truncated watch-only entry: %d bytes (need "+
+			"at least %d)", len(data), watchOnlyOutputFixedSize)
+	}
+
+	var entry watchOnlyEntry
+	copy(entry.key[:], data[0:watchKeySize])
+	offset := watchKeySize
+	entry.amount = int64(byteOrder.Uint64(data[offset : offset+8]))
+	entry.blockHeight = byteOrder.Uint32(data[offset+8 : offset+12])
+	entry.blockIndex = byteOrder.Uint32(data[offset+12 : offset+16])
+	entry.spentHeight = byteOrder.Uint32(data[offset+16 : offset+20])
+	entry.pkScript = append([]byte(nil), data[watchOnlyOutputFixedSize:]...)
+	return &entry, nil
+}
+
+// watchOnlyView tracks the changes to the outputs paying to watched addresses
+// made by connecting and disconnecting blocks before they are written to the
+// index.
+type watchOnlyView struct {
+	chainParams *chaincfg.Params
+
+	// bucket is the index bucket the view is backed by.  It is nil when the
+	// view is used to rescan blocks for a newly imported address.
+	bucket database.Bucket
+
+	// watched returns whether the provided watch key is watched.
+	watched func(key watchKey) bool
+
+	// entries houses the modified entries keyed by outpoint.  A nil entry
+	// indicates the output is no longer tracked.
+	entries map[wire.OutPoint]*watchOnlyEntry
+}
+
+// newWatchOnlyView returns a new view backed by the provided bucket that
+// tracks the outputs of the addresses the passed function reports as watched.
+func newWatchOnlyView(chainParams *chaincfg.Params, bucket database.Bucket,
+	watched func(key watchKey) bool) *watchOnlyView {
+
+	return &watchOnlyView{
+		chainParams: chainParams,
+		bucket:      bucket,
+		watched:     watched,
+		entries:     make(map[wire.OutPoint]*watchOnlyEntry),
+	}
+}
+
+// lookup returns the tracked entry for the provided outpoint or nil when the
+// output is not tracked.
+func (v *watchOnlyView) lookup(op *wire.OutPoint) (*watchOnlyEntry, error) {
+	if entry, ok := v.entries[*op]; ok {
+		return entry, nil
+	}
+	if v.bucket == nil {
+		return nil, nil
+	}
+	data := v.bucket.Get(watchOnlyOutputKey(op))
+	if data == nil {
+		return nil, nil
+	}
+	entry, err := deserializeWatchOnlyEntry(data)
+	if err != nil {
+		return nil, err
+	}
+	v.entries[*op] = entry
+	return entry, nil
+}
+
+// connectTxns tracks the outputs created by the provided transactions that
+// pay to watched addresses and marks the tracked outputs they spend as spent
+// at the provided height.
+func (v *watchOnlyView) connectTxns(txns []*dcrutil.Tx, height int64) error {
+	for txIdx, tx := range txns {
+		msgTx := tx.MsgTx()
+		for _, txIn := range msgTx.TxIn {
+			entry, err := v.lookup(&txIn.PreviousOutPoint)
+			if err != nil {
+				return err
+			}
+			if entry != nil && entry.spentHeight == 0 {
+				entry.spentHeight = uint32(height)
+			}
+		}
+
+		for txOutIdx, txOut := range msgTx.TxOut {
+			_, addrs := stdscript.ExtractAddrs(txOut.Version, txOut.PkScript,
+				v.chainParams)
+			for _, addr := range addrs {
+				key, err := makeWatchKey(addr, txOut.CoinType)
+				if err != nil || !v.watched(key) {
+					continue
+				}
+
+				op := wire.OutPoint{
+					Hash:  *tx.Hash(),
+					Index: uint32(txOutIdx),
+					Tree:  tx.Tree(),
+				}
+				v.entries[op] = &watchOnlyEntry{
+					key:         key,
+					amount:      txOut.Value,
+					blockHeight: uint32(height),
+					blockIndex:  uint32(txIdx),
+					pkScript:    txOut.PkScript,
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// disconnectTxns reverses connectTxns for the provided transactions that were
+// connected at the provided height.
+func (v *watchOnlyView) disconnectTxns(txns []*dcrutil.Tx, height int64) error {
+	for txIdx := len(txns) - 1; txIdx >= 0; txIdx-- {
+		tx := txns[txIdx]
+		msgTx := tx.MsgTx()
+		for txOutIdx := range msgTx.TxOut {
+			op := wire.OutPoint{
+				Hash:  *tx.Hash(),
+				Index: uint32(txOutIdx),
+				Tree:  tx.Tree(),
+			}
+			entry, err := v.lookup(&op)
+			if err != nil {
+				return err
+			}
+			if entry != nil {
+				v.entries[op] = nil
+			}
+		}
+
+		for _, txIn := range msgTx.TxIn {
+			entry, err := v.lookup(&txIn.PreviousOutPoint)
+			if err != nil {
+				return err
+			}
+			if entry != nil && entry.spentHeight == uint32(height) {
+				entry.spentHeight = 0
+			}
+		}
+	}
+	return nil
+}
+
+// connectBlock updates the view for the connection of the provided block,
+// including undoing the regular transaction tree of its parent when the block
+// disapproves it.
+func (v *watchOnlyView) connectBlock(block, parent *dcrutil.Block) error {
+	if !headerApprovesParent(&block.MsgBlock().Header) {
+		err := v.disconnectTxns(parent.Transactions(), parent.Height())
+		if err != nil {
+			return err
+		}
+	}
+	err := v.connectTxns(block.Transactions(), block.Height())
+	if err != nil {
+		return err
+	}
+	return v.connectTxns(block.STransactions(), block.Height())
+}
+
+// disconnectBlock updates the view for the disconnection of the provided
+// block, including restoring the regular transaction tree of its parent when
+// the block disapproves it.
+func (v *watchOnlyView) disconnectBlock(block, parent *dcrutil.Block) error {
+	err := v.disconnectTxns(block.STransactions(), block.Height())
+	if err != nil {
+		return err
+	}
+	err = v.disconnectTxns(block.Transactions(), block.Height())
+	if err != nil {
+		return err
+	}
+	if !headerApprovesParent(&block.MsgBlock().Header) {
+		return v.connectTxns(parent.Transactions(), parent.Height())
+	}
+	return nil
+}
+
+// flush writes all of the modified entries in the view to the provided
+// bucket.
+func (v *watchOnlyView) flush(bucket database.Bucket) error {
+	for op, entry := range v.entries {
+		key := watchOnlyOutputKey(&op)
+		if entry == nil {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := bucket.Put(key, serializeWatchOnlyEntry(entry)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// headerApprovesParent returns whether or not the vote bits in the passed
+// header indicate the regular transaction tree of the parent block should be
+// considered valid.
+func headerApprovesParent(header *wire.BlockHeader) bool {
+	return dcrutil.IsFlagSet16(header.VoteBits, dcrutil.BlockValid)
+}
+
+// WatchedAddress describes an address of a specific coin type that is watched
+// by the watch-only index.
+type WatchedAddress struct {
+	Address  string
+	CoinType cointype.CoinType
+}
+
+// WatchOnlyUtxo describes an unspent output paying to a watched address.
+type WatchOnlyUtxo struct {
+	OutPoint    wire.OutPoint
+	Address     string
+	CoinType    cointype.CoinType
+	Amount      int64
+	PkScript    []byte
+	BlockHeight int64
+	BlockIndex  uint32
+}
+
+// WatchOnlyReceived describes an output that paid to a watched address
+// regardless of whether or not it has since been spent.
+type WatchOnlyReceived struct {
+	OutPoint    wire.OutPoint
+	Amount      int64
+	BlockHeight int64
+
+	// SpentHeight is the height of the block that spent the output.  It is
+	// zero when the output is unspent.
+	SpentHeight int64
+}
+
+// WatchOnlyIndex implements an index that tracks the outputs paying to a list
+// of watched addresses per coin type so their unspent outputs and balances
+// can be queried without a wallet.
+//
+// Outputs are only tracked for addresses once they are imported.  Importing
+// an address may optionally rescan the blocks from a given height to pick up
+// the outputs that paid to it before it was imported.
+//
+// Spent outputs are kept along with the height they were spent at so they can
+// be restored when blocks are disconnected or when the regular transaction
+// tree that spent them is disapproved.
+type WatchOnlyIndex struct {
+	// The following fields are set when the instance is created and can't
+	// be changed afterwards, so there is no need to protect them with a
+	// separate mutex.
+	db    database.DB
+	chain ChainQueryer
+	sub   *IndexSubscription
+
+	// subscribers is a map of clients that are waiting for the index to
+	// signal it has completed syncing.
+	subscribers map[chan bool]struct{}
+
+	// mtx protects concurrent access to the subscribers map.
+	mtx sync.Mutex
+
+	// cancel enables the caller to cancel long running operations.
+	cancel context.CancelFunc
+}
+
+// Ensure WatchOnlyIndex implements the Indexer interface.
+var _ Indexer = (*WatchOnlyIndex)(nil)
+
+// Ensure WatchOnlyIndex implements the IndexDropper interface.
+var _ IndexDropper = (*WatchOnlyIndex)(nil)
+
+// NewWatchOnlyIndex returns a new instance of an indexer that tracks the
+// outputs paying to watched addresses per coin type.
+func NewWatchOnlyIndex(subscriber *IndexSubscriber, db database.DB, chain ChainQueryer) (*WatchOnlyIndex, error) {
+	idx := &WatchOnlyIndex{
+		db:          db,
+		chain:       chain,
+		subscribers: make(map[chan bool]struct{}),
+		cancel:      subscriber.cancel,
+	}
+	sub, err := subscriber.Subscribe(idx, noPrereqs)
+	if err != nil {
+		return nil, err
+	}
+	idx.sub = sub
+	err = idx.Init(subscriber.ctx, chain.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Key returns the key of the index as a byte slice.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) Key() []byte {
+	return watchOnlyIndexKey
+}
+
+// Name returns the human-readable name of the index.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) Name() string {
+	return watchOnlyIndexName
+}
+
+// Version returns the current version of the index.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) Version() uint32 {
+	return watchOnlyIndexVersion
+}
+
+// DB returns the database of the index.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) DB() database.DB {
+	return idx.db
+}
+
+// Queryer returns the chain queryer.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) Queryer() ChainQueryer {
+	return idx.chain
+}
+
+// Tip returns the current tip of the index.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) Tip() (int64, *chainhash.Hash, error) {
+	return tip(idx.db, idx.Key())
+}
+
+// Create is invoked when the indexer is being created.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) Create(dbTx database.Tx) error {
+	_, err := dbTx.Metadata().CreateBucketIfNotExists(watchOnlyIndexKey)
+	return err
+}
+
+// Init is invoked when the index is being initialized.
+// This differs from the Create method in that it is called on
+// every load, including the case the index was just created.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) Init(ctx context.Context, chainParams *chaincfg.Params) error {
+	if interruptRequested(ctx) {
+		return indexerError(ErrInterruptRequested, interruptMsg)
+	}
+
+	// Finish any drops that were previously interrupted.
+	if err := finishDrop(ctx, idx); err != nil {
+		return err
+	}
+
+	// Create the initial state for the index as needed.
+	if err := createIndex(idx, &chainParams.GenesisHash); err != nil {
+		return err
+	}
+
+	// Upgrade the index as needed.
+	if err := upgradeIndex(ctx, idx, &chainParams.GenesisHash); err != nil {
+		return err
+	}
+
+	// Recover the watch-only index to the main chain if needed.
+	return recoverIndex(ctx, idx)
+}
+
+// IndexSubscription returns the subscription for index updates.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) IndexSubscription() *IndexSubscription {
+	return idx.sub
+}
+
+// WaitForSync subscribes clients for the next index sync update.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) WaitForSync() chan bool {
+	c := make(chan bool)
+	idx.mtx.Lock()
+	idx.subscribers[c] = struct{}{}
+	idx.mtx.Unlock()
+	return c
+}
+
+// NotifySyncSubscribers signals subscribers of an index sync update.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) NotifySyncSubscribers() {
+	idx.mtx.Lock()
+	notifySyncSubscribers(idx.subscribers)
+	idx.mtx.Unlock()
+}
+
+// isWatched returns whether the provided watch key is watched according to
+// the passed index bucket.
+func isWatched(bucket database.Bucket, key watchKey) bool {
+	return bucket.Get(watchOnlyAddrKey(key)) != nil
+}
+
+// connectBlock updates the tracked outputs for the connection of the passed
+// block.
+func (idx *WatchOnlyIndex) connectBlock(dbTx database.Tx, block, parent *dcrutil.Block) error {
+	bucket := dbTx.Metadata().Bucket(watchOnlyIndexKey)
+	view := newWatchOnlyView(idx.chain.ChainParams(), bucket,
+		func(key watchKey) bool { return isWatched(bucket, key) })
+	if err := view.connectBlock(block, parent); err != nil {
+		return err
+	}
+	if err := view.flush(bucket); err != nil {
+		return err
+	}
+
+	// Update the current index tip.
+	return dbPutIndexerTip(dbTx, idx.Key(), block.Hash(), int32(block.Height()))
+}
+
+// disconnectBlock updates the tracked outputs for the disconnection of the
+// passed block.
+func (idx *WatchOnlyIndex) disconnectBlock(dbTx database.Tx, block, parent *dcrutil.Block) error {
+	bucket := dbTx.Metadata().Bucket(watchOnlyIndexKey)
+	view := newWatchOnlyView(idx.chain.ChainParams(), bucket,
+		func(key watchKey) bool { return isWatched(bucket, key) })
+	if err := view.disconnectBlock(block, parent); err != nil {
+		return err
+	}
+	if err := view.flush(bucket); err != nil {
+		return err
+	}
+
+	// Update the current index tip.
+	return dbPutIndexerTip(dbTx, idx.Key(), &block.MsgBlock().Header.PrevBlock,
+		int32(block.Height()-1))
+}
+
+// ProcessNotification indexes the provided notification based on its
+// notification type.
+//
+// This is part of the Indexer interface.
+func (idx *WatchOnlyIndex) ProcessNotification(dbTx database.Tx, ntfn *IndexNtfn) error {
+	switch ntfn.NtfnType {
+	case ConnectNtfn:
+		err := idx.connectBlock(dbTx, ntfn.Block, ntfn.Parent)
+		if err != nil {
+			msg := fmt.Sprintf("%s: unable to connect block: %v",
+				idx.Name(), err)
+			return indexerError(ErrConnectBlock, msg)
+		}
+
+	case DisconnectNtfn:
+		err := idx.disconnectBlock(dbTx, ntfn.Block, ntfn.Parent)
+		if err != nil {
+			msg := fmt.Sprintf("%s: unable to disconnect block: %v",
+				idx.Name(), err)
+			return indexerError(ErrDisconnectBlock, msg)
+		}
+
+	default:
+		msg := fmt.Sprintf("%s: unknown notification type received: %d",
+			idx.Name(), ntfn.NtfnType)
+		return indexerError(ErrInvalidNotificationType, msg)
+	}
+
+	return nil
+}
+
+// rescan scans the main chain blocks from the provided start height through
+// the provided end height and applies them to the passed view.  The hash of
+// the block before the start height must be provided when known so the scan
+// can detect that the main chain changed since the prior scan.  It returns the
+// hash of the last scanned block.
+//
+// errWatchOnlyRescanStale is returned when the scanned blocks are not all
+// part of the same chain.
+func (idx *WatchOnlyIndex) rescan(ctx context.Context, view *watchOnlyView,
+	start, end int64, prevHash *chainhash.Hash) (*chainhash.Hash, error) {
+
+	var parent *dcrutil.Block
+	for height := start; height <= end; height++ {
+		if interruptRequested(ctx) {
+			return nil, indexerError(ErrInterruptRequested, interruptMsg)
+		}
+
+		hash, err := idx.chain.BlockHashByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		block, err := idx.chain.BlockByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		header := &block.MsgBlock().Header
+		if prevHash != nil && header.PrevBlock != *prevHash {
+			return nil, errWatchOnlyRescanStale
+		}
+		if parent == nil {
+			parent, err = idx.chain.BlockByHash(&header.PrevBlock)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := view.connectBlock(block, parent); err != nil {
+			return nil, err
+		}
+		parent, prevHash = block, hash
+	}
+	return prevHash, nil
+}
+
+// waitForRescanRetry waits before the rescan of an imported address is
+// retried.  It returns early with an error when the provided context is
+// cancelled.
+func waitForRescanRetry(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return indexerError(ErrInterruptRequested, interruptMsg)
+	case <-time.After(watchOnlyRescanRetryInterval):
+		return nil
+	}
+}
+
+// ImportAddress adds the provided address of the given coin type to the list
+// of watched addresses.  Importing an address that is already watched has no
+// effect.
+//
+// When rescanFrom is not negative, the main chain blocks from that height
+// through the current index tip are scanned for outputs paying to the address
+// before it is added, so that outputs created before the address was imported
+// are tracked as well.  Otherwise, only outputs created in blocks connected
+// after the address is imported are tracked.
+func (idx *WatchOnlyIndex) ImportAddress(ctx context.Context, addr stdaddr.Address,
+	coinType cointype.CoinType, rescanFrom int64) error {
+
+	key, err := makeWatchKey(addr, coinType)
+	if err != nil {
+		return err
+	}
+	addrKey := watchOnlyAddrKey(key)
+	watched := func(k watchKey) bool { return k == key }
+
+	var exists bool
+	err = idx.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(watchOnlyIndexKey)
+		exists = bucket.Get(addrKey) != nil
+		return nil
+	})
+	if err != nil || exists {
+		return err
+	}
+
+	// Scan the blocks through the current index tip and then add the address
+	// along with the outputs found by the scan in a single database update
+	// that fails when the index tip changed in the meantime.  The blocks the
+	// index connected while the scan was in progress are scanned as well
+	// until the scan catches up with the index, and the scan is started over
+	// when the scanned blocks are no longer part of the main chain.
+	start := max(rescanFrom, 1)
+	var view *watchOnlyView
+	var scannedHeight int64
+	var scannedHash *chainhash.Hash
+	reset := func() {
+		view = newWatchOnlyView(idx.chain.ChainParams(), nil, watched)
+		scannedHeight, scannedHash = start-1, nil
+	}
+	reset()
+	for {
+		tipHeight, tipHash, err := idx.Tip()
+		if err != nil {
+			return err
+		}
+
+		if rescanFrom >= 0 {
+			if scannedHash != nil && !idx.chain.MainChainHasBlock(scannedHash) {
+				reset()
+			}
+
+			// Wait for the index to catch up when its tip is not part of the
+			// main chain or is behind the scanned blocks.
+			if !idx.chain.MainChainHasBlock(tipHash) || tipHeight < scannedHeight {
+				if err := waitForRescanRetry(ctx); err != nil {
+					return err
+				}
+				continue
+			}
+
+			hash, err := idx.rescan(ctx, view, scannedHeight+1, tipHeight,
+				scannedHash)
+			if errors.Is(err, errWatchOnlyRescanStale) {
+				reset()
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			scannedHeight, scannedHash = max(scannedHeight, tipHeight), hash
+		}
+
+		err = idx.db.Update(func(dbTx database.Tx) error {
+			curHash, _, err := dbFetchIndexerTip(dbTx, idx.Key())
+			if err != nil {
+				return err
+			}
+			if rescanFrom >= 0 && *curHash != *tipHash {
+				return errWatchOnlyRescanStale
+			}
+
+			bucket := dbTx.Metadata().Bucket(watchOnlyIndexKey)
+			if err := bucket.Put(addrKey, []byte(addr.String())); err != nil {
+				return err
+			}
+			return view.flush(bucket)
+		})
+		if errors.Is(err, errWatchOnlyRescanStale) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		log.Infof("%s: imported %s address %s", idx.Name(), coinType, addr)
+		return nil
+	}
+}
+
+// RemoveAddress removes the provided address of the given coin type from the
+// list of watched addresses along with all of the outputs tracked for it.  It
+// returns whether the address was watched.
+func (idx *WatchOnlyIndex) RemoveAddress(addr stdaddr.Address, coinType cointype.CoinType) (bool, error) {
+	key, err := makeWatchKey(addr, coinType)
+	if err != nil {
+		return false, err
+	}
+
+	var removed bool
+	err = idx.db.Update(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(watchOnlyIndexKey)
+		addrKey := watchOnlyAddrKey(key)
+		if bucket.Get(addrKey) == nil {
+			return nil
+		}
+		removed = true
+
+		var outputKeys [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			if len(k) != watchOnlyOutputKeySize ||
+				k[0] != watchOnlyOutputPrefix ||
+				!bytes.HasPrefix(v, key[:]) {
+
+				return nil
+			}
+			outputKeys = append(outputKeys, append([]byte(nil), k...))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range outputKeys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return bucket.Delete(addrKey)
+	})
+	return removed, err
+}
+
+// WatchedAddresses returns all of the addresses watched by the index.
+func (idx *WatchOnlyIndex) WatchedAddresses() ([]WatchedAddress, error) {
+	var addrs []WatchedAddress
+	err := idx.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(watchOnlyIndexKey)
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) != watchOnlyAddrKeySize || k[0] != watchOnlyAddrPrefix {
+				return nil
+			}
+			addrs = append(addrs, WatchedAddress{
+				Address:  string(v),
+				CoinType: cointype.CoinType(k[watchOnlyAddrKeySize-1]),
+			})
+			return nil
+		})
+	})
+	return addrs, err
+}
+
+// UnspentOutputs returns all of the unspent outputs paying to the addresses
+// watched by the index as of the current index tip.
+func (idx *WatchOnlyIndex) UnspentOutputs() ([]WatchOnlyUtxo, error) {
+	var utxos []WatchOnlyUtxo
+	err := idx.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(watchOnlyIndexKey)
+		addrs := make(map[watchKey]string)
+		err := bucket.ForEach(func(k, v []byte) error {
+			if len(k) == watchOnlyAddrKeySize && k[0] == watchOnlyAddrPrefix {
+				var key watchKey
+				copy(key[:], k[1:])
+				addrs[key] = string(v)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) != watchOnlyOutputKeySize || k[0] != watchOnlyOutputPrefix {
+				return nil
+			}
+			entry, err := deserializeWatchOnlyEntry(v)
+			if err != nil {
+				return err
+			}
+			if entry.spentHeight != 0 {
+				return nil
+			}
+
+			var op wire.OutPoint
+			copy(op.Hash[:], k[1:33])
+			op.Index = byteOrder.Uint32(k[33:37])
+			op.Tree = int8(k[37])
+			utxos = append(utxos, WatchOnlyUtxo{
+				OutPoint:    op,
+				Address:     addrs[entry.key],
+				CoinType:    cointype.CoinType(entry.key[addrKeySize]),
+				Amount:      entry.amount,
+				PkScript:    entry.pkScript,
+				BlockHeight: int64(entry.blockHeight),
+				BlockIndex:  entry.blockIndex,
+			})
+			return nil
+		})
+	})
+	return utxos, err
+}
+
+// ReceivedOutputs returns all of the outputs, both spent and unspent, that paid
+// to the provided watched address of the given coin type as of the current
+// index tip.  Only the outputs created after the address was imported, or by
+// the blocks rescanned when it was imported, are tracked.
+//
+// ErrAddressNotWatched is returned when the address is not watched for the
+// coin type.
+func (idx *WatchOnlyIndex) ReceivedOutputs(addr stdaddr.Address, coinType cointype.CoinType) ([]WatchOnlyReceived, error) {
+	key, err := makeWatchKey(addr, coinType)
+	if err != nil {
+		return nil, err
+	}
+
+	var received []WatchOnlyReceived
+	err = idx.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(watchOnlyIndexKey)
+		if !isWatched(bucket, key) {
+			str := fmt.Sprintf("%s address %s is not watched", coinType,
+				addr)
+			return indexerError(ErrAddressNotWatched, str)
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) != watchOnlyOutputKeySize ||
+				k[0] != watchOnlyOutputPrefix ||
+				!bytes.HasPrefix(v, key[:]) {
+
+				return nil
+			}
+			entry, err := deserializeWatchOnlyEntry(v)
+			if err != nil {
+				return err
+			}
+
+			var op wire.OutPoint
+			copy(op.Hash[:], k[1:33])
+			op.Index = byteOrder.Uint32(k[33:37])
+			op.Tree = int8(k[37])
+			received = append(received, WatchOnlyReceived{
+				OutPoint:    op,
+				Amount:      entry.amount,
+				BlockHeight: int64(entry.blockHeight),
+				SpentHeight: int64(entry.spentHeight),
+			})
+			return nil
+		})
+	})
+	return received, err
+}
+
+// DropWatchOnlyIndex drops the watch-only index from the provided database if
+// it exists.
+func DropWatchOnlyIndex(ctx context.Context, db database.DB) error {
+	return dropFlatIndex(ctx, db, watchOnlyIndexKey, watchOnlyIndexName)
+}
+
+// DropIndex drops the watch-only index from the provided database if it
+// exists.
+//
+// This is part of the IndexDropper interface.
+func (*WatchOnlyIndex) DropIndex(ctx context.Context, db database.DB) error {
+	return DropWatchOnlyIndex(ctx, db)
+}
@@ -0,0 +1,404 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/chaingen"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestWatchOnlyEntrySerialization ensures tracked output entries round trip
+// through serialization and truncated entries are rejected.
+func TestWatchOnlyEntrySerialization(t *testing.T) {
+	t.Parallel()
+
+	entry := &watchOnlyEntry{
+		amount:      123456789,
+		blockHeight: 100,
+		blockIndex:  3,
+		spentHeight: 105,
+		pkScript:    []byte{0x76, 0xa9, 0x14},
+	}
+	entry.key[0] = 1
+	entry.key[addrKeySize] = 2
+
+	serialized := serializeWatchOnlyEntry(entry)
+	if len(serialized) != watchOnlyOutputFixedSize+len(entry.pkScript) {
+		t.Fatalf("unexpected serialized size -- got %d, want %d",
+			len(serialized), watchOnlyOutputFixedSize+len(entry.pkScript))
+	}
+
+	got, err := deserializeWatchOnlyEntry(serialized)
+	if err != nil {
+		t.Fatalf("unexpected deserialization error: %v", err)
+	}
+	if got.key != entry.key || got.amount != entry.amount ||
+		got.blockHeight != entry.blockHeight ||
+		got.blockIndex != entry.blockIndex ||
+		got.spentHeight != entry.spentHeight ||
+		!bytes.Equal(got.pkScript, entry.pkScript) {
+
+		t.Fatalf("mismatched entry -- got %+v, want %+v", got, entry)
+	}
+
+	_, err = deserializeWatchOnlyEntry(serialized[:watchOnlyOutputFixedSize-1])
+	if err == nil {
+		t.Fatal("expected error deserializing truncated entry")
+	}
+}
+
+// TestWatchOnlyView ensures the watch-only view tracks the outputs paying to
+// watched addresses per coin type, marks them spent, and undoes both when
+// transactions are disconnected or disapproved.
+func TestWatchOnlyView(t *testing.T) {
+	t.Parallel()
+
+	params := chaincfg.SimNetParams()
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		bytes.Repeat([]byte{0x01}, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, pkScript := addr.PaymentScript()
+	watchedKey, err := makeWatchKey(addr, cointype.CoinType(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	view := newWatchOnlyView(params, nil, func(key watchKey) bool {
+		return key == watchedKey
+	})
+
+	// Create a transaction that pays the watched address in both VAR and the
+	// watched SKA coin type along with one that spends the SKA output.
+	fundTx := wire.NewMsgTx()
+	fundTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 0, nil))
+	fundTx.AddTxOut(wire.NewTxOut(1000, pkScript))
+	fundTx.AddTxOut(wire.NewTxOutWithCoinType(2000, cointype.CoinType(1),
+		pkScript))
+	fundOp := wire.OutPoint{Hash: fundTx.TxHash(), Index: 1}
+
+	spendTx := wire.NewMsgTx()
+	spendTx.AddTxIn(wire.NewTxIn(&fundOp, 2000, nil))
+	spendTx.AddTxOut(wire.NewTxOut(900, pkScript))
+
+	// regularTxns returns the passed transactions as regular tree
+	// transactions as they would be when connected in a block.
+	regularTxns := func(msgTxns ...*wire.MsgTx) []*dcrutil.Tx {
+		txns := make([]*dcrutil.Tx, 0, len(msgTxns))
+		for _, msgTx := range msgTxns {
+			tx := dcrutil.NewTx(msgTx)
+			tx.SetTree(wire.TxTreeRegular)
+			txns = append(txns, tx)
+		}
+		return txns
+	}
+
+	err = view.connectTxns(regularTxns(fundTx), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(view.entries) != 1 {
+		t.Fatalf("unexpected number of tracked outputs -- got %d, want 1",
+			len(view.entries))
+	}
+	entry := view.entries[fundOp]
+	if entry == nil || entry.amount != 2000 || entry.blockHeight != 10 ||
+		entry.spentHeight != 0 {
+
+		t.Fatalf("unexpected tracked output %+v", entry)
+	}
+
+	// Ensure spending the output marks it spent at the spending height and
+	// disconnecting the spend restores it.
+	spendTxns := regularTxns(spendTx)
+	if err := view.connectTxns(spendTxns, 11); err != nil {
+		t.Fatal(err)
+	}
+	if entry.spentHeight != 11 {
+		t.Fatalf("unexpected spent height -- got %d, want 11",
+			entry.spentHeight)
+	}
+	if err := view.disconnectTxns(spendTxns, 11); err != nil {
+		t.Fatal(err)
+	}
+	if entry.spentHeight != 0 {
+		t.Fatalf("unexpected spent height -- got %d, want 0",
+			entry.spentHeight)
+	}
+
+	// Ensure a block that disapproves its parent undoes the spends in the
+	// regular tree of the parent and disconnecting it redoes them.
+	parent := dcrutil.NewBlock(&wire.MsgBlock{
+		Header:       wire.BlockHeader{Height: 11, VoteBits: dcrutil.BlockValid},
+		Transactions: []*wire.MsgTx{spendTx},
+	})
+	block := dcrutil.NewBlock(&wire.MsgBlock{
+		Header: wire.BlockHeader{Height: 12},
+	})
+	if err := view.connectTxns(parent.Transactions(), 11); err != nil {
+		t.Fatal(err)
+	}
+	if err := view.connectBlock(block, parent); err != nil {
+		t.Fatal(err)
+	}
+	if entry.spentHeight != 0 {
+		t.Fatalf("unexpected spent height after disapproval -- got %d, "+
+			"want 0", entry.spentHeight)
+	}
+	if err := view.disconnectBlock(block, parent); err != nil {
+		t.Fatal(err)
+	}
+	if entry.spentHeight != 11 {
+		t.Fatalf("unexpected spent height after disconnect -- got %d, "+
+			"want 11", entry.spentHeight)
+	}
+
+	// Ensure disconnecting the funding transaction stops tracking the output.
+	if err := view.disconnectTxns(parent.Transactions(), 11); err != nil {
+		t.Fatal(err)
+	}
+	err = view.disconnectTxns(regularTxns(fundTx), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry, ok := view.entries[fundOp]; !ok || entry != nil {
+		t.Fatalf("expected output to no longer be tracked, got %+v", entry)
+	}
+}
+
+// watchOnlyOutputs returns the number of outputs of the provided coin type in
+// the passed blocks that pay to the provided address along with their total
+// amount.
+func watchOnlyOutputs(blocks []*dcrutil.Block, addr stdaddr.Address,
+	coinType cointype.CoinType, params *chaincfg.Params) (int, int64) {
+
+	var count int
+	var total int64
+	for _, block := range blocks {
+		msgBlock := block.MsgBlock()
+		for _, txns := range [][]*wire.MsgTx{msgBlock.Transactions,
+			msgBlock.STransactions} {
+
+			for _, tx := range txns {
+				for _, txOut := range tx.TxOut {
+					if txOut.CoinType != coinType {
+						continue
+					}
+					_, addrs := stdscript.ExtractAddrs(txOut.Version,
+						txOut.PkScript, params)
+					if len(addrs) > 0 && addrs[0].String() == addr.String() {
+						count++
+						total += txOut.Value
+					}
+				}
+			}
+		}
+	}
+	return count, total
+}
+
+// TestWatchOnlyIndexAsync ensures the watch-only index tracks the outputs of
+// imported addresses when rescanning and when receiving updates
+// asynchronously.
+func TestWatchOnlyIndexAsync(t *testing.T) {
+	db := setupDB(t)
+
+	chain, err := newTestChain()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := chaingen.MakeGenerator(chaincfg.SimNetParams())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Add three blocks to the chain.
+	bk1 := addBlock(t, chain, &g, "bk1")
+	bk2 := addBlock(t, chain, &g, "bk2")
+	bk3 := addBlock(t, chain, &g, "bk3")
+
+	// Initialize the watch-only index.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subber := NewIndexSubscriber(ctx)
+	go subber.Run(ctx)
+
+	idx, err := NewWatchOnlyIndex(subber, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = subber.CatchUp(ctx, db, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure the index got synced to bk3 on initialization.
+	tipHeight, tipHash, err := idx.Tip()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tipHeight != bk3.Height() || *tipHash != *bk3.Hash() {
+		t.Fatalf("expected tip to be %s (%d), got %s (%d)", bk3.Hash(),
+			bk3.Height(), tipHash, tipHeight)
+	}
+
+	// Fetch the first spendable address paid to by bk3's coinbase.
+	// Output 0 is dev subsidy (nil PkScript in Monetarium), output 1 is
+	// OP_RETURN, output 2 is the first PoW subsidy output with a valid p2sh
+	// address.
+	params := idx.chain.ChainParams()
+	out := bk3.MsgBlock().Transactions[0].TxOut[2]
+	_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, params)
+	addr := addrs[0]
+
+	assertUnspent := func(blocks []*dcrutil.Block) {
+		t.Helper()
+
+		wantCount, wantTotal := watchOnlyOutputs(blocks, addr,
+			cointype.CoinTypeVAR, params)
+		utxos, err := idx.UnspentOutputs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var total int64
+		for _, utxo := range utxos {
+			if utxo.Address != addr.String() ||
+				utxo.CoinType != cointype.CoinTypeVAR {
+
+				t.Fatalf("unexpected unspent output %+v", utxo)
+			}
+			total += utxo.Amount
+		}
+		if len(utxos) != wantCount || total != wantTotal {
+			t.Fatalf("unexpected unspent outputs -- got %d totaling %d, "+
+				"want %d totaling %d", len(utxos), total, wantCount,
+				wantTotal)
+		}
+	}
+
+	// Ensure importing the address with a rescan picks up the outputs paid to
+	// it by the blocks already in the chain and that importing it again has
+	// no effect.
+	if wantCount, _ := watchOnlyOutputs([]*dcrutil.Block{bk2, bk3}, addr,
+		cointype.CoinTypeVAR, params); wantCount == 0 {
+
+		t.Fatalf("expected coinbase outputs paying to %s", addr)
+	}
+	for i := 0; i < 2; i++ {
+		err = idx.ImportAddress(ctx, addr, cointype.CoinTypeVAR, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertUnspent([]*dcrutil.Block{bk2, bk3})
+	}
+
+	// Ensure importing the address for another coin type without a rescan
+	// adds it to the watched addresses without tracking any outputs.
+	skaCoinType := cointype.CoinType(1)
+	err = idx.ImportAddress(ctx, addr, skaCoinType, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watched, err := idx.WatchedAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(watched) != 2 {
+		t.Fatalf("unexpected number of watched addresses -- got %d, want 2",
+			len(watched))
+	}
+	for _, w := range watched {
+		if w.Address != addr.String() {
+			t.Fatalf("unexpected watched address %s", w.Address)
+		}
+	}
+	assertUnspent([]*dcrutil.Block{bk2, bk3})
+
+	// Ensure the received outputs of the address match the outputs paid to it
+	// and that querying an address that is not watched for a coin type is
+	// rejected.
+	received, err := idx.ReceivedOutputs(addr, cointype.CoinTypeVAR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCount, wantTotal := watchOnlyOutputs([]*dcrutil.Block{bk2, bk3}, addr,
+		cointype.CoinTypeVAR, params)
+	var receivedTotal int64
+	for _, r := range received {
+		receivedTotal += r.Amount
+	}
+	if len(received) != wantCount || receivedTotal != wantTotal {
+		t.Fatalf("unexpected received outputs -- got %d totaling %d, want "+
+			"%d totaling %d", len(received), receivedTotal, wantCount,
+			wantTotal)
+	}
+	_, err = idx.ReceivedOutputs(addr, cointype.CoinType(2))
+	if !errors.Is(err, ErrAddressNotWatched) {
+		t.Fatalf("unexpected error for unwatched address -- got %v, want %v",
+			err, ErrAddressNotWatched)
+	}
+
+	// Ensure the outputs of newly connected blocks are tracked.
+	bk4 := addBlock(t, chain, &g, "bk4")
+	ntfn := &IndexNtfn{
+		NtfnType: ConnectNtfn,
+		Block:    bk4,
+		Parent:   bk3,
+	}
+	notifyAndWait(t, subber, ntfn)
+	assertUnspent([]*dcrutil.Block{bk2, bk3, bk4})
+
+	// Ensure the outputs of disconnected blocks are no longer tracked.
+	err = chain.RemoveBlock(bk4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.SetTip("bk3")
+	ntfn = &IndexNtfn{
+		NtfnType: DisconnectNtfn,
+		Block:    bk4,
+		Parent:   bk3,
+	}
+	notifyAndWait(t, subber, ntfn)
+	assertUnspent([]*dcrutil.Block{bk2, bk3})
+
+	// Ensure removing the address stops tracking its outputs and removing it
+	// again reports it is no longer watched.
+	removed, err := idx.RemoveAddress(addr, cointype.CoinTypeVAR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !removed {
+		t.Fatalf("expected %s to be removed", addr)
+	}
+	assertUnspent(nil)
+	removed, err = idx.RemoveAddress(addr, cointype.CoinTypeVAR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed {
+		t.Fatalf("expected %s to no longer be watched", addr)
+	}
+
+	// Ensure a full rescan picks up the outputs of every block.
+	err = idx.ImportAddress(ctx, addr, cointype.CoinTypeVAR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertUnspent([]*dcrutil.Block{bk1, bk2, bk3})
+}
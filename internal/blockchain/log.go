@@ -15,6 +15,7 @@ import (
 // The default amount of logging is none.
 var (
 	log     = slog.Disabled
+	skaeLog = slog.Disabled
 	trsyLog = slog.Disabled
 )
 
@@ -23,6 +24,12 @@ func UseLogger(logger slog.Logger) {
 	log = logger
 }
 
+// UseSKAEmissionLogger uses a specified Logger to output SKA emission logging
+// info.
+func UseSKAEmissionLogger(logger slog.Logger) {
+	skaeLog = logger
+}
+
 // UseTreasuryLogger uses a specified Logger to output treasury logging info.
 func UseTreasuryLogger(logger slog.Logger) {
 	trsyLog = logger
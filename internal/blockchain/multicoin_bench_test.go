@@ -0,0 +1,214 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/standalone"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/sign"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// multiCoinComposition describes the number of transactions of each coin type
+// in a synthetic multi-coin block.
+type multiCoinComposition struct {
+	name    string
+	numTxns []coinTypeTxns
+}
+
+// coinTypeTxns is the number of transactions of a single coin type.
+type coinTypeTxns struct {
+	coinType cointype.CoinType
+	count    int
+}
+
+// multiCoinCompositions is the corpus of block compositions the multi-coin
+// benchmarks are run against.  The total number of transactions is the same
+// for all of them so results are comparable across compositions.
+var multiCoinCompositions = []multiCoinComposition{{
+	name:    "VAR only",
+	numTxns: []coinTypeTxns{{cointype.CoinTypeVAR, 1000}},
+}, {
+	name:    "balanced",
+	numTxns: []coinTypeTxns{{cointype.CoinTypeVAR, 500}, {1, 500}},
+}, {
+	name:    "SKA heavy",
+	numTxns: []coinTypeTxns{{cointype.CoinTypeVAR, 100}, {1, 900}},
+}, {
+	name: "SKA split",
+	numTxns: []coinTypeTxns{{cointype.CoinTypeVAR, 100}, {1, 450},
+		{2, 450}},
+}}
+
+// multiCoinBenchParams returns the main network parameters with every
+// configured SKA coin type active so all compositions are valid.
+func multiCoinBenchParams() *chaincfg.Params {
+	params := chaincfg.MainNetParams()
+	for _, config := range params.SKACoins {
+		config.Active = true
+	}
+	return params
+}
+
+// multiCoinBlock houses a synthetic block with a mixed VAR/SKA composition
+// along with the transactions that fund it.
+type multiCoinBlock struct {
+	height  int64
+	block   *dcrutil.Block
+	funding []*dcrutil.Tx
+}
+
+// genMultiCoinBlock deterministically generates a synthetic block at the
+// provided height that contains signed pay-to-pubkey-hash transactions of the
+// provided composition.  Each transaction spends a separate funding output of
+// its coin type and pays a fee in it.
+func genMultiCoinBlock(b *testing.B, params *chaincfg.Params, comp multiCoinComposition, height int64) *multiCoinBlock {
+	b.Helper()
+
+	var keyBytes [32]byte
+	keyBytes[31] = 0x01
+	pubKey := secp256k1.PrivKeyFromBytes(keyBytes[:]).PubKey()
+	h160 := stdaddr.Hash160(pubKey.SerializeCompressed())
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(h160, params)
+	if err != nil {
+		b.Fatalf("unable to create address: %v", err)
+	}
+	pkScriptVer, pkScript := addr.PaymentScript()
+
+	const amount = 1e8
+	const fee = 1e5
+	result := &multiCoinBlock{height: height}
+	msgBlock := &wire.MsgBlock{Header: wire.BlockHeader{Height: uint32(height)}}
+	var nonce uint32
+	for _, numTxns := range comp.numTxns {
+		for i := 0; i < numTxns.count; i++ {
+			// Create a unique funding transaction for the coin type.
+			var prevHash chainhash.Hash
+			binary.LittleEndian.PutUint32(prevHash[:], nonce)
+			nonce++
+			funding := wire.NewMsgTx()
+			funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0,
+				wire.TxTreeRegular), amount, nil))
+			funding.AddTxOut(&wire.TxOut{
+				Value:    amount,
+				CoinType: numTxns.coinType,
+				Version:  pkScriptVer,
+				PkScript: pkScript,
+			})
+			fundingHash := funding.TxHash()
+			result.funding = append(result.funding, dcrutil.NewTx(funding))
+
+			// Create and sign the transaction that spends it.
+			tx := wire.NewMsgTx()
+			tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&fundingHash, 0,
+				wire.TxTreeRegular), amount, nil))
+			tx.AddTxOut(&wire.TxOut{
+				Value:    amount - fee,
+				CoinType: numTxns.coinType,
+				Version:  pkScriptVer,
+				PkScript: pkScript,
+			})
+			sigScript, err := sign.SignatureScript(tx, 0, pkScript,
+				txscript.SigHashAll, keyBytes[:], dcrec.STEcdsaSecp256k1, true)
+			if err != nil {
+				b.Fatalf("unable to sign transaction: %v", err)
+			}
+			tx.TxIn[0].SignatureScript = sigScript
+			msgBlock.AddTransaction(tx)
+		}
+	}
+	result.block = dcrutil.NewBlock(msgBlock)
+	return result
+}
+
+// utxoView returns a new view that contains the funding outputs spent by the
+// synthetic block.
+func (mcb *multiCoinBlock) utxoView() *UtxoViewpoint {
+	view := NewUtxoViewpoint(nil)
+	for i, funding := range mcb.funding {
+		view.AddTxOuts(funding, mcb.height-1, uint32(i), true)
+	}
+	return view
+}
+
+// BenchmarkConnectMultiCoinBlock benchmarks the transaction input checks,
+// per-coin-type fee accounting, utxo connection, and script validation
+// performed when connecting the regular transaction tree of blocks with
+// various mixes of VAR and SKA transactions.
+func BenchmarkConnectMultiCoinBlock(b *testing.B) {
+	params := multiCoinBenchParams()
+	subsidyCache := standalone.NewSubsidyCache(params)
+	scriptFlags := txscript.ScriptVerifyCleanStack |
+		txscript.ScriptVerifyCheckLockTimeVerify |
+		txscript.ScriptVerifyCheckSequenceVerify |
+		txscript.ScriptVerifySHA256 |
+		txscript.ScriptVerifyTreasury
+	const height = 10000
+
+	for _, comp := range multiCoinCompositions {
+		mcb := genMultiCoinBlock(b, params, comp, height)
+		txns := mcb.block.Transactions()
+		prevHeader := wire.BlockHeader{Height: height - 1}
+
+		b.Run(comp.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				view := mcb.utxoView()
+				b.StartTimer()
+
+				var cumulativeSigOps int
+				var stxos []spentTxOut
+				inFlightTx := make(map[chainhash.Hash]uint32, len(txns))
+				totalFees := wire.NewFeesByType()
+				for idx, tx := range txns {
+					// The index is offset by one to account for the coinbase
+					// the synthetic block does not have.
+					txIdx := idx + 1
+					var err error
+					cumulativeSigOps, err = checkNumSigOps(tx, view, txIdx,
+						false, cumulativeSigOps, true)
+					if err != nil {
+						b.Fatalf("checkNumSigOps: %v", err)
+					}
+					txFee, err := CheckTransactionInputs(subsidyCache, tx,
+						height, view, false, params, &prevHeader, true, true,
+						0)
+					if err != nil {
+						b.Fatalf("CheckTransactionInputs: %v", err)
+					}
+					totalFees.Add(wire.GetPrimaryCoinType(tx.MsgTx()), txFee)
+					err = view.connectRegularTransaction(tx, height,
+						uint32(txIdx), inFlightTx, &stxos, true)
+					if err != nil {
+						b.Fatalf("connectRegularTransaction: %v", err)
+					}
+				}
+
+				// Scripts are validated against the view prior to connecting
+				// the transactions, so restore the spent funding outputs.
+				b.StopTimer()
+				view = mcb.utxoView()
+				b.StartTimer()
+				err := checkBlockScripts(mcb.block, view, true, scriptFlags,
+					nil, true)
+				if err != nil {
+					b.Fatalf("checkBlockScripts: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -141,6 +141,12 @@ type BlockDisconnectedNtfnsData struct {
 	// CheckTxFlags represents the agendas to consider as active when checking
 	// transactions for the block that was **disconnected**.
 	CheckTxFlags AgendaFlags
+
+	// SKAEmissions are the SKA emissions in the disconnected block that were
+	// rolled back from the emission state.  The associated coin types are no
+	// longer considered emitted, so the emission transactions may be mined
+	// again while their emission windows remain open.
+	SKAEmissions []SKAEmissionRecord
 }
 
 // ReorganizationNtfnsData is the structure for data indicating information
@@ -150,6 +156,11 @@ type ReorganizationNtfnsData struct {
 	OldHeight int64
 	NewHash   chainhash.Hash
 	NewHeight int64
+
+	// DisconnectedSKAEmissions are the SKA emissions from the old best chain
+	// that were rolled back by the reorganization and are not part of the new
+	// best chain.
+	DisconnectedSKAEmissions []SKAEmissionRecord
 }
 
 // TicketNotificationsData is the structure for data indicating information
@@ -7,6 +7,7 @@ package blockchain
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"sync"
 
 	"github.com/monetarium/monetarium-node/chaincfg"
@@ -18,6 +19,7 @@ import (
 // SKA burn state management
 // This file manages the persistent state for SKA burns including:
 // - Total burned amounts per coin type
+// - A journal of every individual burn for auditing
 // - Proper handling of chain reorganizations
 // - Database persistence
 
@@ -27,10 +29,23 @@ const (
 	skaBurnStateBucketName = "skaburnstate"
 
 	// Current version of the on-disk format
-	skaBurnStateFormatVersion = 1
+	skaBurnStateFormatVersion = 2
 
 	// Meta key for format version
 	skaBurnStateVersionKey = "__meta_version__"
+
+	// Database bucket for the SKA burn journal which records every individual
+	// burn.  It is separate from the burn state bucket since that bucket is
+	// rewritten in full whenever the totals change.
+	skaBurnJournalBucketName = "skaburnjournal"
+
+	// Meta key for the height of the first block whose burns are recorded in
+	// the journal.
+	skaBurnJournalStartKey = "__meta_startheight__"
+
+	// skaBurnJournalKeyLen is the length of the key of each journal entry:
+	// [coin type:1][height:4][tx hash:32][output index:4]
+	skaBurnJournalKeyLen = 1 + 4 + 32 + 4
 )
 
 // SKABurnState manages the persistent state for SKA burns.
@@ -47,6 +62,10 @@ type SKABurnState struct {
 	// Only SKA coin types (1-255) are tracked, VAR burns are not allowed
 	burned map[cointype.CoinType]int64
 
+	// Height of the first block whose burns are recorded in the journal.
+	// Burns in prior blocks are only accounted for in the totals.
+	journalStart int64
+
 	// Database handle for persistence
 	db database.DB
 }
@@ -89,8 +108,19 @@ func (s *SKABurnState) GetAllBurnedAmounts() map[cointype.CoinType]int64 {
 	return burnedCopy
 }
 
+// JournalStartHeight returns the height of the first block whose burns are
+// recorded in the burn journal.  It is only non-zero for databases created
+// before the journal existed, in which case burns in earlier blocks are only
+// accounted for in the burned totals.
+func (s *SKABurnState) JournalStartHeight() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.journalStart
+}
+
 // SKABurnRecord represents a burn transaction output in a block.
-// This is used during block connection/disconnection to update state.
+// This is used during block connection/disconnection to update state and is
+// returned from the burn journal.
 type SKABurnRecord struct {
 	CoinType cointype.CoinType
 	Amount   int64
@@ -118,7 +148,10 @@ func (s *SKABurnState) ConnectSKABurnsTx(dbTx database.Tx, burns []SKABurnRecord
 	}
 
 	// Persist to database using the provided transaction
-	return s.saveWithTx(dbTx)
+	if err := s.saveWithTx(dbTx); err != nil {
+		return err
+	}
+	return dbPutSKABurnJournalEntries(dbTx, burns)
 }
 
 // DisconnectSKABurnsTx updates the SKA burn state when a block is disconnected,
@@ -145,7 +178,117 @@ func (s *SKABurnState) DisconnectSKABurnsTx(dbTx database.Tx, burns []SKABurnRec
 	}
 
 	// Persist to database using the provided transaction
-	return s.saveWithTx(dbTx)
+	if err := s.saveWithTx(dbTx); err != nil {
+		return err
+	}
+	return dbRemoveSKABurnJournalEntries(dbTx, burns)
+}
+
+// FetchBurnRecords returns up to maxRecords burns of the provided coin type
+// recorded in the burn journal in blocks at or after the provided height.  The
+// burns are ordered by height and then by transaction hash and output index.
+//
+// Burns in blocks prior to the height returned by JournalStartHeight are not
+// recorded in the journal.
+func (s *SKABurnState) FetchBurnRecords(coinType cointype.CoinType, startHeight int64, maxRecords int) ([]SKABurnRecord, error) {
+	if startHeight < 0 {
+		startHeight = 0
+	}
+	var burns []SKABurnRecord
+	err := s.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket([]byte(skaBurnJournalBucketName))
+		if bucket == nil || startHeight > math.MaxUint32 {
+			return nil
+		}
+
+		var seek [5]byte
+		seek[0] = byte(coinType)
+		binary.BigEndian.PutUint32(seek[1:], uint32(startHeight))
+		cursor := bucket.Cursor()
+		for ok := cursor.Seek(seek[:]); ok && len(burns) < maxRecords; ok = cursor.Next() {
+			k, v := cursor.Key(), cursor.Value()
+			if k[0] != byte(coinType) {
+				break
+			}
+			if len(k) != skaBurnJournalKeyLen {
+				// Skip meta keys.
+				continue
+			}
+			if len(v) != 8 {
+				return fmt.Errorf("invalid value length in SKA burn journal: %d",
+					len(v))
+			}
+			burn := SKABurnRecord{
+				CoinType: coinType,
+				Amount:   int64(binary.LittleEndian.Uint64(v)),
+				Height:   int64(binary.BigEndian.Uint32(k[1:5])),
+				OutIndex: binary.BigEndian.Uint32(k[37:41]),
+			}
+			copy(burn.TxHash[:], k[5:37])
+			burns = append(burns, burn)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch SKA burn records: %w", err)
+	}
+	return burns, nil
+}
+
+// skaBurnJournalKey returns the burn journal key for the provided burn.
+func skaBurnJournalKey(burn *SKABurnRecord) []byte {
+	key := make([]byte, skaBurnJournalKeyLen)
+	key[0] = byte(burn.CoinType)
+	binary.BigEndian.PutUint32(key[1:5], uint32(burn.Height))
+	copy(key[5:37], burn.TxHash[:])
+	binary.BigEndian.PutUint32(key[37:41], burn.OutIndex)
+	return key
+}
+
+// dbPutSKABurnJournalEntries uses an existing database transaction to record
+// the provided burns in the burn journal.
+func dbPutSKABurnJournalEntries(dbTx database.Tx, burns []SKABurnRecord) error {
+	bucket, err := dbTx.Metadata().CreateBucketIfNotExists(
+		[]byte(skaBurnJournalBucketName))
+	if err != nil {
+		return fmt.Errorf("failed to create SKA burn journal bucket: %w", err)
+	}
+	for i := range burns {
+		var value [8]byte
+		binary.LittleEndian.PutUint64(value[:], uint64(burns[i].Amount))
+		if err := bucket.Put(skaBurnJournalKey(&burns[i]), value[:]); err != nil {
+			return fmt.Errorf("failed to record SKA burn: %w", err)
+		}
+	}
+	return nil
+}
+
+// dbRemoveSKABurnJournalEntries uses an existing database transaction to
+// remove the provided burns from the burn journal.
+func dbRemoveSKABurnJournalEntries(dbTx database.Tx, burns []SKABurnRecord) error {
+	bucket := dbTx.Metadata().Bucket([]byte(skaBurnJournalBucketName))
+	if bucket == nil {
+		return nil
+	}
+	for i := range burns {
+		if err := bucket.Delete(skaBurnJournalKey(&burns[i])); err != nil {
+			return fmt.Errorf("failed to remove SKA burn: %w", err)
+		}
+	}
+	return nil
+}
+
+// dbPutSKABurnJournalStart uses an existing database transaction to update the
+// height of the first block whose burns are recorded in the burn journal.
+func dbPutSKABurnJournalStart(dbTx database.Tx, height int64) error {
+	bucket, err := dbTx.Metadata().CreateBucketIfNotExists(
+		[]byte(skaBurnJournalBucketName))
+	if err != nil {
+		return fmt.Errorf("failed to create SKA burn journal bucket: %w", err)
+	}
+	var value [8]byte
+	binary.LittleEndian.PutUint64(value[:], uint64(height))
+	return bucket.Put([]byte(skaBurnJournalStartKey), value[:])
 }
 
 // load reads the SKA burn state from the database.
@@ -169,10 +312,25 @@ func (s *SKABurnState) load() error {
 			version = 1
 		}
 
-		// Reject unsupported versions
+		// Reject unsupported versions.  Older versions are upgraded by the
+		// SKA state migrations before the state is loaded.
 		if version > skaBurnStateFormatVersion {
 			return fmt.Errorf("unsupported SKA burn state version %d > %d", version, skaBurnStateFormatVersion)
 		}
+		if version < skaBurnStateFormatVersion {
+			return fmt.Errorf("SKA burn state version %d must be upgraded to %d", version, skaBurnStateFormatVersion)
+		}
+
+		// Load the start height of the burn journal when it is set.
+		journal := dbTx.Metadata().Bucket([]byte(skaBurnJournalBucketName))
+		if journal != nil {
+			if v := journal.Get([]byte(skaBurnJournalStartKey)); v != nil {
+				if len(v) != 8 {
+					return fmt.Errorf("invalid SKA burn journal start height encoding: expected 8 bytes, got %d", len(v))
+				}
+				s.journalStart = int64(binary.LittleEndian.Uint64(v))
+			}
+		}
 
 		// Read all entries from the bucket
 		return bucket.ForEach(func(k, v []byte) error {
@@ -267,6 +425,7 @@ func (s *SKABurnState) Clear() error {
 
 	// Clear in-memory state
 	s.burned = make(map[cointype.CoinType]int64)
+	s.journalStart = 0
 
 	// Clear database state
 	return s.db.Update(func(dbTx database.Tx) error {
@@ -278,6 +437,11 @@ func (s *SKABurnState) Clear() error {
 				return fmt.Errorf("failed to delete SKA burn state bucket: %w", err)
 			}
 		}
+		if meta.Bucket([]byte(skaBurnJournalBucketName)) != nil {
+			if err := meta.DeleteBucket([]byte(skaBurnJournalBucketName)); err != nil {
+				return fmt.Errorf("failed to delete SKA burn journal bucket: %w", err)
+			}
+		}
 
 		return nil
 	})
@@ -292,29 +456,21 @@ func extractSKABurnsFromBlock(block *dcrutil.Block, blockHeight int64, params *c
 		msgTx := tx.MsgTx()
 		txHash := tx.Hash()
 
-		// Check each output for burn scripts
+		// Check each output for burn scripts.  The burned coin type is the
+		// one committed to by the script, which consensus ensures matches the
+		// coin type of the output.
 		for outIndex, txOut := range msgTx.TxOut {
-			// Only process SKA coin types (1-255)
-			if !txOut.CoinType.IsSKA() {
+			burnCoinType, ok := skaBurnOutputCoinType(txOut, params)
+			if !ok || txOut.CoinType != burnCoinType {
 				continue
 			}
-
-			// Check if this is a burn script using the standard script detection
-			// The params.IsSKABurnScript function validates:
-			// - Exact 11-byte length
-			// - OP_RETURN opcode (0x6a)
-			// - Push length 0x09
-			// - "SKA_BURN" marker
-			// - Valid SKA coin type (1-255)
-			if params.IsSKABurnScript(txOut.PkScript) {
-				burns = append(burns, SKABurnRecord{
-					CoinType: txOut.CoinType,
-					Amount:   txOut.Value,
-					Height:   blockHeight,
-					TxHash:   *txHash,
-					OutIndex: uint32(outIndex),
-				})
-			}
+			burns = append(burns, SKABurnRecord{
+				CoinType: burnCoinType,
+				Amount:   txOut.Value,
+				Height:   blockHeight,
+				TxHash:   *txHash,
+				OutIndex: uint32(outIndex),
+			})
 		}
 	}
 
@@ -5,6 +5,7 @@
 package blockchain
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/monetarium/monetarium-node/chaincfg"
@@ -405,3 +406,81 @@ func createTestDB(t *testing.T, name string) (database.DB, func()) {
 
 	return db, teardown
 }
+
+// TestSKABurnStateJournal ensures every connected burn is recorded in the burn
+// journal, can be fetched by coin type and height, and is removed when its
+// block is disconnected.
+func TestSKABurnStateJournal(t *testing.T) {
+	t.Parallel()
+
+	db, teardown := createTestDB(t, "burnstate_journal")
+	defer teardown()
+
+	state, err := NewSKABurnState(db)
+	if err != nil {
+		t.Fatalf("NewSKABurnState failed: %v", err)
+	}
+
+	block100 := []SKABurnRecord{
+		{CoinType: 1, Amount: 100, Height: 100, TxHash: [32]byte{1}, OutIndex: 1},
+		{CoinType: 95, Amount: 200, Height: 100, TxHash: [32]byte{2}, OutIndex: 0},
+	}
+	block101 := []SKABurnRecord{
+		{CoinType: 1, Amount: 300, Height: 101, TxHash: [32]byte{3}, OutIndex: 0},
+		{CoinType: 1, Amount: 400, Height: 101, TxHash: [32]byte{3}, OutIndex: 2},
+	}
+	for _, burns := range [][]SKABurnRecord{block100, block101} {
+		err := db.Update(func(dbTx database.Tx) error {
+			return state.ConnectSKABurnsTx(dbTx, burns)
+		})
+		if err != nil {
+			t.Fatalf("ConnectSKABurnsTx failed: %v", err)
+		}
+	}
+
+	// checkBurns ensures fetching the burns of the provided coin type returns
+	// the expected burns.
+	checkBurns := func(coinType cointype.CoinType, startHeight int64, maxRecords int, want []SKABurnRecord) {
+		t.Helper()
+		got, err := state.FetchBurnRecords(coinType, startHeight, maxRecords)
+		if err != nil {
+			t.Fatalf("FetchBurnRecords failed: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("mismatched burns for coin type %d from height %d: "+
+				"got %+v, want %+v", coinType, startHeight, got, want)
+		}
+	}
+	checkBurns(1, 0, 10, []SKABurnRecord{block100[0], block101[0], block101[1]})
+	checkBurns(1, 101, 10, block101)
+	checkBurns(1, 0, 2, []SKABurnRecord{block100[0], block101[0]})
+	checkBurns(1, 102, 10, nil)
+	checkBurns(2, 0, 10, nil)
+
+	// Ensure the journal start height does not interfere with fetching the
+	// burns of the coin type that shares the first byte of its meta key.
+	err = db.Update(func(dbTx database.Tx) error {
+		return dbPutSKABurnJournalStart(dbTx, 50)
+	})
+	if err != nil {
+		t.Fatalf("unable to set journal start height: %v", err)
+	}
+	checkBurns(95, 0, 10, block100[1:])
+
+	// Ensure the journal start height is loaded and disconnected burns are
+	// removed from the journal.
+	err = db.Update(func(dbTx database.Tx) error {
+		return state.DisconnectSKABurnsTx(dbTx, block101)
+	})
+	if err != nil {
+		t.Fatalf("DisconnectSKABurnsTx failed: %v", err)
+	}
+	state, err = NewSKABurnState(db)
+	if err != nil {
+		t.Fatalf("NewSKABurnState failed: %v", err)
+	}
+	if got := state.JournalStartHeight(); got != 50 {
+		t.Fatalf("mismatched journal start height: got %d, want 50", got)
+	}
+	checkBurns(1, 0, 10, block100[:1])
+}
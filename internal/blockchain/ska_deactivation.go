@@ -0,0 +1,148 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// SKADeactivationState describes the progress of the deactivation of an SKA
+// coin type by stakeholder vote.
+type SKADeactivationState uint8
+
+const (
+	// SKADeactivationNone indicates the vote to deactivate the coin type has
+	// not passed.
+	SKADeactivationNone SKADeactivationState = iota
+
+	// SKADeactivationPending indicates the vote to deactivate the coin type
+	// has passed, so new outputs of the coin type are non-standard, but the
+	// deactivation delay has not elapsed yet, so they are still valid.
+	SKADeactivationPending
+
+	// SKADeactivationFinal indicates the deactivation delay has elapsed, so
+	// new outputs of the coin type are invalid.
+	SKADeactivationFinal
+)
+
+// skaDeactivationStateStrings is a map of SKA deactivation states back to
+// their constant names for pretty printing.
+var skaDeactivationStateStrings = map[SKADeactivationState]string{
+	SKADeactivationNone:    "SKADeactivationNone",
+	SKADeactivationPending: "SKADeactivationPending",
+	SKADeactivationFinal:   "SKADeactivationFinal",
+}
+
+// String returns the SKADeactivationState as a human-readable name.
+func (s SKADeactivationState) String() string {
+	if str := skaDeactivationStateStrings[s]; str != "" {
+		return str
+	}
+	return fmt.Sprintf("Unknown SKADeactivationState (%d)", uint8(s))
+}
+
+// skaDeactivationState returns the deactivation state of the provided SKA coin
+// type for the block after the provided node.
+//
+// The deactivation becomes final once the vote to deactivate the coin type has
+// been active for more than the deactivation delay defined by the chain
+// parameters, which is the case when it was already active as of the ancestor
+// that many blocks before the provided node.
+//
+// This function MUST be called with the chain state lock held (for reads).
+func (b *BlockChain) skaDeactivationState(coinType cointype.CoinType, prevNode *blockNode) SKADeactivationState {
+	voteID := chaincfg.SKADeactivationVoteID(coinType)
+	if _, ok := b.deploymentData[voteID]; !ok {
+		return SKADeactivationNone
+	}
+	if !b.hasVotePassed(voteID, prevNode) {
+		return SKADeactivationNone
+	}
+
+	delay := b.chainParams.SKADeactivationDelay
+	if delay <= 0 {
+		return SKADeactivationFinal
+	}
+	ancestor := prevNode.Ancestor(prevNode.height - delay)
+	if ancestor != nil && b.hasVotePassed(voteID, ancestor) {
+		return SKADeactivationFinal
+	}
+	return SKADeactivationPending
+}
+
+// SKADeactivationStateAtHeight returns the deactivation state of the provided
+// SKA coin type for the main chain block at the given height.  The state of
+// coin types that have not been deactivated, including when the height is
+// beyond the main chain tip plus one, is SKADeactivationNone.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) SKADeactivationStateAtHeight(coinType cointype.CoinType, blockHeight int64) SKADeactivationState {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	// Deployment states are calculated based on the parent of the block.
+	prevNode := b.bestChain.NodeByHeight(blockHeight - 1)
+	if prevNode == nil {
+		return SKADeactivationNone
+	}
+	return b.skaDeactivationState(coinType, prevNode)
+}
+
+// checkSKADeactivatedOutputs ensures the passed transaction does not create
+// outputs of an SKA coin type whose deactivation is final as of the block
+// after the provided node.  Existing outputs of such coin types may still be
+// spent by transactions that only create VAR outputs.
+//
+// This function MUST be called with the chain state lock held (for reads).
+func (b *BlockChain) checkSKADeactivatedOutputs(tx *wire.MsgTx, prevNode *blockNode) error {
+	for txOutIndex, txOut := range tx.TxOut {
+		coinType := txOut.CoinType
+		if !coinType.IsSKA() {
+			continue
+		}
+		if b.skaDeactivationState(coinType, prevNode) == SKADeactivationFinal {
+			str := fmt.Sprintf("transaction output %d creates coin type %v "+
+				"which was deactivated by stakeholder vote %s", txOutIndex,
+				coinType, chaincfg.SKADeactivationVoteID(coinType))
+			return ruleError(ErrSKACoinTypeDeactivated, str)
+		}
+	}
+	return nil
+}
+
+// checkSKASweepInputs ensures that when the passed transaction only creates
+// VAR outputs, any SKA outputs it spends are of coin types whose deactivation
+// vote has passed as of the block after the provided node.  Such transactions
+// sweep the outputs of deactivated coin types and their value is retired, so
+// outputs of coin types that remain active can't be spent by them.
+//
+// This function MUST be called with the chain state lock held (for reads).
+func (b *BlockChain) checkSKASweepInputs(tx *wire.MsgTx, view *UtxoViewpoint, prevNode *blockNode) error {
+	for _, txOut := range tx.TxOut {
+		if txOut.CoinType.IsSKA() {
+			return nil
+		}
+	}
+
+	for txInIndex, txIn := range tx.TxIn {
+		entry := view.LookupEntry(txIn.PreviousOutPoint)
+		if entry == nil || !entry.CoinType().IsSKA() {
+			continue
+		}
+		coinType := entry.CoinType()
+		if b.skaDeactivationState(coinType, prevNode) == SKADeactivationNone {
+			str := fmt.Sprintf("transaction input %d spends coin type %v "+
+				"into VAR outputs although stakeholder vote %s has not "+
+				"deactivated it", txInIndex, coinType,
+				chaincfg.SKADeactivationVoteID(coinType))
+			return ruleError(ErrSKASweepNotDeactivated, str)
+		}
+	}
+	return nil
+}
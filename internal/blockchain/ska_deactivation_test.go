@@ -0,0 +1,213 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/blockchain/standalone"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestSKADeactivation ensures the deactivation state of SKA coin types follows
+// the state of their deactivation votes and the deactivation delay, and that
+// outputs of coin types whose deactivation is final are rejected.
+func TestSKADeactivation(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	params.SKADeactivationDelay = 10
+	chain := newFakeChain(params)
+	genesis := chain.bestChain.NodeByHeight(0)
+
+	// Force the deactivation vote of SKA-2 to be active.
+	active := ThresholdStateTuple{State: ThresholdActive}
+	chain.deploymentData[chaincfg.SKADeactivationVoteID(2)] = deploymentInfo{
+		forcedState: &active,
+	}
+
+	// Build a chain that is longer than the deactivation delay.
+	tip := genesis
+	curTimestamp := time.Now()
+	for i := 0; i < 15; i++ {
+		tip = newFakeNode(tip, 1, 0, 0, curTimestamp)
+	}
+
+	tests := []struct {
+		name     string
+		coinType cointype.CoinType
+		prevNode *blockNode
+		want     SKADeactivationState
+	}{{
+		name:     "no deactivation vote",
+		coinType: 1,
+		prevNode: tip,
+		want:     SKADeactivationNone,
+	}, {
+		name:     "within deactivation delay",
+		coinType: 2,
+		prevNode: tip.Ancestor(5),
+		want:     SKADeactivationPending,
+	}, {
+		name:     "after deactivation delay",
+		coinType: 2,
+		prevNode: tip,
+		want:     SKADeactivationFinal,
+	}}
+
+	for _, test := range tests {
+		got := chain.skaDeactivationState(test.coinType, test.prevNode)
+		if got != test.want {
+			t.Errorf("%s: unexpected state -- got %v, want %v", test.name, got,
+				test.want)
+		}
+	}
+
+	msgTx := wire.NewMsgTx()
+	msgTx.AddTxOut(&wire.TxOut{Value: 1, PkScript: []byte{0x51}, CoinType: 1})
+	msgTx.AddTxOut(&wire.TxOut{Value: 1, PkScript: []byte{0x51}, CoinType: 2})
+	if err := chain.checkSKADeactivatedOutputs(msgTx, tip.Ancestor(5)); err != nil {
+		t.Errorf("unexpected error within deactivation delay: %v", err)
+	}
+	err := chain.checkSKADeactivatedOutputs(msgTx, tip)
+	if !errors.Is(err, ErrSKACoinTypeDeactivated) {
+		t.Errorf("unexpected error after deactivation delay -- got %v, want %v",
+			err, ErrSKACoinTypeDeactivated)
+	}
+}
+
+// TestSKASweepInputs ensures blocks with transactions that only create VAR
+// outputs and spend outputs of an SKA coin type whose deactivation vote has
+// not passed are rejected once the SKA sweep rule is active, while sweeps of
+// deactivated coin types are accepted without the swept value counting towards
+// the fees, and that the original rules apply before the rule is active.
+func TestSKASweepInputs(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	params.SKADeactivationDelay = 10
+	chain := newFakeChain(params)
+	chain.subsidyCache = standalone.NewSubsidyCache(params)
+	genesis := chain.bestChain.NodeByHeight(0)
+
+	// Force the deactivation vote of SKA-2 to be active.
+	active := ThresholdStateTuple{State: ThresholdActive}
+	chain.deploymentData[chaincfg.SKADeactivationVoteID(2)] = deploymentInfo{
+		forcedState: &active,
+	}
+
+	tip := genesis
+	curTimestamp := time.Now()
+	for i := 0; i < 15; i++ {
+		tip = newFakeNode(tip, 1, 0, 0, curTimestamp)
+	}
+	isTreasuryEnabled, err := chain.isTreasuryAgendaActive(tip)
+	if err != nil {
+		t.Fatalf("unexpected error checking treasury agenda: %v", err)
+	}
+
+	// connectSweep checks and connects the regular transaction tree of a
+	// block after the tip that sweeps an output of the provided coin type
+	// along with a VAR output into a VAR output and whose coinbase claims the
+	// provided fees in addition to the subsidy.
+	const varIn, skaIn, varOut = 5000, 1000, 4000
+	pkScript := []byte{txscript.OP_TRUE}
+	connectSweep := func(coinType cointype.CoinType, fees int64) error {
+		node := newFakeNode(tip, 1, 0, 0, curTimestamp)
+		view := NewUtxoViewpoint(nil)
+		varPrevOut := wire.OutPoint{Hash: chainhash.Hash{1}}
+		skaPrevOut := wire.OutPoint{Hash: chainhash.Hash{2}}
+		view.Entries()[varPrevOut] = &UtxoEntry{
+			amount:      varIn,
+			coinType:    cointype.CoinTypeVAR,
+			pkScript:    pkScript,
+			blockHeight: 1,
+		}
+		view.Entries()[skaPrevOut] = &UtxoEntry{
+			amount:      skaIn,
+			coinType:    coinType,
+			pkScript:    pkScript,
+			blockHeight: 1,
+		}
+
+		sweepTx := wire.NewMsgTx()
+		sweepTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: varPrevOut,
+			ValueIn:          varIn,
+			BlockHeight:      1,
+		})
+		sweepTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: skaPrevOut,
+			ValueIn:          skaIn,
+			BlockHeight:      1,
+		})
+		sweepTx.AddTxOut(&wire.TxOut{Value: varOut, PkScript: pkScript})
+
+		subsidy := chain.subsidyCache.CalcWorkSubsidyV3(node.height,
+			node.voters, standalone.SSVOriginal)
+		coinbase := wire.NewMsgTx()
+		if isTreasuryEnabled {
+			coinbase.Version = wire.TxVersionTreasury
+		} else {
+			subsidy += chain.subsidyCache.CalcTreasurySubsidy(node.height,
+				node.voters, isTreasuryEnabled)
+		}
+		coinbase.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+				wire.MaxPrevOutIndex, wire.TxTreeRegular),
+			ValueIn: subsidy,
+		})
+		coinbase.AddTxOut(&wire.TxOut{Value: subsidy + fees, PkScript: pkScript})
+
+		txs := []*dcrutil.Tx{dcrutil.NewTx(coinbase), dcrutil.NewTx(sweepTx)}
+		var stxos []spentTxOut
+		return chain.checkTransactionsAndConnect(0, node, txs, view, &stxos,
+			false, standalone.SSVOriginal)
+	}
+
+	tests := []struct {
+		name        string
+		sweepHeight int64
+		coinType    cointype.CoinType
+		fees        int64
+		want        error
+	}{{
+		name:        "active coin type before activation",
+		sweepHeight: 17,
+		coinType:    1,
+		fees:        varIn + skaIn - varOut,
+		want:        nil,
+	}, {
+		name:        "active coin type after activation",
+		sweepHeight: 16,
+		coinType:    1,
+		fees:        varIn - varOut,
+		want:        ErrSKASweepNotDeactivated,
+	}, {
+		name:        "deactivated coin type after activation",
+		sweepHeight: 16,
+		coinType:    2,
+		fees:        varIn - varOut,
+		want:        nil,
+	}, {
+		name:        "deactivated coin type value claimed as fee",
+		sweepHeight: 16,
+		coinType:    2,
+		fees:        varIn + skaIn - varOut,
+		want:        ErrBadCoinbaseValue,
+	}}
+
+	for _, test := range tests {
+		params.SKASweepHeight = test.sweepHeight
+		err := connectSweep(test.coinType, test.fees)
+		if !errors.Is(err, test.want) {
+			t.Errorf("%s: unexpected error -- got %v, want %v", test.name,
+				err, test.want)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
+	"math"
 
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
@@ -16,7 +17,6 @@ import (
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
 	"github.com/monetarium/monetarium-node/dcrutil"
-	"github.com/monetarium/monetarium-node/txscript/stdaddr"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -25,10 +25,11 @@ import (
 type ChainStateProvider interface {
 	HasSKAEmissionOccurred(cointype.CoinType) bool
 	GetSKAEmissionNonce(cointype.CoinType) uint64
+	SKAEmissionTranchesEmitted(cointype.CoinType) uint32
 }
 
 // isSKAEmissionWindow returns whether the provided block height is within
-// the emission window for the specified SKA coin type.
+// the emission window of any scheduled tranche for the specified SKA coin type.
 // Note: This function only checks the emission window bounds, not stake validation.
 // Stake validation is checked separately in ValidateAuthorizedSKAEmissionTransaction.
 func isSKAEmissionWindow(blockHeight int64, coinType cointype.CoinType, chainParams *chaincfg.Params) bool {
@@ -37,10 +38,12 @@ func isSKAEmissionWindow(blockHeight int64, coinType cointype.CoinType, chainPar
 		return false
 	}
 
-	emissionStart := int64(config.EmissionHeight)
-	emissionEnd := emissionStart + int64(config.EmissionWindow)
-
-	return blockHeight >= emissionStart && blockHeight <= emissionEnd
+	for _, tranche := range config.EmissionSchedule() {
+		if tranche.InWindow(blockHeight) {
+			return true
+		}
+	}
+	return false
 }
 
 // isSKAEmissionWindowActive returns whether any SKA coin type has an active
@@ -86,9 +89,9 @@ func (b *BlockChain) HasVotePassedAtHeight(voteID string, blockHeight int64) boo
 	return b.hasVotePassed(voteID, node)
 }
 
-// CheckSKAEmissionAlreadyExists checks if a coin type has already been emitted
-// in the blockchain. This now uses the persistent blockchain state for O(1)
-// lookups instead of scanning blocks.
+// CheckSKAEmissionAlreadyExists checks if all scheduled emission tranches of a
+// coin type have already been emitted in the blockchain. This now uses the
+// persistent blockchain state for O(1) lookups instead of scanning blocks.
 func CheckSKAEmissionAlreadyExists(coinType cointype.CoinType, chain ChainStateProvider) bool {
 	// Use blockchain state for efficient and reliable emission tracking
 	return chain.HasSKAEmissionOccurred(coinType)
@@ -173,13 +176,22 @@ func CreateAuthorizedSKAEmissionTransaction(auth *chaincfg.SKAEmissionAuth,
 		return nil, fmt.Errorf("SKA coin type %d not configured", auth.CoinType)
 	}
 
-	// Verify emission height is within the emission window
-	emissionStart := int64(skaConfig.EmissionHeight)
-	emissionEnd := emissionStart + int64(skaConfig.EmissionWindow)
-	if auth.Height < emissionStart || auth.Height > emissionEnd {
-		return nil, fmt.Errorf("emission height %d is outside emission window [%d, %d] for coin type %d",
-			auth.Height, emissionStart, emissionEnd, auth.CoinType)
+	// Verify emission height is within the emission window of a scheduled
+	// tranche.  The tranche that is actually due depends on the chain state
+	// and is verified during transaction validation.
+	var tranche *chaincfg.SKAEmissionTranche
+	schedule := skaConfig.EmissionSchedule()
+	for i := range schedule {
+		if schedule[i].InWindow(auth.Height) {
+			tranche = &schedule[i]
+			break
+		}
 	}
+	if tranche == nil {
+		return nil, fmt.Errorf("emission height %d is outside the emission windows for coin type %d",
+			auth.Height, auth.CoinType)
+	}
+	emissionEnd := tranche.WindowEnd()
 
 	// NOTE: We do NOT verify the signature here because it must bind to the
 	// transaction hash, which we haven't computed yet. The signature will be
@@ -214,15 +226,14 @@ func CreateAuthorizedSKAEmissionTransaction(auth *chaincfg.SKAEmissionAuth,
 		ValueIn:         wire.NullValueIn,
 	})
 
-	// Add outputs for each emission address
+	// Add outputs for each emission address, which may also be an output
+	// script descriptor
 	for i, addressStr := range emissionAddresses {
-		addr, err := stdaddr.DecodeAddress(addressStr, chainParams)
+		output, err := ParseEmissionOutput(addressStr, chainParams)
 		if err != nil {
-			return nil, fmt.Errorf("invalid emission address %s: %w", addressStr, err)
+			return nil, err
 		}
-
-		// Create script for the address
-		_, pkScript := addr.PaymentScript()
+		pkScript := output.Script
 
 		// Add SKA output with specific coin type
 		// Force script version 0 to match validation requirements
@@ -235,6 +246,16 @@ func CreateAuthorizedSKAEmissionTransaction(auth *chaincfg.SKAEmissionAuth,
 		})
 	}
 
+	// Reject outputs that would be uneconomic to ever spend at the minimum
+	// relay fee of SKA transactions since the emission can't be redone.
+	relayFee := dcrutil.Amount(chainParams.SKAMinRelayTxFee)
+	for i, txOut := range tx.TxOut {
+		if IsDustEmissionOutput(txOut, relayFee) {
+			return nil, fmt.Errorf("emission output %d paying %d atoms to "+
+				"%s is dust", i, txOut.Value, emissionAddresses[i])
+		}
+	}
+
 	return tx, nil
 }
 
@@ -242,6 +263,19 @@ func CreateAuthorizedSKAEmissionTransaction(auth *chaincfg.SKAEmissionAuth,
 // data for the emission transaction. This embeds the authorization proof in
 // the transaction itself for validation.
 func createEmissionAuthScript(auth *chaincfg.SKAEmissionAuth) ([]byte, error) {
+	if auth.EmissionKey == nil {
+		return nil, fmt.Errorf("emission authorization is missing the " +
+			"emission key")
+	}
+
+	// The signature length is encoded as a single byte, so longer signatures
+	// can't be represented and would otherwise silently corrupt the script.
+	if len(auth.Signature) > math.MaxUint8 {
+		return nil, fmt.Errorf("emission authorization signature is %d "+
+			"bytes, exceeding the max of %d", len(auth.Signature),
+			math.MaxUint8)
+	}
+
 	var script bytes.Buffer
 
 	// Standard SKA emission marker
@@ -335,14 +369,18 @@ func ValidateAuthorizedSKAEmissionTransaction(tx *wire.MsgTx, blockHeight int64,
 		return fmt.Errorf("SKA emission transaction input is not null")
 	}
 
-	// Extract and validate authorization from signature script
-	auth, err := extractEmissionAuthorization(tx.TxIn[0].SignatureScript)
+	// Extract and validate authorization from signature script.  Data after
+	// the signature is only rejected once the emission witness rule is
+	// active so emissions that were already mined remain valid.
+	rejectWitnessMutations := chainParams.EnforcesSKAEmissionWitness(blockHeight)
+	auth, err := parseEmissionAuthorization(tx.TxIn[0].SignatureScript,
+		rejectWitnessMutations)
 	if err != nil {
 		return fmt.Errorf("invalid emission authorization: %w", err)
 	}
 
 	// Validate authorization against chain parameters
-	if err := validateEmissionAuthorization(auth, chain, chainParams); err != nil {
+	if err := validateEmissionAuthorization(auth, blockHeight, chain, chainParams); err != nil {
 		return fmt.Errorf("emission authorization validation failed: %w", err)
 	}
 
@@ -391,6 +429,18 @@ func ValidateAuthorizedSKAEmissionTransaction(tx *wire.MsgTx, blockHeight int64,
 				i, txOut.Version)
 		}
 
+		// Reject outputs paying to placeholder or burn addresses as a final
+		// safety net against emitting to example addresses that were never
+		// replaced since the emission can't be redone.  The rule only
+		// applies once it is active so emissions that were already mined
+		// remain valid.
+		if chainParams.RejectsPlaceholderEmissions(blockHeight) &&
+			IsPlaceholderEmissionScript(txOut.Version, txOut.PkScript, chainParams) {
+
+			return fmt.Errorf("SKA emission output %d pays to a placeholder "+
+				"or burn address", i)
+		}
+
 		totalEmissionAmount += txOut.Value
 	}
 
@@ -413,12 +463,18 @@ func ValidateAuthorizedSKAEmissionTransaction(tx *wire.MsgTx, blockHeight int64,
 		return fmt.Errorf("SKA coin type %d not configured in chain params", emissionCoinType)
 	}
 
-	// Calculate the expected total emission amount from config
-	var expectedEmissionAmount int64
-	for _, amount := range skaConfig.EmissionAmounts {
-		expectedEmissionAmount += amount
+	// Tranches are emitted in the order they are scheduled, so the emission
+	// must be for the tranche following those already emitted.
+	emitted := chain.SKAEmissionTranchesEmitted(emissionCoinType)
+	tranche := skaConfig.NextEmissionTranche(emitted)
+	if tranche == nil {
+		return fmt.Errorf("all %d scheduled emission tranches for coin type %d have been emitted",
+			emitted, emissionCoinType)
 	}
 
+	// Calculate the expected total emission amount of the tranche
+	expectedEmissionAmount := tranche.TotalAmount()
+
 	// Enforce exact emission amount as configured in governance
 	// This ensures consistency between authorized and basic validation paths
 	if expectedEmissionAmount > 0 && totalEmissionAmount != expectedEmissionAmount {
@@ -426,10 +482,10 @@ func ValidateAuthorizedSKAEmissionTransaction(tx *wire.MsgTx, blockHeight int64,
 			totalEmissionAmount, expectedEmissionAmount, emissionCoinType)
 	}
 
-	// Validate auth.Height is within the emission window
+	// Validate auth.Height is within the emission window of the tranche
 	// This allows mempool broadcasting without per-block re-signing
-	emissionStart := int64(skaConfig.EmissionHeight)
-	emissionEnd := emissionStart + int64(skaConfig.EmissionWindow)
+	emissionStart := int64(tranche.EmissionHeight)
+	emissionEnd := tranche.WindowEnd()
 	if auth.Height < emissionStart || auth.Height > emissionEnd {
 		return fmt.Errorf("authorization height %d is outside emission window [%d, %d] for coin type %d",
 			auth.Height, emissionStart, emissionEnd, emissionCoinType)
@@ -453,6 +509,14 @@ func ValidateAuthorizedSKAEmissionTransaction(tx *wire.MsgTx, blockHeight int64,
 			emissionEnd, tx.Expiry)
 	}
 
+	// Reject any mutation of the fields the signature does not commit to once
+	// the emission witness rule is active.
+	if rejectWitnessMutations {
+		if err := checkEmissionWitness(tx); err != nil {
+			return err
+		}
+	}
+
 	// Note: Nonce is NOT updated here during validation to avoid side effects.
 	// The nonce will be updated when the block is successfully connected to the chain
 	// in CheckSKAEmissionInBlock to ensure replay protection only after commitment.
@@ -460,6 +524,44 @@ func ValidateAuthorizedSKAEmissionTransaction(tx *wire.MsgTx, blockHeight int64,
 	return nil
 }
 
+// checkEmissionWitness ensures the fields of an SKA emission transaction that
+// are not committed to by the emission signature have their only valid value,
+// so emissions can't be malleated by anyone that relays or mines them.
+//
+// The emission signature commits to the no-witness serialization of the
+// transaction, which is also what the transaction hash commits to.  That
+// covers the version, the previous outpoint of the input including its tree,
+// the sequence of the input, the outputs, the lock time, and the expiry, so a
+// mutation of any of them invalidates the signature.
+//
+// The remaining fields are only part of the witness serialization and thus
+// change the full hash of the transaction, and therefore the witness merkle
+// root of the block, without invalidating the signature:
+//
+//   - The signature script is rejected unless it is the exact script
+//     createEmissionAuthScript produces for the parsed authorization, every
+//     authorization field is either bound by the signed message or checked
+//     against the chain parameters and the outputs, and the signature itself
+//     must be strictly DER encoded with a low S value
+//   - The value in, block height, and block index of the input are enforced
+//     here to be their null values since the emission does not spend anything
+func checkEmissionWitness(tx *wire.MsgTx) error {
+	txIn := tx.TxIn[0]
+	if txIn.ValueIn != wire.NullValueIn {
+		return fmt.Errorf("SKA emission input value in must be %d, got %d",
+			wire.NullValueIn, txIn.ValueIn)
+	}
+	if txIn.BlockHeight != wire.NullBlockHeight {
+		return fmt.Errorf("SKA emission input block height must be %d, got %d",
+			wire.NullBlockHeight, txIn.BlockHeight)
+	}
+	if txIn.BlockIndex != wire.NullBlockIndex {
+		return fmt.Errorf("SKA emission input block index must be %d, got %d",
+			wire.NullBlockIndex, txIn.BlockIndex)
+	}
+	return nil
+}
+
 // verifyEmissionSignature verifies the cryptographic signature of an emission transaction.
 // This is a CRITICAL security function that prevents:
 // - Miner redirect attacks (changing outputs)
@@ -473,12 +575,67 @@ func ValidateAuthorizedSKAEmissionTransaction(tx *wire.MsgTx, blockHeight int64,
 func verifyEmissionSignature(tx *wire.MsgTx, auth *chaincfg.SKAEmissionAuth,
 	_ int64, chainParams *chaincfg.Params) error {
 
+	// Compute the domain-separated message hash the signature commits to.
+	msgHash, err := calcEmissionSigHash(tx, auth, chainParams)
+	if err != nil {
+		return err
+	}
+
+	// Parse the signature with strict DER validation
+	sig, err := ecdsa.ParseDERSignature(auth.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid DER signature format: %w", err)
+	}
+
+	// Enforce canonical signature encoding (low-S) to prevent malleability
+	// In ECDSA, both S and -S (mod n) are valid signatures, but we enforce low-S
+	// where S <= n/2 to ensure a canonical form
+	sigS := sig.S()
+	if sigS.IsOverHalfOrder() {
+		return fmt.Errorf("signature not canonical: S value is not low (S > n/2)")
+	}
+
+	// Additional strict DER checks for consensus safety
+	if len(auth.Signature) > 73 {
+		return fmt.Errorf("signature too long: %d bytes (max 73)", len(auth.Signature))
+	}
+
+	// Verify the signature against the message and public key
+	if !sig.Verify(msgHash[:], auth.EmissionKey) {
+		return fmt.Errorf("signature verification failed - unauthorized emission attempt")
+	}
+
+	// Signature verified successfully
+
+	return nil
+}
+
+// emissionSigDomain is the domain separator that prefixes the message SKA
+// emission signatures commit to.
+const emissionSigDomain = "SKA-EMIT-V2"
+
+// EmissionSigningMessageNetIDOffset is the offset of the little-endian
+// encoded network ID in the message SKA emission signatures commit to.  It is
+// the only part of the message that differs between networks for the same
+// emission transaction and authorization.
+const EmissionSigningMessageNetIDOffset = len(emissionSigDomain)
+
+// EmissionSigningMessage returns the domain-separated message whose hash the
+// signature of an SKA emission transaction must commit to.
+//
+// The message binds to:
+// - The exact transaction outputs (via no-witness serialization hash)
+// - The network ID (preventing cross-network replay)
+// - The coin type, nonce, and authorization height (for window-based validation)
+func EmissionSigningMessage(tx *wire.MsgTx, auth *chaincfg.SKAEmissionAuth,
+	chainParams *chaincfg.Params) ([]byte, error) {
+
 	// Compute the transaction hash using explicit no-witness serialization
 	// This ensures the signature binds to the exact outputs without witness data
 	// BytesPrefix() is explicitly documented to use TxSerializeNoWitness
 	txBytes, err := tx.BytesPrefix() // Uses wire.TxSerializeNoWitness internally
 	if err != nil {
-		return fmt.Errorf("failed to serialize transaction (no-witness): %w", err)
+		return nil, fmt.Errorf("failed to serialize transaction (no-witness): %w", err)
 	}
 	txHash := sha256.Sum256(txBytes)
 
@@ -487,11 +644,11 @@ func verifyEmissionSignature(tx *wire.MsgTx, auth *chaincfg.SKAEmissionAuth,
 	var msgBuf bytes.Buffer
 
 	// Domain separator to prevent signature reuse in other contexts
-	msgBuf.WriteString("SKA-EMIT-V2")
+	msgBuf.WriteString(emissionSigDomain)
 
 	// Network ID for replay protection across networks
 	if err := binary.Write(&msgBuf, binary.LittleEndian, uint32(chainParams.Net)); err != nil {
-		return fmt.Errorf("failed to write network ID: %w", err)
+		return nil, fmt.Errorf("failed to write network ID: %w", err)
 	}
 
 	// Coin type
@@ -499,53 +656,81 @@ func verifyEmissionSignature(tx *wire.MsgTx, auth *chaincfg.SKAEmissionAuth,
 
 	// Nonce for replay protection within network
 	if err := binary.Write(&msgBuf, binary.LittleEndian, auth.Nonce); err != nil {
-		return fmt.Errorf("failed to write nonce: %w", err)
+		return nil, fmt.Errorf("failed to write nonce: %w", err)
 	}
 
 	// Use auth.Height (signed by emitter) instead of current blockHeight
 	// This allows broadcasting to mempool and inclusion at any valid height within window
 	if err := binary.Write(&msgBuf, binary.LittleEndian, uint64(auth.Height)); err != nil {
-		return fmt.Errorf("failed to write authorization height: %w", err)
+		return nil, fmt.Errorf("failed to write authorization height: %w", err)
 	}
 
 	// Transaction hash - this binds the signature to exact outputs
 	msgBuf.Write(txHash[:])
 
-	// Create the final message hash
-	msgHash := sha256.Sum256(msgBuf.Bytes())
+	return msgBuf.Bytes(), nil
+}
 
-	// Parse the signature with strict DER validation
-	sig, err := ecdsa.ParseDERSignature(auth.Signature)
+// calcEmissionSigHash returns the domain-separated message hash that the
+// signature of an SKA emission transaction must commit to.  See
+// EmissionSigningMessage for the message.
+func calcEmissionSigHash(tx *wire.MsgTx, auth *chaincfg.SKAEmissionAuth,
+	chainParams *chaincfg.Params) ([32]byte, error) {
+
+	msg, err := EmissionSigningMessage(tx, auth, chainParams)
 	if err != nil {
-		return fmt.Errorf("invalid DER signature format: %w", err)
+		return [32]byte{}, err
 	}
+	return sha256.Sum256(msg), nil
+}
 
-	// Enforce canonical signature encoding (low-S) to prevent malleability
-	// In ECDSA, both S and -S (mod n) are valid signatures, but we enforce low-S
-	// where S <= n/2 to ensure a canonical form
-	sigS := sig.S()
-	if sigS.IsOverHalfOrder() {
-		return fmt.Errorf("signature not canonical: S value is not low (S > n/2)")
-	}
+// SignSKAEmissionTransaction signs the passed emission transaction, as created
+// by CreateAuthorizedSKAEmissionTransaction, with the provided private key.
+// The signature in the authorization is replaced with the new signature and
+// the signature script of the transaction input is rebuilt accordingly.
+//
+// The private key must correspond to the emission key in the authorization,
+// otherwise the resulting transaction will fail validation.
+func SignSKAEmissionTransaction(tx *wire.MsgTx, auth *chaincfg.SKAEmissionAuth,
+	privKey *secp256k1.PrivateKey, chainParams *chaincfg.Params) error {
 
-	// Additional strict DER checks for consensus safety
-	if len(auth.Signature) > 73 {
-		return fmt.Errorf("signature too long: %d bytes (max 73)", len(auth.Signature))
+	if len(tx.TxIn) != 1 {
+		return fmt.Errorf("SKA emission transaction must have exactly 1 input, got %d",
+			len(tx.TxIn))
 	}
-
-	// Verify the signature against the message and public key
-	if !sig.Verify(msgHash[:], auth.EmissionKey) {
-		return fmt.Errorf("signature verification failed - unauthorized emission attempt")
+	if !privKey.PubKey().IsEqual(auth.EmissionKey) {
+		return fmt.Errorf("private key does not match emission key for coin type %d",
+			auth.CoinType)
 	}
 
-	// Signature verified successfully
+	// The signature script is not part of the prefix serialization, so the
+	// message hash is unaffected by the signature it is about to contain.
+	msgHash, err := calcEmissionSigHash(tx, auth, chainParams)
+	if err != nil {
+		return err
+	}
+	auth.Signature = ecdsa.Sign(privKey, msgHash[:]).Serialize()
 
+	authScript, err := createEmissionAuthScript(auth)
+	if err != nil {
+		return fmt.Errorf("failed to create authorization script: %w", err)
+	}
+	tx.TxIn[0].SignatureScript = authScript
 	return nil
 }
 
 // extractEmissionAuthorization extracts the emission authorization from a signature script.
 // The script format is: [SKA_marker][auth_version][nonce][coin_type][amount][height][pubkey][sig_len][signature]
+// Scripts with any data after the signature are rejected.
 func extractEmissionAuthorization(sigScript []byte) (*chaincfg.SKAEmissionAuth, error) {
+	return parseEmissionAuthorization(sigScript, true)
+}
+
+// parseEmissionAuthorization extracts the emission authorization from a
+// signature script in the format described by extractEmissionAuthorization.
+// Data after the signature is only rejected when rejectTrailingData is set
+// since it was permitted prior to the emission witness rule.
+func parseEmissionAuthorization(sigScript []byte, rejectTrailingData bool) (*chaincfg.SKAEmissionAuth, error) {
 
 	// Calculate minimum required length: 4(marker) + 1(version) + 8(nonce) + 1(cointype) + 8(amount) + 8(height) + 33(pubkey) + 1(siglen)
 	const minScriptLen = 4 + 1 + 8 + 1 + 8 + 8 + 33 + 1 // = 64 bytes
@@ -585,11 +770,6 @@ func extractEmissionAuthorization(sigScript []byte) (*chaincfg.SKAEmissionAuth,
 		return nil, fmt.Errorf("insufficient data for amount at offset %d, have %d bytes, need %d", offset, len(sigScript), offset+8)
 	}
 	amount := int64(binary.LittleEndian.Uint64(sigScript[offset : offset+8]))
-
-	// Check if this looks like a public key instead of an amount (starts with 0x02 or 0x03)
-	if sigScript[offset] == 0x02 || sigScript[offset] == 0x03 {
-		return nil, fmt.Errorf("script format error: expected amount at offset %d but found what appears to be a compressed public key (starts with 0x%02x). The script format should be [SKA_marker:4][auth_version:1][nonce:8][coin_type:1][amount:8][height:8][pubkey:33][sig_len:1][signature:var] but this appears to be missing amount and height fields", offset, sigScript[offset])
-	}
 	offset += 8
 
 	// Extract height (8 bytes)
@@ -632,6 +812,15 @@ func extractEmissionAuthorization(sigScript []byte) (*chaincfg.SKAEmissionAuth,
 			sigLen, len(sigScript)-offset)
 	}
 	signature := sigScript[offset : offset+sigLen]
+	offset += sigLen
+
+	// Reject any data after the signature since the script would otherwise
+	// not be the one produced by createEmissionAuthScript for the parsed
+	// authorization.
+	if rejectTrailingData && offset != len(sigScript) {
+		return nil, fmt.Errorf("unexpected %d bytes of trailing data after "+
+			"signature", len(sigScript)-offset)
+	}
 
 	return &chaincfg.SKAEmissionAuth{
 		EmissionKey: pubKey,
@@ -645,7 +834,9 @@ func extractEmissionAuthorization(sigScript []byte) (*chaincfg.SKAEmissionAuth,
 
 // validateEmissionAuthorization validates the cryptographic authorization
 // against the chain parameters and verifies the signature.
-func validateEmissionAuthorization(auth *chaincfg.SKAEmissionAuth, chain ChainStateProvider, chainParams *chaincfg.Params) error {
+func validateEmissionAuthorization(auth *chaincfg.SKAEmissionAuth, blockHeight int64,
+	chain ChainStateProvider, chainParams *chaincfg.Params) error {
+
 	// Check if emission is authorized for this coin type
 	authorizedKey := chainParams.GetSKAEmissionKey(auth.CoinType)
 	if authorizedKey == nil {
@@ -658,12 +849,27 @@ func validateEmissionAuthorization(auth *chaincfg.SKAEmissionAuth, chain ChainSt
 		return fmt.Errorf("unauthorized emission key for coin type %d", auth.CoinType)
 	}
 
-	// Check nonce for replay protection - must be exactly one more than the current nonce
-	// Use blockchain state instead of chainParams for proper persistence
+	// Check nonce for replay protection.  Use blockchain state instead of
+	// chainParams for proper persistence
 	currentNonce := chain.GetSKAEmissionNonce(auth.CoinType)
-	expectedNonce := currentNonce + 1
-	if auth.Nonce != expectedNonce {
-		return fmt.Errorf("invalid nonce: expected %d, got %d", expectedNonce, auth.Nonce)
+	if !chainParams.AllowsSKANonceResync(blockHeight) {
+		// The nonce must be exactly one greater than the nonce of the
+		// last committed emission prior to the resynchronization rule.
+		if auth.Nonce != currentNonce+1 {
+			return fmt.Errorf("invalid nonce: expected %d, got %d",
+				currentNonce+1, auth.Nonce)
+		}
+		return nil
+	}
+
+	// Once the resynchronization rule is active, the nonce must only be
+	// greater than the nonce of the last committed emission.  Gaps are
+	// permitted so an emitter whose signed emission was never committed,
+	// such as one lost in a reorg that did not reconfirm, can resynchronize
+	// with a later nonce instead of blocking all future tranches.
+	if auth.Nonce <= currentNonce {
+		return fmt.Errorf("invalid nonce: expected greater than %d, got %d",
+			currentNonce, auth.Nonce)
 	}
 
 	return nil
@@ -693,7 +899,7 @@ func CheckSKAEmissionInBlock(block *dcrutil.Block, prevNode *blockNode,
 
 	var emissionTxCount int
 	var skaTxCount int
-	emissionTxCoinTypes := make(map[cointype.CoinType]bool)
+	emissionTxCoinTypes := make(map[cointype.CoinType]int)
 
 	// Check all transactions in the block
 	for i, tx := range block.Transactions() {
@@ -711,35 +917,39 @@ func CheckSKAEmissionInBlock(block *dcrutil.Block, prevNode *blockNode,
 			// Note: Nonce update is handled separately during actual block connection
 			// to avoid double-updates during validation phases.
 
-			// Track which coin types are being emitted and check for previous emissions
-			for _, txOut := range msgTx.TxOut {
-				coinType := txOut.CoinType
-
-				// Check for multiple emission transactions in the same block
-				if emissionTxCoinTypes[coinType] {
-					return fmt.Errorf("multiple emission transactions for coin type %d at height %d - only one emission per coin type allowed", coinType, blockHeight)
-				}
-
-				// For SKA-2 and higher coin types, verify stakeholder vote has passed
-				// SKA-1 is always active and doesn't require voting
-				if coinType >= 2 {
-					voteID := fmt.Sprintf("activateska%d", coinType)
-					// Use hasVotePassed with prevNode to avoid re-acquiring the chain lock
-					// that the caller already holds (prevents deadlock)
-					if !chain.hasVotePassed(voteID, prevNode) {
-						return fmt.Errorf("cannot emit %s at height %d: stakeholder vote %s has not activated this coin type",
-							coinType, blockHeight, voteID)
-					}
-				}
+			// All outputs of a valid emission transaction pay the coin type
+			// it is authorized for, so the coin type of the first output is
+			// the coin type of the emission.
+			coinType := msgTx.TxOut[0].CoinType
+
+			// Only one emission transaction per coin type is allowed in a
+			// block.  Each of them is authorized independently against the
+			// nonce of the previous blocks, so two of them for the same coin
+			// type would otherwise both be accepted.
+			if prevIdx, ok := emissionTxCoinTypes[coinType]; ok {
+				return fmt.Errorf("multiple emission transactions for coin type %d at height %d (indices %d and %d) - only one emission per coin type allowed",
+					coinType, blockHeight, prevIdx, i)
+			}
 
-				// Check if this coin type has already been emitted in previous blocks
-				// This uses the blockchain state for O(1) lookups and proper reorg handling
-				if CheckSKAEmissionAlreadyExists(coinType, chain) {
-					return fmt.Errorf("SKA coin type %d has already been emitted - only one emission per coin type allowed", coinType)
+			// For SKA-2 and higher coin types, verify stakeholder vote has passed
+			// SKA-1 is always active and doesn't require voting
+			if coinType >= 2 {
+				voteID := chaincfg.SKAActivationVoteID(coinType)
+				// Use hasVotePassed with prevNode to avoid re-acquiring the chain lock
+				// that the caller already holds (prevents deadlock)
+				if !chain.hasVotePassed(voteID, prevNode) {
+					return fmt.Errorf("cannot emit %s at height %d: stakeholder vote %s has not activated this coin type",
+						coinType, blockHeight, voteID)
 				}
+			}
 
-				emissionTxCoinTypes[coinType] = true
+			// Check if this coin type has already been emitted in previous blocks
+			// This uses the blockchain state for O(1) lookups and proper reorg handling
+			if CheckSKAEmissionAlreadyExists(coinType, chain) {
+				return fmt.Errorf("SKA coin type %d has already been emitted - all scheduled emission tranches are complete", coinType)
 			}
+
+			emissionTxCoinTypes[coinType] = i
 		}
 
 		// Count transactions with SKA outputs (excluding emission transactions)
@@ -758,13 +968,10 @@ func CheckSKAEmissionInBlock(block *dcrutil.Block, prevNode *blockNode,
 		// Emission transactions are allowed during emission windows
 		if emissionTxCount > 0 {
 			// Validate that emission transactions are within their respective windows
-			for coinType := range emissionTxCoinTypes {
+			for _, coinType := range cointype.SortedKeys(emissionTxCoinTypes) {
 				if !isSKAEmissionWindow(blockHeight, coinType, chainParams) {
-					config := chainParams.SKACoins[coinType]
-					emissionStart := int64(config.EmissionHeight)
-					emissionEnd := emissionStart + int64(config.EmissionWindow)
-					return fmt.Errorf("emission transaction for coin type %d at height %d is outside emission window (%d-%d)",
-						coinType, blockHeight, emissionStart, emissionEnd)
+					return fmt.Errorf("emission transaction for coin type %d at height %d is outside emission window",
+						coinType, blockHeight)
 				}
 				// Emission transaction validated successfully
 			}
@@ -792,8 +999,8 @@ func CheckSKAEmissionInBlock(block *dcrutil.Block, prevNode *blockNode,
 	}
 
 	// Verify all used coin types are active
-	for coinType := range usedCoinTypes {
-		if !chainParams.IsSKACoinTypeActive(coinType) {
+	for _, coinType := range cointype.SortedKeys(usedCoinTypes) {
+		if !chainParams.IsSKACoinTypeActiveAtHeight(coinType, blockHeight) {
 			return fmt.Errorf("SKA transactions not allowed for inactive coin type %d", coinType)
 		}
 	}
@@ -819,9 +1026,12 @@ func extractSKAEmissionsFromBlock(block *dcrutil.Block, blockHeight int64) []SKA
 					// Extract nonce from the transaction's authorization
 					var nonce uint64 = 1 // Default nonce for first emission
 
-					// Try to extract authorization for nonce
+					// Try to extract authorization for nonce.  Data after
+					// the signature is allowed since it is only rejected
+					// once the emission witness rule is active.
 					if len(msgTx.TxIn) > 0 && len(msgTx.TxIn[0].SignatureScript) > 0 {
-						auth, err := extractEmissionAuthorization(msgTx.TxIn[0].SignatureScript)
+						auth, err := parseEmissionAuthorization(
+							msgTx.TxIn[0].SignatureScript, false)
 						if err == nil && auth != nil {
 							nonce = auth.Nonce
 						}
@@ -842,3 +1052,30 @@ func extractSKAEmissionsFromBlock(block *dcrutil.Block, blockHeight int64) []SKA
 
 	return emissions
 }
+
+// BlockSKAEmissions returns the SKA emission records for every coin type paid
+// by the emission transactions in the provided block.  The block must have its
+// height set.
+func BlockSKAEmissions(block *dcrutil.Block) []SKAEmissionRecord {
+	return extractSKAEmissionsFromBlock(block, block.Height())
+}
+
+// removeSKAEmissionRecords returns the provided emission records without those
+// that match any of the records to remove by transaction hash and coin type.
+// The passed records slice is filtered in place.
+func removeSKAEmissionRecords(records, remove []SKAEmissionRecord) []SKAEmissionRecord {
+	filtered := records[:0]
+	for _, record := range records {
+		var matched bool
+		for _, r := range remove {
+			if r.TxHash == record.TxHash && r.CoinType == record.CoinType {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			filtered = append(filtered, record)
+		}
+	}
+	return filtered
+}
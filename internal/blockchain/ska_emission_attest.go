@@ -0,0 +1,108 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+)
+
+const (
+	// emissionKeyAttestationDomain is the domain separator of the hash an
+	// emission key attestation is signed over.  It differs from the domain
+	// separators of emission authorizations and manifests so an attestation
+	// can never be used to authorize anything.
+	emissionKeyAttestationDomain = "SKA-KEY-ATTEST-V1"
+
+	// MaxEmissionKeyChallengeSize is the maximum size of the challenge an
+	// emission key attestation commits to.
+	MaxEmissionKeyChallengeSize = 256
+)
+
+// EmissionKeyFingerprint returns the fingerprint that identifies the provided
+// emission key, which is the hex encoding of the first four bytes of the
+// hash160 of its compressed serialization.
+func EmissionKeyFingerprint(key *secp256k1.PublicKey) string {
+	return hex.EncodeToString(stdaddr.Hash160(key.SerializeCompressed())[:4])
+}
+
+// checkEmissionKeyChallenge ensures the provided challenge is within the
+// allowed size range.
+func checkEmissionKeyChallenge(challenge []byte) error {
+	if len(challenge) == 0 {
+		return fmt.Errorf("emission key challenge is empty")
+	}
+	if len(challenge) > MaxEmissionKeyChallengeSize {
+		return fmt.Errorf("emission key challenge is %d bytes, which exceeds "+
+			"the max of %d bytes", len(challenge), MaxEmissionKeyChallengeSize)
+	}
+	return nil
+}
+
+// CalcEmissionKeyAttestationHash returns the hash an attestation of custody of
+// the emission key of the provided coin type is signed over.  It commits to
+// the network and coin type along with the challenge so an attestation can't
+// be replayed for another network or coin type.
+func CalcEmissionKeyAttestationHash(coinType cointype.CoinType, challenge []byte, chainParams *chaincfg.Params) chainhash.Hash {
+	var buf bytes.Buffer
+	var b [4]byte
+	buf.WriteString(emissionKeyAttestationDomain)
+	binary.LittleEndian.PutUint32(b[:], uint32(chainParams.Net))
+	buf.Write(b[:])
+	buf.WriteByte(byte(coinType))
+	binary.LittleEndian.PutUint32(b[:], uint32(len(challenge)))
+	buf.Write(b[:])
+	buf.Write(challenge)
+	return chainhash.HashH(buf.Bytes())
+}
+
+// SignEmissionKeyAttestation returns the DER-encoded signature that attests
+// custody of the private key of the emission key of the provided coin type
+// over the given challenge.
+func SignEmissionKeyAttestation(coinType cointype.CoinType, challenge []byte, privKey *secp256k1.PrivateKey, chainParams *chaincfg.Params) ([]byte, error) {
+	if err := checkEmissionKeyChallenge(challenge); err != nil {
+		return nil, err
+	}
+	hash := CalcEmissionKeyAttestationHash(coinType, challenge, chainParams)
+	return ecdsa.Sign(privKey, hash[:]).Serialize(), nil
+}
+
+// VerifyEmissionKeyAttestation ensures the provided DER-encoded signature over
+// the given challenge was produced by the private key of the emission key
+// configured for the provided coin type on the network.
+func VerifyEmissionKeyAttestation(coinType cointype.CoinType, challenge, signature []byte, chainParams *chaincfg.Params) error {
+	emissionKey := chainParams.GetSKAEmissionKey(coinType)
+	if emissionKey == nil {
+		return fmt.Errorf("no emission key configured for coin type %d",
+			coinType)
+	}
+	if err := checkEmissionKeyChallenge(challenge); err != nil {
+		return err
+	}
+	sig, err := ecdsa.ParseDERSignature(signature)
+	if err != nil {
+		return fmt.Errorf("invalid DER signature format: %w", err)
+	}
+	sigS := sig.S()
+	if sigS.IsOverHalfOrder() {
+		return fmt.Errorf("signature not canonical: S value is not low " +
+			"(S > n/2)")
+	}
+	hash := CalcEmissionKeyAttestationHash(coinType, challenge, chainParams)
+	if !sig.Verify(hash[:], emissionKey) {
+		return fmt.Errorf("emission key attestation signature verification " +
+			"failed")
+	}
+	return nil
+}
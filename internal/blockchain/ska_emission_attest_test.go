@@ -0,0 +1,103 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+)
+
+// TestEmissionKeyAttestation ensures an attestation signed by the configured
+// emission key verifies while attestations over another challenge, for another
+// coin type or network, or signed by another key are rejected.
+func TestEmissionKeyAttestation(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	params.SKACoins[1].EmissionKey = privKey.PubKey()
+
+	challenge := []byte("prove custody of the emission key")
+	sig, err := SignEmissionKeyAttestation(1, challenge, privKey, params)
+	if err != nil {
+		t.Fatalf("Failed to sign attestation: %v", err)
+	}
+	if err := VerifyEmissionKeyAttestation(1, challenge, sig, params); err != nil {
+		t.Fatalf("Valid attestation failed verification: %v", err)
+	}
+
+	// An attestation over another challenge must be rejected.
+	other := []byte("another challenge")
+	if err := VerifyEmissionKeyAttestation(1, other, sig, params); err == nil {
+		t.Error("Attestation over another challenge should have failed")
+	}
+
+	// An attestation must not be accepted for another coin type even when
+	// that coin type is configured with the same key.
+	params.SKACoins[2].EmissionKey = privKey.PubKey()
+	if err := VerifyEmissionKeyAttestation(2, challenge, sig, params); err == nil {
+		t.Error("Attestation for another coin type should have failed")
+	}
+
+	// An attestation must not be accepted on another network.
+	testNetParams := chaincfg.TestNet3Params()
+	testNetParams.SKACoins[1].EmissionKey = privKey.PubKey()
+	if err := VerifyEmissionKeyAttestation(1, challenge, sig, testNetParams); err == nil {
+		t.Error("Attestation for another network should have failed")
+	}
+
+	// An attestation signed by another key must be rejected.
+	otherKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	otherSig, err := SignEmissionKeyAttestation(1, challenge, otherKey, params)
+	if err != nil {
+		t.Fatalf("Failed to sign attestation: %v", err)
+	}
+	if err := VerifyEmissionKeyAttestation(1, challenge, otherSig, params); err == nil {
+		t.Error("Attestation signed by another key should have failed")
+	}
+
+	// Malformed signatures and challenges must be rejected.
+	if err := VerifyEmissionKeyAttestation(1, challenge, sig[1:], params); err == nil {
+		t.Error("Malformed signature should have failed")
+	}
+	if _, err := SignEmissionKeyAttestation(1, nil, privKey, params); err == nil {
+		t.Error("Signing an empty challenge should have failed")
+	}
+	oversized := bytes.Repeat([]byte{0x01}, MaxEmissionKeyChallengeSize+1)
+	if err := VerifyEmissionKeyAttestation(1, oversized, sig, params); err == nil {
+		t.Error("Oversized challenge should have failed")
+	}
+
+	// Coin types without a configured emission key can't be attested.
+	if err := VerifyEmissionKeyAttestation(250, challenge, sig, params); err == nil {
+		t.Error("Attestation for unconfigured coin type should have failed")
+	}
+}
+
+// TestEmissionKeyFingerprint ensures emission key fingerprints are the first
+// four bytes of the hash160 of the compressed key.
+func TestEmissionKeyFingerprint(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	tests := []struct {
+		key  *secp256k1.PublicKey
+		want string
+	}{
+		{params.GetSKAEmissionKey(1), "01557763"},
+		{params.GetSKAEmissionKey(2), "b95a58fb"},
+	}
+	for _, test := range tests {
+		if got := EmissionKeyFingerprint(test.key); got != test.want {
+			t.Errorf("unexpected fingerprint for key %x: got %s, want %s",
+				test.key.SerializeCompressed(), got, test.want)
+		}
+	}
+}
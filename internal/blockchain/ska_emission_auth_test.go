@@ -132,20 +132,19 @@ func TestEmissionAuthorizationBasic(t *testing.T) {
 	chain := &BlockChain{
 		chainParams: params,
 		skaEmissionState: &SKAEmissionState{
-			nonces:  make(map[cointype.CoinType]uint64),
-			emitted: make(map[cointype.CoinType]bool),
+			tranches: make(map[cointype.CoinType][]uint64),
 		},
 	}
 
 	// Test emission authorization validation
-	if err := validateEmissionAuthorization(auth, chain, params); err != nil {
+	if err := validateEmissionAuthorization(auth, 100, chain, params); err != nil {
 		t.Errorf("Valid authorization failed validation: %v", err)
 	}
 
 	// Test invalid nonce (replay protection)
 	authInvalidNonce := *auth
 	authInvalidNonce.Nonce = 0 // Should be 1
-	if err := validateEmissionAuthorization(&authInvalidNonce, chain, params); err == nil {
+	if err := validateEmissionAuthorization(&authInvalidNonce, 100, chain, params); err == nil {
 		t.Error("Invalid nonce should have failed validation")
 	}
 
@@ -154,14 +153,14 @@ func TestEmissionAuthorizationBasic(t *testing.T) {
 	wrongPubKey := wrongPrivKey.PubKey()
 	authWrongKey := *auth
 	authWrongKey.EmissionKey = wrongPubKey
-	if err := validateEmissionAuthorization(&authWrongKey, chain, params); err == nil {
+	if err := validateEmissionAuthorization(&authWrongKey, 100, chain, params); err == nil {
 		t.Error("Wrong key should have failed validation")
 	}
 
 	// Test unauthorized coin type
 	authWrongCoinType := *auth
 	authWrongCoinType.CoinType = 2 // Not configured in params
-	if err := validateEmissionAuthorization(&authWrongCoinType, chain, params); err == nil {
+	if err := validateEmissionAuthorization(&authWrongCoinType, 100, chain, params); err == nil {
 		t.Error("Wrong coin type should have failed validation")
 	}
 }
@@ -250,35 +249,169 @@ func TestUnauthorizedEmissionPrevention(t *testing.T) {
 	chain := &BlockChain{
 		chainParams: params,
 		skaEmissionState: &SKAEmissionState{
-			nonces:  make(map[cointype.CoinType]uint64),
-			emitted: make(map[cointype.CoinType]bool),
+			tranches: make(map[cointype.CoinType][]uint64),
 		},
 	}
 
-	if err := validateEmissionAuthorization(auth, chain, params); err == nil {
+	if err := validateEmissionAuthorization(auth, 100, chain, params); err == nil {
 		t.Error("Should fail when no emission key is configured")
 	}
 
 	// Test 2: Configure a key and test replay protection
 	params.SKACoins[1].EmissionKey = privKey.PubKey()
 	// Set nonce in blockchain state instead of params
-	chain.skaEmissionState.nonces[1] = 5 // Simulate 5 emissions already done
-
-	// Should fail with nonce 5 (replay)
-	auth.Nonce = 5
-	if err := validateEmissionAuthorization(auth, chain, params); err == nil {
-		t.Error("Should fail with replayed nonce")
+	chain.skaEmissionState.tranches[1] = []uint64{2, 5} // Simulate emissions with a resynchronized nonce
+
+	// Allow nonces to resynchronize from height 200 so the strict rule
+	// applies before it and the relaxed rule applies at and after it.
+	params.SKANonceResyncHeight = 200
+	tests := []struct {
+		name    string
+		height  int64
+		nonce   uint64
+		wantErr bool
+	}{
+		{"replayed nonce before resync", 199, 5, true},
+		{"stale nonce before resync", 199, 4, true},
+		{"skipped nonce before resync", 199, 7, true},
+		{"next nonce before resync", 199, 6, false},
+		{"replayed nonce at resync", 200, 5, true},
+		{"stale nonce at resync", 200, 4, true},
+		{"skipped nonce at resync", 200, 7, false},
+		{"next nonce at resync", 200, 6, false},
+		{"skipped nonce after resync", 300, 1000, false},
+	}
+	for _, test := range tests {
+		auth.Nonce = test.nonce
+		err := validateEmissionAuthorization(auth, test.height, chain, params)
+		if test.wantErr && err == nil {
+			t.Errorf("%s: expected nonce %d to be rejected at height %d",
+				test.name, test.nonce, test.height)
+		}
+		if !test.wantErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
 	}
 
-	// Should fail with nonce 7 (skipped nonce)
+	// The strict rule applies at every height when resynchronization is not
+	// scheduled.
+	params.SKANonceResyncHeight = 0
 	auth.Nonce = 7
-	if err := validateEmissionAuthorization(auth, chain, params); err == nil {
-		t.Error("Should fail with skipped nonce")
+	if err := validateEmissionAuthorization(auth, 100000, chain, params); err == nil {
+		t.Error("Should fail with skipped nonce when resync is not scheduled")
+	}
+}
+
+// TestSignSKAEmissionTransaction ensures emission transactions signed with
+// SignSKAEmissionTransaction pass full authorized emission validation and that
+// signing with a key other than the authorized one is rejected.
+func TestSignSKAEmissionTransaction(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	config := params.SKACoins[1]
+	config.EmissionKey = privKey.PubKey()
+
+	var amount int64
+	for _, amt := range config.EmissionAmounts {
+		amount += amt
+	}
+	height := int64(config.EmissionHeight)
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Signature:   []byte{0},
+		Nonce:       1,
+		CoinType:    1,
+		Amount:      amount,
+		Height:      height,
+	}
+	tx, err := CreateAuthorizedSKAEmissionTransaction(auth,
+		config.EmissionAddresses, config.EmissionAmounts, params)
+	if err != nil {
+		t.Fatalf("Failed to create emission transaction: %v", err)
+	}
+
+	// Signing with an unauthorized key must fail.
+	wrongKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	if err := SignSKAEmissionTransaction(tx, auth, wrongKey, params); err == nil {
+		t.Fatal("Signing with an unauthorized key should have failed")
+	}
+
+	if err := SignSKAEmissionTransaction(tx, auth, privKey, params); err != nil {
+		t.Fatalf("Failed to sign emission transaction: %v", err)
+	}
+	chain := &BlockChain{
+		chainParams: params,
+		skaEmissionState: &SKAEmissionState{
+			tranches: make(map[cointype.CoinType][]uint64),
+		},
+	}
+	err = ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+	if err != nil {
+		t.Fatalf("Signed emission transaction failed validation: %v", err)
+	}
+
+	// Modifying the outputs after signing must cause validation to fail.
+	tx.TxOut[0].Value--
+	err = ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+	if err == nil {
+		t.Fatal("Modified emission transaction should have failed validation")
+	}
+}
+
+// TestEmissionSigningMessage ensures the message emission signatures commit to
+// hashes to the signature hash and only differs between networks in the
+// network ID.
+func TestEmissionSigningMessage(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	config := params.SKACoins[1]
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Signature:   []byte{0},
+		Nonce:       1,
+		CoinType:    1,
+		Amount:      config.EmissionAmounts[0],
+		Height:      int64(config.EmissionHeight),
+	}
+	tx, err := CreateAuthorizedSKAEmissionTransaction(auth,
+		config.EmissionAddresses, config.EmissionAmounts, params)
+	if err != nil {
+		t.Fatalf("Failed to create emission transaction: %v", err)
+	}
+
+	msg, err := EmissionSigningMessage(tx, auth, params)
+	if err != nil {
+		t.Fatalf("Failed to create signing message: %v", err)
+	}
+	sigHash, err := calcEmissionSigHash(tx, auth, params)
+	if err != nil {
+		t.Fatalf("Failed to calculate signature hash: %v", err)
+	}
+	if sha256.Sum256(msg) != sigHash {
+		t.Fatal("Signing message does not hash to the signature hash")
+	}
+
+	otherMsg, err := EmissionSigningMessage(tx, auth, chaincfg.TestNet3Params())
+	if err != nil {
+		t.Fatalf("Failed to create signing message: %v", err)
+	}
+	const offset = EmissionSigningMessageNetIDOffset
+	netID := binary.LittleEndian.Uint32(msg[offset : offset+4])
+	if netID != uint32(params.Net) {
+		t.Fatalf("Unexpected network ID %x, want %x", netID, params.Net)
+	}
+	if bytes.Equal(msg[offset:offset+4], otherMsg[offset:offset+4]) {
+		t.Fatal("Signing messages of different networks have the same ID")
 	}
+	if !bytes.Equal(msg[:offset], otherMsg[:offset]) ||
+		!bytes.Equal(msg[offset+4:], otherMsg[offset+4:]) {
 
-	// Should succeed with nonce 6 (next valid nonce)
-	auth.Nonce = 6
-	if err := validateEmissionAuthorization(auth, chain, params); err != nil {
-		t.Errorf("Should succeed with next valid nonce: %v", err)
+		t.Fatal("Signing messages of different networks differ outside " +
+			"the network ID")
 	}
 }
@@ -0,0 +1,273 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
+)
+
+// emissionCheckpointDomain is the domain separator of the hash an emission
+// checkpoint is identified by and signed over.
+const emissionCheckpointDomain = "SKA-CHECKPOINT-V1"
+
+// EmissionCheckpointCoinType is the emission state of a single SKA coin type
+// attested to by an emission checkpoint.
+type EmissionCheckpointCoinType struct {
+	CoinType uint8    `json:"cointype"`
+	Nonces   []uint64 `json:"nonces"`
+}
+
+// EmissionCheckpoint is the canonical JSON description of the SKA emission
+// state of a network as of the main chain block at a specific height, signed
+// by an operator key.
+//
+// Nodes that reconstruct the emission state without fully validating the
+// chain, such as those syncing with an assumed valid block, use checkpoints
+// signed by keys they trust to verify the reconstructed state before relying
+// on it.
+//
+// The emission state is the committed nonces of the emitted tranches of each
+// coin type in the order they were emitted.  Every coin type with at least one
+// emitted tranche must be listed in ascending order and coin types without an
+// emitted tranche must be omitted.
+type EmissionCheckpoint struct {
+	Network   string                       `json:"network"`
+	Height    int64                        `json:"height"`
+	BlockHash string                       `json:"blockhash"`
+	CoinTypes []EmissionCheckpointCoinType `json:"cointypes"`
+	Signature string                       `json:"signature,omitempty"`
+}
+
+// ParseEmissionCheckpoint decodes and validates the provided JSON-encoded
+// emission checkpoint.  Unknown fields are rejected so that checkpoints that
+// were produced for a different format are not silently misinterpreted.
+func ParseEmissionCheckpoint(data []byte) (*EmissionCheckpoint, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var cp EmissionCheckpoint
+	if err := dec.Decode(&cp); err != nil {
+		return nil, fmt.Errorf("invalid emission checkpoint: %w", err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("invalid emission checkpoint: trailing data")
+	}
+	if err := cp.Validate(); err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
+
+// Validate ensures the fields of the checkpoint are well formed.
+func (cp *EmissionCheckpoint) Validate() error {
+	if cp.Network == "" {
+		return fmt.Errorf("emission checkpoint does not specify a network")
+	}
+	if cp.Height < 1 {
+		return fmt.Errorf("invalid emission checkpoint height: %d", cp.Height)
+	}
+	blockHash, err := chainhash.NewHashFromStr(cp.BlockHash)
+	if err != nil {
+		return fmt.Errorf("invalid emission checkpoint block hash: %w", err)
+	}
+	if blockHash.String() != cp.BlockHash {
+		return fmt.Errorf("emission checkpoint block hash %q is not encoded "+
+			"as %q", cp.BlockHash, blockHash)
+	}
+	var prevCoinType uint8
+	for _, ct := range cp.CoinTypes {
+		if ct.CoinType <= prevCoinType {
+			return fmt.Errorf("emission checkpoint coin type %d is not an "+
+				"SKA coin type in ascending order", ct.CoinType)
+		}
+		prevCoinType = ct.CoinType
+		if len(ct.Nonces) == 0 {
+			return fmt.Errorf("emission checkpoint coin type %d has no "+
+				"emitted tranches", ct.CoinType)
+		}
+		var prevNonce uint64
+		for _, nonce := range ct.Nonces {
+			if nonce <= prevNonce {
+				return fmt.Errorf("emission checkpoint nonces of coin type "+
+					"%d are not strictly increasing", ct.CoinType)
+			}
+			prevNonce = nonce
+		}
+	}
+	return nil
+}
+
+// Hash returns the hash that identifies the checkpoint and that its signature
+// commits to.  It commits to every field of the checkpoint except the
+// signature.
+func (cp *EmissionCheckpoint) Hash() chainhash.Hash {
+	var buf bytes.Buffer
+	putUint32 := func(v uint32) {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], v)
+		buf.Write(b[:])
+	}
+	putUint64 := func(v uint64) {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], v)
+		buf.Write(b[:])
+	}
+
+	buf.WriteString(emissionCheckpointDomain)
+	putUint32(uint32(len(cp.Network)))
+	buf.WriteString(cp.Network)
+	putUint64(uint64(cp.Height))
+	putUint32(uint32(len(cp.BlockHash)))
+	buf.WriteString(cp.BlockHash)
+	putUint32(uint32(len(cp.CoinTypes)))
+	for _, ct := range cp.CoinTypes {
+		buf.WriteByte(ct.CoinType)
+		putUint32(uint32(len(ct.Nonces)))
+		for _, nonce := range ct.Nonces {
+			putUint64(nonce)
+		}
+	}
+	return chainhash.HashH(buf.Bytes())
+}
+
+// Sign signs the checkpoint with the provided private key and sets the
+// signature of the checkpoint accordingly.
+func (cp *EmissionCheckpoint) Sign(privKey *secp256k1.PrivateKey) error {
+	if err := cp.Validate(); err != nil {
+		return err
+	}
+	hash := cp.Hash()
+	cp.Signature = hex.EncodeToString(ecdsa.Sign(privKey, hash[:]).Serialize())
+	return nil
+}
+
+// Verify ensures the checkpoint is signed by the private key of one of the
+// provided checkpoint keys.
+func (cp *EmissionCheckpoint) Verify(checkpointKeys []*secp256k1.PublicKey) error {
+	if cp.Signature == "" {
+		return fmt.Errorf("emission checkpoint is not signed")
+	}
+	sigBytes, err := hex.DecodeString(cp.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid emission checkpoint signature: %w", err)
+	}
+	sig, err := ecdsa.ParseDERSignature(sigBytes)
+	if err != nil {
+		return fmt.Errorf("invalid DER signature format: %w", err)
+	}
+	sigS := sig.S()
+	if sigS.IsOverHalfOrder() {
+		return fmt.Errorf("signature not canonical: S value is not low " +
+			"(S > n/2)")
+	}
+	hash := cp.Hash()
+	for _, key := range checkpointKeys {
+		if sig.Verify(hash[:], key) {
+			return nil
+		}
+	}
+	return fmt.Errorf("emission checkpoint is not signed by a trusted " +
+		"checkpoint key")
+}
+
+// CheckEmissionCheckpoint ensures the block at the height of the provided
+// checkpoint in the main chain is the one it attests to and that the SKA
+// emission state as of that block matches the state it attests to.  The
+// checkpoint is expected to have already been validated and its signature
+// verified.
+//
+// The emission state as of the checkpoint is reconstructed by rolling back the
+// emissions of every main chain block after it, so the cost of the check grows
+// with the number of blocks the best chain extends past the checkpoint.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) CheckEmissionCheckpoint(cp *EmissionCheckpoint) error {
+	if cp.Network != b.chainParams.Name {
+		return fmt.Errorf("emission checkpoint is for network %q instead of "+
+			"%q", cp.Network, b.chainParams.Name)
+	}
+	if b.skaEmissionState == nil {
+		return fmt.Errorf("SKA emission state is not available")
+	}
+
+	// Prevent the best chain and the emission state from changing while the
+	// state as of the checkpoint is reconstructed.
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	node := b.bestChain.NodeByHeight(cp.Height)
+	if node == nil {
+		str := fmt.Sprintf("no block at emission checkpoint height %d "+
+			"exists", cp.Height)
+		return errNotInMainChain(str)
+	}
+	blockHash, err := chainhash.NewHashFromStr(cp.BlockHash)
+	if err != nil {
+		return fmt.Errorf("invalid emission checkpoint block hash: %w", err)
+	}
+	if node.hash != *blockHash {
+		return fmt.Errorf("main chain block %v at height %d does not match "+
+			"emission checkpoint block %v", node.hash, cp.Height, blockHash)
+	}
+
+	b.skaEmissionState.mtx.RLock()
+	tranches := make(map[cointype.CoinType][]uint64,
+		len(b.skaEmissionState.tranches))
+	for coinType, nonces := range b.skaEmissionState.tranches {
+		tranches[coinType] = append([]uint64(nil), nonces...)
+	}
+	b.skaEmissionState.mtx.RUnlock()
+
+	// Roll back the emissions of the blocks after the checkpoint in the same
+	// way they are rolled back when the blocks are disconnected.
+	for n := b.bestChain.Tip(); n != node; n = n.parent {
+		block, err := b.fetchMainChainBlockByNode(n)
+		if err != nil {
+			return err
+		}
+		for _, emission := range extractSKAEmissionsFromBlock(block, n.height) {
+			nonces := tranches[emission.CoinType]
+			if len(nonces) > 0 && nonces[len(nonces)-1] == emission.Nonce {
+				tranches[emission.CoinType] = nonces[:len(nonces)-1]
+			}
+		}
+	}
+
+	attested := make(map[cointype.CoinType]struct{}, len(cp.CoinTypes))
+	for _, ct := range cp.CoinTypes {
+		coinType := cointype.CoinType(ct.CoinType)
+		attested[coinType] = struct{}{}
+		nonces := tranches[coinType]
+		if len(nonces) != len(ct.Nonces) {
+			return fmt.Errorf("%d emission tranches of %v are recorded as of "+
+				"height %d, but the emission checkpoint attests to %d",
+				len(nonces), coinType, cp.Height, len(ct.Nonces))
+		}
+		for i, nonce := range ct.Nonces {
+			if nonces[i] != nonce {
+				return fmt.Errorf("emission tranche %d of %v is recorded with "+
+					"nonce %d as of height %d, but the emission checkpoint "+
+					"attests to nonce %d", i+1, coinType, nonces[i],
+					cp.Height, nonce)
+			}
+		}
+	}
+	for coinType, nonces := range tranches {
+		if _, ok := attested[coinType]; !ok && len(nonces) > 0 {
+			return fmt.Errorf("%d emission tranches of %v are recorded as of "+
+				"height %d, but the emission checkpoint attests to none",
+				len(nonces), coinType, cp.Height)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestEmissionCheckpointSignature ensures emission checkpoints round trip
+// through their JSON encoding, are only accepted when signed by one of the
+// trusted checkpoint keys, and that malformed checkpoints are rejected.
+func TestEmissionCheckpointSignature(t *testing.T) {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	otherKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+
+	cp := &EmissionCheckpoint{
+		Network:   "simnet",
+		Height:    100,
+		BlockHash: strings.Repeat("ab", 32),
+		CoinTypes: []EmissionCheckpointCoinType{
+			{CoinType: 1, Nonces: []uint64{1, 3}},
+			{CoinType: 2, Nonces: []uint64{1}},
+		},
+	}
+	if err := cp.Verify([]*secp256k1.PublicKey{privKey.PubKey()}); err == nil {
+		t.Fatal("Unsigned checkpoint should have failed verification")
+	}
+	hash := cp.Hash()
+	if err := cp.Sign(privKey); err != nil {
+		t.Fatalf("Failed to sign checkpoint: %v", err)
+	}
+	if cp.Hash() != hash {
+		t.Fatal("Signing the checkpoint changed its hash")
+	}
+
+	encoded, err := json.Marshal(cp)
+	if err != nil {
+		t.Fatalf("Failed to encode checkpoint: %v", err)
+	}
+	parsed, err := ParseEmissionCheckpoint(encoded)
+	if err != nil {
+		t.Fatalf("Failed to parse checkpoint: %v", err)
+	}
+	keys := []*secp256k1.PublicKey{otherKey.PubKey(), privKey.PubKey()}
+	if err := parsed.Verify(keys); err != nil {
+		t.Fatalf("Signed checkpoint failed verification: %v", err)
+	}
+	if err := parsed.Verify(keys[:1]); err == nil {
+		t.Fatal("Checkpoint signed by an untrusted key should have failed " +
+			"verification")
+	}
+
+	// Any change to the attested state must invalidate the signature.
+	parsed.CoinTypes[0].Nonces[1] = 2
+	if err := parsed.Verify(keys); err == nil {
+		t.Fatal("Modified checkpoint should have failed verification")
+	}
+
+	invalid := []string{
+		`{"network":"simnet","height":100,"blockhash":"` +
+			strings.Repeat("ab", 32) + `","cointypes":[],"extra":1}`,
+		`{"network":"","height":100,"blockhash":"` +
+			strings.Repeat("ab", 32) + `","cointypes":[]}`,
+		`{"network":"simnet","height":0,"blockhash":"` +
+			strings.Repeat("ab", 32) + `","cointypes":[]}`,
+		`{"network":"simnet","height":100,"blockhash":"` +
+			strings.Repeat("AB", 32) + `","cointypes":[]}`,
+		`{"network":"simnet","height":100,"blockhash":"` +
+			strings.Repeat("ab", 32) + `","cointypes":[{"cointype":0,` +
+			`"nonces":[1]}]}`,
+		`{"network":"simnet","height":100,"blockhash":"` +
+			strings.Repeat("ab", 32) + `","cointypes":[{"cointype":2,` +
+			`"nonces":[1]},{"cointype":1,"nonces":[1]}]}`,
+		`{"network":"simnet","height":100,"blockhash":"` +
+			strings.Repeat("ab", 32) + `","cointypes":[{"cointype":1,` +
+			`"nonces":[]}]}`,
+		`{"network":"simnet","height":100,"blockhash":"` +
+			strings.Repeat("ab", 32) + `","cointypes":[{"cointype":1,` +
+			`"nonces":[2,2]}]}`,
+	}
+	for i, data := range invalid {
+		if _, err := ParseEmissionCheckpoint([]byte(data)); err == nil {
+			t.Errorf("invalid checkpoint %d: expected error", i)
+		}
+	}
+}
+
+// TestCheckEmissionCheckpoint ensures the emission state as of the height of a
+// checkpoint is reconstructed from the current state by rolling back the
+// emissions of the blocks after it and compared against the attested state.
+func TestCheckEmissionCheckpoint(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	config := params.SKACoins[1]
+	config.EmissionKey = privKey.PubKey()
+	config.EmissionTranches = nil
+
+	// Create a fake main chain of four blocks where the final block emits the
+	// second tranche of coin type 1.
+	chain := newFakeChain(params)
+	nodes := chainedFakeNodes(chain.bestChain.Tip(), 4)
+	for _, node := range nodes {
+		chain.index.AddNode(node)
+	}
+	chain.bestChain.SetTip(nodes[3])
+
+	height := int64(config.EmissionHeight)
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Signature:   []byte{0},
+		Nonce:       2,
+		CoinType:    1,
+		Amount:      config.NextEmissionTranche(0).TotalAmount(),
+		Height:      height,
+	}
+	tx, err := CreateAuthorizedSKAEmissionTransaction(auth,
+		config.EmissionAddresses, config.EmissionAmounts, params)
+	if err != nil {
+		t.Fatalf("Failed to create emission transaction: %v", err)
+	}
+	if err := SignSKAEmissionTransaction(tx, auth, privKey, params); err != nil {
+		t.Fatalf("Failed to sign emission transaction: %v", err)
+	}
+	for i, node := range nodes {
+		msgBlock := &wire.MsgBlock{Header: node.Header()}
+		if i == 3 {
+			msgBlock.Transactions = []*wire.MsgTx{tx}
+		}
+		block := dcrutil.NewBlock(msgBlock)
+		if i == 3 {
+			emissions := extractSKAEmissionsFromBlock(block, node.height)
+			if len(emissions) != 1 || emissions[0].Nonce != 2 {
+				t.Fatalf("unexpected emissions in block: %+v", emissions)
+			}
+		}
+		chain.addRecentBlock(block)
+	}
+	chain.skaEmissionState = &SKAEmissionState{
+		tranches: map[cointype.CoinType][]uint64{1: {1, 2}},
+	}
+
+	checkpointAt := func(node *blockNode, coinTypes ...EmissionCheckpointCoinType) *EmissionCheckpoint {
+		return &EmissionCheckpoint{
+			Network:   params.Name,
+			Height:    node.height,
+			BlockHash: node.hash.String(),
+			CoinTypes: coinTypes,
+		}
+	}
+	ska1 := func(nonces ...uint64) EmissionCheckpointCoinType {
+		return EmissionCheckpointCoinType{CoinType: 1, Nonces: nonces}
+	}
+
+	tests := []struct {
+		name    string
+		cp      *EmissionCheckpoint
+		wantErr bool
+	}{{
+		name: "tip",
+		cp:   checkpointAt(nodes[3], ska1(1, 2)),
+	}, {
+		name: "before the emission",
+		cp:   checkpointAt(nodes[1], ska1(1)),
+	}, {
+		name:    "emission not rolled back",
+		cp:      checkpointAt(nodes[1], ska1(1, 2)),
+		wantErr: true,
+	}, {
+		name:    "mismatched nonce",
+		cp:      checkpointAt(nodes[2], ska1(2)),
+		wantErr: true,
+	}, {
+		name:    "missing coin type",
+		cp:      checkpointAt(nodes[2]),
+		wantErr: true,
+	}, {
+		name: "extra coin type",
+		cp: checkpointAt(nodes[2], ska1(1), EmissionCheckpointCoinType{
+			CoinType: 2,
+			Nonces:   []uint64{1},
+		}),
+		wantErr: true,
+	}, {
+		name: "mismatched block hash",
+		cp: &EmissionCheckpoint{
+			Network:   params.Name,
+			Height:    nodes[1].height,
+			BlockHash: nodes[2].hash.String(),
+			CoinTypes: []EmissionCheckpointCoinType{ska1(1)},
+		},
+		wantErr: true,
+	}, {
+		name: "other network",
+		cp: &EmissionCheckpoint{
+			Network:   "mainnet",
+			Height:    nodes[3].height,
+			BlockHash: nodes[3].hash.String(),
+			CoinTypes: []EmissionCheckpointCoinType{ska1(1, 2)},
+		},
+		wantErr: true,
+	}, {
+		name: "beyond the tip",
+		cp: &EmissionCheckpoint{
+			Network:   params.Name,
+			Height:    nodes[3].height + 1,
+			BlockHash: nodes[3].hash.String(),
+			CoinTypes: []EmissionCheckpointCoinType{ska1(1, 2)},
+		},
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		err := chain.CheckEmissionCheckpoint(test.cp)
+		if test.wantErr != (err != nil) {
+			t.Errorf("%s: unexpected error: got %v, want error %v", test.name,
+				err, test.wantErr)
+		}
+	}
+
+	// Reconstructing the state must not modify the current state.
+	if nonces := chain.skaEmissionState.tranches[1]; len(nonces) != 2 {
+		t.Fatalf("emission state modified by the check: %v", nonces)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// EmissionAuthDescriptor is the canonical JSON description of an SKA emission
+// authorization along with the outputs of the emission it authorizes.
+//
+// The descriptor contains everything needed to rebuild the emission
+// transaction, so it is the only data that has to cross the gap in air-gapped
+// signing workflows: the unsigned descriptor is carried to the offline signer,
+// which rebuilds the transaction and fills in the signature, and the signed
+// descriptor is carried back and converted to the authorization script.
+//
+// The canonical encoding is the compact JSON encoding of the fields in the
+// order they are declared with hex-encoded keys and signatures in lowercase.
+// The signature is omitted from unsigned descriptors.
+type EmissionAuthDescriptor struct {
+	CoinType  uint8    `json:"cointype"`
+	Nonce     uint64   `json:"nonce"`
+	Amount    int64    `json:"amount"`
+	Height    int64    `json:"height"`
+	Addresses []string `json:"addresses"`
+	Amounts   []int64  `json:"amounts"`
+	PubKey    string   `json:"pubkey"`
+	Signature string   `json:"signature,omitempty"`
+}
+
+// NewEmissionAuthDescriptor returns the descriptor of the provided emission
+// authorization and the outputs it authorizes.
+func NewEmissionAuthDescriptor(auth *chaincfg.SKAEmissionAuth, addresses []string, amounts []int64) *EmissionAuthDescriptor {
+	var pubKey string
+	if auth.EmissionKey != nil {
+		pubKey = hex.EncodeToString(auth.EmissionKey.SerializeCompressed())
+	}
+	return &EmissionAuthDescriptor{
+		CoinType:  uint8(auth.CoinType),
+		Nonce:     auth.Nonce,
+		Amount:    auth.Amount,
+		Height:    auth.Height,
+		Addresses: append([]string(nil), addresses...),
+		Amounts:   append([]int64(nil), amounts...),
+		PubKey:    pubKey,
+		Signature: hex.EncodeToString(auth.Signature),
+	}
+}
+
+// ParseEmissionAuthDescriptor decodes and validates the provided JSON-encoded
+// emission authorization descriptor.  Unknown fields are rejected so that
+// descriptors that were produced for a different format are not silently
+// misinterpreted.
+func ParseEmissionAuthDescriptor(data []byte) (*EmissionAuthDescriptor, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var d EmissionAuthDescriptor
+	if err := dec.Decode(&d); err != nil {
+		return nil, fmt.Errorf("invalid emission authorization descriptor: %w",
+			err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("invalid emission authorization descriptor: " +
+			"trailing data")
+	}
+	if _, err := d.Auth(); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// Auth validates the descriptor and returns the emission authorization it
+// describes.  The signature of the returned authorization is empty when the
+// descriptor is unsigned.
+func (d *EmissionAuthDescriptor) Auth() (*chaincfg.SKAEmissionAuth, error) {
+	if d.CoinType == 0 {
+		return nil, fmt.Errorf("invalid SKA coin type: %d", d.CoinType)
+	}
+	if len(d.Addresses) == 0 {
+		return nil, fmt.Errorf("no emission addresses specified")
+	}
+	if len(d.Addresses) != len(d.Amounts) {
+		return nil, fmt.Errorf("emission addresses and amounts length mismatch")
+	}
+	var totalAmount int64
+	for _, amount := range d.Amounts {
+		if amount <= 0 || amount > math.MaxInt64-totalAmount {
+			return nil, fmt.Errorf("invalid emission amount: %d", amount)
+		}
+		totalAmount += amount
+	}
+	if totalAmount != d.Amount {
+		return nil, fmt.Errorf("total emission amount %d does not match "+
+			"authorization %d", totalAmount, d.Amount)
+	}
+	if d.Height < 0 {
+		return nil, fmt.Errorf("invalid emission height: %d", d.Height)
+	}
+
+	pubKeyBytes, err := hex.DecodeString(d.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid emission public key: %w", err)
+	}
+	if len(pubKeyBytes) != secp256k1.PubKeyBytesLenCompressed {
+		return nil, fmt.Errorf("invalid emission public key length: expected "+
+			"%d bytes, got %d", secp256k1.PubKeyBytesLenCompressed,
+			len(pubKeyBytes))
+	}
+	pubKey, err := secp256k1.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid emission public key: %w", err)
+	}
+	signature, err := hex.DecodeString(d.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid emission signature: %w", err)
+	}
+	if len(signature) > 255 {
+		return nil, fmt.Errorf("invalid emission signature length: %d",
+			len(signature))
+	}
+
+	return &chaincfg.SKAEmissionAuth{
+		EmissionKey: pubKey,
+		Signature:   signature,
+		Nonce:       d.Nonce,
+		CoinType:    cointype.CoinType(d.CoinType),
+		Amount:      d.Amount,
+		Height:      d.Height,
+	}, nil
+}
+
+// Canonical returns the canonical JSON encoding of the descriptor.
+func (d *EmissionAuthDescriptor) Canonical() ([]byte, error) {
+	auth, err := d.Auth()
+	if err != nil {
+		return nil, err
+	}
+	canonical := NewEmissionAuthDescriptor(auth, d.Addresses, d.Amounts)
+	return json.Marshal(canonical)
+}
+
+// AuthScript returns the emission authorization script described by the
+// descriptor.  Unsigned descriptors result in a script with an empty
+// signature.
+func (d *EmissionAuthDescriptor) AuthScript() ([]byte, error) {
+	auth, err := d.Auth()
+	if err != nil {
+		return nil, err
+	}
+	return createEmissionAuthScript(auth)
+}
+
+// Transaction returns the emission transaction described by the descriptor
+// for the provided network.  The authorization script of the input is
+// described by the descriptor, so the transaction is only valid once the
+// descriptor is signed.
+func (d *EmissionAuthDescriptor) Transaction(chainParams *chaincfg.Params) (*wire.MsgTx, error) {
+	auth, err := d.Auth()
+	if err != nil {
+		return nil, err
+	}
+
+	// The transaction creation requires a signature, so use a placeholder
+	// for unsigned descriptors and replace the resulting authorization
+	// script afterwards.
+	createAuth := *auth
+	if len(createAuth.Signature) == 0 {
+		createAuth.Signature = []byte{0}
+	}
+	tx, err := CreateAuthorizedSKAEmissionTransaction(&createAuth, d.Addresses,
+		d.Amounts, chainParams)
+	if err != nil {
+		return nil, err
+	}
+	authScript, err := createEmissionAuthScript(auth)
+	if err != nil {
+		return nil, err
+	}
+	tx.TxIn[0].SignatureScript = authScript
+	return tx, nil
+}
+
+// Sign signs the emission transaction described by the descriptor for the
+// provided network with the provided private key and sets the signature of
+// the descriptor accordingly.  The private key must correspond to the public
+// key of the descriptor.
+func (d *EmissionAuthDescriptor) Sign(privKey *secp256k1.PrivateKey, chainParams *chaincfg.Params) error {
+	tx, err := d.Transaction(chainParams)
+	if err != nil {
+		return err
+	}
+	auth, err := d.Auth()
+	if err != nil {
+		return err
+	}
+	if err := SignSKAEmissionTransaction(tx, auth, privKey, chainParams); err != nil {
+		return err
+	}
+	d.Signature = hex.EncodeToString(auth.Signature)
+	return nil
+}
+
+// Verify ensures the descriptor is signed by the private key of its public key
+// for the emission transaction it describes on the provided network.
+func (d *EmissionAuthDescriptor) Verify(chainParams *chaincfg.Params) error {
+	if d.Signature == "" {
+		return fmt.Errorf("emission authorization descriptor is not signed")
+	}
+	tx, err := d.Transaction(chainParams)
+	if err != nil {
+		return err
+	}
+	auth, err := d.Auth()
+	if err != nil {
+		return err
+	}
+	return verifyEmissionSignature(tx, auth, d.Height, chainParams)
+}
+
+// DecodeEmissionAuthScript returns the descriptor of the emission
+// authorization contained in the provided authorization script.  The outputs
+// of the emission are not part of the script, so the addresses and amounts of
+// the returned descriptor are empty and must be filled in before it can be
+// used to rebuild the transaction.
+func DecodeEmissionAuthScript(script []byte) (*EmissionAuthDescriptor, error) {
+	auth, err := extractEmissionAuthorization(script)
+	if err != nil {
+		return nil, err
+	}
+	d := NewEmissionAuthDescriptor(auth, nil, nil)
+	d.Addresses = []string{}
+	d.Amounts = []int64{}
+	return d, nil
+}
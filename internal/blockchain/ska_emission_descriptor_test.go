@@ -0,0 +1,183 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+)
+
+// TestEmissionAuthDescriptorRoundTrip ensures an unsigned descriptor signed
+// offline results in a canonical descriptor, authorization script, and
+// emission transaction that pass authorized emission validation, and that the
+// script decodes back to the authorization of the descriptor.
+func TestEmissionAuthDescriptorRoundTrip(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	config := params.SKACoins[1]
+	config.EmissionKey = privKey.PubKey()
+
+	var amount int64
+	for _, amt := range config.EmissionAmounts {
+		amount += amt
+	}
+	height := int64(config.EmissionHeight)
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Nonce:       1,
+		CoinType:    1,
+		Amount:      amount,
+		Height:      height,
+	}
+	unsigned, err := NewEmissionAuthDescriptor(auth, config.EmissionAddresses,
+		config.EmissionAmounts).Canonical()
+	if err != nil {
+		t.Fatalf("Failed to encode unsigned descriptor: %v", err)
+	}
+	if bytes.Contains(unsigned, []byte(`"signature"`)) {
+		t.Fatalf("Unsigned descriptor contains a signature: %s", unsigned)
+	}
+
+	// Only the descriptor crosses the gap, so sign a parsed copy.
+	descriptor, err := ParseEmissionAuthDescriptor(unsigned)
+	if err != nil {
+		t.Fatalf("Failed to parse unsigned descriptor: %v", err)
+	}
+	if err := descriptor.Verify(params); err == nil {
+		t.Fatal("Unsigned descriptor should have failed verification")
+	}
+	if err := descriptor.Sign(privKey, params); err != nil {
+		t.Fatalf("Failed to sign descriptor: %v", err)
+	}
+	signed, err := descriptor.Canonical()
+	if err != nil {
+		t.Fatalf("Failed to encode signed descriptor: %v", err)
+	}
+
+	descriptor, err = ParseEmissionAuthDescriptor(signed)
+	if err != nil {
+		t.Fatalf("Failed to parse signed descriptor: %v", err)
+	}
+	if err := descriptor.Verify(params); err != nil {
+		t.Fatalf("Signed descriptor failed verification: %v", err)
+	}
+	reencoded, err := descriptor.Canonical()
+	if err != nil {
+		t.Fatalf("Failed to encode signed descriptor: %v", err)
+	}
+	if !bytes.Equal(reencoded, signed) {
+		t.Fatalf("Descriptor encoding is not canonical: got %s, want %s",
+			reencoded, signed)
+	}
+
+	tx, err := descriptor.Transaction(params)
+	if err != nil {
+		t.Fatalf("Failed to create emission transaction: %v", err)
+	}
+	chain := &BlockChain{
+		chainParams: params,
+		skaEmissionState: &SKAEmissionState{
+			tranches: make(map[cointype.CoinType][]uint64),
+		},
+	}
+	err = ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+	if err != nil {
+		t.Fatalf("Emission transaction failed validation: %v", err)
+	}
+
+	// The authorization script must be the signature script of the
+	// transaction and decode back to the authorization of the descriptor.
+	authScript, err := descriptor.AuthScript()
+	if err != nil {
+		t.Fatalf("Failed to create authorization script: %v", err)
+	}
+	if !bytes.Equal(authScript, tx.TxIn[0].SignatureScript) {
+		t.Fatalf("Authorization script mismatch: got %x, want %x",
+			authScript, tx.TxIn[0].SignatureScript)
+	}
+	decoded, err := DecodeEmissionAuthScript(authScript)
+	if err != nil {
+		t.Fatalf("Failed to decode authorization script: %v", err)
+	}
+	decoded.Addresses = descriptor.Addresses
+	decoded.Amounts = descriptor.Amounts
+	redecoded, err := decoded.Canonical()
+	if err != nil {
+		t.Fatalf("Failed to encode decoded descriptor: %v", err)
+	}
+	if !bytes.Equal(redecoded, signed) {
+		t.Fatalf("Decoded descriptor mismatch: got %s, want %s", redecoded,
+			signed)
+	}
+
+	// A signature for another network must fail verification.
+	if err := descriptor.Verify(chaincfg.RegNetParams()); err == nil {
+		t.Fatal("Descriptor signed for another network should have failed " +
+			"verification")
+	}
+}
+
+// TestParseEmissionAuthDescriptorErrors ensures malformed and inconsistent
+// descriptors are rejected.
+func TestParseEmissionAuthDescriptorErrors(t *testing.T) {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	pubKey := hex.EncodeToString(privKey.PubKey().SerializeCompressed())
+
+	tests := []struct {
+		name       string
+		descriptor string
+	}{{
+		name:       "unknown field",
+		descriptor: `{"cointype":1,"nonce":1,"amount":5,"height":10,"addresses":["a"],"amounts":[5],"pubkey":"` + pubKey + `","extra":1}`,
+	}, {
+		name:       "trailing data",
+		descriptor: `{"cointype":1,"nonce":1,"amount":5,"height":10,"addresses":["a"],"amounts":[5],"pubkey":"` + pubKey + `"}{}`,
+	}, {
+		name:       "var coin type",
+		descriptor: `{"cointype":0,"nonce":1,"amount":5,"height":10,"addresses":["a"],"amounts":[5],"pubkey":"` + pubKey + `"}`,
+	}, {
+		name:       "no addresses",
+		descriptor: `{"cointype":1,"nonce":1,"amount":5,"height":10,"addresses":[],"amounts":[],"pubkey":"` + pubKey + `"}`,
+	}, {
+		name:       "length mismatch",
+		descriptor: `{"cointype":1,"nonce":1,"amount":5,"height":10,"addresses":["a"],"amounts":[2,3],"pubkey":"` + pubKey + `"}`,
+	}, {
+		name:       "amount mismatch",
+		descriptor: `{"cointype":1,"nonce":1,"amount":6,"height":10,"addresses":["a","b"],"amounts":[2,3],"pubkey":"` + pubKey + `"}`,
+	}, {
+		name:       "zero amount",
+		descriptor: `{"cointype":1,"nonce":1,"amount":5,"height":10,"addresses":["a","b"],"amounts":[5,0],"pubkey":"` + pubKey + `"}`,
+	}, {
+		name:       "overflowing amounts",
+		descriptor: `{"cointype":1,"nonce":1,"amount":1,"height":10,"addresses":["a","b"],"amounts":[9223372036854775807,2],"pubkey":"` + pubKey + `"}`,
+	}, {
+		name:       "negative height",
+		descriptor: `{"cointype":1,"nonce":1,"amount":5,"height":-1,"addresses":["a"],"amounts":[5],"pubkey":"` + pubKey + `"}`,
+	}, {
+		name:       "uncompressed pubkey",
+		descriptor: `{"cointype":1,"nonce":1,"amount":5,"height":10,"addresses":["a"],"amounts":[5],"pubkey":"` + hex.EncodeToString(privKey.PubKey().SerializeUncompressed()) + `"}`,
+	}, {
+		name:       "malformed signature",
+		descriptor: `{"cointype":1,"nonce":1,"amount":5,"height":10,"addresses":["a"],"amounts":[5],"pubkey":"` + pubKey + `","signature":"zz"}`,
+	}}
+
+	for _, test := range tests {
+		_, err := ParseEmissionAuthDescriptor([]byte(test.descriptor))
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
@@ -26,8 +26,7 @@ func TestSKAEmissionBasicValidation(t *testing.T) {
 	chain := &BlockChain{
 		chainParams: params,
 		skaEmissionState: &SKAEmissionState{
-			nonces:  make(map[cointype.CoinType]uint64),
-			emitted: make(map[cointype.CoinType]bool),
+			tranches: make(map[cointype.CoinType][]uint64),
 		},
 	}
 
@@ -0,0 +1,163 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+)
+
+// fuzzEmissionAuth returns an emission authorization built from the provided
+// fuzz inputs.  The emission key is derived from the hash of the key seed so
+// every seed maps to a valid private key.
+func fuzzEmissionAuth(nonce uint64, coinType uint8, amount, height int64,
+	keySeed, sig []byte) *chaincfg.SKAEmissionAuth {
+
+	keyBytes := sha256.Sum256(keySeed)
+	privKey := secp256k1.PrivKeyFromBytes(keyBytes[:])
+	return &chaincfg.SKAEmissionAuth{
+		EmissionKey: privKey.PubKey(),
+		Signature:   sig,
+		Nonce:       nonce,
+		CoinType:    cointype.CoinType(coinType),
+		Amount:      amount,
+		Height:      height,
+	}
+}
+
+// addEmissionAuthSeeds adds seed inputs covering the boundaries of every
+// field of the emission authorization script to the provided fuzz target.
+// Notably, it includes amounts whose first serialized byte matches the prefix
+// of a compressed public key, which the parser previously rejected.
+func addEmissionAuthSeeds(f *testing.F, add func(*chaincfg.SKAEmissionAuth)) {
+	sig := bytes.Repeat([]byte{0x30}, 72)
+	seeds := []*chaincfg.SKAEmissionAuth{
+		fuzzEmissionAuth(1, 1, 1e8, 100, []byte("seed"), sig),
+		fuzzEmissionAuth(0, 0, 0, 0, nil, nil),
+		fuzzEmissionAuth(math.MaxUint64, 255, math.MaxInt64, math.MaxInt64,
+			[]byte{0xff}, bytes.Repeat([]byte{0x01}, math.MaxUint8)),
+		fuzzEmissionAuth(2, 1, 2, 2, []byte("amount 2"), sig),
+		fuzzEmissionAuth(3, 1, 0x0303, 3, []byte("amount 3"), sig),
+		fuzzEmissionAuth(1, 1, -1, -1, []byte("negative"), sig[:1]),
+	}
+	for _, auth := range seeds {
+		add(auth)
+	}
+}
+
+// FuzzEmissionAuthScriptRoundTrip ensures every authorization that can be
+// serialized by createEmissionAuthScript is parsed back by
+// extractEmissionAuthorization to the same authorization and that
+// reserializing the parsed authorization produces the identical script.
+func FuzzEmissionAuthScriptRoundTrip(f *testing.F) {
+	addEmissionAuthSeeds(f, func(auth *chaincfg.SKAEmissionAuth) {
+		keyBytes := auth.EmissionKey.SerializeCompressed()
+		f.Add(auth.Nonce, uint8(auth.CoinType), auth.Amount, auth.Height,
+			keyBytes, auth.Signature)
+	})
+
+	f.Fuzz(func(t *testing.T, nonce uint64, coinType uint8, amount,
+		height int64, keySeed, sig []byte) {
+
+		auth := fuzzEmissionAuth(nonce, coinType, amount, height, keySeed,
+			sig)
+		script, err := createEmissionAuthScript(auth)
+		if len(sig) > math.MaxUint8 {
+			if err == nil {
+				t.Fatalf("created script with unrepresentable %d byte "+
+					"signature", len(sig))
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("failed to create script: %v", err)
+		}
+
+		parsed, err := extractEmissionAuthorization(script)
+		if err != nil {
+			t.Fatalf("failed to parse serialized script %x: %v", script, err)
+		}
+		if parsed.Nonce != auth.Nonce {
+			t.Fatalf("nonce mismatch: got %d, want %d", parsed.Nonce,
+				auth.Nonce)
+		}
+		if parsed.CoinType != auth.CoinType {
+			t.Fatalf("coin type mismatch: got %d, want %d", parsed.CoinType,
+				auth.CoinType)
+		}
+		if parsed.Amount != auth.Amount {
+			t.Fatalf("amount mismatch: got %d, want %d", parsed.Amount,
+				auth.Amount)
+		}
+		if parsed.Height != auth.Height {
+			t.Fatalf("height mismatch: got %d, want %d", parsed.Height,
+				auth.Height)
+		}
+		if !parsed.EmissionKey.IsEqual(auth.EmissionKey) {
+			t.Fatal("emission key mismatch")
+		}
+		if !bytes.Equal(parsed.Signature, auth.Signature) {
+			t.Fatalf("signature mismatch: got %x, want %x",
+				parsed.Signature, auth.Signature)
+		}
+
+		reserialized, err := createEmissionAuthScript(parsed)
+		if err != nil {
+			t.Fatalf("failed to reserialize parsed script: %v", err)
+		}
+		if !bytes.Equal(reserialized, script) {
+			t.Fatalf("reserialized script mismatch: got %x, want %x",
+				reserialized, script)
+		}
+	})
+}
+
+// FuzzExtractEmissionAuthorization ensures extractEmissionAuthorization never
+// panics on arbitrary input and never accepts a script other than the exact one
+// createEmissionAuthScript produces for the parsed authorization.
+func FuzzExtractEmissionAuthorization(f *testing.F) {
+	addEmissionAuthSeeds(f, func(auth *chaincfg.SKAEmissionAuth) {
+		script, err := createEmissionAuthScript(auth)
+		if err != nil {
+			f.Fatalf("failed to create seed script: %v", err)
+		}
+		f.Add(script)
+
+		// Add truncated, extended, and corrupted variants of every valid
+		// script to steer the fuzzer towards the field boundaries.
+		f.Add(script[:len(script)-1])
+		f.Add(append(bytes.Clone(script), 0x00))
+		for _, offset := range []int{0, 4, 5, 13, 14, 22, 30, 63} {
+			mutated := bytes.Clone(script)
+			mutated[offset] ^= 0xff
+			f.Add(mutated)
+		}
+	})
+	f.Add([]byte{})
+	f.Add([]byte{0x01, 0x53, 0x4b, 0x41, 0x02})
+
+	f.Fuzz(func(t *testing.T, script []byte) {
+		auth, err := extractEmissionAuthorization(script)
+		if err != nil {
+			return
+		}
+
+		reserialized, err := createEmissionAuthScript(auth)
+		if err != nil {
+			t.Fatalf("parsed authorization from %x can't be serialized: %v",
+				script, err)
+		}
+		if !bytes.Equal(reserialized, script) {
+			t.Fatalf("parser accepted script %x the serializer does not "+
+				"produce (serializer produced %x)", script, reserialized)
+		}
+	})
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
+)
+
+// emissionManifestDomain is the domain separator of the hash an emission
+// manifest is identified by and signed over.
+const emissionManifestDomain = "SKA-MANIFEST-V1"
+
+// EmissionManifest is the canonical JSON description of the distribution of a
+// single emission tranche of an SKA coin type whose chain parameters only pin
+// the hash of the manifest and the total it emits.
+//
+// The manifest is signed by the emission key of the coin type so the exact
+// addresses and amounts can be finalized close to the emission and be
+// distributed to the nodes creating the emission without a node software
+// release.
+//
+// The canonical encoding is the compact JSON encoding of the fields in the
+// order they are declared with the signature hex-encoded in lowercase.  The
+// signature is omitted from unsigned manifests.
+type EmissionManifest struct {
+	CoinType  uint8    `json:"cointype"`
+	Tranche   uint32   `json:"tranche"`
+	Addresses []string `json:"addresses"`
+	Amounts   []int64  `json:"amounts"`
+	Signature string   `json:"signature,omitempty"`
+}
+
+// ParseEmissionManifest decodes and validates the provided JSON-encoded
+// emission manifest.  Unknown fields are rejected so that manifests that were
+// produced for a different format are not silently misinterpreted.
+func ParseEmissionManifest(data []byte) (*EmissionManifest, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var m EmissionManifest
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("invalid emission manifest: %w", err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("invalid emission manifest: trailing data")
+	}
+	if _, err := m.TotalAmount(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// TotalAmount validates the distribution of the manifest and returns the total
+// amount it emits.
+func (m *EmissionManifest) TotalAmount() (int64, error) {
+	if m.CoinType == 0 {
+		return 0, fmt.Errorf("invalid SKA coin type: %d", m.CoinType)
+	}
+	if len(m.Addresses) == 0 {
+		return 0, fmt.Errorf("no emission addresses specified")
+	}
+	if len(m.Addresses) != len(m.Amounts) {
+		return 0, fmt.Errorf("emission addresses and amounts length mismatch")
+	}
+	var totalAmount int64
+	for _, amount := range m.Amounts {
+		if amount <= 0 || amount > math.MaxInt64-totalAmount {
+			return 0, fmt.Errorf("invalid emission amount: %d", amount)
+		}
+		totalAmount += amount
+	}
+	return totalAmount, nil
+}
+
+// Hash returns the hash that identifies the manifest and that its signature
+// commits to.  It commits to every field of the manifest except the signature
+// and is the hash pinned by the chain parameters.
+func (m *EmissionManifest) Hash() chainhash.Hash {
+	var buf bytes.Buffer
+	putUint32 := func(v uint32) {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], v)
+		buf.Write(b[:])
+	}
+
+	buf.WriteString(emissionManifestDomain)
+	buf.WriteByte(m.CoinType)
+	putUint32(m.Tranche)
+	putUint32(uint32(len(m.Addresses)))
+	for _, addr := range m.Addresses {
+		putUint32(uint32(len(addr)))
+		buf.WriteString(addr)
+	}
+	putUint32(uint32(len(m.Amounts)))
+	for _, amount := range m.Amounts {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], uint64(amount))
+		buf.Write(b[:])
+	}
+	return chainhash.HashH(buf.Bytes())
+}
+
+// Canonical returns the canonical JSON encoding of the manifest.
+func (m *EmissionManifest) Canonical() ([]byte, error) {
+	if _, err := m.TotalAmount(); err != nil {
+		return nil, err
+	}
+	canonical := &EmissionManifest{
+		CoinType:  m.CoinType,
+		Tranche:   m.Tranche,
+		Addresses: append([]string(nil), m.Addresses...),
+		Amounts:   append([]int64(nil), m.Amounts...),
+		Signature: m.Signature,
+	}
+	if canonical.Signature != "" {
+		signature, err := hex.DecodeString(canonical.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid emission manifest signature: %w",
+				err)
+		}
+		canonical.Signature = hex.EncodeToString(signature)
+	}
+	return json.Marshal(canonical)
+}
+
+// Sign signs the manifest with the provided private key and sets the signature
+// of the manifest accordingly.  The private key must correspond to the
+// emission key of the coin type of the manifest.
+func (m *EmissionManifest) Sign(privKey *secp256k1.PrivateKey) error {
+	if _, err := m.TotalAmount(); err != nil {
+		return err
+	}
+	hash := m.Hash()
+	m.Signature = hex.EncodeToString(ecdsa.Sign(privKey, hash[:]).Serialize())
+	return nil
+}
+
+// Verify ensures the manifest is signed by the private key of the provided
+// emission key.
+func (m *EmissionManifest) Verify(emissionKey *secp256k1.PublicKey) error {
+	if m.Signature == "" {
+		return fmt.Errorf("emission manifest is not signed")
+	}
+	sigBytes, err := hex.DecodeString(m.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid emission manifest signature: %w", err)
+	}
+	sig, err := ecdsa.ParseDERSignature(sigBytes)
+	if err != nil {
+		return fmt.Errorf("invalid DER signature format: %w", err)
+	}
+	sigS := sig.S()
+	if sigS.IsOverHalfOrder() {
+		return fmt.Errorf("signature not canonical: S value is not low " +
+			"(S > n/2)")
+	}
+	hash := m.Hash()
+	if !sig.Verify(hash[:], emissionKey) {
+		return fmt.Errorf("emission manifest signature verification failed")
+	}
+	return nil
+}
+
+// CheckEmissionManifest ensures the provided manifest is the one pinned by the
+// chain parameters for its coin type and tranche, that its amounts add up to
+// the pinned total, that its addresses are valid for the network, and that it
+// is signed by the emission key of the coin type.
+func CheckEmissionManifest(m *EmissionManifest, chainParams *chaincfg.Params) error {
+	totalAmount, err := m.TotalAmount()
+	if err != nil {
+		return err
+	}
+
+	coinType := cointype.CoinType(m.CoinType)
+	config, ok := chainParams.SKACoins[coinType]
+	if !ok {
+		return fmt.Errorf("SKA coin type %d not configured", coinType)
+	}
+	schedule := config.EmissionSchedule()
+	if uint64(m.Tranche) >= uint64(len(schedule)) {
+		return fmt.Errorf("emission tranche %d is not scheduled for coin "+
+			"type %d", m.Tranche, coinType)
+	}
+	tranche := &schedule[m.Tranche]
+	if !tranche.HasManifest() {
+		return fmt.Errorf("emission tranche %d of coin type %d does not use "+
+			"an emission manifest", m.Tranche, coinType)
+	}
+	if hash := m.Hash(); hash != *tranche.EmissionManifestHash {
+		return fmt.Errorf("emission manifest hash %v does not match the "+
+			"hash %v pinned for tranche %d of coin type %d", hash,
+			tranche.EmissionManifestHash, m.Tranche, coinType)
+	}
+	if totalAmount != tranche.EmissionManifestTotal {
+		return fmt.Errorf("emission manifest total %d does not match the "+
+			"total %d pinned for tranche %d of coin type %d", totalAmount,
+			tranche.EmissionManifestTotal, m.Tranche, coinType)
+	}
+	for _, addr := range m.Addresses {
+		if _, err := ParseEmissionOutput(addr, chainParams); err != nil {
+			return err
+		}
+	}
+	if config.EmissionKey == nil {
+		return fmt.Errorf("no emission key configured for coin type %d",
+			coinType)
+	}
+	return m.Verify(config.EmissionKey)
+}
+
+// EmissionDistribution returns the addresses and amounts the provided tranche
+// emits.  They are provided by the matching manifest from the passed manifests,
+// keyed by their hash, for tranches whose distribution is pinned to an
+// emission manifest.  The manifests are expected to have already been checked
+// with CheckEmissionManifest.
+func EmissionDistribution(tranche *chaincfg.SKAEmissionTranche, manifests map[chainhash.Hash]*EmissionManifest) ([]string, []int64, error) {
+	if !tranche.HasManifest() {
+		return tranche.EmissionAddresses, tranche.EmissionAmounts, nil
+	}
+	m, ok := manifests[*tranche.EmissionManifestHash]
+	if !ok {
+		return nil, nil, fmt.Errorf("emission manifest %v is not loaded",
+			tranche.EmissionManifestHash)
+	}
+	return m.Addresses, m.Amounts, nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+)
+
+// TestEmissionManifest ensures a signed emission manifest pinned by the chain
+// parameters round trips through its canonical encoding, provides the
+// distribution of its tranche, and results in an emission transaction that
+// passes authorized emission validation, while manifests that do not match the
+// pinned hash or total or are signed by another key are rejected.
+func TestEmissionManifest(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	config := params.SKACoins[1]
+	config.EmissionKey = privKey.PubKey()
+	config.EmissionTranches = nil
+
+	// Pin the distribution of the emission to a manifest instead of the
+	// configured addresses and amounts.
+	manifest := &EmissionManifest{
+		CoinType:  1,
+		Tranche:   0,
+		Addresses: config.EmissionAddresses,
+		Amounts:   config.EmissionAmounts,
+	}
+	total, err := manifest.TotalAmount()
+	if err != nil {
+		t.Fatalf("Failed to calculate manifest total: %v", err)
+	}
+	manifestHash := manifest.Hash()
+	config.EmissionManifestHash = &manifestHash
+	config.EmissionManifestTotal = total
+	config.EmissionAddresses = nil
+	config.EmissionAmounts = nil
+
+	if err := CheckEmissionManifest(manifest, params); err == nil {
+		t.Fatal("Unsigned manifest should have failed the check")
+	}
+	if err := manifest.Sign(privKey); err != nil {
+		t.Fatalf("Failed to sign manifest: %v", err)
+	}
+	if manifest.Hash() != manifestHash {
+		t.Fatal("Signing the manifest changed its hash")
+	}
+	signed, err := manifest.Canonical()
+	if err != nil {
+		t.Fatalf("Failed to encode signed manifest: %v", err)
+	}
+	parsed, err := ParseEmissionManifest(signed)
+	if err != nil {
+		t.Fatalf("Failed to parse signed manifest: %v", err)
+	}
+	reencoded, err := parsed.Canonical()
+	if err != nil {
+		t.Fatalf("Failed to encode parsed manifest: %v", err)
+	}
+	if !bytes.Equal(reencoded, signed) {
+		t.Fatalf("Manifest encoding is not canonical: got %s, want %s",
+			reencoded, signed)
+	}
+	if err := CheckEmissionManifest(parsed, params); err != nil {
+		t.Fatalf("Signed manifest failed the check: %v", err)
+	}
+
+	// The distribution of the tranche is only available once its manifest is
+	// loaded.
+	tranche := config.NextEmissionTranche(0)
+	if _, _, err := EmissionDistribution(tranche, nil); err == nil {
+		t.Fatal("Distribution without loaded manifest should have failed")
+	}
+	manifests := map[chainhash.Hash]*EmissionManifest{}
+	manifests[parsed.Hash()] = parsed
+	addrs, amounts, err := EmissionDistribution(tranche, manifests)
+	if err != nil {
+		t.Fatalf("Failed to get manifest distribution: %v", err)
+	}
+
+	// The emission created from the manifest distribution must pass
+	// validation against the pinned total.
+	height := int64(config.EmissionHeight)
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Signature:   []byte{0},
+		Nonce:       1,
+		CoinType:    1,
+		Amount:      tranche.TotalAmount(),
+		Height:      height,
+	}
+	tx, err := CreateAuthorizedSKAEmissionTransaction(auth, addrs, amounts,
+		params)
+	if err != nil {
+		t.Fatalf("Failed to create emission transaction: %v", err)
+	}
+	if err := SignSKAEmissionTransaction(tx, auth, privKey, params); err != nil {
+		t.Fatalf("Failed to sign emission transaction: %v", err)
+	}
+	chain := &BlockChain{
+		chainParams: params,
+		skaEmissionState: &SKAEmissionState{
+			tranches: make(map[cointype.CoinType][]uint64),
+		},
+	}
+	err = ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+	if err != nil {
+		t.Fatalf("Emission transaction failed validation: %v", err)
+	}
+
+	// Manifests that differ from the pinned one must be rejected even when
+	// they are signed by the emission key.
+	otherKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	tests := []struct {
+		name   string
+		modify func(m *EmissionManifest)
+		key    *secp256k1.PrivateKey
+	}{{
+		name: "changed amount",
+		modify: func(m *EmissionManifest) {
+			m.Amounts[0]++
+		},
+		key: privKey,
+	}, {
+		name: "changed tranche",
+		modify: func(m *EmissionManifest) {
+			m.Tranche = 1
+		},
+		key: privKey,
+	}, {
+		name: "redistributed amounts",
+		modify: func(m *EmissionManifest) {
+			m.Addresses = append(m.Addresses, m.Addresses[0])
+			m.Amounts = append(m.Amounts, 1)
+			m.Amounts[0]--
+		},
+		key: privKey,
+	}, {
+		name:   "signed by another key",
+		modify: func(m *EmissionManifest) {},
+		key:    otherKey,
+	}}
+	for _, test := range tests {
+		m, err := ParseEmissionManifest(signed)
+		if err != nil {
+			t.Fatalf("%s: failed to parse manifest: %v", test.name, err)
+		}
+		test.modify(m)
+		if err := m.Sign(test.key); err != nil {
+			t.Fatalf("%s: failed to sign manifest: %v", test.name, err)
+		}
+		if err := CheckEmissionManifest(m, params); err == nil {
+			t.Errorf("%s: manifest should have failed the check", test.name)
+		}
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// EmissionOutput describes an output of an SKA emission that is specified by
+// an emission address of the chain parameters or an emission manifest.
+//
+// An emission address is either a plain address or one of the following
+// output script descriptors, which allow the emission to be paid directly
+// into custody that can not be expressed as a single address:
+//
+//	addr(<address>)                pays to the address
+//	raw(<hex script>)              pays to the version 0 output script
+//	multi(<k>,<pubkey>,...)        pays to a bare k-of-n multisig script
+//	sh(multi(<k>,<pubkey>,...))    pays to the P2SH of the multisig script
+//
+// Public keys must be hex-encoded compressed secp256k1 public keys and raw
+// scripts must be of a standard payment type.
+type EmissionOutput struct {
+	// Descriptor is the canonical encoding of the specification.  Addresses,
+	// including those specified with addr(), are encoded as the plain
+	// address, while the other descriptors are encoded without whitespace
+	// and with hex in lowercase.
+	Descriptor string
+
+	// Script is the version 0 output script the emission pays to.
+	Script []byte
+
+	// Address is the address the output script pays to, or nil when it does
+	// not pay to a single address, such as a bare multisig script.
+	Address stdaddr.Address
+}
+
+// emissionOutputScriptTypes houses the standard script types raw emission
+// output scripts may be of.
+var emissionOutputScriptTypes = map[stdscript.ScriptType]struct{}{
+	stdscript.STPubKeyEcdsaSecp256k1:       {},
+	stdscript.STPubKeyEd25519:              {},
+	stdscript.STPubKeySchnorrSecp256k1:     {},
+	stdscript.STPubKeyHashEcdsaSecp256k1:   {},
+	stdscript.STPubKeyHashEd25519:          {},
+	stdscript.STPubKeyHashSchnorrSecp256k1: {},
+	stdscript.STScriptHash:                 {},
+	stdscript.STMultiSig:                   {},
+}
+
+// IsStandardEmissionScript returns whether the provided output script is of
+// one of the standard payment script types emission outputs may pay to.
+func IsStandardEmissionScript(version uint16, script []byte) bool {
+	if version != 0 {
+		return false
+	}
+	_, ok := emissionOutputScriptTypes[stdscript.DetermineScriptType(version,
+		script)]
+	return ok
+}
+
+// IsDustEmissionOutput returns whether the provided emission output is dust
+// at the provided relay fee rate in atoms/kB.  It mirrors the dust policy of
+// the mempool in that an output is dust when spending it with a typical
+// pay-to-pubkey-hash input costs more than a third of its value, which makes
+// the output uneconomic to ever spend.
+func IsDustEmissionOutput(txOut *wire.TxOut, relayFee dcrutil.Amount) bool {
+	totalSize := int64(txOut.SerializeSize() + 165)
+	return txOut.Value*1000/(3*totalSize) < int64(relayFee)
+}
+
+// isBurnPatternHash returns whether the provided hash consists entirely of
+// zero bytes or entirely of 0xff bytes, which are the patterns of well-known
+// burn addresses that nobody holds the keys to.
+func isBurnPatternHash(hash []byte) bool {
+	if len(hash) == 0 || (hash[0] != 0x00 && hash[0] != 0xff) {
+		return false
+	}
+	for _, b := range hash[1:] {
+		if b != hash[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPlaceholderEmissionScript returns whether the provided output script pays
+// to one of the placeholder emission addresses of the provided network or to
+// a pay-to-pubkey-hash or pay-to-script-hash burn pattern, either of which
+// would permanently lose any emission paid to it.  Placeholder emission
+// addresses that are not valid emission output specifications can't be paid
+// to and are ignored.
+func IsPlaceholderEmissionScript(version uint16, script []byte, chainParams *chaincfg.Params) bool {
+	if version != 0 {
+		return false
+	}
+	if hash := stdscript.ExtractPubKeyHashV0(script); hash != nil {
+		if isBurnPatternHash(hash) {
+			return true
+		}
+	}
+	if hash := stdscript.ExtractScriptHashV0(script); hash != nil {
+		if isBurnPatternHash(hash) {
+			return true
+		}
+	}
+	for _, spec := range chainParams.PlaceholderEmissionAddresses {
+		output, err := ParseEmissionOutput(spec, chainParams)
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(output.Script, script) {
+			return true
+		}
+	}
+	return false
+}
+
+// descriptorArg returns the argument of the provided descriptor when it is of
+// the form <name>(<arg>).
+func descriptorArg(desc, name string) (string, bool) {
+	if !strings.HasPrefix(desc, name+"(") || !strings.HasSuffix(desc, ")") {
+		return "", false
+	}
+	return desc[len(name)+1 : len(desc)-1], true
+}
+
+// parseMultiSigDescriptor parses the argument of a multi() descriptor into
+// the multisig script it describes along with its canonical encoding.
+func parseMultiSigDescriptor(arg string) (string, []byte, error) {
+	args := strings.Split(arg, ",")
+	if len(args) < 2 {
+		return "", nil, fmt.Errorf("multisig descriptor requires a " +
+			"threshold and at least one public key")
+	}
+	threshold, err := strconv.Atoi(strings.TrimSpace(args[0]))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid multisig threshold %q", args[0])
+	}
+	numPubKeys := len(args) - 1
+	if numPubKeys > txscript.MaxPubKeysPerMultiSig {
+		return "", nil, fmt.Errorf("multisig descriptor has %d public keys, "+
+			"exceeding the max of %d", numPubKeys,
+			txscript.MaxPubKeysPerMultiSig)
+	}
+	if threshold < 1 || threshold > numPubKeys {
+		return "", nil, fmt.Errorf("multisig threshold %d is not between 1 "+
+			"and the number of public keys %d", threshold, numPubKeys)
+	}
+
+	pubKeys := make([][]byte, 0, numPubKeys)
+	canonical := make([]string, 0, len(args))
+	canonical = append(canonical, strconv.Itoa(threshold))
+	seen := make(map[string]struct{}, numPubKeys)
+	for _, pubKeyHex := range args[1:] {
+		pubKey, err := hex.DecodeString(strings.TrimSpace(pubKeyHex))
+		if err != nil || !txscript.IsStrictCompressedPubKeyEncoding(pubKey) {
+			return "", nil, fmt.Errorf("invalid multisig public key %q: "+
+				"must be a hex-encoded compressed public key", pubKeyHex)
+		}
+		if _, ok := seen[string(pubKey)]; ok {
+			return "", nil, fmt.Errorf("duplicate multisig public key %x",
+				pubKey)
+		}
+		seen[string(pubKey)] = struct{}{}
+		pubKeys = append(pubKeys, pubKey)
+		canonical = append(canonical, hex.EncodeToString(pubKey))
+	}
+	script, err := stdscript.MultiSigScriptV0(threshold, pubKeys...)
+	if err != nil {
+		return "", nil, err
+	}
+	return "multi(" + strings.Join(canonical, ",") + ")", script, nil
+}
+
+// ParseEmissionOutput parses the provided emission address, which is either a
+// plain address or an output script descriptor, into the emission output it
+// specifies on the provided network.  See EmissionOutput for the supported
+// descriptors.
+func ParseEmissionOutput(spec string, chainParams *chaincfg.Params) (*EmissionOutput, error) {
+	desc := strings.TrimSpace(spec)
+	if arg, ok := descriptorArg(desc, "addr"); ok {
+		desc = strings.TrimSpace(arg)
+	}
+
+	if arg, ok := descriptorArg(desc, "raw"); ok {
+		script, err := hex.DecodeString(strings.TrimSpace(arg))
+		if err != nil {
+			return nil, fmt.Errorf("invalid raw emission script %q: %w", arg,
+				err)
+		}
+		if len(script) > txscript.MaxScriptSize {
+			return nil, fmt.Errorf("raw emission script is %d bytes, "+
+				"exceeding the max of %d", len(script), txscript.MaxScriptSize)
+		}
+		scriptType := stdscript.DetermineScriptType(0, script)
+		if _, ok := emissionOutputScriptTypes[scriptType]; !ok {
+			return nil, fmt.Errorf("raw emission script of type %v is not "+
+				"a standard payment script", scriptType)
+		}
+		output := &EmissionOutput{
+			Descriptor: "raw(" + hex.EncodeToString(script) + ")",
+			Script:     script,
+		}
+		_, addrs := stdscript.ExtractAddrsV0(script, chainParams)
+		if scriptType != stdscript.STMultiSig && len(addrs) == 1 {
+			output.Address = addrs[0]
+		}
+		return output, nil
+	}
+
+	if arg, ok := descriptorArg(desc, "multi"); ok {
+		canonical, script, err := parseMultiSigDescriptor(arg)
+		if err != nil {
+			return nil, err
+		}
+		return &EmissionOutput{Descriptor: canonical, Script: script}, nil
+	}
+
+	if arg, ok := descriptorArg(desc, "sh"); ok {
+		multiArg, ok := descriptorArg(strings.TrimSpace(arg), "multi")
+		if !ok {
+			return nil, fmt.Errorf("unsupported script hash descriptor %q: "+
+				"only sh(multi(...)) is supported", spec)
+		}
+		canonical, redeemScript, err := parseMultiSigDescriptor(multiArg)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := stdaddr.NewAddressScriptHashV0(redeemScript, chainParams)
+		if err != nil {
+			return nil, err
+		}
+		_, script := addr.PaymentScript()
+		return &EmissionOutput{
+			Descriptor: "sh(" + canonical + ")",
+			Script:     script,
+			Address:    addr,
+		}, nil
+	}
+
+	if strings.ContainsAny(desc, "()") {
+		return nil, fmt.Errorf("unsupported emission output descriptor %q",
+			spec)
+	}
+	addr, err := stdaddr.DecodeAddress(desc, chainParams)
+	if err != nil {
+		return nil, fmt.Errorf("invalid emission address %s: %w", spec, err)
+	}
+	version, script := addr.PaymentScript()
+	if version != 0 {
+		return nil, fmt.Errorf("emission address %s pays to an unsupported "+
+			"script version %d", spec, version)
+	}
+	return &EmissionOutput{
+		Descriptor: addr.String(),
+		Script:     script,
+		Address:    addr,
+	}, nil
+}
+
+// CheckEmissionOutputs ensures every emission address of every scheduled
+// emission tranche of every configured SKA coin type of the provided network
+// is a valid emission output specification in its canonical encoding.  The
+// canonical encoding is required so that the SKA configuration hash of nodes
+// that agree on the emission outputs does not differ.  It also ensures none of
+// the configured amounts would result in a dust output at the minimum relay
+// fee of SKA transactions.
+func CheckEmissionOutputs(chainParams *chaincfg.Params) error {
+	relayFee := dcrutil.Amount(chainParams.SKAMinRelayTxFee)
+	for _, coinType := range cointype.SortedKeys(chainParams.SKACoins) {
+		schedule := chainParams.SKACoins[coinType].EmissionSchedule()
+		for i := range schedule {
+			amounts := schedule[i].EmissionAmounts
+			for j, spec := range schedule[i].EmissionAddresses {
+				output, err := ParseEmissionOutput(spec, chainParams)
+				if err != nil {
+					return fmt.Errorf("emission tranche %d of coin type "+
+						"%d: %w", i, coinType, err)
+				}
+				if output.Descriptor != spec {
+					return fmt.Errorf("emission address %q of tranche %d "+
+						"of coin type %d is not canonically encoded as %q",
+						spec, i, coinType, output.Descriptor)
+				}
+				if j >= len(amounts) {
+					continue
+				}
+				txOut := &wire.TxOut{
+					Value:    amounts[j],
+					CoinType: coinType,
+					PkScript: output.Script,
+				}
+				if IsDustEmissionOutput(txOut, relayFee) {
+					return fmt.Errorf("emission amount %d paid to %q by "+
+						"tranche %d of coin type %d is dust", amounts[j],
+						spec, i, coinType)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,519 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestParseEmissionOutput ensures emission addresses and output script
+// descriptors are parsed into the expected output scripts, addresses, and
+// canonical encodings and that invalid specifications are rejected.
+func TestParseEmissionOutput(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	addrStr := params.SKACoins[1].EmissionAddresses[0]
+	addr, err := stdaddr.DecodeAddress(addrStr, params)
+	if err != nil {
+		t.Fatalf("unexpected error decoding address: %v", err)
+	}
+	_, addrScript := addr.PaymentScript()
+
+	pubKeys := make([][]byte, 3)
+	for i := range pubKeys {
+		privKey := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{byte(i + 1)}, 32))
+		pubKeys[i] = privKey.PubKey().SerializeCompressed()
+	}
+	pk0 := hex.EncodeToString(pubKeys[0])
+	pk1 := hex.EncodeToString(pubKeys[1])
+	pk2 := hex.EncodeToString(pubKeys[2])
+	multi := "multi(2," + pk0 + "," + pk1 + "," + pk2 + ")"
+	multiScript, err := stdscript.MultiSigScriptV0(2, pubKeys...)
+	if err != nil {
+		t.Fatalf("unexpected error creating multisig script: %v", err)
+	}
+	shAddr, err := stdaddr.NewAddressScriptHashV0(multiScript, params)
+	if err != nil {
+		t.Fatalf("unexpected error creating script hash address: %v", err)
+	}
+	_, shScript := shAddr.PaymentScript()
+
+	tests := []struct {
+		name      string
+		spec      string
+		canonical string
+		script    []byte
+		address   string
+		wantErr   bool
+	}{{
+		name:      "plain address",
+		spec:      addrStr,
+		canonical: addrStr,
+		script:    addrScript,
+		address:   addrStr,
+	}, {
+		name:      "addr descriptor",
+		spec:      "addr(" + addrStr + ")",
+		canonical: addrStr,
+		script:    addrScript,
+		address:   addrStr,
+	}, {
+		name:      "raw descriptor with uppercase hex",
+		spec:      "raw(" + strings.ToUpper(hex.EncodeToString(addrScript)) + ")",
+		canonical: "raw(" + hex.EncodeToString(addrScript) + ")",
+		script:    addrScript,
+		address:   addrStr,
+	}, {
+		name:      "raw multisig descriptor",
+		spec:      "raw(" + hex.EncodeToString(multiScript) + ")",
+		canonical: "raw(" + hex.EncodeToString(multiScript) + ")",
+		script:    multiScript,
+	}, {
+		name:      "bare multisig descriptor with whitespace",
+		spec:      "multi(2, " + pk0 + ", " + strings.ToUpper(pk1) + ", " + pk2 + ")",
+		canonical: multi,
+		script:    multiScript,
+	}, {
+		name:      "script hash multisig descriptor",
+		spec:      "sh(" + multi + ")",
+		canonical: "sh(" + multi + ")",
+		script:    shScript,
+		address:   shAddr.String(),
+	}, {
+		name:    "invalid address",
+		spec:    "Ssinvalid",
+		wantErr: true,
+	}, {
+		name:    "address for another network",
+		spec:    chaincfg.MainNetParams().SKACoins[1].EmissionAddresses[0],
+		wantErr: true,
+	}, {
+		name:    "unknown descriptor",
+		spec:    "pkh(" + pk0 + ")",
+		wantErr: true,
+	}, {
+		name:    "raw null data script",
+		spec:    "raw(6a0401020304)",
+		wantErr: true,
+	}, {
+		name:    "raw nonstandard script",
+		spec:    "raw(51)",
+		wantErr: true,
+	}, {
+		name:    "raw invalid hex",
+		spec:    "raw(zz)",
+		wantErr: true,
+	}, {
+		name:    "multisig threshold too high",
+		spec:    "multi(3," + pk0 + "," + pk1 + ")",
+		wantErr: true,
+	}, {
+		name:    "multisig zero threshold",
+		spec:    "multi(0," + pk0 + ")",
+		wantErr: true,
+	}, {
+		name:    "multisig uncompressed public key",
+		spec:    "multi(1,04" + pk0[2:] + pk0[2:] + ")",
+		wantErr: true,
+	}, {
+		name:    "multisig duplicate public key",
+		spec:    "multi(1," + pk0 + "," + pk0 + ")",
+		wantErr: true,
+	}, {
+		name:    "script hash of raw script",
+		spec:    "sh(raw(" + hex.EncodeToString(multiScript) + "))",
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		output, err := ParseEmissionOutput(test.spec, params)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got output %+v", test.name,
+					output)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if output.Descriptor != test.canonical {
+			t.Errorf("%s: mismatched canonical encoding -- got %q, want %q",
+				test.name, output.Descriptor, test.canonical)
+		}
+		if !bytes.Equal(output.Script, test.script) {
+			t.Errorf("%s: mismatched script -- got %x, want %x", test.name,
+				output.Script, test.script)
+		}
+		var gotAddr string
+		if output.Address != nil {
+			gotAddr = output.Address.String()
+		}
+		if gotAddr != test.address {
+			t.Errorf("%s: mismatched address -- got %q, want %q", test.name,
+				gotAddr, test.address)
+		}
+
+		// Ensure the canonical encoding parses to the same output.
+		reparsed, err := ParseEmissionOutput(output.Descriptor, params)
+		if err != nil {
+			t.Errorf("%s: unexpected error parsing canonical encoding: %v",
+				test.name, err)
+			continue
+		}
+		if reparsed.Descriptor != output.Descriptor ||
+			!bytes.Equal(reparsed.Script, output.Script) {
+			t.Errorf("%s: canonical encoding is not stable", test.name)
+		}
+	}
+}
+
+// TestCheckEmissionOutputs ensures the emission addresses of all networks
+// other than the test network, which still uses placeholders, are valid and
+// canonically encoded and that emission addresses that are invalid or not
+// canonically encoded are rejected.
+func TestCheckEmissionOutputs(t *testing.T) {
+	for _, params := range []*chaincfg.Params{chaincfg.MainNetParams(),
+		chaincfg.SimNetParams(), chaincfg.RegNetParams()} {
+
+		if err := CheckEmissionOutputs(params); err != nil {
+			t.Errorf("%s: unexpected error: %v", params.Name, err)
+		}
+	}
+
+	params := chaincfg.SimNetParams()
+	config := params.SKACoins[1]
+	addrStr := config.EmissionAddresses[0]
+	config.EmissionAddresses = []string{"addr(" + addrStr + ")"}
+	if err := CheckEmissionOutputs(params); err == nil {
+		t.Fatal("expected error for non-canonical emission address")
+	}
+	config.EmissionAddresses = []string{"raw(51)"}
+	if err := CheckEmissionOutputs(params); err == nil {
+		t.Fatal("expected error for invalid emission output")
+	}
+	config.EmissionAddresses = []string{addrStr}
+	config.EmissionAmounts = []int64{1}
+	if err := CheckEmissionOutputs(params); err == nil {
+		t.Fatal("expected error for dust emission amount")
+	}
+}
+
+// TestEmissionOutputStandardness ensures emission outputs are only considered
+// standard when they pay to a standard payment script and are not dust at the
+// provided relay fee, and that emission transactions paying dust outputs are
+// not created.
+func TestEmissionOutputStandardness(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	config := params.SKACoins[1]
+	addr, err := stdaddr.DecodeAddress(config.EmissionAddresses[0], params)
+	if err != nil {
+		t.Fatalf("unexpected error decoding address: %v", err)
+	}
+	_, p2pkh := addr.PaymentScript()
+
+	scriptTests := []struct {
+		name     string
+		version  uint16
+		script   []byte
+		standard bool
+	}{
+		{"pay to pubkey hash", 0, p2pkh, true},
+		{"unsupported script version", 1, p2pkh, false},
+		{"null data", 0, []byte{0x6a, 0x01, 0x01}, false},
+		{"non-standard", 0, []byte{0x51}, false},
+	}
+	for _, test := range scriptTests {
+		got := IsStandardEmissionScript(test.version, test.script)
+		if got != test.standard {
+			t.Errorf("%s: unexpected standardness: got %v, want %v",
+				test.name, got, test.standard)
+		}
+	}
+
+	// A pay-to-pubkey-hash output and its typical input are 202 bytes, so
+	// outputs below 606 atoms are dust at a relay fee of 1000 atoms/kB.
+	dustTests := []struct {
+		value int64
+		dust  bool
+	}{
+		{0, true},
+		{605, true},
+		{606, false},
+		{1e8, false},
+	}
+	for _, test := range dustTests {
+		txOut := &wire.TxOut{Value: test.value, CoinType: 1, PkScript: p2pkh}
+		if got := IsDustEmissionOutput(txOut, 1000); got != test.dust {
+			t.Errorf("value %d: unexpected dust: got %v, want %v",
+				test.value, got, test.dust)
+		}
+	}
+
+	// Emission transactions paying dust outputs at the SKA minimum relay fee
+	// are not created.
+	params.SKAMinRelayTxFee = 1000
+	tranche := config.EmissionSchedule()[0]
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Signature:   make([]byte, 64),
+		Nonce:       1,
+		CoinType:    1,
+		Amount:      1e8 + 605,
+		Height:      int64(tranche.EmissionHeight),
+	}
+	addrs := []string{addr.String(), addr.String()}
+	_, err = CreateAuthorizedSKAEmissionTransaction(auth, addrs,
+		[]int64{1e8, 605}, params)
+	if err == nil || !strings.Contains(err.Error(), "is dust") {
+		t.Fatalf("unexpected error for dust output: %v", err)
+	}
+	_, err = CreateAuthorizedSKAEmissionTransaction(auth, addrs,
+		[]int64{1e8 - 1, 606}, params)
+	if err != nil {
+		t.Fatalf("unexpected error for non-dust outputs: %v", err)
+	}
+}
+
+// TestEmissionToMultiSigDescriptor ensures an emission to an output script
+// descriptor pays to the described script and that the known addresses report
+// the descriptor along with the address it pays to, if any.
+func TestEmissionToMultiSigDescriptor(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	config := params.SKACoins[1]
+	config.EmissionKey = privKey.PubKey()
+
+	pk0 := hex.EncodeToString(privKey.PubKey().SerializeCompressed())
+	other := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{0x07}, 32))
+	pk1 := hex.EncodeToString(other.PubKey().SerializeCompressed())
+	bare := "multi(1," + pk0 + "," + pk1 + ")"
+	sh := "sh(" + bare + ")"
+	config.EmissionAddresses = []string{bare, sh}
+	config.EmissionAmounts = []int64{config.EmissionAmounts[0] - 1e8, 1e8}
+
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Signature:   []byte{0},
+		Nonce:       1,
+		CoinType:    1,
+		Amount:      config.EmissionAmounts[0] + config.EmissionAmounts[1],
+		Height:      int64(config.EmissionHeight),
+	}
+	tx, err := CreateAuthorizedSKAEmissionTransaction(auth,
+		config.EmissionAddresses, config.EmissionAmounts, params)
+	if err != nil {
+		t.Fatalf("unexpected error creating emission: %v", err)
+	}
+	for i, spec := range config.EmissionAddresses {
+		output, err := ParseEmissionOutput(spec, params)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %v", spec, err)
+		}
+		if !bytes.Equal(tx.TxOut[i].PkScript, output.Script) {
+			t.Fatalf("output %d pays to %x, want %x", i, tx.TxOut[i].PkScript,
+				output.Script)
+		}
+	}
+
+	known := KnownSKAAddresses(params, nil)
+	if len(known) < 2 {
+		t.Fatalf("unexpected number of known addresses: %d", len(known))
+	}
+	if known[0].Descriptor != bare || known[0].Address != "" {
+		t.Fatalf("mismatched bare multisig known address: %+v", known[0])
+	}
+	shOutput, err := ParseEmissionOutput(sh, params)
+	if err != nil {
+		t.Fatalf("unexpected error parsing %q: %v", sh, err)
+	}
+	if known[1].Descriptor != sh ||
+		known[1].Address != shOutput.Address.String() {
+		t.Fatalf("mismatched script hash multisig known address: %+v",
+			known[1])
+	}
+}
+
+// TestPlaceholderEmissionOutputs ensures emission outputs paying to the
+// placeholder emission addresses of the network or to burn patterns are
+// detected and that signed emissions paying to them fail validation.
+func TestPlaceholderEmissionOutputs(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	config := params.SKACoins[1]
+	config.EmissionKey = privKey.PubKey()
+	addr, err := stdaddr.DecodeAddress(config.EmissionAddresses[0], params)
+	if err != nil {
+		t.Fatalf("unexpected error decoding address: %v", err)
+	}
+	_, p2pkh := addr.PaymentScript()
+	zeroAddr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		make([]byte, 20), params)
+	if err != nil {
+		t.Fatalf("unexpected error creating address: %v", err)
+	}
+	_, zeroP2PKH := zeroAddr.PaymentScript()
+	onesAddr, err := stdaddr.NewAddressScriptHashV0FromHash(
+		bytes.Repeat([]byte{0xff}, 20), params)
+	if err != nil {
+		t.Fatalf("unexpected error creating address: %v", err)
+	}
+	_, onesP2SH := onesAddr.PaymentScript()
+	otherAddr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		bytes.Repeat([]byte{0x01}, 20), params)
+	if err != nil {
+		t.Fatalf("unexpected error creating address: %v", err)
+	}
+	_, otherP2PKH := otherAddr.PaymentScript()
+
+	// Flag the configured emission address as a placeholder.
+	params.PlaceholderEmissionAddresses = []string{
+		"TsPlaceholderAddressIgnoredSinceInvalid",
+		config.EmissionAddresses[0],
+	}
+	tests := []struct {
+		name        string
+		version     uint16
+		script      []byte
+		placeholder bool
+	}{
+		{"placeholder address", 0, p2pkh, true},
+		{"placeholder address unsupported version", 1, p2pkh, false},
+		{"zero pubkey hash", 0, zeroP2PKH, true},
+		{"all ones script hash", 0, onesP2SH, true},
+		{"other address", 0, otherP2PKH, false},
+	}
+	for _, test := range tests {
+		got := IsPlaceholderEmissionScript(test.version, test.script, params)
+		if got != test.placeholder {
+			t.Errorf("%s: unexpected placeholder: got %v, want %v",
+				test.name, got, test.placeholder)
+		}
+	}
+
+	// A signed emission paying to the placeholder address must fail
+	// validation once the rule is active while it passes before the rule is
+	// active and once the address is no longer flagged.
+	height := int64(config.EmissionHeight)
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Signature:   []byte{0},
+		Nonce:       1,
+		CoinType:    1,
+		Amount:      config.EmissionAmounts[0],
+		Height:      height,
+	}
+	tx, err := CreateAuthorizedSKAEmissionTransaction(auth,
+		config.EmissionAddresses, config.EmissionAmounts, params)
+	if err != nil {
+		t.Fatalf("Failed to create emission transaction: %v", err)
+	}
+	if err := SignSKAEmissionTransaction(tx, auth, privKey, params); err != nil {
+		t.Fatalf("Failed to sign emission transaction: %v", err)
+	}
+	chain := &BlockChain{
+		chainParams: params,
+		skaEmissionState: &SKAEmissionState{
+			tranches: make(map[cointype.CoinType][]uint64),
+		},
+	}
+	err = ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+	if err == nil || !strings.Contains(err.Error(), "placeholder") {
+		t.Fatalf("unexpected error for placeholder emission: %v", err)
+	}
+	params.PlaceholderEmissionHeight = height + 1
+	err = ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+	if err != nil {
+		t.Fatalf("unexpected error for placeholder emission before "+
+			"activation: %v", err)
+	}
+	params.PlaceholderEmissionHeight = height
+	params.PlaceholderEmissionAddresses = nil
+	err = ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+	if err != nil {
+		t.Fatalf("unexpected error for emission: %v", err)
+	}
+}
+
+// TestMainNetEmissionNotPlaceholder ensures none of the emission addresses the
+// main network is configured with are flagged as placeholders and that the
+// SKA-1 emission of the main network remains valid both under the current
+// rules and with the placeholder emission rule active.
+func TestMainNetEmissionNotPlaceholder(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	for _, coinType := range cointype.SortedKeys(params.SKACoins) {
+		config := params.SKACoins[coinType]
+		for _, spec := range config.EmissionAddresses {
+			output, err := ParseEmissionOutput(spec, params)
+			if err != nil {
+				t.Fatalf("unexpected error parsing emission address %q: %v",
+					spec, err)
+			}
+			if IsPlaceholderEmissionScript(0, output.Script, params) {
+				t.Fatalf("coin type %d emission address %q is flagged as a "+
+					"placeholder", coinType, spec)
+			}
+		}
+	}
+
+	// Sign the SKA-1 emission with a generated key in place of the configured
+	// emission key since its private key is not available.  Every other
+	// parameter is that of the main network.
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	config := params.SKACoins[1]
+	config.EmissionKey = privKey.PubKey()
+	height := int64(config.EmissionHeight)
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Signature:   []byte{0},
+		Nonce:       1,
+		CoinType:    1,
+		Amount:      config.EmissionAmounts[0],
+		Height:      height,
+	}
+	tx, err := CreateAuthorizedSKAEmissionTransaction(auth,
+		config.EmissionAddresses, config.EmissionAmounts, params)
+	if err != nil {
+		t.Fatalf("Failed to create emission transaction: %v", err)
+	}
+	if err := SignSKAEmissionTransaction(tx, auth, privKey, params); err != nil {
+		t.Fatalf("Failed to sign emission transaction: %v", err)
+	}
+	chain := &BlockChain{
+		chainParams: params,
+		skaEmissionState: &SKAEmissionState{
+			tranches: make(map[cointype.CoinType][]uint64),
+		},
+	}
+	err = ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+	if err != nil {
+		t.Fatalf("unexpected error for mainnet emission: %v", err)
+	}
+	params.PlaceholderEmissionHeight = height
+	err = ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+	if err != nil {
+		t.Fatalf("unexpected error for mainnet emission with the placeholder "+
+			"rule active: %v", err)
+	}
+}
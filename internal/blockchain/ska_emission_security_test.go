@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/monetarium/monetarium-node/chaincfg"
@@ -275,8 +277,7 @@ func TestSKAEmissionDuplicateProtection(t *testing.T) {
 	// Create mock blockchain with coin type 1 already emitted
 	chain := createMockChain(t, params)
 	// Mark as already emitted directly without database
-	chain.skaEmissionState.nonces[1] = 1
-	chain.skaEmissionState.emitted[1] = true
+	chain.skaEmissionState.tranches[1] = []uint64{1}
 
 	// Try to emit again with nonce 2
 	addresses := []string{"TsWKp7wtdTZYabYFYSc9cnxhwFEjA5g4pFc"}
@@ -310,8 +311,100 @@ func TestSKAEmissionDuplicateProtection(t *testing.T) {
 		t.Fatal("CRITICAL: Duplicate emission accepted!")
 	}
 
-	if !bytes.Contains([]byte(err.Error()), []byte("already been emitted")) {
-		t.Errorf("Expected 'already been emitted' error, got: %v", err)
+	if !bytes.Contains([]byte(err.Error()), []byte("have been emitted")) {
+		t.Errorf("Expected 'have been emitted' error, got: %v", err)
+	}
+}
+
+// TestSKAEmissionMultipleInBlock ensures blocks may contain emission
+// transactions that pay multiple outputs and emission transactions for
+// distinct coin types, while blocks with multiple emission transactions for
+// the same coin type are rejected.
+func TestSKAEmissionMultipleInBlock(t *testing.T) {
+	params := &chaincfg.Params{
+		Net: wire.TestNet3,
+		SKACoins: map[cointype.CoinType]*chaincfg.SKACoinConfig{
+			1: {
+				EmissionHeight: 100,
+				EmissionWindow: 100,
+			},
+			2: {
+				EmissionHeight: 100,
+				EmissionWindow: 100,
+			},
+		},
+	}
+	privKey, _ := secp256k1.GeneratePrivateKey()
+	params.SKACoins[1].EmissionKey = privKey.PubKey()
+	params.SKACoins[2].EmissionKey = privKey.PubKey()
+
+	// Force the activation vote of SKA-2 to be active.
+	chain := createMockChain(t, params)
+	active := ThresholdStateTuple{State: ThresholdActive}
+	chain.deploymentData = map[string]deploymentInfo{
+		"activateska2": {forcedState: &active},
+	}
+
+	// emissionTx returns a signed emission transaction for the provided coin
+	// type and nonce that pays the provided amounts.
+	emissionTx := func(coinType cointype.CoinType, nonce uint64, amounts ...int64) *wire.MsgTx {
+		addresses := make([]string, 0, len(amounts))
+		var total int64
+		for i, amount := range amounts {
+			addresses = append(addresses, fmt.Sprintf("addr-%d-%d", coinType, i))
+			total += amount
+		}
+		tx := createTestEmissionTx(t, addresses, amounts, coinType, params)
+		auth := &chaincfg.SKAEmissionAuth{
+			EmissionKey: privKey.PubKey(),
+			CoinType:    coinType,
+			Nonce:       nonce,
+			Amount:      total,
+			Height:      150,
+		}
+		signEmissionTx(t, tx, auth, privKey, params)
+		return tx
+	}
+
+	tests := []struct {
+		name    string
+		txns    []*wire.MsgTx
+		wantErr string
+	}{{
+		name: "single emission with multiple outputs",
+		txns: []*wire.MsgTx{emissionTx(1, 1, 1000000, 2000000, 3000000)},
+	}, {
+		name: "emissions for distinct coin types",
+		txns: []*wire.MsgTx{
+			emissionTx(1, 1, 1000000, 2000000),
+			emissionTx(2, 1, 5000000),
+		},
+	}, {
+		name: "emissions for the same coin type",
+		txns: []*wire.MsgTx{
+			emissionTx(1, 1, 1000000),
+			emissionTx(2, 1, 5000000),
+			emissionTx(1, 1, 2000000),
+		},
+		wantErr: "multiple emission transactions for coin type 1",
+	}}
+
+	prevNode := &blockNode{height: 149}
+	for _, test := range tests {
+		block := dcrutil.NewBlock(&wire.MsgBlock{
+			Transactions: test.txns,
+		})
+		err := CheckSKAEmissionInBlock(block, prevNode, chain, params)
+		if test.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Errorf("%s: unexpected error: got %v, want %q", test.name,
+				err, test.wantErr)
+		}
 	}
 }
 
@@ -370,7 +463,8 @@ func TestSKAEmissionNonceValidation(t *testing.T) {
 		t.Errorf("Valid nonce 1 rejected: %v", err)
 	}
 
-	// Test 3: Nonce 2 should fail (skipping ahead)
+	// Test 3: Nonce 2 should fail (skipping ahead) prior to the nonce
+	// resynchronization rule
 	tx2 := createTestEmissionTx(t, addresses, amounts, 1, params)
 	auth2 := &chaincfg.SKAEmissionAuth{
 		EmissionKey: pubKey,
@@ -385,6 +479,14 @@ func TestSKAEmissionNonceValidation(t *testing.T) {
 	if err == nil {
 		t.Error("Nonce skip accepted - should require sequential nonces")
 	}
+
+	// Test 4: Nonce 2 should pass (resynchronizing past a lost nonce) once
+	// the nonce resynchronization rule is active
+	params.SKANonceResyncHeight = 150
+	err = ValidateAuthorizedSKAEmissionTransaction(tx2, 150, chain, params)
+	if err != nil {
+		t.Errorf("Nonce skip rejected - should allow resynchronization: %v", err)
+	}
 }
 
 // TestSKAEmissionWindowValidation tests that emissions are only
@@ -447,6 +549,102 @@ func TestSKAEmissionWindowValidation(t *testing.T) {
 	}
 }
 
+// TestSKAEmissionMalleability tests that any mutation of an emission
+// transaction invalidates it, regardless of whether the mutated field is
+// committed to by the emission signature or only part of the witness, and that
+// mutations of the witness are only rejected once the emission witness rule is
+// active.
+func TestSKAEmissionMalleability(t *testing.T) {
+	const witnessHeight = 121
+	params := &chaincfg.Params{
+		Net: wire.TestNet3,
+		SKACoins: map[cointype.CoinType]*chaincfg.SKACoinConfig{
+			1: {
+				EmissionHeight: 100,
+				EmissionWindow: 50,
+			},
+		},
+		SKAEmissionWitnessHeight: witnessHeight,
+	}
+
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	pubKey := privKey.PubKey()
+	params.SKACoins[1].EmissionKey = pubKey
+	chain := createMockChain(t, params)
+
+	addresses := []string{"TsWKp7wtdTZYabYFYSc9cnxhwFEjA5g4pFc"}
+	amounts := []int64{1000000}
+	tx := createTestEmissionTx(t, addresses, amounts, 1, params)
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: pubKey,
+		CoinType:    1,
+		Nonce:       1,
+		Amount:      1000000,
+		Height:      120,
+	}
+	signEmissionTx(t, tx, auth, privKey, params)
+	for _, height := range []int64{witnessHeight - 1, witnessHeight} {
+		err := ValidateAuthorizedSKAEmissionTransaction(tx, height, chain, params)
+		if err != nil {
+			t.Fatalf("Valid emission failed validation at height %d: %v",
+				height, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(txIn *wire.TxIn)
+		witness bool
+	}{
+		{"Sequence", func(txIn *wire.TxIn) { txIn.Sequence-- }, false},
+		{"Tree", func(txIn *wire.TxIn) { txIn.PreviousOutPoint.Tree = wire.TxTreeStake }, false},
+		{"Value in", func(txIn *wire.TxIn) { txIn.ValueIn = 0 }, true},
+		{"Block height", func(txIn *wire.TxIn) { txIn.BlockHeight = 1 }, true},
+		{"Block index", func(txIn *wire.TxIn) { txIn.BlockIndex = 0 }, true},
+		{"Trailing data", func(txIn *wire.TxIn) {
+			txIn.SignatureScript = append(txIn.SignatureScript, 0x00)
+		}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mutated := tx.Copy()
+			test.mutate(mutated.TxIn[0])
+
+			// Witness mutations do not change the transaction hash, so they
+			// must be rejected independently of the signature.
+			sameHash := mutated.TxHash() == tx.TxHash()
+			if sameHash != test.witness {
+				t.Fatalf("Mutation changed tx hash %v, want %v", !sameHash,
+					!test.witness)
+			}
+
+			// Witness mutations are only rejected once the emission witness
+			// rule is active so emissions that were already mined remain
+			// valid.
+			err := ValidateAuthorizedSKAEmissionTransaction(mutated,
+				witnessHeight-1, chain, params)
+			if test.witness && err != nil {
+				t.Fatalf("Mutated emission failed validation before "+
+					"activation: %v", err)
+			}
+			if !test.witness && err == nil {
+				t.Fatal("Mutated emission passed validation before " +
+					"activation")
+			}
+
+			err = ValidateAuthorizedSKAEmissionTransaction(mutated,
+				witnessHeight, chain, params)
+			if err == nil {
+				t.Fatal("Mutated emission passed validation")
+			}
+		})
+	}
+}
+
 // TestSKAPreActivationProtection tests that SKA transactions are
 // properly rejected for inactive coin types.
 func TestSKAPreActivationProtection(t *testing.T) {
@@ -552,6 +750,9 @@ func createTestEmissionTx(_ *testing.T, addresses []string, amounts []int64, coi
 		},
 		SignatureScript: []byte{0x01, 0x53, 0x4b, 0x41}, // Basic SKA marker
 		Sequence:        0xffffffff,
+		ValueIn:         wire.NullValueIn,
+		BlockHeight:     wire.NullBlockHeight,
+		BlockIndex:      wire.NullBlockIndex,
 	})
 
 	// Add outputs
@@ -651,9 +852,8 @@ func embedAuth(tx *wire.MsgTx, auth *chaincfg.SKAEmissionAuth) {
 func createMockChain(_ *testing.T, params *chaincfg.Params) *BlockChain {
 	// Create a minimal mock chain for testing
 	state := &SKAEmissionState{
-		nonces:  make(map[cointype.CoinType]uint64),
-		emitted: make(map[cointype.CoinType]bool),
-		db:      nil, // No database for tests
+		tranches: make(map[cointype.CoinType][]uint64),
+		db:       nil, // No database for tests
 	}
 
 	return &BlockChain{
@@ -127,8 +127,7 @@ func TestShipCriticalSecurityFixes(t *testing.T) {
 		chain := &BlockChain{
 			chainParams: params,
 			skaEmissionState: &SKAEmissionState{
-				nonces:  make(map[cointype.CoinType]uint64),
-				emitted: make(map[cointype.CoinType]bool),
+				tranches: make(map[cointype.CoinType][]uint64),
 			},
 		}
 
@@ -146,8 +145,7 @@ func TestShipCriticalSecurityFixes(t *testing.T) {
 		chain := &BlockChain{
 			chainParams: params,
 			skaEmissionState: &SKAEmissionState{
-				nonces:  make(map[cointype.CoinType]uint64),
-				emitted: make(map[cointype.CoinType]bool),
+				tranches: make(map[cointype.CoinType][]uint64),
 			},
 		}
 
@@ -190,7 +188,7 @@ func TestShipCriticalSecurityFixes(t *testing.T) {
 
 		// Extract and validate - should fail due to amount mismatch
 		extractedAuth, _ := extractEmissionAuthorization(authScript)
-		err := validateEmissionAuthorization(extractedAuth, chain, params)
+		err := validateEmissionAuthorization(extractedAuth, 100, chain, params)
 		if err != nil {
 			// This is expected - nonce validation will fail first
 			// But the important part is the ValidateAuthorizedSKAEmissionTransaction check
@@ -28,6 +28,13 @@ func (m *mockChainStateForStakeValidation) GetSKAEmissionNonce(ct cointype.CoinT
 	return m.emissionNonces[ct]
 }
 
+func (m *mockChainStateForStakeValidation) SKAEmissionTranchesEmitted(ct cointype.CoinType) uint32 {
+	if m.emissionOccurred[ct] {
+		return 1
+	}
+	return 0
+}
+
 // TestSKAEmissionStakeValidationHeight tests that SKA emissions are properly
 // rejected before stake validation height.
 func TestSKAEmissionStakeValidationHeight(t *testing.T) {
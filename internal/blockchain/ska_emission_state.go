@@ -17,7 +17,7 @@ import (
 // SKA emission state management
 // This file manages the persistent state for SKA emissions including:
 // - Nonces for replay protection
-// - Emission flags to prevent duplicate emissions
+// - Emitted tranches to prevent duplicate emissions
 // - Proper handling of chain reorganizations
 
 const (
@@ -26,15 +26,19 @@ const (
 	skaStateBucketName = "skaemissionstate"
 
 	// Current version of the on-disk format
-	skaStateFormatVersion = 1
+	//
+	// Version 2 records the committed nonce of every emitted tranche instead
+	// of only the last used nonce and an emitted flag.
+	skaStateFormatVersion = 2
 
 	// Meta key for format version
 	skaStateVersionKey = "__meta_version__"
 )
 
 // SKAEmissionState manages the persistent state for SKA emissions.
-// This includes nonces for replay protection and flags to track
-// which coin types have already been emitted.
+// This includes the committed nonce of every emitted tranche of each coin
+// type, which provides both replay protection and tracks which tranches have
+// already been emitted.
 //
 // SECURITY: This state is critical for preventing replay attacks
 // and duplicate emissions. It must be properly synchronized with
@@ -43,11 +47,11 @@ type SKAEmissionState struct {
 	// Protects concurrent access to state
 	mtx sync.RWMutex
 
-	// Nonces for each coin type (last successfully used nonce)
-	nonces map[cointype.CoinType]uint64
-
-	// Tracks which coin types have been emitted
-	emitted map[cointype.CoinType]bool
+	// Committed nonces of the emitted tranches of each coin type in the
+	// order the tranches were emitted.  Nonces are strictly increasing but
+	// need not be contiguous since an emitter may resynchronize past nonces
+	// that were never committed.
+	tranches map[cointype.CoinType][]uint64
 
 	// Database handle for persistence
 	db database.DB
@@ -56,9 +60,8 @@ type SKAEmissionState struct {
 // NewSKAEmissionState creates a new SKA emission state manager.
 func NewSKAEmissionState(db database.DB) (*SKAEmissionState, error) {
 	state := &SKAEmissionState{
-		nonces:  make(map[cointype.CoinType]uint64),
-		emitted: make(map[cointype.CoinType]bool),
-		db:      db,
+		tranches: make(map[cointype.CoinType][]uint64),
+		db:       db,
 	}
 
 	// Load existing state from database
@@ -74,14 +77,26 @@ func NewSKAEmissionState(db database.DB) (*SKAEmissionState, error) {
 func (s *SKAEmissionState) GetNonce(coinType cointype.CoinType) uint64 {
 	s.mtx.RLock()
 	defer s.mtx.RUnlock()
-	return s.nonces[coinType]
+	nonces := s.tranches[coinType]
+	if len(nonces) == 0 {
+		return 0
+	}
+	return nonces[len(nonces)-1]
 }
 
 // IsEmitted returns whether the specified coin type has been emitted.
 func (s *SKAEmissionState) IsEmitted(coinType cointype.CoinType) bool {
 	s.mtx.RLock()
 	defer s.mtx.RUnlock()
-	return s.emitted[coinType]
+	return len(s.tranches[coinType]) > 0
+}
+
+// EmittedTranches returns the number of emission tranches of the specified
+// coin type that have been emitted.
+func (s *SKAEmissionState) EmittedTranches(coinType cointype.CoinType) uint32 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return uint32(len(s.tranches[coinType]))
 }
 
 // DisconnectSKAEmissionsTx updates the SKA emission state when a block is disconnected,
@@ -94,14 +109,20 @@ func (s *SKAEmissionState) DisconnectSKAEmissionsTx(dbTx database.Tx, emissions
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
-	// Remove state for each emission
+	// Roll back state for each emission
 	for _, emission := range emissions {
-		// Only remove if this was the emission that set the current nonce
-		if currentNonce, exists := s.nonces[emission.CoinType]; exists && currentNonce == emission.Nonce {
-			delete(s.nonces, emission.CoinType)
-			delete(s.emitted, emission.CoinType)
+		// Only roll back if this was the emission that set the current nonce.
+		// Coin types with earlier tranches still emitted revert to the nonce
+		// committed by the previous tranche.
+		nonces := s.tranches[emission.CoinType]
+		if len(nonces) > 0 && nonces[len(nonces)-1] == emission.Nonce {
+			if len(nonces) > 1 {
+				s.tranches[emission.CoinType] = nonces[:len(nonces)-1]
+			} else {
+				delete(s.tranches, emission.CoinType)
+			}
 
-			log.Debugf("Disconnected SKA emission: coin type %d, nonce %d at height %d",
+			skaeLog.Debugf("Disconnected SKA emission: coin type %d, nonce %d at height %d",
 				emission.CoinType, emission.Nonce, emission.Height)
 		}
 	}
@@ -131,10 +152,14 @@ func (s *SKAEmissionState) load() error {
 			version = 1
 		}
 
-		// Reject unsupported versions
+		// Reject unsupported versions.  Older versions are upgraded by the
+		// SKA state migrations before the state is loaded.
 		if version > skaStateFormatVersion {
 			return fmt.Errorf("unsupported SKA state version %d > %d", version, skaStateFormatVersion)
 		}
+		if version < skaStateFormatVersion {
+			return fmt.Errorf("SKA state version %d must be upgraded to %d", version, skaStateFormatVersion)
+		}
 
 		// Read all entries from the bucket
 		return bucket.ForEach(func(k, v []byte) error {
@@ -154,18 +179,12 @@ func (s *SKAEmissionState) load() error {
 
 			coinType := cointype.CoinType(k[0])
 
-			// Value format: [nonce:8 bytes][emitted:1 byte]
-			if len(v) != 9 {
-				return fmt.Errorf("invalid value length for coin type %d: %d", coinType, len(v))
+			nonces, err := deserializeSKAEmissionTranches(v)
+			if err != nil {
+				return fmt.Errorf("invalid state for coin type %d: %w", coinType, err)
 			}
-
-			// Parse nonce
-			nonce := binary.LittleEndian.Uint64(v[:8])
-			s.nonces[coinType] = nonce
-
-			// Parse emitted flag
-			if v[8] != 0 {
-				s.emitted[coinType] = true
+			if len(nonces) > 0 {
+				s.tranches[coinType] = nonces
 			}
 
 			return nil
@@ -176,10 +195,41 @@ func (s *SKAEmissionState) load() error {
 		return fmt.Errorf("failed to load SKA emission state: %w", err)
 	}
 
-	log.Debugf("Loaded SKA emission state: %d coin types tracked", len(s.nonces))
+	skaeLog.Debugf("Loaded SKA emission state: %d coin types tracked", len(s.tranches))
 	return nil
 }
 
+// serializeSKAEmissionTranches returns the serialized committed nonces of the
+// emitted tranches of a coin type.
+//
+// Value format: [count:4 bytes][nonce:8 bytes per emitted tranche]
+func serializeSKAEmissionTranches(nonces []uint64) []byte {
+	value := make([]byte, 4+8*len(nonces))
+	binary.LittleEndian.PutUint32(value[:4], uint32(len(nonces)))
+	for i, nonce := range nonces {
+		binary.LittleEndian.PutUint64(value[4+8*i:], nonce)
+	}
+	return value
+}
+
+// deserializeSKAEmissionTranches decodes the committed nonces of the emitted
+// tranches of a coin type serialized by serializeSKAEmissionTranches.
+func deserializeSKAEmissionTranches(value []byte) ([]uint64, error) {
+	if len(value) < 4 {
+		return nil, fmt.Errorf("invalid value length %d", len(value))
+	}
+	count := binary.LittleEndian.Uint32(value[:4])
+	if uint64(len(value)) != 4+8*uint64(count) {
+		return nil, fmt.Errorf("invalid value length %d for %d tranches",
+			len(value), count)
+	}
+	nonces := make([]uint64, count)
+	for i := range nonces {
+		nonces[i] = binary.LittleEndian.Uint64(value[4+8*i:])
+	}
+	return nonces, nil
+}
+
 // saveWithTx writes the SKA emission state using the provided transaction.
 // This allows the state to be saved atomically with other blockchain updates.
 func (s *SKAEmissionState) saveWithTx(dbTx database.Tx) error {
@@ -204,30 +254,11 @@ func (s *SKAEmissionState) saveWithTx(dbTx database.Tx) error {
 		return fmt.Errorf("failed to save format version: %w", err)
 	}
 
-	// Build a set of all coin types that have state
-	// This avoids iterating through all 255 coin types when only a few have data
-	coinTypesToSave := make(map[cointype.CoinType]bool)
-	for ct := range s.nonces {
-		coinTypesToSave[ct] = true
-	}
-	for ct := range s.emitted {
-		coinTypesToSave[ct] = true
-	}
-
 	// Save each coin type's state
-	for coinType := range coinTypesToSave {
-		nonce := s.nonces[coinType]
-		isEmitted := s.emitted[coinType]
-
+	for coinType, nonces := range s.tranches {
 		// Create key (1 byte coin type)
 		key := []byte{byte(coinType)}
-
-		// Create value (8 bytes nonce + 1 byte emitted flag)
-		value := make([]byte, 9)
-		binary.LittleEndian.PutUint64(value[:8], nonce)
-		if isEmitted {
-			value[8] = 1
-		}
+		value := serializeSKAEmissionTranches(nonces)
 
 		// Store in bucket
 		if err := bucket.Put(key, value); err != nil {
@@ -245,8 +276,7 @@ func (s *SKAEmissionState) Clear() error {
 	defer s.mtx.Unlock()
 
 	// Clear in-memory state
-	s.nonces = make(map[cointype.CoinType]uint64)
-	s.emitted = make(map[cointype.CoinType]bool)
+	s.tranches = make(map[cointype.CoinType][]uint64)
 
 	// Clear database state
 	return s.db.Update(func(dbTx database.Tx) error {
@@ -271,13 +301,10 @@ func (s *SKAEmissionState) GetEmissionStateSnapshot() (map[cointype.CoinType]uin
 
 	// Create copies to avoid external modification
 	noncesCopy := make(map[cointype.CoinType]uint64)
-	for k, v := range s.nonces {
-		noncesCopy[k] = v
-	}
-
 	emittedCopy := make(map[cointype.CoinType]bool)
-	for k, v := range s.emitted {
-		emittedCopy[k] = v
+	for k, v := range s.tranches {
+		noncesCopy[k] = v[len(v)-1]
+		emittedCopy[k] = true
 	}
 
 	return noncesCopy, emittedCopy
@@ -304,10 +331,10 @@ func (s *SKAEmissionState) ConnectSKAEmissionsTx(dbTx database.Tx, emissions []S
 
 	// Update state for each emission
 	for _, emission := range emissions {
-		s.nonces[emission.CoinType] = emission.Nonce
-		s.emitted[emission.CoinType] = true
+		s.tranches[emission.CoinType] = append(s.tranches[emission.CoinType],
+			emission.Nonce)
 
-		log.Debugf("Connected SKA emission: coin type %d, nonce %d at height %d",
+		skaeLog.Debugf("Connected SKA emission: coin type %d, nonce %d at height %d",
 			emission.CoinType, emission.Nonce, emission.Height)
 	}
 
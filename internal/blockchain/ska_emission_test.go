@@ -8,12 +8,14 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
 	"github.com/monetarium/monetarium-node/wire"
@@ -326,6 +328,9 @@ func TestValidateAuthorizedSKAEmissionTransactionBasic(t *testing.T) {
 				Index: 0xffffffff,
 			},
 			SignatureScript: []byte{0x01, 0x53, 0x4b, 0x41}, // "SKA" marker initially
+			ValueIn:         wire.NullValueIn,
+			BlockHeight:     wire.NullBlockHeight,
+			BlockIndex:      wire.NullBlockIndex,
 		}},
 		LockTime: 0,
 		Expiry:   expiry,
@@ -392,8 +397,7 @@ func TestValidateAuthorizedSKAEmissionTransactionBasic(t *testing.T) {
 	chain := &BlockChain{
 		chainParams: params,
 		skaEmissionState: &SKAEmissionState{
-			nonces:  make(map[cointype.CoinType]uint64),
-			emitted: make(map[cointype.CoinType]bool),
+			tranches: make(map[cointype.CoinType][]uint64),
 		},
 	}
 
@@ -677,3 +681,225 @@ func TestSKAEmissionWindowEdgeCases(t *testing.T) {
 		t.Error("Expected true for zero emission height with window")
 	}
 }
+
+// TestRemoveSKAEmissionRecords ensures emission records are only removed when
+// both the transaction hash and coin type match a record to remove.
+func TestRemoveSKAEmissionRecords(t *testing.T) {
+	record := func(txHash byte, coinType cointype.CoinType) SKAEmissionRecord {
+		return SKAEmissionRecord{
+			CoinType: coinType,
+			Nonce:    1,
+			Height:   150,
+			TxHash:   [32]byte{txHash},
+		}
+	}
+
+	records := []SKAEmissionRecord{record(1, 1), record(1, 2), record(2, 3)}
+	remove := []SKAEmissionRecord{record(1, 2), record(2, 1), record(3, 3)}
+	got := removeSKAEmissionRecords(records, remove)
+	want := []SKAEmissionRecord{record(1, 1), record(2, 3)}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected number of records: got %d, want %d", len(got),
+			len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("mismatched record %d: got %+v, want %+v", i, got[i],
+				want[i])
+		}
+	}
+
+	// Ensure removing all records results in an empty slice.
+	if got := removeSKAEmissionRecords(got, want); len(got) != 0 {
+		t.Fatalf("unexpected records remaining: %+v", got)
+	}
+}
+
+// TestSKAEmissionTrancheWindows ensures the emission window of a coin type
+// emitted in multiple tranches covers the windows of every tranche.
+func TestSKAEmissionTrancheWindows(t *testing.T) {
+	params := &chaincfg.Params{
+		SKACoins: map[cointype.CoinType]*chaincfg.SKACoinConfig{
+			1: {
+				CoinType: 1,
+				EmissionTranches: []chaincfg.SKAEmissionTranche{
+					{EmissionHeight: 100, EmissionWindow: 10},
+					{EmissionHeight: 200, EmissionWindow: 0},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		height int64
+		want   bool
+	}{
+		{height: 99, want: false},
+		{height: 100, want: true},
+		{height: 110, want: true},
+		{height: 111, want: false},
+		{height: 199, want: false},
+		{height: 200, want: true},
+		{height: 201, want: false},
+	}
+	for _, test := range tests {
+		got := isSKAEmissionWindow(test.height, 1, params)
+		if got != test.want {
+			t.Errorf("height %d: got %v, want %v", test.height, got,
+				test.want)
+		}
+	}
+}
+
+// TestSKAEmissionStateTranches ensures the emission state tracks the number of
+// emitted tranches per coin type across connected and disconnected emissions.
+func TestSKAEmissionStateTranches(t *testing.T) {
+	t.Parallel()
+
+	db, teardown := createTestDB(t, "ska_emission_tranches")
+	defer teardown()
+
+	state, err := NewSKAEmissionState(db)
+	if err != nil {
+		t.Fatalf("unable to create emission state: %v", err)
+	}
+	record := func(nonce uint64) []SKAEmissionRecord {
+		return []SKAEmissionRecord{{CoinType: 1, Nonce: nonce}}
+	}
+	connect := func(nonce uint64) {
+		t.Helper()
+		err := db.Update(func(dbTx database.Tx) error {
+			return state.ConnectSKAEmissionsTx(dbTx, record(nonce))
+		})
+		if err != nil {
+			t.Fatalf("unable to connect emission: %v", err)
+		}
+	}
+	disconnect := func(nonce uint64) {
+		t.Helper()
+		err := db.Update(func(dbTx database.Tx) error {
+			return state.DisconnectSKAEmissionsTx(dbTx, record(nonce))
+		})
+		if err != nil {
+			t.Fatalf("unable to disconnect emission: %v", err)
+		}
+	}
+	check := func(wantTranches uint32, wantNonce uint64, wantEmitted bool) {
+		t.Helper()
+		if got := state.EmittedTranches(1); got != wantTranches {
+			t.Fatalf("mismatched emitted tranches: got %d, want %d", got,
+				wantTranches)
+		}
+		if got := state.GetNonce(1); got != wantNonce {
+			t.Fatalf("mismatched nonce: got %d, want %d", got, wantNonce)
+		}
+		if got := state.IsEmitted(1); got != wantEmitted {
+			t.Fatalf("mismatched emitted flag: got %v, want %v", got,
+				wantEmitted)
+		}
+	}
+
+	// Connect the second tranche with a resynchronized nonce that skips the
+	// nonces which were never committed.
+	connect(1)
+	connect(4)
+	check(2, 4, true)
+
+	// Ensure the state survives reloading from the database.
+	state, err = NewSKAEmissionState(db)
+	if err != nil {
+		t.Fatalf("unable to reload emission state: %v", err)
+	}
+	check(2, 4, true)
+
+	// Disconnecting the latest tranche reverts to the nonce committed by the
+	// previous one while disconnecting the first tranche clears the coin type
+	// entirely.
+	disconnect(4)
+	check(1, 1, true)
+	disconnect(1)
+	check(0, 0, false)
+}
+
+// TestCheckSKAEmissionPositions ensures SKA emission transactions are only
+// allowed in the regular transaction tree immediately after the coinbase once
+// the rule is active and that blocks prior to it are unaffected.
+func TestCheckSKAEmissionPositions(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	params.SKAEmissionPositionHeight = 100
+
+	// newTx returns a transaction with a single output of the provided coin
+	// type that is an SKA emission when requested.
+	newTx := func(coinType cointype.CoinType, isEmission bool) *wire.MsgTx {
+		var sigScript []byte
+		prevOut := wire.OutPoint{Hash: chainhash.Hash{0x01}}
+		if isEmission {
+			sigScript = []byte{0x01, 'S', 'K', 'A', byte(coinType)}
+			prevOut = wire.OutPoint{Index: wire.MaxPrevOutIndex}
+		}
+		msgTx := wire.NewMsgTx()
+		msgTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: prevOut,
+			SignatureScript:  sigScript,
+		})
+		msgTx.AddTxOut(&wire.TxOut{Value: 1, CoinType: coinType})
+		return msgTx
+	}
+	coinbase := newTx(cointype.CoinTypeVAR, false)
+	regular := newTx(cointype.CoinTypeVAR, false)
+	emission1 := newTx(1, true)
+	emission2 := newTx(2, true)
+
+	tests := []struct {
+		name    string
+		height  uint32
+		regular []*wire.MsgTx
+		stake   []*wire.MsgTx
+		wantErr error
+	}{{
+		name:    "no emissions",
+		height:  100,
+		regular: []*wire.MsgTx{coinbase, regular},
+	}, {
+		name:    "emission after coinbase",
+		height:  100,
+		regular: []*wire.MsgTx{coinbase, emission1, regular},
+	}, {
+		name:    "multiple emissions after coinbase",
+		height:  100,
+		regular: []*wire.MsgTx{coinbase, emission1, emission2, regular},
+	}, {
+		name:    "emission after regular transaction",
+		height:  100,
+		regular: []*wire.MsgTx{coinbase, regular, emission1},
+		wantErr: ErrBadSKAEmissionPosition,
+	}, {
+		name:    "second emission after regular transaction",
+		height:  100,
+		regular: []*wire.MsgTx{coinbase, emission1, regular, emission2},
+		wantErr: ErrBadSKAEmissionPosition,
+	}, {
+		name:    "emission in stake tree",
+		height:  100,
+		regular: []*wire.MsgTx{coinbase},
+		stake:   []*wire.MsgTx{emission1},
+		wantErr: ErrSKAEmissionInStakeTree,
+	}, {
+		name:    "emission after regular transaction before activation",
+		height:  99,
+		regular: []*wire.MsgTx{coinbase, regular, emission1},
+	}}
+
+	for _, test := range tests {
+		msgBlock := &wire.MsgBlock{
+			Header:        wire.BlockHeader{Height: test.height},
+			Transactions:  test.regular,
+			STransactions: test.stake,
+		}
+		err := checkSKAEmissionPositions(msgBlock, params)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%s: mismatched error: got %v, want %v", test.name, err,
+				test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+// KnownSKAAddress describes an address a scheduled emission tranche of an SKA
+// coin type pays to according to the chain parameters or the emission manifest
+// pinned by them.
+type KnownSKAAddress struct {
+	CoinType       cointype.CoinType
+	Tranche        uint32
+	EmissionHeight int64
+	Address        string
+	Amount         int64
+
+	// Descriptor is the canonical output script descriptor the tranche pays
+	// to when it is specified by one instead of a plain address.  Address is
+	// the address the descriptor pays to in that case, or empty when it does
+	// not pay to a single address, such as a bare multisig script.
+	Descriptor string
+
+	// ManifestMissing indicates the distribution of the tranche is pinned to
+	// an emission manifest that is not loaded.  The address and amount are
+	// not known in that case.
+	ManifestMissing bool
+}
+
+// KnownSKAAddresses returns the addresses every scheduled emission tranche of
+// every configured SKA coin type pays to ordered by coin type, tranche, and
+// position in the distribution.  Outputs specified by an output script
+// descriptor report the descriptor along with the address it pays to, if any.  The distributions of tranches pinned to an
+// emission manifest are provided by the matching manifest from the passed
+// manifests, keyed by their hash.  A single entry with ManifestMissing set is
+// returned for each tranche whose manifest is not loaded.
+func KnownSKAAddresses(params *chaincfg.Params, manifests map[chainhash.Hash]*EmissionManifest) []KnownSKAAddress {
+	var known []KnownSKAAddress
+	for _, coinType := range cointype.SortedKeys(params.SKACoins) {
+		schedule := params.SKACoins[coinType].EmissionSchedule()
+		for i := range schedule {
+			tranche := &schedule[i]
+			addrs, amounts, err := EmissionDistribution(tranche, manifests)
+			if err != nil {
+				known = append(known, KnownSKAAddress{
+					CoinType:        coinType,
+					Tranche:         uint32(i),
+					EmissionHeight:  int64(tranche.EmissionHeight),
+					ManifestMissing: true,
+				})
+				continue
+			}
+			for j, addr := range addrs {
+				var amount int64
+				if j < len(amounts) {
+					amount = amounts[j]
+				}
+				k := KnownSKAAddress{
+					CoinType:       coinType,
+					Tranche:        uint32(i),
+					EmissionHeight: int64(tranche.EmissionHeight),
+					Address:        addr,
+					Amount:         amount,
+				}
+				output, err := ParseEmissionOutput(addr, params)
+				if err == nil {
+					k.Address = ""
+					if output.Address != nil {
+						k.Address = output.Address.String()
+					}
+					if output.Descriptor != k.Address {
+						k.Descriptor = output.Descriptor
+					}
+				}
+				known = append(known, k)
+			}
+		}
+	}
+	return known
+}
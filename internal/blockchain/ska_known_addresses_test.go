@@ -0,0 +1,81 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+)
+
+// TestKnownSKAAddresses ensures the known SKA addresses are provided by the
+// chain parameters ordered by coin type, by the loaded emission manifest for
+// tranches pinned to one, and are reported as missing when the pinned
+// manifest is not loaded.
+func TestKnownSKAAddresses(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	ska1 := params.SKACoins[1]
+	ska2 := params.SKACoins[2]
+	want := []KnownSKAAddress{{
+		CoinType:       1,
+		EmissionHeight: int64(ska1.EmissionHeight),
+		Address:        ska1.EmissionAddresses[0],
+		Amount:         ska1.EmissionAmounts[0],
+	}, {
+		CoinType:       2,
+		EmissionHeight: int64(ska2.EmissionHeight),
+		Address:        ska2.EmissionAddresses[0],
+		Amount:         ska2.EmissionAmounts[0],
+	}}
+	got := KnownSKAAddresses(params, nil)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("mismatched known addresses -- got %+v, want %+v", got, want)
+	}
+
+	// Pin the distribution of SKA-1 to a manifest that splits it across two
+	// addresses.
+	manifest := &EmissionManifest{
+		CoinType:  1,
+		Tranche:   0,
+		Addresses: []string{ska1.EmissionAddresses[0], ska2.EmissionAddresses[0]},
+		Amounts:   []int64{ska1.EmissionAmounts[0] - 1, 1},
+	}
+	manifestHash := manifest.Hash()
+	ska1.EmissionManifestHash = &manifestHash
+	ska1.EmissionManifestTotal = ska1.EmissionAmounts[0]
+	ska1.EmissionAddresses = nil
+	ska1.EmissionAmounts = nil
+
+	got = KnownSKAAddresses(params, nil)
+	wantMissing := []KnownSKAAddress{{
+		CoinType:        1,
+		EmissionHeight:  want[0].EmissionHeight,
+		ManifestMissing: true,
+	}, want[1]}
+	if !reflect.DeepEqual(got, wantMissing) {
+		t.Fatalf("mismatched known addresses without manifest -- got %+v, "+
+			"want %+v", got, wantMissing)
+	}
+
+	manifests := map[chainhash.Hash]*EmissionManifest{manifestHash: manifest}
+	got = KnownSKAAddresses(params, manifests)
+	wantManifest := []KnownSKAAddress{{
+		CoinType:       1,
+		EmissionHeight: want[0].EmissionHeight,
+		Address:        manifest.Addresses[0],
+		Amount:         manifest.Amounts[0],
+	}, {
+		CoinType:       1,
+		EmissionHeight: want[0].EmissionHeight,
+		Address:        manifest.Addresses[1],
+		Amount:         manifest.Amounts[1],
+	}, want[1]}
+	if !reflect.DeepEqual(got, wantManifest) {
+		t.Fatalf("mismatched known addresses with manifest -- got %+v, "+
+			"want %+v", got, wantManifest)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/monetarium/monetarium-node/database"
+)
+
+// SKA state migrations
+// This file upgrades the on-disk format of the SKA state buckets in place.
+// Each bucket houses its format version under its meta version key and every
+// format change must be accompanied by a migration to the new version so
+// existing databases are upgraded on startup rather than requiring a resync.
+
+// skaStateMigration describes an in-place upgrade of an SKA state bucket from
+// the previous format version to the version it targets.
+type skaStateMigration struct {
+	// version is the format version of the bucket after the migration is
+	// applied.  It must be exactly one more than the version it upgrades from.
+	version uint32
+
+	// desc is a short human-readable description of the migration that is
+	// logged when it is applied.
+	desc string
+
+	// fn performs the migration.  It must either perform all of its work in a
+	// single database transaction or be safe to run again from the start
+	// should it be interrupted before it completes, such as by using
+	// batchedUpdate.
+	fn func(ctx context.Context, db database.DB) error
+}
+
+// skaStateBucket describes an SKA state bucket with a versioned format.
+type skaStateBucket struct {
+	// name is the human-readable name of the bucket used in log messages.
+	name string
+
+	// bucketName and versionKey are the name of the bucket and the key that
+	// houses its format version within it.
+	bucketName string
+	versionKey string
+
+	// version is the current format version of the bucket.
+	version uint32
+
+	// migrations houses the upgrades from each prior format version ordered
+	// by the version they upgrade the bucket to.
+	migrations []skaStateMigration
+}
+
+// skaStateBuckets houses the SKA state buckets that are upgraded on startup.
+var skaStateBuckets = []skaStateBucket{{
+	name:       "SKA emission state",
+	bucketName: skaStateBucketName,
+	versionKey: skaStateVersionKey,
+	version:    skaStateFormatVersion,
+	migrations: []skaStateMigration{{
+		version: 2,
+		desc:    "record the committed nonce of each emitted tranche",
+		fn:      migrateSKAEmissionStateToV2,
+	}},
+}, {
+	name:       "SKA burn state",
+	bucketName: skaBurnStateBucketName,
+	versionKey: skaBurnStateVersionKey,
+	version:    skaBurnStateFormatVersion,
+	migrations: []skaStateMigration{{
+		version: 2,
+		desc:    "start the burn journal after the current best block",
+		fn:      migrateSKABurnStateToV2,
+	}},
+}}
+
+// dbFetchSKAStateVersion uses an existing database transaction to retrieve the
+// format version of the provided SKA state bucket.  A bucket without a stored
+// version is version 1.  False is returned when the bucket does not exist.
+func dbFetchSKAStateVersion(dbTx database.Tx, b *skaStateBucket) (uint32, bool, error) {
+	bucket := dbTx.Metadata().Bucket([]byte(b.bucketName))
+	if bucket == nil {
+		return 0, false, nil
+	}
+	serialized := bucket.Get([]byte(b.versionKey))
+	if serialized == nil {
+		return 1, true, nil
+	}
+	if len(serialized) != 4 {
+		return 0, false, fmt.Errorf("invalid %s version encoding: expected 4 "+
+			"bytes, got %d", b.name, len(serialized))
+	}
+	return binary.LittleEndian.Uint32(serialized), true, nil
+}
+
+// dbPutSKAStateVersion uses an existing database transaction to update the
+// format version of the provided SKA state bucket.
+func dbPutSKAStateVersion(dbTx database.Tx, b *skaStateBucket, version uint32) error {
+	bucket := dbTx.Metadata().Bucket([]byte(b.bucketName))
+	if bucket == nil {
+		return fmt.Errorf("%s bucket does not exist", b.name)
+	}
+	var serialized [4]byte
+	binary.LittleEndian.PutUint32(serialized[:], version)
+	return bucket.Put([]byte(b.versionKey), serialized[:])
+}
+
+// migrateSKAStateBucket upgrades the provided SKA state bucket from its stored
+// format version to its current version by applying its migrations in order.
+// The stored version is updated after each migration completes so an
+// interrupted upgrade resumes from the first migration that has not been fully
+// applied on the next start.
+func migrateSKAStateBucket(ctx context.Context, db database.DB, b *skaStateBucket) error {
+	var version uint32
+	var exists bool
+	err := db.View(func(dbTx database.Tx) error {
+		var err error
+		version, exists, err = dbFetchSKAStateVersion(dbTx, b)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Nothing to do when the bucket has not been created yet since it is
+	// created with the current version or when it is already current.
+	if !exists || version == b.version {
+		return nil
+	}
+	if version > b.version {
+		return fmt.Errorf("unsupported %s version %d > %d", b.name, version,
+			b.version)
+	}
+
+	for _, m := range b.migrations {
+		if m.version <= version {
+			continue
+		}
+		if m.version != version+1 {
+			return fmt.Errorf("no migration of %s from version %d to %d",
+				b.name, version, version+1)
+		}
+		if interruptRequested(ctx) {
+			return errInterruptRequested
+		}
+
+		log.Infof("Upgrading %s to version %d (%s)...", b.name, m.version,
+			m.desc)
+		start := time.Now()
+		if err := m.fn(ctx, db); err != nil {
+			return err
+		}
+		if interruptRequested(ctx) {
+			return errInterruptRequested
+		}
+
+		err := db.Update(func(dbTx database.Tx) error {
+			return dbPutSKAStateVersion(dbTx, b, m.version)
+		})
+		if err != nil {
+			return err
+		}
+		version = m.version
+		log.Infof("Done upgrading %s to version %d.  Total time: %v", b.name,
+			m.version, time.Since(start).Round(time.Millisecond))
+	}
+
+	if version != b.version {
+		return fmt.Errorf("no migration of %s from version %d to %d", b.name,
+			version, b.version)
+	}
+	return nil
+}
+
+// migrateSKAEmissionStateToV2 upgrades the SKA emission state from version 1,
+// which stores the last used nonce and an emitted flag for each coin type, to
+// version 2, which stores the committed nonce of every emitted tranche.
+//
+// Version 1 requires the nonce of each emission to be exactly one greater than
+// the nonce of the previous emission of its coin type, starting from 1 for the
+// first tranche, so an emitted coin type with a last used nonce of N has
+// emitted N tranches with the nonces 1 through N.
+func migrateSKAEmissionStateToV2(ctx context.Context, db database.DB) error {
+	return db.Update(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket([]byte(skaStateBucketName))
+		if bucket == nil {
+			return nil
+		}
+
+		// Collect the upgraded entries first since the bucket must not be
+		// modified while iterating it.
+		upgraded := make(map[byte][]byte)
+		err := bucket.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, []byte(skaStateVersionKey)) {
+				return nil
+			}
+			if len(k) != 1 {
+				return fmt.Errorf("invalid key length in SKA state "+
+					"bucket: %d", len(k))
+			}
+
+			// Version 1 value format: [nonce:8 bytes][emitted:1 byte]
+			if len(v) != 9 {
+				return fmt.Errorf("invalid value length for coin type "+
+					"%d: %d", k[0], len(v))
+			}
+			var nonces []uint64
+			if nonce := binary.LittleEndian.Uint64(v[:8]); nonce != 0 && v[8] != 0 {
+				if nonce > math.MaxUint32 {
+					return fmt.Errorf("invalid nonce for coin type %d: %d",
+						k[0], nonce)
+				}
+				nonces = make([]uint64, nonce)
+				for i := range nonces {
+					nonces[i] = uint64(i + 1)
+				}
+			}
+			upgraded[k[0]] = serializeSKAEmissionTranches(nonces)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for coinType, value := range upgraded {
+			if err := bucket.Put([]byte{coinType}, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// migrateSKABurnStateToV2 upgrades the SKA burn state from version 1, which
+// only stores the total burned amount of each coin type, to version 2, which
+// additionally records every individual burn in the burn journal.
+//
+// The individual burns accounted for by version 1 state are not available
+// without rescanning the chain, so the journal is marked as starting after the
+// current best block instead.
+func migrateSKABurnStateToV2(ctx context.Context, db database.DB) error {
+	return db.Update(func(dbTx database.Tx) error {
+		state, err := dbFetchBestState(dbTx)
+		if err != nil {
+			return err
+		}
+		return dbPutSKABurnJournalStart(dbTx, int64(state.height)+1)
+	})
+}
+
+// upgradeSKAState upgrades all SKA state buckets to their current format
+// versions as needed.  It must be called before the SKA state is loaded.
+func upgradeSKAState(ctx context.Context, db database.DB) error {
+	for i := range skaStateBuckets {
+		if err := migrateSKAStateBucket(ctx, db, &skaStateBuckets[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/math/uint256"
+)
+
+// TestMigrateSKAStateBucket ensures SKA state buckets are upgraded in place by
+// applying their migrations in order, resume interrupted migrations, and
+// reject versions without a migration path.
+func TestMigrateSKAStateBucket(t *testing.T) {
+	t.Parallel()
+
+	db, teardown := createTestDB(t, "ska_state_migrations")
+	defer teardown()
+
+	const bucketName = "skamigrationtest"
+	var applied []uint32
+	migration := func(version uint32) skaStateMigration {
+		return skaStateMigration{
+			version: version,
+			desc:    "test migration",
+			fn: func(ctx context.Context, db database.DB) error {
+				applied = append(applied, version)
+				return nil
+			},
+		}
+	}
+	b := &skaStateBucket{
+		name:       "test state",
+		bucketName: bucketName,
+		versionKey: skaStateVersionKey,
+		version:    3,
+		migrations: []skaStateMigration{migration(2), migration(3)},
+	}
+
+	// checkVersion ensures the stored version of the bucket is the expected
+	// value.
+	checkVersion := func(want uint32) {
+		t.Helper()
+		var version uint32
+		err := db.View(func(dbTx database.Tx) error {
+			var err error
+			version, _, err = dbFetchSKAStateVersion(dbTx, b)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("unable to fetch version: %v", err)
+		}
+		if version != want {
+			t.Fatalf("mismatched version: got %d, want %d", version, want)
+		}
+	}
+
+	// Ensure nothing is done when the bucket does not exist.
+	ctx := context.Background()
+	if err := migrateSKAStateBucket(ctx, db, b); err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+	if len(applied) != 0 {
+		t.Fatalf("migrations unexpectedly applied to missing bucket")
+	}
+
+	// Create the bucket without a version, which is treated as version 1.
+	err := db.Update(func(dbTx database.Tx) error {
+		_, err := dbTx.Metadata().CreateBucket([]byte(bucketName))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to create bucket: %v", err)
+	}
+	checkVersion(1)
+
+	// Ensure an interrupt before the first migration leaves the version
+	// untouched.
+	interruptCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = migrateSKAStateBucket(interruptCtx, db, b)
+	if !errors.Is(err, errInterruptRequested) {
+		t.Fatalf("unexpected migration error: got %v, want %v", err,
+			errInterruptRequested)
+	}
+	if len(applied) != 0 {
+		t.Fatalf("migrations unexpectedly applied after interrupt")
+	}
+	checkVersion(1)
+
+	// Ensure an interrupt during a migration only records the migrations
+	// that completed.
+	interruptCtx, cancel = context.WithCancel(ctx)
+	defer cancel()
+	b.migrations[1].fn = func(ctx context.Context, db database.DB) error {
+		cancel()
+		return nil
+	}
+	err = migrateSKAStateBucket(interruptCtx, db, b)
+	if !errors.Is(err, errInterruptRequested) {
+		t.Fatalf("unexpected migration error: got %v, want %v", err,
+			errInterruptRequested)
+	}
+	checkVersion(2)
+
+	// Ensure the remaining migration is applied on the next run.
+	b.migrations[1] = migration(3)
+	if err := migrateSKAStateBucket(ctx, db, b); err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+	if len(applied) != 2 || applied[0] != 2 || applied[1] != 3 {
+		t.Fatalf("unexpected applied migrations: %v", applied)
+	}
+	checkVersion(3)
+
+	// Ensure a version newer than the current version is rejected.
+	b.version = 2
+	if err := migrateSKAStateBucket(ctx, db, b); err == nil {
+		t.Fatal("migration of newer version did not fail")
+	}
+
+	// Ensure a gap in the migrations is rejected.
+	b.version = 4
+	b.migrations = append(b.migrations, migration(5))
+	if err := migrateSKAStateBucket(ctx, db, b); err == nil {
+		t.Fatal("migration with missing version did not fail")
+	}
+	checkVersion(3)
+}
+
+// TestMigrateSKAEmissionStateToV2 ensures version 1 SKA emission state is
+// upgraded to record the nonce of every tranche emitted for each emitted coin
+// type and is then loaded as the current version.
+func TestMigrateSKAEmissionStateToV2(t *testing.T) {
+	t.Parallel()
+
+	db, teardown := createTestDB(t, "ska_emission_state_v2")
+	defer teardown()
+
+	// Create version 1 state without a stored version where coin type 1 was
+	// emitted with nonce 1, coin type 2 was never emitted, and coin type 3
+	// emitted three tranches with nonces 1 through 3.
+	v1Entry := func(nonce uint64, emitted bool) []byte {
+		value := make([]byte, 9)
+		binary.LittleEndian.PutUint64(value[:8], nonce)
+		if emitted {
+			value[8] = 1
+		}
+		return value
+	}
+	err := db.Update(func(dbTx database.Tx) error {
+		bucket, err := dbTx.Metadata().CreateBucket([]byte(skaStateBucketName))
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put([]byte{1}, v1Entry(1, true)); err != nil {
+			return err
+		}
+		if err := bucket.Put([]byte{2}, v1Entry(0, false)); err != nil {
+			return err
+		}
+		return bucket.Put([]byte{3}, v1Entry(3, true))
+	})
+	if err != nil {
+		t.Fatalf("unable to create version 1 state: %v", err)
+	}
+
+	// Ensure version 1 state must be upgraded before it is loaded.
+	if _, err := NewSKAEmissionState(db); err == nil {
+		t.Fatal("loading version 1 state did not fail")
+	}
+
+	if err := upgradeSKAState(context.Background(), db); err != nil {
+		t.Fatalf("unexpected upgrade error: %v", err)
+	}
+	state, err := NewSKAEmissionState(db)
+	if err != nil {
+		t.Fatalf("unable to load upgraded state: %v", err)
+	}
+	if got := state.EmittedTranches(1); got != 1 {
+		t.Fatalf("mismatched emitted tranches: got %d, want 1", got)
+	}
+	if got := state.GetNonce(1); got != 1 {
+		t.Fatalf("mismatched nonce: got %d, want 1", got)
+	}
+	if state.IsEmitted(2) || state.GetNonce(2) != 0 {
+		t.Fatal("coin type 2 unexpectedly emitted after upgrade")
+	}
+	if got := state.EmittedTranches(3); got != 3 {
+		t.Fatalf("mismatched emitted tranches: got %d, want 3", got)
+	}
+	if got := state.GetNonce(3); got != 3 {
+		t.Fatalf("mismatched nonce: got %d, want 3", got)
+	}
+
+	// Ensure disconnecting the latest tranche of the upgraded coin type
+	// reverts to the nonce committed by the previous tranche.
+	err = db.Update(func(dbTx database.Tx) error {
+		return state.DisconnectSKAEmissionsTx(dbTx, []SKAEmissionRecord{{
+			CoinType: 3,
+			Nonce:    3,
+		}})
+	})
+	if err != nil {
+		t.Fatalf("unable to disconnect emission: %v", err)
+	}
+	if got := state.EmittedTranches(3); got != 2 {
+		t.Fatalf("mismatched emitted tranches: got %d, want 2", got)
+	}
+	if got := state.GetNonce(3); got != 2 {
+		t.Fatalf("mismatched nonce: got %d, want 2", got)
+	}
+}
+
+// TestMigrateSKABurnStateToV2 ensures upgrading version 1 SKA burn state keeps
+// the burned totals and starts the burn journal after the best block.
+func TestMigrateSKABurnStateToV2(t *testing.T) {
+	t.Parallel()
+
+	db, teardown := createTestDB(t, "ska_burn_state_v2")
+	defer teardown()
+
+	// Create version 1 state without a stored version where 1000 atoms of
+	// coin type 1 were burned and a best chain state at height 200.
+	err := db.Update(func(dbTx database.Tx) error {
+		bucket, err := dbTx.Metadata().CreateBucket([]byte(skaBurnStateBucketName))
+		if err != nil {
+			return err
+		}
+		var amount [8]byte
+		binary.LittleEndian.PutUint64(amount[:], 1000)
+		if err := bucket.Put([]byte{1}, amount[:]); err != nil {
+			return err
+		}
+		return dbPutBestState(dbTx, &BestState{Height: 200}, new(uint256.Uint256))
+	})
+	if err != nil {
+		t.Fatalf("unable to create version 1 state: %v", err)
+	}
+
+	// Ensure version 1 state must be upgraded before it is loaded.
+	if _, err := NewSKABurnState(db); err == nil {
+		t.Fatal("loading version 1 state did not fail")
+	}
+
+	if err := upgradeSKAState(context.Background(), db); err != nil {
+		t.Fatalf("unexpected upgrade error: %v", err)
+	}
+	state, err := NewSKABurnState(db)
+	if err != nil {
+		t.Fatalf("unable to load upgraded state: %v", err)
+	}
+	if got := state.GetBurnedAmount(1); got != 1000 {
+		t.Fatalf("mismatched burned amount: got %d, want 1000", got)
+	}
+	if got := state.JournalStartHeight(); got != 201 {
+		t.Fatalf("mismatched journal start height: got %d, want 201", got)
+	}
+}
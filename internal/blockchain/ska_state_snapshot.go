@@ -0,0 +1,421 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+const (
+	// SKAStateSnapshotVersion is the version of the SKA state snapshot
+	// format.
+	SKAStateSnapshotVersion = 1
+
+	// skaStateSnapshotDomain is the domain separator of the hash an SKA state
+	// snapshot is identified by.
+	skaStateSnapshotDomain = "SKA-STATE-SNAPSHOT-V1"
+
+	// skaStateUtxosDomain and skaStateEmissionsDomain are the domain
+	// separators of the integrity hashes of the unspent outputs and the
+	// emissions of a coin type in an SKA state snapshot.
+	skaStateUtxosDomain     = "SKA-STATE-UTXOS-V1"
+	skaStateEmissionsDomain = "SKA-STATE-EMISSIONS-V1"
+)
+
+// SKAStateSnapshotUtxo is an unspent output of an SKA coin type in an SKA
+// state snapshot.
+type SKAStateSnapshotUtxo struct {
+	TxHash       string `json:"txhash"`
+	Tree         int8   `json:"tree"`
+	Vout         uint32 `json:"vout"`
+	Height       int64  `json:"height"`
+	Amount       int64  `json:"amount"`
+	Version      uint16 `json:"version"`
+	ScriptPubKey string `json:"scriptpubkey"`
+}
+
+// SKAStateSnapshotEmission is an emission of an SKA coin type in an SKA state
+// snapshot.  The amount is the total emitted to the coin type in atoms.
+type SKAStateSnapshotEmission struct {
+	Height    int64  `json:"height"`
+	BlockHash string `json:"blockhash"`
+	TxHash    string `json:"txhash"`
+	Nonce     uint64 `json:"nonce"`
+	Amount    int64  `json:"amount"`
+}
+
+// SKAStateSnapshotCoinType is the state of a single SKA coin type in an SKA
+// state snapshot.
+//
+// The supply is the total of the unspent outputs and the emitted amount is the
+// total of the emissions.  The integrity hashes commit to the unspent outputs
+// and the emissions respectively so either may be distributed and verified
+// separately.
+type SKAStateSnapshotCoinType struct {
+	CoinType      uint8                      `json:"cointype"`
+	Supply        int64                      `json:"supply"`
+	Emitted       int64                      `json:"emitted"`
+	UtxosHash     string                     `json:"utxoshash"`
+	EmissionsHash string                     `json:"emissionshash"`
+	Emissions     []SKAStateSnapshotEmission `json:"emissions"`
+	Utxos         []SKAStateSnapshotUtxo     `json:"utxos"`
+}
+
+// SKAStateSnapshot is the canonical JSON description of the unspent outputs,
+// supply totals, and emissions of every SKA coin type of a network as of the
+// main chain block at a specific height.
+//
+// It enables nodes and services that need accurate SKA state immediately, such
+// as explorers, to bootstrap from a snapshot instead of scanning the chain.
+// The snapshot is identified by a hash that commits to every field, including
+// the integrity hashes of each coin type, so a snapshot obtained from an
+// untrusted source only needs its hash compared to one obtained from a trusted
+// source.  The emissions may additionally be checked against a signed emission
+// checkpoint at the same height.
+//
+// Coin types must be listed in ascending order, unspent outputs in ascending
+// order of their outpoints, and emissions in the order they were emitted.
+type SKAStateSnapshot struct {
+	Version   uint32                     `json:"version"`
+	Network   string                     `json:"network"`
+	Height    int64                      `json:"height"`
+	BlockHash string                     `json:"blockhash"`
+	CoinTypes []SKAStateSnapshotCoinType `json:"cointypes"`
+	Hash      string                     `json:"hash"`
+}
+
+// ParseSKAStateSnapshot decodes the provided JSON-encoded SKA state snapshot
+// and verifies its integrity.  Unknown fields are rejected so that snapshots
+// that were produced for a different format are not silently misinterpreted.
+func ParseSKAStateSnapshot(data []byte) (*SKAStateSnapshot, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var s SKAStateSnapshot
+	if err := dec.Decode(&s); err != nil {
+		return nil, fmt.Errorf("invalid SKA state snapshot: %w", err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("invalid SKA state snapshot: trailing data")
+	}
+	if err := s.Verify(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// snapshotHashWriter serializes the fields committed to by the hashes of an
+// SKA state snapshot.
+type snapshotHashWriter struct {
+	bytes.Buffer
+}
+
+func (w *snapshotHashWriter) putUint32(v uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	w.Write(b[:])
+}
+
+func (w *snapshotHashWriter) putUint64(v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	w.Write(b[:])
+}
+
+func (w *snapshotHashWriter) putString(s string) {
+	w.putUint32(uint32(len(s)))
+	w.WriteString(s)
+}
+
+// utxosHash returns the integrity hash of the unspent outputs of the coin
+// type.
+func (ct *SKAStateSnapshotCoinType) utxosHash() chainhash.Hash {
+	var w snapshotHashWriter
+	w.WriteString(skaStateUtxosDomain)
+	w.WriteByte(ct.CoinType)
+	w.putUint32(uint32(len(ct.Utxos)))
+	for i := range ct.Utxos {
+		u := &ct.Utxos[i]
+		w.putString(u.TxHash)
+		w.WriteByte(byte(u.Tree))
+		w.putUint32(u.Vout)
+		w.putUint64(uint64(u.Height))
+		w.putUint64(uint64(u.Amount))
+		w.putUint32(uint32(u.Version))
+		w.putString(u.ScriptPubKey)
+	}
+	return chainhash.HashH(w.Bytes())
+}
+
+// emissionsHash returns the integrity hash of the emissions of the coin type.
+func (ct *SKAStateSnapshotCoinType) emissionsHash() chainhash.Hash {
+	var w snapshotHashWriter
+	w.WriteString(skaStateEmissionsDomain)
+	w.WriteByte(ct.CoinType)
+	w.putUint32(uint32(len(ct.Emissions)))
+	for i := range ct.Emissions {
+		e := &ct.Emissions[i]
+		w.putUint64(uint64(e.Height))
+		w.putString(e.BlockHash)
+		w.putString(e.TxHash)
+		w.putUint64(e.Nonce)
+		w.putUint64(uint64(e.Amount))
+	}
+	return chainhash.HashH(w.Bytes())
+}
+
+// ComputeHash returns the hash that identifies the snapshot.  It commits to
+// every field of the snapshot except the hash itself, and commits to the
+// unspent outputs and emissions of each coin type by way of their integrity
+// hashes.
+func (s *SKAStateSnapshot) ComputeHash() chainhash.Hash {
+	var w snapshotHashWriter
+	w.WriteString(skaStateSnapshotDomain)
+	w.putUint32(s.Version)
+	w.putString(s.Network)
+	w.putUint64(uint64(s.Height))
+	w.putString(s.BlockHash)
+	w.putUint32(uint32(len(s.CoinTypes)))
+	for i := range s.CoinTypes {
+		ct := &s.CoinTypes[i]
+		w.WriteByte(ct.CoinType)
+		w.putUint64(uint64(ct.Supply))
+		w.putUint64(uint64(ct.Emitted))
+		w.putString(ct.UtxosHash)
+		w.putString(ct.EmissionsHash)
+	}
+	return chainhash.HashH(w.Bytes())
+}
+
+// Seal sets the supply totals and integrity hashes of every coin type as well
+// as the hash of the snapshot from its unspent outputs and emissions and then
+// verifies the result.
+func (s *SKAStateSnapshot) Seal() error {
+	for i := range s.CoinTypes {
+		ct := &s.CoinTypes[i]
+		ct.Supply, ct.Emitted = 0, 0
+		for j := range ct.Utxos {
+			ct.Supply += ct.Utxos[j].Amount
+		}
+		for j := range ct.Emissions {
+			ct.Emitted += ct.Emissions[j].Amount
+		}
+		utxosHash, emissionsHash := ct.utxosHash(), ct.emissionsHash()
+		ct.UtxosHash = utxosHash.String()
+		ct.EmissionsHash = emissionsHash.String()
+	}
+	hash := s.ComputeHash()
+	s.Hash = hash.String()
+	return s.Verify()
+}
+
+// parseCanonicalHash parses the provided hash and ensures it is encoded in its
+// canonical form so that the hashes committing to it are unambiguous.
+func parseCanonicalHash(what, s string) (*chainhash.Hash, error) {
+	hash, err := chainhash.NewHashFromStr(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", what, err)
+	}
+	if hash.String() != s {
+		return nil, fmt.Errorf("%s %q is not encoded as %q", what, s, hash)
+	}
+	return hash, nil
+}
+
+// Verify ensures the fields of the snapshot are well formed, that the supply
+// totals match the unspent outputs and emissions, and that the integrity hashes
+// and the hash of the snapshot match its contents.
+func (s *SKAStateSnapshot) Verify() error {
+	if s.Version != SKAStateSnapshotVersion {
+		return fmt.Errorf("unsupported SKA state snapshot version %d",
+			s.Version)
+	}
+	if s.Network == "" {
+		return fmt.Errorf("SKA state snapshot does not specify a network")
+	}
+	if s.Height < 0 {
+		return fmt.Errorf("invalid SKA state snapshot height: %d", s.Height)
+	}
+	if _, err := parseCanonicalHash("SKA state snapshot block hash",
+		s.BlockHash); err != nil {
+
+		return err
+	}
+
+	var prevCoinType uint8
+	for i := range s.CoinTypes {
+		ct := &s.CoinTypes[i]
+		if ct.CoinType <= prevCoinType {
+			return fmt.Errorf("SKA state snapshot coin type %d is not an SKA "+
+				"coin type in ascending order", ct.CoinType)
+		}
+		prevCoinType = ct.CoinType
+
+		// The unspent outputs must be unique, in ascending order of their
+		// outpoints, and pay a positive amount.
+		var supply int64
+		var prevOutPoint *SKAStateSnapshotUtxo
+		for j := range ct.Utxos {
+			u := &ct.Utxos[j]
+			hash, err := parseCanonicalHash("SKA state snapshot output hash",
+				u.TxHash)
+			if err != nil {
+				return err
+			}
+			if _, err := hex.DecodeString(u.ScriptPubKey); err != nil {
+				return fmt.Errorf("invalid SKA state snapshot output script "+
+					"of %v:%d: %w", hash, u.Vout, err)
+			}
+			if u.Amount <= 0 || u.Height < 0 || u.Height > s.Height {
+				return fmt.Errorf("SKA state snapshot output %v:%d of coin "+
+					"type %d has invalid amount %d or height %d", hash,
+					u.Vout, ct.CoinType, u.Amount, u.Height)
+			}
+			if prevOutPoint != nil {
+				prevHash, _ := chainhash.NewHashFromStr(prevOutPoint.TxHash)
+				cmp := bytes.Compare(prevHash[:], hash[:])
+				if cmp > 0 || (cmp == 0 && (prevOutPoint.Tree > u.Tree ||
+					(prevOutPoint.Tree == u.Tree &&
+						prevOutPoint.Vout >= u.Vout))) {
+
+					return fmt.Errorf("SKA state snapshot outputs of coin "+
+						"type %d are not unique in ascending order",
+						ct.CoinType)
+				}
+			}
+			prevOutPoint = u
+			supply += u.Amount
+		}
+		if supply != ct.Supply {
+			return fmt.Errorf("SKA state snapshot supply of coin type %d is "+
+				"%d, but its outputs total %d", ct.CoinType, ct.Supply, supply)
+		}
+
+		// The emissions must be in the order they were emitted with strictly
+		// increasing nonces, which is the order enforced by consensus.
+		var emitted int64
+		var prevNonce uint64
+		var prevHeight int64
+		for j := range ct.Emissions {
+			e := &ct.Emissions[j]
+			if _, err := parseCanonicalHash("SKA state snapshot emission "+
+				"block hash", e.BlockHash); err != nil {
+
+				return err
+			}
+			if _, err := parseCanonicalHash("SKA state snapshot emission "+
+				"hash", e.TxHash); err != nil {
+
+				return err
+			}
+			if e.Nonce <= prevNonce || e.Height < prevHeight ||
+				e.Height > s.Height || e.Amount <= 0 {
+
+				return fmt.Errorf("SKA state snapshot emission %s of coin "+
+					"type %d is out of order or has invalid amount %d or "+
+					"height %d", e.TxHash, ct.CoinType, e.Amount, e.Height)
+			}
+			prevNonce, prevHeight = e.Nonce, e.Height
+			emitted += e.Amount
+		}
+		if emitted != ct.Emitted {
+			return fmt.Errorf("SKA state snapshot emitted amount of coin "+
+				"type %d is %d, but its emissions total %d", ct.CoinType,
+				ct.Emitted, emitted)
+		}
+
+		if hash := ct.utxosHash(); hash.String() != ct.UtxosHash {
+			return fmt.Errorf("SKA state snapshot outputs hash of coin type "+
+				"%d is %q, but its outputs hash to %v", ct.CoinType,
+				ct.UtxosHash, hash)
+		}
+		if hash := ct.emissionsHash(); hash.String() != ct.EmissionsHash {
+			return fmt.Errorf("SKA state snapshot emissions hash of coin "+
+				"type %d is %q, but its emissions hash to %v", ct.CoinType,
+				ct.EmissionsHash, hash)
+		}
+	}
+
+	if hash := s.ComputeHash(); hash.String() != s.Hash {
+		return fmt.Errorf("SKA state snapshot hash is %q, but its contents "+
+			"hash to %v", s.Hash, hash)
+	}
+	return nil
+}
+
+// CheckParams ensures the snapshot is for the provided network and that every
+// coin type it describes is configured on the network.
+func (s *SKAStateSnapshot) CheckParams(params *chaincfg.Params) error {
+	if s.Network != params.Name {
+		return fmt.Errorf("SKA state snapshot is for network %q instead of "+
+			"%q", s.Network, params.Name)
+	}
+	for i := range s.CoinTypes {
+		coinType := s.CoinTypes[i].CoinType
+		if _, ok := params.SKACoins[cointype.CoinType(coinType)]; !ok {
+			return fmt.Errorf("SKA state snapshot coin type %d is not "+
+				"configured on %s", coinType, params.Name)
+		}
+	}
+	return nil
+}
+
+// CheckEmissionCheckpoint ensures the emissions of the snapshot match the
+// emission state attested to by the provided emission checkpoint at the same
+// height.  The checkpoint is expected to have already been validated and its
+// signature verified.
+func (s *SKAStateSnapshot) CheckEmissionCheckpoint(cp *EmissionCheckpoint) error {
+	if cp.Network != s.Network || cp.Height != s.Height ||
+		cp.BlockHash != s.BlockHash {
+
+		return fmt.Errorf("emission checkpoint for block %s at height %d on "+
+			"%q does not match SKA state snapshot block %s at height %d on "+
+			"%q", cp.BlockHash, cp.Height, cp.Network, s.BlockHash, s.Height,
+			s.Network)
+	}
+
+	var numEmitted int
+	for i := range s.CoinTypes {
+		if len(s.CoinTypes[i].Emissions) > 0 {
+			numEmitted++
+		}
+	}
+	if numEmitted != len(cp.CoinTypes) {
+		return fmt.Errorf("%d coin types are emitted in the SKA state "+
+			"snapshot, but the emission checkpoint attests to %d", numEmitted,
+			len(cp.CoinTypes))
+	}
+	for _, cpCoinType := range cp.CoinTypes {
+		var emissions []SKAStateSnapshotEmission
+		for i := range s.CoinTypes {
+			if s.CoinTypes[i].CoinType == cpCoinType.CoinType {
+				emissions = s.CoinTypes[i].Emissions
+				break
+			}
+		}
+		if len(emissions) != len(cpCoinType.Nonces) {
+			return fmt.Errorf("%d emission tranches of coin type %d are in "+
+				"the SKA state snapshot, but the emission checkpoint attests "+
+				"to %d", len(emissions), cpCoinType.CoinType,
+				len(cpCoinType.Nonces))
+		}
+		for i, nonce := range cpCoinType.Nonces {
+			if emissions[i].Nonce != nonce {
+				return fmt.Errorf("emission tranche %d of coin type %d has "+
+					"nonce %d in the SKA state snapshot, but the emission "+
+					"checkpoint attests to nonce %d", i+1,
+					cpCoinType.CoinType, emissions[i].Nonce, nonce)
+			}
+		}
+	}
+	return nil
+}
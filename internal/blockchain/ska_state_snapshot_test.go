@@ -0,0 +1,203 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+)
+
+// TestSKAStateSnapshot ensures SKA state snapshots round trip through their
+// JSON encoding, that tampering with any of their contents is detected, and
+// that their emissions are checked against emission checkpoints as expected.
+func TestSKAStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	newSnapshot := func() *SKAStateSnapshot {
+		return &SKAStateSnapshot{
+			Version:   SKAStateSnapshotVersion,
+			Network:   "simnet",
+			Height:    200,
+			BlockHash: strings.Repeat("ab", 32),
+			CoinTypes: []SKAStateSnapshotCoinType{{
+				CoinType: 1,
+				Emissions: []SKAStateSnapshotEmission{{
+					Height:    150,
+					BlockHash: strings.Repeat("01", 32),
+					TxHash:    strings.Repeat("02", 32),
+					Nonce:     1,
+					Amount:    5000,
+				}},
+				Utxos: []SKAStateSnapshotUtxo{{
+					TxHash:       strings.Repeat("02", 32),
+					Vout:         0,
+					Height:       150,
+					Amount:       3000,
+					ScriptPubKey: "51",
+				}, {
+					TxHash:       strings.Repeat("02", 32),
+					Vout:         1,
+					Height:       150,
+					Amount:       1500,
+					ScriptPubKey: "51",
+				}},
+			}},
+		}
+	}
+
+	snapshot := newSnapshot()
+	if err := snapshot.Verify(); err == nil {
+		t.Fatal("Unsealed snapshot should have failed verification")
+	}
+	if err := snapshot.Seal(); err != nil {
+		t.Fatalf("Failed to seal snapshot: %v", err)
+	}
+	if snapshot.CoinTypes[0].Supply != 4500 ||
+		snapshot.CoinTypes[0].Emitted != 5000 {
+
+		t.Fatalf("Unexpected supply %d and emitted amount %d",
+			snapshot.CoinTypes[0].Supply, snapshot.CoinTypes[0].Emitted)
+	}
+
+	encoded, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatalf("Failed to encode snapshot: %v", err)
+	}
+	parsed, err := ParseSKAStateSnapshot(encoded)
+	if err != nil {
+		t.Fatalf("Failed to parse snapshot: %v", err)
+	}
+	if parsed.Hash != snapshot.Hash {
+		t.Fatalf("Mismatched hash -- got %s, want %s", parsed.Hash,
+			snapshot.Hash)
+	}
+	if err := parsed.CheckParams(chaincfg.SimNetParams()); err != nil {
+		t.Fatalf("Unexpected error checking params: %v", err)
+	}
+	if err := parsed.CheckParams(chaincfg.MainNetParams()); err == nil {
+		t.Fatal("Snapshot for another network should have been rejected")
+	}
+
+	// Ensure tampering with the contents is detected even when the supply
+	// totals are adjusted accordingly.
+	tampered := []struct {
+		name   string
+		modify func(s *SKAStateSnapshot)
+	}{{
+		name: "output amount",
+		modify: func(s *SKAStateSnapshot) {
+			s.CoinTypes[0].Utxos[0].Amount++
+			s.CoinTypes[0].Supply++
+		},
+	}, {
+		name: "output script",
+		modify: func(s *SKAStateSnapshot) {
+			s.CoinTypes[0].Utxos[1].ScriptPubKey = "52"
+		},
+	}, {
+		name: "removed output",
+		modify: func(s *SKAStateSnapshot) {
+			s.CoinTypes[0].Supply -= s.CoinTypes[0].Utxos[1].Amount
+			s.CoinTypes[0].Utxos = s.CoinTypes[0].Utxos[:1]
+		},
+	}, {
+		name: "emission nonce",
+		modify: func(s *SKAStateSnapshot) {
+			s.CoinTypes[0].Emissions[0].Nonce = 2
+		},
+	}, {
+		name: "block hash",
+		modify: func(s *SKAStateSnapshot) {
+			s.BlockHash = strings.Repeat("cd", 32)
+		},
+	}, {
+		name: "network",
+		modify: func(s *SKAStateSnapshot) {
+			s.Network = "testnet3"
+		},
+	}}
+	for _, test := range tampered {
+		s := newSnapshot()
+		if err := s.Seal(); err != nil {
+			t.Fatalf("%q: failed to seal snapshot: %v", test.name, err)
+		}
+		test.modify(s)
+		if err := s.Verify(); err == nil {
+			t.Errorf("%q: tampered snapshot should have failed verification",
+				test.name)
+		}
+	}
+
+	// Ensure malformed snapshots cannot be sealed.
+	malformed := []struct {
+		name   string
+		modify func(s *SKAStateSnapshot)
+	}{{
+		name: "VAR coin type",
+		modify: func(s *SKAStateSnapshot) {
+			s.CoinTypes[0].CoinType = 0
+		},
+	}, {
+		name: "unordered outputs",
+		modify: func(s *SKAStateSnapshot) {
+			utxos := s.CoinTypes[0].Utxos
+			utxos[0], utxos[1] = utxos[1], utxos[0]
+		},
+	}, {
+		name: "output above snapshot height",
+		modify: func(s *SKAStateSnapshot) {
+			s.CoinTypes[0].Utxos[0].Height = 201
+		},
+	}, {
+		name: "non-canonical hash",
+		modify: func(s *SKAStateSnapshot) {
+			s.CoinTypes[0].Emissions[0].TxHash = strings.Repeat("AB", 32)
+		},
+	}}
+	for _, test := range malformed {
+		s := newSnapshot()
+		test.modify(s)
+		if err := s.Seal(); err == nil {
+			t.Errorf("%q: malformed snapshot should have failed to seal",
+				test.name)
+		}
+	}
+
+	// Ensure unknown fields and trailing data are rejected.
+	withUnknown := strings.Replace(string(encoded), `"version"`,
+		`"unknown":1,"version"`, 1)
+	if _, err := ParseSKAStateSnapshot([]byte(withUnknown)); err == nil {
+		t.Fatal("Snapshot with unknown field should have been rejected")
+	}
+	if _, err := ParseSKAStateSnapshot(append(encoded, "{}"...)); err == nil {
+		t.Fatal("Snapshot with trailing data should have been rejected")
+	}
+
+	// Ensure the emissions are checked against emission checkpoints.
+	cp := &EmissionCheckpoint{
+		Network:   snapshot.Network,
+		Height:    snapshot.Height,
+		BlockHash: snapshot.BlockHash,
+		CoinTypes: []EmissionCheckpointCoinType{
+			{CoinType: 1, Nonces: []uint64{1}},
+		},
+	}
+	if err := snapshot.CheckEmissionCheckpoint(cp); err != nil {
+		t.Fatalf("Unexpected error checking emission checkpoint: %v", err)
+	}
+	cp.CoinTypes[0].Nonces = []uint64{1, 2}
+	if err := snapshot.CheckEmissionCheckpoint(cp); err == nil {
+		t.Fatal("Mismatched emission checkpoint should have been rejected")
+	}
+	cp.CoinTypes[0].Nonces = []uint64{1}
+	cp.Height--
+	if err := snapshot.CheckEmissionCheckpoint(cp); err == nil {
+		t.Fatal("Emission checkpoint at another height should have been " +
+			"rejected")
+	}
+}
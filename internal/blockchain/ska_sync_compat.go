@@ -0,0 +1,54 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+)
+
+// checkHeaderSKACompatibility ensures the consensus rules committed to by the
+// header chain leading up to the provided node are known to the local SKA
+// configuration.
+//
+// Headers commit to the stake version locked in by the voters, and SKA coin
+// types are activated and deactivated by stakeholder votes on deployments that
+// are tied to a stake version.  A locked in stake version that is higher than
+// every deployment version known locally therefore means the network enforces
+// votes the local configuration does not know about, such as the activation of
+// coin types it is not configured for.  Blocks that make use of them would
+// otherwise only be rejected once they are reached midway through the sync.
+//
+// This function MUST be called with the chain state lock held (for writes).
+func (b *BlockChain) checkHeaderSKACompatibility(node *blockNode) error {
+	knownVersion := currentDeploymentVersion(b.chainParams)
+	stakeVersion := b.calcStakeVersion(node)
+	if stakeVersion > knownVersion {
+		str := fmt.Sprintf("header %s (height %d) commits to stake version "+
+			"%d, which is higher than the highest stake version %d with "+
+			"deployments known to the local SKA configuration -- the "+
+			"network enforces consensus rules, potentially including SKA "+
+			"coin types, that this node can not validate", node.hash,
+			node.height, stakeVersion, knownVersion)
+		return contextError(ErrIncompatibleSKAConfig, str)
+	}
+	return nil
+}
+
+// CheckHeaderSKACompatibility ensures the local SKA configuration is able to
+// validate the blocks of the best known header chain.  It is intended to be
+// called once the initial headers sync completes so the sync can be stopped
+// with a clear error before downloading any blocks that could not be
+// validated.
+//
+// An error of kind ErrIncompatibleSKAConfig is returned when the header chain
+// commits to consensus rules unknown to the local SKA configuration.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) CheckHeaderSKACompatibility() error {
+	b.chainLock.Lock()
+	err := b.checkHeaderSKACompatibility(b.index.BestHeader())
+	b.chainLock.Unlock()
+	return err
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+)
+
+// TestCheckHeaderSKACompatibility ensures header chains that lock in a stake
+// version higher than every known deployment version are detected as
+// incompatible with the local SKA configuration.
+func TestCheckHeaderSKACompatibility(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	svh := params.StakeValidationHeight
+	svi := params.StakeVersionInterval
+	knownVersion := currentDeploymentVersion(params)
+
+	// Generate enough nodes to reach stake validation height.
+	bc := newFakeChain(params)
+	node := bc.bestChain.Tip()
+	for i := int64(1); i <= svh; i++ {
+		node = newFakeNode(node, 0, 0, 0, time.Now())
+		bc.bestChain.SetTip(node)
+	}
+
+	// appendIntervals appends the provided number of stake version intervals
+	// with votes of the provided version to the chain.
+	appendIntervals := func(numIntervals int64, voteVersion uint32) {
+		for i := int64(0); i < svi*numIntervals; i++ {
+			sv := bc.calcStakeVersion(node)
+			node = newFakeNode(node, 3, sv, 0, time.Now())
+			appendFakeVotes(node, params.TicketsPerBlock, voteVersion, 0)
+			bc.bestChain.SetTip(node)
+		}
+	}
+
+	// A header chain that locks in the highest known deployment version is
+	// compatible.
+	appendIntervals(3, knownVersion)
+	if err := bc.checkHeaderSKACompatibility(node); err != nil {
+		t.Fatalf("unexpected error for known stake version %d: %v",
+			knownVersion, err)
+	}
+
+	// A header chain that locks in an unknown stake version is not.
+	appendIntervals(3, knownVersion+1)
+	err := bc.checkHeaderSKACompatibility(node)
+	if !errors.Is(err, ErrIncompatibleSKAConfig) {
+		t.Fatalf("unexpected error for unknown stake version %d -- got %v, "+
+			"want %v", knownVersion+1, err, ErrIncompatibleSKAConfig)
+	}
+}
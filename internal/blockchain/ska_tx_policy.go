@@ -0,0 +1,137 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// CheckSKATxLockPolicy ensures the passed transaction adheres to the expiry
+// and sequence lock rules configured in the chain parameters for the coin type
+// it transfers.  The block height is the height of the block the transaction
+// is, or would be, included in.
+//
+// The rules only apply to regular transactions that transfer an SKA coin type.
+// VAR transactions, coinbases, stake transactions, and SKA emission
+// transactions, which have their own dedicated expiry requirements, are not
+// affected.
+//
+// This function is used by both block validation and the mempool so that the
+// two always agree on which transactions are acceptable.
+func CheckSKATxLockPolicy(tx *wire.MsgTx, blockHeight int64, params *chaincfg.Params) error {
+	coinType := wire.GetPrimaryCoinType(tx)
+	if !coinType.IsSKA() || wire.IsSKAEmissionTransaction(tx) {
+		return nil
+	}
+	config := params.GetSKACoinConfig(coinType)
+	if config == nil {
+		return nil
+	}
+
+	// Enforce the expiry rules for the coin type.
+	if tx.Expiry != wire.NoExpiryValue {
+		if config.DisallowExpiry {
+			str := fmt.Sprintf("transaction sets expiry %d which is not "+
+				"allowed for coin type %v", tx.Expiry, coinType)
+			return ruleError(ErrSKAExpiryNotAllowed, str)
+		}
+		if config.MaxExpiryDelta != 0 &&
+			int64(tx.Expiry) > blockHeight+int64(config.MaxExpiryDelta) {
+
+			str := fmt.Sprintf("transaction expiry %d is more than the max "+
+				"allowed %d blocks beyond height %d for coin type %v",
+				tx.Expiry, config.MaxExpiryDelta, blockHeight, coinType)
+			return ruleError(ErrSKAExpiryTooFar, str)
+		}
+	}
+
+	// Relative lock times are only enforced for transaction versions 2 and
+	// higher, so there is nothing more to check for older versions.
+	if !config.DisallowSequenceLocks || tx.Version < 2 {
+		return nil
+	}
+	for txInIndex, txIn := range tx.TxIn {
+		if txIn.Sequence&wire.SequenceLockTimeDisabled == 0 {
+			str := fmt.Sprintf("transaction input %d enables a relative lock "+
+				"time which is not allowed for coin type %v", txInIndex,
+				coinType)
+			return ruleError(ErrSKASequenceLockNotAllowed, str)
+		}
+	}
+
+	return nil
+}
+
+// CheckTxOutCoinTypes ensures every output of the passed transaction uses
+// either VAR or an SKA coin type configured in the chain parameters.
+//
+// Outputs with unknown coin types would otherwise only be caught by the input
+// checks, which do not apply to transactions without real inputs such as
+// coinbases and SKA emissions, and are not accounted for by the block space
+// allocator.  Rejecting them outright ensures coin types that are introduced
+// by future parameter changes can never have been created beforehand.
+//
+// The rule is only enforced for blocks at or after the UnknownCoinTypeHeight
+// of the chain parameters.  Prior to it, the mempool rejects such outputs as
+// non-standard instead.
+func CheckTxOutCoinTypes(tx *wire.MsgTx, params *chaincfg.Params) error {
+	for txOutIndex, txOut := range tx.TxOut {
+		coinType := txOut.CoinType
+		if coinType == cointype.CoinTypeVAR {
+			continue
+		}
+		if params.GetSKACoinConfig(coinType) == nil {
+			str := fmt.Sprintf("transaction output %d uses unknown coin "+
+				"type %d", txOutIndex, coinType)
+			return ruleError(ErrUnknownCoinType, str)
+		}
+	}
+
+	return nil
+}
+
+// skaBurnOutputCoinType returns the coin type the passed output burns and
+// whether or not it is an SKA burn output.  Only version 0 scripts are burns
+// since scripts with other versions are not provably unspendable.
+func skaBurnOutputCoinType(txOut *wire.TxOut, params *chaincfg.Params) (cointype.CoinType, bool) {
+	if txOut.Version != 0 || !params.IsSKABurnScript(txOut.PkScript) {
+		return 0, false
+	}
+	return cointype.CoinType(txOut.PkScript[len(txOut.PkScript)-1]), true
+}
+
+// CheckSKABurnOutputs ensures every SKA burn output of the passed transaction
+// burns a positive amount of the coin type its script commits to.
+//
+// Burn outputs permanently reduce the supply of the coin type they burn, so
+// an output whose coin type differs from the one committed to by its script
+// would otherwise be accounted against the wrong coin type by anyone auditing
+// the script alone.
+func CheckSKABurnOutputs(tx *wire.MsgTx, params *chaincfg.Params) error {
+	for txOutIndex, txOut := range tx.TxOut {
+		burnCoinType, ok := skaBurnOutputCoinType(txOut, params)
+		if !ok {
+			continue
+		}
+		if txOut.CoinType != burnCoinType {
+			str := fmt.Sprintf("transaction output %d burns coin type %v "+
+				"with a script committing to coin type %v", txOutIndex,
+				txOut.CoinType, burnCoinType)
+			return ruleError(ErrSKABurnCoinTypeMismatch, str)
+		}
+		if txOut.Value <= 0 {
+			str := fmt.Sprintf("transaction output %d burns a non-positive "+
+				"amount %d of coin type %v", txOutIndex, txOut.Value,
+				burnCoinType)
+			return ruleError(ErrZeroSKABurn, str)
+		}
+	}
+
+	return nil
+}
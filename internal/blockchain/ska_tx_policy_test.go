@@ -0,0 +1,245 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/chaingen"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestCheckSKATxLockPolicy ensures the per-coin-type expiry and sequence lock
+// rules are enforced as configured in the chain parameters.
+func TestCheckSKATxLockPolicy(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	params.SKACoins[1].DisallowExpiry = true
+	params.SKACoins[1].DisallowSequenceLocks = true
+	params.SKACoins[2].MaxExpiryDelta = 10
+
+	const blockHeight = 1000
+	makeTx := func(coinType cointype.CoinType, version uint16, expiry, sequence uint32) *wire.MsgTx {
+		return &wire.MsgTx{
+			SerType: wire.TxSerializeFull,
+			Version: version,
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{Index: 0},
+				Sequence:         sequence,
+			}},
+			TxOut: []*wire.TxOut{{
+				Value:    1e8,
+				CoinType: coinType,
+				PkScript: []byte{0x51},
+			}},
+			Expiry: expiry,
+		}
+	}
+	const lockDisabled = wire.MaxTxInSequenceNum
+
+	tests := []struct {
+		name    string
+		tx      *wire.MsgTx
+		wantErr error
+	}{{
+		name:    "VAR tx with expiry and sequence lock",
+		tx:      makeTx(cointype.CoinTypeVAR, 2, blockHeight+100, 0),
+		wantErr: nil,
+	}, {
+		name:    "SKA-1 tx without expiry",
+		tx:      makeTx(1, 1, wire.NoExpiryValue, lockDisabled),
+		wantErr: nil,
+	}, {
+		name:    "SKA-1 tx with expiry",
+		tx:      makeTx(1, 1, blockHeight+1, lockDisabled),
+		wantErr: ErrSKAExpiryNotAllowed,
+	}, {
+		name:    "SKA-1 version 2 tx with sequence lock",
+		tx:      makeTx(1, 2, wire.NoExpiryValue, 0),
+		wantErr: ErrSKASequenceLockNotAllowed,
+	}, {
+		name:    "SKA-1 version 1 tx with sequence lock is not enforced",
+		tx:      makeTx(1, 1, wire.NoExpiryValue, 0),
+		wantErr: nil,
+	}, {
+		name:    "SKA-2 tx with expiry at max delta",
+		tx:      makeTx(2, 1, blockHeight+10, lockDisabled),
+		wantErr: nil,
+	}, {
+		name:    "SKA-2 tx with expiry beyond max delta",
+		tx:      makeTx(2, 1, blockHeight+11, lockDisabled),
+		wantErr: ErrSKAExpiryTooFar,
+	}, {
+		name:    "SKA-2 version 2 tx with sequence lock",
+		tx:      makeTx(2, 2, wire.NoExpiryValue, 0),
+		wantErr: nil,
+	}}
+
+	for _, test := range tests {
+		err := CheckSKATxLockPolicy(test.tx, blockHeight, params)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%q: mismatched err -- got %v, want %v", test.name, err,
+				test.wantErr)
+		}
+	}
+}
+
+// TestCheckTxOutCoinTypes ensures transactions with outputs of coin types that
+// are neither VAR nor configured SKA coin types are rejected.
+func TestCheckTxOutCoinTypes(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	params.SKACoins[2].Active = false
+
+	makeTx := func(coinTypes ...cointype.CoinType) *wire.MsgTx {
+		tx := &wire.MsgTx{SerType: wire.TxSerializeFull, Version: 1}
+		for _, coinType := range coinTypes {
+			tx.TxOut = append(tx.TxOut, &wire.TxOut{
+				Value:    1e8,
+				CoinType: coinType,
+				PkScript: []byte{0x51},
+			})
+		}
+		return tx
+	}
+
+	tests := []struct {
+		name    string
+		tx      *wire.MsgTx
+		wantErr error
+	}{{
+		name:    "VAR output",
+		tx:      makeTx(cointype.CoinTypeVAR),
+		wantErr: nil,
+	}, {
+		name:    "configured SKA outputs",
+		tx:      makeTx(cointype.CoinTypeVAR, 1, 2),
+		wantErr: nil,
+	}, {
+		name:    "unconfigured SKA output",
+		tx:      makeTx(99),
+		wantErr: ErrUnknownCoinType,
+	}, {
+		name:    "unconfigured SKA output after configured outputs",
+		tx:      makeTx(cointype.CoinTypeVAR, 1, cointype.CoinTypeMax),
+		wantErr: ErrUnknownCoinType,
+	}}
+
+	for _, test := range tests {
+		err := CheckTxOutCoinTypes(test.tx, params)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%q: mismatched err -- got %v, want %v", test.name, err,
+				test.wantErr)
+		}
+	}
+}
+
+// TestCheckSKABurnOutputs ensures SKA burn outputs must burn a positive amount
+// of the coin type committed to by their script.
+func TestCheckSKABurnOutputs(t *testing.T) {
+	params := chaincfg.SimNetParams()
+
+	burnScript := func(coinType cointype.CoinType) []byte {
+		script, err := params.CreateSKABurnScript(coinType)
+		if err != nil {
+			t.Fatalf("unable to create burn script: %v", err)
+		}
+		return script
+	}
+	makeTx := func(txOuts ...*wire.TxOut) *wire.MsgTx {
+		return &wire.MsgTx{SerType: wire.TxSerializeFull, Version: 1,
+			TxOut: txOuts}
+	}
+
+	tests := []struct {
+		name    string
+		tx      *wire.MsgTx
+		wantErr error
+	}{{
+		name: "burn of committed coin type",
+		tx: makeTx(&wire.TxOut{Value: 1e8, CoinType: 1,
+			PkScript: burnScript(1)}),
+		wantErr: nil,
+	}, {
+		name: "non-burn outputs",
+		tx: makeTx(&wire.TxOut{Value: 0, CoinType: 1,
+			PkScript: []byte{0x51}}),
+		wantErr: nil,
+	}, {
+		name: "burn script with non-zero script version is not a burn",
+		tx: makeTx(&wire.TxOut{Value: 1e8, CoinType: 2, Version: 1,
+			PkScript: burnScript(1)}),
+		wantErr: nil,
+	}, {
+		name: "burn of other coin type",
+		tx: makeTx(&wire.TxOut{Value: 1e8, CoinType: 2,
+			PkScript: burnScript(1)}),
+		wantErr: ErrSKABurnCoinTypeMismatch,
+	}, {
+		name: "burn of VAR",
+		tx: makeTx(&wire.TxOut{Value: 1e8, CoinType: cointype.CoinTypeVAR,
+			PkScript: burnScript(1)}),
+		wantErr: ErrSKABurnCoinTypeMismatch,
+	}, {
+		name: "zero amount burn after valid burn",
+		tx: makeTx(&wire.TxOut{Value: 1e8, CoinType: 1,
+			PkScript: burnScript(1)}, &wire.TxOut{Value: 0, CoinType: 2,
+			PkScript: burnScript(2)}),
+		wantErr: ErrZeroSKABurn,
+	}}
+
+	for _, test := range tests {
+		err := CheckSKABurnOutputs(test.tx, params)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%q: mismatched err -- got %v, want %v", test.name, err,
+				test.wantErr)
+		}
+	}
+}
+
+// TestSKABurnOutputsActivation ensures blocks with SKA burn outputs that burn a
+// coin type other than the one committed to by their script are only rejected
+// at or after the activation height of the rule.
+func TestSKABurnOutputsActivation(t *testing.T) {
+	params := chaincfg.RegNetParams()
+	params.SKABurnOutputHeight = params.StakeValidationHeight + 2
+	g := newChaingenHarness(t, params)
+	g.AdvanceToStakeValidationHeight()
+
+	burnScript, err := params.CreateSKABurnScript(1)
+	if err != nil {
+		t.Fatalf("unable to create burn script: %v", err)
+	}
+
+	// mismatchedBurn returns a munger that adds a transaction spending the
+	// provided output which includes a zero-value VAR output paying to an
+	// SKA-1 burn script.
+	mismatchedBurn := func(spend *chaingen.SpendableOut) func(*wire.MsgBlock) {
+		return func(b *wire.MsgBlock) {
+			tx := g.CreateSpendTx(spend, dcrutil.Amount(1))
+			tx.AddTxOut(&wire.TxOut{
+				Value:    0,
+				CoinType: cointype.CoinTypeVAR,
+				PkScript: burnScript,
+			})
+			b.AddTransaction(tx)
+		}
+	}
+
+	// Ensure a block prior to the activation height with a mismatched burn
+	// is accepted.
+	outs := g.OldestCoinbaseOuts()
+	g.NextBlock("bburn0", nil, outs[1:], mismatchedBurn(&outs[0]))
+	g.SaveTipCoinbaseOuts()
+	g.AcceptTipBlock()
+
+	// Ensure a block at the activation height with a mismatched burn is
+	// rejected.
+	outs = g.OldestCoinbaseOuts()
+	g.NextBlock("bburn1", nil, outs[1:], mismatchedBurn(&outs[0]))
+	g.RejectTipBlock(ErrSKABurnCoinTypeMismatch)
+}
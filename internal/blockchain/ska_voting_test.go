@@ -145,6 +145,9 @@ func TestSKA2EmissionTransactionValidation(t *testing.T) {
 				Index: 0xffffffff,
 			},
 			SignatureScript: []byte{0x01, 0x53, 0x4b, 0x41},
+			ValueIn:         wire.NullValueIn,
+			BlockHeight:     wire.NullBlockHeight,
+			BlockIndex:      wire.NullBlockIndex,
 		}},
 		TxOut: []*wire.TxOut{{
 			Value:    emissionAmount,
@@ -242,6 +245,9 @@ func TestSKA1EmissionWithoutVote(t *testing.T) {
 				Index: 0xffffffff,
 			},
 			SignatureScript: []byte{0x01, 0x53, 0x4b, 0x41},
+			ValueIn:         wire.NullValueIn,
+			BlockHeight:     wire.NullBlockHeight,
+			BlockIndex:      wire.NullBlockIndex,
 		}},
 		TxOut: []*wire.TxOut{{
 			Value:    emissionAmount,
@@ -379,12 +385,14 @@ func TestCoinTypeVoteMapping(t *testing.T) {
 		t.Run(test.expectedVoteID, func(t *testing.T) {
 			if test.requiresVoting {
 				expectedID := test.expectedVoteID
-				actualID := chaincfg.VoteIDActivateSKA2 // For SKA-2
-				if test.coinType == 2 {
-					if actualID != expectedID {
-						t.Errorf("Expected vote ID %s for coin type %d, got %s",
-							expectedID, test.coinType, actualID)
-					}
+				actualID := chaincfg.SKAActivationVoteID(test.coinType)
+				if actualID != expectedID {
+					t.Errorf("Expected vote ID %s for coin type %d, got %s",
+						expectedID, test.coinType, actualID)
+				}
+				if test.coinType == 2 && actualID != chaincfg.VoteIDActivateSKA2 {
+					t.Errorf("Vote ID %s does not match %s", actualID,
+						chaincfg.VoteIDActivateSKA2)
 				}
 			}
 		})
@@ -907,6 +907,42 @@ func (b *BlockChain) IsSubsidySplitR2AgendaActive(prevHash *chainhash.Hash) (boo
 	return isActive, err
 }
 
+// isInputCoinTypesAgendaActive returns whether or not the agenda which enables
+// transactions that explicitly declare the coin types of their inputs has
+// passed and is now active from the point of view of the passed block node.
+//
+// Unlike most agendas, the deployment is not defined by all networks, in
+// which case the agenda is never active.
+//
+// It is important to note that, as the variable name indicates, this function
+// expects the block node prior to the block for which the deployment state is
+// desired.  In other words, the returned deployment state is for the block
+// AFTER the passed node.
+//
+// This function MUST be called with the chain state lock held (for writes).
+func (b *BlockChain) isInputCoinTypesAgendaActive(prevNode *blockNode) (bool, error) {
+	const deploymentID = chaincfg.VoteIDInputCoinTypes
+	deployment, ok := b.deploymentData[deploymentID]
+	if !ok {
+		return false, nil
+	}
+
+	// NOTE: The choice field of the return threshold state is not examined
+	// here because there is only one possible choice that can be active for
+	// the agenda, which is yes, so there is no need to check it.
+	state := b.deploymentState(prevNode, &deployment)
+	return state.State == ThresholdActive, nil
+}
+
+// IsInputCoinTypesAgendaActive returns whether or not the agenda which enables
+// transactions that explicitly declare the coin types of their inputs has
+// passed and is now active for the block AFTER the given block.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) IsInputCoinTypesAgendaActive(prevHash *chainhash.Hash) (bool, error) {
+	return b.isAgendaActiveByHash(prevHash, b.isInputCoinTypesAgendaActive)
+}
+
 // VoteCounts is a compacted struct that is used to message vote counts.
 type VoteCounts struct {
 	Total        uint32
@@ -15,6 +15,7 @@ import (
 	"github.com/monetarium/monetarium-node/blockchain/standalone"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/wire"
 	"github.com/syndtr/goleveldb/leveldb"
 	ldberrors "github.com/syndtr/goleveldb/leveldb/errors"
@@ -165,12 +166,24 @@ type UtxoBackendInfo struct {
 }
 
 // UtxoStats represents unspent output statistics on the current utxo set.
+//
+// Total is the sum of the amounts of all unspent outputs regardless of their
+// coin type while CoinTypes breaks the outputs and their amounts down by coin
+// type.
 type UtxoStats struct {
 	Utxos          int64
 	Transactions   int64
 	Size           int64
 	Total          int64
 	SerializedHash chainhash.Hash
+	CoinTypes      map[cointype.CoinType]UtxoCoinTypeStats
+}
+
+// UtxoCoinTypeStats represents unspent output statistics for a single coin
+// type.
+type UtxoCoinTypeStats struct {
+	Utxos int64
+	Total int64
 }
 
 // UtxoBackend represents a persistent storage layer for the UTXO set.
@@ -194,6 +207,10 @@ type UtxoBackend interface {
 	// FetchStats returns statistics on the current UTXO set.
 	FetchStats() (*UtxoStats, error)
 
+	// FetchEntriesByCoinType returns all entries of the provided coin type in
+	// the current UTXO set keyed by their outpoints.
+	FetchEntriesByCoinType(coinType cointype.CoinType) (map[wire.OutPoint]*UtxoEntry, error)
+
 	// Get returns the value for the given key.  It returns nil if the key does
 	// not exist.  An empty slice is returned for keys that exist but have no
 	// value assigned.
@@ -528,7 +545,9 @@ func (l *levelDbUtxoBackend) FetchState() (*UtxoSetState, error) {
 
 // FetchStats returns statistics on the current UTXO set.
 func (l *levelDbUtxoBackend) FetchStats() (*UtxoStats, error) {
-	var stats UtxoStats
+	stats := UtxoStats{
+		CoinTypes: make(map[cointype.CoinType]UtxoCoinTypeStats),
+	}
 	transactions := make(map[chainhash.Hash]struct{})
 	leaves := make([]chainhash.Hash, 0)
 	iter := l.NewIterator(utxoPrefixUtxoSet)
@@ -574,6 +593,10 @@ func (l *levelDbUtxoBackend) FetchStats() (*UtxoStats, error) {
 		}
 
 		stats.Total += entry.amount
+		coinTypeStats := stats.CoinTypes[entry.coinType]
+		coinTypeStats.Utxos++
+		coinTypeStats.Total += entry.amount
+		stats.CoinTypes[entry.coinType] = coinTypeStats
 	}
 	if err := iter.Error(); err != nil {
 		return nil, convertLdbErr(err, "failed to fetch stats")
@@ -585,6 +608,55 @@ func (l *levelDbUtxoBackend) FetchStats() (*UtxoStats, error) {
 	return &stats, nil
 }
 
+// FetchEntriesByCoinType returns all entries of the provided coin type in the
+// current UTXO set keyed by their outpoints.
+func (l *levelDbUtxoBackend) FetchEntriesByCoinType(coinType cointype.CoinType) (map[wire.OutPoint]*UtxoEntry, error) {
+	entries := make(map[wire.OutPoint]*UtxoEntry)
+	iter := l.NewIterator(utxoPrefixUtxoSet)
+	defer iter.Release()
+
+	for iter.Next() {
+		key := iter.Key()
+		var outpoint wire.OutPoint
+		err := decodeOutpointKey(key, &outpoint)
+		if err != nil {
+			str := fmt.Sprintf("corrupt outpoint for key %x: %v", key, err)
+			return nil, contextError(ErrUtxoBackendCorruption, str)
+		}
+
+		// A non-nil zero-length entry means there is an entry in the database
+		// for a spent transaction output which should never be the case.
+		serializedUtxo := iter.Value()
+		if len(serializedUtxo) == 0 {
+			return nil, AssertError(fmt.Sprintf("database contains entry for "+
+				"spent tx output %v", outpoint))
+		}
+
+		// Deserialize the utxo entry.
+		entry, err := deserializeUtxoEntry(serializedUtxo, outpoint.Index)
+		if err != nil {
+			// Ensure any deserialization errors are returned as UTXO backend
+			// corruption errors.
+			if isDeserializeErr(err) {
+				str := fmt.Sprintf("corrupt utxo entry for %v: %v", outpoint,
+					err)
+				return nil, contextError(ErrUtxoBackendCorruption, str)
+			}
+
+			return nil, err
+		}
+
+		if entry.coinType == coinType {
+			entries[outpoint] = entry
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, convertLdbErr(err, "failed to fetch entries by coin type")
+	}
+
+	return entries, nil
+}
+
 // dbPutUtxoBackendInfo uses an existing UTXO backend transaction to store the
 // backend information.
 func (l *levelDbUtxoBackend) dbPutUtxoBackendInfo(tx UtxoBackendTx,
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/database"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/wire"
@@ -99,6 +100,15 @@ type UtxoCacher interface {
 	// FetchStats returns statistics on the current utxo set.
 	FetchStats(bestHash *chainhash.Hash, bestHeight uint32) (*UtxoStats, error)
 
+	// FetchEntriesByCoinType returns all entries of the provided coin type in
+	// the current utxo set keyed by their outpoints.
+	FetchEntriesByCoinType(bestHash *chainhash.Hash, bestHeight uint32,
+		coinType cointype.CoinType) (map[wire.OutPoint]*UtxoEntry, error)
+
+	// NewUtxoSetIterator flushes the cache and returns an iterator over the
+	// entries of the utxo set in the backend as of the flush.
+	NewUtxoSetIterator(bestHash *chainhash.Hash, bestHeight uint32) (UtxoBackendIterator, error)
+
 	// Initialize initializes the utxo cache and underlying utxo backend.  This
 	// entails running any database migrations as well as ensuring that the utxo
 	// set is caught up to the tip of the best chain.
@@ -537,6 +547,37 @@ func (c *UtxoCache) FetchStats(bestHash *chainhash.Hash, bestHeight uint32) (*Ut
 	return c.backend.FetchStats()
 }
 
+// FetchEntriesByCoinType returns all entries of the provided coin type in the
+// current utxo set keyed by their outpoints.
+func (c *UtxoCache) FetchEntriesByCoinType(bestHash *chainhash.Hash,
+	bestHeight uint32, coinType cointype.CoinType) (map[wire.OutPoint]*UtxoEntry, error) {
+
+	// Force a UTXO cache flush.  This is required in order for the backend to
+	// fetch the entries of the full UTXO set.
+	err := c.maybeFlushFn(bestHash, bestHeight, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.backend.FetchEntriesByCoinType(coinType)
+}
+
+// NewUtxoSetIterator flushes the cache and returns an iterator over the entries
+// of the utxo set in the backend.  The iterator operates on a snapshot of the
+// backend, so it is not affected by any flushes after it is created.
+//
+// The iterator must be released after use, by calling the Release method.
+func (c *UtxoCache) NewUtxoSetIterator(bestHash *chainhash.Hash, bestHeight uint32) (UtxoBackendIterator, error) {
+	// Force a UTXO cache flush.  This is required in order for the backend to
+	// contain the full UTXO set.
+	err := c.maybeFlushFn(bestHash, bestHeight, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.backend.NewIterator(utxoPrefixUtxoSet), nil
+}
+
 // Commit updates the cache based on the state of each entry in the provided
 // view.
 //
@@ -1079,6 +1120,41 @@ func (b *BlockChain) FetchUtxoEntry(outpoint wire.OutPoint) (*UtxoEntry, error)
 	return entry, err
 }
 
+// FetchUtxoEntries loads and returns the requested unspent transaction outputs
+// from the point of view of the main chain tip in the same order as the
+// provided outpoints.  All of the outputs are loaded in a single pass over the
+// utxo cache, so it is more efficient than calling FetchUtxoEntry for each of
+// them.
+//
+// NOTE: Requesting an output for which there is no data will NOT return an
+// error.  Instead the returned entry for it will be nil.  This is done to
+// allow pruning of spent transaction outputs.  In practice this means the
+// caller must check if each returned entry is nil before invoking methods on
+// it.
+//
+// This function is safe for concurrent access however the returned entries
+// are NOT.
+func (b *BlockChain) FetchUtxoEntries(outpoints []wire.OutPoint) ([]*UtxoEntry, error) {
+	filteredSet := make(ViewFilteredSet, len(outpoints))
+	for _, outpoint := range outpoints {
+		filteredSet[outpoint] = struct{}{}
+	}
+
+	view := NewUtxoViewpoint(b.utxoCache)
+	b.chainLock.RLock()
+	err := b.utxoCache.FetchEntries(filteredSet, view)
+	b.chainLock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*UtxoEntry, len(outpoints))
+	for i, outpoint := range outpoints {
+		entries[i] = view.LookupEntry(outpoint)
+	}
+	return entries, nil
+}
+
 // FetchUtxoStats returns statistics on the current utxo set.
 func (b *BlockChain) FetchUtxoStats() (*UtxoStats, error) {
 	tip := b.bestChain.Tip()
@@ -684,6 +684,44 @@ func TestFetchEntries(t *testing.T) {
 	}
 }
 
+// TestFetchUtxoEntries validates that the chain returns the requested entries
+// from both the cache and the backend in the order they were requested.
+func TestFetchUtxoEntries(t *testing.T) {
+	t.Parallel()
+
+	// Create a test backend along with a cache that contains one of the
+	// entries and the backend the other.
+	backend := createTestUtxoBackend(t)
+	outpoint299 := outpoint299()
+	outpoint1100, entry1100 := outpoint1100(), makeEntryStates(entry1100())
+	outpoint1200, entry1200 := outpoint1200(), makeEntryStates(entry1200())
+	utxoCache := createTestUtxoCache(t, map[wire.OutPoint]*UtxoEntry{
+		outpoint1100: entry1100.unmodified,
+	})
+	utxoCache.backend = backend
+	err := backend.PutUtxos(map[wire.OutPoint]*UtxoEntry{
+		outpoint1200: entry1200.modified,
+	}, &UtxoSetState{})
+	if err != nil {
+		t.Fatalf("unexpected error adding entries to test backend: %v", err)
+	}
+	chain := &BlockChain{utxoCache: utxoCache}
+
+	// Request the entries out of order with a duplicate and a missing entry.
+	outpoints := []wire.OutPoint{outpoint1200, outpoint299, outpoint1100,
+		outpoint1200}
+	entries, err := chain.FetchUtxoEntries(outpoints)
+	if err != nil {
+		t.Fatalf("unexpected error fetching entries: %v", err)
+	}
+	wantEntries := []*UtxoEntry{entry1200.unmodified, nil,
+		entry1100.unmodified, entry1200.unmodified}
+	if !reflect.DeepEqual(entries, wantEntries) {
+		t.Fatalf("mismatched entries:\nwant: %+v\n got: %+v\n", wantEntries,
+			entries)
+	}
+}
+
 // TestCommit validates that all entries in both the cache and the provided view
 // are updated appropriately when committing the provided view to the cache.
 func TestCommit(t *testing.T) {
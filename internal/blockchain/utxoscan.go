@@ -0,0 +1,119 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// UtxoScanTip describes the main chain tip the UTXO set iterated by
+// ScanUtxoSet corresponds to along with the SKA supply accounted for by the
+// chain state as of that tip.
+type UtxoScanTip struct {
+	Hash   chainhash.Hash
+	Height int64
+
+	// SKAEmitted houses the amounts emitted for every configured SKA coin type
+	// according to the emission tranches recorded in the chain state.
+	SKAEmitted map[cointype.CoinType]int64
+
+	// SKABurned houses the amounts burned for every configured SKA coin type
+	// according to the burn state.
+	SKABurned map[cointype.CoinType]int64
+}
+
+// ScanUtxoSet invokes the provided function with every entry of the UTXO set
+// as of the current main chain tip and returns the tip along with the SKA
+// supply the chain state accounts for as of it.
+//
+// The chain is only locked while the UTXO cache is flushed and a snapshot of
+// the backend is taken, so the scan may proceed as slowly as the caller likes
+// without blocking block processing.  Blocks connected while the scan is in
+// progress are not reflected by the scanned entries.
+//
+// Iteration stops early with the error returned by the provided function, or
+// with the error of the provided context once it is cancelled.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ScanUtxoSet(ctx context.Context, fn func(outpoint wire.OutPoint, entry *UtxoEntry) error) (*UtxoScanTip, error) {
+	b.chainLock.RLock()
+	tip := b.bestChain.Tip()
+	iter, err := b.utxoCache.NewUtxoSetIterator(&tip.hash, uint32(tip.height))
+	if err != nil {
+		b.chainLock.RUnlock()
+		return nil, err
+	}
+	scanTip := &UtxoScanTip{
+		Hash:       tip.hash,
+		Height:     tip.height,
+		SKAEmitted: make(map[cointype.CoinType]int64, len(b.chainParams.SKACoins)),
+		SKABurned:  make(map[cointype.CoinType]int64, len(b.chainParams.SKACoins)),
+	}
+	for coinType, config := range b.chainParams.SKACoins {
+		var emitted int64
+		tranches := b.SKAEmissionTranchesEmitted(coinType)
+		schedule := config.EmissionSchedule()
+		for i := range schedule {
+			if uint64(i) >= uint64(tranches) {
+				break
+			}
+			emitted += schedule[i].TotalAmount()
+		}
+		scanTip.SKAEmitted[coinType] = emitted
+		scanTip.SKABurned[coinType] = b.GetSKABurnedAmount(coinType)
+	}
+	b.chainLock.RUnlock()
+	defer iter.Release()
+
+	for iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		key := iter.Key()
+		var outpoint wire.OutPoint
+		err := decodeOutpointKey(key, &outpoint)
+		if err != nil {
+			str := fmt.Sprintf("corrupt outpoint for key %x: %v", key, err)
+			return nil, contextError(ErrUtxoBackendCorruption, str)
+		}
+
+		// A non-nil zero-length entry means there is an entry in the database
+		// for a spent transaction output which should never be the case.
+		serializedUtxo := iter.Value()
+		if len(serializedUtxo) == 0 {
+			return nil, AssertError(fmt.Sprintf("database contains entry for "+
+				"spent tx output %v", outpoint))
+		}
+
+		// Deserialize the utxo entry.
+		entry, err := deserializeUtxoEntry(serializedUtxo, outpoint.Index)
+		if err != nil {
+			// Ensure any deserialization errors are returned as UTXO backend
+			// corruption errors.
+			if isDeserializeErr(err) {
+				str := fmt.Sprintf("corrupt utxo entry for %v: %v", outpoint,
+					err)
+				return nil, contextError(ErrUtxoBackendCorruption, str)
+			}
+
+			return nil, err
+		}
+
+		if err := fn(outpoint, entry); err != nil {
+			return nil, err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, convertLdbErr(err, "failed to scan utxo set")
+	}
+
+	return scanTip, nil
+}
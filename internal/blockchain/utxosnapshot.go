@@ -0,0 +1,145 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// UtxoSnapshotEntry describes an unspent transaction output in a snapshot of
+// the UTXO set.
+type UtxoSnapshotEntry struct {
+	OutPoint      wire.OutPoint
+	BlockHeight   int64
+	Amount        int64
+	ScriptVersion uint16
+	PkScript      []byte
+}
+
+// UtxoSnapshot houses all unspent transaction outputs of a single coin type as
+// of a block in the main chain.
+//
+// The entries are sorted by the hash, tree, and index of their outpoints so
+// the snapshot of a given coin type at a given block is always identical
+// regardless of the order in which the outputs were created and spent.
+type UtxoSnapshot struct {
+	Hash     chainhash.Hash
+	Height   int64
+	CoinType cointype.CoinType
+	Total    int64
+	Entries  []UtxoSnapshotEntry
+}
+
+// FetchUtxoSnapshot returns all unspent transaction outputs of the provided
+// coin type as of the main chain block at the provided height.
+//
+// The unspent outputs as of the current tip are loaded from the UTXO set and
+// the blocks after the requested height are then disconnected from them with
+// the spent transaction outputs recorded in the spend journal, so the cost of
+// the snapshot grows with the number of outputs of the coin type as well as the
+// depth of the requested block.  The chain is locked while the snapshot is
+// created so that the UTXO set remains consistent with the tip.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchUtxoSnapshot(coinType cointype.CoinType, height int64) (*UtxoSnapshot, error) {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	tip := b.bestChain.Tip()
+	target := b.bestChain.NodeByHeight(height)
+	if target == nil {
+		str := fmt.Sprintf("no block at height %d exists", height)
+		return nil, errNotInMainChain(str)
+	}
+
+	entries, err := b.utxoCache.FetchEntriesByCoinType(&tip.hash,
+		uint32(tip.height), coinType)
+	if err != nil {
+		return nil, err
+	}
+	view := NewUtxoViewpoint(b.utxoCache)
+	for outpoint, entry := range entries {
+		view.entries[outpoint] = entry
+	}
+	view.SetBestHash(&tip.hash)
+
+	// Disconnect the blocks after the requested one from the view in the same
+	// way they are disconnected during a reorganization.  Outputs of other coin
+	// types are loaded into the view as needed to do so, but they are ignored
+	// when the snapshot is assembled.
+	var nextBlock *dcrutil.Block
+	for n := tip; n != target; n = n.parent {
+		block := nextBlock
+		if block == nil {
+			block, err = b.fetchMainChainBlockByNode(n)
+			if err != nil {
+				return nil, err
+			}
+		}
+		parent, err := b.fetchMainChainBlockByNode(n.parent)
+		if err != nil {
+			return nil, err
+		}
+		nextBlock = parent
+
+		isTreasuryEnabled, err := b.isTreasuryAgendaActive(n.parent)
+		if err != nil {
+			return nil, err
+		}
+
+		var stxos []spentTxOut
+		err = b.db.View(func(dbTx database.Tx) error {
+			stxos, err = dbFetchSpendJournalEntry(dbTx, block, isTreasuryEnabled)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = view.disconnectBlock(block, parent, stxos, isTreasuryEnabled)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := &UtxoSnapshot{
+		Hash:     target.hash,
+		Height:   target.height,
+		CoinType: coinType,
+	}
+	for outpoint, entry := range view.entries {
+		if entry == nil || entry.IsSpent() || entry.CoinType() != coinType {
+			continue
+		}
+		snapshot.Total += entry.Amount()
+		snapshot.Entries = append(snapshot.Entries, UtxoSnapshotEntry{
+			OutPoint:      outpoint,
+			BlockHeight:   entry.BlockHeight(),
+			Amount:        entry.Amount(),
+			ScriptVersion: entry.ScriptVersion(),
+			PkScript:      entry.PkScript(),
+		})
+	}
+	sort.Slice(snapshot.Entries, func(i, j int) bool {
+		a, b := &snapshot.Entries[i].OutPoint, &snapshot.Entries[j].OutPoint
+		if cmp := bytes.Compare(a.Hash[:], b.Hash[:]); cmp != 0 {
+			return cmp < 0
+		}
+		if a.Tree != b.Tree {
+			return a.Tree < b.Tree
+		}
+		return a.Index < b.Index
+	})
+
+	return snapshot, nil
+}
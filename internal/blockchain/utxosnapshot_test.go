@@ -0,0 +1,134 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestFetchUtxoSnapshot ensures snapshots of the UTXO set as of blocks prior
+// to the current tip match the UTXO set as it was when those blocks were the
+// tip, including when the regular tree of a block was disapproved.
+func TestFetchUtxoSnapshot(t *testing.T) {
+	params := chaincfg.RegNetParams()
+	g := newChaingenHarness(t, params)
+	g.AdvanceToStakeValidationHeight()
+
+	// expected houses the UTXO set statistics of the VAR coin type recorded
+	// while each block was the tip.
+	type expected struct {
+		utxos int64
+		total int64
+	}
+	expectedByHeight := make(map[int64]expected)
+	recordTip := func() {
+		t.Helper()
+		stats, err := g.chain.FetchUtxoStats()
+		if err != nil {
+			t.Fatalf("unexpected error fetching utxo stats: %v", err)
+		}
+		coinTypeStats := stats.CoinTypes[cointype.CoinTypeVAR]
+		expectedByHeight[g.chain.BestSnapshot().Height] = expected{
+			utxos: coinTypeStats.Utxos,
+			total: coinTypeStats.Total,
+		}
+	}
+	recordTip()
+
+	// Create blocks that spend coinbase outputs, including one whose regular
+	// tree is disapproved by the next block.
+	const vbDisapprovePrev = 0x0000
+	const vbApprovePrev = 0x0001
+	for i := uint16(0); i < params.CoinbaseMaturity+3; i++ {
+		outs := g.OldestCoinbaseOuts()
+		blockName := fmt.Sprintf("bsnap%d", i)
+		var mungers []func(*wire.MsgBlock)
+		if i == params.CoinbaseMaturity+1 {
+			mungers = append(mungers, g.ReplaceVoteBits(vbDisapprovePrev),
+				func(b *wire.MsgBlock) {
+					b.Header.VoteBits &^= vbApprovePrev
+				})
+		}
+		mungers = append(mungers, func(b *wire.MsgBlock) {
+			spend := outs[0]
+			tx := g.CreateSpendTx(&spend, dcrutil.Amount(1))
+			b.AddTransaction(tx)
+		})
+		g.NextBlock(blockName, nil, outs[1:], mungers...)
+		g.SaveTipCoinbaseOuts()
+		g.AcceptTipBlock()
+		recordTip()
+	}
+
+	for height, want := range expectedByHeight {
+		snapshot, err := g.chain.FetchUtxoSnapshot(cointype.CoinTypeVAR, height)
+		if err != nil {
+			t.Fatalf("unexpected error fetching snapshot at height %d: %v",
+				height, err)
+		}
+		header, err := g.chain.HeaderByHeight(height)
+		if err != nil {
+			t.Fatalf("unexpected error fetching header at height %d: %v",
+				height, err)
+		}
+		if snapshot.Hash != header.BlockHash() || snapshot.Height != height {
+			t.Fatalf("mismatched snapshot block at height %d -- got %v (%d), "+
+				"want %v", height, snapshot.Hash, snapshot.Height,
+				header.BlockHash())
+		}
+		if int64(len(snapshot.Entries)) != want.utxos {
+			t.Fatalf("mismatched number of utxos at height %d -- got %d, "+
+				"want %d", height, len(snapshot.Entries), want.utxos)
+		}
+		if snapshot.Total != want.total {
+			t.Fatalf("mismatched total at height %d -- got %d, want %d",
+				height, snapshot.Total, want.total)
+		}
+
+		// Ensure the entries are sorted by outpoint and sum to the total.
+		var total int64
+		for i, entry := range snapshot.Entries {
+			total += entry.Amount
+			if i == 0 {
+				continue
+			}
+			prev := snapshot.Entries[i-1].OutPoint
+			cmp := bytes.Compare(prev.Hash[:], entry.OutPoint.Hash[:])
+			if cmp > 0 || (cmp == 0 && (prev.Tree > entry.OutPoint.Tree ||
+				(prev.Tree == entry.OutPoint.Tree &&
+					prev.Index >= entry.OutPoint.Index))) {
+
+				t.Fatalf("unsorted snapshot entries at height %d: %v "+
+					"before %v", height, prev, entry.OutPoint)
+			}
+		}
+		if total != snapshot.Total {
+			t.Fatalf("mismatched entry total at height %d -- got %d, "+
+				"want %d", height, total, snapshot.Total)
+		}
+	}
+
+	// Ensure snapshots of coin types without outputs are empty and requesting
+	// a snapshot beyond the tip fails.
+	tipHeight := g.chain.BestSnapshot().Height
+	snapshot, err := g.chain.FetchUtxoSnapshot(1, tipHeight)
+	if err != nil {
+		t.Fatalf("unexpected error fetching SKA snapshot: %v", err)
+	}
+	if len(snapshot.Entries) != 0 || snapshot.Total != 0 {
+		t.Fatalf("unexpected SKA snapshot entries: %d", len(snapshot.Entries))
+	}
+	_, err = g.chain.FetchUtxoSnapshot(cointype.CoinTypeVAR, tipHeight+1)
+	if !isNotInMainChainErr(err) {
+		t.Fatalf("unexpected error for snapshot beyond tip: %v", err)
+	}
+}
@@ -290,6 +290,13 @@ const (
 	// agenda being active are applied.
 	AFSubsidySplitR2Enabled
 
+	// AFInputCoinTypesEnabled may be set to indicate that the agenda which
+	// enables transactions that explicitly declare the coin types of their
+	// inputs should be considered as active when checking a transaction so
+	// that any additional checks which depend on the agenda being active are
+	// applied.
+	AFInputCoinTypesEnabled
+
 	// AFNone is a convenience value to specifically indicate no flags.
 	AFNone AgendaFlags = 0
 )
@@ -324,6 +331,13 @@ func (flags AgendaFlags) IsSubsidySplitR2Enabled() bool {
 	return flags&AFSubsidySplitR2Enabled == AFSubsidySplitR2Enabled
 }
 
+// IsInputCoinTypesEnabled returns whether the flags indicate that the agenda
+// which enables transactions that explicitly declare the coin types of their
+// inputs is enabled.
+func (flags AgendaFlags) IsInputCoinTypesEnabled() bool {
+	return flags&AFInputCoinTypesEnabled == AFInputCoinTypesEnabled
+}
+
 // determineCheckTxFlags returns the flags to use when checking transactions
 // based on the agendas that are active as of the block AFTER the given node.
 func (b *BlockChain) determineCheckTxFlags(prevNode *blockNode) (AgendaFlags, error) {
@@ -361,6 +375,13 @@ func (b *BlockChain) determineCheckTxFlags(prevNode *blockNode) (AgendaFlags, er
 		return 0, err
 	}
 
+	// Determine if the input coin types agenda is active as of the block being
+	// checked.
+	isInputCoinTypesEnabled, err := b.isInputCoinTypesAgendaActive(prevNode)
+	if err != nil {
+		return 0, err
+	}
+
 	// Create and return agenda flags for checking transactions based on which
 	// ones are active as of the block being checked.
 	checkTxFlags := AFNone
@@ -379,6 +400,9 @@ func (b *BlockChain) determineCheckTxFlags(prevNode *blockNode) (AgendaFlags, er
 	if isSubsidySplitR2Enabled {
 		checkTxFlags |= AFSubsidySplitR2Enabled
 	}
+	if isInputCoinTypesEnabled {
+		checkTxFlags |= AFInputCoinTypesEnabled
+	}
 	return checkTxFlags, nil
 }
 
@@ -395,6 +419,7 @@ func checkTransactionContext(tx *wire.MsgTx, params *chaincfg.Params, flags Agen
 	isTreasuryEnabled := flags.IsTreasuryEnabled()
 	explicitUpgradesActive := flags.IsExplicitVerUpgradesEnabled()
 	isAutoRevocationsEnabled := flags.IsAutoRevocationsEnabled()
+	isInputCoinTypesEnabled := flags.IsInputCoinTypesEnabled()
 
 	// Reject transaction versions greater than the highest currently supported
 	// version.  Any future consensus changes that result in hard-forking
@@ -406,8 +431,15 @@ func checkTransactionContext(tx *wire.MsgTx, params *chaincfg.Params, flags Agen
 	// Note that prior to the explicit version upgrades agenda, transaction
 	// versions are allowed to go up to a max uint16, so fall back to that value
 	// accordingly.
+	//
+	// Also note that the input coin types agenda is intentionally only defined
+	// by the simulation and regression test networks for now, so it never
+	// becomes active on the main and test networks.  See the documentation of
+	// chaincfg.VoteIDInputCoinTypes for details.
 	maxAllowedTxVer := ^uint16(0)
 	switch {
+	case isInputCoinTypesEnabled:
+		maxAllowedTxVer = wire.TxVersionInputCoinTypes
 	case explicitUpgradesActive:
 		maxAllowedTxVer = 3
 	}
@@ -417,6 +449,24 @@ func checkTransactionContext(tx *wire.MsgTx, params *chaincfg.Params, flags Agen
 		return ruleError(ErrTxVersionTooHigh, str)
 	}
 
+	// Inputs that do not spend an output, such as stakebase inputs, must
+	// declare the VAR coin type in transactions that declare the coin types of
+	// their inputs since there is no output to validate it against.  The
+	// declared coin types of all other inputs are validated against the
+	// outputs they spend along with the rest of the input checks.
+	if tx.Version >= wire.TxVersionInputCoinTypes {
+		for txInIdx, txIn := range tx.TxIn {
+			if txIn.PreviousOutPoint.Index == wire.MaxPrevOutIndex &&
+				txIn.CoinType != cointype.CoinTypeVAR {
+
+				str := fmt.Sprintf("input %d does not spend an output and "+
+					"declares coin type %d instead of %d", txInIdx,
+					txIn.CoinType, cointype.CoinTypeVAR)
+				return ruleError(ErrTxInCoinTypeMismatch, str)
+			}
+		}
+	}
+
 	// Determine type.
 	var isCoinBase, isVote, isTicket, isRevocation bool
 	var isTreasuryBase, isTreasuryAdd, isTreasurySpend bool
@@ -1005,6 +1055,14 @@ func checkBlockSanity(block *dcrutil.Block, timeSource MedianTimeSource, flags B
 		}
 	}
 
+	// SKA emissions must only appear in the regular transaction tree where
+	// they must immediately follow the coinbase so their position in the block
+	// is deterministic once the rule is active.
+	err = checkSKAEmissionPositions(msgBlock, chainParams)
+	if err != nil {
+		return err
+	}
+
 	// A block header must commit to the actual number of tickets purchases that
 	// are in the block.
 	if int64(header.FreshStake) != totalTickets {
@@ -1031,6 +1089,46 @@ func checkBlockSanity(block *dcrutil.Block, timeSource MedianTimeSource, flags B
 	return nil
 }
 
+// checkSKAEmissionPositions ensures any SKA emission transactions in the
+// provided block are in the regular transaction tree and immediately follow
+// the coinbase, meaning only other SKA emissions may precede them after it.
+//
+// The rule is only enforced for blocks at or after the
+// SKAEmissionPositionHeight of the chain parameters.
+func checkSKAEmissionPositions(msgBlock *wire.MsgBlock, chainParams *chaincfg.Params) error {
+	height := int64(msgBlock.Header.Height)
+	if !chainParams.EnforcesSKAEmissionPositions(height) {
+		return nil
+	}
+
+	for txIdx, stx := range msgBlock.STransactions {
+		if wire.IsSKAEmissionTransaction(stx) {
+			str := fmt.Sprintf("block contains an SKA emission transaction in "+
+				"the stake transaction tree at index %d", txIdx)
+			return ruleError(ErrSKAEmissionInStakeTree, str)
+		}
+	}
+
+	var seenNonEmission bool
+	for txIdx, tx := range msgBlock.Transactions {
+		// The first transaction is the coinbase which is verified separately.
+		if txIdx == 0 {
+			continue
+		}
+		if !wire.IsSKAEmissionTransaction(tx) {
+			seenNonEmission = true
+			continue
+		}
+		if seenNonEmission {
+			str := fmt.Sprintf("block contains an SKA emission transaction at "+
+				"index %d of the regular transaction tree that does not "+
+				"immediately follow the coinbase", txIdx)
+			return ruleError(ErrBadSKAEmissionPosition, str)
+		}
+	}
+	return nil
+}
+
 // CheckBlockSanity performs some preliminary checks on a block to ensure it is
 // sane before continuing with block processing.  These checks are context
 // free.
@@ -1393,7 +1491,9 @@ func (b *BlockChain) checkBlockHeaderPositional(header *wire.BlockHeader, prevNo
 // available.
 //
 // The flags modify the behavior of this function as follows:
-//   - BFFastAdd: The transactions are not checked to see if they are expired.
+//   - BFFastAdd: The transactions are not checked to see if they are expired,
+//     violate the per-coin-type expiry and sequence lock rules, or create
+//     outputs of deactivated SKA coin types.
 //
 // This function MUST be called with the chain state lock held (for reads).
 func (b *BlockChain) checkBlockDataPositional(block *dcrutil.Block, prevNode *blockNode, flags BehaviorFlags) error {
@@ -1416,6 +1516,20 @@ func (b *BlockChain) checkBlockDataPositional(block *dcrutil.Block, prevNode *bl
 					tx.MsgTx().Expiry)
 				return ruleError(ErrExpiredTx, str)
 			}
+
+			// Ensure the transaction adheres to the expiry and sequence
+			// lock rules for its coin type.
+			err := CheckSKATxLockPolicy(tx.MsgTx(), blockHeight, b.chainParams)
+			if err != nil {
+				return err
+			}
+
+			// Ensure the transaction does not create outputs of SKA coin
+			// types that have been deactivated.
+			err = b.checkSKADeactivatedOutputs(tx.MsgTx(), prevNode)
+			if err != nil {
+				return err
+			}
 		}
 		for _, stx := range block.STransactions() {
 			if IsExpired(stx, blockHeight) {
@@ -2043,6 +2157,15 @@ func (b *BlockChain) checkBlockContext(block *dcrutil.Block, prevNode *blockNode
 		}
 	}
 
+	// Outputs that use a coin type which is neither VAR nor an SKA coin type
+	// configured for the network are invalid once the rule that rejects them
+	// is active regardless of the transaction type.
+	rejectUnknownCoinTypes := b.chainParams.RejectsUnknownCoinTypes(blockHeight)
+
+	// SKA burn outputs must burn a positive amount of the coin type committed
+	// to by their script once the rule that enforces it is active.
+	enforceSKABurnOutputs := b.chainParams.EnforcesSKABurnOutputs(blockHeight)
+
 	for txIdx, tx := range msgBlock.Transactions {
 		// Perform additional contextual validation checks on each regular
 		// transaction.
@@ -2050,6 +2173,16 @@ func (b *BlockChain) checkBlockContext(block *dcrutil.Block, prevNode *blockNode
 		if err != nil {
 			return err
 		}
+		if rejectUnknownCoinTypes {
+			if err := CheckTxOutCoinTypes(tx, b.chainParams); err != nil {
+				return err
+			}
+		}
+		if enforceSKABurnOutputs {
+			if err := CheckSKABurnOutputs(tx, b.chainParams); err != nil {
+				return err
+			}
+		}
 
 		// A block must not have more than one coinbase.
 		if txIdx > 0 && standalone.IsCoinBaseTx(tx, isTreasuryEnabled) {
@@ -2075,6 +2208,16 @@ func (b *BlockChain) checkBlockContext(block *dcrutil.Block, prevNode *blockNode
 		if err != nil {
 			return err
 		}
+		if rejectUnknownCoinTypes {
+			if err := CheckTxOutCoinTypes(stx, b.chainParams); err != nil {
+				return err
+			}
+		}
+		if enforceSKABurnOutputs {
+			if err := CheckSKABurnOutputs(stx, b.chainParams); err != nil {
+				return err
+			}
+		}
 
 		// A block must not have more than one treasurybase when the treasury
 		// agenda is active.
@@ -2297,10 +2440,12 @@ func (b *BlockChain) checkBlockContext(block *dcrutil.Block, prevNode *blockNode
 
 		// Enforce per-coin-type block space allocation using the same
 		// allocator logic as the mining module to ensure consistency.
+		allocationStart := time.Now()
 		err = b.validateBlockSpaceAllocation(block, maxBlockSize, prevNode)
 		if err != nil {
 			return err
 		}
+		b.recordValidationStage(VSAllocationChecks, time.Since(allocationStart))
 
 		// The calculated merkle root(s) of the transaction trees must match
 		// the associated entries in the header.
@@ -2418,10 +2563,12 @@ func (b *BlockChain) checkBlockContext(block *dcrutil.Block, prevNode *blockNode
 
 	// Validate SKA emission rules for this block
 	// Pass prevNode instead of blockHeight to avoid lock re-acquisition
+	emissionStart := time.Now()
 	err = CheckSKAEmissionInBlock(block, prevNode, b, b.chainParams)
 	if err != nil {
 		return err
 	}
+	b.recordValidationStage(VSEmissionChecks, time.Since(emissionStart))
 
 	return nil
 }
@@ -2429,91 +2576,37 @@ func (b *BlockChain) checkBlockContext(block *dcrutil.Block, prevNode *blockNode
 // validateBlockSpaceAllocation ensures that the block respects per-coin-type
 // space allocation limits using the same allocation logic as mining.
 func (b *BlockChain) validateBlockSpaceAllocation(block *dcrutil.Block, maxBlockSize int64, prevNode *blockNode) error {
-	// Create allocator using the standard block allocation logic
-	allocator := blockalloc.NewBlockSpaceAllocator(uint32(maxBlockSize), b.chainParams)
-
-	// Helper function to get coin type from transaction
-	getCoinType := func(tx *dcrutil.Tx) cointype.CoinType {
-		msgTx := tx.MsgTx()
-
-		// Special cases for transactions with no real outputs
-		isTreasuryActive, _ := b.isTreasuryAgendaActive(prevNode)
-		if standalone.IsCoinBaseTx(msgTx, isTreasuryActive) {
-			return cointype.CoinTypeVAR // Coinbase is always VAR
-		}
-		if stake.IsTreasuryBase(msgTx) {
-			return cointype.CoinTypeVAR // Treasurybase is always VAR
-		}
-		if wire.IsSKAEmissionTransaction(msgTx) {
-			// SKA emission - get type from first output
-			if len(msgTx.TxOut) > 0 {
-				return msgTx.TxOut[0].CoinType
+	// Create allocator using the standard block allocation logic along with
+	// the version of the algorithm that applies to the block.
+	allocator := blockalloc.NewBlockSpaceAllocator(uint32(maxBlockSize),
+		b.chainParams).ForHeight(block.Height())
+
+	// Measure actual space usage per coin type.
+	isTreasuryActive, _ := b.isTreasuryAgendaActive(prevNode)
+	spaceUsed := blockalloc.BlockSpaceUsage(block.MsgBlock(), isTreasuryActive)
+
+	// Transactions with unknown coin types are rejected by the transaction
+	// checks once the rule that rejects them is active, so this should never
+	// happen, but don't allow them to escape the allocation limits if it does.
+	// Prior to that, they are not accounted for by the allocation.
+	if b.chainParams.RejectsUnknownCoinTypes(block.Height()) {
+		for _, coinType := range cointype.SortedKeys(spaceUsed) {
+			if coinType != cointype.CoinTypeVAR &&
+				b.chainParams.GetSKACoinConfig(coinType) == nil {
+
+				return ruleError(ErrUnknownCoinType, fmt.Sprintf(
+					"block contains transactions with unknown coin type %d",
+					coinType))
 			}
-			return cointype.CoinTypeVAR // Fallback
-		}
-		if stake.DetermineTxType(msgTx) == stake.TxTypeSSFee {
-			// SSFee - get type from first non-OP_RETURN output
-			for _, out := range msgTx.TxOut {
-				if len(out.PkScript) > 0 && out.PkScript[0] == txscript.OP_RETURN {
-					continue
-				}
-				return out.CoinType
-			}
-			return cointype.CoinTypeVAR // Fallback
-		}
-
-		// For regular transactions, determine coin type from outputs
-		// (all outputs must have the same coin type due to earlier validation)
-		if len(msgTx.TxOut) > 0 {
-			return msgTx.TxOut[0].CoinType
-		}
-
-		return cointype.CoinTypeVAR // Default to VAR if no outputs
-	}
-
-	// Measure actual space usage per coin type
-	spaceUsed := make(map[cointype.CoinType]uint32)
-
-	// Count space in regular tree
-	for _, tx := range block.Transactions() {
-		coinType := getCoinType(tx)
-		txSize := uint32(tx.MsgTx().SerializeSize())
-		spaceUsed[coinType] += txSize
-	}
-
-	// Count space in stake tree
-	for _, tx := range block.STransactions() {
-		coinType := getCoinType(tx)
-		txSize := uint32(tx.MsgTx().SerializeSize())
-		spaceUsed[coinType] += txSize
-	}
-
-	// Use allocator to determine what's allowed (with spillover logic)
-	allocation := allocator.AllocateBlockSpace(spaceUsed)
-
-	// Validate each coin type respects its final allocation
-	for coinType, used := range spaceUsed {
-		coinAlloc := allocation.GetAllocationForCoinType(coinType)
-		if coinAlloc == nil {
-			// No allocation for this coin type - shouldn't happen but be safe
-			continue
-		}
-
-		if used > coinAlloc.FinalAllocation {
-			return ruleError(ErrBlockTooBig, fmt.Sprintf(
-				"%s transactions exceed allocation: used %d bytes > max %d bytes",
-				coinType.String(), used, coinAlloc.FinalAllocation))
 		}
 	}
 
-	// Also check total block size as a safety check
-	if allocation.TotalUsed > uint32(maxBlockSize) {
-		return ruleError(ErrBlockTooBig, fmt.Sprintf(
-			"block exceeds maximum size: used %d bytes > max %d bytes",
-			allocation.TotalUsed, maxBlockSize))
+	// Validate each coin type respects its final allocation (with spillover
+	// logic) and the block respects the maximum size.
+	if err := allocator.CheckSpaceUsage(spaceUsed); err != nil {
+		return ruleError(ErrBlockTooBig, err.Error())
 	}
 
-	// Validation passed - block respects per-coin-type allocations with spillover
 	return nil
 }
 
@@ -2896,7 +2989,8 @@ func calculateVoterStake(voteTx *wire.MsgTx) int64 {
 	return stake
 }
 
-// extractHash160FromPkScript extracts the hash160 from a P2PKH or P2SH script.
+// extractHash160FromPkScript extracts the hash160 from a P2PKH or P2SH script
+// that is optionally OP_SSGEN-tagged.
 // P2PKH: OP_DUP OP_HASH160 OP_DATA_20 <hash160> OP_EQUALVERIFY OP_CHECKSIG (25 bytes)
 // P2SH:  OP_HASH160 OP_DATA_20 <hash160> OP_EQUAL (23 bytes)
 // Returns the 20-byte hash160 or an error if the script format is unsupported.
@@ -2913,6 +3007,19 @@ func extractHash160FromPkScript(pkScript []byte) ([]byte, error) {
 		return hash160, nil
 	}
 
+	// OP_SSGEN-tagged P2SH: 24 bytes (used by SSFee outputs paying to
+	// multisig consolidation addresses)
+	// OP_SSGEN OP_HASH160 OP_DATA_20 <hash160> OP_EQUAL
+	if len(pkScript) == 24 &&
+		pkScript[0] == txscript.OP_SSGEN &&
+		pkScript[1] == txscript.OP_HASH160 &&
+		pkScript[2] == 0x14 && // OP_DATA_20
+		pkScript[23] == txscript.OP_EQUAL {
+		hash160 := make([]byte, 20)
+		copy(hash160, pkScript[3:23])
+		return hash160, nil
+	}
+
 	// P2PKH: 25 bytes
 	if len(pkScript) == 25 &&
 		pkScript[0] == txscript.OP_DUP &&
@@ -3843,7 +3950,7 @@ func CheckTransactionInputs(subsidyCache *standalone.SubsidyCache,
 	// -------------------------------------------------------------------
 
 	txHash := tx.Hash()
-	var totalAtomIn int64
+	var totalAtomIn, totalSKAIn int64
 	for idx, txIn := range msgTx.TxIn {
 		// Inputs won't exist for stakebase tx, so ignore them.
 		if isVote && idx == 0 {
@@ -3873,6 +3980,18 @@ func CheckTransactionInputs(subsidyCache *standalone.SubsidyCache,
 			return 0, ruleError(ErrMissingTxOut, str)
 		}
 
+		// Ensure the coin type declared by the input matches the coin type
+		// of the output it spends for transaction versions that declare it.
+		if msgTx.Version >= wire.TxVersionInputCoinTypes &&
+			txIn.CoinType != utxoEntry.CoinType() {
+
+			str := fmt.Sprintf("input %d of transaction %s declares coin "+
+				"type %d, but the output %v it spends has coin type %d",
+				idx, txHash, txIn.CoinType, txInOutpoint,
+				utxoEntry.CoinType())
+			return 0, ruleError(ErrTxInCoinTypeMismatch, str)
+		}
+
 		// Check fraud proof witness data.
 
 		// Using zero value outputs as inputs is banned.
@@ -3911,18 +4030,20 @@ func CheckTransactionInputs(subsidyCache *standalone.SubsidyCache,
 		}
 
 		// Ensure the transaction is not spending coins which have not
-		// yet reached the required coinbase maturity.
+		// yet reached the required coinbase maturity for their coin type.
 		coinbaseMaturity := int64(chainParams.CoinbaseMaturity)
 		if utxoEntry.IsCoinBase() {
 			originHeight := utxoEntry.BlockHeight()
 			blocksSincePrev := txHeight - originHeight
-			if blocksSincePrev < coinbaseMaturity {
+			reqMaturity := int64(chainParams.CoinbaseMaturityForCoinType(
+				utxoEntry.CoinType()))
+			if blocksSincePrev < reqMaturity {
 				str := fmt.Sprintf("tx %v tried to spend "+
 					"coinbase transaction %v from height "+
 					"%v at height %v before required "+
 					"maturity of %v blocks", txHash,
 					txInHash, originHeight, txHeight,
-					coinbaseMaturity)
+					reqMaturity)
 				return 0, ruleError(ErrImmatureSpend, str)
 			}
 		}
@@ -4000,17 +4121,27 @@ func CheckTransactionInputs(subsidyCache *standalone.SubsidyCache,
 				}
 			}
 
+			// SSFee outputs are coinbase-like fee distributions, so they
+			// mature according to the coinbase maturity of their coin type.
+			reqMaturity := reqStakeOutMaturity
+			if isTreasuryEnabled &&
+				utxoEntry.TransactionType() == stake.TxTypeSSFee {
+
+				reqMaturity = int64(chainParams.CoinbaseMaturityForCoinType(
+					utxoEntry.CoinType()))
+			}
+
 			// Apply maturity check unless this is an augmented SSFee
 			if !isAugmentedSSFee {
 				originHeight := utxoEntry.BlockHeight()
 				blocksSincePrev := txHeight - originHeight
-				if blocksSincePrev < reqStakeOutMaturity {
+				if blocksSincePrev < reqMaturity {
 					str := fmt.Sprintf("tried to spend OP_SSGEN or"+
 						" OP_SSRTX output from tx %v from "+
 						"height %v at height %v before "+
 						"required maturity of %v blocks",
 						txInHash, originHeight, txHeight,
-						coinbaseMaturity)
+						reqMaturity)
 					return 0, ruleError(ErrImmatureSpend, str)
 				}
 			}
@@ -4065,6 +4196,9 @@ func CheckTransactionInputs(subsidyCache *standalone.SubsidyCache,
 				cointype.MaxVARAmount)
 			return 0, ruleError(ErrBadTxOutValue, str)
 		}
+		if utxoEntry.CoinType().IsSKA() {
+			totalSKAIn += originTxAtom
+		}
 	}
 
 	// Calculate the total output amount for this transaction by coin type.
@@ -4081,7 +4215,7 @@ func CheckTransactionInputs(subsidyCache *standalone.SubsidyCache,
 			totalVAROut += txOut.Value
 		case coinType >= 1 && coinType <= cointype.CoinTypeMax:
 			// Check if this SKA coin type is active
-			if !chainParams.IsSKACoinTypeActive(coinType) {
+			if !chainParams.IsSKACoinTypeActiveAtHeight(coinType, txHeight) {
 				str := fmt.Sprintf("transaction output uses inactive SKA coin type %d (%s)",
 					coinType, coinType.String())
 				return 0, ruleError(ErrBadTxOutValue, str)
@@ -4112,16 +4246,25 @@ func CheckTransactionInputs(subsidyCache *standalone.SubsidyCache,
 	}
 
 	// For backwards compatibility, if this is a VAR-only transaction,
-	// calculate fees using the original logic
+	// calculate fees using the original logic.
+	//
+	// Once the SKA sweep rule is active, any SKA inputs must be sweeps of a
+	// deactivated coin type, which is enforced by checkSKASweepInputs since
+	// it depends on the state of the deactivation votes.  Their value is
+	// retired rather than counted towards the VAR fee since SKA and VAR are
+	// not interchangeable.
 	if len(skaOut) == 0 {
-		// Original VAR-only validation logic
-		if totalAtomIn < totalVAROut {
+		totalVARIn := totalAtomIn
+		if chainParams.RestrictsSKASweeps(txHeight) {
+			totalVARIn -= totalSKAIn
+		}
+		if totalVARIn < totalVAROut {
 			str := fmt.Sprintf("total value of all VAR transaction inputs for "+
 				"transaction %v is %v which is less than the amount "+
-				"spent of %v", txHash, totalAtomIn, totalVAROut)
+				"spent of %v", txHash, totalVARIn, totalVAROut)
 			return 0, ruleError(ErrSpendTooHigh, str)
 		}
-		txFeeInAtom := totalAtomIn - totalVAROut
+		txFeeInAtom := totalVARIn - totalVAROut
 		return txFeeInAtom, nil
 	}
 
@@ -4168,7 +4311,7 @@ func CheckTransactionInputs(subsidyCache *standalone.SubsidyCache,
 			totalVARIn += inputAmount
 		case inputCoinType >= 1 && inputCoinType <= cointype.CoinTypeMax:
 			// Check if this SKA coin type is active
-			if !chainParams.IsSKACoinTypeActive(inputCoinType) {
+			if !chainParams.IsSKACoinTypeActiveAtHeight(inputCoinType, txHeight) {
 				str := fmt.Sprintf("spending inactive SKA coin type %d", inputCoinType)
 				return 0, ruleError(ErrBadTxOutValue, str)
 			}
@@ -4648,6 +4791,16 @@ func (b *BlockChain) checkTransactionsAndConnect(inputFees dcrutil.Amount,
 			return err
 		}
 
+		// Ensure any SKA inputs of transactions that only create VAR
+		// outputs are sweeps of deactivated coin types once the rule is
+		// active.
+		if b.chainParams.RestrictsSKASweeps(node.height) {
+			err := b.checkSKASweepInputs(tx.MsgTx(), view, node.parent)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Sum the total fees by coin type and ensure we don't overflow the
 		// accumulator.
 		coinType := wire.GetPrimaryCoinType(tx.MsgTx())
@@ -5090,13 +5243,18 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block, parent *dcrutil.B
 	// to use the Monetarium split for production
 	subsidySplitVariant := standalone.SSVMonetarium
 
+	// Track the time spent checking transactions and validating scripts across
+	// both trees so it can be recorded once the block passes.
+	var txChecksTime, scriptsTime time.Duration
 	const stakeTreeTrue = true
+	start := time.Now()
 	err = b.checkTransactionsAndConnect(0, node, block.STransactions(),
 		view, stxos, stakeTreeTrue, subsidySplitVariant)
 	if err != nil {
 		log.Tracef("checkTransactionsAndConnect failed for stake tree: %v", err)
 		return err
 	}
+	txChecksTime += time.Since(start)
 
 	stakeTreeFees, err := getStakeTreeFees(b.subsidyCache, node.height,
 		block.STransactions(), view, isTreasuryEnabled, subsidySplitVariant)
@@ -5136,6 +5294,7 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block, parent *dcrutil.B
 	}
 
 	if runScripts {
+		start := time.Now()
 		err = checkBlockScripts(block, view, false, scriptFlags,
 			b.sigCache, isAutoRevocationsEnabled)
 		if err != nil {
@@ -5143,6 +5302,7 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block, parent *dcrutil.B
 				"on txtreestake of cur block: %v", err)
 			return err
 		}
+		scriptsTime += time.Since(start)
 	}
 
 	// Ensure the regular transaction tree does not contain any transactions
@@ -5155,6 +5315,7 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block, parent *dcrutil.B
 
 	// First, validate regular transactions (this detects double spends before SSFee validation)
 	const stakeTreeFalse = false
+	start = time.Now()
 	err = b.checkTransactionsAndConnect(stakeTreeFees, node,
 		block.Transactions(), view, stxos, stakeTreeFalse, subsidySplitVariant)
 	if err != nil {
@@ -5162,57 +5323,15 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block, parent *dcrutil.B
 			err)
 		return err
 	}
+	txChecksTime += time.Since(start)
 
 	// Now validate SSFee transactions AFTER double-spend detection.
 	// This ensures we don't report SSFee mismatches for invalid blocks.
 	if node.height >= b.chainParams.StakeValidationHeight {
-		// Calculate total fees from regular tree using ValueIn (always available).
+		// Calculate total fees from both trees using ValueIn (always available).
 		// We use ValueIn instead of looking up UTXOs because checkTransactionsAndConnect
 		// has already marked some UTXOs as spent.
-		totalFees := wire.NewFeesByType()
-		for _, tx := range block.Transactions()[1:] { // Skip coinbase
-			// Calculate transaction fee
-			var totalIn int64
-			for _, txIn := range tx.MsgTx().TxIn {
-				totalIn += txIn.ValueIn
-			}
-			var totalOut int64
-			for _, txOut := range tx.MsgTx().TxOut {
-				totalOut += txOut.Value
-			}
-			txFee := totalIn - totalOut
-			if txFee > 0 {
-				coinType := wire.GetPrimaryCoinType(tx.MsgTx())
-				totalFees.Add(coinType, txFee)
-			}
-		}
-
-		// ALSO calculate fees from stake tree (ticket purchases pay fees!)
-		for _, stx := range block.STransactions() {
-			// Skip special stake transactions that don't pay fees
-			txType := stake.DetermineTxType(stx.MsgTx())
-			if txType == stake.TxTypeSSGen || // Votes don't pay fees
-				txType == stake.TxTypeTreasuryBase ||
-				txType == stake.TxTypeTSpend ||
-				txType == stake.TxTypeSSFee { // SSFee doesn't pay fees
-				continue
-			}
-
-			// Calculate fee for this stake transaction (e.g., ticket purchase)
-			var totalIn int64
-			for _, txIn := range stx.MsgTx().TxIn {
-				totalIn += txIn.ValueIn
-			}
-			var totalOut int64
-			for _, txOut := range stx.MsgTx().TxOut {
-				totalOut += txOut.Value
-			}
-			txFee := totalIn - totalOut
-			if txFee > 0 {
-				coinType := wire.GetPrimaryCoinType(stx.MsgTx())
-				totalFees.Add(coinType, txFee)
-			}
-		}
+		totalFees := calcBlockFeesByCoinType(block)
 
 		// Scale fees by voter participation (same as mining code)
 		for coinType := range totalFees {
@@ -5309,6 +5428,7 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block, parent *dcrutil.B
 	}
 
 	if runScripts {
+		start := time.Now()
 		err = checkBlockScripts(block, view, true, scriptFlags,
 			b.sigCache, isAutoRevocationsEnabled)
 		if err != nil {
@@ -5316,6 +5436,7 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block, parent *dcrutil.B
 				"on txtreeregular of cur block: %v", err)
 			return err
 		}
+		scriptsTime += time.Since(start)
 	}
 
 	// First block has special rules concerning the ledger.
@@ -5331,6 +5452,11 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block, parent *dcrutil.B
 	// transactions have been connected.
 	view.SetBestHash(&node.hash)
 
+	b.recordValidationStage(VSTransactionChecks, txChecksTime)
+	if runScripts {
+		b.recordValidationStage(VSScriptValidation, scriptsTime)
+	}
+
 	return nil
 }
 
@@ -5347,6 +5473,10 @@ func (b *BlockChain) CheckConnectBlockTemplate(block *dcrutil.Block) error {
 	// Skip the proof of work check as this is just a block template.
 	flags := BFNoPoWCheck
 
+	// Don't record the durations of the validation stages of the template.
+	b.checkingTemplate = true
+	defer func() { b.checkingTemplate = false }()
+
 	// The block template must build off the current tip of the main chain
 	// or its parent.
 	tip := b.bestChain.Tip()
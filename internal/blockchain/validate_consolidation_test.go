@@ -73,6 +73,9 @@ func TestExtractHash160FromPkScript(t *testing.T) {
 	copy(p2shScript[2:22], hash160)
 	p2shScript[22] = txscript.OP_EQUAL
 
+	// Stake tagged P2SH: OP_SSGEN OP_HASH160 OP_DATA_20 <hash160> OP_EQUAL
+	ssgenP2SHScript := append([]byte{txscript.OP_SSGEN}, p2shScript...)
+
 	tests := []struct {
 		name    string
 		script  []byte
@@ -88,6 +91,11 @@ func TestExtractHash160FromPkScript(t *testing.T) {
 			script:  p2shScript,
 			wantErr: false,
 		},
+		{
+			name:    "Valid stake tagged P2SH script",
+			script:  ssgenP2SHScript,
+			wantErr: false,
+		},
 		{
 			name:    "Invalid script (too short)",
 			script:  []byte{0x76, 0xa9},
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/standalone"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestInputCoinTypesAgenda ensures the agenda which enables transactions that
+// declare the coin types of their inputs is only active when its deployment is
+// defined and active.
+func TestInputCoinTypesAgenda(t *testing.T) {
+	// The agenda is never active on networks that do not define it.
+	chain := newFakeChain(chaincfg.MainNetParams())
+	genesis := chain.bestChain.NodeByHeight(0)
+	isActive, err := chain.isInputCoinTypesAgendaActive(genesis)
+	if err != nil || isActive {
+		t.Fatalf("unexpected agenda state without deployment -- got %v (%v), "+
+			"want false", isActive, err)
+	}
+
+	// The agenda follows the state of its deployment otherwise.
+	chain = newFakeChain(chaincfg.SimNetParams())
+	genesis = chain.bestChain.NodeByHeight(0)
+	isActive, err = chain.isInputCoinTypesAgendaActive(genesis)
+	if err != nil || isActive {
+		t.Fatalf("unexpected agenda state prior to vote -- got %v (%v), "+
+			"want false", isActive, err)
+	}
+	active := ThresholdStateTuple{State: ThresholdActive}
+	chain.deploymentData[chaincfg.VoteIDInputCoinTypes] = deploymentInfo{
+		forcedState: &active,
+	}
+	isActive, err = chain.isInputCoinTypesAgendaActive(genesis)
+	if err != nil || !isActive {
+		t.Fatalf("unexpected agenda state once active -- got %v (%v), "+
+			"want true", isActive, err)
+	}
+	flags, err := chain.determineCheckTxFlags(genesis)
+	if err != nil {
+		t.Fatalf("unexpected error determining flags: %v", err)
+	}
+	if !flags.IsInputCoinTypesEnabled() {
+		t.Fatal("input coin types flag is not set once the agenda is active")
+	}
+}
+
+// TestInputCoinTypesContext ensures transactions that declare the coin types
+// of their inputs are only allowed once the agenda is active and that inputs
+// which do not spend an output declare the VAR coin type.
+func TestInputCoinTypesContext(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	newTx := func(version uint16, prevOutIndex uint32, coinType cointype.CoinType) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.Version = version
+		prevOut := wire.NewOutPoint(&chainhash.Hash{0x01}, prevOutIndex,
+			wire.TxTreeRegular)
+		txIn := wire.NewTxIn(prevOut, 0, nil)
+		txIn.CoinType = coinType
+		tx.AddTxIn(txIn)
+		tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+		return tx
+	}
+
+	const baseFlags = AFExplicitVerUpgrades | AFTreasuryEnabled
+	tests := []struct {
+		name    string
+		tx      *wire.MsgTx
+		flags   AgendaFlags
+		wantErr error
+	}{{
+		name:    "version 4 prior to agenda activation",
+		tx:      newTx(wire.TxVersionInputCoinTypes, 0, 1),
+		flags:   baseFlags,
+		wantErr: ErrTxVersionTooHigh,
+	}, {
+		name:  "version 4 after agenda activation",
+		tx:    newTx(wire.TxVersionInputCoinTypes, 0, 1),
+		flags: baseFlags | AFInputCoinTypesEnabled,
+	}, {
+		name:    "version 5 after agenda activation",
+		tx:      newTx(wire.TxVersionInputCoinTypes+1, 0, 1),
+		flags:   baseFlags | AFInputCoinTypesEnabled,
+		wantErr: ErrTxVersionTooHigh,
+	}, {
+		name:    "input without spent output declares SKA",
+		tx:      newTx(wire.TxVersionInputCoinTypes, wire.MaxPrevOutIndex, 1),
+		flags:   baseFlags | AFInputCoinTypesEnabled,
+		wantErr: ErrTxInCoinTypeMismatch,
+	}, {
+		name:  "input without spent output declares VAR",
+		tx:    newTx(wire.TxVersionInputCoinTypes, wire.MaxPrevOutIndex, 0),
+		flags: baseFlags | AFInputCoinTypesEnabled,
+	}, {
+		name:  "declared coin types ignored prior to version 4",
+		tx:    newTx(wire.TxVersionTreasury, wire.MaxPrevOutIndex, 1),
+		flags: baseFlags | AFInputCoinTypesEnabled,
+	}}
+
+	for _, test := range tests {
+		err := CheckTransaction(test.tx, params, test.flags)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%q: unexpected error -- got %v, want %v", test.name,
+				err, test.wantErr)
+		}
+	}
+}
+
+// TestInputCoinTypesSpentOutputs ensures the coin types declared by the inputs
+// of transactions that declare them must match the outputs they spend.
+func TestInputCoinTypesSpentOutputs(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	subsidyCache := standalone.NewSubsidyCache(params)
+
+	prevOut := wire.OutPoint{Hash: chainhash.Hash{0x01}}
+	view := NewUtxoViewpoint(nil)
+	view.Entries()[prevOut] = &UtxoEntry{
+		amount:   100000,
+		coinType: cointype.CoinType(1),
+		pkScript: []byte{0x51},
+	}
+
+	tests := []struct {
+		name     string
+		version  uint16
+		coinType cointype.CoinType
+		wantErr  error
+	}{{
+		name:     "declared coin type matches spent output",
+		version:  wire.TxVersionInputCoinTypes,
+		coinType: 1,
+	}, {
+		name:     "declared coin type differs from spent output",
+		version:  wire.TxVersionInputCoinTypes,
+		coinType: 2,
+		wantErr:  ErrTxInCoinTypeMismatch,
+	}, {
+		name:     "declared coin type ignored prior to version 4",
+		version:  wire.TxVersionTreasury,
+		coinType: 2,
+	}}
+
+	for _, test := range tests {
+		tx := wire.NewMsgTx()
+		tx.Version = test.version
+		txIn := wire.NewTxIn(&prevOut, 100000, nil)
+		txIn.CoinType = test.coinType
+		tx.AddTxIn(txIn)
+		tx.AddTxOut(wire.NewTxOutWithCoinType(50000, 1, []byte{0x51}))
+
+		_, err := CheckTransactionInputs(subsidyCache, dcrutil.NewTx(tx), 101,
+			view, false, params, nil, true, false, standalone.SSVOriginal)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%q: unexpected error -- got %v, want %v", test.name,
+				err, test.wantErr)
+		}
+	}
+}
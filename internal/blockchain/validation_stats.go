@@ -0,0 +1,140 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ValidationStage identifies a stage of block validation whose duration is
+// tracked.
+type ValidationStage int
+
+// Constants for the stages of block validation whose durations are tracked.
+const (
+	// VSTransactionChecks is the contextual checking of the transactions in
+	// both trees of a block against the utxo set, including the input values
+	// and fees of each coin type, excluding the scripts.
+	VSTransactionChecks ValidationStage = iota
+
+	// VSScriptValidation is the execution of the scripts of all inputs in
+	// both trees of a block.  It is skipped for blocks that are ancestors of
+	// the assumed valid block.
+	VSScriptValidation
+
+	// VSEmissionChecks is the validation of the SKA emission transactions in
+	// a block against the emission rules of their coin types.
+	VSEmissionChecks
+
+	// VSAllocationChecks is the enforcement of the block space allocated to
+	// each coin type by the block space allocator.
+	VSAllocationChecks
+
+	// VSIndexUpdates is the update of the database state indexed by a block
+	// when it is connected to the main chain, such as the spend journal, GCS
+	// filter, SKA emission and burn state, and per coin type fee totals and
+	// statistics, along with the commit of the utxo changes to the utxo cache.
+	VSIndexUpdates
+
+	// numValidationStages is the maximum validation stage.  It is NOT a valid
+	// stage and is only used to size the stats.
+	numValidationStages
+)
+
+// validationStageStrings is a map of validation stages back to their names for
+// pretty printing.
+var validationStageStrings = map[ValidationStage]string{
+	VSTransactionChecks: "transactionchecks",
+	VSScriptValidation:  "scriptvalidation",
+	VSEmissionChecks:    "emissionchecks",
+	VSAllocationChecks:  "allocationchecks",
+	VSIndexUpdates:      "indexupdates",
+}
+
+// String returns the ValidationStage in human-readable form.
+func (s ValidationStage) String() string {
+	if str, ok := validationStageStrings[s]; ok {
+		return str
+	}
+	return fmt.Sprintf("Unknown ValidationStage (%d)", int(s))
+}
+
+// ValidationStageStats houses the durations of a validation stage across the
+// blocks that passed it since the chain instance was created.
+type ValidationStageStats struct {
+	// Stage is the validation stage the durations are for.
+	Stage ValidationStage
+
+	// Count is the number of blocks that passed the stage.
+	Count uint64
+
+	// Total, Max, and Last are the total, longest, and most recent durations
+	// of the stage across those blocks.
+	Total time.Duration
+	Max   time.Duration
+	Last  time.Duration
+}
+
+// Average returns the average duration of the stage across the blocks that
+// passed it.  It is zero when no blocks passed the stage.
+func (s *ValidationStageStats) Average() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Count)
+}
+
+// validationStats houses the durations of each validation stage.  It has its
+// own lock so the stats may be queried without waiting on the chain lock which
+// is held for the duration of block processing.
+type validationStats struct {
+	mtx    sync.Mutex
+	stages [numValidationStages]ValidationStageStats
+}
+
+// record adds the provided duration of a validation stage for a block.
+func (v *validationStats) record(stage ValidationStage, d time.Duration) {
+	v.mtx.Lock()
+	stats := &v.stages[stage]
+	stats.Count++
+	stats.Total += d
+	if d > stats.Max {
+		stats.Max = d
+	}
+	stats.Last = d
+	v.mtx.Unlock()
+}
+
+// recordValidationStage adds the provided duration of a validation stage for a
+// block being accepted.  Nothing is recorded while a block template is being
+// checked since templates are not accepted into the chain and would otherwise
+// skew the stats.
+//
+// This function MUST be called with the chain lock held (for writes).
+func (b *BlockChain) recordValidationStage(stage ValidationStage, d time.Duration) {
+	if b.checkingTemplate {
+		return
+	}
+	b.validationStats.record(stage, d)
+}
+
+// ValidationStats returns the durations of each stage of block validation for
+// the blocks processed since the chain instance was created.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ValidationStats() []ValidationStageStats {
+	v := &b.validationStats
+	v.mtx.Lock()
+	stats := make([]ValidationStageStats, 0, numValidationStages)
+	for stage := ValidationStage(0); stage < numValidationStages; stage++ {
+		stageStats := v.stages[stage]
+		stageStats.Stage = stage
+		stats = append(stats, stageStats)
+	}
+	v.mtx.Unlock()
+	return stats
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package emission
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+)
+
+const (
+	// checkpointFetchTimeout is the maximum amount of time to wait for an
+	// emission checkpoint to be fetched from an HTTP(S) URL.
+	checkpointFetchTimeout = time.Second * 30
+
+	// maxCheckpointSize is the maximum size of an emission checkpoint that
+	// is fetched.
+	maxCheckpointSize = 1 << 20
+)
+
+// CheckpointState describes the progress of verifying the emission state of
+// the chain against a single emission checkpoint.
+type CheckpointState string
+
+const (
+	// CheckpointFetching indicates the checkpoint has not been fetched from
+	// its source yet.  Fetching is retried on every block until it succeeds.
+	CheckpointFetching CheckpointState = "fetching"
+
+	// CheckpointPending indicates the checkpoint was fetched and its
+	// signature verified, but the best chain has not reached its height
+	// yet.
+	CheckpointPending CheckpointState = "pending"
+
+	// CheckpointVerified indicates the emission state of the chain matches
+	// the state attested to by the checkpoint.
+	CheckpointVerified CheckpointState = "verified"
+
+	// CheckpointFailed indicates the checkpoint is invalid, is not signed by
+	// a trusted checkpoint key, or the emission state of the chain does not
+	// match the state it attests to.
+	CheckpointFailed CheckpointState = "failed"
+)
+
+// CheckpointStatus reports the progress of verifying the emission state of
+// the chain against the emission checkpoint of a single source.
+type CheckpointStatus struct {
+	Source    string
+	State     CheckpointState
+	Height    int64
+	BlockHash string
+	LastError string
+}
+
+// CheckpointConfig is a descriptor containing the emission checkpoint
+// verifier configuration.
+type CheckpointConfig struct {
+	// ChainParams identifies which chain parameters the verifier is
+	// associated with.
+	ChainParams *chaincfg.Params
+
+	// Keys houses the trusted checkpoint keys.  Checkpoints must be signed
+	// by one of them.
+	Keys []*secp256k1.PublicKey
+
+	// Sources houses the file paths and HTTP(S) URLs the checkpoints are
+	// fetched from.
+	Sources []string
+
+	// BestHeight returns the height of the current best chain tip.
+	BestHeight func() int64
+
+	// CheckEmissionCheckpoint ensures the emission state of the main chain
+	// as of the height of the provided checkpoint matches the state it
+	// attests to.
+	CheckEmissionCheckpoint func(*blockchain.EmissionCheckpoint) error
+}
+
+// checkpointEntry houses an emission checkpoint source along with the
+// checkpoint fetched from it, if any, and the progress of verifying it.
+type checkpointEntry struct {
+	status     CheckpointStatus
+	checkpoint *blockchain.EmissionCheckpoint
+}
+
+// CheckpointVerifier fetches operator-signed emission checkpoints and
+// verifies the emission state of the chain against each of them once the best
+// chain reaches its height.  Nodes that reconstruct the emission state
+// without fully validating the chain use it to avoid serving emission state
+// that has not been verified.
+type CheckpointVerifier struct {
+	cfg      CheckpointConfig
+	client   *http.Client
+	blockChs chan int64
+
+	mtx     sync.Mutex
+	entries []*checkpointEntry
+}
+
+// NewCheckpointVerifier returns a new emission checkpoint verifier for the
+// provided configuration.
+func NewCheckpointVerifier(cfg *CheckpointConfig) *CheckpointVerifier {
+	entries := make([]*checkpointEntry, 0, len(cfg.Sources))
+	for _, source := range cfg.Sources {
+		entries = append(entries, &checkpointEntry{
+			status: CheckpointStatus{
+				Source: source,
+				State:  CheckpointFetching,
+			},
+		})
+	}
+
+	return &CheckpointVerifier{
+		cfg:      *cfg,
+		client:   &http.Client{Timeout: checkpointFetchTimeout},
+		blockChs: make(chan int64, 16),
+		entries:  entries,
+	}
+}
+
+// BlockConnected notifies the verifier that a block at the provided height
+// was connected to the main chain.  The notification is dropped when the
+// verifier is behind since only the most recent tip matters.
+//
+// This function is safe for concurrent access.
+func (v *CheckpointVerifier) BlockConnected(height int64) {
+	select {
+	case v.blockChs <- height:
+	default:
+	}
+}
+
+// Status returns the progress of verifying the emission state against the
+// checkpoint of every source in the order the sources were configured.
+//
+// This function is safe for concurrent access.
+func (v *CheckpointVerifier) Status() []CheckpointStatus {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	result := make([]CheckpointStatus, 0, len(v.entries))
+	for _, entry := range v.entries {
+		result = append(result, entry.status)
+	}
+	return result
+}
+
+// Verified returns nil when the emission state of the chain has been verified
+// against the checkpoint of every source.  Otherwise, it returns an error that
+// describes the first checkpoint that has not been verified.
+//
+// This function is safe for concurrent access.
+func (v *CheckpointVerifier) Verified() error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	for _, entry := range v.entries {
+		status := &entry.status
+		switch status.State {
+		case CheckpointVerified:
+			continue
+
+		case CheckpointFailed:
+			return fmt.Errorf("emission checkpoint %s failed verification: %s",
+				status.Source, status.LastError)
+
+		case CheckpointPending:
+			return fmt.Errorf("emission state has not been verified against "+
+				"the emission checkpoint at height %d yet", status.Height)
+		}
+		return fmt.Errorf("emission checkpoint %s has not been fetched yet",
+			status.Source)
+	}
+	return nil
+}
+
+// Run fetches the checkpoints and processes block notifications until the
+// provided context is cancelled.  It must be run as a goroutine.
+func (v *CheckpointVerifier) Run(ctx context.Context) {
+	log.Infof("Emission checkpoint verifier started for %d checkpoint(s)",
+		len(v.entries))
+
+	v.processBlock(ctx, v.cfg.BestHeight())
+	for {
+		select {
+		case height := <-v.blockChs:
+			v.processBlock(ctx, height)
+		case <-ctx.Done():
+			log.Info("Emission checkpoint verifier stopped")
+			return
+		}
+	}
+}
+
+// fetch reads the emission checkpoint from the provided source, which is
+// either an HTTP(S) URL or a file path.
+func (v *CheckpointVerifier) fetch(ctx context.Context, source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") &&
+		!strings.HasPrefix(source, "https://") {
+
+		return os.ReadFile(source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("server responded with status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCheckpointSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxCheckpointSize {
+		return nil, fmt.Errorf("emission checkpoint exceeds the max size of "+
+			"%d bytes", maxCheckpointSize)
+	}
+	return data, nil
+}
+
+// load parses the provided emission checkpoint and ensures it is for the
+// network of the verifier and signed by one of the trusted checkpoint keys.
+func (v *CheckpointVerifier) load(data []byte) (*blockchain.EmissionCheckpoint, error) {
+	cp, err := blockchain.ParseEmissionCheckpoint(data)
+	if err != nil {
+		return nil, err
+	}
+	if cp.Network != v.cfg.ChainParams.Name {
+		return nil, fmt.Errorf("emission checkpoint is for network %q "+
+			"instead of %q", cp.Network, v.cfg.ChainParams.Name)
+	}
+	if err := cp.Verify(v.cfg.Keys); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// processBlock fetches the checkpoints that have not been fetched yet and
+// verifies the emission state against those the best chain reached for a
+// newly connected block at the provided height.
+func (v *CheckpointVerifier) processBlock(ctx context.Context, height int64) {
+	for _, entry := range v.entries {
+		// Only the verifier goroutine changes the state, so it is safe to
+		// fetch without the lock held.
+		v.mtx.Lock()
+		state := entry.status.State
+		v.mtx.Unlock()
+		if state != CheckpointFetching {
+			continue
+		}
+
+		source := entry.status.Source
+		data, err := v.fetch(ctx, source)
+		if err != nil {
+			log.Warnf("Unable to fetch emission checkpoint %s: %v", source,
+				err)
+			v.mtx.Lock()
+			entry.status.LastError = err.Error()
+			v.mtx.Unlock()
+			continue
+		}
+		cp, err := v.load(data)
+		v.mtx.Lock()
+		if err != nil {
+			log.Errorf("Emission checkpoint %s is invalid: %v", source, err)
+			entry.status.State = CheckpointFailed
+			entry.status.LastError = err.Error()
+			v.mtx.Unlock()
+			continue
+		}
+		entry.checkpoint = cp
+		entry.status.State = CheckpointPending
+		entry.status.Height = cp.Height
+		entry.status.BlockHash = cp.BlockHash
+		entry.status.LastError = ""
+		v.mtx.Unlock()
+	}
+
+	for _, entry := range v.entries {
+		v.mtx.Lock()
+		state := entry.status.State
+		v.mtx.Unlock()
+		if state != CheckpointPending || height < entry.checkpoint.Height {
+			continue
+		}
+
+		cp := entry.checkpoint
+		err := v.cfg.CheckEmissionCheckpoint(cp)
+		v.mtx.Lock()
+		if err != nil {
+			log.Errorf("Emission state does not match emission checkpoint "+
+				"%s at height %d: %v", entry.status.Source, cp.Height, err)
+			entry.status.State = CheckpointFailed
+			entry.status.LastError = err.Error()
+		} else {
+			log.Infof("Verified emission state against emission checkpoint "+
+				"at height %d (block %s)", cp.Height, cp.BlockHash)
+			entry.status.State = CheckpointVerified
+		}
+		v.mtx.Unlock()
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package emission
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+)
+
+// TestCheckpointVerifier ensures the checkpoint verifier fetches checkpoints
+// from files and HTTP(S) URLs, rejects those that are not signed by a trusted
+// key, and only reports the emission state as verified once the best chain
+// reached every checkpoint and the state matched each of them.
+func TestCheckpointVerifier(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	untrustedKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+
+	signedCheckpoint := func(height int64, key *secp256k1.PrivateKey) []byte {
+		t.Helper()
+		cp := &blockchain.EmissionCheckpoint{
+			Network:   params.Name,
+			Height:    height,
+			BlockHash: strings.Repeat("ab", 32),
+			CoinTypes: []blockchain.EmissionCheckpointCoinType{{
+				CoinType: 1,
+				Nonces:   []uint64{1},
+			}},
+		}
+		if err := cp.Sign(key); err != nil {
+			t.Fatalf("Failed to sign checkpoint: %v", err)
+		}
+		data, err := json.Marshal(cp)
+		if err != nil {
+			t.Fatalf("Failed to encode checkpoint: %v", err)
+		}
+		return data
+	}
+
+	serve := func(data []byte) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rw.Write(data)
+		}))
+	}
+
+	// Serve a checkpoint at height 20 over HTTP and store one at height 10
+	// in a file.
+	server := serve(signedCheckpoint(20, privKey))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	if err := os.WriteFile(path, signedCheckpoint(10, privKey), 0600); err != nil {
+		t.Fatalf("Failed to write checkpoint: %v", err)
+	}
+
+	checked := make(map[int64]int)
+	v := NewCheckpointVerifier(&CheckpointConfig{
+		ChainParams: params,
+		Keys:        []*secp256k1.PublicKey{privKey.PubKey()},
+		Sources:     []string{path, server.URL},
+		BestHeight:  func() int64 { return 0 },
+		CheckEmissionCheckpoint: func(cp *blockchain.EmissionCheckpoint) error {
+			checked[cp.Height]++
+			return nil
+		},
+	})
+	if err := v.Verified(); err == nil {
+		t.Fatal("expected error before the checkpoints are fetched")
+	}
+
+	ctx := context.Background()
+	v.processBlock(ctx, 5)
+	for _, status := range v.Status() {
+		if status.State != CheckpointPending {
+			t.Fatalf("unexpected status before the checkpoint height: %+v",
+				status)
+		}
+	}
+	if err := v.Verified(); err == nil {
+		t.Fatal("expected error before the checkpoints are reached")
+	}
+
+	v.processBlock(ctx, 15)
+	statuses := v.Status()
+	if statuses[0].State != CheckpointVerified ||
+		statuses[1].State != CheckpointPending {
+
+		t.Fatalf("unexpected statuses: %+v", statuses)
+	}
+	if err := v.Verified(); err == nil {
+		t.Fatal("expected error before every checkpoint is reached")
+	}
+
+	v.processBlock(ctx, 25)
+	if err := v.Verified(); err != nil {
+		t.Fatalf("unexpected error once every checkpoint is verified: %v", err)
+	}
+	v.processBlock(ctx, 26)
+	if checked[10] != 1 || checked[20] != 1 {
+		t.Fatalf("unexpected number of checks: %v", checked)
+	}
+
+	// Checkpoints signed by an untrusted key and those that do not match the
+	// emission state fail verification.
+	untrustedServer := serve(signedCheckpoint(20, untrustedKey))
+	defer untrustedServer.Close()
+	v = NewCheckpointVerifier(&CheckpointConfig{
+		ChainParams: params,
+		Keys:        []*secp256k1.PublicKey{privKey.PubKey()},
+		Sources:     []string{untrustedServer.URL},
+		BestHeight:  func() int64 { return 0 },
+		CheckEmissionCheckpoint: func(*blockchain.EmissionCheckpoint) error {
+			return nil
+		},
+	})
+	v.processBlock(ctx, 25)
+	if status := v.Status()[0]; status.State != CheckpointFailed {
+		t.Fatalf("unexpected status for untrusted checkpoint: %+v", status)
+	}
+
+	v = NewCheckpointVerifier(&CheckpointConfig{
+		ChainParams: params,
+		Keys:        []*secp256k1.PublicKey{privKey.PubKey()},
+		Sources:     []string{path},
+		BestHeight:  func() int64 { return 0 },
+		CheckEmissionCheckpoint: func(*blockchain.EmissionCheckpoint) error {
+			return errors.New("mismatched nonce")
+		},
+	})
+	v.processBlock(ctx, 25)
+	if status := v.Status()[0]; status.State != CheckpointFailed ||
+		status.LastError != "mismatched nonce" {
+
+		t.Fatalf("unexpected status for mismatched checkpoint: %+v", status)
+	}
+	if err := v.Verified(); err == nil {
+		t.Fatal("expected error for failed checkpoint")
+	}
+
+	// Sources that can't be fetched are retried on the next block.
+	missing := filepath.Join(t.TempDir(), "missing.json")
+	v = NewCheckpointVerifier(&CheckpointConfig{
+		ChainParams: params,
+		Keys:        []*secp256k1.PublicKey{privKey.PubKey()},
+		Sources:     []string{missing},
+		BestHeight:  func() int64 { return 0 },
+		CheckEmissionCheckpoint: func(*blockchain.EmissionCheckpoint) error {
+			return nil
+		},
+	})
+	v.processBlock(ctx, 25)
+	if status := v.Status()[0]; status.State != CheckpointFetching ||
+		status.LastError == "" {
+
+		t.Fatalf("unexpected status for missing checkpoint: %+v", status)
+	}
+	if err := os.WriteFile(missing, signedCheckpoint(10, privKey), 0600); err != nil {
+		t.Fatalf("Failed to write checkpoint: %v", err)
+	}
+	v.processBlock(ctx, 26)
+	if err := v.Verified(); err != nil {
+		t.Fatalf("unexpected error after the checkpoint is fetched: %v", err)
+	}
+}
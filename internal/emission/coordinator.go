@@ -0,0 +1,351 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package emission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// RehearsalState describes the progress of a rehearsed emission for a single
+// coin type.
+type RehearsalState string
+
+const (
+	// StateWaiting indicates the emission window for the coin type has not
+	// started yet or the coin type has not been activated by a stakeholder
+	// vote.
+	StateWaiting RehearsalState = "waiting"
+
+	// StateSubmitted indicates the emission transaction has been created,
+	// signed, and accepted to the mempool, but is not yet in a block.
+	StateSubmitted RehearsalState = "submitted"
+
+	// StateConfirmed indicates the emission for the coin type is recorded in
+	// the main chain.
+	StateConfirmed RehearsalState = "confirmed"
+
+	// StateFailed indicates the most recent attempt to create or submit the
+	// emission transaction failed.  It will be retried on the next block.
+	StateFailed RehearsalState = "failed"
+
+	// StateExpired indicates the emission window for the coin type ended
+	// without the emission being included in a block.
+	StateExpired RehearsalState = "expired"
+)
+
+// CoinTypeStatus reports the progress of a rehearsed emission for a single
+// coin type.
+type CoinTypeStatus struct {
+	CoinType    cointype.CoinType
+	State       RehearsalState
+	WindowStart int64
+	WindowEnd   int64
+	Nonce       uint64
+	TxHash      *chainhash.Hash
+	LastHeight  int64
+	LastError   string
+}
+
+// Config is a descriptor containing the emission coordinator configuration.
+type Config struct {
+	// ChainParams identifies which chain parameters the coordinator is
+	// associated with.  The main network is not permitted.
+	ChainParams *chaincfg.Params
+
+	// Keys houses the test emission private keys to sign with keyed by the
+	// coin type they are authorized to emit.  Every key must correspond to
+	// the emission key configured in the chain parameters for its coin type.
+	Keys map[cointype.CoinType]*secp256k1.PrivateKey
+
+	// Manifests houses the signed emission manifests keyed by their hash
+	// that provide the distribution of tranches whose chain parameters only
+	// pin the manifest hash and total.  Every manifest must have already been
+	// checked against the chain parameters.
+	Manifests map[chainhash.Hash]*blockchain.EmissionManifest
+
+	// BestHeight returns the height of the current best chain tip.
+	BestHeight func() int64
+
+	// HasSKAEmissionOccurred returns whether the emission for the provided
+	// coin type is already recorded in the main chain.
+	HasSKAEmissionOccurred func(cointype.CoinType) bool
+
+	// GetSKAEmissionNonce returns the last used emission nonce for the
+	// provided coin type.
+	GetSKAEmissionNonce func(cointype.CoinType) uint64
+
+	// SKAEmissionTranchesEmitted returns the number of scheduled emission
+	// tranches of the provided coin type recorded in the main chain.
+	SKAEmissionTranchesEmitted func(cointype.CoinType) uint32
+
+	// HasVotePassedAtHeight returns whether the provided consensus vote is
+	// active for a block at the provided height.
+	HasVotePassedAtHeight func(voteID string, blockHeight int64) bool
+
+	// SubmitTx submits the provided transaction to the mempool and relays it
+	// to the network.
+	SubmitTx func(tx *dcrutil.Tx) error
+
+	// HaveTransaction returns whether the transaction with the provided hash
+	// is in the mempool.
+	HaveTransaction func(hash *chainhash.Hash) bool
+}
+
+// Coordinator rehearses SKA emissions on test networks by automatically
+// creating, signing, and submitting the emission transaction for each
+// configured coin type as soon as its emission window opens.
+type Coordinator struct {
+	cfg      Config
+	blockChs chan int64
+
+	mtx    sync.Mutex
+	status map[cointype.CoinType]*CoinTypeStatus
+}
+
+// New returns a new emission coordinator for the provided configuration.  An
+// error is returned when the configuration targets the main network or
+// contains a key that is not authorized to emit its coin type.
+func New(cfg *Config) (*Coordinator, error) {
+	params := cfg.ChainParams
+	if params.Net == wire.MainNet {
+		return nil, errors.New("emission rehearsal is not permitted on the " +
+			"main network")
+	}
+
+	status := make(map[cointype.CoinType]*CoinTypeStatus, len(cfg.Keys))
+	for coinType, privKey := range cfg.Keys {
+		config := params.GetSKACoinConfig(coinType)
+		if config == nil {
+			return nil, fmt.Errorf("coin type %v is not configured on %s",
+				coinType, params.Name)
+		}
+		if config.EmissionKey == nil ||
+			!privKey.PubKey().IsEqual(config.EmissionKey) {
+
+			return nil, fmt.Errorf("key for coin type %v does not match the "+
+				"emission key configured on %s", coinType, params.Name)
+		}
+		tranche := config.NextEmissionTranche(0)
+		status[coinType] = &CoinTypeStatus{
+			CoinType:    coinType,
+			State:       StateWaiting,
+			WindowStart: int64(tranche.EmissionHeight),
+			WindowEnd:   tranche.WindowEnd(),
+		}
+	}
+
+	return &Coordinator{
+		cfg:      *cfg,
+		blockChs: make(chan int64, 16),
+		status:   status,
+	}, nil
+}
+
+// BlockConnected notifies the coordinator that a block at the provided height
+// was connected to the main chain.  The notification is dropped when the
+// coordinator is behind since only the most recent tip matters.
+//
+// This function is safe for concurrent access.
+func (c *Coordinator) BlockConnected(height int64) {
+	select {
+	case c.blockChs <- height:
+	default:
+	}
+}
+
+// Status returns the progress of the rehearsed emission for every configured
+// coin type ordered by coin type.
+//
+// This function is safe for concurrent access.
+func (c *Coordinator) Status() []CoinTypeStatus {
+	c.mtx.Lock()
+	result := make([]CoinTypeStatus, 0, len(c.status))
+	for _, status := range c.status {
+		result = append(result, *status)
+	}
+	c.mtx.Unlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CoinType < result[j].CoinType
+	})
+	return result
+}
+
+// Run processes block notifications until the provided context is cancelled.
+// It must be run as a goroutine.
+func (c *Coordinator) Run(ctx context.Context) {
+	log.Infof("Emission rehearsal coordinator started for %d coin type(s)",
+		len(c.cfg.Keys))
+
+	c.processBlock(c.cfg.BestHeight())
+	for {
+		select {
+		case height := <-c.blockChs:
+			c.processBlock(height)
+		case <-ctx.Done():
+			log.Info("Emission rehearsal coordinator stopped")
+			return
+		}
+	}
+}
+
+// processBlock updates the status of every configured coin type for a newly
+// connected block at the provided height and submits any emissions whose
+// window is open for the next block.  Coin types emitted in multiple tranches
+// move on to the window of the next tranche once the previous one confirms.
+func (c *Coordinator) processBlock(height int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	nextHeight := height + 1
+	for coinType, status := range c.status {
+		status.LastHeight = height
+
+		// Track the window of the next tranche to emit.  A previously
+		// submitted tranche is confirmed once the chain nonce reaches it.
+		emitted := c.cfg.SKAEmissionTranchesEmitted(coinType)
+		config := c.cfg.ChainParams.GetSKACoinConfig(coinType)
+		if tranche := config.NextEmissionTranche(emitted); tranche != nil {
+			status.WindowStart = int64(tranche.EmissionHeight)
+			status.WindowEnd = tranche.WindowEnd()
+		}
+		if status.State == StateSubmitted &&
+			status.Nonce <= c.cfg.GetSKAEmissionNonce(coinType) &&
+			!c.cfg.HasSKAEmissionOccurred(coinType) {
+
+			log.Infof("Rehearsed emission tranche %d for %v confirmed at "+
+				"height %d", status.Nonce, coinType, height)
+			status.State = StateWaiting
+		}
+
+		switch {
+		case c.cfg.HasSKAEmissionOccurred(coinType):
+			if status.State != StateConfirmed {
+				log.Infof("Rehearsed emission for %v confirmed at height %d",
+					coinType, height)
+			}
+			status.State = StateConfirmed
+			status.LastError = ""
+			continue
+
+		case nextHeight < status.WindowStart:
+			status.State = StateWaiting
+			continue
+
+		case nextHeight > status.WindowEnd:
+			if status.State != StateExpired {
+				log.Warnf("Emission window for %v ended at height %d "+
+					"without a confirmed emission", coinType, status.WindowEnd)
+			}
+			status.State = StateExpired
+			continue
+
+		// Submitted emissions that are no longer in the mempool without
+		// having been recorded in the main chain, such as those evicted
+		// from the mempool or lost in a reorganization that did not
+		// reconfirm them, are submitted again.  Emissions recorded in the
+		// main chain already moved the coin type out of the submitted
+		// state above once the chain nonce reached them.
+		case status.State == StateSubmitted:
+			if c.cfg.HaveTransaction(status.TxHash) {
+				continue
+			}
+			log.Warnf("Rehearsed emission %v for %v is neither in the "+
+				"mempool nor the main chain -- resubmitting", status.TxHash,
+				coinType)
+		}
+
+		// Emissions of coin types that are not active in the next block are
+		// invalid, so wait for the activation height of the coin type.
+		if !c.cfg.ChainParams.IsSKACoinTypeActiveAtHeight(coinType, nextHeight) {
+			status.State = StateWaiting
+			continue
+		}
+
+		if coinType >= 2 {
+			voteID := chaincfg.SKAActivationVoteID(coinType)
+			if !c.cfg.HasVotePassedAtHeight(voteID, nextHeight) {
+				status.State = StateWaiting
+				continue
+			}
+		}
+
+		txHash, nonce, err := c.submitEmission(coinType, nextHeight)
+		if err != nil {
+			log.Errorf("Failed to submit rehearsed emission for %v: %v",
+				coinType, err)
+			status.State = StateFailed
+			status.LastError = err.Error()
+			continue
+		}
+		log.Infof("Submitted rehearsed emission %v for %v (nonce %d)", txHash,
+			coinType, nonce)
+		status.State = StateSubmitted
+		status.Nonce = nonce
+		status.TxHash = txHash
+		status.LastError = ""
+	}
+}
+
+// submitEmission creates, signs, and submits the emission transaction for the
+// next tranche of the provided coin type targeting the provided block height.
+// It returns the hash of the submitted transaction along with the nonce it
+// commits to.
+func (c *Coordinator) submitEmission(coinType cointype.CoinType, height int64) (*chainhash.Hash, uint64, error) {
+	params := c.cfg.ChainParams
+	config := params.GetSKACoinConfig(coinType)
+	nonce := c.cfg.GetSKAEmissionNonce(coinType) + 1
+	emitted := c.cfg.SKAEmissionTranchesEmitted(coinType)
+	tranche := config.NextEmissionTranche(emitted)
+	if tranche == nil {
+		return nil, 0, fmt.Errorf("all %d scheduled emission tranches have "+
+			"been emitted", emitted)
+	}
+
+	addrs, amounts, err := blockchain.EmissionDistribution(tranche,
+		c.cfg.Manifests)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The signature is only a placeholder since the transaction must exist
+	// before it can be signed.
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: config.EmissionKey,
+		Signature:   []byte{0},
+		Nonce:       nonce,
+		CoinType:    coinType,
+		Amount:      tranche.TotalAmount(),
+		Height:      height,
+	}
+	tx, err := blockchain.CreateAuthorizedSKAEmissionTransaction(auth, addrs,
+		amounts, params)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = blockchain.SignSKAEmissionTransaction(tx, auth, c.cfg.Keys[coinType],
+		params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	utx := dcrutil.NewTx(tx)
+	if err := c.cfg.SubmitTx(utx); err != nil {
+		return nil, 0, err
+	}
+	return utx.Hash(), auth.Nonce, nil
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package emission
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+)
+
+// fakeChain provides a mock chain state for the coordinator that treats
+// submitted transactions as immediately mined when asked to along with a mock
+// mempool that houses the submitted transactions.
+type fakeChain struct {
+	nonces    map[cointype.CoinType]uint64
+	emitted   map[cointype.CoinType]bool
+	votes     map[string]bool
+	submitted []*dcrutil.Tx
+	mempool   map[chainhash.Hash]bool
+}
+
+func (c *fakeChain) HasSKAEmissionOccurred(coinType cointype.CoinType) bool {
+	return c.emitted[coinType]
+}
+
+func (c *fakeChain) GetSKAEmissionNonce(coinType cointype.CoinType) uint64 {
+	return c.nonces[coinType]
+}
+
+func (c *fakeChain) SKAEmissionTranchesEmitted(coinType cointype.CoinType) uint32 {
+	if c.emitted[coinType] {
+		return 1
+	}
+	return 0
+}
+
+// newTestCoordinator returns a coordinator for the simulation network that
+// holds a freshly generated emission key for SKA-1 and SKA-2 along with the
+// fake chain it is backed by.
+func newTestCoordinator(t *testing.T) (*Coordinator, *fakeChain, *chaincfg.Params) {
+	t.Helper()
+
+	params := chaincfg.SimNetParams()
+	keys := make(map[cointype.CoinType]*secp256k1.PrivateKey)
+	for _, coinType := range []cointype.CoinType{1, 2} {
+		privKey, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			t.Fatalf("failed to generate private key: %v", err)
+		}
+		params.SKACoins[coinType].EmissionKey = privKey.PubKey()
+		keys[coinType] = privKey
+	}
+
+	chain := &fakeChain{
+		nonces:  make(map[cointype.CoinType]uint64),
+		emitted: make(map[cointype.CoinType]bool),
+		votes:   make(map[string]bool),
+		mempool: make(map[chainhash.Hash]bool),
+	}
+	c, err := New(&Config{
+		ChainParams:                params,
+		Keys:                       keys,
+		BestHeight:                 func() int64 { return 0 },
+		HasSKAEmissionOccurred:     chain.HasSKAEmissionOccurred,
+		GetSKAEmissionNonce:        chain.GetSKAEmissionNonce,
+		SKAEmissionTranchesEmitted: chain.SKAEmissionTranchesEmitted,
+		HasVotePassedAtHeight: func(voteID string, _ int64) bool {
+			return chain.votes[voteID]
+		},
+		SubmitTx: func(tx *dcrutil.Tx) error {
+			chain.submitted = append(chain.submitted, tx)
+			chain.mempool[*tx.Hash()] = true
+			return nil
+		},
+		HaveTransaction: func(hash *chainhash.Hash) bool {
+			return chain.mempool[*hash]
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create coordinator: %v", err)
+	}
+	return c, chain, params
+}
+
+// TestNewRejectsInvalidConfig ensures the coordinator refuses to run on the
+// main network and with keys that are not authorized for their coin type.
+func TestNewRejectsInvalidConfig(t *testing.T) {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("failed to generate private key: %v", err)
+	}
+	keys := map[cointype.CoinType]*secp256k1.PrivateKey{1: privKey}
+
+	_, err = New(&Config{ChainParams: chaincfg.MainNetParams(), Keys: keys})
+	if err == nil {
+		t.Fatal("coordinator was created for the main network")
+	}
+	_, err = New(&Config{ChainParams: chaincfg.SimNetParams(), Keys: keys})
+	if err == nil {
+		t.Fatal("coordinator was created with an unauthorized key")
+	}
+}
+
+// TestCoordinatorLifecycle ensures the coordinator submits a valid emission
+// exactly once when the window opens, waits for the activation vote of coin
+// types that require one, and tracks confirmation and expiry.
+func TestCoordinatorLifecycle(t *testing.T) {
+	c, chain, params := newTestCoordinator(t)
+	ska1 := params.SKACoins[1]
+	ska2 := params.SKACoins[2]
+
+	// Mark SKA-2 active so only its activation vote gates its emission.
+	ska2.Active = true
+
+	stateOf := func(coinType cointype.CoinType) CoinTypeStatus {
+		for _, status := range c.Status() {
+			if status.CoinType == coinType {
+				return status
+			}
+		}
+		t.Fatalf("no status for coin type %v", coinType)
+		return CoinTypeStatus{}
+	}
+
+	// Nothing is submitted before the window opens.
+	c.processBlock(int64(ska1.EmissionHeight) - 2)
+	if len(chain.submitted) != 0 {
+		t.Fatalf("submitted %d transactions before the window opened",
+			len(chain.submitted))
+	}
+	if got := stateOf(1).State; got != StateWaiting {
+		t.Fatalf("unexpected SKA-1 state -- got %v, want %v", got, StateWaiting)
+	}
+
+	// The emission is submitted for the first block in the window and must
+	// pass full validation.
+	c.processBlock(int64(ska1.EmissionHeight) - 1)
+	if len(chain.submitted) != 1 {
+		t.Fatalf("unexpected number of submitted transactions -- got %d, "+
+			"want 1", len(chain.submitted))
+	}
+	status := stateOf(1)
+	if status.State != StateSubmitted || status.Nonce != 1 ||
+		*status.TxHash != *chain.submitted[0].Hash() {
+
+		t.Fatalf("unexpected SKA-1 status after submission: %+v", status)
+	}
+	err := blockchain.ValidateAuthorizedSKAEmissionTransaction(
+		chain.submitted[0].MsgTx(), int64(ska1.EmissionHeight), chain, params)
+	if err != nil {
+		t.Fatalf("submitted emission failed validation: %v", err)
+	}
+
+	// Further blocks must not resubmit while the emission is pending.
+	c.processBlock(int64(ska1.EmissionHeight))
+	if len(chain.submitted) != 1 {
+		t.Fatalf("emission was resubmitted while pending")
+	}
+
+	// Confirmation is reported once the chain records the emission.
+	chain.emitted[1] = true
+	chain.nonces[1] = 1
+	c.processBlock(int64(ska1.EmissionHeight) + 1)
+	if got := stateOf(1).State; got != StateConfirmed {
+		t.Fatalf("unexpected SKA-1 state -- got %v, want %v", got,
+			StateConfirmed)
+	}
+
+	// SKA-2 requires its activation vote before it is submitted.
+	c.processBlock(int64(ska2.EmissionHeight) - 1)
+	if got := stateOf(2).State; got != StateWaiting {
+		t.Fatalf("unexpected SKA-2 state -- got %v, want %v", got, StateWaiting)
+	}
+	chain.votes["activateska2"] = true
+	c.processBlock(int64(ska2.EmissionHeight))
+	if got := stateOf(2).State; got != StateSubmitted {
+		t.Fatalf("unexpected SKA-2 state -- got %v, want %v", got,
+			StateSubmitted)
+	}
+
+	// The emission expires when the window ends without confirmation.
+	c.processBlock(int64(ska2.EmissionHeight) + int64(ska2.EmissionWindow))
+	if got := stateOf(2).State; got != StateExpired {
+		t.Fatalf("unexpected SKA-2 state -- got %v, want %v", got, StateExpired)
+	}
+}
+
+// TestCoordinatorWaitsForActivationHeight ensures the coordinator does not
+// submit an emission for a coin type until the block it targets is at or after
+// the activation height of the coin type even when its window is open.
+func TestCoordinatorWaitsForActivationHeight(t *testing.T) {
+	c, chain, params := newTestCoordinator(t)
+	ska1 := params.SKACoins[1]
+	ska1.ActivationHeight = int64(ska1.EmissionHeight) + 10
+
+	// Nothing is submitted while the coin type is not yet active despite
+	// the window being open.
+	c.processBlock(int64(ska1.EmissionHeight) - 1)
+	c.processBlock(ska1.ActivationHeight - 2)
+	if len(chain.submitted) != 0 {
+		t.Fatalf("submitted %d transactions before the coin type activated",
+			len(chain.submitted))
+	}
+	for _, status := range c.Status() {
+		if status.CoinType == 1 && status.State != StateWaiting {
+			t.Fatalf("unexpected SKA-1 state -- got %v, want %v",
+				status.State, StateWaiting)
+		}
+	}
+
+	// The emission is submitted for the block at the activation height.
+	c.processBlock(ska1.ActivationHeight - 1)
+	if len(chain.submitted) != 1 {
+		t.Fatalf("unexpected number of submitted transactions -- got %d, "+
+			"want 1", len(chain.submitted))
+	}
+}
+
+// TestCoordinatorResubmitsEvictedEmission ensures the coordinator submits an
+// emission again when the previously submitted one is no longer in the mempool
+// without having been recorded in the main chain.
+func TestCoordinatorResubmitsEvictedEmission(t *testing.T) {
+	c, chain, params := newTestCoordinator(t)
+	ska1 := params.SKACoins[1]
+
+	c.processBlock(int64(ska1.EmissionHeight) - 1)
+	if len(chain.submitted) != 1 {
+		t.Fatalf("unexpected number of submitted transactions -- got %d, "+
+			"want 1", len(chain.submitted))
+	}
+
+	// Evict the emission from the mempool and ensure it is submitted again
+	// on the next block.
+	delete(chain.mempool, *chain.submitted[0].Hash())
+	c.processBlock(int64(ska1.EmissionHeight))
+	if len(chain.submitted) != 2 {
+		t.Fatalf("evicted emission was not resubmitted -- got %d "+
+			"submissions, want 2", len(chain.submitted))
+	}
+	var status CoinTypeStatus
+	for _, s := range c.Status() {
+		if s.CoinType == 1 {
+			status = s
+		}
+	}
+	if status.State != StateSubmitted || status.Nonce != 1 ||
+		*status.TxHash != *chain.submitted[1].Hash() {
+
+		t.Fatalf("unexpected SKA-1 status after resubmission: %+v", status)
+	}
+
+	// The resubmitted emission remains pending while it is in the mempool.
+	c.processBlock(int64(ska1.EmissionHeight) + 1)
+	if len(chain.submitted) != 2 {
+		t.Fatalf("emission was resubmitted while pending")
+	}
+
+	// An emission that is recorded in the main chain is not resubmitted even
+	// though it is no longer in the mempool.
+	delete(chain.mempool, *chain.submitted[1].Hash())
+	chain.emitted[1] = true
+	chain.nonces[1] = 1
+	c.processBlock(int64(ska1.EmissionHeight) + 2)
+	if len(chain.submitted) != 2 {
+		t.Fatalf("mined emission was resubmitted")
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package emission implements an optional SKA emission rehearsal coordinator, an
+optional SKA emission watchtower, and an optional SKA emission checkpoint
+verifier.
+
+The coordinator is intended for test networks only.  It makes it possible to
+run full dress rehearsals of the emission procedure that will be used on the
+main network without involving any external signing tooling.
+
+# Feature Overview
+
+The following are the primary features provided:
+
+  - Watches the chain for the start of the emission window of every coin type
+    it has been configured with a test emission key for
+  - Constructs the authorized emission transaction with the next required
+    nonce and the governance-configured addresses and amounts
+  - Signs the transaction with the configured test key and submits it to the
+    local mempool for relay
+  - Tracks the progress of each rehearsed emission so it can be reported via
+    RPC
+
+# Watchtower
+
+The watchtower may be run on any network, including the main network.  It
+monitors the emission window of the next scheduled tranche of every coin type
+and raises escalating alerts when a window approaches, opens without a valid
+emission in the mempool, is more than half elapsed, or ends without the
+emission being mined.  Alerts are logged, reported via RPC, and optionally
+posted to webhooks so operations teams do not miss an emission window.
+
+# Checkpoint Verifier
+
+The checkpoint verifier fetches operator-signed emission checkpoints from files
+or HTTP(S) URLs.  Each checkpoint attests to the committed nonces of the
+emitted tranches of every coin type as of the main chain block at a specific
+height and must be signed by one of the configured checkpoint keys.  Once the
+best chain reaches the height of a checkpoint, the emission state of the chain
+as of that block is verified against it.  Nodes that reconstruct the emission
+state without fully validating the chain, such as those syncing with an assumed
+valid block, use it to avoid serving emission state via RPC before it has been
+verified.
+*/
+package emission
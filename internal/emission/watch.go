@@ -0,0 +1,361 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package emission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+// webhookTimeout is the maximum amount of time to wait for a webhook callback
+// to complete.
+const webhookTimeout = time.Second * 10
+
+// AlertLevel describes how urgently an emission for a single coin type needs
+// attention from its operators.  Levels are ordered by increasing severity.
+type AlertLevel int
+
+const (
+	// AlertNone indicates no emission is expected soon or the emission for
+	// the coin type is already recorded in the main chain.
+	AlertNone AlertLevel = iota
+
+	// AlertPending indicates the emission window is open and a valid
+	// emission is waiting in the mempool to be mined.
+	AlertPending
+
+	// AlertApproaching indicates the emission window opens within the
+	// configured lead time.
+	AlertApproaching
+
+	// AlertOpen indicates the emission window is open without a valid
+	// emission in the mempool.
+	AlertOpen
+
+	// AlertCritical indicates at least half of the emission window has
+	// elapsed without a valid emission in the mempool.
+	AlertCritical
+
+	// AlertMissed indicates the emission window ended without the emission
+	// being included in a block.
+	AlertMissed
+)
+
+// alertLevelStrings maps alert levels to their human-readable names.
+var alertLevelStrings = map[AlertLevel]string{
+	AlertNone:        "none",
+	AlertPending:     "pending",
+	AlertApproaching: "approaching",
+	AlertOpen:        "open",
+	AlertCritical:    "critical",
+	AlertMissed:      "missed",
+}
+
+// String returns the AlertLevel as a human-readable name.
+func (l AlertLevel) String() string {
+	if s, ok := alertLevelStrings[l]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown AlertLevel (%d)", int(l))
+}
+
+// WatchStatus reports the emission watch state of a single coin type.
+type WatchStatus struct {
+	CoinType    cointype.CoinType
+	Level       AlertLevel
+	Tranche     uint32
+	WindowStart int64
+	WindowEnd   int64
+	PendingTx   *chainhash.Hash
+	LastHeight  int64
+}
+
+// WatchConfig is a descriptor containing the emission watchtower
+// configuration.
+type WatchConfig struct {
+	// ChainParams identifies which chain parameters the watchtower is
+	// associated with.
+	ChainParams *chaincfg.Params
+
+	// LeadBlocks is the number of blocks before an emission window opens
+	// at which operators are first warned about it.
+	LeadBlocks int64
+
+	// WebhookURLs houses the URLs that are sent an HTTP POST request with
+	// a JSON-encoded WatchAlert whenever the alert level of a coin type
+	// escalates.
+	WebhookURLs []string
+
+	// BestHeight returns the height of the current best chain tip.
+	BestHeight func() int64
+
+	// HasSKAEmissionOccurred returns whether every scheduled emission
+	// tranche for the provided coin type is recorded in the main chain.
+	HasSKAEmissionOccurred func(cointype.CoinType) bool
+
+	// SKAEmissionTranchesEmitted returns the number of scheduled emission
+	// tranches of the provided coin type recorded in the main chain.
+	SKAEmissionTranchesEmitted func(cointype.CoinType) uint32
+
+	// HasVotePassedAtHeight returns whether the provided consensus vote is
+	// active for a block at the provided height.
+	HasVotePassedAtHeight func(voteID string, blockHeight int64) bool
+
+	// PendingSKAEmission returns the hash of the valid emission for the
+	// provided coin type that is waiting in the mempool, if any.
+	PendingSKAEmission func(cointype.CoinType) *chainhash.Hash
+}
+
+// WatchAlert is the JSON-encoded body of the webhook callbacks sent when the
+// alert level of a coin type escalates.
+type WatchAlert struct {
+	Network     string `json:"network"`
+	CoinType    uint8  `json:"cointype"`
+	Level       string `json:"level"`
+	Tranche     uint32 `json:"tranche"`
+	WindowStart int64  `json:"windowstart"`
+	WindowEnd   int64  `json:"windowend"`
+	Height      int64  `json:"height"`
+}
+
+// Watchtower monitors the emission windows of every configured SKA coin type
+// and alerts operators with escalating log messages and optional webhook
+// callbacks when a window approaches or opens without a valid emission
+// appearing in the mempool so the window is not missed.
+type Watchtower struct {
+	cfg      WatchConfig
+	client   *http.Client
+	blockChs chan int64
+
+	mtx    sync.Mutex
+	status map[cointype.CoinType]*WatchStatus
+}
+
+// NewWatchtower returns a new emission watchtower for the provided
+// configuration that watches every SKA coin type configured in its chain
+// parameters.
+func NewWatchtower(cfg *WatchConfig) *Watchtower {
+	status := make(map[cointype.CoinType]*WatchStatus,
+		len(cfg.ChainParams.SKACoins))
+	for coinType := range cfg.ChainParams.SKACoins {
+		status[coinType] = &WatchStatus{CoinType: coinType}
+	}
+
+	return &Watchtower{
+		cfg:      *cfg,
+		client:   &http.Client{Timeout: webhookTimeout},
+		blockChs: make(chan int64, 16),
+		status:   status,
+	}
+}
+
+// BlockConnected notifies the watchtower that a block at the provided height
+// was connected to the main chain.  The notification is dropped when the
+// watchtower is behind since only the most recent tip matters.
+//
+// This function is safe for concurrent access.
+func (w *Watchtower) BlockConnected(height int64) {
+	select {
+	case w.blockChs <- height:
+	default:
+	}
+}
+
+// Status returns the emission watch state of every watched coin type ordered
+// by coin type.
+//
+// This function is safe for concurrent access.
+func (w *Watchtower) Status() []WatchStatus {
+	w.mtx.Lock()
+	result := make([]WatchStatus, 0, len(w.status))
+	for _, status := range w.status {
+		result = append(result, *status)
+	}
+	w.mtx.Unlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CoinType < result[j].CoinType
+	})
+	return result
+}
+
+// Run processes block notifications until the provided context is cancelled.
+// It must be run as a goroutine.
+func (w *Watchtower) Run(ctx context.Context) {
+	log.Infof("Emission watchtower started for %d coin type(s)",
+		len(w.status))
+
+	w.processBlock(ctx, w.cfg.BestHeight())
+	for {
+		select {
+		case height := <-w.blockChs:
+			w.processBlock(ctx, height)
+		case <-ctx.Done():
+			log.Info("Emission watchtower stopped")
+			return
+		}
+	}
+}
+
+// alertLevel returns the alert level for the next emission tranche of the
+// provided coin type given the height of the next block.
+func (w *Watchtower) alertLevel(status *WatchStatus, nextHeight int64) AlertLevel {
+	switch {
+	case nextHeight < status.WindowStart-w.cfg.LeadBlocks:
+		return AlertNone
+
+	case nextHeight < status.WindowStart:
+		return AlertApproaching
+
+	case nextHeight > status.WindowEnd:
+		return AlertMissed
+
+	case status.PendingTx != nil:
+		return AlertPending
+	}
+
+	// Escalate once at least half of the window has elapsed.
+	elapsed := nextHeight - status.WindowStart
+	if 2*elapsed >= status.WindowEnd-status.WindowStart {
+		return AlertCritical
+	}
+	return AlertOpen
+}
+
+// processBlock updates the watch state of every watched coin type for a newly
+// connected block at the provided height and raises alerts for those whose
+// alert level escalated.
+func (w *Watchtower) processBlock(ctx context.Context, height int64) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	nextHeight := height + 1
+	for coinType, status := range w.status {
+		status.LastHeight = height
+
+		// There is nothing to watch once every tranche is emitted.
+		config := w.cfg.ChainParams.GetSKACoinConfig(coinType)
+		emitted := w.cfg.SKAEmissionTranchesEmitted(coinType)
+		tranche := config.NextEmissionTranche(emitted)
+		if tranche == nil || w.cfg.HasSKAEmissionOccurred(coinType) {
+			status.Level = AlertNone
+			status.PendingTx = nil
+			continue
+		}
+
+		// Move on to the next tranche once the previous one is emitted.
+		if status.Tranche != emitted+1 {
+			status.Tranche = emitted + 1
+			status.Level = AlertNone
+		}
+		status.WindowStart = int64(tranche.EmissionHeight)
+		status.WindowEnd = tranche.WindowEnd()
+		status.PendingTx = w.cfg.PendingSKAEmission(coinType)
+
+		// Coin types that have not been activated by a stakeholder vote
+		// can't be emitted, so there is nothing to alert about yet.
+		if coinType >= 2 {
+			voteID := chaincfg.SKAActivationVoteID(coinType)
+			if !w.cfg.HasVotePassedAtHeight(voteID, nextHeight) {
+				status.Level = AlertNone
+				continue
+			}
+		}
+
+		level := w.alertLevel(status, nextHeight)
+		escalated := level > status.Level
+		status.Level = level
+		if escalated {
+			w.alert(ctx, status)
+		}
+	}
+}
+
+// alert logs the current alert level of the provided coin type at a severity
+// matching the level and sends it to the configured webhooks.
+//
+// This function MUST be called with the watchtower lock held.
+func (w *Watchtower) alert(ctx context.Context, status *WatchStatus) {
+	coinType := status.CoinType
+	switch status.Level {
+	case AlertPending:
+		log.Infof("Emission tranche %d for %v is pending in the mempool "+
+			"(window %d-%d)", status.Tranche, coinType, status.WindowStart,
+			status.WindowEnd)
+
+	case AlertApproaching:
+		log.Infof("Emission window for tranche %d of %v opens at height %d "+
+			"(current height %d)", status.Tranche, coinType,
+			status.WindowStart, status.LastHeight)
+
+	case AlertOpen:
+		log.Warnf("Emission window for tranche %d of %v is open until height "+
+			"%d, but no valid emission is in the mempool", status.Tranche,
+			coinType, status.WindowEnd)
+
+	case AlertCritical:
+		log.Errorf("Emission window for tranche %d of %v closes at height %d "+
+			"(current height %d) and no valid emission is in the mempool",
+			status.Tranche, coinType, status.WindowEnd, status.LastHeight)
+
+	case AlertMissed:
+		log.Errorf("Emission window for tranche %d of %v ended at height %d "+
+			"without the emission being mined", status.Tranche, coinType,
+			status.WindowEnd)
+	}
+
+	if len(w.cfg.WebhookURLs) == 0 {
+		return
+	}
+	body, err := json.Marshal(&WatchAlert{
+		Network:     w.cfg.ChainParams.Name,
+		CoinType:    uint8(coinType),
+		Level:       status.Level.String(),
+		Tranche:     status.Tranche,
+		WindowStart: status.WindowStart,
+		WindowEnd:   status.WindowEnd,
+		Height:      status.LastHeight,
+	})
+	if err != nil {
+		log.Errorf("Failed to encode emission alert: %v", err)
+		return
+	}
+	for _, url := range w.cfg.WebhookURLs {
+		go w.postWebhook(ctx, url, body)
+	}
+}
+
+// postWebhook sends the provided JSON-encoded alert to the provided webhook
+// URL.
+func (w *Watchtower) postWebhook(ctx context.Context, url string, body []byte) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url,
+		bytes.NewReader(body))
+	if err != nil {
+		log.Errorf("Failed to create emission alert webhook request: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		log.Errorf("Failed to send emission alert to webhook %s: %v", url,
+			err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Errorf("Emission alert webhook %s responded with status %s", url,
+			resp.Status)
+	}
+}
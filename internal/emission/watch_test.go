@@ -0,0 +1,153 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package emission
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+// TestWatchtowerAlertLevels ensures the watchtower escalates the alert level
+// of a coin type as its emission window approaches, opens, and closes without
+// a valid emission, and relaxes it when an emission is pending or recorded in
+// the main chain.
+func TestWatchtowerAlertLevels(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	ska1 := params.SKACoins[1]
+	start := int64(ska1.EmissionHeight)
+	end := start + int64(ska1.EmissionWindow)
+
+	chain := &fakeChain{
+		nonces:  make(map[cointype.CoinType]uint64),
+		emitted: make(map[cointype.CoinType]bool),
+		votes:   make(map[string]bool),
+	}
+	pending := make(map[cointype.CoinType]*chainhash.Hash)
+	w := NewWatchtower(&WatchConfig{
+		ChainParams:                params,
+		LeadBlocks:                 10,
+		BestHeight:                 func() int64 { return 0 },
+		HasSKAEmissionOccurred:     chain.HasSKAEmissionOccurred,
+		SKAEmissionTranchesEmitted: chain.SKAEmissionTranchesEmitted,
+		HasVotePassedAtHeight: func(voteID string, _ int64) bool {
+			return chain.votes[voteID]
+		},
+		PendingSKAEmission: func(coinType cointype.CoinType) *chainhash.Hash {
+			return pending[coinType]
+		},
+	})
+
+	statusOf := func(coinType cointype.CoinType) WatchStatus {
+		for _, status := range w.Status() {
+			if status.CoinType == coinType {
+				return status
+			}
+		}
+		t.Fatalf("no status for coin type %v", coinType)
+		return WatchStatus{}
+	}
+
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		height  int64
+		pending bool
+		want    AlertLevel
+	}{
+		{"well before window", start - 20, false, AlertNone},
+		{"within lead time", start - 5, false, AlertApproaching},
+		{"window open", start - 1, false, AlertOpen},
+		{"emission pending", start + 10, true, AlertPending},
+		{"pending emission evicted", start + 20, false, AlertOpen},
+		{"half window elapsed", start + (end-start)/2, false, AlertCritical},
+		{"window ended", end, false, AlertMissed},
+	}
+	for _, test := range tests {
+		pending[1] = nil
+		if test.pending {
+			pending[1] = &chainhash.Hash{0x01}
+		}
+		w.processBlock(ctx, test.height)
+		status := statusOf(1)
+		if status.Level != test.want {
+			t.Fatalf("%s: unexpected alert level -- got %v, want %v",
+				test.name, status.Level, test.want)
+		}
+		if status.Tranche != 1 || status.WindowStart != start ||
+			status.WindowEnd != end {
+
+			t.Fatalf("%s: unexpected watched tranche: %+v", test.name, status)
+		}
+	}
+
+	// Coin types that have not been activated by their vote never alert.
+	if got := statusOf(2).Level; got != AlertNone {
+		t.Fatalf("unexpected SKA-2 alert level -- got %v, want %v", got,
+			AlertNone)
+	}
+
+	// Nothing is watched once the emission is recorded in the main chain.
+	chain.emitted[1] = true
+	w.processBlock(ctx, end+1)
+	if got := statusOf(1).Level; got != AlertNone {
+		t.Fatalf("unexpected alert level after emission -- got %v, want %v",
+			got, AlertNone)
+	}
+}
+
+// TestWatchtowerWebhook ensures the watchtower posts an alert to the
+// configured webhooks when the alert level of a coin type escalates.
+func TestWatchtowerWebhook(t *testing.T) {
+	alerts := make(chan WatchAlert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		var alert WatchAlert
+		if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+			t.Errorf("failed to decode alert: %v", err)
+		}
+		alerts <- alert
+	}))
+	defer server.Close()
+
+	params := chaincfg.SimNetParams()
+	ska1 := params.SKACoins[1]
+	chain := &fakeChain{
+		nonces:  make(map[cointype.CoinType]uint64),
+		emitted: make(map[cointype.CoinType]bool),
+		votes:   make(map[string]bool),
+	}
+	w := NewWatchtower(&WatchConfig{
+		ChainParams:                params,
+		WebhookURLs:                []string{server.URL},
+		BestHeight:                 func() int64 { return 0 },
+		HasSKAEmissionOccurred:     chain.HasSKAEmissionOccurred,
+		SKAEmissionTranchesEmitted: chain.SKAEmissionTranchesEmitted,
+		HasVotePassedAtHeight: func(voteID string, _ int64) bool {
+			return chain.votes[voteID]
+		},
+		PendingSKAEmission: func(cointype.CoinType) *chainhash.Hash {
+			return nil
+		},
+	})
+
+	w.processBlock(context.Background(), int64(ska1.EmissionHeight))
+	select {
+	case alert := <-alerts:
+		if alert.CoinType != 1 || alert.Level != AlertOpen.String() ||
+			alert.Network != params.Name {
+
+			t.Fatalf("unexpected alert: %+v", alert)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for webhook alert")
+	}
+}
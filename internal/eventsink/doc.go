@@ -0,0 +1,31 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package eventsink implements an optional sink that posts node events to HTTP
+endpoints.
+
+The sink lets external infrastructure react to events such as newly connected
+blocks, confirmed and final SKA emissions, block space allocation alerts, per
+coin type fee spikes, double spends, spends of conflicting SKA emissions
+evicted from the mempool, and miners whose blocks repeatedly diverge from the
+block space allocation policy without maintaining a websocket connection to the
+node.
+
+# Delivery
+
+Every event is posted as a JSON-encoded Event to each configured endpoint with
+its type in the X-Monetarium-Event header.  Endpoints are delivered to
+independently and in order.  Failed deliveries due to network errors, server
+errors, or rate limiting are retried with exponential backoff up to the
+configured number of retries, while other client errors are not retried.
+
+# Signing
+
+When a secret is configured, the X-Monetarium-Signature header houses
+sha256=<hex> where <hex> is the HMAC-SHA256 of the request body keyed by the
+secret.  Receivers should recompute the signature over the raw body and
+compare it in constant time before trusting the event.
+*/
+package eventsink
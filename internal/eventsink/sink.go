@@ -0,0 +1,372 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times delivery of an event
+	// to an endpoint is retried after the initial attempt fails.
+	DefaultMaxRetries = 5
+
+	// DefaultRetryDelay is the default delay before the first retry of a
+	// failed delivery.  The delay doubles with every subsequent retry.
+	DefaultRetryDelay = time.Second
+
+	// maxRetryDelay is the maximum delay between delivery retries.
+	maxRetryDelay = time.Minute
+
+	// deliveryTimeout is the maximum amount of time to wait for a single
+	// delivery attempt to complete.
+	deliveryTimeout = time.Second * 10
+
+	// queueSize is the maximum number of events queued for delivery to each
+	// endpoint.  Events published while the queue is full are dropped.
+	queueSize = 256
+
+	// SignatureHeader is the HTTP header that houses the hex-encoded
+	// HMAC-SHA256 of the request body keyed by the configured secret, in the
+	// form sha256=<hex>.
+	SignatureHeader = "X-Monetarium-Signature"
+
+	// EventTypeHeader is the HTTP header that houses the type of the
+	// delivered event.
+	EventTypeHeader = "X-Monetarium-Event"
+)
+
+// EventType identifies the kind of an event published to the sink.
+type EventType string
+
+const (
+	// EventBlockConnected is published when a block is connected to the
+	// main chain.  The event data is a BlockConnected.
+	EventBlockConnected EventType = "blockconnected"
+
+	// EventSKAEmissionConfirmed is published when an SKA emission
+	// transaction is included in a block connected to the main chain.  The
+	// event data is a SKAEmissionConfirmed.
+	EventSKAEmissionConfirmed EventType = "skaemissionconfirmed"
+
+	// EventSKAEmissionFinal is published when an SKA emission transaction in
+	// the main chain reaches the configured number of confirmations after
+	// which it is considered final.  The event data is a SKAEmissionFinal.
+	EventSKAEmissionFinal EventType = "skaemissionfinal"
+
+	// EventAllocationAlert is published when the pending mempool demand of a
+	// coin type starts exceeding the block space allocated to it.  The event
+	// data is an AllocationAlert.
+	EventAllocationAlert EventType = "allocationalert"
+
+	// EventFeeSpike is published when the dynamic fee multiplier of a coin
+	// type rises to or above the configured threshold.  The event data is a
+	// FeeSpike.
+	EventFeeSpike EventType = "feespike"
+
+	// EventDoubleSpend is published when a transaction with valid signatures
+	// attempts to spend coins already spent by transactions in the mempool.
+	// The event data is a DoubleSpend.
+	EventDoubleSpend EventType = "doublespend"
+
+	// EventSKAEmissionConflict is published when transactions that spend
+	// the outputs of an SKA emission which conflicts with the emission state
+	// of the main chain after a reorganization are evicted from the mempool.
+	// The event data is a SKAEmissionConflict.
+	EventSKAEmissionConflict EventType = "skaemissionconflict"
+
+	// EventAllocationDivergence is published when enough of the recent
+	// blocks of a miner used significantly less block space for some coin
+	// types than the allocation policy of the node would have given its
+	// pending mempool demand.  The event data is an AllocationDivergence.
+	EventAllocationDivergence EventType = "allocationdivergence"
+)
+
+// Event is the JSON-encoded body of every request sent to the endpoints.
+type Event struct {
+	ID   uint64      `json:"id"`
+	Type EventType   `json:"type"`
+	Time int64       `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+// BlockConnected describes a block connected to the main chain.
+type BlockConnected struct {
+	Hash   string `json:"hash"`
+	Height int64  `json:"height"`
+	Time   int64  `json:"time"`
+	NumTx  int    `json:"numtx"`
+}
+
+// SKAEmissionConfirmed describes an SKA emission transaction included in a
+// block connected to the main chain.
+type SKAEmissionConfirmed struct {
+	CoinType uint8  `json:"cointype"`
+	TxHash   string `json:"txhash"`
+	Amount   int64  `json:"amount"`
+	Height   int64  `json:"height"`
+}
+
+// SKAEmissionFinal describes an SKA emission in the main chain that reached the
+// configured number of confirmations after which it is considered final.
+type SKAEmissionFinal struct {
+	CoinType      uint8  `json:"cointype"`
+	Nonce         uint64 `json:"nonce"`
+	TxHash        string `json:"txhash"`
+	BlockHash     string `json:"blockhash"`
+	Height        int64  `json:"height"`
+	Confirmations int64  `json:"confirmations"`
+}
+
+// AllocationAlert describes a coin type whose pending mempool demand exceeds
+// the block space allocated to it.
+type AllocationAlert struct {
+	CoinType       uint8  `json:"cointype"`
+	PendingBytes   uint32 `json:"pendingbytes"`
+	AllocatedBytes uint32 `json:"allocatedbytes"`
+}
+
+// FeeSpike describes a coin type whose dynamic fee multiplier reached the
+// configured threshold.
+type FeeSpike struct {
+	CoinType   uint8   `json:"cointype"`
+	Multiplier float64 `json:"multiplier"`
+	Threshold  float64 `json:"threshold"`
+}
+
+// DoubleSpend describes a transaction that attempted to spend coins already
+// spent by the conflicting transactions in the mempool.
+type DoubleSpend struct {
+	CoinType  uint8    `json:"cointype"`
+	TxHash    string   `json:"txhash"`
+	Conflicts []string `json:"conflicts"`
+}
+
+// SKAEmissionConflict describes an SKA emission that conflicts with the
+// emission state of the main chain along with the transactions spending its
+// outputs that were evicted from the mempool.  The replacement is the hash of
+// the emission in the main chain that superseded it, if any.
+type SKAEmissionConflict struct {
+	CoinType    uint8    `json:"cointype"`
+	TxHash      string   `json:"txhash"`
+	Replacement string   `json:"replacement,omitempty"`
+	Evicted     []string `json:"evicted"`
+}
+
+// DivergentCoinType describes a coin type a block used significantly less space
+// for than the allocation policy of the node expected.
+type DivergentCoinType struct {
+	CoinType      uint8  `json:"cointype"`
+	PendingBytes  uint32 `json:"pendingbytes"`
+	ExpectedBytes uint32 `json:"expectedbytes"`
+	UsedBytes     uint32 `json:"usedbytes"`
+}
+
+// AllocationDivergence describes a miner whose recent blocks repeatedly
+// diverged from the allocation policy of the node along with the most recent
+// divergent block.
+type AllocationDivergence struct {
+	Miner           string              `json:"miner"`
+	Hash            string              `json:"hash"`
+	Height          int64               `json:"height"`
+	RecentDivergent int                 `json:"recentdivergent"`
+	Window          int                 `json:"window"`
+	CoinTypes       []DivergentCoinType `json:"cointypes"`
+}
+
+// Config is a descriptor containing the event sink configuration.
+type Config struct {
+	// Endpoints houses the HTTP(S) URLs every event is posted to.
+	Endpoints []string
+
+	// Secret is the key used to sign the body of every request with
+	// HMAC-SHA256.  Requests are not signed when it is empty.
+	Secret []byte
+
+	// MaxRetries is the number of times delivery of an event to an endpoint
+	// is retried after the initial attempt fails.
+	MaxRetries int
+
+	// RetryDelay is the delay before the first retry of a failed delivery.
+	// The delay doubles with every subsequent retry.
+	RetryDelay time.Duration
+}
+
+// endpoint houses the delivery queue of a single endpoint.
+type endpoint struct {
+	url   string
+	queue chan *Event
+}
+
+// Sink posts JSON-encoded events to the configured HTTP endpoints so external
+// infrastructure can react to them without maintaining a websocket connection
+// to the node.  Each endpoint is delivered to independently so a slow or
+// unavailable endpoint does not delay the others.
+type Sink struct {
+	cfg       Config
+	client    *http.Client
+	endpoints []*endpoint
+	nextID    atomic.Uint64
+}
+
+// New returns a new event sink for the provided configuration.
+func New(cfg *Config) *Sink {
+	endpoints := make([]*endpoint, 0, len(cfg.Endpoints))
+	for _, url := range cfg.Endpoints {
+		endpoints = append(endpoints, &endpoint{
+			url:   url,
+			queue: make(chan *Event, queueSize),
+		})
+	}
+	return &Sink{
+		cfg:       *cfg,
+		client:    &http.Client{Timeout: deliveryTimeout},
+		endpoints: endpoints,
+	}
+}
+
+// Publish queues an event of the provided type with the provided data for
+// delivery to every endpoint.  The event is dropped for endpoints whose
+// queue is full.
+//
+// This function is safe for concurrent access.
+func (s *Sink) Publish(eventType EventType, data interface{}) {
+	event := &Event{
+		ID:   s.nextID.Add(1),
+		Type: eventType,
+		Time: time.Now().Unix(),
+		Data: data,
+	}
+	for _, ep := range s.endpoints {
+		select {
+		case ep.queue <- event:
+		default:
+			log.Warnf("Dropping %s event %d for endpoint %s: queue is full",
+				eventType, event.ID, ep.url)
+		}
+	}
+}
+
+// Run delivers queued events until the provided context is cancelled.  It
+// must be run as a goroutine.
+func (s *Sink) Run(ctx context.Context) {
+	log.Infof("Event sink started for %d endpoint(s)", len(s.endpoints))
+
+	var wg sync.WaitGroup
+	wg.Add(len(s.endpoints))
+	for _, ep := range s.endpoints {
+		go func(ep *endpoint) {
+			s.deliveryHandler(ctx, ep)
+			wg.Done()
+		}(ep)
+	}
+	wg.Wait()
+
+	log.Info("Event sink stopped")
+}
+
+// deliveryHandler delivers the events queued for the provided endpoint in
+// order until the provided context is cancelled.  It must be run as a
+// goroutine.
+func (s *Sink) deliveryHandler(ctx context.Context, ep *endpoint) {
+	for {
+		select {
+		case event := <-ep.queue:
+			body, err := json.Marshal(event)
+			if err != nil {
+				log.Errorf("Failed to encode %s event %d: %v", event.Type,
+					event.ID, err)
+				continue
+			}
+			s.deliver(ctx, ep.url, event, body)
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver posts the provided encoded event to the provided endpoint, retrying
+// with exponential backoff when the attempt fails with an error that may be
+// temporary.
+func (s *Sink) deliver(ctx context.Context, url string, event *Event, body []byte) {
+	delay := s.cfg.RetryDelay
+	for attempt := 0; ; attempt++ {
+		retry, err := s.post(ctx, url, event.Type, body)
+		if err == nil {
+			log.Tracef("Delivered %s event %d to %s", event.Type, event.ID,
+				url)
+			return
+		}
+		if !retry || attempt >= s.cfg.MaxRetries {
+			log.Errorf("Failed to deliver %s event %d to %s: %v", event.Type,
+				event.ID, url, err)
+			return
+		}
+
+		log.Debugf("Retrying delivery of %s event %d to %s in %v: %v",
+			event.Type, event.ID, url, delay, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		delay *= 2
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
+
+// post performs a single delivery attempt of the provided encoded event to
+// the provided endpoint.  It returns whether a failed attempt may be retried.
+func (s *Sink) post(ctx context.Context, url string, eventType EventType, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url,
+		bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, string(eventType))
+	if len(s.cfg.Secret) > 0 {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(s.cfg.Secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= 500:
+		return true, fmt.Errorf("endpoint responded with status %s",
+			resp.Status)
+	}
+	return false, fmt.Errorf("endpoint responded with status %s", resp.Status)
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 of the provided body keyed by the
+// provided secret.  Receivers verify the signature header of a request by
+// comparing it against the result of signing the request body with the
+// shared secret.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
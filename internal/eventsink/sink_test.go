@@ -0,0 +1,145 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// receivedEvent houses an event received by a test endpoint along with the
+// headers it was delivered with.
+type receivedEvent struct {
+	event     Event
+	eventType string
+	signature string
+	body      []byte
+}
+
+// newTestEndpoint returns a test HTTP endpoint that responds to each request
+// with the status returned by the provided function for the attempt number
+// and sends every successfully delivered event to the returned channel.
+func newTestEndpoint(t *testing.T, status func(attempt int32) int) (*httptest.Server, <-chan receivedEvent) {
+	t.Helper()
+
+	var attempts atomic.Int32
+	received := make(chan receivedEvent, 8)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		code := status(attempts.Add(1))
+		rw.WriteHeader(code)
+		if code != http.StatusOK {
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read body: %v", err)
+			return
+		}
+		var event Event
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("failed to decode event: %v", err)
+			return
+		}
+		received <- receivedEvent{
+			event:     event,
+			eventType: r.Header.Get(EventTypeHeader),
+			signature: r.Header.Get(SignatureHeader),
+			body:      body,
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+// TestSinkDelivery ensures events are delivered to every endpoint signed with
+// the configured secret and that failed deliveries are only retried for
+// errors that may be temporary.
+func TestSinkDelivery(t *testing.T) {
+	// The first endpoint fails twice with a server error before accepting
+	// the event while the second rejects it outright.
+	var rejected atomic.Int32
+	flaky, flakyEvents := newTestEndpoint(t, func(attempt int32) int {
+		if attempt <= 2 {
+			return http.StatusServiceUnavailable
+		}
+		return http.StatusOK
+	})
+	rejecting, rejectingEvents := newTestEndpoint(t, func(int32) int {
+		rejected.Add(1)
+		return http.StatusBadRequest
+	})
+
+	secret := []byte("secret")
+	sink := New(&Config{
+		Endpoints:  []string{flaky.URL, rejecting.URL},
+		Secret:     secret,
+		MaxRetries: 3,
+		RetryDelay: time.Millisecond,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sink.Run(ctx)
+
+	sink.Publish(EventFeeSpike, &FeeSpike{CoinType: 1, Multiplier: 2.5,
+		Threshold: 2})
+
+	var got receivedEvent
+	select {
+	case got = <-flakyEvents:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for event delivery")
+	}
+	if got.event.ID != 1 || got.event.Type != EventFeeSpike ||
+		got.eventType != string(EventFeeSpike) {
+
+		t.Fatalf("unexpected event: %+v", got)
+	}
+	if want := "sha256=" + Sign(secret, got.body); got.signature != want {
+		t.Fatalf("mismatched signature: got %q, want %q", got.signature, want)
+	}
+
+	// Client errors are not retried.
+	time.Sleep(50 * time.Millisecond)
+	if n := rejected.Load(); n != 1 {
+		t.Fatalf("unexpected delivery attempts to rejecting endpoint: %d", n)
+	}
+	select {
+	case event := <-rejectingEvents:
+		t.Fatalf("unexpected event delivered: %+v", event)
+	default:
+	}
+}
+
+// TestEdgeTrigger ensures the edge trigger only fires when a condition starts
+// holding for a coin type.
+func TestEdgeTrigger(t *testing.T) {
+	trigger := NewEdgeTrigger()
+	tests := []struct {
+		active bool
+		want   bool
+	}{
+		{active: false, want: false},
+		{active: true, want: true},
+		{active: true, want: false},
+		{active: false, want: false},
+		{active: true, want: true},
+	}
+	for i, test := range tests {
+		if got := trigger.Update(1, test.active); got != test.want {
+			t.Fatalf("update %d: got %v, want %v", i, got, test.want)
+		}
+	}
+
+	// Coin types are tracked independently.
+	if !trigger.Update(2, true) {
+		t.Fatal("condition for coin type 2 did not fire")
+	}
+}
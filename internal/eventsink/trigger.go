@@ -0,0 +1,42 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package eventsink
+
+import (
+	"sync"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+// EdgeTrigger tracks whether a condition holds for each coin type so that
+// alerts are only published when the condition starts holding rather than
+// every time it is observed.
+type EdgeTrigger struct {
+	mtx    sync.Mutex
+	active map[cointype.CoinType]bool
+}
+
+// NewEdgeTrigger returns a new edge trigger with the condition not holding
+// for any coin type.
+func NewEdgeTrigger() *EdgeTrigger {
+	return &EdgeTrigger{active: make(map[cointype.CoinType]bool)}
+}
+
+// Update records whether the condition holds for the provided coin type and
+// returns true when it did not hold before.
+//
+// This function is safe for concurrent access.
+func (t *EdgeTrigger) Update(coinType cointype.CoinType, active bool) bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	wasActive := t.active[coinType]
+	if active {
+		t.active[coinType] = true
+	} else {
+		delete(t.active, coinType)
+	}
+	return active && !wasActive
+}
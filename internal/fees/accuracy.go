@@ -0,0 +1,153 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package fees
+
+import (
+	"sort"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+)
+
+// MaxPredictedConfirmations is the maximum number of blocks to confirm that is
+// predicted for a transaction.  Transactions that pay a fee rate below the
+// estimate for this target are predicted to take this many blocks.
+const MaxPredictedConfirmations = 12
+
+// EstimateAccuracy houses the accuracy of the fee rate estimates of a coin type
+// as measured by comparing the number of blocks transactions were predicted to
+// take to confirm given their fee rate against the number of blocks they
+// actually took.
+type EstimateAccuracy struct {
+	// CoinType is the coin type of the transactions.
+	CoinType cointype.CoinType
+
+	// Samples is the number of mined transactions measured.
+	Samples uint64
+
+	// OnTime is the number of transactions that confirmed within the number
+	// of blocks predicted.
+	OnTime uint64
+
+	// Late is the number of transactions that took longer to confirm than
+	// predicted.
+	Late uint64
+
+	// TotalPredictedConfs is the sum of the predicted blocks to confirm.
+	TotalPredictedConfs uint64
+
+	// TotalActualConfs is the sum of the actual blocks to confirm.
+	TotalActualConfs uint64
+
+	// TotalAbsError is the sum of the absolute differences between the
+	// predicted and actual blocks to confirm.
+	TotalAbsError uint64
+}
+
+// estimateFeeRate returns the fee rate estimate for the given coin type and
+// target confirmation blocks.
+//
+// This function MUST be called with the calculator lock held (for reads).
+func (calc *CoinTypeFeeCalculator) estimateFeeRate(coinType cointype.CoinType, targetConfirmations int) (dcrutil.Amount, bool) {
+	feeRate, exists := calc.feeRates[coinType]
+	if !exists {
+		return 0, false
+	}
+
+	stats, exists := calc.utilizationStats[coinType]
+	if !exists {
+		return feeRate.MinRelayFee, true
+	}
+
+	// Factor in the dynamic multiplier and adjust based on target
+	// confirmations - faster confirmation = higher fee.
+	estimatedRate := dcrutil.Amount(float64(feeRate.MinRelayFee) *
+		feeRate.DynamicFeeMultiplier)
+	confirmationMultiplier := calc.calculateConfirmationMultiplier(
+		targetConfirmations, stats)
+	estimatedRate = dcrutil.Amount(float64(estimatedRate) *
+		confirmationMultiplier)
+
+	// Ensure within bounds.
+	if estimatedRate > feeRate.MaxFeeRate {
+		estimatedRate = feeRate.MaxFeeRate
+	}
+	if estimatedRate < feeRate.MinRelayFee {
+		estimatedRate = feeRate.MinRelayFee
+	}
+	return estimatedRate, true
+}
+
+// PredictConfirmations returns the number of blocks a transaction of the given
+// coin type paying the given fee for the given serialized size is predicted to
+// take to confirm.  It is the lowest target confirmation whose current fee rate
+// estimate the transaction pays, up to MaxPredictedConfirmations.  Zero is
+// returned for unsupported coin types and invalid sizes.
+func (calc *CoinTypeFeeCalculator) PredictConfirmations(coinType cointype.CoinType, fee, size int64) int32 {
+	if size <= 0 {
+		return 0
+	}
+	txFeeRate := dcrutil.Amount((fee * 1000) / size)
+
+	calc.mu.RLock()
+	defer calc.mu.RUnlock()
+
+	for target := 1; target < MaxPredictedConfirmations; target++ {
+		estimate, ok := calc.estimateFeeRate(coinType, target)
+		if !ok {
+			return 0
+		}
+		if txFeeRate >= estimate {
+			return int32(target)
+		}
+	}
+	return MaxPredictedConfirmations
+}
+
+// RecordConfirmation records that a transaction of the given coin type that
+// was predicted to take the given number of blocks to confirm actually took the
+// given number of blocks.  Transactions without a prediction are ignored.
+func (calc *CoinTypeFeeCalculator) RecordConfirmation(coinType cointype.CoinType, predictedConfs, actualConfs int32) {
+	if predictedConfs <= 0 || actualConfs <= 0 {
+		return
+	}
+
+	calc.mu.Lock()
+	defer calc.mu.Unlock()
+
+	accuracy, ok := calc.accuracy[coinType]
+	if !ok {
+		accuracy = &EstimateAccuracy{CoinType: coinType}
+		calc.accuracy[coinType] = accuracy
+	}
+	accuracy.Samples++
+	accuracy.TotalPredictedConfs += uint64(predictedConfs)
+	accuracy.TotalActualConfs += uint64(actualConfs)
+	if actualConfs <= predictedConfs {
+		accuracy.OnTime++
+		accuracy.TotalAbsError += uint64(predictedConfs - actualConfs)
+	} else {
+		accuracy.Late++
+		accuracy.TotalAbsError += uint64(actualConfs - predictedConfs)
+	}
+	cfeeLog.Tracef("Recorded %v confirmation after %d blocks (predicted %d)",
+		coinType, actualConfs, predictedConfs)
+}
+
+// EstimateAccuracy returns the accuracy of the fee rate estimates of every coin
+// type with recorded confirmations ordered by coin type.
+func (calc *CoinTypeFeeCalculator) EstimateAccuracy() []EstimateAccuracy {
+	calc.mu.RLock()
+	defer calc.mu.RUnlock()
+
+	accuracy := make([]EstimateAccuracy, 0, len(calc.accuracy))
+	for _, a := range calc.accuracy {
+		accuracy = append(accuracy, *a)
+	}
+	sort.Slice(accuracy, func(i, j int) bool {
+		return accuracy[i].CoinType < accuracy[j].CoinType
+	})
+	return accuracy
+}
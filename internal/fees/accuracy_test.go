@@ -0,0 +1,84 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package fees
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+)
+
+// TestPredictConfirmations ensures the predicted number of blocks to confirm
+// is the lowest target confirmation whose fee rate estimate is paid.
+func TestPredictConfirmations(t *testing.T) {
+	calc := NewCoinTypeFeeCalculator(chaincfg.SimNetParams(), dcrutil.Amount(1e4))
+
+	// With no utilization, the VAR estimates are 2x the minimum relay fee
+	// for the next block, 1.5x within 3 blocks, 1.2x within 6 blocks, and
+	// the minimum relay fee otherwise.
+	tests := []struct {
+		name     string
+		coinType cointype.CoinType
+		fee      int64
+		size     int64
+		want     int32
+	}{
+		{"next block", cointype.CoinTypeVAR, 20000, 1000, 1},
+		{"above next block", cointype.CoinTypeVAR, 90000, 1000, 1},
+		{"within 3 blocks", cointype.CoinTypeVAR, 15000, 1000, 2},
+		{"within 6 blocks", cointype.CoinTypeVAR, 12000, 1000, 4},
+		{"minimum relay fee", cointype.CoinTypeVAR, 10000, 1000, 7},
+		{"below minimum relay fee", cointype.CoinTypeVAR, 5000, 1000,
+			MaxPredictedConfirmations},
+		{"unsupported coin type", 200, 20000, 1000, 0},
+		{"invalid size", cointype.CoinTypeVAR, 20000, 0, 0},
+	}
+	for _, test := range tests {
+		got := calc.PredictConfirmations(test.coinType, test.fee, test.size)
+		if got != test.want {
+			t.Errorf("%s: got %d predicted confirmations, want %d",
+				test.name, got, test.want)
+		}
+	}
+}
+
+// TestRecordConfirmation ensures the accuracy of the fee rate estimates is
+// aggregated per coin type and that transactions without a prediction are
+// ignored.
+func TestRecordConfirmation(t *testing.T) {
+	calc := NewCoinTypeFeeCalculator(chaincfg.SimNetParams(), dcrutil.Amount(1e4))
+	if got := calc.EstimateAccuracy(); len(got) != 0 {
+		t.Fatalf("unexpected accuracy for new calculator: %+v", got)
+	}
+
+	calc.RecordConfirmation(1, 2, 2)
+	calc.RecordConfirmation(cointype.CoinTypeVAR, 1, 1)
+	calc.RecordConfirmation(cointype.CoinTypeVAR, 4, 1)
+	calc.RecordConfirmation(cointype.CoinTypeVAR, 2, 5)
+	calc.RecordConfirmation(cointype.CoinTypeVAR, 0, 3)
+	calc.RecordConfirmation(1, 2, 0)
+
+	want := []EstimateAccuracy{{
+		CoinType:            cointype.CoinTypeVAR,
+		Samples:             3,
+		OnTime:              2,
+		Late:                1,
+		TotalPredictedConfs: 7,
+		TotalActualConfs:    7,
+		TotalAbsError:       6,
+	}, {
+		CoinType:            1,
+		Samples:             1,
+		OnTime:              1,
+		TotalPredictedConfs: 2,
+		TotalActualConfs:    2,
+	}}
+	if got := calc.EstimateAccuracy(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("mismatched accuracy -- got %+v, want %+v", got, want)
+	}
+}
@@ -48,6 +48,13 @@ type CoinTypeFeeCalculator struct {
 
 	// updateInterval controls how often fee rates are recalculated
 	updateInterval time.Duration
+
+	// accuracy tracks the accuracy of the fee rate estimates per coin type
+	accuracy map[cointype.CoinType]*EstimateAccuracy
+
+	// activeHeight is the height of the block the supported SKA coin types
+	// were last determined for.
+	activeHeight int64
 }
 
 // UtilizationStats tracks network utilization metrics for dynamic fee calculation
@@ -77,6 +84,7 @@ func NewCoinTypeFeeCalculator(chainParams *chaincfg.Params, defaultMinRelayFee d
 		chainParams:        chainParams,
 		feeRates:           make(map[cointype.CoinType]*CoinTypeFeeRate),
 		utilizationStats:   make(map[cointype.CoinType]*UtilizationStats),
+		accuracy:           make(map[cointype.CoinType]*EstimateAccuracy),
 		defaultMinRelayFee: defaultMinRelayFee,
 		updateInterval:     time.Minute * 5, // Update every 5 minutes
 	}
@@ -106,16 +114,53 @@ func (calc *CoinTypeFeeCalculator) initializeDefaultFeeRates() {
 		LastBlockIncluded: now,
 	}
 
-	// Initialize all active SKA coins from chain configuration
-	for coinType, config := range calc.chainParams.SKACoins {
-		if config.Active {
+	// Initialize all SKA coins that are active in the genesis block from
+	// chain configuration.  The remaining ones are initialized once they
+	// become active.
+	calc.updateActiveCoinTypes(0, now)
+}
+
+// updateActiveCoinTypes initializes the fee rates of the SKA coin types that
+// are active in the block at the provided height and removes the ones that are
+// not.
+//
+// This function MUST be called with the calculator lock held (for writes).
+func (calc *CoinTypeFeeCalculator) updateActiveCoinTypes(height int64, now time.Time) {
+	for _, coinType := range calc.chainParams.GetAllSKATypes() {
+		_, supported := calc.feeRates[coinType]
+		active := calc.chainParams.IsSKACoinTypeActiveAtHeight(coinType, height)
+		switch {
+		case active && !supported:
 			calc.feeRates[coinType] = calc.getDefaultSKAFeeRate()
 			calc.utilizationStats[coinType] = &UtilizationStats{
 				RecentTxFees:      make([]int64, 0, 100),
 				LastBlockIncluded: now,
 			}
+		case !active && supported:
+			delete(calc.feeRates, coinType)
+			delete(calc.utilizationStats, coinType)
 		}
 	}
+	calc.activeHeight = height
+}
+
+// UpdateActiveCoinTypes updates the SKA coin types supported by the calculator
+// to the ones that are active in the block at the provided height, which is
+// expected to be the height of the next block.  This ensures the calculator
+// agrees with the block space allocation and the mempool on which coin types
+// are active once their activation heights are reached or a reorganization
+// moves the chain below them.
+//
+// This function is safe for concurrent access.
+func (calc *CoinTypeFeeCalculator) UpdateActiveCoinTypes(height int64) {
+	calc.mu.Lock()
+	defer calc.mu.Unlock()
+
+	if height == calc.activeHeight {
+		return
+	}
+	calc.updateActiveCoinTypes(height, time.Now())
+	cfeeLog.Tracef("Updated the supported coin types for height %d", height)
 }
 
 // getDefaultSKAFeeRate returns default fee rate configuration for SKA coin types.
@@ -177,34 +222,10 @@ func (calc *CoinTypeFeeCalculator) EstimateFeeRate(coinType cointype.CoinType, t
 	calc.mu.RLock()
 	defer calc.mu.RUnlock()
 
-	feeRate, exists := calc.feeRates[coinType]
-	if !exists {
+	estimatedRate, ok := calc.estimateFeeRate(coinType, targetConfirmations)
+	if !ok {
 		return 0, fmt.Errorf("unsupported coin type: %d", coinType)
 	}
-
-	stats, exists := calc.utilizationStats[coinType]
-	if !exists {
-		return feeRate.MinRelayFee, nil
-	}
-
-	// Calculate estimated fee rate based on recent transactions and target confirmations
-	estimatedRate := feeRate.MinRelayFee
-
-	// Factor in dynamic multiplier
-	estimatedRate = dcrutil.Amount(float64(estimatedRate) * feeRate.DynamicFeeMultiplier)
-
-	// Adjust based on target confirmations - faster confirmation = higher fee
-	confirmationMultiplier := calc.calculateConfirmationMultiplier(targetConfirmations, stats)
-	estimatedRate = dcrutil.Amount(float64(estimatedRate) * confirmationMultiplier)
-
-	// Ensure within bounds
-	if estimatedRate > feeRate.MaxFeeRate {
-		estimatedRate = feeRate.MaxFeeRate
-	}
-	if estimatedRate < feeRate.MinRelayFee {
-		estimatedRate = feeRate.MinRelayFee
-	}
-
 	return estimatedRate, nil
 }
 
@@ -254,6 +275,35 @@ func (calc *CoinTypeFeeCalculator) UpdateUtilization(coinType cointype.CoinType,
 	calc.updateDynamicFeeMultiplier(coinType, stats)
 }
 
+// SeedUtilization replays the block space utilization of the provided recent
+// blocks, ordered newest first as returned by the utilization history, so the
+// dynamic fee multipliers reflect recent network conditions immediately after
+// a restart instead of starting from their defaults.
+func (calc *CoinTypeFeeCalculator) SeedUtilization(blocks []BlockUtilization) {
+	calc.mu.Lock()
+	defer calc.mu.Unlock()
+
+	for i := len(blocks) - 1; i >= 0; i-- {
+		for _, u := range blocks[i].CoinTypes {
+			if u.AllocatedBytes == 0 {
+				continue
+			}
+			stats, exists := calc.utilizationStats[u.CoinType]
+			if !exists {
+				stats = &UtilizationStats{
+					RecentTxFees:      make([]int64, 0, 100),
+					LastBlockIncluded: time.Now(),
+				}
+				calc.utilizationStats[u.CoinType] = stats
+			}
+			stats.BlockSpaceUsed = u.Utilization()
+			calc.updateDynamicFeeMultiplier(u.CoinType, stats)
+		}
+	}
+	cfeeLog.Debugf("Seeded dynamic fee multipliers from the utilization of "+
+		"%d recent block(s)", len(blocks))
+}
+
 // updateDynamicFeeMultiplier adjusts fee multiplier based on network conditions
 func (calc *CoinTypeFeeCalculator) updateDynamicFeeMultiplier(coinType cointype.CoinType, stats *UtilizationStats) {
 	feeRate, exists := calc.feeRates[coinType]
@@ -298,6 +348,10 @@ func (calc *CoinTypeFeeCalculator) updateDynamicFeeMultiplier(coinType cointype.
 	if feeRate.DynamicFeeMultiplier < 0.5 {
 		feeRate.DynamicFeeMultiplier = 0.5 // Min 0.5x multiplier
 	}
+	cfeeLog.Tracef("Updated dynamic fee multiplier of %v to %.3f (target "+
+		"%.3f, block space used %.1f%%, %d pending txns)", coinType,
+		feeRate.DynamicFeeMultiplier, newMultiplier,
+		stats.BlockSpaceUsed*100, stats.PendingTxCount)
 
 	feeRate.LastUpdated = time.Now()
 }
@@ -491,14 +545,10 @@ func (calc *CoinTypeFeeCalculator) ValidateTransactionFees(txFee int64, serializ
 }
 
 // GetSupportedCoinTypes returns a list of coin types supported by the fee calculator
+// in ascending order.
 func (calc *CoinTypeFeeCalculator) GetSupportedCoinTypes() []cointype.CoinType {
 	calc.mu.RLock()
 	defer calc.mu.RUnlock()
 
-	coinTypes := make([]cointype.CoinType, 0, len(calc.feeRates))
-	for coinType := range calc.feeRates {
-		coinTypes = append(coinTypes, coinType)
-	}
-
-	return coinTypes
+	return cointype.SortedKeys(calc.feeRates)
 }
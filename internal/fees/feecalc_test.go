@@ -5,6 +5,7 @@
 package fees
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -553,3 +554,39 @@ func TestInitializeActiveSKACoinsFromConfig(t *testing.T) {
 
 	t.Logf("Successfully verified %d active SKA coins are initialized from config", len(expectedActiveSKACoins))
 }
+
+// TestUpdateActiveCoinTypes ensures the calculator only supports the SKA coin
+// types that are active at the height it was last updated for.
+func TestUpdateActiveCoinTypes(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	params.SKACoins[1].ActivationHeight = 100
+	calc := NewCoinTypeFeeCalculator(params, dcrutil.Amount(1e4))
+
+	supported := func(coinType cointype.CoinType) bool {
+		_, err := calc.GetFeeStats(coinType)
+		return err == nil
+	}
+	if supported(1) {
+		t.Fatal("coin type 1 supported before its activation height")
+	}
+
+	calc.UpdateActiveCoinTypes(100)
+	if !supported(1) {
+		t.Fatal("coin type 1 not supported at its activation height")
+	}
+	want := []cointype.CoinType{cointype.CoinTypeVAR, 1}
+	if got := calc.GetSupportedCoinTypes(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected supported coin types: got %v, want %v", got,
+			want)
+	}
+
+	// Moving below the activation height, such as after a reorganization,
+	// removes support for the coin type again.
+	calc.UpdateActiveCoinTypes(99)
+	if supported(1) {
+		t.Fatal("coin type 1 supported below its activation height")
+	}
+	if !supported(cointype.CoinTypeVAR) {
+		t.Fatal("VAR not supported")
+	}
+}
@@ -11,7 +11,10 @@ import (
 // log is a logger that is initialized with no output filters.  This means the
 // package will not perform any logging by default until the caller requests it.
 // The default amount of logging is none.
-var log = slog.Disabled
+var (
+	log     = slog.Disabled
+	cfeeLog = slog.Disabled
+)
 
 // UseLogger uses a specified Logger to output fee estimator logging info. This
 // should be used in preference to SetLogWriter if the caller is also using
@@ -19,3 +22,9 @@ var log = slog.Disabled
 func UseLogger(logger slog.Logger) {
 	log = logger
 }
+
+// UseCoinTypeFeeLogger uses a specified Logger to output coin type fee
+// calculator logging info.
+func UseCoinTypeFeeLogger(logger slog.Logger) {
+	cfeeLog = logger
+}
@@ -0,0 +1,321 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package fees
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+const (
+	// DefaultUtilizationHistorySize is the default number of blocks the
+	// utilization history keeps.  It is one week of blocks at the target
+	// block time of five minutes.
+	DefaultUtilizationHistorySize = 2016
+
+	// MaxUtilizationCoinTypes is the maximum number of coin types recorded
+	// for a single block.
+	MaxUtilizationCoinTypes = 16
+
+	// utilHistoryMagic identifies a serialized utilization history.
+	utilHistoryMagic = 0x4c54554d // "MUTL"
+
+	// utilHistoryVersion is the current version of the serialized
+	// utilization history format.
+	utilHistoryVersion = 1
+
+	// utilHistoryHeaderSize is the size of the header of a serialized
+	// utilization history:
+	// [magic:4][version:4][capacity:4][next slot:4][num blocks:4]
+	utilHistoryHeaderSize = 4 + 4 + 4 + 4 + 4
+
+	// utilEntrySize is the size of the serialized utilization of a single
+	// coin type:
+	// [coin type:1][num txns:4][used bytes:4][allocated bytes:4]
+	utilEntrySize = 1 + 4 + 4 + 4
+
+	// utilSlotSize is the size of the serialized utilization of a single
+	// block:
+	// [height:8][hash:32][num coin types:1][entries...]
+	//
+	// Every slot has room for the max number of coin types so the slot of a
+	// block is always at the same offset.
+	utilSlotSize = 8 + chainhash.HashSize + 1 +
+		MaxUtilizationCoinTypes*utilEntrySize
+)
+
+// CoinTypeUtilization houses the block space utilization of a single coin type
+// in a block.
+type CoinTypeUtilization struct {
+	CoinType       cointype.CoinType
+	NumTxns        uint32
+	UsedBytes      uint32
+	AllocatedBytes uint32
+}
+
+// Utilization returns the fraction of the space allocated to the coin type
+// that was used.  It is zero when no space was allocated.
+func (u *CoinTypeUtilization) Utilization() float64 {
+	if u.AllocatedBytes == 0 {
+		return 0
+	}
+	return float64(u.UsedBytes) / float64(u.AllocatedBytes)
+}
+
+// BlockUtilization houses the block space utilization of every coin type that
+// either has transactions in a main chain block or is allocated space in it
+// ordered by coin type.
+type BlockUtilization struct {
+	Height    int64
+	Hash      chainhash.Hash
+	CoinTypes []CoinTypeUtilization
+}
+
+// UtilizationHistory is a fixed-size ring buffer of the block space
+// utilization of the most recent main chain blocks that is kept on disk so it
+// survives restarts.  Each block is stored in a fixed-size slot, so recording
+// a block only writes its slot and the header.
+//
+// Only the first MaxUtilizationCoinTypes coin types of a block are recorded.
+type UtilizationHistory struct {
+	mtx    sync.Mutex
+	file   *os.File
+	blocks []BlockUtilization
+	next   int
+	count  int
+}
+
+// OpenUtilizationHistory opens the utilization history stored in the file at
+// the provided path, creating it when it does not exist.  Existing histories
+// that can't be read or that were created with a different capacity are
+// discarded.
+func OpenUtilizationHistory(path string, capacity int) (*UtilizationHistory, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("invalid utilization history capacity %d",
+			capacity)
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	h := &UtilizationHistory{
+		file:   file,
+		blocks: make([]BlockUtilization, capacity),
+	}
+	if err := h.load(); err != nil {
+		cfeeLog.Warnf("Discarding utilization history %s: %v", path, err)
+		h.next, h.count = 0, 0
+		if err := h.reset(); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	cfeeLog.Debugf("Loaded utilization history of %d block(s)", h.count)
+	return h, nil
+}
+
+// load reads the header and the recorded blocks from the file.
+func (h *UtilizationHistory) load() error {
+	var header [utilHistoryHeaderSize]byte
+	_, err := h.file.ReadAt(header[:], 0)
+	if errors.Is(err, io.EOF) {
+		return h.reset()
+	}
+	if err != nil {
+		return err
+	}
+	capacity := len(h.blocks)
+	switch {
+	case binary.LittleEndian.Uint32(header[0:4]) != utilHistoryMagic:
+		return fmt.Errorf("unrecognized file format")
+	case binary.LittleEndian.Uint32(header[4:8]) != utilHistoryVersion:
+		return fmt.Errorf("unsupported version %d",
+			binary.LittleEndian.Uint32(header[4:8]))
+	case binary.LittleEndian.Uint32(header[8:12]) != uint32(capacity):
+		return fmt.Errorf("capacity %d does not match the configured "+
+			"capacity %d", binary.LittleEndian.Uint32(header[8:12]), capacity)
+	}
+	next := int(binary.LittleEndian.Uint32(header[12:16]))
+	count := int(binary.LittleEndian.Uint32(header[16:20]))
+	if next >= capacity || count > capacity {
+		return fmt.Errorf("invalid header")
+	}
+
+	var slot [utilSlotSize]byte
+	for i := 0; i < count; i++ {
+		idx := (next - count + i + capacity) % capacity
+		if _, err := h.file.ReadAt(slot[:], slotOffset(idx)); err != nil {
+			return err
+		}
+		block, err := deserializeBlockUtilization(slot[:])
+		if err != nil {
+			return err
+		}
+		h.blocks[idx] = *block
+	}
+	h.next, h.count = next, count
+	return nil
+}
+
+// reset truncates the file and writes a header for an empty history.
+func (h *UtilizationHistory) reset() error {
+	if err := h.file.Truncate(0); err != nil {
+		return err
+	}
+	return h.writeHeader()
+}
+
+// slotOffset returns the offset of the slot with the provided index.
+func slotOffset(idx int) int64 {
+	return utilHistoryHeaderSize + int64(idx)*utilSlotSize
+}
+
+// writeHeader writes the header of the history to the file.
+func (h *UtilizationHistory) writeHeader() error {
+	var header [utilHistoryHeaderSize]byte
+	binary.LittleEndian.PutUint32(header[0:4], utilHistoryMagic)
+	binary.LittleEndian.PutUint32(header[4:8], utilHistoryVersion)
+	binary.LittleEndian.PutUint32(header[8:12], uint32(len(h.blocks)))
+	binary.LittleEndian.PutUint32(header[12:16], uint32(h.next))
+	binary.LittleEndian.PutUint32(header[16:20], uint32(h.count))
+	_, err := h.file.WriteAt(header[:], 0)
+	return err
+}
+
+// serializeBlockUtilization returns the serialized slot of the provided block.
+func serializeBlockUtilization(block *BlockUtilization) []byte {
+	slot := make([]byte, utilSlotSize)
+	binary.LittleEndian.PutUint64(slot[0:8], uint64(block.Height))
+	copy(slot[8:8+chainhash.HashSize], block.Hash[:])
+	coinTypes := block.CoinTypes
+	if len(coinTypes) > MaxUtilizationCoinTypes {
+		coinTypes = coinTypes[:MaxUtilizationCoinTypes]
+	}
+	offset := 8 + chainhash.HashSize
+	slot[offset] = byte(len(coinTypes))
+	offset++
+	for _, u := range coinTypes {
+		slot[offset] = byte(u.CoinType)
+		binary.LittleEndian.PutUint32(slot[offset+1:], u.NumTxns)
+		binary.LittleEndian.PutUint32(slot[offset+5:], u.UsedBytes)
+		binary.LittleEndian.PutUint32(slot[offset+9:], u.AllocatedBytes)
+		offset += utilEntrySize
+	}
+	return slot
+}
+
+// deserializeBlockUtilization decodes the provided serialized slot.
+func deserializeBlockUtilization(slot []byte) (*BlockUtilization, error) {
+	if len(slot) != utilSlotSize {
+		return nil, fmt.Errorf("unexpected slot size %d", len(slot))
+	}
+	block := &BlockUtilization{
+		Height: int64(binary.LittleEndian.Uint64(slot[0:8])),
+	}
+	copy(block.Hash[:], slot[8:8+chainhash.HashSize])
+	offset := 8 + chainhash.HashSize
+	numCoinTypes := int(slot[offset])
+	if numCoinTypes > MaxUtilizationCoinTypes {
+		return nil, fmt.Errorf("block %v records %d coin types", block.Hash,
+			numCoinTypes)
+	}
+	offset++
+	block.CoinTypes = make([]CoinTypeUtilization, 0, numCoinTypes)
+	for i := 0; i < numCoinTypes; i++ {
+		block.CoinTypes = append(block.CoinTypes, CoinTypeUtilization{
+			CoinType:       cointype.CoinType(slot[offset]),
+			NumTxns:        binary.LittleEndian.Uint32(slot[offset+1:]),
+			UsedBytes:      binary.LittleEndian.Uint32(slot[offset+5:]),
+			AllocatedBytes: binary.LittleEndian.Uint32(slot[offset+9:]),
+		})
+		offset += utilEntrySize
+	}
+	return block, nil
+}
+
+// Add records the utilization of a newly connected main chain block,
+// overwriting the oldest recorded block when the history is full.
+//
+// This function is safe for concurrent access.
+func (h *UtilizationHistory) Add(block *BlockUtilization) error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	slot := serializeBlockUtilization(block)
+	if _, err := h.file.WriteAt(slot, slotOffset(h.next)); err != nil {
+		return err
+	}
+	decoded, err := deserializeBlockUtilization(slot)
+	if err != nil {
+		return err
+	}
+	h.blocks[h.next] = *decoded
+	h.next = (h.next + 1) % len(h.blocks)
+	if h.count < len(h.blocks) {
+		h.count++
+	}
+	return h.writeHeader()
+}
+
+// RemoveBlock removes the utilization of a disconnected main chain block when
+// it is the most recently recorded block.
+//
+// This function is safe for concurrent access.
+func (h *UtilizationHistory) RemoveBlock(hash *chainhash.Hash) error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.count == 0 {
+		return nil
+	}
+	last := (h.next - 1 + len(h.blocks)) % len(h.blocks)
+	if h.blocks[last].Hash != *hash {
+		return nil
+	}
+	h.blocks[last] = BlockUtilization{}
+	h.next = last
+	h.count--
+	return h.writeHeader()
+}
+
+// Recent returns the utilization of up to the provided number of the most
+// recently recorded blocks, newest first.
+//
+// This function is safe for concurrent access.
+func (h *UtilizationHistory) Recent(count int) []BlockUtilization {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if count > h.count {
+		count = h.count
+	}
+	result := make([]BlockUtilization, 0, count)
+	for i := 1; i <= count; i++ {
+		idx := (h.next - i + len(h.blocks)) % len(h.blocks)
+		block := h.blocks[idx]
+		block.CoinTypes = append([]CoinTypeUtilization(nil),
+			block.CoinTypes...)
+		result = append(result, block)
+	}
+	return result
+}
+
+// Close closes the file the history is stored in.
+//
+// This function is safe for concurrent access.
+func (h *UtilizationHistory) Close() error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	return h.file.Close()
+}
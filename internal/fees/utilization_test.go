@@ -0,0 +1,152 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package fees
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+)
+
+// TestUtilizationHistory ensures the utilization history keeps the most recent
+// blocks up to its capacity, removes disconnected blocks, and survives being
+// reopened.
+func TestUtilizationHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "utilization.dat")
+	h, err := OpenUtilizationHistory(path, 3)
+	if err != nil {
+		t.Fatalf("Failed to open utilization history: %v", err)
+	}
+	if got := h.Recent(10); len(got) != 0 {
+		t.Fatalf("unexpected blocks in new history: %+v", got)
+	}
+
+	blockAt := func(height int64) *BlockUtilization {
+		return &BlockUtilization{
+			Height: height,
+			Hash:   chainhash.HashH([]byte{byte(height)}),
+			CoinTypes: []CoinTypeUtilization{{
+				CoinType:       cointype.CoinTypeVAR,
+				NumTxns:        uint32(height),
+				UsedBytes:      uint32(height * 1000),
+				AllocatedBytes: 10000,
+			}, {
+				CoinType:       1,
+				AllocatedBytes: 5000,
+			}},
+		}
+	}
+	heights := func(blocks []BlockUtilization) []int64 {
+		result := make([]int64, 0, len(blocks))
+		for _, block := range blocks {
+			result = append(result, block.Height)
+		}
+		return result
+	}
+
+	for height := int64(1); height <= 4; height++ {
+		if err := h.Add(blockAt(height)); err != nil {
+			t.Fatalf("Failed to add block %d: %v", height, err)
+		}
+	}
+	if got, want := heights(h.Recent(10)), []int64{4, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected recent blocks: got %v, want %v", got, want)
+	}
+	if got := h.Recent(1)[0]; !reflect.DeepEqual(&got, blockAt(4)) {
+		t.Fatalf("unexpected most recent block: %+v", got)
+	}
+
+	// Only the most recent block is removed when disconnected.
+	if err := h.RemoveBlock(&blockAt(3).Hash); err != nil {
+		t.Fatalf("Failed to remove block: %v", err)
+	}
+	if err := h.RemoveBlock(&blockAt(4).Hash); err != nil {
+		t.Fatalf("Failed to remove block: %v", err)
+	}
+	if got, want := heights(h.Recent(10)), []int64{3, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected recent blocks after removal: got %v, want %v",
+			got, want)
+	}
+	if err := h.Add(blockAt(5)); err != nil {
+		t.Fatalf("Failed to add block: %v", err)
+	}
+	if err := h.Close(); err != nil {
+		t.Fatalf("Failed to close utilization history: %v", err)
+	}
+
+	// The recorded blocks survive reopening the history.
+	h, err = OpenUtilizationHistory(path, 3)
+	if err != nil {
+		t.Fatalf("Failed to reopen utilization history: %v", err)
+	}
+	if got, want := heights(h.Recent(10)), []int64{5, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected recent blocks after reopening: got %v, want %v",
+			got, want)
+	}
+	h.Close()
+
+	// Histories with a different capacity or in an unknown format are
+	// discarded.
+	h, err = OpenUtilizationHistory(path, 4)
+	if err != nil {
+		t.Fatalf("Failed to reopen utilization history: %v", err)
+	}
+	if got := h.Recent(10); len(got) != 0 {
+		t.Fatalf("unexpected blocks after changing capacity: %+v", got)
+	}
+	h.Close()
+	if err := os.WriteFile(path, []byte("garbage data"), 0600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	h, err = OpenUtilizationHistory(path, 4)
+	if err != nil {
+		t.Fatalf("Failed to reopen utilization history: %v", err)
+	}
+	if got := h.Recent(10); len(got) != 0 {
+		t.Fatalf("unexpected blocks in corrupt history: %+v", got)
+	}
+	h.Close()
+}
+
+// TestSeedUtilization ensures seeding the fee calculator with the utilization
+// of recent blocks raises the dynamic fee multiplier of congested coin types.
+func TestSeedUtilization(t *testing.T) {
+	calc := NewCoinTypeFeeCalculator(chaincfg.SimNetParams(), dcrutil.Amount(1e4))
+	before, err := calc.GetFeeStats(cointype.CoinTypeVAR)
+	if err != nil {
+		t.Fatalf("Failed to get fee stats: %v", err)
+	}
+
+	blocks := make([]BlockUtilization, 0, 10)
+	for i := 0; i < 10; i++ {
+		blocks = append(blocks, BlockUtilization{
+			Height: int64(100 - i),
+			CoinTypes: []CoinTypeUtilization{{
+				CoinType:       cointype.CoinTypeVAR,
+				UsedBytes:      9500,
+				AllocatedBytes: 10000,
+			}},
+		})
+	}
+	calc.SeedUtilization(blocks)
+
+	after, err := calc.GetFeeStats(cointype.CoinTypeVAR)
+	if err != nil {
+		t.Fatalf("Failed to get fee stats: %v", err)
+	}
+	if after.DynamicFeeMultiplier <= before.DynamicFeeMultiplier {
+		t.Fatalf("dynamic fee multiplier not raised: before %v, after %v",
+			before.DynamicFeeMultiplier, after.DynamicFeeMultiplier)
+	}
+	if after.BlockSpaceUsed != 0.95 {
+		t.Fatalf("unexpected block space used: %v", after.BlockSpaceUsed)
+	}
+}
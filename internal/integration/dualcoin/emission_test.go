@@ -0,0 +1,120 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package dualcoin
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+// TestEmissionReorgConvergence ensures multiple in-process nodes that are
+// relayed an SKA emission, a competing chain that reorganizes the emission
+// out in favor of another one with a higher nonce, and a chain that
+// reorganizes the original emission back in all converge on the same tip,
+// emission nonce, emitted tranches, and UTXO supply regardless of whether
+// they receive the headers ahead of the blocks.  It also ensures an emission
+// once every scheduled tranche has been emitted is rejected by all of them.
+func TestEmissionReorgConvergence(t *testing.T) {
+	const coinType = cointype.CoinType(1)
+	n := newTestNetwork(t, 3, coinType)
+	node0, node1, node2 := n.nodes[0], n.nodes[1], n.nodes[2]
+
+	// Shorter versions of useful params for convenience.
+	emissionHeight := uint32(n.params.SKACoins[coinType].EmissionHeight)
+
+	// ---------------------------------------------------------------------
+	// Generate and relay enough blocks to reach the block just before the
+	// start of the emission window.
+	//
+	//   genesis -> bfb -> b2 -> ... -> b#
+	// ---------------------------------------------------------------------
+
+	n.advanceToHeight(emissionHeight - 1)
+	baseName := n.TipName()
+	n.assertConverged(baseName, 0, 0)
+
+	// ---------------------------------------------------------------------
+	// Create a block with an emission that commits to nonce 1, process it on
+	// the node that mined it, and relay it to the others.
+	//
+	//   ... -> b# -> bem0
+	// ---------------------------------------------------------------------
+
+	emission := n.createEmission(1, int64(emissionHeight))
+	n.NextBlock("bem0", nil, nil, includeEmission(emission))
+	n.relay(node0, "bem0")
+	n.relay(node1, "bem0")
+	n.relay(node2, "bem0")
+	n.assertConverged("bem0", 1, 1)
+
+	// ---------------------------------------------------------------------
+	// Create a competing chain without the emission that has more work and
+	// ensure all nodes reorganize the emission out and roll back its nonce.
+	// The third node receives the headers of the competing chain ahead of
+	// the blocks as it would during a headers-first sync.
+	//
+	//   ... -> b# -> bem0
+	//            \-> bfk0 -> bfk1
+	// ---------------------------------------------------------------------
+
+	n.SetTip(baseName)
+	n.NextBlock("bfk0", nil, nil)
+	n.NextBlock("bfk1", nil, nil)
+	n.relay(node0, "bfk0", "bfk1")
+	n.relay(node1, "bfk0", "bfk1")
+	n.relayHeaders(node2, "bfk0", "bfk1")
+	n.relay(node2, "bfk0", "bfk1")
+	n.assertConverged("bfk1", 0, 0)
+
+	// ---------------------------------------------------------------------
+	// Extend the competing chain with another emission that commits to nonce
+	// 2 and ensure all nodes connect it.
+	//
+	//   ... -> b# -> bem0
+	//            \-> bfk0 -> bfk1 -> bfk2
+	// ---------------------------------------------------------------------
+
+	emission = n.createEmission(2, int64(emissionHeight)+2)
+	n.NextBlock("bfk2", nil, nil, includeEmission(emission))
+	n.relayAll("bfk2")
+	n.assertConverged("bfk2", 2, 1)
+
+	// ---------------------------------------------------------------------
+	// Extend the original chain so it has more work than the competing one
+	// and ensure all nodes reorganize back to the original emission with its
+	// nonce restored, which requires the emission that committed to the
+	// higher nonce to be disconnected first.  The second node receives the
+	// headers ahead of the blocks this time.
+	//
+	//   ... -> b# -> bem0 -> bem1 -> bem2 -> bem3
+	//            \-> bfk0 -> bfk1 -> bfk2
+	// ---------------------------------------------------------------------
+
+	n.SetTip("bem0")
+	n.NextBlock("bem1", nil, nil)
+	n.NextBlock("bem2", nil, nil)
+	n.NextBlock("bem3", nil, nil)
+	n.relay(node0, "bem1", "bem2", "bem3")
+	n.relayHeaders(node1, "bem1", "bem2", "bem3")
+	n.relay(node1, "bem1", "bem2", "bem3")
+	n.relay(node2, "bem1", "bem2", "bem3")
+	n.assertConverged("bem3", 1, 1)
+
+	// ---------------------------------------------------------------------
+	// Create a block with another emission that commits to a higher nonce
+	// and ensure all nodes reject it since every scheduled tranche has
+	// already been emitted.
+	//
+	//   ... -> bem3
+	//               \-> bem4bad
+	// ---------------------------------------------------------------------
+
+	emission = n.createEmission(3, int64(emissionHeight)+4)
+	n.NextBlock("bem4bad", nil, nil, includeEmission(emission))
+	n.rejectAll("bem4bad")
+	n.SetTip("bem3")
+	n.assertConverged("bem3", 1, 1)
+}
@@ -0,0 +1,335 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package dualcoin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/chaingen"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/database"
+	_ "github.com/monetarium/monetarium-node/database/ffldb"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// testNode houses an in-process node with its own block database, UTXO
+// database, and chain instance.
+type testNode struct {
+	name  string
+	chain *blockchain.BlockChain
+}
+
+// newTestNode creates a new node for the provided chain parameters with the
+// genesis block already inserted.  Its databases are removed when the test
+// finishes.
+func newTestNode(t *testing.T, name string, params *chaincfg.Params) *testNode {
+	t.Helper()
+
+	db, err := database.Create("ffldb", t.TempDir(), params.Net)
+	if err != nil {
+		t.Fatalf("%s: failed to create block database: %v", name, err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	ctx := context.Background()
+	utxoDb, err := blockchain.LoadUtxoDB(ctx, params, t.TempDir())
+	if err != nil {
+		t.Fatalf("%s: failed to load UTXO database: %v", name, err)
+	}
+	t.Cleanup(func() {
+		_ = utxoDb.Close()
+	})
+
+	sigCache, err := txscript.NewSigCache(1000)
+	if err != nil {
+		t.Fatalf("%s: failed to create signature cache: %v", name, err)
+	}
+
+	utxoBackend := blockchain.NewLevelDbUtxoBackend(utxoDb)
+	chain, err := blockchain.New(ctx, &blockchain.Config{
+		DB:          db,
+		UtxoBackend: utxoBackend,
+		ChainParams: params,
+		TimeSource:  blockchain.NewMedianTime(),
+		SigCache:    sigCache,
+		UtxoCache: blockchain.NewUtxoCache(&blockchain.UtxoCacheConfig{
+			Backend: utxoBackend,
+			FlushBlockDB: func() error {
+				// Don't flush to disk since it is slow and the databases are
+				// discarded when the test finishes.
+				return nil
+			},
+			MaxSize: 100 * 1024 * 1024, // 100 MiB
+		}),
+	})
+	if err != nil {
+		t.Fatalf("%s: failed to create chain instance: %v", name, err)
+	}
+
+	return &testNode{name: name, chain: chain}
+}
+
+// testNetwork houses a set of in-process nodes along with a generator that
+// produces the blocks relayed between them and the private key that authorizes
+// the emissions of the SKA coin type under test.
+type testNetwork struct {
+	*chaingen.Generator
+	t          *testing.T
+	params     *chaincfg.Params
+	coinType   cointype.CoinType
+	emissionPK *secp256k1.PrivateKey
+	nodes      []*testNode
+}
+
+// newTestNetwork creates a network of the provided number of nodes on simnet
+// with the emission key of the provided SKA coin type replaced by a freshly
+// generated one so the test is able to sign emissions.
+func newTestNetwork(t *testing.T, numNodes int, coinType cointype.CoinType) *testNetwork {
+	t.Helper()
+
+	params := chaincfg.SimNetParams()
+	config, ok := params.SKACoins[coinType]
+	if !ok {
+		t.Fatalf("coin type %v is not configured on simnet", coinType)
+	}
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("failed to generate emission key: %v", err)
+	}
+	config.EmissionKey = privKey.PubKey()
+
+	// Simnet forces every consensus deployment to be active, however, the
+	// generator only produces blocks that follow the rules prior to them, so
+	// remove the forced results.  None of the deployments are able to become
+	// active within the number of blocks the tests generate.
+	for _, deployments := range params.Deployments {
+		for i := range deployments {
+			deployments[i].ForcedChoiceID = ""
+		}
+	}
+
+	g, err := chaingen.MakeGenerator(params)
+	if err != nil {
+		t.Fatalf("failed to create generator: %v", err)
+	}
+
+	n := &testNetwork{
+		Generator:  &g,
+		t:          t,
+		params:     params,
+		coinType:   coinType,
+		emissionPK: privKey,
+	}
+	for i := 0; i < numNodes; i++ {
+		name := fmt.Sprintf("node%d", i)
+		n.nodes = append(n.nodes, newTestNode(t, name, params))
+	}
+	return n
+}
+
+// relayHeaders processes the headers of the blocks associated with the given
+// names on the provided node and expects them to be accepted.
+func (n *testNetwork) relayHeaders(node *testNode, blockNames ...string) {
+	n.t.Helper()
+
+	for _, blockName := range blockNames {
+		header := &n.BlockByName(blockName).Header
+		if err := node.chain.ProcessBlockHeader(header); err != nil {
+			n.t.Fatalf("%s: header of block %q (height %d) should have been "+
+				"accepted: %v", node.name, blockName, header.Height, err)
+		}
+	}
+}
+
+// relay processes the blocks associated with the given names on the provided
+// node in order and expects them to be accepted, but not necessarily to the
+// main chain.
+func (n *testNetwork) relay(node *testNode, blockNames ...string) {
+	n.t.Helper()
+
+	for _, blockName := range blockNames {
+		// Every node is provided with its own deep copy of the block since
+		// processing it may modify the scripts it references.
+		msgBlock := n.BlockByName(blockName)
+		block := dcrutil.NewBlockDeepCopy(msgBlock)
+		if _, err := node.chain.ProcessBlock(block); err != nil {
+			n.t.Fatalf("%s: block %q (hash %s, height %d) should have been "+
+				"accepted: %v", node.name, blockName, block.Hash(),
+				msgBlock.Header.Height, err)
+		}
+	}
+}
+
+// relayAll processes the blocks associated with the given names on every node
+// of the network.
+func (n *testNetwork) relayAll(blockNames ...string) {
+	n.t.Helper()
+
+	for _, node := range n.nodes {
+		n.relay(node, blockNames...)
+	}
+}
+
+// rejectAll processes the block associated with the given name on every node
+// of the network and expects it to be rejected without changing the tip.
+func (n *testNetwork) rejectAll(blockName string) {
+	n.t.Helper()
+
+	msgBlock := n.BlockByName(blockName)
+	for _, node := range n.nodes {
+		prevTip := node.chain.BestSnapshot().Hash
+		block := dcrutil.NewBlockDeepCopy(msgBlock)
+		if _, err := node.chain.ProcessBlock(block); err == nil {
+			n.t.Fatalf("%s: block %q (hash %s, height %d) should have been "+
+				"rejected", node.name, blockName, block.Hash(),
+				msgBlock.Header.Height)
+		}
+		if tip := node.chain.BestSnapshot().Hash; tip != prevTip {
+			n.t.Fatalf("%s: tip changed from %s to %s by rejected block %q",
+				node.name, prevTip, tip, blockName)
+		}
+	}
+}
+
+// advanceToHeight generates enough blocks to reach the provided height while
+// purchasing enough tickets to keep the ticket pool populated and relays each
+// of them to every node as it is generated.  It must only be called on a
+// network whose tip is the genesis block.
+//
+//	genesis -> bfb -> b2 -> b3 -> ... -> b#
+func (n *testNetwork) advanceToHeight(height uint32) {
+	n.t.Helper()
+
+	coinbaseMaturity := uint32(n.params.CoinbaseMaturity)
+	ticketsPerBlock := uint32(n.params.TicketsPerBlock)
+	targetPoolSize := uint32(n.params.TicketPoolSize) * ticketsPerBlock
+
+	n.CreateBlockOne("bfb", 0)
+	n.relayAll("bfb")
+
+	var ticketsPurchased uint32
+	for blockHeight := uint32(2); blockHeight <= height; blockHeight++ {
+		// Purchase tickets that spend the oldest coinbase outputs once they
+		// are mature until the target ticket pool size is reached.
+		var ticketOuts []chaingen.SpendableOut
+		if blockHeight > coinbaseMaturity+1 && ticketsPurchased < targetPoolSize {
+			outs := n.OldestCoinbaseOuts()
+			ticketOuts = outs[1 : ticketsPerBlock+1]
+			ticketsPurchased += ticketsPerBlock
+		}
+
+		blockName := fmt.Sprintf("b%d", blockHeight)
+		n.NextBlock(blockName, nil, ticketOuts)
+		n.SaveTipCoinbaseOuts()
+		n.relayAll(blockName)
+	}
+	n.AssertTipHeight(height)
+}
+
+// createEmission returns a signed emission of the next scheduled tranche of
+// the coin type under test that commits to the provided nonce and height.
+func (n *testNetwork) createEmission(nonce uint64, height int64) *wire.MsgTx {
+	n.t.Helper()
+
+	tranche := n.params.SKACoins[n.coinType].NextEmissionTranche(0)
+	addrs, amounts, err := blockchain.EmissionDistribution(tranche, nil)
+	if err != nil {
+		n.t.Fatalf("failed to get emission distribution: %v", err)
+	}
+	auth := &chaincfg.SKAEmissionAuth{
+		EmissionKey: n.emissionPK.PubKey(),
+		Signature:   []byte{0},
+		Nonce:       nonce,
+		CoinType:    n.coinType,
+		Amount:      tranche.TotalAmount(),
+		Height:      height,
+	}
+	tx, err := blockchain.CreateAuthorizedSKAEmissionTransaction(auth, addrs,
+		amounts, n.params)
+	if err != nil {
+		n.t.Fatalf("failed to create emission transaction: %v", err)
+	}
+	err = blockchain.SignSKAEmissionTransaction(tx, auth, n.emissionPK, n.params)
+	if err != nil {
+		n.t.Fatalf("failed to sign emission transaction: %v", err)
+	}
+	return tx
+}
+
+// includeEmission returns a munge function that adds the provided emission to
+// the regular transaction tree of a block.
+func includeEmission(tx *wire.MsgTx) func(*wire.MsgBlock) {
+	return func(b *wire.MsgBlock) {
+		b.Transactions = append(b.Transactions, tx)
+	}
+}
+
+// assertConverged ensures every node has the block associated with the given
+// name as its tip and agrees on the emission state of the coin type under test
+// along with the supply of it held by the UTXO set.
+func (n *testNetwork) assertConverged(blockName string, wantNonce uint64, wantTranches uint32) {
+	n.t.Helper()
+
+	wantHash := n.BlockByName(blockName).BlockHash()
+	config := n.params.SKACoins[n.coinType]
+	schedule := config.EmissionSchedule()
+	var wantSupply int64
+	for i := uint32(0); i < wantTranches; i++ {
+		wantSupply += schedule[i].TotalAmount()
+	}
+
+	for _, node := range n.nodes {
+		chain := node.chain
+		if tip := chain.BestSnapshot().Hash; tip != wantHash {
+			n.t.Fatalf("%s: tip is %s, want %s (%q)", node.name, tip,
+				wantHash, blockName)
+		}
+		if nonce := chain.GetSKAEmissionNonce(n.coinType); nonce != wantNonce {
+			n.t.Fatalf("%s: emission nonce at %q is %d, want %d", node.name,
+				blockName, nonce, wantNonce)
+		}
+		tranches := chain.SKAEmissionTranchesEmitted(n.coinType)
+		if tranches != wantTranches {
+			n.t.Fatalf("%s: emitted tranches at %q is %d, want %d", node.name,
+				blockName, tranches, wantTranches)
+		}
+		wantOccurred := int(wantTranches) >= len(schedule)
+		if occurred := chain.HasSKAEmissionOccurred(n.coinType); occurred != wantOccurred {
+			n.t.Fatalf("%s: emission occurred at %q is %v, want %v",
+				node.name, blockName, occurred, wantOccurred)
+		}
+
+		// The supply held by the UTXO set must match the emitted tranches.
+		var supply int64
+		scanTip, err := chain.ScanUtxoSet(context.Background(),
+			func(_ wire.OutPoint, entry *blockchain.UtxoEntry) error {
+				if entry.CoinType() == n.coinType {
+					supply += entry.Amount()
+				}
+				return nil
+			})
+		if err != nil {
+			n.t.Fatalf("%s: failed to scan UTXO set: %v", node.name, err)
+		}
+		if scanTip.Hash != wantHash {
+			n.t.Fatalf("%s: scanned UTXO set at %s, want %s", node.name,
+				scanTip.Hash, wantHash)
+		}
+		if supply != wantSupply || scanTip.SKAEmitted[n.coinType] != wantSupply {
+			n.t.Fatalf("%s: supply at %q is %d with %d emitted, want %d",
+				node.name, blockName, supply, scanTip.SKAEmitted[n.coinType],
+				wantSupply)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+)
+
+// ChainLimits defines the limits placed on the chains of unconfirmed regular
+// transactions of a coin type in the mempool.  The counts and sizes include
+// the transaction the limit applies to.
+//
+// The block template generator selects a transaction together with all of its
+// unconfirmed ancestors, so the limits bound how much of the block space
+// allocated to a coin type a single chain of transactions is able to claim.
+type ChainLimits struct {
+	// MaxAncestors is the maximum number of transactions in the mempool a
+	// transaction and its unconfirmed ancestors may number.
+	MaxAncestors int
+
+	// MaxAncestorSize is the maximum number of bytes a transaction and its
+	// unconfirmed ancestors in the mempool may total.
+	MaxAncestorSize int64
+
+	// MaxDescendants is the maximum number of transactions in the mempool a
+	// transaction and its unconfirmed descendants may number.
+	MaxDescendants int
+
+	// MaxDescendantSize is the maximum number of bytes a transaction and its
+	// unconfirmed descendants in the mempool may total.
+	MaxDescendantSize int64
+}
+
+// chainLimits returns the limits placed on the chains of unconfirmed regular
+// transactions of the provided coin type.
+func (p *Policy) chainLimits(coinType cointype.CoinType) ChainLimits {
+	if limits, ok := p.CoinTypeChainLimits[coinType]; ok {
+		return limits
+	}
+	if coinType.IsSKA() {
+		if p.SKAChainLimits == (ChainLimits{}) {
+			return ChainLimits{
+				MaxAncestors:      DefaultSKAMaxAncestors,
+				MaxAncestorSize:   DefaultSKAMaxAncestorSizeKB * 1000,
+				MaxDescendants:    DefaultSKAMaxDescendants,
+				MaxDescendantSize: DefaultSKAMaxDescendantSizeKB * 1000,
+			}
+		}
+		return p.SKAChainLimits
+	}
+
+	if p.VARChainLimits == (ChainLimits{}) {
+		return ChainLimits{
+			MaxAncestors:      DefaultMaxAncestors,
+			MaxAncestorSize:   DefaultMaxAncestorSizeKB * 1000,
+			MaxDescendants:    DefaultMaxDescendants,
+			MaxDescendantSize: DefaultMaxDescendantSizeKB * 1000,
+		}
+	}
+	return p.VARChainLimits
+}
+
+// checkChainLimits ensures adding the provided transaction of the provided coin
+// type and serialized size to the main pool would neither give it more
+// unconfirmed ancestors than the chain limits of the coin type allow nor give
+// any of its unconfirmed ancestors more unconfirmed descendants than they
+// allow.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) checkChainLimits(tx *dcrutil.Tx, coinType cointype.CoinType,
+	serializedSize int64) error {
+
+	limits := mp.cfg.Policy.chainLimits(coinType)
+	txHash := tx.Hash()
+
+	// Gather the unconfirmed ancestors of the transaction while enforcing the
+	// ancestor limits.
+	numAncestors, ancestorSize := 1, serializedSize
+	ancestors := make(map[chainhash.Hash]*TxDesc)
+	queue := []*dcrutil.Tx{tx}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		for _, txIn := range next.MsgTx().TxIn {
+			parentHash := txIn.PreviousOutPoint.Hash
+			parent, ok := mp.pool[parentHash]
+			if !ok {
+				continue
+			}
+			if _, ok := ancestors[parentHash]; ok {
+				continue
+			}
+			ancestors[parentHash] = parent
+			numAncestors++
+			ancestorSize += parent.TxSize
+			if numAncestors > limits.MaxAncestors {
+				str := fmt.Sprintf("transaction %v of coin type %v has "+
+					"more than the limit of %d unconfirmed ancestors "+
+					"including itself", txHash, coinType,
+					limits.MaxAncestors)
+				return txRuleError(ErrTooManyAncestors, str)
+			}
+			if ancestorSize > limits.MaxAncestorSize {
+				str := fmt.Sprintf("transaction %v of coin type %v and its "+
+					"unconfirmed ancestors exceed the limit of %d bytes",
+					txHash, coinType, limits.MaxAncestorSize)
+				return txRuleError(ErrAncestorSizeTooLarge, str)
+			}
+			queue = append(queue, parent.Tx)
+		}
+	}
+
+	// Ensure none of the ancestors would exceed the descendant limits with the
+	// transaction added as another descendant.
+	for ancestorHash, ancestor := range ancestors {
+		numDescendants, descendantSize := mp.descendantTotals(ancestor, limits)
+		numDescendants++
+		descendantSize += serializedSize
+		if numDescendants > limits.MaxDescendants {
+			str := fmt.Sprintf("transaction %v would give its unconfirmed "+
+				"ancestor %v of coin type %v more than the limit of %d "+
+				"unconfirmed descendants including itself", txHash,
+				ancestorHash, coinType, limits.MaxDescendants)
+			return txRuleError(ErrTooManyDescendants, str)
+		}
+		if descendantSize > limits.MaxDescendantSize {
+			str := fmt.Sprintf("transaction %v would make its unconfirmed "+
+				"ancestor %v of coin type %v and its unconfirmed "+
+				"descendants exceed the limit of %d bytes", txHash,
+				ancestorHash, coinType, limits.MaxDescendantSize)
+			return txRuleError(ErrDescendantSizeTooLarge, str)
+		}
+	}
+
+	return nil
+}
+
+// descendantTotals returns the number of transactions and total size in bytes
+// of the provided transaction and its unconfirmed descendants in the main pool.
+// The walk stops as soon as either total exceeds the descendant limits since
+// the exact totals are not needed beyond that point.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) descendantTotals(txDesc *TxDesc, limits ChainLimits) (int, int64) {
+	numDescendants, descendantSize := 1, txDesc.TxSize
+	seen := map[chainhash.Hash]struct{}{*txDesc.Tx.Hash(): {}}
+	queue := []*dcrutil.Tx{txDesc.Tx}
+	for len(queue) > 0 {
+		if numDescendants > limits.MaxDescendants ||
+			descendantSize > limits.MaxDescendantSize {
+
+			break
+		}
+		next := queue[0]
+		queue = queue[1:]
+		mp.forEachRedeemer(next, func(redeemer *TxDesc) {
+			redeemerHash := *redeemer.Tx.Hash()
+			if _, ok := seen[redeemerHash]; ok {
+				return
+			}
+			seen[redeemerHash] = struct{}{}
+			numDescendants++
+			descendantSize += redeemer.TxSize
+			queue = append(queue, redeemer.Tx)
+		})
+	}
+	return numDescendants, descendantSize
+}
+
+// ChainLimits returns the limits placed on the chains of unconfirmed regular
+// transactions of the provided coin type in the mempool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) ChainLimits(coinType cointype.CoinType) ChainLimits {
+	return mp.cfg.Policy.chainLimits(coinType)
+}
@@ -97,6 +97,33 @@ const (
 
 	// ErrTSpendInvalidExpiry indicates a treasury spend expiry is invalid.
 	ErrTSpendInvalidExpiry = ErrorKind("ErrTSpendInvalidExpiry")
+
+	// ErrInvalidPackage indicates a package of transactions submitted for
+	// acceptance together is not a valid package.
+	ErrInvalidPackage = ErrorKind("ErrInvalidPackage")
+
+	// ErrRateLimited indicates a transaction relayed by a peer that pays the
+	// minimum relay fee exceeds the rate limits for such transactions.
+	ErrRateLimited = ErrorKind("ErrRateLimited")
+
+	// ErrTooManyAncestors indicates a transaction has more unconfirmed
+	// ancestors in the mempool than the chain limits of its coin type allow.
+	ErrTooManyAncestors = ErrorKind("ErrTooManyAncestors")
+
+	// ErrAncestorSizeTooLarge indicates the total size of a transaction and
+	// its unconfirmed ancestors in the mempool exceeds the chain limits of
+	// its coin type.
+	ErrAncestorSizeTooLarge = ErrorKind("ErrAncestorSizeTooLarge")
+
+	// ErrTooManyDescendants indicates a transaction would give one of its
+	// unconfirmed ancestors in the mempool more unconfirmed descendants than
+	// the chain limits of its coin type allow.
+	ErrTooManyDescendants = ErrorKind("ErrTooManyDescendants")
+
+	// ErrDescendantSizeTooLarge indicates a transaction would make the total
+	// size of one of its unconfirmed ancestors in the mempool and their
+	// unconfirmed descendants exceed the chain limits of its coin type.
+	ErrDescendantSizeTooLarge = ErrorKind("ErrDescendantSizeTooLarge")
 )
 
 // Error satisfies the error interface and prints human-readable errors.
@@ -37,6 +37,12 @@ func TestErrorKindStringer(t *testing.T) {
 		{ErrTooManyTSpends, "ErrTooManyTSpends"},
 		{ErrTSpendMinedOnAncestor, "ErrTSpendMinedOnAncestor"},
 		{ErrTSpendInvalidExpiry, "ErrTSpendInvalidExpiry"},
+		{ErrInvalidPackage, "ErrInvalidPackage"},
+		{ErrRateLimited, "ErrRateLimited"},
+		{ErrTooManyAncestors, "ErrTooManyAncestors"},
+		{ErrAncestorSizeTooLarge, "ErrAncestorSizeTooLarge"},
+		{ErrTooManyDescendants, "ErrTooManyDescendants"},
+		{ErrDescendantSizeTooLarge, "ErrDescendantSizeTooLarge"},
 	}
 
 	t.Logf("Running %d tests", len(tests))
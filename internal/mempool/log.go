@@ -13,7 +13,11 @@ import (
 // means the package will not perform any logging by default until the caller
 // requests it.
 // The default amount of logging is none.
-var log = slog.Disabled
+var (
+	log     = slog.Disabled
+	cfeeLog = slog.Disabled
+	skaeLog = slog.Disabled
+)
 
 // UseLogger uses a specified Logger to output package logging info.
 // This should be used in preference to SetLogWriter if the caller is also
@@ -22,6 +26,18 @@ func UseLogger(logger slog.Logger) {
 	log = logger
 }
 
+// UseCoinTypeFeeLogger uses a specified Logger to output coin type fee logging
+// info.
+func UseCoinTypeFeeLogger(logger slog.Logger) {
+	cfeeLog = logger
+}
+
+// UseSKAEmissionLogger uses a specified Logger to output SKA emission logging
+// info.
+func UseSKAEmissionLogger(logger slog.Logger) {
+	skaeLog = logger
+}
+
 // pickNoun returns the singular or plural form of a noun depending
 // on the count n.
 func pickNoun(n int, singular, plural string) string {
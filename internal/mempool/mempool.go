@@ -8,6 +8,7 @@ package mempool
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -45,15 +46,18 @@ const (
 	// of height before SSGen relating to that block are pruned.
 	heightDiffToPruneVotes = 10
 
-	// maxNullDataOutputs is the maximum number of OP_RETURN null data
-	// pushes in a transaction, after which it is considered non-standard.
-	maxNullDataOutputs = 4
-
 	// orphanTTL is the maximum amount of time an orphan is allowed to
 	// stay in the orphan pool before it expires and is evicted during the
 	// next scan.
 	orphanTTL = time.Minute * 15
 
+	// preEmissionOrphanTTL is the maximum amount of time an orphan SKA
+	// transaction is allowed to stay in the orphan pool when its coin type
+	// has neither been emitted nor has an emission pending in the pool.  Its
+	// parents are very unlikely to ever appear in that case since no outputs
+	// of the coin type exist before the emission.
+	preEmissionOrphanTTL = time.Minute
+
 	// orphanExpireScanInterval is the minimum amount of time in between
 	// scans of the orphan pool to evict expired transactions.
 	orphanExpireScanInterval = time.Minute * 5
@@ -62,6 +66,12 @@ const (
 	// are allowed in the mempool. The number 7 is also the amount of
 	// physical space available for TSpend votes and thus is a hard limit.
 	MempoolMaxConcurrentTSpends = 7
+
+	// MaxPackageTxns is the maximum number of transactions in a package of
+	// related transactions submitted for acceptance together.  It matches the
+	// number of ancestors tracked when selecting transactions for a block so
+	// that the miner is able to select every package in its entirety.
+	MaxPackageTxns = 25
 )
 
 // Tag represents an identifier to use for tagging orphan transactions.  The
@@ -110,6 +120,10 @@ type Config struct {
 	// for an SKA coin type.
 	GetSKAEmissionNonce func(cointype.CoinType) uint64
 
+	// SKAEmissionTranchesEmitted defines the function to get the number of
+	// scheduled emission tranches already emitted for an SKA coin type.
+	SKAEmissionTranchesEmitted func(cointype.CoinType) uint32
+
 	// HasVotePassedAtHeight checks if a consensus vote has passed and is active
 	// at the specified block height. This is used to validate SKA-2+ emissions
 	// which require stakeholder approval before they can be mined.
@@ -171,10 +185,31 @@ type Config struct {
 	// 2 agenda is active or not.
 	IsSubsidySplitR2AgendaActive func() (bool, error)
 
+	// IsInputCoinTypesAgendaActive returns if the agenda which enables
+	// transactions that explicitly declare the coin types of their inputs is
+	// active or not.
+	IsInputCoinTypesAgendaActive func() (bool, error)
+
 	// OnTSpendReceived defines the function used to signal receiving a new
 	// tspend in the mempool.
 	OnTSpendReceived func(voteTx *dcrutil.Tx)
 
+	// OnDoubleSpend defines the function used to signal that a transaction
+	// with valid signatures attempted to spend coins that are already spent
+	// by transactions in the pool.
+	//
+	// This function is called with the mempool lock held, so it must not call
+	// back into the mempool.
+	OnDoubleSpend func(ds *DoubleSpend)
+
+	// OnEmissionConflict defines the function used to signal that
+	// transactions spending the outputs of an SKA emission which conflicts
+	// with the emission state of the main chain were evicted from the pool.
+	//
+	// This function is called with the mempool lock held, so it must not call
+	// back into the mempool.
+	OnEmissionConflict func(ec *EmissionConflict)
+
 	// TSpendMinedOnAncestor returns an error if the provided tspend has
 	// been mined in an ancestor block.
 	TSpendMinedOnAncestor func(tspend chainhash.Hash) error
@@ -189,10 +224,23 @@ type Policy struct {
 	// network. Otherwise, all non-standard transactions will be rejected.
 	AcceptNonStd bool
 
-	// MaxOrphanTxs is the maximum number of orphan transactions
-	// that can be queued.
+	// MaxOrphanTxs is the maximum number of orphan VAR transactions
+	// that can be queued.  It also limits the combined number of orphan
+	// transactions of all SKA coin types.  No orphans are queued when it is
+	// zero.
 	MaxOrphanTxs int
 
+	// SKAMaxOrphanTxs defines the maximum number of orphan transactions of
+	// each SKA coin type that can be queued so that orphans of one coin type
+	// can not crowd out the orphans of other coin types.  The default is
+	// used when it is not specified.
+	SKAMaxOrphanTxs int
+
+	// SKAOrphanTTL defines the maximum amount of time an orphan SKA
+	// transaction may stay in the orphan pool before it expires.  The
+	// default is used when it is not specified.
+	SKAOrphanTTL time.Duration
+
 	// MaxOrphanTxSize is the maximum size allowed for orphan transactions.
 	// This helps prevent memory exhaustion attacks from sending a lot of big
 	// orphans.
@@ -227,12 +275,174 @@ type Policy struct {
 	// EnableAncestorTracking controls whether the mining view tracks
 	// transaction relationships in the mempool.
 	EnableAncestorTracking bool
+
+	// VARNullData defines the limits placed on null data outputs of regular
+	// transactions that transfer VAR.  The default limits are used when it
+	// is not specified.
+	VARNullData NullDataPolicy
+
+	// SKANullData defines the limits placed on null data outputs of regular
+	// transactions that transfer SKA coins so that they may carry larger
+	// asset metadata than VAR transactions.  The default limits are used
+	// when it is not specified.
+	SKANullData NullDataPolicy
+
+	// VARMaxTxAge defines the maximum amount of time a regular VAR
+	// transaction may remain in the mempool before it expires.  The default
+	// is used when it is not specified.
+	VARMaxTxAge time.Duration
+
+	// SKAMaxTxAge defines the maximum amount of time a regular SKA
+	// transaction may remain in the mempool before it expires.  The default
+	// is used when it is not specified.
+	SKAMaxTxAge time.Duration
+
+	// CoinTypeMaxTxAge optionally overrides the maximum amount of time
+	// regular transactions of specific coin types may remain in the mempool
+	// before they expire.
+	CoinTypeMaxTxAge map[cointype.CoinType]time.Duration
+
+	// SKAMinFeeRelayLimit defines the rate limits placed on regular SKA
+	// transactions relayed by peers that pay the minimum relay fee.  They
+	// apply to every SKA coin type independently.  The rate is not limited
+	// when it is not specified.
+	SKAMinFeeRelayLimit MinFeeRelayLimit
+
+	// CoinTypeMinFeeRelayLimit optionally overrides the rate limits placed on
+	// regular transactions of specific coin types relayed by peers that pay
+	// the minimum relay fee.
+	CoinTypeMinFeeRelayLimit map[cointype.CoinType]MinFeeRelayLimit
+
+	// VARMaxPoolSize defines the maximum number of bytes of regular VAR
+	// transactions the mempool may hold.  The transactions paying the lowest
+	// fee rates are evicted when it is exceeded.  The size is not limited
+	// when it is not specified.
+	VARMaxPoolSize int64
+
+	// SKAMaxPoolSize defines the maximum number of bytes of regular SKA
+	// transactions the mempool may hold.  It applies to every SKA coin type
+	// independently.  The size is not limited when it is not specified.
+	SKAMaxPoolSize int64
+
+	// CoinTypeMaxPoolSize optionally overrides the maximum number of bytes of
+	// regular transactions of specific coin types the mempool may hold.  A
+	// size of zero means the size is not limited.
+	CoinTypeMaxPoolSize map[cointype.CoinType]int64
+
+	// VARChainLimits defines the limits placed on the chains of unconfirmed
+	// regular VAR transactions in the mempool.  The default limits are used
+	// when it is not specified.
+	VARChainLimits ChainLimits
+
+	// SKAChainLimits defines the limits placed on the chains of unconfirmed
+	// regular SKA transactions in the mempool.  They apply to every SKA coin
+	// type independently.  The default limits are used when it is not
+	// specified.
+	SKAChainLimits ChainLimits
+
+	// CoinTypeChainLimits optionally overrides the limits placed on the
+	// chains of unconfirmed regular transactions of specific coin types in
+	// the mempool.
+	CoinTypeChainLimits map[cointype.CoinType]ChainLimits
+}
+
+// maxTxAge returns the maximum amount of time a regular transaction of the
+// provided coin type may remain in the mempool before it expires.
+func (p *Policy) maxTxAge(coinType cointype.CoinType) time.Duration {
+	if maxAge, ok := p.CoinTypeMaxTxAge[coinType]; ok && maxAge > 0 {
+		return maxAge
+	}
+	if coinType.IsSKA() {
+		if p.SKAMaxTxAge == 0 {
+			return DefaultSKAMaxTxAge
+		}
+		return p.SKAMaxTxAge
+	}
+	if p.VARMaxTxAge == 0 {
+		return DefaultMaxTxAge
+	}
+	return p.VARMaxTxAge
+}
+
+// maxOrphanTxs returns the maximum number of orphan transactions of the
+// provided coin type that can be queued.
+func (p *Policy) maxOrphanTxs(coinType cointype.CoinType) int {
+	if coinType.IsSKA() {
+		if p.SKAMaxOrphanTxs == 0 {
+			return DefaultSKAMaxOrphanTxs
+		}
+		return p.SKAMaxOrphanTxs
+	}
+	return p.MaxOrphanTxs
+}
+
+// orphanTTL returns the maximum amount of time an orphan transaction of the
+// provided coin type may stay in the orphan pool before it expires.
+func (p *Policy) orphanTTL(coinType cointype.CoinType) time.Duration {
+	if coinType.IsSKA() {
+		if p.SKAOrphanTTL == 0 {
+			return DefaultSKAOrphanTTL
+		}
+		return p.SKAOrphanTTL
+	}
+	return orphanTTL
+}
+
+// minFeeRelayLimit returns the rate limits placed on regular transactions of
+// the provided coin type relayed by peers that pay the minimum relay fee and
+// whether or not any limits apply.
+func (p *Policy) minFeeRelayLimit(coinType cointype.CoinType) (MinFeeRelayLimit, bool) {
+	limit, ok := p.CoinTypeMinFeeRelayLimit[coinType]
+	if !ok && coinType.IsSKA() {
+		limit = p.SKAMinFeeRelayLimit
+	}
+	return limit, limit.PeerKBPerMinute > 0 || limit.KBPerMinute > 0
+}
+
+// maxPoolSize returns the maximum number of bytes of regular transactions of
+// the provided coin type the mempool may hold.  Zero means the size is not
+// limited.
+func (p *Policy) maxPoolSize(coinType cointype.CoinType) int64 {
+	if maxSize, ok := p.CoinTypeMaxPoolSize[coinType]; ok {
+		return maxSize
+	}
+	if coinType.IsSKA() {
+		return p.SKAMaxPoolSize
+	}
+	return p.VARMaxPoolSize
+}
+
+// nullDataPolicy returns the limits placed on null data outputs of regular
+// transactions of the provided coin type.
+func (p *Policy) nullDataPolicy(coinType cointype.CoinType) NullDataPolicy {
+	if coinType.IsSKA() {
+		if p.SKANullData == (NullDataPolicy{}) {
+			return NullDataPolicy{
+				MaxDataSize: DefaultSKAMaxNullDataSize,
+				MaxOutputs:  DefaultSKAMaxNullDataOutputs,
+			}
+		}
+		return p.SKANullData
+	}
+
+	if p.VARNullData == (NullDataPolicy{}) {
+		return NullDataPolicy{
+			MaxDataSize: DefaultMaxNullDataSize,
+			MaxOutputs:  DefaultMaxNullDataOutputs,
+		}
+	}
+	return p.VARNullData
 }
 
 // TxDesc is a descriptor containing a transaction in the mempool along with
 // additional metadata.
 type TxDesc struct {
 	mining.TxDesc
+
+	// PredictedConfs is the number of blocks the transaction was predicted
+	// to take to confirm given its fee rate when it was added to the pool.
+	// It is zero for transactions that are excluded from fee statistics.
+	PredictedConfs int32
 }
 
 // VerboseTxDesc is a descriptor containing a transaction in the mempool along
@@ -253,6 +463,7 @@ type VerboseTxDesc struct {
 type orphanTx struct {
 	tx         *dcrutil.Tx
 	tag        Tag
+	coinType   cointype.CoinType
 	expiration time.Time
 }
 
@@ -268,6 +479,11 @@ type TxPool struct {
 
 	orphans       map[chainhash.Hash]*orphanTx
 	orphansByPrev map[wire.OutPoint]map[chainhash.Hash]*dcrutil.Tx
+
+	// numOrphans tracks the number of orphans of each coin type and
+	// numSKAOrphans the combined number of orphans of all SKA coin types.
+	numOrphans    map[cointype.CoinType]int
+	numSKAOrphans int
 	outpoints     map[wire.OutPoint]*TxDesc
 	miningView    *mining.TxMiningView
 
@@ -287,6 +503,11 @@ type TxPool struct {
 	// Tracks one emission per SKA coin type to prevent duplicates.
 	skaEmissions map[cointype.CoinType]*chainhash.Hash
 
+	// expiredTxns tracks the total number of regular transactions that have
+	// been evicted for exceeding the max age of their coin type.  Access MUST
+	// be protected by the mempool mutex.
+	expiredTxns map[cointype.CoinType]uint64
+
 	// nextExpireScan is the time after which the orphan pool will be
 	// scanned in order to evict orphans.  This is NOT a hard deadline as
 	// the scan will only run when an orphan is added to the pool as opposed
@@ -295,6 +516,21 @@ type TxPool struct {
 
 	// feeCalculator for advanced fee calculation and validation
 	feeCalculator *fees.CoinTypeFeeCalculator
+
+	// minFeeLimiter limits the rate at which transactions paying the minimum
+	// relay fee are accepted from peers.  Access MUST be protected by the
+	// mempool mutex.
+	minFeeLimiter *minFeeRateLimiter
+
+	// poolSizes tracks the total number of bytes of the regular transactions
+	// in the main pool that are subject to the size limit of their coin
+	// type.  Access MUST be protected by the mempool mutex.
+	poolSizes map[cointype.CoinType]int64
+
+	// rollingMinFees tracks the rolling minimum fees of the coin types whose
+	// transactions were evicted to keep the pool within their size limits.
+	// Access MUST be protected by the mempool mutex.
+	rollingMinFees map[cointype.CoinType]*rollingMinFee
 }
 
 // mempoolChainAdapter adapts the mempool's function-based blockchain access
@@ -302,6 +538,7 @@ type TxPool struct {
 type mempoolChainAdapter struct {
 	hasEmissionOccurred func(cointype.CoinType) bool
 	getEmissionNonce    func(cointype.CoinType) uint64
+	tranchesEmitted     func(cointype.CoinType) uint32
 }
 
 // HasSKAEmissionOccurred checks if SKA emission has occurred for the given coin type.
@@ -314,6 +551,15 @@ func (m *mempoolChainAdapter) GetSKAEmissionNonce(coinType cointype.CoinType) ui
 	return m.getEmissionNonce(coinType)
 }
 
+// SKAEmissionTranchesEmitted returns the number of emitted tranches for the
+// given coin type.
+func (m *mempoolChainAdapter) SKAEmissionTranchesEmitted(coinType cointype.CoinType) uint32 {
+	if m.tranchesEmitted == nil {
+		return 0
+	}
+	return m.tranchesEmitted(coinType)
+}
+
 // insertVote inserts a vote into the map of block votes.
 //
 // This function MUST be called with the vote mutex locked (for writes).
@@ -458,6 +704,13 @@ func (mp *TxPool) removeOrphan(tx *dcrutil.Tx, removeRedeemers bool) {
 
 	// Remove the transaction from the orphan pool.
 	delete(mp.orphans, *txHash)
+	mp.numOrphans[otx.coinType]--
+	if mp.numOrphans[otx.coinType] == 0 {
+		delete(mp.numOrphans, otx.coinType)
+	}
+	if otx.coinType.IsSKA() {
+		mp.numSKAOrphans--
+	}
 }
 
 // RemoveOrphan removes the passed orphan transaction from the orphan pool and
@@ -488,15 +741,47 @@ func (mp *TxPool) RemoveOrphansByTag(tag Tag) uint64 {
 	return numEvicted
 }
 
+// evictRandomOrphan evicts a random orphan of the provided coin type from the
+// orphan pool.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) evictRandomOrphan(coinType cointype.CoinType) {
+	// Remove a random entry from the map.  For most compilers, Go's
+	// range statement iterates starting at a random item although
+	// that is not 100% guaranteed by the spec.  The iteration order
+	// is not important here because an adversary would have to be
+	// able to pull off preimage attacks on the hashing function in
+	// order to target eviction of specific entries anyways.
+	for _, otx := range mp.orphans {
+		if otx.coinType != coinType {
+			continue
+		}
+
+		// Don't remove redeemers in the case of a random eviction since
+		// it is quite possible it might be needed again shortly.
+		mp.removeOrphan(otx.tx, false)
+		break
+	}
+}
+
 // limitNumOrphans limits the number of orphan transactions by evicting a random
-// orphan if adding a new one would cause it to overflow the max allowed.
+// orphan if adding a new one of the provided coin type would cause the orphans
+// of the coin type to overflow the max allowed.  Adding an orphan of an SKA
+// coin type that would cause the combined orphans of all SKA coin types to
+// overflow the max allowed evicts a random orphan of the SKA coin type with
+// the most orphans instead so that orphans of SKA coin types are unable to
+// crowd out VAR orphans or one another.
 //
 // This function MUST be called with the mempool lock held (for writes).
-func (mp *TxPool) limitNumOrphans() {
+func (mp *TxPool) limitNumOrphans(coinType cointype.CoinType) {
 	// Scan through the orphan pool and remove any expired orphans when it's
 	// time.  This is done for efficiency so the scan only happens periodically
 	// instead of on every orphan added to the pool.
 	if now := time.Now(); now.After(mp.nextExpireScan) {
+		// Set next expiration scan to occur after the scan interval or when
+		// the first remaining orphan expires if that is sooner.
+		mp.nextExpireScan = now.Add(orphanExpireScanInterval)
+
 		origNumOrphans := len(mp.orphans)
 		for _, otx := range mp.orphans {
 			if now.After(otx.expiration) {
@@ -506,9 +791,11 @@ func (mp *TxPool) limitNumOrphans() {
 				mp.removeOrphan(otx.tx, true)
 			}
 		}
-
-		// Set next expiration scan to occur after the scan interval.
-		mp.nextExpireScan = now.Add(orphanExpireScanInterval)
+		for _, otx := range mp.orphans {
+			if otx.expiration.Before(mp.nextExpireScan) {
+				mp.nextExpireScan = otx.expiration
+			}
+		}
 
 		numOrphans := len(mp.orphans)
 		if numExpired := origNumOrphans - numOrphans; numExpired > 0 {
@@ -517,26 +804,41 @@ func (mp *TxPool) limitNumOrphans() {
 		}
 	}
 
-	// Nothing to do if adding another orphan will not cause the pool to
-	// exceed the limit.
-	if len(mp.orphans)+1 <= mp.cfg.Policy.MaxOrphanTxs {
-		return
+	// Evict an orphan of the same coin type when adding another one would
+	// cause the orphans of the coin type to exceed the limit.
+	if mp.numOrphans[coinType]+1 > mp.cfg.Policy.maxOrphanTxs(coinType) {
+		mp.evictRandomOrphan(coinType)
 	}
 
-	// Remove a random entry from the map.  For most compilers, Go's
-	// range statement iterates starting at a random item although
-	// that is not 100% guaranteed by the spec.  The iteration order
-	// is not important here because an adversary would have to be
-	// able to pull off preimage attacks on the hashing function in
-	// order to target eviction of specific entries anyways.
-	for _, otx := range mp.orphans {
-		// Don't remove redeemers in the case of a random eviction since
-		// it is quite possible it might be needed again shortly.
-		mp.removeOrphan(otx.tx, false)
-		break
+	// Evict an orphan of the SKA coin type with the most orphans when adding
+	// another SKA orphan would cause the combined orphans of all SKA coin
+	// types to exceed the limit.
+	if coinType.IsSKA() && mp.numSKAOrphans+1 > mp.cfg.Policy.MaxOrphanTxs {
+		largest, largestNum := coinType, 0
+		for ct, num := range mp.numOrphans {
+			if ct.IsSKA() && num > largestNum {
+				largest, largestNum = ct, num
+			}
+		}
+		mp.evictRandomOrphan(largest)
 	}
 }
 
+// isPreEmissionCoinType returns whether the provided coin type is an SKA coin
+// type that has neither been emitted by the main chain nor has an emission
+// pending in the pool, in which case no outputs of it exist yet.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) isPreEmissionCoinType(coinType cointype.CoinType) bool {
+	if !coinType.IsSKA() || mp.cfg.HasSKAEmissionOccurred == nil {
+		return false
+	}
+	if mp.skaEmissions[coinType] != nil {
+		return false
+	}
+	return !mp.cfg.HasSKAEmissionOccurred(coinType)
+}
+
 // addOrphan adds an orphan transaction to the orphan pool.
 //
 // This function MUST be called with the mempool lock held (for writes).
@@ -549,12 +851,30 @@ func (mp *TxPool) addOrphan(tx *dcrutil.Tx, tag Tag) {
 	// Limit the number orphan transactions to prevent memory exhaustion.
 	// This will periodically remove any expired orphans and evict a random
 	// orphan if space is still needed.
-	mp.limitNumOrphans()
+	coinType := mp.determinePrimaryCoinType(tx.MsgTx())
+	mp.limitNumOrphans(coinType)
+
+	// Orphans of SKA coin types expire sooner than VAR orphans and even
+	// sooner before the coin type is emitted since their parents are unlikely
+	// to ever appear.
+	ttl := mp.cfg.Policy.orphanTTL(coinType)
+	if ttl > preEmissionOrphanTTL && mp.isPreEmissionCoinType(coinType) {
+		ttl = preEmissionOrphanTTL
+	}
+	expiration := time.Now().Add(ttl)
+	if expiration.Before(mp.nextExpireScan) {
+		mp.nextExpireScan = expiration
+	}
 
 	mp.orphans[*tx.Hash()] = &orphanTx{
 		tx:         tx,
 		tag:        tag,
-		expiration: time.Now().Add(orphanTTL),
+		coinType:   coinType,
+		expiration: expiration,
+	}
+	mp.numOrphans[coinType]++
+	if coinType.IsSKA() {
+		mp.numSKAOrphans++
 	}
 	for _, txIn := range tx.MsgTx().TxIn {
 		if _, exists := mp.orphansByPrev[txIn.PreviousOutPoint]; !exists {
@@ -564,8 +884,8 @@ func (mp *TxPool) addOrphan(tx *dcrutil.Tx, tag Tag) {
 		mp.orphansByPrev[txIn.PreviousOutPoint][*tx.Hash()] = tx
 	}
 
-	log.Debugf("Stored orphan transaction %v (total: %d)", tx.Hash(),
-		len(mp.orphans))
+	log.Debugf("Stored orphan transaction %v of coin type %d (total: %d)",
+		tx.Hash(), coinType, len(mp.orphans))
 }
 
 // maybeAddOrphan potentially adds an orphan to the orphan pool.
@@ -779,6 +1099,17 @@ func (mp *TxPool) HaveTransaction(hash *chainhash.Hash) bool {
 	return haveTx
 }
 
+// PendingSKAEmission returns the hash of the SKA emission transaction for the
+// provided coin type that is in the pool, or nil if there is none.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) PendingSKAEmission(coinType cointype.CoinType) *chainhash.Hash {
+	mp.mtx.RLock()
+	txHash := mp.skaEmissions[coinType]
+	mp.mtx.RUnlock()
+	return txHash
+}
+
 // haveTransactions returns whether or not the passed transactions already exist
 // in the main pool or in the orphan pool.
 //
@@ -864,6 +1195,7 @@ func (mp *TxPool) removeTransaction(tx *dcrutil.Tx, removeRedeemers bool) {
 		mp.miningView.RemoveTransaction(tx.Hash(), updateDescendantStats)
 
 		delete(mp.pool, *txHash)
+		mp.untrackPoolSize(txDesc)
 
 		mp.lastUpdated.Store(time.Now().Unix())
 
@@ -928,6 +1260,130 @@ func (mp *TxPool) RemoveDoubleSpends(tx *dcrutil.Tx) {
 	mp.mtx.Unlock()
 }
 
+// EmissionConflict describes an SKA emission that conflicts with the emission
+// state of the main chain, such as one rolled back by a reorganization that can
+// not be mined again or one superseded by another emission for the same coin
+// type, along with the transactions spending its outputs that were evicted from
+// the pool as a result.
+type EmissionConflict struct {
+	// Emission is the conflicting SKA emission transaction.
+	Emission *dcrutil.Tx
+
+	// CoinType is the coin type the conflicting emission emits.
+	CoinType cointype.CoinType
+
+	// Replacement is the hash of the emission for the coin type in the main
+	// chain that superseded the conflicting one.  It is nil when the
+	// conflicting emission was rejected for another reason.
+	Replacement *chainhash.Hash
+
+	// Evicted are the hashes of the transactions that directly or indirectly
+	// spend outputs of the conflicting emission that were evicted from the
+	// pool, including the stage pool, in the order they were found.  It does
+	// not include the emission itself.
+	Evicted []chainhash.Hash
+}
+
+// poolDescendants returns the hashes of all transactions in the pool, including
+// the stage pool, that directly or indirectly spend outputs of the passed
+// transaction in breadth-first order.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) poolDescendants(tx *dcrutil.Tx) []chainhash.Hash {
+	var descendants []chainhash.Hash
+	seen := make(map[chainhash.Hash]struct{})
+	queue := []*dcrutil.Tx{tx}
+	for len(queue) > 0 {
+		item := queue[0]
+		queue = queue[1:]
+
+		tree := wire.TxTreeRegular
+		if stake.DetermineTxType(item.MsgTx()) != stake.TxTypeRegular {
+			tree = wire.TxTreeStake
+		}
+		outpoint := wire.OutPoint{Hash: *item.Hash(), Tree: tree}
+		for i := range item.MsgTx().TxOut {
+			outpoint.Index = uint32(i)
+			var redeemer *dcrutil.Tx
+			if txDesc, ok := mp.outpoints[outpoint]; ok {
+				redeemer = txDesc.Tx
+			} else if txDesc, ok := mp.stagedOutpoints[outpoint]; ok {
+				redeemer = txDesc.Tx
+			} else {
+				continue
+			}
+			if _, ok := seen[*redeemer.Hash()]; ok {
+				continue
+			}
+			seen[*redeemer.Hash()] = struct{}{}
+			descendants = append(descendants, *redeemer.Hash())
+			queue = append(queue, redeemer)
+		}
+	}
+	return descendants
+}
+
+// evictSKAEmissionConflict removes the passed SKA emission from the pool when
+// it is in it along with all transactions that directly or indirectly spend
+// its outputs since they can no longer be mined, and signals the eviction of
+// those transactions to subscribers.  The replacement is the hash of the
+// emission in the main chain that superseded it, if any.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) evictSKAEmissionConflict(emission *dcrutil.Tx, replacement *chainhash.Hash) {
+	evicted := mp.poolDescendants(emission)
+	_, inPool := mp.pool[*emission.Hash()]
+	if len(evicted) == 0 && !inPool {
+		return
+	}
+
+	var coinType cointype.CoinType
+	if msgTx := emission.MsgTx(); len(msgTx.TxOut) > 0 {
+		coinType = msgTx.TxOut[0].CoinType
+	}
+	skaeLog.Infof("Evicting %d transaction(s) spending outputs of SKA emission "+
+		"%v for coin type %d since it conflicts with the main chain",
+		len(evicted), emission.Hash(), coinType)
+	mp.removeTransaction(emission, true)
+
+	if mp.cfg.OnEmissionConflict != nil && len(evicted) > 0 {
+		mp.cfg.OnEmissionConflict(&EmissionConflict{
+			Emission:    emission,
+			CoinType:    coinType,
+			Replacement: replacement,
+			Evicted:     evicted,
+		})
+	}
+}
+
+// RemoveSKAEmissionConflicts removes the pending SKA emissions in the pool that
+// conflict with the passed SKA emission along with all transactions that
+// directly or indirectly spend their outputs.  This is necessary when a block
+// that contains an emission is connected to the main chain because an emission
+// for the same coin type that was rolled back by a reorganization, along with
+// any transactions spending its outputs, may still be in the pool and can no
+// longer be mined.  Transactions that are not SKA emissions are ignored.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) RemoveSKAEmissionConflicts(tx *dcrutil.Tx) {
+	msgTx := tx.MsgTx()
+	if !wire.IsSKAEmissionTransaction(msgTx) {
+		return
+	}
+
+	mp.mtx.Lock()
+	for _, txOut := range msgTx.TxOut {
+		pending, ok := mp.skaEmissions[txOut.CoinType]
+		if !ok || pending == nil || *pending == *tx.Hash() {
+			continue
+		}
+		if txDesc, exists := mp.pool[*pending]; exists {
+			mp.evictSKAEmissionConflict(txDesc.Tx, tx.Hash())
+		}
+	}
+	mp.mtx.Unlock()
+}
+
 // findTx returns a transaction from the mempool by hash.  If it does not exist
 // in the mempool, a nil pointer is returned.
 func (mp *TxPool) findTx(txHash *chainhash.Hash) *mining.TxDesc {
@@ -957,6 +1413,7 @@ func (mp *TxPool) addTransaction(utxoView *blockchain.UtxoViewpoint, txDesc *TxD
 	// Add the transaction to the pool and mark the referenced outpoints
 	// as spent by the pool.
 	mp.pool[*txHash] = txDesc
+	mp.trackPoolSize(txDesc)
 	mp.miningView.AddTransaction(&txDesc.TxDesc, mp.findTx)
 
 	msgTx := tx.MsgTx()
@@ -972,18 +1429,28 @@ func (mp *TxPool) addTransaction(utxoView *blockchain.UtxoViewpoint, txDesc *TxD
 	}
 
 	// Inform the associated fee estimator that a new transaction has been added
-	// to the mempool.
-	if mp.cfg.AddTxToFeeEstimation != nil {
+	// to the mempool.  Fee exempt transactions do not pay a fee, so they would
+	// only skew the estimates.
+	if mp.cfg.AddTxToFeeEstimation != nil && !txDesc.FeeExempt {
 		mp.cfg.AddTxToFeeEstimation(txHash, txDesc.Fee, txDesc.TxSize, txType)
 	}
 
 	// Record transaction fee for coin-type-specific tracking
-	// Skip feeless system transactions (votes and revocations) from fee statistics
-	if mp.feeCalculator != nil && txDesc.Type != stake.TxTypeSSGen && txDesc.Type != stake.TxTypeSSRtx {
+	// Skip feeless system transactions (votes, revocations, and fee exempt
+	// transactions) from fee statistics
+	if mp.feeCalculator != nil && !txDesc.FeeExempt &&
+		txDesc.Type != stake.TxTypeSSGen && txDesc.Type != stake.TxTypeSSRtx {
+
 		// Determine the primary coin type from outputs
 		// (inputs and outputs always have the same coin type)
 		primaryCoinType := mp.determinePrimaryCoinType(msgTx)
 
+		// Record the number of blocks the transaction is predicted to take
+		// to confirm prior to recording its fee so the prediction matches
+		// the estimate that would have been given to the sender.
+		txDesc.PredictedConfs = mp.feeCalculator.PredictConfirmations(
+			primaryCoinType, txDesc.Fee, txDesc.TxSize)
+
 		// Record with the primary coin type
 		mp.feeCalculator.RecordTransactionFee(primaryCoinType, txDesc.Fee,
 			txDesc.TxSize, false) // false = not confirmed yet
@@ -1103,6 +1570,99 @@ func (mp *TxPool) IsRegTxTreeKnownDisapproved(hash *chainhash.Hash) bool {
 	return yes <= no
 }
 
+// DoubleSpend describes a transaction that attempted to spend coins that are
+// already spent by transactions in the pool.
+type DoubleSpend struct {
+	// Tx is the rejected transaction.
+	Tx *dcrutil.Tx
+
+	// CoinType is the primary coin type of the rejected transaction.
+	CoinType cointype.CoinType
+
+	// Conflicts are the hashes of the transactions in the pool that already
+	// spend one or more of the same coins.
+	Conflicts []chainhash.Hash
+}
+
+// poolConflicts returns the hashes of all transactions in the pool, including
+// the stage pool, that spend any of the same coins as the passed transaction.
+// Stake bases and treasury bases are ignored in the same way as
+// checkPoolDoubleSpend.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) poolConflicts(tx *dcrutil.Tx, txType stake.TxType, isTreasuryEnabled bool) []chainhash.Hash {
+	var conflicts []chainhash.Hash
+	seen := make(map[chainhash.Hash]struct{})
+	addConflict := func(hash *chainhash.Hash) {
+		if _, ok := seen[*hash]; ok {
+			return
+		}
+		seen[*hash] = struct{}{}
+		conflicts = append(conflicts, *hash)
+	}
+	for i, txIn := range tx.MsgTx().TxIn {
+		if i == 0 && (txType == stake.TxTypeSSGen ||
+			txType == stake.TxTypeSSRtx) {
+			continue
+		}
+		if isTreasuryEnabled && i == 0 &&
+			(txType == stake.TxTypeTreasuryBase ||
+				txType == stake.TxTypeTSpend) {
+			continue
+		}
+
+		if txR, exists := mp.outpoints[txIn.PreviousOutPoint]; exists {
+			addConflict(txR.Tx.Hash())
+		}
+		if txR, exists := mp.stagedOutpoints[txIn.PreviousOutPoint]; exists {
+			addConflict(txR.Tx.Hash())
+		}
+	}
+	return conflicts
+}
+
+// notifyDoubleSpend invokes the double spend callback for the passed
+// transaction, which is known to spend coins already spent by transactions in
+// the pool.  The callback is only invoked when every input of the transaction
+// refers to an available output and all of its signatures are valid so that
+// garbage transactions can't be used to trigger false alerts.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) notifyDoubleSpend(tx *dcrutil.Tx, txType stake.TxType, isTreasuryEnabled, isAutoRevocationsEnabled bool) {
+	conflicts := mp.poolConflicts(tx, txType, isTreasuryEnabled)
+	if len(conflicts) == 0 {
+		return
+	}
+
+	utxoView, err := mp.fetchInputUtxos(tx, isTreasuryEnabled)
+	if err != nil {
+		return
+	}
+	for _, txIn := range tx.MsgTx().TxIn {
+		entry := utxoView.LookupEntry(txIn.PreviousOutPoint)
+		if entry == nil || entry.IsSpent() {
+			return
+		}
+	}
+	flags, err := mp.cfg.Policy.StandardVerifyFlags()
+	if err != nil {
+		return
+	}
+	err = blockchain.ValidateTransactionScripts(tx, utxoView, flags,
+		mp.cfg.SigCache, isAutoRevocationsEnabled)
+	if err != nil {
+		log.Debugf("Ignoring double spend by transaction %v with invalid "+
+			"scripts: %v", tx.Hash(), err)
+		return
+	}
+
+	mp.cfg.OnDoubleSpend(&DoubleSpend{
+		Tx:        tx,
+		CoinType:  mp.determinePrimaryCoinType(tx.MsgTx()),
+		Conflicts: conflicts,
+	})
+}
+
 // fetchInputUtxos loads utxo details about the input transactions referenced by
 // the passed transaction.  First, it loads the details from the viewpoint of
 // the main chain, then it adjusts them based upon the contents of the
@@ -1266,6 +1826,18 @@ func (mp *TxPool) MaybeAcceptDependents(tx *dcrutil.Tx, isTreasuryEnabled bool)
 // MaybeAcceptTransaction.  See the comment for MaybeAcceptTransaction for
 // more details.
 //
+// When the dry run flag is set, the transaction is subjected to all of the
+// checks, but it is not added to the pool and no other pool state is modified.
+//
+// When the package parent flag is set, the transaction is not required to pay
+// the minimum fee of its coin type on its own since the fee rate of the
+// package it belongs to has already been checked.
+//
+// The tag identifies the peer that relayed the transaction and is used to
+// limit the rate at which transactions paying the minimum relay fee are
+// accepted.  Transactions with a zero tag originate from the local node and are
+// not rate limited.
+//
 // This function MUST be called with the mempool lock held (for writes).
 //
 // DECRED - TODO
@@ -1274,7 +1846,7 @@ func (mp *TxPool) MaybeAcceptDependents(tx *dcrutil.Tx, isTreasuryEnabled bool)
 // This should probably be done at the bottom using "IsSStx" etc functions.
 // It should also set the dcrutil tree type for the tx as well.
 func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
-	rejectDupOrphans bool,
+	rejectDupOrphans, dryRun, packageParent bool, tag Tag,
 	checkTxFlags blockchain.AgendaFlags) ([]wire.OutPoint, error) {
 
 	msgTx := tx.MsgTx()
@@ -1330,6 +1902,10 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 
 	// SKA emission transactions require full cryptographic authorization.
 	// Check this BEFORE coinbase check since SKA emissions have null previous outputs.
+	//
+	// Emissions that pass validation are exempt from fee requirements since
+	// they have no inputs to pay a fee from.
+	var feeExempt bool
 	if wire.IsSKAEmissionTransaction(msgTx) {
 		// Get the next block height for validation
 		bestHeight := mp.cfg.BestHeight()
@@ -1357,7 +1933,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 			// SKA-2 and higher require stakeholder vote activation
 			// Only accept to mempool if vote has passed (ready to mine)
 			if coinType >= 2 {
-				voteID := fmt.Sprintf("activateska%d", coinType)
+				voteID := chaincfg.SKAActivationVoteID(coinType)
 				if mp.cfg.HasVotePassedAtHeight != nil {
 					if !mp.cfg.HasVotePassedAtHeight(voteID, nextBlockHeight) {
 						str := fmt.Sprintf("transaction %v cannot be accepted - stakeholder vote %s has not activated coin type %d yet (resubmit after vote passes)",
@@ -1367,22 +1943,11 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 				}
 			}
 
-			// Check emission window
-			if config, exists := mp.cfg.ChainParams.SKACoins[coinType]; exists {
-				emissionStart := int64(config.EmissionHeight)
-				emissionEnd := emissionStart + int64(config.EmissionWindow)
-
-				if nextBlockHeight < emissionStart {
-					str := fmt.Sprintf("transaction %v is outside emission window - too early (emission starts at block %d, current height %d)",
-						txHash, emissionStart, bestHeight)
-					return nil, txRuleError(ErrInvalid, str)
-				}
-
-				if nextBlockHeight > emissionEnd {
-					str := fmt.Sprintf("transaction %v is outside emission window - expired (emission ended at block %d, current height %d)",
-						txHash, emissionEnd, bestHeight)
-					return nil, txRuleError(ErrInvalid, str)
-				}
+			// Check emission window of the next tranche to emit
+			tranche := mp.nextSKAEmissionTranche(coinType)
+			err := checkSKAEmissionWindow(txHash, tranche, nextBlockHeight)
+			if err != nil {
+				return nil, err
 			}
 		}
 
@@ -1390,6 +1955,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 		chainAdapter := &mempoolChainAdapter{
 			hasEmissionOccurred: mp.cfg.HasSKAEmissionOccurred,
 			getEmissionNonce:    mp.cfg.GetSKAEmissionNonce,
+			tranchesEmitted:     mp.cfg.SKAEmissionTranchesEmitted,
 		}
 
 		// Perform full cryptographic validation including signature verification
@@ -1398,6 +1964,14 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 			str := fmt.Sprintf("transaction %v is an invalid authorized SKA emission transaction: %v", txHash, err)
 			return nil, txRuleError(ErrInvalid, str)
 		}
+		err := checkSKAEmissionOutputsStandard(msgTx,
+			mp.cfg.Policy.MinRelayTxFee, mp.cfg.ChainParams)
+		if err != nil {
+			str := fmt.Sprintf("transaction %v is an SKA emission with "+
+				"non-standard outputs: %v", txHash, err)
+			return nil, wrapTxRuleError(ErrNonStandard, str, err)
+		}
+		feeExempt = true
 	} else if standalone.IsCoinBaseTx(msgTx, isTreasuryEnabled) {
 		// A standalone transaction must not be a coinbase transaction.
 		// SKA emissions are excluded above since they also have null previous outputs.
@@ -1412,6 +1986,43 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 	bestHeight := mp.cfg.BestHeight()
 	nextBlockHeight := bestHeight + 1
 
+	// Don't accept transactions with outputs that use a coin type which is
+	// neither VAR nor a configured SKA coin type.  They are invalid once the
+	// consensus rule that rejects them is active and non-standard before.
+	if mp.cfg.ChainParams.RejectsUnknownCoinTypes(nextBlockHeight) {
+		err := blockchain.CheckTxOutCoinTypes(msgTx, mp.cfg.ChainParams)
+		if err != nil {
+			var cerr blockchain.RuleError
+			if errors.As(err, &cerr) {
+				return nil, chainRuleError(cerr)
+			}
+			return nil, err
+		}
+	} else if !mp.cfg.Policy.AcceptNonStd {
+		err := checkTxOutCoinTypesStandard(msgTx, mp.cfg.ChainParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Don't accept transactions with SKA burn outputs that do not burn a
+	// positive amount of the coin type committed to by their script.  They are
+	// invalid once the consensus rule that rejects them is active and
+	// non-standard before.
+	err = blockchain.CheckSKABurnOutputs(msgTx, mp.cfg.ChainParams)
+	if err != nil {
+		if mp.cfg.ChainParams.EnforcesSKABurnOutputs(nextBlockHeight) {
+			var cerr blockchain.RuleError
+			if errors.As(err, &cerr) {
+				return nil, chainRuleError(cerr)
+			}
+			return nil, err
+		}
+		if !mp.cfg.Policy.AcceptNonStd {
+			return nil, txRuleError(ErrNonStandard, err.Error())
+		}
+	}
+
 	// Check if transaction has SKA outputs and validate all coin types are active
 	usedSKACoinTypes := make(map[cointype.CoinType]bool)
 	for _, txOut := range msgTx.TxOut {
@@ -1420,15 +2031,33 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 		}
 	}
 
-	// Validate all used SKA coin types are active
+	// Validate all used SKA coin types are active in the next block.
 	for coinType := range usedSKACoinTypes {
-		if !mp.cfg.ChainParams.IsSKACoinTypeActive(coinType) {
+		if !mp.cfg.ChainParams.IsSKACoinTypeActiveAtHeight(coinType, nextBlockHeight) {
 			str := fmt.Sprintf("transaction %v uses inactive SKA coin type %d",
 				txHash, coinType)
 			return nil, txRuleError(ErrInvalid, str)
 		}
 	}
 
+	// Don't accept transactions that use an SKA coin type before the vote
+	// that activates it has passed.
+	if !mp.cfg.Policy.AcceptNonStd {
+		err := checkSKAActivationStandard(msgTx, nextBlockHeight,
+			mp.cfg.HasVotePassedAtHeight)
+		if err != nil {
+			return nil, err
+		}
+
+		// Don't accept transactions that create outputs of an SKA coin type
+		// that is being deactivated.
+		err = checkSKADeactivationStandard(msgTx, nextBlockHeight,
+			mp.cfg.HasVotePassedAtHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Don't accept transactions that will be expired as of the next block.
 	if blockchain.IsExpired(tx, nextBlockHeight) {
 		str := fmt.Sprintf("transaction %v expired at height %d",
@@ -1436,6 +2065,18 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 		return nil, txRuleError(ErrExpired, str)
 	}
 
+	// Don't accept transactions that violate the expiry and sequence lock
+	// rules configured for their coin type.
+	err = blockchain.CheckSKATxLockPolicy(msgTx, nextBlockHeight,
+		mp.cfg.ChainParams)
+	if err != nil {
+		var cerr blockchain.RuleError
+		if errors.As(err, &cerr) {
+			return nil, chainRuleError(cerr)
+		}
+		return nil, err
+	}
+
 	// Reject votes and treasury spends before stake validation height.
 	isVote := txType == stake.TxTypeSSGen
 	isTSpend := isTreasuryEnabled && txType == stake.TxTypeTSpend
@@ -1465,8 +2106,9 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 	// their acceptance and relaying.
 	medianTime := mp.cfg.PastMedianTime()
 	if !mp.cfg.Policy.AcceptNonStd {
+		nullData := mp.cfg.Policy.nullDataPolicy(wire.GetPrimaryCoinType(msgTx))
 		err := checkTransactionStandard(tx, txType, nextBlockHeight,
-			medianTime, mp.cfg.Policy.MinRelayTxFee)
+			medianTime, mp.cfg.Policy.MinRelayTxFee, nullData)
 		if err != nil {
 			str := fmt.Sprintf("transaction %v is not standard: %v",
 				txHash, err)
@@ -1505,6 +2147,12 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 	if !isVote && !isRevocation {
 		err = mp.checkPoolDoubleSpend(tx, txType, isTreasuryEnabled)
 		if err != nil {
+			if mp.cfg.OnDoubleSpend != nil && !dryRun &&
+				errors.Is(err, ErrMempoolDoubleSpend) {
+
+				mp.notifyDoubleSpend(tx, txType, isTreasuryEnabled,
+					isAutoRevocationsEnabled)
+			}
 			return nil, err
 		}
 
@@ -1581,7 +2229,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 		entry := utxoView.LookupEntry(txIn.PreviousOutPoint)
 		if entry != nil && !entry.IsSpent() {
 			coinType := entry.CoinType()
-			if coinType.IsSKA() && !mp.cfg.ChainParams.IsSKACoinTypeActive(coinType) {
+			if coinType.IsSKA() && !mp.cfg.ChainParams.IsSKACoinTypeActiveAtHeight(coinType, nextBlockHeight) {
 				str := fmt.Sprintf("transaction %v spends inactive SKA coin type %d",
 					txHash, coinType)
 				return nil, txRuleError(ErrInvalid, str)
@@ -1783,7 +2431,8 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 	serializedSize := int64(msgTx.SerializeSize())
 
 	// Validate coin type consistency (no VAR↔SKA crosses)
-	if err := mp.validateCoinTypeConsistency(tx, utxoView); err != nil {
+	err = mp.validateCoinTypeConsistency(tx, utxoView, nextBlockHeight)
+	if err != nil {
 		return nil, err
 	}
 
@@ -1808,16 +2457,18 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 	actualFee := int64(0)
 	if fee, exists := feesByType[primaryCoinType]; exists {
 		actualFee = fee
-	} else if !isSKAEmission {
-		// For non-emission transactions, if we didn't find a fee for the primary coin type,
+	} else if !feeExempt {
+		// For non-exempt transactions, if we didn't find a fee for the primary coin type,
 		// it means the transaction has no inputs (which should have been caught earlier)
 		// Use the consensus-calculated fee as fallback
 		actualFee = txFee
 	}
 
 	// Validate fees for transactions that require them
-	// Note: TSpend transactions are feeless, so we exclude them from fee validation
-	if !isSKAEmission && !isTSpend && (txType == stake.TxTypeRegular || isTicket || isTreasuryAdd) {
+	// Note: TSpend and fee exempt transactions are feeless, so we exclude them
+	// from fee validation
+	var minFeeRateLimited bool
+	if !feeExempt && !isTSpend && (txType == stake.TxTypeRegular || isTicket || isTreasuryAdd) {
 		// Calculate minimum fee for the coin type
 		var minFee int64
 		if mp.feeCalculator != nil {
@@ -1826,7 +2477,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 			minFee = mp.calculateLegacyMinFee(msgTx, serializedSize, primaryCoinType)
 		}
 
-		if actualFee < minFee {
+		if actualFee < minFee && !packageParent {
 			var txTypeStr string
 			switch {
 			case txType == stake.TxTypeRegular:
@@ -1850,6 +2501,30 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 			return nil, txRuleError(ErrInsufficientFee, str)
 		}
 
+		// Don't allow regular transactions that do not pay the rolling
+		// minimum fee of their coin type which is raised when transactions
+		// are evicted to keep the pool within the size limit of the coin
+		// type.  Package parents are exempt since the package as a whole
+		// is checked instead.
+		if txType == stake.TxTypeRegular && !packageParent {
+			mempoolMinFee := calcFeeForRate(serializedSize,
+				mp.mempoolMinFeeRate(primaryCoinType, time.Now()))
+			if actualFee < mempoolMinFee {
+				str := fmt.Sprintf("transaction %v of coin type %v pays a "+
+					"fee of %d atoms which is under the mempool minimum "+
+					"fee of %d atoms for a %d-byte transaction", txHash,
+					primaryCoinType, actualFee, mempoolMinFee,
+					serializedSize)
+				return nil, txRuleError(ErrInsufficientFee, str)
+			}
+		}
+
+		// Note whether the transaction is a regular transaction relayed by
+		// a peer that only pays about the minimum relay fee so the rate at
+		// which such transactions are accepted is limited below.
+		minFeeRateLimited = tag != 0 && txType == stake.TxTypeRegular &&
+			actualFee < minFee*minFeeRateLimitMultiplier
+
 		// Check for excessively high fees
 		if !allowHighFees {
 			var maxFee int64
@@ -1869,6 +2544,17 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 		}
 	}
 
+	// Don't allow new regular transactions that would create chains of
+	// unconfirmed transactions that exceed the chain limits of their coin
+	// type.  Transactions from disconnected blocks are exempt so they are
+	// not lost during reorganizations.
+	if isNew && txType == stake.TxTypeRegular && !feeExempt && !isSKAEmission {
+		err := mp.checkChainLimits(tx, primaryCoinType, serializedSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Verify crypto signatures for each input and reject the transaction if
 	// any don't verify.
 	flags, err := mp.cfg.Policy.StandardVerifyFlags()
@@ -1949,7 +2635,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 		}
 
 		// Notify that we accepted a TSpend.
-		if mp.cfg.OnTSpendReceived != nil {
+		if mp.cfg.OnTSpendReceived != nil && !dryRun {
 			mp.cfg.OnTSpendReceived(tx)
 		}
 
@@ -1958,8 +2644,31 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 			tvi, mul, tspends)
 	}
 
+	// Nothing more to do when only checking whether the transaction would be
+	// accepted.
+	if dryRun {
+		return nil, nil
+	}
+
+	// Limit the rate at which regular transactions relayed by peers that only
+	// pay about the minimum relay fee are accepted.  This is intentionally the
+	// final check so the limits are only consulted for, and only consumed by,
+	// transactions that are otherwise accepted and invalid transactions can
+	// not exhaust them.
+	if minFeeRateLimited {
+		now := time.Now()
+		if !mp.minFeeLimiter.allow(primaryCoinType, tag, serializedSize, now) {
+			str := fmt.Sprintf("transaction %v of coin type %v pays the "+
+				"minimum relay fee and exceeds the relay rate limit for "+
+				"such transactions", txHash, primaryCoinType)
+			return nil, txRuleError(ErrRateLimited, str)
+		}
+		mp.minFeeLimiter.consume(primaryCoinType, tag, serializedSize, now)
+	}
+
 	txDesc := mp.newTxDesc(utxoView, tx, txType, bestHeight, txFee, totalSigOps,
 		serializedSize)
+	txDesc.FeeExempt = feeExempt
 
 	// Tickets cannot be included in a block until all inputs have
 	// been approved by stakeholders. Consensus rules dictate that stake
@@ -2013,6 +2722,19 @@ func (mp *TxPool) maybeAcceptTransaction(tx *dcrutil.Tx, isNew, allowHighFees,
 		mp.skaEmissions[coinType] = txHash
 	}
 
+	// Evict the transactions paying the lowest fee rates when the pool
+	// exceeds the size limit of the coin type.  Package parents are only
+	// subject to the limit once the child that pays for them is accepted.
+	if txType == stake.TxTypeRegular && !packageParent {
+		mp.trimToSize(primaryCoinType)
+		if !mp.isTransactionInPool(txHash) {
+			str := fmt.Sprintf("transaction %v of coin type %v was evicted "+
+				"since the mempool is full and its fee rate is too low",
+				txHash, primaryCoinType)
+			return nil, txRuleError(ErrInsufficientFee, str)
+		}
+	}
+
 	log.Debugf("Accepted transaction %v (pool size: %v)", txHash,
 		len(mp.pool))
 
@@ -2043,6 +2765,11 @@ func (mp *TxPool) determineCheckTxFlags() (blockchain.AgendaFlags, error) {
 		return 0, err
 	}
 
+	isInputCoinTypesEnabled, err := mp.cfg.IsInputCoinTypesAgendaActive()
+	if err != nil {
+		return 0, err
+	}
+
 	// Create agenda flags for checking transactions based on which ones are
 	// active or should otherwise always be enforced.
 	//
@@ -2060,6 +2787,9 @@ func (mp *TxPool) determineCheckTxFlags() (blockchain.AgendaFlags, error) {
 	if isSubsidySplitR2Enabled {
 		checkTxFlags |= blockchain.AFSubsidySplitR2Enabled
 	}
+	if isInputCoinTypesEnabled {
+		checkTxFlags |= blockchain.AFInputCoinTypesEnabled
+	}
 	return checkTxFlags, nil
 }
 
@@ -2080,7 +2810,7 @@ func (mp *TxPool) MaybeAcceptTransaction(tx *dcrutil.Tx, isNew bool) ([]wire.Out
 	// Protect concurrent access.
 	mp.mtx.Lock()
 	missingInputs, err := mp.maybeAcceptTransaction(tx, isNew, true, true,
-		checkTxFlags)
+		false, false, 0, checkTxFlags)
 	mp.mtx.Unlock()
 
 	return missingInputs, err
@@ -2130,7 +2860,8 @@ func (mp *TxPool) MaybeAcceptTransactions(txns []*dcrutil.Tx) error {
 	for i := len(txns) - 1; i >= 0; i-- {
 		tx := txns[i]
 		delete(transientPool, *tx.Hash())
-		_, err := mp.maybeAcceptTransaction(tx, false, true, true, checkTxFlags)
+		_, err := mp.maybeAcceptTransaction(tx, false, true, true, false,
+			false, 0, checkTxFlags)
 		if err != nil && !isDoubleSpendOrDuplicateError(err) {
 			mp.removeTransaction(tx, true)
 			continue
@@ -2151,6 +2882,50 @@ func (mp *TxPool) MaybeAcceptTransactions(txns []*dcrutil.Tx) error {
 	return finalErr
 }
 
+// MaybeAcceptSKAEmissions attempts to add the provided SKA emission
+// transactions back to the pool after they were rolled back from the emission
+// state by a disconnected block so they may be mined again while their
+// emission windows remain open.  Transactions that are not SKA emissions are
+// ignored.
+//
+// Transactions in the pool that directly or indirectly spend outputs of an
+// emission that is rejected are removed since they can no longer be mined.
+//
+// It returns the reason each emission that is not in the pool afterwards was
+// rejected keyed by its transaction hash.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) MaybeAcceptSKAEmissions(txns []*dcrutil.Tx) map[chainhash.Hash]error {
+	checkTxFlags, err := mp.determineCheckTxFlags()
+	if err != nil {
+		rejected := make(map[chainhash.Hash]error, len(txns))
+		for _, tx := range txns {
+			rejected[*tx.Hash()] = err
+		}
+		return rejected
+	}
+
+	var rejected map[chainhash.Hash]error
+	mp.mtx.Lock()
+	for _, tx := range txns {
+		if !wire.IsSKAEmissionTransaction(tx.MsgTx()) {
+			continue
+		}
+		_, err := mp.maybeAcceptTransaction(tx, false, true, true, false,
+			false, 0, checkTxFlags)
+		if err != nil && !mp.haveTransaction(tx.Hash()) {
+			if rejected == nil {
+				rejected = make(map[chainhash.Hash]error)
+			}
+			rejected[*tx.Hash()] = err
+			mp.evictSKAEmissionConflict(tx, nil)
+		}
+	}
+	mp.mtx.Unlock()
+
+	return rejected
+}
+
 // processOrphans is the internal function which implements the public
 // ProcessOrphans.  See the comment for ProcessOrphans for more details.
 //
@@ -2191,10 +2966,15 @@ func (mp *TxPool) processOrphans(acceptedTx *dcrutil.Tx, checkTxFlags blockchain
 				continue
 			}
 
-			// Potentially accept an orphan into the tx pool.
+			// Potentially accept an orphan into the tx pool.  The orphan
+			// is subject to the rate limits of the peer that relayed it.
 			for _, tx := range orphans {
+				var tag Tag
+				if orphan, ok := mp.orphans[*tx.Hash()]; ok {
+					tag = orphan.tag
+				}
 				missing, err := mp.maybeAcceptTransaction(tx, true, true, false,
-					checkTxFlags)
+					false, false, tag, checkTxFlags)
 				if err != nil {
 					// The orphan is now invalid, so there
 					// is no way any other orphans which
@@ -2319,20 +3099,87 @@ func (mp *TxPool) PruneStakeTx(requiredStakeDifficulty, height int64) {
 }
 
 // pruneExpiredTx prunes expired transactions that are no longer able to be
-// included into a block from the mempool.  The height is expected to be the
-// height of the current best chain tip.
+// included into a block from the mempool along with regular transactions that
+// have exceeded the max age of their coin type and transactions that create
+// outputs of SKA coin types that have been deactivated or are not active in
+// the next block.  The height is expected to be the height of the current best
+// chain tip.
 //
 // This function MUST be called with the mempool lock held (for writes).
 func (mp *TxPool) pruneExpiredTx(height int64) {
 	nextBlockHeight := height + 1
+	if mp.feeCalculator != nil {
+		mp.feeCalculator.UpdateActiveCoinTypes(nextBlockHeight)
+	}
 
+	now := time.Now()
+	mp.decayRollingMinFees(now)
+	var numAged map[cointype.CoinType]int
 	for _, txDesc := range mp.pool {
 		tx := txDesc.Tx
 		if blockchain.IsExpired(tx, nextBlockHeight) {
 			log.Debugf("Pruning expired transaction %v from the mempool",
 				tx.Hash())
 			mp.removeTransaction(tx, true)
+			continue
+		}
+
+		// Evict transactions that create outputs of coin types that are
+		// not active in the next block, such as after a reorganization to
+		// below their activation height, since they are invalid.
+		if coinType, ok := inactiveSKAOutput(tx.MsgTx(), nextBlockHeight,
+			mp.cfg.ChainParams); ok {
+
+			log.Debugf("Pruning transaction %v from the mempool: coin "+
+				"type %v is not active at height %d", tx.Hash(), coinType,
+				nextBlockHeight)
+			mp.removeTransaction(tx, true)
+			continue
+		}
+
+		// Evict transactions that create outputs of coin types that are
+		// being deactivated since they will become invalid.
+		err := checkSKADeactivationStandard(tx.MsgTx(), nextBlockHeight,
+			mp.cfg.HasVotePassedAtHeight)
+		if err != nil {
+			log.Debugf("Pruning transaction %v from the mempool: %v",
+				tx.Hash(), err)
+			mp.removeTransaction(tx, true)
+			continue
 		}
+
+		// Evict regular transactions that have been in the pool for longer
+		// than the max age of their coin type.  SKA emissions are exempt
+		// since they are bound by their emission window instead.
+		if txDesc.Type != stake.TxTypeRegular || txDesc.FeeExempt {
+			continue
+		}
+		coinType := wire.GetPrimaryCoinType(tx.MsgTx())
+		maxAge := mp.cfg.Policy.maxTxAge(coinType)
+		if now.Sub(txDesc.Added) <= maxAge {
+			continue
+		}
+		log.Debugf("Pruning %v transaction %v from the mempool since it "+
+			"exceeded the max age of %v", coinType, tx.Hash(), maxAge)
+		mp.removeTransaction(tx, true)
+		mp.expiredTxns[coinType]++
+		if numAged == nil {
+			numAged = make(map[cointype.CoinType]int)
+		}
+		numAged[coinType]++
+	}
+	if len(numAged) > 0 {
+		coinTypes := cointype.SortedKeys(numAged)
+		var total int
+		counts := make([]string, 0, len(coinTypes))
+		for _, coinType := range coinTypes {
+			total += numAged[coinType]
+			counts = append(counts, fmt.Sprintf("%v: %d", coinType,
+				numAged[coinType]))
+		}
+		log.Infof("Expired %d %s that exceeded the max mempool age (%s)",
+			total, pickNoun(total, "transaction", "transactions"),
+			strings.Join(counts, ", "))
 	}
 
 	for _, txDesc := range mp.staged {
@@ -2343,11 +3190,87 @@ func (mp *TxPool) pruneExpiredTx(height int64) {
 			mp.removeStagedTransaction(tx)
 		}
 	}
+
+	// Prune SKA emissions whose emission window has closed, such as those
+	// rolled back by a reorganization that were not mined again in time,
+	// since they can no longer be included in a block.
+	for coinType, txHash := range mp.skaEmissions {
+		tranche := mp.nextSKAEmissionTranche(coinType)
+		if tranche == nil {
+			continue
+		}
+		emissionEnd := tranche.WindowEnd()
+		if nextBlockHeight <= emissionEnd {
+			continue
+		}
+		if txDesc, exists := mp.pool[*txHash]; exists {
+			skaeLog.Debugf("Pruning SKA emission %v for coin type %d from the "+
+				"mempool since its emission window closed at height %d",
+				txHash, coinType, emissionEnd)
+			mp.removeTransaction(txDesc.Tx, true)
+		}
+	}
+}
+
+// checkSKAEmissionWindow returns an error when the provided block height is
+// outside the emission window of the provided tranche.  A nil tranche means
+// there is no scheduled window to enforce.
+func checkSKAEmissionWindow(txHash *chainhash.Hash, tranche *chaincfg.SKAEmissionTranche, nextBlockHeight int64) error {
+	if tranche == nil {
+		return nil
+	}
+
+	bestHeight := nextBlockHeight - 1
+	emissionStart := int64(tranche.EmissionHeight)
+	if nextBlockHeight < emissionStart {
+		str := fmt.Sprintf("transaction %v is outside emission window - too early (emission starts at block %d, current height %d)",
+			txHash, emissionStart, bestHeight)
+		return txRuleError(ErrInvalid, str)
+	}
+
+	emissionEnd := tranche.WindowEnd()
+	if nextBlockHeight > emissionEnd {
+		str := fmt.Sprintf("transaction %v is outside emission window - expired (emission ended at block %d, current height %d)",
+			txHash, emissionEnd, bestHeight)
+		return txRuleError(ErrInvalid, str)
+	}
+
+	return nil
+}
+
+// nextSKAEmissionTranche returns the scheduled emission tranche the next SKA
+// emission for the provided coin type is expected to authorize based on the
+// current chain state.  It returns nil when the coin type is not configured or
+// all of its tranches have already been emitted.
+func (mp *TxPool) nextSKAEmissionTranche(coinType cointype.CoinType) *chaincfg.SKAEmissionTranche {
+	config, ok := mp.cfg.ChainParams.SKACoins[coinType]
+	if !ok {
+		return nil
+	}
+	var emitted uint32
+	if mp.cfg.SKAEmissionTranchesEmitted != nil {
+		emitted = mp.cfg.SKAEmissionTranchesEmitted(coinType)
+	}
+	return config.NextEmissionTranche(emitted)
+}
+
+// inactiveSKAOutput returns the first SKA coin type of the outputs of the
+// provided transaction that is not active in the block at the provided height
+// and whether there is one.
+func inactiveSKAOutput(msgTx *wire.MsgTx, height int64, params *chaincfg.Params) (cointype.CoinType, bool) {
+	for _, txOut := range msgTx.TxOut {
+		coinType := txOut.CoinType
+		if coinType.IsSKA() && !params.IsSKACoinTypeActiveAtHeight(coinType, height) {
+			return coinType, true
+		}
+	}
+	return 0, false
 }
 
 // PruneExpiredTx prunes expired transactions that are no longer able to be
-// included into a block from the mempool.  The height is expected to be the
-// height of the current best chain tip.
+// included into a block from the mempool along with regular transactions that
+// have exceeded the max age of their coin type.  The height is expected to be
+// the height of the current best chain tip.
 //
 // This function is safe for concurrent access.
 func (mp *TxPool) PruneExpiredTx(height int64) {
@@ -2357,6 +3280,29 @@ func (mp *TxPool) PruneExpiredTx(height int64) {
 	mp.mtx.Unlock()
 }
 
+// MaxTxAge returns the maximum amount of time a regular transaction of the
+// provided coin type may remain in the mempool before it expires.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) MaxTxAge(coinType cointype.CoinType) time.Duration {
+	return mp.cfg.Policy.maxTxAge(coinType)
+}
+
+// ExpiredTxCounts returns the total number of regular transactions that have
+// been evicted from the mempool for exceeding the max age of their coin type
+// broken down by coin type.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) ExpiredTxCounts() map[cointype.CoinType]uint64 {
+	mp.mtx.RLock()
+	counts := make(map[cointype.CoinType]uint64, len(mp.expiredTxns))
+	for coinType, count := range mp.expiredTxns {
+		counts[coinType] = count
+	}
+	mp.mtx.RUnlock()
+	return counts
+}
+
 // ProcessOrphans determines if there are any orphans which depend on the passed
 // transaction hash (it is possible that they are no longer orphans) and
 // potentially accepts them to the memory pool.  It repeats the process for the
@@ -2405,7 +3351,7 @@ func (mp *TxPool) ProcessTransaction(tx *dcrutil.Tx, allowOrphan, allowHighFees
 
 	// Potentially accept the transaction to the memory pool.
 	missingParents, err := mp.maybeAcceptTransaction(tx, true, allowHighFees,
-		true, checkTxFlags)
+		true, false, false, tag, checkTxFlags)
 	if err != nil {
 		return nil, err
 	}
@@ -2450,6 +3396,270 @@ func (mp *TxPool) ProcessTransaction(tx *dcrutil.Tx, allowOrphan, allowHighFees
 	return nil, err
 }
 
+// TestAcceptTransaction subjects the passed transaction to all of the checks
+// required for it to be accepted to the memory pool without actually adding
+// it.  Orphan transactions are rejected.  When the transaction would be
+// accepted, the fee it pays in its primary coin type is returned.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) TestAcceptTransaction(tx *dcrutil.Tx, allowHighFees bool) (int64, error) {
+	// Create agenda flags for checking transactions based on which ones are
+	// active or should otherwise always be enforced.
+	checkTxFlags, err := mp.determineCheckTxFlags()
+	if err != nil {
+		return 0, err
+	}
+
+	// Protect concurrent access.
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	missingParents, err := mp.maybeAcceptTransaction(tx, true, allowHighFees,
+		true, true, false, 0, checkTxFlags)
+	if err != nil {
+		return 0, err
+	}
+	if len(missingParents) != 0 {
+		str := fmt.Sprintf("orphan transaction %v references "+
+			"output %v of unknown or fully-spent transaction",
+			tx.Hash(), missingParents[0])
+		return 0, txRuleError(ErrOrphan, str)
+	}
+
+	// Calculate the fee paid in the primary coin type.  The inputs are known
+	// to be available since the transaction passed all of the checks.
+	msgTx := tx.MsgTx()
+	utxoView, err := mp.fetchInputUtxos(tx, checkTxFlags.IsTreasuryEnabled())
+	if err != nil {
+		return 0, err
+	}
+	feesByType, err := mp.computeFeesByType(utxoView, msgTx,
+		stake.DetermineTxType(msgTx))
+	if err != nil {
+		return 0, err
+	}
+	return feesByType[mp.determinePrimaryCoinType(msgTx)], nil
+}
+
+// checkPackage ensures the passed transactions form a valid package and
+// returns the coin type shared by all of them.  A valid package consists of
+// regular transactions of the same primary coin type ordered such that every
+// transaction only spends outputs of the package transactions that precede
+// it, and every transaction other than the final child has at least one output
+// spent by a later package transaction.
+func (mp *TxPool) checkPackage(pkg []*dcrutil.Tx) (cointype.CoinType, error) {
+	if len(pkg) < 2 || len(pkg) > MaxPackageTxns {
+		str := fmt.Sprintf("package has %d transactions which is not in the "+
+			"allowed range of 2 to %d", len(pkg), MaxPackageTxns)
+		return 0, txRuleError(ErrInvalidPackage, str)
+	}
+
+	positions := make(map[chainhash.Hash]int, len(pkg))
+	for i, tx := range pkg {
+		if _, ok := positions[*tx.Hash()]; ok {
+			str := fmt.Sprintf("package contains transaction %v more than "+
+				"once", tx.Hash())
+			return 0, txRuleError(ErrInvalidPackage, str)
+		}
+		positions[*tx.Hash()] = i
+	}
+
+	coinType := mp.determinePrimaryCoinType(pkg[len(pkg)-1].MsgTx())
+	hasChild := make([]bool, len(pkg))
+	for i, tx := range pkg {
+		msgTx := tx.MsgTx()
+		if stake.DetermineTxType(msgTx) != stake.TxTypeRegular ||
+			wire.IsSKAEmissionTransaction(msgTx) {
+
+			str := fmt.Sprintf("package transaction %v is not a regular "+
+				"transaction", tx.Hash())
+			return 0, txRuleError(ErrInvalidPackage, str)
+		}
+		if txCoinType := mp.determinePrimaryCoinType(msgTx); txCoinType != coinType {
+			str := fmt.Sprintf("package transaction %v has coin type %d "+
+				"while the package has coin type %d", tx.Hash(), txCoinType,
+				coinType)
+			return 0, txRuleError(ErrInvalidPackage, str)
+		}
+		for _, txIn := range msgTx.TxIn {
+			parent, ok := positions[txIn.PreviousOutPoint.Hash]
+			if !ok {
+				continue
+			}
+			if parent >= i {
+				str := fmt.Sprintf("package transaction %v spends an "+
+					"output of transaction %v which does not precede it",
+					tx.Hash(), &txIn.PreviousOutPoint.Hash)
+				return 0, txRuleError(ErrInvalidPackage, str)
+			}
+			hasChild[parent] = true
+		}
+	}
+	for i, tx := range pkg[:len(pkg)-1] {
+		if !hasChild[i] {
+			str := fmt.Sprintf("package transaction %v is not spent by "+
+				"another package transaction", tx.Hash())
+			return 0, txRuleError(ErrInvalidPackage, str)
+		}
+	}
+
+	return coinType, nil
+}
+
+// packageFee returns the fee paid in the passed coin type and the total
+// serialized size of the passed package transactions that are not already in
+// the pool.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) packageFee(pkg []*dcrutil.Tx, coinType cointype.CoinType, isTreasuryEnabled bool) (int64, int64, error) {
+	var totalFee, totalSize int64
+	pkgTxns := make(map[chainhash.Hash]*dcrutil.Tx, len(pkg))
+	for _, tx := range pkg {
+		pkgTxns[*tx.Hash()] = tx
+		if mp.isTransactionInPool(tx.Hash()) {
+			continue
+		}
+
+		// Load the inputs from the chain and pool and fill in any that are
+		// created by the preceding package transactions.
+		utxoView, err := mp.fetchInputUtxos(tx, isTreasuryEnabled)
+		if err != nil {
+			return 0, 0, err
+		}
+		msgTx := tx.MsgTx()
+		for _, txIn := range msgTx.TxIn {
+			prevOut := &txIn.PreviousOutPoint
+			entry := utxoView.LookupEntry(*prevOut)
+			if entry != nil && !entry.IsSpent() {
+				continue
+			}
+			parent, ok := pkgTxns[prevOut.Hash]
+			if !ok {
+				str := fmt.Sprintf("package transaction %v references "+
+					"output %v of unknown or fully-spent transaction",
+					tx.Hash(), prevOut)
+				return 0, 0, txRuleError(ErrOrphan, str)
+			}
+			utxoView.AddTxOut(parent, prevOut.Index, mining.UnminedHeight,
+				wire.NullBlockIndex, isTreasuryEnabled)
+		}
+
+		feesByType, err := mp.computeFeesByType(utxoView, msgTx,
+			stake.TxTypeRegular)
+		if err != nil {
+			str := fmt.Sprintf("package transaction %v fee calculation "+
+				"error: %v", tx.Hash(), err)
+			return 0, 0, txRuleError(ErrInvalid, str)
+		}
+		totalFee += feesByType[coinType]
+		totalSize += int64(msgTx.SerializeSize())
+	}
+	return totalFee, totalSize, nil
+}
+
+// ProcessPackage is the main workhorse for handling insertion of a package of
+// related transactions into the memory pool.  A package consists of a child
+// transaction, which must be the final transaction, preceded by its unconfirmed
+// parents ordered such that every transaction only spends outputs of the
+// package transactions that precede it.  All of the transactions must be
+// regular transactions of the same coin type.
+//
+// The parents are not required to pay the minimum fee of the coin type on
+// their own.  Instead, the fee rate of the package as a whole must meet the
+// minimum fee of the coin type which allows a child that pays a high fee to pay
+// for parents with a low fee.  The child must still pay the minimum fee on its
+// own.  Either all of the package transactions that are not already in the pool
+// are accepted or none of them are.
+//
+// It returns a slice of transactions added to the mempool.  The package
+// transactions come first, in order, followed by any orphans that were accepted
+// as a result.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) ProcessPackage(pkg []*dcrutil.Tx, allowHighFees bool) ([]*dcrutil.Tx, error) {
+	// Create agenda flags for checking transactions based on which ones are
+	// active or should otherwise always be enforced.
+	checkTxFlags, err := mp.determineCheckTxFlags()
+	if err != nil {
+		return nil, err
+	}
+	isTreasuryEnabled := checkTxFlags.IsTreasuryEnabled()
+
+	// Protect concurrent access.
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	coinType, err := mp.checkPackage(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the package as a whole pays the minimum fee for its coin type.
+	child := pkg[len(pkg)-1]
+	fee, size, err := mp.packageFee(pkg, coinType, isTreasuryEnabled)
+	if err != nil {
+		return nil, err
+	}
+	var minFee int64
+	if mp.feeCalculator != nil {
+		minFee = mp.feeCalculator.CalculateMinFee(size, coinType)
+	} else {
+		minFee = mp.calculateLegacyMinFee(child.MsgTx(), size, coinType)
+	}
+	mempoolMinFee := calcFeeForRate(size, mp.mempoolMinFeeRate(coinType,
+		time.Now()))
+	if mempoolMinFee > minFee {
+		minFee = mempoolMinFee
+	}
+	if fee < minFee {
+		str := fmt.Sprintf("package with child %v pays a fee of %d atoms of "+
+			"coin type %d which is under the required fee of %d atoms for "+
+			"%d bytes", child.Hash(), fee, coinType, minFee, size)
+		return nil, txRuleError(ErrInsufficientFee, str)
+	}
+
+	// Attempt to accept every transaction in the package that is not already
+	// in the pool and remove any that were accepted when one of them fails.
+	accepted := make([]*dcrutil.Tx, 0, len(pkg))
+	for i, tx := range pkg {
+		if mp.isTransactionInPool(tx.Hash()) {
+			continue
+		}
+
+		isParent := i != len(pkg)-1
+		missingParents, err := mp.maybeAcceptTransaction(tx, true,
+			allowHighFees, true, false, isParent, 0, checkTxFlags)
+		if err == nil && len(missingParents) != 0 {
+			str := fmt.Sprintf("package transaction %v references "+
+				"output %v of unknown or fully-spent transaction",
+				tx.Hash(), missingParents[0])
+			err = txRuleError(ErrOrphan, str)
+		}
+		if err != nil {
+			for j := len(accepted) - 1; j >= 0; j-- {
+				mp.removeTransaction(accepted[j], true)
+			}
+			log.Tracef("Failed to process package with child %v: %v",
+				child.Hash(), err)
+			return nil, err
+		}
+		accepted = append(accepted, tx)
+	}
+
+	// Accept any orphan transactions that depend on the package transactions.
+	acceptedTxs := make([]*dcrutil.Tx, len(accepted))
+	copy(acceptedTxs, accepted)
+	for _, tx := range accepted {
+		acceptedTxs = append(acceptedTxs, mp.processOrphans(tx, checkTxFlags)...)
+	}
+
+	log.Debugf("Accepted package with child %v (%d transactions, fee %d, "+
+		"size %d, coin type %d)", child.Hash(), len(accepted), fee, size,
+		coinType)
+
+	return acceptedTxs, nil
+}
+
 // Count returns the number of transactions in the main pool.  It does not
 // include the orphan pool.
 //
@@ -2593,10 +3803,14 @@ func New(cfg *Config) *TxPool {
 		pool:            make(map[chainhash.Hash]*TxDesc),
 		orphans:         make(map[chainhash.Hash]*orphanTx),
 		orphansByPrev:   make(map[wire.OutPoint]map[chainhash.Hash]*dcrutil.Tx),
+		numOrphans:      make(map[cointype.CoinType]int),
 		outpoints:       make(map[wire.OutPoint]*TxDesc),
 		votes:           make(map[chainhash.Hash][]mining.VoteDesc),
 		tspends:         make(map[chainhash.Hash]*dcrutil.Tx),
 		skaEmissions:    make(map[cointype.CoinType]*chainhash.Hash),
+		expiredTxns:     make(map[cointype.CoinType]uint64),
+		poolSizes:       make(map[cointype.CoinType]int64),
+		rollingMinFees:  make(map[cointype.CoinType]*rollingMinFee),
 		nextExpireScan:  time.Now().Add(orphanExpireScanInterval),
 		staged:          make(map[chainhash.Hash]*TxDesc),
 		stagedOutpoints: make(map[wire.OutPoint]*TxDesc),
@@ -2621,6 +3835,7 @@ func New(cfg *Config) *TxPool {
 
 	// Initialize fee calculator for coin-type-specific fee validation
 	mp.feeCalculator = fees.NewCoinTypeFeeCalculator(cfg.ChainParams, cfg.Policy.MinRelayTxFee)
+	mp.minFeeLimiter = newMinFeeRateLimiter(mp.cfg.Policy.minFeeRelayLimit)
 
 	return mp
 }
@@ -2749,12 +3964,21 @@ func (mp *TxPool) ProcessConfirmedTransactions(block *dcrutil.Block, isTreasuryE
 		for _, tx := range txns {
 			mp.mtx.RLock()
 			if poolTxDesc, exists := mp.pool[*tx.Hash()]; exists {
-				// Skip feeless system transactions (votes and revocations) from fee statistics
-				if poolTxDesc.Type != stake.TxTypeSSGen && poolTxDesc.Type != stake.TxTypeSSRtx {
+				// Skip feeless system transactions (votes, revocations, and
+				// fee exempt transactions) from fee statistics
+				if !poolTxDesc.FeeExempt && poolTxDesc.Type != stake.TxTypeSSGen &&
+					poolTxDesc.Type != stake.TxTypeSSRtx {
+
 					// Determine coin type from outputs (inputs and outputs always match)
 					primaryCoinType := mp.determinePrimaryCoinType(tx.MsgTx())
 					txSize := int64(tx.MsgTx().SerializeSize())
 					mp.feeCalculator.RecordTransactionFee(primaryCoinType, poolTxDesc.Fee, txSize, true) // true = confirmed
+
+					// Track the accuracy of the prediction made when the
+					// transaction was added to the pool.
+					actualConfs := int32(block.Height() - poolTxDesc.Height)
+					mp.feeCalculator.RecordConfirmation(primaryCoinType,
+						poolTxDesc.PredictedConfs, actualConfs)
 				}
 			}
 			mp.mtx.RUnlock()
@@ -2777,9 +4001,23 @@ func (mp *TxPool) ProcessConfirmedTransactions(block *dcrutil.Block, isTreasuryE
 	}
 }
 
+// isSKADeactivated returns whether or not the vote to deactivate the provided
+// SKA coin type has passed as of the provided block height.
+func (mp *TxPool) isSKADeactivated(coinType cointype.CoinType, height int64) bool {
+	if mp.cfg.HasVotePassedAtHeight == nil || !coinType.IsSKA() {
+		return false
+	}
+	return mp.cfg.HasVotePassedAtHeight(chaincfg.SKADeactivationVoteID(coinType),
+		height)
+}
+
 // validateCoinTypeConsistency ensures that transactions don't mix coin types
 // (VAR inputs can only produce VAR outputs, SKA inputs can only produce SKA outputs)
-func (mp *TxPool) validateCoinTypeConsistency(tx *dcrutil.Tx, utxoView *blockchain.UtxoViewpoint) error {
+//
+// The only exception is sweeps of coin types that have been deactivated as of
+// the provided block height: transactions that only create VAR outputs may
+// spend their outputs alongside the VAR inputs that pay the fee.
+func (mp *TxPool) validateCoinTypeConsistency(tx *dcrutil.Tx, utxoView *blockchain.UtxoViewpoint, nextBlockHeight int64) error {
 	msgTx := tx.MsgTx()
 	txHash := tx.Hash()
 
@@ -2876,6 +4114,16 @@ func (mp *TxPool) validateCoinTypeConsistency(tx *dcrutil.Tx, utxoView *blockcha
 		outputCoinTypes[txOut.CoinType] = true
 	}
 
+	// Inputs of deactivated coin types in transactions that only create VAR
+	// outputs are sweeps whose value is retired.
+	if len(outputCoinTypes) == 1 && outputCoinTypes[cointype.CoinTypeVAR] {
+		for ct := range inputCoinTypes {
+			if mp.isSKADeactivated(ct, nextBlockHeight) {
+				delete(inputCoinTypes, ct)
+			}
+		}
+	}
+
 	// Check for mixed coin types
 	if len(inputCoinTypes) > 1 {
 		return txRuleError(ErrMixedCoinTypes,
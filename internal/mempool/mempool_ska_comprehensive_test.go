@@ -5,8 +5,12 @@
 package mempool
 
 import (
+	"errors"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/monetarium/monetarium-node/blockchain/stake"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/cointype"
@@ -212,7 +216,7 @@ func TestCoinTypeConsistencyValidation(t *testing.T) {
 			dcrTx := dcrutil.NewTx(tx)
 
 			// Test coin type consistency validation
-			err := mp.validateCoinTypeConsistency(dcrTx, utxoView)
+			err := mp.validateCoinTypeConsistency(dcrTx, utxoView, 1)
 
 			if test.expectError {
 				if err == nil {
@@ -322,8 +326,348 @@ func TestSKAEmissionsMapCleanup(t *testing.T) {
 	})
 }
 
+// TestPruneClosedWindowSKAEmissions ensures SKA emissions in the pool, such as
+// those rolled back by a reorganization, are pruned once their emission window
+// closes and are kept while it remains open.
+func TestPruneClosedWindowSKAEmissions(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	config := params.SKACoins[cointype.CoinType(1)]
+	emissionEnd := int64(config.EmissionHeight) + int64(config.EmissionWindow)
+
+	mp := New(&Config{
+		Policy: Policy{
+			MinRelayTxFee: DefaultMinRelayTxFee,
+		},
+		ChainParams: params,
+	})
+
+	// Mock the emission as being in the pool.  Emissions are accepted as fee
+	// exempt.
+	emissionTx := createSKAEmissionTx(cointype.CoinType(1))
+	mp.pool[*emissionTx.Hash()] = &TxDesc{TxDesc: mining.TxDesc{
+		Tx:        emissionTx,
+		FeeExempt: true,
+	}}
+	mp.skaEmissions[cointype.CoinType(1)] = emissionTx.Hash()
+
+	// Ensure the emission is kept when the next block is the final block of
+	// the emission window.
+	mp.pruneExpiredTx(emissionEnd - 1)
+	if !mp.haveTransaction(emissionTx.Hash()) {
+		t.Fatal("emission pruned while its emission window is open")
+	}
+	if mp.skaEmissions[cointype.CoinType(1)] == nil {
+		t.Fatal("emission no longer tracked while its emission window is open")
+	}
+
+	// Ensure the emission is pruned once the window has closed.
+	mp.pruneExpiredTx(emissionEnd)
+	if mp.haveTransaction(emissionTx.Hash()) {
+		t.Fatal("emission not pruned after its emission window closed")
+	}
+	if _, ok := mp.skaEmissions[cointype.CoinType(1)]; ok {
+		t.Fatal("emission still tracked after its emission window closed")
+	}
+}
+
+// TestSKAEmissionConflictEviction ensures that when an emission for a coin type
+// is connected while a conflicting emission rolled back by a reorganization is
+// still pending in the pool, or when a rolled back emission can not be added
+// back to the pool, the transactions spending its outputs are evicted along
+// with it and subscribers are notified, while unrelated transactions remain.
+func TestSKAEmissionConflictEviction(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	var conflicts []*EmissionConflict
+	mp := New(&Config{
+		Policy: Policy{
+			MinRelayTxFee: DefaultMinRelayTxFee,
+		},
+		ChainParams: params,
+		OnEmissionConflict: func(ec *EmissionConflict) {
+			conflicts = append(conflicts, ec)
+		},
+	})
+
+	// addToPool mocks the passed transaction as being in the pool.
+	addToPool := func(tx *dcrutil.Tx) {
+		txDesc := &TxDesc{TxDesc: mining.TxDesc{Tx: tx}}
+		mp.pool[*tx.Hash()] = txDesc
+		for _, txIn := range tx.MsgTx().TxIn {
+			mp.outpoints[txIn.PreviousOutPoint] = txDesc
+		}
+	}
+
+	// spendTx returns a transaction that spends the first output of the
+	// passed transaction.
+	spendTx := func(parent *dcrutil.Tx) *dcrutil.Tx {
+		return dcrutil.NewTx(&wire.MsgTx{
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{Hash: *parent.Hash()},
+			}},
+			TxOut: []*wire.TxOut{{
+				Value:    parent.MsgTx().TxOut[0].Value - 1000,
+				CoinType: parent.MsgTx().TxOut[0].CoinType,
+				PkScript: []byte{0x76, 0xa9, 0x14, 0x04, 0x05, 0x06},
+			}},
+		})
+	}
+
+	// Mock the emission rolled back by a reorganization as being pending in
+	// the pool along with a chain of transactions spending its outputs and
+	// an unrelated transaction.
+	rolledBack := createSKAEmissionTx(cointype.CoinType(1))
+	spend := spendTx(rolledBack)
+	child := spendTx(spend)
+	unrelated := spendTx(createSKAEmissionTx(cointype.CoinType(2)))
+	addToPool(rolledBack)
+	mp.skaEmissions[cointype.CoinType(1)] = rolledBack.Hash()
+	addToPool(spend)
+	addToPool(child)
+	addToPool(unrelated)
+
+	// Ensure connecting the same emission does not evict anything.
+	mp.RemoveSKAEmissionConflicts(rolledBack)
+	for _, tx := range []*dcrutil.Tx{rolledBack, spend, child, unrelated} {
+		if !mp.haveTransaction(tx.Hash()) {
+			t.Fatalf("transaction %v evicted when the same emission was "+
+				"connected", tx.Hash())
+		}
+	}
+	if len(conflicts) != 0 {
+		t.Fatalf("unexpected conflict notifications: %d", len(conflicts))
+	}
+
+	// Ensure connecting a conflicting emission for the same coin type evicts
+	// the pending emission along with the transactions spending its outputs.
+	connectedMsgTx := createSKAEmissionTx(cointype.CoinType(1)).MsgTx()
+	connectedMsgTx.LockTime = 1
+	connected := dcrutil.NewTx(connectedMsgTx)
+	mp.RemoveSKAEmissionConflicts(connected)
+	for _, tx := range []*dcrutil.Tx{rolledBack, spend, child} {
+		if mp.haveTransaction(tx.Hash()) {
+			t.Fatalf("transaction %v not evicted when a conflicting "+
+				"emission was connected", tx.Hash())
+		}
+	}
+	if !mp.haveTransaction(unrelated.Hash()) {
+		t.Fatal("unrelated transaction evicted")
+	}
+	if _, ok := mp.skaEmissions[cointype.CoinType(1)]; ok {
+		t.Fatal("conflicting emission still tracked")
+	}
+	want := &EmissionConflict{
+		Emission:    rolledBack,
+		CoinType:    cointype.CoinType(1),
+		Replacement: connected.Hash(),
+		Evicted:     []chainhash.Hash{*spend.Hash(), *child.Hash()},
+	}
+	if len(conflicts) != 1 || !reflect.DeepEqual(conflicts[0], want) {
+		t.Fatalf("mismatched conflict notifications -- got %+v, want %+v",
+			conflicts, want)
+	}
+
+	// Ensure transactions spending the outputs of a rolled back emission that
+	// is not in the pool, such as one that could not be added back, are
+	// evicted as well.
+	conflicts = nil
+	spend = spendTx(rolledBack)
+	addToPool(spend)
+	mp.evictSKAEmissionConflict(rolledBack, nil)
+	if mp.haveTransaction(spend.Hash()) {
+		t.Fatal("spend of rejected emission not evicted")
+	}
+	want = &EmissionConflict{
+		Emission: rolledBack,
+		CoinType: cointype.CoinType(1),
+		Evicted:  []chainhash.Hash{*spend.Hash()},
+	}
+	if len(conflicts) != 1 || !reflect.DeepEqual(conflicts[0], want) {
+		t.Fatalf("mismatched conflict notifications -- got %+v, want %+v",
+			conflicts, want)
+	}
+
+	// Ensure nothing is signaled when there is nothing to evict.
+	conflicts = nil
+	mp.evictSKAEmissionConflict(rolledBack, nil)
+	if len(conflicts) != 0 {
+		t.Fatalf("unexpected conflict notifications: %d", len(conflicts))
+	}
+}
+
+// TestCheckSKAEmissionWindow ensures emissions are only accepted for blocks
+// within the emission window of the next tranche, including both edges.
+func TestCheckSKAEmissionWindow(t *testing.T) {
+	tranche := &chaincfg.SKAEmissionTranche{
+		EmissionHeight: 100,
+		EmissionWindow: 10,
+	}
+	exactTranche := &chaincfg.SKAEmissionTranche{EmissionHeight: 100}
+	txHash := createSKAEmissionTx(cointype.CoinType(1)).Hash()
+
+	tests := []struct {
+		name       string
+		tranche    *chaincfg.SKAEmissionTranche
+		nextHeight int64
+		wantErr    bool
+	}{
+		{"no scheduled tranche", nil, 1, false},
+		{"block before window start", tranche, 99, true},
+		{"first block of window", tranche, 100, false},
+		{"final block of window", tranche, 110, false},
+		{"block after window end", tranche, 111, true},
+		{"zero length window before", exactTranche, 99, true},
+		{"zero length window exact", exactTranche, 100, false},
+		{"zero length window after", exactTranche, 101, true},
+	}
+	for _, test := range tests {
+		err := checkSKAEmissionWindow(txHash, test.tranche, test.nextHeight)
+		if test.wantErr != (err != nil) {
+			t.Errorf("%s: unexpected error: got %v, want error %v", test.name,
+				err, test.wantErr)
+			continue
+		}
+		if err != nil && !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: unexpected error kind: %v", test.name, err)
+		}
+	}
+}
+
+// TestFeeExemptExcludedFromFeeEstimation ensures fee exempt transactions added
+// to the pool are not reported to the fee estimator while transactions that
+// pay a fee are.
+func TestFeeExemptExcludedFromFeeEstimation(t *testing.T) {
+	var estimated []chainhash.Hash
+	mp := New(&Config{
+		Policy: Policy{
+			MinRelayTxFee: DefaultMinRelayTxFee,
+		},
+		ChainParams: chaincfg.SimNetParams(),
+		AddTxToFeeEstimation: func(txHash *chainhash.Hash, fee, size int64, txType stake.TxType) {
+			estimated = append(estimated, *txHash)
+		},
+	})
+
+	emissionTx := createSKAEmissionTx(cointype.CoinType(1))
+	mp.addTransaction(nil, &TxDesc{TxDesc: mining.TxDesc{
+		Tx:        emissionTx,
+		TxSize:    int64(emissionTx.MsgTx().SerializeSize()),
+		FeeExempt: true,
+	}})
+	if len(estimated) != 0 {
+		t.Fatalf("fee exempt transaction reported to fee estimator")
+	}
+
+	regularTx := createMockTransaction(1e8, cointype.CoinType(1))
+	mp.addTransaction(nil, &TxDesc{TxDesc: mining.TxDesc{
+		Tx:     regularTx,
+		Fee:    1000,
+		TxSize: int64(regularTx.MsgTx().SerializeSize()),
+	}})
+	if len(estimated) != 1 || estimated[0] != *regularTx.Hash() {
+		t.Fatalf("unexpected transactions reported to fee estimator: %v",
+			estimated)
+	}
+}
+
 // Helper functions
 
+// TestSKAOrphanLimits ensures orphans of each SKA coin type are limited
+// separately from VAR orphans and one another, that the combined SKA orphans
+// are limited by evicting from the SKA coin type with the most orphans, and
+// that SKA orphans expire sooner than VAR orphans, especially before the coin
+// type is emitted.
+func TestSKAOrphanLimits(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	mp := New(&Config{
+		Policy: Policy{
+			MinRelayTxFee:   DefaultMinRelayTxFee,
+			MaxOrphanTxs:    3,
+			SKAMaxOrphanTxs: 3,
+		},
+		ChainParams: params,
+		HasSKAEmissionOccurred: func(coinType cointype.CoinType) bool {
+			return coinType == cointype.CoinType(1)
+		},
+	})
+
+	// newOrphan returns a unique orphan transaction paying to the provided
+	// coin type.
+	var nextPrev uint32
+	newOrphan := func(coinType cointype.CoinType) *dcrutil.Tx {
+		nextPrev++
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0x01},
+			nextPrev, wire.TxTreeRegular), 0, nil))
+		tx.AddTxOut(&wire.TxOut{Value: 1, CoinType: coinType})
+		return dcrutil.NewTx(tx)
+	}
+	addOrphans := func(coinType cointype.CoinType, n int) []*dcrutil.Tx {
+		txns := make([]*dcrutil.Tx, 0, n)
+		for i := 0; i < n; i++ {
+			tx := newOrphan(coinType)
+			mp.addOrphan(tx, 0)
+			txns = append(txns, tx)
+		}
+		return txns
+	}
+	assertNumOrphans := func(coinType cointype.CoinType, want int) {
+		t.Helper()
+		if got := mp.numOrphans[coinType]; got != want {
+			t.Fatalf("unexpected number of orphans of coin type %d -- got %d, "+
+				"want %d", coinType, got, want)
+		}
+	}
+
+	// Ensure the orphans of an SKA coin type are limited separately and do
+	// not affect VAR orphans.
+	varOrphans := addOrphans(cointype.CoinTypeVAR, 3)
+	addOrphans(cointype.CoinType(1), 5)
+	assertNumOrphans(cointype.CoinTypeVAR, 3)
+	assertNumOrphans(cointype.CoinType(1), 3)
+
+	// Ensure adding an orphan of another SKA coin type that would exceed the
+	// combined SKA limit evicts an orphan of the coin type with the most
+	// orphans.
+	ska2Orphans := addOrphans(cointype.CoinType(2), 1)
+	assertNumOrphans(cointype.CoinType(1), 2)
+	assertNumOrphans(cointype.CoinType(2), 1)
+	if mp.numSKAOrphans != 3 {
+		t.Fatalf("unexpected number of SKA orphans -- got %d, want 3",
+			mp.numSKAOrphans)
+	}
+	assertNumOrphans(cointype.CoinTypeVAR, 3)
+
+	// Ensure orphans expire according to their coin type and that orphans of
+	// coin types that have not been emitted expire soonest.
+	ska1Orphans := addOrphans(cointype.CoinType(1), 1)
+	now := time.Now()
+	assertTTL := func(tx *dcrutil.Tx, want time.Duration) {
+		t.Helper()
+		otx := mp.orphans[*tx.Hash()]
+		if otx == nil {
+			t.Fatalf("orphan %v not in the orphan pool", tx.Hash())
+		}
+		ttl := otx.expiration.Sub(now)
+		if ttl > want || ttl < want-time.Minute {
+			t.Fatalf("unexpected expiration of orphan of coin type %d -- "+
+				"got ttl %v, want %v", otx.coinType, ttl, want)
+		}
+	}
+	assertTTL(varOrphans[0], orphanTTL)
+	assertTTL(ska1Orphans[0], DefaultSKAOrphanTTL)
+	assertTTL(ska2Orphans[0], preEmissionOrphanTTL)
+
+	// Ensure removing orphans updates the counts.
+	mp.removeOrphan(ska2Orphans[0], false)
+	assertNumOrphans(cointype.CoinType(2), 0)
+	mp.removeOrphan(varOrphans[0], false)
+	assertNumOrphans(cointype.CoinTypeVAR, 2)
+	if mp.numSKAOrphans != 2 {
+		t.Fatalf("unexpected number of SKA orphans -- got %d, want 2",
+			mp.numSKAOrphans)
+	}
+}
+
 // createMockTransaction creates a complete mock transaction with proper structure
 func createMockTransaction(amount int64, coinType cointype.CoinType) *dcrutil.Tx {
 	tx := &wire.MsgTx{
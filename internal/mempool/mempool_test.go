@@ -6,10 +6,12 @@
 package mempool
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"runtime"
 	"sync"
@@ -369,6 +371,7 @@ type poolHarness struct {
 	autoRevocationsActive bool
 	subsidySplitActive    bool
 	subsidySplitR2Active  bool
+	inputCoinTypesActive  bool
 
 	chain  *fakeChain
 	txPool *TxPool
@@ -870,6 +873,9 @@ func newPoolHarness(chainParams *chaincfg.Params) (*poolHarness, []spendableOutp
 			IsSubsidySplitR2AgendaActive: func() (bool, error) {
 				return harness.subsidySplitR2Active, nil
 			},
+			IsInputCoinTypesAgendaActive: func() (bool, error) {
+				return harness.inputCoinTypesActive, nil
+			},
 		}),
 	}
 
@@ -1019,6 +1025,63 @@ func TestSimpleOrphanChain(t *testing.T) {
 	}
 }
 
+// TestTestAcceptTransaction ensures checking whether a transaction would be
+// accepted reports the expected fee and errors without modifying the pool.
+func TestTestAcceptTransaction(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(chaincfg.RegNetParams())
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	tc := &testContext{t, harness}
+
+	chainedTxns, err := harness.CreateTxChain(spendableOuts[0], 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+
+	// Ensure orphans are rejected and not added to the orphan pool.
+	_, err = harness.txPool.TestAcceptTransaction(chainedTxns[1], false)
+	if !errors.Is(err, ErrOrphan) {
+		t.Fatalf("TestAcceptTransaction: unexpected error for orphan -- got "+
+			"%v, want %v", err, ErrOrphan)
+	}
+	testPoolMembership(tc, chainedTxns[1], false, false)
+
+	// Ensure a valid transaction reports the fee it pays and is not added to
+	// the pool.
+	tx := chainedTxns[0]
+	var wantFee int64
+	for _, txIn := range tx.MsgTx().TxIn {
+		wantFee += txIn.ValueIn
+	}
+	for _, txOut := range tx.MsgTx().TxOut {
+		wantFee -= txOut.Value
+	}
+	fee, err := harness.txPool.TestAcceptTransaction(tx, false)
+	if err != nil {
+		t.Fatalf("TestAcceptTransaction: unexpected error: %v", err)
+	}
+	if fee != wantFee {
+		t.Fatalf("TestAcceptTransaction: unexpected fee -- got %d, want %d",
+			fee, wantFee)
+	}
+	testPoolMembership(tc, tx, false, false)
+
+	// Ensure the transaction is reported as a duplicate once it is actually
+	// in the pool.
+	_, err = harness.txPool.ProcessTransaction(tx, false, false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept valid tx: %v", err)
+	}
+	_, err = harness.txPool.TestAcceptTransaction(tx, false)
+	if !errors.Is(err, ErrDuplicate) {
+		t.Fatalf("TestAcceptTransaction: unexpected error for duplicate -- "+
+			"got %v, want %v", err, ErrDuplicate)
+	}
+}
+
 // TestTicketPurchaseOrphan ensures that ticket purchases are orphaned when
 // referenced outputs spent are from missing transactions.
 func TestTicketPurchaseOrphan(t *testing.T) {
@@ -1426,6 +1489,79 @@ func TestExpirationPruning(t *testing.T) {
 	}
 }
 
+// TestMaxAgePruning ensures that regular transactions that remain in the pool
+// for longer than the max age of their coin type are removed along with their
+// redeemers and counted by coin type.
+func TestMaxAgePruning(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(chaincfg.RegNetParams())
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	tc := &testContext{t, harness}
+
+	// Ensure the max age for each coin type follows the configured policy
+	// and falls back to the defaults.
+	policy := &harness.txPool.cfg.Policy
+	policy.CoinTypeMaxTxAge = map[cointype.CoinType]time.Duration{
+		cointype.CoinType(2): time.Minute * 30,
+	}
+	tests := []struct {
+		coinType cointype.CoinType
+		want     time.Duration
+	}{
+		{cointype.CoinTypeVAR, DefaultMaxTxAge},
+		{cointype.CoinType(1), DefaultSKAMaxTxAge},
+		{cointype.CoinType(2), time.Minute * 30},
+	}
+	for _, test := range tests {
+		got := harness.txPool.MaxTxAge(test.coinType)
+		if got != test.want {
+			t.Fatalf("unexpected max age for coin type %v -- got %v, want %v",
+				test.coinType, got, test.want)
+		}
+	}
+
+	// Create a chain of transactions rooted with the first spendable output
+	// provided by the harness and add them to the pool.
+	chainedTxns, err := harness.CreateTxChain(outputs[0], 3)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	for _, tx := range chainedTxns {
+		_, err := harness.txPool.ProcessTransaction(tx, false, true, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept valid tx: %v", err)
+		}
+		testPoolMembership(tc, tx, false, true)
+	}
+
+	// Ensure transactions within the max age are not pruned.
+	bestHeight := harness.chain.BestHeight()
+	harness.txPool.PruneExpiredTx(bestHeight)
+	for _, tx := range chainedTxns {
+		testPoolMembership(tc, tx, false, true)
+	}
+	if counts := harness.txPool.ExpiredTxCounts(); len(counts) != 0 {
+		t.Fatalf("unexpected expired transaction counts %v", counts)
+	}
+
+	// Age the first transaction beyond the VAR max age and ensure it is
+	// pruned along with the transactions that redeem it.
+	txDesc := harness.txPool.pool[*chainedTxns[0].Hash()]
+	txDesc.Added = time.Now().Add(-DefaultMaxTxAge - time.Minute)
+	harness.txPool.PruneExpiredTx(bestHeight)
+	for _, tx := range chainedTxns {
+		testPoolMembership(tc, tx, false, false)
+	}
+	counts := harness.txPool.ExpiredTxCounts()
+	if len(counts) != 1 || counts[cointype.CoinTypeVAR] != 1 {
+		t.Fatalf("unexpected expired transaction counts -- got %v, want "+
+			"map[VAR:1]", counts)
+	}
+}
+
 // TestBasicOrphanRemoval ensure that orphan removal works as expected when an
 // orphan that doesn't exist is removed both when there is another orphan that
 // redeems it and when there is not.
@@ -2230,6 +2366,197 @@ func TestMempoolDoubleSpend(t *testing.T) {
 	testPoolMembership(tc, doubleSpendTx, false, false)
 }
 
+// TestDoubleSpendNotification ensures that the double spend callback is
+// invoked with the conflicting transactions when a transaction with valid
+// signatures attempts to spend coins already spent in the pool and that it is
+// not invoked for double spends with invalid signatures.
+func TestDoubleSpendNotification(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(chaincfg.RegNetParams())
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	var notified []*DoubleSpend
+	harness.txPool.cfg.OnDoubleSpend = func(ds *DoubleSpend) {
+		notified = append(notified, ds)
+	}
+
+	// Split the spendable output into two outputs so there are two separate
+	// outputs to spend.
+	splitTx, err := harness.CreateSignedTx(spendableOuts, 2)
+	if err != nil {
+		t.Fatalf("unable to create split tx: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(splitTx, true, true, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept split tx: %v", err)
+	}
+	splitOuts := []spendableOutput{
+		txOutToSpendableOut(splitTx, 0, wire.TxTreeRegular),
+		txOutToSpendableOut(splitTx, 1, wire.TxTreeRegular),
+	}
+
+	// Add two transactions to the pool that each spend one of the split
+	// outputs.
+	tx1, err := harness.CreateTx(splitOuts[0])
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	tx2, err := harness.CreateTx(splitOuts[1])
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	for _, tx := range []*dcrutil.Tx{tx1, tx2} {
+		_, err = harness.txPool.ProcessTransaction(tx, true, true, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+		}
+	}
+
+	// Ensure a double spend with invalid signatures is rejected without
+	// invoking the callback.
+	badTx, err := harness.CreateSignedTx(splitOuts, 2)
+	if err != nil {
+		t.Fatalf("unable to create double spend tx: %v", err)
+	}
+	badMsgTx := badTx.MsgTx()
+	sigScript := badMsgTx.TxIn[0].SignatureScript
+	sigScript[len(sigScript)-1] ^= 0x01
+	badTx = dcrutil.NewTx(badMsgTx)
+	_, err = harness.txPool.ProcessTransaction(badTx, true, true, 0)
+	if !errors.Is(err, ErrMempoolDoubleSpend) {
+		t.Fatalf("ProcessTransaction: did not get expected " +
+			"ErrMempoolDoubleSpend")
+	}
+	if len(notified) != 0 {
+		t.Fatalf("double spend callback invoked for tx with invalid " +
+			"signatures")
+	}
+
+	// Ensure a valid double spend of both outputs is rejected and invokes the
+	// callback with both conflicting transactions.
+	doubleSpendTx, err := harness.CreateSignedTx(splitOuts, 3)
+	if err != nil {
+		t.Fatalf("unable to create double spend tx: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(doubleSpendTx, true, true, 0)
+	if !errors.Is(err, ErrMempoolDoubleSpend) {
+		t.Fatalf("ProcessTransaction: did not get expected " +
+			"ErrMempoolDoubleSpend")
+	}
+	if len(notified) != 1 {
+		t.Fatalf("unexpected number of double spend notifications: got %d, "+
+			"want 1", len(notified))
+	}
+	ds := notified[0]
+	if *ds.Tx.Hash() != *doubleSpendTx.Hash() {
+		t.Fatalf("unexpected double spend tx: got %v, want %v",
+			ds.Tx.Hash(), doubleSpendTx.Hash())
+	}
+	if ds.CoinType != cointype.CoinTypeVAR {
+		t.Fatalf("unexpected double spend coin type: got %v, want %v",
+			ds.CoinType, cointype.CoinTypeVAR)
+	}
+	wantConflicts := []chainhash.Hash{*tx1.Hash(), *tx2.Hash()}
+	if len(ds.Conflicts) != len(wantConflicts) {
+		t.Fatalf("unexpected conflicts: got %v, want %v", ds.Conflicts,
+			wantConflicts)
+	}
+	for i := range wantConflicts {
+		if ds.Conflicts[i] != wantConflicts[i] {
+			t.Fatalf("unexpected conflicts: got %v, want %v",
+				ds.Conflicts, wantConflicts)
+		}
+	}
+}
+
+// TestProcessPackage ensures that a package consisting of a parent that does
+// not pay the minimum fee on its own and a child that pays enough for both is
+// accepted together while invalid and underpaying packages are rejected
+// without adding any of their transactions to the pool.
+func TestProcessPackage(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(chaincfg.RegNetParams())
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	tc := &testContext{t, harness}
+
+	// Create a parent that pays no fee and ensure it is rejected on its own.
+	parent, err := harness.CreateSignedTx(spendableOuts[:1], 1,
+		func(tx *wire.MsgTx) {
+			tx.TxOut[0].Value = int64(spendableOuts[0].amount)
+		})
+	if err != nil {
+		t.Fatalf("unable to create parent tx: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(parent, false, true, 0)
+	if !errors.Is(err, ErrInsufficientFee) {
+		t.Fatalf("ProcessTransaction: unexpected error for zero fee parent: "+
+			"got %v, want %v", err, ErrInsufficientFee)
+	}
+	parentOut := txOutToSpendableOut(parent, 0, wire.TxTreeRegular)
+
+	// Ensure a package whose child only pays the minimum fee for itself is
+	// rejected without adding either transaction to the pool.
+	cheapChild, err := harness.CreateSignedTx([]spendableOutput{parentOut}, 1)
+	if err != nil {
+		t.Fatalf("unable to create child tx: %v", err)
+	}
+	_, err = harness.txPool.ProcessPackage([]*dcrutil.Tx{parent, cheapChild},
+		true)
+	if !errors.Is(err, ErrInsufficientFee) {
+		t.Fatalf("ProcessPackage: unexpected error for underpaying "+
+			"package: got %v, want %v", err, ErrInsufficientFee)
+	}
+	testPoolMembership(tc, parent, false, false)
+	testPoolMembership(tc, cheapChild, false, false)
+
+	// Create a child that pays enough to cover the fee of its parent.
+	child, err := harness.CreateSignedTx([]spendableOutput{parentOut}, 1,
+		func(tx *wire.MsgTx) {
+			tx.TxOut[0].Value -= 10000
+		})
+	if err != nil {
+		t.Fatalf("unable to create child tx: %v", err)
+	}
+
+	// Ensure packages that are out of order or that are missing the child
+	// are rejected as invalid.
+	invalidPkgs := [][]*dcrutil.Tx{
+		{child, parent},
+		{parent},
+		{parent, parent, child},
+	}
+	for i, pkg := range invalidPkgs {
+		_, err = harness.txPool.ProcessPackage(pkg, true)
+		if !errors.Is(err, ErrInvalidPackage) {
+			t.Fatalf("ProcessPackage #%d: unexpected error: got %v, want %v",
+				i, err, ErrInvalidPackage)
+		}
+	}
+	testPoolMembership(tc, parent, false, false)
+	testPoolMembership(tc, child, false, false)
+
+	// Ensure the package is accepted and both transactions are returned in
+	// order.
+	acceptedTxs, err := harness.txPool.ProcessPackage([]*dcrutil.Tx{parent,
+		child}, true)
+	if err != nil {
+		t.Fatalf("ProcessPackage: failed to accept package: %v", err)
+	}
+	if len(acceptedTxs) != 2 || acceptedTxs[0] != parent ||
+		acceptedTxs[1] != child {
+
+		t.Fatalf("ProcessPackage: unexpected accepted transactions %v",
+			acceptedTxs)
+	}
+	testPoolMembership(tc, parent, false, true)
+	testPoolMembership(tc, child, false, true)
+}
+
 // TestFetchTransaction ensures that a ticket which spends an output in the
 // mempool is returned by FetchTransaction.
 func TestFetchTransaction(t *testing.T) {
@@ -3440,3 +3767,569 @@ func TestMaybeAcceptTransactions(t *testing.T) {
 
 	testExpectedAncestorFee(txC, txAFee+txBFee)
 }
+
+// TestPersistRestore ensures transactions written by WritePersisted are
+// restored to the pool in dependency order along with the time they were
+// originally added and that entries with mismatched coin type metadata or
+// malformed serializations are rejected.
+func TestPersistRestore(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(chaincfg.RegNetParams())
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	txPool := harness.txPool
+
+	// Add a chain of transactions to the pool and backdate the time they were
+	// added.
+	chainedTxns, err := harness.CreateTxChain(spendableOuts[0], 3)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	for _, tx := range chainedTxns {
+		_, err := txPool.ProcessTransaction(tx, false, true, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+		}
+	}
+	added := time.Now().Add(-time.Hour).Truncate(time.Second)
+	txPool.mtx.Lock()
+	for _, tx := range chainedTxns {
+		txPool.pool[*tx.Hash()].Added = added
+	}
+	txPool.mtx.Unlock()
+
+	var buf bytes.Buffer
+	n, err := txPool.WritePersisted(&buf)
+	if err != nil {
+		t.Fatalf("WritePersisted: unexpected error: %v", err)
+	}
+	if n != len(chainedTxns) {
+		t.Fatalf("WritePersisted: wrote %d txns, want %d", n,
+			len(chainedTxns))
+	}
+	serialized := buf.Bytes()
+
+	// Remove the transactions and ensure they are all restored.
+	txPool.RemoveTransaction(chainedTxns[0], true)
+	if txPool.Count() != 0 {
+		t.Fatalf("pool not empty after removal: %d txns", txPool.Count())
+	}
+	result, err := txPool.RestorePersisted(bytes.NewReader(serialized))
+	if err != nil {
+		t.Fatalf("RestorePersisted: unexpected error: %v", err)
+	}
+	if got := result.Restored[cointype.CoinTypeVAR]; got != len(chainedTxns) {
+		t.Fatalf("RestorePersisted: restored %d txns, want %d", got,
+			len(chainedTxns))
+	}
+	if len(result.Rejected) != 0 || len(result.Emissions) != 0 {
+		t.Fatalf("RestorePersisted: unexpected result %+v", result)
+	}
+	for _, tx := range chainedTxns {
+		txPool.mtx.RLock()
+		desc, ok := txPool.pool[*tx.Hash()]
+		txPool.mtx.RUnlock()
+		if !ok {
+			t.Fatalf("transaction %v not restored", tx.Hash())
+		}
+		if !desc.Added.Equal(added) {
+			t.Fatalf("transaction %v added at %v, want %v", tx.Hash(),
+				desc.Added, added)
+		}
+	}
+
+	// Ensure restoring again rejects the transactions already in the pool.
+	result, err = txPool.RestorePersisted(bytes.NewReader(serialized))
+	if err != nil {
+		t.Fatalf("RestorePersisted: unexpected error: %v", err)
+	}
+	if got := result.Rejected[cointype.CoinTypeVAR]; got != len(chainedTxns) {
+		t.Fatalf("RestorePersisted: rejected %d txns, want %d", got,
+			len(chainedTxns))
+	}
+
+	// Ensure an entry with a mismatched coin type is rejected along with its
+	// descendants that are then orphans.
+	txPool.RemoveTransaction(chainedTxns[0], true)
+	corrupt := append([]byte(nil), serialized...)
+	corrupt[12] = 1
+	result, err = txPool.RestorePersisted(bytes.NewReader(corrupt))
+	if err != nil {
+		t.Fatalf("RestorePersisted: unexpected error: %v", err)
+	}
+	if result.Rejected[1] != 1 || result.Rejected[cointype.CoinTypeVAR] != 2 {
+		t.Fatalf("RestorePersisted: unexpected rejections %v",
+			result.Rejected)
+	}
+	if txPool.Count() != 0 {
+		t.Fatalf("pool not empty after corrupt restore: %d txns",
+			txPool.Count())
+	}
+
+	// Ensure malformed serializations are rejected.
+	for _, bad := range [][]byte{nil, serialized[:11], serialized[:len(serialized)-1]} {
+		if _, err := txPool.RestorePersisted(bytes.NewReader(bad)); err == nil {
+			t.Fatalf("restoring %x did not fail", bad)
+		}
+	}
+}
+
+// TestMinFeeRateLimit ensures transactions relayed by peers that pay the
+// minimum relay fee are rate limited per peer and globally according to the
+// limits of their coin type while transactions submitted locally are not.
+func TestMinFeeRateLimit(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(chaincfg.RegNetParams())
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	txPool := harness.txPool
+
+	// Split the spendable output into several so independent transactions
+	// may be created.
+	const numTxns = 7
+	splitTx, err := harness.CreateSignedTx(spendableOuts, numTxns)
+	if err != nil {
+		t.Fatalf("unable to create split transaction: %v", err)
+	}
+	if _, err := txPool.ProcessTransaction(splitTx, false, true, 0); err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept split tx: %v", err)
+	}
+	txns := make([]*dcrutil.Tx, 0, numTxns)
+	for i := uint32(0); i < numTxns; i++ {
+		out := txOutToSpendableOut(splitTx, i, wire.TxTreeRegular)
+		tx, err := harness.CreateTx(out)
+		if err != nil {
+			t.Fatalf("unable to create transaction: %v", err)
+		}
+		txns = append(txns, tx)
+	}
+
+	// Limit minimum fee VAR transactions so a single peer may only relay two
+	// of the transactions and all peers combined may only relay four.
+	txSize := float64(txns[0].MsgTx().SerializeSize())
+	txPool.mtx.Lock()
+	txPool.cfg.Policy.CoinTypeMinFeeRelayLimit = map[cointype.CoinType]MinFeeRelayLimit{
+		cointype.CoinTypeVAR: {
+			PeerKBPerMinute: txSize * 2.5 / 1000,
+			KBPerMinute:     txSize * 4.5 / 1000,
+		},
+	}
+	txPool.mtx.Unlock()
+
+	tests := []struct {
+		name    string
+		tag     Tag
+		limited bool
+	}{
+		{name: "peer 1 first tx", tag: 1},
+		{name: "peer 1 second tx", tag: 1},
+		{name: "peer 1 exceeds peer limit", tag: 1, limited: true},
+		{name: "peer 2 first tx", tag: 2},
+		{name: "peer 2 second tx", tag: 2},
+		{name: "peer 3 exceeds global limit", tag: 3, limited: true},
+		{name: "local tx is not limited", tag: 0},
+	}
+	for i, test := range tests {
+		_, err := txPool.ProcessTransaction(txns[i], false, true, test.tag)
+		if test.limited {
+			if !errors.Is(err, ErrRateLimited) {
+				t.Fatalf("%s: unexpected error: got %v, want %v", test.name,
+					err, ErrRateLimited)
+			}
+			if txPool.HaveTransaction(txns[i].Hash()) {
+				t.Fatalf("%s: rate limited tx is in the pool", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: failed to accept tx: %v", test.name, err)
+		}
+	}
+
+	// Ensure an otherwise invalid transaction from a peer that exceeded its
+	// limit is rejected for being invalid rather than for being rate limited
+	// since the limit is only applied once all other checks have passed.
+	badSigMsgTx := txns[2].MsgTx().Copy()
+	badSigMsgTx.TxIn[0].SignatureScript = []byte{txscript.OP_TRUE}
+	badSigTx := dcrutil.NewTx(badSigMsgTx)
+	_, err = txPool.ProcessTransaction(badSigTx, false, true, 1)
+	if err == nil {
+		t.Fatal("accepted tx with an invalid signature script")
+	}
+	if errors.Is(err, ErrRateLimited) {
+		t.Fatalf("tx with an invalid signature script was rate limited: %v",
+			err)
+	}
+
+	// Ensure the limits no longer apply once they are removed for the coin
+	// type.
+	txPool.mtx.Lock()
+	txPool.cfg.Policy.CoinTypeMinFeeRelayLimit = nil
+	txPool.mtx.Unlock()
+	if _, err := txPool.ProcessTransaction(txns[2], false, true, 1); err != nil {
+		t.Fatalf("failed to accept tx with limits disabled: %v", err)
+	}
+}
+
+// TestMempoolMinFee ensures regular transactions paying the lowest fee rates
+// are evicted when the size limit of their coin type is exceeded, that the
+// rolling minimum fee of the coin type rises above the fee rate of evicted
+// transactions and rejects transactions paying less, and that it decays once
+// blocks are connected and survives persisting the pool.
+func TestMempoolMinFee(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(chaincfg.RegNetParams())
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	txPool := harness.txPool
+
+	// Split the spendable output into several confirmed outputs so
+	// independent transactions that do not depend on the pool may be
+	// created.
+	const numTxns = 5
+	splitTx, err := harness.CreateSignedTx(spendableOuts, numTxns)
+	if err != nil {
+		t.Fatalf("unable to create split transaction: %v", err)
+	}
+	harness.AddFakeUTXO(splitTx, harness.chain.BestHeight(), 0)
+
+	// createTx creates a transaction spending the provided output of the
+	// split transaction that pays the provided multiple of the minimum relay
+	// fee.
+	minRelayFeeRate := txPool.minRelayFeeRate(cointype.CoinTypeVAR)
+	createTx := func(outputNum uint32, feeMultiple int64) *dcrutil.Tx {
+		t.Helper()
+		out := txOutToSpendableOut(splitTx, outputNum, wire.TxTreeRegular)
+		tx, err := harness.CreateSignedTx([]spendableOutput{out}, 1,
+			func(tx *wire.MsgTx) {
+				extraFee := calcFeeForRate(int64(tx.SerializeSize())+107,
+					minRelayFeeRate*(feeMultiple-1))
+				tx.TxOut[0].Value -= extraFee
+			})
+		if err != nil {
+			t.Fatalf("unable to create transaction: %v", err)
+		}
+		return tx
+	}
+	txns := []*dcrutil.Tx{
+		createTx(0, 1),
+		createTx(1, 4),
+		createTx(2, 6),
+		createTx(3, 2),
+		createTx(4, 3),
+	}
+
+	// Limit the pool to two of the transactions.
+	txSize := int64(txns[0].MsgTx().SerializeSize())
+	txPool.mtx.Lock()
+	txPool.cfg.Policy.CoinTypeMaxPoolSize = map[cointype.CoinType]int64{
+		cointype.CoinTypeVAR: txSize*5/2 + 1,
+	}
+	txPool.mtx.Unlock()
+
+	// Ensure the first two transactions are accepted without a rolling
+	// minimum fee and the third evicts the one paying the lowest fee rate.
+	for _, tx := range txns[:3] {
+		if _, err := txPool.ProcessTransaction(tx, false, true, 0); err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+		}
+	}
+	if txPool.HaveTransaction(txns[0].Hash()) {
+		t.Fatal("transaction paying the lowest fee rate was not evicted")
+	}
+	if !txPool.HaveTransaction(txns[1].Hash()) ||
+		!txPool.HaveTransaction(txns[2].Hash()) {
+
+		t.Fatal("transaction paying a higher fee rate was evicted")
+	}
+	minFees := txPool.MempoolMinFees()
+	evictedFeeRate := txns[0].MsgTx().TxIn[0].ValueIn -
+		txns[0].MsgTx().TxOut[0].Value
+	evictedFeeRate = evictedFeeRate * 1000 / txSize
+	if want := evictedFeeRate + minRelayFeeRate; minFees[cointype.CoinTypeVAR] != want {
+		t.Fatalf("unexpected rolling minimum fee: got %d, want %d",
+			minFees[cointype.CoinTypeVAR], want)
+	}
+
+	// Ensure a transaction paying less than the rolling minimum fee is
+	// rejected and one paying more, but less than the transactions in the
+	// pool, is evicted immediately and raises the rolling minimum fee.
+	_, err = txPool.ProcessTransaction(txns[3], false, true, 0)
+	if !errors.Is(err, ErrInsufficientFee) {
+		t.Fatalf("unexpected error: got %v, want %v", err, ErrInsufficientFee)
+	}
+	_, err = txPool.ProcessTransaction(txns[4], false, true, 0)
+	if !errors.Is(err, ErrInsufficientFee) {
+		t.Fatalf("unexpected error: got %v, want %v", err, ErrInsufficientFee)
+	}
+	if txPool.HaveTransaction(txns[4].Hash()) {
+		t.Fatal("transaction exceeding the size limit was not evicted")
+	}
+	raisedFeeRate := txPool.MempoolMinFees()[cointype.CoinTypeVAR]
+	if raisedFeeRate <= minFees[cointype.CoinTypeVAR] {
+		t.Fatalf("rolling minimum fee was not raised: got %d, previous %d",
+			raisedFeeRate, minFees[cointype.CoinTypeVAR])
+	}
+
+	// Ensure the rolling minimum fee survives persisting the pool.
+	var buf bytes.Buffer
+	if _, err := txPool.WritePersisted(&buf); err != nil {
+		t.Fatalf("WritePersisted: unexpected error: %v", err)
+	}
+	txPool.mtx.Lock()
+	txPool.rollingMinFees = make(map[cointype.CoinType]*rollingMinFee)
+	txPool.mtx.Unlock()
+	if _, err := txPool.RestorePersisted(&buf); err != nil {
+		t.Fatalf("RestorePersisted: unexpected error: %v", err)
+	}
+	if got := txPool.MempoolMinFees()[cointype.CoinTypeVAR]; got != raisedFeeRate {
+		t.Fatalf("unexpected restored rolling minimum fee: got %d, want %d",
+			got, raisedFeeRate)
+	}
+
+	// Ensure the rolling minimum fee does not decay until a block is
+	// connected and is removed once it decays below half of the minimum
+	// relay fee.
+	bestHeight := harness.chain.BestHeight()
+	txPool.PruneExpiredTx(bestHeight)
+	txPool.mtx.Lock()
+	fee := txPool.rollingMinFees[cointype.CoinTypeVAR]
+	if fee.decayStart.IsZero() {
+		txPool.mtx.Unlock()
+		t.Fatal("rolling minimum fee did not start decaying")
+	}
+	fee.decayStart = fee.decayStart.Add(-rollingMinFeeHalfLife * 10)
+	txPool.mtx.Unlock()
+	if got := txPool.MempoolMinFees()[cointype.CoinTypeVAR]; got >= raisedFeeRate/2 {
+		t.Fatalf("rolling minimum fee did not decay: got %d", got)
+	}
+	txPool.PruneExpiredTx(bestHeight)
+	if minFees := txPool.MempoolMinFees(); len(minFees) != 0 {
+		t.Fatalf("rolling minimum fee was not removed: %v", minFees)
+	}
+
+	// Ensure transactions paying less than the removed rolling minimum fee
+	// are accepted once the pool has room for them.
+	txPool.mtx.Lock()
+	txPool.cfg.Policy.CoinTypeMaxPoolSize = nil
+	txPool.mtx.Unlock()
+	if _, err := txPool.ProcessTransaction(txns[3], false, true, 0); err != nil {
+		t.Fatalf("failed to accept tx after decay: %v", err)
+	}
+}
+
+// TestChainLimits ensures new regular transactions that would exceed the
+// ancestor or descendant limits of their coin type are rejected with the error
+// that identifies the exceeded limit.
+func TestChainLimits(t *testing.T) {
+	t.Parallel()
+
+	harness, spendableOuts, err := newPoolHarness(chaincfg.RegNetParams())
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	txPool := harness.txPool
+
+	// Split the spendable output into confirmed outputs for each scenario so
+	// the chains of transactions they create are independent.
+	const numScenarios = 4
+	splitTx, err := harness.CreateSignedTx(spendableOuts, numScenarios)
+	if err != nil {
+		t.Fatalf("unable to create split transaction: %v", err)
+	}
+	harness.AddFakeUTXO(splitTx, harness.chain.BestHeight(), 0)
+
+	// setLimits sets the chain limits of VAR with unset limits treated as
+	// unlimited.
+	setLimits := func(limits ChainLimits) {
+		t.Helper()
+		if limits.MaxAncestors == 0 {
+			limits.MaxAncestors = math.MaxInt32
+		}
+		if limits.MaxAncestorSize == 0 {
+			limits.MaxAncestorSize = math.MaxInt64
+		}
+		if limits.MaxDescendants == 0 {
+			limits.MaxDescendants = math.MaxInt32
+		}
+		if limits.MaxDescendantSize == 0 {
+			limits.MaxDescendantSize = math.MaxInt64
+		}
+		txPool.mtx.Lock()
+		txPool.cfg.Policy.CoinTypeChainLimits = map[cointype.CoinType]ChainLimits{
+			cointype.CoinTypeVAR: limits,
+		}
+		txPool.mtx.Unlock()
+	}
+
+	// createChain creates a chain of transactions that starts with the
+	// provided output of the split transaction.
+	createChain := func(outputNum uint32, numTxns uint32) []*dcrutil.Tx {
+		t.Helper()
+		out := txOutToSpendableOut(splitTx, outputNum, wire.TxTreeRegular)
+		chain, err := harness.CreateTxChain(out, numTxns)
+		if err != nil {
+			t.Fatalf("unable to create transaction chain: %v", err)
+		}
+		return chain
+	}
+
+	// createFanOut creates a parent transaction spending the provided output
+	// of the split transaction along with children that each spend one of its
+	// outputs.
+	createFanOut := func(outputNum uint32, numChildren uint32) []*dcrutil.Tx {
+		t.Helper()
+		out := txOutToSpendableOut(splitTx, outputNum, wire.TxTreeRegular)
+		parent, err := harness.CreateSignedTx([]spendableOutput{out},
+			numChildren)
+		if err != nil {
+			t.Fatalf("unable to create parent transaction: %v", err)
+		}
+		txns := []*dcrutil.Tx{parent}
+		for i := uint32(0); i < numChildren; i++ {
+			out := txOutToSpendableOut(parent, i, wire.TxTreeRegular)
+			child, err := harness.CreateTx(out)
+			if err != nil {
+				t.Fatalf("unable to create child transaction: %v", err)
+			}
+			txns = append(txns, child)
+		}
+		return txns
+	}
+
+	// acceptAllButLast ensures all of the provided transactions except the
+	// last one are accepted and that the last one is rejected with the
+	// provided error.
+	acceptAllButLast := func(txns []*dcrutil.Tx, wantErr error) {
+		t.Helper()
+		for _, tx := range txns[:len(txns)-1] {
+			_, err := txPool.ProcessTransaction(tx, false, true, 0)
+			if err != nil {
+				t.Fatalf("failed to accept tx: %v", err)
+			}
+		}
+		last := txns[len(txns)-1]
+		_, err := txPool.ProcessTransaction(last, false, true, 0)
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("unexpected error: got %v, want %v", err, wantErr)
+		}
+		if txPool.HaveTransaction(last.Hash()) {
+			t.Fatalf("transaction exceeding the chain limits was accepted")
+		}
+	}
+
+	// Ensure the fourth transaction of a chain is rejected when at most three
+	// transactions may form a chain of ancestors.
+	setLimits(ChainLimits{MaxAncestors: 3})
+	acceptAllButLast(createChain(0, 4), ErrTooManyAncestors)
+
+	// Ensure the third transaction of a chain is rejected when the ancestor
+	// size limit only fits two of them.
+	chain := createChain(1, 3)
+	txSize := int64(chain[0].MsgTx().SerializeSize())
+	setLimits(ChainLimits{MaxAncestorSize: txSize*2 + 1})
+	acceptAllButLast(chain, ErrAncestorSizeTooLarge)
+
+	// Ensure the third child of a parent is rejected when at most three
+	// transactions may form a tree of descendants even though none of the
+	// children have more than one ancestor.
+	setLimits(ChainLimits{MaxDescendants: 3})
+	acceptAllButLast(createFanOut(2, 3), ErrTooManyDescendants)
+
+	// Ensure the second child of a parent is rejected when the descendant
+	// size limit only fits the parent and one child.
+	fanOut := createFanOut(3, 2)
+	fanOutSize := int64(fanOut[0].MsgTx().SerializeSize() +
+		fanOut[1].MsgTx().SerializeSize())
+	setLimits(ChainLimits{MaxDescendantSize: fanOutSize})
+	acceptAllButLast(fanOut, ErrDescendantSizeTooLarge)
+
+	// Ensure the rejected transactions are accepted once the limits are
+	// raised.
+	txPool.mtx.Lock()
+	txPool.cfg.Policy.CoinTypeChainLimits = nil
+	txPool.mtx.Unlock()
+	_, err = txPool.ProcessTransaction(fanOut[2], false, true, 0)
+	if err != nil {
+		t.Fatalf("failed to accept tx with default limits: %v", err)
+	}
+}
+
+// TestChainLimitsPolicy ensures the chain limits of each coin type default to
+// the limits of its class of coin types unless they are overridden.
+func TestChainLimitsPolicy(t *testing.T) {
+	t.Parallel()
+
+	override := ChainLimits{
+		MaxAncestors:      5,
+		MaxAncestorSize:   5000,
+		MaxDescendants:    6,
+		MaxDescendantSize: 6000,
+	}
+	skaLimits := ChainLimits{
+		MaxAncestors:      8,
+		MaxAncestorSize:   8000,
+		MaxDescendants:    9,
+		MaxDescendantSize: 9000,
+	}
+	defaultVAR := ChainLimits{
+		MaxAncestors:      DefaultMaxAncestors,
+		MaxAncestorSize:   DefaultMaxAncestorSizeKB * 1000,
+		MaxDescendants:    DefaultMaxDescendants,
+		MaxDescendantSize: DefaultMaxDescendantSizeKB * 1000,
+	}
+	defaultSKA := ChainLimits{
+		MaxAncestors:      DefaultSKAMaxAncestors,
+		MaxAncestorSize:   DefaultSKAMaxAncestorSizeKB * 1000,
+		MaxDescendants:    DefaultSKAMaxDescendants,
+		MaxDescendantSize: DefaultSKAMaxDescendantSizeKB * 1000,
+	}
+
+	tests := []struct {
+		name     string
+		policy   Policy
+		coinType cointype.CoinType
+		want     ChainLimits
+	}{{
+		name:     "VAR defaults",
+		coinType: cointype.CoinTypeVAR,
+		want:     defaultVAR,
+	}, {
+		name:     "SKA defaults",
+		coinType: 1,
+		want:     defaultSKA,
+	}, {
+		name:     "SKA limits",
+		policy:   Policy{SKAChainLimits: skaLimits},
+		coinType: 2,
+		want:     skaLimits,
+	}, {
+		name:     "SKA limits do not apply to VAR",
+		policy:   Policy{SKAChainLimits: skaLimits},
+		coinType: cointype.CoinTypeVAR,
+		want:     defaultVAR,
+	}, {
+		name: "coin type override",
+		policy: Policy{
+			SKAChainLimits: skaLimits,
+			CoinTypeChainLimits: map[cointype.CoinType]ChainLimits{
+				1: override,
+			},
+		},
+		coinType: 1,
+		want:     override,
+	}}
+
+	for _, test := range tests {
+		if got := test.policy.chainLimits(test.coinType); got != test.want {
+			t.Errorf("%q: unexpected limits -- got %+v, want %+v", test.name,
+				got, test.want)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+const (
+	// persistMagic identifies a serialized transaction pool.
+	persistMagic = 0x4c504d4d // "MMPL"
+
+	// persistVersion is the current version of the serialized transaction
+	// pool format.  Version 2 adds the rolling minimum fees of the coin types
+	// after the transactions.
+	persistVersion = 2
+
+	// persistFlagSKAEmission is set for entries that are SKA emission
+	// transactions waiting to be mined.
+	persistFlagSKAEmission = 1 << 0
+
+	// maxPersistedTxSize is the maximum serialized size of a persisted
+	// transaction that is accepted when restoring the pool.
+	maxPersistedTxSize = wire.MaxBlockPayload
+)
+
+// RestoreResult houses the outcome of restoring persisted transactions to the
+// pool.
+type RestoreResult struct {
+	// Restored and Rejected are the number of persisted transactions that
+	// were and were not added back to the pool by coin type.
+	Restored map[cointype.CoinType]int
+	Rejected map[cointype.CoinType]int
+
+	// Emissions are the hashes of the SKA emission transactions that were
+	// added back to the pool.
+	Emissions []chainhash.Hash
+}
+
+// persistedMinFee is a rolling minimum fee read from a serialized transaction
+// pool.
+type persistedMinFee struct {
+	coinType cointype.CoinType
+	fee      rollingMinFee
+}
+
+// persistedTx is a transaction read from a serialized transaction pool.
+type persistedTx struct {
+	tx       *dcrutil.Tx
+	coinType cointype.CoinType
+	flags    uint8
+	added    time.Time
+}
+
+// persistOrder returns the descriptors of all transactions in the main and
+// stage pools ordered such that every transaction comes after any of its
+// ancestors that are also in the pools.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) persistOrder() []*TxDesc {
+	descs := make([]*TxDesc, 0, len(mp.pool)+len(mp.staged))
+	for _, desc := range mp.pool {
+		descs = append(descs, desc)
+	}
+	for _, desc := range mp.staged {
+		descs = append(descs, desc)
+	}
+	sort.Slice(descs, func(i, j int) bool {
+		return descs[i].Added.Before(descs[j].Added)
+	})
+
+	ordered := make([]*TxDesc, 0, len(descs))
+	visited := make(map[chainhash.Hash]struct{}, len(descs))
+	var visit func(desc *TxDesc)
+	visit = func(desc *TxDesc) {
+		txHash := desc.Tx.Hash()
+		if _, ok := visited[*txHash]; ok {
+			return
+		}
+		visited[*txHash] = struct{}{}
+		for _, txIn := range desc.Tx.MsgTx().TxIn {
+			prevHash := &txIn.PreviousOutPoint.Hash
+			if parent, ok := mp.pool[*prevHash]; ok {
+				visit(parent)
+			} else if parent, ok := mp.staged[*prevHash]; ok {
+				visit(parent)
+			}
+		}
+		ordered = append(ordered, desc)
+	}
+	for _, desc := range descs {
+		visit(desc)
+	}
+	return ordered
+}
+
+// WritePersisted serializes all transactions in the pool, including those in
+// the stage pool and pending SKA emissions, to the provided writer along with
+// their coin types and the time they were added so they can be restored with
+// RestorePersisted after a restart.  Orphans are not included.  The rolling
+// minimum fees of the coin types are also included so a restart does not
+// reset the relay floor of coin types under sustained pressure.
+//
+// It returns the number of transactions written.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) WritePersisted(w io.Writer) (int, error) {
+	mp.mtx.RLock()
+	descs := mp.persistOrder()
+	minFees := make([]persistedMinFee, 0, len(mp.rollingMinFees))
+	for coinType, fee := range mp.rollingMinFees {
+		minFees = append(minFees, persistedMinFee{
+			coinType: coinType,
+			fee:      *fee,
+		})
+	}
+	mp.mtx.RUnlock()
+	sort.Slice(minFees, func(i, j int) bool {
+		return minFees[i].coinType < minFees[j].coinType
+	})
+
+	bw := bufio.NewWriter(w)
+	var buf [17]byte
+	binary.LittleEndian.PutUint32(buf[0:4], persistMagic)
+	binary.LittleEndian.PutUint32(buf[4:8], persistVersion)
+	binary.LittleEndian.PutUint32(buf[8:12], uint32(len(descs)))
+	if _, err := bw.Write(buf[:12]); err != nil {
+		return 0, err
+	}
+	for _, desc := range descs {
+		msgTx := desc.Tx.MsgTx()
+		var flags uint8
+		if wire.IsSKAEmissionTransaction(msgTx) {
+			flags |= persistFlagSKAEmission
+		}
+
+		// Entry: [coin type:1][flags:1][added:8][tx size:4][tx...]
+		buf[0] = byte(wire.GetPrimaryCoinType(msgTx))
+		buf[1] = flags
+		binary.LittleEndian.PutUint64(buf[2:10], uint64(desc.Added.Unix()))
+		binary.LittleEndian.PutUint32(buf[10:14], uint32(msgTx.SerializeSize()))
+		if _, err := bw.Write(buf[:14]); err != nil {
+			return 0, err
+		}
+		if err := msgTx.Serialize(bw); err != nil {
+			return 0, err
+		}
+	}
+
+	// Rolling minimum fees: [count:1] followed by entries of the form
+	// [coin type:1][fee rate:8][decay start:8] where a decay start of zero
+	// means the fee is not decaying yet.
+	buf[0] = byte(len(minFees))
+	if _, err := bw.Write(buf[:1]); err != nil {
+		return 0, err
+	}
+	for _, minFee := range minFees {
+		var decayStart int64
+		if !minFee.fee.decayStart.IsZero() {
+			decayStart = minFee.fee.decayStart.Unix()
+		}
+		buf[0] = byte(minFee.coinType)
+		binary.LittleEndian.PutUint64(buf[1:9], uint64(minFee.fee.feeRate))
+		binary.LittleEndian.PutUint64(buf[9:17], uint64(decayStart))
+		if _, err := bw.Write(buf[:17]); err != nil {
+			return 0, err
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	return len(descs), nil
+}
+
+// readPersisted reads the transactions and rolling minimum fees of a
+// serialized transaction pool from the provided reader.  Version 1 pools do
+// not contain any rolling minimum fees.
+func readPersisted(r io.Reader) ([]persistedTx, []persistedMinFee, error) {
+	br := bufio.NewReader(r)
+	var buf [17]byte
+	if _, err := io.ReadFull(br, buf[:12]); err != nil {
+		return nil, nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	if magic := binary.LittleEndian.Uint32(buf[0:4]); magic != persistMagic {
+		return nil, nil, fmt.Errorf("invalid magic %08x", magic)
+	}
+	ver := binary.LittleEndian.Uint32(buf[4:8])
+	if ver < 1 || ver > persistVersion {
+		return nil, nil, fmt.Errorf("unsupported version %d", ver)
+	}
+	numTxns := binary.LittleEndian.Uint32(buf[8:12])
+
+	var txns []persistedTx
+	for i := uint32(0); i < numTxns; i++ {
+		if _, err := io.ReadFull(br, buf[:14]); err != nil {
+			return nil, nil, fmt.Errorf("failed to read entry %d: %w", i, err)
+		}
+		txSize := binary.LittleEndian.Uint32(buf[10:14])
+		if txSize > maxPersistedTxSize {
+			return nil, nil, fmt.Errorf("entry %d transaction size %d exceeds "+
+				"max %d", i, txSize, maxPersistedTxSize)
+		}
+		serializedTx := make([]byte, txSize)
+		if _, err := io.ReadFull(br, serializedTx); err != nil {
+			return nil, nil, fmt.Errorf("failed to read entry %d: %w", i, err)
+		}
+		tx, err := dcrutil.NewTxFromBytes(serializedTx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode entry %d: %w", i, err)
+		}
+		txns = append(txns, persistedTx{
+			tx:       tx,
+			coinType: cointype.CoinType(buf[0]),
+			flags:    buf[1],
+			added:    time.Unix(int64(binary.LittleEndian.Uint64(buf[2:10])), 0),
+		})
+	}
+	if ver < 2 {
+		return txns, nil, nil
+	}
+
+	if _, err := io.ReadFull(br, buf[:1]); err != nil {
+		return nil, nil, fmt.Errorf("failed to read rolling minimum fee "+
+			"count: %w", err)
+	}
+	numMinFees := int(buf[0])
+	minFees := make([]persistedMinFee, 0, numMinFees)
+	for i := 0; i < numMinFees; i++ {
+		if _, err := io.ReadFull(br, buf[:17]); err != nil {
+			return nil, nil, fmt.Errorf("failed to read rolling minimum "+
+				"fee %d: %w", i, err)
+		}
+		feeRate := int64(binary.LittleEndian.Uint64(buf[1:9]))
+		if feeRate <= 0 {
+			return nil, nil, fmt.Errorf("rolling minimum fee %d has invalid "+
+				"fee rate %d", i, feeRate)
+		}
+		minFee := persistedMinFee{
+			coinType: cointype.CoinType(buf[0]),
+			fee:      rollingMinFee{feeRate: feeRate},
+		}
+		if decayStart := int64(binary.LittleEndian.Uint64(buf[9:17])); decayStart != 0 {
+			minFee.fee.decayStart = time.Unix(decayStart, 0)
+		}
+		minFees = append(minFees, minFee)
+	}
+	return txns, minFees, nil
+}
+
+// RestorePersisted reads transactions serialized by WritePersisted from the
+// provided reader and attempts to add them back to the pool.
+//
+// Every transaction is subjected to the same checks as when it was first
+// accepted against the current chain state, so transactions that were mined,
+// double spent, or expired while the node was stopped are rejected.  In
+// particular, SKA emissions are only restored when the emission state still
+// permits them and their emission window remains open.  Transactions whose
+// recorded coin type does not match the transaction are rejected as corrupt.
+//
+// The time transactions were originally added to the pool is retained so
+// restarting does not extend how long they may remain in the pool.  The
+// persisted rolling minimum fees are restored once the transactions have been
+// restored so that the transactions that were already in the pool are not
+// rejected for paying less than a fee that rose after they were accepted.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) RestorePersisted(r io.Reader) (*RestoreResult, error) {
+	txns, minFees, err := readPersisted(r)
+	if err != nil {
+		return nil, err
+	}
+
+	checkTxFlags, err := mp.determineCheckTxFlags()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RestoreResult{
+		Restored: make(map[cointype.CoinType]int),
+		Rejected: make(map[cointype.CoinType]int),
+	}
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	for _, ptx := range txns {
+		tx := ptx.tx
+		msgTx := tx.MsgTx()
+		isEmission := wire.IsSKAEmissionTransaction(msgTx)
+		if coinType := wire.GetPrimaryCoinType(msgTx); coinType != ptx.coinType ||
+			isEmission != (ptx.flags&persistFlagSKAEmission != 0) {
+
+			log.Debugf("Not restoring transaction %v with mismatched "+
+				"persisted metadata", tx.Hash())
+			result.Rejected[ptx.coinType]++
+			continue
+		}
+
+		missingParents, err := mp.maybeAcceptTransaction(tx, false, true,
+			true, false, false, 0, checkTxFlags)
+		if err == nil && len(missingParents) > 0 {
+			err = errors.New("transaction is an orphan")
+		}
+		if err != nil {
+			log.Debugf("Not restoring %v transaction %v: %v", ptx.coinType,
+				tx.Hash(), err)
+			result.Rejected[ptx.coinType]++
+			continue
+		}
+
+		// Retain the time the transaction was originally added.
+		if desc, ok := mp.pool[*tx.Hash()]; ok {
+			desc.Added = ptx.added
+		} else if desc, ok := mp.staged[*tx.Hash()]; ok {
+			desc.Added = ptx.added
+		}
+		result.Restored[ptx.coinType]++
+		if isEmission {
+			result.Emissions = append(result.Emissions, *tx.Hash())
+		}
+	}
+
+	// Restore the rolling minimum fees unless restoring the transactions
+	// already raised them further.
+	now := time.Now()
+	for _, minFee := range minFees {
+		coinType := minFee.coinType
+		fee := minFee.fee
+		feeRate := fee.rate(now, mp.rollingMinFeeHalfLife(coinType))
+		if feeRate <= mp.mempoolMinFeeRate(coinType, now) {
+			continue
+		}
+		mp.rollingMinFees[coinType] = &fee
+	}
+	return result, nil
+}
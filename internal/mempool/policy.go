@@ -6,6 +6,7 @@
 package mempool
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -70,8 +71,147 @@ const (
 		txscript.ScriptVerifyCleanStack |
 		txscript.ScriptVerifyCheckLockTimeVerify |
 		txscript.ScriptVerifyCheckSequenceVerify
+
+	// DefaultMaxNullDataSize is the default maximum number of bytes of data
+	// a null data output of a regular VAR transaction may carry for the
+	// transaction to be considered standard.
+	DefaultMaxNullDataSize = stdscript.MaxDataCarrierSizeV0
+
+	// DefaultMaxNullDataOutputs is the default maximum number of null data
+	// outputs a regular VAR transaction may have for it to be considered
+	// standard.
+	DefaultMaxNullDataOutputs = 4
+
+	// DefaultSKAMaxNullDataSize is the default maximum number of bytes of
+	// data a null data output of a regular SKA transaction may carry for the
+	// transaction to be considered standard.  It is larger than the VAR
+	// limit so SKA transactions are able to carry asset metadata.
+	DefaultSKAMaxNullDataSize = 1024
+
+	// DefaultSKAMaxNullDataOutputs is the default maximum number of null data
+	// outputs a regular SKA transaction may have for it to be considered
+	// standard.
+	DefaultSKAMaxNullDataOutputs = 4
+
+	// DefaultMaxTxAge is the default maximum amount of time a regular VAR
+	// transaction may remain in the mempool before it expires and is evicted.
+	DefaultMaxTxAge = time.Hour * 24
+
+	// DefaultSKAMaxTxAge is the default maximum amount of time a regular SKA
+	// transaction may remain in the mempool before it expires and is evicted.
+	// It is shorter than the VAR limit since SKA transfers are typically
+	// settlements that are no longer useful when they are not mined promptly.
+	DefaultSKAMaxTxAge = time.Hour * 2
+
+	// DefaultSKAMaxOrphanTxs is the default maximum number of orphan
+	// transactions of each SKA coin type that can be queued.  It is smaller
+	// than the typical VAR limit so the orphans of a single SKA coin type can
+	// not crowd out the orphans of other SKA coin types.
+	DefaultSKAMaxOrphanTxs = 25
+
+	// DefaultSKAOrphanTTL is the default maximum amount of time an orphan
+	// SKA transaction may stay in the orphan pool before it expires.  It is
+	// shorter than the time allowed for VAR orphans since SKA transfers are
+	// typically relayed along with their parents.
+	DefaultSKAOrphanTTL = time.Minute * 5
+
+	// DefaultSKAPeerMinFeeRelayLimit is the default number of kilobytes of
+	// regular SKA transactions paying the minimum relay fee that a single
+	// peer may relay per minute for each SKA coin type.
+	DefaultSKAPeerMinFeeRelayLimit = 15
+
+	// DefaultSKAMinFeeRelayLimit is the default number of kilobytes of
+	// regular SKA transactions paying the minimum relay fee that all peers
+	// combined may relay per minute for each SKA coin type.  The lower SKA
+	// minimum relay fee otherwise makes flooding the network with SKA
+	// transactions far cheaper than with VAR transactions.
+	DefaultSKAMinFeeRelayLimit = 150
+
+	// DefaultMaxPoolSizeMB is the default number of megabytes of regular VAR
+	// transactions the mempool may hold before the transactions paying the
+	// lowest fee rates are evicted.
+	DefaultMaxPoolSizeMB = 300
+
+	// DefaultSKAMaxPoolSizeMB is the default number of megabytes of regular
+	// SKA transactions the mempool may hold for each SKA coin type before the
+	// transactions paying the lowest fee rates are evicted.  It is smaller
+	// than the VAR limit so a single SKA coin type under pressure can not
+	// consume most of the memory of the node.
+	DefaultSKAMaxPoolSizeMB = 50
+
+	// DefaultMaxAncestors is the default maximum number of transactions a
+	// regular VAR transaction and its unconfirmed ancestors in the mempool
+	// may number.  It matches the number of ancestors tracked when selecting
+	// transactions for a block.
+	DefaultMaxAncestors = 25
+
+	// DefaultMaxAncestorSizeKB is the default maximum number of kilobytes a
+	// regular VAR transaction and its unconfirmed ancestors in the mempool
+	// may total.
+	DefaultMaxAncestorSizeKB = 101
+
+	// DefaultMaxDescendants is the default maximum number of transactions a
+	// regular VAR transaction and its unconfirmed descendants in the mempool
+	// may number.
+	DefaultMaxDescendants = 25
+
+	// DefaultMaxDescendantSizeKB is the default maximum number of kilobytes a
+	// regular VAR transaction and its unconfirmed descendants in the mempool
+	// may total.
+	DefaultMaxDescendantSizeKB = 101
+
+	// DefaultSKAMaxAncestors is the default maximum number of transactions a
+	// regular SKA transaction and its unconfirmed ancestors in the mempool
+	// may number.  It is lower than the VAR limit since the block space of
+	// the SKA allocation is shared by every SKA coin type, so a single deep
+	// chain selected as a whole could otherwise claim most of it.
+	DefaultSKAMaxAncestors = 10
+
+	// DefaultSKAMaxAncestorSizeKB is the default maximum number of kilobytes
+	// a regular SKA transaction and its unconfirmed ancestors in the mempool
+	// may total.
+	DefaultSKAMaxAncestorSizeKB = 40
+
+	// DefaultSKAMaxDescendants is the default maximum number of transactions
+	// a regular SKA transaction and its unconfirmed descendants in the
+	// mempool may number.
+	DefaultSKAMaxDescendants = 10
+
+	// DefaultSKAMaxDescendantSizeKB is the default maximum number of
+	// kilobytes a regular SKA transaction and its unconfirmed descendants in
+	// the mempool may total.
+	DefaultSKAMaxDescendantSizeKB = 40
+
+	// MaxNullDataSizeLimit is the largest null data size limit that may be
+	// configured.  It is the largest amount of data a single push may carry.
+	MaxNullDataSizeLimit = txscript.MaxScriptElementSize
 )
 
+// NullDataPolicy defines the limits placed on the outputs of a regular
+// transaction that only carry data (OP_RETURN null data scripts) for the
+// transaction to be considered standard.
+type NullDataPolicy struct {
+	// MaxDataSize is the maximum number of bytes of data each null data
+	// output may carry.
+	MaxDataSize int
+
+	// MaxOutputs is the maximum number of null data outputs.
+	MaxOutputs int
+}
+
+// MinFeeRelayLimit defines the rate limits placed on regular transactions of a
+// coin type relayed by peers that pay the minimum relay fee of the coin type.
+// A limit of zero means the rate is not limited.
+type MinFeeRelayLimit struct {
+	// PeerKBPerMinute is the number of kilobytes of minimum fee
+	// transactions a single peer may relay per minute.
+	PeerKBPerMinute float64
+
+	// KBPerMinute is the number of kilobytes of minimum fee transactions
+	// all peers combined may relay per minute.
+	KBPerMinute float64
+}
+
 // calcMinRequiredTxRelayFee returns the minimum transaction fee required for a
 // transaction with the passed serialized size to be accepted into the memory
 // pool and relayed.
@@ -329,6 +469,167 @@ func isDust(txOut *wire.TxOut, minRelayTxFee dcrutil.Amount) bool {
 	return txOut.Value*1000/(3*int64(totalSize)) < int64(minRelayTxFee)
 }
 
+// checkSKAEmissionOutputsStandard returns an error when any output of the
+// passed SKA emission transaction does not pay to one of the standard payment
+// script types emission outputs may pay to or is dust at the minimum relay fee
+// of its coin type.  The emission of a tranche can't be redone, so such
+// outputs would permanently lock up part of the supply of the coin type.
+//
+// Note: all non-nil errors MUST be RuleError with an underlying TxRuleError
+// instance.
+func checkSKAEmissionOutputsStandard(msgTx *wire.MsgTx, minRelayTxFee dcrutil.Amount,
+	chainParams *chaincfg.Params) error {
+
+	relayFee := minRelayTxFee
+	if chainParams.SKAMinRelayTxFee > 0 {
+		relayFee = dcrutil.Amount(chainParams.SKAMinRelayTxFee)
+	}
+	for i, txOut := range msgTx.TxOut {
+		if !blockchain.IsStandardEmissionScript(txOut.Version, txOut.PkScript) {
+			str := fmt.Sprintf("emission output %d does not pay to a "+
+				"standard payment script", i)
+			return txRuleError(ErrNonStandard, str)
+		}
+		if blockchain.IsDustEmissionOutput(txOut, relayFee) {
+			str := fmt.Sprintf("emission output %d: payment of %d is dust "+
+				"for coin type %d", i, txOut.Value, txOut.CoinType)
+			return txRuleError(ErrDustOutput, str)
+		}
+	}
+	return nil
+}
+
+// nullDataPayload returns the data carried by the passed public key script
+// along with whether or not it is a version 0 null data script, which is an
+// OP_RETURN optionally followed by a single canonical data push.  Unlike the
+// standard script type detection, the size of the data is not limited so the
+// limits defined by the null data policy of each coin type can be imposed
+// instead.
+func nullDataPayload(version uint16, pkScript []byte) ([]byte, bool) {
+	if version != 0 || len(pkScript) < 1 || pkScript[0] != txscript.OP_RETURN {
+		return nil, false
+	}
+
+	// Single OP_RETURN.
+	if len(pkScript) == 1 {
+		return nil, true
+	}
+
+	// OP_RETURN followed by a single data push that must be encoded with the
+	// smallest possible instruction.
+	tokenizer := txscript.MakeScriptTokenizer(version, pkScript[1:])
+	if !tokenizer.Next() || !tokenizer.Done() ||
+		tokenizer.Opcode() > txscript.OP_16 {
+
+		return nil, false
+	}
+
+	// Canonical pushes of a single byte that represents a small integer use
+	// the dedicated opcode, which carries no data.
+	data := tokenizer.Data()
+	switch opcode := tokenizer.Opcode(); {
+	case opcode == txscript.OP_1NEGATE:
+		data = []byte{0x81}
+	case opcode >= txscript.OP_1 && opcode <= txscript.OP_16:
+		data = []byte{opcode - (txscript.OP_1 - 1)}
+	}
+	canonical, err := txscript.NewScriptBuilder().AddData(data).Script()
+	if err != nil || !bytes.Equal(canonical, pkScript[1:]) {
+		return nil, false
+	}
+	return data, true
+}
+
+// checkSKAActivationStandard returns an error when the passed transaction has
+// outputs of an SKA coin type that requires a stakeholder vote to be activated
+// before that vote has passed as of the provided block height.  No coins of
+// such a coin type can have been emitted yet, so the transaction can not be
+// valid and is rejected as non-standard rather than being relayed.
+//
+// SKA-1 does not require a vote and is therefore never rejected.
+//
+// Note: all non-nil errors MUST be RuleError with an underlying TxRuleError
+// instance.
+func checkSKAActivationStandard(tx *wire.MsgTx, height int64,
+	hasVotePassed func(voteID string, height int64) bool) error {
+
+	if hasVotePassed == nil {
+		return nil
+	}
+	for txOutIndex, txOut := range tx.TxOut {
+		coinType := txOut.CoinType
+		if coinType < 2 {
+			continue
+		}
+		voteID := chaincfg.SKAActivationVoteID(coinType)
+		if !hasVotePassed(voteID, height) {
+			str := fmt.Sprintf("transaction output %d uses coin type %d "+
+				"before stakeholder vote %s activated it", txOutIndex,
+				coinType, voteID)
+			return txRuleError(ErrNonStandard, str)
+		}
+	}
+
+	return nil
+}
+
+// checkTxOutCoinTypesStandard returns an error when the passed transaction has
+// an output that uses a coin type which is neither VAR nor an SKA coin type
+// configured for the network.  Such outputs are only rejected by consensus
+// once the rule that rejects them is active, so they are rejected as
+// non-standard before then to ensure coin types that are introduced by future
+// parameter changes can't be created beforehand.
+//
+// Note: all non-nil errors MUST be RuleError with an underlying TxRuleError
+// instance.
+func checkTxOutCoinTypesStandard(tx *wire.MsgTx, params *chaincfg.Params) error {
+	for txOutIndex, txOut := range tx.TxOut {
+		coinType := txOut.CoinType
+		if coinType == cointype.CoinTypeVAR {
+			continue
+		}
+		if params.GetSKACoinConfig(coinType) == nil {
+			str := fmt.Sprintf("transaction output %d uses unknown coin "+
+				"type %d", txOutIndex, coinType)
+			return txRuleError(ErrNonStandard, str)
+		}
+	}
+
+	return nil
+}
+
+// checkSKADeactivationStandard returns an error when the passed transaction
+// has outputs of an SKA coin type whose deactivation vote has passed as of the
+// provided block height.  Such outputs remain valid for a delay after the vote
+// activates, but they are rejected as non-standard straight away so the coin
+// type can wind down.  Existing outputs of the coin type may still be swept
+// by transactions that only create VAR outputs.
+//
+// Note: all non-nil errors MUST be RuleError with an underlying TxRuleError
+// instance.
+func checkSKADeactivationStandard(tx *wire.MsgTx, height int64,
+	hasVotePassed func(voteID string, height int64) bool) error {
+
+	if hasVotePassed == nil {
+		return nil
+	}
+	for txOutIndex, txOut := range tx.TxOut {
+		coinType := txOut.CoinType
+		if !coinType.IsSKA() {
+			continue
+		}
+		voteID := chaincfg.SKADeactivationVoteID(coinType)
+		if hasVotePassed(voteID, height) {
+			str := fmt.Sprintf("transaction output %d uses coin type %d "+
+				"which was deactivated by stakeholder vote %s", txOutIndex,
+				coinType, voteID)
+			return txRuleError(ErrNonStandard, str)
+		}
+	}
+
+	return nil
+}
+
 // checkTransactionStandard performs a series of checks on a transaction to
 // ensure it is a "standard" transaction.  A standard transaction is one that
 // conforms to several additional limiting cases over what is considered a
@@ -337,10 +638,15 @@ func isDust(txOut *wire.TxOut, minRelayTxFee dcrutil.Amount) bool {
 // of recognized forms, and not containing "dust" outputs (those that are
 // so small it costs more to process them than they are worth).
 //
+// The provided null data policy limits the size and number of outputs that
+// only carry data in regular transactions and is expected to be the one for
+// the coin type of the transaction.
+//
 // Note: all non-nil errors MUST be RuleError with an underlying TxRuleError
 // instance.
 func checkTransactionStandard(tx *dcrutil.Tx, txType stake.TxType, height int64,
-	medianTime time.Time, minRelayTxFee dcrutil.Amount) error {
+	medianTime time.Time, minRelayTxFee dcrutil.Amount,
+	nullData NullDataPolicy) error {
 
 	// The transaction must be a currently supported serialize type.
 	msgTx := tx.MsgTx()
@@ -415,31 +721,52 @@ func checkTransactionStandard(tx *dcrutil.Tx, txType stake.TxType, height int64,
 	for i, txOut := range msgTx.TxOut {
 		scriptType := stdscript.DetermineScriptType(txOut.Version,
 			txOut.PkScript)
-		err := checkPkScriptStandard(txOut.Version, txOut.PkScript, scriptType)
-		if err != nil {
-			str := fmt.Sprintf("transaction output %d: %v", i, err)
-			return wrapTxRuleError(ErrNonStandard, str, err)
+
+		// Null data scripts that carry more data than the standard script
+		// type detection permits are not standard script types, but they
+		// may still be permitted by the null data policy of regular
+		// transactions.
+		data, isNullData := nullDataPayload(txOut.Version, txOut.PkScript)
+		isPolicyNullData := isNullData && txType == stake.TxTypeRegular
+		if !isPolicyNullData || scriptType != stdscript.STNonStandard {
+			err := checkPkScriptStandard(txOut.Version, txOut.PkScript,
+				scriptType)
+			if err != nil {
+				str := fmt.Sprintf("transaction output %d: %v", i, err)
+				return wrapTxRuleError(ErrNonStandard, str, err)
+			}
 		}
 
-		// Accumulate the number of outputs which only carry data.  For
-		// all other script types, ensure the output value is not
-		// "dust".
-		if scriptType == stdscript.STNullData {
+		// Accumulate the number of outputs which only carry data and
+		// ensure the data they carry does not exceed the limit for regular
+		// transactions.  For all other script types, ensure the output value
+		// is not "dust".  The outputs of SKA emissions are instead checked
+		// against the dust threshold of their coin type when the emission is
+		// validated.
+		if isNullData {
 			numNullDataOutputs++
-		} else if txType == stake.TxTypeRegular && isDust(txOut, minRelayTxFee) {
+			if isPolicyNullData && len(data) > nullData.MaxDataSize {
+				str := fmt.Sprintf("transaction output %d: null data "+
+					"size of %d bytes is larger than max allowed size of "+
+					"%d bytes", i, len(data), nullData.MaxDataSize)
+				return txRuleError(ErrNonStandard, str)
+			}
+		} else if txType == stake.TxTypeRegular && !isSKAEmission &&
+			isDust(txOut, minRelayTxFee) {
+
 			str := fmt.Sprintf("transaction output %d: payment "+
 				"of %d is dust", i, txOut.Value)
 			return txRuleError(ErrDustOutput, str)
 		}
 	}
 
-	// A standard transaction must not have more than one output script that
-	// only carries data. However, certain types of standard stake transactions
-	// are allowed to have multiple OP_RETURN outputs, so only throw an error here
-	// if the tx is TxTypeRegular.
-	if numNullDataOutputs > maxNullDataOutputs && txType == stake.TxTypeRegular {
-		str := "more than one transaction output in a nulldata script for a " +
-			"regular type tx"
+	// A standard transaction must not have more output scripts that only
+	// carry data than the limit. However, certain types of standard stake
+	// transactions are allowed to have multiple OP_RETURN outputs, so only
+	// throw an error here if the tx is TxTypeRegular.
+	if numNullDataOutputs > nullData.MaxOutputs && txType == stake.TxTypeRegular {
+		str := fmt.Sprintf("more than %d transaction outputs in a nulldata "+
+			"script for a regular type tx", nullData.MaxOutputs)
 		return txRuleError(ErrNonStandard, str)
 	}
 
@@ -349,6 +349,11 @@ func TestCheckTransactionStandard(t *testing.T) {
 		CoinType: cointype.CoinTypeVAR,
 	}
 
+	varNullData := NullDataPolicy{
+		MaxDataSize: DefaultMaxNullDataSize,
+		MaxOutputs:  DefaultMaxNullDataOutputs,
+	}
+
 	tests := []struct {
 		name       string
 		tx         wire.MsgTx
@@ -540,7 +545,7 @@ func TestCheckTransactionStandard(t *testing.T) {
 		txType := stake.DetermineTxType(&test.tx)
 		tx := dcrutil.NewTx(&test.tx)
 		err := checkTransactionStandard(tx, txType, test.height, medianTime,
-			DefaultMinRelayTxFee)
+			DefaultMinRelayTxFee, varNullData)
 		if err == nil && test.isStandard {
 			// Test passes since function returned standard for a
 			// transaction which is intended to be standard.
@@ -565,3 +570,349 @@ func TestCheckTransactionStandard(t *testing.T) {
 		}
 	}
 }
+
+// TestCheckTransactionStandardNullData ensures the null data outputs of regular
+// transactions are subject to the null data policy of their coin type.
+func TestCheckTransactionStandardNullData(t *testing.T) {
+	prevOutHash, err := chainhash.NewHashFromStr("01")
+	if err != nil {
+		t.Fatalf("NewHashFromStr: unexpected error: %v", err)
+	}
+	txIn := &wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: *prevOutHash, Index: 1},
+		Sequence:         wire.MaxTxInSequenceNum,
+		SignatureScript:  bytes.Repeat([]byte{0x00}, 65),
+	}
+	addrHash := [20]byte{0x01}
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(addrHash[:],
+		chaincfg.RegNetParams())
+	if err != nil {
+		t.Fatalf("NewAddressPubKeyHash: unexpected error: %v", err)
+	}
+	pkScriptVer, pkScript := addr.PaymentScript()
+
+	// nullDataScript returns a null data script that carries the provided
+	// number of bytes of data.
+	nullDataScript := func(size int) []byte {
+		script, err := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).
+			AddData(bytes.Repeat([]byte{0x01}, size)).Script()
+		if err != nil {
+			t.Fatalf("unable to build null data script: %v", err)
+		}
+		return script
+	}
+
+	policy := Policy{}
+	varNullData := policy.nullDataPolicy(cointype.CoinTypeVAR)
+	skaNullData := policy.nullDataPolicy(cointype.CoinType(1))
+	customNullData := NullDataPolicy{MaxDataSize: 40, MaxOutputs: 1}
+
+	tests := []struct {
+		name       string
+		coinType   cointype.CoinType
+		dataSizes  []int
+		nullData   NullDataPolicy
+		isStandard bool
+	}{{
+		name:       "VAR at max data size",
+		coinType:   cointype.CoinTypeVAR,
+		dataSizes:  []int{DefaultMaxNullDataSize},
+		nullData:   varNullData,
+		isStandard: true,
+	}, {
+		name:      "VAR over max data size",
+		coinType:  cointype.CoinTypeVAR,
+		dataSizes: []int{DefaultMaxNullDataSize + 1},
+		nullData:  varNullData,
+	}, {
+		name:       "SKA over VAR max data size",
+		coinType:   cointype.CoinType(1),
+		dataSizes:  []int{DefaultMaxNullDataSize + 1},
+		nullData:   skaNullData,
+		isStandard: true,
+	}, {
+		name:       "SKA at max data size",
+		coinType:   cointype.CoinType(1),
+		dataSizes:  []int{DefaultSKAMaxNullDataSize},
+		nullData:   skaNullData,
+		isStandard: true,
+	}, {
+		name:      "SKA over max data size",
+		coinType:  cointype.CoinType(1),
+		dataSizes: []int{DefaultSKAMaxNullDataSize + 1},
+		nullData:  skaNullData,
+	}, {
+		name:      "SKA over max outputs",
+		coinType:  cointype.CoinType(1),
+		dataSizes: []int{1, 1, 1, 1, 1},
+		nullData:  skaNullData,
+	}, {
+		name:      "configured data size below standard script limit",
+		coinType:  cointype.CoinTypeVAR,
+		dataSizes: []int{41},
+		nullData:  customNullData,
+	}, {
+		name:      "configured max outputs",
+		coinType:  cointype.CoinTypeVAR,
+		dataSizes: []int{1, 1},
+		nullData:  customNullData,
+	}}
+
+	medianTime := time.Now()
+	for _, test := range tests {
+		msgTx := wire.NewMsgTx()
+		msgTx.AddTxIn(txIn)
+		msgTx.AddTxOut(&wire.TxOut{
+			Value:    100000000,
+			Version:  pkScriptVer,
+			PkScript: pkScript,
+			CoinType: test.coinType,
+		})
+		for _, size := range test.dataSizes {
+			msgTx.AddTxOut(&wire.TxOut{
+				PkScript: nullDataScript(size),
+				CoinType: test.coinType,
+			})
+		}
+
+		tx := dcrutil.NewTx(msgTx)
+		err := checkTransactionStandard(tx, stake.TxTypeRegular, 300000,
+			medianTime, DefaultMinRelayTxFee, test.nullData)
+		if test.isStandard && err != nil {
+			t.Errorf("%s: nonstandard when it should not be: %v", test.name,
+				err)
+			continue
+		}
+		if !test.isStandard && !errors.Is(err, ErrNonStandard) {
+			t.Errorf("%s: unexpected error -- got %v, want %v", test.name,
+				err, ErrNonStandard)
+		}
+	}
+}
+
+// TestCheckSKAActivationStandard ensures transactions with outputs of SKA coin
+// types whose activation vote has not passed are rejected as non-standard.
+func TestCheckSKAActivationStandard(t *testing.T) {
+	passedVotes := map[string]bool{"activateska2": true}
+	hasVotePassed := func(voteID string, height int64) bool {
+		return passedVotes[voteID]
+	}
+
+	tests := []struct {
+		name       string
+		coinTypes  []cointype.CoinType
+		isStandard bool
+	}{{
+		name:       "VAR output",
+		coinTypes:  []cointype.CoinType{cointype.CoinTypeVAR},
+		isStandard: true,
+	}, {
+		name:       "SKA-1 output without vote",
+		coinTypes:  []cointype.CoinType{1},
+		isStandard: true,
+	}, {
+		name:       "SKA-2 output after activation",
+		coinTypes:  []cointype.CoinType{cointype.CoinTypeVAR, 2},
+		isStandard: true,
+	}, {
+		name:       "SKA-3 output before activation",
+		coinTypes:  []cointype.CoinType{cointype.CoinTypeVAR, 3},
+		isStandard: false,
+	}}
+
+	for _, test := range tests {
+		msgTx := wire.NewMsgTx()
+		for _, coinType := range test.coinTypes {
+			msgTx.AddTxOut(&wire.TxOut{
+				Value:    100000000,
+				PkScript: []byte{0x51},
+				CoinType: coinType,
+			})
+		}
+
+		err := checkSKAActivationStandard(msgTx, 300000, hasVotePassed)
+		if test.isStandard && err != nil {
+			t.Errorf("%s: nonstandard when it should not be: %v", test.name,
+				err)
+			continue
+		}
+		if !test.isStandard && !errors.Is(err, ErrNonStandard) {
+			t.Errorf("%s: unexpected error -- got %v, want %v", test.name,
+				err, ErrNonStandard)
+		}
+	}
+
+	// Nothing is rejected when vote states are unavailable.
+	msgTx := wire.NewMsgTx()
+	msgTx.AddTxOut(&wire.TxOut{Value: 1, PkScript: []byte{0x51}, CoinType: 3})
+	if err := checkSKAActivationStandard(msgTx, 300000, nil); err != nil {
+		t.Errorf("unexpected error without vote states: %v", err)
+	}
+}
+
+// TestCheckSKADeactivationStandard ensures transactions with outputs of SKA
+// coin types whose deactivation vote has passed are rejected as non-standard.
+func TestCheckSKADeactivationStandard(t *testing.T) {
+	passedVotes := map[string]bool{"deactivateska2": true}
+	hasVotePassed := func(voteID string, height int64) bool {
+		return passedVotes[voteID]
+	}
+
+	tests := []struct {
+		name       string
+		coinTypes  []cointype.CoinType
+		isStandard bool
+	}{{
+		name:       "VAR output",
+		coinTypes:  []cointype.CoinType{cointype.CoinTypeVAR},
+		isStandard: true,
+	}, {
+		name:       "SKA-1 output not deactivated",
+		coinTypes:  []cointype.CoinType{1},
+		isStandard: true,
+	}, {
+		name:       "SKA-2 output after deactivation",
+		coinTypes:  []cointype.CoinType{cointype.CoinTypeVAR, 2},
+		isStandard: false,
+	}}
+
+	for _, test := range tests {
+		msgTx := wire.NewMsgTx()
+		for _, coinType := range test.coinTypes {
+			msgTx.AddTxOut(&wire.TxOut{
+				Value:    100000000,
+				PkScript: []byte{0x51},
+				CoinType: coinType,
+			})
+		}
+
+		err := checkSKADeactivationStandard(msgTx, 300000, hasVotePassed)
+		if test.isStandard && err != nil {
+			t.Errorf("%s: nonstandard when it should not be: %v", test.name,
+				err)
+			continue
+		}
+		if !test.isStandard && !errors.Is(err, ErrNonStandard) {
+			t.Errorf("%s: unexpected error -- got %v, want %v", test.name,
+				err, ErrNonStandard)
+		}
+	}
+}
+
+// TestCheckTxOutCoinTypesStandard ensures transactions with outputs of coin
+// types that are not configured for the network are rejected as non-standard.
+func TestCheckTxOutCoinTypesStandard(t *testing.T) {
+	params := chaincfg.SimNetParams()
+
+	tests := []struct {
+		name       string
+		coinTypes  []cointype.CoinType
+		isStandard bool
+	}{{
+		name:       "VAR output",
+		coinTypes:  []cointype.CoinType{cointype.CoinTypeVAR},
+		isStandard: true,
+	}, {
+		name:       "configured SKA-1 output",
+		coinTypes:  []cointype.CoinType{cointype.CoinTypeVAR, 1},
+		isStandard: true,
+	}, {
+		name:       "unconfigured SKA-99 output",
+		coinTypes:  []cointype.CoinType{1, 99},
+		isStandard: false,
+	}, {
+		name:       "max SKA coin type output",
+		coinTypes:  []cointype.CoinType{cointype.CoinTypeMax},
+		isStandard: false,
+	}}
+
+	for _, test := range tests {
+		msgTx := wire.NewMsgTx()
+		for _, coinType := range test.coinTypes {
+			msgTx.AddTxOut(&wire.TxOut{
+				Value:    100000000,
+				PkScript: []byte{0x51},
+				CoinType: coinType,
+			})
+		}
+
+		err := checkTxOutCoinTypesStandard(msgTx, params)
+		if test.isStandard && err != nil {
+			t.Errorf("%s: nonstandard when it should not be: %v", test.name,
+				err)
+			continue
+		}
+		if !test.isStandard && !errors.Is(err, ErrNonStandard) {
+			t.Errorf("%s: unexpected error -- got %v, want %v", test.name,
+				err, ErrNonStandard)
+		}
+	}
+}
+
+// TestCheckSKAEmissionOutputsStandard ensures SKA emissions are rejected when
+// any of their outputs does not pay to a standard payment script or is dust at
+// the minimum relay fee of SKA transactions.
+func TestCheckSKAEmissionOutputsStandard(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	p2pkh := append(append([]byte{0x76, 0xa9, 0x14}, make([]byte, 20)...),
+		0x88, 0xac)
+
+	tests := []struct {
+		name     string
+		skaFee   int64
+		values   []int64
+		scripts  [][]byte
+		wantKind ErrorKind
+	}{{
+		name:    "standard outputs above SKA dust",
+		skaFee:  50,
+		values:  []int64{1e8, 31},
+		scripts: [][]byte{p2pkh, p2pkh},
+	}, {
+		name:     "output below SKA dust",
+		skaFee:   50,
+		values:   []int64{1e8, 30},
+		scripts:  [][]byte{p2pkh, p2pkh},
+		wantKind: ErrDustOutput,
+	}, {
+		name:     "non-standard output script",
+		skaFee:   50,
+		values:   []int64{1e8, 1e8},
+		scripts:  [][]byte{p2pkh, {0x6a, 0x01, 0x01}},
+		wantKind: ErrNonStandard,
+	}, {
+		name:     "output below dust without SKA relay fee",
+		values:   []int64{6059},
+		scripts:  [][]byte{p2pkh},
+		wantKind: ErrDustOutput,
+	}, {
+		name:    "output above dust without SKA relay fee",
+		values:  []int64{6060},
+		scripts: [][]byte{p2pkh},
+	}}
+
+	for _, test := range tests {
+		params.SKAMinRelayTxFee = test.skaFee
+		msgTx := wire.NewMsgTx()
+		for i, value := range test.values {
+			msgTx.AddTxOut(&wire.TxOut{
+				Value:    value,
+				CoinType: 1,
+				PkScript: test.scripts[i],
+			})
+		}
+
+		err := checkSKAEmissionOutputsStandard(msgTx, DefaultMinRelayTxFee,
+			params)
+		if test.wantKind == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+			continue
+		}
+		if !errors.Is(err, test.wantKind) {
+			t.Errorf("%s: unexpected error -- got %v, want %v", test.name,
+				err, test.wantKind)
+		}
+	}
+}
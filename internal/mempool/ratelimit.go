@@ -0,0 +1,182 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+const (
+	// minFeeRateLimitMultiplier is the multiple of the minimum relay fee
+	// below which the fee paid by a transaction is considered to be the
+	// minimum fee for the purposes of rate limiting.  Transactions paying
+	// slightly more than the minimum are treated the same since the fee could
+	// otherwise be raised by a single atom to evade the limits.
+	minFeeRateLimitMultiplier = 2
+
+	// minFeeRateLimitPruneInterval is the interval at which the buckets of
+	// peers that have not relayed minimum fee transactions recently are
+	// removed.
+	minFeeRateLimitPruneInterval = time.Minute
+)
+
+// tokenBucket is a token bucket that refills at a constant rate up to its
+// capacity.  Each token represents a byte of serialized transactions.
+type tokenBucket struct {
+	tokens     float64
+	lastUpdate time.Time
+}
+
+// newTokenBucket returns a full token bucket with the provided capacity.
+func newTokenBucket(capacity float64, now time.Time) *tokenBucket {
+	return &tokenBucket{tokens: capacity, lastUpdate: now}
+}
+
+// refill adds the tokens accrued at the provided rate per second since the
+// bucket was last updated without exceeding its capacity.
+func (b *tokenBucket) refill(rate, capacity float64, now time.Time) {
+	if elapsed := now.Sub(b.lastUpdate).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * rate
+		if b.tokens > capacity {
+			b.tokens = capacity
+		}
+	}
+	b.lastUpdate = now
+}
+
+// allows returns whether or not the bucket holds enough tokens for a
+// transaction of the provided size.  A transaction larger than the capacity of
+// the bucket is allowed when the bucket is full so it may still be relayed.
+//
+// The bucket must have been refilled as of the current time.
+func (b *tokenBucket) allows(size, capacity float64) bool {
+	return b.tokens >= size || b.tokens >= capacity
+}
+
+// minFeeRateLimiter limits the rate at which regular transactions that pay the
+// minimum relay fee of their coin type are accepted from peers, both from each
+// individual peer and from all peers combined, by way of token buckets that
+// are maintained independently for every coin type.
+//
+// It is not safe for concurrent access, so callers MUST hold the mempool lock.
+type minFeeRateLimiter struct {
+	limits    func(cointype.CoinType) (MinFeeRelayLimit, bool)
+	global    map[cointype.CoinType]*tokenBucket
+	peers     map[cointype.CoinType]map[Tag]*tokenBucket
+	nextPrune time.Time
+}
+
+// newMinFeeRateLimiter returns a new rate limiter for minimum fee transactions
+// that enforces the limits returned by the provided function for each coin
+// type.  Coin types for which it returns false are not limited.
+func newMinFeeRateLimiter(limits func(cointype.CoinType) (MinFeeRelayLimit, bool)) *minFeeRateLimiter {
+	return &minFeeRateLimiter{
+		limits: limits,
+		global: make(map[cointype.CoinType]*tokenBucket),
+		peers:  make(map[cointype.CoinType]map[Tag]*tokenBucket),
+	}
+}
+
+// rates returns the refill rates in bytes per second and the capacities in
+// bytes of the peer and global buckets for the provided limits.  The buckets
+// hold one minute worth of transactions.
+func (limit MinFeeRelayLimit) rates() (peerRate, peerCap, globalRate, globalCap float64) {
+	peerCap = limit.PeerKBPerMinute * 1000
+	globalCap = limit.KBPerMinute * 1000
+	return peerCap / 60, peerCap, globalCap / 60, globalCap
+}
+
+// buckets returns the peer and global buckets for the provided coin type and
+// peer refilled as of the provided time.  The buckets are created as needed.
+func (l *minFeeRateLimiter) buckets(coinType cointype.CoinType, tag Tag,
+	limit MinFeeRelayLimit, now time.Time) (*tokenBucket, *tokenBucket) {
+
+	peerRate, peerCap, globalRate, globalCap := limit.rates()
+	peers, ok := l.peers[coinType]
+	if !ok {
+		peers = make(map[Tag]*tokenBucket)
+		l.peers[coinType] = peers
+	}
+	peer, ok := peers[tag]
+	if !ok {
+		peer = newTokenBucket(peerCap, now)
+		peers[tag] = peer
+	}
+	global, ok := l.global[coinType]
+	if !ok {
+		global = newTokenBucket(globalCap, now)
+		l.global[coinType] = global
+	}
+	peer.refill(peerRate, peerCap, now)
+	global.refill(globalRate, globalCap, now)
+	return peer, global
+}
+
+// allow returns whether or not a minimum fee transaction of the provided coin
+// type and size relayed by the peer identified by the tag is within the limits
+// of the coin type.  No tokens are consumed.
+func (l *minFeeRateLimiter) allow(coinType cointype.CoinType, tag Tag,
+	size int64, now time.Time) bool {
+
+	limit, ok := l.limits(coinType)
+	if !ok {
+		return true
+	}
+	_, peerCap, _, globalCap := limit.rates()
+	peer, global := l.buckets(coinType, tag, limit, now)
+	if limit.PeerKBPerMinute > 0 && !peer.allows(float64(size), peerCap) {
+		return false
+	}
+	if limit.KBPerMinute > 0 && !global.allows(float64(size), globalCap) {
+		return false
+	}
+	return true
+}
+
+// consume removes the tokens for an accepted minimum fee transaction of the
+// provided coin type and size relayed by the peer identified by the tag.
+func (l *minFeeRateLimiter) consume(coinType cointype.CoinType, tag Tag,
+	size int64, now time.Time) {
+
+	limit, ok := l.limits(coinType)
+	if !ok {
+		return
+	}
+	peer, global := l.buckets(coinType, tag, limit, now)
+	if limit.PeerKBPerMinute > 0 {
+		peer.tokens -= float64(size)
+	}
+	if limit.KBPerMinute > 0 {
+		global.tokens -= float64(size)
+	}
+	l.prune(now)
+}
+
+// prune removes the buckets of peers that have refilled completely since they
+// are indistinguishable from new buckets.  This prevents the buckets of peers
+// that have disconnected from accumulating.  It only runs once per prune
+// interval.
+func (l *minFeeRateLimiter) prune(now time.Time) {
+	if now.Before(l.nextPrune) {
+		return
+	}
+	l.nextPrune = now.Add(minFeeRateLimitPruneInterval)
+
+	for coinType, peers := range l.peers {
+		limit, _ := l.limits(coinType)
+		peerRate, peerCap, _, _ := limit.rates()
+		for tag, peer := range peers {
+			peer.refill(peerRate, peerCap, now)
+			if peer.tokens >= peerCap {
+				delete(peers, tag)
+			}
+		}
+		if len(peers) == 0 {
+			delete(l.peers, coinType)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// rollingMinFeeHalfLife is the amount of time it takes the rolling minimum
+// fee of a coin type to decay to half of its value once a block has been
+// connected after it was raised.  The fee decays two and four times faster
+// when the transactions of the coin type occupy less than half and a quarter
+// of its size limit, respectively.
+const rollingMinFeeHalfLife = time.Hour * 12
+
+// rollingMinFee tracks the minimum fee rate regular transactions of a coin type
+// must pay to enter the pool after transactions of the coin type were evicted
+// to keep the pool within the size limit of the coin type.
+//
+// The fee rate rises to just above the fee rate of the evicted transactions
+// and does not decay until a block is connected so the pool is not refilled
+// with transactions that would be evicted again before the pool has had a
+// chance to drain.  It then decays exponentially until it falls below half of
+// the minimum relay fee rate of the coin type, at which point it no longer
+// applies.
+type rollingMinFee struct {
+	// feeRate is the fee rate in atoms per kB as of decayStart.
+	feeRate int64
+
+	// decayStart is the time the fee rate started decaying from feeRate.  It
+	// is zero when no block has been connected since the fee rate was
+	// raised.
+	decayStart time.Time
+}
+
+// rate returns the fee rate in atoms per kB as of the provided time given the
+// provided half-life.
+func (f *rollingMinFee) rate(now time.Time, halfLife time.Duration) int64 {
+	if f.decayStart.IsZero() || !now.After(f.decayStart) {
+		return f.feeRate
+	}
+	halvings := now.Sub(f.decayStart).Seconds() / halfLife.Seconds()
+	return int64(float64(f.feeRate) / math.Pow(2, halvings))
+}
+
+// calcFeeForRate returns the fee for a transaction of the provided serialized
+// size paying the provided fee rate in atoms per kB.
+func calcFeeForRate(serializedSize, feeRate int64) int64 {
+	return serializedSize * feeRate / 1000
+}
+
+// isSizeLimited returns whether or not the provided transaction counts toward
+// the size limit of its coin type and may be evicted to enforce it.  Only
+// regular transactions that pay fees are subject to the limits since stake
+// transactions and SKA emissions are integral to block production.
+func isSizeLimited(txDesc *TxDesc) bool {
+	return txDesc.Type == stake.TxTypeRegular && !txDesc.FeeExempt &&
+		!wire.IsSKAEmissionTransaction(txDesc.Tx.MsgTx())
+}
+
+// trackPoolSize adds the size of the provided transaction to the total size of
+// its coin type when it is subject to the size limit of the coin type.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) trackPoolSize(txDesc *TxDesc) {
+	if isSizeLimited(txDesc) {
+		coinType := wire.GetPrimaryCoinType(txDesc.Tx.MsgTx())
+		mp.poolSizes[coinType] += txDesc.TxSize
+	}
+}
+
+// untrackPoolSize removes the size of the provided transaction from the total
+// size of its coin type when it is subject to the size limit of the coin type.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) untrackPoolSize(txDesc *TxDesc) {
+	if isSizeLimited(txDesc) {
+		coinType := wire.GetPrimaryCoinType(txDesc.Tx.MsgTx())
+		mp.poolSizes[coinType] -= txDesc.TxSize
+		if mp.poolSizes[coinType] <= 0 {
+			delete(mp.poolSizes, coinType)
+		}
+	}
+}
+
+// minRelayFeeRate returns the minimum relay fee rate in atoms per kB of the
+// provided coin type.  It is also the increment by which the rolling minimum
+// fee of the coin type exceeds the fee rate of evicted transactions.
+func (mp *TxPool) minRelayFeeRate(coinType cointype.CoinType) int64 {
+	if mp.feeCalculator != nil {
+		return mp.feeCalculator.CalculateMinFee(1000, coinType)
+	}
+	return mp.calculateLegacyMinFee(nil, 1000, coinType)
+}
+
+// rollingMinFeeHalfLife returns the half-life of the rolling minimum fee of
+// the provided coin type given how much of its size limit its transactions
+// currently occupy.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) rollingMinFeeHalfLife(coinType cointype.CoinType) time.Duration {
+	halfLife := rollingMinFeeHalfLife
+	maxSize := mp.cfg.Policy.maxPoolSize(coinType)
+	switch size := mp.poolSizes[coinType]; {
+	case maxSize <= 0:
+	case size < maxSize/4:
+		halfLife /= 4
+	case size < maxSize/2:
+		halfLife /= 2
+	}
+	return halfLife
+}
+
+// mempoolMinFeeRate returns the rolling minimum fee rate in atoms per kB that
+// regular transactions of the provided coin type must pay to enter the pool as
+// of the provided time.  It is zero when the rolling minimum fee does not
+// apply.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) mempoolMinFeeRate(coinType cointype.CoinType, now time.Time) int64 {
+	fee, ok := mp.rollingMinFees[coinType]
+	if !ok {
+		return 0
+	}
+	return fee.rate(now, mp.rollingMinFeeHalfLife(coinType))
+}
+
+// raiseRollingMinFee raises the rolling minimum fee of the provided coin type
+// to the provided fee rate in atoms per kB unless it already exceeds it.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) raiseRollingMinFee(coinType cointype.CoinType, feeRate int64, now time.Time) {
+	if feeRate <= mp.mempoolMinFeeRate(coinType, now) {
+		return
+	}
+	cfeeLog.Debugf("Raising rolling minimum fee of coin type %v to %d "+
+		"atoms/kB", coinType, feeRate)
+	mp.rollingMinFees[coinType] = &rollingMinFee{feeRate: feeRate}
+}
+
+// decayRollingMinFees starts the decay of the rolling minimum fees that were
+// raised since the previous block and removes those that decayed below half of
+// the minimum relay fee rate of their coin type.  The decay of fees that are
+// already decaying is restarted from their current value so that changes to
+// the half-life only apply from the time they are made.  It is expected to be
+// called when a block is connected.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) decayRollingMinFees(now time.Time) {
+	for coinType, fee := range mp.rollingMinFees {
+		feeRate := fee.rate(now, mp.rollingMinFeeHalfLife(coinType))
+		if feeRate < mp.minRelayFeeRate(coinType)/2 {
+			cfeeLog.Debugf("Rolling minimum fee of coin type %v decayed below "+
+				"half of the minimum relay fee", coinType)
+			delete(mp.rollingMinFees, coinType)
+			continue
+		}
+		fee.feeRate = feeRate
+		fee.decayStart = now
+	}
+}
+
+// evictionFeeRate returns the fee rate in atoms per kB used to determine the
+// order in which the provided transaction is evicted to enforce the size limit
+// of its coin type.  It is the higher of the fee rate of the transaction and
+// the combined fee rate of the transaction and all transactions in the pool
+// that redeem it, directly or indirectly, so that parents are not evicted
+// ahead of children that pay for them.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) evictionFeeRate(txDesc *TxDesc) int64 {
+	fee, size := txDesc.Fee, txDesc.TxSize
+	visited := make(map[chainhash.Hash]struct{})
+	var visit func(desc *TxDesc)
+	visit = func(desc *TxDesc) {
+		mp.forEachRedeemer(desc.Tx, func(redeemer *TxDesc) {
+			redeemerHash := redeemer.Tx.Hash()
+			if _, ok := visited[*redeemerHash]; ok {
+				return
+			}
+			visited[*redeemerHash] = struct{}{}
+			fee += redeemer.Fee
+			size += redeemer.TxSize
+			visit(redeemer)
+		})
+	}
+	visit(txDesc)
+
+	feeRate := txDesc.Fee * 1000 / txDesc.TxSize
+	if packageRate := fee * 1000 / size; packageRate > feeRate {
+		return packageRate
+	}
+	return feeRate
+}
+
+// trimToSize evicts the regular transactions of the provided coin type with
+// the lowest eviction fee rates, along with all transactions that redeem them,
+// until the transactions of the coin type no longer exceed its size limit.
+// The rolling minimum fee of the coin type is raised to the fee rate of the
+// last evicted transaction plus the minimum relay fee rate of the coin type so
+// that transactions paying less are not accepted only to be evicted again.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) trimToSize(coinType cointype.CoinType) {
+	maxSize := mp.cfg.Policy.maxPoolSize(coinType)
+	if maxSize <= 0 || mp.poolSizes[coinType] <= maxSize {
+		return
+	}
+
+	// Order the candidates by their eviction fee rates and break ties by
+	// evicting the most recently added transactions first.
+	type candidate struct {
+		txDesc  *TxDesc
+		feeRate int64
+	}
+	var candidates []candidate
+	for _, txDesc := range mp.pool {
+		if !isSizeLimited(txDesc) ||
+			wire.GetPrimaryCoinType(txDesc.Tx.MsgTx()) != coinType {
+
+			continue
+		}
+		candidates = append(candidates, candidate{
+			txDesc:  txDesc,
+			feeRate: mp.evictionFeeRate(txDesc),
+		})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].feeRate != candidates[j].feeRate {
+			return candidates[i].feeRate < candidates[j].feeRate
+		}
+		return candidates[i].txDesc.Added.After(candidates[j].txDesc.Added)
+	})
+
+	now := time.Now()
+	var numEvicted int
+	for _, c := range candidates {
+		if mp.poolSizes[coinType] <= maxSize {
+			break
+		}
+
+		// Skip transactions that were already evicted as redeemers of
+		// previously evicted transactions.
+		txHash := c.txDesc.Tx.Hash()
+		if _, ok := mp.pool[*txHash]; !ok {
+			continue
+		}
+
+		log.Debugf("Evicting %v transaction %v with fee rate %d atoms/kB "+
+			"from the mempool since it exceeds the size limit of %d bytes",
+			coinType, txHash, c.feeRate, maxSize)
+		numBefore := len(mp.pool)
+		mp.removeTransaction(c.txDesc.Tx, true)
+		numEvicted += numBefore - len(mp.pool)
+		mp.raiseRollingMinFee(coinType, c.feeRate+mp.minRelayFeeRate(coinType),
+			now)
+	}
+	if numEvicted > 0 {
+		log.Infof("Evicted %d %v %s to enforce the mempool size limit "+
+			"(rolling minimum fee %d atoms/kB)", numEvicted, coinType,
+			pickNoun(numEvicted, "transaction", "transactions"),
+			mp.mempoolMinFeeRate(coinType, now))
+	}
+}
+
+// MaxPoolSize returns the maximum number of bytes of regular transactions of
+// the provided coin type the mempool may hold.  Zero means the size is not
+// limited.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) MaxPoolSize(coinType cointype.CoinType) int64 {
+	return mp.cfg.Policy.maxPoolSize(coinType)
+}
+
+// MempoolMinFees returns the rolling minimum fee rates in atoms per kB that
+// regular transactions must pay to enter the mempool keyed by coin type.  Coin
+// types whose rolling minimum fee does not currently apply are not included.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) MempoolMinFees() map[cointype.CoinType]int64 {
+	now := time.Now()
+	mp.mtx.RLock()
+	feeRates := make(map[cointype.CoinType]int64, len(mp.rollingMinFees))
+	for coinType := range mp.rollingMinFees {
+		if feeRate := mp.mempoolMinFeeRate(coinType, now); feeRate > 0 {
+			feeRates[coinType] = feeRate
+		}
+	}
+	mp.mtx.RUnlock()
+	return feeRates
+}
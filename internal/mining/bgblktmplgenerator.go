@@ -406,6 +406,35 @@ func (g *BgBlkTmplGenerator) currentTemplate() (*BlockTemplate, TemplateUpdateRe
 	return template, reason, err
 }
 
+// TemplateBudgetStats returns statistics about how often block templates were
+// delivered partially filled because their time budget was exhausted.
+//
+// This function is safe for concurrent access.
+func (g *BgBlkTmplGenerator) TemplateBudgetStats() TemplateBudgetStats {
+	return g.tg.TemplateBudgetStats()
+}
+
+// BlockSpacePolicy returns a copy of the block space policy applied to the
+// generated block templates.
+//
+// This function is safe for concurrent access.
+func (g *BgBlkTmplGenerator) BlockSpacePolicy() BlockSpacePolicy {
+	return g.tg.BlockSpacePolicy()
+}
+
+// SetBlockSpacePolicy replaces the block space policy applied to the generated
+// block templates and regenerates the current template so the change is
+// reflected without waiting for the next regeneration event.
+//
+// This function is safe for concurrent access.
+func (g *BgBlkTmplGenerator) SetBlockSpacePolicy(policy BlockSpacePolicy) error {
+	if err := g.tg.SetBlockSpacePolicy(policy); err != nil {
+		return err
+	}
+	g.ForceRegen()
+	return nil
+}
+
 // CurrentTemplate returns the current template associated with the background
 // template generator along with any associated error.
 //
@@ -5,10 +5,13 @@
 package mining
 
 import (
+	"github.com/monetarium/monetarium-node/blockchain/stake"
 	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/internal/blockalloc"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
 	"github.com/monetarium/monetarium-node/internal/fees"
 )
 
@@ -19,8 +22,8 @@ type BlockSpaceAllocator struct {
 	feeCalculator *fees.CoinTypeFeeCalculator
 }
 
-// NewBlockSpaceAllocator creates a new block space allocator with the standard
-// 10% VAR / 90% SKA allocation strategy.
+// NewBlockSpaceAllocator creates a new block space allocator with the VAR / SKA
+// split defined by the chain parameters.
 func NewBlockSpaceAllocator(maxBlockSize uint32, chainParams *chaincfg.Params) *BlockSpaceAllocator {
 	return &BlockSpaceAllocator{
 		BlockSpaceAllocator: blockalloc.NewBlockSpaceAllocator(maxBlockSize, chainParams),
@@ -37,6 +40,34 @@ func NewBlockSpaceAllocatorWithFeeCalculator(maxBlockSize uint32, chainParams *c
 	}
 }
 
+// ForHeight returns a copy of the allocator that uses the version of the
+// allocation algorithm that applies to the block at the provided height.
+func (bsa *BlockSpaceAllocator) ForHeight(height int64) *BlockSpaceAllocator {
+	return &BlockSpaceAllocator{
+		BlockSpaceAllocator: bsa.BlockSpaceAllocator.ForHeight(height),
+		feeCalculator:       bsa.feeCalculator,
+	}
+}
+
+// WithStakeReserve returns a copy of the allocator that reserves the provided
+// number of bytes for the stake tree and only allocates the remainder of the
+// block among the coin types.
+func (bsa *BlockSpaceAllocator) WithStakeReserve(reserve uint32) *BlockSpaceAllocator {
+	return &BlockSpaceAllocator{
+		BlockSpaceAllocator: bsa.BlockSpaceAllocator.WithStakeReserve(reserve),
+		feeCalculator:       bsa.feeCalculator,
+	}
+}
+
+// WithWeight returns a copy of the allocator that charges transactions with
+// the provided weight function in addition to their bytes.
+func (bsa *BlockSpaceAllocator) WithWeight(weight blockalloc.WeightFunc) *BlockSpaceAllocator {
+	return &BlockSpaceAllocator{
+		BlockSpaceAllocator: bsa.BlockSpaceAllocator.WithWeight(weight),
+		feeCalculator:       bsa.feeCalculator,
+	}
+}
+
 // SetFeeCalculator sets the fee calculator for utilization tracking.
 func (bsa *BlockSpaceAllocator) SetFeeCalculator(feeCalculator *fees.CoinTypeFeeCalculator) {
 	bsa.feeCalculator = feeCalculator
@@ -67,10 +98,7 @@ func (bsa *BlockSpaceAllocator) updateFeeCalculatorUtilization(allocations map[c
 		pendingTxCount := int(pending / avgTxSize) // Rough estimate
 
 		// Calculate block space utilization for this coin type
-		var blockSpaceUsed float64
-		if allocation.FinalAllocation > 0 {
-			blockSpaceUsed = float64(allocation.UsedBytes) / float64(allocation.FinalAllocation)
-		}
+		blockSpaceUsed := allocation.UtilizationPercentage() / 100.0
 
 		// Update fee calculator with utilization data
 		bsa.feeCalculator.UpdateUtilization(coinType, pendingTxCount,
@@ -103,3 +131,58 @@ func (bsa *BlockSpaceAllocator) GetFeeEstimate(coinType cointype.CoinType, targe
 	// Return basic estimate if no fee calculator
 	return dcrutil.Amount(1e4), nil // Default 10000 atoms/KB
 }
+
+// estimateStakeTreeSize returns an estimate of the number of bytes the stake
+// transactions in the passed transaction descriptors will occupy in the stake
+// tree of a block built on the block with the provided hash.  Only votes on
+// that block are counted and the number of votes, tickets and treasury adds
+// is limited to the maximum allowed in a block.  Treasury spends are only
+// counted when the block is on a treasury vote interval.
+//
+// The estimate does not account for revocations that are created by the
+// template generator itself.
+func estimateStakeTreeSize(txDescs []*TxDesc, prevHash *chainhash.Hash,
+	chainParams *chaincfg.Params, isTVI bool) uint32 {
+
+	var numVotes, numTickets, numTAdds int
+	var size uint32
+	for _, txDesc := range txDescs {
+		msgTx := txDesc.Tx.MsgTx()
+		switch txDesc.Type {
+		case stake.TxTypeSSGen:
+			blockHash, _ := stake.SSGenBlockVotedOn(msgTx)
+			if blockHash != *prevHash ||
+				numVotes >= int(chainParams.TicketsPerBlock) {
+				continue
+			}
+			numVotes++
+
+		case stake.TxTypeSStx:
+			if numTickets >= int(chainParams.MaxFreshStakePerBlock) {
+				continue
+			}
+			numTickets++
+
+		case stake.TxTypeTAdd:
+			if numTAdds >= blockchain.MaxTAddsPerBlock {
+				continue
+			}
+			numTAdds++
+
+		case stake.TxTypeTSpend:
+			if !isTVI {
+				continue
+			}
+
+		case stake.TxTypeSSRtx:
+			// Revocations are only limited by the number of tickets that
+			// can be revoked.
+
+		default:
+			continue
+		}
+
+		size += uint32(msgTx.SerializeSize())
+	}
+	return size
+}
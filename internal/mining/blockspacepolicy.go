@@ -0,0 +1,164 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
+)
+
+// OverflowStrategy identifies which coin types block templates allow to use
+// the overflow space the block space allocation grants on top of the base
+// allocation of a coin type.
+type OverflowStrategy uint8
+
+const (
+	// OverflowProportional allows every coin type to use all of the space
+	// the allocation grants it, including the overflow space redistributed
+	// to it in proportion to its demand.  This is the default.
+	OverflowProportional OverflowStrategy = iota
+
+	// OverflowVAROnly only allows VAR to use overflow space, so SKA coin
+	// types are limited to their base allocation.
+	OverflowVAROnly
+
+	// OverflowNone limits every coin type to its base allocation.
+	OverflowNone
+)
+
+// overflowStrategyNames maps each overflow strategy to its name.
+var overflowStrategyNames = map[OverflowStrategy]string{
+	OverflowProportional: "proportional",
+	OverflowVAROnly:      "varonly",
+	OverflowNone:         "none",
+}
+
+// String returns the name of the overflow strategy.
+func (s OverflowStrategy) String() string {
+	if name, ok := overflowStrategyNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown OverflowStrategy (%d)", uint8(s))
+}
+
+// ParseOverflowStrategy returns the overflow strategy with the provided name.
+func ParseOverflowStrategy(name string) (OverflowStrategy, error) {
+	for strategy, strategyName := range overflowStrategyNames {
+		if strings.EqualFold(name, strategyName) {
+			return strategy, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown overflow strategy %q (valid strategies: "+
+		"proportional, varonly, none)", name)
+}
+
+// BlockSpacePolicy houses the policy that controls how block templates make
+// use of the block space allocated to each coin type.  It is not part of the
+// consensus rules, so it may only make templates more restrictive than the
+// allocation blocks are validated against, and it may be changed at runtime
+// with BlkTmplGenerator.SetBlockSpacePolicy.
+type BlockSpacePolicy struct {
+	// OverflowStrategy determines which coin types may use the overflow
+	// space granted on top of their base allocation.
+	OverflowStrategy OverflowStrategy
+
+	// StakeReserveBytes is the minimum number of bytes to reserve for the
+	// stake tree.  More is reserved when the stake transactions that are
+	// expected to be included in the template need more.  A value of zero
+	// means only the expected size is reserved.
+	StakeReserveBytes uint32
+
+	// MaxCoinTypeBytes houses the maximum number of bytes the regular
+	// transactions of each coin type may use in a template.  Coin types
+	// without an entry are only limited by the allocation.
+	MaxCoinTypeBytes map[cointype.CoinType]uint32
+}
+
+// Copy returns a deep copy of the policy.
+func (p *BlockSpacePolicy) Copy() BlockSpacePolicy {
+	policy := *p
+	if p.MaxCoinTypeBytes != nil {
+		policy.MaxCoinTypeBytes = make(map[cointype.CoinType]uint32,
+			len(p.MaxCoinTypeBytes))
+		for coinType, maxBytes := range p.MaxCoinTypeBytes {
+			policy.MaxCoinTypeBytes[coinType] = maxBytes
+		}
+	}
+	return policy
+}
+
+// Validate ensures the policy is sane for the provided network and maximum
+// block size.
+func (p *BlockSpacePolicy) Validate(chainParams *chaincfg.Params, maxBlockSize uint32) error {
+	if _, ok := overflowStrategyNames[p.OverflowStrategy]; !ok {
+		return fmt.Errorf("unknown overflow strategy %d",
+			uint8(p.OverflowStrategy))
+	}
+	if p.StakeReserveBytes > maxBlockSize {
+		return fmt.Errorf("stake reserve of %d bytes exceeds the maximum "+
+			"block size of %d bytes", p.StakeReserveBytes, maxBlockSize)
+	}
+	for coinType, maxBytes := range p.MaxCoinTypeBytes {
+		if coinType != cointype.CoinTypeVAR &&
+			chainParams.GetSKACoinConfig(coinType) == nil {
+
+			return fmt.Errorf("coin type %d is not configured on %s",
+				uint8(coinType), chainParams.Name)
+		}
+		if maxBytes == 0 {
+			return fmt.Errorf("cap of %s must be greater than zero", coinType)
+		}
+	}
+	return nil
+}
+
+// String returns the policy formatted as space separated key=value fields
+// that are suitable for logging.
+func (p *BlockSpacePolicy) String() string {
+	caps := make([]string, 0, len(p.MaxCoinTypeBytes))
+	for _, coinType := range p.CappedCoinTypes() {
+		caps = append(caps, fmt.Sprintf("%s:%d", coinType,
+			p.MaxCoinTypeBytes[coinType]))
+	}
+	capsStr := "none"
+	if len(caps) > 0 {
+		capsStr = strings.Join(caps, ",")
+	}
+	return fmt.Sprintf("overflow=%s stake_reserve=%d caps=%s",
+		p.OverflowStrategy, p.StakeReserveBytes, capsStr)
+}
+
+// CappedCoinTypes returns the coin types the policy caps in ascending order.
+func (p *BlockSpacePolicy) CappedCoinTypes() []cointype.CoinType {
+	return cointype.SortedKeys(p.MaxCoinTypeBytes)
+}
+
+// stakeReserve returns the number of bytes to reserve for the stake tree given
+// the expected size of the stake transactions.
+func (p *BlockSpacePolicy) stakeReserve(expected uint32) uint32 {
+	return max(expected, p.StakeReserveBytes)
+}
+
+// allocationLimit returns the maximum number of bytes the policy allows the
+// coin type of the provided allocation to use in a template.
+func (p *BlockSpacePolicy) allocationLimit(alloc *blockalloc.CoinTypeAllocation) uint32 {
+	limit := alloc.FinalAllocation
+	switch p.OverflowStrategy {
+	case OverflowVAROnly:
+		if alloc.CoinType.IsSKA() {
+			limit = min(limit, alloc.BaseAllocation)
+		}
+	case OverflowNone:
+		limit = min(limit, alloc.BaseAllocation)
+	}
+	if maxBytes, ok := p.MaxCoinTypeBytes[alloc.CoinType]; ok {
+		limit = min(limit, maxBytes)
+	}
+	return limit
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
+)
+
+// TestParseOverflowStrategy ensures overflow strategies round trip through
+// their names regardless of case and unknown names are rejected.
+func TestParseOverflowStrategy(t *testing.T) {
+	for _, strategy := range []OverflowStrategy{OverflowProportional,
+		OverflowVAROnly, OverflowNone} {
+
+		got, err := ParseOverflowStrategy(strategy.String())
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", strategy, err)
+		}
+		if got != strategy {
+			t.Fatalf("mismatched strategy -- got %v, want %v", got, strategy)
+		}
+	}
+	if got, err := ParseOverflowStrategy("VARONLY"); err != nil ||
+		got != OverflowVAROnly {
+
+		t.Fatalf("unexpected result parsing uppercase name: %v, %v", got, err)
+	}
+	if _, err := ParseOverflowStrategy("all"); err == nil {
+		t.Fatal("expected error for unknown strategy")
+	}
+}
+
+// TestBlockSpacePolicyAllocationLimit ensures the limit the block space policy
+// applies to the allocation of a coin type honors the overflow strategy and
+// the cap of the coin type and never exceeds the final allocation.
+func TestBlockSpacePolicyAllocationLimit(t *testing.T) {
+	varAlloc := &blockalloc.CoinTypeAllocation{
+		CoinType:        cointype.CoinTypeVAR,
+		BaseAllocation:  1000,
+		FinalAllocation: 3000,
+	}
+	skaAlloc := &blockalloc.CoinTypeAllocation{
+		CoinType:        cointype.CoinType(1),
+		BaseAllocation:  9000,
+		FinalAllocation: 12000,
+	}
+
+	tests := []struct {
+		name    string
+		policy  BlockSpacePolicy
+		wantVAR uint32
+		wantSKA uint32
+	}{{
+		name:    "proportional",
+		policy:  BlockSpacePolicy{OverflowStrategy: OverflowProportional},
+		wantVAR: 3000,
+		wantSKA: 12000,
+	}, {
+		name:    "varonly",
+		policy:  BlockSpacePolicy{OverflowStrategy: OverflowVAROnly},
+		wantVAR: 3000,
+		wantSKA: 9000,
+	}, {
+		name:    "none",
+		policy:  BlockSpacePolicy{OverflowStrategy: OverflowNone},
+		wantVAR: 1000,
+		wantSKA: 9000,
+	}, {
+		name: "caps below the allocation",
+		policy: BlockSpacePolicy{
+			MaxCoinTypeBytes: map[cointype.CoinType]uint32{
+				cointype.CoinTypeVAR: 2000,
+				cointype.CoinType(1): 5000,
+			},
+		},
+		wantVAR: 2000,
+		wantSKA: 5000,
+	}, {
+		name: "cap above the allocation",
+		policy: BlockSpacePolicy{
+			OverflowStrategy: OverflowNone,
+			MaxCoinTypeBytes: map[cointype.CoinType]uint32{
+				cointype.CoinType(1): 100000,
+			},
+		},
+		wantVAR: 1000,
+		wantSKA: 9000,
+	}}
+
+	for _, test := range tests {
+		if got := test.policy.allocationLimit(varAlloc); got != test.wantVAR {
+			t.Errorf("%s: mismatched VAR limit -- got %d, want %d", test.name,
+				got, test.wantVAR)
+		}
+		if got := test.policy.allocationLimit(skaAlloc); got != test.wantSKA {
+			t.Errorf("%s: mismatched SKA limit -- got %d, want %d", test.name,
+				got, test.wantSKA)
+		}
+	}
+
+	policy := BlockSpacePolicy{StakeReserveBytes: 5000}
+	if got := policy.stakeReserve(2000); got != 5000 {
+		t.Errorf("mismatched stake reserve -- got %d, want 5000", got)
+	}
+	if got := policy.stakeReserve(8000); got != 8000 {
+		t.Errorf("mismatched stake reserve -- got %d, want 8000", got)
+	}
+}
+
+// TestSetBlockSpacePolicy ensures invalid block space policies are rejected
+// and the policy in effect is isolated from the caller.
+func TestSetBlockSpacePolicy(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	g := NewBlkTmplGenerator(&Config{
+		Policy:      &Policy{BlockMaxSize: 375000},
+		ChainParams: params,
+	})
+
+	invalid := []BlockSpacePolicy{{
+		OverflowStrategy: OverflowNone + 1,
+	}, {
+		StakeReserveBytes: 375001,
+	}, {
+		MaxCoinTypeBytes: map[cointype.CoinType]uint32{cointype.CoinType(1): 0},
+	}, {
+		MaxCoinTypeBytes: map[cointype.CoinType]uint32{cointype.CoinType(200): 1},
+	}}
+	for i, policy := range invalid {
+		if err := g.SetBlockSpacePolicy(policy); err == nil {
+			t.Fatalf("policy %d: expected error for invalid policy %s", i,
+				&policy)
+		}
+	}
+
+	policy := BlockSpacePolicy{
+		OverflowStrategy:  OverflowVAROnly,
+		StakeReserveBytes: 20000,
+		MaxCoinTypeBytes:  map[cointype.CoinType]uint32{cointype.CoinType(1): 50000},
+	}
+	if err := g.SetBlockSpacePolicy(policy); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	policy.MaxCoinTypeBytes[cointype.CoinType(1)] = 1
+	got := g.BlockSpacePolicy()
+	if got.OverflowStrategy != OverflowVAROnly || got.StakeReserveBytes != 20000 ||
+		got.MaxCoinTypeBytes[cointype.CoinType(1)] != 50000 {
+
+		t.Fatalf("unexpected policy in effect: %s", &got)
+	}
+	got.MaxCoinTypeBytes[cointype.CoinType(1)] = 1
+	if g.BlockSpacePolicy().MaxCoinTypeBytes[cointype.CoinType(1)] != 50000 {
+		t.Fatal("policy in effect modified through a returned copy")
+	}
+}
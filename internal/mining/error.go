@@ -36,6 +36,11 @@ const (
 	// failed blockchain.CheckConnectBlock.
 	ErrCheckConnectBlock = ErrorKind("ErrCheckConnectBlock")
 
+	// ErrBlockSpaceAllocation indicates that the transactions in a newly
+	// created block template do not fit within the block space allocation
+	// the network validates blocks against.
+	ErrBlockSpaceAllocation = ErrorKind("ErrBlockSpaceAllocation")
+
 	// ErrFraudProofIndex indicates that there was an error finding the index
 	// for a fraud proof.
 	ErrFraudProofIndex = ErrorKind("ErrFraudProofIndex")
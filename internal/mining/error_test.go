@@ -22,6 +22,7 @@ func TestErrorKindStringer(t *testing.T) {
 		{ErrTransactionAppend, "ErrTransactionAppend"},
 		{ErrTicketExhaustion, "ErrTicketExhaustion"},
 		{ErrCheckConnectBlock, "ErrCheckConnectBlock"},
+		{ErrBlockSpaceAllocation, "ErrBlockSpaceAllocation"},
 		{ErrFraudProofIndex, "ErrFraudProofIndex"},
 		{ErrFetchTxStore, "ErrFetchTxStore"},
 		{ErrCalcCommitmentRoot, "ErrCalcCommitmentRoot"},
@@ -12,9 +12,25 @@ import (
 // means the package will not perform any logging by default until the caller
 // requests it.
 // The default amount of logging is none.
-var log = slog.Disabled
+var (
+	log     = slog.Disabled
+	alocLog = slog.Disabled
+	skaeLog = slog.Disabled
+)
 
 // UseLogger uses a specified Logger to output package logging info.
 func UseLogger(logger slog.Logger) {
 	log = logger
 }
+
+// UseAllocationLogger uses a specified Logger to output block space allocation
+// logging info.
+func UseAllocationLogger(logger slog.Logger) {
+	alocLog = logger
+}
+
+// UseSKAEmissionLogger uses a specified Logger to output SKA emission logging
+// info.
+func UseSKAEmissionLogger(logger slog.Logger) {
+	skaeLog = logger
+}
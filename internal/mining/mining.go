@@ -8,7 +8,6 @@ package mining
 import (
 	"container/heap"
 	"encoding/binary"
-	"encoding/hex"
 	"fmt"
 	"math"
 	"sort"
@@ -27,6 +26,7 @@ import (
 	"github.com/monetarium/monetarium-node/internal/blockchain"
 	"github.com/monetarium/monetarium-node/internal/blockchain/indexers"
 	"github.com/monetarium/monetarium-node/internal/fees"
+	"github.com/monetarium/monetarium-node/internal/ssfee"
 	"github.com/monetarium/monetarium-node/txscript"
 	"github.com/monetarium/monetarium-node/txscript/stdaddr"
 	"github.com/monetarium/monetarium-node/txscript/stdscript"
@@ -200,6 +200,11 @@ type Config struct {
 	// called on an invalid TVI.
 	MaxTreasuryExpenditure func(preTVIBlock *chainhash.Hash) (int64, error)
 
+	// MaxBlockSize defines the function to use to get the maximum permitted
+	// size of the block AFTER the given block hash as defined by the consensus
+	// rules.
+	MaxBlockSize func(hash *chainhash.Hash) (int64, error)
+
 	// NewUtxoViewpoint defines the function to use to create a new empty unspent
 	// transaction output view.
 	NewUtxoViewpoint func() *blockchain.UtxoViewpoint
@@ -226,6 +231,11 @@ type Config struct {
 	// SSFee consolidation. When provided, enables UTXO augmentation to reduce
 	// dust UTXO accumulation. If nil, SSFee transactions create new UTXOs.
 	SSFeeIndex *indexers.SSFeeIndex
+
+	// BlockSpaceAllocated, when set, is invoked with the block space
+	// allocation computed from the pending mempool demand of each coin type
+	// every time a block template is generated.
+	BlockSpaceAllocated func(result *blockalloc.AllocationResult)
 }
 
 // TxDesc is a descriptor about a transaction in a transaction source along with
@@ -252,6 +262,14 @@ type TxDesc struct {
 
 	// TxSize is the size of the transaction.
 	TxSize int64
+
+	// FeeExempt indicates the transaction belongs to the class of
+	// transactions that are exempt from fee requirements and excluded from
+	// fee statistics.  It is only set for SKA emissions that have been
+	// validated, which have no inputs to pay a fee from.  Since emission
+	// outputs are subject to coinbase maturity, fee exempt transactions can
+	// never be the ancestor of another unconfirmed transaction.
+	FeeExempt bool
 }
 
 // TxAncestorStats is a descriptor that stores aggregated statistics for the
@@ -456,6 +474,10 @@ type BlockTemplate struct {
 	// NewBlockTemplate for details on which this can be useful to generate
 	// templates without a coinbase payment address.
 	ValidPayAddress bool
+
+	// Partial indicates whether or not the template was delivered partially
+	// filled because the time budget for assembling it was exhausted.
+	Partial bool
 }
 
 // mergeUtxoView adds all of the entries in viewB to viewA.  The result is that
@@ -483,6 +505,24 @@ func hashInSlice(h chainhash.Hash, list []chainhash.Hash) bool {
 	return false
 }
 
+// orderedSKAEmissions returns the SKA emission transactions in the regular
+// tree from the provided list ordered by the coin type they emit.
+func orderedSKAEmissions(txns []*dcrutil.Tx) []*dcrutil.Tx {
+	var emissions []*dcrutil.Tx
+	for _, tx := range txns {
+		if tx.Tree() == wire.TxTreeRegular &&
+			wire.IsSKAEmissionTransaction(tx.MsgTx()) {
+
+			emissions = append(emissions, tx)
+		}
+	}
+	sort.SliceStable(emissions, func(i, j int) bool {
+		return emissions[i].MsgTx().TxOut[0].CoinType <
+			emissions[j].MsgTx().TxOut[0].CoinType
+	})
+	return emissions
+}
+
 // txIndexFromTxList returns a transaction's index in a list, or -1 if it
 // can not be found.
 func txIndexFromTxList(hash chainhash.Hash, list []*dcrutil.Tx) int {
@@ -731,432 +771,40 @@ func createTreasuryBaseTx(subsidyCache *standalone.SubsidyCache, nextBlockHeight
 	return retTx, nil
 }
 
-// createSSFeeTxBatched creates batched staker fee distribution transactions for all coin types,
-// grouping voters by consolidation address to reduce UTXO fragmentation.
-//
-// This function extracts consolidation addresses from votes and groups voters with the same
-// consolidation address together. It then creates one SSFee transaction per group instead of
-// one per voter, significantly reducing dust UTXO accumulation.
-//
-// The function creates transactions with:
-// - Version 3+ (required for coin type support)
-// - Single input: either null input (creating new UTXO) or real UTXO input (augmenting existing)
-// - Single OP_RETURN output with SSFee marker (height + voter sequence)
-// - Single payment output to the consolidation address (with fee proportional to total stake)
-// - The payment output has the specified coin type (VAR, SKA-1, SKA-2, etc.)
-//
-// UTXO Augmentation:
-// If ssfeeIndex is provided, the function will look up existing UTXOs matching the consolidation
-// address and coin type. If found, it augments the UTXO by using it as input and creating an output
-// with value = utxo_value + total_fee. This prevents dust accumulation by consolidating fees into
-// existing UTXOs. If no matching UTXO exists, falls back to null input (creates new UTXO).
-//
-// Parameters:
-//   - coinType: The coin type for the fees (VAR=0, SKA-1=1, SKA-2=2, etc.)
-//   - totalFee: Total fee to distribute across all voters
-//   - voters: Vote transactions to extract consolidation addresses from
-//   - nextBlockHeight: Height of the block being created
-//   - ssfeeIndex: SSFee index for efficient UTXO lookup (nil disables augmentation)
-//
-// Returns:
-//   - Array of batched SSFee transactions (one per unique consolidation address)
-//   - Error if extraction or transaction creation fails
-func createSSFeeTxBatched(coinType cointype.CoinType, totalFee int64,
-	voters []*dcrutil.Tx, nextBlockHeight int64,
-	ssfeeIndex *indexers.SSFeeIndex,
-	blockUtxos *blockchain.UtxoViewpoint,
-	fetchUtxoEntry func(wire.OutPoint) (*blockchain.UtxoEntry, error),
-	generator *BlkTmplGenerator) ([]*dcrutil.Tx, error) {
-
-	if len(voters) == 0 {
-		return nil, nil
-	}
-
-	// consolidationGroup represents a group of voters with the same consolidation address
-	type consolidationGroup struct {
-		voterIndices []int  // Indices into voters array
-		hash160      []byte // Consolidation address hash160
-	}
-
-	// Step 1: Extract consolidation addresses from votes and group voters
-	groups := make(map[string]*consolidationGroup) // key: hex(hash160)
-
-	for i, voter := range voters {
-		voteTx := voter.MsgTx()
-
-		// Extract consolidation address from vote
-		hash160, err := stake.ExtractSSFeeConsolidationAddr(voteTx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to extract consolidation address from vote %s: %w",
-				voter.Hash(), err)
-		}
-
-		// Group by consolidation address
-		key := hex.EncodeToString(hash160)
-		if group, exists := groups[key]; exists {
-			group.voterIndices = append(group.voterIndices, i)
-		} else {
-			groups[key] = &consolidationGroup{
-				voterIndices: []int{i},
-				hash160:      hash160,
-			}
-		}
-	}
-
-	// Step 2: Create one batched SSFee transaction per group
-	// Use equal-per-vote distribution (not stake-weighted)
-	ssFeeTxns := make([]*dcrutil.Tx, 0, len(groups))
-
-	// Calculate equal fee per voter
-	numVotes := int64(len(voters))
-	feePerVoter := totalFee / numVotes
-	remainder := totalFee - (feePerVoter * numVotes)
-
-	// Sort group keys for deterministic remainder assignment
-	sortedKeys := make([]string, 0, len(groups))
-	for key := range groups {
-		sortedKeys = append(sortedKeys, key)
-	}
-	sort.Strings(sortedKeys)
-
-	for i, key := range sortedKeys {
-		group := groups[key]
-		// Calculate this group's fee (equal per voter in group)
-		groupFee := feePerVoter * int64(len(group.voterIndices))
-		// First sorted group gets remainder for deterministic results
-		if i == 0 && remainder > 0 {
-			groupFee += remainder
-		}
-
-		if groupFee <= 0 {
-			// Skip groups with zero fee (can happen due to rounding)
-			continue
-		}
-
-		// Convert hash160 to P2PKH pkScript for recipient output
-		recipientPkScript, err := stake.ConsolidationAddrToPkScript(group.hash160)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert hash160 to pkScript: %w", err)
-		}
-
-		// Step 3: Look up existing UTXO for augmentation (if index provided)
-		var existingOutpoint *wire.OutPoint
-		var existingValue int64
-		var existingBlockHeight int64
-		var existingBlockIndex uint32
-
-		if ssfeeIndex != nil {
-			outpoint, value, blockHeight, blockIndex, err := ssfeeIndex.LookupUTXO(coinType, group.hash160)
-			if err != nil {
-				// Log but don't fail - fall back to null input
-				log.Debugf("Failed to query SSFeeIndex for UTXO lookup: %v", err)
-			} else if outpoint != nil && value > 0 {
-				// Check if UTXO is already in-flight (used in a pending template)
-				if generator != nil && generator.isSSFeeUTXOInFlight(*outpoint, nextBlockHeight) {
-					log.Debugf("SSFee UTXO %v is in-flight for height %d - skipping", outpoint, nextBlockHeight)
-					outpoint = nil
-					value = 0
-				}
-			}
-
-			if outpoint != nil && value > 0 {
-				// CRITICAL: Verify UTXO is still available (not spent in mempool/current block)
-				var entry *blockchain.UtxoEntry
-				var isSpent bool
-
-				// First check blockUtxos (for UTXOs already used in current template)
-				if blockUtxos != nil {
-					entry = blockUtxos.LookupEntry(*outpoint)
-				}
-
-				if entry != nil {
-					// Entry found in block template view - check if spent
-					isSpent = entry.IsSpent()
-				} else if fetchUtxoEntry != nil {
-					// Entry not in block template - fetch from chain UTXO set
-					chainEntry, err := fetchUtxoEntry(*outpoint)
-					if err != nil {
-						log.Debugf("Failed to fetch UTXO %v from chain: %v", outpoint, err)
-						isSpent = true // Treat error as spent
-					} else if chainEntry == nil || chainEntry.IsSpent() {
-						isSpent = true
-					} else {
-						entry = chainEntry
-						isSpent = false
-					}
-				} else {
-					// No way to verify - assume available (legacy behavior)
-					isSpent = false
-				}
-
-				if isSpent {
-					log.Debugf("SSFee UTXO %v is spent - creating new UTXO instead", outpoint)
-				} else {
-					// UTXO is available - use for augmentation
-					existingOutpoint = outpoint
-					existingValue = value
-					existingBlockHeight = blockHeight
-					existingBlockIndex = blockIndex
-					log.Debugf("Found augmentable UTXO %v (value=%d, height=%d, index=%d) for consolidation address %x",
-						outpoint, value, blockHeight, blockIndex, group.hash160)
-				}
-			} else if outpoint == nil {
-				log.Debugf("No existing UTXO found in SSFeeIndex for consolidation address %x (will create new UTXO)",
-					group.hash160)
-			}
-		}
-
-		// Step 4: Create the batched SSFee transaction
-		tx := wire.NewMsgTx()
-		tx.Version = wire.TxVersionTreasury // Version 3+ required for coin type
-
-		// Add input: either null input or existing UTXO
-		if existingOutpoint != nil && existingValue > 0 {
-			// Augment existing UTXO
-			tx.AddTxIn(&wire.TxIn{
-				PreviousOutPoint: *existingOutpoint,
-				Sequence:         wire.MaxTxInSequenceNum,
-				BlockHeight:      uint32(existingBlockHeight),
-				BlockIndex:       existingBlockIndex,
-				ValueIn:          existingValue,
-			})
-		} else {
-			// Create null input (new UTXO)
-			tx.AddTxIn(&wire.TxIn{
-				PreviousOutPoint: wire.OutPoint{
-					Hash:  chainhash.Hash{},
-					Index: wire.MaxPrevOutIndex,
-				},
-				Sequence:    wire.MaxTxInSequenceNum,
-				BlockHeight: wire.NullBlockHeight,
-				BlockIndex:  wire.NullBlockIndex,
-				ValueIn:     0,
-			})
-		}
-
-		// Add OP_RETURN output with consolidation address (output[0] - standard SSFee format)
-		// Use first voter in group to determine the sequence
-		voterSeq := uint16(group.voterIndices[0])
-		ssfeeMarker := stake.CreateStakerSSFeeMarker(nextBlockHeight, voterSeq)
-		tx.AddTxOut(&wire.TxOut{
-			Value:    0,
-			Version:  0,
-			CoinType: coinType,
-			PkScript: ssfeeMarker,
-		})
-
-		// Add payment output to consolidation address (output[1] - standard SSFee format)
-		outputValue := groupFee
-		if existingValue > 0 {
-			// Augmenting: new value = existing value + fee
-			outputValue = existingValue + groupFee
-		}
-
-		tx.AddTxOut(&wire.TxOut{
-			Value:    outputValue,
-			Version:  0,
-			PkScript: recipientPkScript,
-			CoinType: coinType,
-		})
-
-		retTx := dcrutil.NewTx(tx)
-		retTx.SetTree(wire.TxTreeStake)
-
-		// Mark the UTXO as in-flight if we used one for augmentation
-		if existingOutpoint != nil && generator != nil {
-			generator.markSSFeeUTXOInFlight(*existingOutpoint, nextBlockHeight)
-		}
-
-		ssFeeTxns = append(ssFeeTxns, retTx)
-	}
-
-	return ssFeeTxns, nil
-}
-
-// createMinerSSFeeTx creates a miner fee distribution transaction for non-VAR coins.
-// These transactions distribute fees collected from non-VAR transactions to the miner.
-//
-// The transaction has:
-// - Version 3+ (same as SSFee for stakers)
-// - Single input: either null input (creating new UTXO) or real UTXO input (augmenting existing)
-// - Single output to the miner address (with fee added to existing value if augmenting)
-// - The output has the specified non-VAR coin type
-//
-// UTXO Augmentation:
-// If utxoView is provided and contains a UTXO matching the miner address and coin type,
-// this function will use that UTXO as input and create an output with value = utxo_value + fee.
-// This prevents dust accumulation by consolidating fees into existing UTXOs.
-// If no matching UTXO exists, falls back to null input (creates new UTXO).
-func createMinerSSFeeTx(coinType cointype.CoinType, totalFee int64,
-	minerAddress stdaddr.Address, nextBlockHeight int64,
-	ssfeeIndex *indexers.SSFeeIndex,
-	blockUtxos *blockchain.UtxoViewpoint,
-	fetchUtxoEntry func(wire.OutPoint) (*blockchain.UtxoEntry, error),
-	generator *BlkTmplGenerator) (*dcrutil.Tx, error) {
-
-	// SSFee cannot be used for VAR fees (those go through coinbase)
-	if coinType == cointype.CoinTypeVAR {
-		return nil, fmt.Errorf("miner SSFee cannot distribute VAR fees")
-	}
-
-	// Validate fee amount
-	if totalFee <= 0 {
-		return nil, fmt.Errorf("invalid fee amount: %d", totalFee)
-	}
-
-	// Create OP_RETURN output for unique hash
-	opReturnScript := stake.CreateMinerSSFeeMarker(nextBlockHeight)
-
-	// Create payment script for the miner address or anyone-can-spend if nil
-	// Use OP_SSGEN-tagged scripts like staker SSFee outputs for consistency
-	var scriptVersion uint16
-	var payScript []byte
-	if minerAddress != nil {
-		var rawScript []byte
-		scriptVersion, rawScript = minerAddress.PaymentScript()
-		// Convert to OP_SSGEN-tagged script (stake-tagged)
-		// This ensures the output is properly recognized as a stake tree output
-		payScript = make([]byte, 0, len(rawScript)+1)
-		payScript = append(payScript, txscript.OP_SSGEN)
-		payScript = append(payScript, rawScript...)
-	} else {
-		// Fallback to anyone-can-spend with OP_SSGEN tag
-		scriptVersion = 0
-		payScript = []byte{txscript.OP_SSGEN, txscript.OP_TRUE}
-	}
-
-	// Extract hash160 from payScript for SSFeeIndex lookup
-	// This is needed to find existing miner SSFee UTXOs for consolidation
-	var minerHash160 []byte
-	if minerAddress != nil && len(payScript) == 26 {
-		// OP_SSGEN-tagged P2PKH script format (26 bytes):
-		// OP_SSGEN OP_DUP OP_HASH160 OP_DATA_20 <20 bytes hash160> OP_EQUALVERIFY OP_CHECKSIG
-		minerHash160 = payScript[4:24]
+// ssfeeAugmentSource returns the source used to select existing SSFee UTXOs
+// to augment with the SSFee transactions of a block template.  UTXOs are
+// considered spent when they are spent in the provided view of the template
+// or are not available in the main chain.  UTXOs are only augmented at the
+// heights allowed by the SSFee consolidation intervals of the policy.
+func (g *BlkTmplGenerator) ssfeeAugmentSource(blockUtxos *blockchain.UtxoViewpoint) *ssfee.AugmentSource {
+	if g.cfg.SSFeeIndex == nil {
+		return nil
 	}
-
-	// Try to find existing UTXO to augment using SSFeeIndex (prevent dust accumulation)
-	var inputOutpoint *wire.OutPoint
-	var augmentValue int64
-	var existingBlockHeight int64
-	var existingBlockIndex uint32
-
-	if ssfeeIndex != nil && minerHash160 != nil {
-		outpoint, value, blockHeight, blockIndex, err := ssfeeIndex.LookupUTXO(coinType, minerHash160)
-		if err != nil {
-			// Log but don't fail - fall back to null input
-			log.Debugf("Failed to query SSFeeIndex for miner UTXO lookup: %v", err)
-		} else if outpoint != nil && value > 0 {
-			// Check if UTXO is already in-flight (used in a pending template)
-			if generator != nil && generator.isSSFeeUTXOInFlight(*outpoint, nextBlockHeight) {
-				log.Debugf("Miner SSFee UTXO %v is in-flight for height %d - skipping", outpoint, nextBlockHeight)
-				outpoint = nil
-				value = 0
-			}
-		}
-
-		if outpoint != nil && value > 0 {
-			// CRITICAL: Verify UTXO is still available (not spent in mempool/current block)
-			var entry *blockchain.UtxoEntry
-			var isSpent bool
-
-			// First check blockUtxos (for UTXOs already used in current template)
+	return &ssfee.AugmentSource{
+		Index: g.cfg.SSFeeIndex,
+		IsSpent: func(outpoint wire.OutPoint) bool {
+			// Check the template view first since it includes the UTXOs
+			// already used by the template.
 			if blockUtxos != nil {
-				entry = blockUtxos.LookupEntry(*outpoint)
-			}
-
-			if entry != nil {
-				// Entry found in block template view - check if spent
-				isSpent = entry.IsSpent()
-			} else if fetchUtxoEntry != nil {
-				// Entry not in block template - fetch from chain UTXO set
-				chainEntry, err := fetchUtxoEntry(*outpoint)
-				if err != nil {
-					log.Debugf("Failed to fetch miner UTXO %v from chain: %v", outpoint, err)
-					isSpent = true
-				} else if chainEntry == nil || chainEntry.IsSpent() {
-					isSpent = true
-				} else {
-					entry = chainEntry
-					isSpent = false
+				if entry := blockUtxos.LookupEntry(outpoint); entry != nil {
+					return entry.IsSpent()
 				}
-			} else {
-				// No way to verify - assume available (legacy behavior)
-				isSpent = false
 			}
-
-			if isSpent {
-				log.Debugf("Miner SSFee UTXO %v is spent - creating new UTXO instead", outpoint)
-			} else {
-				// UTXO is available - safe to use for augmentation
-				inputOutpoint = outpoint
-				augmentValue = value
-				existingBlockHeight = blockHeight
-				existingBlockIndex = blockIndex
-				log.Debugf("Found augmentable miner SSFee UTXO %v (value=%d, height=%d, index=%d) for coin type %d",
-					outpoint, value, blockHeight, blockIndex, coinType)
+			if g.cfg.FetchUtxoEntry == nil {
+				return false
 			}
-		} else if outpoint == nil {
-			log.Debugf("No existing miner SSFee UTXO found in SSFeeIndex for hash160 %x (will create new UTXO)",
-				minerHash160)
-		}
-	}
-
-	// Create the miner SSFee transaction
-	tx := wire.NewMsgTx()
-	tx.Version = 3 // Same version as staker SSFee
-
-	// Add input: either real UTXO (augmentation) or null input (creation)
-	// SignatureScript must be explicitly empty (not nil) for validation
-	if inputOutpoint != nil {
-		// UTXO augmentation: use real input with fraud proof data
-		tx.AddTxIn(&wire.TxIn{
-			PreviousOutPoint: *inputOutpoint,
-			Sequence:         wire.MaxTxInSequenceNum,
-			BlockHeight:      uint32(existingBlockHeight),
-			BlockIndex:       existingBlockIndex,
-			ValueIn:          augmentValue,
-			SignatureScript:  []byte{}, // Anyone-can-spend (OP_SSGEN output)
-		})
-	} else {
-		// Fallback: create new UTXO with null input (like coinbase/treasurybase)
-		tx.AddTxIn(&wire.TxIn{
-			PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
-				wire.MaxPrevOutIndex, wire.TxTreeRegular),
-			Sequence:        wire.MaxTxInSequenceNum,
-			BlockHeight:     wire.NullBlockHeight,
-			BlockIndex:      wire.NullBlockIndex,
-			ValueIn:         totalFee,
-			SignatureScript: []byte{},
-		})
-	}
-
-	// Calculate output value: fee + existing UTXO value (if augmenting)
-	outputValue := totalFee + augmentValue
-
-	// Add OP_RETURN output for unique hash (output[0] - consistent with voter SSFee)
-	tx.AddTxOut(&wire.TxOut{
-		Value:    0,
-		CoinType: coinType,
-		PkScript: opReturnScript,
-	})
-
-	// Create payment output to miner (output[1] - consistent with voter SSFee)
-	tx.AddTxOut(&wire.TxOut{
-		Value:    outputValue,
-		CoinType: coinType,
-		Version:  scriptVersion,
-		PkScript: payScript,
-	})
-
-	retTx := dcrutil.NewTx(tx)
-	retTx.SetTree(wire.TxTreeStake)
-
-	// Mark the UTXO as in-flight if we used one for augmentation
-	if inputOutpoint != nil && generator != nil {
-		generator.markSSFeeUTXOInFlight(*inputOutpoint, nextBlockHeight)
+			entry, err := g.cfg.FetchUtxoEntry(outpoint)
+			if err != nil {
+				log.Debugf("Failed to fetch SSFee UTXO %v from chain: %v",
+					outpoint, err)
+				return true
+			}
+			return entry == nil || entry.IsSpent()
+		},
+		IsInFlight:             g.isSSFeeUTXOInFlight,
+		MarkInFlight:           g.markSSFeeUTXOInFlight,
+		ConsolidationIntervals: g.cfg.Policy.SSFeeConsolidationIntervals,
 	}
-
-	return retTx, nil
 }
 
 // spendTransaction updates the passed view by marking the inputs to the passed
@@ -1391,11 +1039,11 @@ func (g *BlkTmplGenerator) handleTooFewVoters(nextHeight int64,
 
 		// Make sure the block validates.
 		btBlock := dcrutil.NewBlockDeepCopyCoinbase(&block)
-		err = g.cfg.CheckConnectBlockTemplate(btBlock)
+		err = g.checkTemplate(btBlock, isTreasuryEnabled)
 		if err != nil {
-			str := fmt.Sprintf("failed to check template: %v while "+
-				"constructing a new parent", err.Error())
-			return nil, makeError(ErrCheckConnectBlock, str)
+			log.Errorf("Failed to check template while constructing a new "+
+				"parent: %v", err)
+			return nil, err
 		}
 
 		return bt, nil
@@ -1550,6 +1198,16 @@ type BlkTmplGenerator struct {
 	// Key: OutPoint being spent, Value: block height the template was created for
 	inFlightSSFeeUTXOs map[wire.OutPoint]int64
 	inFlightMtx        sync.Mutex
+
+	// budgetStats tracks how often templates are delivered partially filled
+	// because their time budget was exhausted.
+	budgetStats templateBudgetStats
+
+	// spacePolicy is the block space policy applied to generated templates.
+	// It is protected by the space policy mutex since it may be changed at
+	// runtime.
+	spacePolicy    BlockSpacePolicy
+	spacePolicyMtx sync.Mutex
 }
 
 // NewBlkTmplGenerator returns a new block template generator for the given
@@ -1561,6 +1219,45 @@ func NewBlkTmplGenerator(cfg *Config) *BlkTmplGenerator {
 	}
 }
 
+// TemplateBudgetStats returns statistics about how often block templates were
+// delivered partially filled because their time budget was exhausted.
+//
+// This function is safe for concurrent access.
+func (g *BlkTmplGenerator) TemplateBudgetStats() TemplateBudgetStats {
+	return g.budgetStats.snapshot()
+}
+
+// BlockSpacePolicy returns a copy of the block space policy applied to
+// generated block templates.
+//
+// This function is safe for concurrent access.
+func (g *BlkTmplGenerator) BlockSpacePolicy() BlockSpacePolicy {
+	g.spacePolicyMtx.Lock()
+	policy := g.spacePolicy.Copy()
+	g.spacePolicyMtx.Unlock()
+	return policy
+}
+
+// SetBlockSpacePolicy replaces the block space policy applied to block
+// templates generated from now on after ensuring it is valid.
+//
+// This function is safe for concurrent access.
+func (g *BlkTmplGenerator) SetBlockSpacePolicy(policy BlockSpacePolicy) error {
+	err := policy.Validate(g.cfg.ChainParams, g.cfg.Policy.BlockMaxSize)
+	if err != nil {
+		return err
+	}
+
+	policy = policy.Copy()
+	g.spacePolicyMtx.Lock()
+	prev := g.spacePolicy
+	g.spacePolicy = policy
+	g.spacePolicyMtx.Unlock()
+
+	log.Infof("Block space policy changed from %s to %s", &prev, &policy)
+	return nil
+}
+
 // ClearInFlightSSFeeUTXOs clears all in-flight SSFee UTXOs for heights <= the
 // given block height. Called when a block is connected to clear obsolete entries.
 func (g *BlkTmplGenerator) ClearInFlightSSFeeUTXOs(blockHeight int64) {
@@ -1590,14 +1287,24 @@ func (g *BlkTmplGenerator) markSSFeeUTXOInFlight(outpoint wire.OutPoint, blockHe
 }
 
 // calcFeePerKb returns an adjusted fee per kilobyte taking the provided
-// transaction and its ancestors into account.
-func calcFeePerKb(txDesc *TxDesc, ancestorStats *TxAncestorStats) float64 {
-	txSize := txDesc.Tx.MsgTx().SerializeSize()
-	if ancestorStats.Fees < 0 || ancestorStats.SizeBytes < 0 {
-		return (float64(txDesc.Fee) * float64(kilobyte)) / float64(txSize)
-	}
-	return (float64(txDesc.Fee+ancestorStats.Fees) * float64(kilobyte)) /
-		float64(int64(txSize)+ancestorStats.SizeBytes)
+// transaction and its ancestors into account.  The size of the transaction and
+// its ancestors is measured in weight units of the provided coin type, so the
+// result is the fee per thousand weight units, which is the fee per kilobyte
+// when every byte costs one unit.
+func calcFeePerKb(txDesc *TxDesc, ancestorStats *TxAncestorStats,
+	coinType cointype.CoinType, weight blockalloc.WeightFunc) float64 {
+
+	fee := txDesc.Fee
+	txSize := int64(txDesc.Tx.MsgTx().SerializeSize())
+	if ancestorStats.Fees >= 0 && ancestorStats.SizeBytes >= 0 {
+		fee += ancestorStats.Fees
+		txSize += ancestorStats.SizeBytes
+	}
+	txWeight := weight(coinType, uint32(txSize))
+	if txWeight == 0 {
+		txWeight = 1
+	}
+	return (float64(fee) * float64(kilobyte)) / float64(txWeight)
 }
 
 // calcCoinTypeAwareFeePerKb returns a coin-type-adjusted fee per kilobyte that
@@ -1605,10 +1312,11 @@ func calcFeePerKb(txDesc *TxDesc, ancestorStats *TxAncestorStats) float64 {
 // the transaction's coin type. This enables intelligent prioritization where
 // each coin type can have its own fee market.
 func calcCoinTypeAwareFeePerKb(txDesc *TxDesc, ancestorStats *TxAncestorStats,
-	coinType cointype.CoinType, feeCalc *fees.CoinTypeFeeCalculator) float64 {
+	coinType cointype.CoinType, weight blockalloc.WeightFunc,
+	feeCalc *fees.CoinTypeFeeCalculator) float64 {
 
 	// Get base fee rate using standard calculation
-	baseFeeRate := calcFeePerKb(txDesc, ancestorStats)
+	baseFeeRate := calcFeePerKb(txDesc, ancestorStats, coinType, weight)
 
 	// Get coin-type-specific fee estimation for comparison and adjustment
 
@@ -1696,6 +1404,10 @@ func calcCoinTypeAwareFeePerKb(txDesc *TxDesc, ancestorStats *TxAncestorStats,
 // This function returns nil when there are not enough voters on any of the
 // current top blocks to create a new block template.
 func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress stdaddr.Address) (*BlockTemplate, error) {
+	// Track the time spent assembling the template against the configured
+	// budget.
+	budget := newTemplateBudget(g.cfg.Policy.TemplateTimeBudget, time.Now())
+
 	// All transaction scripts are verified using the more strict standard
 	// flags.
 	scriptFlags, err := g.cfg.Policy.StandardVerifyFlags()
@@ -1703,6 +1415,13 @@ func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress stdaddr.Address) (*Bloc
 		return nil, err
 	}
 
+	// Transactions are charged and ordered by their weight, which is their
+	// size unless the policy defines otherwise.
+	txWeight := g.cfg.Policy.TxWeight
+	if txWeight == nil {
+		txWeight = blockalloc.ByteWeight
+	}
+
 	// Extend the most recently known best block.
 	// The most recently known best block is the top block that has the most
 	// ssgen votes for it. We only need this after the height in which stake voting
@@ -1907,11 +1626,14 @@ mempoolLoop:
 		// Check if this is an SKA emission transaction
 		isSKAEmission := wire.IsSKAEmissionTransaction(msgTx)
 
+		// Transactions added to the source pool while the current tip was
+		// the best block have not waited for any blocks yet.
 		prioItem := &txPrioItem{
 			txDesc:        txDesc,
 			txType:        txDesc.Type,
 			coinType:      primaryCoinType,
 			isSKAEmission: isSKAEmission,
+			blocksWaited:  best.Height - txDesc.Height,
 		}
 		for i, txIn := range tx.MsgTx().TxIn {
 			// Evaluate if this is a stakebase input or not. If it is, continue
@@ -1950,10 +1672,10 @@ mempoolLoop:
 
 		// Use coin-type-aware fee calculation when available
 		if g.cfg.FeeCalculator != nil {
-			prioItem.feePerKB = calcCoinTypeAwareFeePerKb(txDesc, ancestorStats, prioItem.coinType, g.cfg.FeeCalculator)
+			prioItem.feePerKB = calcCoinTypeAwareFeePerKb(txDesc, ancestorStats, prioItem.coinType, txWeight, g.cfg.FeeCalculator)
 		} else {
 			// Fallback to standard calculation
-			prioItem.feePerKB = calcFeePerKb(txDesc, ancestorStats)
+			prioItem.feePerKB = calcFeePerKb(txDesc, ancestorStats, prioItem.coinType, txWeight)
 		}
 		prioItem.fee = txDesc.Fee + ancestorStats.Fees
 		prioItemMap[*tx.Hash()] = prioItem
@@ -1997,6 +1719,12 @@ mempoolLoop:
 	numSSGen := 0
 	numTAdds := 0
 
+	// Keep track of the SKA emission included in the template for each coin
+	// type so a conflicting emission, such as one rolled back by a
+	// reorganization that is still in the source pool, and the transactions
+	// that spend its outputs are never included alongside it.
+	templateEmissions := make(map[cointype.CoinType]*chainhash.Hash)
+
 	foundWinningTickets := make(map[chainhash.Hash]bool, len(best.NextWinningTickets))
 	for _, ticketHash := range best.NextWinningTickets {
 		foundWinningTickets[ticketHash] = false
@@ -2039,6 +1767,25 @@ mempoolLoop:
 		blockSpaceAllocator = NewBlockSpaceAllocator(g.cfg.Policy.BlockMaxSize, g.cfg.ChainParams)
 	}
 
+	// Allocate space with the version of the allocation algorithm the block
+	// will be validated against and charge transactions their weight.
+	blockSpaceAllocator = blockSpaceAllocator.ForHeight(nextBlockHeight).
+		WithWeight(txWeight)
+
+	// Apply the block space policy that is in effect when generation starts
+	// to the entire template.
+	spacePolicy := g.BlockSpacePolicy()
+
+	// Reserve the space the stake transactions are expected to take in the
+	// stake tree so it is not also allocated to regular transactions.  Stake
+	// transactions that fit in the reserve are not tracked against the
+	// allocation of their coin type below.  The policy may reserve more.
+	stakeReserve := spacePolicy.stakeReserve(estimateStakeTreeSize(
+		sourceTxns, &prevHash, g.cfg.ChainParams, isTVI))
+	blockSpaceAllocator = blockSpaceAllocator.WithStakeReserve(stakeReserve)
+	stakeReserveLeft := blockSpaceAllocator.StakeReserve()
+	log.Debugf("Reserved %d bytes for the stake tree", stakeReserve)
+
 	// Calculate total pending transaction bytes from mempool for each coin type.
 	// This provides visibility into the allocation decisions and helps with debugging.
 	mempoolPendingBytes := make(map[cointype.CoinType]uint32)
@@ -2050,8 +1797,9 @@ mempoolLoop:
 
 	// Log initial allocation based on actual mempool demand.
 	// This helps diagnose issues where SKA reserves space but has no transactions.
+	var initialAlloc *blockalloc.AllocationResult
 	if len(mempoolPendingBytes) > 0 {
-		initialAlloc := blockSpaceAllocator.AllocateBlockSpace(mempoolPendingBytes)
+		initialAlloc = blockSpaceAllocator.AllocateBlockSpace(mempoolPendingBytes)
 		varAlloc := initialAlloc.GetAllocationForCoinType(cointype.CoinTypeVAR)
 		varPending := mempoolPendingBytes[cointype.CoinTypeVAR]
 
@@ -2060,17 +1808,17 @@ mempoolLoop:
 			varAllocSize = varAlloc.FinalAllocation
 		}
 
-		log.Debugf("Block template mempool analysis: VAR pending=%d bytes, "+
+		alocLog.Debugf("Block template mempool analysis: VAR pending=%d bytes, "+
 			"VAR allocation=%d bytes, block max=%d bytes",
 			varPending, varAllocSize, g.cfg.Policy.BlockMaxSize)
 
 		// Log VAR allocation efficiency
 		if varAllocSize > 0 && varPending > 0 {
 			efficiency := float64(varPending) / float64(varAllocSize) * 100
-			log.Debugf("VAR allocation efficiency: %.1f%% (%d used of %d allocated)",
+			alocLog.Debugf("VAR allocation efficiency: %.1f%% (%d used of %d allocated)",
 				efficiency, varPending, varAllocSize)
 		} else if varAllocSize > 0 && varPending == 0 {
-			log.Debugf("VAR allocation: %d bytes allocated, but 0 bytes pending (allocation unused)",
+			alocLog.Debugf("VAR allocation: %d bytes allocated, but 0 bytes pending (allocation unused)",
 				varAllocSize)
 		}
 
@@ -2082,18 +1830,29 @@ mempoolLoop:
 				if skaAlloc != nil {
 					skaAllocSize = skaAlloc.FinalAllocation
 				}
-				log.Debugf("Block template mempool analysis: %s pending=%d bytes, "+
+				alocLog.Debugf("Block template mempool analysis: %s pending=%d bytes, "+
 					"%s allocation=%d bytes", coinType, pending, coinType, skaAllocSize)
 
 				// Log SKA allocation efficiency
 				if skaAllocSize > 0 {
 					skaEfficiency := float64(pending) / float64(skaAllocSize) * 100
-					log.Debugf("%s allocation efficiency: %.1f%% (%d used of %d allocated)",
+					alocLog.Debugf("%s allocation efficiency: %.1f%% (%d used of %d allocated)",
 						coinType, skaEfficiency, pending, skaAllocSize)
 				}
 			}
 		}
 	}
+	if g.cfg.BlockSpaceAllocated != nil {
+		if initialAlloc == nil {
+			initialAlloc = blockSpaceAllocator.BlockSpaceAllocator.AllocateBlockSpace(
+				mempoolPendingBytes)
+		}
+		g.cfg.BlockSpaceAllocated(initialAlloc)
+	}
+	if initialAlloc != nil {
+		blockalloc.LogAllocationDecision("initial", nextBlockHeight,
+			blockSpaceAllocator.Version(), initialAlloc)
+	}
 
 	transactionTracker := blockalloc.NewTransactionSizeTracker(blockSpaceAllocator.BlockSpaceAllocator)
 
@@ -2129,6 +1888,15 @@ nextPriorityQueueItem:
 			isTAdd = prioItem.txType == stake.TxTypeTAdd
 		}
 
+		// Stop adding regular transactions once the time budget for assembling
+		// the template is exhausted so work is delivered to miners without
+		// further delay.  Stake transactions are still considered since they
+		// are few in number and votes are required.
+		if prioItem.txType == stake.TxTypeRegular && budget.isExhausted() {
+			budget.skippedTxns++
+			continue
+		}
+
 		// If the transaction is not a vote, then we are done adding votes since
 		// votes are the highest priority in the queue.
 		doneAddingVotes := !isSSGen
@@ -2277,9 +2045,9 @@ nextPriorityQueueItem:
 
 		// Recalculate fee using coin-type-aware method when available
 		if g.cfg.FeeCalculator != nil {
-			prioItem.feePerKB = calcCoinTypeAwareFeePerKb(prioItem.txDesc, ancestorStats, prioItem.coinType, g.cfg.FeeCalculator)
+			prioItem.feePerKB = calcCoinTypeAwareFeePerKb(prioItem.txDesc, ancestorStats, prioItem.coinType, txWeight, g.cfg.FeeCalculator)
 		} else {
-			prioItem.feePerKB = calcFeePerKb(prioItem.txDesc, ancestorStats)
+			prioItem.feePerKB = calcFeePerKb(prioItem.txDesc, ancestorStats, prioItem.coinType, txWeight)
 		}
 
 		feeDecreased := oldFee > prioItem.feePerKB
@@ -2324,14 +2092,34 @@ nextPriorityQueueItem:
 		coinType := blockalloc.GetTransactionCoinType(tx)
 		isSKAEmission := wire.IsSKAEmissionTransaction(tx.MsgTx())
 
+		// Stake transactions without ancestors use the space reserved for
+		// the stake tree while it lasts and otherwise compete for the space
+		// allocated to their coin type.
+		useStakeReserve := tx.Tree() == wire.TxTreeStake &&
+			len(ancestors) == 0 && txSize <= stakeReserveLeft
+
+		// The transaction is added along with any ancestors that are not
+		// yet in the template, so they must all fit together.
+		bundleTxns := make([]*dcrutil.Tx, 0, len(ancestors)+1)
+		for _, ancestor := range ancestors {
+			bundleTxns = append(bundleTxns, ancestor.Tx)
+		}
+		bundleTxns = append(bundleTxns, tx)
+
 		if isSKAEmission {
-			log.Infof("Including SKA emission tx %s (coin type %d, size %v) with guaranteed block space",
+			skaeLog.Infof("Including SKA emission tx %s (coin type %d, size %v) with guaranteed block space",
 				tx.Hash(), coinType, txSize)
-		} else if !transactionTracker.CanAddTransaction(tx) {
+		} else if useStakeReserve {
+			log.Tracef("Including stake tx %s (size %v) in the space "+
+				"reserved for the stake tree", tx.Hash(), txSize)
+		} else if !transactionTracker.CanAddTransactionsWithLimit(bundleTxns,
+			spacePolicy.allocationLimit) {
+
 			log.Debugf("Skipping tx %s (coin type %d, size %v) because it "+
-				"would exceed the coin type allocation; cur block "+
-				"size %v, cur num tx %v", tx.Hash(), coinType, txSize,
-				blockSize, len(blockTxns))
+				"and its ancestors would exceed the coin type allocation "+
+				"permitted by the block space policy; "+
+				"cur block size %v, cur num tx %v", tx.Hash(), coinType,
+				txSize, blockSize, len(blockTxns))
 			logSkippedDeps(tx, deps)
 			miningView.reject(tx.Hash())
 			continue
@@ -2383,9 +2171,11 @@ nextPriorityQueueItem:
 		//
 		// But allows valid mempool transactions (that passed dynamic fee
 		// validation at entry time) to eventually be mined.
+		//
+		// Fee exempt transactions are not subject to this check since they
+		// have no inputs to pay a fee from.
 		skipForLowFee := false
-		// Note: isSKAEmission already declared above for blockspace check
-		if tx.Tree() != wire.TxTreeStake && !isSKAEmission {
+		if tx.Tree() != wire.TxTreeStake && !prioItem.txDesc.FeeExempt {
 			// Use coin-type-specific minimum relay fee
 			var minStaticFee float64
 			if prioItem.coinType == cointype.CoinTypeVAR {
@@ -2407,12 +2197,37 @@ nextPriorityQueueItem:
 		}
 
 		if skipForLowFee {
+			// Transactions with children are not rejected since a child
+			// that pays a high enough fee of the same coin type to cover
+			// its ancestors, such as the child of a package, selects them
+			// along with itself.  The fee rate of the child already
+			// includes the fees and sizes of its ancestors.
+			if len(miningView.children(tx.Hash())) > 0 {
+				log.Debugf("Deferring low fee tx %s to its descendants",
+					tx.Hash())
+				continue
+			}
 			logSkippedDeps(tx, deps)
 			miningView.reject(tx.Hash())
 			continue
 		}
 
 		txBundle := append(ancestors, prioItem.txDesc)
+		for _, bundledTx := range txBundle {
+			if !wire.IsSKAEmissionTransaction(bundledTx.Tx.MsgTx()) {
+				continue
+			}
+			coinType := blockalloc.GetTransactionCoinType(bundledTx.Tx)
+			if included, ok := templateEmissions[coinType]; ok {
+				skaeLog.Debugf("Skipping SKA emission %s and its descendants "+
+					"since it conflicts with SKA emission %s for coin "+
+					"type %d already in the template",
+					bundledTx.Tx.Hash(), included, coinType)
+				logSkippedDeps(tx, deps)
+				miningView.reject(bundledTx.Tx.Hash())
+				continue nextPriorityQueueItem
+			}
+		}
 		for _, bundledTx := range txBundle {
 			// Ensure the transaction inputs pass all of the necessary
 			// preconditions before allowing it to be added to the block.
@@ -2468,8 +2283,9 @@ nextPriorityQueueItem:
 			// template.
 			if wire.IsSKAEmissionTransaction(bundledTx.MsgTx()) {
 				coinType := blockalloc.GetTransactionCoinType(bundledTx)
+				templateEmissions[coinType] = bundledTxHash
 				maturityBlock := nextBlockHeight + int64(g.cfg.ChainParams.CoinbaseMaturity)
-				log.Infof("Added SKA emission transaction %v (coin type %d) to block at height %d, matures at block %d",
+				skaeLog.Infof("Added SKA emission transaction %v (coin type %d) to block at height %d, matures at block %d",
 					bundledTx.Hash(), coinType, nextBlockHeight, maturityBlock)
 			} else {
 				log.Debugf("Adding transaction %v to block at height %d", bundledTx.Hash(), nextBlockHeight)
@@ -2480,11 +2296,18 @@ nextPriorityQueueItem:
 			blockSigOps += bundledTxSigOps
 
 			// Update block space allocation tracking
-			transactionTracker.AddTransaction(bundledTx)
+			if useStakeReserve {
+				stakeReserveLeft -= uint32(bundledTx.MsgTx().SerializeSize())
+			} else {
+				transactionTracker.AddTransaction(bundledTx)
+			}
 
 			// Record transaction fee for coin-type-specific fee estimation
-			// Skip feeless system transactions (votes and revocations) from fee statistics
-			if g.cfg.FeeCalculator != nil && bundledTxDesc.Type != stake.TxTypeSSGen && bundledTxDesc.Type != stake.TxTypeSSRtx {
+			// Skip feeless system transactions (votes, revocations, and fee
+			// exempt transactions) from fee statistics
+			if g.cfg.FeeCalculator != nil && !bundledTxDesc.FeeExempt &&
+				bundledTxDesc.Type != stake.TxTypeSSGen && bundledTxDesc.Type != stake.TxTypeSSRtx {
+
 				bundledCoinType := blockalloc.GetTransactionCoinType(bundledTx)
 				bundledSize := int64(bundledTx.MsgTx().SerializeSize())
 				g.cfg.FeeCalculator.RecordTransactionFee(bundledCoinType,
@@ -2567,8 +2390,15 @@ nextPriorityQueueItem:
 
 	// Log block space allocation results and update fee calculator with utilization data
 	allocation := transactionTracker.GetAllocation()
-	log.Debugf("Block space allocation: %.1f%% utilization (%d/%d bytes used)",
+	alocLog.Debugf("Block space allocation: %.1f%% utilization (%d/%d bytes used)",
 		allocation.GetUtilizationPercentage(), allocation.TotalUsed, allocation.TotalAllocated)
+	for _, util := range allocation.GetCoinTypeUtilization() {
+		alocLog.Debugf("  Coin type %d: %.1f%% of final allocation, %.1f%% of "+
+			"base allocation", util.CoinType, util.FinalPercentage,
+			util.BasePercentage)
+	}
+	blockalloc.LogAllocationDecision("final", nextBlockHeight,
+		blockSpaceAllocator.Version(), allocation)
 
 	// Pre-bucket pending transactions by coin type for performance (O(n) instead of O(n*m))
 	var pendingBuckets map[cointype.CoinType]struct {
@@ -2591,19 +2421,10 @@ nextPriorityQueueItem:
 	}
 
 	for coinType, coinAlloc := range allocation.Allocations {
-		if coinAlloc.UsedBytes > 0 {
-			log.Debugf("  Coin type %d: %d bytes used (%.1f%% of allocation)",
-				coinType, coinAlloc.UsedBytes,
-				float64(coinAlloc.UsedBytes)/float64(coinAlloc.FinalAllocation)*100.0)
-		}
-
 		// Update fee calculator with utilization feedback for dynamic adjustment
 		if g.cfg.FeeCalculator != nil {
-			var utilizationRate float64
-			if coinAlloc.FinalAllocation > 0 {
-				utilizationRate = float64(coinAlloc.UsedBytes) / float64(coinAlloc.FinalAllocation)
-			}
 			// utilizationRate is 0.0 if allocation is 0 (no space allocated)
+			utilizationRate := coinAlloc.UtilizationPercentage() / 100.0
 
 			bucket := pendingBuckets[coinType]
 			g.cfg.FeeCalculator.UpdateUtilization(coinType,
@@ -2848,9 +2669,16 @@ nextPriorityQueueItem:
 	// Append coinbase.
 	blockTxnsRegular = append(blockTxnsRegular, coinbaseTx)
 
+	// SKA emissions must immediately follow the coinbase, so add them next
+	// ordered by coin type to keep their positions deterministic.
+	blockTxnsRegular = append(blockTxnsRegular, orderedSKAEmissions(blockTxns)...)
+
 	// Assemble the two transaction trees.
 	for _, tx := range blockTxns {
 		if tx.Tree() == wire.TxTreeRegular {
+			if wire.IsSKAEmissionTransaction(tx.MsgTx()) {
+				continue
+			}
 			blockTxnsRegular = append(blockTxnsRegular, tx)
 		} else if tx.Tree() == wire.TxTreeStake {
 			continue
@@ -2912,6 +2740,15 @@ nextPriorityQueueItem:
 		// Calculate fee split between miners and stakers
 		minerFees, stakerFees := wire.CalcFeeSplitByCoinType(totalFees, work, stake)
 
+		// Create the SSFee transactions without looking up existing UTXOs to
+		// augment once the time budget is exhausted since the new UTXOs they
+		// create instead are equally valid.
+		augmentSource := g.ssfeeAugmentSource(blockUtxos)
+		if augmentSource != nil && budget.isExhausted() {
+			augmentSource = nil
+			budget.skippedAugment = true
+		}
+
 		// Create SSFee transactions for staker fees if there are voters
 		if voters > 0 {
 			// Create SSFee transactions for each coin type with staker fees
@@ -2923,8 +2760,8 @@ nextPriorityQueueItem:
 				// Create batched SSFee transactions for this coin type (one per consolidation address)
 				// Use SSFeeIndex for UTXO augmentation if available
 				// Note: Both VAR and non-VAR staker fees use SSFee (only VAR miner fees go to coinbase)
-				voterSSFeeTxns, err := createSSFeeTxBatched(coinType, stakerFee, votes, nextBlockHeight,
-					g.cfg.SSFeeIndex, blockUtxos, g.cfg.FetchUtxoEntry, g)
+				voterSSFeeTxns, err := ssfee.NewStakerTxs(coinType, stakerFee,
+					votes, nextBlockHeight, augmentSource)
 				if err != nil {
 					// Critical error: staker fees cannot be distributed
 					// This is a serious issue as fees would be lost if we continue
@@ -2968,7 +2805,8 @@ nextPriorityQueueItem:
 
 			// Create miner SSFee transaction for this coin type
 			// Uses SSFeeIndex to find existing miner SSFee UTXOs for consolidation
-			minerSSFeeTx, err := createMinerSSFeeTx(coinType, minerFee, payToAddress, nextBlockHeight, g.cfg.SSFeeIndex, blockUtxos, g.cfg.FetchUtxoEntry, g)
+			minerSSFeeTx, err := ssfee.NewMinerTx(coinType, minerFee,
+				payToAddress, nextBlockHeight, augmentSource)
 			if err != nil {
 				// Critical error: miner fees cannot be distributed
 				// This is a serious issue as fees would be lost if we continue
@@ -3197,16 +3035,15 @@ nextPriorityQueueItem:
 
 	msgBlock.Header.Size = uint32(msgBlock.SerializeSize())
 
-	// Finally, perform a full check on the created block against the chain
+	// Finally, perform a dry run of the created block against the chain
 	// consensus rules to ensure it properly connects to the current best
-	// chain with no issues.
+	// chain with no issues so miners are never handed work the network would
+	// reject.
 	block := dcrutil.NewBlockDeepCopyCoinbase(&msgBlock)
-	err = g.cfg.CheckConnectBlockTemplate(block)
+	err = g.checkTemplate(block, isTreasuryEnabled)
 	if err != nil {
-		str := fmt.Sprintf("failed to do final check for check connect "+
-			"block when making new block template: %v", err)
-		log.Debugf(str)
-		return nil, makeError(ErrCheckConnectBlock, str)
+		log.Errorf("Failed to do final check of new block template: %v", err)
+		return nil, err
 	}
 
 	log.Debugf("Created new block template (%d transactions, %d stake "+
@@ -3223,11 +3060,53 @@ nextPriorityQueueItem:
 		SigOpCounts:     txSigOpCounts,
 		Height:          nextBlockHeight,
 		ValidPayAddress: payToAddress != nil,
+		Partial:         budget.isPartial(),
+	}
+
+	g.budgetStats.record(budget)
+	if blockTemplate.Partial {
+		log.Infof("Delivered a partially filled block template for height %d "+
+			"after exceeding the time budget of %v (skipped regular "+
+			"transactions: %d, skipped SSFee augmentation: %v)",
+			nextBlockHeight, g.cfg.Policy.TemplateTimeBudget,
+			budget.skippedTxns, budget.skippedAugment)
 	}
 
 	return blockTemplate, nil
 }
 
+// checkTemplate performs a dry run of the fully assembled block template
+// against the rules the network validates blocks with.
+//
+// The space used by each coin type is first checked against the block space
+// allocation computed for the consensus maximum block size, which may differ
+// from the size the template was assembled with, so allocation violations are
+// reported as such.  The template is then fully checked against the consensus
+// rules, including the SKA emission rules, aside from the proof of work.
+func (g *BlkTmplGenerator) checkTemplate(block *dcrutil.Block, isTreasuryEnabled bool) error {
+	msgBlock := block.MsgBlock()
+	maxBlockSize, err := g.cfg.MaxBlockSize(&msgBlock.Header.PrevBlock)
+	if err != nil {
+		str := fmt.Sprintf("unable to determine the maximum block size for "+
+			"block template: %v", err)
+		return makeError(ErrCheckConnectBlock, str)
+	}
+	allocator := blockalloc.NewBlockSpaceAllocator(uint32(maxBlockSize),
+		g.cfg.ChainParams).ForHeight(int64(msgBlock.Header.Height))
+	spaceUsed := blockalloc.BlockSpaceUsage(msgBlock, isTreasuryEnabled)
+	if err := allocator.CheckSpaceUsage(spaceUsed); err != nil {
+		str := fmt.Sprintf("block template violates the block space "+
+			"allocation: %v", err)
+		return makeError(ErrBlockSpaceAllocation, str)
+	}
+
+	if err := g.cfg.CheckConnectBlockTemplate(block); err != nil {
+		str := fmt.Sprintf("failed to check connect block template: %v", err)
+		return makeError(ErrCheckConnectBlock, str)
+	}
+	return nil
+}
+
 // UpdateBlockTime updates the timestamp in the passed header to the current
 // time while taking into account the median time of the last several blocks to
 // ensure the new time is after that time per the chain consensus rules.
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// benchTemplateCompositions is the corpus of source pool mixes the template
+// generation benchmarks are run against expressed as the number of
+// transactions of each coin type.
+var benchTemplateCompositions = []struct {
+	name    string
+	numTxns []int // indexed by coin type
+}{
+	{name: "VAR only", numTxns: []int{1000}},
+	{name: "balanced", numTxns: []int{500, 500}},
+	{name: "SKA heavy", numTxns: []int{100, 900}},
+	{name: "SKA split", numTxns: []int{100, 450, 450}},
+}
+
+// newBenchTemplateHarness returns a mining harness whose tx source is
+// populated with signed transactions of the provided composition.  Each
+// transaction spends a separate fake utxo of its coin type and pays a fee in
+// it.
+func newBenchTemplateHarness(b *testing.B, numTxns []int) *miningHarness {
+	b.Helper()
+
+	params := chaincfg.MainNetParams()
+	for _, config := range params.SKACoins {
+		config.Active = true
+	}
+	harness, _, err := newMiningHarness(params)
+	if err != nil {
+		b.Fatalf("error creating mining harness: %v", err)
+	}
+
+	const amount = 1e8
+	const fee = 1e5
+	var nonce uint32
+	for coinType, count := range numTxns {
+		coinType := cointype.CoinType(coinType)
+
+		// Create a fake mined transaction with an output for each of the
+		// transactions of the coin type to spend.
+		var prevHash chainhash.Hash
+		prevHash[0] = byte(nonce)
+		nonce++
+		funding := wire.NewMsgTx()
+		funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0,
+			wire.TxTreeRegular), int64(count)*amount, nil))
+		for i := 0; i < count; i++ {
+			funding.AddTxOut(&wire.TxOut{
+				Value:    amount,
+				CoinType: coinType,
+				Version:  harness.payScriptVer,
+				PkScript: harness.payScript,
+			})
+		}
+		fundingTx := dcrutil.NewTx(funding)
+		harness.AddFakeUTXO(fundingTx, harness.chain.bestState.Height, 1,
+			harness.chain.isTreasuryAgendaActive)
+
+		for i := 0; i < count; i++ {
+			out := txOutToSpendableOut(fundingTx, uint32(i),
+				wire.TxTreeRegular)
+			tx, err := harness.CreateSignedTx([]spendableOutput{out}, 1,
+				func(tx *wire.MsgTx) {
+					tx.TxOut[0].Value -= fee
+					tx.TxOut[0].CoinType = coinType
+				})
+			if err != nil {
+				b.Fatalf("unable to create transaction: %v", err)
+			}
+			_, err = harness.AddTransactionToTxSource(tx)
+			if err != nil {
+				b.Fatalf("unable to add transaction to the tx source: %v",
+					err)
+			}
+		}
+	}
+
+	return harness
+}
+
+// BenchmarkNewBlockTemplate benchmarks generating block templates from source
+// pools with various mixes of VAR and SKA transactions.
+func BenchmarkNewBlockTemplate(b *testing.B) {
+	for _, comp := range benchTemplateCompositions {
+		harness := newBenchTemplateHarness(b, comp.numTxns)
+
+		b.Run(comp.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := harness.generator.NewBlockTemplate(harness.payAddr)
+				if err != nil {
+					b.Fatalf("unexpected err generating block template: %v",
+						err)
+				}
+			}
+		})
+	}
+}
@@ -78,6 +78,8 @@ type fakeChain struct {
 	isSubsidySplitR2AgendaActiveErr    error
 	maxTreasuryExpenditure             int64
 	maxTreasuryExpenditureErr          error
+	maxBlockSize                       int64
+	maxBlockSizeErr                    error
 	parentUtxos                        *blockchain.UtxoViewpoint
 	tipGeneration                      []chainhash.Hash
 	utxos                              *blockchain.UtxoViewpoint
@@ -247,6 +249,12 @@ func (c *fakeChain) MaxTreasuryExpenditure(preTVIBlock *chainhash.Hash) (int64,
 	return c.maxTreasuryExpenditure, c.maxTreasuryExpenditureErr
 }
 
+// MaxBlockSize returns a mocked maximum permitted size of the block AFTER the
+// given block hash.
+func (c *fakeChain) MaxBlockSize(hash *chainhash.Hash) (int64, error) {
+	return c.maxBlockSize, c.maxBlockSizeErr
+}
+
 // NewUtxoViewpoint returns a new empty unspent transaction output view.
 func (c *fakeChain) NewUtxoViewpoint() *blockchain.UtxoViewpoint {
 	return blockchain.NewUtxoViewpoint(nil)
@@ -1376,6 +1384,7 @@ func newMiningHarness(chainParams *chaincfg.Params) (*miningHarness, []spendable
 		blocks:                          make(map[chainhash.Hash]*dcrutil.Block),
 		isHeaderCommitmentsAgendaActive: true,
 		isTreasuryAgendaActive:          true,
+		maxBlockSize:                    int64(chainParams.MaximumBlockSizes[0]),
 		parentUtxos:                     blockchain.NewUtxoViewpoint(nil),
 		utxos:                           blockchain.NewUtxoViewpoint(nil),
 	}
@@ -1484,6 +1493,7 @@ func newMiningHarness(chainParams *chaincfg.Params) (*miningHarness, []spendable
 			IsSubsidySplitAgendaActive:      chain.IsSubsidySplitAgendaActive,
 			IsSubsidySplitR2AgendaActive:    chain.IsSubsidySplitR2AgendaActive,
 			MaxTreasuryExpenditure:          chain.MaxTreasuryExpenditure,
+			MaxBlockSize:                    chain.MaxBlockSize,
 			NewUtxoViewpoint:                chain.NewUtxoViewpoint,
 			TipGeneration:                   chain.TipGeneration,
 			ValidateTransactionScripts: func(tx *dcrutil.Tx,
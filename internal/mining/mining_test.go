@@ -9,10 +9,14 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/monetarium/monetarium-node/blockchain/stake"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
 	"github.com/monetarium/monetarium-node/internal/blockchain"
 	"github.com/monetarium/monetarium-node/txscript"
 	"github.com/monetarium/monetarium-node/txscript/stdaddr"
@@ -196,6 +200,56 @@ func TestNewBlockTemplate(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error when checking block sanity: %v", err)
 	}
+
+	// Ensure templates that violate the block space allocation for the
+	// consensus maximum block size are not handed out even though they fit
+	// within the size they were assembled with.
+	harness.chain.maxBlockSize = 1000
+	_, err = harness.generator.NewBlockTemplate(address)
+	if !errors.Is(err, ErrBlockSpaceAllocation) {
+		t.Fatalf("unexpected error for template violating allocation -- got "+
+			"%v, want %v", err, ErrBlockSpaceAllocation)
+	}
+	harness.chain.maxBlockSize = int64(harness.chainParams.MaximumBlockSizes[0])
+
+	// Ensure templates that fail the consensus checks are not handed out.
+	harness.chain.checkConnectBlockTemplateErr = errors.New("bad template")
+	_, err = harness.generator.NewBlockTemplate(address)
+	if !errors.Is(err, ErrCheckConnectBlock) {
+		t.Fatalf("unexpected error for template failing consensus checks -- "+
+			"got %v, want %v", err, ErrCheckConnectBlock)
+	}
+	harness.chain.checkConnectBlockTemplateErr = nil
+
+	// Ensure a partially filled template that only contains the stake
+	// transactions is delivered once the time budget is exhausted.
+	harness.generator.cfg.Policy.TemplateTimeBudget = time.Nanosecond
+	blockTemplate, err = harness.generator.NewBlockTemplate(address)
+	if err != nil {
+		t.Fatalf("unexpected err generating partial block template: %v", err)
+	}
+	if !blockTemplate.Partial {
+		t.Fatal("block template exceeding the time budget is not partial")
+	}
+	gotTx = len(blockTemplate.Block.Transactions)
+	if gotTx != 1 {
+		t.Fatalf("unexpected number of transactions in partial template -- "+
+			"got %v, want 1", gotTx)
+	}
+	gotStx = len(blockTemplate.Block.STransactions)
+	if gotStx != numVotes+1 {
+		t.Fatalf("unexpected number of stake transactions in partial "+
+			"template -- got %v, want %v", gotStx, numVotes+1)
+	}
+	wantStats := TemplateBudgetStats{
+		Templates:        2,
+		PartialTemplates: 1,
+		SkippedTxns:      numTxs - numVotes,
+	}
+	if stats := harness.generator.TemplateBudgetStats(); stats != wantStats {
+		t.Fatalf("unexpected template budget stats -- got %+v, want %+v",
+			stats, wantStats)
+	}
 }
 
 // TestNewBlockTemplateAutoRevocations tests the generation of a new block with
@@ -539,6 +593,92 @@ func TestNewBlockTemplateAutoRevocationsVotesOnly(t *testing.T) {
 	}
 }
 
+// TestEstimateStakeTreeSize ensures the stake tree size estimate only counts
+// the stake transactions that are able to be included in the next block.
+func TestEstimateStakeTreeSize(t *testing.T) {
+	params := chaincfg.RegNetParams()
+	prevHash := chainhash.Hash{0x01}
+	otherHash := chainhash.Hash{0x02}
+
+	newTxDesc := func(txType stake.TxType, pkScript []byte) *TxDesc {
+		msgTx := wire.NewMsgTx()
+		msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 0, nil))
+		msgTx.AddTxOut(wire.NewTxOut(0, pkScript))
+		return &TxDesc{Tx: dcrutil.NewTx(msgTx), Type: txType}
+	}
+	newVote := func(blockHash *chainhash.Hash) *TxDesc {
+		pkScript := make([]byte, 38)
+		pkScript[0] = txscript.OP_RETURN
+		pkScript[1] = txscript.OP_DATA_36
+		copy(pkScript[2:34], blockHash[:])
+		binary.LittleEndian.PutUint32(pkScript[34:38], 1)
+		return newTxDesc(stake.TxTypeSSGen, pkScript)
+	}
+	txSize := func(txDesc *TxDesc) uint32 {
+		return uint32(txDesc.Tx.MsgTx().SerializeSize())
+	}
+
+	// Create more votes on the previous block than are allowed in a block
+	// along with a vote on another block, a ticket, a treasury spend and a
+	// regular transaction.
+	var txDescs []*TxDesc
+	for i := 0; i <= int(params.TicketsPerBlock); i++ {
+		txDescs = append(txDescs, newVote(&prevHash))
+	}
+	txDescs = append(txDescs, newVote(&otherHash))
+	ticket := newTxDesc(stake.TxTypeSStx, []byte{txscript.OP_TRUE})
+	tspend := newTxDesc(stake.TxTypeTSpend, []byte{txscript.OP_TRUE, txscript.OP_TRUE})
+	regular := newTxDesc(stake.TxTypeRegular, []byte{txscript.OP_TRUE})
+	txDescs = append(txDescs, ticket, tspend, regular)
+
+	want := uint32(params.TicketsPerBlock)*txSize(txDescs[0]) + txSize(ticket)
+	got := estimateStakeTreeSize(txDescs, &prevHash, params, false)
+	if got != want {
+		t.Fatalf("unexpected estimate: got %d, want %d", got, want)
+	}
+
+	// Treasury spends are only counted on a treasury vote interval.
+	want += txSize(tspend)
+	got = estimateStakeTreeSize(txDescs, &prevHash, params, true)
+	if got != want {
+		t.Fatalf("unexpected estimate on TVI: got %d, want %d", got, want)
+	}
+}
+
+// TestCalcFeePerKbWeight ensures the fee per kilobyte used to order
+// transactions is the fee of the transaction and its ancestors per thousand
+// weight units of their coin type.
+func TestCalcFeePerKbWeight(t *testing.T) {
+	msgTx := wire.NewMsgTx()
+	msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 0, nil))
+	msgTx.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_TRUE}))
+	txDesc := &TxDesc{Tx: dcrutil.NewTx(msgTx), Fee: 1000}
+	txSize := int64(msgTx.SerializeSize())
+	ancestorStats := &TxAncestorStats{Fees: 3000, SizeBytes: 3 * txSize}
+
+	const skaCoinType = cointype.CoinType(1)
+	weight := blockalloc.CoinTypeWeight(map[cointype.CoinType]uint32{
+		skaCoinType: 2 * blockalloc.UnitWeightBasisPoints,
+	})
+	tests := []struct {
+		name     string
+		coinType cointype.CoinType
+		weight   blockalloc.WeightFunc
+		want     float64
+	}{
+		{"bytes", skaCoinType, blockalloc.ByteWeight, 4000 * 1000 / float64(4*txSize)},
+		{"unweighted coin type", cointype.CoinTypeVAR, weight, 4000 * 1000 / float64(4*txSize)},
+		{"weighted coin type", skaCoinType, weight, 4000 * 1000 / float64(8*txSize)},
+	}
+	for _, test := range tests {
+		got := calcFeePerKb(txDesc, ancestorStats, test.coinType, test.weight)
+		if got != test.want {
+			t.Errorf("%s: got fee per kb %v, want %v", test.name, got,
+				test.want)
+		}
+	}
+}
+
 // TestSortParentsByVotes ensures the function that sorts parent blocks based on
 // the number of votes available for them and the current tip block works as
 // intended, including reorg prevention for an equal number of votes.
@@ -689,3 +829,47 @@ func TestSortParentsByVotes(t *testing.T) {
 		}
 	}
 }
+
+// TestOrderedSKAEmissions ensures SKA emissions are selected from a list of
+// transactions and ordered by the coin type they emit.
+func TestOrderedSKAEmissions(t *testing.T) {
+	// newTx returns a transaction with a single output of the provided coin
+	// type that is an SKA emission when requested.
+	newTx := func(coinType cointype.CoinType, isEmission bool) *dcrutil.Tx {
+		var sigScript []byte
+		prevOut := wire.OutPoint{Hash: chainhash.Hash{0x01}}
+		if isEmission {
+			sigScript = []byte{0x01, 'S', 'K', 'A', byte(coinType)}
+			prevOut = wire.OutPoint{Index: wire.MaxPrevOutIndex}
+		}
+		msgTx := wire.NewMsgTx()
+		msgTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: prevOut,
+			SignatureScript:  sigScript,
+		})
+		msgTx.AddTxOut(&wire.TxOut{Value: 1, CoinType: coinType})
+		tx := dcrutil.NewTx(msgTx)
+		tx.SetTree(wire.TxTreeRegular)
+		return tx
+	}
+
+	regularVAR := newTx(cointype.CoinTypeVAR, false)
+	regularSKA := newTx(1, false)
+	emission1 := newTx(1, true)
+	emission2 := newTx(2, true)
+	emission3 := newTx(3, true)
+
+	txns := []*dcrutil.Tx{regularVAR, emission3, regularSKA, emission1,
+		emission2}
+	got := orderedSKAEmissions(txns)
+	want := []*dcrutil.Tx{emission1, emission2, emission3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected emissions: got %d txns, want %d", len(got),
+			len(want))
+	}
+
+	// Ensure there are no emissions when none are in the list.
+	if got := orderedSKAEmissions([]*dcrutil.Tx{regularVAR, regularSKA}); len(got) != 0 {
+		t.Fatalf("unexpected emissions: got %d txns, want none", len(got))
+	}
+}
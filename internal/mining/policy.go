@@ -6,7 +6,11 @@
 package mining
 
 import (
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
 	"github.com/monetarium/monetarium-node/txscript"
 	"github.com/monetarium/monetarium-node/wire"
 )
@@ -33,6 +37,29 @@ type Policy struct {
 
 	AggressiveMining bool
 
+	// TemplateTimeBudget is the maximum amount of time to spend assembling a
+	// block template.  Once it is exceeded, no further regular transactions
+	// are added and SSFee transactions are created without looking up
+	// existing UTXOs to augment, so a partially filled, but valid, template
+	// is delivered instead of delaying work for miners.  A value of zero
+	// means there is no limit.
+	TemplateTimeBudget time.Duration
+
+	// SSFeeConsolidationIntervals defines the number of blocks between the
+	// heights at which the SSFee transactions of each coin type consolidate
+	// the existing SSFee UTXOs of their addresses by augmenting them.  SSFee
+	// transactions create new UTXOs at all other heights.  Coin types without
+	// an interval, or with an interval of 0 or 1, are consolidated in every
+	// block.
+	SSFeeConsolidationIntervals map[cointype.CoinType]uint32
+
+	// TxWeight defines the cost, in weight units, of the bytes of the
+	// transactions of each coin type.  Block templates charge transactions
+	// their weight against the allocation of their coin type, in addition to
+	// their bytes, and order them by the fees they pay per thousand weight
+	// units.  Nil charges one unit per byte regardless of the coin type.
+	TxWeight blockalloc.WeightFunc
+
 	// StandardVerifyFlags defines the function to retrieve the flags to
 	// use for verifying scripts for the block after the current best block.
 	// It must set the verification flags properly depending on the result
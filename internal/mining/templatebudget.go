@@ -0,0 +1,114 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// templateBudget tracks the time spent assembling a block template against
+// the time budget configured by the mining policy.  Once the budget is
+// exhausted, the remaining optional work such as adding further regular
+// transactions and looking up SSFee UTXOs to augment is skipped so that a
+// partially filled, but still valid, template is delivered to miners without
+// further delay.
+type templateBudget struct {
+	deadline  time.Time
+	exhausted bool
+
+	// skippedTxns is the number of regular transactions that were not
+	// considered for inclusion because the budget was exhausted.
+	skippedTxns uint64
+
+	// skippedAugment is whether or not the SSFee transactions of the
+	// template were created without augmenting existing UTXOs because the
+	// budget was exhausted.
+	skippedAugment bool
+}
+
+// newTemplateBudget returns a budget that is exhausted once the provided
+// duration has elapsed after the provided start time.  A duration of zero
+// means the budget is never exhausted.
+func newTemplateBudget(budget time.Duration, start time.Time) *templateBudget {
+	var deadline time.Time
+	if budget > 0 {
+		deadline = start.Add(budget)
+	}
+	return &templateBudget{deadline: deadline}
+}
+
+// isExhausted returns whether or not the time budget has been exhausted.  The
+// budget remains exhausted once it has been exhausted.
+func (b *templateBudget) isExhausted() bool {
+	if b.exhausted || b.deadline.IsZero() {
+		return b.exhausted
+	}
+	if !time.Now().Before(b.deadline) {
+		log.Debugf("Block template time budget exhausted -- delivering a " +
+			"partially filled template")
+		b.exhausted = true
+	}
+	return b.exhausted
+}
+
+// isPartial returns whether or not any work was skipped due to the budget
+// being exhausted.
+func (b *templateBudget) isPartial() bool {
+	return b.skippedTxns > 0 || b.skippedAugment
+}
+
+// TemplateBudgetStats houses statistics about how often block templates were
+// delivered partially filled because their time budget was exhausted.
+type TemplateBudgetStats struct {
+	// Templates is the total number of block templates generated.
+	Templates uint64
+
+	// PartialTemplates is the number of block templates that were delivered
+	// partially filled because the time budget was exhausted.
+	PartialTemplates uint64
+
+	// SkippedTxns is the total number of regular transactions that were not
+	// considered for inclusion because the time budget was exhausted.
+	SkippedTxns uint64
+
+	// SkippedAugments is the number of block templates whose SSFee
+	// transactions were created without augmenting existing UTXOs because the
+	// time budget was exhausted.
+	SkippedAugments uint64
+}
+
+// templateBudgetStats tracks the statistics about the time budget of the
+// generated block templates.  It is safe for concurrent access.
+type templateBudgetStats struct {
+	templates        atomic.Uint64
+	partialTemplates atomic.Uint64
+	skippedTxns      atomic.Uint64
+	skippedAugments  atomic.Uint64
+}
+
+// record updates the statistics with the provided budget of a generated block
+// template.
+func (s *templateBudgetStats) record(b *templateBudget) {
+	s.templates.Add(1)
+	if !b.isPartial() {
+		return
+	}
+	s.partialTemplates.Add(1)
+	s.skippedTxns.Add(b.skippedTxns)
+	if b.skippedAugment {
+		s.skippedAugments.Add(1)
+	}
+}
+
+// snapshot returns the current statistics.
+func (s *templateBudgetStats) snapshot() TemplateBudgetStats {
+	return TemplateBudgetStats{
+		Templates:        s.templates.Load(),
+		PartialTemplates: s.partialTemplates.Load(),
+		SkippedTxns:      s.skippedTxns.Load(),
+		SkippedAugments:  s.skippedAugments.Load(),
+	}
+}
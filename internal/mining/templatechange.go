@@ -0,0 +1,149 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TemplateBucket summarizes the regular transactions of a single coin type in a
+// block template.
+type TemplateBucket struct {
+	// NumTxns is the number of regular transactions of the coin type in the
+	// template, excluding SKA emissions.
+	NumTxns int
+
+	// TopFeeRate is the highest fee rate, in atoms of the coin type per KB,
+	// paid by a regular transaction of the coin type in the template.
+	TopFeeRate int64
+}
+
+// TemplateBuckets summarizes the per coin type buckets of the regular
+// transaction tree of a block template so that subsequent templates can be
+// compared to determine whether or not they changed materially for miners
+// that are only interested in some coin types.
+type TemplateBuckets struct {
+	// Buckets houses the summary of each coin type that has at least one
+	// regular transaction in the template.
+	Buckets map[cointype.CoinType]TemplateBucket
+
+	// Emissions houses the hashes of the SKA emission transactions in the
+	// template along with the coin type they emit.
+	Emissions map[chainhash.Hash]cointype.CoinType
+}
+
+// SummarizeTemplateBuckets returns the per coin type bucket summary of the
+// regular transaction tree of the provided block template.
+func SummarizeTemplateBuckets(template *BlockTemplate) *TemplateBuckets {
+	summary := &TemplateBuckets{
+		Buckets:   make(map[cointype.CoinType]TemplateBucket),
+		Emissions: make(map[chainhash.Hash]cointype.CoinType),
+	}
+
+	// Skip the coinbase since it does not pay a fee.  The fees of the
+	// regular transactions are in the same order as the transactions.
+	txns := template.Block.Transactions
+	for i := 1; i < len(txns); i++ {
+		tx := txns[i]
+		if wire.IsSKAEmissionTransaction(tx) {
+			var coinType cointype.CoinType
+			if len(tx.TxOut) > 0 {
+				coinType = tx.TxOut[0].CoinType
+			}
+			summary.Emissions[tx.TxHash()] = coinType
+			continue
+		}
+
+		coinType := blockalloc.GetTransactionCoinType(dcrutil.NewTx(tx))
+		bucket := summary.Buckets[coinType]
+		bucket.NumTxns++
+		if i < len(template.Fees) {
+			size := int64(tx.SerializeSize())
+			feeRate := template.Fees[i] * 1000 / size
+			if feeRate > bucket.TopFeeRate {
+				bucket.TopFeeRate = feeRate
+			}
+		}
+		summary.Buckets[coinType] = bucket
+	}
+
+	return summary
+}
+
+// MateriallyChanged returns whether or not the buckets of the provided coin
+// types changed materially from the provided previous summary.  All coin types
+// are considered when none are provided.
+//
+// A bucket changed materially when an SKA emission of its coin type was added
+// or removed, when it became populated or empty, or when its top fee rate moved
+// by more than the provided percentage of the previous top fee rate.  A nil
+// previous summary is always considered a material change.
+func (b *TemplateBuckets) MateriallyChanged(prev *TemplateBuckets, coinTypes []cointype.CoinType, thresholdPct uint32) bool {
+	if prev == nil {
+		return true
+	}
+
+	relevant := func(coinType cointype.CoinType) bool {
+		if len(coinTypes) == 0 {
+			return true
+		}
+		for _, ct := range coinTypes {
+			if ct == coinType {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Any relevant emission that was added or removed is a material change.
+	for hash, coinType := range b.Emissions {
+		if _, ok := prev.Emissions[hash]; !ok && relevant(coinType) {
+			return true
+		}
+	}
+	for hash, coinType := range prev.Emissions {
+		if _, ok := b.Emissions[hash]; !ok && relevant(coinType) {
+			return true
+		}
+	}
+
+	// changed returns whether or not the bucket of the provided coin type
+	// changed materially.
+	changed := func(coinType cointype.CoinType) bool {
+		cur, curOk := b.Buckets[coinType]
+		old, oldOk := prev.Buckets[coinType]
+		if curOk != oldOk {
+			return true
+		}
+		diff := cur.TopFeeRate - old.TopFeeRate
+		if diff < 0 {
+			diff = -diff
+		}
+		return diff*100 > old.TopFeeRate*int64(thresholdPct)
+	}
+	if len(coinTypes) != 0 {
+		for _, coinType := range coinTypes {
+			if changed(coinType) {
+				return true
+			}
+		}
+		return false
+	}
+	for coinType := range b.Buckets {
+		if changed(coinType) {
+			return true
+		}
+	}
+	for coinType := range prev.Buckets {
+		if _, ok := b.Buckets[coinType]; !ok {
+			return true
+		}
+	}
+	return false
+}
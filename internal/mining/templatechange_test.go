@@ -0,0 +1,202 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mining
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestSummarizeTemplateBuckets ensures the regular transactions of a block
+// template are summarized into the expected per coin type buckets.
+func TestSummarizeTemplateBuckets(t *testing.T) {
+	t.Parallel()
+
+	newTx := func(prevHash byte, coinType cointype.CoinType) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+			Hash: chainhash.Hash{prevHash},
+		}, 0, nil))
+		tx.AddTxOut(wire.NewTxOutWithCoinType(1000, coinType, []byte{0x51}))
+		return tx
+	}
+	coinbase := wire.NewMsgTx()
+	coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		0, nil))
+	coinbase.AddTxOut(wire.NewTxOut(0, []byte{0x51}))
+	emission := wire.NewMsgTx()
+	emission.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		0, []byte{0x01, 0x53, 0x4b, 0x41}))
+	emission.AddTxOut(wire.NewTxOutWithCoinType(1000, 2, []byte{0x51}))
+	varTx := newTx(0x01, cointype.CoinTypeVAR)
+	skaTx1 := newTx(0x02, 1)
+	skaTx2 := newTx(0x03, 1)
+
+	template := &BlockTemplate{
+		Block: &wire.MsgBlock{
+			Transactions: []*wire.MsgTx{coinbase, emission, varTx, skaTx1,
+				skaTx2},
+		},
+		Fees: []int64{-7000, 0, 1000, 2000, 4000},
+	}
+	summary := SummarizeTemplateBuckets(template)
+
+	if len(summary.Emissions) != 1 || summary.Emissions[emission.TxHash()] != 2 {
+		t.Fatalf("unexpected emissions: %v", summary.Emissions)
+	}
+	size := int64(varTx.SerializeSize())
+	want := map[cointype.CoinType]TemplateBucket{
+		cointype.CoinTypeVAR: {NumTxns: 1, TopFeeRate: 1000 * 1000 / size},
+		1:                    {NumTxns: 2, TopFeeRate: 4000 * 1000 / size},
+	}
+	if len(summary.Buckets) != len(want) {
+		t.Fatalf("unexpected buckets: %v", summary.Buckets)
+	}
+	for coinType, wantBucket := range want {
+		if got := summary.Buckets[coinType]; got != wantBucket {
+			t.Fatalf("unexpected bucket for coin type %d -- got %+v, want "+
+				"%+v", coinType, got, wantBucket)
+		}
+	}
+}
+
+// TestTemplateBucketsMateriallyChanged ensures material changes to the buckets
+// of block templates are detected as expected.
+func TestTemplateBucketsMateriallyChanged(t *testing.T) {
+	t.Parallel()
+
+	emission := chainhash.Hash{0x01}
+	base := &TemplateBuckets{
+		Buckets: map[cointype.CoinType]TemplateBucket{
+			cointype.CoinTypeVAR: {NumTxns: 10, TopFeeRate: 10000},
+			1:                    {NumTxns: 5, TopFeeRate: 20000},
+		},
+		Emissions: map[chainhash.Hash]cointype.CoinType{},
+	}
+	modify := func(f func(b *TemplateBuckets)) *TemplateBuckets {
+		b := &TemplateBuckets{
+			Buckets:   make(map[cointype.CoinType]TemplateBucket),
+			Emissions: make(map[chainhash.Hash]cointype.CoinType),
+		}
+		for coinType, bucket := range base.Buckets {
+			b.Buckets[coinType] = bucket
+		}
+		for hash, coinType := range base.Emissions {
+			b.Emissions[hash] = coinType
+		}
+		f(b)
+		return b
+	}
+
+	tests := []struct {
+		name      string
+		cur       *TemplateBuckets
+		prev      *TemplateBuckets
+		coinTypes []cointype.CoinType
+		threshold uint32
+		want      bool
+	}{{
+		name:      "no previous summary",
+		cur:       base,
+		threshold: 10,
+		want:      true,
+	}, {
+		name:      "unchanged",
+		cur:       modify(func(b *TemplateBuckets) {}),
+		prev:      base,
+		threshold: 10,
+		want:      false,
+	}, {
+		name: "top fee rate moved within threshold",
+		cur: modify(func(b *TemplateBuckets) {
+			b.Buckets[1] = TemplateBucket{NumTxns: 50, TopFeeRate: 22000}
+		}),
+		prev:      base,
+		threshold: 10,
+		want:      false,
+	}, {
+		name: "top fee rate moved beyond threshold",
+		cur: modify(func(b *TemplateBuckets) {
+			b.Buckets[1] = TemplateBucket{NumTxns: 5, TopFeeRate: 17000}
+		}),
+		prev:      base,
+		threshold: 10,
+		want:      true,
+	}, {
+		name: "irrelevant top fee rate moved beyond threshold",
+		cur: modify(func(b *TemplateBuckets) {
+			b.Buckets[1] = TemplateBucket{NumTxns: 500, TopFeeRate: 90000}
+		}),
+		prev:      base,
+		coinTypes: []cointype.CoinType{cointype.CoinTypeVAR},
+		threshold: 10,
+		want:      false,
+	}, {
+		name: "relevant bucket emptied",
+		cur: modify(func(b *TemplateBuckets) {
+			delete(b.Buckets, 1)
+		}),
+		prev:      base,
+		coinTypes: []cointype.CoinType{1},
+		threshold: 10,
+		want:      true,
+	}, {
+		name: "bucket populated",
+		cur: modify(func(b *TemplateBuckets) {
+			b.Buckets[2] = TemplateBucket{NumTxns: 1, TopFeeRate: 1}
+		}),
+		prev:      base,
+		threshold: 10,
+		want:      true,
+	}, {
+		name: "relevant emission added",
+		cur: modify(func(b *TemplateBuckets) {
+			b.Emissions[emission] = 2
+		}),
+		prev:      base,
+		coinTypes: []cointype.CoinType{2},
+		threshold: 10,
+		want:      true,
+	}, {
+		name: "irrelevant emission added",
+		cur: modify(func(b *TemplateBuckets) {
+			b.Emissions[emission] = 2
+		}),
+		prev:      base,
+		coinTypes: []cointype.CoinType{cointype.CoinTypeVAR, 1},
+		threshold: 10,
+		want:      false,
+	}, {
+		name: "relevant emission removed",
+		cur:  base,
+		prev: modify(func(b *TemplateBuckets) {
+			b.Emissions[emission] = 1
+		}),
+		coinTypes: []cointype.CoinType{1},
+		threshold: 10,
+		want:      true,
+	}, {
+		name: "any fee rate move with zero threshold",
+		cur: modify(func(b *TemplateBuckets) {
+			b.Buckets[cointype.CoinTypeVAR] = TemplateBucket{NumTxns: 10,
+				TopFeeRate: 10001}
+		}),
+		prev:      base,
+		threshold: 0,
+		want:      true,
+	}}
+
+	for _, test := range tests {
+		got := test.cur.MateriallyChanged(test.prev, test.coinTypes,
+			test.threshold)
+		if got != test.want {
+			t.Errorf("%q: unexpected result -- got %v, want %v", test.name,
+				got, test.want)
+		}
+	}
+}
@@ -24,6 +24,39 @@ type txPrioItem struct {
 	priority       float64
 	feePerKB       float64
 	coinType       cointype.CoinType // Primary coin type for this transaction
+	blocksWaited   int64             // Blocks the transaction has waited in the source pool
+}
+
+const (
+	// skaAgingRatePerBlock is the fraction of its fee per kilobyte that an SKA
+	// transaction gains in effective fee per kilobyte for every block it has
+	// waited for inclusion.
+	skaAgingRatePerBlock = 0.05
+
+	// skaMaxAgingBlocks is the maximum number of waited blocks that count
+	// towards the effective fee per kilobyte of an SKA transaction.  It bounds
+	// the aging boost so long-waiting transactions can never outrank fresh
+	// transactions that pay more than twice their fee rate.
+	skaMaxAgingBlocks = 20
+)
+
+// effectiveFeePerKB returns the fee per kilobyte used to order the item among
+// transactions of the same stake priority.
+//
+// SKA transactions compete for a constrained share of the block, so their fee
+// per kilobyte is boosted by the number of blocks they have waited in order to
+// prevent a persistent stream of slightly higher fee transactions from starving
+// older ones indefinitely.  All other transactions are ordered by their actual
+// fee per kilobyte.
+func (item *txPrioItem) effectiveFeePerKB() float64 {
+	if !item.coinType.IsSKA() || item.blocksWaited <= 0 {
+		return item.feePerKB
+	}
+	blocksWaited := item.blocksWaited
+	if blocksWaited > skaMaxAgingBlocks {
+		blocksWaited = skaMaxAgingBlocks
+	}
+	return item.feePerKB * (1 + skaAgingRatePerBlock*float64(blocksWaited))
 }
 
 // txPriorityQueueLessFunc describes a function that can be used as a compare
@@ -146,13 +179,15 @@ func txPQByStakeAndFee(pq *txPriorityQueue, i, j int) bool {
 
 	// Using > here so that pop gives the highest fee item as opposed
 	// to the lowest.  Sort by fee first, then priority.
-	if pq.items[i].feePerKB == pq.items[j].feePerKB {
+	iFeePerKB := pq.items[i].effectiveFeePerKB()
+	jFeePerKB := pq.items[j].effectiveFeePerKB()
+	if iFeePerKB == jFeePerKB {
 		return pq.items[i].priority > pq.items[j].priority
 	}
 
 	// The stake priorities are equal, so return based on fees
 	// per KB.
-	return pq.items[i].feePerKB > pq.items[j].feePerKB
+	return iFeePerKB > jFeePerKB
 }
 
 // txPQByCoinTypeAndFee sorts a txPriorityQueue by stake priority, then by
@@ -171,12 +206,14 @@ func txPQByCoinTypeAndFee(pq *txPriorityQueue, i, j int) bool {
 	// For equal stake priorities, use coin-type-adjusted fee rates
 	// This allows each coin type to have its own fee market dynamics
 	// while maintaining overall transaction ordering fairness
-	if pq.items[i].feePerKB == pq.items[j].feePerKB {
+	iFeePerKB := pq.items[i].effectiveFeePerKB()
+	jFeePerKB := pq.items[j].effectiveFeePerKB()
+	if iFeePerKB == jFeePerKB {
 		return pq.items[i].priority > pq.items[j].priority
 	}
 
 	// Return based on coin-type-adjusted fees per KB
-	return pq.items[i].feePerKB > pq.items[j].feePerKB
+	return iFeePerKB > jFeePerKB
 }
 
 // newTxPriorityQueue returns a new transaction priority queue that reserves the
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/cointype"
 )
 
 // TestStakeTxFeePrioHeap tests the priority heap including the stake types for
@@ -76,3 +77,66 @@ func TestStakeTxFeePrioHeap(t *testing.T) {
 		}
 	}
 }
+
+// TestSKAPriorityAging ensures SKA transactions gain effective fee per KB the
+// longer they wait so they are eventually ordered ahead of newer transactions
+// paying slightly higher fees, that the boost is capped, and that VAR
+// transactions are not affected.
+func TestSKAPriorityAging(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []*txPrioItem
+		wantNext int // index of the item expected to be popped first
+	}{{
+		name: "new SKA tx with slightly higher fee first without aging",
+		items: []*txPrioItem{
+			{feePerKB: 1000, coinType: 1},
+			{feePerKB: 1100, coinType: 1},
+		},
+		wantNext: 1,
+	}, {
+		name: "old SKA tx overtakes new SKA tx with slightly higher fee",
+		items: []*txPrioItem{
+			{feePerKB: 1000, coinType: 1, blocksWaited: 5},
+			{feePerKB: 1100, coinType: 1},
+		},
+		wantNext: 0,
+	}, {
+		name: "aging boost is capped",
+		items: []*txPrioItem{
+			{feePerKB: 1000, coinType: 1, blocksWaited: 1000},
+			{feePerKB: 2100, coinType: 1},
+		},
+		wantNext: 1,
+	}, {
+		name: "VAR tx does not age",
+		items: []*txPrioItem{
+			{feePerKB: 1000, coinType: cointype.CoinTypeVAR, blocksWaited: 5},
+			{feePerKB: 1100, coinType: cointype.CoinTypeVAR},
+		},
+		wantNext: 1,
+	}, {
+		name: "aging does not override stake priority",
+		items: []*txPrioItem{
+			{feePerKB: 1000, coinType: 1, blocksWaited: 20},
+			{txType: stake.TxTypeSSGen},
+		},
+		wantNext: 1,
+	}}
+
+	for _, test := range tests {
+		lessFuncs := []txPriorityQueueLessFunc{txPQByStakeAndFee,
+			txPQByCoinTypeAndFee}
+		for _, lessFunc := range lessFuncs {
+			pq := newTxPriorityQueue(len(test.items), lessFunc)
+			for _, item := range test.items {
+				heap.Push(pq, item)
+			}
+			got := heap.Pop(pq).(*txPrioItem)
+			if got != test.items[test.wantNext] {
+				t.Errorf("%q: unexpected first item -- got %+v, want %+v",
+					test.name, got, test.items[test.wantNext])
+			}
+		}
+	}
+}
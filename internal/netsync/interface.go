@@ -19,4 +19,9 @@ type PeerNotifier interface {
 	// AnnounceMixMessages generates and relays inventory vectors of the
 	// passed messages.
 	AnnounceMixMessages(msgs []mixing.Message)
+
+	// RejectedTransaction notifies that the passed transaction received from
+	// the passed peer was rejected by the transaction pool with the passed
+	// rule error.
+	RejectedTransaction(peer *Peer, tx *dcrutil.Tx, err error)
 }
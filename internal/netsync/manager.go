@@ -147,9 +147,42 @@ type peerDisconnectedMsg struct {
 // txMsg packages a Decred tx message and the peer it came from together
 // so the event handler has access to that information.
 type txMsg struct {
-	tx    *dcrutil.Tx
-	peer  *Peer
-	reply chan struct{}
+	tx     *dcrutil.Tx
+	peer   *Peer
+	reply  chan struct{}
+	queued time.Time
+}
+
+// TxLaneStats houses metrics about the transactions validated by one of the
+// validation lanes of the sync manager.
+type TxLaneStats struct {
+	// Processed is the number of transactions validated by the lane.
+	Processed uint64
+
+	// Pending is the number of transactions waiting in the lane.
+	Pending int
+
+	// TotalWait and MaxWait are the total and maximum time the transactions
+	// validated by the lane waited to be validated.
+	TotalWait time.Duration
+	MaxWait   time.Duration
+}
+
+// record updates the stats with a transaction that waited the provided
+// duration to be validated.
+func (s *TxLaneStats) record(wait time.Duration) {
+	s.Processed++
+	s.TotalWait += wait
+	if wait > s.MaxWait {
+		s.MaxWait = wait
+	}
+}
+
+// isPriorityStakeTx returns whether the provided transaction is a vote or
+// revocation, which are validated in the dedicated stake lane ahead of all
+// other transactions since they are consensus critical and time sensitive.
+func isPriorityStakeTx(msgTx *wire.MsgTx) bool {
+	return stake.IsSSGen(msgTx) || stake.IsSSRtx(msgTx)
 }
 
 // getSyncPeerMsg is a message type to be sent across the message channel for
@@ -213,6 +246,16 @@ type Peer struct {
 	requestedBlocks  map[chainhash.Hash]struct{}
 	requestedMixMsgs map[chainhash.Hash]struct{}
 
+	// requestedStakeTxns tracks the votes and treasury spends requested from
+	// the peer by hash during mining state synchronization.  The peer is not
+	// penalized for not finding them since they are routinely pruned from the
+	// mempool of the peer once they no longer apply to the current tip.
+	//
+	// It is protected by its own mutex since it is queried by the server
+	// while the sync manager updates it.
+	stakeTxnsMtx       sync.Mutex
+	requestedStakeTxns map[chainhash.Hash]struct{}
+
 	// requestInitialStateOnce is used to ensure the initial state data is only
 	// requested from the peer once.
 	requestInitialStateOnce sync.Once
@@ -248,16 +291,49 @@ type Peer struct {
 // NewPeer returns a new instance of a peer that wraps the provided underlying
 // common peer with additional state that is used throughout the package.
 func NewPeer(peer *peerpkg.Peer) *Peer {
-	isSyncCandidate := peer.Services()&wire.SFNodeNetwork == wire.SFNodeNetwork
+	// Only full nodes that negotiated a protocol version that serializes the
+	// coin types of transaction outputs are able to serve blocks containing
+	// SKA transactions, so only consider those to be sync candidates.
+	isFullNode := peer.Services()&wire.SFNodeNetwork == wire.SFNodeNetwork
+	isSyncCandidate := isFullNode &&
+		peer.ProtocolVersion() >= wire.DualCoinVersion
 	return &Peer{
 		Peer:             peer,
 		syncCandidate:    isSyncCandidate,
 		requestedTxns:    make(map[chainhash.Hash]struct{}),
 		requestedBlocks:  make(map[chainhash.Hash]struct{}),
 		requestedMixMsgs: make(map[chainhash.Hash]struct{}),
+
+		requestedStakeTxns: make(map[chainhash.Hash]struct{}),
 	}
 }
 
+// IsRequestedStakeTx returns whether the provided transaction hash is a vote or
+// treasury spend requested from the peer during mining state synchronization
+// that the peer has not yet delivered.
+//
+// This function is safe for concurrent access.
+func (p *Peer) IsRequestedStakeTx(hash *chainhash.Hash) bool {
+	p.stakeTxnsMtx.Lock()
+	_, ok := p.requestedStakeTxns[*hash]
+	p.stakeTxnsMtx.Unlock()
+	return ok
+}
+
+// setRequestedStakeTx adds the provided transaction hash to or removes it from
+// the votes and treasury spends requested from the peer.
+//
+// This function is safe for concurrent access.
+func (p *Peer) setRequestedStakeTx(hash *chainhash.Hash, requested bool) {
+	p.stakeTxnsMtx.Lock()
+	if requested {
+		p.requestedStakeTxns[*hash] = struct{}{}
+	} else {
+		delete(p.requestedStakeTxns, *hash)
+	}
+	p.stakeTxnsMtx.Unlock()
+}
+
 // maybeRequestInitialState potentially requests initial state information from
 // the peer by sending it an appropriate initial state sync message dependending
 // on the protocol version.
@@ -388,10 +464,27 @@ type SyncManager struct {
 	msgChan          chan interface{}
 	peers            map[*Peer]struct{}
 
+	// stakeTxChan is the dedicated lane used to validate votes and
+	// revocations ahead of the transactions and other messages queued on
+	// msgChan so consensus-critical stake transactions never queue behind
+	// bulk transactions such as SKA spam.
+	stakeTxChan chan *txMsg
+
+	// The following fields track the metrics of the stake and regular
+	// transaction validation lanes.
+	laneStatsMtx     sync.Mutex
+	stakeLaneStats   TxLaneStats
+	regularLaneStats TxLaneStats
+
 	// hdrSyncState houses the state used to track the initial header sync
 	// process and related stall handling.
 	hdrSyncState headerSyncState
 
+	// incompatibleErr is set when the best known header chain commits to
+	// consensus rules the local SKA configuration is unable to validate.  No
+	// more blocks are downloaded once it is set.
+	incompatibleErr error
+
 	// The following fields are used to track the height being synced to from
 	// peers.
 	syncHeightMtx sync.Mutex
@@ -480,6 +573,12 @@ func (m *SyncManager) isRequestedBlock(hash *chainhash.Hash) bool {
 // fetchNextBlocks creates and sends a request to the provided peer for the next
 // blocks to be downloaded based on the current headers.
 func (m *SyncManager) fetchNextBlocks(peer *Peer) {
+	// Do not download blocks that the local SKA configuration is unable to
+	// validate.
+	if m.incompatibleErr != nil {
+		return
+	}
+
 	// Nothing to do if the target maximum number of blocks to request from the
 	// peer at the same time are already in flight.
 	numInFlight := len(peer.requestedBlocks)
@@ -839,6 +938,7 @@ func (m *SyncManager) handleTxMsg(tmsg *txMsg) {
 	// we'll retry next time we get an inv.
 	delete(peer.requestedTxns, *txHash)
 	delete(m.requestedTxns, *txHash)
+	peer.setRequestedStakeTx(txHash, false)
 
 	if err != nil {
 		// Do not request this transaction again until a new block has been
@@ -852,6 +952,7 @@ func (m *SyncManager) handleTxMsg(tmsg *txMsg) {
 		var rErr mempool.RuleError
 		if errors.As(err, &rErr) {
 			log.Debugf("Rejected transaction %v from %s: %v", txHash, peer, err)
+			m.cfg.PeerNotifier.RejectedTransaction(peer, tmsg.tx, err)
 		} else {
 			log.Errorf("Failed to process transaction %v: %v", txHash, err)
 		}
@@ -1428,6 +1529,19 @@ func (m *SyncManager) handleHeadersMsg(hmsg *headersMsg) {
 				headersSynced, m.headerSyncProgress)
 			log.Infof("Initial headers sync complete (best header hash %s, "+
 				"height %d)", newBestHeaderHash, newBestHeaderHeight)
+
+			// Stop syncing before downloading any blocks when the header chain
+			// commits to consensus rules that the local SKA configuration is
+			// unable to validate since the blocks would otherwise only be
+			// rejected once they are reached midway through the sync.
+			if err := chain.CheckHeaderSKACompatibility(); err != nil {
+				m.incompatibleErr = err
+				log.Errorf("Unable to sync the chain: %v", err)
+				log.Error("Upgrade the software or correct the SKA " +
+					"configuration and restart to resume syncing")
+				return
+			}
+
 			log.Info("Syncing chain")
 			m.progressLogger.SetLastLogTime(time.Now())
 
@@ -1513,6 +1627,7 @@ func (m *SyncManager) handleNotFoundMsg(nfmsg *notFoundMsg) {
 			if _, exists := peer.requestedTxns[inv.Hash]; exists {
 				delete(peer.requestedTxns, inv.Hash)
 				delete(m.requestedTxns, inv.Hash)
+				peer.setRequestedStakeTx(&inv.Hash, false)
 			}
 		case wire.InvTypeMix:
 			if _, exists := peer.requestedMixMsgs[inv.Hash]; exists {
@@ -1714,6 +1829,22 @@ func limitAdd(m map[chainhash.Hash]struct{}, hash chainhash.Hash, limit int) {
 	m[hash] = struct{}{}
 }
 
+// processTxMsg validates the transaction of the provided message, records the
+// time it waited in the provided lane, and replies to the sender once done.
+func (m *SyncManager) processTxMsg(ctx context.Context, msg *txMsg, lane *TxLaneStats) {
+	wait := time.Since(msg.queued)
+	m.handleTxMsg(msg)
+
+	m.laneStatsMtx.Lock()
+	lane.record(wait)
+	m.laneStatsMtx.Unlock()
+
+	select {
+	case msg.reply <- struct{}{}:
+	case <-ctx.Done():
+	}
+}
+
 // eventHandler is the main handler for the sync manager.  It must be run as a
 // goroutine.  It processes block and inv messages in a separate goroutine from
 // the peer handlers so the block (MsgBlock) messages are handled by a single
@@ -1723,18 +1854,27 @@ func limitAdd(m map[chainhash.Hash]struct{}, hash chainhash.Hash, limit int) {
 func (m *SyncManager) eventHandler(ctx context.Context) {
 out:
 	for {
+		// Validate any pending votes and revocations before handling any
+		// other messages so they never wait behind a backlog of regular
+		// transactions.
+		select {
+		case msg := <-m.stakeTxChan:
+			m.processTxMsg(ctx, msg, &m.stakeLaneStats)
+			continue
+		default:
+		}
+
 		select {
+		case msg := <-m.stakeTxChan:
+			m.processTxMsg(ctx, msg, &m.stakeLaneStats)
+
 		case data := <-m.msgChan:
 			switch msg := data.(type) {
 			case *peerConnectedMsg:
 				m.handlePeerConnectedMsg(ctx, msg.peer)
 
 			case *txMsg:
-				m.handleTxMsg(msg)
-				select {
-				case msg.reply <- struct{}{}:
-				case <-ctx.Done():
-				}
+				m.processTxMsg(ctx, msg, &m.regularLaneStats)
 
 			case *blockMsg:
 				m.handleBlockMsg(msg)
@@ -1832,10 +1972,21 @@ func (m *SyncManager) PeerConnected(peer *Peer) {
 }
 
 // OnTx adds the passed transaction message and peer to the event handling
-// queue.
+// queue.  Votes and revocations are added to the dedicated stake lane that is
+// handled ahead of all other messages.
 func (m *SyncManager) OnTx(tx *dcrutil.Tx, peer *Peer, done chan struct{}) {
+	msg := &txMsg{tx: tx, peer: peer, reply: done, queued: time.Now()}
+	if isPriorityStakeTx(tx.MsgTx()) {
+		select {
+		case m.stakeTxChan <- msg:
+		case <-m.quit:
+			done <- struct{}{}
+		}
+		return
+	}
+
 	select {
-	case m.msgChan <- &txMsg{tx: tx, peer: peer, reply: done}:
+	case m.msgChan <- msg:
 	case <-m.quit:
 		done <- struct{}{}
 	}
@@ -2026,6 +2177,7 @@ func (m *SyncManager) requestFromPeer(peer *Peer, blocks, voteHashes,
 
 			peer.requestedTxns[*tx] = struct{}{}
 			m.requestedTxns[*tx] = struct{}{}
+			peer.setRequestedStakeTx(tx, true)
 		}
 
 		return nil
@@ -2094,6 +2246,23 @@ func (m *SyncManager) ProcessBlock(block *dcrutil.Block) error {
 	}
 }
 
+// TxLaneStats returns the metrics of the stake lane used to validate votes and
+// revocations and the regular lane used to validate all other transactions
+// received from peers.
+//
+// This function is safe for concurrent access.
+func (m *SyncManager) TxLaneStats() (TxLaneStats, TxLaneStats) {
+	m.laneStatsMtx.Lock()
+	stakeLane, regularLane := m.stakeLaneStats, m.regularLaneStats
+	m.laneStatsMtx.Unlock()
+
+	// The regular lane shares its queue with the other messages handled by
+	// the sync manager, so its pending count is an upper bound.
+	stakeLane.Pending = len(m.stakeTxChan)
+	regularLane.Pending = len(m.msgChan)
+	return stakeLane, regularLane
+}
+
 // IsCurrent returns whether or not the sync manager believes it is synced with
 // the connected peers.
 //
@@ -2197,6 +2366,7 @@ func New(config *Config) *SyncManager {
 		hdrSyncState:     makeHeaderSyncState(),
 		progressLogger:   progresslog.New("Processed", log),
 		msgChan:          make(chan interface{}, config.MaxPeers*3),
+		stakeTxChan:      make(chan *txMsg, config.MaxPeers),
 		quit:             make(chan struct{}),
 		syncHeight:       config.Chain.BestSnapshot().Height,
 		isCurrent:        config.Chain.IsCurrent(),
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AuthScope is a set of permission scopes granted to RPC credentials that
+// determines which methods may be called with them.
+type AuthScope uint8
+
+const (
+	// ScopeRead grants access to the methods that are safe for limited users,
+	// which are read-only queries along with the methods that only submit
+	// data to the network.
+	ScopeRead AuthScope = 1 << iota
+
+	// ScopeAdmin grants access to every method other than the SKA
+	// administrative methods.
+	ScopeAdmin
+
+	// ScopeSKAAdmin grants access to the SKA administrative methods, such as
+	// the emission broadcast helpers and watch list management, which
+	// require a credential separate from the one used for queries when
+	// credentials are configured with scopes.
+	ScopeSKAAdmin
+
+	// ScopeAll grants access to every method.
+	ScopeAll = ScopeRead | ScopeAdmin | ScopeSKAAdmin
+)
+
+// authScopeNames maps the name of each scope as specified in the
+// configuration to the scope.
+var authScopeNames = map[string]AuthScope{
+	"read":     ScopeRead,
+	"admin":    ScopeAdmin,
+	"skaadmin": ScopeSKAAdmin,
+}
+
+// rpcSKAAdmin houses the SKA administrative methods that may only be called
+// with credentials granted ScopeSKAAdmin.
+var rpcSKAAdmin = map[string]struct{}{
+	"abandonrebroadcasttx":   {},
+	"encodeemissionauth":     {},
+	"importwatchonlyaddress": {},
+	"removewatchonlyaddress": {},
+}
+
+// ParseAuthScope parses a comma-separated list of scope names, such as
+// "read,skaadmin", into the set of scopes it specifies.
+func ParseAuthScope(scopes string) (AuthScope, error) {
+	var scope AuthScope
+	for _, name := range strings.Split(scopes, ",") {
+		s, ok := authScopeNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return 0, fmt.Errorf("unknown RPC scope %q (valid scopes: "+
+				"read, admin, skaadmin)", name)
+		}
+		scope |= s
+	}
+	return scope, nil
+}
+
+// String returns the scope as a comma-separated list of scope names.
+func (s AuthScope) String() string {
+	var names []string
+	for _, name := range []string{"read", "admin", "skaadmin"} {
+		if s&authScopeNames[name] != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// Authorizes returns whether the scope grants access to the provided method.
+func (s AuthScope) Authorizes(method string) bool {
+	if _, ok := rpcSKAAdmin[method]; ok {
+		return s&ScopeSKAAdmin != 0
+	}
+	if s&ScopeAdmin != 0 {
+		return true
+	}
+	_, ok := rpcLimited[method]
+	return ok && s&ScopeRead != 0
+}
+
+// unauthorizedMessage returns the message of the error returned to clients
+// whose credentials do not grant access to the provided method.
+func unauthorizedMessage(method string) string {
+	if _, ok := rpcSKAAdmin[method]; ok {
+		return "credentials without the skaadmin scope not authorized " +
+			"for this method"
+	}
+	return "limited user not authorized for this method"
+}
+
+// AuthCredential houses a username and password that RPC clients may
+// authenticate with along with the scopes granted to them.
+type AuthCredential struct {
+	User   string
+	Pass   string
+	Scopes AuthScope
+}
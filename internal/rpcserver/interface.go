@@ -15,10 +15,15 @@ import (
 	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/gcs"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
 	"github.com/monetarium/monetarium-node/internal/blockchain"
 	"github.com/monetarium/monetarium-node/internal/blockchain/indexers"
+	"github.com/monetarium/monetarium-node/internal/emission"
+	"github.com/monetarium/monetarium-node/internal/fees"
 	"github.com/monetarium/monetarium-node/internal/mempool"
 	"github.com/monetarium/monetarium-node/internal/mining"
+	"github.com/monetarium/monetarium-node/internal/netsync"
+	"github.com/monetarium/monetarium-node/internal/supplyscan"
 	"github.com/monetarium/monetarium-node/math/uint256"
 	"github.com/monetarium/monetarium-node/mixing"
 	"github.com/monetarium/monetarium-node/peer"
@@ -93,6 +98,19 @@ type Peer interface {
 	// BanScore returns the current integer value that represents how close
 	// the peer is to being banned.
 	BanScore() uint32
+
+	// TxRelayStats returns the number of transactions and bytes relayed to
+	// and from the peer broken down by coin type.
+	TxRelayStats() map[cointype.CoinType]PeerTxRelayStats
+}
+
+// PeerTxRelayStats contains the number of transactions and bytes relayed to
+// and from a peer for a single coin type.
+type PeerTxRelayStats struct {
+	TxSent      uint64
+	TxBytesSent uint64
+	TxRecv      uint64
+	TxBytesRecv uint64
 }
 
 // AddrManager represents an address manager for use with the RPC server.
@@ -159,6 +177,15 @@ type ConnManager interface {
 	// in a block.
 	AddRebroadcastInventory(iv *wire.InvVect, data interface{})
 
+	// RebroadcastTxs returns the transactions that are periodically
+	// rebroadcast until they show up in a block ordered by the time they
+	// were added.
+	RebroadcastTxs() []RebroadcastTx
+
+	// AbandonRebroadcastTx stops rebroadcasting the transaction with the
+	// provided hash and returns whether or not it was being rebroadcast.
+	AbandonRebroadcastTx(hash *chainhash.Hash) bool
+
 	// RelayTransactions generates and relays inventory vectors for all of
 	// the passed transactions to all connected peers.
 	RelayTransactions(txns []*dcrutil.Tx)
@@ -195,6 +222,10 @@ type SyncManager interface {
 	ProcessTransaction(tx *dcrutil.Tx, allowOrphans bool, allowHighFees bool,
 		tag mempool.Tag) ([]*dcrutil.Tx, error)
 
+	// ProcessPackage relays the provided package of related transactions for
+	// validation and insertion into the memory pool together.
+	ProcessPackage(pkg []*dcrutil.Tx, allowHighFees bool) ([]*dcrutil.Tx, error)
+
 	// RecentlyConfirmedTxn returns with high degree of confidence whether a
 	// transaction has been recently confirmed in a block.
 	//
@@ -204,6 +235,11 @@ type SyncManager interface {
 	// SubmitMixMessage submits the mixing message to the network after
 	// processing it locally.
 	SubmitMixMessage(msg mixing.Message) error
+
+	// TxLaneStats returns the metrics of the stake lane votes and revocations
+	// received from peers are validated in ahead of all other transactions
+	// and the regular lane all other transactions are validated in.
+	TxLaneStats() (netsync.TxLaneStats, netsync.TxLaneStats)
 }
 
 // UtxoEntry represents a utxo entry for use with the RPC server.
@@ -238,6 +274,13 @@ type UtxoEntry interface {
 	// transaction.
 	IsCoinBase() bool
 
+	// HasExpiry returns whether or not the output was contained in a
+	// transaction that included an expiry.
+	HasExpiry() bool
+
+	// CoinType returns the coin type of the output.
+	CoinType() cointype.CoinType
+
 	// TicketMinimalOutputs returns the minimal outputs for the ticket transaction
 	// that the output is contained in.  Note that the ticket minimal outputs are
 	// only stored in ticket submission outputs and nil will be returned for all
@@ -286,6 +329,11 @@ type Chain interface {
 	// provided block hash.
 	ChainWork(hash *chainhash.Hash) (uint256.Uint256, error)
 
+	// CheckConnectBlockTemplate fully validates that connecting the passed block
+	// to either the tip of the main chain or its parent does not violate any
+	// consensus rules, aside from the proof of work requirement.
+	CheckConnectBlockTemplate(block *dcrutil.Block) error
+
 	// CheckLiveTicket returns whether or not a ticket exists in the live ticket
 	// treap of the best node.
 	CheckLiveTicket(hash chainhash.Hash) bool
@@ -320,6 +368,10 @@ type Chain interface {
 	// FetchUtxoStats returns statistics on the current utxo set.
 	FetchUtxoStats() (*blockchain.UtxoStats, error)
 
+	// FetchUtxoSnapshot returns all unspent transaction outputs of the
+	// provided coin type as of the main chain block at the provided height.
+	FetchUtxoSnapshot(coinType cointype.CoinType, height int64) (*blockchain.UtxoSnapshot, error)
+
 	// GetStakeVersions returns a cooked array of StakeVersions.  We do this in
 	// order to not bloat memory by returning raw blocks.
 	GetStakeVersions(hash *chainhash.Hash, count int32) ([]blockchain.StakeVersions, error)
@@ -333,6 +385,12 @@ type Chain interface {
 	// deployment version.
 	GetVoteInfo(hash *chainhash.Hash, version uint32) (*blockchain.VoteInfo, error)
 
+	// HaveBlock returns whether or not the chain instance has the block
+	// represented by the passed hash.  This includes checking the various
+	// places a block can be in, such as part of the main chain or on a side
+	// chain.
+	HaveBlock(hash *chainhash.Hash) bool
+
 	// HeaderByHash returns the block header identified by the given hash or an
 	// error if it doesn't exist.  Note that this will return headers from both the
 	// main chain and any side chains.
@@ -472,6 +530,10 @@ type Chain interface {
 	// emitted in the blockchain.
 	HasSKAEmissionOccurred(cointype.CoinType) bool
 
+	// SKAEmissionTranchesEmitted returns the number of scheduled emission
+	// tranches of the specified coin type that have been emitted.
+	SKAEmissionTranchesEmitted(cointype.CoinType) uint32
+
 	// GetSKABurnedAmount returns the total amount burned for the specified SKA
 	// coin type. Returns 0 if no burns have occurred for this coin type.
 	GetSKABurnedAmount(cointype.CoinType) int64
@@ -479,6 +541,30 @@ type Chain interface {
 	// GetAllSKABurnedAmounts returns a map of all SKA coin types to their total
 	// burned amounts. Only coin types with non-zero burned amounts are included.
 	GetAllSKABurnedAmounts() map[cointype.CoinType]int64
+
+	// FetchSKABurns returns up to the provided maximum number of burns of the
+	// specified SKA coin type in main chain blocks at or after the provided
+	// height along with the height of the first block whose individual burns
+	// are recorded.
+	FetchSKABurns(coinType cointype.CoinType, startHeight int64, maxBurns int) ([]blockchain.SKABurnRecord, int64, error)
+
+	// FetchBlockFeeTotals returns the per coin type fee totals of the main
+	// chain block with the provided hash.  It returns nil when there are no
+	// fee totals recorded for the block.
+	FetchBlockFeeTotals(hash *chainhash.Hash) (*blockchain.BlockFeeTotals, error)
+
+	// FetchBlockStats returns the per coin type statistics of the main chain
+	// block with the provided hash.  It returns nil for blocks that are not in
+	// the main chain.
+	FetchBlockStats(hash *chainhash.Hash) (*blockchain.BlockStats, error)
+
+	// FetchBlockSpends returns all transaction outputs spent by the main chain
+	// block with the provided hash as recorded in its spend journal entry.
+	FetchBlockSpends(hash *chainhash.Hash) (*blockchain.BlockSpends, error)
+
+	// ValidationStats returns the durations of each stage of block validation
+	// for the blocks processed since the chain instance was created.
+	ValidationStats() []blockchain.ValidationStageStats
 }
 
 // Clock represents a clock for use with the RPC server. The purpose of this
@@ -520,6 +606,23 @@ type CoinTypeFeeCalculator interface {
 	// EstimateFeeRate returns the current fee rate estimate for the given coin type
 	// and target confirmation blocks.
 	EstimateFeeRate(coinType cointype.CoinType, targetConfirmations int) (dcrutil.Amount, error)
+
+	// EstimateAccuracy returns the accuracy of the fee rate estimates of
+	// every coin type with mined transactions ordered by coin type.
+	EstimateAccuracy() []FeeEstimateAccuracy
+}
+
+// FeeEstimateAccuracy houses the accuracy of the fee rate estimates of a coin
+// type as measured by comparing the number of blocks mined transactions were
+// predicted to take to confirm against the number of blocks they actually took.
+type FeeEstimateAccuracy struct {
+	CoinType            cointype.CoinType
+	Samples             uint64
+	OnTime              uint64
+	Late                uint64
+	TotalPredictedConfs uint64
+	TotalActualConfs    uint64
+	TotalAbsError       uint64
 }
 
 // CoinTypeFeeStats contains fee statistics for a specific coin type as used by
@@ -538,6 +641,21 @@ type CoinTypeFeeStats struct {
 	LastUpdated          time.Time
 }
 
+// RebroadcastTx describes a transaction submitted through the RPC server that
+// is periodically rebroadcast until it shows up in a block.
+type RebroadcastTx struct {
+	Hash        chainhash.Hash
+	CoinType    cointype.CoinType
+	Added       time.Time
+	Attempts    uint32
+	LastAttempt time.Time
+	NextAttempt time.Time
+
+	// Saturated indicates the last scheduled rebroadcast was postponed
+	// because the block space allocated to the coin type was saturated.
+	Saturated bool
+}
+
 // LogManager represents a log manager for use with the RPC server.
 //
 // The interface contract does NOT require that these methods are safe for
@@ -624,6 +742,19 @@ type BlockTemplater interface {
 	// UpdateBlockTime updates the timestamp in the passed header to the current
 	// time while taking into account the consensus rules.
 	UpdateBlockTime(header *wire.BlockHeader)
+
+	// TemplateBudgetStats returns statistics about how often block templates
+	// were delivered partially filled because their time budget was
+	// exhausted.
+	TemplateBudgetStats() mining.TemplateBudgetStats
+
+	// BlockSpacePolicy returns the block space policy applied to the
+	// generated block templates.
+	BlockSpacePolicy() mining.BlockSpacePolicy
+
+	// SetBlockSpacePolicy replaces the block space policy applied to the
+	// generated block templates and regenerates the current template.
+	SetBlockSpacePolicy(policy mining.BlockSpacePolicy) error
 }
 
 // FiltererV2 provides an interface for retrieving a block's version 2 GCS
@@ -700,6 +831,35 @@ type TxMempooler interface {
 	// TSpendHashes returns the hashes of the treasury spend transactions
 	// currently in the mempool.
 	TSpendHashes() []chainhash.Hash
+
+	// TestAcceptTransaction returns whether or not the passed transaction
+	// would be accepted to the main pool along with the fee it pays in its
+	// primary coin type without modifying the pool.
+	TestAcceptTransaction(tx *dcrutil.Tx, allowHighFees bool) (int64, error)
+
+	// MaxTxAge returns the maximum amount of time a regular transaction of
+	// the provided coin type may remain in the pool before it expires.
+	MaxTxAge(coinType cointype.CoinType) time.Duration
+
+	// ExpiredTxCounts returns the total number of regular transactions that
+	// have been evicted from the pool for exceeding the max age of their
+	// coin type broken down by coin type.
+	ExpiredTxCounts() map[cointype.CoinType]uint64
+
+	// MaxPoolSize returns the maximum number of bytes of regular
+	// transactions of the provided coin type the pool may hold.  Zero means
+	// the size is not limited.
+	MaxPoolSize(coinType cointype.CoinType) int64
+
+	// ChainLimits returns the limits placed on the chains of unconfirmed
+	// regular transactions of the provided coin type in the pool.
+	ChainLimits(coinType cointype.CoinType) mempool.ChainLimits
+
+	// MempoolMinFees returns the rolling minimum fee rates in atoms per kB
+	// that regular transactions must pay to enter the pool keyed by coin
+	// type.  Coin types whose rolling minimum fee does not currently apply
+	// are not included.
+	MempoolMinFees() map[cointype.CoinType]int64
 }
 
 // MixPooler represents a source of mixpool message data for the RPC server.
@@ -736,6 +896,156 @@ type TxIndexer interface {
 	Entry(hash *chainhash.Hash) (*indexers.TxIndexEntry, error)
 }
 
+// WatchOnlyIndexer provides an interface for managing the addresses watched by
+// the watch-only index and querying the unspent outputs that pay to them.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+//
+// WatchOnlyIndexer may be nil. The RPC server must check for the presence of a
+// WatchOnlyIndexer before calling methods associated with it.
+type WatchOnlyIndexer interface {
+	// Name returns the human-readable name of the index.
+	Name() string
+
+	// Tip returns the current index tip.
+	Tip() (int64, *chainhash.Hash, error)
+
+	// WaitForSync subscribes clients for the next index sync update.
+	WaitForSync() chan bool
+
+	// ImportAddress adds the provided address of the given coin type to the
+	// watched addresses.  The main chain blocks starting at rescanFrom are
+	// scanned for outputs paying to the address unless it is negative.
+	ImportAddress(ctx context.Context, addr stdaddr.Address,
+		coinType cointype.CoinType, rescanFrom int64) error
+
+	// RemoveAddress removes the provided address of the given coin type from
+	// the watched addresses and returns whether or not it was watched.
+	RemoveAddress(addr stdaddr.Address, coinType cointype.CoinType) (bool, error)
+
+	// WatchedAddresses returns all of the watched addresses.
+	WatchedAddresses() ([]indexers.WatchedAddress, error)
+
+	// UnspentOutputs returns all of the unspent outputs paying to the
+	// watched addresses as of the current index tip.
+	UnspentOutputs() ([]indexers.WatchOnlyUtxo, error)
+
+	// ReceivedOutputs returns all of the outputs, both spent and unspent, that
+	// paid to the provided watched address of the given coin type as of the
+	// current index tip.
+	ReceivedOutputs(addr stdaddr.Address, coinType cointype.CoinType) ([]indexers.WatchOnlyReceived, error)
+}
+
+// EmissionIndexer provides an interface for querying the SKA emissions tracked
+// by the emission index.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+type EmissionIndexer interface {
+	// Name returns the human-readable name of the index.
+	Name() string
+
+	// Tip returns the current index tip.
+	Tip() (int64, *chainhash.Hash, error)
+
+	// WaitForSync subscribes clients for the next index sync update.
+	WaitForSync() chan bool
+
+	// Emissions returns the SKA emissions of the provided coin type connected
+	// to the main chain as of the current index tip ordered by height.
+	Emissions(coinType cointype.CoinType) ([]indexers.EmissionEntry, error)
+
+	// FirstTransaction returns the first transaction other than an SKA
+	// emission or a coinbase that pays an output of the provided coin type in
+	// the main chain as of the current index tip.  It returns nil when no such
+	// transaction exists.
+	FirstTransaction(coinType cointype.CoinType) (*indexers.FirstTxEntry, error)
+}
+
+// IndexSyncReporter provides an interface for querying the sync progress of
+// the indexes.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+type IndexSyncReporter interface {
+	// SyncStatus returns the sync progress of all indexes ordered by name.
+	SyncStatus() ([]indexers.IndexSyncStatus, error)
+
+	// WaitForSync subscribes clients for the next sync update of the index
+	// with the provided name.  False is returned when there is no such index.
+	WaitForSync(name string) (chan bool, bool)
+}
+
+// EmissionRehearser provides an interface for querying the progress of the
+// optional SKA emission rehearsal coordinator.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+type EmissionRehearser interface {
+	// Status returns the progress of the rehearsed emission for every
+	// configured coin type ordered by coin type.
+	Status() []emission.CoinTypeStatus
+}
+
+// EmissionWatcher provides an interface for querying the alert levels of the
+// optional SKA emission watchtower.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+type EmissionWatcher interface {
+	// Status returns the emission watch state of every watched coin type
+	// ordered by coin type.
+	Status() []emission.WatchStatus
+}
+
+// EmissionCheckpointer provides an interface for querying whether the SKA
+// emission state has been verified against the configured operator-signed
+// emission checkpoints.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+type EmissionCheckpointer interface {
+	// Verified returns nil when the emission state has been verified
+	// against every configured checkpoint or an error that describes why
+	// it has not.
+	Verified() error
+}
+
+// UtilizationHistorian provides an interface for querying the optional block
+// space utilization history of the most recent blocks.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+type UtilizationHistorian interface {
+	// Recent returns the utilization of up to the provided number of the
+	// most recently recorded blocks, newest first.
+	Recent(count int) []fees.BlockUtilization
+}
+
+// AllocDivergenceMonitor provides an interface for querying how the blocks of
+// each miner diverge from the block space allocation policy.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+type AllocDivergenceMonitor interface {
+	// Stats returns the allocation divergence statistics of all checked
+	// blocks along with those of every tracked miner with at least one
+	// divergent block.
+	Stats() *blockalloc.DivergenceStats
+}
+
+// SupplyScanner provides an interface for querying the results of the
+// optional background UTXO supply scanner.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+type SupplyScanner interface {
+	// Status returns the progress of the scan in progress, if any, and the
+	// result of the most recently completed scan.
+	Status() supplyscan.Status
+}
+
 // NtfnManager provides an interface for processing and sending chain
 // notifications.
 //
@@ -756,10 +1066,20 @@ type NtfnManager interface {
 	// NotifyTSpend passes new tspends to the manager for processing.
 	NotifyTSpend(tx *dcrutil.Tx)
 
+	// NotifyDoubleSpend passes transactions rejected for double spending
+	// coins spent by the passed conflicting mempool transactions to the
+	// manager for processing.
+	NotifyDoubleSpend(tx *dcrutil.Tx, coinType cointype.CoinType, conflicts []chainhash.Hash)
+
 	// NotifyReorganization passes a blockchain reorganization notification to
 	// the manager for processing.
 	NotifyReorganization(rd *blockchain.ReorganizationNtfnsData)
 
+	// NotifySKAEmissionsFinal passes SKA emissions in a main chain block that
+	// reached the configured number of confirmations to the manager for
+	// processing.
+	NotifySKAEmissionsFinal(block *dcrutil.Block, emissions []blockchain.SKAEmissionRecord)
+
 	// NotifyWinningTickets passes newly winning tickets to the manager for
 	// processing.
 	NotifyWinningTickets(wtnd *WinningTicketsNtfnData)
@@ -788,8 +1108,10 @@ type NtfnManager interface {
 	UnregisterBlockUpdates(wsc *wsClient)
 
 	// RegisterWorkUpdates requests work update notifications to the passed
-	// websocket client.
-	RegisterWorkUpdates(wsc *wsClient)
+	// websocket client.  Notifications for templates that were only updated
+	// due to new transactions are limited to those that pass the provided
+	// filter when it is not nil.
+	RegisterWorkUpdates(wsc *wsClient, filter *workNtfnFilter)
 
 	// UnregisterWorkUpdates removes work update notifications for the passed
 	// websocket client.
@@ -803,6 +1125,14 @@ type NtfnManager interface {
 	// websocket client.
 	UnregisterTSpendUpdates(wsc *wsClient)
 
+	// RegisterDoubleSpendUpdates requests double spend notifications to the
+	// passed websocket client.
+	RegisterDoubleSpendUpdates(wsc *wsClient)
+
+	// UnregisterDoubleSpendUpdates removes double spend notifications for the
+	// passed websocket client.
+	UnregisterDoubleSpendUpdates(wsc *wsClient)
+
 	// RegisterWinningTickets requests winning tickets update notifications
 	// to the passed websocket client.
 	RegisterWinningTickets(wsc *wsClient)
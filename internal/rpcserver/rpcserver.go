@@ -47,9 +47,12 @@ import (
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
 	"github.com/monetarium/monetarium-node/dcrjson"
 	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
 	"github.com/monetarium/monetarium-node/internal/blockchain"
+	"github.com/monetarium/monetarium-node/internal/blockchain/indexers"
 	"github.com/monetarium/monetarium-node/internal/mempool"
 	"github.com/monetarium/monetarium-node/internal/mining"
+	"github.com/monetarium/monetarium-node/internal/netsync"
 	"github.com/monetarium/monetarium-node/internal/version"
 	"github.com/monetarium/monetarium-node/mixing"
 	"github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
@@ -121,6 +124,16 @@ const (
 	// syncWait is the maximum time in seconds to wait for an index
 	// to sync with the main chain.
 	syncWait = time.Second * 3
+
+	// advisoryMaxBlocks is the estimated number of blocks until inclusion
+	// beyond which an accepted transaction is reported as unlikely to
+	// confirm soon due to the pending demand for its coin type.
+	advisoryMaxBlocks = 6
+
+	// advisoryMinUtilization is the fraction of the block space allocated
+	// to a coin type used by recent blocks at or above which the allocation
+	// is considered persistently saturated.
+	advisoryMinUtilization = 0.95
 )
 
 var (
@@ -171,88 +184,126 @@ type commandHandler func(context.Context, *Server, interface{}) (interface{}, er
 // a dependency loop.
 var rpcHandlers map[types.Method]commandHandler
 var rpcHandlersBeforeInit = map[types.Method]commandHandler{
-	"addnode":                  handleAddNode,
-	"createrawsstx":            handleCreateRawSStx,
-	"createrawssrtx":           handleCreateRawSSRtx,
-	"createrawtransaction":     handleCreateRawTransaction,
-	"debuglevel":               handleDebugLevel,
-	"decoderawtransaction":     handleDecodeRawTransaction,
-	"decodescript":             handleDecodeScript,
-	"estimatefee":              handleEstimateFee,
-	"estimatesmartfee":         handleEstimateSmartFee,
-	"getfeestimatesbycointype": handleGetFeeEstimatesByCoinType,
-	"estimatestakediff":        handleEstimateStakeDiff,
-	"existsaddress":            handleExistsAddress,
-	"existsaddresses":          handleExistsAddresses,
-	"existsliveticket":         handleExistsLiveTicket,
-	"existslivetickets":        handleExistsLiveTickets,
-	"existsmempooltxs":         handleExistsMempoolTxs,
-	"generate":                 handleGenerate,
-	"getaddednodeinfo":         handleGetAddedNodeInfo,
-	"getbestblock":             handleGetBestBlock,
-	"getbestblockhash":         handleGetBestBlockHash,
-	"getblock":                 handleGetBlock,
-	"getblockchaininfo":        handleGetBlockchainInfo,
-	"getblockcount":            handleGetBlockCount,
-	"getblockhash":             handleGetBlockHash,
-	"getblockheader":           handleGetBlockHeader,
-	"getblocksubsidy":          handleGetBlockSubsidy,
-	"getcfilterv2":             handleGetCFilterV2,
-	"getchaintips":             handleGetChainTips,
-	"getcoinsupply":            handleGetCoinSupply,
-	"getconnectioncount":       handleGetConnectionCount,
-	"getcurrentnet":            handleGetCurrentNet,
-	"getdifficulty":            handleGetDifficulty,
-	"getgenerate":              handleGetGenerate,
-	"gethashespersec":          handleGetHashesPerSec,
-	"getheaders":               handleGetHeaders,
-	"getinfo":                  handleGetInfo,
-	"getmempoolinfo":           handleGetMempoolInfo,
-	"getmempoolfeesinfo":       handleGetMempoolFeesInfo,
-	"getmininginfo":            handleGetMiningInfo,
-	"getmixmessage":            handleGetMixMessage,
-	"getmixpairrequests":       handleGetMixPairRequests,
-	"getnettotals":             handleGetNetTotals,
-	"getnetworkhashps":         handleGetNetworkHashPS,
-	"getnetworkinfo":           handleGetNetworkInfo,
-	"getpeerinfo":              handleGetPeerInfo,
-	"getrawmempool":            handleGetRawMempool,
-	"getrawtransaction":        handleGetRawTransaction,
-	"getskainfo":               handleGetSKAInfo,
-	"getemissionstatus":        handleGetEmissionStatus,
-	"getburnedcoins":           handleGetBurnedCoins,
-	"getstakedifficulty":       handleGetStakeDifficulty,
-	"getstakeversioninfo":      handleGetStakeVersionInfo,
-	"getstakeversions":         handleGetStakeVersions,
-	"getticketpoolvalue":       handleGetTicketPoolValue,
-	"gettreasurybalance":       handleGetTreasuryBalance,
-	"gettreasuryspendvotes":    handleGetTreasurySpendVotes,
-	"getvoteinfo":              handleGetVoteInfo,
-	"gettxout":                 handleGetTxOut,
-	"gettxoutsetinfo":          handleGetTxOutSetInfo,
-	"getwork":                  handleGetWork,
-	"help":                     handleHelp,
-	"invalidateblock":          handleInvalidateBlock,
-	"livetickets":              handleLiveTickets,
-	"node":                     handleNode,
-	"ping":                     handlePing,
-	"reconsiderblock":          handleReconsiderBlock,
-	"regentemplate":            handleRegenTemplate,
-	"sendrawmixmessage":        handleSendRawMixMessage,
-	"sendrawtransaction":       handleSendRawTransaction,
-	"setgenerate":              handleSetGenerate,
-	"startprofiler":            handleStartProfiler,
-	"stop":                     handleStop,
-	"stopprofiler":             handleStopProfiler,
-	"submitblock":              handleSubmitBlock,
-	"ticketfeeinfo":            handleTicketFeeInfo,
-	"ticketsforaddress":        handleTicketsForAddress,
-	"ticketvwap":               handleTicketVWAP,
-	"txfeeinfo":                handleTxFeeInfo,
-	"validateaddress":          handleValidateAddress,
-	"verifychain":              handleVerifyChain,
-	"verifymessage":            handleVerifyMessage,
-	"version":                  handleVersion,
+	"abandonrebroadcasttx":       handleAbandonRebroadcastTx,
+	"addnode":                    handleAddNode,
+	"attestemissionkey":          handleAttestEmissionKey,
+	"auditskasupply":             handleAuditSKASupply,
+	"bumpfeeestimate":            handleBumpFeeEstimate,
+	"createrawsstx":              handleCreateRawSStx,
+	"createrawssrtx":             handleCreateRawSSRtx,
+	"createrawtransaction":       handleCreateRawTransaction,
+	"createsweeptransaction":     handleCreateSweepTransaction,
+	"debuglevel":                 handleDebugLevel,
+	"decoderawtransaction":       handleDecodeRawTransaction,
+	"decodescript":               handleDecodeScript,
+	"decodeemissionauth":         handleDecodeEmissionAuth,
+	"encodeemissionauth":         handleEncodeEmissionAuth,
+	"estimatefee":                handleEstimateFee,
+	"estimatefeeaccuracy":        handleEstimateFeeAccuracy,
+	"estimatesmartfee":           handleEstimateSmartFee,
+	"getfeestimatesbycointype":   handleGetFeeEstimatesByCoinType,
+	"estimatestakediff":          handleEstimateStakeDiff,
+	"existsaddress":              handleExistsAddress,
+	"existsaddresses":            handleExistsAddresses,
+	"existsliveticket":           handleExistsLiveTicket,
+	"existslivetickets":          handleExistsLiveTickets,
+	"existsmempooltxs":           handleExistsMempoolTxs,
+	"fundrawtransaction":         handleFundRawTransaction,
+	"generate":                   handleGenerate,
+	"getaddednodeinfo":           handleGetAddedNodeInfo,
+	"getaddressreceived":         handleGetAddressReceived,
+	"getallocationdivergence":    handleGetAllocationDivergence,
+	"getbestblock":               handleGetBestBlock,
+	"getbestblockhash":           handleGetBestBlockHash,
+	"getblock":                   handleGetBlock,
+	"getblockchaininfo":          handleGetBlockchainInfo,
+	"getblockcount":              handleGetBlockCount,
+	"getblockhash":               handleGetBlockHash,
+	"getblockheader":             handleGetBlockHeader,
+	"getblockspacepolicy":        handleGetBlockSpacePolicy,
+	"getblockspends":             handleGetBlockSpends,
+	"getblockstats":              handleGetBlockStats,
+	"getblocksubsidy":            handleGetBlockSubsidy,
+	"getblocktemplate":           handleGetBlockTemplate,
+	"getcfilterv2":               handleGetCFilterV2,
+	"getchaintips":               handleGetChainTips,
+	"getcoinsupply":              handleGetCoinSupply,
+	"getcointypes":               handleGetCoinTypes,
+	"getcointypesnapshot":        handleGetCoinTypeSnapshot,
+	"getconnectioncount":         handleGetConnectionCount,
+	"getcurrentnet":              handleGetCurrentNet,
+	"getdifficulty":              handleGetDifficulty,
+	"getgenerate":                handleGetGenerate,
+	"gethashespersec":            handleGetHashesPerSec,
+	"getheaders":                 handleGetHeaders,
+	"getindexinfo":               handleGetIndexInfo,
+	"getinfo":                    handleGetInfo,
+	"getmempoolinfo":             handleGetMempoolInfo,
+	"getmempoolfeesinfo":         handleGetMempoolFeesInfo,
+	"getmininginfo":              handleGetMiningInfo,
+	"getmixmessage":              handleGetMixMessage,
+	"getmixpairrequests":         handleGetMixPairRequests,
+	"getnettotals":               handleGetNetTotals,
+	"getnetworkhashps":           handleGetNetworkHashPS,
+	"getnetworkinfo":             handleGetNetworkInfo,
+	"getpeerinfo":                handleGetPeerInfo,
+	"getrawmempool":              handleGetRawMempool,
+	"getrawtransaction":          handleGetRawTransaction,
+	"getrecentutilization":       handleGetRecentUtilization,
+	"getskainfo":                 handleGetSKAInfo,
+	"getemissionkeys":            handleGetEmissionKeys,
+	"getemissionstatus":          handleGetEmissionStatus,
+	"getemissionrehearsalstatus": handleGetEmissionRehearsalStatus,
+	"getemissionwatchstatus":     handleGetEmissionWatchStatus,
+	"getburnedcoins":             handleGetBurnedCoins,
+	"getskaburns":                handleGetSKABurns,
+	"getskaemissions":            handleGetSKAEmissions,
+	"getskaemissionstatus":       handleGetSKAEmissionStatus,
+	"getskaactivationtimeline":   handleGetSKAActivationTimeline,
+	"getstakedifficulty":         handleGetStakeDifficulty,
+	"getstakeversioninfo":        handleGetStakeVersionInfo,
+	"getstakeversions":           handleGetStakeVersions,
+	"getsupplyscaninfo":          handleGetSupplyScanInfo,
+	"getticketpoolvalue":         handleGetTicketPoolValue,
+	"gettreasurybalance":         handleGetTreasuryBalance,
+	"gettreasuryspendvotes":      handleGetTreasurySpendVotes,
+	"getvoteinfo":                handleGetVoteInfo,
+	"gettxout":                   handleGetTxOut,
+	"gettxoutsetinfo":            handleGetTxOutSetInfo,
+	"getvalidationstats":         handleGetValidationStats,
+	"getwatchonlybalance":        handleGetWatchOnlyBalance,
+	"getwork":                    handleGetWork,
+	"help":                       handleHelp,
+	"importwatchonlyaddress":     handleImportWatchOnlyAddress,
+	"invalidateblock":            handleInvalidateBlock,
+	"listknownskaaddresses":      handleListKnownSKAAddresses,
+	"listrebroadcasttxs":         handleListRebroadcastTxs,
+	"listunspentwatchonly":       handleListUnspentWatchOnly,
+	"livetickets":                handleLiveTickets,
+	"node":                       handleNode,
+	"ping":                       handlePing,
+	"reconsiderblock":            handleReconsiderBlock,
+	"regentemplate":              handleRegenTemplate,
+	"removewatchonlyaddress":     handleRemoveWatchOnlyAddress,
+	"sendrawmixmessage":          handleSendRawMixMessage,
+	"sendrawpackage":             handleSendRawPackage,
+	"sendrawtransaction":         handleSendRawTransaction,
+	"setblockspacepolicy":        handleSetBlockSpacePolicy,
+	"setgenerate":                handleSetGenerate,
+	"startprofiler":              handleStartProfiler,
+	"stop":                       handleStop,
+	"stopprofiler":               handleStopProfiler,
+	"submitblock":                handleSubmitBlock,
+	"testblockassembly":          handleTestBlockAssembly,
+	"testmempoolaccept":          handleTestMempoolAccept,
+	"ticketfeeinfo":              handleTicketFeeInfo,
+	"ticketsforaddress":          handleTicketsForAddress,
+	"ticketvwap":                 handleTicketVWAP,
+	"txfeeinfo":                  handleTxFeeInfo,
+	"validateaddress":            handleValidateAddress,
+	"verifychain":                handleVerifyChain,
+	"verifymessage":              handleVerifyMessage,
+	"version":                    handleVersion,
 }
 
 // list of commands that we recognize, but for which dcrd has no support because
@@ -356,12 +407,17 @@ var rpcLimited = map[string]struct{}{
 	"help": {},
 
 	// HTTP/S-only commands
+	"attestemissionkey":        {},
+	"bumpfeeestimate":          {},
 	"createrawsstx":            {},
 	"createrawssrtx":           {},
 	"createrawtransaction":     {},
+	"createsweeptransaction":   {},
 	"decoderawtransaction":     {},
 	"decodescript":             {},
+	"decodeemissionauth":       {},
 	"estimatefee":              {},
+	"estimatefeeaccuracy":      {},
 	"estimatesmartfee":         {},
 	"getfeestimatesbycointype": {},
 	"getmempoolfeesinfo":       {},
@@ -371,6 +427,8 @@ var rpcLimited = map[string]struct{}{
 	"existsliveticket":         {},
 	"existslivetickets":        {},
 	"existsmempooltxs":         {},
+	"fundrawtransaction":       {},
+	"getallocationdivergence":  {},
 	"getbestblock":             {},
 	"getbestblockhash":         {},
 	"getblock":                 {},
@@ -378,12 +436,16 @@ var rpcLimited = map[string]struct{}{
 	"getblockcount":            {},
 	"getblockhash":             {},
 	"getblockheader":           {},
+	"getblockspends":           {},
+	"getblockstats":            {},
 	"getblocksubsidy":          {},
 	"getcfilterv2":             {},
 	"getchaintips":             {},
 	"getcoinsupply":            {},
+	"getcointypes":             {},
 	"getcurrentnet":            {},
 	"getdifficulty":            {},
+	"getemissionkeys":          {},
 	"getheaders":               {},
 	"getinfo":                  {},
 	"getmixmessage":            {},
@@ -392,18 +454,22 @@ var rpcLimited = map[string]struct{}{
 	"getnetworkhashps":         {},
 	"getnetworkinfo":           {},
 	"getrawmempool":            {},
+	"getskaactivationtimeline": {},
 	"getstakedifficulty":       {},
 	"getstakeversioninfo":      {},
 	"getstakeversions":         {},
 	"getrawtransaction":        {},
+	"getrecentutilization":     {},
 	"gettreasurybalance":       {},
 	"gettxout":                 {},
 	"getvoteinfo":              {},
 	"livetickets":              {},
 	"regentemplate":            {},
 	"sendrawmixmessage":        {},
+	"sendrawpackage":           {},
 	"sendrawtransaction":       {},
 	"submitblock":              {},
+	"testblockassembly":        {},
 	"ticketfeeinfo":            {},
 	"ticketsforaddress":        {},
 	"ticketvwap":               {},
@@ -596,6 +662,21 @@ func newWorkState() *workState {
 	}
 }
 
+// handleAbandonRebroadcastTx implements the abandonrebroadcasttx command.  It
+// only stops rebroadcasting the transaction and does not remove it from the
+// mempool.
+func handleAbandonRebroadcastTx(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.AbandonRebroadcastTxCmd)
+	txHash, err := chainhash.NewHashFromStr(c.TxHash)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxHash)
+	}
+	if !s.cfg.ConnMgr.AbandonRebroadcastTx(txHash) {
+		return nil, rpcNoTxInfoError(txHash)
+	}
+	return nil, nil
+}
+
 // handleAddNode handles addnode commands.
 func handleAddNode(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
 	c := cmd.(*types.AddNodeCmd)
@@ -836,6 +917,329 @@ func handleCreateRawTransaction(_ context.Context, s *Server, cmd interface{}) (
 	return mtxHex, nil
 }
 
+const (
+	// redeemP2PKHSigScriptSize is the worst case size of a signature script
+	// that redeems a version 0 pay-to-pubkey-hash output.  It is used to
+	// estimate the size of the unsigned transactions created by the
+	// fundrawtransaction and createsweeptransaction commands.
+	redeemP2PKHSigScriptSize = 1 + 73 + 1 + 33
+
+	// defaultFundConfTarget is the confirmation target used to estimate the
+	// fee rate of funded transactions when neither a fee rate nor a
+	// confirmation target is provided.
+	defaultFundConfTarget = 6
+)
+
+// fundingInput houses an unspent output that may be used to fund a
+// transaction.
+type fundingInput struct {
+	outpoint wire.OutPoint
+	amount   int64
+}
+
+// spendableFundingInputs returns the outputs among the provided candidates
+// that are unspent, have the given coin type, and may be spent by a regular
+// transaction in the next block sorted by descending amount.
+//
+// All other candidates are skipped as opposed to causing an error since thin
+// wallets typically provide every output they know about, which might be stale
+// or of other coin types.
+func (s *Server) spendableFundingInputs(candidates []types.OutPoint, coinType cointype.CoinType) ([]fundingInput, error) {
+	chain := s.cfg.Chain
+	nextHeight := chain.BestSnapshot().Height + 1
+	coinbaseMaturity := int64(s.cfg.ChainParams.CoinbaseMaturity)
+	seen := make(map[wire.OutPoint]struct{}, len(candidates))
+	inputs := make([]fundingInput, 0, len(candidates))
+	for _, candidate := range candidates {
+		txHash, err := chainhash.NewHashFromStr(candidate.Hash)
+		if err != nil {
+			return nil, rpcDecodeHexError(candidate.Hash)
+		}
+		if !(candidate.Tree == wire.TxTreeRegular ||
+			candidate.Tree == wire.TxTreeStake) {
+			return nil, rpcInvalidError("Tx tree must be regular or stake")
+		}
+		outpoint := wire.OutPoint{
+			Hash:  *txHash,
+			Index: candidate.Index,
+			Tree:  candidate.Tree,
+		}
+		if _, ok := seen[outpoint]; ok {
+			continue
+		}
+		seen[outpoint] = struct{}{}
+
+		entry, err := chain.FetchUtxoEntry(outpoint)
+		if err != nil {
+			return nil, rpcInternalErr(err, "Failed to retrieve utxo entry")
+		}
+		if entry == nil || entry.IsSpent() || entry.Amount() == 0 ||
+			entry.CoinType() != coinType {
+
+			continue
+		}
+
+		// Ticket outputs may only be spent by votes and revocations.
+		if entry.TransactionType() == stake.TxTypeSStx {
+			continue
+		}
+
+		// Coinbases, stake outputs, and outputs of transactions with an
+		// expiry must reach coinbase maturity before they can be spent.
+		// Coinbase-like outputs mature according to their coin type.
+		blocksSincePrev := nextHeight - entry.BlockHeight()
+		needsMaturity := entry.IsCoinBase() || entry.HasExpiry() ||
+			outpoint.Tree == wire.TxTreeStake
+		reqMaturity := coinbaseMaturity
+		if entry.IsCoinBase() || entry.TransactionType() == stake.TxTypeSSFee {
+			reqMaturity = int64(s.cfg.ChainParams.CoinbaseMaturityForCoinType(
+				coinType))
+		}
+		if needsMaturity && blocksSincePrev < reqMaturity {
+			continue
+		}
+
+		inputs = append(inputs, fundingInput{
+			outpoint: outpoint,
+			amount:   entry.Amount(),
+		})
+	}
+
+	sort.SliceStable(inputs, func(i, j int) bool {
+		return inputs[i].amount > inputs[j].amount
+	})
+	return inputs, nil
+}
+
+// fundingFeeRate returns the fee rate, in atoms of the provided coin type per
+// KB, to use for a transaction funded by the server.  The fee rate provided in
+// the options takes precedence over the estimate for the confirmation target.
+func (s *Server) fundingFeeRate(coinType cointype.CoinType, options *types.FundRawTransactionOptions) (dcrutil.Amount, error) {
+	if options != nil && options.FeeRate != nil {
+		feeRate, err := dcrutil.NewAmount(*options.FeeRate)
+		if err != nil {
+			return 0, rpcInvalidError("Invalid fee rate: %v", err)
+		}
+		if feeRate <= 0 {
+			return 0, rpcInvalidError("Fee rate must be positive")
+		}
+		return feeRate, nil
+	}
+
+	confTarget := int64(defaultFundConfTarget)
+	if options != nil && options.ConfTarget != nil {
+		confTarget = *options.ConfTarget
+		if confTarget <= 0 {
+			return 0, rpcInvalidError("Confirmation target must be " +
+				"positive")
+		}
+	}
+	if s.cfg.CoinTypeFeeCalculator == nil {
+		return 0, rpcMiscError("Fee estimation by coin type is not " +
+			"available")
+	}
+	feeRate, err := s.cfg.CoinTypeFeeCalculator.EstimateFeeRate(coinType,
+		int(confTarget))
+	if err != nil {
+		return 0, rpcInvalidError("Unable to estimate fee rate for coin "+
+			"type %d: %v", coinType, err)
+	}
+	return feeRate, nil
+}
+
+// estimateSignedSize returns the estimated serialized size of the provided
+// transaction once its inputs, which must not have signature scripts yet, are
+// signed with scripts that redeem pay-to-pubkey-hash outputs.  The one byte
+// length prefix of each signature script is already accounted for since the
+// worst case script size still fits in it.
+func estimateSignedSize(mtx *wire.MsgTx) int64 {
+	return int64(mtx.SerializeSize() + len(mtx.TxIn)*redeemP2PKHSigScriptSize)
+}
+
+// feeForSize returns the fee for a transaction of the provided serialized size
+// at the provided fee rate per KB.
+func feeForSize(size int64, feeRate dcrutil.Amount) int64 {
+	return size * int64(feeRate) / 1000
+}
+
+// isDustOutput returns whether or not the provided output would be considered
+// dust at the provided fee rate.  It mirrors the mempool policy in that an
+// output is dust when spending it with a typical pay-to-pubkey-hash input
+// costs more than a third of its value.
+func isDustOutput(txOut *wire.TxOut, feeRate dcrutil.Amount) bool {
+	totalSize := int64(txOut.SerializeSize() + 165)
+	return txOut.Value*1000/(3*totalSize) < int64(feeRate)
+}
+
+// decodePaymentAddress decodes the provided address and returns the version
+// and script that pay to it.
+func (s *Server) decodePaymentAddress(encodedAddr string) (uint16, []byte, error) {
+	// Decode the provided address.  This also ensures the network encoded
+	// with the address matches the network the server is currently on.
+	addr, err := stdaddr.DecodeAddress(encodedAddr, s.cfg.ChainParams)
+	if err != nil {
+		return 0, nil, rpcAddressKeyError("Could not decode address: %v", err)
+	}
+
+	// Ensure the address is one of the supported types.
+	if _, ok := addr.(stdaddr.StakeAddress); !ok {
+		return 0, nil, rpcAddressKeyError("Invalid type: %T", addr)
+	}
+
+	pkScriptVer, pkScript := addr.PaymentScript()
+	return pkScriptVer, pkScript, nil
+}
+
+// addFundingInput adds an input that spends the provided output to the
+// transaction.
+func addFundingInput(mtx *wire.MsgTx, input *fundingInput) {
+	txIn := wire.NewTxIn(&input.outpoint, input.amount, nil)
+	if mtx.LockTime != 0 {
+		txIn.Sequence = wire.MaxTxInSequenceNum - 1
+	}
+	mtx.AddTxIn(txIn)
+}
+
+// handleCreateSweepTransaction handles createsweeptransaction commands.
+func handleCreateSweepTransaction(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.CreateSweepTransactionCmd)
+
+	coinType := cointype.CoinType(c.CoinType)
+	pkScriptVer, pkScript, err := s.decodePaymentAddress(c.Address)
+	if err != nil {
+		return nil, err
+	}
+	feeRate, err := s.fundingFeeRate(coinType, c.Options)
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := s.spendableFundingInputs(c.Outpoints, coinType)
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) == 0 {
+		return nil, dcrjson.NewRPCError(dcrjson.ErrRPCWalletInsufficientFunds,
+			fmt.Sprintf("No spendable outputs of coin type %d", coinType))
+	}
+
+	// Spend every output to a single output that pays the provided address
+	// the total amount less the fee.
+	mtx := wire.NewMsgTx()
+	var total int64
+	for i := range inputs {
+		addFundingInput(mtx, &inputs[i])
+		total += inputs[i].amount
+	}
+	txOut := &wire.TxOut{
+		CoinType: coinType,
+		Version:  pkScriptVer,
+		PkScript: pkScript,
+	}
+	mtx.AddTxOut(txOut)
+	fee := feeForSize(estimateSignedSize(mtx), feeRate)
+	txOut.Value = total - fee
+	if txOut.Value <= 0 || isDustOutput(txOut, feeRate) {
+		return nil, dcrjson.NewRPCError(dcrjson.ErrRPCWalletInsufficientFunds,
+			fmt.Sprintf("Swept amount of %v less the fee of %v would be "+
+				"dust", dcrutil.Amount(total), dcrutil.Amount(fee)))
+	}
+
+	mtxHex, err := s.messageToHex(mtx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.FundRawTransactionResult{
+		Hex:       mtxHex,
+		Fee:       dcrutil.Amount(fee).ToCoin(),
+		CoinType:  uint8(coinType),
+		ChangePos: -1,
+	}, nil
+}
+
+// handleBumpFeeEstimate implements the bumpfeeestimate command.
+func handleBumpFeeEstimate(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.BumpFeeEstimateCmd)
+
+	txHash, err := chainhash.NewHashFromStr(c.TxHash)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxHash)
+	}
+
+	// Find the transaction in the mempool while tallying the pending demand
+	// for each coin type and collecting the transactions it competes with
+	// for the space allocated to its coin type.
+	descs := s.cfg.TxMempooler.TxDescs()
+	var target *mempool.TxDesc
+	for _, desc := range descs {
+		if *desc.Tx.Hash() == *txHash {
+			target = desc
+			break
+		}
+	}
+	if target == nil {
+		return nil, rpcNoTxInfoError(txHash)
+	}
+	coinType := blockalloc.GetTransactionCoinType(target.Tx)
+	txSize := target.TxSize
+	var feeRate int64
+	if txSize > 0 {
+		feeRate = target.Fee * 1000 / txSize
+	}
+
+	pendingTxBytes := make(map[cointype.CoinType]uint32)
+	var competing []blockalloc.CompetingTx
+	var bytesAhead uint32
+	for _, desc := range descs {
+		descCoinType := blockalloc.GetTransactionCoinType(desc.Tx)
+		pendingTxBytes[descCoinType] += uint32(desc.TxSize)
+		if descCoinType != coinType || desc == target || desc.TxSize <= 0 {
+			continue
+		}
+		descFeeRate := desc.Fee * 1000 / desc.TxSize
+		competing = append(competing, blockalloc.CompetingTx{
+			Size:    uint32(desc.TxSize),
+			FeeRate: descFeeRate,
+		})
+		if descFeeRate >= feeRate {
+			bytesAhead += uint32(desc.TxSize)
+		}
+	}
+
+	nextHeight := s.cfg.Chain.BestSnapshot().Height + 1
+	allocator := blockalloc.NewBlockSpaceAllocator(s.cfg.BlockMaxSize,
+		s.cfg.ChainParams).ForHeight(nextHeight)
+	requiredFeeRate, bucketSize, ok := allocator.NextBlockFeeRate(coinType,
+		uint32(txSize), competing, pendingTxBytes)
+	if !ok {
+		return nil, rpcMiscError(fmt.Sprintf("Transaction %v can not be "+
+			"selected into the next block since no space large enough is "+
+			"allocated to %v", txHash, coinType))
+	}
+
+	// The fee delta is the additional fee, rounded up, that raises the fee
+	// rate of the transaction to the required fee rate.
+	var feeDelta int64
+	if requiredFeeRate > feeRate {
+		feeDelta = (requiredFeeRate*txSize+999)/1000 - target.Fee
+		if feeDelta < 0 {
+			feeDelta = 0
+		}
+	}
+
+	return &types.BumpFeeEstimateResult{
+		Txid:            txHash.String(),
+		CoinType:        uint8(coinType),
+		Size:            txSize,
+		Fee:             dcrutil.Amount(target.Fee).ToCoin(),
+		FeeRate:         dcrutil.Amount(feeRate).ToCoin(),
+		RequiredFeeRate: dcrutil.Amount(requiredFeeRate).ToCoin(),
+		FeeDelta:        dcrutil.Amount(feeDelta).ToCoin(),
+		BucketSize:      bucketSize,
+		BytesAhead:      bytesAhead,
+		FitsNextBlock:   uint64(bytesAhead)+uint64(txSize) <= uint64(bucketSize),
+	}, nil
+}
+
 // handleCreateRawSStx handles createrawsstx commands.
 func handleCreateRawSStx(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
 	c := cmd.(*types.CreateRawSStxCmd)
@@ -1211,6 +1615,10 @@ func createVinList(mtx *wire.MsgTx, isTreasuryEnabled bool) []types.Vin {
 			Asm: disbuf,
 			Hex: hex.EncodeToString(txIn.SignatureScript),
 		}
+		if mtx.Version >= wire.TxVersionInputCoinTypes {
+			coinType := uint8(txIn.CoinType)
+			vinEntry.CoinType = &coinType
+		}
 	}
 
 	return vinList
@@ -1310,6 +1718,17 @@ func createVoutList(mtx *wire.MsgTx, chainParams *chaincfg.Params,
 	return voutList
 }
 
+// minRelayTxFeeForCoinType returns the minimum fee rate, in atoms/kB, the
+// mempool requires for transactions of the passed coin type to be relayed.
+func (s *Server) minRelayTxFeeForCoinType(chainParams *chaincfg.Params,
+	coinType cointype.CoinType) dcrutil.Amount {
+
+	if coinType.IsSKA() && chainParams.SKAMinRelayTxFee > 0 {
+		return dcrutil.Amount(chainParams.SKAMinRelayTxFee)
+	}
+	return s.cfg.MinRelayTxFee
+}
+
 // createTxRawResult converts the passed transaction and associated parameters
 // to a raw transaction JSON object.
 func (s *Server) createTxRawResult(chainParams *chaincfg.Params,
@@ -1327,14 +1746,18 @@ func (s *Server) createTxRawResult(chainParams *chaincfg.Params,
 			"expected %v", txHash, mtx.TxHash())
 	}
 
+	primaryCoinType := wire.GetPrimaryCoinType(mtx)
 	txReply := &types.TxRawResult{
-		Hex:         mtxHex,
-		Txid:        txHash,
-		Vin:         createVinList(mtx, isTreasuryEnabled),
-		Vout:        createVoutList(mtx, chainParams, nil),
-		Version:     int32(mtx.Version),
-		LockTime:    mtx.LockTime,
-		Expiry:      mtx.Expiry,
+		Hex:             mtxHex,
+		Txid:            txHash,
+		Vin:             createVinList(mtx, isTreasuryEnabled),
+		Vout:            createVoutList(mtx, chainParams, nil),
+		Version:         int32(mtx.Version),
+		LockTime:        mtx.LockTime,
+		Expiry:          mtx.Expiry,
+		PrimaryCoinType: uint8(primaryCoinType),
+		MinRelayFee: s.minRelayTxFeeForCoinType(chainParams,
+			primaryCoinType).ToCoin(),
 		BlockHeight: blkHeight,
 		BlockIndex:  blkIdx,
 	}
@@ -1363,14 +1786,18 @@ func (s *Server) createTxRawResultWithHex(chainParams *chaincfg.Params,
 			"expected %v", txHash, mtx.TxHash())
 	}
 
+	primaryCoinType := wire.GetPrimaryCoinType(mtx)
 	txReply := &types.TxRawResult{
-		Hex:         mtxHex,
-		Txid:        txHash,
-		Vin:         createVinList(mtx, isTreasuryEnabled),
-		Vout:        createVoutList(mtx, chainParams, nil),
-		Version:     int32(mtx.Version),
-		LockTime:    mtx.LockTime,
-		Expiry:      mtx.Expiry,
+		Hex:             mtxHex,
+		Txid:            txHash,
+		Vin:             createVinList(mtx, isTreasuryEnabled),
+		Vout:            createVoutList(mtx, chainParams, nil),
+		Version:         int32(mtx.Version),
+		LockTime:        mtx.LockTime,
+		Expiry:          mtx.Expiry,
+		PrimaryCoinType: uint8(primaryCoinType),
+		MinRelayFee: s.minRelayTxFeeForCoinType(chainParams,
+			primaryCoinType).ToCoin(),
 		BlockHeight: blkHeight,
 		BlockIndex:  blkIdx,
 	}
@@ -1414,13 +1841,17 @@ func handleDecodeRawTransaction(_ context.Context, s *Server, cmd interface{}) (
 	}
 
 	// Create and return the result.
+	primaryCoinType := wire.GetPrimaryCoinType(&mtx)
 	txReply := types.TxRawDecodeResult{
-		Txid:     mtx.TxHash().String(),
-		Version:  int32(mtx.Version),
-		Locktime: mtx.LockTime,
-		Expiry:   mtx.Expiry,
-		Vin:      createVinList(&mtx, isTreasuryEnabled),
-		Vout:     createVoutList(&mtx, s.cfg.ChainParams, nil),
+		Txid:            mtx.TxHash().String(),
+		Version:         int32(mtx.Version),
+		Locktime:        mtx.LockTime,
+		Expiry:          mtx.Expiry,
+		PrimaryCoinType: uint8(primaryCoinType),
+		MinRelayFee: s.minRelayTxFeeForCoinType(s.cfg.ChainParams,
+			primaryCoinType).ToCoin(),
+		Vin:  createVinList(&mtx, isTreasuryEnabled),
+		Vout: createVoutList(&mtx, s.cfg.ChainParams, nil),
 	}
 	return txReply, nil
 }
@@ -1491,6 +1922,40 @@ func handleEstimateFee(_ context.Context, s *Server, cmd interface{}) (interface
 	return s.cfg.MinRelayTxFee.ToCoin(), nil
 }
 
+// handleEstimateFeeAccuracy implements the estimatefeeaccuracy command.
+func handleEstimateFeeAccuracy(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.EstimateFeeAccuracyCmd)
+
+	if s.cfg.CoinTypeFeeCalculator == nil {
+		return nil, rpcMiscError("Fee estimation by coin type is not " +
+			"available")
+	}
+
+	accuracy := s.cfg.CoinTypeFeeCalculator.EstimateAccuracy()
+	results := make([]types.EstimateFeeAccuracyResult, 0, len(accuracy))
+	for _, a := range accuracy {
+		if c.CoinType != nil && a.CoinType != cointype.CoinType(*c.CoinType) {
+			continue
+		}
+		result := types.EstimateFeeAccuracyResult{
+			CoinType: uint8(a.CoinType),
+			Name:     generateCoinTypeName(a.CoinType),
+			Samples:  a.Samples,
+			OnTime:   a.OnTime,
+			Late:     a.Late,
+		}
+		if a.Samples > 0 {
+			samples := float64(a.Samples)
+			result.OnTimeRate = float64(a.OnTime) / samples
+			result.AvgPredictedConfs = float64(a.TotalPredictedConfs) / samples
+			result.AvgActualConfs = float64(a.TotalActualConfs) / samples
+			result.MeanAbsError = float64(a.TotalAbsError) / samples
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
 // handleEstimateSmartFee implements the estimatesmartfee command.
 //
 // The default estimation mode when unset is assumed as "conservative". As of
@@ -1915,6 +2380,105 @@ func handleExistsMempoolTxs(_ context.Context, s *Server, cmd interface{}) (inte
 	return hex.EncodeToString([]byte(set)), nil
 }
 
+// handleFundRawTransaction handles fundrawtransaction commands.
+func handleFundRawTransaction(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.FundRawTransactionCmd)
+
+	hexStr := c.HexTx
+	if len(hexStr)%2 != 0 {
+		hexStr = "0" + hexStr
+	}
+	serializedTx, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return nil, rpcDecodeHexError(hexStr)
+	}
+	mtx := wire.NewMsgTx()
+	err = mtx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		return nil, rpcDeserializationError("Could not decode Tx: %v",
+			err)
+	}
+
+	// Coin types are never mixed, so every output must be of the same coin
+	// type, which determines the outputs selected to fund it.
+	if len(mtx.TxIn) != 0 {
+		return nil, rpcInvalidError("Transaction must not have any inputs")
+	}
+	if len(mtx.TxOut) == 0 {
+		return nil, rpcInvalidError("Transaction must have at least one " +
+			"output")
+	}
+	coinType := mtx.TxOut[0].CoinType
+	var outputTotal int64
+	for _, txOut := range mtx.TxOut {
+		if txOut.CoinType != coinType {
+			return nil, rpcInvalidError("Transaction outputs must all be "+
+				"of coin type %d", coinType)
+		}
+		outputTotal += txOut.Value
+	}
+
+	changeScriptVer, changeScript, err := s.decodePaymentAddress(c.ChangeAddress)
+	if err != nil {
+		return nil, err
+	}
+	feeRate, err := s.fundingFeeRate(coinType, c.Options)
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := s.spendableFundingInputs(c.Outpoints, coinType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add inputs in order of descending amount until they cover the outputs
+	// and the fee.  Any remainder is returned to the change address unless
+	// it would be dust, in which case it is added to the fee instead.
+	changeOut := &wire.TxOut{
+		CoinType: coinType,
+		Version:  changeScriptVer,
+		PkScript: changeScript,
+	}
+	var inputTotal int64
+	for i := range inputs {
+		addFundingInput(mtx, &inputs[i])
+		inputTotal += inputs[i].amount
+
+		size := estimateSignedSize(mtx)
+		if inputTotal < outputTotal+feeForSize(size, feeRate) {
+			continue
+		}
+
+		changePos := int32(-1)
+		sizeWithChange := size + int64(changeOut.SerializeSize())
+		changeOut.Value = inputTotal - outputTotal -
+			feeForSize(sizeWithChange, feeRate)
+		if changeOut.Value > 0 && !isDustOutput(changeOut, feeRate) {
+			changePos = int32(len(mtx.TxOut))
+			mtx.AddTxOut(changeOut)
+		}
+
+		mtxHex, err := s.messageToHex(mtx)
+		if err != nil {
+			return nil, err
+		}
+		fee := inputTotal - outputTotal
+		if changePos != -1 {
+			fee -= changeOut.Value
+		}
+		return &types.FundRawTransactionResult{
+			Hex:       mtxHex,
+			Fee:       dcrutil.Amount(fee).ToCoin(),
+			CoinType:  uint8(coinType),
+			ChangePos: changePos,
+		}, nil
+	}
+
+	return nil, dcrjson.NewRPCError(dcrjson.ErrRPCWalletInsufficientFunds,
+		fmt.Sprintf("Insufficient spendable outputs of coin type %d to "+
+			"fund %v plus fees", coinType, dcrutil.Amount(outputTotal)))
+}
+
 // handleGenerate handles generate commands.
 func handleGenerate(ctx context.Context, s *Server, cmd interface{}) (interface{}, error) {
 	// Respond with an error if there are no addresses to pay the
@@ -2037,6 +2601,131 @@ func handleGetAddedNodeInfo(_ context.Context, s *Server, cmd interface{}) (inte
 	return results, nil
 }
 
+// handleGetAddressReceived implements the getaddressreceived command.  It sums
+// the amounts of all outputs of the provided coin type paid to a watched
+// address with at least the requested number of confirmations, regardless of
+// whether or not they have since been spent.  Outputs paying to the address in
+// the mempool are included when the minimum number of confirmations is zero.
+func handleGetAddressReceived(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.GetAddressReceivedCmd)
+
+	// Decode the provided address.  This also ensures the network encoded with
+	// the address matches the network the server is currently on.
+	addr, err := stdaddr.DecodeAddress(c.Address, s.cfg.ChainParams)
+	if err != nil {
+		return nil, rpcAddressKeyError("Could not decode address: %v",
+			err)
+	}
+
+	coinType := cointype.CoinType(c.CoinType)
+	if !isWatchOnlyCoinType(s.cfg.ChainParams, coinType) {
+		return nil, rpcInvalidError("Coin type %d is not supported on this "+
+			"network", c.CoinType)
+	}
+
+	minConf := int64(1)
+	if c.MinConf != nil {
+		minConf = *c.MinConf
+	}
+	if minConf < 0 {
+		return nil, rpcInvalidError("Minimum confirmations %d must not be "+
+			"negative", minConf)
+	}
+
+	watchOnlyIndex, tHeight, err := syncedWatchOnlyIndexer(s)
+	if err != nil {
+		return nil, err
+	}
+	received, err := watchOnlyIndex.ReceivedOutputs(addr, coinType)
+	if err != nil {
+		if errors.Is(err, indexers.ErrAddressNotWatched) {
+			return nil, rpcInvalidError("Address %s is not watched for coin "+
+				"type %d (import it with importwatchonlyaddress)", c.Address,
+				c.CoinType)
+		}
+		if errors.Is(err, indexers.ErrUnsupportedAddressType) {
+			return nil, rpcInvalidError("Unsupported address type: %v", addr)
+		}
+		return nil, rpcInternalErr(err, "Could not fetch received outputs")
+	}
+
+	// Sum the amounts in atoms before converting to coins to avoid
+	// accumulating rounding errors.
+	var atoms, count int64
+	for i := range received {
+		output := &received[i]
+		if tHeight-output.BlockHeight+1 < minConf {
+			continue
+		}
+		atoms += output.Amount
+		count++
+	}
+
+	if minConf == 0 {
+		addrStr := addr.String()
+		params := s.cfg.ChainParams
+		for _, desc := range s.cfg.TxMempooler.TxDescs() {
+			for _, txOut := range desc.Tx.MsgTx().TxOut {
+				if txOut.CoinType != coinType {
+					continue
+				}
+				_, addrs := stdscript.ExtractAddrs(txOut.Version,
+					txOut.PkScript, params)
+				for _, a := range addrs {
+					if a.String() == addrStr {
+						atoms += txOut.Value
+						count++
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return types.GetAddressReceivedResult{
+		Address:     c.Address,
+		CoinType:    c.CoinType,
+		MinConf:     minConf,
+		Height:      tHeight,
+		Received:    dcrutil.Amount(atoms).ToCoinType(coinType),
+		OutputCount: count,
+	}, nil
+}
+
+// handleGetAllocationDivergence implements the getallocationdivergence
+// command.
+func handleGetAllocationDivergence(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
+	monitor := s.cfg.AllocDivergenceMonitor
+	if monitor == nil {
+		return nil, rpcMiscError("The block space allocation divergence " +
+			"self-check is not enabled")
+	}
+
+	stats := monitor.Stats()
+	result := types.GetAllocationDivergenceResult{
+		BlocksChecked:   stats.BlocksChecked,
+		DivergentBlocks: stats.DivergentBlocks,
+		Window:          blockalloc.DivergenceWindow,
+		Threshold:       blockalloc.DivergenceThreshold,
+		Miners: make([]types.AllocationDivergenceMinerResult, 0,
+			len(stats.Miners)),
+	}
+	for i := range stats.Miners {
+		m := &stats.Miners[i]
+		result.Miners = append(result.Miners,
+			types.AllocationDivergenceMinerResult{
+				Miner:               m.Miner,
+				Blocks:              m.Blocks,
+				DivergentBlocks:     m.DivergentBlocks,
+				RecentDivergent:     m.RecentDivergent,
+				LastHeight:          m.LastHeight,
+				LastDivergentHeight: m.LastDivergentHeight,
+				Flagged:             m.Flagged,
+			})
+	}
+	return result, nil
+}
+
 // handleGetBestBlock implements the getbestblock command.
 func handleGetBestBlock(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
 	// All other "get block" commands give either the height, the hash, or
@@ -2335,6 +3024,7 @@ func handleGetBlockchainInfo(_ context.Context, s *Server, _ interface{}) (inter
 		Difficulty:           best.Bits,
 		DifficultyRatio:      getDifficultyRatio(best.Bits, params),
 		MaxBlockSize:         maxBlockSize,
+		SKAConfigHash:        params.SKAConfigHash().String(),
 		Deployments:          dInfo,
 	}
 
@@ -2462,17 +3152,213 @@ func handleGetBlockHeader(_ context.Context, s *Server, cmd interface{}) (interf
 	return blockHeaderReply, nil
 }
 
-// handleGetBlockSubsidy implements the getblocksubsidy command.
-func handleGetBlockSubsidy(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
-	c := cmd.(*types.GetBlockSubsidyCmd)
+// blockSpacePolicyResult returns the provided block space policy as the result
+// of the getblockspacepolicy and setblockspacepolicy commands.
+func blockSpacePolicyResult(policy *mining.BlockSpacePolicy) *types.GetBlockSpacePolicyResult {
+	coinTypes := policy.CappedCoinTypes()
+	caps := make([]types.BlockSpaceCoinTypeCap, 0, len(coinTypes))
+	for _, coinType := range coinTypes {
+		caps = append(caps, types.BlockSpaceCoinTypeCap{
+			CoinType: uint8(coinType),
+			Name:     generateCoinTypeName(coinType),
+			MaxBytes: policy.MaxCoinTypeBytes[coinType],
+		})
+	}
+	return &types.GetBlockSpacePolicyResult{
+		OverflowStrategy:  policy.OverflowStrategy.String(),
+		StakeReserveBytes: policy.StakeReserveBytes,
+		CoinTypeCaps:      caps,
+	}
+}
 
-	height := c.Height
-	voters := c.Voters
+// handleGetBlockSpacePolicy implements the getblockspacepolicy command.
+func handleGetBlockSpacePolicy(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
+	bt := s.cfg.BlockTemplater
+	if bt == nil {
+		err := errors.New("node is not configured for mining")
+		return nil, rpcInternalErr(err, "")
+	}
+	policy := bt.BlockSpacePolicy()
+	return blockSpacePolicyResult(&policy), nil
+}
 
-	// Determine which agendas are active as of the provided height when that
-	// height exists in the main chain or as of the current best tip otherwise.
+// handleGetBlockSpends implements the getblockspends command.
+func handleGetBlockSpends(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.GetBlockSpendsCmd)
 	chain := s.cfg.Chain
-	best := chain.BestSnapshot()
+	chainParams := s.cfg.ChainParams
+
+	hash, err := chainhash.NewHashFromStr(c.Hash)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Hash)
+	}
+
+	// The spend journal is only available for blocks in the main chain.
+	if !chain.MainChainHasBlock(hash) {
+		return nil, &dcrjson.RPCError{
+			Code: dcrjson.ErrRPCBlockNotFound,
+			Message: fmt.Sprintf("Block %v is not in the main chain",
+				c.Hash),
+		}
+	}
+	spends, err := chain.FetchBlockSpends(hash)
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch block spends")
+	}
+
+	// Summarize the spent outputs by coin type in ascending order of coin
+	// type, optionally limited to a single coin type.
+	verbose := c.Verbose != nil && *c.Verbose
+	type coinTypeSpends struct {
+		result    types.BlockSpendsCoinType
+		addresses map[string]struct{}
+		weighted  float64
+	}
+	byCoinType := make(map[cointype.CoinType]*coinTypeSpends)
+	for i := range spends.Outputs {
+		spent := &spends.Outputs[i]
+		if c.CoinType != nil && spent.CoinType != cointype.CoinType(*c.CoinType) {
+			continue
+		}
+		summary, ok := byCoinType[spent.CoinType]
+		if !ok {
+			summary = &coinTypeSpends{
+				result: types.BlockSpendsCoinType{
+					CoinType: uint8(spent.CoinType),
+					Name:     generateCoinTypeName(spent.CoinType),
+				},
+				addresses: make(map[string]struct{}),
+			}
+			byCoinType[spent.CoinType] = summary
+		}
+
+		var address string
+		_, addrs := stdscript.ExtractAddrs(spent.ScriptVersion,
+			spent.PkScript, chainParams)
+		if len(addrs) == 1 {
+			address = addrs[0].String()
+			summary.addresses[address] = struct{}{}
+		}
+		summary.result.Count++
+		summary.result.Amount += spent.Amount
+		summary.weighted += float64(spent.Amount) *
+			float64(spends.Height-spent.BlockHeight)
+		if verbose {
+			summary.result.Outputs = append(summary.result.Outputs,
+				types.BlockSpentOutput{
+					TxHash:       spent.OutPoint.Hash.String(),
+					Tree:         spent.OutPoint.Tree,
+					Vout:         spent.OutPoint.Index,
+					SpendingTx:   spent.SpendingTx.String(),
+					Vin:          spent.SpendingInput,
+					Height:       spent.BlockHeight,
+					Amount:       spent.Amount,
+					Version:      spent.ScriptVersion,
+					ScriptPubKey: hex.EncodeToString(spent.PkScript),
+					Address:      address,
+					CoinBase:     spent.IsCoinBase,
+				})
+		}
+	}
+
+	result := &types.GetBlockSpendsResult{
+		Hash:        spends.Hash.String(),
+		Height:      spends.Height,
+		Disapproved: spends.Disapproved,
+		CoinTypes:   make([]types.BlockSpendsCoinType, 0, len(byCoinType)),
+	}
+	for _, summary := range byCoinType {
+		summary.result.Addresses = int64(len(summary.addresses))
+		if summary.result.Amount > 0 {
+			summary.result.AvgAge = summary.weighted /
+				float64(summary.result.Amount)
+		}
+		result.CoinTypes = append(result.CoinTypes, summary.result)
+	}
+	sort.Slice(result.CoinTypes, func(i, j int) bool {
+		return result.CoinTypes[i].CoinType < result.CoinTypes[j].CoinType
+	})
+	return result, nil
+}
+
+// handleGetBlockStats implements the getblockstats command.
+func handleGetBlockStats(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.GetBlockStatsCmd)
+
+	hash, err := chainhash.NewHashFromStr(c.Hash)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Hash)
+	}
+
+	// The per coin type statistics are available for all main chain blocks,
+	// while fee totals are only recorded for main chain blocks connected since
+	// they were first tracked.
+	stats, err := s.cfg.Chain.FetchBlockStats(hash)
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch block stats")
+	}
+	if stats == nil {
+		return nil, &dcrjson.RPCError{
+			Code: dcrjson.ErrRPCBlockNotFound,
+			Message: fmt.Sprintf("No block stats for block %v in the main "+
+				"chain", c.Hash),
+		}
+	}
+	totals, err := s.cfg.Chain.FetchBlockFeeTotals(hash)
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch block fee totals")
+	}
+
+	result := &types.GetBlockStatsResult{
+		Hash:      c.Hash,
+		Height:    stats.Height,
+		Fees:      []types.BlockStatsCoinTypeFees{},
+		CoinTypes: make([]types.BlockStatsCoinType, 0, len(stats.Stats)),
+	}
+	if totals != nil {
+		result.CumulativeStart = totals.StartHeight
+		for _, total := range totals.Totals {
+			result.Fees = append(result.Fees, types.BlockStatsCoinTypeFees{
+				CoinType:       uint8(total.CoinType),
+				Name:           generateCoinTypeName(total.CoinType),
+				Fees:           dcrutil.Amount(total.Fees).ToCoinType(total.CoinType),
+				CumulativeFees: dcrutil.Amount(total.CumulativeFees).ToCoinType(total.CoinType),
+			})
+		}
+	}
+	for _, stat := range stats.Stats {
+		var utilization float64
+		if stat.AllocatedBytes > 0 {
+			utilization = float64(stat.TotalBytes) /
+				float64(stat.AllocatedBytes)
+		}
+		coinType := stat.CoinType
+		result.CoinTypes = append(result.CoinTypes, types.BlockStatsCoinType{
+			CoinType:       uint8(coinType),
+			Name:           generateCoinTypeName(coinType),
+			NumTxns:        stat.NumTxns,
+			TotalBytes:     stat.TotalBytes,
+			AllocatedBytes: stat.AllocatedBytes,
+			Utilization:    utilization,
+			MinFeeRate:     dcrutil.Amount(stat.MinFeeRate).ToCoinType(coinType),
+			MedianFeeRate:  dcrutil.Amount(stat.MedianFeeRate).ToCoinType(coinType),
+			MaxFeeRate:     dcrutil.Amount(stat.MaxFeeRate).ToCoinType(coinType),
+		})
+	}
+	return result, nil
+}
+
+// handleGetBlockSubsidy implements the getblocksubsidy command.
+func handleGetBlockSubsidy(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.GetBlockSubsidyCmd)
+
+	height := c.Height
+	voters := c.Voters
+
+	// Determine which agendas are active as of the provided height when that
+	// height exists in the main chain or as of the current best tip otherwise.
+	chain := s.cfg.Chain
+	best := chain.BestSnapshot()
 	prevBlkHash := best.Hash
 	if height <= best.Height {
 		header, err := chain.HeaderByHeight(height)
@@ -2510,6 +3396,76 @@ func handleGetBlockSubsidy(_ context.Context, s *Server, cmd interface{}) (inter
 	return rep, nil
 }
 
+// handleGetBlockTemplate implements the getblocktemplate command.  Only the
+// BIP23 proposal mode is supported since block templates are provided to
+// miners via getwork.
+func handleGetBlockTemplate(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.GetBlockTemplateCmd)
+	switch c.Request.Mode {
+	case "proposal":
+		return handleGetBlockTemplateProposal(s, &c.Request)
+	case "", "template":
+		return nil, rpcInvalidError("Block templates are only provided " +
+			"via getwork -- only the proposal mode is supported")
+	}
+	return nil, rpcInvalidError("Invalid mode %q", c.Request.Mode)
+}
+
+// handleGetBlockTemplateProposal validates the candidate block provided in the
+// passed BIP23 proposal request without submitting it.  The block must build
+// on the current tip of the main chain or its parent and is fully validated,
+// including the SKA emission and block space allocation rules, aside from the
+// proof of work requirement.
+//
+// Blocks that violate a consensus rule are reported via the result as opposed
+// to an error so the miner is informed of the specific rule that was violated.
+func handleGetBlockTemplateProposal(s *Server, request *types.TemplateRequest) (interface{}, error) {
+	if request.Data == "" {
+		return nil, rpcInvalidError("Data must contain the hex-encoded " +
+			"serialized block that is being proposed")
+	}
+	serializedBlock, err := hex.DecodeString(request.Data)
+	if err != nil {
+		return nil, rpcDecodeHexError(request.Data)
+	}
+	block, err := dcrutil.NewBlockFromBytes(serializedBlock)
+	if err != nil {
+		return nil, rpcDeserializationError("Block decode failed: %v", err)
+	}
+
+	result := &types.GetBlockTemplateProposalResult{
+		Hash: block.Hash().String(),
+	}
+	if s.cfg.Chain.HaveBlock(block.Hash()) {
+		result.RejectReason = "duplicate"
+		return result, nil
+	}
+
+	err = s.cfg.Chain.CheckConnectBlockTemplate(block)
+	if err != nil {
+		var rErr blockchain.RuleError
+		if !errors.As(err, &rErr) {
+			context := "Could not validate block proposal"
+			return nil, rpcInternalErr(err, context)
+		}
+
+		log.Debugf("Rejected block proposal %s: %v", block.Hash(), err)
+		result.RejectReason = "rejected"
+		if errors.Is(err, blockchain.ErrInvalidTemplateParent) {
+			result.RejectReason = "inconclusive-not-best-prevblk"
+		}
+		var kind blockchain.ErrorKind
+		if errors.As(err, &kind) {
+			result.ErrorKind = string(kind)
+		}
+		result.Message = err.Error()
+		return result, nil
+	}
+
+	result.Accepted = true
+	return result, nil
+}
+
 // handleGetChainTips implements the getchaintips command.
 func handleGetChainTips(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
 	chainTips := s.cfg.Chain.ChainTips()
@@ -2530,6 +3486,98 @@ func handleGetCoinSupply(_ context.Context, s *Server, cmd interface{}) (interfa
 	return s.cfg.Chain.BestSnapshot().TotalSubsidy, nil
 }
 
+// Emission statuses reported by the getcointypes command.
+const (
+	// emissionStatusSubsidy is the emission status of VAR, which is issued
+	// through the block subsidy instead of an emission.
+	emissionStatusSubsidy = "subsidy"
+
+	// emissionStatusPending is the emission status of a coin type whose next
+	// emission window has not started yet.
+	emissionStatusPending = "pending"
+
+	// emissionStatusActive is the emission status of a coin type whose next
+	// emission window is open.
+	emissionStatusActive = "active"
+
+	// emissionStatusExpired is the emission status of a coin type whose next
+	// emission window closed without the emission taking place.
+	emissionStatusExpired = "expired"
+
+	// emissionStatusComplete is the emission status of a coin type whose
+	// scheduled emissions have all taken place.
+	emissionStatusComplete = "complete"
+)
+
+// currentMinRelayFee returns the minimum relay fee rate per kB currently
+// accepted for the provided coin type.  It falls back to the static minimum
+// relay fee for the coin type when no fee statistics are available.
+func (s *Server) currentMinRelayFee(coinType cointype.CoinType) dcrutil.Amount {
+	if s.cfg.CoinTypeFeeCalculator != nil {
+		stats, err := s.cfg.CoinTypeFeeCalculator.GetFeeStats(coinType)
+		if err == nil {
+			return acceptedMinFeeRate(stats)
+		}
+	}
+	return s.minRelayTxFeeForCoinType(s.cfg.ChainParams, coinType)
+}
+
+// handleGetCoinTypes implements the getcointypes command.
+func handleGetCoinTypes(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
+	chain := s.cfg.Chain
+	chainParams := s.cfg.ChainParams
+	currentHeight := chain.BestSnapshot().Height
+
+	result := make([]types.GetCoinTypesResult, 0, len(chainParams.SKACoins)+1)
+	result = append(result, types.GetCoinTypesResult{
+		CoinType:       uint8(cointype.CoinTypeVAR),
+		Name:           cointype.CoinTypeVAR.String(),
+		Symbol:         cointype.CoinTypeVAR.String(),
+		Active:         true,
+		MaxSupply:      cointype.CoinTypeVAR.MaxAtoms(),
+		EmissionStatus: emissionStatusSubsidy,
+		MinRelayFee:    s.currentMinRelayFee(cointype.CoinTypeVAR).ToCoin(),
+	})
+
+	coinTypes := cointype.SortedKeys(chainParams.SKACoins)
+
+	for _, coinType := range coinTypes {
+		config := chainParams.SKACoins[coinType]
+
+		// Report the emission window of the next tranche to emit, falling
+		// back to the final tranche once all of them have been emitted.
+		emitted := chain.SKAEmissionTranchesEmitted(coinType)
+		schedule := config.EmissionSchedule()
+		tranche := config.NextEmissionTranche(emitted)
+		var status string
+		switch {
+		case tranche == nil:
+			tranche = &schedule[len(schedule)-1]
+			status = emissionStatusComplete
+		case currentHeight < int64(tranche.EmissionHeight):
+			status = emissionStatusPending
+		case currentHeight <= tranche.WindowEnd():
+			status = emissionStatusActive
+		default:
+			status = emissionStatusExpired
+		}
+
+		result = append(result, types.GetCoinTypesResult{
+			CoinType:       uint8(coinType),
+			Name:           config.Name,
+			Symbol:         config.Symbol,
+			Active:         config.Active,
+			MaxSupply:      config.MaxSupply,
+			EmissionHeight: int64(tranche.EmissionHeight),
+			EmissionWindow: int64(tranche.EmissionWindow),
+			EmissionStatus: status,
+			MinRelayFee:    s.currentMinRelayFee(coinType).ToCoin(),
+		})
+	}
+
+	return result, nil
+}
+
 // handleGetConnectionCount implements the getconnectioncount command.
 func handleGetConnectionCount(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
 	return s.cfg.ConnMgr.ConnectedCount(), nil
@@ -2638,6 +3686,51 @@ func handleGetCFilterV2(_ context.Context, s *Server, cmd interface{}) (interfac
 	return result, nil
 }
 
+// indexInfoResult returns the JSON-RPC representation of the provided index
+// sync progress.
+func indexInfoResult(status *indexers.IndexSyncStatus) types.GetIndexInfoResult {
+	// The progress is rounded down so lagging indexes are never reported as
+	// fully synced.
+	progress := 100.0
+	if !status.Synced && status.BestHeight > 0 {
+		progress = float64(status.TipHeight) / float64(status.BestHeight) * 100
+		progress = math.Min(math.Floor(progress*100)/100, 99.99)
+	}
+	remaining := status.EstimatedRemaining.Round(time.Second) / time.Second
+	return types.GetIndexInfoResult{
+		Name:               status.Name,
+		Version:            status.Version,
+		TipHeight:          status.TipHeight,
+		TipHash:            status.TipHash.String(),
+		BestHeight:         status.BestHeight,
+		Synced:             status.Synced,
+		Progress:           progress,
+		EstimatedRemaining: int64(remaining),
+	}
+}
+
+// handleGetIndexInfo implements the getindexinfo command.
+func handleGetIndexInfo(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.GetIndexInfoCmd)
+
+	statuses, err := s.cfg.IndexSyncReporter.SyncStatus()
+	if err != nil {
+		return nil, rpcInternalErr(err, "Index sync status")
+	}
+
+	results := make([]types.GetIndexInfoResult, 0, len(statuses))
+	for i := range statuses {
+		if c.Name != nil && statuses[i].Name != *c.Name {
+			continue
+		}
+		results = append(results, indexInfoResult(&statuses[i]))
+	}
+	if c.Name != nil && len(results) == 0 {
+		return nil, rpcInvalidError("Unknown index %q", *c.Name)
+	}
+	return results, nil
+}
+
 // handleGetInfo implements the getinfo command. We only return the fields
 // that are not related to wallet functionality.
 func handleGetInfo(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
@@ -2663,19 +3756,119 @@ func handleGetInfo(_ context.Context, s *Server, _ interface{}) (interface{}, er
 func handleGetMempoolInfo(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
 	mempoolTxns := s.cfg.TxMempooler.TxDescs()
 
+	// Break the totals down by the primary coin type of each transaction.
+	type coinTypeTotals struct {
+		size  int64
+		bytes int64
+		fees  dcrutil.Amount
+	}
 	var numBytes int64
+	totals := make(map[cointype.CoinType]*coinTypeTotals)
 	for _, txD := range mempoolTxns {
-		numBytes += int64(txD.Tx.MsgTx().SerializeSize())
+		msgTx := txD.Tx.MsgTx()
+		txSize := int64(msgTx.SerializeSize())
+		numBytes += txSize
+
+		coinType := wire.GetPrimaryCoinType(msgTx)
+		t, ok := totals[coinType]
+		if !ok {
+			t = new(coinTypeTotals)
+			totals[coinType] = t
+		}
+		t.size++
+		t.bytes += txSize
+		t.fees += dcrutil.Amount(txD.Fee)
+	}
+
+	// Include coin types that only have expired transactions so the expiry
+	// counters remain visible after the pool drains.
+	expiredCounts := s.cfg.TxMempooler.ExpiredTxCounts()
+	for coinType := range expiredCounts {
+		if _, ok := totals[coinType]; !ok {
+			totals[coinType] = new(coinTypeTotals)
+		}
+	}
+
+	// Likewise include coin types with a rolling minimum fee in effect so the
+	// raised relay floor remains visible while it decays.
+	mempoolMinFees := s.cfg.TxMempooler.MempoolMinFees()
+	for coinType := range mempoolMinFees {
+		if _, ok := totals[coinType]; !ok {
+			totals[coinType] = new(coinTypeTotals)
+		}
+	}
+
+	coinTypes := make(map[string]types.MempoolCoinTypeInfo, len(totals))
+	for coinType, t := range totals {
+		chainLimits := s.cfg.TxMempooler.ChainLimits(coinType)
+		info := types.MempoolCoinTypeInfo{
+			CoinType:          uint8(coinType),
+			Name:              generateCoinTypeName(coinType),
+			Size:              t.size,
+			Bytes:             t.bytes,
+			TotalFees:         t.fees.ToCoin(),
+			MempoolMinFee:     dcrutil.Amount(mempoolMinFees[coinType]).ToCoin(),
+			MaxBytes:          s.cfg.TxMempooler.MaxPoolSize(coinType),
+			MaxAncestors:      int64(chainLimits.MaxAncestors),
+			MaxAncestorSize:   chainLimits.MaxAncestorSize,
+			MaxDescendants:    int64(chainLimits.MaxDescendants),
+			MaxDescendantSize: chainLimits.MaxDescendantSize,
+			Expiry:            int64(s.cfg.TxMempooler.MaxTxAge(coinType).Seconds()),
+			Expired:           expiredCounts[coinType],
+		}
+
+		// Include the minimum fee rate currently being accepted for the coin
+		// type when coin-type-specific fees are available.
+		if feeCalc := s.cfg.CoinTypeFeeCalculator; feeCalc != nil {
+			stats, err := feeCalc.GetFeeStats(coinType)
+			if err == nil {
+				info.MinFee = acceptedMinFeeRate(stats).ToCoin()
+			}
+		}
+		coinTypes[info.Name] = info
+	}
+
+	// Include the metrics of the lanes transactions received from peers are
+	// validated in.
+	laneInfo := func(stats netsync.TxLaneStats) types.TxValidationLaneInfo {
+		var avgWait time.Duration
+		if stats.Processed > 0 {
+			avgWait = stats.TotalWait / time.Duration(stats.Processed)
+		}
+		return types.TxValidationLaneInfo{
+			Processed: stats.Processed,
+			Pending:   int64(stats.Pending),
+			AvgWait:   avgWait.Seconds(),
+			MaxWait:   stats.MaxWait.Seconds(),
+		}
 	}
+	stakeLane, regularLane := s.cfg.SyncMgr.TxLaneStats()
 
 	ret := &types.GetMempoolInfoResult{
-		Size:  int64(len(mempoolTxns)),
-		Bytes: numBytes,
+		Size:      int64(len(mempoolTxns)),
+		Bytes:     numBytes,
+		CoinTypes: coinTypes,
+		ValidationLanes: map[string]types.TxValidationLaneInfo{
+			"stake":   laneInfo(stakeLane),
+			"regular": laneInfo(regularLane),
+		},
 	}
 
 	return ret, nil
 }
 
+// acceptedMinFeeRate returns the minimum fee rate per kB currently accepted
+// for the coin type of the provided fee statistics, which is the minimum relay
+// fee scaled by the dynamic fee multiplier and limited to the max fee rate.
+func acceptedMinFeeRate(stats *CoinTypeFeeStats) dcrutil.Amount {
+	feeRate := dcrutil.Amount(float64(stats.MinRelayFee) *
+		stats.DynamicFeeMultiplier)
+	if stats.MaxFeeRate > 0 && feeRate > stats.MaxFeeRate {
+		feeRate = stats.MaxFeeRate
+	}
+	return feeRate
+}
+
 // handleGetMempoolFeesInfo implements the getmempoolfeesinfo command.
 func handleGetMempoolFeesInfo(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
 	c := cmd.(*types.GetMempoolFeesInfoCmd)
@@ -2936,6 +4129,15 @@ func handleGetMiningInfo(ctx context.Context, s *Server, _ interface{}) (interfa
 		PooledTx:         uint64(s.cfg.TxMempooler.Count()),
 		TestNet:          s.cfg.TestNet,
 	}
+	if bt := s.cfg.BlockTemplater; bt != nil {
+		stats := bt.TemplateBudgetStats()
+		result.TemplateBudget = &types.TemplateBudgetResult{
+			Templates:        stats.Templates,
+			PartialTemplates: stats.PartialTemplates,
+			SkippedTxns:      stats.SkippedTxns,
+			SkippedAugments:  stats.SkippedAugments,
+		}
+	}
 	return &result, nil
 }
 
@@ -3151,6 +4353,18 @@ func handleGetPeerInfo(_ context.Context, s *Server, _ interface{}) (interface{}
 			// We actually want microseconds.
 			info.PingWait = wait / 1000
 		}
+		for coinType, stats := range p.TxRelayStats() {
+			info.TxRelayStats = append(info.TxRelayStats, types.PeerTxRelayStats{
+				CoinType:    uint8(coinType),
+				TxSent:      stats.TxSent,
+				TxBytesSent: stats.TxBytesSent,
+				TxRecv:      stats.TxRecv,
+				TxBytesRecv: stats.TxBytesRecv,
+			})
+		}
+		sort.Slice(info.TxRelayStats, func(i, j int) bool {
+			return info.TxRelayStats[i].CoinType < info.TxRelayStats[j].CoinType
+		})
 		infos = append(infos, info)
 	}
 	sort.Slice(infos, func(i, j int) bool {
@@ -3433,6 +4647,51 @@ func handleGetRawTransaction(_ context.Context, s *Server, cmd interface{}) (int
 	return *rawTxn, nil
 }
 
+// handleGetRecentUtilization implements the getrecentutilization command.
+func handleGetRecentUtilization(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.GetRecentUtilizationCmd)
+
+	history := s.cfg.UtilizationHistorian
+	if history == nil {
+		return nil, rpcMiscError("The block space utilization history is " +
+			"not enabled")
+	}
+	count := 10
+	if c.Count != nil {
+		count = *c.Count
+	}
+	if count < 1 {
+		return nil, rpcInvalidError("Count must be at least 1")
+	}
+
+	blocks := history.Recent(count)
+	results := make([]types.GetRecentUtilizationResult, 0, len(blocks))
+	for _, block := range blocks {
+		result := types.GetRecentUtilizationResult{
+			Height:    block.Height,
+			Hash:      block.Hash.String(),
+			CoinTypes: make([]types.CoinTypeUtilizationResult, 0, len(block.CoinTypes)),
+		}
+		for i := range block.CoinTypes {
+			u := &block.CoinTypes[i]
+			if c.CoinType != nil && u.CoinType != cointype.CoinType(*c.CoinType) {
+				continue
+			}
+			result.CoinTypes = append(result.CoinTypes,
+				types.CoinTypeUtilizationResult{
+					CoinType:       uint8(u.CoinType),
+					Name:           generateCoinTypeName(u.CoinType),
+					NumTxns:        u.NumTxns,
+					UsedBytes:      u.UsedBytes,
+					AllocatedBytes: u.AllocatedBytes,
+					Utilization:    u.Utilization(),
+				})
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
 // handleGetStakeDifficulty implements the getstakedifficulty command.
 func handleGetStakeDifficulty(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
 	chain := s.cfg.Chain
@@ -3458,8 +4717,9 @@ func handleGetSKAInfo(_ context.Context, s *Server, _ interface{}) (interface{},
 
 	result := make([]types.GetSKAInfoResult, 0)
 
-	// Get all configured SKA coin types
-	for coinType, cfg := range chainParams.SKACoins {
+	// Get all configured SKA coin types in ascending order.
+	for _, coinType := range cointype.SortedKeys(chainParams.SKACoins) {
+		cfg := chainParams.SKACoins[coinType]
 		result = append(result, types.GetSKAInfoResult{
 			CoinType:    uint8(coinType),
 			Name:        cfg.Name,
@@ -3470,120 +4730,872 @@ func handleGetSKAInfo(_ context.Context, s *Server, _ interface{}) (interface{},
 		})
 	}
 
-	// Sort by coin type for consistent output
-	for i := 0; i < len(result)-1; i++ {
-		for j := i + 1; j < len(result); j++ {
-			if result[i].CoinType > result[j].CoinType {
-				result[i], result[j] = result[j], result[i]
-			}
+	return result, nil
+}
+
+// checkEmissionStateVerified returns an error suitable for RPC clients when the
+// SKA emission state has not been verified against the configured emission
+// checkpoints yet.
+func (s *Server) checkEmissionStateVerified() error {
+	checkpointer := s.cfg.EmissionCheckpointer
+	if checkpointer == nil {
+		return nil
+	}
+	if err := checkpointer.Verified(); err != nil {
+		return &dcrjson.RPCError{
+			Code:    dcrjson.ErrRPCClientInInitialDownload,
+			Message: "SKA emission state is not verified: " + err.Error(),
+		}
+	}
+	return nil
+}
+
+// handleGetEmissionStatus returns the current emission status for a specific SKA coin type.
+func handleGetEmissionStatus(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.GetEmissionStatusCmd)
+
+	// Validate coin type range
+	coinType := cointype.CoinType(c.CoinType)
+	if coinType < 1 || coinType > 255 {
+		return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
+			"coin type must be between 1 and 255 (SKA types)")
+	}
+
+	// Get chain configuration for this coin type
+	chainParams := s.cfg.ChainParams
+	config, exists := chainParams.SKACoins[coinType]
+	if !exists {
+		return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
+			fmt.Sprintf("coin type %d is not configured in chain parameters", c.CoinType))
+	}
+	if err := s.checkEmissionStateVerified(); err != nil {
+		return nil, err
+	}
+
+	// Get current block height
+	best := s.cfg.Chain.BestSnapshot()
+	currentHeight := best.Height
+
+	// Get current nonce from blockchain state (not chain parameters)
+	currentNonce := s.cfg.Chain.GetSKAEmissionNonce(coinType)
+
+	// Calculate emission window boundaries of the next tranche to emit,
+	// falling back to the final tranche once all of them have been emitted.
+	schedule := config.EmissionSchedule()
+	tranche := config.NextEmissionTranche(s.cfg.Chain.SKAEmissionTranchesEmitted(coinType))
+	if tranche == nil {
+		tranche = &schedule[len(schedule)-1]
+	}
+	windowStart := int64(tranche.EmissionHeight)
+	windowEnd := tranche.WindowEnd()
+
+	// Determine if emission window is currently active
+	windowActive := currentHeight >= windowStart && currentHeight <= windowEnd
+
+	// Check if already emitted by examining blockchain state
+	alreadyEmitted := s.cfg.Chain.HasSKAEmissionOccurred(coinType)
+
+	// Calculate circulating supply (max - burned), 0 if not yet emitted
+	var circulatingSupply int64
+	if alreadyEmitted {
+		burnedAmount := s.cfg.Chain.GetSKABurnedAmount(coinType)
+		circulatingSupply = config.MaxSupply - burnedAmount
+	}
+
+	return types.GetEmissionStatusResult{
+		CoinType:          c.CoinType,
+		EmissionHeight:    windowStart,
+		EmissionWindow:    int64(tranche.EmissionWindow),
+		CurrentHeight:     currentHeight,
+		WindowActive:      windowActive,
+		WindowStart:       windowStart,
+		WindowEnd:         windowEnd,
+		CurrentNonce:      currentNonce,
+		NextNonce:         currentNonce + 1,
+		AlreadyEmitted:    alreadyEmitted,
+		MaxSupply:         config.MaxSupply,
+		CirculatingSupply: circulatingSupply,
+	}, nil
+}
+
+// handleGetEmissionRehearsalStatus implements the getemissionrehearsalstatus
+// command.
+func handleGetEmissionRehearsalStatus(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
+	rehearser := s.cfg.EmissionRehearser
+	if rehearser == nil {
+		err := errors.New("emission rehearsal mode is disabled (specify " +
+			"--emissionrehearsal)")
+		return nil, rpcInternalErr(err, "Configuration")
+	}
+
+	statuses := rehearser.Status()
+	result := make([]types.EmissionRehearsalStatusResult, 0, len(statuses))
+	for _, status := range statuses {
+		var txHash string
+		if status.TxHash != nil {
+			txHash = status.TxHash.String()
+		}
+		result = append(result, types.EmissionRehearsalStatusResult{
+			CoinType:    uint8(status.CoinType),
+			State:       string(status.State),
+			WindowStart: status.WindowStart,
+			WindowEnd:   status.WindowEnd,
+			Nonce:       status.Nonce,
+			TxHash:      txHash,
+			Height:      status.LastHeight,
+			LastError:   status.LastError,
+		})
+	}
+	return result, nil
+}
+
+// handleGetEmissionWatchStatus implements the getemissionwatchstatus command.
+func handleGetEmissionWatchStatus(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
+	watcher := s.cfg.EmissionWatcher
+	if watcher == nil {
+		err := errors.New("emission watch mode is disabled (specify " +
+			"--emissionwatch)")
+		return nil, rpcInternalErr(err, "Configuration")
+	}
+
+	statuses := watcher.Status()
+	result := make([]types.EmissionWatchStatusResult, 0, len(statuses))
+	for _, status := range statuses {
+		var pendingTx string
+		if status.PendingTx != nil {
+			pendingTx = status.PendingTx.String()
+		}
+		result = append(result, types.EmissionWatchStatusResult{
+			CoinType:    uint8(status.CoinType),
+			Level:       status.Level.String(),
+			Tranche:     status.Tranche,
+			WindowStart: status.WindowStart,
+			WindowEnd:   status.WindowEnd,
+			PendingTx:   pendingTx,
+			Height:      status.LastHeight,
+		})
+	}
+	return result, nil
+}
+
+// handleGetBurnedCoins implements the getburnedcoins JSON-RPC command.
+func handleGetBurnedCoins(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.GetBurnedCoinsCmd)
+
+	// Get burned amounts from blockchain
+	var burnedAmounts map[cointype.CoinType]int64
+
+	if c.CoinType != nil {
+		// Specific coin type requested
+		coinType := cointype.CoinType(*c.CoinType)
+
+		// Validate coin type is in SKA range (1-255)
+		if !coinType.IsSKA() {
+			return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
+				"coin type must be between 1 and 255 (SKA types)")
+		}
+
+		// Get burned amount for this coin type
+		amount := s.cfg.Chain.GetSKABurnedAmount(coinType)
+		burnedAmounts = map[cointype.CoinType]int64{coinType: amount}
+	} else {
+		// Get all burned amounts
+		burnedAmounts = s.cfg.Chain.GetAllSKABurnedAmounts()
+	}
+
+	// Convert to result format
+	stats := make([]types.GetBurnedCoinsStat, 0, len(burnedAmounts))
+	for coinType, amount := range burnedAmounts {
+		// Skip coin types with zero burns (shouldn't happen but be defensive)
+		if amount == 0 {
+			continue
+		}
+
+		stats = append(stats, types.GetBurnedCoinsStat{
+			CoinType:    uint8(coinType),
+			Name:        coinType.String(),
+			TotalBurned: dcrutil.Amount(amount).ToCoinType(coinType),
+		})
+	}
+
+	return types.GetBurnedCoinsResult{
+		Stats: stats,
+	}, nil
+}
+
+const (
+	// defaultSKABurnsCount is the number of burns returned by the
+	// getskaburns command when no count is provided.
+	defaultSKABurnsCount = 100
+
+	// maxSKABurnsCount is the maximum number of burns that may be requested
+	// by a single getskaburns command.
+	maxSKABurnsCount = 1000
+)
+
+// handleGetSKABurns implements the getskaburns JSON-RPC command.
+func handleGetSKABurns(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.GetSKABurnsCmd)
+
+	coinType := cointype.CoinType(c.CoinType)
+	if !coinType.IsSKA() {
+		return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
+			"coin type must be between 1 and 255 (SKA types)")
+	}
+	var startHeight int64
+	if c.StartHeight != nil {
+		startHeight = *c.StartHeight
+	}
+	if startHeight < 0 {
+		return nil, rpcInvalidError("Start height %d must not be negative",
+			startHeight)
+	}
+	count := int32(defaultSKABurnsCount)
+	if c.Count != nil {
+		count = *c.Count
+	}
+	if count < 1 || count > maxSKABurnsCount {
+		return nil, rpcInvalidError("Count must be in the range [1, %d]",
+			maxSKABurnsCount)
+	}
+
+	chain := s.cfg.Chain
+	burns, journalStart, err := chain.FetchSKABurns(coinType, startHeight,
+		int(count))
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch SKA burns")
+	}
+	results := make([]types.SKABurnResult, 0, len(burns))
+	for _, burn := range burns {
+		results = append(results, types.SKABurnResult{
+			TxHash: chainhash.Hash(burn.TxHash).String(),
+			Vout:   burn.OutIndex,
+			Height: burn.Height,
+			Amount: dcrutil.Amount(burn.Amount).ToCoinType(coinType),
+		})
+	}
+
+	totalBurned := chain.GetSKABurnedAmount(coinType)
+	return types.GetSKABurnsResult{
+		CoinType:     c.CoinType,
+		TotalBurned:  dcrutil.Amount(totalBurned).ToCoinType(coinType),
+		JournalStart: journalStart,
+		Burns:        results,
+	}, nil
+}
+
+// handleGetSKAEmissions implements the getskaemissions JSON-RPC command.
+func handleGetSKAEmissions(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.GetSKAEmissionsCmd)
+
+	coinType := cointype.CoinType(c.CoinType)
+	if !coinType.IsSKA() {
+		return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
+			"coin type must be between 1 and 255 (SKA types)")
+	}
+	if err := s.checkEmissionStateVerified(); err != nil {
+		return nil, err
+	}
+
+	emissionIndex := s.cfg.EmissionIndexer
+	if emissionIndex == nil {
+		err := errors.New("emission index disabled")
+		return nil, rpcInternalErr(err, "Configuration")
+	}
+	emissions, err := emissionIndex.Emissions(coinType)
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch SKA emissions")
+	}
+
+	best := s.cfg.Chain.BestSnapshot()
+	results := make([]types.SKAEmissionResult, 0, len(emissions))
+	for _, emission := range emissions {
+		outputs := make([]types.SKAEmissionOutputResult, 0,
+			len(emission.Outputs))
+		for _, out := range emission.Outputs {
+			outputs = append(outputs, types.SKAEmissionOutputResult{
+				Vout:         out.Index,
+				Amount:       dcrutil.Amount(out.Value).ToCoinType(coinType),
+				Version:      out.Version,
+				ScriptPubKey: hex.EncodeToString(out.PkScript),
+			})
+		}
+		confirmations := best.Height - emission.Height + 1
+		results = append(results, types.SKAEmissionResult{
+			BlockHash:     emission.BlockHash.String(),
+			Height:        emission.Height,
+			TxHash:        emission.TxHash.String(),
+			Nonce:         emission.Nonce,
+			Confirmations: confirmations,
+			Final:         confirmations >= s.cfg.EmissionFinalConfs,
+			Outputs:       outputs,
+		})
+	}
+
+	return types.GetSKAEmissionsResult{
+		CoinType:  c.CoinType,
+		Emissions: results,
+	}, nil
+}
+
+// Statuses reported for SKA emissions by the getskaemissionstatus command.
+const (
+	// skaEmissionStatusPending is the status of emissions in the mempool.
+	skaEmissionStatusPending = "pending"
+
+	// skaEmissionStatusProvisional is the status of emissions in the main chain
+	// with fewer than the configured number of confirmations, which may still
+	// be rolled back by a reorganization.
+	skaEmissionStatusProvisional = "provisional"
+
+	// skaEmissionStatusFinal is the status of emissions in the main chain with at
+	// least the configured number of confirmations.
+	skaEmissionStatusFinal = "final"
+)
+
+// handleGetSKAEmissionStatus implements the getskaemissionstatus command.  It
+// reports whether the emission transaction with the provided hash is pending in
+// the mempool or included in the main chain and, in the latter case, whether it
+// reached the configured number of confirmations to be considered final.
+func handleGetSKAEmissionStatus(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.GetSKAEmissionStatusCmd)
+
+	txHash, err := chainhash.NewHashFromStr(c.TxHash)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxHash)
+	}
+	if err := s.checkEmissionStateVerified(); err != nil {
+		return nil, err
+	}
+	finalConfs := s.cfg.EmissionFinalConfs
+
+	// Emissions in the mempool are pending.
+	tx, err := s.cfg.TxMempooler.FetchTransaction(txHash)
+	if err == nil && wire.IsSKAEmissionTransaction(tx.MsgTx()) {
+		msgTx := tx.MsgTx()
+		var nonce uint64 = 1
+		auth, err := blockchain.DecodeEmissionAuthScript(
+			msgTx.TxIn[0].SignatureScript)
+		if err == nil {
+			nonce = auth.Nonce
+		}
+		return types.GetSKAEmissionStatusResult{
+			TxHash:             c.TxHash,
+			Status:             skaEmissionStatusPending,
+			CoinType:           uint8(msgTx.TxOut[0].CoinType),
+			Nonce:              nonce,
+			FinalConfirmations: finalConfs,
+		}, nil
+	}
+
+	emissionIndex := s.cfg.EmissionIndexer
+	if emissionIndex == nil {
+		err := errors.New("emission index disabled")
+		return nil, rpcInternalErr(err, "Configuration")
+	}
+
+	// Search the emissions of every configured coin type in order.
+	chainParams := s.cfg.ChainParams
+	coinTypes := cointype.SortedKeys(chainParams.SKACoins)
+	best := s.cfg.Chain.BestSnapshot()
+	for _, coinType := range coinTypes {
+		emissions, err := emissionIndex.Emissions(coinType)
+		if err != nil {
+			return nil, rpcInternalErr(err, "Could not fetch SKA emissions")
+		}
+		for i := range emissions {
+			emission := &emissions[i]
+			if emission.TxHash != *txHash {
+				continue
+			}
+
+			status := skaEmissionStatusProvisional
+			confirmations := best.Height - emission.Height + 1
+			if confirmations >= finalConfs {
+				status = skaEmissionStatusFinal
+			}
+			return types.GetSKAEmissionStatusResult{
+				TxHash:             c.TxHash,
+				Status:             status,
+				CoinType:           uint8(coinType),
+				Nonce:              emission.Nonce,
+				BlockHash:          emission.BlockHash.String(),
+				Height:             emission.Height,
+				Confirmations:      confirmations,
+				FinalConfirmations: finalConfs,
+			}, nil
+		}
+	}
+
+	return nil, rpcNoTxInfoError(txHash)
+}
+
+// handleGetSKAActivationTimeline implements the getskaactivationtimeline
+// command.  It consolidates the configured activation height and emission
+// schedule of each SKA coin type with the tranches emitted according to the
+// chain state and, when the emission index is enabled, the heights of the
+// first emission and the first regular transaction of the coin type, which
+// mark when it was actually emitted and first used.
+func handleGetSKAActivationTimeline(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.GetSKAActivationTimelineCmd)
+
+	chain := s.cfg.Chain
+	chainParams := s.cfg.ChainParams
+	coinTypes := chainParams.GetAllSKATypes()
+	if c.CoinType != nil {
+		coinType := cointype.CoinType(*c.CoinType)
+		if !coinType.IsSKA() {
+			return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
+				"coin type must be between 1 and 255 (SKA types)")
+		}
+		if chainParams.GetSKACoinConfig(coinType) == nil {
+			return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
+				fmt.Sprintf("coin type %d is not configured", coinType))
+		}
+		coinTypes = []cointype.CoinType{coinType}
+	}
+
+	best := chain.BestSnapshot()
+	emissionIndex := s.cfg.EmissionIndexer
+	results := make([]types.SKAActivationTimelineResult, 0, len(coinTypes))
+	for _, coinType := range coinTypes {
+		config := chainParams.GetSKACoinConfig(coinType)
+		result := types.SKAActivationTimelineResult{
+			CoinType:         uint8(coinType),
+			Name:             config.Name,
+			Symbol:           config.Symbol,
+			Active:           config.Active,
+			ActivationHeight: config.ActivationHeight,
+			TranchesEmitted:  chain.SKAEmissionTranchesEmitted(coinType),
+		}
+		schedule := config.EmissionSchedule()
+		result.Tranches = make([]types.SKAActivationTrancheResult, 0,
+			len(schedule))
+		for i := range schedule {
+			tranche := &schedule[i]
+			result.Tranches = append(result.Tranches,
+				types.SKAActivationTrancheResult{
+					EmissionHeight:    int64(tranche.EmissionHeight),
+					EmissionWindowEnd: tranche.WindowEnd(),
+					Amount: dcrutil.Amount(tranche.TotalAmount()).
+						ToCoinType(coinType),
+					Emitted: uint64(i) < uint64(result.TranchesEmitted),
+				})
+		}
+
+		// Coin types can only be spent in blocks they are active in once
+		// they have been emitted.  Emission outputs are not subject to the
+		// coinbase maturity, so they may be spent in the next block.
+		nextHeight := best.Height + 1
+		if config.Active && nextHeight < config.ActivationHeight {
+			result.BlocksUntilActivate = config.ActivationHeight - nextHeight
+		}
+		result.Spendable = config.IsActiveAtHeight(nextHeight) &&
+			chain.HasSKAEmissionOccurred(coinType)
+
+		if emissionIndex != nil {
+			emissions, err := emissionIndex.Emissions(coinType)
+			if err != nil {
+				return nil, rpcInternalErr(err, "Could not fetch SKA emissions")
+			}
+			if len(emissions) > 0 {
+				result.EmissionHeight = emissions[0].Height
+				result.EmissionTxHash = emissions[0].TxHash.String()
+			}
+			first, err := emissionIndex.FirstTransaction(coinType)
+			if err != nil {
+				return nil, rpcInternalErr(err, "Could not fetch first "+
+					"transaction")
+			}
+			if first != nil {
+				result.FirstTxHeight = first.Height
+				result.FirstTxHash = first.TxHash.String()
+			}
+		}
+		results = append(results, result)
+	}
+
+	return types.GetSKAActivationTimelineResult{
+		Height:    best.Height,
+		BestBlock: best.Hash.String(),
+		Indexed:   emissionIndex != nil,
+		CoinTypes: results,
+	}, nil
+}
+
+// handleAuditSKASupply implements the auditskasupply command.  It walks the
+// UTXO set to independently compute the supply of every SKA coin type and
+// compares it with the amounts emitted and burned according to the chain
+// state, the emission index when it is enabled, and the max supply defined by
+// the chain parameters.
+func handleAuditSKASupply(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
+	chain := s.cfg.Chain
+	chainParams := s.cfg.ChainParams
+	best := chain.BestSnapshot()
+	stats, err := chain.FetchUtxoStats()
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch UTXO set statistics")
+	}
+
+	// Audit every configured coin type along with any other SKA coin types
+	// that have unspent outputs.
+	coinTypes := make([]cointype.CoinType, 0, len(chainParams.SKACoins))
+	for coinType := range chainParams.SKACoins {
+		coinTypes = append(coinTypes, coinType)
+	}
+	for coinType := range stats.CoinTypes {
+		if _, ok := chainParams.SKACoins[coinType]; !ok && coinType.IsSKA() {
+			coinTypes = append(coinTypes, coinType)
+		}
+	}
+	sort.Slice(coinTypes, func(i, j int) bool {
+		return coinTypes[i] < coinTypes[j]
+	})
+
+	consistent := true
+	results := make([]types.SKASupplyAuditResult, 0, len(coinTypes))
+	for _, coinType := range coinTypes {
+		utxoStats := stats.CoinTypes[coinType]
+		result := types.SKASupplyAuditResult{
+			CoinType:   uint8(coinType),
+			UtxoSupply: utxoStats.Total,
+			UtxoCount:  utxoStats.Utxos,
+		}
+
+		config, ok := chainParams.SKACoins[coinType]
+		if !ok {
+			result.Issues = append(result.Issues, "unspent outputs exist "+
+				"for a coin type that is not configured")
+			result.Discrepancy = result.UtxoSupply
+			consistent = false
+			results = append(results, result)
+			continue
+		}
+		result.Name = config.Name
+		result.MaxSupply = config.MaxSupply
+
+		// Determine the amount emitted according to the chain state from the
+		// tranches of the emission schedule that have been emitted.
+		result.TranchesEmitted = chain.SKAEmissionTranchesEmitted(coinType)
+		schedule := config.EmissionSchedule()
+		for i := range schedule {
+			if uint64(i) >= uint64(result.TranchesEmitted) {
+				break
+			}
+			result.Emitted += schedule[i].TotalAmount()
+		}
+		if result.Emitted > result.MaxSupply {
+			result.Issues = append(result.Issues, fmt.Sprintf("emitted "+
+				"amount %d exceeds max supply %d", result.Emitted,
+				result.MaxSupply))
+		}
+
+		// Cross-check the emitted amount against the emission transactions
+		// found in the blocks when the emission index is enabled.
+		if emissionIndex := s.cfg.EmissionIndexer; emissionIndex != nil {
+			emissions, err := emissionIndex.Emissions(coinType)
+			if err != nil {
+				return nil, rpcInternalErr(err, "Could not fetch SKA emissions")
+			}
+			var indexedEmitted int64
+			for _, emission := range emissions {
+				for _, out := range emission.Outputs {
+					indexedEmitted += out.Value
+				}
+			}
+			result.IndexedEmitted = &indexedEmitted
+			if indexedEmitted != result.Emitted {
+				result.Issues = append(result.Issues, fmt.Sprintf("emission "+
+					"index amount %d does not match emitted amount %d",
+					indexedEmitted, result.Emitted))
+			}
+		}
+
+		result.Burned = chain.GetSKABurnedAmount(coinType)
+		result.ExpectedSupply = result.Emitted - result.Burned
+		result.Discrepancy = result.UtxoSupply - result.ExpectedSupply
+		if result.Discrepancy != 0 {
+			result.Issues = append(result.Issues, fmt.Sprintf("UTXO supply "+
+				"%d does not match expected supply %d", result.UtxoSupply,
+				result.ExpectedSupply))
+		}
+		if len(result.Issues) > 0 {
+			consistent = false
 		}
+		results = append(results, result)
 	}
 
-	return result, nil
+	return types.AuditSKASupplyResult{
+		Height:      best.Height,
+		BestBlock:   best.Hash.String(),
+		UtxoSetHash: stats.SerializedHash.String(),
+		Consistent:  consistent,
+		CoinTypes:   results,
+	}, nil
 }
 
-// handleGetEmissionStatus returns the current emission status for a specific SKA coin type.
-func handleGetEmissionStatus(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
-	c := icmd.(*types.GetEmissionStatusCmd)
-
-	// Validate coin type range
-	coinType := cointype.CoinType(c.CoinType)
-	if coinType < 1 || coinType > 255 {
-		return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
-			"coin type must be between 1 and 255 (SKA types)")
+// handleGetSupplyScanInfo implements the getsupplyscaninfo command.
+func handleGetSupplyScanInfo(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
+	scanner := s.cfg.SupplyScanner
+	if scanner == nil {
+		err := errors.New("UTXO supply scanner is disabled (specify " +
+			"--supplyscan)")
+		return nil, rpcInternalErr(err, "Configuration")
 	}
 
-	// Get chain configuration for this coin type
-	chainParams := s.cfg.ChainParams
-	config, exists := chainParams.SKACoins[coinType]
-	if !exists {
-		return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
-			fmt.Sprintf("coin type %d is not configured in chain parameters", c.CoinType))
+	status := scanner.Status()
+	result := types.GetSupplyScanInfoResult{
+		Scanning:   status.Scanning,
+		Scanned:    status.Scanned,
+		Scans:      status.Scans,
+		Height:     status.Height,
+		Consistent: status.Consistent,
+		CoinTypes:  make([]types.SupplyScanCoinTypeResult, 0, len(status.CoinTypes)),
+		LastError:  status.LastError,
+	}
+	if status.Scans > 0 {
+		result.LastScanStart = status.LastScanStart.Unix()
+		result.LastScanEnd = status.LastScanEnd.Unix()
+		result.BestBlock = status.Hash.String()
+	}
+	for _, ctStatus := range status.CoinTypes {
+		result.CoinTypes = append(result.CoinTypes, types.SupplyScanCoinTypeResult{
+			CoinType:       uint8(ctStatus.CoinType),
+			UtxoCount:      ctStatus.Utxos,
+			UtxoSupply:     ctStatus.Supply,
+			OutOfBounds:    ctStatus.OutOfBounds,
+			Emitted:        ctStatus.Emitted,
+			IndexedEmitted: ctStatus.IndexedEmitted,
+			Burned:         ctStatus.Burned,
+			ExpectedSupply: ctStatus.ExpectedSupply,
+			Issues:         ctStatus.Issues,
+		})
 	}
+	return result, nil
+}
 
-	// Get current block height
-	best := s.cfg.Chain.BestSnapshot()
-	currentHeight := best.Height
+// handleGetCoinTypeSnapshot implements the getcointypesnapshot command.  It
+// returns all unspent outputs of the requested coin type, or the balances they
+// aggregate to, as of the main chain block at the requested height in a
+// deterministic order so issuers can publish attestations of the snapshot.
+func handleGetCoinTypeSnapshot(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.GetCoinTypeSnapshotCmd)
+	chain := s.cfg.Chain
+	chainParams := s.cfg.ChainParams
 
-	// Calculate emission window boundaries
-	windowStart := int64(config.EmissionHeight)
-	windowEnd := windowStart + int64(config.EmissionWindow)
+	coinType := cointype.CoinType(c.CoinType)
+	height := chain.BestSnapshot().Height
+	if c.Height != nil {
+		if *c.Height < 0 || *c.Height > height {
+			return nil, rpcInvalidError("Height must be in the range [0, %d]",
+				height)
+		}
+		height = *c.Height
+	}
+	snapshot, err := chain.FetchUtxoSnapshot(coinType, height)
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch UTXO snapshot")
+	}
+
+	result := types.GetCoinTypeSnapshotResult{
+		CoinType:  c.CoinType,
+		Height:    snapshot.Height,
+		BlockHash: snapshot.Hash.String(),
+		Total:     snapshot.Total,
+		UtxoCount: int64(len(snapshot.Entries)),
+	}
+
+	// snapshotAddress returns the address paid by the provided output script
+	// or an empty string when it does not pay exactly one address.
+	snapshotAddress := func(entry *blockchain.UtxoSnapshotEntry) string {
+		_, addrs := stdscript.ExtractAddrs(entry.ScriptVersion,
+			entry.PkScript, chainParams)
+		if len(addrs) != 1 {
+			return ""
+		}
+		return addrs[0].String()
+	}
+
+	if c.Aggregate == nil || !*c.Aggregate {
+		result.Utxos = make([]types.CoinTypeSnapshotUtxo, 0,
+			len(snapshot.Entries))
+		for i := range snapshot.Entries {
+			entry := &snapshot.Entries[i]
+			result.Utxos = append(result.Utxos, types.CoinTypeSnapshotUtxo{
+				TxHash:       entry.OutPoint.Hash.String(),
+				Tree:         entry.OutPoint.Tree,
+				Vout:         entry.OutPoint.Index,
+				Height:       entry.BlockHeight,
+				Amount:       entry.Amount,
+				Version:      entry.ScriptVersion,
+				ScriptPubKey: hex.EncodeToString(entry.PkScript),
+				Address:      snapshotAddress(entry),
+			})
+		}
+		return result, nil
+	}
 
-	// Determine if emission window is currently active
-	windowActive := currentHeight >= windowStart && currentHeight <= windowEnd
+	// Aggregate the outputs by the address they pay and fall back to the
+	// script for outputs that do not pay exactly one address.
+	type balanceKey struct {
+		address string
+		script  string
+	}
+	balances := make(map[balanceKey]*types.CoinTypeSnapshotBalance)
+	for i := range snapshot.Entries {
+		entry := &snapshot.Entries[i]
+		key := balanceKey{address: snapshotAddress(entry)}
+		if key.address == "" {
+			key.script = hex.EncodeToString(entry.PkScript)
+		}
+		balance, ok := balances[key]
+		if !ok {
+			balance = &types.CoinTypeSnapshotBalance{
+				Address:      key.address,
+				ScriptPubKey: key.script,
+			}
+			balances[key] = balance
+		}
+		balance.Amount += entry.Amount
+		balance.Utxos++
+	}
+	result.Balances = make([]types.CoinTypeSnapshotBalance, 0, len(balances))
+	for _, balance := range balances {
+		result.Balances = append(result.Balances, *balance)
+	}
+	sort.Slice(result.Balances, func(i, j int) bool {
+		a, b := &result.Balances[i], &result.Balances[j]
+		if a.Address != b.Address {
+			return a.Address < b.Address
+		}
+		return a.ScriptPubKey < b.ScriptPubKey
+	})
+	return result, nil
+}
 
-	// Get current nonce from blockchain state (not chain parameters)
-	currentNonce := s.cfg.Chain.GetSKAEmissionNonce(coinType)
+// handleEncodeEmissionAuth implements the encodeemissionauth command.  It
+// converts an emission authorization descriptor to the authorization script
+// and the emission transaction it describes for the active network.  The
+// signature of signed descriptors is verified so that descriptors returned
+// from an offline signer are checked before the transaction is broadcast.
+func handleEncodeEmissionAuth(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.EncodeEmissionAuthCmd)
 
-	// Check if already emitted by examining blockchain state
-	alreadyEmitted := s.cfg.Chain.HasSKAEmissionOccurred(coinType)
+	descriptor, err := blockchain.ParseEmissionAuthDescriptor([]byte(c.Descriptor))
+	if err != nil {
+		return nil, rpcInvalidError("%v", err)
+	}
+	signed := descriptor.Signature != ""
+	if signed {
+		if err := descriptor.Verify(s.cfg.ChainParams); err != nil {
+			return nil, rpcInvalidError("Invalid emission authorization "+
+				"signature: %v", err)
+		}
+	}
 
-	// Calculate circulating supply (max - burned), 0 if not yet emitted
-	var circulatingSupply int64
-	if alreadyEmitted {
-		burnedAmount := s.cfg.Chain.GetSKABurnedAmount(coinType)
-		circulatingSupply = config.MaxSupply - burnedAmount
+	canonical, err := descriptor.Canonical()
+	if err != nil {
+		return nil, rpcInvalidError("%v", err)
+	}
+	authScript, err := descriptor.AuthScript()
+	if err != nil {
+		return nil, rpcInvalidError("%v", err)
+	}
+	tx, err := descriptor.Transaction(s.cfg.ChainParams)
+	if err != nil {
+		return nil, rpcInvalidError("%v", err)
+	}
+	txBytes, err := tx.Bytes()
+	if err != nil {
+		return nil, rpcInternalErr(err, "Failed to serialize emission "+
+			"transaction")
 	}
 
-	return types.GetEmissionStatusResult{
-		CoinType:          c.CoinType,
-		EmissionHeight:    windowStart,
-		EmissionWindow:    int64(config.EmissionWindow),
-		CurrentHeight:     currentHeight,
-		WindowActive:      windowActive,
-		WindowStart:       windowStart,
-		WindowEnd:         windowEnd,
-		CurrentNonce:      currentNonce,
-		NextNonce:         currentNonce + 1,
-		AlreadyEmitted:    alreadyEmitted,
-		MaxSupply:         config.MaxSupply,
-		CirculatingSupply: circulatingSupply,
+	return types.EncodeEmissionAuthResult{
+		Descriptor: string(canonical),
+		AuthScript: hex.EncodeToString(authScript),
+		Hex:        hex.EncodeToString(txBytes),
+		Signed:     signed,
 	}, nil
 }
 
-// handleGetBurnedCoins implements the getburnedcoins JSON-RPC command.
-func handleGetBurnedCoins(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
-	c := icmd.(*types.GetBurnedCoinsCmd)
-
-	// Get burned amounts from blockchain
-	var burnedAmounts map[cointype.CoinType]int64
-
-	if c.CoinType != nil {
-		// Specific coin type requested
-		coinType := cointype.CoinType(*c.CoinType)
-
-		// Validate coin type is in SKA range (1-255)
-		if !coinType.IsSKA() {
-			return nil, dcrjson.NewRPCError(dcrjson.ErrRPCInvalidParameter,
-				"coin type must be between 1 and 255 (SKA types)")
-		}
+// handleDecodeEmissionAuth implements the decodeemissionauth command.
+func handleDecodeEmissionAuth(_ context.Context, _ *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.DecodeEmissionAuthCmd)
 
-		// Get burned amount for this coin type
-		amount := s.cfg.Chain.GetSKABurnedAmount(coinType)
-		burnedAmounts = map[cointype.CoinType]int64{coinType: amount}
-	} else {
-		// Get all burned amounts
-		burnedAmounts = s.cfg.Chain.GetAllSKABurnedAmounts()
+	script, err := hex.DecodeString(c.HexScript)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.HexScript)
 	}
+	descriptor, err := blockchain.DecodeEmissionAuthScript(script)
+	if err != nil {
+		return nil, rpcDeserializationError("Could not decode emission "+
+			"authorization script: %v", err)
+	}
+
+	return types.DecodeEmissionAuthResult{
+		CoinType:  descriptor.CoinType,
+		Nonce:     descriptor.Nonce,
+		Amount:    descriptor.Amount,
+		Height:    descriptor.Height,
+		Addresses: descriptor.Addresses,
+		Amounts:   descriptor.Amounts,
+		PubKey:    descriptor.PubKey,
+		Signature: descriptor.Signature,
+	}, nil
+}
 
-	// Convert to result format
-	stats := make([]types.GetBurnedCoinsStat, 0, len(burnedAmounts))
-	for coinType, amount := range burnedAmounts {
-		// Skip coin types with zero burns (shouldn't happen but be defensive)
-		if amount == 0 {
+// handleGetEmissionKeys implements the getemissionkeys command.
+func handleGetEmissionKeys(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
+	chainParams := s.cfg.ChainParams
+	result := make([]types.GetEmissionKeysResult, 0, len(chainParams.SKACoins))
+	for _, coinType := range cointype.SortedKeys(chainParams.SKACoins) {
+		config := chainParams.SKACoins[coinType]
+		if config.EmissionKey == nil {
 			continue
 		}
-
-		stats = append(stats, types.GetBurnedCoinsStat{
+		result = append(result, types.GetEmissionKeysResult{
 			CoinType:    uint8(coinType),
-			Name:        coinType.String(),
-			TotalBurned: dcrutil.Amount(amount).ToCoinType(coinType),
+			Name:        config.Name,
+			PubKey:      hex.EncodeToString(config.EmissionKey.SerializeCompressed()),
+			Fingerprint: blockchain.EmissionKeyFingerprint(config.EmissionKey),
+			Placeholder: chaincfg.IsPlaceholderEmissionKey(config.EmissionKey),
 		})
 	}
+	return result, nil
+}
 
-	return types.GetBurnedCoinsResult{
-		Stats: stats,
-	}, nil
+// handleAttestEmissionKey implements the attestemissionkey command.
+func handleAttestEmissionKey(_ context.Context, s *Server, icmd interface{}) (interface{}, error) {
+	c := icmd.(*types.AttestEmissionKeyCmd)
+
+	coinType := cointype.CoinType(c.CoinType)
+	emissionKey := s.cfg.ChainParams.GetSKAEmissionKey(coinType)
+	if emissionKey == nil {
+		return nil, rpcInvalidError("No emission key configured for coin "+
+			"type %d", c.CoinType)
+	}
+	challenge, err := hex.DecodeString(c.Challenge)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Challenge)
+	}
+	signature, err := hex.DecodeString(c.Signature)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Signature)
+	}
+
+	result := types.AttestEmissionKeyResult{
+		CoinType:    c.CoinType,
+		Fingerprint: blockchain.EmissionKeyFingerprint(emissionKey),
+		Valid:       true,
+	}
+	err = blockchain.VerifyEmissionKeyAttestation(coinType, challenge,
+		signature, s.cfg.ChainParams)
+	if err != nil {
+		result.Valid = false
+		result.Reason = err.Error()
+	}
+	return result, nil
 }
 
 // convertVersionMap translates a map[int]int into a sorted array of
@@ -4000,6 +6012,31 @@ func handleGetTreasurySpendVotes(_ context.Context, s *Server, cmd interface{})
 	}, nil
 }
 
+// handleGetValidationStats implements the getvalidationstats command.
+func handleGetValidationStats(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
+	// toMs converts the provided duration to fractional milliseconds.
+	toMs := func(d time.Duration) float64 {
+		return float64(d) / float64(time.Millisecond)
+	}
+
+	stats := s.cfg.Chain.ValidationStats()
+	result := types.GetValidationStatsResult{
+		Stages: make([]types.ValidationStageStats, 0, len(stats)),
+	}
+	for i := range stats {
+		stage := &stats[i]
+		result.Stages = append(result.Stages, types.ValidationStageStats{
+			Stage:     stage.Stage.String(),
+			Count:     stage.Count,
+			TotalMs:   toMs(stage.Total),
+			AverageMs: toMs(stage.Average()),
+			MaxMs:     toMs(stage.Max),
+			LastMs:    toMs(stage.Last),
+		})
+	}
+	return result, nil
+}
+
 // handleGetVoteInfo implements the getvoteinfo command.
 func handleGetVoteInfo(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
 	c := cmd.(*types.GetVoteInfoCmd)
@@ -4147,6 +6184,7 @@ func handleGetTxOut(_ context.Context, s *Server, cmd interface{}) (interface{},
 	var scriptVersion uint16
 	var pkScript []byte
 	var isCoinbase bool
+	var coinType cointype.CoinType
 	var isTreasuryEnabled bool
 	includeMempool := true
 	if c.IncludeMempool != nil {
@@ -4195,6 +6233,7 @@ func handleGetTxOut(_ context.Context, s *Server, cmd interface{}) (interface{},
 		scriptVersion = txOut.Version
 		pkScript = txOut.PkScript
 		isCoinbase = standalone.IsCoinBaseTx(mtx, isTreasuryEnabled)
+		coinType = txOut.CoinType
 	} else {
 		outpoint := wire.OutPoint{Hash: *txHash, Index: c.Vout, Tree: c.Tree}
 		entry, err := chain.FetchUtxoEntry(outpoint)
@@ -4218,6 +6257,7 @@ func handleGetTxOut(_ context.Context, s *Server, cmd interface{}) (interface{},
 		scriptVersion = entry.ScriptVersion()
 		pkScript = entry.PkScript()
 		isCoinbase = entry.IsCoinBase()
+		coinType = entry.CoinType()
 	}
 
 	// Disassemble script into single line printable format.  The
@@ -4249,7 +6289,22 @@ func handleGetTxOut(_ context.Context, s *Server, cmd interface{}) (interface{},
 			Addresses: addresses,
 			Version:   scriptVersion,
 		},
-		Coinbase: isCoinbase,
+		Coinbase:       isCoinbase,
+		CoinType:       uint8(coinType),
+		CoinTypeActive: true,
+	}
+
+	// Provide the supply context of SKA outputs so explorers do not have to
+	// look up the configuration of the coin type.
+	if coinType.IsSKA() {
+		chainParams := s.cfg.ChainParams
+		txOutReply.CoinTypeActive = chainParams.IsSKACoinTypeActiveAtHeight(
+			coinType, best.Height+1)
+		config := chainParams.GetSKACoinConfig(coinType)
+		if config != nil && config.MaxSupply > 0 {
+			fraction := float64(value) / float64(config.MaxSupply)
+			txOutReply.SupplyFraction = &fraction
+		}
 	}
 	return txOutReply, nil
 }
@@ -4340,6 +6395,164 @@ func serializeGetWorkData(header *wire.BlockHeader, isBlake3PowActive bool) ([]b
 	return data, nil
 }
 
+// syncedWatchOnlyIndexer returns the watch-only index along with the height
+// of its tip once it is synced to the main chain tip.  An error is returned
+// when the index is disabled or does not sync in time.
+func syncedWatchOnlyIndexer(s *Server) (WatchOnlyIndexer, int64, error) {
+	watchOnlyIndex := s.cfg.WatchOnlyIndexer
+	if watchOnlyIndex == nil {
+		err := errors.New("watch-only index disabled")
+		return nil, 0, rpcInternalErr(err, "Configuration")
+	}
+
+	tHeight, tHash, err := watchOnlyIndex.Tip()
+	if err != nil {
+		return nil, 0, rpcInternalErr(err, "Watch-only index tip")
+	}
+
+	chain := s.cfg.Chain
+
+	// Return an out-of-sync error if index is lagging a
+	// maximum reorg depth (6) blocks or more from the chain tip.
+	if chain.BestSnapshot().Height > (tHeight + 5) {
+		err := fmt.Errorf("%s: index not synced", watchOnlyIndex.Name())
+		return nil, 0, rpcInternalErr(err, "Sync")
+	}
+
+	timer := time.NewTimer(syncWait)
+	defer timer.Stop()
+
+	for !chain.BestSnapshot().Hash.IsEqual(tHash) {
+		timer.Reset(syncWait)
+		select {
+		case <-timer.C:
+			err := fmt.Errorf("%s: index not synced", watchOnlyIndex.Name())
+			return nil, 0, rpcInternalErr(err, "Sync")
+		case <-watchOnlyIndex.WaitForSync():
+		}
+
+		tHeight, tHash, err = watchOnlyIndex.Tip()
+		if err != nil {
+			return nil, 0, rpcInternalErr(err, "Watch-only index tip")
+		}
+	}
+
+	return watchOnlyIndex, tHeight, nil
+}
+
+// isWatchOnlyCoinType returns whether or not the provided coin type can be
+// watched by the watch-only index on the active network.
+func isWatchOnlyCoinType(params *chaincfg.Params, coinType cointype.CoinType) bool {
+	if coinType == cointype.CoinTypeVAR {
+		return true
+	}
+	_, ok := params.SKACoins[coinType]
+	return ok
+}
+
+// handleGetWatchOnlyBalance implements the getwatchonlybalance command.
+func handleGetWatchOnlyBalance(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.GetWatchOnlyBalanceCmd)
+
+	minConf := int64(1)
+	if c.MinConf != nil {
+		minConf = *c.MinConf
+	}
+	if minConf < 0 {
+		return nil, rpcInvalidError("Minimum confirmations %d must not be "+
+			"negative", minConf)
+	}
+
+	watchOnlyIndex, tHeight, err := syncedWatchOnlyIndexer(s)
+	if err != nil {
+		return nil, err
+	}
+
+	watched, err := watchOnlyIndex.WatchedAddresses()
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch watched addresses")
+	}
+	utxos, err := watchOnlyIndex.UnspentOutputs()
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch unspent outputs")
+	}
+
+	includeCoinType := func(coinType cointype.CoinType) bool {
+		return c.CoinType == nil || *c.CoinType == uint8(coinType)
+	}
+
+	type balanceKey struct {
+		address  string
+		coinType cointype.CoinType
+	}
+	addrBalances := make(map[balanceKey]*types.WatchOnlyAddressBalance)
+	totals := make(map[cointype.CoinType]*types.WatchOnlyCoinTypeBalance)
+	for _, w := range watched {
+		if !includeCoinType(w.CoinType) {
+			continue
+		}
+		addrBalances[balanceKey{w.Address, w.CoinType}] =
+			&types.WatchOnlyAddressBalance{
+				Address:  w.Address,
+				CoinType: uint8(w.CoinType),
+			}
+		if _, ok := totals[w.CoinType]; !ok {
+			totals[w.CoinType] = &types.WatchOnlyCoinTypeBalance{
+				CoinType: uint8(w.CoinType),
+			}
+		}
+	}
+
+	// Sum the amounts in atoms before converting to coins to avoid
+	// accumulating rounding errors.
+	addrAtoms := make(map[balanceKey]int64)
+	totalAtoms := make(map[cointype.CoinType]int64)
+	for i := range utxos {
+		utxo := &utxos[i]
+		if !includeCoinType(utxo.CoinType) {
+			continue
+		}
+		if tHeight-utxo.BlockHeight+1 < minConf {
+			continue
+		}
+		key := balanceKey{utxo.Address, utxo.CoinType}
+		addrBalance, ok := addrBalances[key]
+		if !ok {
+			continue
+		}
+		addrAtoms[key] += utxo.Amount
+		addrBalance.UtxoCount++
+		totalAtoms[utxo.CoinType] += utxo.Amount
+		totals[utxo.CoinType].UtxoCount++
+	}
+
+	result := types.GetWatchOnlyBalanceResult{
+		Height:    tHeight,
+		Totals:    make([]types.WatchOnlyCoinTypeBalance, 0, len(totals)),
+		Addresses: make([]types.WatchOnlyAddressBalance, 0, len(addrBalances)),
+	}
+	for coinType, total := range totals {
+		total.Balance = dcrutil.Amount(totalAtoms[coinType]).ToCoinType(coinType)
+		result.Totals = append(result.Totals, *total)
+	}
+	for key, addrBalance := range addrBalances {
+		addrBalance.Balance = dcrutil.Amount(addrAtoms[key]).ToCoinType(key.coinType)
+		result.Addresses = append(result.Addresses, *addrBalance)
+	}
+	sort.Slice(result.Totals, func(i, j int) bool {
+		return result.Totals[i].CoinType < result.Totals[j].CoinType
+	})
+	sort.Slice(result.Addresses, func(i, j int) bool {
+		a, b := &result.Addresses[i], &result.Addresses[j]
+		if a.CoinType != b.CoinType {
+			return a.CoinType < b.CoinType
+		}
+		return a.Address < b.Address
+	})
+
+	return result, nil
+}
+
 // handleGetWorkRequest is a helper for handleGetWork which deals with
 // generating and returning work to the caller.
 func handleGetWorkRequest(ctx context.Context, s *Server) (interface{}, error) {
@@ -4689,20 +6902,66 @@ func handleHelp(_ context.Context, s *Server, cmd interface{}) (interface{}, err
 		return usage, nil
 	}
 
-	// Check that the command asked for is supported and implemented.  Only
-	// search the main list of handlers since help should not be provided
-	// for commands that are unimplemented or related to wallet
-	// functionality.
-	if _, ok := rpcHandlers[method]; !ok {
-		return nil, rpcInvalidError("Unknown method: %v", method)
+	// Check that the command asked for is supported and implemented.  Only
+	// search the main list of handlers since help should not be provided
+	// for commands that are unimplemented or related to wallet
+	// functionality.
+	if _, ok := rpcHandlers[method]; !ok {
+		return nil, rpcInvalidError("Unknown method: %v", method)
+	}
+
+	// Get the help for the command.
+	help, err := s.helpCacher.RPCMethodHelp(method)
+	if err != nil {
+		return nil, rpcInternalErr(err, "Failed to generate help")
+	}
+	return help, nil
+}
+
+// handleImportWatchOnlyAddress implements the importwatchonlyaddress command.
+func handleImportWatchOnlyAddress(ctx context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.ImportWatchOnlyAddressCmd)
+
+	// Decode the provided address.  This also ensures the network encoded with
+	// the address matches the network the server is currently on.
+	addr, err := stdaddr.DecodeAddress(c.Address, s.cfg.ChainParams)
+	if err != nil {
+		return nil, rpcAddressKeyError("Could not decode address: %v",
+			err)
+	}
+
+	coinType := cointype.CoinType(c.CoinType)
+	if !isWatchOnlyCoinType(s.cfg.ChainParams, coinType) {
+		return nil, rpcInvalidError("Coin type %d is not supported on this "+
+			"network", c.CoinType)
+	}
+
+	rescanFrom := int64(0)
+	if c.RescanFrom != nil {
+		rescanFrom = *c.RescanFrom
+	}
+	if rescanFrom < 0 {
+		return nil, rpcInvalidError("Rescan height %d must not be negative",
+			rescanFrom)
+	}
+	if c.Rescan != nil && !*c.Rescan {
+		rescanFrom = -1
+	}
+
+	watchOnlyIndex, _, err := syncedWatchOnlyIndexer(s)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get the help for the command.
-	help, err := s.helpCacher.RPCMethodHelp(method)
+	err = watchOnlyIndex.ImportAddress(ctx, addr, coinType, rescanFrom)
 	if err != nil {
-		return nil, rpcInternalErr(err, "Failed to generate help")
+		if errors.Is(err, indexers.ErrUnsupportedAddressType) {
+			return nil, rpcInvalidError("Unsupported address type: %v", addr)
+		}
+		return nil, rpcInternalErr(err, "Could not import address")
 	}
-	return help, nil
+
+	return nil, nil
 }
 
 // handleInvalidateBlock implements the invalidateblock command.
@@ -4734,6 +6993,163 @@ func handleInvalidateBlock(_ context.Context, s *Server, cmd interface{}) (inter
 	return nil, nil
 }
 
+// handleListKnownSKAAddresses implements the listknownskaaddresses command.
+func handleListKnownSKAAddresses(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.ListKnownSKAAddressesCmd)
+
+	// Report whether the addresses are watched when the watch-only index is
+	// enabled.  The watched addresses do not depend on the index being synced.
+	type watchKey struct {
+		address  string
+		coinType cointype.CoinType
+	}
+	watched := make(map[watchKey]struct{})
+	if s.cfg.WatchOnlyIndexer != nil {
+		addrs, err := s.cfg.WatchOnlyIndexer.WatchedAddresses()
+		if err != nil {
+			return nil, rpcInternalErr(err, "Could not fetch watched addresses")
+		}
+		for _, w := range addrs {
+			watched[watchKey{w.Address, w.CoinType}] = struct{}{}
+		}
+	}
+
+	known := blockchain.KnownSKAAddresses(s.cfg.ChainParams,
+		s.cfg.EmissionManifests)
+	result := make([]types.ListKnownSKAAddressesResult, 0, len(known))
+	for i := range known {
+		k := &known[i]
+		if c.CoinType != nil && uint8(k.CoinType) != *c.CoinType {
+			continue
+		}
+		_, isWatched := watched[watchKey{k.Address, k.CoinType}]
+		result = append(result, types.ListKnownSKAAddressesResult{
+			CoinType:        uint8(k.CoinType),
+			Tranche:         k.Tranche,
+			EmissionHeight:  k.EmissionHeight,
+			Address:         k.Address,
+			Descriptor:      k.Descriptor,
+			Amount:          dcrutil.Amount(k.Amount).ToCoinType(k.CoinType),
+			ManifestMissing: k.ManifestMissing,
+			Watched:         isWatched && k.Address != "",
+		})
+	}
+	return result, nil
+}
+
+// handleListRebroadcastTxs implements the listrebroadcasttxs command.
+func handleListRebroadcastTxs(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.ListRebroadcastTxsCmd)
+
+	txns := s.cfg.ConnMgr.RebroadcastTxs()
+	result := make([]types.ListRebroadcastTxsResult, 0, len(txns))
+	for i := range txns {
+		tx := &txns[i]
+		if c.CoinType != nil && uint8(tx.CoinType) != *c.CoinType {
+			continue
+		}
+		var lastAttempt int64
+		if !tx.LastAttempt.IsZero() {
+			lastAttempt = tx.LastAttempt.Unix()
+		}
+		result = append(result, types.ListRebroadcastTxsResult{
+			TxID:        tx.Hash.String(),
+			CoinType:    uint8(tx.CoinType),
+			Added:       tx.Added.Unix(),
+			Attempts:    tx.Attempts,
+			LastAttempt: lastAttempt,
+			NextAttempt: tx.NextAttempt.Unix(),
+			Saturated:   tx.Saturated,
+		})
+	}
+	return result, nil
+}
+
+// handleListUnspentWatchOnly implements the listunspentwatchonly command.
+func handleListUnspentWatchOnly(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.ListUnspentWatchOnlyCmd)
+
+	minConf, maxConf := int64(1), int64(9999999)
+	if c.MinConf != nil {
+		minConf = *c.MinConf
+	}
+	if c.MaxConf != nil {
+		maxConf = *c.MaxConf
+	}
+	if minConf < 0 || maxConf < minConf {
+		return nil, rpcInvalidError("Invalid confirmation range [%d, %d]",
+			minConf, maxConf)
+	}
+
+	// Decode the provided addresses to ensure they are valid for the
+	// network the server is currently on and filter on their encoding.
+	var filterAddrs map[string]struct{}
+	if c.Addresses != nil {
+		filterAddrs = make(map[string]struct{}, len(*c.Addresses))
+		for _, encodedAddr := range *c.Addresses {
+			addr, err := stdaddr.DecodeAddress(encodedAddr, s.cfg.ChainParams)
+			if err != nil {
+				return nil, rpcAddressKeyError("Could not decode address: "+
+					"%v", err)
+			}
+			filterAddrs[addr.String()] = struct{}{}
+		}
+	}
+
+	watchOnlyIndex, tHeight, err := syncedWatchOnlyIndexer(s)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos, err := watchOnlyIndex.UnspentOutputs()
+	if err != nil {
+		return nil, rpcInternalErr(err, "Could not fetch unspent outputs")
+	}
+
+	results := make([]types.ListUnspentWatchOnlyResult, 0, len(utxos))
+	for i := range utxos {
+		utxo := &utxos[i]
+		if c.CoinType != nil && *c.CoinType != uint8(utxo.CoinType) {
+			continue
+		}
+		if filterAddrs != nil {
+			if _, ok := filterAddrs[utxo.Address]; !ok {
+				continue
+			}
+		}
+		confirmations := tHeight - utxo.BlockHeight + 1
+		if confirmations < minConf || confirmations > maxConf {
+			continue
+		}
+
+		results = append(results, types.ListUnspentWatchOnlyResult{
+			TxID:          utxo.OutPoint.Hash.String(),
+			Vout:          utxo.OutPoint.Index,
+			Tree:          utxo.OutPoint.Tree,
+			Address:       utxo.Address,
+			CoinType:      uint8(utxo.CoinType),
+			Amount:        dcrutil.Amount(utxo.Amount).ToCoinType(utxo.CoinType),
+			ScriptPubKey:  hex.EncodeToString(utxo.PkScript),
+			BlockHeight:   utxo.BlockHeight,
+			Confirmations: confirmations,
+		})
+	}
+
+	// Order the results from the oldest to the newest output.
+	sort.Slice(results, func(i, j int) bool {
+		a, b := &results[i], &results[j]
+		if a.BlockHeight != b.BlockHeight {
+			return a.BlockHeight < b.BlockHeight
+		}
+		if a.TxID != b.TxID {
+			return a.TxID < b.TxID
+		}
+		return a.Vout < b.Vout
+	})
+
+	return results, nil
+}
+
 // handleLiveTickets implements the livetickets command.
 func handleLiveTickets(_ context.Context, s *Server, _ interface{}) (interface{}, error) {
 	lt, err := s.cfg.Chain.LiveTickets()
@@ -4821,6 +7237,36 @@ func handleRegenTemplate(_ context.Context, s *Server, _ interface{}) (interface
 	return nil, nil
 }
 
+// handleRemoveWatchOnlyAddress implements the removewatchonlyaddress command.
+func handleRemoveWatchOnlyAddress(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.RemoveWatchOnlyAddressCmd)
+
+	// Decode the provided address.  This also ensures the network encoded with
+	// the address matches the network the server is currently on.
+	addr, err := stdaddr.DecodeAddress(c.Address, s.cfg.ChainParams)
+	if err != nil {
+		return nil, rpcAddressKeyError("Could not decode address: %v",
+			err)
+	}
+
+	watchOnlyIndex := s.cfg.WatchOnlyIndexer
+	if watchOnlyIndex == nil {
+		err := errors.New("watch-only index disabled")
+		return nil, rpcInternalErr(err, "Configuration")
+	}
+
+	removed, err := watchOnlyIndex.RemoveAddress(addr,
+		cointype.CoinType(c.CoinType))
+	if err != nil {
+		if errors.Is(err, indexers.ErrUnsupportedAddressType) {
+			return nil, rpcInvalidError("Unsupported address type: %v", addr)
+		}
+		return nil, rpcInternalErr(err, "Could not remove address")
+	}
+
+	return removed, nil
+}
+
 // handleSendRawMixMessage implements the sendrawmixmessage command.
 func handleSendRawMixMessage(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
 	c := cmd.(*types.SendRawMixMessageCmd)
@@ -4880,6 +7326,64 @@ func handleSendRawMixMessage(_ context.Context, s *Server, cmd interface{}) (int
 	return nil, nil
 }
 
+// handleSendRawPackage implements the sendrawpackage command.
+func handleSendRawPackage(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.SendRawPackageCmd)
+
+	pkg := make([]*dcrutil.Tx, 0, len(c.HexTxs))
+	for _, hexStr := range c.HexTxs {
+		if len(hexStr)%2 != 0 {
+			hexStr = "0" + hexStr
+		}
+		serializedTx, err := hex.DecodeString(hexStr)
+		if err != nil {
+			return nil, rpcDecodeHexError(hexStr)
+		}
+		msgTx := wire.NewMsgTx()
+		err = msgTx.Deserialize(bytes.NewReader(serializedTx))
+		if err != nil {
+			return nil, rpcDeserializationError("Could not decode Tx: %v",
+				err)
+		}
+		pkg = append(pkg, dcrutil.NewTx(msgTx))
+	}
+
+	acceptedTxs, err := s.cfg.SyncMgr.ProcessPackage(pkg, *c.AllowHighFees)
+	if err != nil {
+		// When the error is a rule error, it means the package was simply
+		// rejected as opposed to something actually going wrong, so log it
+		// as such.
+		var rErr mempool.RuleError
+		if errors.As(err, &rErr) {
+			err = fmt.Errorf("rejected package: %w", err)
+			log.Debugf("%v", err)
+			return nil, rpcRuleError("%v", err)
+		}
+
+		err = fmt.Errorf("failed to process package: %w", err)
+		log.Errorf("%v", err)
+		return nil, rpcDeserializationError("rejected: %v", err)
+	}
+
+	// Generate and relay inventory vectors for all newly accepted
+	// transactions.  The parents are relayed before their children.
+	s.cfg.ConnMgr.RelayTransactions(acceptedTxs)
+
+	// Notify websocket clients of all newly accepted transactions.
+	s.NotifyNewTransactions(acceptedTxs)
+
+	// Keep track of the package transactions so that they can be
+	// rebroadcast if they don't make their way into a block.
+	hashes := make([]string, 0, len(acceptedTxs))
+	for _, tx := range acceptedTxs {
+		iv := wire.NewInvVect(wire.InvTypeTx, tx.Hash())
+		s.cfg.ConnMgr.AddRebroadcastInventory(iv, tx)
+		hashes = append(hashes, tx.Hash().String())
+	}
+
+	return hashes, nil
+}
+
 // handleSendRawTransaction implements the sendrawtransaction command.
 func handleSendRawTransaction(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
 	c := cmd.(*types.SendRawTransactionCmd)
@@ -4960,7 +7464,153 @@ func handleSendRawTransaction(_ context.Context, s *Server, cmd interface{}) (in
 		s.cfg.ConnMgr.AddRebroadcastInventory(iv, tx)
 	}
 
-	return tx.Hash().String(), nil
+	if c.Verbose == nil || !*c.Verbose {
+		return tx.Hash().String(), nil
+	}
+
+	// Advise when the transaction is unlikely to confirm soon because the
+	// block space allocated to its coin type is saturated.  The fee paid by
+	// the transaction is only known once it is in the mempool, so there is
+	// no advisory when it was not added to the mempool directly.
+	result := &types.SendRawTransactionResult{Txid: tx.Hash().String()}
+	for _, desc := range s.cfg.TxMempooler.TxDescs() {
+		if *desc.Tx.Hash() != *tx.Hash() {
+			continue
+		}
+		if desc.TxSize > 0 {
+			coinType := blockalloc.GetTransactionCoinType(tx)
+			feeRate := desc.Fee * 1000 / desc.TxSize
+			estimate, demand := estimateTxInclusion(s, tx, coinType,
+				desc.TxSize, feeRate)
+			result.Advisory = allocationAdvisory(s, coinType, estimate,
+				demand)
+		}
+		break
+	}
+	return result, nil
+}
+
+// estimateTxInclusion estimates when the provided transaction of the given
+// coin type, size, and fee rate would be mined given the block space allocated
+// to its coin type and the transactions currently in the mempool.  It also
+// returns the pending bytes of the coin type including the transaction.
+//
+// The transaction is not counted twice when it is already in the mempool.
+func estimateTxInclusion(s *Server, tx *dcrutil.Tx, coinType cointype.CoinType, txSize, feeRate int64) (*blockalloc.InclusionEstimate, uint32) {
+	// Tally the pending demand for each coin type along with the bytes of
+	// the same coin type that pay at least the same fee rate and therefore
+	// take precedence over the transaction.
+	pendingTxBytes := make(map[cointype.CoinType]uint32)
+	pendingTxBytes[coinType] += uint32(txSize)
+	var bytesAhead uint32
+	for _, desc := range s.cfg.TxMempooler.TxDescs() {
+		if *desc.Tx.Hash() == *tx.Hash() {
+			continue
+		}
+		descCoinType := blockalloc.GetTransactionCoinType(desc.Tx)
+		pendingTxBytes[descCoinType] += uint32(desc.TxSize)
+		if descCoinType == coinType && desc.TxSize > 0 &&
+			desc.Fee*1000/desc.TxSize >= feeRate {
+
+			bytesAhead += uint32(desc.TxSize)
+		}
+	}
+
+	nextHeight := s.cfg.Chain.BestSnapshot().Height + 1
+	allocator := blockalloc.NewBlockSpaceAllocator(s.cfg.BlockMaxSize,
+		s.cfg.ChainParams).ForHeight(nextHeight)
+	estimate := allocator.EstimateInclusion(coinType, uint32(txSize),
+		bytesAhead, pendingTxBytes)
+	return estimate, pendingTxBytes[coinType]
+}
+
+// allocationAdvisory returns an advisory that describes why a transaction of
+// the provided coin type with the given inclusion estimate is unlikely to
+// confirm soon or nil when there is no reason to expect it to be delayed by
+// the block space allocated to its coin type.
+func allocationAdvisory(s *Server, coinType cointype.CoinType, estimate *blockalloc.InclusionEstimate, demand uint32) *types.AllocationAdvisory {
+	// The fraction of the allocated space used by recent blocks is only
+	// available when the fee calculator tracks the coin type.
+	var utilization float64
+	if feeCalc := s.cfg.CoinTypeFeeCalculator; feeCalc != nil {
+		stats, err := feeCalc.GetFeeStats(coinType)
+		if err == nil {
+			utilization = stats.BlockSpaceUsed
+		}
+	}
+
+	var reason string
+	switch {
+	case estimate.BucketSize == 0:
+		reason = fmt.Sprintf("no block space is allocated to coin type %d",
+			coinType)
+	case estimate.BlocksToConfirm > advisoryMaxBlocks:
+		reason = fmt.Sprintf("pending demand for coin type %d exceeds the "+
+			"block space allocated to it for an estimated %d blocks",
+			coinType, estimate.BlocksToConfirm)
+	case !estimate.FitsNextBlock && utilization >= advisoryMinUtilization:
+		reason = fmt.Sprintf("the block space allocated to coin type %d is "+
+			"saturated by recent blocks", coinType)
+	default:
+		return nil
+	}
+
+	return &types.AllocationAdvisory{
+		Reason:          reason,
+		EstimatedBlocks: estimate.BlocksToConfirm,
+		BucketSize:      estimate.BucketSize,
+		BucketDemand:    demand,
+		Utilization:     utilization,
+	}
+}
+
+// handleSetBlockSpacePolicy implements the setblockspacepolicy command.
+func handleSetBlockSpacePolicy(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.SetBlockSpacePolicyCmd)
+
+	bt := s.cfg.BlockTemplater
+	if bt == nil {
+		err := errors.New("node is not configured for mining")
+		return nil, rpcInternalErr(err, "")
+	}
+
+	// Only the parts of the policy that are provided are changed.
+	policy := bt.BlockSpacePolicy()
+	if c.OverflowStrategy != nil {
+		strategy, err := mining.ParseOverflowStrategy(*c.OverflowStrategy)
+		if err != nil {
+			return nil, rpcInvalidError("%v", err)
+		}
+		policy.OverflowStrategy = strategy
+	}
+	if c.StakeReserveBytes != nil {
+		policy.StakeReserveBytes = *c.StakeReserveBytes
+	}
+	if c.CoinTypeCaps != nil {
+		if policy.MaxCoinTypeBytes == nil {
+			policy.MaxCoinTypeBytes = make(map[cointype.CoinType]uint32)
+		}
+		for coinTypeStr, maxBytes := range *c.CoinTypeCaps {
+			ct, err := strconv.ParseUint(coinTypeStr, 10, 8)
+			if err != nil {
+				return nil, rpcInvalidError("invalid coin type %q",
+					coinTypeStr)
+			}
+
+			// A cap of zero removes the cap of the coin type.
+			coinType := cointype.CoinType(ct)
+			if maxBytes == 0 {
+				delete(policy.MaxCoinTypeBytes, coinType)
+				continue
+			}
+			policy.MaxCoinTypeBytes[coinType] = maxBytes
+		}
+	}
+
+	if err := bt.SetBlockSpacePolicy(policy); err != nil {
+		return nil, rpcInvalidError("invalid block space policy: %v", err)
+	}
+	return blockSpacePolicyResult(&policy), nil
 }
 
 // handleSetGenerate implements the setgenerate command.
@@ -5298,6 +7948,189 @@ func ticketFeeInfoForRange(s *Server, start int64, end int64, txType stake.TxTyp
 	}, nil
 }
 
+// handleTestBlockAssembly implements the testblockassembly command.
+func handleTestBlockAssembly(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.TestBlockAssemblyCmd)
+
+	if len(c.Txs) == 0 {
+		return nil, rpcInvalidError("No transactions provided")
+	}
+
+	// Each entry is either the hash of a transaction in the mempool, in
+	// which case the fee it pays is known, or a serialized transaction, in
+	// which case the fee is calculated from the input amounts it commits
+	// to.
+	descs := make(map[chainhash.Hash]*mempool.TxDesc)
+	for _, desc := range s.cfg.TxMempooler.TxDescs() {
+		descs[*desc.Tx.Hash()] = desc
+	}
+	candidates := make([]blockalloc.PackCandidate, 0, len(c.Txs))
+	seen := make(map[chainhash.Hash]struct{}, len(c.Txs))
+	for _, entry := range c.Txs {
+		var candidate blockalloc.PackCandidate
+		if len(entry) == chainhash.MaxHashStringSize {
+			txHash, err := chainhash.NewHashFromStr(entry)
+			if err != nil {
+				return nil, rpcDecodeHexError(entry)
+			}
+			desc, ok := descs[*txHash]
+			if !ok {
+				return nil, rpcNoTxInfoError(txHash)
+			}
+			candidate.Tx = desc.Tx
+			if desc.TxSize > 0 {
+				candidate.FeeRate = desc.Fee * 1000 / desc.TxSize
+			}
+		} else {
+			hexStr := entry
+			if len(hexStr)%2 != 0 {
+				hexStr = "0" + hexStr
+			}
+			serializedTx, err := hex.DecodeString(hexStr)
+			if err != nil {
+				return nil, rpcDecodeHexError(hexStr)
+			}
+			msgTx := wire.NewMsgTx()
+			err = msgTx.Deserialize(bytes.NewReader(serializedTx))
+			if err != nil {
+				return nil, rpcDeserializationError("Could not decode "+
+					"Tx: %v", err)
+			}
+			candidate.Tx = dcrutil.NewTx(msgTx)
+			if feeRate := calcFeePerKb(candidate.Tx); feeRate > 0 {
+				candidate.FeeRate = int64(feeRate)
+			}
+		}
+		txHash := candidate.Tx.Hash()
+		if _, ok := seen[*txHash]; ok {
+			return nil, rpcInvalidError("Transaction %v provided more "+
+				"than once", txHash)
+		}
+		seen[*txHash] = struct{}{}
+		candidates = append(candidates, candidate)
+	}
+
+	nextHeight := s.cfg.Chain.BestSnapshot().Height + 1
+	allocator := blockalloc.NewBlockSpaceAllocator(s.cfg.BlockMaxSize,
+		s.cfg.ChainParams).ForHeight(nextHeight)
+	packed := allocator.PackTransactions(candidates)
+
+	result := &types.TestBlockAssemblyResult{
+		Height:       nextHeight,
+		TotalBytes:   packed.TotalBytes,
+		Transactions: make([]types.TestBlockAssemblyTxResult, 0, len(packed.Txns)),
+	}
+	coinTypes := cointype.SortedKeys(packed.Demand)
+	summaries := make(map[cointype.CoinType]*types.TestBlockAssemblyCoinTypeResult,
+		len(coinTypes))
+	result.CoinTypes = make([]types.TestBlockAssemblyCoinTypeResult, len(coinTypes))
+	for i, coinType := range coinTypes {
+		summary := &result.CoinTypes[i]
+		summary.CoinType = uint8(coinType)
+		summary.Name = generateCoinTypeName(coinType)
+		summary.Demand = packed.Demand[coinType]
+		summary.Included = []string{}
+		summary.Deferred = []string{}
+		if alloc := packed.Allocation.GetAllocationForCoinType(coinType); alloc != nil {
+			summary.Allocated = alloc.FinalAllocation
+		}
+		summaries[coinType] = summary
+	}
+	for _, tx := range packed.Txns {
+		txid := tx.Tx.Hash().String()
+		summary := summaries[tx.CoinType]
+		if tx.Included {
+			summary.Used += tx.Size
+			summary.Included = append(summary.Included, txid)
+		} else {
+			summary.Deferred = append(summary.Deferred, txid)
+		}
+		result.Transactions = append(result.Transactions,
+			types.TestBlockAssemblyTxResult{
+				Txid:     txid,
+				CoinType: uint8(tx.CoinType),
+				Size:     tx.Size,
+				FeeRate:  dcrutil.Amount(tx.FeeRate).ToCoin(),
+				Included: tx.Included,
+				Reason:   tx.Reason,
+			})
+	}
+	return result, nil
+}
+
+// handleTestMempoolAccept implements the testmempoolaccept command.
+func handleTestMempoolAccept(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
+	c := cmd.(*types.TestMempoolAcceptCmd)
+
+	hexStr := c.HexTx
+	if len(hexStr)%2 != 0 {
+		hexStr = "0" + hexStr
+	}
+	serializedTx, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return nil, rpcDecodeHexError(hexStr)
+	}
+	msgtx := wire.NewMsgTx()
+	err = msgtx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		return nil, rpcDeserializationError("Could not decode Tx: %v",
+			err)
+	}
+
+	tx := dcrutil.NewTx(msgtx)
+	coinType := blockalloc.GetTransactionCoinType(tx)
+	txSize := int64(msgtx.SerializeSize())
+	result := &types.TestMempoolAcceptResult{
+		Txid:     tx.Hash().String(),
+		CoinType: uint8(coinType),
+		Size:     txSize,
+	}
+
+	// Rule errors mean the transaction would simply be rejected, so report
+	// the reason as opposed to failing the request.
+	mp := s.cfg.TxMempooler
+	fee, err := mp.TestAcceptTransaction(tx, *c.AllowHighFees)
+	if err != nil {
+		var rErr mempool.RuleError
+		if !errors.As(err, &rErr) {
+			return nil, rpcInternalErr(err, "Could not test transaction")
+		}
+		result.RejectReason = err.Error()
+		return result, nil
+	}
+	feeRate := fee * 1000 / txSize
+	result.Allowed = true
+	result.Fee = dcrutil.Amount(fee).ToCoin()
+	result.FeeRate = dcrutil.Amount(feeRate).ToCoin()
+
+	estimate, demand := estimateTxInclusion(s, tx, coinType, txSize, feeRate)
+	result.BucketSize = estimate.BucketSize
+	result.BytesAhead = estimate.BytesAhead
+	result.FitsNextBlock = estimate.FitsNextBlock
+	result.EstimatedBlocks = estimate.BlocksToConfirm
+
+	// The fee rate may also be too low to compete for the allocated space
+	// regardless of the pending demand, so account for the shortest
+	// confirmation target the fee calculator considers it sufficient for.
+	if feeCalc := s.cfg.CoinTypeFeeCalculator; feeCalc != nil {
+		for _, target := range []int{1, 3, 6, 12} {
+			targetFeeRate, err := feeCalc.EstimateFeeRate(coinType, target)
+			if err != nil {
+				return nil, rpcInternalErr(err, "Could not estimate fee rate")
+			}
+			if int64(targetFeeRate) <= feeRate {
+				if int64(target) > result.EstimatedBlocks {
+					result.EstimatedBlocks = int64(target)
+				}
+				break
+			}
+		}
+	}
+	result.Advisory = allocationAdvisory(s, coinType, estimate, demand)
+
+	return result, nil
+}
+
 // handleTicketFeeInfo implements the ticketfeeinfo command.
 func handleTicketFeeInfo(_ context.Context, s *Server, cmd interface{}) (interface{}, error) {
 	c := cmd.(*types.TicketFeeInfoCmd)
@@ -5713,8 +8546,7 @@ type Server struct {
 	cfg                    Config
 	hmac                   hash.Hash
 	hmacMu                 sync.Mutex
-	authsha                [sha256.Size]byte
-	limitauthsha           [sha256.Size]byte
+	auths                  []authEntry
 	ntfnMgr                NtfnManager
 	statusLines            map[int]string
 	statusLock             sync.RWMutex
@@ -5847,6 +8679,14 @@ func (s *Server) NotifyTSpend(tx *dcrutil.Tx) {
 	s.ntfnMgr.NotifyTSpend(tx)
 }
 
+// NotifyDoubleSpend notifies websocket clients that have registered to receive
+// double spend notifications that the passed transaction of the given coin type
+// was rejected for double spending coins spent by the conflicting mempool
+// transactions.
+func (s *Server) NotifyDoubleSpend(tx *dcrutil.Tx, coinType cointype.CoinType, conflicts []chainhash.Hash) {
+	s.ntfnMgr.NotifyDoubleSpend(tx, coinType, conflicts)
+}
+
 // NotifyMixMessages notifies websocket clients that have registered to
 // receive mixing message notifications of newly accepted mix messages.
 func (s *Server) NotifyMixMessages(msgs []mixing.Message) {
@@ -5873,6 +8713,13 @@ func (s *Server) NotifyBlockDisconnected(block *dcrutil.Block) {
 	s.ntfnMgr.NotifyBlockDisconnected(block)
 }
 
+// NotifySKAEmissionsFinal notifies websocket clients that have registered for
+// block updates that the provided SKA emissions in the passed main chain block
+// reached the configured number of confirmations to be considered final.
+func (s *Server) NotifySKAEmissionsFinal(block *dcrutil.Block, emissions []blockchain.SKAEmissionRecord) {
+	s.ntfnMgr.NotifySKAEmissionsFinal(block, emissions)
+}
+
 // NotifyReorganization notifies websocket clients that have registered for
 // block updates when the blockchain is beginning a reorganization.
 func (s *Server) NotifyReorganization(rd *blockchain.ReorganizationNtfnsData) {
@@ -5931,34 +8778,61 @@ func (s *Server) authMAC(dst, auth []byte) []byte {
 	return dst
 }
 
+// authEntry houses the MAC of the HTTP Basic authentication string of
+// configured RPC credentials along with the scopes granted to them.
+type authEntry struct {
+	mac    [sha256.Size]byte
+	scopes AuthScope
+}
+
+// addAuth generates the MAC of the HTTP Basic authentication string of the
+// provided credentials and adds it to the credentials the server accepts with
+// the provided scopes.
+func (s *Server) addAuth(user, pass string, scopes AuthScope) {
+	login := user + ":" + pass
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
+	var entry authEntry
+	s.authMAC(entry.mac[:0], []byte(auth))
+	entry.scopes = scopes
+	s.auths = append(s.auths, entry)
+}
+
 // checkAuthMAC checks the HTTP Basic authentication string by comparing
-// it with the already generated hash.
+// it with the already generated hashes.
 //
-// The first bool return value signifies auth success (true if successful) and
-// the second bool return value specifies whether the user can change the state
-// of the server (true) or whether the user is limited (false).
-func (s *Server) checkAuthMAC(auth, remoteAddr string) (bool, bool) {
+// The bool return value signifies auth success (true if successful) and the
+// scope return value specifies the methods the user is allowed to call.  Every
+// configured credential is compared so the check is time-constant with regard
+// to which of them matches.
+func (s *Server) checkAuthMAC(auth, remoteAddr string) (bool, AuthScope) {
 	mac := make([]byte, 0, sha256.Size)
 	mac = s.authMAC(mac, []byte(auth))
 
-	cmp := subtle.ConstantTimeCompare(mac, s.authsha[:])
-	limitcmp := subtle.ConstantTimeCompare(mac, s.limitauthsha[:])
-	if cmp|limitcmp == 0 {
-		// Request's auth doesn't match either user
+	var matched int
+	var scopes AuthScope
+	for i := range s.auths {
+		cmp := subtle.ConstantTimeCompare(mac, s.auths[i].mac[:])
+		matched |= cmp
+
+		// Only grant the scopes of the matching credential without
+		// branching on the result of the comparison.
+		scopes |= s.auths[i].scopes & AuthScope(-cmp)
+	}
+	if matched == 0 {
+		// Request's auth doesn't match any user
 		log.Warnf("RPC authentication failure from %s", remoteAddr)
-		return false, false
+		return false, 0
 	}
-	return true, cmp == 1
+	return true, scopes
 }
 
 // checkAuthUserPass checks the correctness of username and password by
 // generating the corresponding HTTP Basic authentication string then
 // compare the string with the already generated hash.
 //
-// The first bool return value signifies auth success (true if successful) and
-// the second bool return value specifies whether the user can change the state
-// of the server (true) or whether the user is limited (false).
-func (s *Server) checkAuthUserPass(user, pass, remoteAddr string) (bool, bool) {
+// The bool return value signifies auth success (true if successful) and the
+// scope return value specifies the methods the user is allowed to call.
+func (s *Server) checkAuthUserPass(user, pass, remoteAddr string) (bool, AuthScope) {
 	login := user + ":" + pass
 	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 	return s.checkAuthMAC(auth, remoteAddr)
@@ -5970,16 +8844,15 @@ func (s *Server) checkAuthUserPass(user, pass, remoteAddr string) (bool, bool) {
 //
 // This check is time-constant.
 //
-// The first bool return value signifies auth success (true if successful) and
-// the second bool return value specifies whether the user can change the state
-// of the server (true) or whether the user is limited (false). The second is
-// always false if the first is.
-func (s *Server) checkAuth(r *http.Request, require bool) (bool, bool, error) {
-	// If admin-level RPC user and pass options are not set, this always
-	// succeeds.  This will be the case when TLS client certificates are
-	// being used for authentication.
-	if s.authsha == ([32]byte{}) {
-		return true, true, nil
+// The bool return value signifies auth success (true if successful) and the
+// scope return value specifies the methods the user is allowed to call.  The
+// scope is always empty if auth is not successful.
+func (s *Server) checkAuth(r *http.Request, require bool) (bool, AuthScope, error) {
+	// If no RPC credentials are configured, this always succeeds.  This
+	// will be the case when TLS client certificates are being used for
+	// authentication.
+	if len(s.auths) == 0 {
+		return true, ScopeAll, nil
 	}
 
 	authhdr := r.Header["Authorization"]
@@ -5987,17 +8860,17 @@ func (s *Server) checkAuth(r *http.Request, require bool) (bool, bool, error) {
 		if require {
 			log.Warnf("RPC authentication failure from %s",
 				r.RemoteAddr)
-			return false, false, errors.New("auth failure")
+			return false, 0, errors.New("auth failure")
 		}
 
-		return false, false, nil
+		return false, 0, nil
 	}
 
-	authed, isAdmin := s.checkAuthMAC(authhdr[0], r.RemoteAddr)
+	authed, scopes := s.checkAuthMAC(authhdr[0], r.RemoteAddr)
 	if !authed {
-		return false, false, errors.New("auth failure")
+		return false, 0, errors.New("auth failure")
 	}
-	return authed, isAdmin, nil
+	return authed, scopes, nil
 }
 
 // parsedRPCCmd represents a JSON-RPC request object that has been parsed into
@@ -6076,15 +8949,12 @@ func createMarshalledReply(rpcVersion string, id interface{}, result interface{}
 
 // processRequest determines the incoming request type (single or batched),
 // parses it and returns a marshalled response.
-func (s *Server) processRequest(ctx context.Context, request *dcrjson.Request, isAdmin bool) []byte {
+func (s *Server) processRequest(ctx context.Context, request *dcrjson.Request, scopes AuthScope) []byte {
 	var result interface{}
 	var jsonErr error
 
-	if !isAdmin {
-		if _, ok := rpcLimited[request.Method]; !ok {
-			jsonErr = rpcInvalidError("limited user not " +
-				"authorized for this method")
-		}
+	if !scopes.Authorizes(request.Method) {
+		jsonErr = rpcInvalidError("%s", unauthorizedMessage(request.Method))
 	}
 
 	if jsonErr == nil {
@@ -6127,7 +8997,7 @@ func (s *Server) processRequest(ctx context.Context, request *dcrjson.Request, i
 }
 
 // jsonRPCRead handles reading and responding to RPC messages.
-func (s *Server) jsonRPCRead(sCtx context.Context, w http.ResponseWriter, r *http.Request, isAdmin bool) {
+func (s *Server) jsonRPCRead(sCtx context.Context, w http.ResponseWriter, r *http.Request, scopes AuthScope) {
 	select {
 	case <-sCtx.Done():
 		return
@@ -6210,7 +9080,7 @@ func (s *Server) jsonRPCRead(sCtx context.Context, w http.ResponseWriter, r *htt
 				log.Errorf("Failed to create reply: %v", err)
 			}
 		} else {
-			resp = s.processRequest(ctx, &req, isAdmin)
+			resp = s.processRequest(ctx, &req, scopes)
 		}
 
 		if resp != nil {
@@ -6279,7 +9149,7 @@ func (s *Server) jsonRPCRead(sCtx context.Context, w http.ResponseWriter, r *htt
 						continue
 					}
 
-					resp = s.processRequest(ctx, &req, isAdmin)
+					resp = s.processRequest(ctx, &req, scopes)
 					if resp != nil {
 						results = append(results, resp)
 					}
@@ -6406,19 +9276,19 @@ func (s *Server) route(ctx context.Context) *http.Server {
 		// Keep track of the number of connected clients.
 		s.incrementClients()
 		defer s.decrementClients()
-		_, isAdmin, err := s.checkAuth(r, true)
+		_, scopes, err := s.checkAuth(r, true)
 		if err != nil {
 			jsonAuthFail(w)
 			return
 		}
 
 		// Read and respond to the request.
-		s.jsonRPCRead(r.Context(), w, r, isAdmin)
+		s.jsonRPCRead(r.Context(), w, r, scopes)
 	})
 
 	// Websocket endpoint.
 	rpcServeMux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
-		authenticated, isAdmin, err := s.checkAuth(r, false)
+		authenticated, scopes, err := s.checkAuth(r, false)
 		if err != nil {
 			jsonAuthFail(w)
 			return
@@ -6497,7 +9367,7 @@ func (s *Server) route(ctx context.Context) *http.Server {
 			ws.SetReadLimit(websocketReadLimitAuthenticated)
 		}
 		s.WebsocketHandler(r.Context(), ws, r.RemoteAddr, authenticated,
-			isAdmin)
+			scopes)
 	})
 	return httpServer
 }
@@ -6628,6 +9498,54 @@ type Config struct {
 	// use.
 	TxIndexer TxIndexer
 
+	// WatchOnlyIndexer defines the optional watch-only index for the RPC
+	// server to use.
+	WatchOnlyIndexer WatchOnlyIndexer
+
+	// EmissionIndexer defines the SKA emission index for the RPC server to
+	// use.
+	EmissionIndexer EmissionIndexer
+
+	// IndexSyncReporter defines the source of the sync progress of the
+	// indexes for the RPC server to use.
+	IndexSyncReporter IndexSyncReporter
+
+	// EmissionRehearser defines the optional SKA emission rehearsal
+	// coordinator for the RPC server to use.
+	EmissionRehearser EmissionRehearser
+
+	// EmissionWatcher defines the optional SKA emission watchtower for the
+	// RPC server to use.
+	EmissionWatcher EmissionWatcher
+
+	// EmissionCheckpointer defines the optional source of whether the SKA
+	// emission state has been verified against the configured emission
+	// checkpoints for the RPC server to use.  The RPCs that report emission
+	// state return an error until it has been verified.
+	EmissionCheckpointer EmissionCheckpointer
+
+	// SupplyScanner defines the optional background UTXO supply scanner for
+	// the RPC server to use.
+	SupplyScanner SupplyScanner
+
+	// UtilizationHistorian defines the optional block space utilization
+	// history for the RPC server to use.
+	UtilizationHistorian UtilizationHistorian
+
+	// AllocDivergenceMonitor defines the optional monitor of how the blocks
+	// of each miner diverge from the block space allocation policy for the
+	// RPC server to use.
+	AllocDivergenceMonitor AllocDivergenceMonitor
+
+	// EmissionFinalConfs defines the number of confirmations after which an
+	// SKA emission is reported as final instead of provisional.
+	EmissionFinalConfs int64
+
+	// EmissionManifests houses the loaded emission manifests, keyed by their
+	// hash, that provide the distributions of the emission tranches pinned to
+	// them.
+	EmissionManifests map[chainhash.Hash]*blockchain.EmissionManifest
+
 	// NetInfo defines a slice of the available networks.
 	NetInfo []types.NetworksResult
 
@@ -6635,6 +9553,10 @@ type Config struct {
 	// considered a non-zero fee.
 	MinRelayTxFee dcrutil.Amount
 
+	// BlockMaxSize defines the maximum block size in bytes used when
+	// generating block templates.
+	BlockMaxSize uint32
+
 	// Proxy defines the proxy that is being used for connections.
 	Proxy string
 
@@ -6645,6 +9567,11 @@ type Config struct {
 	RPCLimitUser string
 	RPCLimitPass string
 
+	// RPCAuth defines additional credentials for RPC connections that are
+	// only granted the provided scopes.  The RPCUser credentials are granted
+	// every scope and the RPCLimitUser credentials only ScopeRead.
+	RPCAuth []AuthCredential
+
 	// RPCMaxClients defines the max number of RPC clients for standard
 	// connections.
 	RPCMaxClients int
@@ -6697,16 +9624,13 @@ func New(config *Config) (*Server, error) {
 	rand.Read(key)
 	rpc.hmac = hmac.New(sha256.New, key)
 	if config.RPCUser != "" && config.RPCPass != "" {
-		login := config.RPCUser + ":" + config.RPCPass
-		auth := "Basic " +
-			base64.StdEncoding.EncodeToString([]byte(login))
-		rpc.authMAC(rpc.authsha[:0], []byte(auth))
+		rpc.addAuth(config.RPCUser, config.RPCPass, ScopeAll)
 	}
 	if config.RPCLimitUser != "" && config.RPCLimitPass != "" {
-		login := config.RPCLimitUser + ":" + config.RPCLimitPass
-		auth := "Basic " +
-			base64.StdEncoding.EncodeToString([]byte(login))
-		rpc.authMAC(rpc.limitauthsha[:0], []byte(auth))
+		rpc.addAuth(config.RPCLimitUser, config.RPCLimitPass, ScopeRead)
+	}
+	for _, cred := range config.RPCAuth {
+		rpc.addAuth(cred.User, cred.Pass, cred.Scopes)
 	}
 	rpc.ntfnMgr = newWsNotificationManager(&rpc)
 
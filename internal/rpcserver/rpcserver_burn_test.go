@@ -6,9 +6,13 @@ package rpcserver
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrjson"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
 	"github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
 )
 
@@ -231,3 +235,96 @@ func TestBurnCoinTypeValidation(t *testing.T) {
 func uint8Ptr(v uint8) *uint8 {
 	return &v
 }
+
+// TestHandleGetSKABurns ensures the getskaburns handler validates its
+// parameters and returns the burns recorded in the burn journal.
+func TestHandleGetSKABurns(t *testing.T) {
+	t.Parallel()
+
+	burns := []blockchain.SKABurnRecord{
+		{CoinType: 1, Amount: 150000000, Height: 100, TxHash: [32]byte{1}, OutIndex: 1},
+		{CoinType: 1, Amount: 250000000, Height: 120, TxHash: [32]byte{2}, OutIndex: 0},
+	}
+	chainWithBurns := func() *testRPCChain {
+		chain := defaultMockRPCChain()
+		chain.skaBurnedAmounts = map[cointype.CoinType]int64{1: 500000000}
+		chain.skaBurns = burns
+		chain.skaBurnJournalStart = 50
+		return chain
+	}
+	chainWithErr := chainWithBurns()
+	chainWithErr.fetchSKABurnsErr = errors.New("fetch failed")
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetSKABurns: ok",
+		handler: handleGetSKABurns,
+		cmd: &types.GetSKABurnsCmd{
+			CoinType: 1,
+		},
+		mockChain: chainWithBurns(),
+		result: types.GetSKABurnsResult{
+			CoinType:     1,
+			TotalBurned:  5,
+			JournalStart: 50,
+			Burns: []types.SKABurnResult{{
+				TxHash: chainhash.Hash(burns[0].TxHash).String(),
+				Vout:   1,
+				Height: 100,
+				Amount: 1.5,
+			}, {
+				TxHash: chainhash.Hash(burns[1].TxHash).String(),
+				Vout:   0,
+				Height: 120,
+				Amount: 2.5,
+			}},
+		},
+	}, {
+		name:    "handleGetSKABurns: no burns",
+		handler: handleGetSKABurns,
+		cmd: &types.GetSKABurnsCmd{
+			CoinType:    2,
+			StartHeight: dcrjson.Int64(10),
+			Count:       dcrjson.Int32(5),
+		},
+		mockChain: defaultMockRPCChain(),
+		result: types.GetSKABurnsResult{
+			CoinType: 2,
+			Burns:    []types.SKABurnResult{},
+		},
+	}, {
+		name:    "handleGetSKABurns: VAR coin type",
+		handler: handleGetSKABurns,
+		cmd: &types.GetSKABurnsCmd{
+			CoinType: 0,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetSKABurns: negative start height",
+		handler: handleGetSKABurns,
+		cmd: &types.GetSKABurnsCmd{
+			CoinType:    1,
+			StartHeight: dcrjson.Int64(-1),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetSKABurns: count too large",
+		handler: handleGetSKABurns,
+		cmd: &types.GetSKABurnsCmd{
+			CoinType: 1,
+			Count:    dcrjson.Int32(maxSKABurnsCount + 1),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetSKABurns: fetch error",
+		handler: handleGetSKABurns,
+		cmd: &types.GetSKABurnsCmd{
+			CoinType: 1,
+		},
+		mockChain: chainWithErr,
+		wantErr:   true,
+		errCode:   dcrjson.ErrRPCInternal.Code,
+	}})
+}
@@ -67,7 +67,8 @@ func TestCheckAuthUserPass(t *testing.T) {
 		},
 	}
 	for _, test := range tests {
-		authed, isAdmin := s.checkAuthUserPass(test.user, test.pass, "addr")
+		authed, scopes := s.checkAuthUserPass(test.user, test.pass, "addr")
+		isAdmin := scopes&ScopeAdmin != 0
 		if authed != test.wantAuthed {
 			t.Errorf("%q: unexpected authed -- got %v, want %v", test.name, authed,
 				test.wantAuthed)
@@ -86,7 +87,8 @@ func TestCheckAuth(t *testing.T) {
 			t.Fatalf("unable to create RPC server: %v", err)
 		}
 		for i := 0; i <= 1; i++ {
-			authed, isAdmin, err := s.checkAuth(&http.Request{}, i == 0)
+			authed, scopes, err := s.checkAuth(&http.Request{}, i == 0)
+			isAdmin := scopes&ScopeAdmin != 0
 			if !authed {
 				t.Errorf(" unexpected authed -- got %v, want %v", authed, true)
 			}
@@ -109,7 +111,8 @@ func TestCheckAuth(t *testing.T) {
 			t.Fatalf("unable to create RPC server: %v", err)
 		}
 		for i := 0; i <= 1; i++ {
-			authed, isAdmin, err := s.checkAuth(&http.Request{}, i == 0)
+			authed, scopes, err := s.checkAuth(&http.Request{}, i == 0)
+			isAdmin := scopes&ScopeAdmin != 0
 			if authed {
 				t.Errorf(" unexpected authed -- got %v, want %v", authed, false)
 			}
@@ -125,7 +128,8 @@ func TestCheckAuth(t *testing.T) {
 		for i := 0; i <= 1; i++ {
 			r := &http.Request{Header: make(map[string][]string, 1)}
 			r.Header["Authorization"] = []string{"Basic Nothing"}
-			authed, isAdmin, err := s.checkAuth(r, i == 0)
+			authed, scopes, err := s.checkAuth(r, i == 0)
+			isAdmin := scopes&ScopeAdmin != 0
 			if authed {
 				t.Errorf(" unexpected authed -- got %v, want %v", authed, false)
 			}
@@ -151,3 +155,109 @@ func TestLimitedMethodsExist(t *testing.T) {
 		}
 	}
 }
+
+// TestAuthScopes ensures credentials configured with scopes only authorize the
+// methods their scopes grant access to, that SKA administrative methods
+// require the skaadmin scope, and that the legacy credentials retain their
+// access.
+func TestAuthScopes(t *testing.T) {
+	s, err := New(&Config{
+		RPCUser:      "user",
+		RPCPass:      "pass",
+		RPCLimitUser: "limit",
+		RPCLimitPass: "limit",
+		RPCAuth: []AuthCredential{
+			{User: "reader", Pass: "r", Scopes: ScopeRead},
+			{User: "operator", Pass: "o", Scopes: ScopeRead | ScopeAdmin},
+			{User: "emitter", Pass: "e", Scopes: ScopeSKAAdmin},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create RPC server: %v", err)
+	}
+
+	tests := []struct {
+		user       string
+		pass       string
+		method     string
+		wantAuthed bool
+	}{
+		{"user", "pass", "getblockcount", true},
+		{"user", "pass", "stop", true},
+		{"user", "pass", "importwatchonlyaddress", true},
+		{"limit", "limit", "getblockcount", true},
+		{"limit", "limit", "stop", false},
+		{"limit", "limit", "encodeemissionauth", false},
+		{"reader", "r", "getblockcount", true},
+		{"reader", "r", "stop", false},
+		{"operator", "o", "stop", true},
+		{"operator", "o", "importwatchonlyaddress", false},
+		{"operator", "o", "removewatchonlyaddress", false},
+		{"emitter", "e", "encodeemissionauth", true},
+		{"emitter", "e", "abandonrebroadcasttx", true},
+		{"emitter", "e", "getblockcount", false},
+	}
+	for _, test := range tests {
+		authed, scopes := s.checkAuthUserPass(test.user, test.pass, "addr")
+		if !authed {
+			t.Errorf("%s: unexpected auth failure", test.user)
+			continue
+		}
+		if got := scopes.Authorizes(test.method); got != test.wantAuthed {
+			t.Errorf("%s (%s): unexpected authorization for %s -- got %v, "+
+				"want %v", test.user, scopes, test.method, got,
+				test.wantAuthed)
+		}
+	}
+
+	// Credentials that only differ from configured ones in their password
+	// are rejected.
+	if authed, scopes := s.checkAuthUserPass("emitter", "o", "addr"); authed ||
+		scopes != 0 {
+
+		t.Errorf("unexpected auth for mismatched password -- got %v (%s)",
+			authed, scopes)
+	}
+}
+
+// TestParseAuthScope ensures scope lists are parsed into the scopes they
+// specify and unknown scopes are rejected.
+func TestParseAuthScope(t *testing.T) {
+	tests := []struct {
+		scopes  string
+		want    AuthScope
+		wantErr bool
+	}{
+		{scopes: "read", want: ScopeRead},
+		{scopes: "read, SKAAdmin", want: ScopeRead | ScopeSKAAdmin},
+		{scopes: "admin,read,skaadmin", want: ScopeAll},
+		{scopes: "", wantErr: true},
+		{scopes: "read,write", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseAuthScope(test.scopes)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%q: unexpected error -- got %v, want error %v",
+				test.scopes, err, test.wantErr)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%q: unexpected scopes -- got %s, want %s", test.scopes,
+				got, test.want)
+		}
+	}
+}
+
+// TestSKAAdminMethodsExist ensures all RPC methods listed in the SKA
+// administrative methods map have associated handlers defined.
+func TestSKAAdminMethodsExist(t *testing.T) {
+	for methodStr := range rpcSKAAdmin {
+		method := types.Method(methodStr)
+		_, haveRegularHandler := rpcHandlers[method]
+		_, haveWebsocketHandler := wsHandlers[method]
+		if !haveRegularHandler && !haveWebsocketHandler {
+			t.Errorf("no handler found for SKA administrative method %q",
+				method)
+		}
+	}
+}
@@ -32,14 +32,19 @@ import (
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
 	"github.com/monetarium/monetarium-node/dcrjson"
 	"github.com/monetarium/monetarium-node/dcrutil"
 	"github.com/monetarium/monetarium-node/gcs"
 	"github.com/monetarium/monetarium-node/gcs/blockcf2"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
 	"github.com/monetarium/monetarium-node/internal/blockchain"
 	"github.com/monetarium/monetarium-node/internal/blockchain/indexers"
+	"github.com/monetarium/monetarium-node/internal/fees"
 	"github.com/monetarium/monetarium-node/internal/mempool"
 	"github.com/monetarium/monetarium-node/internal/mining"
+	"github.com/monetarium/monetarium-node/internal/netsync"
+	"github.com/monetarium/monetarium-node/internal/supplyscan"
 	"github.com/monetarium/monetarium-node/internal/version"
 	"github.com/monetarium/monetarium-node/math/uint256"
 	"github.com/monetarium/monetarium-node/mixing"
@@ -63,6 +68,7 @@ const testDataPath = "testdata"
 // testRPCUtxoEntry provides a mock utxo entry by implementing the UtxoEntry interface.
 type testRPCUtxoEntry struct {
 	amount               int64
+	coinType             cointype.CoinType
 	hasExpiry            bool
 	height               uint32
 	index                uint32
@@ -117,6 +123,17 @@ func (u *testRPCUtxoEntry) IsCoinBase() bool {
 	return u.isCoinBase
 }
 
+// HasExpiry returns a mocked bool representing whether or not the output was
+// contained in a transaction that included an expiry.
+func (u *testRPCUtxoEntry) HasExpiry() bool {
+	return u.hasExpiry
+}
+
+// CoinType returns a mocked coin type of the output.
+func (u *testRPCUtxoEntry) CoinType() cointype.CoinType {
+	return u.coinType
+}
+
 // TicketMinimalOutputs returns mocked minimal outputs for the ticket
 // transaction that the output is contained in.
 func (u *testRPCUtxoEntry) TicketMinimalOutputs() []*stake.MinimalOutput {
@@ -149,6 +166,7 @@ type testRPCChain struct {
 	chainTips                     []blockchain.ChainTipInfo
 	chainWork                     uint256.Uint256
 	chainWorkErr                  error
+	checkConnectBlockTemplateErr  error
 	checkLiveTicket               bool
 	checkLiveTickets              []bool
 	countVoteVersion              uint32
@@ -157,12 +175,15 @@ type testRPCChain struct {
 	fetchUtxoEntry                UtxoEntry
 	fetchUtxoEntryErr             error
 	fetchUtxoStats                *blockchain.UtxoStats
+	fetchUtxoSnapshot             *blockchain.UtxoSnapshot
+	fetchUtxoSnapshotErr          error
 	getStakeVersions              []blockchain.StakeVersions
 	getStakeVersionsErr           error
 	getVoteCounts                 blockchain.VoteCounts
 	getVoteCountsErr              error
 	getVoteInfo                   *blockchain.VoteInfo
 	getVoteInfoErr                error
+	haveBlock                     bool
 	headerByHashFn                func() wire.BlockHeader
 	headerByHashErr               error
 	headerByHeight                wire.BlockHeader
@@ -205,7 +226,18 @@ type testRPCChain struct {
 	subsidySplitR2ActiveErr       error
 	skaEmissionNonce              uint64
 	skaEmissionOccurred           bool
+	skaEmissionTranches           uint32
 	skaBurnedAmounts              map[cointype.CoinType]int64
+	skaBurns                      []blockchain.SKABurnRecord
+	skaBurnJournalStart           int64
+	fetchSKABurnsErr              error
+	blockFeeTotals                *blockchain.BlockFeeTotals
+	fetchBlockFeeTotalsErr        error
+	blockStats                    *blockchain.BlockStats
+	fetchBlockStatsErr            error
+	blockSpends                   *blockchain.BlockSpends
+	fetchBlockSpendsErr           error
+	validationStats               []blockchain.ValidationStageStats
 }
 
 // BestSnapshot returns a mocked blockchain.BestState.
@@ -255,6 +287,12 @@ func (c *testRPCChain) ChainWork(hash *chainhash.Hash) (uint256.Uint256, error)
 	return c.chainWork, c.chainWorkErr
 }
 
+// CheckConnectBlockTemplate returns a mocked result of fully validating the
+// passed block template.
+func (c *testRPCChain) CheckConnectBlockTemplate(block *dcrutil.Block) error {
+	return c.checkConnectBlockTemplateErr
+}
+
 // CheckLiveTicket returns a mocked result of whether or not a ticket
 // exists in the live ticket treap of the best node.
 func (c *testRPCChain) CheckLiveTicket(hash chainhash.Hash) bool {
@@ -288,6 +326,11 @@ func (c *testRPCChain) FetchUtxoStats() (*blockchain.UtxoStats, error) {
 	return c.fetchUtxoStats, nil
 }
 
+// FetchUtxoSnapshot returns a mocked blockchain.UtxoSnapshot.
+func (c *testRPCChain) FetchUtxoSnapshot(coinType cointype.CoinType, height int64) (*blockchain.UtxoSnapshot, error) {
+	return c.fetchUtxoSnapshot, c.fetchUtxoSnapshotErr
+}
+
 // GetStakeVersions returns a mocked cooked array of StakeVersions.
 func (c *testRPCChain) GetStakeVersions(hash *chainhash.Hash, count int32) ([]blockchain.StakeVersions, error) {
 	return c.getStakeVersions, c.getStakeVersionsErr
@@ -306,6 +349,12 @@ func (c *testRPCChain) GetVoteInfo(hash *chainhash.Hash, version uint32) (*block
 	return c.getVoteInfo, c.getVoteInfoErr
 }
 
+// HaveBlock returns a mocked bool representing whether or not the chain
+// instance has the block represented by the passed hash.
+func (c *testRPCChain) HaveBlock(hash *chainhash.Hash) bool {
+	return c.haveBlock
+}
+
 // HeaderByHash returns a mocked block header identified by the given hash.
 func (c *testRPCChain) HeaderByHash(hash *chainhash.Hash) (wire.BlockHeader, error) {
 	return c.headerByHashFn(), c.headerByHashErr
@@ -467,6 +516,11 @@ func (c *testRPCChain) HasSKAEmissionOccurred(cointype.CoinType) bool {
 	return c.skaEmissionOccurred
 }
 
+// SKAEmissionTranchesEmitted returns the mocked number of emitted tranches.
+func (c *testRPCChain) SKAEmissionTranchesEmitted(cointype.CoinType) uint32 {
+	return c.skaEmissionTranches
+}
+
 // GetSKABurnedAmount returns the mocked burned amount for the specified coin type.
 func (c *testRPCChain) GetSKABurnedAmount(ct cointype.CoinType) int64 {
 	if c.skaBurnedAmounts == nil {
@@ -488,6 +542,31 @@ func (c *testRPCChain) GetAllSKABurnedAmounts() map[cointype.CoinType]int64 {
 	return result
 }
 
+// FetchSKABurns returns the mocked burns and journal start height.
+func (c *testRPCChain) FetchSKABurns(cointype.CoinType, int64, int) ([]blockchain.SKABurnRecord, int64, error) {
+	return c.skaBurns, c.skaBurnJournalStart, c.fetchSKABurnsErr
+}
+
+// FetchBlockFeeTotals returns the mocked per coin type fee totals of a block.
+func (c *testRPCChain) FetchBlockFeeTotals(*chainhash.Hash) (*blockchain.BlockFeeTotals, error) {
+	return c.blockFeeTotals, c.fetchBlockFeeTotalsErr
+}
+
+// FetchBlockStats returns the mocked per coin type statistics of a block.
+func (c *testRPCChain) FetchBlockStats(*chainhash.Hash) (*blockchain.BlockStats, error) {
+	return c.blockStats, c.fetchBlockStatsErr
+}
+
+// FetchBlockSpends returns the mocked outputs spent by a block.
+func (c *testRPCChain) FetchBlockSpends(*chainhash.Hash) (*blockchain.BlockSpends, error) {
+	return c.blockSpends, c.fetchBlockSpendsErr
+}
+
+// ValidationStats returns the mocked durations of each validation stage.
+func (c *testRPCChain) ValidationStats() []blockchain.ValidationStageStats {
+	return c.validationStats
+}
+
 // testPeer provides a mock peer by implementing the Peer interface.
 type testPeer struct {
 	addr              string
@@ -499,6 +578,7 @@ type testPeer struct {
 	isTxRelayDisabled bool
 	banScore          uint32
 	statsSnapshot     *peer.StatsSnap
+	txRelayStats      map[cointype.CoinType]PeerTxRelayStats
 }
 
 // Addr returns a mocked peer address.
@@ -550,6 +630,12 @@ func (p *testPeer) BanScore() uint32 {
 	return p.banScore
 }
 
+// TxRelayStats returns mocked transaction relay statistics for the peer broken
+// down by coin type.
+func (p *testPeer) TxRelayStats() map[cointype.CoinType]PeerTxRelayStats {
+	return p.txRelayStats
+}
+
 // testProfManager provides a mock profiler manager by implementing the
 // ProfilerManager interface.
 type testProfManager struct {
@@ -610,7 +696,11 @@ type testSyncManager struct {
 	syncHeight            int64
 	processTransaction    []*dcrutil.Tx
 	processTransactionErr error
+	processPackage        []*dcrutil.Tx
+	processPackageErr     error
 	recentlyConfirmedTxn  bool
+	stakeLaneStats        netsync.TxLaneStats
+	regularLaneStats      netsync.TxLaneStats
 }
 
 // IsCurrent returns a mocked bool representing whether or not the sync manager
@@ -646,12 +736,25 @@ func (s *testSyncManager) ProcessTransaction(tx *dcrutil.Tx, allowOrphans bool,
 	return s.processTransaction, s.processTransactionErr
 }
 
+// ProcessPackage provides a mock implementation for relaying the provided
+// package of related transactions for validation and insertion into the memory
+// pool together.
+func (s *testSyncManager) ProcessPackage(pkg []*dcrutil.Tx, allowHighFees bool) ([]*dcrutil.Tx, error) {
+	return s.processPackage, s.processPackageErr
+}
+
 // RecentlyConfirmedTxn provides a mock implementation for checking if a
 // transaction has been confirmed by a recent block.
 func (s *testSyncManager) RecentlyConfirmedTxn(hash *chainhash.Hash) bool {
 	return s.recentlyConfirmedTxn
 }
 
+// TxLaneStats returns the mocked metrics of the stake and regular transaction
+// validation lanes.
+func (s *testSyncManager) TxLaneStats() (netsync.TxLaneStats, netsync.TxLaneStats) {
+	return s.stakeLaneStats, s.regularLaneStats
+}
+
 // testExistsAddresser provides a mock exists addresser by implementing the
 // ExistsAddresser interface.
 type testExistsAddresser struct {
@@ -731,6 +834,185 @@ func (t *testTxIndexer) Entry(hash *chainhash.Hash) (*indexers.TxIndexEntry, err
 	return t.entry(hash)
 }
 
+// testWatchOnlyIndexer provides a mock watch-only index by implementing the
+// WatchOnlyIndexer interface.
+type testWatchOnlyIndexer struct {
+	tipHeight    int64
+	tipHash      *chainhash.Hash
+	tipErr       error
+	signalOnWait bool
+	importErr    error
+	removed      bool
+	removeErr    error
+	watched      []indexers.WatchedAddress
+	watchedErr   error
+	utxos        []indexers.WatchOnlyUtxo
+	utxosErr     error
+	received     []indexers.WatchOnlyReceived
+	receivedErr  error
+}
+
+// Name returns the human-readable name of the index.
+func (w *testWatchOnlyIndexer) Name() string {
+	return "testWatchOnlyIndexer"
+}
+
+// Tip returns the current index tip.
+func (w *testWatchOnlyIndexer) Tip() (int64, *chainhash.Hash, error) {
+	return w.tipHeight, w.tipHash, w.tipErr
+}
+
+// WaitForSync subscribes clients for the next index sync update.
+func (w *testWatchOnlyIndexer) WaitForSync() chan bool {
+	c := make(chan bool)
+	if w.signalOnWait {
+		close(c)
+	}
+	return c
+}
+
+// ImportAddress returns a mocked error for importing the provided address.
+func (w *testWatchOnlyIndexer) ImportAddress(_ context.Context, _ stdaddr.Address, _ cointype.CoinType, _ int64) error {
+	return w.importErr
+}
+
+// RemoveAddress returns a mocked result for removing the provided address.
+func (w *testWatchOnlyIndexer) RemoveAddress(_ stdaddr.Address, _ cointype.CoinType) (bool, error) {
+	return w.removed, w.removeErr
+}
+
+// WatchedAddresses returns the mocked watched addresses.
+func (w *testWatchOnlyIndexer) WatchedAddresses() ([]indexers.WatchedAddress, error) {
+	return w.watched, w.watchedErr
+}
+
+// UnspentOutputs returns the mocked unspent outputs of the watched addresses.
+func (w *testWatchOnlyIndexer) UnspentOutputs() ([]indexers.WatchOnlyUtxo, error) {
+	return w.utxos, w.utxosErr
+}
+
+// ReceivedOutputs returns a mocked slice of all outputs received by a watched
+// address.
+func (w *testWatchOnlyIndexer) ReceivedOutputs(_ stdaddr.Address, _ cointype.CoinType) ([]indexers.WatchOnlyReceived, error) {
+	return w.received, w.receivedErr
+}
+
+// testEmissionIndexer provides a mock emission index by implementing the
+// EmissionIndexer interface.
+type testEmissionIndexer struct {
+	tipHeight    int64
+	tipHash      *chainhash.Hash
+	tipErr       error
+	signalOnWait bool
+	emissions    map[cointype.CoinType][]indexers.EmissionEntry
+	emissionsErr error
+	firstTxns    map[cointype.CoinType]*indexers.FirstTxEntry
+	firstTxErr   error
+}
+
+// Name returns the human-readable name of the index.
+func (e *testEmissionIndexer) Name() string {
+	return "testEmissionIndexer"
+}
+
+// Tip returns the current index tip.
+func (e *testEmissionIndexer) Tip() (int64, *chainhash.Hash, error) {
+	return e.tipHeight, e.tipHash, e.tipErr
+}
+
+// WaitForSync subscribes clients for the next index sync update.
+func (e *testEmissionIndexer) WaitForSync() chan bool {
+	c := make(chan bool)
+	if e.signalOnWait {
+		close(c)
+	}
+	return c
+}
+
+// Emissions returns the mocked emissions of the provided coin type.
+func (e *testEmissionIndexer) Emissions(coinType cointype.CoinType) ([]indexers.EmissionEntry, error) {
+	return e.emissions[coinType], e.emissionsErr
+}
+
+// FirstTransaction returns the mocked first transaction of the provided coin
+// type.
+func (e *testEmissionIndexer) FirstTransaction(coinType cointype.CoinType) (*indexers.FirstTxEntry, error) {
+	return e.firstTxns[coinType], e.firstTxErr
+}
+
+// testEmissionCheckpointer provides a mock source of whether the emission state
+// has been verified against the emission checkpoints by implementing the
+// EmissionCheckpointer interface.
+type testEmissionCheckpointer struct {
+	verifiedErr error
+}
+
+// Verified returns the mocked verification error.
+func (c *testEmissionCheckpointer) Verified() error {
+	return c.verifiedErr
+}
+
+// testUtilizationHistorian provides a mock block space utilization history by
+// implementing the UtilizationHistorian interface.
+type testUtilizationHistorian struct {
+	blocks []fees.BlockUtilization
+}
+
+// Recent returns up to the provided number of the mocked blocks.
+func (h *testUtilizationHistorian) Recent(count int) []fees.BlockUtilization {
+	if count > len(h.blocks) {
+		count = len(h.blocks)
+	}
+	return h.blocks[:count]
+}
+
+// testAllocDivergenceMonitor provides a mock block space allocation divergence
+// monitor by implementing the AllocDivergenceMonitor interface.
+type testAllocDivergenceMonitor struct {
+	stats blockalloc.DivergenceStats
+}
+
+// Stats returns the mocked allocation divergence statistics.
+func (m *testAllocDivergenceMonitor) Stats() *blockalloc.DivergenceStats {
+	return &m.stats
+}
+
+// testSupplyScanner provides a mock UTXO supply scanner by implementing the
+// SupplyScanner interface.
+type testSupplyScanner struct {
+	status supplyscan.Status
+}
+
+// Status returns the mocked status of the scanner.
+func (s *testSupplyScanner) Status() supplyscan.Status {
+	return s.status
+}
+
+// testIndexSyncReporter provides a mock source of the sync progress of the
+// indexes by implementing the IndexSyncReporter interface.
+type testIndexSyncReporter struct {
+	statuses  []indexers.IndexSyncStatus
+	statusErr error
+}
+
+// SyncStatus returns the mocked sync progress of the indexes.
+func (r *testIndexSyncReporter) SyncStatus() ([]indexers.IndexSyncStatus, error) {
+	return r.statuses, r.statusErr
+}
+
+// WaitForSync subscribes clients for the next sync update of the index with
+// the provided name.  The returned channel is already closed.
+func (r *testIndexSyncReporter) WaitForSync(name string) (chan bool, bool) {
+	for i := range r.statuses {
+		if r.statuses[i].Name == name {
+			c := make(chan bool)
+			close(c)
+			return c, true
+		}
+	}
+	return nil, false
+}
+
 // testDB provides a mock database by implementing the database.DB interface.
 type testDB struct {
 	dbType   string
@@ -881,6 +1163,8 @@ type testConnManager struct {
 	netTotalSent        uint64
 	connectedPeers      []Peer
 	persistentPeers     []Peer
+	rebroadcastTxs      []RebroadcastTx
+	abandonRebroadcast  bool
 	lookup              func(host string) ([]net.IP, error)
 }
 
@@ -944,6 +1228,18 @@ func (c *testConnManager) BroadcastMessage(msg wire.Message) {}
 // intervals until they show up in a block.
 func (c *testConnManager) AddRebroadcastInventory(iv *wire.InvVect, data interface{}) {}
 
+// RebroadcastTxs provides a mock implementation for returning the transactions
+// that are periodically rebroadcast.
+func (c *testConnManager) RebroadcastTxs() []RebroadcastTx {
+	return c.rebroadcastTxs
+}
+
+// AbandonRebroadcastTx provides a mock implementation for stopping the
+// rebroadcast of a transaction.
+func (c *testConnManager) AbandonRebroadcastTx(hash *chainhash.Hash) bool {
+	return c.abandonRebroadcast
+}
+
 // RelayTransactions provides a mock implementation for generating and relaying
 // inventory vectors for all of the passed transactions to all connected peers.
 func (c *testConnManager) RelayTransactions(txns []*dcrutil.Tx) {}
@@ -1038,6 +1334,38 @@ func (e *testFeeEstimator) EstimateFee(targetConfs int32) (dcrutil.Amount, error
 	return e.estimateFeeAmt, e.estimateFeeErr
 }
 
+// testCoinTypeFeeCalculator provides a mock coin type fee calculator by
+// implementing the CoinTypeFeeCalculator interface.
+type testCoinTypeFeeCalculator struct {
+	feeStats         map[cointype.CoinType]*CoinTypeFeeStats
+	feeStatsErr      error
+	estimateFeeRate  dcrutil.Amount
+	estimateFeeErr   error
+	estimateAccuracy []FeeEstimateAccuracy
+}
+
+// GetFeeStats returns the mocked fee statistics for the provided coin type.
+func (c *testCoinTypeFeeCalculator) GetFeeStats(coinType cointype.CoinType) (*CoinTypeFeeStats, error) {
+	if c.feeStatsErr != nil {
+		return nil, c.feeStatsErr
+	}
+	stats, ok := c.feeStats[coinType]
+	if !ok {
+		return nil, fmt.Errorf("no fee stats for coin type %d", coinType)
+	}
+	return stats, nil
+}
+
+// EstimateFeeRate returns a mocked fee rate estimate.
+func (c *testCoinTypeFeeCalculator) EstimateFeeRate(coinType cointype.CoinType, targetConfirmations int) (dcrutil.Amount, error) {
+	return c.estimateFeeRate, c.estimateFeeErr
+}
+
+// EstimateAccuracy returns the mocked fee estimate accuracy.
+func (c *testCoinTypeFeeCalculator) EstimateAccuracy() []FeeEstimateAccuracy {
+	return c.estimateAccuracy
+}
+
 // testLogManager provides a mock log manager by implementing the LogManager
 // interface.
 type testLogManager struct {
@@ -1121,6 +1449,9 @@ type testBlockTemplater struct {
 	currTemplate    *mining.BlockTemplate
 	currTemplateErr error
 	simulateNewNtfn bool
+	budgetStats     mining.TemplateBudgetStats
+	spacePolicy     mining.BlockSpacePolicy
+	spacePolicyErr  error
 }
 
 // ForceRegen asks the block templater to generate a new template immediately.
@@ -1160,6 +1491,27 @@ func (b *testBlockTemplater) CurrentTemplate() (*mining.BlockTemplate, error) {
 // time while taking into account the consensus rules.
 func (b *testBlockTemplater) UpdateBlockTime(header *wire.BlockHeader) {}
 
+// TemplateBudgetStats returns the mocked block template time budget
+// statistics.
+func (b *testBlockTemplater) TemplateBudgetStats() mining.TemplateBudgetStats {
+	return b.budgetStats
+}
+
+// BlockSpacePolicy returns the mocked block space policy.
+func (b *testBlockTemplater) BlockSpacePolicy() mining.BlockSpacePolicy {
+	return b.spacePolicy
+}
+
+// SetBlockSpacePolicy replaces the mocked block space policy unless a mocked
+// error is set.
+func (b *testBlockTemplater) SetBlockSpacePolicy(policy mining.BlockSpacePolicy) error {
+	if b.spacePolicyErr != nil {
+		return b.spacePolicyErr
+	}
+	b.spacePolicy = policy
+	return nil
+}
+
 // testTxMempooler provides a mock mempool transaction data source by
 // implementing the TxMempooler interface.
 type testTxMempooler struct {
@@ -1170,6 +1522,13 @@ type testTxMempooler struct {
 	fetchTransaction    *dcrutil.Tx
 	fetchTransactionErr error
 	tspendHashes        []chainhash.Hash
+	testAcceptFee       int64
+	testAcceptErr       error
+	maxTxAge            map[cointype.CoinType]time.Duration
+	expiredTxCounts     map[cointype.CoinType]uint64
+	maxPoolSize         map[cointype.CoinType]int64
+	mempoolMinFees      map[cointype.CoinType]int64
+	chainLimits         map[cointype.CoinType]mempool.ChainLimits
 }
 
 // HaveTransactions returns a mocked bool slice representing whether or not the
@@ -1207,6 +1566,41 @@ func (mp *testTxMempooler) TSpendHashes() []chainhash.Hash {
 	return mp.tspendHashes
 }
 
+// TestAcceptTransaction returns the mocked fee and error for checking whether
+// the passed transaction would be accepted to the pool.
+func (mp *testTxMempooler) TestAcceptTransaction(tx *dcrutil.Tx, allowHighFees bool) (int64, error) {
+	return mp.testAcceptFee, mp.testAcceptErr
+}
+
+// MaxTxAge returns the mocked max age of regular transactions of the provided
+// coin type.
+func (mp *testTxMempooler) MaxTxAge(coinType cointype.CoinType) time.Duration {
+	return mp.maxTxAge[coinType]
+}
+
+// ExpiredTxCounts returns the mocked number of transactions evicted for
+// exceeding the max age of their coin type.
+func (mp *testTxMempooler) ExpiredTxCounts() map[cointype.CoinType]uint64 {
+	return mp.expiredTxCounts
+}
+
+// MaxPoolSize returns the mocked size limit of regular transactions of the
+// provided coin type.
+func (mp *testTxMempooler) MaxPoolSize(coinType cointype.CoinType) int64 {
+	return mp.maxPoolSize[coinType]
+}
+
+// MempoolMinFees returns the mocked rolling minimum fee rates.
+func (mp *testTxMempooler) MempoolMinFees() map[cointype.CoinType]int64 {
+	return mp.mempoolMinFees
+}
+
+// ChainLimits returns the mocked limits placed on the chains of unconfirmed
+// regular transactions of the provided coin type.
+func (mp *testTxMempooler) ChainLimits(coinType cointype.CoinType) mempool.ChainLimits {
+	return mp.chainLimits[coinType]
+}
+
 // testNtfnManager provides a mock notification manager by implementing the
 // NtfnManager interface.
 type testNtfnManager struct {
@@ -1228,10 +1622,22 @@ func (mgr *testNtfnManager) NotifyWork(templateNtfn *mining.TemplateNtfn) {}
 // NotifyTSpend passes new tspends to the manager for processing.
 func (mgr *testNtfnManager) NotifyTSpend(tx *dcrutil.Tx) {}
 
+// NotifyDoubleSpend passes transactions rejected for double spending coins
+// spent by the passed conflicting mempool transactions to the manager for
+// processing.
+func (mgr *testNtfnManager) NotifyDoubleSpend(tx *dcrutil.Tx, coinType cointype.CoinType, conflicts []chainhash.Hash) {
+}
+
 // NotifyReorganization passes a blockchain reorganization notification to
 // the manager for processing.
 func (mgr *testNtfnManager) NotifyReorganization(rd *blockchain.ReorganizationNtfnsData) {}
 
+// NotifySKAEmissionsFinal passes SKA emissions in a main chain block that
+// reached the configured number of confirmations to the manager for
+// processing.
+func (mgr *testNtfnManager) NotifySKAEmissionsFinal(block *dcrutil.Block, emissions []blockchain.SKAEmissionRecord) {
+}
+
 // NotifyWinningTickets passes newly winning tickets to the manager for
 // processing.
 func (mgr *testNtfnManager) NotifyWinningTickets(wtnd *WinningTicketsNtfnData) {}
@@ -1263,7 +1669,7 @@ func (mgr *testNtfnManager) UnregisterBlockUpdates(wsc *wsClient) {}
 
 // RegisterWorkUpdates requests work update notifications to the passed
 // websocket client.
-func (mgr *testNtfnManager) RegisterWorkUpdates(wsc *wsClient) {}
+func (mgr *testNtfnManager) RegisterWorkUpdates(wsc *wsClient, filter *workNtfnFilter) {}
 
 // UnregisterWorkUpdates removes work update notifications for the passed
 // websocket client.
@@ -1277,6 +1683,14 @@ func (mgr *testNtfnManager) RegisterTSpendUpdates(wsc *wsClient) {}
 // websocket client.
 func (mgr *testNtfnManager) UnregisterTSpendUpdates(wsc *wsClient) {}
 
+// RegisterDoubleSpendUpdates requests double spend notifications to the passed
+// websocket client.
+func (mgr *testNtfnManager) RegisterDoubleSpendUpdates(wsc *wsClient) {}
+
+// UnregisterDoubleSpendUpdates removes double spend notifications for the
+// passed websocket client.
+func (mgr *testNtfnManager) UnregisterDoubleSpendUpdates(wsc *wsClient) {}
+
 // RegisterWinningTickets requests winning tickets update notifications
 // to the passed websocket client.
 func (mgr *testNtfnManager) RegisterWinningTickets(wsc *wsClient) {}
@@ -1508,11 +1922,21 @@ type rpcTest struct {
 	mockProfManager       *testProfManager
 	mockAddrManager       *testAddrManager
 	mockFeeEstimator      *testFeeEstimator
+	mockCoinTypeFeeCalc   *testCoinTypeFeeCalculator
 	mockSyncManager       *testSyncManager
 	mockExistsAddresser   *testExistsAddresser
 	setExistsAddresserNil bool
 	mockTxIndexer         *testTxIndexer
 	setTxIndexerNil       bool
+	mockWatchOnlyIndexer  *testWatchOnlyIndexer
+	setWatchOnlyIdxNil    bool
+	mockEmissionIndexer   *testEmissionIndexer
+	setEmissionIdxNil     bool
+	mockIndexSyncReporter *testIndexSyncReporter
+	mockSupplyScanner     *testSupplyScanner
+	mockEmissionCkpt      *testEmissionCheckpointer
+	mockUtilizationHist   *testUtilizationHistorian
+	mockAllocDivergence   *testAllocDivergenceMonitor
 	mockDB                *testDB
 	mockConnManager       *testConnManager
 	mockClock             *testClock
@@ -1762,6 +2186,61 @@ func defaultMockExistsAddresser() *testExistsAddresser {
 	}
 }
 
+// defaultMockWatchOnlyIndexer provides a default mock watch-only index to be
+// used throughout the tests. Tests can override these defaults by calling
+// defaultMockWatchOnlyIndexer, updating fields as necessary on the returned
+// *testWatchOnlyIndexer, and then setting rpcTest.mockWatchOnlyIndexer as that
+// *testWatchOnlyIndexer.
+func defaultMockWatchOnlyIndexer() *testWatchOnlyIndexer {
+	bestHash := block432100.Header.BlockHash()
+	return &testWatchOnlyIndexer{
+		tipHeight:    int64(block432100.Header.Height),
+		tipHash:      &bestHash,
+		signalOnWait: true,
+	}
+}
+
+// defaultMockEmissionIndexer provides a default mock emission index to be used
+// throughout the tests. Tests can override these defaults by calling
+// defaultMockEmissionIndexer, updating fields as necessary on the returned
+// *testEmissionIndexer, and then setting rpcTest.mockEmissionIndexer as that
+// *testEmissionIndexer.
+func defaultMockEmissionIndexer() *testEmissionIndexer {
+	bestHash := block432100.Header.BlockHash()
+	return &testEmissionIndexer{
+		tipHeight:    int64(block432100.Header.Height),
+		tipHash:      &bestHash,
+		signalOnWait: true,
+	}
+}
+
+// defaultMockIndexSyncReporter provides a default mock source of the sync
+// progress of the indexes to be used throughout the tests.  Tests can override
+// these defaults by calling defaultMockIndexSyncReporter, updating fields as
+// necessary on the returned *testIndexSyncReporter, and then setting
+// rpcTest.mockIndexSyncReporter as that *testIndexSyncReporter.
+func defaultMockIndexSyncReporter() *testIndexSyncReporter {
+	bestHeight := int64(block432100.Header.Height)
+	bestHash := block432100.Header.BlockHash()
+	return &testIndexSyncReporter{
+		statuses: []indexers.IndexSyncStatus{{
+			Name:       "ska emission index",
+			Version:    1,
+			TipHeight:  bestHeight,
+			TipHash:    bestHash,
+			BestHeight: bestHeight,
+			Synced:     true,
+		}, {
+			Name:               "transaction index",
+			Version:            2,
+			TipHeight:          bestHeight / 4,
+			TipHash:            chainhash.Hash{0x01},
+			BestHeight:         bestHeight,
+			EstimatedRemaining: 90*time.Minute + 400*time.Millisecond,
+		}},
+	}
+}
+
 // defaultMockTxIndexer provides a default mock transaction indexer to be
 // used throughout the tests. Tests can override these defaults by calling
 // defaultMockTxIndexer, updating fields as necessary on the returned
@@ -1934,25 +2413,28 @@ func defaultMockTxMempooler() *testTxMempooler {
 // the tests.  Defaults can be overridden by tests through the rpcTest struct.
 func defaultMockConfig(chainParams *chaincfg.Params) *Config {
 	return &Config{
-		ChainParams:     chainParams,
-		Chain:           defaultMockRPCChain(),
-		SanityChecker:   defaultMockSanityChecker(),
-		BlockTemplater:  defaultMockBlockTemplater(),
-		AddrManager:     defaultMockAddrManager(),
-		FeeEstimator:    defaultMockFeeEstimator(),
-		SyncMgr:         defaultMockSyncManager(),
-		ExistsAddresser: defaultMockExistsAddresser(),
-		TxIndexer:       defaultMockTxIndexer(),
-		DB:              defaultMockDB(),
-		ConnMgr:         defaultMockConnManager(),
-		CPUMiner:        defaultMockCPUMiner(),
-		TxMempooler:     defaultMockTxMempooler(),
-		Clock:           &testClock{},
-		LogManager:      defaultMockLogManager(),
-		FiltererV2:      defaultMockFiltererV2(),
-		TimeSource:      blockchain.NewMedianTime(),
-		Services:        wire.SFNodeNetwork | wire.SFNodeCF,
-		SubsidyCache:    standalone.NewSubsidyCache(chainParams),
+		ChainParams:       chainParams,
+		Chain:             defaultMockRPCChain(),
+		SanityChecker:     defaultMockSanityChecker(),
+		BlockTemplater:    defaultMockBlockTemplater(),
+		AddrManager:       defaultMockAddrManager(),
+		FeeEstimator:      defaultMockFeeEstimator(),
+		SyncMgr:           defaultMockSyncManager(),
+		ExistsAddresser:   defaultMockExistsAddresser(),
+		TxIndexer:         defaultMockTxIndexer(),
+		WatchOnlyIndexer:  defaultMockWatchOnlyIndexer(),
+		EmissionIndexer:   defaultMockEmissionIndexer(),
+		IndexSyncReporter: defaultMockIndexSyncReporter(),
+		DB:                defaultMockDB(),
+		ConnMgr:           defaultMockConnManager(),
+		CPUMiner:          defaultMockCPUMiner(),
+		TxMempooler:       defaultMockTxMempooler(),
+		Clock:             &testClock{},
+		LogManager:        defaultMockLogManager(),
+		FiltererV2:        defaultMockFiltererV2(),
+		TimeSource:        blockchain.NewMedianTime(),
+		Services:          wire.SFNodeNetwork | wire.SFNodeCF,
+		SubsidyCache:      standalone.NewSubsidyCache(chainParams),
 		NetInfo: []types.NetworksResult{{
 			Name:                      "IPV4",
 			Limited:                   false,
@@ -1972,7 +2454,9 @@ func defaultMockConfig(chainParams *chaincfg.Params) *Config {
 			Proxy:                     "",
 			ProxyRandomizeCredentials: false,
 		}},
+		EmissionFinalConfs: 6,
 		MinRelayTxFee:      dcrutil.Amount(10000),
+		BlockMaxSize:       375000,
 		MaxProtocolVersion: wire.DualCoinVersion,
 		UserAgentVersion: fmt.Sprintf("%d.%d.%d", version.Major, version.Minor,
 			version.Patch),
@@ -2044,6 +2528,119 @@ func TestHandleAddNode(t *testing.T) {
 	}})
 }
 
+func TestHandleAttestEmissionKey(t *testing.T) {
+	t.Parallel()
+
+	privKey := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{0x01}, 32))
+	params := cloneParams(defaultChainParams)
+	params.SKACoins[1].EmissionKey = privKey.PubKey()
+	params.SKACoins[2].EmissionKey = nil
+	challenge := []byte("custody challenge")
+	sig, err := blockchain.SignEmissionKeyAttestation(1, challenge, privKey,
+		params)
+	if err != nil {
+		t.Fatalf("unexpected error signing attestation: %v", err)
+	}
+	challengeHex := hex.EncodeToString(challenge)
+	sigHex := hex.EncodeToString(sig)
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:            "handleAttestEmissionKey: valid",
+		handler:         handleAttestEmissionKey,
+		cmd:             types.NewAttestEmissionKeyCmd(1, challengeHex, sigHex),
+		mockChainParams: params,
+		result: types.AttestEmissionKeyResult{
+			CoinType:    1,
+			Fingerprint: "bac02fa8",
+			Valid:       true,
+		},
+	}, {
+		name:            "handleAttestEmissionKey: other challenge",
+		handler:         handleAttestEmissionKey,
+		cmd:             types.NewAttestEmissionKeyCmd(1, "00", sigHex),
+		mockChainParams: params,
+		result: types.AttestEmissionKeyResult{
+			CoinType:    1,
+			Fingerprint: "bac02fa8",
+			Reason: "emission key attestation signature verification " +
+				"failed",
+		},
+	}, {
+		name:            "handleAttestEmissionKey: no emission key",
+		handler:         handleAttestEmissionKey,
+		cmd:             types.NewAttestEmissionKeyCmd(2, challengeHex, sigHex),
+		mockChainParams: params,
+		wantErr:         true,
+		errCode:         dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:            "handleAttestEmissionKey: invalid challenge hex",
+		handler:         handleAttestEmissionKey,
+		cmd:             types.NewAttestEmissionKeyCmd(1, "zz", sigHex),
+		mockChainParams: params,
+		wantErr:         true,
+		errCode:         dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:            "handleAttestEmissionKey: invalid signature hex",
+		handler:         handleAttestEmissionKey,
+		cmd:             types.NewAttestEmissionKeyCmd(1, challengeHex, "zz"),
+		mockChainParams: params,
+		wantErr:         true,
+		errCode:         dcrjson.ErrRPCDecodeHexString,
+	}})
+}
+
+func TestHandleBumpFeeEstimate(t *testing.T) {
+	t.Parallel()
+
+	tx := dcrutil.NewTx(block432100.Transactions[1])
+	txSize := int64(tx.MsgTx().SerializeSize())
+	txDesc := &mempool.TxDesc{
+		TxDesc: mining.TxDesc{
+			Tx:     tx,
+			Type:   stake.TxTypeRegular,
+			Fee:    10000,
+			TxSize: txSize,
+		},
+	}
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleBumpFeeEstimate: invalid tx hash",
+		handler: handleBumpFeeEstimate,
+		cmd: &types.BumpFeeEstimateCmd{
+			TxHash: "invalid",
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:    "handleBumpFeeEstimate: transaction not in mempool",
+		handler: handleBumpFeeEstimate,
+		cmd: &types.BumpFeeEstimateCmd{
+			TxHash: tx.Hash().String(),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCNoTxInfo,
+	}, {
+		name:    "handleBumpFeeEstimate: ok",
+		handler: handleBumpFeeEstimate,
+		cmd: &types.BumpFeeEstimateCmd{
+			TxHash: tx.Hash().String(),
+		},
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.txDescs = []*mempool.TxDesc{txDesc}
+			return mp
+		}(),
+		result: &types.BumpFeeEstimateResult{
+			Txid:          tx.Hash().String(),
+			Size:          txSize,
+			Fee:           0.0001,
+			FeeRate:       dcrutil.Amount(10000 * 1000 / txSize).ToCoin(),
+			BucketSize:    375000,
+			FitsNextBlock: true,
+		},
+	}})
+}
+
 func TestHandleCreateRawSStx(t *testing.T) {
 	t.Parallel()
 
@@ -2764,6 +3361,110 @@ func TestHandleCreateRawTransaction(t *testing.T) {
 	}})
 }
 
+func TestHandleCreateSweepTransaction(t *testing.T) {
+	t.Parallel()
+
+	const (
+		payAddr  = "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8"
+		feeRate  = dcrutil.Amount(10000)
+		coinType = cointype.CoinType(1)
+	)
+	addr, err := stdaddr.DecodeAddress(payAddr, chaincfg.MainNetParams())
+	if err != nil {
+		t.Fatalf("unexpected address decode error: %v", err)
+	}
+	scriptVer, script := addr.PaymentScript()
+	outpoints := []types.OutPoint{{
+		Hash:  "e02f03a25a57afdd402818fe5b13985a0731502ad8a8c93d1874900e84d3330d",
+		Index: 0,
+	}}
+	prevHash, err := chainhash.NewHashFromStr(outpoints[0].Hash)
+	if err != nil {
+		t.Fatalf("unexpected hash decode error: %v", err)
+	}
+	chainWithUtxo := func(amount int64, isCoinBase bool) *testRPCChain {
+		chain := defaultMockRPCChain()
+		chain.fetchUtxoEntry = &testRPCUtxoEntry{
+			amount:     amount,
+			coinType:   coinType,
+			height:     uint32(chain.bestSnapshot.Height),
+			isCoinBase: isCoinBase,
+			txType:     stake.TxTypeRegular,
+		}
+		return chain
+	}
+
+	// The sweep pays the total amount less the fee for the signed size to
+	// the address.
+	sweepTx := wire.NewMsgTx()
+	sweepTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0,
+		wire.TxTreeRegular), 5e8, nil))
+	sweepOut := &wire.TxOut{
+		CoinType: coinType,
+		Version:  scriptVer,
+		PkScript: script,
+	}
+	sweepTx.AddTxOut(sweepOut)
+	fee := estimateSignedSize(sweepTx) * int64(feeRate) / 1000
+	sweepOut.Value = 5e8 - fee
+	var buf bytes.Buffer
+	if err := sweepTx.BtcEncode(&buf, wire.DualCoinVersion); err != nil {
+		t.Fatalf("unexpected tx serialization error: %v", err)
+	}
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleCreateSweepTransaction: invalid address",
+		handler: handleCreateSweepTransaction,
+		cmd: &types.CreateSweepTransactionCmd{
+			CoinType:  uint8(coinType),
+			Outpoints: outpoints,
+			Address:   "Tsf5Qvq2m7X5KzTZDdSGfa6WrMtikYVRkaL",
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidAddressOrKey,
+	}, {
+		name:    "handleCreateSweepTransaction: immature coinbase ignored",
+		handler: handleCreateSweepTransaction,
+		cmd: &types.CreateSweepTransactionCmd{
+			CoinType:  uint8(coinType),
+			Outpoints: outpoints,
+			Address:   payAddr,
+		},
+		mockChain:           chainWithUtxo(5e8, true),
+		mockCoinTypeFeeCalc: &testCoinTypeFeeCalculator{estimateFeeRate: feeRate},
+		wantErr:             true,
+		errCode:             dcrjson.ErrRPCWalletInsufficientFunds,
+	}, {
+		name:    "handleCreateSweepTransaction: dust after fee",
+		handler: handleCreateSweepTransaction,
+		cmd: &types.CreateSweepTransactionCmd{
+			CoinType:  uint8(coinType),
+			Outpoints: outpoints,
+			Address:   payAddr,
+		},
+		mockChain:           chainWithUtxo(1000, false),
+		mockCoinTypeFeeCalc: &testCoinTypeFeeCalculator{estimateFeeRate: feeRate},
+		wantErr:             true,
+		errCode:             dcrjson.ErrRPCWalletInsufficientFunds,
+	}, {
+		name:    "handleCreateSweepTransaction: ok",
+		handler: handleCreateSweepTransaction,
+		cmd: &types.CreateSweepTransactionCmd{
+			CoinType:  uint8(coinType),
+			Outpoints: outpoints,
+			Address:   payAddr,
+		},
+		mockChain:           chainWithUtxo(5e8, false),
+		mockCoinTypeFeeCalc: &testCoinTypeFeeCalculator{estimateFeeRate: feeRate},
+		result: &types.FundRawTransactionResult{
+			Hex:       hex.EncodeToString(buf.Bytes()),
+			Fee:       dcrutil.Amount(fee).ToCoin(),
+			CoinType:  uint8(coinType),
+			ChangePos: -1,
+		},
+	}})
+}
+
 func TestHandleDebugLevel(t *testing.T) {
 	t.Parallel()
 
@@ -2811,10 +3512,12 @@ func TestHandleDecodeRawTransaction(t *testing.T) {
 				"000000000ffffffff00",
 		},
 		result: types.TxRawDecodeResult{
-			Txid:     "aa062122e592a84c4a365bb40b93a16088c1e43b2af2ab96d7c7ff9433ba5857",
-			Version:  1,
-			Locktime: 1,
-			Expiry:   1,
+			Txid:            "aa062122e592a84c4a365bb40b93a16088c1e43b2af2ab96d7c7ff9433ba5857",
+			Version:         1,
+			Locktime:        1,
+			Expiry:          1,
+			PrimaryCoinType: 0, // VAR coin type
+			MinRelayFee:     0.0001,
 			Vin: []types.Vin{{
 				Txid:        "e02f03a25a57afdd402818fe5b13985a0731502ad8a8c93d1874900e84d3330d",
 				Vout:        0,
@@ -2854,10 +3557,12 @@ func TestHandleDecodeRawTransaction(t *testing.T) {
 				"000000000ffffffff00",
 		},
 		result: types.TxRawDecodeResult{
-			Txid:     "aa062122e592a84c4a365bb40b93a16088c1e43b2af2ab96d7c7ff9433ba5857",
-			Version:  1,
-			Locktime: 1,
-			Expiry:   1,
+			Txid:            "aa062122e592a84c4a365bb40b93a16088c1e43b2af2ab96d7c7ff9433ba5857",
+			Version:         1,
+			Locktime:        1,
+			Expiry:          1,
+			PrimaryCoinType: 0, // VAR coin type
+			MinRelayFee:     0.0001,
 			Vin: []types.Vin{{
 				Txid:        "e02f03a25a57afdd402818fe5b13985a0731502ad8a8c93d1874900e84d3330d",
 				Vout:        0,
@@ -3021,6 +3726,74 @@ func TestHandleEstimateFee(t *testing.T) {
 	}})
 }
 
+func TestHandleEstimateFeeAccuracy(t *testing.T) {
+	t.Parallel()
+
+	skaCoinType := uint8(1)
+	feeCalc := &testCoinTypeFeeCalculator{
+		estimateAccuracy: []FeeEstimateAccuracy{{
+			CoinType:            cointype.CoinTypeVAR,
+			Samples:             4,
+			OnTime:              3,
+			Late:                1,
+			TotalPredictedConfs: 8,
+			TotalActualConfs:    6,
+			TotalAbsError:       4,
+		}, {
+			CoinType:            1,
+			Samples:             2,
+			OnTime:              2,
+			TotalPredictedConfs: 2,
+			TotalActualConfs:    2,
+		}},
+	}
+	varResult := types.EstimateFeeAccuracyResult{
+		CoinType:          0,
+		Name:              "VAR",
+		Samples:           4,
+		OnTime:            3,
+		Late:              1,
+		OnTimeRate:        0.75,
+		AvgPredictedConfs: 2,
+		AvgActualConfs:    1.5,
+		MeanAbsError:      1,
+	}
+	skaResult := types.EstimateFeeAccuracyResult{
+		CoinType:          1,
+		Name:              generateCoinTypeName(1),
+		Samples:           2,
+		OnTime:            2,
+		OnTimeRate:        1,
+		AvgPredictedConfs: 1,
+		AvgActualConfs:    1,
+	}
+	testRPCServerHandler(t, []rpcTest{{
+		name:                "handleEstimateFeeAccuracy: ok all coin types",
+		handler:             handleEstimateFeeAccuracy,
+		cmd:                 &types.EstimateFeeAccuracyCmd{},
+		mockCoinTypeFeeCalc: feeCalc,
+		result:              []types.EstimateFeeAccuracyResult{varResult, skaResult},
+	}, {
+		name:                "handleEstimateFeeAccuracy: ok single coin type",
+		handler:             handleEstimateFeeAccuracy,
+		cmd:                 &types.EstimateFeeAccuracyCmd{CoinType: &skaCoinType},
+		mockCoinTypeFeeCalc: feeCalc,
+		result:              []types.EstimateFeeAccuracyResult{skaResult},
+	}, {
+		name:                "handleEstimateFeeAccuracy: ok no samples",
+		handler:             handleEstimateFeeAccuracy,
+		cmd:                 &types.EstimateFeeAccuracyCmd{},
+		mockCoinTypeFeeCalc: &testCoinTypeFeeCalculator{},
+		result:              []types.EstimateFeeAccuracyResult{},
+	}, {
+		name:    "handleEstimateFeeAccuracy: no fee calculator",
+		handler: handleEstimateFeeAccuracy,
+		cmd:     &types.EstimateFeeAccuracyCmd{},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCMisc,
+	}})
+}
+
 func TestHandleEstimateSmartFee(t *testing.T) {
 	t.Parallel()
 
@@ -3561,6 +4334,145 @@ func TestHandleExistsMempoolTxs(t *testing.T) {
 	}})
 }
 
+func TestHandleFundRawTransaction(t *testing.T) {
+	t.Parallel()
+
+	const (
+		payAddr  = "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8"
+		feeRate  = dcrutil.Amount(10000)
+		coinType = cointype.CoinType(1)
+	)
+	addr, err := stdaddr.DecodeAddress(payAddr, chaincfg.MainNetParams())
+	if err != nil {
+		t.Fatalf("unexpected address decode error: %v", err)
+	}
+	scriptVer, script := addr.PaymentScript()
+	outpoints := []types.OutPoint{{
+		Hash:  "e02f03a25a57afdd402818fe5b13985a0731502ad8a8c93d1874900e84d3330d",
+		Index: 0,
+	}}
+	prevHash, err := chainhash.NewHashFromStr(outpoints[0].Hash)
+	if err != nil {
+		t.Fatalf("unexpected hash decode error: %v", err)
+	}
+	txHex := func(mtx *wire.MsgTx) string {
+		var buf bytes.Buffer
+		if err := mtx.BtcEncode(&buf, wire.DualCoinVersion); err != nil {
+			t.Fatalf("unexpected tx serialization error: %v", err)
+		}
+		return hex.EncodeToString(buf.Bytes())
+	}
+	unfundedTx := func(outputCoinTypes ...cointype.CoinType) *wire.MsgTx {
+		mtx := wire.NewMsgTx()
+		for _, ct := range outputCoinTypes {
+			mtx.AddTxOut(&wire.TxOut{
+				Value:    1e8,
+				CoinType: ct,
+				Version:  scriptVer,
+				PkScript: script,
+			})
+		}
+		return mtx
+	}
+	chainWithUtxo := func(amount int64, ct cointype.CoinType) *testRPCChain {
+		chain := defaultMockRPCChain()
+		chain.fetchUtxoEntry = &testRPCUtxoEntry{
+			amount:   amount,
+			coinType: ct,
+			height:   100,
+			txType:   stake.TxTypeRegular,
+		}
+		return chain
+	}
+
+	// The funded transaction spends the single output and returns the
+	// change less the fee for the signed size to the change address.
+	fundedTx := unfundedTx(coinType)
+	fundedTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0,
+		wire.TxTreeRegular), 5e8, nil))
+	changeOut := &wire.TxOut{
+		CoinType: coinType,
+		Version:  scriptVer,
+		PkScript: script,
+	}
+	fundedTx.AddTxOut(changeOut)
+	fee := estimateSignedSize(fundedTx) * int64(feeRate) / 1000
+	changeOut.Value = 5e8 - 1e8 - fee
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleFundRawTransaction: tx has inputs",
+		handler: handleFundRawTransaction,
+		cmd: &types.FundRawTransactionCmd{
+			HexTx:         txHex(fundedTx),
+			Outpoints:     outpoints,
+			ChangeAddress: payAddr,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleFundRawTransaction: mixed output coin types",
+		handler: handleFundRawTransaction,
+		cmd: &types.FundRawTransactionCmd{
+			HexTx:         txHex(unfundedTx(cointype.CoinTypeVAR, coinType)),
+			Outpoints:     outpoints,
+			ChangeAddress: payAddr,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleFundRawTransaction: fee estimation unavailable",
+		handler: handleFundRawTransaction,
+		cmd: &types.FundRawTransactionCmd{
+			HexTx:         txHex(unfundedTx(coinType)),
+			Outpoints:     outpoints,
+			ChangeAddress: payAddr,
+		},
+		mockChain: chainWithUtxo(5e8, coinType),
+		wantErr:   true,
+		errCode:   dcrjson.ErrRPCMisc,
+	}, {
+		name:    "handleFundRawTransaction: outputs of other coin types ignored",
+		handler: handleFundRawTransaction,
+		cmd: &types.FundRawTransactionCmd{
+			HexTx:         txHex(unfundedTx(coinType)),
+			Outpoints:     outpoints,
+			ChangeAddress: payAddr,
+		},
+		mockChain:           chainWithUtxo(5e8, cointype.CoinTypeVAR),
+		mockCoinTypeFeeCalc: &testCoinTypeFeeCalculator{estimateFeeRate: feeRate},
+		wantErr:             true,
+		errCode:             dcrjson.ErrRPCWalletInsufficientFunds,
+	}, {
+		name:    "handleFundRawTransaction: insufficient funds",
+		handler: handleFundRawTransaction,
+		cmd: &types.FundRawTransactionCmd{
+			HexTx:         txHex(unfundedTx(coinType)),
+			Outpoints:     outpoints,
+			ChangeAddress: payAddr,
+		},
+		mockChain:           chainWithUtxo(1e8, coinType),
+		mockCoinTypeFeeCalc: &testCoinTypeFeeCalculator{estimateFeeRate: feeRate},
+		wantErr:             true,
+		errCode:             dcrjson.ErrRPCWalletInsufficientFunds,
+	}, {
+		name:    "handleFundRawTransaction: ok",
+		handler: handleFundRawTransaction,
+		cmd: &types.FundRawTransactionCmd{
+			HexTx:         txHex(unfundedTx(coinType)),
+			Outpoints:     outpoints,
+			ChangeAddress: payAddr,
+		},
+		mockChain:           chainWithUtxo(5e8, coinType),
+		mockCoinTypeFeeCalc: &testCoinTypeFeeCalculator{estimateFeeRate: feeRate},
+		result: &types.FundRawTransactionResult{
+			Hex:       txHex(fundedTx),
+			Fee:       dcrutil.Amount(fee).ToCoin(),
+			CoinType:  uint8(coinType),
+			ChangePos: 1,
+		},
+	}})
+}
+
 func TestHandleGenerate(t *testing.T) {
 	t.Parallel()
 
@@ -3779,6 +4691,7 @@ func TestHandleGetBlockchainInfo(t *testing.T) {
 			Difficulty:           uint32(404696953),
 			DifficultyRatio:      float64(35256672611.3862),
 			MaxBlockSize:         int64(393216),
+			SKAConfigHash:        defaultChainParams.SKAConfigHash().String(),
 			Deployments: map[string]types.AgendaInfo{
 				"headercommitments": {
 					Status:     "started",
@@ -3827,6 +4740,7 @@ func TestHandleGetBlockchainInfo(t *testing.T) {
 			Difficulty:           uint32(453115903),
 			DifficultyRatio:      float64(32767.74999809),
 			MaxBlockSize:         int64(393216),
+			SKAConfigHash:        defaultChainParams.SKAConfigHash().String(),
 			Deployments: map[string]types.AgendaInfo{
 				"headercommitments": {
 					Status:     "defined",
@@ -4320,6 +5234,357 @@ func TestHandleGetBlockHeader(t *testing.T) {
 	}})
 }
 
+func TestHandleGetBlockSpacePolicy(t *testing.T) {
+	t.Parallel()
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:                 "handleGetBlockSpacePolicy: node is not configured for mining",
+		handler:              handleGetBlockSpacePolicy,
+		cmd:                  &types.GetBlockSpacePolicyCmd{},
+		setBlockTemplaterNil: true,
+		wantErr:              true,
+		errCode:              dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleGetBlockSpacePolicy: default policy",
+		handler: handleGetBlockSpacePolicy,
+		cmd:     &types.GetBlockSpacePolicyCmd{},
+		result: &types.GetBlockSpacePolicyResult{
+			OverflowStrategy: "proportional",
+			CoinTypeCaps:     []types.BlockSpaceCoinTypeCap{},
+		},
+	}, {
+		name:    "handleGetBlockSpacePolicy: caps ordered by coin type",
+		handler: handleGetBlockSpacePolicy,
+		cmd:     &types.GetBlockSpacePolicyCmd{},
+		mockBlockTemplater: func() *testBlockTemplater {
+			templater := defaultMockBlockTemplater()
+			templater.spacePolicy = mining.BlockSpacePolicy{
+				OverflowStrategy:  mining.OverflowNone,
+				StakeReserveBytes: 20000,
+				MaxCoinTypeBytes: map[cointype.CoinType]uint32{
+					2: 30000,
+					1: 50000,
+				},
+			}
+			return templater
+		}(),
+		result: &types.GetBlockSpacePolicyResult{
+			OverflowStrategy:  "none",
+			StakeReserveBytes: 20000,
+			CoinTypeCaps: []types.BlockSpaceCoinTypeCap{
+				{CoinType: 1, Name: "SKA-1", MaxBytes: 50000},
+				{CoinType: 2, Name: "SKA-2", MaxBytes: 30000},
+			},
+		},
+	}})
+}
+
+func TestHandleGetBlockStats(t *testing.T) {
+	t.Parallel()
+
+	blkHash := block432100.BlockHash().String()
+	blockStats := &blockchain.BlockStats{
+		Height: 432100,
+		Stats: []blockchain.CoinTypeBlockStats{{
+			CoinType:       cointype.CoinTypeVAR,
+			NumTxns:        10,
+			TotalBytes:     5000,
+			AllocatedBytes: 20000,
+			MinFeeRate:     10000,
+			MedianFeeRate:  15000,
+			MaxFeeRate:     40000,
+		}, {
+			CoinType:       cointype.CoinType(1),
+			NumTxns:        2,
+			TotalBytes:     600,
+			AllocatedBytes: 0,
+		}},
+	}
+	wantCoinTypes := []types.BlockStatsCoinType{{
+		CoinType:       0,
+		Name:           "VAR",
+		NumTxns:        10,
+		TotalBytes:     5000,
+		AllocatedBytes: 20000,
+		Utilization:    0.25,
+		MinFeeRate:     0.0001,
+		MedianFeeRate:  0.00015,
+		MaxFeeRate:     0.0004,
+	}, {
+		CoinType:   1,
+		Name:       "SKA-1",
+		NumTxns:    2,
+		TotalBytes: 600,
+	}}
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetBlockStats: ok",
+		handler: handleGetBlockStats,
+		cmd: &types.GetBlockStatsCmd{
+			Hash: blkHash,
+		},
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.blockFeeTotals = &blockchain.BlockFeeTotals{
+				Height:      432100,
+				StartHeight: 1,
+				Totals: []blockchain.CoinTypeFeeTotal{{
+					CoinType:       cointype.CoinTypeVAR,
+					Fees:           25000,
+					CumulativeFees: 5000000000,
+				}, {
+					CoinType:       cointype.CoinType(1),
+					Fees:           0,
+					CumulativeFees: 100000000,
+				}},
+			}
+			chain.blockStats = blockStats
+			return chain
+		}(),
+		result: &types.GetBlockStatsResult{
+			Hash:            blkHash,
+			Height:          432100,
+			CumulativeStart: 1,
+			Fees: []types.BlockStatsCoinTypeFees{{
+				CoinType:       0,
+				Name:           "VAR",
+				Fees:           0.00025,
+				CumulativeFees: 50,
+			}, {
+				CoinType:       1,
+				Name:           "SKA-1",
+				Fees:           0,
+				CumulativeFees: 1,
+			}},
+			CoinTypes: wantCoinTypes,
+		},
+	}, {
+		name:    "handleGetBlockStats: ok without fee totals",
+		handler: handleGetBlockStats,
+		cmd: &types.GetBlockStatsCmd{
+			Hash: blkHash,
+		},
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.blockStats = blockStats
+			return chain
+		}(),
+		result: &types.GetBlockStatsResult{
+			Hash:      blkHash,
+			Height:    432100,
+			Fees:      []types.BlockStatsCoinTypeFees{},
+			CoinTypes: wantCoinTypes,
+		},
+	}, {
+		name:    "handleGetBlockStats: invalid hash",
+		handler: handleGetBlockStats,
+		cmd: &types.GetBlockStatsCmd{
+			Hash: "invalid",
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:    "handleGetBlockStats: not in main chain",
+		handler: handleGetBlockStats,
+		cmd: &types.GetBlockStatsCmd{
+			Hash: blkHash,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCBlockNotFound,
+	}, {
+		name:    "handleGetBlockStats: fetch stats error",
+		handler: handleGetBlockStats,
+		cmd: &types.GetBlockStatsCmd{
+			Hash: blkHash,
+		},
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.fetchBlockStatsErr = errors.New("db error")
+			return chain
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleGetBlockStats: fetch fee totals error",
+		handler: handleGetBlockStats,
+		cmd: &types.GetBlockStatsCmd{
+			Hash: blkHash,
+		},
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.blockStats = blockStats
+			chain.fetchBlockFeeTotalsErr = errors.New("db error")
+			return chain
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+func TestHandleGetBlockSpends(t *testing.T) {
+	t.Parallel()
+
+	const payAddr = "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8"
+	addr, err := stdaddr.DecodeAddress(payAddr, defaultChainParams)
+	if err != nil {
+		t.Fatalf("unexpected address decode error: %v", err)
+	}
+	scriptVer, script := addr.PaymentScript()
+	blkHash := block432100.BlockHash()
+	txHash1 := mustParseHash("e02f03a25a57afdd402818fe5b13985a0731502ad8a8c93d1874900e84d3330d")
+	txHash2 := mustParseHash("fe7b32aa188800f07268b17f3bead5f3d8a1b6d18654182066436efce6effa86")
+	spendingTx := mustParseHash("4f1a5e8ec4a1e51cd5fb6b1ab3d72c8c1a4e5bd2ab5ea4ae8ba8ee8fd50e9d05")
+	blockSpends := &blockchain.BlockSpends{
+		Hash:        blkHash,
+		Height:      432100,
+		Disapproved: true,
+		Outputs: []blockchain.SpentOutput{{
+			OutPoint:      wire.OutPoint{Hash: *txHash1, Index: 0},
+			SpendingTx:    *spendingTx,
+			SpendingInput: 0,
+			BlockHeight:   432000,
+			Amount:        1e8,
+			CoinType:      cointype.CoinTypeVAR,
+			ScriptVersion: scriptVer,
+			PkScript:      script,
+		}, {
+			OutPoint:      wire.OutPoint{Hash: *txHash2, Index: 1},
+			SpendingTx:    *spendingTx,
+			SpendingInput: 1,
+			BlockHeight:   431100,
+			Amount:        2e8,
+			CoinType:      1,
+			ScriptVersion: scriptVer,
+			PkScript:      script,
+			IsCoinBase:    true,
+		}, {
+			OutPoint:      wire.OutPoint{Hash: *txHash1, Index: 2},
+			SpendingTx:    *spendingTx,
+			SpendingInput: 2,
+			BlockHeight:   432090,
+			Amount:        3e8,
+			CoinType:      cointype.CoinTypeVAR,
+			PkScript:      []byte{0x51},
+		}},
+	}
+	chainWithSpends := func() *testRPCChain {
+		chain := defaultMockRPCChain()
+		chain.blockSpends = blockSpends
+		return chain
+	}
+	varSpends := types.BlockSpendsCoinType{
+		CoinType:  0,
+		Name:      "VAR",
+		Count:     2,
+		Amount:    4e8,
+		Addresses: 1,
+		AvgAge:    32.5,
+	}
+	skaSpends := types.BlockSpendsCoinType{
+		CoinType:  1,
+		Name:      "SKA-1",
+		Count:     1,
+		Amount:    2e8,
+		Addresses: 1,
+		AvgAge:    1000,
+	}
+	skaCoinType := uint8(1)
+	verboseSKASpends := skaSpends
+	verboseSKASpends.Outputs = []types.BlockSpentOutput{{
+		TxHash:       txHash2.String(),
+		Vout:         1,
+		SpendingTx:   spendingTx.String(),
+		Vin:          1,
+		Height:       431100,
+		Amount:       2e8,
+		Version:      scriptVer,
+		ScriptPubKey: hex.EncodeToString(script),
+		Address:      payAddr,
+		CoinBase:     true,
+	}}
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetBlockSpends: ok",
+		handler: handleGetBlockSpends,
+		cmd: &types.GetBlockSpendsCmd{
+			Hash: blkHash.String(),
+		},
+		mockChain: chainWithSpends(),
+		result: &types.GetBlockSpendsResult{
+			Hash:        blkHash.String(),
+			Height:      432100,
+			Disapproved: true,
+			CoinTypes:   []types.BlockSpendsCoinType{varSpends, skaSpends},
+		},
+	}, {
+		name:    "handleGetBlockSpends: verbose coin type",
+		handler: handleGetBlockSpends,
+		cmd: &types.GetBlockSpendsCmd{
+			Hash:     blkHash.String(),
+			CoinType: &skaCoinType,
+			Verbose:  dcrjson.Bool(true),
+		},
+		mockChain: chainWithSpends(),
+		result: &types.GetBlockSpendsResult{
+			Hash:        blkHash.String(),
+			Height:      432100,
+			Disapproved: true,
+			CoinTypes:   []types.BlockSpendsCoinType{verboseSKASpends},
+		},
+	}, {
+		name:    "handleGetBlockSpends: no spends",
+		handler: handleGetBlockSpends,
+		cmd: &types.GetBlockSpendsCmd{
+			Hash: blkHash.String(),
+		},
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.blockSpends = &blockchain.BlockSpends{
+				Hash:   blkHash,
+				Height: 432100,
+			}
+			return chain
+		}(),
+		result: &types.GetBlockSpendsResult{
+			Hash:      blkHash.String(),
+			Height:    432100,
+			CoinTypes: []types.BlockSpendsCoinType{},
+		},
+	}, {
+		name:    "handleGetBlockSpends: invalid hash",
+		handler: handleGetBlockSpends,
+		cmd: &types.GetBlockSpendsCmd{
+			Hash: "invalid",
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:    "handleGetBlockSpends: not in main chain",
+		handler: handleGetBlockSpends,
+		cmd: &types.GetBlockSpendsCmd{
+			Hash: blkHash.String(),
+		},
+		mockChain: func() *testRPCChain {
+			chain := chainWithSpends()
+			chain.mainChainHasBlock = false
+			return chain
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCBlockNotFound,
+	}, {
+		name:    "handleGetBlockSpends: fetch spends error",
+		handler: handleGetBlockSpends,
+		cmd: &types.GetBlockSpendsCmd{
+			Hash: blkHash.String(),
+		},
+		mockChain: func() *testRPCChain {
+			chain := chainWithSpends()
+			chain.fetchBlockSpendsErr = errors.New("db error")
+			return chain
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
 func TestHandleGetBlockSubsidy(t *testing.T) {
 	t.Parallel()
 
@@ -4456,6 +5721,113 @@ func TestHandleGetCoinSupply(t *testing.T) {
 	}})
 }
 
+func TestHandleGetCoinTypes(t *testing.T) {
+	t.Parallel()
+
+	params := cloneParams(defaultChainParams)
+	params.SKAMinRelayTxFee = 1000
+	params.SKACoins = map[cointype.CoinType]*chaincfg.SKACoinConfig{
+		2: {
+			CoinType:       2,
+			Name:           "Skarb-2",
+			Symbol:         "SKA-2",
+			MaxSupply:      5e14,
+			EmissionHeight: 200,
+			EmissionWindow: 10,
+		},
+		1: {
+			CoinType:       1,
+			Name:           "Skarb-1",
+			Symbol:         "SKA-1",
+			MaxSupply:      1e15,
+			EmissionHeight: 100,
+			EmissionWindow: 100,
+			Active:         true,
+		},
+	}
+	chainAtHeight := func(height int64, tranches uint32) *testRPCChain {
+		chain := defaultMockRPCChain()
+		chain.bestSnapshot = &blockchain.BestState{Height: height}
+		chain.skaEmissionTranches = tranches
+		return chain
+	}
+	varResult := types.GetCoinTypesResult{
+		CoinType:       0,
+		Name:           "VAR",
+		Symbol:         "VAR",
+		Active:         true,
+		MaxSupply:      cointype.MaxVARAtoms,
+		EmissionStatus: "subsidy",
+		MinRelayFee:    0.0001,
+	}
+	skaResults := func(status1, status2 string) []types.GetCoinTypesResult {
+		return []types.GetCoinTypesResult{{
+			CoinType:       1,
+			Name:           "Skarb-1",
+			Symbol:         "SKA-1",
+			Active:         true,
+			MaxSupply:      1e15,
+			EmissionHeight: 100,
+			EmissionWindow: 100,
+			EmissionStatus: status1,
+			MinRelayFee:    0.00001,
+		}, {
+			CoinType:       2,
+			Name:           "Skarb-2",
+			Symbol:         "SKA-2",
+			MaxSupply:      5e14,
+			EmissionHeight: 200,
+			EmissionWindow: 10,
+			EmissionStatus: status2,
+			MinRelayFee:    0.00001,
+		}}
+	}
+	dynamicVARResult := varResult
+	dynamicVARResult.MinRelayFee = 0.0002
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:            "handleGetCoinTypes: active and pending",
+		handler:         handleGetCoinTypes,
+		cmd:             &types.GetCoinTypesCmd{},
+		mockChainParams: params,
+		mockChain:       chainAtHeight(150, 0),
+		result: append([]types.GetCoinTypesResult{varResult},
+			skaResults("active", "pending")...),
+	}, {
+		name:            "handleGetCoinTypes: expired",
+		handler:         handleGetCoinTypes,
+		cmd:             &types.GetCoinTypesCmd{},
+		mockChainParams: params,
+		mockChain:       chainAtHeight(250, 0),
+		result: append([]types.GetCoinTypesResult{varResult},
+			skaResults("expired", "expired")...),
+	}, {
+		name:            "handleGetCoinTypes: complete",
+		handler:         handleGetCoinTypes,
+		cmd:             &types.GetCoinTypesCmd{},
+		mockChainParams: params,
+		mockChain:       chainAtHeight(250, 1),
+		result: append([]types.GetCoinTypesResult{varResult},
+			skaResults("complete", "complete")...),
+	}, {
+		name:            "handleGetCoinTypes: dynamic min relay fee",
+		handler:         handleGetCoinTypes,
+		cmd:             &types.GetCoinTypesCmd{},
+		mockChainParams: params,
+		mockChain:       chainAtHeight(150, 0),
+		mockCoinTypeFeeCalc: &testCoinTypeFeeCalculator{
+			feeStats: map[cointype.CoinType]*CoinTypeFeeStats{
+				cointype.CoinTypeVAR: {
+					MinRelayFee:          10000,
+					DynamicFeeMultiplier: 2,
+				},
+			},
+		},
+		result: append([]types.GetCoinTypesResult{dynamicVARResult},
+			skaResults("active", "pending")...),
+	}})
+}
+
 func TestHandleGetConnectionCount(t *testing.T) {
 	t.Parallel()
 
@@ -4494,6 +5866,38 @@ func TestHandleGetDifficulty(t *testing.T) {
 	}})
 }
 
+func TestHandleGetEmissionKeys(t *testing.T) {
+	t.Parallel()
+
+	privKey := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{0x01}, 32))
+	placeholderKey := chaincfg.SimNetParams().GetSKAEmissionKey(1)
+	params := cloneParams(defaultChainParams)
+	params.SKACoins = map[cointype.CoinType]*chaincfg.SKACoinConfig{
+		3: {CoinType: 3, Name: "Skarb-3"},
+		2: {CoinType: 2, Name: "Skarb-2", EmissionKey: privKey.PubKey()},
+		1: {CoinType: 1, Name: "Skarb-1", EmissionKey: placeholderKey},
+	}
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:            "handleGetEmissionKeys: ok",
+		handler:         handleGetEmissionKeys,
+		cmd:             &types.GetEmissionKeysCmd{},
+		mockChainParams: params,
+		result: []types.GetEmissionKeysResult{{
+			CoinType:    1,
+			Name:        "Skarb-1",
+			PubKey:      "02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9",
+			Fingerprint: "01557763",
+			Placeholder: true,
+		}, {
+			CoinType:    2,
+			Name:        "Skarb-2",
+			PubKey:      "031b84c5567b126440995d3ed5aaba0565d71e1834604819ff9c17f5e9d5dd078f",
+			Fingerprint: "bac02fa8",
+		}},
+	}})
+}
+
 func TestHandleGetGenerate(t *testing.T) {
 	t.Parallel()
 
@@ -4610,6 +6014,12 @@ func TestHandleGetMempoolInfo(t *testing.T) {
 		},
 	}
 
+	// The validation lanes are idle unless the sync manager reports metrics.
+	idleLanes := map[string]types.TxValidationLaneInfo{
+		"stake":   {},
+		"regular": {},
+	}
+
 	testRPCServerHandler(t, []rpcTest{{
 		name:    "handleGetMempoolInfo: ok",
 		handler: handleGetMempoolInfo,
@@ -4620,74 +6030,309 @@ func TestHandleGetMempoolInfo(t *testing.T) {
 		}(),
 		cmd: &types.GetMempoolInfoCmd{},
 		result: &types.GetMempoolInfoResult{
-			Size:  2,
-			Bytes: 633,
-		},
-	}})
-}
-
-func TestHandleGetMiningInfo(t *testing.T) {
-	t.Parallel()
-
-	testRPCServerHandler(t, []rpcTest{{
-		name:    "handleGetMiningInfo: ok",
-		handler: handleGetMiningInfo,
-		result: &types.GetMiningInfoResult{
-			Blocks:           432100,
-			CurrentBlockSize: 2782,
-			CurrentBlockTx:   7,
-			Difficulty:       2.8147398026656624e+10,
-			StakeDifficulty:  14428162590,
+			Size:            2,
+			Bytes:           633,
+			ValidationLanes: idleLanes,
+			CoinTypes: map[string]types.MempoolCoinTypeInfo{
+				"VAR": {
+					CoinType:  0,
+					Name:      "VAR",
+					Size:      2,
+					Bytes:     633,
+					TotalFees: dcrutil.Amount(300001).ToCoin(),
+				},
+			},
 		},
 	}, {
-		name:    "handleGetMiningInfo: invalid network hashes per sec",
-		handler: handleGetMiningInfo,
-		mockChain: func() *testRPCChain {
-			chain := defaultMockRPCChain()
-			chain.headerByHashErr = errors.New("")
-			return chain
+		name:    "handleGetMempoolInfo: ok with coin type fees",
+		handler: handleGetMempoolInfo,
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.txDescs = []*mempool.TxDesc{txDescOne, txDescTwo}
+			return mp
 		}(),
-		wantErr: true,
-		errCode: dcrjson.ErrRPCInternal.Code,
-	}})
-}
-
-func TestHandleGetNetTotals(t *testing.T) {
-	t.Parallel()
-
-	testRPCServerHandler(t, []rpcTest{{
-		name:    "handleGetNetTotals: ok",
-		handler: handleGetNetTotals,
-		cmd:     &types.GetNetTotalsCmd{},
-		mockClock: &testClock{
-			now: time.Unix(1592931302, 0),
+		mockCoinTypeFeeCalc: &testCoinTypeFeeCalculator{
+			feeStats: map[cointype.CoinType]*CoinTypeFeeStats{
+				cointype.CoinTypeVAR: {
+					CoinType:             cointype.CoinTypeVAR,
+					MinRelayFee:          10000,
+					DynamicFeeMultiplier: 1.5,
+					MaxFeeRate:           100000,
+				},
+			},
 		},
-		result: &types.GetNetTotalsResult{
-			TotalBytesRecv: uint64(9598159),
-			TotalBytesSent: uint64(4783802),
-			TimeMillis:     int64(1592931302000),
+		cmd: &types.GetMempoolInfoCmd{},
+		result: &types.GetMempoolInfoResult{
+			Size:            2,
+			Bytes:           633,
+			ValidationLanes: idleLanes,
+			CoinTypes: map[string]types.MempoolCoinTypeInfo{
+				"VAR": {
+					CoinType:  0,
+					Name:      "VAR",
+					Size:      2,
+					Bytes:     633,
+					TotalFees: dcrutil.Amount(300001).ToCoin(),
+					MinFee:    dcrutil.Amount(15000).ToCoin(),
+				},
+			},
 		},
-	}})
-}
-
-func TestHandleGetNetworkHashPS(t *testing.T) {
-	t.Parallel()
-
-	mc := func() *testRPCChain {
-		chain := defaultMockRPCChain()
-		header := block432100.Header
-		i := 0
-		inverter := 1
-		// Increase or decrease the block header timestamp
-		// every block.
-		fn := func() wire.BlockHeader {
-			t := time.Minute * time.Duration(i) * time.Duration(inverter)
-			header.Timestamp = header.Timestamp.Add(t)
-			i++
-			inverter *= -1
-			return header
-		}
-		chain.headerByHashFn = fn
+	}, {
+		name:    "handleGetMempoolInfo: ok with expiry",
+		handler: handleGetMempoolInfo,
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.txDescs = []*mempool.TxDesc{txDescOne, txDescTwo}
+			mp.maxTxAge = map[cointype.CoinType]time.Duration{
+				cointype.CoinTypeVAR: 24 * time.Hour,
+				cointype.CoinType(1): 2 * time.Hour,
+			}
+			mp.expiredTxCounts = map[cointype.CoinType]uint64{
+				cointype.CoinTypeVAR: 2,
+				cointype.CoinType(1): 7,
+			}
+			return mp
+		}(),
+		cmd: &types.GetMempoolInfoCmd{},
+		result: &types.GetMempoolInfoResult{
+			Size:            2,
+			Bytes:           633,
+			ValidationLanes: idleLanes,
+			CoinTypes: map[string]types.MempoolCoinTypeInfo{
+				"VAR": {
+					CoinType:  0,
+					Name:      "VAR",
+					Size:      2,
+					Bytes:     633,
+					TotalFees: dcrutil.Amount(300001).ToCoin(),
+					Expiry:    86400,
+					Expired:   2,
+				},
+				"SKA-1": {
+					CoinType: 1,
+					Name:     "SKA-1",
+					Expiry:   7200,
+					Expired:  7,
+				},
+			},
+		},
+	}, {
+		name:    "handleGetMempoolInfo: ok with rolling minimum fee",
+		handler: handleGetMempoolInfo,
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.txDescs = []*mempool.TxDesc{txDescOne, txDescTwo}
+			mp.maxPoolSize = map[cointype.CoinType]int64{
+				cointype.CoinTypeVAR: 300000000,
+				cointype.CoinType(1): 50000000,
+			}
+			mp.mempoolMinFees = map[cointype.CoinType]int64{
+				cointype.CoinType(1): 25000,
+			}
+			return mp
+		}(),
+		cmd: &types.GetMempoolInfoCmd{},
+		result: &types.GetMempoolInfoResult{
+			Size:            2,
+			Bytes:           633,
+			ValidationLanes: idleLanes,
+			CoinTypes: map[string]types.MempoolCoinTypeInfo{
+				"VAR": {
+					CoinType:  0,
+					Name:      "VAR",
+					Size:      2,
+					Bytes:     633,
+					TotalFees: dcrutil.Amount(300001).ToCoin(),
+					MaxBytes:  300000000,
+				},
+				"SKA-1": {
+					CoinType:      1,
+					Name:          "SKA-1",
+					MempoolMinFee: dcrutil.Amount(25000).ToCoin(),
+					MaxBytes:      50000000,
+				},
+			},
+		},
+	}, {
+		name:    "handleGetMempoolInfo: ok with chain limits",
+		handler: handleGetMempoolInfo,
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.txDescs = []*mempool.TxDesc{txDescOne, txDescTwo}
+			mp.chainLimits = map[cointype.CoinType]mempool.ChainLimits{
+				cointype.CoinTypeVAR: {
+					MaxAncestors:      25,
+					MaxAncestorSize:   101000,
+					MaxDescendants:    25,
+					MaxDescendantSize: 101000,
+				},
+			}
+			return mp
+		}(),
+		cmd: &types.GetMempoolInfoCmd{},
+		result: &types.GetMempoolInfoResult{
+			Size:            2,
+			Bytes:           633,
+			ValidationLanes: idleLanes,
+			CoinTypes: map[string]types.MempoolCoinTypeInfo{
+				"VAR": {
+					CoinType:          0,
+					Name:              "VAR",
+					Size:              2,
+					Bytes:             633,
+					TotalFees:         dcrutil.Amount(300001).ToCoin(),
+					MaxAncestors:      25,
+					MaxAncestorSize:   101000,
+					MaxDescendants:    25,
+					MaxDescendantSize: 101000,
+				},
+			},
+		},
+	}, {
+		name:    "handleGetMempoolInfo: ok empty",
+		handler: handleGetMempoolInfo,
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.txDescs = nil
+			return mp
+		}(),
+		cmd: &types.GetMempoolInfoCmd{},
+		result: &types.GetMempoolInfoResult{
+			CoinTypes:       map[string]types.MempoolCoinTypeInfo{},
+			ValidationLanes: idleLanes,
+		},
+	}, {
+		name:    "handleGetMempoolInfo: ok with validation lanes",
+		handler: handleGetMempoolInfo,
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.txDescs = nil
+			return mp
+		}(),
+		mockSyncManager: func() *testSyncManager {
+			syncManager := defaultMockSyncManager()
+			syncManager.stakeLaneStats = netsync.TxLaneStats{
+				Processed: 4,
+				TotalWait: 2 * time.Millisecond,
+				MaxWait:   time.Millisecond,
+			}
+			syncManager.regularLaneStats = netsync.TxLaneStats{
+				Processed: 10,
+				Pending:   3,
+				TotalWait: 5 * time.Second,
+				MaxWait:   2 * time.Second,
+			}
+			return syncManager
+		}(),
+		cmd: &types.GetMempoolInfoCmd{},
+		result: &types.GetMempoolInfoResult{
+			CoinTypes: map[string]types.MempoolCoinTypeInfo{},
+			ValidationLanes: map[string]types.TxValidationLaneInfo{
+				"stake": {
+					Processed: 4,
+					AvgWait:   0.0005,
+					MaxWait:   0.001,
+				},
+				"regular": {
+					Processed: 10,
+					Pending:   3,
+					AvgWait:   0.5,
+					MaxWait:   2,
+				},
+			},
+		},
+	}})
+}
+
+func TestHandleGetMiningInfo(t *testing.T) {
+	t.Parallel()
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetMiningInfo: ok",
+		handler: handleGetMiningInfo,
+		result: &types.GetMiningInfoResult{
+			Blocks:           432100,
+			CurrentBlockSize: 2782,
+			CurrentBlockTx:   7,
+			Difficulty:       2.8147398026656624e+10,
+			StakeDifficulty:  14428162590,
+			TemplateBudget:   &types.TemplateBudgetResult{},
+		},
+	}, {
+		name:    "handleGetMiningInfo: partial templates",
+		handler: handleGetMiningInfo,
+		mockBlockTemplater: func() *testBlockTemplater {
+			templater := defaultMockBlockTemplater()
+			templater.budgetStats = mining.TemplateBudgetStats{
+				Templates:        10,
+				PartialTemplates: 2,
+				SkippedTxns:      150,
+				SkippedAugments:  1,
+			}
+			return templater
+		}(),
+		result: &types.GetMiningInfoResult{
+			Blocks:           432100,
+			CurrentBlockSize: 2782,
+			CurrentBlockTx:   7,
+			Difficulty:       2.8147398026656624e+10,
+			StakeDifficulty:  14428162590,
+			TemplateBudget: &types.TemplateBudgetResult{
+				Templates:        10,
+				PartialTemplates: 2,
+				SkippedTxns:      150,
+				SkippedAugments:  1,
+			},
+		},
+	}, {
+		name:    "handleGetMiningInfo: invalid network hashes per sec",
+		handler: handleGetMiningInfo,
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.headerByHashErr = errors.New("")
+			return chain
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+func TestHandleGetNetTotals(t *testing.T) {
+	t.Parallel()
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetNetTotals: ok",
+		handler: handleGetNetTotals,
+		cmd:     &types.GetNetTotalsCmd{},
+		mockClock: &testClock{
+			now: time.Unix(1592931302, 0),
+		},
+		result: &types.GetNetTotalsResult{
+			TotalBytesRecv: uint64(9598159),
+			TotalBytesSent: uint64(4783802),
+			TimeMillis:     int64(1592931302000),
+		},
+	}})
+}
+
+func TestHandleGetNetworkHashPS(t *testing.T) {
+	t.Parallel()
+
+	mc := func() *testRPCChain {
+		chain := defaultMockRPCChain()
+		header := block432100.Header
+		i := 0
+		inverter := 1
+		// Increase or decrease the block header timestamp
+		// every block.
+		fn := func() wire.BlockHeader {
+			t := time.Minute * time.Duration(i) * time.Duration(inverter)
+			header.Timestamp = header.Timestamp.Add(t)
+			i++
+			inverter *= -1
+			return header
+		}
+		chain.headerByHashFn = fn
 		return chain
 	}
 	networkHashPSResult := int64(2014899978133500709)
@@ -4817,6 +6462,18 @@ func TestHandleGetPeerInfo(t *testing.T) {
 					id:                int32(5),
 					addr:              "106.14.238.184:19108",
 					lastPingNonce:     uint64(10),
+					txRelayStats: map[cointype.CoinType]PeerTxRelayStats{
+						cointype.CoinType(1): {
+							TxRecv:      uint64(120),
+							TxBytesRecv: uint64(36000),
+						},
+						cointype.CoinTypeVAR: {
+							TxSent:      uint64(3),
+							TxBytesSent: uint64(900),
+							TxRecv:      uint64(2),
+							TxBytesRecv: uint64(600),
+						},
+					},
 					statsSnapshot: &peer.StatsSnap{
 						ID:             int32(5),
 						Addr:           "106.14.238.184:19108",
@@ -4864,6 +6521,17 @@ func TestHandleGetPeerInfo(t *testing.T) {
 			CurrentHeight:  int64(323327),
 			BanScore:       int32(0),
 			SyncNode:       false,
+			TxRelayStats: []types.PeerTxRelayStats{{
+				CoinType:    uint8(0),
+				TxSent:      uint64(3),
+				TxBytesSent: uint64(900),
+				TxRecv:      uint64(2),
+				TxBytesRecv: uint64(600),
+			}, {
+				CoinType:    uint8(1),
+				TxRecv:      uint64(120),
+				TxBytesRecv: uint64(36000),
+			}},
 		}},
 	}})
 }
@@ -5205,11 +6873,23 @@ func TestHandleGetTxOut(t *testing.T) {
 			Type:      scriptType.String(),
 			Addresses: addresses,
 		},
-		Coinbase: false,
+		Coinbase:       false,
+		CoinType:       uint8(cointype.CoinTypeVAR),
+		CoinTypeActive: true,
 	}
 	txOutResultChain := txOutResultMempool
 	txOutResultChain.Confirmations = 1
 
+	// SKA outputs additionally report the fraction of the max supply of the
+	// coin type they represent.
+	skaCoinType := cointype.CoinType(1)
+	skaConfig := defaultChainParams.SKACoins[skaCoinType]
+	skaFraction := float64(txOut.Value) / float64(skaConfig.MaxSupply)
+	txOutResultSKA := txOutResultChain
+	txOutResultSKA.CoinType = uint8(skaCoinType)
+	txOutResultSKA.CoinTypeActive = skaConfig.Active
+	txOutResultSKA.SupplyFraction = &skaFraction
+
 	// Setup a mock mempooler that has the test tx.
 	mempoolerWithTx := func() *testTxMempooler {
 		mp := defaultMockTxMempooler()
@@ -5246,6 +6926,17 @@ func TestHandleGetTxOut(t *testing.T) {
 		cmd:       &cmd,
 		mockChain: chainWithTx(),
 		result:    &txOutResultChain,
+	}, {
+		name:    "handleGetTxOut: ok SKA output from chain",
+		handler: handleGetTxOut,
+		cmd:     &cmd,
+		mockChain: func() *testRPCChain {
+			chain := chainWithTx()
+			entry := chain.fetchUtxoEntry.(*testRPCUtxoEntry)
+			entry.coinType = skaCoinType
+			return chain
+		}(),
+		result: &txOutResultSKA,
 	}, {
 		name:    "handleGetTxOut: ok transaction not found",
 		handler: handleGetTxOut,
@@ -5449,6 +7140,1141 @@ func TestHandleLiveTickets(t *testing.T) {
 	}})
 }
 
+// watchOnlyTestFixtures returns the mocked unspent outputs and watched addresses
+// used by the watch-only RPC tests.
+func watchOnlyTestFixtures() ([]indexers.WatchOnlyUtxo, []indexers.WatchedAddress) {
+	const addrA = "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8"
+	const addrB = "McSmCFDZ8MykpEWiCGoUUpBVM6DehASf49c"
+	utxos := []indexers.WatchOnlyUtxo{{
+		OutPoint:    wire.OutPoint{Hash: chainhash.Hash{0x01}, Index: 0},
+		Address:     addrA,
+		CoinType:    cointype.CoinTypeVAR,
+		Amount:      1e8,
+		PkScript:    []byte{0x76},
+		BlockHeight: 432100,
+	}, {
+		OutPoint:    wire.OutPoint{Hash: chainhash.Hash{0x02}, Index: 1},
+		Address:     addrA,
+		CoinType:    cointype.CoinTypeVAR,
+		Amount:      2e8,
+		PkScript:    []byte{0x76},
+		BlockHeight: 432000,
+		BlockIndex:  3,
+	}, {
+		OutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{0x03},
+			Index: 2,
+			Tree:  wire.TxTreeStake,
+		},
+		Address:     addrB,
+		CoinType:    cointype.CoinType(1),
+		Amount:      5 * cointype.AtomsPerSKA,
+		PkScript:    []byte{0xa9},
+		BlockHeight: 431000,
+	}}
+	watched := []indexers.WatchedAddress{
+		{Address: addrA, CoinType: cointype.CoinTypeVAR},
+		{Address: addrB, CoinType: cointype.CoinType(1)},
+		{Address: addrB, CoinType: cointype.CoinTypeVAR},
+	}
+	return utxos, watched
+}
+
+func TestHandleImportWatchOnlyAddress(t *testing.T) {
+	t.Parallel()
+
+	validAddr := "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8"
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleImportWatchOnlyAddress: ok",
+		handler: handleImportWatchOnlyAddress,
+		cmd: &types.ImportWatchOnlyAddressCmd{
+			Address:    validAddr,
+			CoinType:   1,
+			Rescan:     dcrjson.Bool(true),
+			RescanFrom: dcrjson.Int64(0),
+		},
+		result: nil,
+	}, {
+		name:    "handleImportWatchOnlyAddress: watch-only index not enabled",
+		handler: handleImportWatchOnlyAddress,
+		cmd: &types.ImportWatchOnlyAddressCmd{
+			Address:  validAddr,
+			CoinType: 1,
+		},
+		setWatchOnlyIdxNil: true,
+		wantErr:            true,
+		errCode:            dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleImportWatchOnlyAddress: bad address",
+		handler: handleImportWatchOnlyAddress,
+		cmd: &types.ImportWatchOnlyAddressCmd{
+			Address:  "bad",
+			CoinType: 1,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidAddressOrKey,
+	}, {
+		name:    "handleImportWatchOnlyAddress: unknown coin type",
+		handler: handleImportWatchOnlyAddress,
+		cmd: &types.ImportWatchOnlyAddressCmd{
+			Address:  validAddr,
+			CoinType: 200,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleImportWatchOnlyAddress: negative rescan height",
+		handler: handleImportWatchOnlyAddress,
+		cmd: &types.ImportWatchOnlyAddressCmd{
+			Address:    validAddr,
+			CoinType:   1,
+			RescanFrom: dcrjson.Int64(-1),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleImportWatchOnlyAddress: index is not synced",
+		handler: handleImportWatchOnlyAddress,
+		cmd: &types.ImportWatchOnlyAddressCmd{
+			Address:  validAddr,
+			CoinType: 1,
+		},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := defaultMockWatchOnlyIndexer()
+			idx.tipHeight -= 6
+			return idx
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleImportWatchOnlyAddress: unsupported address type",
+		handler: handleImportWatchOnlyAddress,
+		cmd: &types.ImportWatchOnlyAddressCmd{
+			Address:  validAddr,
+			CoinType: 1,
+		},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := defaultMockWatchOnlyIndexer()
+			idx.importErr = indexers.ErrUnsupportedAddressType
+			return idx
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleImportWatchOnlyAddress: import error",
+		handler: handleImportWatchOnlyAddress,
+		cmd: &types.ImportWatchOnlyAddressCmd{
+			Address:  validAddr,
+			CoinType: 1,
+		},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := defaultMockWatchOnlyIndexer()
+			idx.importErr = errors.New("rescan failed")
+			return idx
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+func TestHandleRemoveWatchOnlyAddress(t *testing.T) {
+	t.Parallel()
+
+	validAddr := "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8"
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleRemoveWatchOnlyAddress: ok, removed",
+		handler: handleRemoveWatchOnlyAddress,
+		cmd: &types.RemoveWatchOnlyAddressCmd{
+			Address:  validAddr,
+			CoinType: 1,
+		},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := defaultMockWatchOnlyIndexer()
+			idx.removed = true
+			return idx
+		}(),
+		result: true,
+	}, {
+		name:    "handleRemoveWatchOnlyAddress: ok, not watched",
+		handler: handleRemoveWatchOnlyAddress,
+		cmd: &types.RemoveWatchOnlyAddressCmd{
+			Address:  validAddr,
+			CoinType: 1,
+		},
+		result: false,
+	}, {
+		name:    "handleRemoveWatchOnlyAddress: watch-only index not enabled",
+		handler: handleRemoveWatchOnlyAddress,
+		cmd: &types.RemoveWatchOnlyAddressCmd{
+			Address:  validAddr,
+			CoinType: 1,
+		},
+		setWatchOnlyIdxNil: true,
+		wantErr:            true,
+		errCode:            dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleRemoveWatchOnlyAddress: bad address",
+		handler: handleRemoveWatchOnlyAddress,
+		cmd: &types.RemoveWatchOnlyAddressCmd{
+			Address:  "bad",
+			CoinType: 1,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidAddressOrKey,
+	}, {
+		name:    "handleRemoveWatchOnlyAddress: remove error",
+		handler: handleRemoveWatchOnlyAddress,
+		cmd: &types.RemoveWatchOnlyAddressCmd{
+			Address:  validAddr,
+			CoinType: 1,
+		},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := defaultMockWatchOnlyIndexer()
+			idx.removeErr = errors.New("db error")
+			return idx
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+func TestHandleListUnspentWatchOnly(t *testing.T) {
+	t.Parallel()
+
+	utxos, watched := watchOnlyTestFixtures()
+	watchOnlyIndexer := func() *testWatchOnlyIndexer {
+		idx := defaultMockWatchOnlyIndexer()
+		idx.utxos = utxos
+		idx.watched = watched
+		return idx
+	}
+	varCoinType := uint8(0)
+	result := func(utxo *indexers.WatchOnlyUtxo, confirmations int64) types.ListUnspentWatchOnlyResult {
+		return types.ListUnspentWatchOnlyResult{
+			TxID:          utxo.OutPoint.Hash.String(),
+			Vout:          utxo.OutPoint.Index,
+			Tree:          utxo.OutPoint.Tree,
+			Address:       utxo.Address,
+			CoinType:      uint8(utxo.CoinType),
+			Amount:        dcrutil.Amount(utxo.Amount).ToCoinType(utxo.CoinType),
+			ScriptPubKey:  hex.EncodeToString(utxo.PkScript),
+			BlockHeight:   utxo.BlockHeight,
+			Confirmations: confirmations,
+		}
+	}
+	testRPCServerHandler(t, []rpcTest{{
+		name:                 "handleListUnspentWatchOnly: ok",
+		handler:              handleListUnspentWatchOnly,
+		cmd:                  &types.ListUnspentWatchOnlyCmd{},
+		mockWatchOnlyIndexer: watchOnlyIndexer(),
+		result: []types.ListUnspentWatchOnlyResult{
+			result(&utxos[2], 1101),
+			result(&utxos[1], 101),
+			result(&utxos[0], 1),
+		},
+	}, {
+		name:    "handleListUnspentWatchOnly: ok, coin type and confirmations",
+		handler: handleListUnspentWatchOnly,
+		cmd: &types.ListUnspentWatchOnlyCmd{
+			MinConf:  dcrjson.Int64(2),
+			MaxConf:  dcrjson.Int64(1000),
+			CoinType: &varCoinType,
+		},
+		mockWatchOnlyIndexer: watchOnlyIndexer(),
+		result: []types.ListUnspentWatchOnlyResult{
+			result(&utxos[1], 101),
+		},
+	}, {
+		name:    "handleListUnspentWatchOnly: ok, addresses",
+		handler: handleListUnspentWatchOnly,
+		cmd: &types.ListUnspentWatchOnlyCmd{
+			Addresses: &[]string{utxos[2].Address},
+		},
+		mockWatchOnlyIndexer: watchOnlyIndexer(),
+		result: []types.ListUnspentWatchOnlyResult{
+			result(&utxos[2], 1101),
+		},
+	}, {
+		name:    "handleListUnspentWatchOnly: ok, no outputs",
+		handler: handleListUnspentWatchOnly,
+		cmd:     &types.ListUnspentWatchOnlyCmd{},
+		result:  []types.ListUnspentWatchOnlyResult{},
+	}, {
+		name:               "handleListUnspentWatchOnly: watch-only index not enabled",
+		handler:            handleListUnspentWatchOnly,
+		cmd:                &types.ListUnspentWatchOnlyCmd{},
+		setWatchOnlyIdxNil: true,
+		wantErr:            true,
+		errCode:            dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleListUnspentWatchOnly: invalid confirmation range",
+		handler: handleListUnspentWatchOnly,
+		cmd: &types.ListUnspentWatchOnlyCmd{
+			MinConf: dcrjson.Int64(5),
+			MaxConf: dcrjson.Int64(1),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleListUnspentWatchOnly: bad address",
+		handler: handleListUnspentWatchOnly,
+		cmd: &types.ListUnspentWatchOnlyCmd{
+			Addresses: &[]string{"bad"},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidAddressOrKey,
+	}, {
+		name:    "handleListUnspentWatchOnly: unable to fetch unspent outputs",
+		handler: handleListUnspentWatchOnly,
+		cmd:     &types.ListUnspentWatchOnlyCmd{},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := defaultMockWatchOnlyIndexer()
+			idx.utxosErr = errors.New("db error")
+			return idx
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+func TestHandleGetWatchOnlyBalance(t *testing.T) {
+	t.Parallel()
+
+	utxos, watched := watchOnlyTestFixtures()
+	watchOnlyIndexer := func() *testWatchOnlyIndexer {
+		idx := defaultMockWatchOnlyIndexer()
+		idx.utxos = utxos
+		idx.watched = watched
+		return idx
+	}
+	addrA, addrB := utxos[0].Address, utxos[2].Address
+	varCoinType := uint8(0)
+	testRPCServerHandler(t, []rpcTest{{
+		name:                 "handleGetWatchOnlyBalance: ok",
+		handler:              handleGetWatchOnlyBalance,
+		cmd:                  &types.GetWatchOnlyBalanceCmd{},
+		mockWatchOnlyIndexer: watchOnlyIndexer(),
+		result: types.GetWatchOnlyBalanceResult{
+			Height: int64(block432100.Header.Height),
+			Totals: []types.WatchOnlyCoinTypeBalance{
+				{CoinType: 0, Balance: 3, UtxoCount: 2},
+				{CoinType: 1, Balance: 5, UtxoCount: 1},
+			},
+			Addresses: []types.WatchOnlyAddressBalance{
+				{Address: addrB, CoinType: 0},
+				{Address: addrA, CoinType: 0, Balance: 3, UtxoCount: 2},
+				{Address: addrB, CoinType: 1, Balance: 5, UtxoCount: 1},
+			},
+		},
+	}, {
+		name:    "handleGetWatchOnlyBalance: ok, coin type and confirmations",
+		handler: handleGetWatchOnlyBalance,
+		cmd: &types.GetWatchOnlyBalanceCmd{
+			CoinType: &varCoinType,
+			MinConf:  dcrjson.Int64(2),
+		},
+		mockWatchOnlyIndexer: watchOnlyIndexer(),
+		result: types.GetWatchOnlyBalanceResult{
+			Height: int64(block432100.Header.Height),
+			Totals: []types.WatchOnlyCoinTypeBalance{
+				{CoinType: 0, Balance: 2, UtxoCount: 1},
+			},
+			Addresses: []types.WatchOnlyAddressBalance{
+				{Address: addrB, CoinType: 0},
+				{Address: addrA, CoinType: 0, Balance: 2, UtxoCount: 1},
+			},
+		},
+	}, {
+		name:               "handleGetWatchOnlyBalance: watch-only index not enabled",
+		handler:            handleGetWatchOnlyBalance,
+		cmd:                &types.GetWatchOnlyBalanceCmd{},
+		setWatchOnlyIdxNil: true,
+		wantErr:            true,
+		errCode:            dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleGetWatchOnlyBalance: negative minimum confirmations",
+		handler: handleGetWatchOnlyBalance,
+		cmd: &types.GetWatchOnlyBalanceCmd{
+			MinConf: dcrjson.Int64(-1),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetWatchOnlyBalance: unable to fetch watched addresses",
+		handler: handleGetWatchOnlyBalance,
+		cmd:     &types.GetWatchOnlyBalanceCmd{},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := watchOnlyIndexer()
+			idx.watchedErr = errors.New("db error")
+			return idx
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+// TestHandleGetAddressReceived ensures the getaddressreceived handler validates
+// its parameters and sums the outputs received by a watched address.
+func TestHandleGetAddressReceived(t *testing.T) {
+	t.Parallel()
+
+	const addr = "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8"
+	tipHeight := int64(block432100.Header.Height)
+	watchOnlyIndexer := func() *testWatchOnlyIndexer {
+		idx := defaultMockWatchOnlyIndexer()
+		idx.received = []indexers.WatchOnlyReceived{{
+			OutPoint:    wire.OutPoint{Hash: chainhash.Hash{0x01}},
+			Amount:      1e8,
+			BlockHeight: tipHeight,
+		}, {
+			OutPoint:    wire.OutPoint{Hash: chainhash.Hash{0x02}, Index: 1},
+			Amount:      2e8,
+			BlockHeight: tipHeight - 10,
+			SpentHeight: tipHeight - 5,
+		}}
+		return idx
+	}
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetAddressReceived: ok, default confirmations",
+		handler: handleGetAddressReceived,
+		cmd: &types.GetAddressReceivedCmd{
+			Address:  addr,
+			CoinType: 0,
+		},
+		mockWatchOnlyIndexer: watchOnlyIndexer(),
+		result: types.GetAddressReceivedResult{
+			Address:     addr,
+			CoinType:    0,
+			MinConf:     1,
+			Height:      tipHeight,
+			Received:    3,
+			OutputCount: 2,
+		},
+	}, {
+		name:    "handleGetAddressReceived: ok, minimum confirmations",
+		handler: handleGetAddressReceived,
+		cmd: &types.GetAddressReceivedCmd{
+			Address:  addr,
+			CoinType: 0,
+			MinConf:  dcrjson.Int64(2),
+		},
+		mockWatchOnlyIndexer: watchOnlyIndexer(),
+		result: types.GetAddressReceivedResult{
+			Address:     addr,
+			CoinType:    0,
+			MinConf:     2,
+			Height:      tipHeight,
+			Received:    2,
+			OutputCount: 1,
+		},
+	}, {
+		name:    "handleGetAddressReceived: watch-only index not enabled",
+		handler: handleGetAddressReceived,
+		cmd: &types.GetAddressReceivedCmd{
+			Address:  addr,
+			CoinType: 0,
+		},
+		setWatchOnlyIdxNil: true,
+		wantErr:            true,
+		errCode:            dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleGetAddressReceived: bad address",
+		handler: handleGetAddressReceived,
+		cmd: &types.GetAddressReceivedCmd{
+			Address:  "bad",
+			CoinType: 0,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidAddressOrKey,
+	}, {
+		name:    "handleGetAddressReceived: unknown coin type",
+		handler: handleGetAddressReceived,
+		cmd: &types.GetAddressReceivedCmd{
+			Address:  addr,
+			CoinType: 200,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetAddressReceived: negative minimum confirmations",
+		handler: handleGetAddressReceived,
+		cmd: &types.GetAddressReceivedCmd{
+			Address:  addr,
+			CoinType: 0,
+			MinConf:  dcrjson.Int64(-1),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetAddressReceived: address not watched",
+		handler: handleGetAddressReceived,
+		cmd: &types.GetAddressReceivedCmd{
+			Address:  addr,
+			CoinType: 0,
+		},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := defaultMockWatchOnlyIndexer()
+			idx.receivedErr = indexers.ErrAddressNotWatched
+			return idx
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetAddressReceived: unable to fetch outputs",
+		handler: handleGetAddressReceived,
+		cmd: &types.GetAddressReceivedCmd{
+			Address:  addr,
+			CoinType: 0,
+		},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := defaultMockWatchOnlyIndexer()
+			idx.receivedErr = errors.New("db error")
+			return idx
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+// TestHandleGetSKAEmissions ensures the getskaemissions handler validates its
+// parameters and returns the emissions tracked by the emission index.
+func TestHandleGetSKAEmissions(t *testing.T) {
+	t.Parallel()
+
+	blockHash := block432100.Header.BlockHash()
+	emissions := []indexers.EmissionEntry{{
+		CoinType:  1,
+		BlockHash: blockHash,
+		Height:    2,
+		TxHash:    chainhash.Hash{1},
+		Nonce:     1,
+		Outputs: []indexers.EmissionOutput{{
+			Index:    0,
+			Value:    150000000,
+			PkScript: []byte{0x76, 0xa9},
+		}, {
+			Index:    1,
+			Value:    250000000,
+			PkScript: []byte{0x51},
+		}},
+	}}
+	emissionIndexer := func() *testEmissionIndexer {
+		idx := defaultMockEmissionIndexer()
+		idx.emissions = map[cointype.CoinType][]indexers.EmissionEntry{
+			1: emissions,
+		}
+		return idx
+	}
+	indexerWithErr := emissionIndexer()
+	indexerWithErr.emissionsErr = errors.New("fetch failed")
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetSKAEmissions: ok",
+		handler: handleGetSKAEmissions,
+		cmd: &types.GetSKAEmissionsCmd{
+			CoinType: 1,
+		},
+		mockEmissionIndexer: emissionIndexer(),
+		result: types.GetSKAEmissionsResult{
+			CoinType: 1,
+			Emissions: []types.SKAEmissionResult{{
+				BlockHash:     blockHash.String(),
+				Height:        2,
+				TxHash:        chainhash.Hash{1}.String(),
+				Nonce:         1,
+				Confirmations: 432099,
+				Final:         true,
+				Outputs: []types.SKAEmissionOutputResult{{
+					Vout:         0,
+					Amount:       1.5,
+					ScriptPubKey: "76a9",
+				}, {
+					Vout:         1,
+					Amount:       2.5,
+					ScriptPubKey: "51",
+				}},
+			}},
+		},
+	}, {
+		name:    "handleGetSKAEmissions: not emitted",
+		handler: handleGetSKAEmissions,
+		cmd: &types.GetSKAEmissionsCmd{
+			CoinType: 2,
+		},
+		mockEmissionIndexer: emissionIndexer(),
+		result: types.GetSKAEmissionsResult{
+			CoinType:  2,
+			Emissions: []types.SKAEmissionResult{},
+		},
+	}, {
+		name:    "handleGetSKAEmissions: VAR coin type",
+		handler: handleGetSKAEmissions,
+		cmd: &types.GetSKAEmissionsCmd{
+			CoinType: 0,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetSKAEmissions: index disabled",
+		handler: handleGetSKAEmissions,
+		cmd: &types.GetSKAEmissionsCmd{
+			CoinType: 1,
+		},
+		setEmissionIdxNil: true,
+		wantErr:           true,
+		errCode:           dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleGetSKAEmissions: fetch error",
+		handler: handleGetSKAEmissions,
+		cmd: &types.GetSKAEmissionsCmd{
+			CoinType: 1,
+		},
+		mockEmissionIndexer: indexerWithErr,
+		wantErr:             true,
+		errCode:             dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleGetSKAEmissions: checkpoint verified",
+		handler: handleGetSKAEmissions,
+		cmd: &types.GetSKAEmissionsCmd{
+			CoinType: 2,
+		},
+		mockEmissionIndexer: emissionIndexer(),
+		mockEmissionCkpt:    &testEmissionCheckpointer{},
+		result: types.GetSKAEmissionsResult{
+			CoinType:  2,
+			Emissions: []types.SKAEmissionResult{},
+		},
+	}, {
+		name:    "handleGetSKAEmissions: checkpoint not verified",
+		handler: handleGetSKAEmissions,
+		cmd: &types.GetSKAEmissionsCmd{
+			CoinType: 1,
+		},
+		mockEmissionIndexer: emissionIndexer(),
+		mockEmissionCkpt: &testEmissionCheckpointer{
+			verifiedErr: errors.New("checkpoint pending"),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCClientInInitialDownload,
+	}})
+}
+
+// TestHandleGetSKAActivationTimeline ensures the getskaactivationtimeline
+// handler consolidates the configured activation and emission schedule of the
+// SKA coin types with the chain state and the emission index.
+func TestHandleGetSKAActivationTimeline(t *testing.T) {
+	t.Parallel()
+
+	params := cloneParams(defaultChainParams)
+	params.SKACoins = map[cointype.CoinType]*chaincfg.SKACoinConfig{
+		1: {
+			CoinType: 1,
+			Name:     "Skarb-1",
+			Symbol:   "SKA1",
+			Active:   true,
+			EmissionTranches: []chaincfg.SKAEmissionTranche{{
+				EmissionHeight:  100,
+				EmissionWindow:  50,
+				EmissionAmounts: []int64{4e8},
+			}, {
+				EmissionHeight:  800,
+				EmissionAmounts: []int64{1e8},
+			}},
+		},
+		2: {
+			CoinType:         2,
+			Name:             "Skarb-2",
+			Symbol:           "SKA2",
+			Active:           true,
+			ActivationHeight: 1000,
+			EmissionHeight:   1000,
+			EmissionWindow:   10,
+			EmissionAmounts:  []int64{2e8},
+		},
+	}
+	blkHash := mustParseHash("00000000000000001e6ec1501c858506de1de4703d1be8bab4061126e8f61480")
+	chain := defaultMockRPCChain()
+	chain.bestSnapshot = &blockchain.BestState{Height: 500, Hash: *blkHash}
+	chain.skaEmissionOccurred = true
+	chain.skaEmissionTranches = 1
+	emissionIndexer := func() *testEmissionIndexer {
+		idx := defaultMockEmissionIndexer()
+		idx.emissions = map[cointype.CoinType][]indexers.EmissionEntry{
+			1: {{CoinType: 1, Height: 120, TxHash: chainhash.Hash{1}}},
+		}
+		idx.firstTxns = map[cointype.CoinType]*indexers.FirstTxEntry{
+			1: {CoinType: 1, Height: 130, TxHash: chainhash.Hash{2}},
+		}
+		return idx
+	}
+	indexerWithErr := emissionIndexer()
+	indexerWithErr.firstTxErr = errors.New("fetch failed")
+
+	timeline1 := types.SKAActivationTimelineResult{
+		CoinType: 1,
+		Name:     "Skarb-1",
+		Symbol:   "SKA1",
+		Active:   true,
+		Tranches: []types.SKAActivationTrancheResult{{
+			EmissionHeight:    100,
+			EmissionWindowEnd: 150,
+			Amount:            4,
+			Emitted:           true,
+		}, {
+			EmissionHeight:    800,
+			EmissionWindowEnd: 800,
+			Amount:            1,
+		}},
+		TranchesEmitted: 1,
+		EmissionHeight:  120,
+		EmissionTxHash:  chainhash.Hash{1}.String(),
+		FirstTxHeight:   130,
+		FirstTxHash:     chainhash.Hash{2}.String(),
+		Spendable:       true,
+	}
+	timeline2 := types.SKAActivationTimelineResult{
+		CoinType:         2,
+		Name:             "Skarb-2",
+		Symbol:           "SKA2",
+		Active:           true,
+		ActivationHeight: 1000,
+		Tranches: []types.SKAActivationTrancheResult{{
+			EmissionHeight:    1000,
+			EmissionWindowEnd: 1010,
+			Amount:            2,
+			Emitted:           true,
+		}},
+		TranchesEmitted:     1,
+		BlocksUntilActivate: 499,
+	}
+	unindexed1 := timeline1
+	unindexed1.EmissionHeight, unindexed1.EmissionTxHash = 0, ""
+	unindexed1.FirstTxHeight, unindexed1.FirstTxHash = 0, ""
+	ska2 := uint8(2)
+	ska3 := uint8(3)
+	varCoinType := uint8(0)
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:                "handleGetSKAActivationTimeline: all coin types",
+		handler:             handleGetSKAActivationTimeline,
+		cmd:                 &types.GetSKAActivationTimelineCmd{},
+		mockChainParams:     params,
+		mockChain:           chain,
+		mockEmissionIndexer: emissionIndexer(),
+		result: types.GetSKAActivationTimelineResult{
+			Height:    500,
+			BestBlock: blkHash.String(),
+			Indexed:   true,
+			CoinTypes: []types.SKAActivationTimelineResult{timeline1,
+				timeline2},
+		},
+	}, {
+		name:    "handleGetSKAActivationTimeline: single coin type",
+		handler: handleGetSKAActivationTimeline,
+		cmd: &types.GetSKAActivationTimelineCmd{
+			CoinType: &ska2,
+		},
+		mockChainParams:     params,
+		mockChain:           chain,
+		mockEmissionIndexer: emissionIndexer(),
+		result: types.GetSKAActivationTimelineResult{
+			Height:    500,
+			BestBlock: blkHash.String(),
+			Indexed:   true,
+			CoinTypes: []types.SKAActivationTimelineResult{timeline2},
+		},
+	}, {
+		name:              "handleGetSKAActivationTimeline: index disabled",
+		handler:           handleGetSKAActivationTimeline,
+		cmd:               &types.GetSKAActivationTimelineCmd{},
+		mockChainParams:   params,
+		mockChain:         chain,
+		setEmissionIdxNil: true,
+		result: types.GetSKAActivationTimelineResult{
+			Height:    500,
+			BestBlock: blkHash.String(),
+			CoinTypes: []types.SKAActivationTimelineResult{unindexed1,
+				timeline2},
+		},
+	}, {
+		name:    "handleGetSKAActivationTimeline: VAR coin type",
+		handler: handleGetSKAActivationTimeline,
+		cmd: &types.GetSKAActivationTimelineCmd{
+			CoinType: &varCoinType,
+		},
+		mockChainParams: params,
+		wantErr:         true,
+		errCode:         dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetSKAActivationTimeline: unconfigured coin type",
+		handler: handleGetSKAActivationTimeline,
+		cmd: &types.GetSKAActivationTimelineCmd{
+			CoinType: &ska3,
+		},
+		mockChainParams: params,
+		wantErr:         true,
+		errCode:         dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:                "handleGetSKAActivationTimeline: fetch error",
+		handler:             handleGetSKAActivationTimeline,
+		cmd:                 &types.GetSKAActivationTimelineCmd{},
+		mockChainParams:     params,
+		mockChain:           chain,
+		mockEmissionIndexer: indexerWithErr,
+		wantErr:             true,
+		errCode:             dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+// TestHandleGetSKAEmissionStatus ensures the getskaemissionstatus handler
+// reports pending, provisional, and final emissions.
+func TestHandleGetSKAEmissionStatus(t *testing.T) {
+	t.Parallel()
+
+	blockHash := block432100.Header.BlockHash()
+	finalHash := chainhash.Hash{1}
+	provisionalHash := chainhash.Hash{2}
+	emissionIndexer := func() *testEmissionIndexer {
+		idx := defaultMockEmissionIndexer()
+		idx.emissions = map[cointype.CoinType][]indexers.EmissionEntry{
+			1: {{
+				CoinType:  1,
+				BlockHash: blockHash,
+				Height:    2,
+				TxHash:    finalHash,
+				Nonce:     1,
+			}},
+			2: {{
+				CoinType:  2,
+				BlockHash: blockHash,
+				Height:    432098,
+				TxHash:    provisionalHash,
+				Nonce:     3,
+			}},
+		}
+		return idx
+	}
+	indexerWithErr := emissionIndexer()
+	indexerWithErr.emissionsErr = errors.New("fetch failed")
+
+	emissionTx := wire.NewMsgTx()
+	emissionTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  []byte{0x01, 'S', 'K', 'A'},
+	})
+	emissionTx.AddTxOut(&wire.TxOut{Value: 1e8, CoinType: 2})
+	pendingTx := dcrutil.NewTx(emissionTx)
+	mempoolWithEmission := defaultMockTxMempooler()
+	mempoolWithEmission.fetchTransaction = pendingTx
+	mempoolWithEmission.fetchTransactionErr = nil
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetSKAEmissionStatus: final",
+		handler: handleGetSKAEmissionStatus,
+		cmd: &types.GetSKAEmissionStatusCmd{
+			TxHash: finalHash.String(),
+		},
+		mockEmissionIndexer: emissionIndexer(),
+		result: types.GetSKAEmissionStatusResult{
+			TxHash:             finalHash.String(),
+			Status:             "final",
+			CoinType:           1,
+			Nonce:              1,
+			BlockHash:          blockHash.String(),
+			Height:             2,
+			Confirmations:      432099,
+			FinalConfirmations: 6,
+		},
+	}, {
+		name:    "handleGetSKAEmissionStatus: provisional",
+		handler: handleGetSKAEmissionStatus,
+		cmd: &types.GetSKAEmissionStatusCmd{
+			TxHash: provisionalHash.String(),
+		},
+		mockEmissionIndexer: emissionIndexer(),
+		result: types.GetSKAEmissionStatusResult{
+			TxHash:             provisionalHash.String(),
+			Status:             "provisional",
+			CoinType:           2,
+			Nonce:              3,
+			BlockHash:          blockHash.String(),
+			Height:             432098,
+			Confirmations:      3,
+			FinalConfirmations: 6,
+		},
+	}, {
+		name:    "handleGetSKAEmissionStatus: pending",
+		handler: handleGetSKAEmissionStatus,
+		cmd: &types.GetSKAEmissionStatusCmd{
+			TxHash: pendingTx.Hash().String(),
+		},
+		mockTxMempooler: mempoolWithEmission,
+		result: types.GetSKAEmissionStatusResult{
+			TxHash:             pendingTx.Hash().String(),
+			Status:             "pending",
+			CoinType:           2,
+			Nonce:              1,
+			FinalConfirmations: 6,
+		},
+	}, {
+		name:    "handleGetSKAEmissionStatus: unknown emission",
+		handler: handleGetSKAEmissionStatus,
+		cmd: &types.GetSKAEmissionStatusCmd{
+			TxHash: chainhash.Hash{3}.String(),
+		},
+		mockEmissionIndexer: emissionIndexer(),
+		wantErr:             true,
+		errCode:             dcrjson.ErrRPCNoTxInfo,
+	}, {
+		name:    "handleGetSKAEmissionStatus: invalid hash",
+		handler: handleGetSKAEmissionStatus,
+		cmd: &types.GetSKAEmissionStatusCmd{
+			TxHash: "invalid",
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:    "handleGetSKAEmissionStatus: index disabled",
+		handler: handleGetSKAEmissionStatus,
+		cmd: &types.GetSKAEmissionStatusCmd{
+			TxHash: finalHash.String(),
+		},
+		setEmissionIdxNil: true,
+		wantErr:           true,
+		errCode:           dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleGetSKAEmissionStatus: fetch error",
+		handler: handleGetSKAEmissionStatus,
+		cmd: &types.GetSKAEmissionStatusCmd{
+			TxHash: finalHash.String(),
+		},
+		mockEmissionIndexer: indexerWithErr,
+		wantErr:             true,
+		errCode:             dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+func TestHandleAuditSKASupply(t *testing.T) {
+	t.Parallel()
+
+	params := cloneParams(defaultChainParams)
+	params.SKACoins = map[cointype.CoinType]*chaincfg.SKACoinConfig{
+		1: {
+			CoinType:  1,
+			Name:      "Skarb-1",
+			MaxSupply: 1e9,
+			EmissionTranches: []chaincfg.SKAEmissionTranche{
+				{EmissionAmounts: []int64{4e8}},
+				{EmissionAmounts: []int64{1e8}},
+			},
+		},
+	}
+	blkHash := mustParseHash("00000000000000001e6ec1501c858506de1de4703d1be8bab4061126e8f61480")
+	utxoSetHash := mustParseHash("fe7b32aa188800f07268b17f3bead5f3d8a1b6d18654182066436efce6effa86")
+	chainWithUtxos := func(coinTypes map[cointype.CoinType]blockchain.UtxoCoinTypeStats) *testRPCChain {
+		chain := defaultMockRPCChain()
+		chain.bestSnapshot = &blockchain.BestState{Height: 500, Hash: *blkHash}
+		chain.skaEmissionTranches = 1
+		chain.skaBurnedAmounts = map[cointype.CoinType]int64{1: 1e8}
+		chain.fetchUtxoStats = &blockchain.UtxoStats{
+			SerializedHash: *utxoSetHash,
+			CoinTypes:      coinTypes,
+		}
+		return chain
+	}
+	emissionIndexer := func(amount int64) *testEmissionIndexer {
+		idx := defaultMockEmissionIndexer()
+		idx.emissions = map[cointype.CoinType][]indexers.EmissionEntry{
+			1: {{
+				CoinType: 1,
+				Outputs:  []indexers.EmissionOutput{{Value: amount}},
+			}},
+		}
+		return idx
+	}
+	indexedEmitted := int64(4e8)
+	mismatchedEmitted := int64(3e8)
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:            "handleAuditSKASupply: consistent",
+		handler:         handleAuditSKASupply,
+		cmd:             &types.AuditSKASupplyCmd{},
+		mockChainParams: params,
+		mockChain: chainWithUtxos(map[cointype.CoinType]blockchain.UtxoCoinTypeStats{
+			0: {Utxos: 10, Total: 1e12},
+			1: {Utxos: 3, Total: 3e8},
+		}),
+		mockEmissionIndexer: emissionIndexer(4e8),
+		result: types.AuditSKASupplyResult{
+			Height:      500,
+			BestBlock:   blkHash.String(),
+			UtxoSetHash: utxoSetHash.String(),
+			Consistent:  true,
+			CoinTypes: []types.SKASupplyAuditResult{{
+				CoinType:        1,
+				Name:            "Skarb-1",
+				MaxSupply:       1e9,
+				TranchesEmitted: 1,
+				Emitted:         4e8,
+				IndexedEmitted:  &indexedEmitted,
+				Burned:          1e8,
+				ExpectedSupply:  3e8,
+				UtxoSupply:      3e8,
+				UtxoCount:       3,
+			}},
+		},
+	}, {
+		name:            "handleAuditSKASupply: inconsistent",
+		handler:         handleAuditSKASupply,
+		cmd:             &types.AuditSKASupplyCmd{},
+		mockChainParams: params,
+		mockChain: chainWithUtxos(map[cointype.CoinType]blockchain.UtxoCoinTypeStats{
+			1: {Utxos: 3, Total: 4e8},
+			3: {Utxos: 1, Total: 5},
+		}),
+		mockEmissionIndexer: emissionIndexer(3e8),
+		result: types.AuditSKASupplyResult{
+			Height:      500,
+			BestBlock:   blkHash.String(),
+			UtxoSetHash: utxoSetHash.String(),
+			Consistent:  false,
+			CoinTypes: []types.SKASupplyAuditResult{{
+				CoinType:        1,
+				Name:            "Skarb-1",
+				MaxSupply:       1e9,
+				TranchesEmitted: 1,
+				Emitted:         4e8,
+				IndexedEmitted:  &mismatchedEmitted,
+				Burned:          1e8,
+				ExpectedSupply:  3e8,
+				UtxoSupply:      4e8,
+				UtxoCount:       3,
+				Discrepancy:     1e8,
+				Issues: []string{
+					"emission index amount 300000000 does not match " +
+						"emitted amount 400000000",
+					"UTXO supply 400000000 does not match expected " +
+						"supply 300000000",
+				},
+			}, {
+				CoinType:    3,
+				UtxoSupply:  5,
+				UtxoCount:   1,
+				Discrepancy: 5,
+				Issues: []string{
+					"unspent outputs exist for a coin type that is not " +
+						"configured",
+				},
+			}},
+		},
+	}, {
+		name:            "handleAuditSKASupply: index disabled",
+		handler:         handleAuditSKASupply,
+		cmd:             &types.AuditSKASupplyCmd{},
+		mockChainParams: params,
+		mockChain: chainWithUtxos(map[cointype.CoinType]blockchain.UtxoCoinTypeStats{
+			1: {Utxos: 3, Total: 3e8},
+		}),
+		setEmissionIdxNil: true,
+		result: types.AuditSKASupplyResult{
+			Height:      500,
+			BestBlock:   blkHash.String(),
+			UtxoSetHash: utxoSetHash.String(),
+			Consistent:  true,
+			CoinTypes: []types.SKASupplyAuditResult{{
+				CoinType:        1,
+				Name:            "Skarb-1",
+				MaxSupply:       1e9,
+				TranchesEmitted: 1,
+				Emitted:         4e8,
+				Burned:          1e8,
+				ExpectedSupply:  3e8,
+				UtxoSupply:      3e8,
+				UtxoCount:       3,
+			}},
+		},
+	}})
+}
+
+func TestHandleGetSupplyScanInfo(t *testing.T) {
+	t.Parallel()
+
+	blkHash := mustParseHash("00000000000000001e6ec1501c858506de1de4703d1be8bab4061126e8f61480")
+	start := time.Unix(1700000000, 0)
+	indexedEmitted := int64(4e8)
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetSupplyScanInfo: disabled",
+		handler: handleGetSupplyScanInfo,
+		cmd:     &types.GetSupplyScanInfoCmd{},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleGetSupplyScanInfo: no completed scan",
+		handler: handleGetSupplyScanInfo,
+		cmd:     &types.GetSupplyScanInfoCmd{},
+		mockSupplyScanner: &testSupplyScanner{
+			status: supplyscan.Status{Scanning: true, Scanned: 1000},
+		},
+		result: types.GetSupplyScanInfoResult{
+			Scanning:  true,
+			Scanned:   1000,
+			CoinTypes: []types.SupplyScanCoinTypeResult{},
+		},
+	}, {
+		name:    "handleGetSupplyScanInfo: divergence",
+		handler: handleGetSupplyScanInfo,
+		cmd:     &types.GetSupplyScanInfoCmd{},
+		mockSupplyScanner: &testSupplyScanner{
+			status: supplyscan.Status{
+				Scanned:       13,
+				Scans:         2,
+				LastScanStart: start,
+				LastScanEnd:   start.Add(time.Minute),
+				Hash:          *blkHash,
+				Height:        500,
+				CoinTypes: []supplyscan.CoinTypeStatus{{
+					CoinType: 0,
+					Utxos:    10,
+					Supply:   1e12,
+				}, {
+					CoinType:       1,
+					Utxos:          3,
+					Supply:         4e8,
+					Emitted:        4e8,
+					Burned:         1e8,
+					ExpectedSupply: 3e8,
+					IndexedEmitted: &indexedEmitted,
+					Issues: []string{"UTXO supply 400000000 does not " +
+						"match expected supply 300000000"},
+				}},
+			},
+		},
+		result: types.GetSupplyScanInfoResult{
+			Scanned:       13,
+			Scans:         2,
+			LastScanStart: start.Unix(),
+			LastScanEnd:   start.Add(time.Minute).Unix(),
+			Height:        500,
+			BestBlock:     blkHash.String(),
+			CoinTypes: []types.SupplyScanCoinTypeResult{{
+				CoinType:   0,
+				UtxoCount:  10,
+				UtxoSupply: 1e12,
+			}, {
+				CoinType:       1,
+				UtxoCount:      3,
+				UtxoSupply:     4e8,
+				Emitted:        4e8,
+				IndexedEmitted: &indexedEmitted,
+				Burned:         1e8,
+				ExpectedSupply: 3e8,
+				Issues: []string{"UTXO supply 400000000 does not " +
+					"match expected supply 300000000"},
+			}},
+		},
+	}})
+}
+
 func TestHandleNode(t *testing.T) {
 	t.Parallel()
 
@@ -6151,6 +8977,75 @@ func TestHandleGetWork(t *testing.T) {
 	}})
 }
 
+func TestHandleSetBlockSpacePolicy(t *testing.T) {
+	t.Parallel()
+
+	cappedTemplater := func() *testBlockTemplater {
+		templater := defaultMockBlockTemplater()
+		templater.spacePolicy = mining.BlockSpacePolicy{
+			StakeReserveBytes: 20000,
+			MaxCoinTypeBytes: map[cointype.CoinType]uint32{
+				1: 50000,
+				2: 30000,
+			},
+		}
+		return templater
+	}
+	testRPCServerHandler(t, []rpcTest{{
+		name:                 "handleSetBlockSpacePolicy: node is not configured for mining",
+		handler:              handleSetBlockSpacePolicy,
+		cmd:                  &types.SetBlockSpacePolicyCmd{},
+		setBlockTemplaterNil: true,
+		wantErr:              true,
+		errCode:              dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleSetBlockSpacePolicy: unknown overflow strategy",
+		handler: handleSetBlockSpacePolicy,
+		cmd: &types.SetBlockSpacePolicyCmd{
+			OverflowStrategy: dcrjson.String("everything"),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleSetBlockSpacePolicy: invalid coin type",
+		handler: handleSetBlockSpacePolicy,
+		cmd: &types.SetBlockSpacePolicyCmd{
+			CoinTypeCaps: &map[string]uint32{"SKA-1": 50000},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleSetBlockSpacePolicy: invalid policy",
+		handler: handleSetBlockSpacePolicy,
+		cmd: &types.SetBlockSpacePolicyCmd{
+			StakeReserveBytes: dcrjson.Uint32(20000),
+		},
+		mockBlockTemplater: func() *testBlockTemplater {
+			templater := defaultMockBlockTemplater()
+			templater.spacePolicyErr = errors.New("stake reserve too large")
+			return templater
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleSetBlockSpacePolicy: only provided parts change",
+		handler: handleSetBlockSpacePolicy,
+		cmd: &types.SetBlockSpacePolicyCmd{
+			OverflowStrategy: dcrjson.String("VARONLY"),
+			CoinTypeCaps:     &map[string]uint32{"2": 0, "3": 10000},
+		},
+		mockBlockTemplater: cappedTemplater(),
+		result: &types.GetBlockSpacePolicyResult{
+			OverflowStrategy:  "varonly",
+			StakeReserveBytes: 20000,
+			CoinTypeCaps: []types.BlockSpaceCoinTypeCap{
+				{CoinType: 1, Name: "SKA-1", MaxBytes: 50000},
+				{CoinType: 3, Name: "SKA-3", MaxBytes: 10000},
+			},
+		},
+	}})
+}
+
 func TestHandleSetGenerate(t *testing.T) {
 	t.Parallel()
 
@@ -6750,6 +9645,250 @@ func TestHandleTxFeeInfo(t *testing.T) {
 	}})
 }
 
+func TestHandleTestBlockAssembly(t *testing.T) {
+	t.Parallel()
+
+	tx := dcrutil.NewTx(block432100.Transactions[1])
+	txSize := int64(tx.MsgTx().SerializeSize())
+	txDesc := &mempool.TxDesc{
+		TxDesc: mining.TxDesc{
+			Tx:     tx,
+			Type:   stake.TxTypeRegular,
+			Fee:    10000,
+			TxSize: txSize,
+		},
+	}
+	mockTxMempooler := func() *testTxMempooler {
+		mp := defaultMockTxMempooler()
+		mp.txDescs = []*mempool.TxDesc{txDesc}
+		return mp
+	}
+	txid := tx.Hash().String()
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleTestBlockAssembly: no transactions",
+		handler: handleTestBlockAssembly,
+		cmd: &types.TestBlockAssemblyCmd{
+			Txs: []string{},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleTestBlockAssembly: transaction not in mempool",
+		handler: handleTestBlockAssembly,
+		cmd: &types.TestBlockAssemblyCmd{
+			Txs: []string{txid},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCNoTxInfo,
+	}, {
+		name:    "handleTestBlockAssembly: invalid raw transaction",
+		handler: handleTestBlockAssembly,
+		cmd: &types.TestBlockAssemblyCmd{
+			Txs: []string{"zz"},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:    "handleTestBlockAssembly: duplicate transaction",
+		handler: handleTestBlockAssembly,
+		cmd: &types.TestBlockAssemblyCmd{
+			Txs: []string{txid, txid},
+		},
+		mockTxMempooler: mockTxMempooler(),
+		wantErr:         true,
+		errCode:         dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleTestBlockAssembly: ok",
+		handler: handleTestBlockAssembly,
+		cmd: &types.TestBlockAssemblyCmd{
+			Txs: []string{txid},
+		},
+		mockTxMempooler: mockTxMempooler(),
+		result: &types.TestBlockAssemblyResult{
+			Height:     int64(block432100.Header.Height) + 1,
+			TotalBytes: uint32(wire.MaxBlockHeaderPayload + 2 + txSize),
+			CoinTypes: []types.TestBlockAssemblyCoinTypeResult{{
+				Name:      "VAR",
+				Demand:    uint32(txSize),
+				Allocated: 375000,
+				Used:      uint32(txSize),
+				Included:  []string{txid},
+				Deferred:  []string{},
+			}},
+			Transactions: []types.TestBlockAssemblyTxResult{{
+				Txid:     txid,
+				Size:     uint32(txSize),
+				FeeRate:  dcrutil.Amount(10000 * 1000 / txSize).ToCoin(),
+				Included: true,
+			}},
+		},
+	}})
+}
+
+func TestHandleTestMempoolAccept(t *testing.T) {
+	t.Parallel()
+
+	doNotAllowHighFees := false
+	tx := dcrutil.NewTx(block432100.Transactions[1])
+	txB, err := block432100.Transactions[1].Bytes()
+	if err != nil {
+		t.Fatalf("unexpected tx serialization error: %v", err)
+	}
+	hexTx := hex.EncodeToString(txB)
+	txSize := int64(len(txB))
+
+	// pendingDesc returns a descriptor for a pending transaction of the same
+	// coin type with the provided size that pays a higher fee rate.
+	pendingDesc := func(size int64) *mempool.TxDesc {
+		return &mempool.TxDesc{
+			TxDesc: mining.TxDesc{
+				Tx:     dcrutil.NewTx(block432100.Transactions[0]),
+				Type:   stake.TxTypeRegular,
+				Fee:    size * 1000,
+				TxSize: size,
+			},
+		}
+	}
+	saturatedFeeCalc := &testCoinTypeFeeCalculator{
+		feeStats: map[cointype.CoinType]*CoinTypeFeeStats{
+			cointype.CoinTypeVAR: {
+				CoinType:       cointype.CoinTypeVAR,
+				BlockSpaceUsed: 0.97,
+			},
+		},
+	}
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleTestMempoolAccept: invalid tx hex",
+		handler: handleTestMempoolAccept,
+		cmd: &types.TestMempoolAcceptCmd{
+			HexTx:         "invalid",
+			AllowHighFees: &doNotAllowHighFees,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:    "handleTestMempoolAccept: unable to test transaction",
+		handler: handleTestMempoolAccept,
+		cmd: &types.TestMempoolAcceptCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &doNotAllowHighFees,
+		},
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.testAcceptErr = errors.New("unable to test transaction")
+			return mp
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleTestMempoolAccept: rejected transaction",
+		handler: handleTestMempoolAccept,
+		cmd: &types.TestMempoolAcceptCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &doNotAllowHighFees,
+		},
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.testAcceptErr = mempool.RuleError{
+				Err:         mempool.ErrDuplicate,
+				Description: "duplicate tx",
+			}
+			return mp
+		}(),
+		result: &types.TestMempoolAcceptResult{
+			Txid:         tx.Hash().String(),
+			RejectReason: "duplicate tx",
+			Size:         txSize,
+		},
+	}, {
+		name:    "handleTestMempoolAccept: ok",
+		handler: handleTestMempoolAccept,
+		cmd: &types.TestMempoolAcceptCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &doNotAllowHighFees,
+		},
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.testAcceptFee = 10000
+			return mp
+		}(),
+		result: &types.TestMempoolAcceptResult{
+			Txid:            tx.Hash().String(),
+			Allowed:         true,
+			Size:            txSize,
+			Fee:             0.0001,
+			FeeRate:         dcrutil.Amount(10000 * 1000 / txSize).ToCoin(),
+			BucketSize:      375000,
+			FitsNextBlock:   true,
+			EstimatedBlocks: 1,
+		},
+	}, {
+		name:    "handleTestMempoolAccept: ok with pending demand advisory",
+		handler: handleTestMempoolAccept,
+		cmd: &types.TestMempoolAcceptCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &doNotAllowHighFees,
+		},
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.testAcceptFee = 10000
+			mp.txDescs = []*mempool.TxDesc{pendingDesc(2300000)}
+			return mp
+		}(),
+		result: &types.TestMempoolAcceptResult{
+			Txid:            tx.Hash().String(),
+			Allowed:         true,
+			Size:            txSize,
+			Fee:             0.0001,
+			FeeRate:         dcrutil.Amount(10000 * 1000 / txSize).ToCoin(),
+			BucketSize:      375000,
+			BytesAhead:      2300000,
+			EstimatedBlocks: 7,
+			Advisory: &types.AllocationAdvisory{
+				Reason: "pending demand for coin type 0 exceeds the " +
+					"block space allocated to it for an estimated 7 blocks",
+				EstimatedBlocks: 7,
+				BucketSize:      375000,
+				BucketDemand:    2300000 + uint32(txSize),
+			},
+		},
+	}, {
+		name:    "handleTestMempoolAccept: ok with saturated allocation advisory",
+		handler: handleTestMempoolAccept,
+		cmd: &types.TestMempoolAcceptCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &doNotAllowHighFees,
+		},
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.testAcceptFee = 10000
+			mp.txDescs = []*mempool.TxDesc{pendingDesc(400000)}
+			return mp
+		}(),
+		mockCoinTypeFeeCalc: saturatedFeeCalc,
+		result: &types.TestMempoolAcceptResult{
+			Txid:            tx.Hash().String(),
+			Allowed:         true,
+			Size:            txSize,
+			Fee:             0.0001,
+			FeeRate:         dcrutil.Amount(10000 * 1000 / txSize).ToCoin(),
+			BucketSize:      375000,
+			BytesAhead:      400000,
+			EstimatedBlocks: 2,
+			Advisory: &types.AllocationAdvisory{
+				Reason: "the block space allocated to coin type 0 is " +
+					"saturated by recent blocks",
+				EstimatedBlocks: 2,
+				BucketSize:      375000,
+				BucketDemand:    400000 + uint32(txSize),
+				Utilization:     0.97,
+			},
+		},
+	}})
+}
+
 func TestHandleTicketFeeInfo(t *testing.T) {
 	t.Parallel()
 
@@ -6993,6 +10132,28 @@ func TestHandleSendRawTransaction(t *testing.T) {
 	}
 
 	hexTx := hex.EncodeToString(txB)
+	txSize := int64(len(txB))
+	verbose := true
+
+	// The verbose result advises when pending transactions of the same coin
+	// type that pay a higher fee rate exceed the allocated space for more
+	// blocks than the advisory threshold.
+	txDesc := &mempool.TxDesc{
+		TxDesc: mining.TxDesc{
+			Tx:     tx,
+			Type:   stake.TxTypeRegular,
+			Fee:    10000,
+			TxSize: txSize,
+		},
+	}
+	pendingDesc := &mempool.TxDesc{
+		TxDesc: mining.TxDesc{
+			Tx:     dcrutil.NewTx(block432100.Transactions[0]),
+			Type:   stake.TxTypeRegular,
+			Fee:    2300000 * 1000,
+			TxSize: 2300000,
+		},
+	}
 
 	testRPCServerHandler(t, []rpcTest{{
 		name:    "handleSendRawTransaction: invalid tx hex",
@@ -7064,59 +10225,186 @@ func TestHandleSendRawTransaction(t *testing.T) {
 			return syncManager
 		}(),
 		wantErr: true,
-		errCode: dcrjson.ErrRPCDuplicateTx,
+		errCode: dcrjson.ErrRPCDuplicateTx,
+	}, {
+		name:    "handleSendRawTransaction: recently mined transaction",
+		handler: handleSendRawTransaction,
+		cmd: &types.SendRawTransactionCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &allowHighFees,
+		},
+		mockSyncManager: func() *testSyncManager {
+			syncManager := defaultMockSyncManager()
+			syncManager.processTransactionErr = mempool.RuleError{
+				Err: mempool.RuleError{
+					Err:         mempool.ErrInvalid,
+					Description: "invalid tx",
+				},
+			}
+			syncManager.recentlyConfirmedTxn = true
+			return syncManager
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDuplicateTx,
+	}, {
+		name:    "handleSendRawTransaction: expired transaction",
+		handler: handleSendRawTransaction,
+		cmd: &types.SendRawTransactionCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &allowHighFees,
+		},
+		mockSyncManager: func() *testSyncManager {
+			syncManager := defaultMockSyncManager()
+			syncManager.processTransactionErr = mempool.RuleError{
+				Err: mempool.RuleError{
+					Err:         mempool.ErrExpired,
+					Description: "tx expired",
+				},
+			}
+			return syncManager
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCMisc,
+	}, {
+		name:    "handleSendRawTransaction: ok",
+		handler: handleSendRawTransaction,
+		cmd: &types.SendRawTransactionCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &allowHighFees,
+		},
+		mockSyncManager: func() *testSyncManager {
+			syncManager := defaultMockSyncManager()
+			syncManager.processTransaction = []*dcrutil.Tx{tx}
+			return syncManager
+		}(),
+		result: tx.Hash().String(),
+	}, {
+		name:    "handleSendRawTransaction: ok verbose",
+		handler: handleSendRawTransaction,
+		cmd: &types.SendRawTransactionCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &allowHighFees,
+			Verbose:       &verbose,
+		},
+		mockSyncManager: func() *testSyncManager {
+			syncManager := defaultMockSyncManager()
+			syncManager.processTransaction = []*dcrutil.Tx{tx}
+			return syncManager
+		}(),
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.txDescs = []*mempool.TxDesc{txDesc}
+			return mp
+		}(),
+		result: &types.SendRawTransactionResult{Txid: tx.Hash().String()},
+	}, {
+		name:    "handleSendRawTransaction: ok verbose with advisory",
+		handler: handleSendRawTransaction,
+		cmd: &types.SendRawTransactionCmd{
+			HexTx:         hexTx,
+			AllowHighFees: &allowHighFees,
+			Verbose:       &verbose,
+		},
+		mockSyncManager: func() *testSyncManager {
+			syncManager := defaultMockSyncManager()
+			syncManager.processTransaction = []*dcrutil.Tx{tx}
+			return syncManager
+		}(),
+		mockTxMempooler: func() *testTxMempooler {
+			mp := defaultMockTxMempooler()
+			mp.txDescs = []*mempool.TxDesc{txDesc, pendingDesc}
+			return mp
+		}(),
+		result: &types.SendRawTransactionResult{
+			Txid: tx.Hash().String(),
+			Advisory: &types.AllocationAdvisory{
+				Reason: "pending demand for coin type 0 exceeds the " +
+					"block space allocated to it for an estimated 7 blocks",
+				EstimatedBlocks: 7,
+				BucketSize:      375000,
+				BucketDemand:    2300000 + uint32(txSize),
+			},
+		},
+	}})
+}
+
+func TestHandleSendRawPackage(t *testing.T) {
+	t.Parallel()
+
+	allowHighFees := true
+	parent := dcrutil.NewTx(block432100.Transactions[0])
+	child := dcrutil.NewTx(block432100.Transactions[1])
+	hexTxs := make([]string, 0, 2)
+	for _, tx := range []*dcrutil.Tx{parent, child} {
+		txB, err := tx.MsgTx().Bytes()
+		if err != nil {
+			t.Fatalf("unexpected tx serialization error: %v", err)
+		}
+		hexTxs = append(hexTxs, hex.EncodeToString(txB))
+	}
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleSendRawPackage: invalid tx hex",
+		handler: handleSendRawPackage,
+		cmd: &types.SendRawPackageCmd{
+			HexTxs:        []string{hexTxs[0], "invalid"},
+			AllowHighFees: &allowHighFees,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:    "handleSendRawPackage: invalid tx",
+		handler: handleSendRawPackage,
+		cmd: &types.SendRawPackageCmd{
+			HexTxs:        []string{"fefefefefefe", hexTxs[1]},
+			AllowHighFees: &allowHighFees,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDeserialization,
 	}, {
-		name:    "handleSendRawTransaction: recently mined transaction",
-		handler: handleSendRawTransaction,
-		cmd: &types.SendRawTransactionCmd{
-			HexTx:         hexTx,
+		name:    "handleSendRawPackage: package rejected",
+		handler: handleSendRawPackage,
+		cmd: &types.SendRawPackageCmd{
+			HexTxs:        hexTxs,
 			AllowHighFees: &allowHighFees,
 		},
 		mockSyncManager: func() *testSyncManager {
 			syncManager := defaultMockSyncManager()
-			syncManager.processTransactionErr = mempool.RuleError{
-				Err: mempool.RuleError{
-					Err:         mempool.ErrInvalid,
-					Description: "invalid tx",
-				},
+			syncManager.processPackageErr = mempool.RuleError{
+				Err:         mempool.ErrInsufficientFee,
+				Description: "insufficient package fee",
 			}
-			syncManager.recentlyConfirmedTxn = true
 			return syncManager
 		}(),
 		wantErr: true,
-		errCode: dcrjson.ErrRPCDuplicateTx,
+		errCode: dcrjson.ErrRPCMisc,
 	}, {
-		name:    "handleSendRawTransaction: expired transaction",
-		handler: handleSendRawTransaction,
-		cmd: &types.SendRawTransactionCmd{
-			HexTx:         hexTx,
+		name:    "handleSendRawPackage: unable to process package",
+		handler: handleSendRawPackage,
+		cmd: &types.SendRawPackageCmd{
+			HexTxs:        hexTxs,
 			AllowHighFees: &allowHighFees,
 		},
 		mockSyncManager: func() *testSyncManager {
 			syncManager := defaultMockSyncManager()
-			syncManager.processTransactionErr = mempool.RuleError{
-				Err: mempool.RuleError{
-					Err:         mempool.ErrExpired,
-					Description: "tx expired",
-				},
-			}
+			syncManager.processPackageErr = errors.New("unable to process")
 			return syncManager
 		}(),
 		wantErr: true,
-		errCode: dcrjson.ErrRPCMisc,
+		errCode: dcrjson.ErrRPCDeserialization,
 	}, {
-		name:    "handleSendRawTransaction: ok",
-		handler: handleSendRawTransaction,
-		cmd: &types.SendRawTransactionCmd{
-			HexTx:         hexTx,
+		name:    "handleSendRawPackage: ok",
+		handler: handleSendRawPackage,
+		cmd: &types.SendRawPackageCmd{
+			HexTxs:        hexTxs,
 			AllowHighFees: &allowHighFees,
 		},
 		mockSyncManager: func() *testSyncManager {
 			syncManager := defaultMockSyncManager()
-			syncManager.processTransaction = []*dcrutil.Tx{tx}
+			syncManager.processPackage = []*dcrutil.Tx{parent, child}
 			return syncManager
 		}(),
-		result: tx.Hash().String(),
+		result: []string{parent.Hash().String(), child.Hash().String()},
 	}})
 }
 
@@ -7552,11 +10840,13 @@ func TestHandleGetRawTransaction(t *testing.T) {
 	nonVerboseMempoolResult := nonVerboseResult
 
 	verboseResult := types.TxRawResult{
-		Hex:      nonVerboseResult,
-		Txid:     "b1a172e05df393fb5e8dd812ccdef517367f0da7abb017ae7e7adc4853b785a2",
-		Version:  1,
-		LockTime: 0,
-		Expiry:   0,
+		Hex:             nonVerboseResult,
+		Txid:            "b1a172e05df393fb5e8dd812ccdef517367f0da7abb017ae7e7adc4853b785a2",
+		Version:         1,
+		LockTime:        0,
+		Expiry:          0,
+		PrimaryCoinType: 0, // VAR coin type
+		MinRelayFee:     0.0001,
 		Vin: []types.Vin{{
 			Coinbase:      "",
 			Stakebase:     "",
@@ -7652,11 +10942,13 @@ func TestHandleGetRawTransaction(t *testing.T) {
 	}
 
 	verboseMempoolResult := types.TxRawResult{
-		Hex:      nonVerboseMempoolResult,
-		Txid:     "b1a172e05df393fb5e8dd812ccdef517367f0da7abb017ae7e7adc4853b785a2",
-		Version:  1,
-		LockTime: 0,
-		Expiry:   0,
+		Hex:             nonVerboseMempoolResult,
+		Txid:            "b1a172e05df393fb5e8dd812ccdef517367f0da7abb017ae7e7adc4853b785a2",
+		Version:         1,
+		LockTime:        0,
+		Expiry:          0,
+		PrimaryCoinType: 0, // VAR coin type
+		MinRelayFee:     0.0001,
 		Vin: []types.Vin{{
 			Coinbase:      "",
 			Stakebase:     "",
@@ -8113,6 +11405,134 @@ func TestHandleVersion(t *testing.T) {
 	}})
 }
 
+func TestHandleGetRecentUtilization(t *testing.T) {
+	t.Parallel()
+
+	hash := block432100.BlockHash()
+	history := &testUtilizationHistorian{
+		blocks: []fees.BlockUtilization{{
+			Height: 432100,
+			Hash:   hash,
+			CoinTypes: []fees.CoinTypeUtilization{{
+				CoinType:       cointype.CoinTypeVAR,
+				NumTxns:        4,
+				UsedBytes:      3000,
+				AllocatedBytes: 12000,
+			}, {
+				CoinType:       1,
+				AllocatedBytes: 5000,
+			}},
+		}, {
+			Height: 432099,
+			Hash:   chainhash.Hash{0x01},
+		}},
+	}
+	skaCoinType := uint8(1)
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetRecentUtilization: history not enabled",
+		handler: handleGetRecentUtilization,
+		cmd:     &types.GetRecentUtilizationCmd{},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCMisc,
+	}, {
+		name:                "handleGetRecentUtilization: invalid count",
+		handler:             handleGetRecentUtilization,
+		cmd:                 &types.GetRecentUtilizationCmd{Count: dcrjson.Int(0)},
+		mockUtilizationHist: history,
+		wantErr:             true,
+		errCode:             dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:                "handleGetRecentUtilization: ok",
+		handler:             handleGetRecentUtilization,
+		cmd:                 &types.GetRecentUtilizationCmd{Count: dcrjson.Int(1)},
+		mockUtilizationHist: history,
+		result: []types.GetRecentUtilizationResult{{
+			Height: 432100,
+			Hash:   hash.String(),
+			CoinTypes: []types.CoinTypeUtilizationResult{{
+				CoinType:       0,
+				Name:           "VAR",
+				NumTxns:        4,
+				UsedBytes:      3000,
+				AllocatedBytes: 12000,
+				Utilization:    0.25,
+			}, {
+				CoinType:       1,
+				Name:           "SKA-1",
+				AllocatedBytes: 5000,
+			}},
+		}},
+	}, {
+		name:    "handleGetRecentUtilization: coin type",
+		handler: handleGetRecentUtilization,
+		cmd: &types.GetRecentUtilizationCmd{
+			Count:    dcrjson.Int(10),
+			CoinType: &skaCoinType,
+		},
+		mockUtilizationHist: history,
+		result: []types.GetRecentUtilizationResult{{
+			Height: 432100,
+			Hash:   hash.String(),
+			CoinTypes: []types.CoinTypeUtilizationResult{{
+				CoinType:       1,
+				Name:           "SKA-1",
+				AllocatedBytes: 5000,
+			}},
+		}, {
+			Height:    432099,
+			Hash:      chainhash.Hash{0x01}.String(),
+			CoinTypes: []types.CoinTypeUtilizationResult{},
+		}},
+	}})
+}
+
+func TestHandleGetAllocationDivergence(t *testing.T) {
+	t.Parallel()
+
+	monitor := &testAllocDivergenceMonitor{
+		stats: blockalloc.DivergenceStats{
+			BlocksChecked:   40,
+			DivergentBlocks: 5,
+			Miners: []blockalloc.MinerDivergence{{
+				Miner:               "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8",
+				Blocks:              12,
+				DivergentBlocks:     4,
+				RecentDivergent:     4,
+				LastHeight:          432100,
+				LastDivergentHeight: 432100,
+				Flagged:             true,
+			}},
+		},
+	}
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetAllocationDivergence: self-check not enabled",
+		handler: handleGetAllocationDivergence,
+		cmd:     &types.GetAllocationDivergenceCmd{},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCMisc,
+	}, {
+		name:                "handleGetAllocationDivergence: ok",
+		handler:             handleGetAllocationDivergence,
+		cmd:                 &types.GetAllocationDivergenceCmd{},
+		mockAllocDivergence: monitor,
+		result: types.GetAllocationDivergenceResult{
+			BlocksChecked:   40,
+			DivergentBlocks: 5,
+			Window:          blockalloc.DivergenceWindow,
+			Threshold:       blockalloc.DivergenceThreshold,
+			Miners: []types.AllocationDivergenceMinerResult{{
+				Miner:               "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8",
+				Blocks:              12,
+				DivergentBlocks:     4,
+				RecentDivergent:     4,
+				LastHeight:          432100,
+				LastDivergentHeight: 432100,
+				Flagged:             true,
+			}},
+		},
+	}})
+}
+
 func TestHandleGetStakeDifficulty(t *testing.T) {
 	t.Parallel()
 
@@ -8365,6 +11785,33 @@ func testRPCServerHandler(t *testing.T, tests []rpcTest) {
 			if test.setTxIndexerNil {
 				rpcserverConfig.TxIndexer = nil
 			}
+			if test.mockWatchOnlyIndexer != nil {
+				rpcserverConfig.WatchOnlyIndexer = test.mockWatchOnlyIndexer
+			}
+			if test.setWatchOnlyIdxNil {
+				rpcserverConfig.WatchOnlyIndexer = nil
+			}
+			if test.mockEmissionIndexer != nil {
+				rpcserverConfig.EmissionIndexer = test.mockEmissionIndexer
+			}
+			if test.setEmissionIdxNil {
+				rpcserverConfig.EmissionIndexer = nil
+			}
+			if test.mockIndexSyncReporter != nil {
+				rpcserverConfig.IndexSyncReporter = test.mockIndexSyncReporter
+			}
+			if test.mockSupplyScanner != nil {
+				rpcserverConfig.SupplyScanner = test.mockSupplyScanner
+			}
+			if test.mockEmissionCkpt != nil {
+				rpcserverConfig.EmissionCheckpointer = test.mockEmissionCkpt
+			}
+			if test.mockUtilizationHist != nil {
+				rpcserverConfig.UtilizationHistorian = test.mockUtilizationHist
+			}
+			if test.mockAllocDivergence != nil {
+				rpcserverConfig.AllocDivergenceMonitor = test.mockAllocDivergence
+			}
 			if test.mockDB != nil {
 				rpcserverConfig.DB = test.mockDB
 			}
@@ -8377,6 +11824,9 @@ func testRPCServerHandler(t *testing.T, tests []rpcTest) {
 			if test.mockFeeEstimator != nil {
 				rpcserverConfig.FeeEstimator = test.mockFeeEstimator
 			}
+			if test.mockCoinTypeFeeCalc != nil {
+				rpcserverConfig.CoinTypeFeeCalculator = test.mockCoinTypeFeeCalc
+			}
 			if test.mockLogManager != nil {
 				rpcserverConfig.LogManager = test.mockLogManager
 			}
@@ -8439,3 +11889,522 @@ func testRPCServerHandler(t *testing.T, tests []rpcTest) {
 		})
 	}
 }
+
+func TestHandleGetCoinTypeSnapshot(t *testing.T) {
+	t.Parallel()
+
+	const payAddr = "MsMfPyfBF2ztzKkT8ged6EaNrJ3iwQXmZR8"
+	addr, err := stdaddr.DecodeAddress(payAddr, defaultChainParams)
+	if err != nil {
+		t.Fatalf("unexpected address decode error: %v", err)
+	}
+	scriptVer, script := addr.PaymentScript()
+	nonStdScript := []byte{0x51}
+	blkHash := mustParseHash("00000000000000001e6ec1501c858506de1de4703d1be8bab4061126e8f61480")
+	txHash1 := mustParseHash("e02f03a25a57afdd402818fe5b13985a0731502ad8a8c93d1874900e84d3330d")
+	txHash2 := mustParseHash("fe7b32aa188800f07268b17f3bead5f3d8a1b6d18654182066436efce6effa86")
+	snapshot := &blockchain.UtxoSnapshot{
+		Hash:     *blkHash,
+		Height:   400,
+		CoinType: 1,
+		Total:    6e8,
+		Entries: []blockchain.UtxoSnapshotEntry{{
+			OutPoint:      wire.OutPoint{Hash: *txHash1, Index: 0},
+			BlockHeight:   300,
+			Amount:        1e8,
+			ScriptVersion: scriptVer,
+			PkScript:      script,
+		}, {
+			OutPoint:    wire.OutPoint{Hash: *txHash1, Index: 1},
+			BlockHeight: 300,
+			Amount:      2e8,
+			PkScript:    nonStdScript,
+		}, {
+			OutPoint:      wire.OutPoint{Hash: *txHash2, Index: 2},
+			BlockHeight:   350,
+			Amount:        3e8,
+			ScriptVersion: scriptVer,
+			PkScript:      script,
+		}},
+	}
+	chainWithSnapshot := func() *testRPCChain {
+		chain := defaultMockRPCChain()
+		chain.bestSnapshot = &blockchain.BestState{Height: 500}
+		chain.fetchUtxoSnapshot = snapshot
+		return chain
+	}
+	chainWithSnapshotErr := func() *testRPCChain {
+		chain := chainWithSnapshot()
+		chain.fetchUtxoSnapshotErr = errors.New("snapshot error")
+		return chain
+	}
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:      "handleGetCoinTypeSnapshot: ok",
+		handler:   handleGetCoinTypeSnapshot,
+		cmd:       &types.GetCoinTypeSnapshotCmd{CoinType: 1, Height: dcrjson.Int64(400)},
+		mockChain: chainWithSnapshot(),
+		result: types.GetCoinTypeSnapshotResult{
+			CoinType:  1,
+			Height:    400,
+			BlockHash: blkHash.String(),
+			Total:     6e8,
+			UtxoCount: 3,
+			Utxos: []types.CoinTypeSnapshotUtxo{{
+				TxHash:       txHash1.String(),
+				Vout:         0,
+				Height:       300,
+				Amount:       1e8,
+				Version:      scriptVer,
+				ScriptPubKey: hex.EncodeToString(script),
+				Address:      payAddr,
+			}, {
+				TxHash:       txHash1.String(),
+				Vout:         1,
+				Height:       300,
+				Amount:       2e8,
+				ScriptPubKey: "51",
+			}, {
+				TxHash:       txHash2.String(),
+				Vout:         2,
+				Height:       350,
+				Amount:       3e8,
+				Version:      scriptVer,
+				ScriptPubKey: hex.EncodeToString(script),
+				Address:      payAddr,
+			}},
+		},
+	}, {
+		name:    "handleGetCoinTypeSnapshot: aggregated",
+		handler: handleGetCoinTypeSnapshot,
+		cmd: &types.GetCoinTypeSnapshotCmd{
+			CoinType:  1,
+			Aggregate: dcrjson.Bool(true),
+		},
+		mockChain: chainWithSnapshot(),
+		result: types.GetCoinTypeSnapshotResult{
+			CoinType:  1,
+			Height:    400,
+			BlockHash: blkHash.String(),
+			Total:     6e8,
+			UtxoCount: 3,
+			Balances: []types.CoinTypeSnapshotBalance{{
+				ScriptPubKey: "51",
+				Amount:       2e8,
+				Utxos:        1,
+			}, {
+				Address: payAddr,
+				Amount:  4e8,
+				Utxos:   2,
+			}},
+		},
+	}, {
+		name:      "handleGetCoinTypeSnapshot: height beyond tip",
+		handler:   handleGetCoinTypeSnapshot,
+		cmd:       &types.GetCoinTypeSnapshotCmd{CoinType: 1, Height: dcrjson.Int64(501)},
+		mockChain: chainWithSnapshot(),
+		wantErr:   true,
+		errCode:   dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:      "handleGetCoinTypeSnapshot: negative height",
+		handler:   handleGetCoinTypeSnapshot,
+		cmd:       &types.GetCoinTypeSnapshotCmd{CoinType: 1, Height: dcrjson.Int64(-1)},
+		mockChain: chainWithSnapshot(),
+		wantErr:   true,
+		errCode:   dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:      "handleGetCoinTypeSnapshot: snapshot error",
+		handler:   handleGetCoinTypeSnapshot,
+		cmd:       &types.GetCoinTypeSnapshotCmd{CoinType: 1},
+		mockChain: chainWithSnapshotErr(),
+		wantErr:   true,
+		errCode:   dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+func TestHandleGetValidationStats(t *testing.T) {
+	t.Parallel()
+
+	chainWithStats := func() *testRPCChain {
+		chain := defaultMockRPCChain()
+		chain.validationStats = []blockchain.ValidationStageStats{{
+			Stage: blockchain.VSTransactionChecks,
+			Count: 4,
+			Total: 10 * time.Millisecond,
+			Max:   4 * time.Millisecond,
+			Last:  1500 * time.Microsecond,
+		}, {
+			Stage: blockchain.VSScriptValidation,
+		}}
+		return chain
+	}
+
+	testRPCServerHandler(t, []rpcTest{{
+		name:      "handleGetValidationStats: ok",
+		handler:   handleGetValidationStats,
+		cmd:       &types.GetValidationStatsCmd{},
+		mockChain: chainWithStats(),
+		result: types.GetValidationStatsResult{
+			Stages: []types.ValidationStageStats{{
+				Stage:     "transactionchecks",
+				Count:     4,
+				TotalMs:   10,
+				AverageMs: 2.5,
+				MaxMs:     4,
+				LastMs:    1.5,
+			}, {
+				Stage: "scriptvalidation",
+			}},
+		},
+	}})
+}
+
+// TestHandleListKnownSKAAddresses ensures the listknownskaaddresses handler
+// returns the addresses the scheduled emission tranches pay to filtered by coin
+// type along with whether the watch-only index watches them.
+func TestHandleListKnownSKAAddresses(t *testing.T) {
+	t.Parallel()
+
+	const emissionAddr = "MsMz7mvUPBu5GDFexM2W8KiFxEeToFAC4Wv"
+	ska1 := types.ListKnownSKAAddressesResult{
+		CoinType:       1,
+		EmissionHeight: 4096,
+		Address:        emissionAddr,
+		Amount:         dcrutil.Amount(10e6 * 1e8).ToCoinType(1),
+	}
+	ska2 := types.ListKnownSKAAddressesResult{
+		CoinType:       2,
+		EmissionHeight: 150000,
+		Address:        emissionAddr,
+		Amount:         dcrutil.Amount(5e6 * 1e8).ToCoinType(2),
+	}
+	watchedSKA1 := ska1
+	watchedSKA1.Watched = true
+	watchingSKA1 := func() *testWatchOnlyIndexer {
+		idx := defaultMockWatchOnlyIndexer()
+		idx.watched = []indexers.WatchedAddress{
+			{Address: emissionAddr, CoinType: cointype.CoinType(1)},
+			{Address: emissionAddr, CoinType: cointype.CoinTypeVAR},
+		}
+		return idx
+	}
+	testRPCServerHandler(t, []rpcTest{{
+		name:                 "handleListKnownSKAAddresses: ok",
+		handler:              handleListKnownSKAAddresses,
+		cmd:                  &types.ListKnownSKAAddressesCmd{},
+		mockWatchOnlyIndexer: watchingSKA1(),
+		result:               []types.ListKnownSKAAddressesResult{watchedSKA1, ska2},
+	}, {
+		name:    "handleListKnownSKAAddresses: filtered by coin type",
+		handler: handleListKnownSKAAddresses,
+		cmd: &types.ListKnownSKAAddressesCmd{
+			CoinType: uint8Ptr(2),
+		},
+		mockWatchOnlyIndexer: watchingSKA1(),
+		result:               []types.ListKnownSKAAddressesResult{ska2},
+	}, {
+		name:               "handleListKnownSKAAddresses: watch-only index not enabled",
+		handler:            handleListKnownSKAAddresses,
+		cmd:                &types.ListKnownSKAAddressesCmd{},
+		setWatchOnlyIdxNil: true,
+		result:             []types.ListKnownSKAAddressesResult{ska1, ska2},
+	}, {
+		name:    "handleListKnownSKAAddresses: watched addresses error",
+		handler: handleListKnownSKAAddresses,
+		cmd:     &types.ListKnownSKAAddressesCmd{},
+		mockWatchOnlyIndexer: func() *testWatchOnlyIndexer {
+			idx := defaultMockWatchOnlyIndexer()
+			idx.watchedErr = errors.New("db error")
+			return idx
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
+
+// TestHandleListRebroadcastTxs ensures the listrebroadcasttxs handler returns
+// the tracked transactions filtered by coin type.
+func TestHandleListRebroadcastTxs(t *testing.T) {
+	t.Parallel()
+
+	added := time.Unix(1700000000, 0)
+	connManager := func() *testConnManager {
+		cm := defaultMockConnManager()
+		cm.rebroadcastTxs = []RebroadcastTx{{
+			Hash:        chainhash.Hash{0x01},
+			CoinType:    cointype.CoinTypeVAR,
+			Added:       added,
+			NextAttempt: added.Add(5 * time.Minute),
+		}, {
+			Hash:        chainhash.Hash{0x02},
+			CoinType:    cointype.CoinType(1),
+			Added:       added.Add(time.Minute),
+			Attempts:    2,
+			LastAttempt: added.Add(time.Hour),
+			NextAttempt: added.Add(2 * time.Hour),
+			Saturated:   true,
+		}}
+		return cm
+	}
+	skaCoinType := uint8(1)
+	testRPCServerHandler(t, []rpcTest{{
+		name:            "handleListRebroadcastTxs: ok",
+		handler:         handleListRebroadcastTxs,
+		cmd:             &types.ListRebroadcastTxsCmd{},
+		mockConnManager: connManager(),
+		result: []types.ListRebroadcastTxsResult{{
+			TxID:        chainhash.Hash{0x01}.String(),
+			CoinType:    0,
+			Added:       added.Unix(),
+			NextAttempt: added.Add(5 * time.Minute).Unix(),
+		}, {
+			TxID:        chainhash.Hash{0x02}.String(),
+			CoinType:    1,
+			Added:       added.Add(time.Minute).Unix(),
+			Attempts:    2,
+			LastAttempt: added.Add(time.Hour).Unix(),
+			NextAttempt: added.Add(2 * time.Hour).Unix(),
+			Saturated:   true,
+		}},
+	}, {
+		name:    "handleListRebroadcastTxs: ok, coin type",
+		handler: handleListRebroadcastTxs,
+		cmd: &types.ListRebroadcastTxsCmd{
+			CoinType: &skaCoinType,
+		},
+		mockConnManager: connManager(),
+		result: []types.ListRebroadcastTxsResult{{
+			TxID:        chainhash.Hash{0x02}.String(),
+			CoinType:    1,
+			Added:       added.Add(time.Minute).Unix(),
+			Attempts:    2,
+			LastAttempt: added.Add(time.Hour).Unix(),
+			NextAttempt: added.Add(2 * time.Hour).Unix(),
+			Saturated:   true,
+		}},
+	}, {
+		name:    "handleListRebroadcastTxs: ok, none",
+		handler: handleListRebroadcastTxs,
+		cmd:     &types.ListRebroadcastTxsCmd{},
+		result:  []types.ListRebroadcastTxsResult{},
+	}})
+}
+
+// TestHandleAbandonRebroadcastTx ensures the abandonrebroadcasttx handler
+// stops rebroadcasting tracked transactions.
+func TestHandleAbandonRebroadcastTx(t *testing.T) {
+	t.Parallel()
+
+	txHash := chainhash.Hash{0x01}.String()
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleAbandonRebroadcastTx: ok",
+		handler: handleAbandonRebroadcastTx,
+		cmd: &types.AbandonRebroadcastTxCmd{
+			TxHash: txHash,
+		},
+		mockConnManager: func() *testConnManager {
+			cm := defaultMockConnManager()
+			cm.abandonRebroadcast = true
+			return cm
+		}(),
+		result: nil,
+	}, {
+		name:    "handleAbandonRebroadcastTx: invalid hash",
+		handler: handleAbandonRebroadcastTx,
+		cmd: &types.AbandonRebroadcastTxCmd{
+			TxHash: "invalid",
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:    "handleAbandonRebroadcastTx: not tracked",
+		handler: handleAbandonRebroadcastTx,
+		cmd: &types.AbandonRebroadcastTxCmd{
+			TxHash: txHash,
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCNoTxInfo,
+	}})
+}
+
+// TestHandleGetBlockTemplate ensures the getblocktemplate handler only accepts
+// the proposal mode and reports the reason proposed blocks are rejected.
+func TestHandleGetBlockTemplate(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := block432100.Serialize(&buf); err != nil {
+		t.Fatalf("failed to serialize block: %v", err)
+	}
+	blkHex := hex.EncodeToString(buf.Bytes())
+	blkHash := block432100.BlockHash().String()
+	proposal := &types.GetBlockTemplateCmd{
+		Request: types.TemplateRequest{Mode: "proposal", Data: blkHex},
+	}
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetBlockTemplate: ok",
+		handler: handleGetBlockTemplate,
+		cmd:     proposal,
+		result: &types.GetBlockTemplateProposalResult{
+			Hash:     blkHash,
+			Accepted: true,
+		},
+	}, {
+		name:    "handleGetBlockTemplate: duplicate",
+		handler: handleGetBlockTemplate,
+		cmd:     proposal,
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.haveBlock = true
+			return chain
+		}(),
+		result: &types.GetBlockTemplateProposalResult{
+			Hash:         blkHash,
+			RejectReason: "duplicate",
+		},
+	}, {
+		name:    "handleGetBlockTemplate: not best prev block",
+		handler: handleGetBlockTemplate,
+		cmd:     proposal,
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.checkConnectBlockTemplateErr = blockchain.RuleError{
+				Err:         blockchain.ErrInvalidTemplateParent,
+				Description: "bad parent",
+			}
+			return chain
+		}(),
+		result: &types.GetBlockTemplateProposalResult{
+			Hash:         blkHash,
+			RejectReason: "inconclusive-not-best-prevblk",
+			ErrorKind:    "ErrInvalidTemplateParent",
+			Message:      "bad parent",
+		},
+	}, {
+		name:    "handleGetBlockTemplate: consensus rule violation",
+		handler: handleGetBlockTemplate,
+		cmd:     proposal,
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.checkConnectBlockTemplateErr = blockchain.RuleError{
+				Err:         blockchain.ErrBadMerkleRoot,
+				Description: "bad merkle root",
+			}
+			return chain
+		}(),
+		result: &types.GetBlockTemplateProposalResult{
+			Hash:         blkHash,
+			RejectReason: "rejected",
+			ErrorKind:    "ErrBadMerkleRoot",
+			Message:      "bad merkle root",
+		},
+	}, {
+		name:    "handleGetBlockTemplate: validation failure",
+		handler: handleGetBlockTemplate,
+		cmd:     proposal,
+		mockChain: func() *testRPCChain {
+			chain := defaultMockRPCChain()
+			chain.checkConnectBlockTemplateErr = errors.New("db failure")
+			return chain
+		}(),
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}, {
+		name:    "handleGetBlockTemplate: template mode",
+		handler: handleGetBlockTemplate,
+		cmd: &types.GetBlockTemplateCmd{
+			Request: types.TemplateRequest{Mode: "template"},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetBlockTemplate: invalid mode",
+		handler: handleGetBlockTemplate,
+		cmd: &types.GetBlockTemplateCmd{
+			Request: types.TemplateRequest{Mode: "bogus", Data: blkHex},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetBlockTemplate: missing data",
+		handler: handleGetBlockTemplate,
+		cmd: &types.GetBlockTemplateCmd{
+			Request: types.TemplateRequest{Mode: "proposal"},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetBlockTemplate: invalid hex",
+		handler: handleGetBlockTemplate,
+		cmd: &types.GetBlockTemplateCmd{
+			Request: types.TemplateRequest{Mode: "proposal", Data: "zz"},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDecodeHexString,
+	}, {
+		name:    "handleGetBlockTemplate: invalid block",
+		handler: handleGetBlockTemplate,
+		cmd: &types.GetBlockTemplateCmd{
+			Request: types.TemplateRequest{Mode: "proposal", Data: "00"},
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCDeserialization,
+	}})
+}
+
+// TestHandleGetIndexInfo ensures the getindexinfo handler reports the sync
+// progress of the indexes.
+func TestHandleGetIndexInfo(t *testing.T) {
+	t.Parallel()
+
+	bestHeight := int64(block432100.Header.Height)
+	bestHash := block432100.Header.BlockHash()
+	emissionIdx := types.GetIndexInfoResult{
+		Name:       "ska emission index",
+		Version:    1,
+		TipHeight:  bestHeight,
+		TipHash:    bestHash.String(),
+		BestHeight: bestHeight,
+		Synced:     true,
+		Progress:   100,
+	}
+	txIdx := types.GetIndexInfoResult{
+		Name:               "transaction index",
+		Version:            2,
+		TipHeight:          bestHeight / 4,
+		TipHash:            chainhash.Hash{0x01}.String(),
+		BestHeight:         bestHeight,
+		Progress:           25,
+		EstimatedRemaining: 5400,
+	}
+	testRPCServerHandler(t, []rpcTest{{
+		name:    "handleGetIndexInfo: ok",
+		handler: handleGetIndexInfo,
+		cmd:     &types.GetIndexInfoCmd{},
+		result:  []types.GetIndexInfoResult{emissionIdx, txIdx},
+	}, {
+		name:    "handleGetIndexInfo: ok, named index",
+		handler: handleGetIndexInfo,
+		cmd: &types.GetIndexInfoCmd{
+			Name: dcrjson.String("transaction index"),
+		},
+		result: []types.GetIndexInfoResult{txIdx},
+	}, {
+		name:    "handleGetIndexInfo: unknown index",
+		handler: handleGetIndexInfo,
+		cmd: &types.GetIndexInfoCmd{
+			Name: dcrjson.String("unknown index"),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInvalidParameter,
+	}, {
+		name:    "handleGetIndexInfo: sync status error",
+		handler: handleGetIndexInfo,
+		cmd:     &types.GetIndexInfoCmd{},
+		mockIndexSyncReporter: &testIndexSyncReporter{
+			statusErr: errors.New("unable to fetch index tip"),
+		},
+		wantErr: true,
+		errCode: dcrjson.ErrRPCInternal.Code,
+	}})
+}
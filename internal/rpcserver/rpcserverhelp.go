@@ -30,11 +30,33 @@ var helpDescsEnUS = map[string]string{
 	"debuglevel--result0":    "The string 'Done.'",
 	"debuglevel--result1":    "The list of subsystems",
 
+	// AbandonRebroadcastTxCmd help.
+	"abandonrebroadcasttx--synopsis": "Stops periodically rebroadcasting a transaction submitted through the RPC server.\n" +
+		"The transaction is not removed from the mempool.",
+	"abandonrebroadcasttx-txhash": "The hash of the transaction to stop rebroadcasting",
+
 	// AddNodeCmd help.
 	"addnode--synopsis": "Attempts to add or remove a persistent peer.",
 	"addnode-addr":      "IP address and port of the peer to operate on",
 	"addnode-subcmd":    "'add' to add a persistent peer, 'remove' to remove a persistent peer, or 'onetry' to try a single connection to a peer",
 
+	// BumpFeeEstimateCmd help.
+	"bumpfeeestimate--synopsis": "Returns the fee rate a transaction in the mempool must pay to be selected into the next block given the demand for the space allocated to its coin type, along with the additional fee needed to reach it.\n" +
+		"The transaction must outbid every pending transaction of its coin type that no longer fits in the space allocated to its coin type ahead of it.",
+	"bumpfeeestimate-txhash": "The hash of the transaction",
+
+	// BumpFeeEstimateResult help.
+	"bumpfeeestimateresult-txid":            "The hash of the transaction",
+	"bumpfeeestimateresult-cointype":        "The primary coin type of the transaction",
+	"bumpfeeestimateresult-size":            "The serialized size of the transaction in bytes",
+	"bumpfeeestimateresult-fee":             "The fee paid in coins of the primary coin type",
+	"bumpfeeestimateresult-feerate":         "The fee rate in coins of the primary coin type per kB",
+	"bumpfeeestimateresult-requiredfeerate": "The fee rate in coins of the primary coin type per kB needed to be selected into the next block (0 when the transaction fits regardless of its fee rate)",
+	"bumpfeeestimateresult-feedelta":        "The additional fee in coins of the primary coin type needed to reach the required fee rate",
+	"bumpfeeestimateresult-bucketsize":      "The number of bytes allocated to the coin type in the next block given the pending demand",
+	"bumpfeeestimateresult-bytesahead":      "The number of pending bytes of the coin type that pay an equal or higher fee rate",
+	"bumpfeeestimateresult-fitsnextblock":   "Whether or not the transaction fits in the space allocated to its coin type in the next block at its current fee rate",
+
 	// NodeCmd help.
 	"node--synopsis":     "Attempts to add or remove a peer.",
 	"node-subcmd":        "'disconnect' to remove all matching non-persistent peers, 'remove' to remove a persistent peer, or 'connect' to connect to a peer",
@@ -88,6 +110,14 @@ var helpDescsEnUS = map[string]string{
 	"createrawtransaction-expiry":         "Expiry value; a non-zero value when the transaction expiry",
 	"createrawtransaction--result0":       "Hex-encoded bytes of the serialized transaction",
 
+	// CreateSweepTransactionCmd help.
+	"createsweeptransaction--synopsis": "Returns a new unsigned transaction that spends every provided unspent output of the coin type to a single output paying the address the total amount less the fee.\n" +
+		"Outpoints that are spent, unknown, immature, or of another coin type are ignored.",
+	"createsweeptransaction-cointype":  "The coin type of the outputs to sweep",
+	"createsweeptransaction-outpoints": "The outpoints that may be swept",
+	"createsweeptransaction-address":   "The address to send the swept amount to",
+	"createsweeptransaction-options":   "Options that control the fee rate",
+
 	// ScriptSig help.
 	"scriptsig-asm": "Disassembly of the script",
 	"scriptsig-hex": "Hex-encoded bytes of the script",
@@ -124,6 +154,7 @@ var helpDescsEnUS = map[string]string{
 	"vin-blockindex":    "The block idx of the origin transaction",
 	"vin-blockheight":   "The block height of the origin transaction",
 	"vin-amountin":      "The amount in",
+	"vin-cointype":      "The coin type of the output being redeemed (transaction version 4 and later only)",
 
 	// ScriptPubKeyResult help.
 	"scriptpubkeyresult-asm":       "Disassembly of the script",
@@ -138,16 +169,18 @@ var helpDescsEnUS = map[string]string{
 	"vout-value":        "The amount in VAR",
 	"vout-n":            "The index of this transaction output",
 	"vout-version":      "The version of the public key script",
-	"vout-cointype":     "The coin type (0=VAR, 1=SKA)",
+	"vout-cointype":     "The coin type of the output (0 for VAR, 1-255 for SKA)",
 	"vout-scriptPubKey": "The public key script used to pay coins as a JSON object",
 
 	// TxRawDecodeResult help.
-	"txrawdecoderesult-txid":     "The hash of the transaction",
-	"txrawdecoderesult-version":  "The transaction version",
-	"txrawdecoderesult-locktime": "The transaction lock time",
-	"txrawdecoderesult-vin":      "The transaction inputs as JSON objects",
-	"txrawdecoderesult-vout":     "The transaction outputs as JSON objects",
-	"txrawdecoderesult-expiry":   "The transaction expiry",
+	"txrawdecoderesult-txid":            "The hash of the transaction",
+	"txrawdecoderesult-version":         "The transaction version",
+	"txrawdecoderesult-locktime":        "The transaction lock time",
+	"txrawdecoderesult-vin":             "The transaction inputs as JSON objects",
+	"txrawdecoderesult-vout":            "The transaction outputs as JSON objects",
+	"txrawdecoderesult-expiry":          "The transaction expiry",
+	"txrawdecoderesult-primarycointype": "The coin type transferred by the transaction (0 for VAR, 1-255 for SKA)",
+	"txrawdecoderesult-minrelayfee":     "The minimum relay fee rate in coins/kB that applies to the primary coin type of the transaction",
 
 	// DecodeRawTransactionCmd help.
 	"decoderawtransaction--synopsis": "Returns a JSON object representing the provided serialized, hex-encoded transaction.",
@@ -190,6 +223,25 @@ var helpDescsEnUS = map[string]string{
 	"existsmempooltxs-txhashes":  "Array of hashes to check",
 	"existsmempooltxs--result0":  "Bool blob showing if txs exist in the mempool or not",
 
+	// FundRawTransactionCmd help.
+	"fundrawtransaction--synopsis": "Returns the provided transaction, which must not have any inputs and whose outputs must all be of the same coin type, with inputs selected from the provided outpoints that cover the outputs and the fee along with an output that sends any change to the change address.\n" +
+		"Coin types are never mixed, so outpoints that are spent, unknown, immature, or of another coin type are ignored.\n" +
+		"The transaction inputs are not signed in the returned transaction.",
+	"fundrawtransaction-hextx":         "Serialized, hex-encoded transaction to fund",
+	"fundrawtransaction-outpoints":     "The outpoints that may be selected as inputs",
+	"fundrawtransaction-changeaddress": "The address to send any change to",
+	"fundrawtransaction-options":       "Options that control the fee rate",
+
+	// FundRawTransactionOptions help.
+	"fundrawtransactionoptions-feerate":    "The fee rate to pay in coins of the coin type per KB (default: the estimated fee rate for the confirmation target)",
+	"fundrawtransactionoptions-conftarget": "The number of blocks within which the transaction should confirm used to estimate the fee rate (default: 6)",
+
+	// FundRawTransactionResult help.
+	"fundrawtransactionresult-hex":       "Hex-encoded bytes of the serialized unsigned transaction",
+	"fundrawtransactionresult-fee":       "The fee paid in coins of the coin type",
+	"fundrawtransactionresult-cointype":  "The coin type of every input and output",
+	"fundrawtransactionresult-changepos": "The index of the change output or -1 when there is none",
+
 	// GenerateCmd help
 	"generate--synopsis": "Generates a set number of blocks (simnet or regtest only) and returns a JSON\n" +
 		" array of their hashes.",
@@ -213,6 +265,42 @@ var helpDescsEnUS = map[string]string{
 	"getaddednodeinfo--condition1": "dns=true",
 	"getaddednodeinfo--result0":    "List of added peers",
 
+	// GetAddressReceivedCmd help.
+	"getaddressreceived--synopsis": "Returns the total amount of the provided coin type ever received by a watched address, including outputs that have since been spent.\n" +
+		"Requires the watch-only index to be enabled via --watchonlyindex and the address to be imported via importwatchonlyaddress.",
+	"getaddressreceived-address":  "The address to report the received amount for",
+	"getaddressreceived-cointype": "The coin type to sum (0 for VAR, 1-255 for SKA)",
+	"getaddressreceived-minconf":  "The minimum number of confirmations of the outputs counted towards the total (0 includes mempool outputs)",
+
+	// GetAddressReceivedResult help.
+	"getaddressreceivedresult-address":     "The address the received amount is reported for",
+	"getaddressreceivedresult-cointype":    "The coin type of the received amount",
+	"getaddressreceivedresult-minconf":     "The minimum number of confirmations applied",
+	"getaddressreceivedresult-height":      "The height of the main chain tip the received amount is reported for",
+	"getaddressreceivedresult-received":    "The total amount received by the address in coins",
+	"getaddressreceivedresult-outputcount": "The number of outputs counted towards the total",
+
+	// GetAllocationDivergenceCmd help.
+	"getallocationdivergence--synopsis": "Returns how the blocks of each miner diverge from the block space allocation policy of the node.\n" +
+		"Every block connected while synced is checked for coin types it used significantly less space for than the allocation policy expects given the pending mempool demand.\n" +
+		"Miners are flagged once enough of their recent blocks diverge, which indicates they may be running a modified allocation policy.",
+
+	// GetAllocationDivergenceResult help.
+	"getallocationdivergenceresult-blockschecked":   "The number of blocks checked since the node started",
+	"getallocationdivergenceresult-divergentblocks": "The number of checked blocks that diverged",
+	"getallocationdivergenceresult-window":          "The number of most recent checked blocks of each miner considered when flagging it",
+	"getallocationdivergenceresult-threshold":       "The number of divergent blocks within the window at which a miner is flagged",
+	"getallocationdivergenceresult-miners":          "The miners with at least one divergent block ordered by their number of recent divergent blocks",
+
+	// AllocationDivergenceMinerResult help.
+	"allocationdivergenceminerresult-miner":               "The address the blocks of the miner pay the work subsidy to, or its hex-encoded script when it is not a standard address",
+	"allocationdivergenceminerresult-blocks":              "The number of checked blocks of the miner",
+	"allocationdivergenceminerresult-divergentblocks":     "The number of divergent blocks of the miner",
+	"allocationdivergenceminerresult-recentdivergent":     "The number of divergent blocks within the most recent checked blocks of the miner",
+	"allocationdivergenceminerresult-lastheight":          "The height of the most recent checked block of the miner",
+	"allocationdivergenceminerresult-lastdivergentheight": "The height of the most recent divergent block of the miner",
+	"allocationdivergenceminerresult-flagged":             "Whether the number of recent divergent blocks of the miner reached the threshold",
+
 	// GetBestBlockResult help.
 	"getbestblockresult-hash":   "Hex-encoded bytes of the best block hash",
 	"getbestblockresult-height": "Height of the best block",
@@ -249,6 +337,7 @@ var helpDescsEnUS = map[string]string{
 	"getblockchaininforesult-chainwork":            "Hex encoded total work done for the chain.",
 	"getblockchaininforesult-initialblockdownload": "Best guess of whether this node is in the initial chain sync mode used to catch up the chain when it is far behind",
 	"getblockchaininforesult-maxblocksize":         "The maximum allowed block size.",
+	"getblockchaininforesult-skaconfighash":        "The canonical hash of the SKA configuration (coin types, emission keys, amounts and heights) of the active network.",
 	"getblockchaininforesult-deployments":          "Network consensus deployments.",
 	"getblockchaininforesult-deployments--desc":    "Consensus deployment agendas.",
 	"getblockchaininforesult-deployments--key":     "The consensus deployment agenda id.",
@@ -261,19 +350,21 @@ var helpDescsEnUS = map[string]string{
 	"agendainfo-expiretime": "The expiry time of the voting period for the agenda.",
 
 	// TxRawResult help.
-	"txrawresult-hex":           "Hex-encoded transaction",
-	"txrawresult-txid":          "The hash of the transaction",
-	"txrawresult-version":       "The transaction version",
-	"txrawresult-locktime":      "The transaction lock time",
-	"txrawresult-vin":           "The transaction inputs as JSON objects",
-	"txrawresult-vout":          "The transaction outputs as JSON objects",
-	"txrawresult-blockhash":     "The hash of the block that contains the transaction",
-	"txrawresult-confirmations": "Number of confirmations of the block",
-	"txrawresult-time":          "Transaction time in seconds since 1 Jan 1970 GMT",
-	"txrawresult-blocktime":     "Block time in seconds since the 1 Jan 1970 GMT",
-	"txrawresult-blockindex":    "The index within the array of transactions contained by the block",
-	"txrawresult-blockheight":   "The height of the block that contains the transaction",
-	"txrawresult-expiry":        "The transacion expiry",
+	"txrawresult-hex":             "Hex-encoded transaction",
+	"txrawresult-txid":            "The hash of the transaction",
+	"txrawresult-version":         "The transaction version",
+	"txrawresult-locktime":        "The transaction lock time",
+	"txrawresult-vin":             "The transaction inputs as JSON objects",
+	"txrawresult-vout":            "The transaction outputs as JSON objects",
+	"txrawresult-blockhash":       "The hash of the block that contains the transaction",
+	"txrawresult-confirmations":   "Number of confirmations of the block",
+	"txrawresult-time":            "Transaction time in seconds since 1 Jan 1970 GMT",
+	"txrawresult-blocktime":       "Block time in seconds since the 1 Jan 1970 GMT",
+	"txrawresult-blockindex":      "The index within the array of transactions contained by the block",
+	"txrawresult-blockheight":     "The height of the block that contains the transaction",
+	"txrawresult-expiry":          "The transacion expiry",
+	"txrawresult-primarycointype": "The coin type transferred by the transaction (0 for VAR, 1-255 for SKA)",
+	"txrawresult-minrelayfee":     "The minimum relay fee rate in coins/kB that applies to the primary coin type of the transaction",
 
 	// GetBlockVerboseResult help.
 	"getblockverboseresult-hash":              "The hash of the block (same as provided)",
@@ -350,6 +441,83 @@ var helpDescsEnUS = map[string]string{
 	"getblockheaderverboseresult-extradata":         "Extra data field for the requested block",
 	"getblockheaderverboseresult-stakeversion":      "The stake version of the block",
 
+	// GetBlockSpacePolicyCmd help.
+	"getblockspacepolicy--synopsis": "Returns the block space policy applied to the block templates generated by the node.\n" +
+		"The policy is not part of the consensus rules and may only restrict block templates further than the block space allocation blocks are validated against.",
+
+	// GetBlockSpacePolicyResult help.
+	"getblockspacepolicyresult-overflowstrategy":  "Which coin types may use the overflow space granted on top of their base allocation (proportional: all coin types, varonly: only VAR, none: no coin types)",
+	"getblockspacepolicyresult-stakereservebytes": "The minimum number of bytes reserved for the stake tree (0 when only the expected size of the stake transactions is reserved)",
+	"getblockspacepolicyresult-cointypecaps":      "The maximum number of bytes the transactions of each capped coin type may use ordered by coin type",
+
+	// BlockSpaceCoinTypeCap help.
+	"blockspacecointypecap-cointype": "The numeric coin type",
+	"blockspacecointypecap-name":     "The coin type name (e.g., 'VAR', 'SKA-1')",
+	"blockspacecointypecap-maxbytes": "The maximum number of bytes the transactions of the coin type may use in a block template",
+
+	// GetBlockSpendsCmd help.
+	"getblockspends--synopsis": "Returns the outputs spent by a main chain block summarized by coin type as recorded in the undo data of the block.\n" +
+		"The outputs spent by the regular tree are included even when the next block disapproves it.",
+	"getblockspends-hash":     "The hash of the block",
+	"getblockspends-cointype": "Only return the outputs of this coin type (default: all coin types)",
+	"getblockspends-verbose":  "Include the individual spent outputs for each coin type",
+
+	// GetBlockSpendsResult help.
+	"getblockspendsresult-hash":        "The hash of the block",
+	"getblockspendsresult-height":      "The height of the block",
+	"getblockspendsresult-disapproved": "Whether the regular tree of the block is disapproved by the next block in the main chain",
+	"getblockspendsresult-cointypes":   "The spent outputs per coin type ordered by coin type",
+
+	// BlockSpendsCoinType help.
+	"blockspendscointype-cointype":  "The numeric coin type",
+	"blockspendscointype-name":      "The coin type name (e.g., 'VAR', 'SKA-1')",
+	"blockspendscointype-count":     "The number of outputs of the coin type spent by the block",
+	"blockspendscointype-amount":    "The total amount in atoms of the outputs of the coin type spent by the block",
+	"blockspendscointype-addresses": "The number of distinct addresses paid by the spent outputs",
+	"blockspendscointype-avgage":    "The average age in blocks of the spent outputs weighted by their amounts",
+	"blockspendscointype-outputs":   "The spent outputs in the order the block spends them (only when verbose)",
+
+	// BlockSpentOutput help.
+	"blockspentoutput-txhash":       "The hash of the transaction that created the output",
+	"blockspentoutput-tree":         "The tree of the transaction that created the output",
+	"blockspentoutput-vout":         "The index of the output",
+	"blockspentoutput-spendingtx":   "The hash of the transaction that spent the output",
+	"blockspentoutput-vin":          "The index of the input that spent the output",
+	"blockspentoutput-height":       "The height of the block that created the output",
+	"blockspentoutput-amount":       "The amount of the output in atoms",
+	"blockspentoutput-version":      "The version of the output script",
+	"blockspentoutput-scriptpubkey": "The hex-encoded output script",
+	"blockspentoutput-address":      "The address paid by the output script (omitted when it does not pay exactly one address)",
+	"blockspentoutput-coinbase":     "Whether the output was created by a coinbase transaction (omitted when false)",
+
+	// GetBlockStatsCmd help.
+	"getblockstats--synopsis": "Returns statistics about a main chain block including the fees collected per coin type, the cumulative fee totals up to and including the block, and the transaction counts, sizes, block space allocation, and fee rates of each coin type.",
+	"getblockstats-hash":      "The hash of the block",
+
+	// GetBlockStatsResult help.
+	"getblockstatsresult-hash":            "The hash of the block",
+	"getblockstatsresult-height":          "The height of the block",
+	"getblockstatsresult-cumulativestart": "The height of the first block whose fees are included in the cumulative totals",
+	"getblockstatsresult-fees":            "The fees collected per coin type ordered by coin type (empty for blocks connected before fee totals were tracked)",
+	"getblockstatsresult-cointypes":       "The transaction statistics per coin type ordered by coin type",
+
+	// BlockStatsCoinTypeFees help.
+	"blockstatscointypefees-cointype":       "The numeric coin type",
+	"blockstatscointypefees-name":           "The coin type name (e.g., 'VAR', 'SKA-1')",
+	"blockstatscointypefees-fees":           "The fees paid by the transactions in the block in coins of the coin type",
+	"blockstatscointypefees-cumulativefees": "The cumulative fees paid up to and including the block in coins of the coin type",
+
+	// BlockStatsCoinType help.
+	"blockstatscointype-cointype":       "The numeric coin type",
+	"blockstatscointype-name":           "The coin type name (e.g., 'VAR', 'SKA-1')",
+	"blockstatscointype-numtxns":        "The number of transactions in the block accounted against the coin type",
+	"blockstatscointype-totalbytes":     "The total serialized size in bytes of the transactions accounted against the coin type",
+	"blockstatscointype-allocatedbytes": "The block space in bytes allocated to the coin type",
+	"blockstatscointype-utilization":    "The fraction of the allocated block space used by the coin type",
+	"blockstatscointype-minfeerate":     "The minimum fee rate in coins/kB paid by the transactions of the coin type",
+	"blockstatscointype-medianfeerate":  "The median fee rate in coins/kB paid by the transactions of the coin type",
+	"blockstatscointype-maxfeerate":     "The maximum fee rate in coins/kB paid by the transactions of the coin type",
+
 	// GetBlockSubsidyCmd help.
 	"getblocksubsidy--synopsis": "Returns information regarding subsidy amounts.",
 	"getblocksubsidy-height":    "The block height",
@@ -361,6 +529,23 @@ var helpDescsEnUS = map[string]string{
 	"getblocksubsidyresult-pow":       "The Proof-of-Work subsidy",
 	"getblocksubsidyresult-total":     "The total subsidy",
 
+	// TemplateRequest help.
+	"templaterequest-mode": "The mode of the request which must be 'proposal'",
+	"templaterequest-data": "Serialized, hex-encoded block to validate",
+
+	// GetBlockTemplateCmd help.
+	"getblocktemplate--synopsis": "Fully validates a block proposed by an external miner, aside from the proof of work, without submitting it.\n" +
+		"The block must build on the current best block or its parent.\n" +
+		"Only the BIP23 proposal mode is supported since block templates are provided via getwork.",
+	"getblocktemplate-request": "Request object describing the block proposal",
+
+	// GetBlockTemplateProposalResult help.
+	"getblocktemplateproposalresult-hash":         "The hash of the proposed block",
+	"getblocktemplateproposalresult-accepted":     "Whether or not the proposed block is valid",
+	"getblocktemplateproposalresult-rejectreason": "The reason the block was rejected: 'duplicate', 'inconclusive-not-best-prevblk', or 'rejected' (omitted when accepted)",
+	"getblocktemplateproposalresult-errorkind":    "The consensus rule violation that caused the block to be rejected, such as ErrBadMerkleRoot (omitted when not applicable)",
+	"getblocktemplateproposalresult-message":      "A human-readable description of why the block was rejected (omitted when not applicable)",
+
 	// GetBurnedCoinsCmd help.
 	"getburnedcoins--synopsis": "Returns information about burned coins for SKA coin types.",
 	"getburnedcoins-cointype":  "Optional: specific SKA coin type to query (1-255). If not specified, returns all coin types with burns.",
@@ -373,6 +558,198 @@ var helpDescsEnUS = map[string]string{
 	"getburnedcoinsstat-name":        "The name of the coin type (e.g., 'SKA-1', 'SKA-2')",
 	"getburnedcoinsstat-totalburned": "Total amount of coins burned",
 
+	// GetSKABurnsCmd help.
+	"getskaburns--synopsis":   "Returns the individual burns of an SKA coin type recorded in the main chain so retired supply can be audited.",
+	"getskaburns-cointype":    "The SKA coin type (1-255)",
+	"getskaburns-startheight": "The height of the first block to return burns from",
+	"getskaburns-count":       "The maximum number of burns to return (1-1000)",
+
+	// GetSKABurnsResult help.
+	"getskaburnsresult-cointype":     "The SKA coin type",
+	"getskaburnsresult-totalburned":  "Total amount of coins burned, including burns prior to the journal start height",
+	"getskaburnsresult-journalstart": "Height of the first block whose individual burns are recorded",
+	"getskaburnsresult-burns":        "The burns ordered by height",
+
+	// SKABurnResult help.
+	"skaburnresult-txhash": "The hash of the burn transaction",
+	"skaburnresult-vout":   "The index of the burn output",
+	"skaburnresult-height": "The height of the block that contains the burn",
+	"skaburnresult-amount": "The amount of coins burned",
+
+	// GetSKAEmissionsCmd help.
+	"getskaemissions--synopsis": "Returns the emission transactions of an SKA coin type connected to the main chain as tracked by the emission index.",
+	"getskaemissions-cointype":  "The SKA coin type (1-255)",
+
+	// GetSKAEmissionsResult help.
+	"getskaemissionsresult-cointype":  "The SKA coin type",
+	"getskaemissionsresult-emissions": "The emissions ordered by height",
+
+	// SKAEmissionResult help.
+	"skaemissionresult-blockhash":     "The hash of the block that contains the emission",
+	"skaemissionresult-height":        "The height of the block that contains the emission",
+	"skaemissionresult-txhash":        "The hash of the emission transaction",
+	"skaemissionresult-nonce":         "The emission nonce",
+	"skaemissionresult-confirmations": "The number of confirmations of the emission",
+	"skaemissionresult-final":         "Whether the emission reached the number of confirmations after which it is considered final",
+	"skaemissionresult-outputs":       "The outputs of the emission that pay the coin type",
+
+	// GetSKAEmissionStatusCmd help.
+	"getskaemissionstatus--synopsis": "Returns whether an SKA emission transaction is pending in the mempool, provisional in the main chain, or final once it reached the configured number of confirmations.\n" +
+		"Provisional emissions may still be rolled back by a reorganization.  Emissions in the main chain are found by way of the emission index.",
+	"getskaemissionstatus-txhash": "The hash of the emission transaction",
+
+	// GetSKAEmissionStatusResult help.
+	"getskaemissionstatusresult-txhash":             "The hash of the emission transaction",
+	"getskaemissionstatusresult-status":             "The status of the emission (pending, provisional, or final)",
+	"getskaemissionstatusresult-cointype":           "The SKA coin type of the emission",
+	"getskaemissionstatusresult-nonce":              "The emission nonce",
+	"getskaemissionstatusresult-blockhash":          "The hash of the block that contains the emission (omitted when pending)",
+	"getskaemissionstatusresult-height":             "The height of the block that contains the emission (omitted when pending)",
+	"getskaemissionstatusresult-confirmations":      "The number of confirmations of the emission",
+	"getskaemissionstatusresult-finalconfirmations": "The number of confirmations after which the emission is final",
+
+	// GetSKAActivationTimelineCmd help.
+	"getskaactivationtimeline--synopsis": "Returns the activation timeline of SKA coin types, consolidating the configured activation height and emission schedule with the emissions according to the chain state and whether the coin type may currently be spent.\n" +
+		"The heights of the first emission and the first transaction of each coin type are only included when the emission index is enabled.",
+	"getskaactivationtimeline-cointype": "Only return the timeline of this SKA coin type (1-255)",
+
+	// GetSKAActivationTimelineResult help.
+	"getskaactivationtimelineresult-height":    "The height of the current best block",
+	"getskaactivationtimelineresult-bestblock": "The hash of the current best block",
+	"getskaactivationtimelineresult-indexed":   "Whether the heights observed by the emission index are included",
+	"getskaactivationtimelineresult-cointypes": "The timeline of each coin type ordered by coin type",
+
+	// SKAActivationTimelineResult help.
+	"skaactivationtimelineresult-cointype":            "The SKA coin type",
+	"skaactivationtimelineresult-name":                "The name of the coin type",
+	"skaactivationtimelineresult-symbol":              "The symbol of the coin type",
+	"skaactivationtimelineresult-active":              "Whether the coin type is configured as active",
+	"skaactivationtimelineresult-activationheight":    "The configured height of the first block the coin type is active in",
+	"skaactivationtimelineresult-tranches":            "The configured emission schedule of the coin type",
+	"skaactivationtimelineresult-tranchesemitted":     "The number of tranches emitted according to the chain state",
+	"skaactivationtimelineresult-emissionheight":      "The height of the block that contains the first emission (omitted when not emitted or the emission index is disabled)",
+	"skaactivationtimelineresult-emissiontxhash":      "The hash of the first emission transaction (omitted when not emitted or the emission index is disabled)",
+	"skaactivationtimelineresult-firsttxheight":       "The height of the block that contains the first transaction other than an emission or coinbase paying the coin type (omitted when none exists or the emission index is disabled)",
+	"skaactivationtimelineresult-firsttxhash":         "The hash of the first transaction other than an emission or coinbase paying the coin type (omitted when none exists or the emission index is disabled)",
+	"skaactivationtimelineresult-blocksuntilactivate": "The number of blocks until the coin type activates (omitted when inactive or already activated)",
+	"skaactivationtimelineresult-spendable":           "Whether the coin type is active and emitted so it may be spent in the next block",
+
+	// SKAActivationTrancheResult help.
+	"skaactivationtrancheresult-emissionheight":    "The first height the tranche may be emitted at",
+	"skaactivationtrancheresult-emissionwindowend": "The final height the tranche may be emitted at",
+	"skaactivationtrancheresult-amount":            "The total amount emitted by the tranche in coins",
+	"skaactivationtrancheresult-emitted":           "Whether the tranche has been emitted according to the chain state",
+
+	// SKAEmissionOutputResult help.
+	"skaemissionoutputresult-vout":         "The index of the output",
+	"skaemissionoutputresult-amount":       "The amount of coins emitted",
+	"skaemissionoutputresult-version":      "The script version of the output",
+	"skaemissionoutputresult-scriptpubkey": "The hex-encoded output script",
+
+	// AuditSKASupplyCmd help.
+	"auditskasupply--synopsis": "Walks the UTXO set to independently compute the supply of every SKA coin type and compares it with the amounts emitted and burned according to the chain state, the emission index when it is enabled, and the max supply.\n" +
+		"The UTXO cache is flushed to the database in order to walk the full UTXO set, so the command may take a while to complete.\n" +
+		"A negative discrepancy may also be the result of sweeps of deactivated coin types since their value is retired.",
+
+	// AuditSKASupplyResult help.
+	"auditskasupplyresult-height":      "The height of the audited main chain tip",
+	"auditskasupplyresult-bestblock":   "The hash of the audited main chain tip",
+	"auditskasupplyresult-utxosethash": "The hash of the audited UTXO set",
+	"auditskasupplyresult-consistent":  "Whether or not all checks passed for all coin types",
+	"auditskasupplyresult-cointypes":   "The audits of the coin types ordered by coin type",
+
+	// SKASupplyAuditResult help.
+	"skasupplyauditresult-cointype":        "The SKA coin type",
+	"skasupplyauditresult-name":            "The name of the coin type",
+	"skasupplyauditresult-maxsupply":       "The maximum supply defined by the chain parameters in atoms",
+	"skasupplyauditresult-tranchesemitted": "The number of emission tranches emitted",
+	"skasupplyauditresult-emitted":         "The amount emitted according to the chain state in atoms",
+	"skasupplyauditresult-indexedemitted":  "The amount emitted according to the emission index in atoms (only when the emission index is enabled)",
+	"skasupplyauditresult-burned":          "The amount burned in atoms",
+	"skasupplyauditresult-expectedsupply":  "The amount emitted less the amount burned in atoms",
+	"skasupplyauditresult-utxosupply":      "The total of the unspent outputs of the coin type in atoms",
+	"skasupplyauditresult-utxocount":       "The number of unspent outputs of the coin type",
+	"skasupplyauditresult-discrepancy":     "The UTXO supply less the expected supply in atoms",
+	"skasupplyauditresult-issues":          "Descriptions of the checks that failed (omitted when all checks passed)",
+
+	// GetSupplyScanInfoCmd help.
+	"getsupplyscaninfo--synopsis": "Returns the progress of the background UTXO supply scan and the result of the most recently completed scan.\n" +
+		"Every scan verifies the amounts of all unspent outputs are within the bounds of their coin type and compares the supply of every SKA coin type with the amounts emitted and burned according to the chain state and the emission index.\n" +
+		"A supply below the expected supply may also be the result of sweeps of deactivated coin types since their value is retired.",
+
+	// GetSupplyScanInfoResult help.
+	"getsupplyscaninforesult-scanning":      "Whether or not a scan is in progress",
+	"getsupplyscaninforesult-scanned":       "The number of UTXO set entries checked by the scan in progress, or by the last scan when none is in progress",
+	"getsupplyscaninforesult-scans":         "The number of completed scans",
+	"getsupplyscaninforesult-lastscanstart": "The unix time the most recently completed scan started (0 when no scan completed yet)",
+	"getsupplyscaninforesult-lastscanend":   "The unix time the most recently completed scan ended (0 when no scan completed yet)",
+	"getsupplyscaninforesult-height":        "The height of the main chain block the UTXO set of the most recently completed scan corresponds to",
+	"getsupplyscaninforesult-bestblock":     "The hash of the main chain block the UTXO set of the most recently completed scan corresponds to",
+	"getsupplyscaninforesult-consistent":    "Whether or not the most recently completed scan found no divergence",
+	"getsupplyscaninforesult-cointypes":     "The results of the most recently completed scan ordered by coin type",
+	"getsupplyscaninforesult-lasterror":     "The error that caused the most recent scan to fail",
+
+	// SupplyScanCoinTypeResult help.
+	"supplyscancointyperesult-cointype":       "The coin type",
+	"supplyscancointyperesult-utxocount":      "The number of unspent outputs of the coin type",
+	"supplyscancointyperesult-utxosupply":     "The total of the unspent outputs of the coin type with amounts within bounds in atoms",
+	"supplyscancointyperesult-outofbounds":    "The number of unspent outputs with amounts that are negative or exceed the max amount of the coin type",
+	"supplyscancointyperesult-emitted":        "The amount emitted according to the chain state in atoms (SKA coin types only)",
+	"supplyscancointyperesult-indexedemitted": "The amount emitted according to the emission index in atoms (only when the index is synced to the scanned block)",
+	"supplyscancointyperesult-burned":         "The amount burned in atoms (SKA coin types only)",
+	"supplyscancointyperesult-expectedsupply": "The amount emitted less the amount burned in atoms (SKA coin types only)",
+	"supplyscancointyperesult-issues":         "Descriptions of the divergences found (omitted when none were found)",
+
+	// EncodeEmissionAuthCmd help.
+	"encodeemissionauth--synopsis": "Converts an emission authorization descriptor to the emission authorization script and the emission transaction it describes for the active network.\n" +
+		"The descriptor is the canonical JSON description of the authorization and the outputs of the emission, so it is the only data that has to be carried to and from an offline signer.\n" +
+		"The signature of signed descriptors is verified and the command fails when it is invalid.",
+	"encodeemissionauth-descriptor": `The JSON-encoded descriptor: {"cointype":n,"nonce":n,"amount":n,"height":n,"addresses":["address",...],"amounts":[n,...],"pubkey":"hex","signature":"hex"} with amounts in atoms and the signature omitted for unsigned descriptors`,
+
+	// EncodeEmissionAuthResult help.
+	"encodeemissionauthresult-descriptor": "The canonical JSON encoding of the descriptor",
+	"encodeemissionauthresult-authscript": "The hex-encoded emission authorization script",
+	"encodeemissionauthresult-hex":        "The hex-encoded emission transaction",
+	"encodeemissionauthresult-signed":     "Whether or not the descriptor is signed",
+
+	// DecodeEmissionAuthCmd help.
+	"decodeemissionauth--synopsis": "Returns the emission authorization descriptor of an emission authorization script.\n" +
+		"The outputs of the emission are not part of the script, so the addresses and amounts are empty and must be filled in before the descriptor can be encoded.",
+	"decodeemissionauth-hexscript": "The hex-encoded emission authorization script",
+
+	// DecodeEmissionAuthResult help.
+	"decodeemissionauthresult-cointype":  "The SKA coin type",
+	"decodeemissionauthresult-nonce":     "The emission nonce",
+	"decodeemissionauthresult-amount":    "The total amount emitted in atoms",
+	"decodeemissionauthresult-height":    "The height the emission is authorized for",
+	"decodeemissionauthresult-addresses": "The addresses paid by the emission (always empty)",
+	"decodeemissionauthresult-amounts":   "The amounts paid to the addresses in atoms (always empty)",
+	"decodeemissionauthresult-pubkey":    "The hex-encoded emission public key",
+	"decodeemissionauthresult-signature": "The hex-encoded emission signature (omitted when the script is unsigned)",
+
+	// GetEmissionKeysCmd help.
+	"getemissionkeys--synopsis": "Returns the emission key configured for each SKA coin type along with whether it is a known placeholder key.",
+
+	// GetEmissionKeysResult help.
+	"getemissionkeysresult-cointype":    "The SKA coin type",
+	"getemissionkeysresult-name":        "The name of the coin type",
+	"getemissionkeysresult-pubkey":      "The hex-encoded compressed emission public key",
+	"getemissionkeysresult-fingerprint": "The fingerprint of the emission public key (first four bytes of its hash160)",
+	"getemissionkeysresult-placeholder": "Whether the key is a known placeholder key that must be replaced before launch",
+
+	// AttestEmissionKeyCmd help.
+	"attestemissionkey--synopsis": "Verifies a signature over a challenge made with the emission key of an SKA coin type to prove custody of the key without emitting.\n" +
+		"The signature commits to the network and coin type along with the challenge so it can't be replayed elsewhere.",
+	"attestemissionkey-cointype":  "The SKA coin type whose emission key made the signature",
+	"attestemissionkey-challenge": "The hex-encoded challenge that was signed",
+	"attestemissionkey-signature": "The hex-encoded DER signature over the challenge",
+
+	// AttestEmissionKeyResult help.
+	"attestemissionkeyresult-cointype":    "The SKA coin type",
+	"attestemissionkeyresult-fingerprint": "The fingerprint of the emission public key",
+	"attestemissionkeyresult-valid":       "Whether the signature proves custody of the emission key",
+	"attestemissionkeyresult-reason":      "The reason the signature is invalid (omitted when valid)",
+
 	// GetCFilterV2Cmd help.
 	"getcfilterv2--synopsis": "Returns the version 2 block filter for the given block along with a proof that can be used to prove the filter is committed to by the block header",
 	"getcfilterv2-blockhash": "The block hash of the filter to retrieve",
@@ -442,6 +819,21 @@ var helpDescsEnUS = map[string]string{
 	"versionbits-version":                  "The version of the vote.",
 	"versionbits-bits":                     "The bits assigned by the vote.",
 
+	// GetValidationStatsCmd help.
+	"getvalidationstats--synopsis": "Returns the durations of each stage of block validation for the blocks processed since the server started.\n" +
+		"Block templates checked for mining are not included and the script validation stage is skipped for blocks that are ancestors of the assumed valid block.",
+
+	// GetValidationStatsResult help.
+	"getvalidationstatsresult-stages": "The durations of each validation stage",
+
+	// ValidationStageStats help.
+	"validationstagestats-stage":     "The name of the validation stage (transactionchecks, scriptvalidation, emissionchecks, allocationchecks, or indexupdates)",
+	"validationstagestats-count":     "The number of blocks that passed the stage",
+	"validationstagestats-totalms":   "The total duration of the stage in milliseconds",
+	"validationstagestats-averagems": "The average duration of the stage per block in milliseconds",
+	"validationstagestats-maxms":     "The longest duration of the stage in milliseconds",
+	"validationstagestats-lastms":    "The most recent duration of the stage in milliseconds",
+
 	// GetVoteInfo
 	"getvoteinfo--synopsis":           "Returns the vote info statistics.",
 	"getvoteinfo-version":             "The stake version.",
@@ -496,9 +888,68 @@ var helpDescsEnUS = map[string]string{
 	"getheaders-hashstop":      "Block hash to stop including block headers for. Set to zero to get as many blocks as possible",
 	"getheadersresult-headers": "Serialized block headers of all located blocks, limited to some arbitrary maximum number of hashes (currently 2000, which matches the wire protocol headers message, but this is not guaranteed)",
 
-	// GetInfoCmd help.
+	// GetIndexInfoCmd help.
+	"getindexinfo--synopsis": "Returns the sync progress of the indexes.",
+	"getindexinfo-name":      "Only return the sync progress of the index with this name",
+
+	// GetIndexInfoResult help.
+	"getindexinforesult-name":               "The human-readable name of the index",
+	"getindexinforesult-version":            "The current version of the index",
+	"getindexinforesult-tipheight":          "The height of the block the index is synced to",
+	"getindexinforesult-tiphash":            "The hash of the block the index is synced to",
+	"getindexinforesult-bestheight":         "The height of the current best block of the main chain",
+	"getindexinforesult-synced":             "Whether or not the index is synced to the current best block",
+	"getindexinforesult-progress":           "The percentage of the main chain blocks that have been indexed",
+	"getindexinforesult-estimatedremaining": "The estimated number of seconds until the index is synced based on the time recently taken to index blocks (0 when synced or unknown)",
+
 	"getinfo--synopsis": "Returns a JSON object containing various state info.",
 
+	// GetCoinTypesCmd help.
+	"getcointypes--synopsis": "Returns information about VAR and all configured SKA coin types, including their emission status and the minimum relay fee they currently accept.",
+
+	// GetCoinTypesResult help.
+	"getcointypesresult-cointype":       "The coin type number (0 for VAR, 1-255 for SKA)",
+	"getcointypesresult-name":           "The full name of the coin type",
+	"getcointypesresult-symbol":         "The trading symbol for the coin type",
+	"getcointypesresult-active":         "Whether the coin type is currently active",
+	"getcointypesresult-maxsupply":      "The maximum supply for the coin type in atoms",
+	"getcointypesresult-emissionheight": "The block height at which the next emission window begins, or the final window once all emissions took place (0 for VAR)",
+	"getcointypesresult-emissionwindow": "The number of blocks in the reported emission window (0 for VAR)",
+	"getcointypesresult-emissionstatus": "The emission status (subsidy for VAR, otherwise pending, active, expired or complete)",
+	"getcointypesresult-minrelayfee":    "The minimum fee rate in coins/kB currently accepted for relay of transactions of the coin type",
+
+	// GetCoinTypeSnapshotCmd help.
+	"getcointypesnapshot--synopsis": "Returns all unspent outputs of a coin type, or the balances of the addresses they pay, as of a main chain block in a deterministic order suitable for proof-of-reserve attestations.\n" +
+		"The UTXO cache is flushed to the database and the blocks after the requested height are undone with the spend journal while the chain is locked, so the command may take a while to complete for large coin types and deep heights.",
+	"getcointypesnapshot-cointype":  "The coin type of the outputs (0 for VAR, 1-255 for SKA)",
+	"getcointypesnapshot-height":    "The height of the main chain block as of which to take the snapshot (default: current tip)",
+	"getcointypesnapshot-aggregate": "Aggregate the outputs into balances by address instead of listing them individually",
+
+	// GetCoinTypeSnapshotResult help.
+	"getcointypesnapshotresult-cointype":  "The coin type of the snapshot",
+	"getcointypesnapshotresult-height":    "The height of the snapshot block",
+	"getcointypesnapshotresult-blockhash": "The hash of the snapshot block",
+	"getcointypesnapshotresult-total":     "The total amount of the unspent outputs in atoms",
+	"getcointypesnapshotresult-utxocount": "The number of unspent outputs",
+	"getcointypesnapshotresult-utxos":     "The unspent outputs ordered by transaction hash, tree, and output index (omitted when aggregated)",
+	"getcointypesnapshotresult-balances":  "The balances ordered by address and then by script (only when aggregated)",
+
+	// CoinTypeSnapshotUtxo help.
+	"cointypesnapshotutxo-txhash":       "The hash of the transaction that created the output",
+	"cointypesnapshotutxo-tree":         "The tree of the transaction that created the output",
+	"cointypesnapshotutxo-vout":         "The index of the output",
+	"cointypesnapshotutxo-height":       "The height of the block that created the output",
+	"cointypesnapshotutxo-amount":       "The amount of the output in atoms",
+	"cointypesnapshotutxo-version":      "The version of the output script",
+	"cointypesnapshotutxo-scriptpubkey": "The hex-encoded output script",
+	"cointypesnapshotutxo-address":      "The address paid by the output script (omitted when it does not pay exactly one address)",
+
+	// CoinTypeSnapshotBalance help.
+	"cointypesnapshotbalance-address":      "The address paid by the outputs (omitted for outputs that do not pay exactly one address)",
+	"cointypesnapshotbalance-scriptpubkey": "The hex-encoded output script of outputs that do not pay exactly one address",
+	"cointypesnapshotbalance-amount":       "The total amount of the outputs in atoms",
+	"cointypesnapshotbalance-utxos":        "The number of outputs",
+
 	// GetSKAInfoCmd help.
 	"getskainfo--synopsis": "Returns information about all configured SKA coin types.",
 
@@ -528,12 +979,63 @@ var helpDescsEnUS = map[string]string{
 	"getemissionstatusresult-maxsupply":         "The maximum supply for this coin type in atoms",
 	"getemissionstatusresult-circulatingsupply": "The current circulating supply in atoms (max supply minus burned), 0 if not yet emitted",
 
+	// GetEmissionRehearsalStatusCmd help.
+	"getemissionrehearsalstatus--synopsis": "Returns the progress of the rehearsed SKA emission for each coin type configured with the emission rehearsal coordinator.",
+
+	// EmissionRehearsalStatusResult help.
+	"emissionrehearsalstatusresult-cointype":    "The coin type number (1-255)",
+	"emissionrehearsalstatusresult-state":       "The rehearsal state (waiting, submitted, confirmed, failed, or expired)",
+	"emissionrehearsalstatusresult-windowstart": "The block height when the emission window starts",
+	"emissionrehearsalstatusresult-windowend":   "The block height when the emission window ends",
+	"emissionrehearsalstatusresult-nonce":       "The nonce committed to by the submitted emission transaction",
+	"emissionrehearsalstatusresult-txhash":      "The hash of the submitted emission transaction",
+	"emissionrehearsalstatusresult-height":      "The height of the most recent block processed by the coordinator",
+	"emissionrehearsalstatusresult-lasterror":   "The error from the most recent failed submission attempt",
+
+	// GetEmissionWatchStatusCmd help.
+	"getemissionwatchstatus--synopsis": "Returns the alert level of the next scheduled SKA emission for each coin type monitored by the emission watchtower.",
+
+	// EmissionWatchStatusResult help.
+	"emissionwatchstatusresult-cointype":    "The coin type number (1-255)",
+	"emissionwatchstatusresult-level":       "The alert level (none, pending, approaching, open, critical, or missed)",
+	"emissionwatchstatusresult-tranche":     "The 1-based index of the watched emission tranche",
+	"emissionwatchstatusresult-windowstart": "The block height when the emission window of the tranche starts",
+	"emissionwatchstatusresult-windowend":   "The block height when the emission window of the tranche ends",
+	"emissionwatchstatusresult-pendingtx":   "The hash of the emission transaction waiting in the mempool",
+	"emissionwatchstatusresult-height":      "The height of the most recent block processed by the watchtower",
+
 	// GetMempoolInfoCmd help.
 	"getmempoolinfo--synopsis": "Returns memory pool information",
 
 	// GetMempoolInfoResult help.
-	"getmempoolinforesult-bytes": "Size in bytes of the mempool",
-	"getmempoolinforesult-size":  "Number of transactions in the mempool",
+	"getmempoolinforesult-bytes":                  "Size in bytes of the mempool",
+	"getmempoolinforesult-size":                   "Number of transactions in the mempool",
+	"getmempoolinforesult-cointypes":              "Breakdown of the mempool by the primary coin type of each transaction keyed by coin type name",
+	"getmempoolinforesult-cointypes--desc":        "Mempool information keyed by coin type name",
+	"getmempoolinforesult-cointypes--key":         "Coin type name (e.g., 'VAR', 'SKA-1')",
+	"getmempoolinforesult-cointypes--value":       "Mempool information for the coin type",
+	"getmempoolinforesult-validationlanes":        "Metrics of the lanes transactions received from peers are validated in keyed by lane.  Votes and revocations are validated in the 'stake' lane ahead of all other transactions, which are validated in the 'regular' lane",
+	"getmempoolinforesult-validationlanes--desc":  "Validation lane metrics keyed by lane name",
+	"getmempoolinforesult-validationlanes--key":   "Lane name ('stake' or 'regular')",
+	"getmempoolinforesult-validationlanes--value": "Metrics of the lane",
+	"txvalidationlaneinfo-processed":              "Number of transactions validated by the lane since the node started",
+	"txvalidationlaneinfo-pending":                "Number of transactions waiting in the lane (an upper bound for the regular lane since it is shared with other messages)",
+	"txvalidationlaneinfo-avgwait":                "Average number of seconds transactions waited in the lane before being validated",
+	"txvalidationlaneinfo-maxwait":                "Maximum number of seconds a transaction waited in the lane before being validated",
+	"mempoolcointypeinfo-cointype":                "The numeric coin type",
+	"mempoolcointypeinfo-name":                    "The coin type name (e.g., 'VAR', 'SKA-1')",
+	"mempoolcointypeinfo-size":                    "Number of transactions of the coin type in the mempool",
+	"mempoolcointypeinfo-bytes":                   "Size in bytes of the transactions of the coin type",
+	"mempoolcointypeinfo-totalfees":               "Total fees paid by the transactions of the coin type in coins of the coin type",
+	"mempoolcointypeinfo-minfee":                  "Minimum fee rate per kB currently accepted for the coin type (omitted when coin-type-specific fees are unavailable)",
+	"mempoolcointypeinfo-mempoolminfee":           "Rolling minimum fee rate per kB regular transactions of the coin type must pay to enter the mempool after transactions were evicted to enforce its size limit.  It decays once blocks are connected (omitted when not in effect)",
+	"mempoolcointypeinfo-maxbytes":                "Maximum size in bytes of the regular transactions of the coin type the mempool may hold (0 when the size is not limited)",
+	"mempoolcointypeinfo-maxancestors":            "Maximum number of transactions a new regular transaction of the coin type and its unconfirmed ancestors in the mempool may number",
+	"mempoolcointypeinfo-maxancestorsize":         "Maximum size in bytes a new regular transaction of the coin type and its unconfirmed ancestors in the mempool may total",
+	"mempoolcointypeinfo-maxdescendants":          "Maximum number of transactions an unconfirmed regular transaction of the coin type and its unconfirmed descendants in the mempool may number",
+	"mempoolcointypeinfo-maxdescendantsize":       "Maximum size in bytes an unconfirmed regular transaction of the coin type and its unconfirmed descendants in the mempool may total",
+	"mempoolcointypeinfo-expiry":                  "Number of seconds a regular transaction of the coin type may remain in the mempool before it expires",
+	"mempoolcointypeinfo-expired":                 "Total number of regular transactions of the coin type evicted from the mempool for exceeding the expiry since the node started",
 
 	// GetMempoolFeesInfo help.
 	"getmempoolfeesinfo--synopsis":              "Returns detailed mempool fee analytics per coin type.",
@@ -575,6 +1077,13 @@ var helpDescsEnUS = map[string]string{
 	"getmininginforesult-networkhashps":    "Estimated network hashes per second for the most recent blocks",
 	"getmininginforesult-pooledtx":         "Number of transactions in the memory pool",
 	"getmininginforesult-testnet":          "Whether or not server is using testnet",
+	"getmininginforesult-templatebudget":   "Statistics about block templates delivered partially filled because their time budget was exhausted (only when block templates are generated)",
+
+	// TemplateBudgetResult help.
+	"templatebudgetresult-templates":        "The total number of block templates generated",
+	"templatebudgetresult-partialtemplates": "The number of block templates delivered partially filled because the time budget was exhausted",
+	"templatebudgetresult-skippedtxns":      "The total number of regular transactions not considered because the time budget was exhausted",
+	"templatebudgetresult-skippedaugments":  "The number of block templates whose SSFee transactions did not augment existing UTXOs because the time budget was exhausted",
 
 	// GetMiningInfoCmd help.
 	"getmininginfo--synopsis": "Returns a JSON object containing mining-related information.",
@@ -653,6 +1162,14 @@ var helpDescsEnUS = map[string]string{
 	"getpeerinforesult-currentheight":  "The current height of the peer",
 	"getpeerinforesult-banscore":       "The ban score",
 	"getpeerinforesult-syncnode":       "Whether or not the peer is the sync peer",
+	"getpeerinforesult-txrelaystats":   "Transaction relay statistics for the peer broken down by the primary coin type of the transactions (omitted if no transactions have been relayed)",
+
+	// PeerTxRelayStats help.
+	"peertxrelaystats-cointype":    "The coin type (0 = VAR, 1-255 = SKA types)",
+	"peertxrelaystats-txsent":      "Total number of transactions of the coin type sent to the peer",
+	"peertxrelaystats-txbytessent": "Total number of bytes of transactions of the coin type sent to the peer",
+	"peertxrelaystats-txrecv":      "Total number of transactions of the coin type received from the peer",
+	"peertxrelaystats-txbytesrecv": "Total number of bytes of transactions of the coin type received from the peer",
 
 	// GetPeerInfoCmd help.
 	"getpeerinfo--synopsis": "Returns data about each connected network peer as an array of json objects.",
@@ -682,6 +1199,25 @@ var helpDescsEnUS = map[string]string{
 	"getrawtransaction--condition1": "verbose=true",
 	"getrawtransaction--result0":    "Hex-encoded bytes of the serialized transaction",
 
+	// GetRecentUtilizationCmd help.
+	"getrecentutilization--synopsis": "Returns the block space utilization of each coin type in the most recent main chain blocks kept in the utilization history, newest first.\n" +
+		"The history is kept on disk, so it is available immediately after a restart.",
+	"getrecentutilization-count":    "The maximum number of blocks to return",
+	"getrecentutilization-cointype": "Only return the utilization of this coin type (0 for VAR, 1-255 for SKA variants)",
+
+	// GetRecentUtilizationResult help.
+	"getrecentutilizationresult-height":    "The height of the block",
+	"getrecentutilizationresult-hash":      "The hash of the block",
+	"getrecentutilizationresult-cointypes": "The utilization of each coin type that has transactions in the block or is allocated space in it",
+
+	// CoinTypeUtilizationResult help.
+	"cointypeutilizationresult-cointype":       "The coin type",
+	"cointypeutilizationresult-name":           "The name of the coin type",
+	"cointypeutilizationresult-numtxns":        "The number of transactions accounted against the coin type",
+	"cointypeutilizationresult-usedbytes":      "The number of bytes used by the transactions of the coin type",
+	"cointypeutilizationresult-allocatedbytes": "The number of bytes allocated to the coin type",
+	"cointypeutilizationresult-utilization":    "The fraction of the allocated bytes that was used (0 when no space was allocated)",
+
 	// GetTicketPoolValue help.
 	"getticketpoolvalue--synopsis": "Return the current value of all locked funds in the ticket pool",
 	"getticketpoolvalue--result0":  "Total value of ticket pool",
@@ -720,11 +1256,14 @@ var helpDescsEnUS = map[string]string{
 		"If empty, count votes for all tspends currently in the mempool.",
 
 	// GetTxOutResult help.
-	"gettxoutresult-bestblock":     "The block hash that contains the transaction output",
-	"gettxoutresult-confirmations": "The number of confirmations",
-	"gettxoutresult-value":         "The transaction amount in VAR",
-	"gettxoutresult-scriptPubKey":  "The public key script used to pay coins as a JSON object",
-	"gettxoutresult-coinbase":      "Whether or not the transaction is a coinbase",
+	"gettxoutresult-bestblock":      "The block hash that contains the transaction output",
+	"gettxoutresult-confirmations":  "The number of confirmations",
+	"gettxoutresult-value":          "The transaction amount in VAR",
+	"gettxoutresult-scriptPubKey":   "The public key script used to pay coins as a JSON object",
+	"gettxoutresult-coinbase":       "Whether or not the transaction is a coinbase",
+	"gettxoutresult-cointype":       "The coin type of the output (0 for VAR, 1-255 for SKA)",
+	"gettxoutresult-cointypeactive": "Whether or not the coin type of the output is active on the network",
+	"gettxoutresult-supplyfraction": "The fraction of the max supply of the SKA coin type the output represents (omitted for VAR)",
 
 	// GetTxOutCmd help.
 	"gettxout--synopsis":      "Returns information about an unspent transaction output.",
@@ -745,6 +1284,28 @@ var helpDescsEnUS = map[string]string{
 	"gettxoutsetinforesult-disksize":       "The size of the utxo set on disk, in bytes.",
 	"gettxoutsetinforesult-totalamount":    "The total value of the utxo set.",
 
+	// GetWatchOnlyBalanceCmd help.
+	"getwatchonlybalance--synopsis": "Returns the balances of the addresses watched by the watch-only index along with the total balance per coin type.\n" +
+		"Requires the watch-only index to be enabled via --watchonlyindex.",
+	"getwatchonlybalance-cointype": "Only report the balances of the provided coin type",
+	"getwatchonlybalance-minconf":  "The minimum number of confirmations of the outputs counted towards the balances",
+
+	// GetWatchOnlyBalanceResult help.
+	"getwatchonlybalanceresult-height":    "The height of the main chain tip the balances are reported for",
+	"getwatchonlybalanceresult-totals":    "The total balance of all watched addresses per coin type",
+	"getwatchonlybalanceresult-addresses": "The balance of every watched address per coin type",
+
+	// WatchOnlyCoinTypeBalance help.
+	"watchonlycointypebalance-cointype":  "The coin type (0 for VAR, 1-255 for SKA)",
+	"watchonlycointypebalance-balance":   "The total balance of the watched addresses of the coin type in coins",
+	"watchonlycointypebalance-utxocount": "The number of unspent outputs counted towards the balance",
+
+	// WatchOnlyAddressBalance help.
+	"watchonlyaddressbalance-address":   "The watched address",
+	"watchonlyaddressbalance-cointype":  "The coin type the address is watched for (0 for VAR, 1-255 for SKA)",
+	"watchonlyaddressbalance-balance":   "The balance of the address in coins",
+	"watchonlyaddressbalance-utxocount": "The number of unspent outputs counted towards the balance",
+
 	// GetWorkResult help.
 	"getworkresult-data":     "Hex-encoded block data",
 	"getworkresult-hash1":    "(DEPRECATED) Hex-encoded formatted hash buffer",
@@ -766,6 +1327,15 @@ var helpDescsEnUS = map[string]string{
 	"help--result0":    "List of commands",
 	"help--result1":    "Help for specified command",
 
+	// ImportWatchOnlyAddressCmd help.
+	"importwatchonlyaddress--synopsis": "Adds an address of a coin type to the addresses watched by the watch-only index.\n" +
+		"Requires the watch-only index to be enabled via --watchonlyindex.\n" +
+		"The rescan is performed before the command returns and may take a long time when starting at a low height.",
+	"importwatchonlyaddress-address":    "The address to watch",
+	"importwatchonlyaddress-cointype":   "The coin type to watch the address for (0 for VAR, 1-255 for SKA)",
+	"importwatchonlyaddress-rescan":     "Scan the main chain for outputs that paid to the address before it was imported",
+	"importwatchonlyaddress-rescanfrom": "The block height to start the rescan at",
+
 	// InvalidateBlockCmd help.
 	"invalidateblock--synopsis": "Permanently invalidates a block as if it had violated consensus rules.\n" +
 		"Use reconsiderblock to remove the invalid status.",
@@ -783,12 +1353,46 @@ var helpDescsEnUS = map[string]string{
 		"Any descendants that are neither themselves marked as having failed validation, nor descendants of another such block, are also made eligibile for best chain selection.",
 	"reconsiderblock-blockhash": "The hash of the block to reconsider",
 
+	// SendRawPackageCmd help.
+	"sendrawpackage--synopsis": "Submits a package of serialized, hex-encoded transactions to the local peer for acceptance together and relays them to the network.\n" +
+		"The package consists of a child transaction, which must be last, preceded by its unconfirmed parents such that every transaction only spends outputs of the transactions that precede it.\n" +
+		"All of the transactions must be regular transactions of the same coin type.\n" +
+		"The parents do not need to pay the minimum fee on their own as long as the fee rate of the package as a whole meets the minimum fee of the coin type.",
+	"sendrawpackage-hextxs":        "Serialized, hex-encoded signed transactions ordered with parents before their children",
+	"sendrawpackage-allowhighfees": "Whether or not to allow insanely high fees",
+	"sendrawpackage--result0":      "The hashes of the transactions accepted to the mempool",
+
 	// SendRawTransactionCmd help.
 	"sendrawtransaction--synopsis":     "Submits the serialized, hex-encoded transaction to the local peer and relays it to the network.",
 	"sendrawtransaction-hextx":         "Serialized, hex-encoded signed transaction",
 	"sendrawtransaction-allowhighfees": "Whether or not to allow insanely high fees (dcrd does not yet implement this parameter, so it has no effect)",
+	"sendrawtransaction-verbose":       "Returns JSON object that includes an allocation advisory when true or the hash of the transaction when false",
+	"sendrawtransaction--condition0":   "verbose=false",
+	"sendrawtransaction--condition1":   "verbose=true",
 	"sendrawtransaction--result0":      "The hash of the transaction",
 
+	// SendRawTransactionResult help.
+	"sendrawtransactionresult-txid":     "The hash of the transaction",
+	"sendrawtransactionresult-advisory": "Why the transaction is unlikely to confirm soon due to the block space allocated to its coin type (omitted when it is not expected to be delayed)",
+
+	// AllocationAdvisory help.
+	"allocationadvisory-reason":          "Why the transaction is unlikely to confirm soon",
+	"allocationadvisory-estimatedblocks": "The estimated number of blocks until the transaction is mined given the pending demand for its coin type (0 when no space is allocated to the coin type)",
+	"allocationadvisory-bucketsize":      "The number of bytes allocated to the coin type in the next block given the pending demand",
+	"allocationadvisory-bucketdemand":    "The number of pending bytes of the coin type including the transaction",
+	"allocationadvisory-utilization":     "The fraction of the block space allocated to the coin type used by recent blocks",
+
+	// SetBlockSpacePolicyCmd help.
+	"setblockspacepolicy--synopsis": "Changes the block space policy applied to the block templates generated by the node and regenerates the current template.\n" +
+		"Only the provided parts of the policy are changed and the change is logged.\n" +
+		"The policy is not part of the consensus rules, is not persisted across restarts, and may only restrict block templates further than the block space allocation blocks are validated against.",
+	"setblockspacepolicy-overflowstrategy":    "Which coin types may use the overflow space granted on top of their base allocation (proportional: all coin types, varonly: only VAR, none: no coin types)",
+	"setblockspacepolicy-stakereservebytes":   "The minimum number of bytes to reserve for the stake tree or 0 to only reserve the expected size of the stake transactions",
+	"setblockspacepolicy-cointypecaps":        "JSON object with numeric coin types as keys and the maximum number of bytes their transactions may use as values",
+	"setblockspacepolicy-cointypecaps--key":   "cointype",
+	"setblockspacepolicy-cointypecaps--value": "maxbytes",
+	"setblockspacepolicy-cointypecaps--desc":  "The numeric coin type as the key and the maximum number of bytes, or 0 to remove the cap, as the value",
+
 	// SetGenerateCmd help.
 	"setgenerate--synopsis":    "Set the server to generate coins (mine) or not.",
 	"setgenerate-generate":     "Use true to enable generation, false to disable it",
@@ -850,6 +1454,10 @@ var helpDescsEnUS = map[string]string{
 	"session--synopsis":       "Return details regarding a websocket client's current connection session.",
 	"sessionresult-sessionid": "The unique session ID for a client's websocket connection.",
 
+	// WaitForIndexSyncCmd help.
+	"waitforindexsync--synopsis": "Blocks until the index with the provided name is synced to the current best block and returns its sync progress.",
+	"waitforindexsync-name":      "The name of the index to wait for",
+
 	// NotifyNewTicketsCmd help
 	"notifynewtickets--synopsis": "Request notifications for whenever new tickets are found.",
 
@@ -860,7 +1468,12 @@ var helpDescsEnUS = map[string]string{
 	"notifyblocks--synopsis": "Request notifications for whenever a block is connected or disconnected from the main (best) chain.",
 
 	// NotifyWorkCmd help.
-	"notifywork--synopsis": "Request notifications for whenever a new block template is generated.",
+	"notifywork--synopsis": "Request notifications for whenever a new block template is generated.\n" +
+		"Specifying either of the optional parameters limits the notifications for templates that were only updated due to new transactions to those that materially changed the buckets of the relevant coin types since the last notification sent to the caller.\n" +
+		"A bucket changed materially when an SKA emission of its coin type was added or removed, when it became populated or empty, or when its top fee rate moved by more than the change percentage.\n" +
+		"Templates that were updated due to a new parent or new votes are always notified.",
+	"notifywork-cointypes":        "The coin types whose buckets are relevant to the caller (default: all coin types)",
+	"notifywork-feeratechangepct": "The percentage the top fee rate of a relevant bucket must move by to be considered a material change (default: 10 when coin types are specified)",
 
 	// StopNotifyBlocksCmd help.
 	"stopnotifyblocks--synopsis": "Cancel registered notifications for whenever a block is connected or disconnected from the main (best) chain.",
@@ -874,6 +1487,12 @@ var helpDescsEnUS = map[string]string{
 	// StopNotifyTSpendCmd help.
 	"stopnotifytspend--synopsis": "Cancel registered notifications for whenever a new tspend arrives in the mempool.",
 
+	// NotifyDoubleSpendsCmd help.
+	"notifydoublespends--synopsis": "Request notifications for whenever a transaction with valid signatures is rejected for attempting to spend coins already spent by transactions in the mempool.",
+
+	// StopNotifyDoubleSpendsCmd help.
+	"stopnotifydoublespends--synopsis": "Cancel registered notifications for whenever a transaction is rejected for attempting to double spend transactions in the mempool.",
+
 	// NotifyNewTransactionsCmd help.
 	"notifynewtransactions--synopsis": "Send either a txaccepted or a txacceptedverbose notification when a new transaction is accepted into the mempool.",
 	"notifynewtransactions-verbose":   "Specifies which type of notification to receive. If verbose is true, then the caller receives txacceptedverbose, otherwise the caller receives txaccepted",
@@ -907,11 +1526,41 @@ var helpDescsEnUS = map[string]string{
 	"rescannedblock-hash":         "The hash of the block containing matching transactions.",
 	"rescannedblock-transactions": "Array of hex-encoded bytes of the serialized matching transactions.",
 
+	// RescanCoinTypeCmd help.
+	"rescancointype--synopsis":   "Scan a range of main chain blocks for transactions of a single coin type that pay to or spend outputs paying to the provided addresses.\nBlocks are scanned concurrently and a rescancointypeblock notification is sent for each block with relevant transactions, in block order, before the command returns.",
+	"rescancointype-cointype":    "The coin type to scan for",
+	"rescancointype-addresses":   "The addresses to scan for outputs of the coin type",
+	"rescancointype-startheight": "The height of the first block to scan",
+	"rescancointype-endheight":   "The height of the last block to scan (default: the current best height)",
+
+	// RescanCoinTypeResult help.
+	"rescancointyperesult-cointype":         "The scanned coin type",
+	"rescancointyperesult-startheight":      "The height of the first scanned block",
+	"rescancointyperesult-endheight":        "The height of the last scanned block",
+	"rescancointyperesult-endhash":          "The hash of the last scanned block",
+	"rescancointyperesult-discoveredblocks": "The number of blocks with relevant transactions",
+	"rescancointyperesult-discoveredtxns":   "The number of relevant transactions",
+
 	// EstimateFee help.
 	"estimatefee--synopsis": "Returns the estimated fee in dcr/kb.",
 	"estimatefee-numblocks": "(unused)",
 	"estimatefee--result0":  "Estimated fee.",
 
+	// EstimateFeeAccuracyCmd help.
+	"estimatefeeaccuracy--synopsis": "Returns the accuracy of the coin type fee rate estimates by comparing the number of blocks mined transactions were predicted to take to confirm, given their fee rate when they entered the mempool, against the number of blocks they actually took.",
+	"estimatefeeaccuracy-cointype":  "Only return the accuracy for this coin type (0 for VAR, 1-255 for SKA variants)",
+
+	// EstimateFeeAccuracyResult help.
+	"estimatefeeaccuracyresult-cointype":          "The coin type",
+	"estimatefeeaccuracyresult-name":              "The name of the coin type",
+	"estimatefeeaccuracyresult-samples":           "The number of mined transactions measured",
+	"estimatefeeaccuracyresult-ontime":            "The number of transactions that confirmed within the predicted number of blocks",
+	"estimatefeeaccuracyresult-late":              "The number of transactions that took longer than predicted to confirm",
+	"estimatefeeaccuracyresult-ontimerate":        "The fraction of transactions that confirmed within the predicted number of blocks",
+	"estimatefeeaccuracyresult-avgpredictedconfs": "The average predicted number of blocks to confirm",
+	"estimatefeeaccuracyresult-avgactualconfs":    "The average actual number of blocks to confirm",
+	"estimatefeeaccuracyresult-meanabserror":      "The mean absolute difference between the predicted and actual number of blocks to confirm",
+
 	// EstimateSmartFee help.
 	"estimatesmartfee--synopsis":     "Returns the estimated fee using the historical fee data in dcr/kb.",
 	"estimatesmartfee-confirmations": "Estimate the fee rate a transaction requires so that it is mined in up to this number of blocks.",
@@ -950,6 +1599,53 @@ var helpDescsEnUS = map[string]string{
 	"getcoinsupply--synopsis": "Returns current total coin supply in atoms",
 	"getcoinsupply--result0":  "Current coin supply in atoms",
 
+	// ListKnownSKAAddressesCmd help.
+	"listknownskaaddresses--synopsis": "Returns the addresses the scheduled emission tranches of the configured SKA coin types pay to according to the chain parameters and loaded emission manifests, along with whether the watch-only index watches them.\n" +
+		"The node imports these addresses into the watch-only index on startup when it is enabled via --watchonlyindex.",
+	"listknownskaaddresses-cointype": "Only return the addresses of the provided coin type",
+
+	// ListKnownSKAAddressesResult help.
+	"listknownskaaddressesresult-cointype":        "The SKA coin type the address is paid by the emission",
+	"listknownskaaddressesresult-tranche":         "The index of the emission tranche in the emission schedule of the coin type",
+	"listknownskaaddressesresult-emissionheight":  "The height the emission window of the tranche starts at",
+	"listknownskaaddressesresult-address":         "The address the tranche pays to (omitted when the manifest of the tranche is not loaded or the output does not pay to a single address)",
+	"listknownskaaddressesresult-descriptor":      "The canonical output script descriptor the tranche pays to when it is specified by one instead of a plain address",
+	"listknownskaaddressesresult-amount":          "The amount the tranche pays to the address in coins",
+	"listknownskaaddressesresult-manifestmissing": "Whether the distribution of the tranche is pinned to an emission manifest that is not loaded",
+	"listknownskaaddressesresult-watched":         "Whether the watch-only index watches the address for the coin type",
+
+	// ListRebroadcastTxsCmd help.
+	"listrebroadcasttxs--synopsis": "Returns the transactions submitted through the RPC server that are periodically rebroadcast with exponential backoff until they are included in a block.",
+	"listrebroadcasttxs-cointype":  "Only return the transactions of the provided coin type",
+
+	// ListRebroadcastTxsResult help.
+	"listrebroadcasttxsresult-txid":        "The hash of the transaction",
+	"listrebroadcasttxsresult-cointype":    "The coin type of the transaction (0 for VAR, 1-255 for SKA)",
+	"listrebroadcasttxsresult-added":       "The time the transaction was submitted in seconds since 1 Jan 1970 GMT",
+	"listrebroadcasttxsresult-attempts":    "The number of times the transaction has been rebroadcast",
+	"listrebroadcasttxsresult-lastattempt": "The time the transaction was last rebroadcast in seconds since 1 Jan 1970 GMT",
+	"listrebroadcasttxsresult-nextattempt": "The time the transaction is next scheduled to be rebroadcast in seconds since 1 Jan 1970 GMT",
+	"listrebroadcasttxsresult-saturated":   "Whether or not the last scheduled rebroadcast was postponed because the block space allocated to the coin type was saturated",
+
+	// ListUnspentWatchOnlyCmd help.
+	"listunspentwatchonly--synopsis": "Returns the unspent outputs paying to the addresses watched by the watch-only index.\n" +
+		"Requires the watch-only index to be enabled via --watchonlyindex.",
+	"listunspentwatchonly-minconf":   "The minimum number of confirmations of the returned outputs",
+	"listunspentwatchonly-maxconf":   "The maximum number of confirmations of the returned outputs",
+	"listunspentwatchonly-addresses": "Only return the outputs paying to the provided addresses",
+	"listunspentwatchonly-cointype":  "Only return the outputs of the provided coin type",
+
+	// ListUnspentWatchOnlyResult help.
+	"listunspentwatchonlyresult-txid":          "The hash of the transaction that created the output",
+	"listunspentwatchonlyresult-vout":          "The index of the output in the transaction",
+	"listunspentwatchonlyresult-tree":          "The tree of the transaction that created the output",
+	"listunspentwatchonlyresult-address":       "The watched address the output pays to",
+	"listunspentwatchonlyresult-cointype":      "The coin type of the output (0 for VAR, 1-255 for SKA)",
+	"listunspentwatchonlyresult-amount":        "The amount of the output in coins",
+	"listunspentwatchonlyresult-scriptpubkey":  "The hex-encoded public key script of the output",
+	"listunspentwatchonlyresult-blockheight":   "The height of the block that contains the output",
+	"listunspentwatchonlyresult-confirmations": "The number of confirmations of the output",
+
 	// LiveTickets help.
 	"livetickets--synopsis":     "Returns live ticket hashes from the ticket database",
 	"liveticketsresult-tickets": "List of live tickets",
@@ -959,6 +1655,44 @@ var helpDescsEnUS = map[string]string{
 	"ticketbucket-tickets":    "Number of tickets in bucket.",
 	"ticketbucket-number":     "Bucket number.",
 
+	// TestBlockAssemblyCmd help.
+	"testblockassembly--synopsis":               "Reports how the provided transactions would be packed into a hypothetical next block given the block space the allocator grants each coin type, without considering any other mempool transactions.",
+	"testblockassembly-txs":                     "The transactions to pack, each either the hash of a mempool transaction or a serialized, hex-encoded transaction",
+	"testblockassemblyresult-height":            "The height of the hypothetical block",
+	"testblockassemblyresult-totalbytes":        "The serialized size of the block including the block header",
+	"testblockassemblyresult-cointypes":         "The packing summary of each coin type of the provided transactions",
+	"testblockassemblyresult-transactions":      "The packing outcome of each transaction in the order provided",
+	"testblockassemblycointyperesult-cointype":  "The coin type",
+	"testblockassemblycointyperesult-name":      "The name of the coin type",
+	"testblockassemblycointyperesult-demand":    "The number of bytes of provided transactions of the coin type",
+	"testblockassemblycointyperesult-allocated": "The number of bytes allocated to the coin type in the block",
+	"testblockassemblycointyperesult-used":      "The number of bytes of included transactions of the coin type",
+	"testblockassemblycointyperesult-included":  "The hashes of the included transactions of the coin type",
+	"testblockassemblycointyperesult-deferred":  "The hashes of the deferred transactions of the coin type",
+	"testblockassemblytxresult-txid":            "The hash of the transaction",
+	"testblockassemblytxresult-cointype":        "The coin type the transaction is accounted against",
+	"testblockassemblytxresult-size":            "The serialized size of the transaction in bytes",
+	"testblockassemblytxresult-feerate":         "The fee rate in coins of the coin type per kB",
+	"testblockassemblytxresult-included":        "Whether or not the transaction would be included in the block",
+	"testblockassemblytxresult-reason":          "The reason the transaction would be deferred (only when not included)",
+
+	// TestMempoolAcceptCmd help.
+	"testmempoolaccept--synopsis":             "Tests whether the serialized, hex-encoded transaction would be accepted to the mempool without submitting it and estimates when it would be mined given the block space allocated to its coin type.",
+	"testmempoolaccept-hextx":                 "Serialized, hex-encoded signed transaction",
+	"testmempoolaccept-allowhighfees":         "Whether or not to allow insanely high fees",
+	"testmempoolacceptresult-txid":            "The hash of the transaction",
+	"testmempoolacceptresult-allowed":         "Whether or not the transaction would be accepted to the mempool",
+	"testmempoolacceptresult-rejectreason":    "The reason the transaction would be rejected (only when not allowed)",
+	"testmempoolacceptresult-cointype":        "The primary coin type of the transaction",
+	"testmempoolacceptresult-size":            "The serialized size of the transaction in bytes",
+	"testmempoolacceptresult-fee":             "The fee paid in coins of the primary coin type",
+	"testmempoolacceptresult-feerate":         "The fee rate in coins of the primary coin type per kB",
+	"testmempoolacceptresult-bucketsize":      "The number of bytes allocated to the coin type in the next block given the pending demand",
+	"testmempoolacceptresult-bytesahead":      "The number of pending bytes of the coin type that pay an equal or higher fee rate",
+	"testmempoolacceptresult-fitsnextblock":   "Whether or not the transaction fits in the space allocated to its coin type in the next block",
+	"testmempoolacceptresult-estimatedblocks": "The estimated number of blocks until the transaction is mined (0 when no space is allocated to the coin type)",
+	"testmempoolacceptresult-advisory":        "Why the transaction is unlikely to confirm soon due to the block space allocated to its coin type (omitted when it is not expected to be delayed)",
+
 	// TicketFeeInfo help.
 	"ticketfeeinfo--synopsis":            "Get various information about ticket fees from the mempool, blocks, and difficulty windows (units: VAR/kB)",
 	"ticketfeeinfo-blocks":               "The number of blocks, starting from the chain tip and descending, to return fee information about",
@@ -1033,98 +1767,144 @@ var helpDescsEnUS = map[string]string{
 
 	// regentemplate help
 	"regentemplate--synopsis": "Asks the node to regenerate its block mining template.",
+
+	// RemoveWatchOnlyAddressCmd help.
+	"removewatchonlyaddress--synopsis": "Removes an address of a coin type from the addresses watched by the watch-only index along with all of its tracked outputs.\n" +
+		"Requires the watch-only index to be enabled via --watchonlyindex.",
+	"removewatchonlyaddress-address":  "The address to stop watching",
+	"removewatchonlyaddress-cointype": "The coin type the address is watched for",
+	"removewatchonlyaddress--result0": "Whether or not the address was watched",
 }
 
 // rpcResultTypes specifies the result types that each RPC command can return.
 // This information is used to generate the help.  Each result type must be a
 // pointer to the type (or nil to indicate no return value).
 var rpcResultTypes = map[types.Method][]interface{}{
-	"addnode":                  nil,
-	"createrawssrtx":           {(*string)(nil)},
-	"createrawsstx":            {(*string)(nil)},
-	"createrawtransaction":     {(*string)(nil)},
-	"debuglevel":               {(*string)(nil), (*string)(nil)},
-	"decoderawtransaction":     {(*types.TxRawDecodeResult)(nil)},
-	"decodescript":             {(*types.DecodeScriptResult)(nil)},
-	"estimatefee":              {(*float64)(nil)},
-	"estimatesmartfee":         {(*types.EstimateSmartFeeResult)(nil)},
-	"estimatestakediff":        {(*types.EstimateStakeDiffResult)(nil)},
-	"existsaddress":            {(*bool)(nil)},
-	"existsaddresses":          {(*string)(nil)},
-	"existsliveticket":         {(*bool)(nil)},
-	"existslivetickets":        {(*string)(nil)},
-	"existsmempooltxs":         {(*string)(nil)},
-	"generate":                 {(*[]string)(nil)},
-	"getaddednodeinfo":         {(*[]string)(nil), (*[]types.GetAddedNodeInfoResult)(nil)},
-	"getbestblock":             {(*types.GetBestBlockResult)(nil)},
-	"getbestblockhash":         {(*string)(nil)},
-	"getblock":                 {(*string)(nil), (*types.GetBlockVerboseResult)(nil)},
-	"getblockchaininfo":        {(*types.GetBlockChainInfoResult)(nil)},
-	"getblockcount":            {(*int64)(nil)},
-	"getblockhash":             {(*string)(nil)},
-	"getblockheader":           {(*string)(nil), (*types.GetBlockHeaderVerboseResult)(nil)},
-	"getblocksubsidy":          {(*types.GetBlockSubsidyResult)(nil)},
-	"getburnedcoins":           {(*types.GetBurnedCoinsResult)(nil)},
-	"getcfilterv2":             {(*types.GetCFilterV2Result)(nil)},
-	"getchaintips":             {(*[]types.GetChainTipsResult)(nil)},
-	"getcoinsupply":            {(*int64)(nil)},
-	"getconnectioncount":       {(*int32)(nil)},
-	"getcurrentnet":            {(*uint32)(nil)},
-	"getdifficulty":            {(*float64)(nil)},
-	"getfeestimatesbycointype": {(*types.GetFeeResult)(nil)},
-	"getgenerate":              {(*bool)(nil)},
-	"gethashespersec":          {(*float64)(nil)},
-	"getheaders":               {(*types.GetHeadersResult)(nil)},
-	"getinfo":                  {(*types.InfoChainResult)(nil)},
-	"getskainfo":               {(*[]types.GetSKAInfoResult)(nil)},
-	"getemissionstatus":        {(*types.GetEmissionStatusResult)(nil)},
-	"getmempoolinfo":           {(*types.GetMempoolInfoResult)(nil)},
-	"getmempoolfeesinfo":       {(*types.GetMempoolFeesInfoResult)(nil)},
-	"getmininginfo":            {(*types.GetMiningInfoResult)(nil)},
-	"getmixmessage":            {(*types.GetMixMessageResult)(nil)},
-	"getmixpairrequests":       {(*[]string)(nil)},
-	"getnettotals":             {(*types.GetNetTotalsResult)(nil)},
-	"getnetworkhashps":         {(*int64)(nil)},
-	"getnetworkinfo":           {(*[]types.GetNetworkInfoResult)(nil)},
-	"getpeerinfo":              {(*[]types.GetPeerInfoResult)(nil)},
-	"getrawmempool":            {(*[]string)(nil), (*types.GetRawMempoolVerboseResult)(nil)},
-	"getrawtransaction":        {(*string)(nil), (*types.TxRawResult)(nil)},
-	"getstakedifficulty":       {(*types.GetStakeDifficultyResult)(nil)},
-	"getstakeversioninfo":      {(*types.GetStakeVersionInfoResult)(nil)},
-	"getstakeversions":         {(*types.GetStakeVersionsResult)(nil)},
-	"getticketpoolvalue":       {(*float64)(nil)},
-	"gettreasurybalance":       {(*types.GetTreasuryBalanceResult)(nil)},
-	"gettreasuryspendvotes":    {(*types.GetTreasurySpendVotesResult)(nil)},
-	"gettxout":                 {(*types.GetTxOutResult)(nil)},
-	"gettxoutsetinfo":          {(*types.GetTxOutSetInfoResult)(nil)},
-	"getvoteinfo":              {(*types.GetVoteInfoResult)(nil)},
-	"getwork":                  {(*types.GetWorkResult)(nil), (*bool)(nil)},
-	"help":                     {(*string)(nil), (*string)(nil)},
-	"invalidateblock":          nil,
-	"livetickets":              {(*types.LiveTicketsResult)(nil)},
-	"node":                     nil,
-	"ping":                     nil,
-	"reconsiderblock":          nil,
-	"regentemplate":            nil,
-	"sendrawmixmessage":        nil,
-	"sendrawtransaction":       {(*string)(nil)},
-	"setgenerate":              nil,
-	"startprofiler":            {(*types.StartProfilerResult)(nil)},
-	"stop":                     {(*string)(nil)},
-	"stopprofiler":             {(*string)(nil)},
-	"submitblock":              {nil, (*string)(nil)},
-	"ticketfeeinfo":            {(*types.TicketFeeInfoResult)(nil)},
-	"ticketsforaddress":        {(*types.TicketsForAddressResult)(nil)},
-	"ticketvwap":               {(*float64)(nil)},
-	"txfeeinfo":                {(*types.TxFeeInfoResult)(nil)},
-	"validateaddress":          {(*types.ValidateAddressChainResult)(nil)},
-	"verifychain":              {(*bool)(nil)},
-	"verifymessage":            {(*bool)(nil)},
-	"version":                  {(*map[string]types.VersionResult)(nil)},
+	"abandonrebroadcasttx":       nil,
+	"addnode":                    nil,
+	"attestemissionkey":          {(*types.AttestEmissionKeyResult)(nil)},
+	"auditskasupply":             {(*types.AuditSKASupplyResult)(nil)},
+	"bumpfeeestimate":            {(*types.BumpFeeEstimateResult)(nil)},
+	"getsupplyscaninfo":          {(*types.GetSupplyScanInfoResult)(nil)},
+	"createrawssrtx":             {(*string)(nil)},
+	"createrawsstx":              {(*string)(nil)},
+	"createrawtransaction":       {(*string)(nil)},
+	"createsweeptransaction":     {(*types.FundRawTransactionResult)(nil)},
+	"debuglevel":                 {(*string)(nil), (*string)(nil)},
+	"decoderawtransaction":       {(*types.TxRawDecodeResult)(nil)},
+	"decodescript":               {(*types.DecodeScriptResult)(nil)},
+	"decodeemissionauth":         {(*types.DecodeEmissionAuthResult)(nil)},
+	"encodeemissionauth":         {(*types.EncodeEmissionAuthResult)(nil)},
+	"estimatefee":                {(*float64)(nil)},
+	"estimatefeeaccuracy":        {(*[]types.EstimateFeeAccuracyResult)(nil)},
+	"estimatesmartfee":           {(*types.EstimateSmartFeeResult)(nil)},
+	"estimatestakediff":          {(*types.EstimateStakeDiffResult)(nil)},
+	"existsaddress":              {(*bool)(nil)},
+	"existsaddresses":            {(*string)(nil)},
+	"existsliveticket":           {(*bool)(nil)},
+	"existslivetickets":          {(*string)(nil)},
+	"existsmempooltxs":           {(*string)(nil)},
+	"fundrawtransaction":         {(*types.FundRawTransactionResult)(nil)},
+	"generate":                   {(*[]string)(nil)},
+	"getaddednodeinfo":           {(*[]string)(nil), (*[]types.GetAddedNodeInfoResult)(nil)},
+	"getaddressreceived":         {(*types.GetAddressReceivedResult)(nil)},
+	"getallocationdivergence":    {(*types.GetAllocationDivergenceResult)(nil)},
+	"getbestblock":               {(*types.GetBestBlockResult)(nil)},
+	"getbestblockhash":           {(*string)(nil)},
+	"getblock":                   {(*string)(nil), (*types.GetBlockVerboseResult)(nil)},
+	"getblockchaininfo":          {(*types.GetBlockChainInfoResult)(nil)},
+	"getblockcount":              {(*int64)(nil)},
+	"getblockhash":               {(*string)(nil)},
+	"getblockheader":             {(*string)(nil), (*types.GetBlockHeaderVerboseResult)(nil)},
+	"getblockspacepolicy":        {(*types.GetBlockSpacePolicyResult)(nil)},
+	"getblockspends":             {(*types.GetBlockSpendsResult)(nil)},
+	"getblockstats":              {(*types.GetBlockStatsResult)(nil)},
+	"getblocksubsidy":            {(*types.GetBlockSubsidyResult)(nil)},
+	"getblocktemplate":           {(*types.GetBlockTemplateProposalResult)(nil)},
+	"getburnedcoins":             {(*types.GetBurnedCoinsResult)(nil)},
+	"getcfilterv2":               {(*types.GetCFilterV2Result)(nil)},
+	"getchaintips":               {(*[]types.GetChainTipsResult)(nil)},
+	"getcoinsupply":              {(*int64)(nil)},
+	"getcointypes":               {(*[]types.GetCoinTypesResult)(nil)},
+	"getcointypesnapshot":        {(*types.GetCoinTypeSnapshotResult)(nil)},
+	"getconnectioncount":         {(*int32)(nil)},
+	"getcurrentnet":              {(*uint32)(nil)},
+	"getdifficulty":              {(*float64)(nil)},
+	"getfeestimatesbycointype":   {(*types.GetFeeResult)(nil)},
+	"getgenerate":                {(*bool)(nil)},
+	"gethashespersec":            {(*float64)(nil)},
+	"getheaders":                 {(*types.GetHeadersResult)(nil)},
+	"getindexinfo":               {(*[]types.GetIndexInfoResult)(nil)},
+	"getinfo":                    {(*types.InfoChainResult)(nil)},
+	"getskaburns":                {(*types.GetSKABurnsResult)(nil)},
+	"getskaemissions":            {(*types.GetSKAEmissionsResult)(nil)},
+	"getskaemissionstatus":       {(*types.GetSKAEmissionStatusResult)(nil)},
+	"getskaactivationtimeline":   {(*types.GetSKAActivationTimelineResult)(nil)},
+	"getskainfo":                 {(*[]types.GetSKAInfoResult)(nil)},
+	"getemissionkeys":            {(*[]types.GetEmissionKeysResult)(nil)},
+	"getemissionstatus":          {(*types.GetEmissionStatusResult)(nil)},
+	"getemissionrehearsalstatus": {(*[]types.EmissionRehearsalStatusResult)(nil)},
+	"getemissionwatchstatus":     {(*[]types.EmissionWatchStatusResult)(nil)},
+	"getmempoolinfo":             {(*types.GetMempoolInfoResult)(nil)},
+	"getmempoolfeesinfo":         {(*types.GetMempoolFeesInfoResult)(nil)},
+	"getmininginfo":              {(*types.GetMiningInfoResult)(nil)},
+	"getmixmessage":              {(*types.GetMixMessageResult)(nil)},
+	"getmixpairrequests":         {(*[]string)(nil)},
+	"getnettotals":               {(*types.GetNetTotalsResult)(nil)},
+	"getnetworkhashps":           {(*int64)(nil)},
+	"getnetworkinfo":             {(*[]types.GetNetworkInfoResult)(nil)},
+	"getpeerinfo":                {(*[]types.GetPeerInfoResult)(nil)},
+	"getrawmempool":              {(*[]string)(nil), (*types.GetRawMempoolVerboseResult)(nil)},
+	"getrawtransaction":          {(*string)(nil), (*types.TxRawResult)(nil)},
+	"getrecentutilization":       {(*[]types.GetRecentUtilizationResult)(nil)},
+	"getstakedifficulty":         {(*types.GetStakeDifficultyResult)(nil)},
+	"getstakeversioninfo":        {(*types.GetStakeVersionInfoResult)(nil)},
+	"getstakeversions":           {(*types.GetStakeVersionsResult)(nil)},
+	"getticketpoolvalue":         {(*float64)(nil)},
+	"gettreasurybalance":         {(*types.GetTreasuryBalanceResult)(nil)},
+	"gettreasuryspendvotes":      {(*types.GetTreasurySpendVotesResult)(nil)},
+	"gettxout":                   {(*types.GetTxOutResult)(nil)},
+	"gettxoutsetinfo":            {(*types.GetTxOutSetInfoResult)(nil)},
+	"getvalidationstats":         {(*types.GetValidationStatsResult)(nil)},
+	"getvoteinfo":                {(*types.GetVoteInfoResult)(nil)},
+	"getwatchonlybalance":        {(*types.GetWatchOnlyBalanceResult)(nil)},
+	"getwork":                    {(*types.GetWorkResult)(nil), (*bool)(nil)},
+	"help":                       {(*string)(nil), (*string)(nil)},
+	"importwatchonlyaddress":     nil,
+	"invalidateblock":            nil,
+	"listknownskaaddresses":      {(*[]types.ListKnownSKAAddressesResult)(nil)},
+	"listrebroadcasttxs":         {(*[]types.ListRebroadcastTxsResult)(nil)},
+	"listunspentwatchonly":       {(*[]types.ListUnspentWatchOnlyResult)(nil)},
+	"livetickets":                {(*types.LiveTicketsResult)(nil)},
+	"node":                       nil,
+	"ping":                       nil,
+	"reconsiderblock":            nil,
+	"regentemplate":              nil,
+	"removewatchonlyaddress":     {(*bool)(nil)},
+	"sendrawmixmessage":          nil,
+	"sendrawpackage":             {(*[]string)(nil)},
+	"sendrawtransaction":         {(*string)(nil), (*types.SendRawTransactionResult)(nil)},
+	"setblockspacepolicy":        {(*types.GetBlockSpacePolicyResult)(nil)},
+	"setgenerate":                nil,
+	"startprofiler":              {(*types.StartProfilerResult)(nil)},
+	"stop":                       {(*string)(nil)},
+	"stopprofiler":               {(*string)(nil)},
+	"submitblock":                {nil, (*string)(nil)},
+	"testblockassembly":          {(*types.TestBlockAssemblyResult)(nil)},
+	"testmempoolaccept":          {(*types.TestMempoolAcceptResult)(nil)},
+	"ticketfeeinfo":              {(*types.TicketFeeInfoResult)(nil)},
+	"ticketsforaddress":          {(*types.TicketsForAddressResult)(nil)},
+	"ticketvwap":                 {(*float64)(nil)},
+	"txfeeinfo":                  {(*types.TxFeeInfoResult)(nil)},
+	"validateaddress":            {(*types.ValidateAddressChainResult)(nil)},
+	"verifychain":                {(*bool)(nil)},
+	"verifymessage":              {(*bool)(nil)},
+	"version":                    {(*map[string]types.VersionResult)(nil)},
 
 	// Websocket commands.
 	"loadtxfilter":              nil,
 	"notifyblocks":              nil,
+	"notifydoublespends":        nil,
 	"notifymixmessages":         nil,
 	"notifynewtickets":          nil,
 	"notifynewtransactions":     nil,
@@ -1133,12 +1913,15 @@ var rpcResultTypes = map[types.Method][]interface{}{
 	"notifywork":                nil,
 	"rebroadcastwinners":        nil,
 	"rescan":                    {(*types.RescanResult)(nil)},
+	"rescancointype":            {(*types.RescanCoinTypeResult)(nil)},
 	"session":                   {(*types.SessionResult)(nil)},
 	"stopnotifyblocks":          nil,
+	"stopnotifydoublespends":    nil,
 	"stopnotifymixmessages":     nil,
 	"stopnotifynewtransactions": nil,
 	"stopnotifytspend":          nil,
 	"stopnotifywork":            nil,
+	"waitforindexsync":          {(*types.GetIndexInfoResult)(nil)},
 }
 
 // helpCacher provides a concurrent safe type that provides help and usage for
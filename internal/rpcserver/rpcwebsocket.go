@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"runtime"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -23,6 +24,7 @@ import (
 	"github.com/monetarium/monetarium-node/blockchain/standalone"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/crypto/rand"
 	"github.com/monetarium/monetarium-node/crypto/ripemd160"
 	"github.com/monetarium/monetarium-node/dcrjson"
@@ -55,6 +57,10 @@ const (
 	// websocketPongTimeout is the maximum amount of time attempts to respond to
 	// websocket ping messages with a pong will wait before giving up.
 	websocketPongTimeout = time.Second * 5
+
+	// rescanCoinTypeBatchSize is the number of blocks a rescancointype request
+	// scans concurrently before the results are reduced in block order.
+	rescanCoinTypeBatchSize = 64
 )
 
 type semaphore chan struct{}
@@ -84,18 +90,22 @@ var wsHandlersBeforeInit = map[types.Method]wsCommandHandler{
 	"notifyblocks":              handleNotifyBlocks,
 	"notifywork":                handleNotifyWork,
 	"notifytspend":              handleNotifyTSpend,
+	"notifydoublespends":        handleNotifyDoubleSpends,
 	"notifywinningtickets":      handleWinningTickets,
 	"notifynewtickets":          handleNewTickets,
 	"notifynewtransactions":     handleNotifyNewTransactions,
 	"notifymixmessages":         handleNotifyMixMessages,
 	"rebroadcastwinners":        handleRebroadcastWinners,
 	"rescan":                    handleRescan,
+	"rescancointype":            handleRescanCoinType,
 	"session":                   handleSession,
 	"stopnotifyblocks":          handleStopNotifyBlocks,
 	"stopnotifywork":            handleStopNotifyWork,
 	"stopnotifytspend":          handleStopNotifyTSpend,
+	"stopnotifydoublespends":    handleStopNotifyDoubleSpends,
 	"stopnotifynewtransactions": handleStopNotifyNewTransactions,
 	"stopnotifymixmessages":     handleStopNotifyMixMessages,
+	"waitforindexsync":          handleWaitForIndexSync,
 }
 
 // WebsocketHandler handles a new websocket client by creating a new wsClient,
@@ -103,7 +113,7 @@ var wsHandlersBeforeInit = map[types.Method]wsCommandHandler{
 // must be run in a separate goroutine.  It should be invoked from the websocket
 // server handler which runs each new connection in a new goroutine thereby
 // satisfying the requirement.
-func (s *Server) WebsocketHandler(ctx context.Context, conn *websocket.Conn, remoteAddr string, authenticated bool, isAdmin bool) {
+func (s *Server) WebsocketHandler(ctx context.Context, conn *websocket.Conn, remoteAddr string, authenticated bool, scopes AuthScope) {
 	// Clear the read deadline that was set before the websocket hijacked
 	// the connection.
 	conn.SetReadDeadline(timeZeroVal)
@@ -121,7 +131,7 @@ func (s *Server) WebsocketHandler(ctx context.Context, conn *websocket.Conn, rem
 	// Create a new websocket client to handle the new websocket connection
 	// and wait for it to shutdown.  Once it has shutdown (and hence
 	// disconnected), remove it and any notifications it registered for.
-	client, err := newWebsocketClient(s, conn, remoteAddr, authenticated, isAdmin)
+	client, err := newWebsocketClient(s, conn, remoteAddr, authenticated, scopes)
 	if err != nil {
 		log.Errorf("Failed to serve client %s: %v", remoteAddr, err)
 		conn.Close()
@@ -238,6 +248,21 @@ func (m *wsNotificationManager) NotifyTSpend(tx *dcrutil.Tx) {
 	}
 }
 
+// NotifyDoubleSpend passes transactions rejected for double spending coins
+// spent by the passed conflicting mempool transactions for double spend
+// notification processing.
+func (m *wsNotificationManager) NotifyDoubleSpend(tx *dcrutil.Tx, coinType cointype.CoinType, conflicts []chainhash.Hash) {
+	n := &notificationDoubleSpend{
+		tx:        tx,
+		coinType:  coinType,
+		conflicts: conflicts,
+	}
+	select {
+	case m.queueNotification <- n:
+	case <-m.quit:
+	}
+}
+
 // NotifyReorganization passes a blockchain reorganization notification for
 // reorganization notification processing.
 func (m *wsNotificationManager) NotifyReorganization(rd *blockchain.ReorganizationNtfnsData) {
@@ -247,6 +272,20 @@ func (m *wsNotificationManager) NotifyReorganization(rd *blockchain.Reorganizati
 	}
 }
 
+// NotifySKAEmissionsFinal passes SKA emissions in a main chain block that
+// reached the configured number of confirmations to the notification manager
+// for block notification processing.
+func (m *wsNotificationManager) NotifySKAEmissionsFinal(block *dcrutil.Block, emissions []blockchain.SKAEmissionRecord) {
+	n := &notificationSKAEmissionsFinal{
+		block:     block,
+		emissions: emissions,
+	}
+	select {
+	case m.queueNotification <- n:
+	case <-m.quit:
+	}
+}
+
 // NotifyWinningTickets passes newly winning tickets for an incoming block
 // to the notification manager for further processing.
 func (m *wsNotificationManager) NotifyWinningTickets(wtnd *WinningTicketsNtfnData) {
@@ -413,7 +452,16 @@ type notificationBlockConnected dcrutil.Block
 type notificationBlockDisconnected dcrutil.Block
 type notificationWork mining.TemplateNtfn
 type notificationTSpend dcrutil.Tx
+type notificationDoubleSpend struct {
+	tx        *dcrutil.Tx
+	coinType  cointype.CoinType
+	conflicts []chainhash.Hash
+}
 type notificationReorganization blockchain.ReorganizationNtfnsData
+type notificationSKAEmissionsFinal struct {
+	block     *dcrutil.Block
+	emissions []blockchain.SKAEmissionRecord
+}
 type notificationWinningTickets WinningTicketsNtfnData
 type notificationNewTickets blockchain.TicketNotificationsData
 type notificationTxAcceptedByMempool struct {
@@ -427,10 +475,12 @@ type notificationRegisterClient wsClient
 type notificationUnregisterClient wsClient
 type notificationRegisterBlocks wsClient
 type notificationUnregisterBlocks wsClient
-type notificationRegisterWork wsClient
+type notificationRegisterWork workSubscription
 type notificationUnregisterWork wsClient
 type notificationRegisterTSpend wsClient
 type notificationUnregisterTSpend wsClient
+type notificationRegisterDoubleSpends wsClient
+type notificationUnregisterDoubleSpends wsClient
 type notificationRegisterWinningTickets wsClient
 type notificationUnregisterWinningTickets wsClient
 type notificationRegisterNewTickets wsClient
@@ -454,8 +504,9 @@ func (m *wsNotificationManager) notificationHandler(ctx context.Context) {
 	// Where possible, the quit channel is used as the unique id for a client
 	// since it is quite a bit more efficient than using the entire struct.
 	blockNotifications := make(map[chan struct{}]*wsClient)
-	workNotifications := make(map[chan struct{}]*wsClient)
+	workNotifications := make(map[chan struct{}]*workSubscription)
 	tspendNotifications := make(map[chan struct{}]*wsClient)
+	doubleSpendNotifications := make(map[chan struct{}]*wsClient)
 	winningTicketNotifications := make(map[chan struct{}]*wsClient)
 	ticketNewNotifications := make(map[chan struct{}]*wsClient)
 	txNotifications := make(map[chan struct{}]*wsClient)
@@ -487,10 +538,16 @@ out:
 			case *notificationTSpend:
 				m.notifyTSpend(tspendNotifications, (*dcrutil.Tx)(n))
 
+			case *notificationDoubleSpend:
+				m.notifyDoubleSpend(doubleSpendNotifications, n)
+
 			case *notificationReorganization:
 				m.notifyReorganization(blockNotifications,
 					(*blockchain.ReorganizationNtfnsData)(n))
 
+			case *notificationSKAEmissionsFinal:
+				m.notifySKAEmissionsFinal(blockNotifications, n)
+
 			case *notificationWinningTickets:
 				m.notifyWinningTickets(winningTicketNotifications,
 					(*WinningTicketsNtfnData)(n))
@@ -525,8 +582,8 @@ out:
 				delete(blockNotifications, wsc.quit)
 
 			case *notificationRegisterWork:
-				wsc := (*wsClient)(n)
-				workNotifications[wsc.quit] = wsc
+				sub := (*workSubscription)(n)
+				workNotifications[sub.wsc.quit] = sub
 
 			case *notificationUnregisterWork:
 				wsc := (*wsClient)(n)
@@ -540,6 +597,14 @@ out:
 				wsc := (*wsClient)(n)
 				delete(tspendNotifications, wsc.quit)
 
+			case *notificationRegisterDoubleSpends:
+				wsc := (*wsClient)(n)
+				doubleSpendNotifications[wsc.quit] = wsc
+
+			case *notificationUnregisterDoubleSpends:
+				wsc := (*wsClient)(n)
+				delete(doubleSpendNotifications, wsc.quit)
+
 			case *notificationRegisterWinningTickets:
 				wsc := (*wsClient)(n)
 				winningTicketNotifications[wsc.quit] = wsc
@@ -567,6 +632,7 @@ out:
 				delete(blockNotifications, wsc.quit)
 				delete(workNotifications, wsc.quit)
 				delete(tspendNotifications, wsc.quit)
+				delete(doubleSpendNotifications, wsc.quit)
 				delete(txNotifications, wsc.quit)
 				delete(winningTicketNotifications, wsc.quit)
 				delete(ticketNewNotifications, wsc.quit)
@@ -622,10 +688,13 @@ func (m *wsNotificationManager) UnregisterBlockUpdates(wsc *wsClient) {
 }
 
 // RegisterWorkUpdates requests work update notifications to the passed
-// websocket client.
-func (m *wsNotificationManager) RegisterWorkUpdates(wsc *wsClient) {
+// websocket client.  Notifications for templates that were only updated due to
+// new transactions are limited to those that pass the provided filter when it
+// is not nil.
+func (m *wsNotificationManager) RegisterWorkUpdates(wsc *wsClient, filter *workNtfnFilter) {
+	sub := &workSubscription{wsc: wsc, filter: filter}
 	select {
-	case m.queueNotification <- (*notificationRegisterWork)(wsc):
+	case m.queueNotification <- (*notificationRegisterWork)(sub):
 	case <-m.quit:
 	}
 }
@@ -657,6 +726,24 @@ func (m *wsNotificationManager) UnregisterTSpendUpdates(wsc *wsClient) {
 	}
 }
 
+// RegisterDoubleSpendUpdates requests double spend notifications to the passed
+// websocket client.
+func (m *wsNotificationManager) RegisterDoubleSpendUpdates(wsc *wsClient) {
+	select {
+	case m.queueNotification <- (*notificationRegisterDoubleSpends)(wsc):
+	case <-m.quit:
+	}
+}
+
+// UnregisterDoubleSpendUpdates removes double spend notifications for the
+// passed websocket client.
+func (m *wsNotificationManager) UnregisterDoubleSpendUpdates(wsc *wsClient) {
+	select {
+	case m.queueNotification <- (*notificationUnregisterDoubleSpends)(wsc):
+	case <-m.quit:
+	}
+}
+
 // subscribedClients returns the set of all websocket client quit channels that
 // are registered to receive notifications regarding tx, either due to tx
 // spending a watched output or outputting to a watched address.  Matching
@@ -848,9 +935,48 @@ func updateReasonToWorkNtfnString(reason mining.TemplateUpdateReason) string {
 	return "unknown"
 }
 
+// defaultWorkFeeRateChangePct is the default percentage the top fee rate of a
+// coin type bucket must move by for a template that was only updated due to
+// new transactions to be notified to websocket clients that filter work
+// notifications.
+const defaultWorkFeeRateChangePct = 10
+
+// workNtfnFilter limits the work notifications sent to a websocket client for
+// templates that were only updated due to new transactions to those that
+// materially changed the coin type buckets the client is interested in as
+// compared to the last template it was notified about.  Templates that were
+// updated due to a new parent or new votes are always notified.
+//
+// It is only accessed by the notification handler.
+type workNtfnFilter struct {
+	coinTypes    []cointype.CoinType
+	thresholdPct uint32
+	lastNotified *mining.TemplateBuckets
+}
+
+// wants returns whether or not a template with the provided update reason and
+// bucket summary should be notified and records the summary as the last
+// notified one when it should.
+func (f *workNtfnFilter) wants(reason mining.TemplateUpdateReason, buckets *mining.TemplateBuckets) bool {
+	if reason == mining.TURNewTxns && !buckets.MateriallyChanged(f.lastNotified,
+		f.coinTypes, f.thresholdPct) {
+
+		return false
+	}
+	f.lastNotified = buckets
+	return true
+}
+
+// workSubscription houses a websocket client that registered for work
+// notifications along with the optional filter it requested.
+type workSubscription struct {
+	wsc    *wsClient
+	filter *workNtfnFilter
+}
+
 // notifyWork notifies websocket clients that have registered for template
 // updates when a new block template is generated.
-func (m *wsNotificationManager) notifyWork(clients map[chan struct{}]*wsClient, templateNtfn *mining.TemplateNtfn) {
+func (m *wsNotificationManager) notifyWork(clients map[chan struct{}]*workSubscription, templateNtfn *mining.TemplateNtfn) {
 	// Skip notification creation if no clients have requested work
 	// notifications.
 	if len(clients) == 0 {
@@ -905,8 +1031,17 @@ func (m *wsNotificationManager) notifyWork(clients map[chan struct{}]*wsClient,
 	state.templatePool[templateKey] = templateNtfn.Template.Block
 	state.Unlock()
 
-	for _, wsc := range clients {
-		wsc.QueueNotification(marshalledJSON)
+	var buckets *mining.TemplateBuckets
+	for _, sub := range clients {
+		if sub.filter != nil {
+			if buckets == nil {
+				buckets = mining.SummarizeTemplateBuckets(templateNtfn.Template)
+			}
+			if !sub.filter.wants(templateNtfn.Reason, buckets) {
+				continue
+			}
+		}
+		sub.wsc.QueueNotification(marshalledJSON)
 	}
 }
 
@@ -941,6 +1076,34 @@ func (m *wsNotificationManager) notifyTSpend(clients map[chan struct{}]*wsClient
 	}
 }
 
+// notifyDoubleSpend notifies websocket clients that have registered for double
+// spend notifications about a transaction rejected for double spending coins
+// spent by transactions in the mempool.
+func (m *wsNotificationManager) notifyDoubleSpend(clients map[chan struct{}]*wsClient,
+	ds *notificationDoubleSpend) {
+	// Skip notification creation if no clients have requested double spend
+	// notifications.
+	if len(clients) == 0 {
+		return
+	}
+
+	conflicts := make([]string, 0, len(ds.conflicts))
+	for i := range ds.conflicts {
+		conflicts = append(conflicts, ds.conflicts[i].String())
+	}
+	ntfn := types.NewDoubleSpendNtfn(uint8(ds.coinType), ds.tx.Hash().String(),
+		conflicts)
+	marshalledJSON, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
+	if err != nil {
+		log.Errorf("Failed to marshal double spend notification: %v", err)
+		return
+	}
+
+	for _, wsc := range clients {
+		wsc.QueueNotification(marshalledJSON)
+	}
+}
+
 // notifyReorganization notifies websocket clients that have registered for
 // block updates when the blockchain is beginning a reorganization.
 func (m *wsNotificationManager) notifyReorganization(clients map[chan struct{}]*wsClient, rd *blockchain.ReorganizationNtfnsData) {
@@ -955,6 +1118,19 @@ func (m *wsNotificationManager) notifyReorganization(clients map[chan struct{}]*
 		int32(rd.OldHeight),
 		rd.NewHash.String(),
 		int32(rd.NewHeight))
+	if len(rd.DisconnectedSKAEmissions) > 0 {
+		emissions := make([]types.ReorganizationSKAEmission, 0,
+			len(rd.DisconnectedSKAEmissions))
+		for _, emission := range rd.DisconnectedSKAEmissions {
+			emissions = append(emissions, types.ReorganizationSKAEmission{
+				CoinType: uint8(emission.CoinType),
+				Nonce:    emission.Nonce,
+				Height:   emission.Height,
+				TxHash:   chainhash.Hash(emission.TxHash).String(),
+			})
+		}
+		ntfn.SKAEmissions = &emissions
+	}
 	marshalledJSON, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
 	if err != nil {
 		log.Errorf("Failed to marshal reorganization "+
@@ -966,6 +1142,33 @@ func (m *wsNotificationManager) notifyReorganization(clients map[chan struct{}]*
 	}
 }
 
+// notifySKAEmissionsFinal notifies websocket clients that have registered for
+// block updates that SKA emissions in a main chain block reached the configured
+// number of confirmations to be considered final.
+func (m *wsNotificationManager) notifySKAEmissionsFinal(clients map[chan struct{}]*wsClient, n *notificationSKAEmissionsFinal) {
+	// Skip notification creation if no clients have requested block
+	// notifications.
+	if len(clients) == 0 {
+		return
+	}
+
+	blockHash := n.block.Hash().String()
+	for _, emission := range n.emissions {
+		ntfn := types.NewSKAEmissionFinalNtfn(uint8(emission.CoinType),
+			emission.Nonce, chainhash.Hash(emission.TxHash).String(),
+			blockHash, emission.Height, m.server.cfg.EmissionFinalConfs)
+		marshalledJSON, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
+		if err != nil {
+			log.Errorf("Failed to marshal skaemissionfinal "+
+				"notification: %v", err)
+			continue
+		}
+		for _, wsc := range clients {
+			wsc.QueueNotification(marshalledJSON)
+		}
+	}
+}
+
 // RegisterWinningTickets requests winning tickets update notifications
 // to the passed websocket client.
 func (m *wsNotificationManager) RegisterWinningTickets(wsc *wsClient) {
@@ -1345,9 +1548,8 @@ type wsClient struct {
 	// and therefore is allowed to communicated over the websocket.
 	authenticated bool
 
-	// isAdmin specifies whether a client may change the state of the server;
-	// false means its access is only to the limited set of RPC calls.
-	isAdmin bool
+	// scopes specifies the methods the client is allowed to call.
+	scopes AuthScope
 
 	// sessionID is a random ID generated for each client when connected.
 	// These IDs may be queried by a client using the session RPC.  A change
@@ -1489,7 +1691,7 @@ out:
 				break out
 			case !c.authenticated:
 				// Check credentials.
-				c.authenticated, c.isAdmin = c.rpcServer.checkAuthUserPass(
+				c.authenticated, c.scopes = c.rpcServer.checkAuthUserPass(
 					authCmd.Username, authCmd.Passphrase, c.addr)
 				if !c.authenticated {
 					break out
@@ -1511,22 +1713,20 @@ out:
 
 			// Check if the client is using limited RPC credentials and
 			// error when not authorized to call the supplied RPC.
-			if !c.isAdmin {
-				if _, ok := rpcLimited[req.Method]; !ok {
-					jsonErr := &dcrjson.RPCError{
-						Code:    dcrjson.ErrRPCInvalidParams.Code,
-						Message: "limited user not authorized for this method",
-					}
-					// Marshal and send response.
-					reply, err = createMarshalledReply("", req.ID, nil, jsonErr)
-					if err != nil {
-						log.Errorf("Failed to marshal parse failure "+
-							"reply: %v", err)
-						continue
-					}
-					c.SendMessage(reply, nil)
+			if !c.scopes.Authorizes(req.Method) {
+				jsonErr := &dcrjson.RPCError{
+					Code:    dcrjson.ErrRPCInvalidParams.Code,
+					Message: unauthorizedMessage(req.Method),
+				}
+				// Marshal and send response.
+				reply, err = createMarshalledReply("", req.ID, nil, jsonErr)
+				if err != nil {
+					log.Errorf("Failed to marshal parse failure "+
+						"reply: %v", err)
 					continue
 				}
+				c.SendMessage(reply, nil)
+				continue
 			}
 
 			// Asynchronously handle the request.  A semaphore is used to
@@ -1698,7 +1898,7 @@ out:
 							break out
 						case !c.authenticated:
 							// Check credentials.
-							c.authenticated, c.isAdmin = c.rpcServer.checkAuthUserPass(
+							c.authenticated, c.scopes = c.rpcServer.checkAuthUserPass(
 								authCmd.Username, authCmd.Passphrase, c.addr)
 							if !c.authenticated {
 								break out
@@ -1720,25 +1920,23 @@ out:
 
 						// Check if the client is using limited RPC credentials and
 						// error when not authorized to call the supplied RPC.
-						if !c.isAdmin {
-							if _, ok := rpcLimited[req.Method]; !ok {
-								jsonErr := &dcrjson.RPCError{
-									Code:    dcrjson.ErrRPCInvalidParams.Code,
-									Message: "limited user not authorized for this method",
-								}
-								// Marshal and send response.
-								reply, err = createMarshalledReply(req.Jsonrpc, req.ID, nil, jsonErr)
-								if err != nil {
-									log.Errorf("Failed to marshal parse failure "+
-										"reply: %v", err)
-									continue
-								}
-
-								if reply != nil {
-									results = append(results, reply)
-								}
+						if !c.scopes.Authorizes(req.Method) {
+							jsonErr := &dcrjson.RPCError{
+								Code:    dcrjson.ErrRPCInvalidParams.Code,
+								Message: unauthorizedMessage(req.Method),
+							}
+							// Marshal and send response.
+							reply, err = createMarshalledReply(req.Jsonrpc, req.ID, nil, jsonErr)
+							if err != nil {
+								log.Errorf("Failed to marshal parse failure "+
+									"reply: %v", err)
 								continue
 							}
+
+							if reply != nil {
+								results = append(results, reply)
+							}
+							continue
 						}
 
 						// Lookup the websocket extension for the command, if it doesn't
@@ -2030,12 +2228,13 @@ func (c *wsClient) Run(ctx context.Context) {
 
 // newWebsocketClient returns a new websocket client given the notification
 // manager, websocket connection, remote address, and whether or not the client
-// has already been authenticated (via HTTP Basic access authentication).  The
+// has already been authenticated (via HTTP Basic access authentication) along
+// with the scopes granted to its credentials.  The
 // returned client is ready to start.  Once started, the client will process
 // incoming and outgoing messages in separate goroutines complete with queuing
 // and asynchronous handling for long-running operations.
 func newWebsocketClient(server *Server, conn *websocket.Conn,
-	remoteAddr string, authenticated bool, isAdmin bool) (*wsClient, error) {
+	remoteAddr string, authenticated bool, scopes AuthScope) (*wsClient, error) {
 
 	sessionID := rand.Uint64()
 
@@ -2043,7 +2242,7 @@ func newWebsocketClient(server *Server, conn *websocket.Conn,
 		conn:              conn,
 		addr:              remoteAddr,
 		authenticated:     authenticated,
-		isAdmin:           isAdmin,
+		scopes:            scopes,
 		sessionID:         sessionID,
 		rpcServer:         server,
 		serviceRequestSem: makeSemaphore(server.cfg.RPCMaxConcurrentReqs),
@@ -2176,11 +2375,43 @@ func handleRebroadcastWinners(_ context.Context, wsc *wsClient, _ interface{}) (
 
 // handleNotifyWork implements the notifywork command extension for
 // websocket connections.
-func handleNotifyWork(_ context.Context, wsc *wsClient, _ interface{}) (interface{}, error) {
-	wsc.rpcServer.ntfnMgr.RegisterWorkUpdates(wsc)
+func handleNotifyWork(_ context.Context, wsc *wsClient, icmd interface{}) (interface{}, error) {
+	cmd, ok := icmd.(*types.NotifyWorkCmd)
+	if !ok {
+		return nil, dcrjson.ErrRPCInternal
+	}
+
+	filter, err := parseWorkNtfnFilter(cmd)
+	if err != nil {
+		return nil, err
+	}
+	wsc.rpcServer.ntfnMgr.RegisterWorkUpdates(wsc, filter)
 	return nil, nil
 }
 
+// parseWorkNtfnFilter returns the work notification filter requested by the
+// provided notifywork command or nil when no filter was requested.
+func parseWorkNtfnFilter(cmd *types.NotifyWorkCmd) (*workNtfnFilter, error) {
+	if cmd.CoinTypes == nil && cmd.FeeRateChangePct == nil {
+		return nil, nil
+	}
+
+	filter := &workNtfnFilter{thresholdPct: defaultWorkFeeRateChangePct}
+	if cmd.FeeRateChangePct != nil {
+		filter.thresholdPct = *cmd.FeeRateChangePct
+	}
+	if cmd.CoinTypes != nil {
+		for _, coinType := range *cmd.CoinTypes {
+			if coinType < 0 || coinType > int(cointype.CoinTypeMax) {
+				return nil, rpcInvalidError("Invalid coin type %d", coinType)
+			}
+			filter.coinTypes = append(filter.coinTypes,
+				cointype.CoinType(coinType))
+		}
+	}
+	return filter, nil
+}
+
 // handleNotifyTSpend implements the notifytspend command extension for
 // websocket connections.
 func handleNotifyTSpend(_ context.Context, wsc *wsClient, _ interface{}) (interface{}, error) {
@@ -2188,6 +2419,51 @@ func handleNotifyTSpend(_ context.Context, wsc *wsClient, _ interface{}) (interf
 	return nil, nil
 }
 
+// handleNotifyDoubleSpends implements the notifydoublespends command extension
+// for websocket connections.
+func handleNotifyDoubleSpends(_ context.Context, wsc *wsClient, _ interface{}) (interface{}, error) {
+	wsc.rpcServer.ntfnMgr.RegisterDoubleSpendUpdates(wsc)
+	return nil, nil
+}
+
+// handleWaitForIndexSync implements the waitforindexsync command extension for
+// websocket connections.  It blocks until the requested index is synced to the
+// current best block of the main chain and returns its sync progress.
+func handleWaitForIndexSync(ctx context.Context, wsc *wsClient, icmd interface{}) (interface{}, error) {
+	cmd, ok := icmd.(*types.WaitForIndexSyncCmd)
+	if !ok {
+		return nil, dcrjson.ErrRPCInternal
+	}
+
+	reporter := wsc.rpcServer.cfg.IndexSyncReporter
+	for {
+		// Subscribe for the next sync update prior to checking the current
+		// progress to avoid missing an update in between.
+		synced, ok := reporter.WaitForSync(cmd.Name)
+		if !ok {
+			return nil, rpcInvalidError("Unknown index %q", cmd.Name)
+		}
+
+		statuses, err := reporter.SyncStatus()
+		if err != nil {
+			return nil, rpcInternalErr(err, "Index sync status")
+		}
+		for i := range statuses {
+			if statuses[i].Name == cmd.Name && statuses[i].Synced {
+				return indexInfoResult(&statuses[i]), nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-wsc.quit:
+			return nil, ErrClientQuit
+		case <-synced:
+		}
+	}
+}
+
 // handleSession implements the session command extension for websocket
 // connections.
 func handleSession(_ context.Context, wsc *wsClient, icmd interface{}) (interface{}, error) {
@@ -2229,6 +2505,13 @@ func handleStopNotifyTSpend(_ context.Context, wsc *wsClient, _ interface{}) (in
 	return nil, nil
 }
 
+// handleStopNotifyDoubleSpends implements the stopnotifydoublespends command
+// extension for websocket connections.
+func handleStopNotifyDoubleSpends(_ context.Context, wsc *wsClient, _ interface{}) (interface{}, error) {
+	wsc.rpcServer.ntfnMgr.UnregisterDoubleSpendUpdates(wsc)
+	return nil, nil
+}
+
 // handleNotifyNewTransations implements the notifynewtransactions command
 // extension for websocket connections.
 func handleNotifyNewTransactions(_ context.Context, wsc *wsClient, icmd interface{}) (interface{}, error) {
@@ -2414,6 +2697,253 @@ func handleRescan(_ context.Context, wsc *wsClient, icmd interface{}) (interface
 	return &types.RescanResult{DiscoveredData: discoveredData}, nil
 }
 
+// coinTypeRescanTx describes a transaction found by the map phase of a coin
+// type rescan that either pays one of the rescanned addresses or may spend an
+// output discovered in an earlier block.
+type coinTypeRescanTx struct {
+	tx       *wire.MsgTx
+	prevOuts []wire.OutPoint
+	matched  []wire.OutPoint
+}
+
+// coinTypeRescanBlock houses the result of the map phase of a coin type rescan
+// for a single block.
+type coinTypeRescanBlock struct {
+	hash   chainhash.Hash
+	height int64
+	txns   []coinTypeRescanTx
+}
+
+// mapCoinTypeRescanBlock performs the map phase of a coin type rescan for the
+// passed block.  Only transactions with at least one output of the coin type
+// are considered, so blocks are scanned independently of each other and
+// without keeping track of any state.  The outputs of the coin type that pay
+// one of the provided addresses are recorded along with the outputs spent by
+// the transaction so the reduce phase can determine which transactions spend
+// previously discovered outputs.
+func mapCoinTypeRescanBlock(block *dcrutil.Block, coinType cointype.CoinType,
+	addrs map[string]struct{}, params *chaincfg.Params,
+	isTreasuryEnabled bool) *coinTypeRescanBlock {
+
+	result := &coinTypeRescanBlock{
+		hash:   *block.Hash(),
+		height: block.Height(),
+	}
+	mapTx := func(tx *wire.MsgTx, tree int8) {
+		var rescanTx *coinTypeRescanTx
+		var txHash chainhash.Hash
+		for i, txOut := range tx.TxOut {
+			if txOut.CoinType != coinType {
+				continue
+			}
+			if rescanTx == nil {
+				txHash = tx.TxHash()
+				rescanTx = &coinTypeRescanTx{tx: tx}
+			}
+			_, outAddrs := stdscript.ExtractAddrs(txOut.Version,
+				txOut.PkScript, params)
+			for _, addr := range outAddrs {
+				if _, ok := addrs[addr.String()]; !ok {
+					continue
+				}
+				rescanTx.matched = append(rescanTx.matched, wire.OutPoint{
+					Hash:  txHash,
+					Index: uint32(i),
+					Tree:  tree,
+				})
+				break
+			}
+		}
+		if rescanTx == nil {
+			return
+		}
+
+		// Coinbases and stakebases do not reference previous outputs.
+		inputs := tx.TxIn
+		switch {
+		case tree == wire.TxTreeRegular &&
+			standalone.IsCoinBaseTx(tx, isTreasuryEnabled):
+			inputs = nil
+		case tree == wire.TxTreeStake && stake.IsSSGen(tx):
+			inputs = inputs[1:]
+		}
+		rescanTx.prevOuts = make([]wire.OutPoint, 0, len(inputs))
+		for _, txIn := range inputs {
+			rescanTx.prevOuts = append(rescanTx.prevOuts,
+				txIn.PreviousOutPoint)
+		}
+		result.txns = append(result.txns, *rescanTx)
+	}
+
+	msgBlock := block.MsgBlock()
+	for _, tx := range msgBlock.STransactions {
+		mapTx(tx, wire.TxTreeStake)
+	}
+	for _, tx := range msgBlock.Transactions {
+		mapTx(tx, wire.TxTreeRegular)
+	}
+	return result
+}
+
+// reduce performs the reduce phase of a coin type rescan for the block.  The
+// blocks must be reduced in order since the passed set of discovered unspent
+// outputs is updated with the outputs the block creates and spends.  The
+// relevant transactions are returned hex encoded.
+func (b *coinTypeRescanBlock) reduce(unspent map[wire.OutPoint]struct{}) []string {
+	var transactions []string
+	for i := range b.txns {
+		rescanTx := &b.txns[i]
+		relevant := len(rescanTx.matched) > 0
+		for j := range rescanTx.prevOuts {
+			prevOut := &rescanTx.prevOuts[j]
+			if _, ok := unspent[*prevOut]; ok {
+				delete(unspent, *prevOut)
+				relevant = true
+			}
+		}
+		for _, op := range rescanTx.matched {
+			unspent[op] = struct{}{}
+		}
+		if relevant {
+			transactions = append(transactions, txHexString(rescanTx.tx))
+		}
+	}
+	return transactions
+}
+
+// handleRescanCoinType implements the rescancointype command extension for
+// websocket connections.
+//
+// The blocks in the requested range are scanned concurrently in batches and
+// the results of each batch are reduced in block order, so a wallet that is
+// only interested in a single coin type does not need to scan the
+// transactions of other coin types.  A rescancointypeblock notification is
+// sent for each block with relevant transactions before the command returns.
+func handleRescanCoinType(ctx context.Context, wsc *wsClient, icmd interface{}) (interface{}, error) {
+	cmd, ok := icmd.(*types.RescanCoinTypeCmd)
+	if !ok {
+		return nil, dcrjson.ErrRPCInternal
+	}
+
+	rpcServer := wsc.rpcServer
+	cfg := rpcServer.cfg
+	coinType := cointype.CoinType(cmd.CoinType)
+	if coinType.IsSKA() && cfg.ChainParams.GetSKACoinConfig(coinType) == nil {
+		return nil, rpcInvalidError("Coin type %d is not configured", coinType)
+	}
+
+	addrs := make(map[string]struct{}, len(cmd.Addresses))
+	for _, addrStr := range cmd.Addresses {
+		addr, err := stdaddr.DecodeAddress(addrStr, cfg.ChainParams)
+		if err != nil {
+			return nil, rpcInvalidError("Invalid address %q: %v", addrStr,
+				err)
+		}
+		addrs[addr.String()] = struct{}{}
+	}
+
+	best := cfg.Chain.BestSnapshot()
+	endHeight := best.Height
+	if cmd.EndHeight != nil {
+		endHeight = *cmd.EndHeight
+	}
+	if cmd.StartHeight < 0 || endHeight > best.Height ||
+		cmd.StartHeight > endHeight {
+
+		return nil, rpcInvalidError("Invalid height range [%d, %d] -- "+
+			"must be within [0, %d]", cmd.StartHeight, endHeight,
+			best.Height)
+	}
+
+	// mapBlock performs the map phase for the block at the provided height.
+	mapBlock := func(height int64) (*coinTypeRescanBlock, error) {
+		block, err := cfg.Chain.BlockByHeight(height)
+		if err != nil {
+			return nil, &dcrjson.RPCError{
+				Code:    dcrjson.ErrRPCBlockNotFound,
+				Message: "Failed to fetch block: " + err.Error(),
+			}
+		}
+		prevHash := &block.MsgBlock().Header.PrevBlock
+		isTreasuryEnabled, err := rpcServer.isTreasuryAgendaActive(prevHash)
+		if err != nil {
+			return nil, err
+		}
+		return mapCoinTypeRescanBlock(block, coinType, addrs,
+			cfg.ChainParams, isTreasuryEnabled), nil
+	}
+
+	result := &types.RescanCoinTypeResult{
+		CoinType:    cmd.CoinType,
+		StartHeight: cmd.StartHeight,
+		EndHeight:   endHeight,
+	}
+	numWorkers := runtime.NumCPU()
+	unspent := make(map[wire.OutPoint]struct{})
+	mapped := make([]*coinTypeRescanBlock, rescanCoinTypeBatchSize)
+	errs := make([]error, rescanCoinTypeBatchSize)
+	for batchStart := cmd.StartHeight; batchStart <= endHeight; batchStart += rescanCoinTypeBatchSize {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-wsc.quit:
+			return nil, ErrClientQuit
+		default:
+		}
+
+		// Map the blocks of the batch concurrently.
+		batchLen := endHeight - batchStart + 1
+		if batchLen > rescanCoinTypeBatchSize {
+			batchLen = rescanCoinTypeBatchSize
+		}
+		var wg sync.WaitGroup
+		var next atomic.Int64
+		for i := 0; i < numWorkers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					idx := next.Add(1) - 1
+					if idx >= batchLen {
+						return
+					}
+					mapped[idx], errs[idx] = mapBlock(batchStart + idx)
+				}
+			}()
+		}
+		wg.Wait()
+
+		// Reduce the batch in block order and stream the blocks with
+		// relevant transactions to the client.
+		for idx := int64(0); idx < batchLen; idx++ {
+			if errs[idx] != nil {
+				return nil, errs[idx]
+			}
+			block := mapped[idx]
+			result.EndHash = block.hash.String()
+			transactions := block.reduce(unspent)
+			if len(transactions) == 0 {
+				continue
+			}
+			result.DiscoveredBlocks++
+			result.DiscoveredTxns += int64(len(transactions))
+
+			ntfn := types.NewRescanCoinTypeBlockNtfn(cmd.CoinType,
+				block.hash.String(), block.height, transactions)
+			marshalledJSON, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
+			if err != nil {
+				return nil, rpcInternalErr(err, "Failed to marshal "+
+					"rescancointypeblock notification")
+			}
+			if err := wsc.QueueNotification(marshalledJSON); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
 func init() {
 	wsHandlers = wsHandlersBeforeInit
 }
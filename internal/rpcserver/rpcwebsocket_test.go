@@ -0,0 +1,195 @@
+// Copyright (c) 2025 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/mining"
+	"github.com/monetarium/monetarium-node/rpc/jsonrpc/types"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestCoinTypeRescanMapReduce ensures the map phase of a coin type rescan only
+// considers transactions with outputs of the rescanned coin type and that
+// reducing the mapped blocks in order discovers both the transactions paying
+// the rescanned addresses and the ones spending their outputs.
+func TestCoinTypeRescanMapReduce(t *testing.T) {
+	t.Parallel()
+
+	params := chaincfg.RegNetParams()
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		bytes.Repeat([]byte{0x01}, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, pkScript := addr.PaymentScript()
+	addrs := map[string]struct{}{addr.String(): {}}
+	const coinType = cointype.CoinType(1)
+
+	// newTx returns a transaction that spends the provided outpoint and pays
+	// the provided value of the coin type to the address.
+	newTx := func(prevOut wire.OutPoint, coinType cointype.CoinType,
+		value int64) *wire.MsgTx {
+
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&prevOut, 0, nil))
+		tx.AddTxOut(wire.NewTxOutWithCoinType(value, coinType, pkScript))
+		return tx
+	}
+	newBlock := func(height uint32, txns ...*wire.MsgTx) *dcrutil.Block {
+		coinbase := wire.NewMsgTx()
+		coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+			Index: wire.MaxPrevOutIndex,
+		}, 0, nil))
+		coinbase.AddTxOut(wire.NewTxOut(0, []byte{0x51}))
+		return dcrutil.NewBlock(&wire.MsgBlock{
+			Header:       wire.BlockHeader{Height: height},
+			Transactions: append([]*wire.MsgTx{coinbase}, txns...),
+		})
+	}
+
+	// Create a block with a VAR payment and an SKA payment to the address
+	// followed by a block that spends the SKA output with a transaction that
+	// pays elsewhere and an unrelated SKA transaction.
+	varPay := newTx(wire.OutPoint{Hash: chainhash.Hash{0x01}}, 0, 1000)
+	skaPay := newTx(wire.OutPoint{Hash: chainhash.Hash{0x02}}, coinType, 2000)
+	skaSpend := newTx(wire.OutPoint{Hash: skaPay.TxHash()}, coinType, 1900)
+	skaSpend.TxOut[0].PkScript = []byte{0x51}
+	unrelated := newTx(wire.OutPoint{Hash: chainhash.Hash{0x03}}, coinType,
+		3000)
+	unrelated.TxOut[0].PkScript = []byte{0x51}
+	blocks := []*dcrutil.Block{
+		newBlock(10, varPay, skaPay),
+		newBlock(11, skaSpend, unrelated),
+	}
+
+	// Map the blocks in reverse order to ensure the map phase does not depend
+	// on the order the blocks are scanned in.
+	mapped := make([]*coinTypeRescanBlock, len(blocks))
+	for i := len(blocks) - 1; i >= 0; i-- {
+		mapped[i] = mapCoinTypeRescanBlock(blocks[i], coinType, addrs,
+			params, false)
+	}
+	if len(mapped[0].txns) != 1 || len(mapped[1].txns) != 2 {
+		t.Fatalf("unexpected mapped transactions -- got %d and %d, want 1 "+
+			"and 2", len(mapped[0].txns), len(mapped[1].txns))
+	}
+
+	unspent := make(map[wire.OutPoint]struct{})
+	wants := [][]string{{txHexString(skaPay)}, {txHexString(skaSpend)}}
+	for i, block := range mapped {
+		if block.height != int64(10+i) {
+			t.Fatalf("unexpected height -- got %d, want %d", block.height,
+				10+i)
+		}
+		got := block.reduce(unspent)
+		if !reflect.DeepEqual(got, wants[i]) {
+			t.Fatalf("block %d: unexpected transactions -- got %v, want %v",
+				block.height, got, wants[i])
+		}
+	}
+	if len(unspent) != 0 {
+		t.Fatalf("unexpected unspent outputs after spend: %v", unspent)
+	}
+
+	// Ensure a VAR rescan only discovers the VAR payment.
+	varBlock := mapCoinTypeRescanBlock(blocks[0], cointype.CoinTypeVAR, addrs,
+		params, false)
+	got := varBlock.reduce(make(map[wire.OutPoint]struct{}))
+	if !reflect.DeepEqual(got, []string{txHexString(varPay)}) {
+		t.Fatalf("unexpected VAR transactions -- got %v", got)
+	}
+}
+
+// TestWorkNtfnFilter ensures the work notification filter requested by the
+// notifywork command is parsed as expected and only lets through templates
+// that were updated due to new transactions when the relevant buckets changed
+// materially since the last notified template.
+func TestWorkNtfnFilter(t *testing.T) {
+	t.Parallel()
+
+	// Ensure no filter is requested without any of the optional parameters
+	// and invalid coin types are rejected.
+	filter, err := parseWorkNtfnFilter(types.NewNotifyWorkCmd(nil, nil))
+	if err != nil || filter != nil {
+		t.Fatalf("unexpected filter without parameters: %v, %v", filter, err)
+	}
+	_, err = parseWorkNtfnFilter(types.NewNotifyWorkCmd(&[]int{256}, nil))
+	if err == nil {
+		t.Fatal("did not receive expected error for invalid coin type")
+	}
+
+	// Ensure the default threshold is used when only coin types are
+	// provided.
+	filter, err = parseWorkNtfnFilter(types.NewNotifyWorkCmd(&[]int{1}, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if filter.thresholdPct != defaultWorkFeeRateChangePct ||
+		!reflect.DeepEqual(filter.coinTypes, []cointype.CoinType{1}) {
+
+		t.Fatalf("unexpected filter: %+v", filter)
+	}
+
+	newBuckets := func(skaFeeRate, varFeeRate int64) *mining.TemplateBuckets {
+		return &mining.TemplateBuckets{
+			Buckets: map[cointype.CoinType]mining.TemplateBucket{
+				cointype.CoinTypeVAR: {NumTxns: 1, TopFeeRate: varFeeRate},
+				1:                    {NumTxns: 1, TopFeeRate: skaFeeRate},
+			},
+			Emissions: map[chainhash.Hash]cointype.CoinType{},
+		}
+	}
+	tests := []struct {
+		name    string
+		reason  mining.TemplateUpdateReason
+		buckets *mining.TemplateBuckets
+		want    bool
+	}{{
+		name:    "first template",
+		reason:  mining.TURNewTxns,
+		buckets: newBuckets(10000, 10000),
+		want:    true,
+	}, {
+		name:    "irrelevant bucket changed",
+		reason:  mining.TURNewTxns,
+		buckets: newBuckets(10000, 50000),
+		want:    false,
+	}, {
+		name:    "relevant bucket changed within threshold",
+		reason:  mining.TURNewTxns,
+		buckets: newBuckets(10500, 50000),
+		want:    false,
+	}, {
+		name:    "new votes",
+		reason:  mining.TURNewVotes,
+		buckets: newBuckets(10500, 50000),
+		want:    true,
+	}, {
+		name:    "relevant bucket drifted within threshold of last notified",
+		reason:  mining.TURNewTxns,
+		buckets: newBuckets(11500, 50000),
+		want:    false,
+	}, {
+		name:    "relevant bucket changed beyond threshold",
+		reason:  mining.TURNewTxns,
+		buckets: newBuckets(12000, 50000),
+		want:    true,
+	}}
+	for _, test := range tests {
+		if got := filter.wants(test.reason, test.buckets); got != test.want {
+			t.Fatalf("%q: unexpected result -- got %v, want %v", test.name,
+				got, test.want)
+		}
+	}
+}
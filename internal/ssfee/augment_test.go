@@ -0,0 +1,724 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ssfee
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestSSFeeAugmentation_VAR_NullInput tests VAR staker SSFee null-input creation
+// (no previous SSFee UTXO exists)
+func TestSSFeeAugmentation_VAR_NullInput(t *testing.T) {
+	// Create mock voter with VAR reward
+	voter := createMockVoterWithConsolidationAddr(t, cointype.CoinTypeVAR, 1000, makeTestHash160(0xAA))
+	voters := []*dcrutil.Tx{voter}
+
+	// Create SSFee without SSFeeIndex (null-input mode)
+	ssFeeTxns, err := NewStakerTxs(cointype.CoinTypeVAR, 1000, voters, 100, nil)
+	if err != nil {
+		t.Fatalf("Failed to create VAR SSFee: %v", err)
+	}
+
+	if len(ssFeeTxns) != 1 {
+		t.Fatalf("Expected 1 SSFee transaction, got %d", len(ssFeeTxns))
+	}
+
+	tx := ssFeeTxns[0].MsgTx()
+
+	// Verify null input
+	if len(tx.TxIn) != 1 {
+		t.Fatalf("Expected 1 input, got %d", len(tx.TxIn))
+	}
+	if tx.TxIn[0].PreviousOutPoint.Index != wire.MaxPrevOutIndex {
+		t.Errorf("Expected null input (MaxPrevOutIndex), got %d", tx.TxIn[0].PreviousOutPoint.Index)
+	}
+	if tx.TxIn[0].BlockHeight != wire.NullBlockHeight {
+		t.Errorf("Expected null fraud proof (NullBlockHeight), got %d", tx.TxIn[0].BlockHeight)
+	}
+
+	// Verify output value equals fee
+	if len(tx.TxOut) < 2 {
+		t.Fatalf("Expected at least 2 outputs, got %d", len(tx.TxOut))
+	}
+	// Output[0] = OP_RETURN, Output[1] = payment
+	if tx.TxOut[1].Value != 1000 {
+		t.Errorf("Expected output value 1000, got %d", tx.TxOut[1].Value)
+	}
+	if tx.TxOut[1].CoinType != cointype.CoinTypeVAR {
+		t.Errorf("Expected VAR coin type, got %d", tx.TxOut[1].CoinType)
+	}
+}
+
+// TestSSFeeAugmentation_SKA_Staker_NullInput tests SKA staker SSFee null-input creation
+func TestSSFeeAugmentation_SKA_Staker_NullInput(t *testing.T) {
+	testCases := []struct {
+		name     string
+		coinType cointype.CoinType
+		fee      int64
+	}{
+		{
+			name:     "SKA-1 staker null-input",
+			coinType: cointype.CoinType(1),
+			fee:      1500,
+		},
+		{
+			name:     "SKA-2 staker null-input",
+			coinType: cointype.CoinType(2),
+			fee:      2500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			voter := createMockVoterWithConsolidationAddr(t, tc.coinType, tc.fee, makeTestHash160(0xBB))
+			voters := []*dcrutil.Tx{voter}
+
+			ssFeeTxns, err := NewStakerTxs(tc.coinType, tc.fee, voters, 200, nil)
+			if err != nil {
+				t.Fatalf("Failed to create SKA staker SSFee: %v", err)
+			}
+
+			if len(ssFeeTxns) != 1 {
+				t.Fatalf("Expected 1 SSFee transaction, got %d", len(ssFeeTxns))
+			}
+
+			tx := ssFeeTxns[0].MsgTx()
+
+			// Verify null input
+			if tx.TxIn[0].PreviousOutPoint.Index != wire.MaxPrevOutIndex {
+				t.Errorf("Expected null input")
+			}
+
+			// Verify output
+			if tx.TxOut[1].Value != tc.fee {
+				t.Errorf("Expected output %d, got %d", tc.fee, tx.TxOut[1].Value)
+			}
+			if tx.TxOut[1].CoinType != tc.coinType {
+				t.Errorf("Expected coin type %d, got %d", tc.coinType, tx.TxOut[1].CoinType)
+			}
+		})
+	}
+}
+
+// TestSSFeeAugmentation_SKA_Miner_NullInput tests SKA miner SSFee null-input creation
+func TestSSFeeAugmentation_SKA_Miner_NullInput(t *testing.T) {
+	testCases := []struct {
+		name     string
+		coinType cointype.CoinType
+		fee      int64
+	}{
+		{
+			name:     "SKA-1 miner null-input",
+			coinType: cointype.CoinType(1),
+			fee:      3000,
+		},
+		{
+			name:     "SKA-2 miner null-input",
+			coinType: cointype.CoinType(2),
+			fee:      4000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minerAddr := createMockAddress(t)
+
+			minerSSFeeTx, err := NewMinerTx(tc.coinType, tc.fee, minerAddr, 250, nil)
+			if err != nil {
+				t.Fatalf("Failed to create SKA miner SSFee: %v", err)
+			}
+
+			tx := minerSSFeeTx.MsgTx()
+
+			// Verify null input
+			if len(tx.TxIn) != 1 {
+				t.Fatalf("Expected 1 input, got %d", len(tx.TxIn))
+			}
+			if tx.TxIn[0].PreviousOutPoint.Index != wire.MaxPrevOutIndex {
+				t.Errorf("Expected null input")
+			}
+			if tx.TxIn[0].BlockHeight != wire.NullBlockHeight {
+				t.Errorf("Expected null fraud proof")
+			}
+
+			// Verify output
+			if len(tx.TxOut) < 2 {
+				t.Fatalf("Expected at least 2 outputs, got %d", len(tx.TxOut))
+			}
+			if tx.TxOut[1].Value != tc.fee {
+				t.Errorf("Expected output %d, got %d", tc.fee, tx.TxOut[1].Value)
+			}
+			if tx.TxOut[1].CoinType != tc.coinType {
+				t.Errorf("Expected coin type %d, got %d", tc.coinType, tx.TxOut[1].CoinType)
+			}
+
+			// Verify OP_SSGEN-tagged output
+			if len(tx.TxOut[1].PkScript) < 1 {
+				t.Fatal("Missing payment script")
+			}
+			if tx.TxOut[1].PkScript[0] != txscript.OP_SSGEN {
+				t.Errorf("Expected OP_SSGEN tag, got %x", tx.TxOut[1].PkScript[0])
+			}
+		})
+	}
+}
+
+// TestSSFeeConsolidatedUTXOSpendability tests consolidated SSFee UTXO maturity
+func TestSSFeeConsolidatedUTXOSpendability(t *testing.T) {
+	t.Skip("Requires blockchain harness - tested in blockchain integration tests")
+	// This would test that consolidated SSFee UTXOs:
+	// 1. Require 16 blocks maturity before external spending
+	// 2. Can be augmented by SSFee before maturity (maturity exemption)
+	// 3. Can be spent by users after maturity
+	// 4. Validation enforced in blockchain/validate.go:CheckTransactionInputs
+}
+
+// TestSSFeeAugmentation_MultipleRounds tests accumulation across blocks
+func TestSSFeeAugmentation_MultipleRounds(t *testing.T) {
+	// This test demonstrates the difference between null-input and augmented SSFee
+	voter := createMockVoterWithConsolidationAddr(t, cointype.CoinType(1), 1000, makeTestHash160(0xCC))
+	voters := []*dcrutil.Tx{voter}
+
+	// Round 1: Create initial SSFee (null input)
+	round1Txns, err := NewStakerTxs(cointype.CoinType(1), 1000, voters, 100, nil)
+	if err != nil {
+		t.Fatalf("Round 1 failed: %v", err)
+	}
+
+	// Round 2: Create another SSFee (also null input without SSFeeIndex)
+	round2Txns, err := NewStakerTxs(cointype.CoinType(1), 1000, voters, 101, nil)
+	if err != nil {
+		t.Fatalf("Round 2 failed: %v", err)
+	}
+
+	// Without augmentation: 2 separate UTXOs (dust accumulation)
+	totalUTXOs := len(round1Txns) + len(round2Txns)
+	if totalUTXOs != 2 {
+		t.Errorf("Expected 2 UTXOs without augmentation, got %d", totalUTXOs)
+	}
+
+	t.Logf("Without SSFeeIndex: %d UTXOs created (dust accumulation)", totalUTXOs)
+	t.Logf("With SSFeeIndex: 1 UTXO (consolidated: 1000 → 2000)")
+
+	// Verify both are null-input transactions
+	for i, txns := range [][]*dcrutil.Tx{round1Txns, round2Txns} {
+		for j, tx := range txns {
+			if tx.MsgTx().TxIn[0].PreviousOutPoint.Index != wire.MaxPrevOutIndex {
+				t.Errorf("Round %d, tx %d: Expected null input", i+1, j)
+			}
+		}
+	}
+}
+
+// mockUTXOIndex is a UTXOIndex backed by a map keyed by coin type, script
+// class, and hash160.
+type mockUTXOIndex struct {
+	utxos map[string]AugmentInput
+	err   error
+}
+
+// indexKey returns the key of the mock index for the coin type, script class,
+// and hash160.
+func indexKey(coinType cointype.CoinType, class stake.SSFeeScriptClass, hash160 []byte) string {
+	return string(append([]byte{byte(coinType), byte(class)}, hash160...))
+}
+
+// LookupUTXO returns the UTXO for the coin type, script class, and hash160
+// from the map.
+func (m *mockUTXOIndex) LookupUTXO(coinType cointype.CoinType, class stake.SSFeeScriptClass, hash160 []byte) (*wire.OutPoint, int64, int64, uint32, error) {
+	if m.err != nil {
+		return nil, 0, 0, 0, m.err
+	}
+	utxo, ok := m.utxos[indexKey(coinType, class, hash160)]
+	if !ok {
+		return nil, 0, 0, 0, nil
+	}
+	outpoint := utxo.OutPoint
+	return &outpoint, utxo.Value, int64(utxo.BlockHeight), utxo.BlockIndex, nil
+}
+
+// TestSelectAugmentInput ensures existing SSFee UTXOs are only selected for
+// augmentation when they are found in the index, are not in-flight, and are
+// not spent.
+func TestSelectAugmentInput(t *testing.T) {
+	hash160 := makeTestHash160(0xAA)
+	utxo := AugmentInput{
+		OutPoint:    wire.OutPoint{Hash: chainhash.Hash{0x01}, Index: 1, Tree: wire.TxTreeStake},
+		Value:       5000,
+		BlockHeight: 90,
+		BlockIndex:  2,
+	}
+	index := &mockUTXOIndex{utxos: map[string]AugmentInput{
+		indexKey(1, stake.SSFeeScriptClassP2PKH, hash160): utxo,
+	}}
+	isTrue := func(wire.OutPoint) bool { return true }
+	isFalse := func(wire.OutPoint) bool { return false }
+
+	tests := []struct {
+		name     string
+		src      *AugmentSource
+		coinType cointype.CoinType
+		want     *AugmentInput
+	}{{
+		name:     "nil source",
+		src:      nil,
+		coinType: 1,
+	}, {
+		name:     "no index",
+		src:      &AugmentSource{IsSpent: isFalse},
+		coinType: 1,
+	}, {
+		name:     "available",
+		src:      &AugmentSource{Index: index, IsSpent: isFalse},
+		coinType: 1,
+		want:     &utxo,
+	}, {
+		name:     "available without spent check",
+		src:      &AugmentSource{Index: index},
+		coinType: 1,
+		want:     &utxo,
+	}, {
+		name:     "not found for coin type",
+		src:      &AugmentSource{Index: index},
+		coinType: 2,
+	}, {
+		name:     "index error",
+		src:      &AugmentSource{Index: &mockUTXOIndex{err: errors.New("fail")}},
+		coinType: 1,
+	}, {
+		name:     "spent",
+		src:      &AugmentSource{Index: index, IsSpent: isTrue},
+		coinType: 1,
+	}, {
+		name: "in-flight",
+		src: &AugmentSource{
+			Index:      index,
+			IsInFlight: func(wire.OutPoint, int64) bool { return true },
+		},
+		coinType: 1,
+	}, {
+		name: "consolidation height",
+		src: &AugmentSource{
+			Index:                  index,
+			ConsolidationIntervals: map[cointype.CoinType]uint32{1: 10},
+		},
+		coinType: 1,
+		want:     &utxo,
+	}, {
+		name: "not consolidation height",
+		src: &AugmentSource{
+			Index:                  index,
+			ConsolidationIntervals: map[cointype.CoinType]uint32{1: 7},
+		},
+		coinType: 1,
+	}, {
+		name: "interval of another coin type",
+		src: &AugmentSource{
+			Index:                  index,
+			ConsolidationIntervals: map[cointype.CoinType]uint32{2: 7},
+		},
+		coinType: 1,
+		want:     &utxo,
+	}}
+
+	for _, test := range tests {
+		got := SelectAugmentInput(test.src, test.coinType,
+			stake.SSFeeScriptClassP2PKH, hash160, 100)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: mismatched input -- got %+v, want %+v", test.name,
+				got, test.want)
+		}
+	}
+}
+
+// TestSSFeeAugmentation_Staker ensures staker SSFee transactions augment the
+// existing SSFee UTXO of the consolidation address, mark it in-flight, and
+// fall back to a null input once it is in-flight.
+func TestSSFeeAugmentation_Staker(t *testing.T) {
+	for _, coinType := range []cointype.CoinType{cointype.CoinTypeVAR, 1} {
+		hash160 := makeTestHash160(0xBB)
+		utxo := AugmentInput{
+			OutPoint:    wire.OutPoint{Hash: chainhash.Hash{0x02}, Index: 1, Tree: wire.TxTreeStake},
+			Value:       5000,
+			BlockHeight: 150,
+			BlockIndex:  3,
+		}
+		inFlight := make(map[wire.OutPoint]int64)
+		src := &AugmentSource{
+			Index: &mockUTXOIndex{utxos: map[string]AugmentInput{
+				indexKey(coinType, stake.SSFeeScriptClassP2PKH, hash160): utxo,
+			}},
+			IsInFlight: func(outpoint wire.OutPoint, height int64) bool {
+				_, ok := inFlight[outpoint]
+				return ok
+			},
+			MarkInFlight: func(outpoint wire.OutPoint, height int64) {
+				inFlight[outpoint] = height
+			},
+		}
+		voter := createMockVoterWithConsolidationAddr(t, coinType, 1000, hash160)
+		voters := []*dcrutil.Tx{voter}
+
+		ssFeeTxns, err := NewStakerTxs(coinType, 1500, voters, 200, src)
+		if err != nil {
+			t.Fatalf("Failed to create staker SSFee: %v", err)
+		}
+		if len(ssFeeTxns) != 1 {
+			t.Fatalf("Expected 1 SSFee transaction, got %d", len(ssFeeTxns))
+		}
+		tx := ssFeeTxns[0].MsgTx()
+		txIn := tx.TxIn[0]
+		if txIn.PreviousOutPoint != utxo.OutPoint {
+			t.Fatalf("Expected input %v, got %v", utxo.OutPoint,
+				txIn.PreviousOutPoint)
+		}
+		if txIn.ValueIn != utxo.Value || txIn.BlockHeight != utxo.BlockHeight ||
+			txIn.BlockIndex != utxo.BlockIndex {
+
+			t.Fatalf("Unexpected fraud proof data: %+v", txIn)
+		}
+		if tx.TxOut[1].Value != 6500 {
+			t.Fatalf("Expected output value 6500, got %d", tx.TxOut[1].Value)
+		}
+		if height, ok := inFlight[utxo.OutPoint]; !ok || height != 200 {
+			t.Fatalf("Augmented UTXO was not marked in-flight: %v", inFlight)
+		}
+
+		// The UTXO is in-flight, so another template for the same height
+		// must create a new UTXO instead.
+		ssFeeTxns, err = NewStakerTxs(coinType, 1500, voters, 200, src)
+		if err != nil {
+			t.Fatalf("Failed to create staker SSFee: %v", err)
+		}
+		tx = ssFeeTxns[0].MsgTx()
+		if tx.TxIn[0].PreviousOutPoint.Index != wire.MaxPrevOutIndex {
+			t.Fatalf("Expected null input for in-flight UTXO")
+		}
+		if tx.TxOut[1].Value != 1500 {
+			t.Fatalf("Expected output value 1500, got %d", tx.TxOut[1].Value)
+		}
+	}
+}
+
+// TestSSFeeAugmentation_StakerP2SH ensures staker SSFee transactions pay votes
+// with a P2SH consolidation address with an OP_SSGEN-tagged P2SH script and
+// only augment existing SSFee UTXOs of the same script class.
+func TestSSFeeAugmentation_StakerP2SH(t *testing.T) {
+	hash160 := makeTestHash160(0xCC)
+	utxo := AugmentInput{
+		OutPoint:    wire.OutPoint{Hash: chainhash.Hash{0x04}, Index: 1, Tree: wire.TxTreeStake},
+		Value:       7000,
+		BlockHeight: 180,
+		BlockIndex:  4,
+	}
+	voteTx := createMockVoterWithConsolidationAddr(t, 1, 1000, hash160).MsgTx()
+	consolidationOut, err := stake.CreateSSFeeConsolidationScriptOutput(
+		stake.SSFeeScriptClassP2SH, hash160)
+	if err != nil {
+		t.Fatalf("Failed to create consolidation output: %v", err)
+	}
+	voteTx.TxOut[len(voteTx.TxOut)-1] = consolidationOut
+	voters := []*dcrutil.Tx{dcrutil.NewTx(voteTx)}
+	wantScript, err := stake.ConsolidationScriptToPkScript(
+		stake.SSFeeScriptClassP2SH, hash160)
+	if err != nil {
+		t.Fatalf("Failed to create payment script: %v", err)
+	}
+
+	// A UTXO of the P2PKH script class with the same hash160 must not be
+	// augmented.
+	src := &AugmentSource{Index: &mockUTXOIndex{utxos: map[string]AugmentInput{
+		indexKey(1, stake.SSFeeScriptClassP2PKH, hash160): utxo,
+	}}}
+	ssFeeTxns, err := NewStakerTxs(1, 1500, voters, 200, src)
+	if err != nil {
+		t.Fatalf("Failed to create staker SSFee: %v", err)
+	}
+	tx := ssFeeTxns[0].MsgTx()
+	if tx.TxIn[0].PreviousOutPoint.Index != wire.MaxPrevOutIndex {
+		t.Fatalf("Expected null input for UTXO of another script class")
+	}
+	if !bytes.Equal(tx.TxOut[1].PkScript, wantScript) {
+		t.Fatalf("Unexpected payment script %x, want %x", tx.TxOut[1].PkScript,
+			wantScript)
+	}
+
+	src = &AugmentSource{Index: &mockUTXOIndex{utxos: map[string]AugmentInput{
+		indexKey(1, stake.SSFeeScriptClassP2SH, hash160): utxo,
+	}}}
+	ssFeeTxns, err = NewStakerTxs(1, 1500, voters, 200, src)
+	if err != nil {
+		t.Fatalf("Failed to create staker SSFee: %v", err)
+	}
+	tx = ssFeeTxns[0].MsgTx()
+	if tx.TxIn[0].PreviousOutPoint != utxo.OutPoint {
+		t.Fatalf("Expected input %v, got %v", utxo.OutPoint,
+			tx.TxIn[0].PreviousOutPoint)
+	}
+	if tx.TxOut[1].Value != 8500 {
+		t.Fatalf("Expected output value 8500, got %d", tx.TxOut[1].Value)
+	}
+	if !bytes.Equal(tx.TxOut[1].PkScript, wantScript) {
+		t.Fatalf("Unexpected payment script %x, want %x", tx.TxOut[1].PkScript,
+			wantScript)
+	}
+}
+
+// TestSSFeeAugmentation_Miner ensures miner SSFee transactions augment the
+// existing SSFee UTXO of the miner address found by the hash160 of its
+// payment script.
+func TestSSFeeAugmentation_Miner(t *testing.T) {
+	minerAddr := createMockAddress(t)
+	utxo := AugmentInput{
+		OutPoint:    wire.OutPoint{Hash: chainhash.Hash{0x03}, Index: 1, Tree: wire.TxTreeStake},
+		Value:       8000,
+		BlockHeight: 240,
+		BlockIndex:  5,
+	}
+	var marked []wire.OutPoint
+	src := &AugmentSource{
+		Index: &mockUTXOIndex{utxos: map[string]AugmentInput{
+			indexKey(2, stake.SSFeeScriptClassP2PKH, makeTestHash160(0x12)): utxo,
+		}},
+		IsSpent: func(wire.OutPoint) bool { return false },
+		MarkInFlight: func(outpoint wire.OutPoint, height int64) {
+			marked = append(marked, outpoint)
+		},
+	}
+
+	minerSSFeeTx, err := NewMinerTx(2, 2000, minerAddr, 250, src)
+	if err != nil {
+		t.Fatalf("Failed to create miner SSFee: %v", err)
+	}
+	tx := minerSSFeeTx.MsgTx()
+	if tx.TxIn[0].PreviousOutPoint != utxo.OutPoint {
+		t.Fatalf("Expected input %v, got %v", utxo.OutPoint,
+			tx.TxIn[0].PreviousOutPoint)
+	}
+	if tx.TxIn[0].ValueIn != utxo.Value {
+		t.Fatalf("Expected input value %d, got %d", utxo.Value,
+			tx.TxIn[0].ValueIn)
+	}
+	if tx.TxIn[0].SignatureScript == nil || len(tx.TxIn[0].SignatureScript) != 0 {
+		t.Fatalf("Expected empty signature script, got %x",
+			tx.TxIn[0].SignatureScript)
+	}
+	if tx.TxOut[1].Value != 10000 {
+		t.Fatalf("Expected output value 10000, got %d", tx.TxOut[1].Value)
+	}
+	if len(marked) != 1 || marked[0] != utxo.OutPoint {
+		t.Fatalf("Augmented UTXO was not marked in-flight: %v", marked)
+	}
+
+	// A nil miner address pays to an anyone can spend script which is never
+	// augmented.
+	minerSSFeeTx, err = NewMinerTx(2, 2000, nil, 250, src)
+	if err != nil {
+		t.Fatalf("Failed to create miner SSFee: %v", err)
+	}
+	if minerSSFeeTx.MsgTx().TxIn[0].PreviousOutPoint.Index != wire.MaxPrevOutIndex {
+		t.Fatal("Expected null input for nil miner address")
+	}
+}
+
+// TestSSFeeAugmentation_DoubleSpendPrevention ensures UTXOs that are spent,
+// such as by a user transaction in the same block, are not augmented.
+func TestSSFeeAugmentation_DoubleSpendPrevention(t *testing.T) {
+	hash160 := makeTestHash160(0xCC)
+	utxo := AugmentInput{
+		OutPoint: wire.OutPoint{Hash: chainhash.Hash{0x04}, Index: 1, Tree: wire.TxTreeStake},
+		Value:    5000,
+	}
+	var marked bool
+	src := &AugmentSource{
+		Index: &mockUTXOIndex{utxos: map[string]AugmentInput{
+			indexKey(1, stake.SSFeeScriptClassP2PKH, hash160): utxo,
+		}},
+		IsSpent: func(outpoint wire.OutPoint) bool {
+			return outpoint == utxo.OutPoint
+		},
+		MarkInFlight: func(wire.OutPoint, int64) { marked = true },
+	}
+	voter := createMockVoterWithConsolidationAddr(t, 1, 1000, hash160)
+
+	ssFeeTxns, err := NewStakerTxs(1, 1000, []*dcrutil.Tx{voter}, 100, src)
+	if err != nil {
+		t.Fatalf("Failed to create staker SSFee: %v", err)
+	}
+	tx := ssFeeTxns[0].MsgTx()
+	if tx.TxIn[0].PreviousOutPoint.Index != wire.MaxPrevOutIndex {
+		t.Fatal("Expected null input for spent UTXO")
+	}
+	if tx.TxOut[1].Value != 1000 {
+		t.Fatalf("Expected output value 1000, got %d", tx.TxOut[1].Value)
+	}
+	if marked {
+		t.Fatal("Spent UTXO was marked in-flight")
+	}
+}
+
+// TestSplitStakerFee ensures fees are split equally per vote with the
+// remainder returned separately.
+func TestSplitStakerFee(t *testing.T) {
+	tests := []struct {
+		totalFee      int64
+		numVotes      int
+		wantPerVote   int64
+		wantRemainder int64
+	}{
+		{totalFee: 1000, numVotes: 5, wantPerVote: 200, wantRemainder: 0},
+		{totalFee: 1001, numVotes: 5, wantPerVote: 200, wantRemainder: 1},
+		{totalFee: 3, numVotes: 5, wantPerVote: 0, wantRemainder: 3},
+		{totalFee: 1000, numVotes: 0, wantPerVote: 0, wantRemainder: 1000},
+	}
+	for _, test := range tests {
+		perVote, remainder := SplitStakerFee(test.totalFee, test.numVotes)
+		if perVote != test.wantPerVote || remainder != test.wantRemainder {
+			t.Errorf("SplitStakerFee(%d, %d) = %d, %d, want %d, %d",
+				test.totalFee, test.numVotes, perVote, remainder,
+				test.wantPerVote, test.wantRemainder)
+		}
+	}
+}
+
+// TestMinerPayScript ensures miner payment scripts are OP_SSGEN-tagged and
+// that the script class and hash160 of tagged P2PKH and P2SH scripts are
+// extracted.
+func TestMinerPayScript(t *testing.T) {
+	minerAddr := createMockAddress(t)
+	version, script := MinerPayScript(minerAddr)
+	if version != 0 {
+		t.Fatalf("Expected script version 0, got %d", version)
+	}
+	if len(script) != 26 || script[0] != txscript.OP_SSGEN {
+		t.Fatalf("Unexpected payment script %x", script)
+	}
+	class, got := StakeTaggedScriptHash(script)
+	if class != stake.SSFeeScriptClassP2PKH || !bytes.Equal(got, makeTestHash160(0x12)) {
+		t.Fatalf("Unexpected %v hash160 %x", class, got)
+	}
+
+	scriptHashAddr, err := stdaddr.NewAddressScriptHashV0FromHash(
+		makeTestHash160(0x34), chaincfg.MainNetParams())
+	if err != nil {
+		t.Fatalf("Failed to create script hash address: %v", err)
+	}
+	_, script = MinerPayScript(scriptHashAddr)
+	class, got = StakeTaggedScriptHash(script)
+	if class != stake.SSFeeScriptClassP2SH || !bytes.Equal(got, makeTestHash160(0x34)) {
+		t.Fatalf("Unexpected %v hash160 %x for P2SH script", class, got)
+	}
+
+	_, script = MinerPayScript(nil)
+	if !bytes.Equal(script, []byte{txscript.OP_SSGEN, txscript.OP_TRUE}) {
+		t.Fatalf("Unexpected anyone can spend script %x", script)
+	}
+	if _, got := StakeTaggedScriptHash(script); got != nil {
+		t.Fatalf("Unexpected hash160 %x for anyone can spend script", got)
+	}
+
+	// Untagged P2PKH scripts do not have a stake tagged hash160.
+	_, rawScript := minerAddr.PaymentScript()
+	if _, got := StakeTaggedScriptHash(rawScript); got != nil {
+		t.Fatalf("Unexpected hash160 %x for untagged script", got)
+	}
+}
+
+// Helper functions
+
+func createMockVoterWithConsolidationAddr(t *testing.T, coinType cointype.CoinType, reward int64, hash160 []byte) *dcrutil.Tx {
+	t.Helper()
+	voteTx := wire.NewMsgTx()
+	voteTx.Version = 3
+
+	// Vote structure:
+	// output[0] = block reference (OP_RETURN + 40 bytes)
+	// output[1] = vote bits (OP_RETURN)
+	// output[2] = VAR reward
+	// output[3] = consolidation address (OP_RETURN + "SC" + hash160)
+
+	// Block reference output (simplified)
+	voteTx.AddTxOut(&wire.TxOut{
+		Value:    0,
+		CoinType: cointype.CoinTypeVAR,
+		PkScript: append([]byte{txscript.OP_RETURN, 0x28}, make([]byte, 40)...), // OP_RETURN + OP_DATA_40 + 40 bytes
+	})
+
+	// Vote bits output
+	voteTx.AddTxOut(&wire.TxOut{
+		Value:    0,
+		CoinType: cointype.CoinTypeVAR,
+		PkScript: []byte{txscript.OP_RETURN, 0x02, 0x00, 0x00}, // OP_RETURN + OP_DATA_2 + votebits
+	})
+
+	// Reward output with P2PKH script
+	rewardPkScript := make([]byte, 0, 25)
+	rewardPkScript = append(rewardPkScript, txscript.OP_DUP)
+	rewardPkScript = append(rewardPkScript, txscript.OP_HASH160)
+	rewardPkScript = append(rewardPkScript, txscript.OP_DATA_20)
+	rewardPkScript = append(rewardPkScript, hash160...)
+	rewardPkScript = append(rewardPkScript, txscript.OP_EQUALVERIFY)
+	rewardPkScript = append(rewardPkScript, txscript.OP_CHECKSIG)
+
+	voteTx.AddTxOut(&wire.TxOut{
+		Value:    reward,
+		CoinType: coinType,
+		Version:  0,
+		PkScript: rewardPkScript,
+	})
+
+	// Consolidation address output: OP_RETURN + OP_DATA_22 + "SC" + hash160
+	consolidationScript := make([]byte, 24)
+	consolidationScript[0] = txscript.OP_RETURN // 0x6a
+	consolidationScript[1] = 0x16               // OP_DATA_22
+	consolidationScript[2] = 0x53               // 'S'
+	consolidationScript[3] = 0x43               // 'C'
+	copy(consolidationScript[4:24], hash160)
+
+	voteTx.AddTxOut(&wire.TxOut{
+		Value:    0,
+		CoinType: cointype.CoinTypeVAR,
+		Version:  0,
+		PkScript: consolidationScript,
+	})
+
+	return dcrutil.NewTx(voteTx)
+}
+
+func createMockAddress(t *testing.T) stdaddr.Address {
+	t.Helper()
+	hash160 := makeTestHash160(0x12)
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(hash160, mockMainNetParams())
+	if err != nil {
+		t.Fatalf("Failed to create mock address: %v", err)
+	}
+	return addr
+}
+
+func makeTestHash160(seed byte) []byte {
+	hash160 := make([]byte, 20)
+	for i := range hash160 {
+		hash160[i] = seed + byte(i)
+	}
+	return hash160
+}
+
+func mockMainNetParams() *chaincfg.Params {
+	return &chaincfg.Params{
+		PubKeyHashAddrID: [2]byte{0x13, 0x86}, // Mainnet P2PKH prefix
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package ssfee implements the construction of SSFee transactions.
+
+SSFee transactions live in the stake tree and distribute the fees of non-VAR
+coin types to the miner, and the staker share of the fees of all coin types to
+the voters, of the block that contains them.
+
+# Output Format
+
+Every SSFee transaction has exactly one input and two outputs:
+
+  - Output 0 is an OP_RETURN marker that makes the transaction hash unique.
+    Staker transactions use "SF" followed by the block height and the sequence
+    of the first vote they pay while miner transactions use "MF" followed by
+    the block height.
+  - Output 1 pays the fee in the coin type being distributed with an
+    OP_SSGEN-tagged script so wallets recognize it as a stake tree output.
+
+# Augmentation
+
+To avoid accumulating dust, an SSFee transaction may spend the existing SSFee
+UTXO of the address it pays and add the fee to its value rather than creating
+a new UTXO from a null input.  Callers control augmentation with an
+AugmentSource which looks up existing UTXOs, reports whether they are still
+available, and tracks UTXOs already augmented by pending block templates.
+
+Validation of SSFee transactions is performed by the stake and blockchain
+packages.
+*/
+package ssfee
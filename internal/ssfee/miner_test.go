@@ -2,7 +2,7 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package mining
+package ssfee
 
 import (
 	"testing"
@@ -13,8 +13,8 @@ import (
 	"github.com/monetarium/monetarium-node/wire"
 )
 
-// TestCreateMinerSSFeeTx tests the createMinerSSFeeTx function.
-func TestCreateMinerSSFeeTx(t *testing.T) {
+// TestNewMinerTx tests the NewMinerTx function.
+func TestNewMinerTx(t *testing.T) {
 	// Create a mock address for testing - use a simple P2PKH address
 	simNetParams := chaincfg.SimNetParams()
 	// Create a mock hash for the address
@@ -131,8 +131,8 @@ func TestCreateMinerSSFeeTx(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			// Pass nil SSFeeIndex, blockUtxos, fetchUtxoEntry, and generator to test null-input SSFee creation (no augmentation)
-			minerSSFeeTx, err := createMinerSSFeeTx(test.coinType, test.totalFee,
-				test.minerAddress, test.height, nil, nil, nil, nil)
+			minerSSFeeTx, err := NewMinerTx(test.coinType, test.totalFee,
+				test.minerAddress, test.height, nil)
 
 			if test.expectError {
 				if err == nil {
@@ -176,7 +176,7 @@ func TestMinerSSFeeOpReturn(t *testing.T) {
 
 	height := int64(12345)
 	// Pass nil SSFeeIndex, blockUtxos, fetchUtxoEntry, and generator to test null-input SSFee creation (no augmentation)
-	minerSSFeeTx, err := createMinerSSFeeTx(cointype.CoinType(1), 100000, mockAddr, height, nil, nil, nil, nil)
+	minerSSFeeTx, err := NewMinerTx(cointype.CoinType(1), 100000, mockAddr, height, nil)
 	if err != nil {
 		t.Fatalf("Failed to create miner SSFee tx: %v", err)
 	}
@@ -242,7 +242,7 @@ func TestMinerSSFeeDistribution(t *testing.T) {
 
 	for _, tc := range testCases {
 		// Pass nil SSFeeIndex, blockUtxos, fetchUtxoEntry, and generator to test null-input SSFee creation (no augmentation)
-		minerSSFeeTx, err := createMinerSSFeeTx(tc.coinType, tc.feeAmount, minerAddr, 1000, nil, nil, nil, nil)
+		minerSSFeeTx, err := NewMinerTx(tc.coinType, tc.feeAmount, minerAddr, 1000, nil)
 		if err != nil {
 			t.Errorf("Failed to create miner SSFee for coin type %d: %v", tc.coinType, err)
 			continue
@@ -0,0 +1,376 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ssfee
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/monetarium/monetarium-node/blockchain/stake"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+const (
+	// TxVersion is the transaction version of SSFee transactions.  Version 3
+	// or greater is required for coin type support.
+	TxVersion = wire.TxVersionTreasury
+)
+
+// UTXOIndex defines the interface used to look up the existing SSFee UTXO
+// paying to a script class and hash160 in a given coin type.  It is satisfied
+// by the SSFee index.
+type UTXOIndex interface {
+	// LookupUTXO returns the outpoint, value, block height, and block index
+	// of the SSFee UTXO for the provided coin type, script class, and
+	// hash160.  A nil outpoint is returned when there is no such UTXO.
+	LookupUTXO(coinType cointype.CoinType, class stake.SSFeeScriptClass, hash160 []byte) (*wire.OutPoint, int64, int64, uint32, error)
+}
+
+// AugmentSource houses the facilities used to select existing SSFee UTXOs to
+// augment with new fees rather than creating new UTXOs.  A nil source, or one
+// without an index, disables augmentation.
+type AugmentSource struct {
+	// Index is used to look up the existing SSFee UTXO for an address.
+	Index UTXOIndex
+
+	// IsSpent reports whether the provided outpoint is spent or otherwise
+	// unavailable, such as when it is spent by a transaction already in the
+	// block being built.  When nil, UTXOs found in the index are assumed to
+	// be available.
+	IsSpent func(outpoint wire.OutPoint) bool
+
+	// IsInFlight reports whether the provided outpoint is already augmented
+	// by a pending block template for the provided height.  It may be nil.
+	IsInFlight func(outpoint wire.OutPoint, height int64) bool
+
+	// MarkInFlight is invoked with every outpoint selected for augmentation
+	// along with the height of the block being built.  It may be nil.
+	MarkInFlight func(outpoint wire.OutPoint, height int64)
+
+	// ConsolidationIntervals defines the number of blocks between the heights
+	// at which existing SSFee UTXOs of each coin type are consolidated by
+	// augmentation.  New UTXOs are created at all other heights, which lowers
+	// contention with owners spending their outputs at the cost of more UTXOs.
+	// Coin types without an interval, or with an interval of 0 or 1, are
+	// consolidated at every height.
+	ConsolidationIntervals map[cointype.CoinType]uint32
+}
+
+// IsConsolidationHeight returns whether existing SSFee UTXOs of the provided
+// coin type are consolidated by augmentation in the block at the provided
+// height according to the consolidation interval of the coin type.
+func (src *AugmentSource) IsConsolidationHeight(coinType cointype.CoinType, height int64) bool {
+	interval := int64(src.ConsolidationIntervals[coinType])
+	return interval <= 1 || height%interval == 0
+}
+
+// AugmentInput describes an existing SSFee UTXO selected for augmentation.
+type AugmentInput struct {
+	OutPoint    wire.OutPoint
+	Value       int64
+	BlockHeight uint32
+	BlockIndex  uint32
+}
+
+// SelectAugmentInput returns the existing SSFee UTXO paying to the provided
+// script class and hash160 in the provided coin type that may be augmented by
+// an SSFee transaction in the block at the provided height.  It returns nil
+// when augmentation is disabled or there is no available UTXO, in which case a
+// new UTXO must be created instead.
+//
+// Failures to look up or verify a UTXO are not fatal and result in nil since
+// creating a new UTXO is always a valid fallback.
+func SelectAugmentInput(src *AugmentSource, coinType cointype.CoinType, class stake.SSFeeScriptClass, hash160 []byte, height int64) *AugmentInput {
+	if src == nil || src.Index == nil || hash160 == nil {
+		return nil
+	}
+	if !src.IsConsolidationHeight(coinType, height) {
+		log.Debugf("Height %d is not a consolidation height for coin type %d "+
+			"(will create new UTXO)", height, coinType)
+		return nil
+	}
+
+	outpoint, value, blockHeight, blockIndex, err := src.Index.LookupUTXO(
+		coinType, class, hash160)
+	if err != nil {
+		log.Debugf("Failed to query SSFee index for UTXO lookup: %v", err)
+		return nil
+	}
+	if outpoint == nil || value <= 0 {
+		log.Debugf("No existing SSFee UTXO found for coin type %d and %v "+
+			"hash160 %x (will create new UTXO)", coinType, class, hash160)
+		return nil
+	}
+
+	// Skip UTXOs already augmented by a pending template.
+	if src.IsInFlight != nil && src.IsInFlight(*outpoint, height) {
+		log.Debugf("SSFee UTXO %v is in-flight for height %d - skipping",
+			outpoint, height)
+		return nil
+	}
+
+	// Ensure the UTXO is still available since it might be spent by a
+	// transaction in the block being built.
+	if src.IsSpent != nil && src.IsSpent(*outpoint) {
+		log.Debugf("SSFee UTXO %v is spent - creating new UTXO instead",
+			outpoint)
+		return nil
+	}
+
+	log.Debugf("Found augmentable SSFee UTXO %v (value=%d, height=%d, "+
+		"index=%d) for coin type %d and %v hash160 %x", outpoint, value,
+		blockHeight, blockIndex, coinType, class, hash160)
+	return &AugmentInput{
+		OutPoint:    *outpoint,
+		Value:       value,
+		BlockHeight: uint32(blockHeight),
+		BlockIndex:  blockIndex,
+	}
+}
+
+// markInFlight notifies the source, if any, that the provided input was
+// selected for augmentation.
+func (src *AugmentSource) markInFlight(input *AugmentInput, height int64) {
+	if src != nil && src.MarkInFlight != nil && input != nil {
+		src.MarkInFlight(input.OutPoint, height)
+	}
+}
+
+// nullInput returns the null input used by SSFee transactions that create a
+// new UTXO.
+func nullInput(valueIn int64) *wire.TxIn {
+	return &wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+			wire.MaxPrevOutIndex, wire.TxTreeRegular),
+		Sequence:    wire.MaxTxInSequenceNum,
+		BlockHeight: wire.NullBlockHeight,
+		BlockIndex:  wire.NullBlockIndex,
+		ValueIn:     valueIn,
+	}
+}
+
+// augmentInput returns the input used by SSFee transactions that augment the
+// provided existing UTXO.
+func augmentInput(input *AugmentInput) *wire.TxIn {
+	return &wire.TxIn{
+		PreviousOutPoint: input.OutPoint,
+		Sequence:         wire.MaxTxInSequenceNum,
+		BlockHeight:      input.BlockHeight,
+		BlockIndex:       input.BlockIndex,
+		ValueIn:          input.Value,
+	}
+}
+
+// SplitStakerFee returns the fee paid per vote when the provided total fee is
+// split equally between the provided number of votes along with the remainder
+// that can't be split equally.
+func SplitStakerFee(totalFee int64, numVotes int) (int64, int64) {
+	if numVotes <= 0 {
+		return 0, totalFee
+	}
+	feePerVote := totalFee / int64(numVotes)
+	return feePerVote, totalFee - feePerVote*int64(numVotes)
+}
+
+// stakerGroup is a group of votes that share a consolidation address.  The
+// script class of the group is the one designated by its first vote.
+type stakerGroup struct {
+	voteIndices []int
+	class       stake.SSFeeScriptClass
+	hash160     []byte
+}
+
+// NewStakerTxs returns the staker SSFee transactions that distribute the
+// provided total fee of the provided coin type to the provided votes in the
+// block at the provided height.
+//
+// The fee is split equally per vote and votes that share a consolidation
+// address are batched into a single transaction to reduce UTXO fragmentation.
+// Any remainder that can't be split equally is paid to the group with the
+// lexicographically smallest consolidation address so the results are
+// deterministic.
+//
+// Each transaction has:
+//   - A single input which is either a null input that creates a new UTXO or
+//     the existing SSFee UTXO of the consolidation address when the source
+//     selects one for augmentation
+//   - An OP_RETURN output with the staker SSFee marker for the height and the
+//     sequence of the first vote of the group
+//   - An OP_SSGEN-tagged output of the script class designated by the votes
+//     paying to the consolidation address with the fee of the group plus the
+//     value of the augmented UTXO, if any
+func NewStakerTxs(coinType cointype.CoinType, totalFee int64, votes []*dcrutil.Tx, height int64, src *AugmentSource) ([]*dcrutil.Tx, error) {
+	if len(votes) == 0 {
+		return nil, nil
+	}
+
+	// Group the votes by consolidation address.
+	groups := make(map[string]*stakerGroup)
+	for i, vote := range votes {
+		class, hash160, err := stake.ExtractSSFeeConsolidationScript(vote.MsgTx())
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract consolidation address "+
+				"from vote %s: %w", vote.Hash(), err)
+		}
+		key := hex.EncodeToString(hash160)
+		if group, ok := groups[key]; ok {
+			group.voteIndices = append(group.voteIndices, i)
+			continue
+		}
+		groups[key] = &stakerGroup{
+			voteIndices: []int{i},
+			class:       class,
+			hash160:     hash160,
+		}
+	}
+	sortedKeys := make([]string, 0, len(groups))
+	for key := range groups {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	feePerVote, remainder := SplitStakerFee(totalFee, len(votes))
+	txns := make([]*dcrutil.Tx, 0, len(groups))
+	for i, key := range sortedKeys {
+		group := groups[key]
+		groupFee := feePerVote * int64(len(group.voteIndices))
+		if i == 0 && remainder > 0 {
+			groupFee += remainder
+		}
+		if groupFee <= 0 {
+			// Skip groups with zero fee due to rounding.
+			continue
+		}
+
+		payScript, err := stake.ConsolidationScriptToPkScript(group.class,
+			group.hash160)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert hash160 to pkScript: %w",
+				err)
+		}
+
+		input := SelectAugmentInput(src, coinType, group.class, group.hash160,
+			height)
+		tx := wire.NewMsgTx()
+		tx.Version = TxVersion
+		outputValue := groupFee
+		if input != nil {
+			tx.AddTxIn(augmentInput(input))
+			outputValue += input.Value
+		} else {
+			tx.AddTxIn(nullInput(0))
+		}
+		voteSeq := uint16(group.voteIndices[0])
+		tx.AddTxOut(&wire.TxOut{
+			Value:    0,
+			CoinType: coinType,
+			PkScript: stake.CreateStakerSSFeeMarker(height, voteSeq),
+		})
+		tx.AddTxOut(&wire.TxOut{
+			Value:    outputValue,
+			CoinType: coinType,
+			PkScript: payScript,
+		})
+
+		src.markInFlight(input, height)
+		stx := dcrutil.NewTx(tx)
+		stx.SetTree(wire.TxTreeStake)
+		txns = append(txns, stx)
+	}
+	return txns, nil
+}
+
+// MinerPayScript returns the script version and OP_SSGEN-tagged payment script
+// used by miner SSFee transactions to pay the provided address.  The tag
+// ensures the output is recognized as a stake tree output.  An anyone can
+// spend script is returned when the address is nil.
+func MinerPayScript(addr stdaddr.Address) (uint16, []byte) {
+	if addr == nil {
+		return 0, []byte{txscript.OP_SSGEN, txscript.OP_TRUE}
+	}
+	version, rawScript := addr.PaymentScript()
+	script := make([]byte, 0, len(rawScript)+1)
+	script = append(script, txscript.OP_SSGEN)
+	script = append(script, rawScript...)
+	return version, script
+}
+
+// StakeTaggedScriptHash returns the script class and hash160 paid to by the
+// provided OP_SSGEN-tagged P2PKH or P2SH script.  A nil hash160 is returned
+// when the script is not of either form.
+func StakeTaggedScriptHash(script []byte) (stake.SSFeeScriptClass, []byte) {
+	if len(script) == 0 || script[0] != txscript.OP_SSGEN {
+		return 0, nil
+	}
+	class, hash160, err := stake.ExtractSSFeePayScript(script)
+	if err != nil {
+		return 0, nil
+	}
+	return class, hash160
+}
+
+// NewMinerTx returns the miner SSFee transaction that distributes the provided
+// fee of the provided non-VAR coin type to the provided miner address in the
+// block at the provided height.  VAR miner fees are paid by the coinbase
+// instead.
+//
+// The transaction has:
+//   - A single input which is either a null input that creates a new UTXO or
+//     the existing SSFee UTXO of the miner address when the source selects
+//     one for augmentation
+//   - An OP_RETURN output with the miner SSFee marker for the height
+//   - An OP_SSGEN-tagged output paying to the miner address with the fee plus
+//     the value of the augmented UTXO, if any
+func NewMinerTx(coinType cointype.CoinType, totalFee int64, minerAddr stdaddr.Address, height int64, src *AugmentSource) (*dcrutil.Tx, error) {
+	if coinType == cointype.CoinTypeVAR {
+		return nil, errors.New("miner SSFee cannot distribute VAR fees")
+	}
+	if totalFee <= 0 {
+		return nil, fmt.Errorf("invalid fee amount: %d", totalFee)
+	}
+
+	scriptVersion, payScript := MinerPayScript(minerAddr)
+	var input *AugmentInput
+	if minerAddr != nil {
+		class, hash160 := StakeTaggedScriptHash(payScript)
+		input = SelectAugmentInput(src, coinType, class, hash160, height)
+	}
+
+	// The signature script must be explicitly empty rather than nil for
+	// validation.
+	tx := wire.NewMsgTx()
+	tx.Version = TxVersion
+	outputValue := totalFee
+	if input != nil {
+		tx.AddTxIn(augmentInput(input))
+		outputValue += input.Value
+	} else {
+		tx.AddTxIn(nullInput(totalFee))
+	}
+	tx.TxIn[0].SignatureScript = []byte{}
+	tx.AddTxOut(&wire.TxOut{
+		Value:    0,
+		CoinType: coinType,
+		PkScript: stake.CreateMinerSSFeeMarker(height),
+	})
+	tx.AddTxOut(&wire.TxOut{
+		Value:    outputValue,
+		CoinType: coinType,
+		Version:  scriptVersion,
+		PkScript: payScript,
+	})
+
+	src.markInFlight(input, height)
+	stx := dcrutil.NewTx(tx)
+	stx.SetTree(wire.TxTreeStake)
+	return stx, nil
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package supplyscan implements an optional background consistency scanner of the
+UTXO set.
+
+The scanner slowly iterates a snapshot of the UTXO set, pausing after every
+batch of entries so that it does not compete with block processing, and
+verifies the set independently of the incremental accounting performed while
+blocks are connected.
+
+# Feature Overview
+
+The following are the primary features provided:
+
+  - Verifies the amount of every unspent output is within the bounds of its
+    coin type
+  - Accumulates the supply of every coin type held by unspent outputs
+  - Cross-checks the supply of every SKA coin type against the amounts emitted
+    and burned according to the chain state, and against the emission index
+    when it is enabled
+  - Logs an error for every divergence found and reports the result of the
+    most recent scan via RPC
+*/
+package supplyscan
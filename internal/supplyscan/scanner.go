@@ -0,0 +1,373 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package supplyscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+	"github.com/monetarium/monetarium-node/internal/blockchain/indexers"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+const (
+	// DefaultBatchSize is the default number of UTXO set entries that are
+	// checked between the pauses of a scan.
+	DefaultBatchSize = 1000
+
+	// DefaultBatchDelay is the default pause after every batch of UTXO set
+	// entries.
+	DefaultBatchDelay = 50 * time.Millisecond
+
+	// notCurrentRetryInterval is the interval at which the scanner checks
+	// whether the chain is current again when a scan is due while it is not.
+	notCurrentRetryInterval = time.Minute
+
+	// maxReportedOutputs is the max number of unspent outputs with amounts
+	// out of bounds that are individually reported per coin type.
+	maxReportedOutputs = 10
+)
+
+// CoinTypeStatus reports the result of a scan of the UTXO set for a single
+// coin type.
+type CoinTypeStatus struct {
+	CoinType cointype.CoinType
+	Utxos    int64
+	Supply   int64
+
+	// OutOfBounds is the number of unspent outputs with amounts that are
+	// negative or exceed the max amount of the coin type.  Their amounts are
+	// not included in the supply.
+	OutOfBounds int64
+
+	// Emitted, Burned, and ExpectedSupply are the amounts emitted and burned
+	// according to the chain state and the supply they imply.  They are only
+	// set for configured SKA coin types.
+	Emitted        int64
+	Burned         int64
+	ExpectedSupply int64
+
+	// IndexedEmitted is the amount emitted according to the emission index.
+	// It is only set when the emission index is enabled and synced to the
+	// scanned block.
+	IndexedEmitted *int64
+
+	// Issues describes every divergence found for the coin type.
+	Issues []string
+}
+
+// Status reports the progress of the scan in progress, if any, and the result
+// of the most recently completed scan.
+type Status struct {
+	// Scanning indicates whether a scan is in progress and Scanned is the
+	// number of entries checked by it so far.
+	Scanning bool
+	Scanned  int64
+
+	// Scans is the number of completed scans.
+	Scans uint64
+
+	// LastScanStart and LastScanEnd are the times the most recently completed
+	// scan started and ended.  Hash and Height identify the main chain block
+	// the UTXO set it scanned corresponds to.
+	LastScanStart time.Time
+	LastScanEnd   time.Time
+	Hash          chainhash.Hash
+	Height        int64
+
+	// Consistent indicates whether the most recently completed scan did not
+	// find any divergence.
+	Consistent bool
+	CoinTypes  []CoinTypeStatus
+
+	// LastError is the error that caused the most recent scan to fail.
+	LastError string
+}
+
+// EmissionIndexer provides an interface for querying the SKA emissions tracked
+// by the emission index.
+//
+// The interface contract requires that all of these methods are safe for
+// concurrent access.
+type EmissionIndexer interface {
+	// Tip returns the current index tip.
+	Tip() (int64, *chainhash.Hash, error)
+
+	// Emissions returns the SKA emissions of the provided coin type connected
+	// to the main chain as of the current index tip ordered by height.
+	Emissions(coinType cointype.CoinType) ([]indexers.EmissionEntry, error)
+}
+
+// Config is a descriptor containing the UTXO supply scanner configuration.
+type Config struct {
+	// ChainParams identifies which chain parameters the scanner is associated
+	// with.
+	ChainParams *chaincfg.Params
+
+	// Interval is the delay between the end of a scan and the start of the
+	// next one.
+	Interval time.Duration
+
+	// BatchSize is the number of UTXO set entries that are checked between
+	// the pauses of a scan and BatchDelay is the duration of the pauses.
+	BatchSize  int64
+	BatchDelay time.Duration
+
+	// IsCurrent returns whether the chain believes it is synced with the
+	// network.  Scans are postponed while it is not to avoid flushing the
+	// UTXO cache during the initial chain sync.
+	IsCurrent func() bool
+
+	// ScanUtxoSet invokes the provided function with the outpoint, amount,
+	// and coin type of every entry of the UTXO set as of the current main
+	// chain tip and returns the tip along with the SKA supply accounted for
+	// by the chain state as of it.
+	ScanUtxoSet func(ctx context.Context, fn func(outpoint wire.OutPoint,
+		amount int64, coinType cointype.CoinType) error) (*blockchain.UtxoScanTip, error)
+
+	// EmissionIndex is the optional emission index the supply of the SKA
+	// coin types is additionally cross-checked against.
+	EmissionIndex EmissionIndexer
+}
+
+// Scanner periodically scans the UTXO set in the background to verify the
+// amounts of all unspent outputs are within the bounds of their coin type and
+// that the supply of every SKA coin type they hold matches the supply
+// accounted for by the chain state.
+type Scanner struct {
+	cfg Config
+
+	mtx    sync.Mutex
+	status Status
+}
+
+// New returns a new UTXO supply scanner for the provided configuration.
+func New(cfg *Config) *Scanner {
+	return &Scanner{cfg: *cfg}
+}
+
+// Status returns the progress of the scan in progress, if any, and the result
+// of the most recently completed scan.
+//
+// This function is safe for concurrent access.
+func (s *Scanner) Status() Status {
+	s.mtx.Lock()
+	status := s.status
+	status.CoinTypes = append([]CoinTypeStatus(nil), s.status.CoinTypes...)
+	s.mtx.Unlock()
+	return status
+}
+
+// Run periodically scans the UTXO set until the provided context is cancelled.
+// It must be run as a goroutine.
+func (s *Scanner) Run(ctx context.Context) {
+	log.Infof("UTXO supply scanner started (interval %v)", s.cfg.Interval)
+
+	delay := time.Duration(0)
+	for {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			log.Info("UTXO supply scanner stopped")
+			return
+		}
+
+		if !s.cfg.IsCurrent() {
+			delay = notCurrentRetryInterval
+			continue
+		}
+		s.scan(ctx)
+		delay = s.cfg.Interval
+	}
+}
+
+// scanState houses the totals accumulated by a scan in progress.
+type scanState struct {
+	chainParams *chaincfg.Params
+	coinTypes   map[cointype.CoinType]*CoinTypeStatus
+}
+
+// add checks the amount of an unspent output against the bounds of its coin
+// type and includes it in the supply of the coin type.
+func (state *scanState) add(outpoint wire.OutPoint, amount int64, coinType cointype.CoinType) {
+	status, ok := state.coinTypes[coinType]
+	if !ok {
+		status = &CoinTypeStatus{CoinType: coinType}
+		state.coinTypes[coinType] = status
+	}
+	status.Utxos++
+
+	maxAmount := int64(coinType.MaxAmount())
+	if amount < 0 || amount > maxAmount || amount > maxAmount-status.Supply {
+		status.OutOfBounds++
+		if status.OutOfBounds <= maxReportedOutputs {
+			status.Issues = append(status.Issues, fmt.Sprintf("output %v "+
+				"amount %d is out of bounds (max amount %d, supply so far %d)",
+				outpoint, amount, maxAmount, status.Supply))
+		}
+		return
+	}
+	status.Supply += amount
+}
+
+// finish cross-checks the accumulated supply of the SKA coin types against the
+// supply accounted for by the chain state and the emission index, if any, as
+// of the scanned tip and returns the status of every coin type ordered by coin
+// type.
+func (state *scanState) finish(tip *blockchain.UtxoScanTip, emissionIndex EmissionIndexer) ([]CoinTypeStatus, error) {
+	// Check every configured coin type even when it has no unspent outputs.
+	for coinType := range state.chainParams.SKACoins {
+		if _, ok := state.coinTypes[coinType]; !ok {
+			state.coinTypes[coinType] = &CoinTypeStatus{CoinType: coinType}
+		}
+	}
+
+	// Only cross-check against the emission index when it is synced to at
+	// least the scanned block.
+	if emissionIndex != nil {
+		indexHeight, _, err := emissionIndex.Tip()
+		if err != nil {
+			return nil, err
+		}
+		if indexHeight < tip.Height {
+			emissionIndex = nil
+		}
+	}
+
+	result := make([]CoinTypeStatus, 0, len(state.coinTypes))
+	for coinType, status := range state.coinTypes {
+		if status.OutOfBounds > maxReportedOutputs {
+			status.Issues = append(status.Issues, fmt.Sprintf("%d more "+
+				"outputs with amounts out of bounds",
+				status.OutOfBounds-maxReportedOutputs))
+		}
+		if !coinType.IsSKA() {
+			result = append(result, *status)
+			continue
+		}
+
+		if _, ok := state.chainParams.SKACoins[coinType]; !ok {
+			status.Issues = append(status.Issues, "unspent outputs exist "+
+				"for a coin type that is not configured")
+			result = append(result, *status)
+			continue
+		}
+
+		status.Emitted = tip.SKAEmitted[coinType]
+		status.Burned = tip.SKABurned[coinType]
+		status.ExpectedSupply = status.Emitted - status.Burned
+		if status.Supply != status.ExpectedSupply {
+			status.Issues = append(status.Issues, fmt.Sprintf("UTXO supply "+
+				"%d does not match expected supply %d (emitted %d, burned "+
+				"%d)", status.Supply, status.ExpectedSupply, status.Emitted,
+				status.Burned))
+		}
+
+		if emissionIndex != nil {
+			emissions, err := emissionIndex.Emissions(coinType)
+			if err != nil {
+				return nil, err
+			}
+			var indexedEmitted int64
+			for _, emission := range emissions {
+				if emission.Height > tip.Height {
+					continue
+				}
+				for _, out := range emission.Outputs {
+					indexedEmitted += out.Value
+				}
+			}
+			status.IndexedEmitted = &indexedEmitted
+			if indexedEmitted != status.Emitted {
+				status.Issues = append(status.Issues, fmt.Sprintf("emission "+
+					"index amount %d does not match emitted amount %d",
+					indexedEmitted, status.Emitted))
+			}
+		}
+		result = append(result, *status)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CoinType < result[j].CoinType
+	})
+	return result, nil
+}
+
+// scan performs a single throttled scan of the UTXO set and records its result.
+// An error is logged for every divergence found.
+func (s *Scanner) scan(ctx context.Context) {
+	start := time.Now()
+	s.mtx.Lock()
+	s.status.Scanning = true
+	s.status.Scanned = 0
+	s.mtx.Unlock()
+
+	state := &scanState{
+		chainParams: s.cfg.ChainParams,
+		coinTypes:   make(map[cointype.CoinType]*CoinTypeStatus),
+	}
+	var scanned int64
+	tip, err := s.cfg.ScanUtxoSet(ctx, func(outpoint wire.OutPoint, amount int64, coinType cointype.CoinType) error {
+		state.add(outpoint, amount, coinType)
+		scanned++
+		if s.cfg.BatchSize <= 0 || scanned%s.cfg.BatchSize != 0 {
+			return nil
+		}
+
+		s.mtx.Lock()
+		s.status.Scanned = scanned
+		s.mtx.Unlock()
+		select {
+		case <-time.After(s.cfg.BatchDelay):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	var coinTypes []CoinTypeStatus
+	if err == nil {
+		coinTypes, err = state.finish(tip, s.cfg.EmissionIndex)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.status.Scanning = false
+	s.status.Scanned = scanned
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			log.Errorf("UTXO supply scan failed: %v", err)
+			s.status.LastError = err.Error()
+		}
+		return
+	}
+
+	s.status.Scans++
+	s.status.LastScanStart = start
+	s.status.LastScanEnd = time.Now()
+	s.status.Hash = tip.Hash
+	s.status.Height = tip.Height
+	s.status.Consistent = true
+	s.status.CoinTypes = coinTypes
+	s.status.LastError = ""
+	for _, status := range coinTypes {
+		for _, issue := range status.Issues {
+			s.status.Consistent = false
+			log.Errorf("UTXO supply divergence for %v at height %d: %s",
+				status.CoinType, tip.Height, issue)
+		}
+	}
+	if s.status.Consistent {
+		log.Infof("UTXO supply scan of %d entries at height %d found no "+
+			"divergence (took %v)", scanned, tip.Height,
+			s.status.LastScanEnd.Sub(start).Round(time.Second))
+	}
+}
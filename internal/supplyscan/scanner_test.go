@@ -0,0 +1,194 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package supplyscan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/internal/blockchain"
+	"github.com/monetarium/monetarium-node/internal/blockchain/indexers"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// fakeUtxo describes an unspent output provided by a fake UTXO set scan.
+type fakeUtxo struct {
+	amount   int64
+	coinType cointype.CoinType
+}
+
+// fakeEmissionIndex provides a fake emission index for testing.
+type fakeEmissionIndex struct {
+	height    int64
+	emissions map[cointype.CoinType][]indexers.EmissionEntry
+}
+
+// Tip returns the configured height of the fake emission index.
+func (idx *fakeEmissionIndex) Tip() (int64, *chainhash.Hash, error) {
+	return idx.height, &chainhash.Hash{}, nil
+}
+
+// Emissions returns the configured emissions of the provided coin type.
+func (idx *fakeEmissionIndex) Emissions(coinType cointype.CoinType) ([]indexers.EmissionEntry, error) {
+	return idx.emissions[coinType], nil
+}
+
+// TestScan ensures a scan of the UTXO set accumulates the supply of every coin
+// type and reports amounts out of bounds, SKA supply that does not match the
+// supply accounted for by the chain state, unconfigured SKA coin types, and
+// emission index amounts that do not match the chain state.
+func TestScan(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	const height = 100
+	tip := &blockchain.UtxoScanTip{
+		Height:     height,
+		SKAEmitted: map[cointype.CoinType]int64{1: 1000, 2: 0},
+		SKABurned:  map[cointype.CoinType]int64{1: 100, 2: 0},
+	}
+	emissionIndex := &fakeEmissionIndex{
+		height: height,
+		emissions: map[cointype.CoinType][]indexers.EmissionEntry{
+			1: {{
+				Height:  50,
+				Outputs: []indexers.EmissionOutput{{Value: 600}, {Value: 400}},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		utxos      []fakeUtxo
+		index      *fakeEmissionIndex
+		consistent bool
+		issues     map[cointype.CoinType]int
+	}{{
+		name: "consistent",
+		utxos: []fakeUtxo{
+			{amount: 5000, coinType: cointype.CoinTypeVAR},
+			{amount: 500, coinType: 1},
+			{amount: 400, coinType: 1},
+		},
+		index:      emissionIndex,
+		consistent: true,
+	}, {
+		name: "amounts out of bounds",
+		utxos: []fakeUtxo{
+			{amount: -1, coinType: cointype.CoinTypeVAR},
+			{amount: int64(cointype.MaxVARAmount) + 1, coinType: cointype.CoinTypeVAR},
+			{amount: 900, coinType: 1},
+		},
+		issues: map[cointype.CoinType]int{cointype.CoinTypeVAR: 2},
+	}, {
+		name: "SKA supply does not match",
+		utxos: []fakeUtxo{
+			{amount: 1000, coinType: 1},
+		},
+		issues: map[cointype.CoinType]int{1: 1},
+	}, {
+		name: "unconfigured SKA coin type",
+		utxos: []fakeUtxo{
+			{amount: 900, coinType: 1},
+			{amount: 1, coinType: 200},
+		},
+		issues: map[cointype.CoinType]int{200: 1},
+	}, {
+		name: "emission index does not match",
+		utxos: []fakeUtxo{
+			{amount: 900, coinType: 1},
+		},
+		index: &fakeEmissionIndex{
+			height: height,
+			emissions: map[cointype.CoinType][]indexers.EmissionEntry{
+				1: {{Height: 50, Outputs: []indexers.EmissionOutput{{Value: 999}}}},
+			},
+		},
+		issues: map[cointype.CoinType]int{1: 1},
+	}, {
+		name: "emission index behind is ignored",
+		utxos: []fakeUtxo{
+			{amount: 900, coinType: 1},
+		},
+		index: &fakeEmissionIndex{
+			height: height - 1,
+			emissions: map[cointype.CoinType][]indexers.EmissionEntry{
+				1: {{Height: 50, Outputs: []indexers.EmissionOutput{{Value: 999}}}},
+			},
+		},
+		consistent: true,
+	}}
+
+	for _, test := range tests {
+		cfg := &Config{
+			ChainParams: params,
+			BatchSize:   2,
+			ScanUtxoSet: func(ctx context.Context, fn func(wire.OutPoint, int64, cointype.CoinType) error) (*blockchain.UtxoScanTip, error) {
+				for i, utxo := range test.utxos {
+					outpoint := wire.OutPoint{Index: uint32(i)}
+					if err := fn(outpoint, utxo.amount, utxo.coinType); err != nil {
+						return nil, err
+					}
+				}
+				return tip, nil
+			},
+		}
+		if test.index != nil {
+			cfg.EmissionIndex = test.index
+		}
+		s := New(cfg)
+		s.scan(context.Background())
+
+		status := s.Status()
+		if status.Scanning || status.Scans != 1 || status.LastError != "" {
+			t.Fatalf("%s: unexpected scan state: %+v", test.name, status)
+		}
+		if status.Scanned != int64(len(test.utxos)) {
+			t.Fatalf("%s: got %d scanned entries, want %d", test.name,
+				status.Scanned, len(test.utxos))
+		}
+		if status.Height != height {
+			t.Fatalf("%s: got height %d, want %d", test.name, status.Height,
+				height)
+		}
+		if status.Consistent != test.consistent {
+			t.Fatalf("%s: got consistent %v, want %v (%+v)", test.name,
+				status.Consistent, test.consistent, status.CoinTypes)
+		}
+		for _, ctStatus := range status.CoinTypes {
+			if got, want := len(ctStatus.Issues), test.issues[ctStatus.CoinType]; got != want {
+				t.Fatalf("%s: got %d issues for %v, want %d: %v", test.name,
+					got, ctStatus.CoinType, want, ctStatus.Issues)
+			}
+		}
+		for i := 1; i < len(status.CoinTypes); i++ {
+			if status.CoinTypes[i-1].CoinType >= status.CoinTypes[i].CoinType {
+				t.Fatalf("%s: coin types are not ordered", test.name)
+			}
+		}
+	}
+
+	// Amounts out of bounds must be excluded from the supply.
+	s := New(&Config{
+		ChainParams: params,
+		ScanUtxoSet: func(ctx context.Context, fn func(wire.OutPoint, int64, cointype.CoinType) error) (*blockchain.UtxoScanTip, error) {
+			fn(wire.OutPoint{Index: 0}, 900, 1)
+			fn(wire.OutPoint{Index: 1}, int64(cointype.MaxSKAAmount)+1, 1)
+			return tip, nil
+		},
+	})
+	s.scan(context.Background())
+	for _, ctStatus := range s.Status().CoinTypes {
+		if ctStatus.CoinType != 1 {
+			continue
+		}
+		if ctStatus.Supply != 900 || ctStatus.OutOfBounds != 1 ||
+			ctStatus.Utxos != 2 || ctStatus.ExpectedSupply != 900 {
+
+			t.Fatalf("unexpected SKA-1 status: %+v", ctStatus)
+		}
+	}
+}
@@ -16,14 +16,19 @@ import (
 	"github.com/monetarium/monetarium-node/blockchain/stake"
 	"github.com/monetarium/monetarium-node/connmgr"
 	"github.com/monetarium/monetarium-node/database"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
 	"github.com/monetarium/monetarium-node/internal/blockchain"
 	"github.com/monetarium/monetarium-node/internal/blockchain/indexers"
+	"github.com/monetarium/monetarium-node/internal/emission"
+	"github.com/monetarium/monetarium-node/internal/eventsink"
 	"github.com/monetarium/monetarium-node/internal/fees"
 	"github.com/monetarium/monetarium-node/internal/mempool"
 	"github.com/monetarium/monetarium-node/internal/mining"
 	"github.com/monetarium/monetarium-node/internal/mining/cpuminer"
 	"github.com/monetarium/monetarium-node/internal/netsync"
 	"github.com/monetarium/monetarium-node/internal/rpcserver"
+	"github.com/monetarium/monetarium-node/internal/ssfee"
+	"github.com/monetarium/monetarium-node/internal/supplyscan"
 	"github.com/monetarium/monetarium-node/mixing/mixpool"
 	"github.com/monetarium/monetarium-node/peer"
 	"github.com/monetarium/monetarium-node/txscript"
@@ -60,12 +65,15 @@ var (
 	logRotator *rotator.Rotator
 
 	adxrLog = backendLog.Logger("ADXR")
+	alocLog = backendLog.Logger("ALOC")
 	amgrLog = backendLog.Logger("AMGR")
 	bcdbLog = backendLog.Logger("BCDB")
+	cfeeLog = backendLog.Logger("CFEE")
 	chanLog = backendLog.Logger("CHAN")
 	cmgrLog = backendLog.Logger("CMGR")
 	dcrdLog = backendLog.Logger("DCRD")
 	discLog = backendLog.Logger("DISC")
+	evntLog = backendLog.Logger("EVNT")
 	feesLog = backendLog.Logger("FEES")
 	indxLog = backendLog.Logger("INDX")
 	minrLog = backendLog.Logger("MINR")
@@ -73,6 +81,8 @@ var (
 	peerLog = backendLog.Logger("PEER")
 	rpcsLog = backendLog.Logger("RPCS")
 	scrpLog = backendLog.Logger("SCRP")
+	skaeLog = backendLog.Logger("SKAE")
+	splyLog = backendLog.Logger("SPLY")
 	srvrLog = backendLog.Logger("SRVR")
 	stkeLog = backendLog.Logger("STKE")
 	syncLog = backendLog.Logger("SYNC")
@@ -83,19 +93,30 @@ var (
 // Initialize package-global logger variables.
 func init() {
 	addrmgr.UseLogger(amgrLog)
+	blockalloc.UseLogger(alocLog)
 	blockchain.UseLogger(chanLog)
+	blockchain.UseSKAEmissionLogger(skaeLog)
 	blockchain.UseTreasuryLogger(trsyLog)
 	connmgr.UseLogger(cmgrLog)
 	database.UseLogger(bcdbLog)
+	emission.UseLogger(skaeLog)
+	eventsink.UseLogger(evntLog)
 	fees.UseLogger(feesLog)
+	fees.UseCoinTypeFeeLogger(cfeeLog)
 	indexers.UseLogger(indxLog)
 	mempool.UseLogger(txmpLog)
+	mempool.UseCoinTypeFeeLogger(cfeeLog)
+	mempool.UseSKAEmissionLogger(skaeLog)
 	mining.UseLogger(minrLog)
+	mining.UseAllocationLogger(alocLog)
+	mining.UseSKAEmissionLogger(skaeLog)
 	mixpool.UseLogger(mixpLog)
 	cpuminer.UseLogger(minrLog)
+	ssfee.UseLogger(minrLog)
 	peer.UseLogger(peerLog)
 	rpcserver.UseLogger(rpcsLog)
 	stake.UseLogger(stkeLog)
+	supplyscan.UseLogger(splyLog)
 	netsync.UseLogger(syncLog)
 	txscript.UseLogger(scrpLog)
 }
@@ -103,12 +124,15 @@ func init() {
 // subsystemLoggers maps each subsystem identifier to its associated logger.
 var subsystemLoggers = map[string]slog.Logger{
 	"ADXR": adxrLog,
+	"ALOC": alocLog,
 	"AMGR": amgrLog,
 	"BCDB": bcdbLog,
+	"CFEE": cfeeLog,
 	"CHAN": chanLog,
 	"CMGR": cmgrLog,
 	"DCRD": dcrdLog,
 	"DISC": discLog,
+	"EVNT": evntLog,
 	"FEES": feesLog,
 	"INDX": indxLog,
 	"MINR": minrLog,
@@ -116,6 +140,8 @@ var subsystemLoggers = map[string]slog.Logger{
 	"PEER": peerLog,
 	"RPCS": rpcsLog,
 	"SCRP": scrpLog,
+	"SKAE": skaeLog,
+	"SPLY": splyLog,
 	"SRVR": srvrLog,
 	"STKE": stkeLog,
 	"SYNC": syncLog,
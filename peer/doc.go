@@ -99,7 +99,11 @@ Of special interest are inventory messages.  Rather than manually sending MsgInv
 messages via Queuemessage, the inventory vectors should be queued using the
 QueueInventory function.  It employs batching and trickling along with
 intelligent known remote peer inventory detection and avoidance through the use
-of a most-recently used algorithm.
+of a most-recently used algorithm.  Transaction inventory of SKA coin types
+should be queued using the QueueInventoryForCoinType function instead, which
+keeps the inventory of each coin type in a separate queue that is dequeued with
+weighted fair dequeuing so a flood of SKA inventory is unable to delay VAR
+inventory such as votes and tickets.
 
 # Message Sending Helper Functions
 
@@ -5,6 +5,7 @@ go 1.18
 require (
 	github.com/davecgh/go-spew v1.1.1
 	github.com/monetarium/monetarium-node/chaincfg/chainhash v1.0.6
+	github.com/monetarium/monetarium-node/cointype v1.0.6
 	github.com/monetarium/monetarium-node/container/lru v1.0.6
 	github.com/monetarium/monetarium-node/crypto/blake256 v1.0.6
 	github.com/monetarium/monetarium-node/crypto/rand v1.0.6
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"sort"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+const (
+	// invQueueVARWeight is the number of inventory vectors dequeued from the
+	// VAR inventory queue in each round of the weighted fair dequeuing.
+	// Votes, tickets, and blocks are latency-critical for consensus and are
+	// all announced through the VAR queue.
+	invQueueVARWeight = 4
+
+	// invQueueSKAWeight is the number of inventory vectors dequeued from the
+	// inventory queue of each SKA coin type in each round of the weighted
+	// fair dequeuing.
+	invQueueSKAWeight = 1
+)
+
+// queuedInv houses an inventory vector queued to be trickled to a peer along
+// with the coin type it is prioritized by.
+type queuedInv struct {
+	invVect  *wire.InvVect
+	coinType cointype.CoinType
+}
+
+// invQueue houses inventory waiting to be trickled to a peer in a separate
+// queue per coin type.  Inventory is dequeued from the queues with weighted
+// fair dequeuing so that a flood of inventory of one SKA coin type is unable
+// to delay the announcement of VAR inventory or the inventory of other SKA
+// coin types.
+//
+// It is not safe for concurrent access.
+type invQueue struct {
+	queues map[cointype.CoinType][]*wire.InvVect
+	len    int
+}
+
+// newInvQueue returns an empty inventory queue.
+func newInvQueue() *invQueue {
+	return &invQueue{
+		queues: make(map[cointype.CoinType][]*wire.InvVect),
+	}
+}
+
+// Len returns the number of queued inventory vectors across all coin types.
+func (q *invQueue) Len() int {
+	return q.len
+}
+
+// Push adds the provided inventory vector to the end of the queue of the
+// provided coin type.
+func (q *invQueue) Push(invVect *wire.InvVect, coinType cointype.CoinType) {
+	q.queues[coinType] = append(q.queues[coinType], invVect)
+	q.len++
+}
+
+// weight returns the number of inventory vectors dequeued from the queue of
+// the provided coin type in each round of the weighted fair dequeuing.
+func (q *invQueue) weight(coinType cointype.CoinType) int {
+	if coinType == cointype.CoinTypeVAR {
+		return invQueueVARWeight
+	}
+	return invQueueSKAWeight
+}
+
+// PopBatch removes and returns up to the provided maximum number of inventory
+// vectors.  The queues are visited in rounds in ascending coin type order with
+// each round dequeuing up to the weight of every queue, so VAR inventory is
+// always dequeued first and inventory of each coin type remains in the order
+// it was queued.
+func (q *invQueue) PopBatch(max int) []*wire.InvVect {
+	if max > q.len {
+		max = q.len
+	}
+	if max <= 0 {
+		return nil
+	}
+
+	coinTypes := make([]cointype.CoinType, 0, len(q.queues))
+	for coinType := range q.queues {
+		coinTypes = append(coinTypes, coinType)
+	}
+	sort.Slice(coinTypes, func(i, j int) bool {
+		return coinTypes[i] < coinTypes[j]
+	})
+
+	batch := make([]*wire.InvVect, 0, max)
+	for len(batch) < max {
+		for _, coinType := range coinTypes {
+			queue := q.queues[coinType]
+			n := q.weight(coinType)
+			if n > len(queue) {
+				n = len(queue)
+			}
+			if n > max-len(batch) {
+				n = max - len(batch)
+			}
+			batch = append(batch, queue[:n]...)
+			q.queues[coinType] = queue[n:]
+		}
+	}
+
+	// Remove the queues that were drained so they do not keep their backing
+	// arrays alive.
+	for _, coinType := range coinTypes {
+		if len(q.queues[coinType]) == 0 {
+			delete(q.queues, coinType)
+		}
+	}
+	q.len -= len(batch)
+	return batch
+}
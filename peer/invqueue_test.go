@@ -0,0 +1,81 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"testing"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestInvQueue ensures inventory is dequeued from the per coin type queues
+// with weighted fair dequeuing that favors VAR, that the inventory of each
+// coin type remains in the order it was queued, and that batches are limited
+// to the requested size.
+func TestInvQueue(t *testing.T) {
+	// newInv returns an inventory vector that identifies the coin type and
+	// position it was queued at by its hash.
+	newInv := func(coinType cointype.CoinType, i int) *wire.InvVect {
+		var hash chainhash.Hash
+		hash[0] = byte(coinType)
+		hash[1] = byte(i)
+		return wire.NewInvVect(wire.InvTypeTx, &hash)
+	}
+
+	// Flood the queue with inventory of SKA-1 ahead of the inventory of VAR
+	// and SKA-2.
+	q := newInvQueue()
+	for i := 0; i < 10; i++ {
+		q.Push(newInv(1, i), 1)
+	}
+	for i := 0; i < 6; i++ {
+		q.Push(newInv(cointype.CoinTypeVAR, i), cointype.CoinTypeVAR)
+	}
+	q.Push(newInv(2, 0), 2)
+	if q.Len() != 17 {
+		t.Fatalf("unexpected queue length -- got %d, want 17", q.Len())
+	}
+
+	want := []*wire.InvVect{
+		newInv(cointype.CoinTypeVAR, 0), newInv(cointype.CoinTypeVAR, 1),
+		newInv(cointype.CoinTypeVAR, 2), newInv(cointype.CoinTypeVAR, 3),
+		newInv(1, 0), newInv(2, 0),
+		newInv(cointype.CoinTypeVAR, 4), newInv(cointype.CoinTypeVAR, 5),
+		newInv(1, 1),
+	}
+	batch := q.PopBatch(len(want))
+	if len(batch) != len(want) {
+		t.Fatalf("unexpected batch size -- got %d, want %d", len(batch),
+			len(want))
+	}
+	for i := range want {
+		if *batch[i] != *want[i] {
+			t.Fatalf("unexpected inventory at index %d -- got %v, want %v",
+				i, batch[i], want[i])
+		}
+	}
+
+	// The remaining SKA-1 inventory is dequeued in order and the queue is
+	// empty afterwards even when a larger batch is requested.
+	batch = q.PopBatch(maxInvTrickleSize)
+	if len(batch) != 8 {
+		t.Fatalf("unexpected batch size -- got %d, want 8", len(batch))
+	}
+	for i, iv := range batch {
+		if want := newInv(1, i+2); *iv != *want {
+			t.Fatalf("unexpected inventory at index %d -- got %v, want %v",
+				i, iv, want)
+		}
+	}
+	if q.Len() != 0 || len(q.queues) != 0 {
+		t.Fatalf("queue not empty -- len %d, queues %d", q.Len(),
+			len(q.queues))
+	}
+	if batch := q.PopBatch(maxInvTrickleSize); batch != nil {
+		t.Fatalf("unexpected batch from empty queue: %v", batch)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/decred/go-socks/socks"
 	"github.com/decred/slog"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/container/lru"
 	"github.com/monetarium/monetarium-node/crypto/blake256"
 	"github.com/monetarium/monetarium-node/crypto/rand"
@@ -305,6 +306,11 @@ type Config struct {
 	// not send inv messages for transactions.
 	DisableRelayTx bool
 
+	// SKAConfigHash specifies the SKA configuration hash to advertise to
+	// the remote peer.  This field can be omitted in which case it will be
+	// the zero hash and therefore not advertised.
+	SKAConfigHash chainhash.Hash
+
 	// Listeners houses callback functions to be invoked on receiving peer
 	// messages.
 	Listeners MessageListeners
@@ -511,7 +517,7 @@ type Peer struct {
 	outputQueue   chan outMsg
 	sendQueue     chan outMsg
 	sendDoneQueue chan struct{}
-	outputInvChan chan *wire.InvVect
+	outputInvChan chan queuedInv
 	inQuit        chan struct{}
 	queueQuit     chan struct{}
 	outQuit       chan struct{}
@@ -1598,7 +1604,7 @@ out:
 // to outHandler to be actually written.
 func (p *Peer) queueHandler() {
 	var pendingMsgs []outMsg
-	var invSendQueue []*wire.InvVect
+	invSendQueue := newInvQueue()
 
 	// We keep the waiting flag so that we know if we have a message queued
 	// to the outHandler or not.  We could use the presence of a head of
@@ -1650,10 +1656,10 @@ out:
 			pendingMsgs = pendingMsgs[1:]
 			p.sendQueue <- next
 
-		case iv := <-p.outputInvChan:
+		case qi := <-p.outputInvChan:
 			// No handshake?  They'll find out soon enough.
 			if p.VersionKnown() {
-				invSendQueue = append(invSendQueue, iv)
+				invSendQueue.Push(qi.invVect, qi.coinType)
 			}
 
 		case <-trickleTimer.C:
@@ -1663,16 +1669,19 @@ out:
 			switch {
 			case atomic.LoadInt32(&p.disconnect) != 0:
 				continue
-			case len(invSendQueue) == 0:
+			case invSendQueue.Len() == 0:
 				trickleTimer.Reset(trickleTimeout())
 				continue
 			}
 
-			// Create and send as many inv messages as needed to
-			// drain the inventory send queue.
-			invMsg := wire.NewMsgInvSizeHint(uint(len(invSendQueue)))
-
-			for _, iv := range invSendQueue {
+			// Create and send a single inv message with the inventory
+			// dequeued fairly among the coin types.  Any inventory that
+			// does not fit is left for the next trickle so a flood of
+			// inventory of one coin type never queues more than a single
+			// message ahead of inventory of the others.
+			batch := invSendQueue.PopBatch(maxInvTrickleSize)
+			invMsg := wire.NewMsgInvSizeHint(uint(len(batch)))
+			for _, iv := range batch {
 				// Don't send inventory that became known after
 				// the initial check.
 				if p.knownInventory.Contains(*iv) {
@@ -1680,12 +1689,6 @@ out:
 				}
 
 				invMsg.AddInvVect(iv)
-				if len(invMsg.InvList) >= maxInvTrickleSize {
-					waiting = queuePacket(
-						outMsg{msg: invMsg},
-						&pendingMsgs, waiting)
-					invMsg = wire.NewMsgInvSizeHint(uint(len(invSendQueue)))
-				}
 
 				// Add the inventory that is being relayed to
 				// the known inventory for the peer.
@@ -1695,7 +1698,6 @@ out:
 				waiting = queuePacket(outMsg{msg: invMsg},
 					&pendingMsgs, waiting)
 			}
-			invSendQueue = nil
 
 			trickleTimer.Reset(trickleTimeout())
 
@@ -1869,8 +1871,25 @@ func (p *Peer) QueueMessage(msg wire.Message, doneChan chan<- struct{}) {
 // might not be sent right away, rather it is trickled to the peer in batches.
 // Inventory that the peer is already known to have is ignored.
 //
+// The inventory is prioritized along with VAR inventory.  Inventory of
+// transactions of SKA coin types should be queued with
+// QueueInventoryForCoinType instead.
+//
 // This function is safe for concurrent access.
 func (p *Peer) QueueInventory(invVect *wire.InvVect) {
+	p.QueueInventoryForCoinType(invVect, cointype.CoinTypeVAR)
+}
+
+// QueueInventoryForCoinType adds the passed inventory to the inventory send
+// queue of the provided coin type which might not be sent right away, rather
+// it is trickled to the peer in batches.  The inventory of each coin type is
+// queued separately and dequeued with weighted fair dequeuing that favors VAR
+// so that a flood of SKA inventory is unable to delay latency-critical VAR
+// inventory such as votes and tickets.  Inventory that the peer is already
+// known to have is ignored.
+//
+// This function is safe for concurrent access.
+func (p *Peer) QueueInventoryForCoinType(invVect *wire.InvVect, coinType cointype.CoinType) {
 	// Don't add the inventory to the send queue if the peer is already
 	// known to have it.
 	if p.knownInventory.Contains(*invVect) {
@@ -1884,7 +1903,7 @@ func (p *Peer) QueueInventory(invVect *wire.InvVect) {
 		return
 	}
 
-	p.outputInvChan <- invVect
+	p.outputInvChan <- queuedInv{invVect: invVect, coinType: coinType}
 }
 
 // QueueInventoryImmediate adds the passed inventory to the send queue to be
@@ -2066,6 +2085,10 @@ func (p *Peer) localVersionMsg() (*wire.MsgVersion, error) {
 	// Advertise if inv messages for transactions are desired.
 	msg.DisableRelayTx = p.cfg.DisableRelayTx
 
+	// Advertise the SKA configuration hash so the remote peer can detect a
+	// divergent SKA configuration before the chains fork apart.
+	msg.SKAConfigHash = p.cfg.SKAConfigHash
+
 	return msg, nil
 }
 
@@ -2232,7 +2255,7 @@ func newPeerBase(cfgOrig *Config, inbound bool) *Peer {
 		outputQueue:     make(chan outMsg, outputBufferSize),
 		sendQueue:       make(chan outMsg, 1),   // nonblocking sync
 		sendDoneQueue:   make(chan struct{}, 1), // nonblocking sync
-		outputInvChan:   make(chan *wire.InvVect, outputBufferSize),
+		outputInvChan:   make(chan queuedInv, outputBufferSize),
 		inQuit:          make(chan struct{}),
 		queueQuit:       make(chan struct{}),
 		outQuit:         make(chan struct{}),
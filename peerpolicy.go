@@ -0,0 +1,200 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+const (
+	// peerPolicyVersion is the version of the format the peer policy is
+	// persisted with.
+	peerPolicyVersion = 1
+
+	// coinTypeMisbehaviorScore is the amount the misbehavior score of a host
+	// for a coin type is increased by for every invalid transaction of the
+	// coin type it sends.
+	coinTypeMisbehaviorScore = 10
+
+	// coinTypeMuteThreshold is the misbehavior score of a host for a coin type
+	// at which transactions of the coin type are no longer accepted from it.
+	coinTypeMuteThreshold = 100
+
+	// coinTypeScoreHalfLife is the duration after which the misbehavior score
+	// of a host for a coin type has decayed to half of its value.  It is
+	// intentionally long so hosts that spam invalid transactions of a coin
+	// type remain muted across restarts.
+	coinTypeScoreHalfLife = 24 * time.Hour
+
+	// minCoinTypeScore is the misbehavior score below which the score of a
+	// host for a coin type is forgotten.
+	minCoinTypeScore = 1
+)
+
+// coinTypeScore is the misbehavior score of a host for a coin type along with
+// the time it was last updated.  The score decays exponentially with the
+// configured half-life since it was last updated.
+type coinTypeScore struct {
+	Score   float64 `json:"score"`
+	Updated int64   `json:"updated"`
+}
+
+// decayed returns the score decayed to the provided time.
+func (s coinTypeScore) decayed(now time.Time) float64 {
+	elapsed := now.Sub(time.Unix(s.Updated, 0))
+	if elapsed <= 0 {
+		return s.Score
+	}
+	halfLives := elapsed.Seconds() / coinTypeScoreHalfLife.Seconds()
+	return s.Score * math.Pow(0.5, halfLives)
+}
+
+// coinTypePeerPolicy tracks the per coin type misbehavior scores of remote
+// hosts, keyed by host like bans, which determine the coin types whose
+// transactions are no longer accepted from them.
+//
+// This is used to avoid processing floods of invalid SKA transactions from
+// hosts that are known to send them while still relaying all other coin types
+// with them.  Only SKA coin types are scored since VAR transactions include
+// the votes and tickets that must always be accepted.
+type coinTypePeerPolicy struct {
+	mtx    sync.Mutex
+	scores map[string]map[cointype.CoinType]coinTypeScore
+}
+
+// newCoinTypePeerPolicy returns a new empty coin type peer policy.
+func newCoinTypePeerPolicy() *coinTypePeerPolicy {
+	return &coinTypePeerPolicy{
+		scores: make(map[string]map[cointype.CoinType]coinTypeScore),
+	}
+}
+
+// Misbehaved increases the misbehavior score of the provided host for the
+// provided coin type and returns the resulting score along with whether or not
+// the coin type is muted for the host as a result.
+//
+// This function is safe for concurrent access.
+func (p *coinTypePeerPolicy) Misbehaved(host string, coinType cointype.CoinType, now time.Time) (float64, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	hostScores := p.scores[host]
+	if hostScores == nil {
+		hostScores = make(map[cointype.CoinType]coinTypeScore)
+		p.scores[host] = hostScores
+	}
+	score := hostScores[coinType].decayed(now) + coinTypeMisbehaviorScore
+	hostScores[coinType] = coinTypeScore{Score: score, Updated: now.Unix()}
+	return score, score >= coinTypeMuteThreshold
+}
+
+// IsMuted returns whether or not transactions of the provided coin type are no
+// longer accepted from the provided host.
+//
+// This function is safe for concurrent access.
+func (p *coinTypePeerPolicy) IsMuted(host string, coinType cointype.CoinType, now time.Time) bool {
+	p.mtx.Lock()
+	score, ok := p.scores[host][coinType]
+	p.mtx.Unlock()
+	return ok && score.decayed(now) >= coinTypeMuteThreshold
+}
+
+// persistedPeerPolicy is the format the bans and the per coin type misbehavior
+// scores of remote hosts are persisted with.  Ban expirations and score update
+// times are unix timestamps.
+type persistedPeerPolicy struct {
+	Version        uint32                                         `json:"version"`
+	Banned         map[string]int64                               `json:"banned"`
+	CoinTypeScores map[string]map[cointype.CoinType]coinTypeScore `json:"cointypescores"`
+}
+
+// writePeerPolicy writes the provided bans that have not yet expired along with
+// the misbehavior scores of the policy that have not decayed below the minimum
+// score to the provided writer.  It returns the number of bans and hosts with
+// scores written.
+func writePeerPolicy(w io.Writer, banned map[string]time.Time, policy *coinTypePeerPolicy, now time.Time) (int, int, error) {
+	persisted := persistedPeerPolicy{
+		Version:        peerPolicyVersion,
+		Banned:         make(map[string]int64, len(banned)),
+		CoinTypeScores: make(map[string]map[cointype.CoinType]coinTypeScore),
+	}
+	for host, until := range banned {
+		if now.Before(until) {
+			persisted.Banned[host] = until.Unix()
+		}
+	}
+
+	policy.mtx.Lock()
+	for host, hostScores := range policy.scores {
+		for coinType, score := range hostScores {
+			decayed := score.decayed(now)
+			if decayed < minCoinTypeScore {
+				continue
+			}
+			if persisted.CoinTypeScores[host] == nil {
+				persisted.CoinTypeScores[host] =
+					make(map[cointype.CoinType]coinTypeScore)
+			}
+			persisted.CoinTypeScores[host][coinType] = coinTypeScore{
+				Score:   decayed,
+				Updated: now.Unix(),
+			}
+		}
+	}
+	policy.mtx.Unlock()
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(&persisted); err != nil {
+		return 0, 0, err
+	}
+	return len(persisted.Banned), len(persisted.CoinTypeScores), nil
+}
+
+// readPeerPolicy reads the bans and per coin type misbehavior scores written
+// by writePeerPolicy from the provided reader.  The bans that have not yet
+// expired are returned and the scores that have not decayed below the minimum
+// score are added to the provided policy.
+func readPeerPolicy(r io.Reader, policy *coinTypePeerPolicy, now time.Time) (map[string]time.Time, error) {
+	var persisted persistedPeerPolicy
+	if err := json.NewDecoder(r).Decode(&persisted); err != nil {
+		return nil, err
+	}
+	if persisted.Version != peerPolicyVersion {
+		return nil, fmt.Errorf("unsupported peer policy version %d",
+			persisted.Version)
+	}
+
+	banned := make(map[string]time.Time, len(persisted.Banned))
+	for host, until := range persisted.Banned {
+		bannedUntil := time.Unix(until, 0)
+		if now.Before(bannedUntil) {
+			banned[host] = bannedUntil
+		}
+	}
+
+	policy.mtx.Lock()
+	for host, hostScores := range persisted.CoinTypeScores {
+		for coinType, score := range hostScores {
+			if score.decayed(now) < minCoinTypeScore {
+				continue
+			}
+			if policy.scores[host] == nil {
+				policy.scores[host] = make(map[cointype.CoinType]coinTypeScore)
+			}
+			policy.scores[host][coinType] = score
+		}
+	}
+	policy.mtx.Unlock()
+
+	return banned, nil
+}
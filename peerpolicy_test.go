@@ -0,0 +1,90 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+)
+
+// TestCoinTypePeerPolicy ensures hosts are muted for a coin type once they
+// send enough invalid transactions of it, that the misbehavior scores decay
+// over time, and that the bans and scores survive a persistence round trip.
+func TestCoinTypePeerPolicy(t *testing.T) {
+	t.Parallel()
+
+	const host = "192.0.2.1"
+	const coinType = cointype.CoinType(1)
+	now := time.Unix(1700000000, 0)
+
+	// Ensure the host is only muted for the coin type once the threshold is
+	// reached.
+	policy := newCoinTypePeerPolicy()
+	const numToMute = coinTypeMuteThreshold / coinTypeMisbehaviorScore
+	for i := 1; i <= numToMute; i++ {
+		_, muted := policy.Misbehaved(host, coinType, now)
+		if muted != (i == numToMute) {
+			t.Fatalf("unexpected muted status after %d invalid "+
+				"transactions: %v", i, muted)
+		}
+	}
+	if !policy.IsMuted(host, coinType, now) {
+		t.Fatal("host is not muted for the coin type")
+	}
+	if policy.IsMuted(host, 2, now) || policy.IsMuted("192.0.2.2", coinType,
+		now) {
+
+		t.Fatal("host is muted for an unrelated coin type or host")
+	}
+
+	// Ensure the score decays over time.
+	if policy.IsMuted(host, coinType, now.Add(coinTypeScoreHalfLife)) {
+		t.Fatal("host is still muted after a half-life")
+	}
+
+	// Ensure unexpired bans and scores that have not decayed below the
+	// minimum are restored.
+	policy.Misbehaved("192.0.2.3", coinType, now.Add(-30*coinTypeScoreHalfLife))
+	banned := map[string]time.Time{
+		"192.0.2.4": now.Add(time.Hour),
+		"192.0.2.5": now.Add(-time.Hour),
+	}
+	var buf bytes.Buffer
+	numBans, numScored, err := writePeerPolicy(&buf, banned, policy, now)
+	if err != nil {
+		t.Fatalf("unexpected error writing peer policy: %v", err)
+	}
+	if numBans != 1 || numScored != 1 {
+		t.Fatalf("unexpected number of bans and scored hosts written -- "+
+			"got %d and %d, want 1 and 1", numBans, numScored)
+	}
+
+	restored := newCoinTypePeerPolicy()
+	gotBanned, err := readPeerPolicy(&buf, restored, now)
+	if err != nil {
+		t.Fatalf("unexpected error reading peer policy: %v", err)
+	}
+	if len(gotBanned) != 1 || !gotBanned["192.0.2.4"].Equal(
+		banned["192.0.2.4"]) {
+
+		t.Fatalf("unexpected restored bans: %v", gotBanned)
+	}
+	if !restored.IsMuted(host, coinType, now) {
+		t.Fatal("host is not muted for the coin type after restoring")
+	}
+	if len(restored.scores) != 1 {
+		t.Fatalf("unexpected restored scores: %v", restored.scores)
+	}
+
+	// Ensure unsupported versions are rejected.
+	_, err = readPeerPolicy(bytes.NewBufferString(`{"version":0}`),
+		newCoinTypePeerPolicy(), now)
+	if err == nil {
+		t.Fatal("did not receive expected error for unsupported version")
+	}
+}
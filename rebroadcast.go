@@ -0,0 +1,183 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"sort"
+	"time"
+
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/crypto/rand"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
+	"github.com/monetarium/monetarium-node/internal/rpcserver"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+const (
+	// rebroadcastInitialDelay is the delay before inventory submitted through
+	// the RPC server is rebroadcast for the first time.
+	rebroadcastInitialDelay = 5 * time.Minute
+
+	// rebroadcastMaxDelay is the max delay between rebroadcasts of the same
+	// inventory the exponential backoff is capped at.
+	rebroadcastMaxDelay = 4 * time.Hour
+
+	// rebroadcastCheckInterval is the interval at which the tracked inventory
+	// is checked for entries that are due to be rebroadcast.
+	rebroadcastCheckInterval = time.Minute
+)
+
+// rebroadcastBackoff returns the delay before the next rebroadcast of
+// inventory that has already been rebroadcast the provided number of times.
+// The delay doubles with every attempt starting from the initial delay and is
+// capped at the max delay.
+func rebroadcastBackoff(attempts uint32) time.Duration {
+	delay := rebroadcastInitialDelay
+	for i := uint32(0); i < attempts && delay < rebroadcastMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > rebroadcastMaxDelay {
+		delay = rebroadcastMaxDelay
+	}
+	return delay
+}
+
+// rebroadcastJitter returns a random delay in the range [delay/2, delay) so
+// the rebroadcasts of inventory submitted at the same time do not happen in
+// lockstep and do not reveal the schedule to peers.
+func rebroadcastJitter(delay time.Duration) time.Duration {
+	half := delay / 2
+	return half + rand.Duration(delay-half)
+}
+
+// pendingRebroadcast describes inventory submitted through the RPC server that
+// has not yet made it into a block along with its rebroadcast schedule.
+type pendingRebroadcast struct {
+	data        interface{}
+	coinType    cointype.CoinType
+	added       time.Time
+	attempts    uint32
+	lastAttempt time.Time
+	nextAttempt time.Time
+
+	// saturated indicates the last scheduled rebroadcast was skipped because
+	// the block space allocated to the coin type was saturated.
+	saturated bool
+}
+
+// rebroadcastTracker keeps track of the inventory submitted through the RPC
+// server that is periodically rebroadcast with exponential backoff until it
+// makes it into a block.
+//
+// Rebroadcasting transactions of a coin type whose allocated block space is
+// saturated does not help them confirm any sooner, so such rebroadcasts are
+// postponed while the utilization of the coin type is at or above the max
+// utilization.
+//
+// It is NOT safe for concurrent access.
+type rebroadcastTracker struct {
+	pending        map[wire.InvVect]*pendingRebroadcast
+	maxUtilization float64
+}
+
+// newRebroadcastTracker returns a new empty rebroadcast tracker that postpones
+// rebroadcasts of coin types whose block space utilization is at or above the
+// provided max utilization.  A max utilization of 0 disables postponing.
+func newRebroadcastTracker(maxUtilization float64) *rebroadcastTracker {
+	return &rebroadcastTracker{
+		pending:        make(map[wire.InvVect]*pendingRebroadcast),
+		maxUtilization: maxUtilization,
+	}
+}
+
+// add starts tracking the provided inventory for rebroadcast.  Adding
+// inventory that is already tracked only updates its data so resubmitting a
+// transaction does not reset its backoff.
+func (t *rebroadcastTracker) add(iv wire.InvVect, data interface{}, now time.Time) {
+	if entry, ok := t.pending[iv]; ok {
+		entry.data = data
+		return
+	}
+
+	coinType := cointype.CoinTypeVAR
+	if tx, ok := data.(*dcrutil.Tx); ok {
+		coinType = blockalloc.GetTransactionCoinType(tx)
+	}
+	t.pending[iv] = &pendingRebroadcast{
+		data:        data,
+		coinType:    coinType,
+		added:       now,
+		nextAttempt: now.Add(rebroadcastJitter(rebroadcastInitialDelay)),
+	}
+}
+
+// remove stops tracking the provided inventory and returns whether or not it
+// was tracked.
+func (t *rebroadcastTracker) remove(iv wire.InvVect) bool {
+	if _, ok := t.pending[iv]; !ok {
+		return false
+	}
+	delete(t.pending, iv)
+	return true
+}
+
+// due returns the tracked inventory that is due to be rebroadcast as of the
+// provided time and schedules its next rebroadcast.  The utilization function
+// returns the fraction of the block space allocated to a coin type that is in
+// use.  Inventory of coin types that are saturated is rescheduled without
+// being returned.
+func (t *rebroadcastTracker) due(now time.Time, utilization func(cointype.CoinType) float64) []relayMsg {
+	var relay []relayMsg
+	saturated := make(map[cointype.CoinType]bool)
+	for iv, entry := range t.pending {
+		if now.Before(entry.nextAttempt) {
+			continue
+		}
+
+		isSaturated, ok := saturated[entry.coinType]
+		if !ok {
+			isSaturated = t.maxUtilization > 0 &&
+				utilization(entry.coinType) >= t.maxUtilization
+			saturated[entry.coinType] = isSaturated
+		}
+		entry.saturated = isSaturated
+		if !isSaturated {
+			ivCopy := iv
+			relay = append(relay, relayMsg{invVect: &ivCopy, data: entry.data})
+			entry.attempts++
+			entry.lastAttempt = now
+		}
+		delay := rebroadcastBackoff(entry.attempts)
+		entry.nextAttempt = now.Add(rebroadcastJitter(delay))
+	}
+	return relay
+}
+
+// list returns the tracked transactions ordered by the time they were added.
+func (t *rebroadcastTracker) list() []rpcserver.RebroadcastTx {
+	txns := make([]rpcserver.RebroadcastTx, 0, len(t.pending))
+	for iv, entry := range t.pending {
+		if iv.Type != wire.InvTypeTx {
+			continue
+		}
+		txns = append(txns, rpcserver.RebroadcastTx{
+			Hash:        iv.Hash,
+			CoinType:    entry.coinType,
+			Added:       entry.added,
+			Attempts:    entry.attempts,
+			LastAttempt: entry.lastAttempt,
+			NextAttempt: entry.nextAttempt,
+			Saturated:   entry.saturated,
+		})
+	}
+	sort.Slice(txns, func(i, j int) bool {
+		if !txns[i].Added.Equal(txns[j].Added) {
+			return txns[i].Added.Before(txns[j].Added)
+		}
+		return txns[i].Hash.String() < txns[j].Hash.String()
+	})
+	return txns
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 The Monetarium developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/wire"
+)
+
+// TestRebroadcastBackoff ensures the rebroadcast delay doubles with every
+// attempt and is capped at the max delay.
+func TestRebroadcastBackoff(t *testing.T) {
+	tests := []struct {
+		attempts uint32
+		want     time.Duration
+	}{
+		{attempts: 0, want: rebroadcastInitialDelay},
+		{attempts: 1, want: 2 * rebroadcastInitialDelay},
+		{attempts: 3, want: 8 * rebroadcastInitialDelay},
+		{attempts: 6, want: rebroadcastMaxDelay},
+		{attempts: 1000, want: rebroadcastMaxDelay},
+	}
+	for _, test := range tests {
+		got := rebroadcastBackoff(test.attempts)
+		if got != test.want {
+			t.Errorf("attempts %d: got delay %v, want %v", test.attempts, got,
+				test.want)
+		}
+	}
+}
+
+// TestRebroadcastTracker ensures the rebroadcast tracker schedules tracked
+// transactions with backoff and postpones the rebroadcasts of coin types whose
+// block space is saturated.
+func TestRebroadcastTracker(t *testing.T) {
+	newTx := func(coinType cointype.CoinType, lockTime uint32) (wire.InvVect, *dcrutil.Tx) {
+		msgTx := wire.NewMsgTx()
+		msgTx.LockTime = lockTime
+		msgTx.AddTxOut(&wire.TxOut{Value: 1, CoinType: coinType})
+		tx := dcrutil.NewTx(msgTx)
+		return *wire.NewInvVect(wire.InvTypeTx, tx.Hash()), tx
+	}
+	varIV, varTx := newTx(cointype.CoinTypeVAR, 1)
+	skaIV, skaTx := newTx(cointype.CoinType(1), 2)
+
+	now := time.Unix(1700000000, 0)
+	tracker := newRebroadcastTracker(0.9)
+	tracker.add(varIV, varTx, now)
+	tracker.add(skaIV, skaTx, now.Add(time.Second))
+
+	// Nothing is due before the initial delay elapses.
+	utilization := map[cointype.CoinType]float64{1: 0.95}
+	utilizationFn := func(coinType cointype.CoinType) float64 {
+		return utilization[coinType]
+	}
+	if relay := tracker.due(now, utilizationFn); len(relay) != 0 {
+		t.Fatalf("got %d due rebroadcasts before the initial delay", len(relay))
+	}
+
+	// Only the VAR transaction is rebroadcast since the block space of the SKA
+	// coin type is saturated.
+	now = now.Add(rebroadcastInitialDelay + time.Second)
+	relay := tracker.due(now, utilizationFn)
+	if len(relay) != 1 || *relay[0].invVect != varIV {
+		t.Fatalf("got %d due rebroadcasts, want only the VAR tx", len(relay))
+	}
+	txns := tracker.list()
+	if len(txns) != 2 {
+		t.Fatalf("got %d tracked txns, want 2", len(txns))
+	}
+	if txns[0].Hash != varIV.Hash || txns[0].Attempts != 1 ||
+		!txns[0].LastAttempt.Equal(now) || txns[0].Saturated {
+
+		t.Fatalf("unexpected VAR tx state: %+v", txns[0])
+	}
+	if txns[1].Hash != skaIV.Hash || txns[1].CoinType != 1 ||
+		txns[1].Attempts != 0 || !txns[1].Saturated {
+
+		t.Fatalf("unexpected SKA tx state: %+v", txns[1])
+	}
+	minNext := now.Add(rebroadcastBackoff(1) / 2)
+	if txns[0].NextAttempt.Before(minNext) {
+		t.Fatalf("next attempt %v is earlier than the backoff allows %v",
+			txns[0].NextAttempt, minNext)
+	}
+
+	// Resubmitting a tracked transaction must not reset its backoff.
+	tracker.add(varIV, varTx, now)
+	if got := tracker.list()[0]; got.Attempts != 1 {
+		t.Fatalf("resubmitting reset the attempts to %d", got.Attempts)
+	}
+
+	// The SKA transaction is rebroadcast once its block space is no longer
+	// saturated.
+	utilization[1] = 0.5
+	now = now.Add(rebroadcastMaxDelay)
+	relay = tracker.due(now, utilizationFn)
+	if len(relay) != 2 {
+		t.Fatalf("got %d due rebroadcasts, want 2", len(relay))
+	}
+
+	// Removed transactions are no longer tracked.
+	if !tracker.remove(skaIV) {
+		t.Fatal("failed to remove tracked SKA tx")
+	}
+	if tracker.remove(skaIV) {
+		t.Fatal("removed SKA tx that is no longer tracked")
+	}
+	unknown := *wire.NewInvVect(wire.InvTypeTx, &chainhash.Hash{0xff})
+	if tracker.remove(unknown) {
+		t.Fatal("removed unknown tx")
+	}
+	if txns := tracker.list(); len(txns) != 1 {
+		t.Fatalf("got %d tracked txns, want 1", len(txns))
+	}
+}
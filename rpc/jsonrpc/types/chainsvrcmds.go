@@ -45,6 +45,19 @@ const (
 	NDisconnect NodeSubCmd = "disconnect"
 )
 
+// AbandonRebroadcastTxCmd defines the abandonrebroadcasttx JSON-RPC command.
+type AbandonRebroadcastTxCmd struct {
+	TxHash string
+}
+
+// NewAbandonRebroadcastTxCmd returns a new instance which can be used to issue
+// an abandonrebroadcasttx JSON-RPC command.
+func NewAbandonRebroadcastTxCmd(txHash string) *AbandonRebroadcastTxCmd {
+	return &AbandonRebroadcastTxCmd{
+		TxHash: txHash,
+	}
+}
+
 // AddNodeCmd defines the addnode JSON-RPC command.
 type AddNodeCmd struct {
 	Addr   string
@@ -60,6 +73,19 @@ func NewAddNodeCmd(addr string, subCmd AddNodeSubCmd) *AddNodeCmd {
 	}
 }
 
+// BumpFeeEstimateCmd defines the bumpfeeestimate JSON-RPC command.
+type BumpFeeEstimateCmd struct {
+	TxHash string
+}
+
+// NewBumpFeeEstimateCmd returns a new instance which can be used to issue a
+// bumpfeeestimate JSON-RPC command.
+func NewBumpFeeEstimateCmd(txHash string) *BumpFeeEstimateCmd {
+	return &BumpFeeEstimateCmd{
+		TxHash: txHash,
+	}
+}
+
 // SStxInput represents the inputs to an SStx transaction. Specifically a
 // transactionsha and output number pair, along with the output amounts.
 type SStxInput struct {
@@ -143,6 +169,31 @@ func NewCreateRawTransactionCmd(inputs []TransactionInput, amounts map[string]fl
 	}
 }
 
+// CreateSweepTransactionCmd defines the createsweeptransaction JSON-RPC
+// command.
+type CreateSweepTransactionCmd struct {
+	CoinType  uint8
+	Outpoints []OutPoint
+	Address   string
+	Options   *FundRawTransactionOptions
+}
+
+// NewCreateSweepTransactionCmd returns a new instance which can be used to
+// issue a createsweeptransaction JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateSweepTransactionCmd(coinType uint8, outpoints []OutPoint,
+	address string, options *FundRawTransactionOptions) *CreateSweepTransactionCmd {
+
+	return &CreateSweepTransactionCmd{
+		CoinType:  coinType,
+		Outpoints: outpoints,
+		Address:   address,
+		Options:   options,
+	}
+}
+
 // DebugLevelCmd defines the debuglevel JSON-RPC command.  This command is not a
 // standard Bitcoin command.  It is an extension for btcd.
 type DebugLevelCmd struct {
@@ -198,6 +249,19 @@ func NewEstimateFeeCmd(numBlocks int64) *EstimateFeeCmd {
 	}
 }
 
+// EstimateFeeAccuracyCmd defines the estimatefeeaccuracy JSON-RPC command.
+type EstimateFeeAccuracyCmd struct {
+	CoinType *uint8
+}
+
+// NewEstimateFeeAccuracyCmd returns a new instance which can be used to issue
+// an estimatefeeaccuracy JSON-RPC command.
+func NewEstimateFeeAccuracyCmd(coinType *uint8) *EstimateFeeAccuracyCmd {
+	return &EstimateFeeAccuracyCmd{
+		CoinType: coinType,
+	}
+}
+
 // EstimateSmartFeeMode defines estimation mode to be used with
 // the estimatesmartfee command.
 type EstimateSmartFeeMode string
@@ -345,6 +409,37 @@ func NewExistsMempoolTxsCmd(txHashes []string) *ExistsMempoolTxsCmd {
 	}
 }
 
+// FundRawTransactionOptions houses the optional parameters of the
+// fundrawtransaction and createsweeptransaction JSON-RPC commands.
+type FundRawTransactionOptions struct {
+	FeeRate    *float64 `json:"feerate,omitempty"`    // In coins of the coin type per KB
+	ConfTarget *int64   `json:"conftarget,omitempty"` // Used to estimate the fee rate
+}
+
+// FundRawTransactionCmd defines the fundrawtransaction JSON-RPC command.
+type FundRawTransactionCmd struct {
+	HexTx         string
+	Outpoints     []OutPoint
+	ChangeAddress string
+	Options       *FundRawTransactionOptions
+}
+
+// NewFundRawTransactionCmd returns a new instance which can be used to issue a
+// fundrawtransaction JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewFundRawTransactionCmd(hexTx string, outpoints []OutPoint,
+	changeAddress string, options *FundRawTransactionOptions) *FundRawTransactionCmd {
+
+	return &FundRawTransactionCmd{
+		HexTx:         hexTx,
+		Outpoints:     outpoints,
+		ChangeAddress: changeAddress,
+		Options:       options,
+	}
+}
+
 // GenerateCmd defines the generate JSON-RPC command.
 type GenerateCmd struct {
 	NumBlocks uint32
@@ -376,6 +471,36 @@ func NewGetAddedNodeInfoCmd(dns bool, node *string) *GetAddedNodeInfoCmd {
 	}
 }
 
+// GetAddressReceivedCmd defines the getaddressreceived JSON-RPC command.
+type GetAddressReceivedCmd struct {
+	Address  string
+	CoinType uint8
+	MinConf  *int64 `jsonrpcdefault:"1"`
+}
+
+// NewGetAddressReceivedCmd returns a new instance which can be used to issue a
+// getaddressreceived JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetAddressReceivedCmd(address string, coinType uint8, minConf *int64) *GetAddressReceivedCmd {
+	return &GetAddressReceivedCmd{
+		Address:  address,
+		CoinType: coinType,
+		MinConf:  minConf,
+	}
+}
+
+// GetAllocationDivergenceCmd defines the getallocationdivergence JSON-RPC
+// command.
+type GetAllocationDivergenceCmd struct{}
+
+// NewGetAllocationDivergenceCmd returns a new instance which can be used to
+// issue a getallocationdivergence JSON-RPC command.
+func NewGetAllocationDivergenceCmd() *GetAllocationDivergenceCmd {
+	return &GetAllocationDivergenceCmd{}
+}
+
 // GetBestBlockCmd defines the getbestblock JSON-RPC command.
 type GetBestBlockCmd struct{}
 
@@ -460,6 +585,48 @@ func NewGetBlockHeaderCmd(hash string, verbose *bool) *GetBlockHeaderCmd {
 	}
 }
 
+// GetBlockSpacePolicyCmd defines the getblockspacepolicy JSON-RPC command.
+type GetBlockSpacePolicyCmd struct{}
+
+// NewGetBlockSpacePolicyCmd returns a new instance which can be used to issue
+// a getblockspacepolicy JSON-RPC command.
+func NewGetBlockSpacePolicyCmd() *GetBlockSpacePolicyCmd {
+	return &GetBlockSpacePolicyCmd{}
+}
+
+// GetBlockSpendsCmd defines the getblockspends JSON-RPC command.
+type GetBlockSpendsCmd struct {
+	Hash     string
+	CoinType *uint8
+	Verbose  *bool `jsonrpcdefault:"false"`
+}
+
+// NewGetBlockSpendsCmd returns a new instance which can be used to issue a
+// getblockspends JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetBlockSpendsCmd(hash string, coinType *uint8, verbose *bool) *GetBlockSpendsCmd {
+	return &GetBlockSpendsCmd{
+		Hash:     hash,
+		CoinType: coinType,
+		Verbose:  verbose,
+	}
+}
+
+// GetBlockStatsCmd defines the getblockstats JSON-RPC command.
+type GetBlockStatsCmd struct {
+	Hash string
+}
+
+// NewGetBlockStatsCmd returns a new instance which can be used to issue a
+// getblockstats JSON-RPC command.
+func NewGetBlockStatsCmd(hash string) *GetBlockStatsCmd {
+	return &GetBlockStatsCmd{
+		Hash: hash,
+	}
+}
+
 // GetBlockSubsidyCmd defines the getblocksubsidy JSON-RPC command.
 type GetBlockSubsidyCmd struct {
 	Height int64
@@ -475,6 +642,27 @@ func NewGetBlockSubsidyCmd(height int64, voters uint16) *GetBlockSubsidyCmd {
 	}
 }
 
+// TemplateRequest is a request object as defined in BIP22 and BIP23.  Only
+// the proposal mode of BIP23 is supported, in which case Data is the
+// hex-encoded candidate block to validate.
+type TemplateRequest struct {
+	Mode string `json:"mode"`
+	Data string `json:"data,omitempty"`
+}
+
+// GetBlockTemplateCmd defines the getblocktemplate JSON-RPC command.
+type GetBlockTemplateCmd struct {
+	Request TemplateRequest
+}
+
+// NewGetBlockTemplateCmd returns a new instance which can be used to issue a
+// getblocktemplate JSON-RPC command.
+func NewGetBlockTemplateCmd(request TemplateRequest) *GetBlockTemplateCmd {
+	return &GetBlockTemplateCmd{
+		Request: request,
+	}
+}
+
 // GetCFilterV2Cmd defines the getcfilterv2 JSON-RPC command.
 type GetCFilterV2Cmd struct {
 	BlockHash string
@@ -551,6 +739,22 @@ func NewGetHashesPerSecCmd() *GetHashesPerSecCmd {
 	return &GetHashesPerSecCmd{}
 }
 
+// GetIndexInfoCmd defines the getindexinfo JSON-RPC command.
+type GetIndexInfoCmd struct {
+	Name *string
+}
+
+// NewGetIndexInfoCmd returns a new instance which can be used to issue a
+// getindexinfo JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetIndexInfoCmd(name *string) *GetIndexInfoCmd {
+	return &GetIndexInfoCmd{
+		Name: name,
+	}
+}
+
 // GetInfoCmd defines the getinfo JSON-RPC command.
 type GetInfoCmd struct{}
 
@@ -560,6 +764,15 @@ func NewGetInfoCmd() *GetInfoCmd {
 	return &GetInfoCmd{}
 }
 
+// GetCoinTypesCmd defines the getcointypes JSON-RPC command.
+type GetCoinTypesCmd struct{}
+
+// NewGetCoinTypesCmd returns a new instance which can be used to issue a
+// getcointypes JSON-RPC command.
+func NewGetCoinTypesCmd() *GetCoinTypesCmd {
+	return &GetCoinTypesCmd{}
+}
+
 // GetSKAInfoCmd defines the getskainfo JSON-RPC command.
 type GetSKAInfoCmd struct{}
 
@@ -582,6 +795,26 @@ func NewGetEmissionStatusCmd(coinType uint8) *GetEmissionStatusCmd {
 	}
 }
 
+// GetEmissionRehearsalStatusCmd defines the getemissionrehearsalstatus
+// JSON-RPC command.
+type GetEmissionRehearsalStatusCmd struct{}
+
+// NewGetEmissionRehearsalStatusCmd returns a new instance which can be used to
+// issue a getemissionrehearsalstatus JSON-RPC command.
+func NewGetEmissionRehearsalStatusCmd() *GetEmissionRehearsalStatusCmd {
+	return &GetEmissionRehearsalStatusCmd{}
+}
+
+// GetEmissionWatchStatusCmd defines the getemissionwatchstatus JSON-RPC
+// command.
+type GetEmissionWatchStatusCmd struct{}
+
+// NewGetEmissionWatchStatusCmd returns a new instance which can be used to
+// issue a getemissionwatchstatus JSON-RPC command.
+func NewGetEmissionWatchStatusCmd() *GetEmissionWatchStatusCmd {
+	return &GetEmissionWatchStatusCmd{}
+}
+
 // GetHeadersCmd defines the getheaders JSON-RPC command.
 type GetHeadersCmd struct {
 	BlockLocators []string `json:"blocklocators"`
@@ -748,6 +981,24 @@ func NewGetRawTransactionCmd(txHash string, verbose *int) *GetRawTransactionCmd
 	}
 }
 
+// GetRecentUtilizationCmd defines the getrecentutilization JSON-RPC command.
+type GetRecentUtilizationCmd struct {
+	Count    *int `jsonrpcdefault:"10"`
+	CoinType *uint8
+}
+
+// NewGetRecentUtilizationCmd returns a new instance which can be used to issue
+// a getrecentutilization JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetRecentUtilizationCmd(count *int, coinType *uint8) *GetRecentUtilizationCmd {
+	return &GetRecentUtilizationCmd{
+		Count:    count,
+		CoinType: coinType,
+	}
+}
+
 // GetStakeDifficultyCmd is a type handling custom marshaling and
 // unmarshaling of getstakedifficulty JSON RPC commands.
 type GetStakeDifficultyCmd struct{}
@@ -877,6 +1128,24 @@ func NewGetTreasurySpendVotesCmd(block *string, tspends *[]string) *GetTreasuryS
 	}
 }
 
+// GetWatchOnlyBalanceCmd defines the getwatchonlybalance JSON-RPC command.
+type GetWatchOnlyBalanceCmd struct {
+	CoinType *uint8
+	MinConf  *int64 `jsonrpcdefault:"1"`
+}
+
+// NewGetWatchOnlyBalanceCmd returns a new instance which can be used to issue
+// a getwatchonlybalance JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetWatchOnlyBalanceCmd(coinType *uint8, minConf *int64) *GetWatchOnlyBalanceCmd {
+	return &GetWatchOnlyBalanceCmd{
+		CoinType: coinType,
+		MinConf:  minConf,
+	}
+}
+
 // GetWorkCmd defines the getwork JSON-RPC command.
 type GetWorkCmd struct {
 	Data *string
@@ -902,6 +1171,22 @@ func NewRegenTemplateCmd() *RegenTemplateCmd {
 	return &RegenTemplateCmd{}
 }
 
+// RemoveWatchOnlyAddressCmd defines the removewatchonlyaddress JSON-RPC
+// command.
+type RemoveWatchOnlyAddressCmd struct {
+	Address  string
+	CoinType uint8
+}
+
+// NewRemoveWatchOnlyAddressCmd returns a new instance which can be used to
+// issue a removewatchonlyaddress JSON-RPC command.
+func NewRemoveWatchOnlyAddressCmd(address string, coinType uint8) *RemoveWatchOnlyAddressCmd {
+	return &RemoveWatchOnlyAddressCmd{
+		Address:  address,
+		CoinType: coinType,
+	}
+}
+
 // HelpCmd defines the help JSON-RPC command.
 type HelpCmd struct {
 	Command *string
@@ -918,6 +1203,29 @@ func NewHelpCmd(command *string) *HelpCmd {
 	}
 }
 
+// ImportWatchOnlyAddressCmd defines the importwatchonlyaddress JSON-RPC
+// command.
+type ImportWatchOnlyAddressCmd struct {
+	Address    string
+	CoinType   uint8
+	Rescan     *bool  `jsonrpcdefault:"true"`
+	RescanFrom *int64 `jsonrpcdefault:"0"`
+}
+
+// NewImportWatchOnlyAddressCmd returns a new instance which can be used to
+// issue an importwatchonlyaddress JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewImportWatchOnlyAddressCmd(address string, coinType uint8, rescan *bool, rescanFrom *int64) *ImportWatchOnlyAddressCmd {
+	return &ImportWatchOnlyAddressCmd{
+		Address:    address,
+		CoinType:   coinType,
+		Rescan:     rescan,
+		RescanFrom: rescanFrom,
+	}
+}
+
 // InvalidateBlockCmd defines the invalidateblock JSON-RPC command.
 type InvalidateBlockCmd struct {
 	BlockHash string
@@ -931,6 +1239,60 @@ func NewInvalidateBlockCmd(hash string) *InvalidateBlockCmd {
 	}
 }
 
+// ListKnownSKAAddressesCmd defines the listknownskaaddresses JSON-RPC command.
+type ListKnownSKAAddressesCmd struct {
+	CoinType *uint8
+}
+
+// NewListKnownSKAAddressesCmd returns a new instance which can be used to
+// issue a listknownskaaddresses JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListKnownSKAAddressesCmd(coinType *uint8) *ListKnownSKAAddressesCmd {
+	return &ListKnownSKAAddressesCmd{
+		CoinType: coinType,
+	}
+}
+
+// ListRebroadcastTxsCmd defines the listrebroadcasttxs JSON-RPC command.
+type ListRebroadcastTxsCmd struct {
+	CoinType *uint8
+}
+
+// NewListRebroadcastTxsCmd returns a new instance which can be used to issue a
+// listrebroadcasttxs JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListRebroadcastTxsCmd(coinType *uint8) *ListRebroadcastTxsCmd {
+	return &ListRebroadcastTxsCmd{
+		CoinType: coinType,
+	}
+}
+
+// ListUnspentWatchOnlyCmd defines the listunspentwatchonly JSON-RPC command.
+type ListUnspentWatchOnlyCmd struct {
+	MinConf   *int64 `jsonrpcdefault:"1"`
+	MaxConf   *int64 `jsonrpcdefault:"9999999"`
+	Addresses *[]string
+	CoinType  *uint8
+}
+
+// NewListUnspentWatchOnlyCmd returns a new instance which can be used to issue
+// a listunspentwatchonly JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListUnspentWatchOnlyCmd(minConf, maxConf *int64, addresses *[]string, coinType *uint8) *ListUnspentWatchOnlyCmd {
+	return &ListUnspentWatchOnlyCmd{
+		MinConf:   minConf,
+		MaxConf:   maxConf,
+		Addresses: addresses,
+		CoinType:  coinType,
+	}
+}
+
 // LiveTicketsCmd is a type handling custom marshaling and
 // unmarshaling of livetickets JSON RPC commands.
 type LiveTicketsCmd struct{}
@@ -998,10 +1360,29 @@ func NewSendRawMixMessageCmd(command, message string) *SendRawMixMessageCmd {
 	}
 }
 
+// SendRawPackageCmd defines the sendrawpackage JSON-RPC command.
+type SendRawPackageCmd struct {
+	HexTxs        []string
+	AllowHighFees *bool `jsonrpcdefault:"false"`
+}
+
+// NewSendRawPackageCmd returns a new instance which can be used to issue a
+// sendrawpackage JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSendRawPackageCmd(hexTxs []string, allowHighFees *bool) *SendRawPackageCmd {
+	return &SendRawPackageCmd{
+		HexTxs:        hexTxs,
+		AllowHighFees: allowHighFees,
+	}
+}
+
 // SendRawTransactionCmd defines the sendrawtransaction JSON-RPC command.
 type SendRawTransactionCmd struct {
 	HexTx         string
 	AllowHighFees *bool `jsonrpcdefault:"false"`
+	Verbose       *bool `jsonrpcdefault:"false"`
 }
 
 // NewSendRawTransactionCmd returns a new instance which can be used to issue a
@@ -1009,10 +1390,33 @@ type SendRawTransactionCmd struct {
 //
 // The parameters which are pointers indicate they are optional.  Passing nil
 // for optional parameters will use the default value.
-func NewSendRawTransactionCmd(hexTx string, allowHighFees *bool) *SendRawTransactionCmd {
+func NewSendRawTransactionCmd(hexTx string, allowHighFees, verbose *bool) *SendRawTransactionCmd {
 	return &SendRawTransactionCmd{
 		HexTx:         hexTx,
 		AllowHighFees: allowHighFees,
+		Verbose:       verbose,
+	}
+}
+
+// SetBlockSpacePolicyCmd defines the setblockspacepolicy JSON-RPC command.
+type SetBlockSpacePolicyCmd struct {
+	OverflowStrategy  *string
+	StakeReserveBytes *uint32
+	CoinTypeCaps      *map[string]uint32 `jsonrpcusage:"{\"cointype\":maxbytes,...}"`
+}
+
+// NewSetBlockSpacePolicyCmd returns a new instance which can be used to issue
+// a setblockspacepolicy JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters leaves the associated part of the policy unchanged.
+func NewSetBlockSpacePolicyCmd(overflowStrategy *string, stakeReserveBytes *uint32,
+	coinTypeCaps *map[string]uint32) *SetBlockSpacePolicyCmd {
+
+	return &SetBlockSpacePolicyCmd{
+		OverflowStrategy:  overflowStrategy,
+		StakeReserveBytes: stakeReserveBytes,
+		CoinTypeCaps:      coinTypeCaps,
 	}
 }
 
@@ -1092,6 +1496,38 @@ func NewSubmitBlockCmd(hexBlock string, options *SubmitBlockOptions) *SubmitBloc
 	}
 }
 
+// TestBlockAssemblyCmd defines the testblockassembly JSON-RPC command.
+type TestBlockAssemblyCmd struct {
+	Txs []string
+}
+
+// NewTestBlockAssemblyCmd returns a new instance which can be used to issue a
+// testblockassembly JSON-RPC command.  Each entry is either the hash of a
+// mempool transaction or a serialized transaction encoded as hex.
+func NewTestBlockAssemblyCmd(txs []string) *TestBlockAssemblyCmd {
+	return &TestBlockAssemblyCmd{
+		Txs: txs,
+	}
+}
+
+// TestMempoolAcceptCmd defines the testmempoolaccept JSON-RPC command.
+type TestMempoolAcceptCmd struct {
+	HexTx         string
+	AllowHighFees *bool `jsonrpcdefault:"false"`
+}
+
+// NewTestMempoolAcceptCmd returns a new instance which can be used to issue a
+// testmempoolaccept JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewTestMempoolAcceptCmd(hexTx string, allowHighFees *bool) *TestMempoolAcceptCmd {
+	return &TestMempoolAcceptCmd{
+		HexTx:         hexTx,
+		AllowHighFees: allowHighFees,
+	}
+}
+
 // TicketFeeInfoCmd defines the ticketfeeinfo JSON-RPC command.
 type TicketFeeInfoCmd struct {
 	Blocks  *uint32
@@ -1218,18 +1654,175 @@ func NewGetBurnedCoinsCmd(coinType *uint8) *GetBurnedCoinsCmd {
 	}
 }
 
+// GetSKABurnsCmd defines the getskaburns JSON-RPC command.
+type GetSKABurnsCmd struct {
+	CoinType    uint8
+	StartHeight *int64 `jsonrpcdefault:"0"`
+	Count       *int32 `jsonrpcdefault:"100"`
+}
+
+// NewGetSKABurnsCmd returns a new instance which can be used to issue a
+// getskaburns JSON-RPC command.
+func NewGetSKABurnsCmd(coinType uint8, startHeight *int64, count *int32) *GetSKABurnsCmd {
+	return &GetSKABurnsCmd{
+		CoinType:    coinType,
+		StartHeight: startHeight,
+		Count:       count,
+	}
+}
+
+// GetSKAEmissionsCmd defines the getskaemissions JSON-RPC command.
+type GetSKAEmissionsCmd struct {
+	CoinType uint8
+}
+
+// NewGetSKAEmissionsCmd returns a new instance which can be used to issue a
+// getskaemissions JSON-RPC command.
+func NewGetSKAEmissionsCmd(coinType uint8) *GetSKAEmissionsCmd {
+	return &GetSKAEmissionsCmd{
+		CoinType: coinType,
+	}
+}
+
+// GetSKAActivationTimelineCmd defines the getskaactivationtimeline JSON-RPC
+// command.
+type GetSKAActivationTimelineCmd struct {
+	CoinType *uint8 // Optional: if nil, returns the timeline of all SKA coin types
+}
+
+// NewGetSKAActivationTimelineCmd returns a new instance which can be used to
+// issue a getskaactivationtimeline JSON-RPC command.
+func NewGetSKAActivationTimelineCmd(coinType *uint8) *GetSKAActivationTimelineCmd {
+	return &GetSKAActivationTimelineCmd{
+		CoinType: coinType,
+	}
+}
+
+// GetSKAEmissionStatusCmd defines the getskaemissionstatus JSON-RPC command.
+type GetSKAEmissionStatusCmd struct {
+	TxHash string
+}
+
+// NewGetSKAEmissionStatusCmd returns a new instance which can be used to issue
+// a getskaemissionstatus JSON-RPC command.
+func NewGetSKAEmissionStatusCmd(txHash string) *GetSKAEmissionStatusCmd {
+	return &GetSKAEmissionStatusCmd{
+		TxHash: txHash,
+	}
+}
+
+// EncodeEmissionAuthCmd defines the encodeemissionauth JSON-RPC command.
+type EncodeEmissionAuthCmd struct {
+	Descriptor string
+}
+
+// NewEncodeEmissionAuthCmd returns a new instance which can be used to issue
+// an encodeemissionauth JSON-RPC command.
+func NewEncodeEmissionAuthCmd(descriptor string) *EncodeEmissionAuthCmd {
+	return &EncodeEmissionAuthCmd{
+		Descriptor: descriptor,
+	}
+}
+
+// DecodeEmissionAuthCmd defines the decodeemissionauth JSON-RPC command.
+type DecodeEmissionAuthCmd struct {
+	HexScript string
+}
+
+// NewDecodeEmissionAuthCmd returns a new instance which can be used to issue
+// a decodeemissionauth JSON-RPC command.
+func NewDecodeEmissionAuthCmd(hexScript string) *DecodeEmissionAuthCmd {
+	return &DecodeEmissionAuthCmd{
+		HexScript: hexScript,
+	}
+}
+
+// GetEmissionKeysCmd defines the getemissionkeys JSON-RPC command.
+type GetEmissionKeysCmd struct{}
+
+// NewGetEmissionKeysCmd returns a new instance which can be used to issue a
+// getemissionkeys JSON-RPC command.
+func NewGetEmissionKeysCmd() *GetEmissionKeysCmd {
+	return &GetEmissionKeysCmd{}
+}
+
+// AttestEmissionKeyCmd defines the attestemissionkey JSON-RPC command.
+type AttestEmissionKeyCmd struct {
+	CoinType  uint8
+	Challenge string
+	Signature string
+}
+
+// NewAttestEmissionKeyCmd returns a new instance which can be used to issue an
+// attestemissionkey JSON-RPC command.
+func NewAttestEmissionKeyCmd(coinType uint8, challenge, signature string) *AttestEmissionKeyCmd {
+	return &AttestEmissionKeyCmd{
+		CoinType:  coinType,
+		Challenge: challenge,
+		Signature: signature,
+	}
+}
+
+// AuditSKASupplyCmd defines the auditskasupply JSON-RPC command.
+type AuditSKASupplyCmd struct{}
+
+// NewAuditSKASupplyCmd returns a new instance which can be used to issue an
+// auditskasupply JSON-RPC command.
+func NewAuditSKASupplyCmd() *AuditSKASupplyCmd {
+	return &AuditSKASupplyCmd{}
+}
+
+// GetSupplyScanInfoCmd defines the getsupplyscaninfo JSON-RPC command.
+type GetSupplyScanInfoCmd struct{}
+
+// NewGetSupplyScanInfoCmd returns a new instance which can be used to issue a
+// getsupplyscaninfo JSON-RPC command.
+func NewGetSupplyScanInfoCmd() *GetSupplyScanInfoCmd {
+	return &GetSupplyScanInfoCmd{}
+}
+
+// GetCoinTypeSnapshotCmd defines the getcointypesnapshot JSON-RPC command.
+type GetCoinTypeSnapshotCmd struct {
+	CoinType  uint8
+	Height    *int64
+	Aggregate *bool `jsonrpcdefault:"false"`
+}
+
+// NewGetCoinTypeSnapshotCmd returns a new instance which can be used to issue
+// a getcointypesnapshot JSON-RPC command.
+func NewGetCoinTypeSnapshotCmd(coinType uint8, height *int64, aggregate *bool) *GetCoinTypeSnapshotCmd {
+	return &GetCoinTypeSnapshotCmd{
+		CoinType:  coinType,
+		Height:    height,
+		Aggregate: aggregate,
+	}
+}
+
+// GetValidationStatsCmd defines the getvalidationstats JSON-RPC command.
+type GetValidationStatsCmd struct{}
+
+// NewGetValidationStatsCmd returns a new instance which can be used to issue a
+// getvalidationstats JSON-RPC command.
+func NewGetValidationStatsCmd() *GetValidationStatsCmd {
+	return &GetValidationStatsCmd{}
+}
+
 func init() {
 	// No special flags for commands in this file.
 	flags := dcrjson.UsageFlag(0)
 
+	dcrjson.MustRegister(Method("abandonrebroadcasttx"), (*AbandonRebroadcastTxCmd)(nil), flags)
 	dcrjson.MustRegister(Method("addnode"), (*AddNodeCmd)(nil), flags)
+	dcrjson.MustRegister(Method("bumpfeeestimate"), (*BumpFeeEstimateCmd)(nil), flags)
 	dcrjson.MustRegister(Method("createrawssrtx"), (*CreateRawSSRtxCmd)(nil), flags)
 	dcrjson.MustRegister(Method("createrawsstx"), (*CreateRawSStxCmd)(nil), flags)
 	dcrjson.MustRegister(Method("createrawtransaction"), (*CreateRawTransactionCmd)(nil), flags)
+	dcrjson.MustRegister(Method("createsweeptransaction"), (*CreateSweepTransactionCmd)(nil), flags)
 	dcrjson.MustRegister(Method("debuglevel"), (*DebugLevelCmd)(nil), flags)
 	dcrjson.MustRegister(Method("decoderawtransaction"), (*DecodeRawTransactionCmd)(nil), flags)
 	dcrjson.MustRegister(Method("decodescript"), (*DecodeScriptCmd)(nil), flags)
 	dcrjson.MustRegister(Method("estimatefee"), (*EstimateFeeCmd)(nil), flags)
+	dcrjson.MustRegister(Method("estimatefeeaccuracy"), (*EstimateFeeAccuracyCmd)(nil), flags)
 	dcrjson.MustRegister(Method("estimatesmartfee"), (*EstimateSmartFeeCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getfeestimatesbycointype"), (*GetFeeEstimatesByCoinTypeCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getmempoolfeesinfo"), (*GetMempoolFeesInfoCmd)(nil), flags)
@@ -1239,8 +1832,11 @@ func init() {
 	dcrjson.MustRegister(Method("existsliveticket"), (*ExistsLiveTicketCmd)(nil), flags)
 	dcrjson.MustRegister(Method("existslivetickets"), (*ExistsLiveTicketsCmd)(nil), flags)
 	dcrjson.MustRegister(Method("existsmempooltxs"), (*ExistsMempoolTxsCmd)(nil), flags)
+	dcrjson.MustRegister(Method("fundrawtransaction"), (*FundRawTransactionCmd)(nil), flags)
 	dcrjson.MustRegister(Method("generate"), (*GenerateCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getaddednodeinfo"), (*GetAddedNodeInfoCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getaddressreceived"), (*GetAddressReceivedCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getallocationdivergence"), (*GetAllocationDivergenceCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getbestblock"), (*GetBestBlockCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getbestblockhash"), (*GetBestBlockHashCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getblock"), (*GetBlockCmd)(nil), flags)
@@ -1248,19 +1844,27 @@ func init() {
 	dcrjson.MustRegister(Method("getblockcount"), (*GetBlockCountCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getblockhash"), (*GetBlockHashCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getblockheader"), (*GetBlockHeaderCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getblockspacepolicy"), (*GetBlockSpacePolicyCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getblockspends"), (*GetBlockSpendsCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getblockstats"), (*GetBlockStatsCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getblocksubsidy"), (*GetBlockSubsidyCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getblocktemplate"), (*GetBlockTemplateCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getcfilterv2"), (*GetCFilterV2Cmd)(nil), flags)
 	dcrjson.MustRegister(Method("getchaintips"), (*GetChainTipsCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getcoinsupply"), (*GetCoinSupplyCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getcointypes"), (*GetCoinTypesCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getconnectioncount"), (*GetConnectionCountCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getcurrentnet"), (*GetCurrentNetCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getdifficulty"), (*GetDifficultyCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getgenerate"), (*GetGenerateCmd)(nil), flags)
 	dcrjson.MustRegister(Method("gethashespersec"), (*GetHashesPerSecCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getheaders"), (*GetHeadersCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getindexinfo"), (*GetIndexInfoCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getinfo"), (*GetInfoCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getskainfo"), (*GetSKAInfoCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getemissionstatus"), (*GetEmissionStatusCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getemissionrehearsalstatus"), (*GetEmissionRehearsalStatusCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getemissionwatchstatus"), (*GetEmissionWatchStatusCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getmempoolinfo"), (*GetMempoolInfoCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getmininginfo"), (*GetMiningInfoCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getmixmessage"), (*GetMixMessageCmd)(nil), flags)
@@ -1271,6 +1875,7 @@ func init() {
 	dcrjson.MustRegister(Method("getpeerinfo"), (*GetPeerInfoCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getrawmempool"), (*GetRawMempoolCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getrawtransaction"), (*GetRawTransactionCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getrecentutilization"), (*GetRecentUtilizationCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getstakedifficulty"), (*GetStakeDifficultyCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getstakeversioninfo"), (*GetStakeVersionInfoCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getstakeversions"), (*GetStakeVersionsCmd)(nil), flags)
@@ -1280,21 +1885,31 @@ func init() {
 	dcrjson.MustRegister(Method("gettxout"), (*GetTxOutCmd)(nil), flags)
 	dcrjson.MustRegister(Method("gettxoutsetinfo"), (*GetTxOutSetInfoCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getvoteinfo"), (*GetVoteInfoCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getwatchonlybalance"), (*GetWatchOnlyBalanceCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getwork"), (*GetWorkCmd)(nil), flags)
 	dcrjson.MustRegister(Method("help"), (*HelpCmd)(nil), flags)
+	dcrjson.MustRegister(Method("importwatchonlyaddress"), (*ImportWatchOnlyAddressCmd)(nil), flags)
 	dcrjson.MustRegister(Method("invalidateblock"), (*InvalidateBlockCmd)(nil), flags)
+	dcrjson.MustRegister(Method("listknownskaaddresses"), (*ListKnownSKAAddressesCmd)(nil), flags)
+	dcrjson.MustRegister(Method("listrebroadcasttxs"), (*ListRebroadcastTxsCmd)(nil), flags)
+	dcrjson.MustRegister(Method("listunspentwatchonly"), (*ListUnspentWatchOnlyCmd)(nil), flags)
 	dcrjson.MustRegister(Method("livetickets"), (*LiveTicketsCmd)(nil), flags)
 	dcrjson.MustRegister(Method("node"), (*NodeCmd)(nil), flags)
 	dcrjson.MustRegister(Method("ping"), (*PingCmd)(nil), flags)
 	dcrjson.MustRegister(Method("reconsiderblock"), (*ReconsiderBlockCmd)(nil), flags)
 	dcrjson.MustRegister(Method("regentemplate"), (*RegenTemplateCmd)(nil), flags)
+	dcrjson.MustRegister(Method("removewatchonlyaddress"), (*RemoveWatchOnlyAddressCmd)(nil), flags)
 	dcrjson.MustRegister(Method("sendrawmixmessage"), (*SendRawMixMessageCmd)(nil), flags)
+	dcrjson.MustRegister(Method("sendrawpackage"), (*SendRawPackageCmd)(nil), flags)
 	dcrjson.MustRegister(Method("sendrawtransaction"), (*SendRawTransactionCmd)(nil), flags)
+	dcrjson.MustRegister(Method("setblockspacepolicy"), (*SetBlockSpacePolicyCmd)(nil), flags)
 	dcrjson.MustRegister(Method("setgenerate"), (*SetGenerateCmd)(nil), flags)
 	dcrjson.MustRegister(Method("startprofiler"), (*StartProfilerCmd)(nil), flags)
 	dcrjson.MustRegister(Method("stop"), (*StopCmd)(nil), flags)
 	dcrjson.MustRegister(Method("stopprofiler"), (*StopProfilerCmd)(nil), flags)
 	dcrjson.MustRegister(Method("submitblock"), (*SubmitBlockCmd)(nil), flags)
+	dcrjson.MustRegister(Method("testblockassembly"), (*TestBlockAssemblyCmd)(nil), flags)
+	dcrjson.MustRegister(Method("testmempoolaccept"), (*TestMempoolAcceptCmd)(nil), flags)
 	dcrjson.MustRegister(Method("ticketfeeinfo"), (*TicketFeeInfoCmd)(nil), flags)
 	dcrjson.MustRegister(Method("ticketsforaddress"), (*TicketsForAddressCmd)(nil), flags)
 	dcrjson.MustRegister(Method("ticketvwap"), (*TicketVWAPCmd)(nil), flags)
@@ -1304,4 +1919,16 @@ func init() {
 	dcrjson.MustRegister(Method("verifymessage"), (*VerifyMessageCmd)(nil), flags)
 	dcrjson.MustRegister(Method("version"), (*VersionCmd)(nil), flags)
 	dcrjson.MustRegister(Method("getburnedcoins"), (*GetBurnedCoinsCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getskaburns"), (*GetSKABurnsCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getskaemissions"), (*GetSKAEmissionsCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getskaemissionstatus"), (*GetSKAEmissionStatusCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getskaactivationtimeline"), (*GetSKAActivationTimelineCmd)(nil), flags)
+	dcrjson.MustRegister(Method("auditskasupply"), (*AuditSKASupplyCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getsupplyscaninfo"), (*GetSupplyScanInfoCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getcointypesnapshot"), (*GetCoinTypeSnapshotCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getvalidationstats"), (*GetValidationStatsCmd)(nil), flags)
+	dcrjson.MustRegister(Method("encodeemissionauth"), (*EncodeEmissionAuthCmd)(nil), flags)
+	dcrjson.MustRegister(Method("decodeemissionauth"), (*DecodeEmissionAuthCmd)(nil), flags)
+	dcrjson.MustRegister(Method("getemissionkeys"), (*GetEmissionKeysCmd)(nil), flags)
+	dcrjson.MustRegister(Method("attestemissionkey"), (*AttestEmissionKeyCmd)(nil), flags)
 }
@@ -23,6 +23,7 @@ func TestChainSvrCmds(t *testing.T) {
 	t.Parallel()
 
 	testID := int(1)
+	skaCoinType := uint8(1)
 	tests := []struct {
 		name         string
 		newCmd       func() (interface{}, error)
@@ -30,6 +31,17 @@ func TestChainSvrCmds(t *testing.T) {
 		marshalled   string
 		unmarshalled interface{}
 	}{
+		{
+			name: "abandonrebroadcasttx",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("abandonrebroadcasttx"), "123")
+			},
+			staticCmd: func() interface{} {
+				return NewAbandonRebroadcastTxCmd("123")
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"abandonrebroadcasttx","params":["123"],"id":1}`,
+			unmarshalled: &AbandonRebroadcastTxCmd{TxHash: "123"},
+		},
 		{
 			name: "addnode",
 			newCmd: func() (interface{}, error) {
@@ -41,6 +53,17 @@ func TestChainSvrCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"addnode","params":["127.0.0.1","remove"],"id":1}`,
 			unmarshalled: &AddNodeCmd{Addr: "127.0.0.1", SubCmd: ANRemove},
 		},
+		{
+			name: "bumpfeeestimate",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("bumpfeeestimate"), "123")
+			},
+			staticCmd: func() interface{} {
+				return NewBumpFeeEstimateCmd("123")
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"bumpfeeestimate","params":["123"],"id":1}`,
+			unmarshalled: &BumpFeeEstimateCmd{TxHash: "123"},
+		},
 		{
 			name: "createrawtransaction",
 			newCmd: func() (interface{}, error) {
@@ -81,6 +104,23 @@ func TestChainSvrCmds(t *testing.T) {
 				Expiry:   dcrjson.Int64(12312333333),
 			},
 		},
+		{
+			name: "createsweeptransaction",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("createsweeptransaction"), 1,
+					`[{"hash":"123","tree":0,"index":1}]`, "456")
+			},
+			staticCmd: func() interface{} {
+				outpoints := []OutPoint{{Hash: "123", Index: 1}}
+				return NewCreateSweepTransactionCmd(1, outpoints, "456", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"createsweeptransaction","params":[1,[{"hash":"123","tree":0,"index":1}],"456"],"id":1}`,
+			unmarshalled: &CreateSweepTransactionCmd{
+				CoinType:  1,
+				Outpoints: []OutPoint{{Hash: "123", Index: 1}},
+				Address:   "456",
+			},
+		},
 		{
 			name: "debuglevel",
 			newCmd: func() (interface{}, error) {
@@ -142,6 +182,30 @@ func TestChainSvrCmds(t *testing.T) {
 				NumBlocks: 6,
 			},
 		},
+		{
+			name: "estimatefeeaccuracy",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("estimatefeeaccuracy"))
+			},
+			staticCmd: func() interface{} {
+				return NewEstimateFeeAccuracyCmd(nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"estimatefeeaccuracy","params":[],"id":1}`,
+			unmarshalled: &EstimateFeeAccuracyCmd{},
+		},
+		{
+			name: "estimatefeeaccuracy optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("estimatefeeaccuracy"), 1)
+			},
+			staticCmd: func() interface{} {
+				return NewEstimateFeeAccuracyCmd(&skaCoinType)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"estimatefeeaccuracy","params":[1],"id":1}`,
+			unmarshalled: &EstimateFeeAccuracyCmd{
+				CoinType: &skaCoinType,
+			},
+		},
 		{
 			name: "estimatesmartfee",
 			newCmd: func() (interface{}, error) {
@@ -171,6 +235,49 @@ func TestChainSvrCmds(t *testing.T) {
 				Mode:          EstimateSmartFeeModeAddr(EstimateSmartFeeConservative),
 			},
 		},
+		{
+			name: "fundrawtransaction",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("fundrawtransaction"), "1122",
+					`[{"hash":"123","tree":0,"index":1}]`, "456")
+			},
+			staticCmd: func() interface{} {
+				outpoints := []OutPoint{{Hash: "123", Index: 1}}
+				return NewFundRawTransactionCmd("1122", outpoints, "456", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"fundrawtransaction","params":["1122",[{"hash":"123","tree":0,"index":1}],"456"],"id":1}`,
+			unmarshalled: &FundRawTransactionCmd{
+				HexTx:         "1122",
+				Outpoints:     []OutPoint{{Hash: "123", Index: 1}},
+				ChangeAddress: "456",
+			},
+		},
+		{
+			name: "fundrawtransaction optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("fundrawtransaction"), "1122",
+					`[{"hash":"123","tree":0,"index":1}]`, "456",
+					`{"feerate":0.0002,"conftarget":3}`)
+			},
+			staticCmd: func() interface{} {
+				outpoints := []OutPoint{{Hash: "123", Index: 1}}
+				options := &FundRawTransactionOptions{
+					FeeRate:    dcrjson.Float64(0.0002),
+					ConfTarget: dcrjson.Int64(3),
+				}
+				return NewFundRawTransactionCmd("1122", outpoints, "456", options)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"fundrawtransaction","params":["1122",[{"hash":"123","tree":0,"index":1}],"456",{"feerate":0.0002,"conftarget":3}],"id":1}`,
+			unmarshalled: &FundRawTransactionCmd{
+				HexTx:         "1122",
+				Outpoints:     []OutPoint{{Hash: "123", Index: 1}},
+				ChangeAddress: "456",
+				Options: &FundRawTransactionOptions{
+					FeeRate:    dcrjson.Float64(0.0002),
+					ConfTarget: dcrjson.Int64(3),
+				},
+			},
+		},
 		{
 			name: "generate",
 			newCmd: func() (interface{}, error) {
@@ -209,6 +316,47 @@ func TestChainSvrCmds(t *testing.T) {
 				Node: dcrjson.String("127.0.0.1"),
 			},
 		},
+		{
+			name: "getaddressreceived",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getaddressreceived"), "1Address", 1)
+			},
+			staticCmd: func() interface{} {
+				return NewGetAddressReceivedCmd("1Address", 1, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressreceived","params":["1Address",1],"id":1}`,
+			unmarshalled: &GetAddressReceivedCmd{
+				Address:  "1Address",
+				CoinType: 1,
+				MinConf:  dcrjson.Int64(1),
+			},
+		},
+		{
+			name: "getaddressreceived optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getaddressreceived"), "1Address", 1, 0)
+			},
+			staticCmd: func() interface{} {
+				return NewGetAddressReceivedCmd("1Address", 1, dcrjson.Int64(0))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressreceived","params":["1Address",1,0],"id":1}`,
+			unmarshalled: &GetAddressReceivedCmd{
+				Address:  "1Address",
+				CoinType: 1,
+				MinConf:  dcrjson.Int64(0),
+			},
+		},
+		{
+			name: "getallocationdivergence",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getallocationdivergence"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetAllocationDivergenceCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getallocationdivergence","params":[],"id":1}`,
+			unmarshalled: &GetAllocationDivergenceCmd{},
+		},
 		{
 			name: "getbestblock",
 			newCmd: func() (interface{}, error) {
@@ -327,6 +475,59 @@ func TestChainSvrCmds(t *testing.T) {
 				Verbose: dcrjson.Bool(true),
 			},
 		},
+		{
+			name: "getblockspacepolicy",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getblockspacepolicy"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetBlockSpacePolicyCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getblockspacepolicy","params":[],"id":1}`,
+			unmarshalled: &GetBlockSpacePolicyCmd{},
+		},
+		{
+			name: "getblockspends",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getblockspends"), "123")
+			},
+			staticCmd: func() interface{} {
+				return NewGetBlockSpendsCmd("123", nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblockspends","params":["123"],"id":1}`,
+			unmarshalled: &GetBlockSpendsCmd{
+				Hash:    "123",
+				Verbose: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "getblockspends optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getblockspends"), "123", 1, true)
+			},
+			staticCmd: func() interface{} {
+				return NewGetBlockSpendsCmd("123", &skaCoinType, dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblockspends","params":["123",1,true],"id":1}`,
+			unmarshalled: &GetBlockSpendsCmd{
+				Hash:     "123",
+				CoinType: &skaCoinType,
+				Verbose:  dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "getblockstats",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getblockstats"), "123")
+			},
+			staticCmd: func() interface{} {
+				return NewGetBlockStatsCmd("123")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblockstats","params":["123"],"id":1}`,
+			unmarshalled: &GetBlockStatsCmd{
+				Hash: "123",
+			},
+		},
 		{
 			name: "getblocksubsidy",
 			newCmd: func() (interface{}, error) {
@@ -341,6 +542,26 @@ func TestChainSvrCmds(t *testing.T) {
 				Voters: 256,
 			},
 		},
+		{
+			name: "getblocktemplate proposal",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getblocktemplate"),
+					`{"mode":"proposal","data":"0102"}`)
+			},
+			staticCmd: func() interface{} {
+				return NewGetBlockTemplateCmd(TemplateRequest{
+					Mode: "proposal",
+					Data: "0102",
+				})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblocktemplate","params":[{"mode":"proposal","data":"0102"}],"id":1}`,
+			unmarshalled: &GetBlockTemplateCmd{
+				Request: TemplateRequest{
+					Mode: "proposal",
+					Data: "0102",
+				},
+			},
+		},
 		{
 			name: "getcfilterv2",
 			newCmd: func() (interface{}, error) {
@@ -365,6 +586,17 @@ func TestChainSvrCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"getchaintips","params":[],"id":1}`,
 			unmarshalled: &GetChainTipsCmd{},
 		},
+		{
+			name: "getcointypes",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getcointypes"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetCoinTypesCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getcointypes","params":[],"id":1}`,
+			unmarshalled: &GetCoinTypesCmd{},
+		},
 		{
 			name: "getconnectioncount",
 			newCmd: func() (interface{}, error) {
@@ -420,6 +652,30 @@ func TestChainSvrCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"gethashespersec","params":[],"id":1}`,
 			unmarshalled: &GetHashesPerSecCmd{},
 		},
+		{
+			name: "getindexinfo",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getindexinfo"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetIndexInfoCmd(nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getindexinfo","params":[],"id":1}`,
+			unmarshalled: &GetIndexInfoCmd{},
+		},
+		{
+			name: "getindexinfo name",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getindexinfo"), "txindex")
+			},
+			staticCmd: func() interface{} {
+				return NewGetIndexInfoCmd(dcrjson.String("txindex"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getindexinfo","params":["txindex"],"id":1}`,
+			unmarshalled: &GetIndexInfoCmd{
+				Name: dcrjson.String("txindex"),
+			},
+		},
 		{
 			name: "getinfo",
 			newCmd: func() (interface{}, error) {
@@ -607,6 +863,228 @@ func TestChainSvrCmds(t *testing.T) {
 				Verbose: dcrjson.Int(1),
 			},
 		},
+		{
+			name: "getrecentutilization",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getrecentutilization"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetRecentUtilizationCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getrecentutilization","params":[],"id":1}`,
+			unmarshalled: &GetRecentUtilizationCmd{
+				Count: dcrjson.Int(10),
+			},
+		},
+		{
+			name: "getrecentutilization optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getrecentutilization"), 5, 1)
+			},
+			staticCmd: func() interface{} {
+				return NewGetRecentUtilizationCmd(dcrjson.Int(5), &skaCoinType)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getrecentutilization","params":[5,1],"id":1}`,
+			unmarshalled: &GetRecentUtilizationCmd{
+				Count:    dcrjson.Int(5),
+				CoinType: &skaCoinType,
+			},
+		},
+		{
+			name: "getskaburns",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getskaburns"), 1)
+			},
+			staticCmd: func() interface{} {
+				return NewGetSKABurnsCmd(1, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getskaburns","params":[1],"id":1}`,
+			unmarshalled: &GetSKABurnsCmd{
+				CoinType:    1,
+				StartHeight: dcrjson.Int64(0),
+				Count:       dcrjson.Int32(100),
+			},
+		},
+		{
+			name: "getskaburns optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getskaburns"), 1, 500, 10)
+			},
+			staticCmd: func() interface{} {
+				return NewGetSKABurnsCmd(1, dcrjson.Int64(500), dcrjson.Int32(10))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getskaburns","params":[1,500,10],"id":1}`,
+			unmarshalled: &GetSKABurnsCmd{
+				CoinType:    1,
+				StartHeight: dcrjson.Int64(500),
+				Count:       dcrjson.Int32(10),
+			},
+		},
+		{
+			name: "getskaemissions",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getskaemissions"), 1)
+			},
+			staticCmd: func() interface{} {
+				return NewGetSKAEmissionsCmd(1)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getskaemissions","params":[1],"id":1}`,
+			unmarshalled: &GetSKAEmissionsCmd{
+				CoinType: 1,
+			},
+		},
+		{
+			name: "getskaactivationtimeline",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getskaactivationtimeline"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetSKAActivationTimelineCmd(nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getskaactivationtimeline","params":[],"id":1}`,
+			unmarshalled: &GetSKAActivationTimelineCmd{},
+		},
+		{
+			name: "getskaactivationtimeline optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getskaactivationtimeline"), 1)
+			},
+			staticCmd: func() interface{} {
+				return NewGetSKAActivationTimelineCmd(&skaCoinType)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getskaactivationtimeline","params":[1],"id":1}`,
+			unmarshalled: &GetSKAActivationTimelineCmd{
+				CoinType: &skaCoinType,
+			},
+		},
+		{
+			name: "getskaemissionstatus",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getskaemissionstatus"), "123")
+			},
+			staticCmd: func() interface{} {
+				return NewGetSKAEmissionStatusCmd("123")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getskaemissionstatus","params":["123"],"id":1}`,
+			unmarshalled: &GetSKAEmissionStatusCmd{
+				TxHash: "123",
+			},
+		},
+		{
+			name: "auditskasupply",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("auditskasupply"))
+			},
+			staticCmd: func() interface{} {
+				return NewAuditSKASupplyCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"auditskasupply","params":[],"id":1}`,
+			unmarshalled: &AuditSKASupplyCmd{},
+		},
+		{
+			name: "getsupplyscaninfo",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getsupplyscaninfo"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetSupplyScanInfoCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getsupplyscaninfo","params":[],"id":1}`,
+			unmarshalled: &GetSupplyScanInfoCmd{},
+		},
+		{
+			name: "getcointypesnapshot",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getcointypesnapshot"), 1)
+			},
+			staticCmd: func() interface{} {
+				return NewGetCoinTypeSnapshotCmd(1, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getcointypesnapshot","params":[1],"id":1}`,
+			unmarshalled: &GetCoinTypeSnapshotCmd{
+				CoinType:  1,
+				Aggregate: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "getcointypesnapshot optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getcointypesnapshot"), 1, 100, true)
+			},
+			staticCmd: func() interface{} {
+				return NewGetCoinTypeSnapshotCmd(1, dcrjson.Int64(100),
+					dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getcointypesnapshot","params":[1,100,true],"id":1}`,
+			unmarshalled: &GetCoinTypeSnapshotCmd{
+				CoinType:  1,
+				Height:    dcrjson.Int64(100),
+				Aggregate: dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "getvalidationstats",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getvalidationstats"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetValidationStatsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getvalidationstats","params":[],"id":1}`,
+			unmarshalled: &GetValidationStatsCmd{},
+		},
+		{
+			name: "encodeemissionauth",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("encodeemissionauth"), `{"cointype":1}`)
+			},
+			staticCmd: func() interface{} {
+				return NewEncodeEmissionAuthCmd(`{"cointype":1}`)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"encodeemissionauth","params":["{\"cointype\":1}"],"id":1}`,
+			unmarshalled: &EncodeEmissionAuthCmd{
+				Descriptor: `{"cointype":1}`,
+			},
+		},
+		{
+			name: "decodeemissionauth",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("decodeemissionauth"), "01534b41")
+			},
+			staticCmd: func() interface{} {
+				return NewDecodeEmissionAuthCmd("01534b41")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"decodeemissionauth","params":["01534b41"],"id":1}`,
+			unmarshalled: &DecodeEmissionAuthCmd{
+				HexScript: "01534b41",
+			},
+		},
+		{
+			name: "getemissionkeys",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getemissionkeys"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetEmissionKeysCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getemissionkeys","params":[],"id":1}`,
+			unmarshalled: &GetEmissionKeysCmd{},
+		},
+		{
+			name: "attestemissionkey",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("attestemissionkey"), 1, "deadbeef", "3006")
+			},
+			staticCmd: func() interface{} {
+				return NewAttestEmissionKeyCmd(1, "deadbeef", "3006")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"attestemissionkey","params":[1,"deadbeef","3006"],"id":1}`,
+			unmarshalled: &AttestEmissionKeyCmd{
+				CoinType:  1,
+				Challenge: "deadbeef",
+				Signature: "3006",
+			},
+		},
 		{
 			name: "getstakeversions",
 			newCmd: func() (interface{}, error) {
@@ -719,6 +1197,34 @@ func TestChainSvrCmds(t *testing.T) {
 				TSpends: &[]string{"456"},
 			},
 		},
+		{
+			name: "getwatchonlybalance",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getwatchonlybalance"))
+			},
+			staticCmd: func() interface{} {
+				return NewGetWatchOnlyBalanceCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getwatchonlybalance","params":[],"id":1}`,
+			unmarshalled: &GetWatchOnlyBalanceCmd{
+				CoinType: nil,
+				MinConf:  dcrjson.Int64(1),
+			},
+		},
+		{
+			name: "getwatchonlybalance optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("getwatchonlybalance"), 1, 6)
+			},
+			staticCmd: func() interface{} {
+				return NewGetWatchOnlyBalanceCmd(&skaCoinType, dcrjson.Int64(6))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getwatchonlybalance","params":[1,6],"id":1}`,
+			unmarshalled: &GetWatchOnlyBalanceCmd{
+				CoinType: &skaCoinType,
+				MinConf:  dcrjson.Int64(6),
+			},
+		},
 		{
 			name: "getwork",
 			newCmd: func() (interface{}, error) {
@@ -771,6 +1277,117 @@ func TestChainSvrCmds(t *testing.T) {
 				Command: dcrjson.String("getblock"),
 			},
 		},
+		{
+			name: "importwatchonlyaddress",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("importwatchonlyaddress"), "1Address", 1)
+			},
+			staticCmd: func() interface{} {
+				return NewImportWatchOnlyAddressCmd("1Address", 1, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importwatchonlyaddress","params":["1Address",1],"id":1}`,
+			unmarshalled: &ImportWatchOnlyAddressCmd{
+				Address:    "1Address",
+				CoinType:   1,
+				Rescan:     dcrjson.Bool(true),
+				RescanFrom: dcrjson.Int64(0),
+			},
+		},
+		{
+			name: "importwatchonlyaddress optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("importwatchonlyaddress"), "1Address", 1, true, 1000)
+			},
+			staticCmd: func() interface{} {
+				return NewImportWatchOnlyAddressCmd("1Address", 1,
+					dcrjson.Bool(true), dcrjson.Int64(1000))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importwatchonlyaddress","params":["1Address",1,true,1000],"id":1}`,
+			unmarshalled: &ImportWatchOnlyAddressCmd{
+				Address:    "1Address",
+				CoinType:   1,
+				Rescan:     dcrjson.Bool(true),
+				RescanFrom: dcrjson.Int64(1000),
+			},
+		},
+		{
+			name: "listknownskaaddresses",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("listknownskaaddresses"))
+			},
+			staticCmd: func() interface{} {
+				return NewListKnownSKAAddressesCmd(nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listknownskaaddresses","params":[],"id":1}`,
+			unmarshalled: &ListKnownSKAAddressesCmd{CoinType: nil},
+		},
+		{
+			name: "listknownskaaddresses optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("listknownskaaddresses"), 1)
+			},
+			staticCmd: func() interface{} {
+				return NewListKnownSKAAddressesCmd(&skaCoinType)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listknownskaaddresses","params":[1],"id":1}`,
+			unmarshalled: &ListKnownSKAAddressesCmd{CoinType: &skaCoinType},
+		},
+		{
+			name: "listrebroadcasttxs",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("listrebroadcasttxs"))
+			},
+			staticCmd: func() interface{} {
+				return NewListRebroadcastTxsCmd(nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listrebroadcasttxs","params":[],"id":1}`,
+			unmarshalled: &ListRebroadcastTxsCmd{CoinType: nil},
+		},
+		{
+			name: "listrebroadcasttxs optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("listrebroadcasttxs"), 1)
+			},
+			staticCmd: func() interface{} {
+				return NewListRebroadcastTxsCmd(&skaCoinType)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listrebroadcasttxs","params":[1],"id":1}`,
+			unmarshalled: &ListRebroadcastTxsCmd{CoinType: &skaCoinType},
+		},
+		{
+			name: "listunspentwatchonly",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("listunspentwatchonly"))
+			},
+			staticCmd: func() interface{} {
+				return NewListUnspentWatchOnlyCmd(nil, nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listunspentwatchonly","params":[],"id":1}`,
+			unmarshalled: &ListUnspentWatchOnlyCmd{
+				MinConf:   dcrjson.Int64(1),
+				MaxConf:   dcrjson.Int64(9999999),
+				Addresses: nil,
+				CoinType:  nil,
+			},
+		},
+		{
+			name: "listunspentwatchonly optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("listunspentwatchonly"), 6, 100,
+					[]string{"1Address"}, 1)
+			},
+			staticCmd: func() interface{} {
+				return NewListUnspentWatchOnlyCmd(dcrjson.Int64(6),
+					dcrjson.Int64(100), &[]string{"1Address"}, &skaCoinType)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listunspentwatchonly","params":[6,100,["1Address"],1],"id":1}`,
+			unmarshalled: &ListUnspentWatchOnlyCmd{
+				MinConf:   dcrjson.Int64(6),
+				MaxConf:   dcrjson.Int64(100),
+				Addresses: &[]string{"1Address"},
+				CoinType:  &skaCoinType,
+			},
+		},
 		{
 			name: "node option remove",
 			newCmd: func() (interface{}, error) {
@@ -825,6 +1442,20 @@ func TestChainSvrCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"ping","params":[],"id":1}`,
 			unmarshalled: &PingCmd{},
 		},
+		{
+			name: "removewatchonlyaddress",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("removewatchonlyaddress"), "1Address", 1)
+			},
+			staticCmd: func() interface{} {
+				return NewRemoveWatchOnlyAddressCmd("1Address", 1)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"removewatchonlyaddress","params":["1Address",1],"id":1}`,
+			unmarshalled: &RemoveWatchOnlyAddressCmd{
+				Address:  "1Address",
+				CoinType: 1,
+			},
+		},
 		{
 			name: "sendrawmixmessage",
 			newCmd: func() (interface{}, error) {
@@ -839,18 +1470,50 @@ func TestChainSvrCmds(t *testing.T) {
 				Message: "1122",
 			},
 		},
+		{
+			name: "sendrawpackage",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("sendrawpackage"),
+					[]string{"1122", "3344"})
+			},
+			staticCmd: func() interface{} {
+				return NewSendRawPackageCmd([]string{"1122", "3344"}, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendrawpackage","params":[["1122","3344"]],"id":1}`,
+			unmarshalled: &SendRawPackageCmd{
+				HexTxs:        []string{"1122", "3344"},
+				AllowHighFees: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "sendrawpackage optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("sendrawpackage"),
+					[]string{"1122", "3344"}, true)
+			},
+			staticCmd: func() interface{} {
+				return NewSendRawPackageCmd([]string{"1122", "3344"},
+					dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendrawpackage","params":[["1122","3344"],true],"id":1}`,
+			unmarshalled: &SendRawPackageCmd{
+				HexTxs:        []string{"1122", "3344"},
+				AllowHighFees: dcrjson.Bool(true),
+			},
+		},
 		{
 			name: "sendrawtransaction",
 			newCmd: func() (interface{}, error) {
 				return dcrjson.NewCmd(Method("sendrawtransaction"), "1122")
 			},
 			staticCmd: func() interface{} {
-				return NewSendRawTransactionCmd("1122", nil)
+				return NewSendRawTransactionCmd("1122", nil, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"sendrawtransaction","params":["1122"],"id":1}`,
 			unmarshalled: &SendRawTransactionCmd{
 				HexTx:         "1122",
 				AllowHighFees: dcrjson.Bool(false),
+				Verbose:       dcrjson.Bool(false),
 			},
 		},
 		{
@@ -859,12 +1522,58 @@ func TestChainSvrCmds(t *testing.T) {
 				return dcrjson.NewCmd(Method("sendrawtransaction"), "1122", false)
 			},
 			staticCmd: func() interface{} {
-				return NewSendRawTransactionCmd("1122", dcrjson.Bool(false))
+				return NewSendRawTransactionCmd("1122", dcrjson.Bool(false), nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"sendrawtransaction","params":["1122",false],"id":1}`,
 			unmarshalled: &SendRawTransactionCmd{
 				HexTx:         "1122",
 				AllowHighFees: dcrjson.Bool(false),
+				Verbose:       dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "sendrawtransaction verbose",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("sendrawtransaction"), "1122", false, true)
+			},
+			staticCmd: func() interface{} {
+				return NewSendRawTransactionCmd("1122", dcrjson.Bool(false),
+					dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendrawtransaction","params":["1122",false,true],"id":1}`,
+			unmarshalled: &SendRawTransactionCmd{
+				HexTx:         "1122",
+				AllowHighFees: dcrjson.Bool(false),
+				Verbose:       dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "setblockspacepolicy",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("setblockspacepolicy"))
+			},
+			staticCmd: func() interface{} {
+				return NewSetBlockSpacePolicyCmd(nil, nil, nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"setblockspacepolicy","params":[],"id":1}`,
+			unmarshalled: &SetBlockSpacePolicyCmd{},
+		},
+		{
+			name: "setblockspacepolicy optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("setblockspacepolicy"), "varonly",
+					20000, `{"1":50000}`)
+			},
+			staticCmd: func() interface{} {
+				caps := map[string]uint32{"1": 50000}
+				return NewSetBlockSpacePolicyCmd(dcrjson.String("varonly"),
+					dcrjson.Uint32(20000), &caps)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setblockspacepolicy","params":["varonly",20000,{"1":50000}],"id":1}`,
+			unmarshalled: &SetBlockSpacePolicyCmd{
+				OverflowStrategy:  dcrjson.String("varonly"),
+				StakeReserveBytes: dcrjson.Uint32(20000),
+				CoinTypeCaps:      &map[string]uint32{"1": 50000},
 			},
 		},
 		{
@@ -978,6 +1687,47 @@ func TestChainSvrCmds(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "testblockassembly",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("testblockassembly"), []string{"123", "1122"})
+			},
+			staticCmd: func() interface{} {
+				return NewTestBlockAssemblyCmd([]string{"123", "1122"})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"testblockassembly","params":[["123","1122"]],"id":1}`,
+			unmarshalled: &TestBlockAssemblyCmd{
+				Txs: []string{"123", "1122"},
+			},
+		},
+		{
+			name: "testmempoolaccept",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("testmempoolaccept"), "1122")
+			},
+			staticCmd: func() interface{} {
+				return NewTestMempoolAcceptCmd("1122", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"testmempoolaccept","params":["1122"],"id":1}`,
+			unmarshalled: &TestMempoolAcceptCmd{
+				HexTx:         "1122",
+				AllowHighFees: dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "testmempoolaccept optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("testmempoolaccept"), "1122", true)
+			},
+			staticCmd: func() interface{} {
+				return NewTestMempoolAcceptCmd("1122", dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"testmempoolaccept","params":["1122",true],"id":1}`,
+			unmarshalled: &TestMempoolAcceptCmd{
+				HexTx:         "1122",
+				AllowHighFees: dcrjson.Bool(true),
+			},
+		},
 		{
 			name: "validateaddress",
 			newCmd: func() (interface{}, error) {
@@ -9,12 +9,14 @@ import "encoding/json"
 
 // TxRawDecodeResult models the data from the decoderawtransaction command.
 type TxRawDecodeResult struct {
-	Txid     string `json:"txid"`
-	Version  int32  `json:"version"`
-	Locktime uint32 `json:"locktime"`
-	Expiry   uint32 `json:"expiry"`
-	Vin      []Vin  `json:"vin"`
-	Vout     []Vout `json:"vout"`
+	Txid            string  `json:"txid"`
+	Version         int32   `json:"version"`
+	Locktime        uint32  `json:"locktime"`
+	Expiry          uint32  `json:"expiry"`
+	PrimaryCoinType uint8   `json:"primarycointype"`
+	MinRelayFee     float64 `json:"minrelayfee"`
+	Vin             []Vin   `json:"vin"`
+	Vout            []Vout  `json:"vout"`
 }
 
 // DecodeScriptResult models the data returned from the decodescript command.
@@ -26,6 +28,35 @@ type DecodeScriptResult struct {
 	P2sh      string   `json:"p2sh,omitempty"`
 }
 
+// BumpFeeEstimateResult models the data returned from the bumpfeeestimate
+// command.
+type BumpFeeEstimateResult struct {
+	Txid            string  `json:"txid"`            // Transaction hash
+	CoinType        uint8   `json:"cointype"`        // Primary coin type of the transaction
+	Size            int64   `json:"size"`            // Serialized size in bytes
+	Fee             float64 `json:"fee"`             // Fee paid in coins of the primary coin type
+	FeeRate         float64 `json:"feerate"`         // Fee rate in coins per KB
+	RequiredFeeRate float64 `json:"requiredfeerate"` // Fee rate in coins per KB needed to be selected into the next block
+	FeeDelta        float64 `json:"feedelta"`        // Fee to add in coins to reach the required fee rate
+	BucketSize      uint32  `json:"bucketsize"`      // Bytes the next block allocates to the coin type
+	BytesAhead      uint32  `json:"bytesahead"`      // Pending bytes of the coin type that take precedence at the current fee rate
+	FitsNextBlock   bool    `json:"fitsnextblock"`   // Fits in the next block at the current fee rate
+}
+
+// EstimateFeeAccuracyResult models the data returned from the
+// estimatefeeaccuracy command for a single coin type.
+type EstimateFeeAccuracyResult struct {
+	CoinType          uint8   `json:"cointype"`
+	Name              string  `json:"name"`
+	Samples           uint64  `json:"samples"`
+	OnTime            uint64  `json:"ontime"`
+	Late              uint64  `json:"late"`
+	OnTimeRate        float64 `json:"ontimerate"`
+	AvgPredictedConfs float64 `json:"avgpredictedconfs"`
+	AvgActualConfs    float64 `json:"avgactualconfs"`
+	MeanAbsError      float64 `json:"meanabserror"`
+}
+
 // EstimateSmartFeeResult models the data returned from the estimatesmartfee
 // command.
 type EstimateSmartFeeResult struct {
@@ -154,6 +185,40 @@ type AgendaInfo struct {
 	ExpireTime uint64 `json:"expiretime"`
 }
 
+// GetAddressReceivedResult models the data returned from the
+// getaddressreceived command.
+type GetAddressReceivedResult struct {
+	Address     string  `json:"address"`
+	CoinType    uint8   `json:"cointype"`
+	MinConf     int64   `json:"minconf"`
+	Height      int64   `json:"height"`
+	Received    float64 `json:"received"`
+	OutputCount int64   `json:"outputcount"`
+}
+
+// AllocationDivergenceMinerResult models the block space allocation
+// divergence of a single miner returned from the getallocationdivergence
+// command.
+type AllocationDivergenceMinerResult struct {
+	Miner               string `json:"miner"`
+	Blocks              uint64 `json:"blocks"`
+	DivergentBlocks     uint64 `json:"divergentblocks"`
+	RecentDivergent     int    `json:"recentdivergent"`
+	LastHeight          int64  `json:"lastheight"`
+	LastDivergentHeight int64  `json:"lastdivergentheight"`
+	Flagged             bool   `json:"flagged"`
+}
+
+// GetAllocationDivergenceResult models the data returned from the
+// getallocationdivergence command.
+type GetAllocationDivergenceResult struct {
+	BlocksChecked   uint64                            `json:"blockschecked"`
+	DivergentBlocks uint64                            `json:"divergentblocks"`
+	Window          int                               `json:"window"`
+	Threshold       int                               `json:"threshold"`
+	Miners          []AllocationDivergenceMinerResult `json:"miners"`
+}
+
 // GetBestBlockResult models the data from the getbestblock command.
 type GetBestBlockResult struct {
 	Hash   string `json:"hash"`
@@ -174,6 +239,7 @@ type GetBlockChainInfoResult struct {
 	ChainWork            string                `json:"chainwork"`
 	InitialBlockDownload bool                  `json:"initialblockdownload"`
 	MaxBlockSize         int64                 `json:"maxblocksize"`
+	SKAConfigHash        string                `json:"skaconfighash"`
 	Deployments          map[string]AgendaInfo `json:"deployments"`
 }
 
@@ -208,6 +274,92 @@ type GetBlockHeaderVerboseResult struct {
 	NextHash      string  `json:"nextblockhash,omitempty"`
 }
 
+// BlockSpaceCoinTypeCap models the maximum number of bytes block templates may
+// use for transactions of a coin type returned as part of the
+// getblockspacepolicy and setblockspacepolicy commands.
+type BlockSpaceCoinTypeCap struct {
+	CoinType uint8  `json:"cointype"`
+	Name     string `json:"name"`
+	MaxBytes uint32 `json:"maxbytes"`
+}
+
+// GetBlockSpacePolicyResult models the data returned from the
+// getblockspacepolicy and setblockspacepolicy commands.
+type GetBlockSpacePolicyResult struct {
+	OverflowStrategy  string                  `json:"overflowstrategy"`
+	StakeReserveBytes uint32                  `json:"stakereservebytes"`
+	CoinTypeCaps      []BlockSpaceCoinTypeCap `json:"cointypecaps"`
+}
+
+// BlockSpentOutput models an output spent by a block in the result of the
+// getblockspends command.
+type BlockSpentOutput struct {
+	TxHash       string `json:"txhash"`             // Hash of the transaction that created the output
+	Tree         int8   `json:"tree"`               // Tree of the transaction that created the output
+	Vout         uint32 `json:"vout"`               // Index of the output
+	SpendingTx   string `json:"spendingtx"`         // Hash of the transaction that spent the output
+	Vin          uint32 `json:"vin"`                // Index of the input that spent the output
+	Height       int64  `json:"height"`             // Height of the block that created the output
+	Amount       int64  `json:"amount"`             // Amount of the output in atoms
+	Version      uint16 `json:"version"`            // Version of the output script
+	ScriptPubKey string `json:"scriptpubkey"`       // Hex-encoded output script
+	Address      string `json:"address,omitempty"`  // Address paid by the output script
+	CoinBase     bool   `json:"coinbase,omitempty"` // Whether the output was created by a coinbase
+}
+
+// BlockSpendsCoinType models the outputs of a single coin type spent by a
+// block in the result of the getblockspends command.
+type BlockSpendsCoinType struct {
+	CoinType  uint8              `json:"cointype"`          // Coin type of the spent outputs
+	Name      string             `json:"name"`              // Name of the coin type
+	Count     int64              `json:"count"`             // Number of spent outputs
+	Amount    int64              `json:"amount"`            // Total amount of the spent outputs in atoms
+	Addresses int64              `json:"addresses"`         // Number of distinct addresses paid by the spent outputs
+	AvgAge    float64            `json:"avgage"`            // Amount-weighted average age of the spent outputs in blocks
+	Outputs   []BlockSpentOutput `json:"outputs,omitempty"` // Spent outputs when verbose
+}
+
+// GetBlockSpendsResult models the data returned from the getblockspends
+// command.
+type GetBlockSpendsResult struct {
+	Hash        string                `json:"hash"`        // Hash of the block
+	Height      int64                 `json:"height"`      // Height of the block
+	Disapproved bool                  `json:"disapproved"` // Whether the regular tree of the block is disapproved
+	CoinTypes   []BlockSpendsCoinType `json:"cointypes"`   // Spent outputs by coin type
+}
+
+// BlockStatsCoinTypeFees models the fees of a single coin type returned as
+// part of the getblockstats command.
+type BlockStatsCoinTypeFees struct {
+	CoinType       uint8   `json:"cointype"`
+	Name           string  `json:"name"`
+	Fees           float64 `json:"fees"`
+	CumulativeFees float64 `json:"cumulativefees"`
+}
+
+// BlockStatsCoinType models the transaction statistics of a single coin type
+// returned as part of the getblockstats command.
+type BlockStatsCoinType struct {
+	CoinType       uint8   `json:"cointype"`
+	Name           string  `json:"name"`
+	NumTxns        uint32  `json:"numtxns"`
+	TotalBytes     uint32  `json:"totalbytes"`
+	AllocatedBytes uint32  `json:"allocatedbytes"`
+	Utilization    float64 `json:"utilization"`
+	MinFeeRate     float64 `json:"minfeerate"`
+	MedianFeeRate  float64 `json:"medianfeerate"`
+	MaxFeeRate     float64 `json:"maxfeerate"`
+}
+
+// GetBlockStatsResult models the data returned from the getblockstats command.
+type GetBlockStatsResult struct {
+	Hash            string                   `json:"hash"`
+	Height          int64                    `json:"height"`
+	CumulativeStart int64                    `json:"cumulativestart"`
+	Fees            []BlockStatsCoinTypeFees `json:"fees"`
+	CoinTypes       []BlockStatsCoinType     `json:"cointypes"`
+}
+
 // GetBlockSubsidyResult models the data returned from the getblocksubsidy
 // command.
 type GetBlockSubsidyResult struct {
@@ -217,6 +369,34 @@ type GetBlockSubsidyResult struct {
 	Total     int64 `json:"total"`
 }
 
+// GetBlockTemplateProposalResult models the data returned from the
+// getblocktemplate command in proposal mode.
+//
+// RejectReason is one of the BIP23 reasons "duplicate",
+// "inconclusive-not-best-prevblk", or "rejected", with the consensus rule the
+// block violates identified by ErrorKind in the latter case.
+type GetBlockTemplateProposalResult struct {
+	Hash         string `json:"hash"`
+	Accepted     bool   `json:"accepted"`
+	RejectReason string `json:"rejectreason,omitempty"`
+	ErrorKind    string `json:"errorkind,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+// GetCoinTypesResult models the data returned for each coin type from the
+// getcointypes command.
+type GetCoinTypesResult struct {
+	CoinType       uint8   `json:"cointype"`
+	Name           string  `json:"name"`
+	Symbol         string  `json:"symbol"`
+	Active         bool    `json:"active"`
+	MaxSupply      int64   `json:"maxsupply"`
+	EmissionHeight int64   `json:"emissionheight"`
+	EmissionWindow int64   `json:"emissionwindow"`
+	EmissionStatus string  `json:"emissionstatus"`
+	MinRelayFee    float64 `json:"minrelayfee"`
+}
+
 // GetSKAInfoResult models the data returned from the getskainfo command.
 type GetSKAInfoResult struct {
 	CoinType    uint8  `json:"cointype"`
@@ -243,6 +423,110 @@ type GetEmissionStatusResult struct {
 	CirculatingSupply int64  `json:"circulatingsupply"` // Current circulating supply in atoms (max - burned)
 }
 
+// EmissionRehearsalStatusResult models the data returned for each coin type
+// from the getemissionrehearsalstatus command.
+type EmissionRehearsalStatusResult struct {
+	CoinType    uint8  `json:"cointype"`            // SKA coin type (1-255)
+	State       string `json:"state"`               // Rehearsal state
+	WindowStart int64  `json:"windowstart"`         // Emission window start height
+	WindowEnd   int64  `json:"windowend"`           // Emission window end height
+	Nonce       uint64 `json:"nonce,omitempty"`     // Nonce of the submitted emission
+	TxHash      string `json:"txhash,omitempty"`    // Hash of the submitted emission
+	Height      int64  `json:"height"`              // Height of the last processed block
+	LastError   string `json:"lasterror,omitempty"` // Most recent submission error
+}
+
+// EmissionWatchStatusResult models the data returned for each coin type from
+// the getemissionwatchstatus command.
+type EmissionWatchStatusResult struct {
+	CoinType    uint8  `json:"cointype"`            // SKA coin type (1-255)
+	Level       string `json:"level"`               // Alert level
+	Tranche     uint32 `json:"tranche,omitempty"`   // Watched emission tranche
+	WindowStart int64  `json:"windowstart"`         // Emission window start height
+	WindowEnd   int64  `json:"windowend"`           // Emission window end height
+	PendingTx   string `json:"pendingtx,omitempty"` // Hash of the pending emission
+	Height      int64  `json:"height"`              // Height of the last processed block
+}
+
+// TestBlockAssemblyTxResult models the data of a single transaction returned
+// from the testblockassembly command.
+type TestBlockAssemblyTxResult struct {
+	Txid     string  `json:"txid"`             // Transaction hash
+	CoinType uint8   `json:"cointype"`         // Coin type the transaction is accounted against
+	Size     uint32  `json:"size"`             // Serialized size in bytes
+	FeeRate  float64 `json:"feerate"`          // Fee rate in coins per KB
+	Included bool    `json:"included"`         // Would be included in the block
+	Reason   string  `json:"reason,omitempty"` // Reason the transaction would be deferred
+}
+
+// TestBlockAssemblyCoinTypeResult models the data of a single coin type
+// returned from the testblockassembly command.
+type TestBlockAssemblyCoinTypeResult struct {
+	CoinType  uint8    `json:"cointype"`  // Coin type
+	Name      string   `json:"name"`      // Coin type name
+	Demand    uint32   `json:"demand"`    // Bytes of provided transactions of the coin type
+	Allocated uint32   `json:"allocated"` // Bytes allocated to the coin type in the block
+	Used      uint32   `json:"used"`      // Bytes of included transactions of the coin type
+	Included  []string `json:"included"`  // Hashes of included transactions
+	Deferred  []string `json:"deferred"`  // Hashes of deferred transactions
+}
+
+// TestBlockAssemblyResult models the data returned from the testblockassembly
+// command.
+type TestBlockAssemblyResult struct {
+	Height       int64                             `json:"height"`       // Height of the hypothetical block
+	TotalBytes   uint32                            `json:"totalbytes"`   // Serialized size of the block including the header
+	CoinTypes    []TestBlockAssemblyCoinTypeResult `json:"cointypes"`    // Packing summary per coin type
+	Transactions []TestBlockAssemblyTxResult       `json:"transactions"` // Packing outcome per transaction in the order provided
+}
+
+// TestMempoolAcceptResult models the data returned from the testmempoolaccept
+// command.
+type TestMempoolAcceptResult struct {
+	Txid            string  `json:"txid"`                   // Transaction hash
+	Allowed         bool    `json:"allowed"`                // Would be accepted to the mempool
+	RejectReason    string  `json:"rejectreason,omitempty"` // Reason the transaction would be rejected
+	CoinType        uint8   `json:"cointype"`               // Primary coin type of the transaction
+	Size            int64   `json:"size"`                   // Serialized size in bytes
+	Fee             float64 `json:"fee,omitempty"`          // Fee paid in coins of the primary coin type
+	FeeRate         float64 `json:"feerate,omitempty"`      // Fee rate in coins per KB
+	BucketSize      uint32  `json:"bucketsize,omitempty"`   // Bytes allocated to the coin type in the next block
+	BytesAhead      uint32  `json:"bytesahead,omitempty"`   // Pending bytes of the coin type paying an equal or higher fee rate
+	FitsNextBlock   bool    `json:"fitsnextblock"`          // Would fit in the coin type allocation of the next block
+	EstimatedBlocks int64   `json:"estimatedblocks"`        // Estimated blocks until confirmation
+
+	// Advisory is set when the transaction is allowed but is unlikely to
+	// confirm soon because the block space allocated to its coin type is
+	// saturated.
+	Advisory *AllocationAdvisory `json:"advisory,omitempty"`
+}
+
+// AllocationAdvisory describes why a transaction accepted to the mempool is
+// unlikely to confirm soon due to the block space allocated to its coin type.
+type AllocationAdvisory struct {
+	Reason          string  `json:"reason"`                // Why the transaction is unlikely to confirm soon
+	EstimatedBlocks int64   `json:"estimatedblocks"`       // Estimated blocks until confirmation given the pending demand
+	BucketSize      uint32  `json:"bucketsize"`            // Bytes allocated to the coin type in the next block
+	BucketDemand    uint32  `json:"bucketdemand"`          // Pending bytes of the coin type including the transaction
+	Utilization     float64 `json:"utilization,omitempty"` // Fraction of the allocated space used by recent blocks
+}
+
+// SendRawTransactionResult models the data returned from the
+// sendrawtransaction command when the verbose flag is set.
+type SendRawTransactionResult struct {
+	Txid     string              `json:"txid"`
+	Advisory *AllocationAdvisory `json:"advisory,omitempty"`
+}
+
+// FundRawTransactionResult models the data returned from the
+// fundrawtransaction and createsweeptransaction commands.
+type FundRawTransactionResult struct {
+	Hex       string  `json:"hex"`       // Unsigned transaction
+	Fee       float64 `json:"fee"`       // Fee paid in coins of the coin type
+	CoinType  uint8   `json:"cointype"`  // Coin type of every input and output
+	ChangePos int32   `json:"changepos"` // Index of the change output or -1
+}
+
 // GetBurnedCoinsStat models burn statistics for a single coin type.
 type GetBurnedCoinsStat struct {
 	CoinType    uint8   `json:"cointype"`    // Coin type (1-255 for SKA)
@@ -257,6 +541,251 @@ type GetBurnedCoinsResult struct {
 	Stats []GetBurnedCoinsStat `json:"stats"` // Burn statistics by coin type
 }
 
+// SKABurnResult models a single burn returned from the getskaburns command.
+type SKABurnResult struct {
+	TxHash string  `json:"txhash"` // Hash of the burn transaction
+	Vout   uint32  `json:"vout"`   // Index of the burn output
+	Height int64   `json:"height"` // Height of the block containing the burn
+	Amount float64 `json:"amount"` // Amount burned in coins
+}
+
+// GetSKABurnsResult models the data returned from the getskaburns command.
+type GetSKABurnsResult struct {
+	CoinType     uint8           `json:"cointype"`     // SKA coin type (1-255)
+	TotalBurned  float64         `json:"totalburned"`  // Total amount burned in coins
+	JournalStart int64           `json:"journalstart"` // Height of the first block with recorded burns
+	Burns        []SKABurnResult `json:"burns"`        // Burns ordered by height
+}
+
+// SKAEmissionOutputResult models an output of an emission returned from the
+// getskaemissions command.
+type SKAEmissionOutputResult struct {
+	Vout         uint32  `json:"vout"`         // Index of the output
+	Amount       float64 `json:"amount"`       // Amount emitted in coins
+	Version      uint16  `json:"version"`      // Script version of the output
+	ScriptPubKey string  `json:"scriptpubkey"` // Hex-encoded output script
+}
+
+// SKAEmissionResult models a single emission returned from the
+// getskaemissions command.
+type SKAEmissionResult struct {
+	BlockHash     string                    `json:"blockhash"`     // Hash of the block containing the emission
+	Height        int64                     `json:"height"`        // Height of the block containing the emission
+	TxHash        string                    `json:"txhash"`        // Hash of the emission transaction
+	Nonce         uint64                    `json:"nonce"`         // Emission nonce
+	Confirmations int64                     `json:"confirmations"` // Number of confirmations of the emission
+	Final         bool                      `json:"final"`         // Whether the emission reached the configured number of confirmations
+	Outputs       []SKAEmissionOutputResult `json:"outputs"`       // Outputs paying the coin type
+}
+
+// GetSKAEmissionsResult models the data returned from the getskaemissions
+// command.
+type GetSKAEmissionsResult struct {
+	CoinType  uint8               `json:"cointype"`  // SKA coin type (1-255)
+	Emissions []SKAEmissionResult `json:"emissions"` // Emissions ordered by height
+}
+
+// SKAActivationTrancheResult models a scheduled emission tranche of a coin
+// type returned from the getskaactivationtimeline command.
+type SKAActivationTrancheResult struct {
+	EmissionHeight    int64   `json:"emissionheight"`    // First height the tranche may be emitted at
+	EmissionWindowEnd int64   `json:"emissionwindowend"` // Final height the tranche may be emitted at
+	Amount            float64 `json:"amount"`            // Total amount emitted by the tranche in coins
+	Emitted           bool    `json:"emitted"`           // Whether the tranche has been emitted
+}
+
+// SKAActivationTimelineResult models the activation timeline of a single coin
+// type returned from the getskaactivationtimeline command.
+type SKAActivationTimelineResult struct {
+	CoinType            uint8                        `json:"cointype"`                      // SKA coin type (1-255)
+	Name                string                       `json:"name"`                          // Name of the coin type
+	Symbol              string                       `json:"symbol"`                        // Symbol of the coin type
+	Active              bool                         `json:"active"`                        // Whether the coin type is configured as active
+	ActivationHeight    int64                        `json:"activationheight"`              // Configured height the coin type activates at
+	Tranches            []SKAActivationTrancheResult `json:"tranches"`                      // Configured emission schedule
+	TranchesEmitted     uint32                       `json:"tranchesemitted"`               // Number of tranches emitted according to the chain state
+	EmissionHeight      int64                        `json:"emissionheight,omitempty"`      // Height of the first emission transaction
+	EmissionTxHash      string                       `json:"emissiontxhash,omitempty"`      // Hash of the first emission transaction
+	FirstTxHeight       int64                        `json:"firsttxheight,omitempty"`       // Height of the first transaction using the coin type
+	FirstTxHash         string                       `json:"firsttxhash,omitempty"`         // Hash of the first transaction using the coin type
+	BlocksUntilActivate int64                        `json:"blocksuntilactivate,omitempty"` // Blocks until the coin type activates
+	Spendable           bool                         `json:"spendable"`                     // Whether the coin type may be spent in the next block
+}
+
+// GetSKAActivationTimelineResult models the data returned from the
+// getskaactivationtimeline command.
+type GetSKAActivationTimelineResult struct {
+	Height    int64                         `json:"height"`    // Height of the current best block
+	BestBlock string                        `json:"bestblock"` // Hash of the current best block
+	Indexed   bool                          `json:"indexed"`   // Whether observed heights from the emission index are included
+	CoinTypes []SKAActivationTimelineResult `json:"cointypes"` // Timeline of each coin type ordered by coin type
+}
+
+// GetSKAEmissionStatusResult models the data returned from the
+// getskaemissionstatus command.
+type GetSKAEmissionStatusResult struct {
+	TxHash             string `json:"txhash"`              // Hash of the emission transaction
+	Status             string `json:"status"`              // Status of the emission (pending, provisional, or final)
+	CoinType           uint8  `json:"cointype"`            // SKA coin type (1-255)
+	Nonce              uint64 `json:"nonce"`               // Emission nonce
+	BlockHash          string `json:"blockhash,omitempty"` // Hash of the block containing the emission
+	Height             int64  `json:"height,omitempty"`    // Height of the block containing the emission
+	Confirmations      int64  `json:"confirmations"`       // Number of confirmations of the emission
+	FinalConfirmations int64  `json:"finalconfirmations"`  // Number of confirmations after which the emission is final
+}
+
+// EncodeEmissionAuthResult models the data returned from the
+// encodeemissionauth command.
+type EncodeEmissionAuthResult struct {
+	Descriptor string `json:"descriptor"` // Canonical JSON encoding of the descriptor
+	AuthScript string `json:"authscript"` // Hex-encoded emission authorization script
+	Hex        string `json:"hex"`        // Hex-encoded emission transaction
+	Signed     bool   `json:"signed"`     // Whether the descriptor is signed
+}
+
+// DecodeEmissionAuthResult models the data returned from the
+// decodeemissionauth command.  The outputs of the emission are not part of
+// the authorization script, so the addresses and amounts are always empty.
+type DecodeEmissionAuthResult struct {
+	CoinType  uint8    `json:"cointype"`            // SKA coin type (1-255)
+	Nonce     uint64   `json:"nonce"`               // Emission nonce
+	Amount    int64    `json:"amount"`              // Total amount emitted in atoms
+	Height    int64    `json:"height"`              // Height the emission is authorized for
+	Addresses []string `json:"addresses"`           // Addresses paid by the emission
+	Amounts   []int64  `json:"amounts"`             // Amounts paid to the addresses in atoms
+	PubKey    string   `json:"pubkey"`              // Hex-encoded emission public key
+	Signature string   `json:"signature,omitempty"` // Hex-encoded emission signature
+}
+
+// GetEmissionKeysResult models the data returned for each coin type with a
+// configured emission key from the getemissionkeys command.
+type GetEmissionKeysResult struct {
+	CoinType    uint8  `json:"cointype"`    // SKA coin type (1-255)
+	Name        string `json:"name"`        // Name of the coin type
+	PubKey      string `json:"pubkey"`      // Hex-encoded compressed emission public key
+	Fingerprint string `json:"fingerprint"` // Fingerprint of the emission public key
+	Placeholder bool   `json:"placeholder"` // Whether the key is a known placeholder key
+}
+
+// AttestEmissionKeyResult models the data returned from the attestemissionkey
+// command.
+type AttestEmissionKeyResult struct {
+	CoinType    uint8  `json:"cointype"`         // SKA coin type (1-255)
+	Fingerprint string `json:"fingerprint"`      // Fingerprint of the emission public key
+	Valid       bool   `json:"valid"`            // Whether the attestation is valid
+	Reason      string `json:"reason,omitempty"` // Reason the attestation is invalid
+}
+
+// SKASupplyAuditResult models the audit of the supply of a single SKA coin
+// type returned from the auditskasupply command.  All amounts are in atoms.
+type SKASupplyAuditResult struct {
+	CoinType        uint8    `json:"cointype"`                 // SKA coin type (1-255)
+	Name            string   `json:"name"`                     // Name of the coin type
+	MaxSupply       int64    `json:"maxsupply"`                // Maximum supply defined by the chain parameters
+	TranchesEmitted uint32   `json:"tranchesemitted"`          // Number of emission tranches emitted
+	Emitted         int64    `json:"emitted"`                  // Amount emitted according to the chain state
+	IndexedEmitted  *int64   `json:"indexedemitted,omitempty"` // Amount emitted according to the emission index
+	Burned          int64    `json:"burned"`                   // Amount burned
+	ExpectedSupply  int64    `json:"expectedsupply"`           // Amount emitted less the amount burned
+	UtxoSupply      int64    `json:"utxosupply"`               // Total of the unspent outputs of the coin type
+	UtxoCount       int64    `json:"utxocount"`                // Number of unspent outputs of the coin type
+	Discrepancy     int64    `json:"discrepancy"`              // UTXO supply less the expected supply
+	Issues          []string `json:"issues,omitempty"`         // Descriptions of the failed checks
+}
+
+// AuditSKASupplyResult models the data returned from the auditskasupply
+// command.
+type AuditSKASupplyResult struct {
+	Height      int64                  `json:"height"`      // Height of the audited main chain tip
+	BestBlock   string                 `json:"bestblock"`   // Hash of the audited main chain tip
+	UtxoSetHash string                 `json:"utxosethash"` // Hash of the audited UTXO set
+	Consistent  bool                   `json:"consistent"`  // Whether all checks passed for all coin types
+	CoinTypes   []SKASupplyAuditResult `json:"cointypes"`   // Audits ordered by coin type
+}
+
+// SupplyScanCoinTypeResult models the result of the most recent UTXO supply
+// scan for a single coin type returned from the getsupplyscaninfo command.
+// All amounts are in atoms.
+type SupplyScanCoinTypeResult struct {
+	CoinType       uint8    `json:"cointype"`                 // Coin type (0-255)
+	UtxoCount      int64    `json:"utxocount"`                // Number of unspent outputs of the coin type
+	UtxoSupply     int64    `json:"utxosupply"`               // Total of the unspent outputs within bounds
+	OutOfBounds    int64    `json:"outofbounds"`              // Number of unspent outputs with amounts out of bounds
+	Emitted        int64    `json:"emitted,omitempty"`        // Amount emitted according to the chain state
+	IndexedEmitted *int64   `json:"indexedemitted,omitempty"` // Amount emitted according to the emission index
+	Burned         int64    `json:"burned,omitempty"`         // Amount burned
+	ExpectedSupply int64    `json:"expectedsupply,omitempty"` // Amount emitted less the amount burned
+	Issues         []string `json:"issues,omitempty"`         // Descriptions of the divergences found
+}
+
+// GetSupplyScanInfoResult models the data returned from the getsupplyscaninfo
+// command.
+type GetSupplyScanInfoResult struct {
+	Scanning      bool                       `json:"scanning"`            // Whether a scan is in progress
+	Scanned       int64                      `json:"scanned"`             // Number of entries checked by the current or last scan
+	Scans         uint64                     `json:"scans"`               // Number of completed scans
+	LastScanStart int64                      `json:"lastscanstart"`       // Unix time the last completed scan started
+	LastScanEnd   int64                      `json:"lastscanend"`         // Unix time the last completed scan ended
+	Height        int64                      `json:"height"`              // Height of the block the last scan corresponds to
+	BestBlock     string                     `json:"bestblock"`           // Hash of the block the last scan corresponds to
+	Consistent    bool                       `json:"consistent"`          // Whether the last scan found no divergence
+	CoinTypes     []SupplyScanCoinTypeResult `json:"cointypes"`           // Results ordered by coin type
+	LastError     string                     `json:"lasterror,omitempty"` // Error that caused the most recent scan to fail
+}
+
+// CoinTypeSnapshotUtxo models an unspent output in the result of the
+// getcointypesnapshot command.
+type CoinTypeSnapshotUtxo struct {
+	TxHash       string `json:"txhash"`            // Hash of the transaction that created the output
+	Tree         int8   `json:"tree"`              // Tree of the transaction that created the output
+	Vout         uint32 `json:"vout"`              // Index of the output
+	Height       int64  `json:"height"`            // Height of the block that created the output
+	Amount       int64  `json:"amount"`            // Amount of the output in atoms
+	Version      uint16 `json:"version"`           // Version of the output script
+	ScriptPubKey string `json:"scriptpubkey"`      // Hex-encoded output script
+	Address      string `json:"address,omitempty"` // Address paid by the output script
+}
+
+// CoinTypeSnapshotBalance models the aggregated balance of an address in the
+// result of the getcointypesnapshot command.  Outputs whose script does not
+// pay a single address are aggregated by script instead.
+type CoinTypeSnapshotBalance struct {
+	Address      string `json:"address,omitempty"`      // Address paid by the outputs
+	ScriptPubKey string `json:"scriptpubkey,omitempty"` // Hex-encoded output script when there is no address
+	Amount       int64  `json:"amount"`                 // Total amount of the outputs in atoms
+	Utxos        int64  `json:"utxos"`                  // Number of outputs
+}
+
+// GetCoinTypeSnapshotResult models the data returned from the
+// getcointypesnapshot command.  Either the unspent outputs or the balances are
+// set depending on whether or not the snapshot is aggregated.
+type GetCoinTypeSnapshotResult struct {
+	CoinType  uint8                     `json:"cointype"`           // Coin type of the snapshot
+	Height    int64                     `json:"height"`             // Height of the snapshot block
+	BlockHash string                    `json:"blockhash"`          // Hash of the snapshot block
+	Total     int64                     `json:"total"`              // Total amount of the unspent outputs in atoms
+	UtxoCount int64                     `json:"utxocount"`          // Number of unspent outputs
+	Utxos     []CoinTypeSnapshotUtxo    `json:"utxos,omitempty"`    // Unspent outputs ordered by outpoint
+	Balances  []CoinTypeSnapshotBalance `json:"balances,omitempty"` // Balances ordered by address and then script
+}
+
+// ValidationStageStats models the durations of a stage of block validation in
+// the result of the getvalidationstats command.
+type ValidationStageStats struct {
+	Stage     string  `json:"stage"`     // Name of the validation stage
+	Count     uint64  `json:"count"`     // Number of blocks that passed the stage
+	TotalMs   float64 `json:"totalms"`   // Total duration of the stage in milliseconds
+	AverageMs float64 `json:"averagems"` // Average duration of the stage per block in milliseconds
+	MaxMs     float64 `json:"maxms"`     // Longest duration of the stage in milliseconds
+	LastMs    float64 `json:"lastms"`    // Most recent duration of the stage in milliseconds
+}
+
+// GetValidationStatsResult models the data returned from the
+// getvalidationstats command.
+type GetValidationStatsResult struct {
+	Stages []ValidationStageStats `json:"stages"` // Durations of each validation stage
+}
+
 // GetChainTipsResult models the data returns from the getchaintips command.
 type GetChainTipsResult struct {
 	Height    int64  `json:"height"`
@@ -279,6 +808,19 @@ type GetHeadersResult struct {
 	Headers []string `json:"headers"`
 }
 
+// GetIndexInfoResult models the data returned for each index from the
+// getindexinfo command and from the waitforindexsync websocket command.
+type GetIndexInfoResult struct {
+	Name               string  `json:"name"`
+	Version            uint32  `json:"version"`
+	TipHeight          int64   `json:"tipheight"`
+	TipHash            string  `json:"tiphash"`
+	BestHeight         int64   `json:"bestheight"`
+	Synced             bool    `json:"synced"`
+	Progress           float64 `json:"progress"`
+	EstimatedRemaining int64   `json:"estimatedremaining"`
+}
+
 // InfoChainResult models the data returned by the chain server getinfo command.
 type InfoChainResult struct {
 	Version         int32   `json:"version"`
@@ -297,8 +839,39 @@ type InfoChainResult struct {
 // GetMempoolInfoResult models the data returned from the getmempoolinfo
 // command.
 type GetMempoolInfoResult struct {
-	Size  int64 `json:"size"`
-	Bytes int64 `json:"bytes"`
+	Size            int64                           `json:"size"`
+	Bytes           int64                           `json:"bytes"`
+	CoinTypes       map[string]MempoolCoinTypeInfo  `json:"cointypes"`
+	ValidationLanes map[string]TxValidationLaneInfo `json:"validationlanes,omitempty"`
+}
+
+// TxValidationLaneInfo models the metrics of one of the lanes transactions
+// received from peers are validated in returned as part of the getmempoolinfo
+// command.
+type TxValidationLaneInfo struct {
+	Processed uint64  `json:"processed"`
+	Pending   int64   `json:"pending"`
+	AvgWait   float64 `json:"avgwait"`
+	MaxWait   float64 `json:"maxwait"`
+}
+
+// MempoolCoinTypeInfo models the memory pool information for a single coin
+// type returned as part of the getmempoolinfo command.
+type MempoolCoinTypeInfo struct {
+	CoinType          uint8   `json:"cointype"`
+	Name              string  `json:"name"`
+	Size              int64   `json:"size"`
+	Bytes             int64   `json:"bytes"`
+	TotalFees         float64 `json:"totalfees"`
+	MinFee            float64 `json:"minfee,omitempty"`
+	MempoolMinFee     float64 `json:"mempoolminfee,omitempty"`
+	MaxBytes          int64   `json:"maxbytes"`
+	MaxAncestors      int64   `json:"maxancestors"`
+	MaxAncestorSize   int64   `json:"maxancestorsize"`
+	MaxDescendants    int64   `json:"maxdescendants"`
+	MaxDescendantSize int64   `json:"maxdescendantsize"`
+	Expiry            int64   `json:"expiry"`
+	Expired           uint64  `json:"expired"`
 }
 
 // GetMiningInfoResult models the data from the getmininginfo command.
@@ -316,6 +889,17 @@ type GetMiningInfoResult struct {
 	NetworkHashPS    int64   `json:"networkhashps"`
 	PooledTx         uint64  `json:"pooledtx"`
 	TestNet          bool    `json:"testnet"`
+
+	TemplateBudget *TemplateBudgetResult `json:"templatebudget,omitempty"`
+}
+
+// TemplateBudgetResult models the statistics about the time budget of block
+// templates returned as part of the getmininginfo command.
+type TemplateBudgetResult struct {
+	Templates        uint64 `json:"templates"`
+	PartialTemplates uint64 `json:"partialtemplates"`
+	SkippedTxns      uint64 `json:"skippedtxns"`
+	SkippedAugments  uint64 `json:"skippedaugments"`
 }
 
 // GetMixMessageResult models the data from the getmixmessage command.
@@ -384,6 +968,18 @@ type GetPeerInfoResult struct {
 	CurrentHeight  int64   `json:"currentheight,omitempty"`
 	BanScore       int32   `json:"banscore"`
 	SyncNode       bool    `json:"syncnode"`
+
+	TxRelayStats []PeerTxRelayStats `json:"txrelaystats,omitempty"`
+}
+
+// PeerTxRelayStats models the number of transactions and bytes relayed to and
+// from a peer for a single coin type as returned by the getpeerinfo command.
+type PeerTxRelayStats struct {
+	CoinType    uint8  `json:"cointype"`
+	TxSent      uint64 `json:"txsent"`
+	TxBytesSent uint64 `json:"txbytessent"`
+	TxRecv      uint64 `json:"txrecv"`
+	TxBytesRecv uint64 `json:"txbytesrecv"`
 }
 
 // GetRawMempoolVerboseResult models the data returned from the getrawmempool
@@ -403,19 +999,40 @@ type GetRawMempoolVerboseResult struct {
 
 // TxRawResult models the data from the getrawtransaction command.
 type TxRawResult struct {
-	Hex           string `json:"hex"`
-	Txid          string `json:"txid"`
-	Version       int32  `json:"version"`
-	LockTime      uint32 `json:"locktime"`
-	Expiry        uint32 `json:"expiry"`
-	Vin           []Vin  `json:"vin"`
-	Vout          []Vout `json:"vout"`
-	BlockHash     string `json:"blockhash,omitempty"`
-	BlockHeight   int64  `json:"blockheight,omitempty"`
-	BlockIndex    uint32 `json:"blockindex,omitempty"`
-	Confirmations int64  `json:"confirmations,omitempty"`
-	Time          int64  `json:"time,omitempty"`
-	Blocktime     int64  `json:"blocktime,omitempty"`
+	Hex             string  `json:"hex"`
+	Txid            string  `json:"txid"`
+	Version         int32   `json:"version"`
+	LockTime        uint32  `json:"locktime"`
+	Expiry          uint32  `json:"expiry"`
+	PrimaryCoinType uint8   `json:"primarycointype"`
+	MinRelayFee     float64 `json:"minrelayfee"`
+	Vin             []Vin   `json:"vin"`
+	Vout            []Vout  `json:"vout"`
+	BlockHash       string  `json:"blockhash,omitempty"`
+	BlockHeight     int64   `json:"blockheight,omitempty"`
+	BlockIndex      uint32  `json:"blockindex,omitempty"`
+	Confirmations   int64   `json:"confirmations,omitempty"`
+	Time            int64   `json:"time,omitempty"`
+	Blocktime       int64   `json:"blocktime,omitempty"`
+}
+
+// CoinTypeUtilizationResult models the block space utilization of a single
+// coin type in a block returned from the getrecentutilization command.
+type CoinTypeUtilizationResult struct {
+	CoinType       uint8   `json:"cointype"`
+	Name           string  `json:"name"`
+	NumTxns        uint32  `json:"numtxns"`
+	UsedBytes      uint32  `json:"usedbytes"`
+	AllocatedBytes uint32  `json:"allocatedbytes"`
+	Utilization    float64 `json:"utilization"`
+}
+
+// GetRecentUtilizationResult models the data returned from the
+// getrecentutilization command for a single block.
+type GetRecentUtilizationResult struct {
+	Height    int64                       `json:"height"`
+	Hash      string                      `json:"hash"`
+	CoinTypes []CoinTypeUtilizationResult `json:"cointypes"`
 }
 
 // GetStakeDifficultyResult models the data returned from the
@@ -470,11 +1087,14 @@ type GetStakeVersionsResult struct {
 
 // GetTxOutResult models the data from the gettxout command.
 type GetTxOutResult struct {
-	BestBlock     string             `json:"bestblock"`
-	Confirmations int64              `json:"confirmations"`
-	Value         float64            `json:"value"`
-	ScriptPubKey  ScriptPubKeyResult `json:"scriptPubKey"`
-	Coinbase      bool               `json:"coinbase"`
+	BestBlock      string             `json:"bestblock"`
+	Confirmations  int64              `json:"confirmations"`
+	Value          float64            `json:"value"`
+	ScriptPubKey   ScriptPubKeyResult `json:"scriptPubKey"`
+	Coinbase       bool               `json:"coinbase"`
+	CoinType       uint8              `json:"cointype"`
+	CoinTypeActive bool               `json:"cointypeactive"`
+	SupplyFraction *float64           `json:"supplyfraction,omitempty"` // Fraction of the max supply of an SKA coin type
 }
 
 // GetTxOutSetInfoResult models the data from the gettxoutsetinfo command.
@@ -551,6 +1171,70 @@ type GetTreasurySpendVotesResult struct {
 	Votes  []TreasurySpendVotes `json:"votes"`
 }
 
+// WatchOnlyAddressBalance models the balance of a single watched address of a
+// specific coin type returned from the getwatchonlybalance command.
+type WatchOnlyAddressBalance struct {
+	Address   string  `json:"address"`
+	CoinType  uint8   `json:"cointype"`
+	Balance   float64 `json:"balance"`
+	UtxoCount int64   `json:"utxocount"`
+}
+
+// WatchOnlyCoinTypeBalance models the total balance of all watched addresses
+// of a specific coin type returned from the getwatchonlybalance command.
+type WatchOnlyCoinTypeBalance struct {
+	CoinType  uint8   `json:"cointype"`
+	Balance   float64 `json:"balance"`
+	UtxoCount int64   `json:"utxocount"`
+}
+
+// GetWatchOnlyBalanceResult models the data returned from the
+// getwatchonlybalance command.
+type GetWatchOnlyBalanceResult struct {
+	Height    int64                      `json:"height"`
+	Totals    []WatchOnlyCoinTypeBalance `json:"totals"`
+	Addresses []WatchOnlyAddressBalance  `json:"addresses"`
+}
+
+// ListKnownSKAAddressesResult models the data returned for each address from
+// the listknownskaaddresses command.
+type ListKnownSKAAddressesResult struct {
+	CoinType        uint8   `json:"cointype"`
+	Tranche         uint32  `json:"tranche"`
+	EmissionHeight  int64   `json:"emissionheight"`
+	Address         string  `json:"address,omitempty"`
+	Descriptor      string  `json:"descriptor,omitempty"`
+	Amount          float64 `json:"amount"`
+	ManifestMissing bool    `json:"manifestmissing,omitempty"`
+	Watched         bool    `json:"watched"`
+}
+
+// ListRebroadcastTxsResult models the data returned for each transaction from
+// the listrebroadcasttxs command.
+type ListRebroadcastTxsResult struct {
+	TxID        string `json:"txid"`
+	CoinType    uint8  `json:"cointype"`
+	Added       int64  `json:"added"`
+	Attempts    uint32 `json:"attempts"`
+	LastAttempt int64  `json:"lastattempt,omitempty"`
+	NextAttempt int64  `json:"nextattempt"`
+	Saturated   bool   `json:"saturated"`
+}
+
+// ListUnspentWatchOnlyResult models the data returned for each unspent output
+// from the listunspentwatchonly command.
+type ListUnspentWatchOnlyResult struct {
+	TxID          string  `json:"txid"`
+	Vout          uint32  `json:"vout"`
+	Tree          int8    `json:"tree"`
+	Address       string  `json:"address"`
+	CoinType      uint8   `json:"cointype"`
+	Amount        float64 `json:"amount"`
+	ScriptPubKey  string  `json:"scriptpubkey"`
+	BlockHeight   int64   `json:"blockheight"`
+	Confirmations int64   `json:"confirmations"`
+}
+
 // GetWorkResult models the data from the getwork command.
 type GetWorkResult struct {
 	Data   string `json:"data"`
@@ -690,6 +1374,7 @@ type Vin struct {
 	BlockHeight   uint32     `json:"blockheight"`
 	BlockIndex    uint32     `json:"blockindex"`
 	ScriptSig     *ScriptSig `json:"scriptSig"`
+	CoinType      *uint8     `json:"cointype,omitempty"`
 }
 
 // IsCoinBase returns whether or not an input is a coinbase input.
@@ -784,6 +1469,7 @@ func (v *Vin) MarshalJSON() ([]byte, error) {
 		BlockHeight uint32     `json:"blockheight"`
 		BlockIndex  uint32     `json:"blockindex"`
 		ScriptSig   *ScriptSig `json:"scriptSig"`
+		CoinType    *uint8     `json:"cointype,omitempty"`
 	}{
 		Txid:        v.Txid,
 		Vout:        v.Vout,
@@ -793,6 +1479,7 @@ func (v *Vin) MarshalJSON() ([]byte, error) {
 		BlockHeight: v.BlockHeight,
 		BlockIndex:  v.BlockIndex,
 		ScriptSig:   v.ScriptSig,
+		CoinType:    v.CoinType,
 	}
 	return json.Marshal(txStruct)
 }
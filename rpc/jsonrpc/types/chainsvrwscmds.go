@@ -61,12 +61,25 @@ func NewNotifyBlocksCmd() *NotifyBlocksCmd {
 }
 
 // NotifyWorkCmd defines the notifywork JSON-RPC command.
-type NotifyWorkCmd struct{}
+//
+// Work notifications for templates that were only updated due to new
+// transactions are limited to those that materially changed the buckets of
+// the provided coin types when either of the optional fields is set.
+type NotifyWorkCmd struct {
+	CoinTypes        *[]int
+	FeeRateChangePct *uint32
+}
 
 // NewNotifyWorkCmd returns a new instance which can be used to issue a
 // notifywork JSON-RPC command.
-func NewNotifyWorkCmd() *NotifyWorkCmd {
-	return &NotifyWorkCmd{}
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewNotifyWorkCmd(coinTypes *[]int, feeRateChangePct *uint32) *NotifyWorkCmd {
+	return &NotifyWorkCmd{
+		CoinTypes:        coinTypes,
+		FeeRateChangePct: feeRateChangePct,
+	}
 }
 
 // NotifyTSpendCmd defines the notifytspend JSON-RPC command.
@@ -78,6 +91,15 @@ func NewNotifyTSpendCmd() *NotifyTSpendCmd {
 	return &NotifyTSpendCmd{}
 }
 
+// NotifyDoubleSpendsCmd defines the notifydoublespends JSON-RPC command.
+type NotifyDoubleSpendsCmd struct{}
+
+// NewNotifyDoubleSpendsCmd returns a new instance which can be used to issue a
+// notifydoublespends JSON-RPC command.
+func NewNotifyDoubleSpendsCmd() *NotifyDoubleSpendsCmd {
+	return &NotifyDoubleSpendsCmd{}
+}
+
 // NotifyWinningTicketsCmd is a type handling custom marshaling and
 // unmarshaling of notifywinningtickets JSON websocket extension
 // commands.
@@ -137,6 +159,16 @@ func NewStopNotifyTSpendCmd() *StopNotifyTSpendCmd {
 	return &StopNotifyTSpendCmd{}
 }
 
+// StopNotifyDoubleSpendsCmd defines the stopnotifydoublespends JSON-RPC
+// command.
+type StopNotifyDoubleSpendsCmd struct{}
+
+// NewStopNotifyDoubleSpendsCmd returns a new instance which can be used to
+// issue a stopnotifydoublespends JSON-RPC command.
+func NewStopNotifyDoubleSpendsCmd() *StopNotifyDoubleSpendsCmd {
+	return &StopNotifyDoubleSpendsCmd{}
+}
+
 // NotifyNewTransactionsCmd defines the notifynewtransactions JSON-RPC command.
 type NotifyNewTransactionsCmd struct {
 	Verbose *bool `jsonrpcdefault:"false"`
@@ -171,6 +203,19 @@ func NewStopNewNotifyMixMessagesCmd() *StopNotifyMixMessagesCmd {
 	return &StopNotifyMixMessagesCmd{}
 }
 
+// WaitForIndexSyncCmd defines the waitforindexsync JSON-RPC command.
+type WaitForIndexSyncCmd struct {
+	Name string
+}
+
+// NewWaitForIndexSyncCmd returns a new instance which can be used to issue a
+// waitforindexsync JSON-RPC command.
+func NewWaitForIndexSyncCmd(name string) *WaitForIndexSyncCmd {
+	return &WaitForIndexSyncCmd{
+		Name: name,
+	}
+}
+
 // SessionCmd defines the session JSON-RPC command.
 type SessionCmd struct{}
 
@@ -203,6 +248,25 @@ func NewRescanCmd(blockHashes []string) *RescanCmd {
 	return &RescanCmd{BlockHashes: blockHashes}
 }
 
+// RescanCoinTypeCmd defines the rescancointype JSON-RPC command.
+type RescanCoinTypeCmd struct {
+	CoinType    uint8
+	Addresses   []string
+	StartHeight int64
+	EndHeight   *int64
+}
+
+// NewRescanCoinTypeCmd returns a new instance which can be used to issue a
+// rescancointype JSON-RPC command.
+func NewRescanCoinTypeCmd(coinType uint8, addresses []string, startHeight int64, endHeight *int64) *RescanCoinTypeCmd {
+	return &RescanCoinTypeCmd{
+		CoinType:    coinType,
+		Addresses:   addresses,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
 func init() {
 	// The commands in this file are only usable by websockets.
 	flags := dcrjson.UFWebsocketOnly
@@ -212,6 +276,7 @@ func init() {
 	dcrjson.MustRegister(Method("notifyblocks"), (*NotifyBlocksCmd)(nil), flags)
 	dcrjson.MustRegister(Method("notifywork"), (*NotifyWorkCmd)(nil), flags)
 	dcrjson.MustRegister(Method("notifytspend"), (*NotifyTSpendCmd)(nil), flags)
+	dcrjson.MustRegister(Method("notifydoublespends"), (*NotifyDoubleSpendsCmd)(nil), flags)
 	dcrjson.MustRegister(Method("notifynewtransactions"), (*NotifyNewTransactionsCmd)(nil), flags)
 	dcrjson.MustRegister(Method("notifynewtickets"), (*NotifyNewTicketsCmd)(nil), flags)
 	dcrjson.MustRegister(Method("notifywinningtickets"), (*NotifyWinningTicketsCmd)(nil), flags)
@@ -221,7 +286,10 @@ func init() {
 	dcrjson.MustRegister(Method("stopnotifyblocks"), (*StopNotifyBlocksCmd)(nil), flags)
 	dcrjson.MustRegister(Method("stopnotifywork"), (*StopNotifyWorkCmd)(nil), flags)
 	dcrjson.MustRegister(Method("stopnotifytspend"), (*StopNotifyTSpendCmd)(nil), flags)
+	dcrjson.MustRegister(Method("stopnotifydoublespends"), (*StopNotifyDoubleSpendsCmd)(nil), flags)
 	dcrjson.MustRegister(Method("stopnotifynewtransactions"), (*StopNotifyNewTransactionsCmd)(nil), flags)
 	dcrjson.MustRegister(Method("stopnotifymixmessages"), (*StopNotifyMixMessagesCmd)(nil), flags)
 	dcrjson.MustRegister(Method("rescan"), (*RescanCmd)(nil), flags)
+	dcrjson.MustRegister(Method("rescancointype"), (*RescanCoinTypeCmd)(nil), flags)
+	dcrjson.MustRegister(Method("waitforindexsync"), (*WaitForIndexSyncCmd)(nil), flags)
 }
@@ -80,11 +80,25 @@ func TestChainSvrWsCmds(t *testing.T) {
 				return dcrjson.NewCmd(Method("notifywork"))
 			},
 			staticCmd: func() interface{} {
-				return NewNotifyWorkCmd()
+				return NewNotifyWorkCmd(nil, nil)
 			},
 			marshalled:   `{"jsonrpc":"1.0","method":"notifywork","params":[],"id":1}`,
 			unmarshalled: &NotifyWorkCmd{},
 		},
+		{
+			name: "notifywork optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("notifywork"), []int{0, 1}, 10)
+			},
+			staticCmd: func() interface{} {
+				return NewNotifyWorkCmd(&[]int{0, 1}, dcrjson.Uint32(10))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifywork","params":[[0,1],10],"id":1}`,
+			unmarshalled: &NotifyWorkCmd{
+				CoinTypes:        &[]int{0, 1},
+				FeeRateChangePct: dcrjson.Uint32(10),
+			},
+		},
 		{
 			name: "notifytspend",
 			newCmd: func() (interface{}, error) {
@@ -96,6 +110,17 @@ func TestChainSvrWsCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"notifytspend","params":[],"id":1}`,
 			unmarshalled: &NotifyTSpendCmd{},
 		},
+		{
+			name: "notifydoublespends",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("notifydoublespends"))
+			},
+			staticCmd: func() interface{} {
+				return NewNotifyDoubleSpendsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"notifydoublespends","params":[],"id":1}`,
+			unmarshalled: &NotifyDoubleSpendsCmd{},
+		},
 		{
 			name: "stopnotifyblocks",
 			newCmd: func() (interface{}, error) {
@@ -129,6 +154,17 @@ func TestChainSvrWsCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"stopnotifytspend","params":[],"id":1}`,
 			unmarshalled: &StopNotifyTSpendCmd{},
 		},
+		{
+			name: "stopnotifydoublespends",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("stopnotifydoublespends"))
+			},
+			staticCmd: func() interface{} {
+				return NewStopNotifyDoubleSpendsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"stopnotifydoublespends","params":[],"id":1}`,
+			unmarshalled: &StopNotifyDoubleSpendsCmd{},
+		},
 		{
 			name: "notifymixmessages",
 			newCmd: func() (interface{}, error) {
@@ -151,6 +187,19 @@ func TestChainSvrWsCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"stopnotifymixmessages","params":[],"id":1}`,
 			unmarshalled: &StopNotifyMixMessagesCmd{},
 		},
+		{
+			name: "waitforindexsync",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("waitforindexsync"), "txindex")
+			},
+			staticCmd: func() interface{} {
+				return NewWaitForIndexSyncCmd("txindex")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"waitforindexsync","params":["txindex"],"id":1}`,
+			unmarshalled: &WaitForIndexSyncCmd{
+				Name: "txindex",
+			},
+		},
 		{
 			name: "notifynewtransactions",
 			newCmd: func() (interface{}, error) {
@@ -201,6 +250,37 @@ func TestChainSvrWsCmds(t *testing.T) {
 				BlockHashes: []string{"0000000000000000000000000000000000000000000000000000000000000123"},
 			},
 		},
+		{
+			name: "rescancointype",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("rescancointype"), 1, []string{"Ssaddr"}, 100)
+			},
+			staticCmd: func() interface{} {
+				return NewRescanCoinTypeCmd(1, []string{"Ssaddr"}, 100, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"rescancointype","params":[1,["Ssaddr"],100],"id":1}`,
+			unmarshalled: &RescanCoinTypeCmd{
+				CoinType:    1,
+				Addresses:   []string{"Ssaddr"},
+				StartHeight: 100,
+			},
+		},
+		{
+			name: "rescancointype with end height",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("rescancointype"), 1, []string{"Ssaddr"}, 100, 200)
+			},
+			staticCmd: func() interface{} {
+				return NewRescanCoinTypeCmd(1, []string{"Ssaddr"}, 100, dcrjson.Int64(200))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"rescancointype","params":[1,["Ssaddr"],100,200],"id":1}`,
+			unmarshalled: &RescanCoinTypeCmd{
+				CoinType:    1,
+				Addresses:   []string{"Ssaddr"},
+				StartHeight: 100,
+				EndHeight:   dcrjson.Int64(200),
+			},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -30,6 +30,11 @@ const (
 	// server that a new tspend has arrived in the mempool.
 	TSpendNtfnMethod Method = "tspend"
 
+	// DoubleSpendNtfnMethod is the method used for notifications from the
+	// chain server that a transaction attempting to double spend coins
+	// spent by a transaction in the mempool was rejected.
+	DoubleSpendNtfnMethod Method = "doublespend"
+
 	// ReorganizationNtfnMethod is the method used for notifications that the
 	// block chain is in the process of a reorganization.
 	ReorganizationNtfnMethod Method = "reorganization"
@@ -55,6 +60,16 @@ const (
 
 	// MixMessageNtfnMethod is the method of the mixmessage notification.
 	MixMessageNtfnMethod Method = "mixmessage"
+
+	// RescanCoinTypeBlockNtfnMethod is the method used for notifications
+	// from the chain server that a block scanned by a rescancointype request
+	// contains relevant transactions.
+	RescanCoinTypeBlockNtfnMethod Method = "rescancointypeblock"
+
+	// SKAEmissionFinalNtfnMethod is the method used for notifications from
+	// the chain server that an SKA emission reached the number of
+	// confirmations after which it is considered final.
+	SKAEmissionFinalNtfnMethod Method = "skaemissionfinal"
 )
 
 // BlockConnectedNtfn defines the blockconnected JSON-RPC notification.
@@ -133,12 +148,42 @@ func NewTSpendNtfn(tspend string) *TSpendNtfn {
 	}
 }
 
+// DoubleSpendNtfn defines the doublespend JSON-RPC notification.
+type DoubleSpendNtfn struct {
+	CoinType  uint8    `json:"cointype"`
+	TxHash    string   `json:"txhash"`
+	Conflicts []string `json:"conflicts"`
+}
+
+// NewDoubleSpendNtfn returns a new instance which can be used to issue a
+// doublespend JSON-RPC notification.
+func NewDoubleSpendNtfn(coinType uint8, txHash string, conflicts []string) *DoubleSpendNtfn {
+	return &DoubleSpendNtfn{
+		CoinType:  coinType,
+		TxHash:    txHash,
+		Conflicts: conflicts,
+	}
+}
+
+// ReorganizationSKAEmission describes an SKA emission from the old best chain
+// that was rolled back by a reorganization.
+type ReorganizationSKAEmission struct {
+	CoinType uint8  `json:"cointype"`
+	Nonce    uint64 `json:"nonce"`
+	Height   int64  `json:"height"`
+	TxHash   string `json:"txhash"`
+}
+
 // ReorganizationNtfn defines the reorganization JSON-RPC notification.
 type ReorganizationNtfn struct {
 	OldHash   string `json:"oldhash"`
 	OldHeight int32  `json:"oldheight"`
 	NewHash   string `json:"newhash"`
 	NewHeight int32  `json:"newheight"`
+
+	// SKAEmissions are the SKA emissions rolled back by the reorganization.
+	// It is omitted when no emissions were rolled back.
+	SKAEmissions *[]ReorganizationSKAEmission `json:"skaemissions"`
 }
 
 // NewReorganizationNtfn returns a new instance which can be used to issue a
@@ -224,6 +269,50 @@ func NewMixMessageNtfn(command, payload string) *MixMessageNtfn {
 		Payload: payload,
 	}
 }
+
+// RescanCoinTypeBlockNtfn defines the rescancointypeblock JSON-RPC
+// notification.
+type RescanCoinTypeBlockNtfn struct {
+	CoinType     uint8    `json:"cointype"`
+	Hash         string   `json:"hash"`
+	Height       int64    `json:"height"`
+	Transactions []string `json:"transactions"`
+}
+
+// NewRescanCoinTypeBlockNtfn returns a new instance which can be used to issue
+// a rescancointypeblock JSON-RPC notification.
+func NewRescanCoinTypeBlockNtfn(coinType uint8, hash string, height int64, transactions []string) *RescanCoinTypeBlockNtfn {
+	return &RescanCoinTypeBlockNtfn{
+		CoinType:     coinType,
+		Hash:         hash,
+		Height:       height,
+		Transactions: transactions,
+	}
+}
+
+// SKAEmissionFinalNtfn defines the skaemissionfinal JSON-RPC notification.
+type SKAEmissionFinalNtfn struct {
+	CoinType      uint8  `json:"cointype"`
+	Nonce         uint64 `json:"nonce"`
+	TxHash        string `json:"txhash"`
+	BlockHash     string `json:"blockhash"`
+	Height        int64  `json:"height"`
+	Confirmations int64  `json:"confirmations"`
+}
+
+// NewSKAEmissionFinalNtfn returns a new instance which can be used to issue a
+// skaemissionfinal JSON-RPC notification.
+func NewSKAEmissionFinalNtfn(coinType uint8, nonce uint64, txHash, blockHash string, height, confirmations int64) *SKAEmissionFinalNtfn {
+	return &SKAEmissionFinalNtfn{
+		CoinType:      coinType,
+		Nonce:         nonce,
+		TxHash:        txHash,
+		BlockHash:     blockHash,
+		Height:        height,
+		Confirmations: confirmations,
+	}
+}
+
 func init() {
 	// The commands in this file are only usable by websockets and are
 	// notifications.
@@ -233,11 +322,14 @@ func init() {
 	dcrjson.MustRegister(BlockDisconnectedNtfnMethod, (*BlockDisconnectedNtfn)(nil), flags)
 	dcrjson.MustRegister(WorkNtfnMethod, (*WorkNtfn)(nil), flags)
 	dcrjson.MustRegister(TSpendNtfnMethod, (*TSpendNtfn)(nil), flags)
+	dcrjson.MustRegister(DoubleSpendNtfnMethod, (*DoubleSpendNtfn)(nil), flags)
 	dcrjson.MustRegister(NewTicketsNtfnMethod, (*NewTicketsNtfn)(nil), flags)
 	dcrjson.MustRegister(ReorganizationNtfnMethod, (*ReorganizationNtfn)(nil), flags)
+	dcrjson.MustRegister(SKAEmissionFinalNtfnMethod, (*SKAEmissionFinalNtfn)(nil), flags)
 	dcrjson.MustRegister(TxAcceptedNtfnMethod, (*TxAcceptedNtfn)(nil), flags)
 	dcrjson.MustRegister(TxAcceptedVerboseNtfnMethod, (*TxAcceptedVerboseNtfn)(nil), flags)
 	dcrjson.MustRegister(RelevantTxAcceptedNtfnMethod, (*RelevantTxAcceptedNtfn)(nil), flags)
 	dcrjson.MustRegister(WinningTicketsNtfnMethod, (*WinningTicketsNtfn)(nil), flags)
 	dcrjson.MustRegister(MixMessageNtfnMethod, (*MixMessageNtfn)(nil), flags)
+	dcrjson.MustRegister(RescanCoinTypeBlockNtfnMethod, (*RescanCoinTypeBlockNtfn)(nil), flags)
 }
@@ -56,6 +56,22 @@ func TestChainSvrWsNtfns(t *testing.T) {
 				Header: "header",
 			},
 		},
+		{
+			name: "doublespend",
+			newNtfn: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("doublespend"), 1, "123",
+					[]string{"456"})
+			},
+			staticNtfn: func() interface{} {
+				return NewDoubleSpendNtfn(1, "123", []string{"456"})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"doublespend","params":[1,"123",["456"]],"id":null}`,
+			unmarshalled: &DoubleSpendNtfn{
+				CoinType:  1,
+				TxHash:    "123",
+				Conflicts: []string{"456"},
+			},
+		},
 		{
 			name: "newtickets",
 			newNtfn: func() (interface{}, error) {
@@ -72,6 +88,70 @@ func TestChainSvrWsNtfns(t *testing.T) {
 				Tickets:   []string{"a", "b"},
 			},
 		},
+		{
+			name: "reorganization",
+			newNtfn: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("reorganization"), "old", 100, "new", 101)
+			},
+			staticNtfn: func() interface{} {
+				return NewReorganizationNtfn("old", 100, "new", 101)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"reorganization","params":["old",100,"new",101],"id":null}`,
+			unmarshalled: &ReorganizationNtfn{
+				OldHash:   "old",
+				OldHeight: 100,
+				NewHash:   "new",
+				NewHeight: 101,
+			},
+		},
+		{
+			name: "reorganization with ska emissions",
+			newNtfn: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("reorganization"), "old", 100, "new", 101,
+					[]ReorganizationSKAEmission{{CoinType: 1, Nonce: 1, Height: 99, TxHash: "123"}})
+			},
+			staticNtfn: func() interface{} {
+				ntfn := NewReorganizationNtfn("old", 100, "new", 101)
+				ntfn.SKAEmissions = &[]ReorganizationSKAEmission{{
+					CoinType: 1,
+					Nonce:    1,
+					Height:   99,
+					TxHash:   "123",
+				}}
+				return ntfn
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"reorganization","params":["old",100,"new",101,[{"cointype":1,"nonce":1,"height":99,"txhash":"123"}]],"id":null}`,
+			unmarshalled: &ReorganizationNtfn{
+				OldHash:   "old",
+				OldHeight: 100,
+				NewHash:   "new",
+				NewHeight: 101,
+				SKAEmissions: &[]ReorganizationSKAEmission{{
+					CoinType: 1,
+					Nonce:    1,
+					Height:   99,
+					TxHash:   "123",
+				}},
+			},
+		},
+		{
+			name: "skaemissionfinal",
+			newNtfn: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("skaemissionfinal"), 1, 2, "123", "456", 100, 6)
+			},
+			staticNtfn: func() interface{} {
+				return NewSKAEmissionFinalNtfn(1, 2, "123", "456", 100, 6)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"skaemissionfinal","params":[1,2,"123","456",100,6],"id":null}`,
+			unmarshalled: &SKAEmissionFinalNtfn{
+				CoinType:      1,
+				Nonce:         2,
+				TxHash:        "123",
+				BlockHash:     "456",
+				Height:        100,
+				Confirmations: 6,
+			},
+		},
 		{
 			name: "relevanttxaccepted",
 			newNtfn: func() (interface{}, error) {
@@ -116,7 +196,7 @@ func TestChainSvrWsNtfns(t *testing.T) {
 				}
 				return NewTxAcceptedVerboseNtfn(txResult)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"txacceptedverbose","params":[{"hex":"001122","txid":"123","version":1,"locktime":4294967295,"expiry":0,"vin":null,"vout":null}],"id":null}`,
+			marshalled: `{"jsonrpc":"1.0","method":"txacceptedverbose","params":[{"hex":"001122","txid":"123","version":1,"locktime":4294967295,"expiry":0,"primarycointype":0,"minrelayfee":0,"vin":null,"vout":null}],"id":null}`,
 			unmarshalled: &TxAcceptedVerboseNtfn{
 				RawTx: TxRawResult{
 					Hex:           "001122",
@@ -129,6 +209,22 @@ func TestChainSvrWsNtfns(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "rescancointypeblock",
+			newNtfn: func() (interface{}, error) {
+				return dcrjson.NewCmd(Method("rescancointypeblock"), 1, "123", 100, []string{"001122"})
+			},
+			staticNtfn: func() interface{} {
+				return NewRescanCoinTypeBlockNtfn(1, "123", 100, []string{"001122"})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"rescancointypeblock","params":[1,"123",100,["001122"]],"id":null}`,
+			unmarshalled: &RescanCoinTypeBlockNtfn{
+				CoinType:     1,
+				Hash:         "123",
+				Height:       100,
+				Transactions: []string{"001122"},
+			},
+		},
 		{
 			name: "winningtickets",
 			newNtfn: func() (interface{}, error) {
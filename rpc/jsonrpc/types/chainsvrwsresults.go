@@ -21,3 +21,14 @@ type RescannedBlock struct {
 	Hash         string   `json:"hash"`
 	Transactions []string `json:"transactions"`
 }
+
+// RescanCoinTypeResult models the result object returned by the rescancointype
+// RPC once every block in the requested range has been scanned.
+type RescanCoinTypeResult struct {
+	CoinType         uint8  `json:"cointype"`
+	StartHeight      int64  `json:"startheight"`
+	EndHeight        int64  `json:"endheight"`
+	EndHash          string `json:"endhash"`
+	DiscoveredBlocks int64  `json:"discoveredblocks"`
+	DiscoveredTxns   int64  `json:"discoveredtxns"`
+}
@@ -110,6 +110,15 @@ func (p *rpcPeer) BanScore() uint32 {
 	return (*serverPeer)(p).banScore.Int()
 }
 
+// TxRelayStats returns the number of transactions and bytes relayed to and
+// from the peer broken down by coin type.
+//
+// This function is safe for concurrent access and is part of the rpcserver.Peer
+// interface implementation.
+func (p *rpcPeer) TxRelayStats() map[cointype.CoinType]rpcserver.PeerTxRelayStats {
+	return (*serverPeer)(p).txRelayStatsSnapshot()
+}
+
 // rpcConnManager provides a connection manager for use with the RPC server and
 // implements the rpcserver.ConnManager interface.
 type rpcConnManager struct {
@@ -362,6 +371,24 @@ func (cm *rpcConnManager) AddRebroadcastInventory(iv *wire.InvVect, data interfa
 	cm.server.AddRebroadcastInventory(iv, data)
 }
 
+// RebroadcastTxs returns the transactions that are periodically rebroadcast
+// until they show up in a block ordered by the time they were added.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserver.ConnManager interface implementation.
+func (cm *rpcConnManager) RebroadcastTxs() []rpcserver.RebroadcastTx {
+	return cm.server.RebroadcastTxs()
+}
+
+// AbandonRebroadcastTx stops rebroadcasting the transaction with the provided
+// hash and returns whether or not it was being rebroadcast.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserver.ConnManager interface implementation.
+func (cm *rpcConnManager) AbandonRebroadcastTx(hash *chainhash.Hash) bool {
+	return cm.server.AbandonRebroadcastTx(hash)
+}
+
 // RelayTransactions generates and relays inventory vectors for all of the
 // passed transactions to all connected peers.
 //
@@ -438,6 +465,12 @@ func (b *rpcSyncMgr) ProcessTransaction(tx *dcrutil.Tx, allowOrphans bool,
 		allowHighFees, tag)
 }
 
+// ProcessPackage relays the provided package of related transactions for
+// validation and insertion into the memory pool together.
+func (b *rpcSyncMgr) ProcessPackage(pkg []*dcrutil.Tx, allowHighFees bool) ([]*dcrutil.Tx, error) {
+	return b.server.txMemPool.ProcessPackage(pkg, allowHighFees)
+}
+
 // RecentlyConfirmedTxn returns with high degree of confidence whether a
 // transaction has been recently confirmed in a block.
 //
@@ -452,6 +485,15 @@ func (b *rpcSyncMgr) SubmitMixMessage(msg mixing.Message) error {
 	return err
 }
 
+// TxLaneStats returns the metrics of the stake and regular transaction
+// validation lanes of the net sync manager.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserver.SyncManager interface implementation.
+func (b *rpcSyncMgr) TxLaneStats() (netsync.TxLaneStats, netsync.TxLaneStats) {
+	return b.syncMgr.TxLaneStats()
+}
+
 // rpcUtxoEntry represents a utxo entry for use with the RPC server and
 // implements the rpcserver.UtxoEntry interface.
 type rpcUtxoEntry struct {
@@ -667,3 +709,22 @@ func (r *rpcCoinTypeFeeCalculator) GetFeeStats(coinType cointype.CoinType) (*rpc
 func (r *rpcCoinTypeFeeCalculator) EstimateFeeRate(coinType cointype.CoinType, targetConfirmations int) (dcrutil.Amount, error) {
 	return r.calc.EstimateFeeRate(coinType, targetConfirmations)
 }
+
+// EstimateAccuracy returns the accuracy of the fee rate estimates of every coin
+// type with mined transactions ordered by coin type.
+func (r *rpcCoinTypeFeeCalculator) EstimateAccuracy() []rpcserver.FeeEstimateAccuracy {
+	accuracy := r.calc.EstimateAccuracy()
+	result := make([]rpcserver.FeeEstimateAccuracy, 0, len(accuracy))
+	for _, a := range accuracy {
+		result = append(result, rpcserver.FeeEstimateAccuracy{
+			CoinType:            a.CoinType,
+			Samples:             a.Samples,
+			OnTime:              a.OnTime,
+			Late:                a.Late,
+			TotalPredictedConfs: a.TotalPredictedConfs,
+			TotalActualConfs:    a.TotalActualConfs,
+			TotalAbsError:       a.TotalAbsError,
+		})
+	}
+	return result
+}
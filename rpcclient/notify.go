@@ -764,7 +764,7 @@ func (c *Client) NotifyWorkAsync(ctx context.Context) *FutureNotifyWorkResult {
 		return (*FutureNotifyWorkResult)(newNilFutureResult(ctx))
 	}
 
-	cmd := chainjson.NewNotifyWorkCmd()
+	cmd := chainjson.NewNotifyWorkCmd(nil, nil)
 	return (*FutureNotifyWorkResult)(c.sendCmd(ctx, cmd))
 }
 
@@ -385,7 +385,7 @@ func (c *Client) SendRawTransactionAsync(ctx context.Context, tx *wire.MsgTx, al
 		txHex = hex.EncodeToString(buf.Bytes())
 	}
 
-	cmd := chainjson.NewSendRawTransactionCmd(txHex, &allowHighFees)
+	cmd := chainjson.NewSendRawTransactionCmd(txHex, &allowHighFees, nil)
 	return (*FutureSendRawTransactionResult)(c.sendCmd(ctx, cmd))
 }
 
@@ -0,0 +1,70 @@
+#!/usr/bin/env bash
+
+set -e
+
+# This script runs the multi-coin block validation, block template generation,
+# and block space allocation benchmarks and writes the results to a file.  When
+# a file with results from a previous run is provided, it also compares the
+# results against it and fails when any benchmark regressed by more than the
+# allowed threshold.
+#
+# Usage: ./run_benchmarks.sh [baseline results file]
+#
+# Environment variables:
+#   BENCH_COUNT      number of times to run each benchmark (default: 5)
+#   BENCH_OUT        file to write the results to (default: bench-<commit>.txt)
+#   BENCH_THRESHOLD  allowed slowdown in percent (default: 10)
+
+BASELINE=$1
+COUNT=${BENCH_COUNT:-5}
+THRESHOLD=${BENCH_THRESHOLD:-10}
+OUT=${BENCH_OUT:-bench-$(git rev-parse --short HEAD).txt}
+PKGS="./internal/blockalloc ./internal/blockchain ./internal/mining"
+BENCHES='^(BenchmarkAllocateBlockSpace|BenchmarkTransactionSizeTracker|BenchmarkConnectMultiCoinBlock|BenchmarkNewBlockTemplate)$'
+
+go version
+
+echo "==> benchmark ${PKGS}"
+go test -run='^$' -bench="${BENCHES}" -benchmem -count="${COUNT}" ${PKGS} | tee "${OUT}"
+echo "Results written to ${OUT}"
+
+if [ -z "${BASELINE}" ]; then
+  exit 0
+fi
+
+# Show a detailed comparison when benchstat is available.
+if command -v benchstat >/dev/null 2>&1; then
+  benchstat "${BASELINE}" "${OUT}"
+fi
+
+# Compare the mean time per operation of every benchmark in both runs and
+# report those that slowed down by more than the threshold.
+echo "==> compare against ${BASELINE} (threshold ${THRESHOLD}%)"
+awk -v threshold="${THRESHOLD}" '
+  /^Benchmark/ {
+    for (i = 3; i < NF; i++) {
+      if ($(i+1) == "ns/op") {
+        if (FILENAME == ARGV[1]) { base[$1] += $i; baseN[$1]++ }
+        else { cur[$1] += $i; curN[$1]++ }
+      }
+    }
+  }
+  END {
+    regressions = 0
+    for (name in cur) {
+      if (!(name in base)) continue
+      b = base[name] / baseN[name]
+      c = cur[name] / curN[name]
+      delta = (c - b) / b * 100
+      if (delta > threshold) {
+        printf "REGRESSION %s: %.0f ns/op -> %.0f ns/op (%+.1f%%)\n", name, b, c, delta
+        regressions++
+      }
+    }
+    if (regressions > 0) {
+      printf "%d benchmark(s) regressed by more than %s%%\n", regressions, threshold
+      exit 1
+    }
+    print "No regressions detected"
+  }
+' "${BASELINE}" "${OUT}"
@@ -6,10 +6,12 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"crypto/elliptic"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
@@ -30,26 +32,32 @@ import (
 	"github.com/monetarium/monetarium-node/certgen"
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/connmgr"
 	"github.com/monetarium/monetarium-node/container/apbf"
 	"github.com/monetarium/monetarium-node/container/lru"
-	"github.com/monetarium/monetarium-node/crypto/rand"
 	"github.com/monetarium/monetarium-node/database"
 	"github.com/monetarium/monetarium-node/dcrutil"
+	"github.com/monetarium/monetarium-node/internal/blockalloc"
 	"github.com/monetarium/monetarium-node/internal/blockchain"
 	"github.com/monetarium/monetarium-node/internal/blockchain/indexers"
+	"github.com/monetarium/monetarium-node/internal/emission"
+	"github.com/monetarium/monetarium-node/internal/eventsink"
 	"github.com/monetarium/monetarium-node/internal/fees"
 	"github.com/monetarium/monetarium-node/internal/mempool"
 	"github.com/monetarium/monetarium-node/internal/mining"
 	"github.com/monetarium/monetarium-node/internal/mining/cpuminer"
 	"github.com/monetarium/monetarium-node/internal/netsync"
 	"github.com/monetarium/monetarium-node/internal/rpcserver"
+	"github.com/monetarium/monetarium-node/internal/supplyscan"
 	"github.com/monetarium/monetarium-node/internal/version"
 	"github.com/monetarium/monetarium-node/math/uint256"
 	"github.com/monetarium/monetarium-node/mixing"
 	"github.com/monetarium/monetarium-node/mixing/mixpool"
 	"github.com/monetarium/monetarium-node/peer"
 	"github.com/monetarium/monetarium-node/txscript"
+	"github.com/monetarium/monetarium-node/txscript/stdaddr"
+	"github.com/monetarium/monetarium-node/txscript/stdscript"
 	"github.com/monetarium/monetarium-node/wire"
 	"github.com/syndtr/goleveldb/leveldb"
 )
@@ -71,6 +79,19 @@ const (
 	// that can be voted on.
 	defaultMaximumVoteAge = 1440
 
+	// mempoolFileName is the name of the file in the data directory the
+	// mempool is saved to on shutdown and restored from on startup.
+	mempoolFileName = "mempool.dat"
+
+	// peerPolicyFileName is the name of the file in the data directory the
+	// bans and per coin type misbehavior scores of remote hosts are saved to
+	// on shutdown and restored from on startup.
+	peerPolicyFileName = "peerpolicy.json"
+
+	// utilizationFileName is the name of the file in the data directory the
+	// block space utilization history is kept in.
+	utilizationFileName = "utilization.dat"
+
 	// connectionRetryInterval is the base amount of time to wait in between
 	// retries when connecting to persistent peers.  It is adjusted by the
 	// number of retries such that there is a retry backoff.
@@ -164,6 +185,15 @@ const (
 	// These values result in about 640 KiB memory usage including overhead.
 	maxRecentlyAdvertisedTxns = 4500
 	recentlyAdvertisedTxnsTTL = 45 * time.Second
+
+	// defaultWantSKARelayOutbound is the minimum desired number of outbound
+	// peers that relay the transactions of every SKA coin type.
+	defaultWantSKARelayOutbound = 3
+
+	// maxSKARelayAddrs is the maximum number of addresses of outbound peers
+	// that relayed the transactions of every SKA coin type to remember in
+	// order to prefer them when selecting new outbound peers.
+	maxSKARelayAddrs = 256
 )
 
 var (
@@ -218,6 +248,20 @@ type broadcastInventoryDel *wire.InvVect
 // inventory entries need to be filtered and removed where necessary.
 type broadcastPruneInventory struct{}
 
+// broadcastListInventory is a type used to request the transactions in the
+// rebroadcast map be sent to the reply channel.
+type broadcastListInventory struct {
+	reply chan []rpcserver.RebroadcastTx
+}
+
+// broadcastAbandonInventory is a type used to declare that the transaction it
+// contains needs to be removed from the rebroadcast map.  Whether or not the
+// transaction was present is sent to the reply channel.
+type broadcastAbandonInventory struct {
+	invVect *wire.InvVect
+	reply   chan bool
+}
+
 // relayMsg packages an inventory vector along with the newly discovered
 // inventory and a flag that determines if the relay should happen immediately
 // (it will be put into a trickle queue if false) so the relay has access to
@@ -420,6 +464,22 @@ func (ps *peerState) ForAllPeers(closure func(sp *serverPeer)) {
 	ps.Unlock()
 }
 
+// LookupPeer returns the peer known to peerState with the provided ID or nil
+// when there is no such peer.
+//
+// This function is safe for concurrent access.
+func (ps *peerState) LookupPeer(id int32) *serverPeer {
+	ps.Lock()
+	defer ps.Unlock()
+	if sp, ok := ps.inboundPeers[id]; ok {
+		return sp
+	}
+	if sp, ok := ps.outboundPeers[id]; ok {
+		return sp
+	}
+	return ps.persistentPeers[id]
+}
+
 // connectionsWithIP returns the number of connections with the given IP.
 //
 // This function MUST be called with the embedded mutex locked (for reads).
@@ -586,6 +646,18 @@ type server struct {
 	// bump, so a one-time conversion is a good tradeoff in the mean time.
 	minKnownWork uint256.Uint256
 
+	// skaConfigHash is the canonical hash of the SKA configuration of the
+	// active network.  It is advertised to peers in the version handshake so
+	// divergent SKA configurations are detected before the chains fork.
+	skaConfigHash chainhash.Hash
+
+	// skaRelayAddrs houses the addresses of outbound peers that recently
+	// advertised the local SKA configuration hash without disabling
+	// transaction relay keyed by their address key.  They are preferred when
+	// selecting new outbound peers while the server has fewer outbound peers
+	// that relay the transactions of every SKA coin type than desired.
+	skaRelayAddrs *lru.Map[string, *addrmgr.NetAddress]
+
 	chainParams          *chaincfg.Params
 	addrManager          *addrmgr.AddrManager
 	connManager          *connmgr.ConnManager
@@ -596,9 +668,21 @@ type server struct {
 	bg                   *mining.BgBlkTmplGenerator
 	chain                *blockchain.BlockChain
 	txMemPool            *mempool.TxPool
+	mempoolFile          string // Empty when the mempool is not persisted
+	coinTypePolicy       *coinTypePeerPolicy
+	peerPolicyFile       string // Empty when the peer policy is not persisted
 	feeEstimator         *fees.Estimator
-	feeCalculator        *fees.CoinTypeFeeCalculator // Shared fee calculator for mining and RPC
+	feeCalculator        *fees.CoinTypeFeeCalculator   // Shared fee calculator for mining and RPC
+	utilizationHistory   *fees.UtilizationHistory      // Nil when disabled
+	allocDivergence      *blockalloc.DivergenceMonitor // Nil when disabled
 	cpuMiner             *cpuminer.CPUMiner
+	emissionCoordinator  *emission.Coordinator
+	emissionWatchtower   *emission.Watchtower
+	emissionCheckpoints  *emission.CheckpointVerifier
+	supplyScanner        *supplyscan.Scanner
+	eventSink            *eventsink.Sink
+	allocationAlerts     *eventsink.EdgeTrigger
+	feeSpikes            *eventsink.EdgeTrigger
 	mixMsgPool           *mixpool.Pool
 	modifyRebroadcastInv chan interface{}
 	peerState            peerState
@@ -618,6 +702,8 @@ type server struct {
 	txIndex         *indexers.TxIndex
 	existsAddrIndex *indexers.ExistsAddrIndex
 	ssfeeIndex      *indexers.SSFeeIndex
+	emissionIndex   *indexers.EmissionIndex
+	watchOnlyIndex  *indexers.WatchOnlyIndex
 
 	// These following fields are used to filter duplicate block lottery data
 	// anouncements.
@@ -693,6 +779,11 @@ type serverPeer struct {
 	knownAddresses *apbf.Filter
 	banScore       connmgr.DynamicBanScore
 
+	// skaRelay indicates the peer advertised the local SKA configuration
+	// hash and did not disable transaction relay, so it validates and relays
+	// the transactions of every SKA coin type, including emissions.
+	skaRelay atomic.Bool
+
 	// addrsSent, getMiningStateSent and initState track whether or not the peer
 	// has already sent the respective request.  They are used to prevent more
 	// than one response of each respective request per connection.
@@ -730,6 +821,14 @@ type serverPeer struct {
 	// data item requests that still need to be served.
 	getDataQueue              chan []*wire.InvVect
 	numPendingGetDataItemReqs atomic.Uint32
+
+	// txRelayStats tracks the number of transactions and bytes relayed to and
+	// from the peer broken down by the primary coin type of the transactions.
+	//
+	// It is protected by txRelayStatsMtx since it is updated from both the
+	// peer input handler and the goroutine that serves getdata requests.
+	txRelayStatsMtx sync.Mutex
+	txRelayStats    map[cointype.CoinType]rpcserver.PeerTxRelayStats
 }
 
 // newServerPeer returns a new serverPeer instance. The peer needs to be set by
@@ -789,6 +888,7 @@ func (sp *serverPeer) handleServeGetData(invVects []*wire.InvVect,
 				}
 			}
 			dataMsg = tx.MsgTx()
+			sp.recordTxRelay(tx.MsgTx(), true)
 
 		case wire.InvTypeBlock:
 			blockHash := &iv.Hash
@@ -958,6 +1058,45 @@ func (sp *serverPeer) Run() {
 	wg.Wait()
 }
 
+// recordTxRelay updates the per coin type relay statistics for the peer with
+// the provided transaction that was either sent to or received from it.
+//
+// This function is safe for concurrent access.
+func (sp *serverPeer) recordTxRelay(msgTx *wire.MsgTx, sent bool) {
+	coinType := wire.GetPrimaryCoinType(msgTx)
+	size := uint64(msgTx.SerializeSize())
+
+	sp.txRelayStatsMtx.Lock()
+	if sp.txRelayStats == nil {
+		sp.txRelayStats = make(map[cointype.CoinType]rpcserver.PeerTxRelayStats)
+	}
+	stats := sp.txRelayStats[coinType]
+	if sent {
+		stats.TxSent++
+		stats.TxBytesSent += size
+	} else {
+		stats.TxRecv++
+		stats.TxBytesRecv += size
+	}
+	sp.txRelayStats[coinType] = stats
+	sp.txRelayStatsMtx.Unlock()
+}
+
+// txRelayStatsSnapshot returns a copy of the per coin type relay statistics
+// for the peer.
+//
+// This function is safe for concurrent access.
+func (sp *serverPeer) txRelayStatsSnapshot() map[cointype.CoinType]rpcserver.PeerTxRelayStats {
+	sp.txRelayStatsMtx.Lock()
+	snapshot := make(map[cointype.CoinType]rpcserver.PeerTxRelayStats,
+		len(sp.txRelayStats))
+	for coinType, stats := range sp.txRelayStats {
+		snapshot[coinType] = stats
+	}
+	sp.txRelayStatsMtx.Unlock()
+	return snapshot
+}
+
 // newestBlock returns the current best block hash and height using the format
 // required by the configuration for the peer package.
 func (sp *serverPeer) newestBlock() (*chainhash.Hash, int64, error) {
@@ -1071,6 +1210,38 @@ func (sp *serverPeer) addBanScore(persistent, transient uint32, reason string) b
 	return false
 }
 
+// addCoinTypeMisbehavior increases the misbehavior score of the host of the peer
+// for the provided SKA coin type and logs a warning including the reason
+// provided once transactions of the coin type are no longer accepted from the
+// host as a result.  Unlike the ban score, the coin type misbehavior scores are
+// tracked by host and persisted across restarts.
+func (sp *serverPeer) addCoinTypeMisbehavior(coinType cointype.CoinType, reason string) {
+	// No score is calculated if banning is disabled.
+	if cfg.DisableBanning || !coinType.IsSKA() {
+		return
+	}
+	if sp.isWhitelisted {
+		srvrLog.Debugf("Misbehaving whitelisted peer %s: %s", sp, reason)
+		return
+	}
+
+	host, _, err := net.SplitHostPort(sp.Addr())
+	if err != nil {
+		srvrLog.Debugf("can't split hostport %v", err)
+		return
+	}
+	score, muted := sp.server.coinTypePolicy.Misbehaved(host, coinType,
+		time.Now())
+	if muted {
+		srvrLog.Warnf("Misbehaving peer %s: %s -- %v misbehavior score "+
+			"increased to %.0f, no longer accepting %v transactions", sp,
+			reason, coinType, score, coinType)
+		return
+	}
+	srvrLog.Debugf("Misbehaving peer %s: %s -- %v misbehavior score "+
+		"increased to %.0f", sp, reason, coinType, score)
+}
+
 // hasServices returns whether or not the provided advertised service flags have
 // all of the provided desired service flags set.
 func hasServices(advertised, desired wire.ServiceFlag) bool {
@@ -1089,6 +1260,65 @@ func natfSupported(pver uint32) addrmgr.NetAddressTypeFilter {
 	return isSupportedNetAddrTypeV1
 }
 
+// skaConfigHashMismatch returns whether the provided version message
+// advertises an SKA configuration hash that differs from the provided local
+// one.  Peers with protocol versions prior to the one that added the hash to
+// the version message and peers that do not advertise a hash are never
+// considered mismatched for compatibility.
+func skaConfigHashMismatch(msg *wire.MsgVersion, localHash *chainhash.Hash) bool {
+	if msg.ProtocolVersion < int32(wire.SKAConfigHashVersion) {
+		return false
+	}
+	return msg.SKAConfigHash != (chainhash.Hash{}) &&
+		msg.SKAConfigHash != *localHash
+}
+
+// wantSKARelayOutbound returns the minimum desired number of outbound peers
+// that relay the transactions of every SKA coin type for the provided target
+// number of outbound peers.
+func wantSKARelayOutbound(targetOutbound uint32) uint32 {
+	if targetOutbound < defaultWantSKARelayOutbound {
+		return targetOutbound
+	}
+	return defaultWantSKARelayOutbound
+}
+
+// needsMoreSKARelayOutbound returns whether an additional outbound peer that
+// does not relay the transactions of every SKA coin type must be rejected in
+// order to leave room for the minimum desired number of outbound peers that do
+// given the provided number of existing outbound peers, how many of them relay
+// the transactions of every SKA coin type, and the target number of outbound
+// peers.
+func needsMoreSKARelayOutbound(numOutbound, numSKARelayOutbound, targetOutbound uint32) bool {
+	want := wantSKARelayOutbound(targetOutbound)
+	if numSKARelayOutbound >= want {
+		return false
+	}
+	return numOutbound+want >= targetOutbound
+}
+
+// nextSKARelayAddr removes and returns the most recently remembered address in
+// the provided addresses of peers that relayed the transactions of every SKA
+// coin type that is not in a network group the provided function reports an
+// existing outbound peer for.  Addresses are removed when they are returned so
+// an address that can no longer be reached is only preferred once until the
+// peer at it relays the transactions of every SKA coin type again.  It returns
+// nil when there is no such address.
+func nextSKARelayAddr(addrs *lru.Map[string, *addrmgr.NetAddress],
+	outboundGroupCount func(key string) int) *addrmgr.NetAddress {
+
+	candidates := addrs.Values()
+	for i := len(candidates) - 1; i >= 0; i-- {
+		addr := candidates[i]
+		if outboundGroupCount(addr.GroupKey()) != 0 {
+			continue
+		}
+		addrs.Delete(addr.Key())
+		return addr
+	}
+	return nil
+}
+
 // OnVersion is invoked when a peer receives a version wire message and is used
 // to negotiate the protocol version details as well as kick start the
 // communications.
@@ -1123,6 +1353,53 @@ func (sp *serverPeer) OnVersion(_ *peer.Peer, msg *wire.MsgVersion) {
 		return
 	}
 
+	// Reject peers that advertise an SKA configuration that differs from the
+	// local one since they will inevitably fork away.  Peers that do not
+	// advertise a hash are allowed for compatibility.
+	if skaConfigHashMismatch(msg, &sp.server.skaConfigHash) {
+		srvrLog.Warnf("Rejecting peer %s with SKA configuration hash %v "+
+			"that differs from the local SKA configuration hash %v", sp,
+			msg.SKAConfigHash, sp.server.skaConfigHash)
+		sp.Disconnect()
+		return
+	}
+
+	// Note whether the peer validates and relays the transactions of every SKA
+	// coin type.  Peers that do not advertise an SKA configuration hash might
+	// not enforce the SKA rules or relay SKA transactions, such as emissions.
+	isSKARelay := msg.SKAConfigHash == sp.server.skaConfigHash &&
+		!msg.DisableRelayTx
+	sp.skaRelay.Store(isSKARelay)
+
+	// Remember the addresses of outbound peers that relay the transactions of
+	// every SKA coin type so they are preferred when selecting new outbound
+	// peers and forget those that no longer do.
+	if !isInbound {
+		if isSKARelay {
+			sp.server.skaRelayAddrs.Put(remoteAddr.Key(), remoteAddr)
+		} else {
+			sp.server.skaRelayAddrs.Delete(remoteAddr.Key())
+		}
+	}
+
+	// Maintain a minimum desired number of outbound peers that relay the
+	// transactions of every SKA coin type so an eclipse of such peers can't
+	// silently block the propagation of SKA transactions, notably emissions.
+	if !isInbound && !isSKARelay && len(sp.server.chainParams.SKACoins) > 0 {
+		numOutbound, numSKARelayOutbound := sp.server.skaRelayOutboundCounts()
+		targetOutbound := sp.server.targetOutbound
+		if needsMoreSKARelayOutbound(numOutbound, numSKARelayOutbound,
+			targetOutbound) {
+
+			srvrLog.Debugf("Rejecting outbound peer %s that does not relay "+
+				"the transactions of every SKA coin type in favor of a peer "+
+				"that does (have: %d, target: %d)", sp, numSKARelayOutbound,
+				wantSKARelayOutbound(targetOutbound))
+			sp.Disconnect()
+			return
+		}
+	}
+
 	// Maintain a minimum desired number of outbound peers capable of supporting
 	// p2p mixing.
 	if !isInbound && msg.ProtocolVersion < int32(wire.MixVersion) {
@@ -1240,7 +1517,8 @@ func (sp *serverPeer) OnMemPool(_ *peer.Peer, msg *wire.MsgMemPool) {
 	// Send the inventory message if there is anything to send.
 	for _, txDesc := range txDescs {
 		iv := wire.NewInvVect(wire.InvTypeTx, txDesc.Tx.Hash())
-		sp.QueueInventory(iv)
+		sp.QueueInventoryForCoinType(iv,
+			blockalloc.GetTransactionCoinType(txDesc.Tx))
 	}
 }
 
@@ -1467,6 +1745,21 @@ func (sp *serverPeer) OnTx(_ *peer.Peer, msg *wire.MsgTx) {
 	tx := dcrutil.NewTx(msg)
 	iv := wire.NewInvVect(wire.InvTypeTx, tx.Hash())
 	sp.AddKnownInventory(iv)
+	sp.recordTxRelay(msg, false)
+
+	// Ignore transactions of coin types that are no longer accepted from the
+	// host due to previously sending invalid transactions of them.
+	coinType := wire.GetPrimaryCoinType(msg)
+	if coinType.IsSKA() && !sp.isWhitelisted {
+		host, _, err := net.SplitHostPort(sp.Addr())
+		if err == nil && sp.server.coinTypePolicy.IsMuted(host, coinType,
+			time.Now()) {
+
+			peerLog.Tracef("Ignoring %v tx %v from muted peer %v", coinType,
+				msg.TxHash(), sp)
+			return
+		}
+	}
 
 	// Queue the transaction up to be handled by the net sync manager and
 	// intentionally block further receives until the transaction is fully
@@ -1930,6 +2223,12 @@ func (sp *serverPeer) OnNotFound(_ *peer.Peer, msg *wire.MsgNotFound) {
 		case wire.InvTypeBlock:
 			numBlocks++
 		case wire.InvTypeTx:
+			// Votes and treasury spends requested during mining state
+			// synchronization are exempt since peers routinely prune them
+			// once they no longer apply to the current tip.
+			if sp.syncMgrPeer.IsRequestedStakeTx(&inv.Hash) {
+				continue
+			}
 			numTxns++
 		case wire.InvTypeMix:
 			numMixMsgs++
@@ -2020,6 +2319,35 @@ func (s *server) PruneRebroadcastInventory() {
 	}
 }
 
+// RebroadcastTxs returns the transactions that are periodically rebroadcast
+// until they show up in a block ordered by the time they were added.
+func (s *server) RebroadcastTxs() []rpcserver.RebroadcastTx {
+	reply := make(chan []rpcserver.RebroadcastTx, 1)
+	select {
+	case <-s.quit:
+		return nil
+	case s.modifyRebroadcastInv <- broadcastListInventory{reply: reply}:
+	}
+	return <-reply
+}
+
+// AbandonRebroadcastTx stops rebroadcasting the transaction with the provided
+// hash and returns whether or not it was being rebroadcast.  The transaction
+// is not removed from the mempool.
+func (s *server) AbandonRebroadcastTx(hash *chainhash.Hash) bool {
+	reply := make(chan bool, 1)
+	msg := broadcastAbandonInventory{
+		invVect: wire.NewInvVect(wire.InvTypeTx, hash),
+		reply:   reply,
+	}
+	select {
+	case <-s.quit:
+		return false
+	case s.modifyRebroadcastInv <- msg:
+	}
+	return <-reply
+}
+
 // relayTransactions generates and relays inventory vectors for all of the
 // passed transactions to all connected peers.
 func (s *server) relayTransactions(txns []*dcrutil.Tx) {
@@ -2039,6 +2367,23 @@ func (s *server) relayMixMessages(msgs []mixing.Message) {
 	}
 }
 
+// RejectedTransaction increases the misbehavior score of the host of the passed
+// peer for the coin type of the passed transaction when it was rejected by the
+// transaction pool for being invalid.
+func (s *server) RejectedTransaction(peer *netsync.Peer, tx *dcrutil.Tx, err error) {
+	var cErr blockchain.RuleError
+	if !errors.As(err, &cErr) && !errors.Is(err, mempool.ErrInvalid) {
+		return
+	}
+	sp := s.peerState.LookupPeer(peer.ID())
+	if sp == nil {
+		return
+	}
+	coinType := wire.GetPrimaryCoinType(tx.MsgTx())
+	reason := fmt.Sprintf("sent invalid %v transaction %v", coinType, tx.Hash())
+	sp.addCoinTypeMisbehavior(coinType, reason)
+}
+
 // AnnounceNewTransactions generates and relays inventory vectors and notifies
 // websocket clients of the passed transactions.  This function should be
 // called whenever new transactions are added to the mempool.
@@ -2147,6 +2492,7 @@ func (s *server) handleRelayPeerInvMsg(msg relayMsg, sp *serverPeer) {
 		return
 	}
 
+	coinType := cointype.CoinTypeVAR
 	if iv.Type == wire.InvTypeTx {
 		// Don't relay the transaction to the peer when it has transaction
 		// relaying disabled.
@@ -2175,6 +2521,10 @@ func (s *server) handleRelayPeerInvMsg(msg relayMsg, sp *serverPeer) {
 		numEvicted := s.recentlyAdvertisedTxns.Put(iv.Hash, tx)
 		s.totalAdvertisedTxnsEvicted += uint64(numEvicted)
 		s.maybeLogRecentlyAdvertisedNumEvicted()
+
+		// Trickle the transaction with the inventory of its coin type so a
+		// flood of SKA transactions does not delay VAR announcements.
+		coinType = blockalloc.GetTransactionCoinType(tx)
 	}
 
 	if iv.Type == wire.InvTypeMix {
@@ -2199,7 +2549,7 @@ func (s *server) handleRelayPeerInvMsg(msg relayMsg, sp *serverPeer) {
 	if msg.immediate {
 		sp.QueueInventoryImmediate(iv)
 	} else {
-		sp.QueueInventory(iv)
+		sp.QueueInventoryForCoinType(iv, coinType)
 	}
 }
 
@@ -2311,6 +2661,7 @@ func newPeerConfig(sp *serverPeer) *peer.Config {
 		Services:          sp.server.services,
 		DisableRelayTx:    cfg.BlocksOnly,
 		ProtocolVersion:   maxProtocolVersion,
+		SKAConfigHash:     sp.server.skaConfigHash,
 		IdleTimeout:       cfg.PeerIdleTimeout,
 	}
 }
@@ -2708,6 +3059,22 @@ func (s *server) OutboundGroupCount(key string) int {
 	return count
 }
 
+// skaRelayOutboundCounts returns the number of outbound peers and how many of
+// them relay the transactions of every SKA coin type.
+//
+// This function is safe for concurrent access.
+func (s *server) skaRelayOutboundCounts() (numOutbound, numSKARelayOutbound uint32) {
+	s.peerState.Lock()
+	s.peerState.forAllOutboundPeers(func(sp *serverPeer) {
+		if sp.skaRelay.Load() {
+			numSKARelayOutbound++
+		}
+		numOutbound++
+	})
+	s.peerState.Unlock()
+	return numOutbound, numSKARelayOutbound
+}
+
 // AddBytesSent adds the passed number of bytes to the total bytes sent counter
 // for the server.  It is safe for concurrent access.
 func (s *server) AddBytesSent(bytesSent uint64) {
@@ -2910,6 +3277,23 @@ func (s *server) handleBlockchainNotification(notification *blockchain.Notificat
 		// so we can access the original coin type and fee information
 		s.txMemPool.ProcessConfirmedTransactions(block, isTreasuryEnabled)
 
+		if s.utilizationHistory != nil {
+			s.recordBlockUtilization(block)
+		}
+
+		// Compare the block space the block used for each coin type with
+		// the allocation policy given the pending demand of the pool.  This
+		// must be done before removing the transactions in the block from
+		// the pool since they are part of the demand the miner could have
+		// served.  The demand of the pool is only meaningful once synced.
+		if s.allocDivergence != nil && s.syncManager.IsCurrent() {
+			s.checkAllocDivergence(block)
+		}
+
+		// Keep the SKA coin types supported by the fee calculator in line
+		// with the ones that are active in the next block.
+		s.feeCalculator.UpdateActiveCoinTypes(block.Height() + 1)
+
 		// TODO: In the case the new tip disapproves the previous block, any
 		// transactions the previous block contains in its regular tree which
 		// double spend the same inputs as transactions in either tree of the
@@ -2945,6 +3329,11 @@ func (s *server) handleBlockchainNotification(notification *blockchain.Notificat
 					// Unlike coinbase which never exists in mempool, SKA emissions can be
 					// submitted to mempool before being mined.
 					txMemPool.RemoveTransaction(tx, false)
+					// Evict any conflicting emission for the same coin type
+					// rolled back by a reorganization along with the
+					// transactions spending its outputs since they can no
+					// longer be mined.
+					txMemPool.RemoveSKAEmissionConflicts(tx)
 					// Still mark it as confirmed for tracking purposes
 					s.TransactionConfirmed(tx)
 					continue
@@ -3011,6 +3400,22 @@ func (s *server) handleBlockchainNotification(notification *blockchain.Notificat
 			s.bg.BlockConnected(block)
 		}
 
+		if s.emissionCoordinator != nil {
+			s.emissionCoordinator.BlockConnected(block.Height())
+		}
+		if s.emissionWatchtower != nil {
+			s.emissionWatchtower.BlockConnected(block.Height())
+		}
+		if s.emissionCheckpoints != nil {
+			s.emissionCheckpoints.BlockConnected(block.Height())
+		}
+		if s.eventSink != nil {
+			s.publishBlockEvents(block)
+		}
+		if s.rpcServer != nil || s.eventSink != nil {
+			s.notifyFinalSKAEmissions(block)
+		}
+
 		// Notify subscribed indexes of connected block.
 		if s.indexSubscriber != nil {
 			s.indexSubscriber.Notify(&indexers.IndexNtfn{
@@ -3098,6 +3503,15 @@ func (s *server) handleBlockchainNotification(notification *blockchain.Notificat
 		handleDisconnectedBlockTxns := func(txns []*dcrutil.Tx) {
 			txMemPool.MaybeAcceptTransactions(txns)
 		}
+
+		// Add the SKA emissions that were rolled back from the emission state
+		// back to the transaction pool first so they may be mined again while
+		// their emission windows remain open and so any transactions in the
+		// block that spend them are not treated as orphans.
+		if len(ntfn.SKAEmissions) > 0 {
+			s.reinjectSKAEmissions(block, ntfn.SKAEmissions)
+		}
+
 		handleDisconnectedBlockTxns(block.Transactions()[1:])
 
 		if isTreasuryEnabled {
@@ -3111,6 +3525,18 @@ func (s *server) handleBlockchainNotification(notification *blockchain.Notificat
 			s.bg.BlockDisconnected(block)
 		}
 
+		// The height of the disconnected block is the height of the next
+		// block again.
+		s.feeCalculator.UpdateActiveCoinTypes(block.Height())
+
+		if s.utilizationHistory != nil {
+			err := s.utilizationHistory.RemoveBlock(block.Hash())
+			if err != nil {
+				srvrLog.Errorf("Unable to remove block %v from the "+
+					"utilization history: %v", block.Hash(), err)
+			}
+		}
+
 		// Notify subscribed indexes of disconnected block.
 		if s.indexSubscriber != nil {
 			s.indexSubscriber.Notify(&indexers.IndexNtfn{
@@ -3159,6 +3585,13 @@ func (s *server) handleBlockchainNotification(notification *blockchain.Notificat
 			break
 		}
 
+		for _, emission := range rd.DisconnectedSKAEmissions {
+			syncLog.Infof("Reorganization rolled back SKA emission %v for "+
+				"coin type %d (nonce %d) from height %d",
+				chainhash.Hash(emission.TxHash), emission.CoinType,
+				emission.Nonce, emission.Height)
+		}
+
 		// Notify registered websocket clients.
 		if r := s.rpcServer; r != nil {
 			r.NotifyReorganization(rd)
@@ -3166,13 +3599,447 @@ func (s *server) handleBlockchainNotification(notification *blockchain.Notificat
 	}
 }
 
+// publishBlockEvents publishes the events for the passed block newly connected
+// to the main chain to the event sink.  This includes the block itself, any
+// SKA emissions it confirms, and fee spikes of coin types whose dynamic fee
+// multiplier reached the configured threshold.
+func (s *server) publishBlockEvents(block *dcrutil.Block) {
+	header := &block.MsgBlock().Header
+	s.eventSink.Publish(eventsink.EventBlockConnected, &eventsink.BlockConnected{
+		Hash:   block.Hash().String(),
+		Height: block.Height(),
+		Time:   header.Timestamp.Unix(),
+		NumTx:  len(block.MsgBlock().Transactions),
+	})
+
+	for _, tx := range block.Transactions() {
+		msgTx := tx.MsgTx()
+		if !wire.IsSKAEmissionTransaction(msgTx) {
+			continue
+		}
+		var amount int64
+		for _, txOut := range msgTx.TxOut {
+			amount += txOut.Value
+		}
+		s.eventSink.Publish(eventsink.EventSKAEmissionConfirmed,
+			&eventsink.SKAEmissionConfirmed{
+				CoinType: uint8(msgTx.TxOut[0].CoinType),
+				TxHash:   tx.Hash().String(),
+				Amount:   amount,
+				Height:   block.Height(),
+			})
+	}
+
+	threshold := cfg.EventSinkFeeSpike
+	for _, coinType := range s.feeCalculator.GetSupportedCoinTypes() {
+		stats, err := s.feeCalculator.GetFeeStats(coinType)
+		if err != nil {
+			continue
+		}
+		spike := stats.DynamicFeeMultiplier >= threshold
+		if s.feeSpikes.Update(coinType, spike) {
+			s.eventSink.Publish(eventsink.EventFeeSpike, &eventsink.FeeSpike{
+				CoinType:   uint8(coinType),
+				Multiplier: stats.DynamicFeeMultiplier,
+				Threshold:  threshold,
+			})
+		}
+	}
+}
+
+// notifyFinalSKAEmissions notifies websocket clients and the event sink of the
+// SKA emissions that reached the configured number of confirmations to be
+// considered final now that the passed block is connected to the main chain.
+// Those are the emissions in the main chain block at the height that many
+// blocks back from, and including, the passed block.
+func (s *server) notifyFinalSKAEmissions(block *dcrutil.Block) {
+	finalConfs := cfg.EmissionFinalConfs
+	finalHeight := block.Height() - finalConfs + 1
+	if finalHeight < 1 {
+		return
+	}
+	finalBlock := block
+	if finalHeight != block.Height() {
+		var err error
+		finalBlock, err = s.chain.BlockByHeight(finalHeight)
+		if err != nil {
+			// The block may have been disconnected in the mean time, in which
+			// case the emissions are notified once they are final on the new
+			// main chain.
+			syncLog.Debugf("Unable to fetch block at height %d to notify "+
+				"final SKA emissions: %v", finalHeight, err)
+			return
+		}
+	}
+
+	emissions := blockchain.BlockSKAEmissions(finalBlock)
+	if len(emissions) == 0 {
+		return
+	}
+	for _, emission := range emissions {
+		syncLog.Infof("SKA emission %v for coin type %d (nonce %d) at "+
+			"height %d is final after %d confirmations",
+			chainhash.Hash(emission.TxHash), emission.CoinType,
+			emission.Nonce, emission.Height, finalConfs)
+		if s.eventSink != nil {
+			s.eventSink.Publish(eventsink.EventSKAEmissionFinal,
+				&eventsink.SKAEmissionFinal{
+					CoinType:      uint8(emission.CoinType),
+					Nonce:         emission.Nonce,
+					TxHash:        chainhash.Hash(emission.TxHash).String(),
+					BlockHash:     finalBlock.Hash().String(),
+					Height:        emission.Height,
+					Confirmations: finalConfs,
+				})
+		}
+	}
+	if r := s.rpcServer; r != nil {
+		r.NotifySKAEmissionsFinal(finalBlock, emissions)
+	}
+}
+
+// publishAllocationAlerts publishes an allocation alert to the event sink for
+// every coin type whose pending mempool demand started exceeding the block
+// space allocated to it in the passed allocation.
+func (s *server) publishAllocationAlerts(result *blockalloc.AllocationResult) {
+	if s.eventSink == nil {
+		return
+	}
+	for coinType, alloc := range result.Allocations {
+		congested := alloc.PendingBytes > alloc.FinalAllocation
+		if s.allocationAlerts.Update(coinType, congested) {
+			s.eventSink.Publish(eventsink.EventAllocationAlert,
+				&eventsink.AllocationAlert{
+					CoinType:       uint8(coinType),
+					PendingBytes:   alloc.PendingBytes,
+					AllocatedBytes: alloc.FinalAllocation,
+				})
+		}
+	}
+}
+
+// coinbaseMiner returns a string that identifies the miner of a block with the
+// provided coinbase transaction.  It is the address the first output that pays
+// a non-zero amount other than the treasury output pays to, or the hex-encoded
+// script of that output when it does not pay to a standard address.
+func coinbaseMiner(coinbase *wire.MsgTx, params *chaincfg.Params) string {
+	for _, txOut := range coinbase.TxOut {
+		if txOut.Value == 0 ||
+			bytes.Equal(txOut.PkScript, params.OrganizationPkScript) {
+
+			continue
+		}
+		_, addrs := stdscript.ExtractAddrs(txOut.Version, txOut.PkScript,
+			params)
+		if len(addrs) == 1 {
+			return addrs[0].String()
+		}
+		return hex.EncodeToString(txOut.PkScript)
+	}
+	return "unknown"
+}
+
+// checkAllocDivergence recomputes the block space allocation for the pending
+// demand of each coin type in the transaction pool that the miner of the
+// provided newly connected block could have included in it and compares it
+// with the block space the block actually used for each coin type.  Miners
+// whose blocks repeatedly use significantly less space for some coin types
+// than the allocation policy would have are surfaced in the log, the event
+// sink, and the getallocationdivergence RPC since they are likely running a
+// modified allocation policy.
+//
+// Only regular transactions that were added to the pool before the timestamp
+// of the block count towards the pending demand, and the stake tree of the
+// block is reserved before allocating, so the comparison only covers the
+// regular transactions the miner is expected to have selected.
+func (s *server) checkAllocDivergence(block *dcrutil.Block) {
+	msgBlock := block.MsgBlock()
+	blockTime := msgBlock.Header.Timestamp
+
+	pending := make(map[cointype.CoinType]uint32)
+	for _, desc := range s.txMemPool.TxDescs() {
+		msgTx := desc.Tx.MsgTx()
+		if desc.Type != stake.TxTypeRegular || desc.Added.After(blockTime) ||
+			wire.IsSKAEmissionTransaction(msgTx) {
+
+			continue
+		}
+		coinType := blockalloc.GetTransactionCoinType(desc.Tx)
+		pending[coinType] += uint32(msgTx.SerializeSize())
+	}
+
+	// The coinbase and any SKA emissions are not selected from the pending
+	// demand, so they are not counted against the block.
+	used := make(map[cointype.CoinType]uint32)
+	for i, tx := range block.Transactions() {
+		msgTx := tx.MsgTx()
+		if i == 0 || wire.IsSKAEmissionTransaction(msgTx) {
+			continue
+		}
+		coinType := blockalloc.GetTransactionCoinType(tx)
+		used[coinType] += uint32(msgTx.SerializeSize())
+	}
+	var stakeBytes uint32
+	for _, stx := range msgBlock.STransactions {
+		stakeBytes += uint32(stx.SerializeSize())
+	}
+
+	allocator := blockalloc.NewBlockSpaceAllocator(uint32(cfg.BlockMaxSize),
+		s.chainParams).ForHeight(block.Height()).WithStakeReserve(stakeBytes)
+	divergences := allocator.CheckDivergence(pending, used,
+		cfg.AllocDivergenceMargin)
+
+	miner := coinbaseMiner(msgBlock.Transactions[0], s.chainParams)
+	stats, flagged := s.allocDivergence.Record(miner, block.Height(),
+		len(divergences) > 0)
+	if len(divergences) == 0 {
+		return
+	}
+	for _, d := range divergences {
+		srvrLog.Debugf("Block %v at height %d by %s used %d bytes for %s "+
+			"while the allocation policy expects %d of %d pending bytes",
+			block.Hash(), block.Height(), miner, d.UsedBytes, d.CoinType,
+			d.ExpectedBytes, d.PendingBytes)
+	}
+	if !flagged {
+		return
+	}
+
+	srvrLog.Warnf("%d of the last %d blocks by %s used significantly less "+
+		"block space for some coin types than the allocation policy "+
+		"expects -- the miner may be running a modified allocation policy",
+		stats.RecentDivergent, blockalloc.DivergenceWindow, miner)
+	if s.eventSink != nil {
+		coinTypes := make([]eventsink.DivergentCoinType, 0, len(divergences))
+		for _, d := range divergences {
+			coinTypes = append(coinTypes, eventsink.DivergentCoinType{
+				CoinType:      uint8(d.CoinType),
+				PendingBytes:  d.PendingBytes,
+				ExpectedBytes: d.ExpectedBytes,
+				UsedBytes:     d.UsedBytes,
+			})
+		}
+		s.eventSink.Publish(eventsink.EventAllocationDivergence,
+			&eventsink.AllocationDivergence{
+				Miner:           miner,
+				Hash:            block.Hash().String(),
+				Height:          block.Height(),
+				RecentDivergent: stats.RecentDivergent,
+				Window:          blockalloc.DivergenceWindow,
+				CoinTypes:       coinTypes,
+			})
+	}
+}
+
+// recordBlockUtilization records the block space utilization of each coin type
+// in the provided newly connected block in the utilization history.
+func (s *server) recordBlockUtilization(block *dcrutil.Block) {
+	stats, err := s.chain.FetchBlockStats(block.Hash())
+	if err != nil {
+		srvrLog.Errorf("Unable to fetch stats of block %v for the "+
+			"utilization history: %v", block.Hash(), err)
+		return
+	}
+	if stats == nil {
+		// The block is no longer in the main chain.
+		return
+	}
+	utilization := &fees.BlockUtilization{
+		Height:    block.Height(),
+		Hash:      *block.Hash(),
+		CoinTypes: make([]fees.CoinTypeUtilization, 0, len(stats.Stats)),
+	}
+	for _, stat := range stats.Stats {
+		utilization.CoinTypes = append(utilization.CoinTypes,
+			fees.CoinTypeUtilization{
+				CoinType:       stat.CoinType,
+				NumTxns:        stat.NumTxns,
+				UsedBytes:      stat.TotalBytes,
+				AllocatedBytes: stat.AllocatedBytes,
+			})
+	}
+	if err := s.utilizationHistory.Add(utilization); err != nil {
+		srvrLog.Errorf("Unable to record block %v in the utilization "+
+			"history: %v", block.Hash(), err)
+	}
+}
+
+// reinjectSKAEmissions adds the emission transactions for the provided SKA
+// emissions rolled back by disconnecting the passed block back to the
+// transaction pool and logs those that can no longer be mined, such as when
+// their emission window has closed.
+func (s *server) reinjectSKAEmissions(block *dcrutil.Block, emissions []blockchain.SKAEmissionRecord) {
+	// Multiple records share the same transaction when it emits more than one
+	// coin type.
+	rolledBack := make(map[chainhash.Hash]struct{}, len(emissions))
+	for _, emission := range emissions {
+		rolledBack[emission.TxHash] = struct{}{}
+	}
+	var txns []*dcrutil.Tx
+	for _, tx := range block.Transactions() {
+		if _, ok := rolledBack[*tx.Hash()]; ok {
+			txns = append(txns, tx)
+		}
+	}
+
+	rejected := s.txMemPool.MaybeAcceptSKAEmissions(txns)
+	for _, tx := range txns {
+		if err, ok := rejected[*tx.Hash()]; ok {
+			syncLog.Infof("Rolled back SKA emission %v from block %v will "+
+				"not be mined again: %v", tx.Hash(), block.Hash(), err)
+			continue
+		}
+		syncLog.Debugf("Added rolled back SKA emission %v from block %v "+
+			"back to the transaction pool", tx.Hash(), block.Hash())
+	}
+}
+
+// restoreMempool adds the transactions saved to the mempool file on the last
+// shutdown back to the transaction pool.  The file is removed afterwards so
+// the transactions are not restored again should the node crash before it is
+// saved on the next shutdown.
+func (s *server) restoreMempool() {
+	f, err := os.Open(s.mempoolFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			srvrLog.Warnf("Unable to open saved mempool: %v", err)
+		}
+		return
+	}
+	result, err := s.txMemPool.RestorePersisted(f)
+	f.Close()
+	if err := os.Remove(s.mempoolFile); err != nil {
+		srvrLog.Warnf("Unable to remove saved mempool: %v", err)
+	}
+	if err != nil {
+		srvrLog.Warnf("Unable to restore saved mempool: %v", err)
+		return
+	}
+
+	coinTypes := make([]cointype.CoinType, 0, len(result.Restored))
+	for coinType := range result.Restored {
+		coinTypes = append(coinTypes, coinType)
+	}
+	for coinType := range result.Rejected {
+		if _, ok := result.Restored[coinType]; !ok {
+			coinTypes = append(coinTypes, coinType)
+		}
+	}
+	cointype.Sort(coinTypes)
+	for _, coinType := range coinTypes {
+		srvrLog.Infof("Restored %d saved %v mempool transactions (%d "+
+			"rejected)", result.Restored[coinType], coinType,
+			result.Rejected[coinType])
+	}
+	for i := range result.Emissions {
+		srvrLog.Infof("Restored pending SKA emission %v to the mempool",
+			&result.Emissions[i])
+	}
+}
+
+// saveMempool writes the transactions in the transaction pool to the mempool
+// file so they can be restored on the next startup.
+func (s *server) saveMempool() {
+	tmpFile := s.mempoolFile + ".tmp"
+	f, err := os.Create(tmpFile)
+	if err != nil {
+		srvrLog.Errorf("Unable to save mempool: %v", err)
+		return
+	}
+	n, err := s.txMemPool.WritePersisted(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpFile, s.mempoolFile)
+	}
+	if err != nil {
+		os.Remove(tmpFile)
+		srvrLog.Errorf("Unable to save mempool: %v", err)
+		return
+	}
+	srvrLog.Infof("Saved %d mempool transactions", n)
+}
+
+// restorePeerPolicy restores the bans and per coin type misbehavior scores of
+// remote hosts saved to the peer policy file on the last shutdown.  Bans that
+// have expired and scores that have decayed in the meantime are discarded.
+func (s *server) restorePeerPolicy() {
+	f, err := os.Open(s.peerPolicyFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			srvrLog.Warnf("Unable to open saved peer policy: %v", err)
+		}
+		return
+	}
+	banned, err := readPeerPolicy(f, s.coinTypePolicy, time.Now())
+	f.Close()
+	if err != nil {
+		srvrLog.Warnf("Unable to restore saved peer policy: %v", err)
+		return
+	}
+
+	s.peerState.Lock()
+	for host, bannedUntil := range banned {
+		s.peerState.banned[host] = bannedUntil
+	}
+	s.peerState.Unlock()
+	srvrLog.Debugf("Restored %d saved peer %s", len(banned),
+		pickNoun(uint64(len(banned)), "ban", "bans"))
+}
+
+// savePeerPolicy writes the bans and per coin type misbehavior scores of remote
+// hosts to the peer policy file so they can be restored on the next startup.
+func (s *server) savePeerPolicy() {
+	s.peerState.Lock()
+	banned := make(map[string]time.Time, len(s.peerState.banned))
+	for host, bannedUntil := range s.peerState.banned {
+		banned[host] = bannedUntil
+	}
+	s.peerState.Unlock()
+
+	tmpFile := s.peerPolicyFile + ".tmp"
+	f, err := os.Create(tmpFile)
+	if err != nil {
+		srvrLog.Errorf("Unable to save peer policy: %v", err)
+		return
+	}
+	numBans, numScored, err := writePeerPolicy(f, banned, s.coinTypePolicy,
+		time.Now())
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpFile, s.peerPolicyFile)
+	}
+	if err != nil {
+		os.Remove(tmpFile)
+		srvrLog.Errorf("Unable to save peer policy: %v", err)
+		return
+	}
+	srvrLog.Debugf("Saved %d peer %s and the coin type misbehavior scores "+
+		"of %d %s", numBans, pickNoun(uint64(numBans), "ban", "bans"), numScored,
+		pickNoun(uint64(numScored), "host", "hosts"))
+}
+
 // rebroadcastHandler keeps track of user submitted inventories that we have
 // sent out but have not yet made it into a block. We periodically rebroadcast
-// them in case our peers restarted or otherwise lost track of them.
+// them with exponential backoff in case our peers restarted or otherwise lost
+// track of them.  Rebroadcasts of coin types whose allocated block space is
+// saturated are postponed.
 func (s *server) rebroadcastHandler(ctx context.Context) {
-	// Wait 5 min before first tx rebroadcast.
-	timer := time.NewTimer(5 * time.Minute)
-	pendingInvs := make(map[wire.InvVect]interface{})
+	ticker := time.NewTicker(rebroadcastCheckInterval)
+	pending := newRebroadcastTracker(cfg.RebroadcastMaxUtilization)
+	utilization := func(coinType cointype.CoinType) float64 {
+		if s.feeCalculator == nil {
+			return 0
+		}
+		stats, err := s.feeCalculator.GetFeeStats(coinType)
+		if err != nil {
+			return 0
+		}
+		return stats.BlockSpaceUsed
+	}
 
 	for {
 		select {
@@ -3181,17 +4048,28 @@ func (s *server) rebroadcastHandler(ctx context.Context) {
 
 			// Incoming InvVects are added to our map of RPC txs.
 			case broadcastInventoryAdd:
-				pendingInvs[*msg.invVect] = msg.data
+				pending.add(*msg.invVect, msg.data, time.Now())
 
 			// When an InvVect has been added to a block, we can
 			// now remove it, if it was present.
 			case broadcastInventoryDel:
-				delete(pendingInvs, *msg)
+				pending.remove(*msg)
+
+			case broadcastListInventory:
+				msg.reply <- pending.list()
+
+			case broadcastAbandonInventory:
+				removed := pending.remove(*msg.invVect)
+				if removed {
+					srvrLog.Infof("Abandoned rebroadcast of tx %v",
+						msg.invVect.Hash)
+				}
+				msg.reply <- removed
 
 			case broadcastPruneInventory:
 				best := s.chain.BestSnapshot()
-				for iv, data := range pendingInvs {
-					tx, ok := data.(*dcrutil.Tx)
+				for iv, entry := range pending.pending {
+					tx, ok := entry.data.(*dcrutil.Tx)
 					if !ok {
 						continue
 					}
@@ -3202,7 +4080,7 @@ func (s *server) rebroadcastHandler(ctx context.Context) {
 					// the current stake difficulty.
 					if txType == stake.TxTypeSStx &&
 						tx.MsgTx().TxOut[0].Value != best.NextStakeDiff {
-						delete(pendingInvs, iv)
+						pending.remove(iv)
 						srvrLog.Debugf("Pending ticket purchase broadcast "+
 							"inventory for tx %v removed. Ticket value not "+
 							"equal to stake difficulty.", tx.Hash())
@@ -3212,7 +4090,7 @@ func (s *server) rebroadcastHandler(ctx context.Context) {
 					// Remove the ticket rebroadcast if it has already expired.
 					if txType == stake.TxTypeSStx &&
 						blockchain.IsExpired(tx, best.Height) {
-						delete(pendingInvs, iv)
+						pending.remove(iv)
 						srvrLog.Debugf("Pending ticket purchase broadcast "+
 							"inventory for tx %v removed. Transaction "+
 							"expired.", tx.Hash())
@@ -3224,7 +4102,7 @@ func (s *server) rebroadcastHandler(ctx context.Context) {
 					if txType == stake.TxTypeSSRtx {
 						refSStxHash := tx.MsgTx().TxIn[0].PreviousOutPoint.Hash
 						if !s.chain.CheckLiveTicket(refSStxHash) {
-							delete(pendingInvs, iv)
+							pending.remove(iv)
 							srvrLog.Debugf("Pending revocation broadcast "+
 								"inventory for tx %v removed. "+
 								"Associated ticket was revived.", tx.Hash())
@@ -3234,19 +4112,15 @@ func (s *server) rebroadcastHandler(ctx context.Context) {
 				}
 			}
 
-		case <-timer.C:
-			// Any inventory we have has not made it into a block
+		case <-ticker.C:
+			// Any inventory that is due has not made it into a block
 			// yet. We periodically resubmit them until they have.
-			for iv, data := range pendingInvs {
-				ivCopy := iv
-				s.RelayInventory(&ivCopy, data, false)
+			for _, msg := range pending.due(time.Now(), utilization) {
+				s.RelayInventory(msg.invVect, msg.data, false)
 			}
 
-			// Process at a random time up to 30mins in the future.
-			timer.Reset(rand.Duration(30 * time.Minute))
-
 		case <-ctx.Done():
-			timer.Stop()
+			ticker.Stop()
 			return
 		}
 	}
@@ -3318,11 +4192,66 @@ func (s *server) querySeeders(ctx context.Context) {
 	}
 }
 
+// watchKnownSKAAddresses imports the addresses the scheduled emission tranches
+// of the configured SKA coin types pay to into the watch-only index so they are
+// watched without having to be imported manually.  Each address is rescanned
+// from the start of the emission window of the first tranche that pays to it,
+// and addresses that are already watched are left untouched.
+func (s *server) watchKnownSKAAddresses(ctx context.Context) {
+	var imported int
+	known := blockchain.KnownSKAAddresses(s.chainParams, cfg.emissionManifests)
+	for i := range known {
+		k := &known[i]
+		if k.ManifestMissing {
+			srvrLog.Warnf("Unable to watch the addresses of %s emission "+
+				"tranche %d since its emission manifest is not loaded",
+				k.CoinType, k.Tranche)
+			continue
+		}
+		if k.Address == "" {
+			srvrLog.Infof("Unable to watch %s emission output %s of "+
+				"tranche %d since it does not pay to a single address",
+				k.CoinType, k.Descriptor, k.Tranche)
+			continue
+		}
+		addr, err := stdaddr.DecodeAddress(k.Address, s.chainParams)
+		if err != nil {
+			srvrLog.Errorf("Invalid %s emission address %s: %v", k.CoinType,
+				k.Address, err)
+			continue
+		}
+		err = s.watchOnlyIndex.ImportAddress(ctx, addr, k.CoinType,
+			k.EmissionHeight)
+		if err != nil {
+			if ctx.Err() == nil {
+				srvrLog.Errorf("Unable to watch %s emission address %s: %v",
+					k.CoinType, k.Address, err)
+			}
+			continue
+		}
+		imported++
+	}
+	if imported > 0 {
+		srvrLog.Infof("Watching %d known SKA emission addresses", imported)
+	}
+}
+
 // Run starts the server and blocks until the provided context is cancelled.
 // This entails accepting connections from peers.
 func (s *server) Run(ctx context.Context) {
 	srvrLog.Trace("Starting server")
 
+	// Restore the transactions saved to disk on the last shutdown.
+	if s.mempoolFile != "" {
+		s.restoreMempool()
+	}
+
+	// Restore the bans and coin type misbehavior scores of remote hosts saved
+	// to disk on the last shutdown.
+	if s.peerPolicyFile != "" {
+		s.restorePeerPolicy()
+	}
+
 	// Start the peer handler which in turn starts the address manager.
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -3391,6 +4320,61 @@ func (s *server) Run(ctx context.Context) {
 		}
 	}
 
+	// Start the emission rehearsal coordinator when enabled.
+	if s.emissionCoordinator != nil {
+		wg.Add(1)
+		go func() {
+			s.emissionCoordinator.Run(ctx)
+			wg.Done()
+		}()
+	}
+
+	// Start the emission watchtower when enabled.
+	if s.emissionWatchtower != nil {
+		wg.Add(1)
+		go func() {
+			s.emissionWatchtower.Run(ctx)
+			wg.Done()
+		}()
+	}
+
+	// Start the emission checkpoint verifier when enabled.
+	if s.emissionCheckpoints != nil {
+		wg.Add(1)
+		go func() {
+			s.emissionCheckpoints.Run(ctx)
+			wg.Done()
+		}()
+	}
+
+	// Start the UTXO supply scanner when enabled.
+	if s.supplyScanner != nil {
+		wg.Add(1)
+		go func() {
+			s.supplyScanner.Run(ctx)
+			wg.Done()
+		}()
+	}
+
+	// Watch the known SKA emission addresses when the watch-only index is
+	// enabled.
+	if s.watchOnlyIndex != nil {
+		wg.Add(1)
+		go func() {
+			s.watchKnownSKAAddresses(ctx)
+			wg.Done()
+		}()
+	}
+
+	// Start the event sink when enabled.
+	if s.eventSink != nil {
+		wg.Add(1)
+		go func() {
+			s.eventSink.Run(ctx)
+			wg.Done()
+		}()
+	}
+
 	// Start the chain's index subscriber.
 	wg.Add(1)
 	go func() {
@@ -3404,8 +4388,17 @@ func (s *server) Run(ctx context.Context) {
 
 	srvrLog.Warnf("Server shutting down")
 	s.feeEstimator.Close()
+	if s.utilizationHistory != nil {
+		s.utilizationHistory.Close()
+	}
 	s.chain.ShutdownUtxoCache()
 	wg.Wait()
+	if s.mempoolFile != "" {
+		s.saveMempool()
+	}
+	if s.peerPolicyFile != "" {
+		s.savePeerPolicy()
+	}
 	srvrLog.Trace("Server stopped")
 }
 
@@ -3900,7 +4893,11 @@ func newServer(ctx context.Context, profiler *profileServer,
 		recentlyAdvertisedTxns: lru.NewMapWithDefaultTTL[chainhash.Hash,
 			*dcrutil.Tx](maxRecentlyAdvertisedTxns, recentlyAdvertisedTxnsTTL),
 		lastAdvertisedTxnsEvictedLogged: time.Now(),
+		skaConfigHash:                   chainParams.SKAConfigHash(),
+		skaRelayAddrs: lru.NewMap[string,
+			*addrmgr.NetAddress](maxSKARelayAddrs),
 	}
+	srvrLog.Infof("SKA configuration hash: %v", s.skaConfigHash)
 
 	// Convert the minimum known work to a uint256 when it exists.  Ideally, the
 	// chain params should be updated to use the new type, but that will be a
@@ -3935,6 +4932,25 @@ func newServer(ctx context.Context, profiler *profileServer,
 	// This single instance is used by both mining and RPC to ensure consistent fee estimates
 	s.feeCalculator = fees.NewCoinTypeFeeCalculator(chainParams, cfg.minRelayTxFee)
 
+	// Seed the dynamic fee multipliers from the block space utilization of
+	// the most recent blocks recorded before the restart.
+	if cfg.UtilizationHistory > 0 {
+		utilFile := path.Join(dataDir, utilizationFileName)
+		history, err := fees.OpenUtilizationHistory(utilFile,
+			cfg.UtilizationHistory)
+		if err != nil {
+			return nil, err
+		}
+		s.utilizationHistory = history
+		s.feeCalculator.SeedUtilization(history.Recent(cfg.UtilizationHistory))
+	}
+
+	// Track how the blocks of each miner diverge from the allocation policy
+	// unless the self-check is disabled.
+	if cfg.AllocDivergenceMargin > 0 {
+		s.allocDivergence = blockalloc.NewDivergenceMonitor()
+	}
+
 	if cfg.AllowOldForks {
 		srvrLog.Info("Processing forks deep in history is enabled")
 	}
@@ -3983,6 +4999,10 @@ func newServer(ctx context.Context, profiler *profileServer,
 		return nil, err
 	}
 
+	// Only support the SKA coin types in the fee calculator that are active
+	// in the next block.
+	s.feeCalculator.UpdateActiveCoinTypes(s.chain.BestSnapshot().Height + 1)
+
 	queryer := &blockchain.ChainQueryerAdapter{BlockChain: s.chain}
 	if cfg.TxIndex {
 		indxLog.Info("Transaction index is enabled")
@@ -4000,6 +5020,15 @@ func newServer(ctx context.Context, profiler *profileServer,
 		}
 	}
 
+	if cfg.WatchOnlyIndex {
+		indxLog.Info("Watch-only index is enabled")
+		s.watchOnlyIndex, err = indexers.NewWatchOnlyIndex(s.indexSubscriber,
+			db, queryer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// SSFee index is always enabled to support UTXO consolidation.
 	// This index tracks SSFee outputs by (coinType, address) for efficient
 	// UTXO lookup during block template generation.
@@ -4009,6 +5038,15 @@ func newServer(ctx context.Context, profiler *profileServer,
 		return nil, err
 	}
 
+	// The emission index is always enabled since it is tiny and lets the
+	// emissions of each SKA coin type be looked up without scanning the chain.
+	indxLog.Info("SKA emission index is enabled")
+	s.emissionIndex, err = indexers.NewEmissionIndex(s.indexSubscriber, db,
+		queryer)
+	if err != nil {
+		return nil, err
+	}
+
 	err = s.indexSubscriber.CatchUp(ctx, s.db, queryer)
 	if err != nil {
 		return nil, err
@@ -4019,10 +5057,44 @@ func newServer(ctx context.Context, profiler *profileServer,
 			EnableAncestorTracking: len(cfg.miningAddrs) > 0,
 			AcceptNonStd:           cfg.AcceptNonStd,
 			MaxOrphanTxs:           cfg.MaxOrphanTxs,
+			SKAMaxOrphanTxs:        cfg.SKAMaxOrphanTxs,
+			SKAOrphanTTL:           cfg.SKAOrphanExpiry,
 			MaxOrphanTxSize:        mempool.MaxStandardTxSize,
 			MaxSigOpsPerTx:         blockchain.MaxSigOpsPerBlock / 5,
 			MinRelayTxFee:          cfg.minRelayTxFee,
 			AllowOldVotes:          cfg.AllowOldVotes,
+			VARNullData: mempool.NullDataPolicy{
+				MaxDataSize: cfg.MaxNullDataSize,
+				MaxOutputs:  cfg.MaxNullDataOutputs,
+			},
+			SKANullData: mempool.NullDataPolicy{
+				MaxDataSize: cfg.SKAMaxNullDataSize,
+				MaxOutputs:  cfg.SKAMaxNullDataOutputs,
+			},
+			VARMaxTxAge:      cfg.MempoolExpiry,
+			SKAMaxTxAge:      cfg.SKAMempoolExpiry,
+			CoinTypeMaxTxAge: cfg.coinTypeMempoolExpiry,
+			SKAMinFeeRelayLimit: mempool.MinFeeRelayLimit{
+				PeerKBPerMinute: cfg.SKAPeerMinFeeRelayLimit,
+				KBPerMinute:     cfg.SKAMinFeeRelayLimit,
+			},
+			CoinTypeMinFeeRelayLimit: cfg.coinTypeMinFeeLimit,
+			VARMaxPoolSize:           cfg.MempoolMaxSize * 1e6,
+			SKAMaxPoolSize:           cfg.SKAMempoolMaxSize * 1e6,
+			CoinTypeMaxPoolSize:      cfg.coinTypeMempoolSize,
+			VARChainLimits: mempool.ChainLimits{
+				MaxAncestors:      cfg.MempoolMaxAncestors,
+				MaxAncestorSize:   cfg.MempoolMaxAncestorSize * 1000,
+				MaxDescendants:    cfg.MempoolMaxDescendants,
+				MaxDescendantSize: cfg.MempoolMaxDescendantSize * 1000,
+			},
+			SKAChainLimits: mempool.ChainLimits{
+				MaxAncestors:      cfg.SKAMempoolMaxAncestors,
+				MaxAncestorSize:   cfg.SKAMempoolMaxAncestorSize * 1000,
+				MaxDescendants:    cfg.SKAMempoolMaxDescendants,
+				MaxDescendantSize: cfg.SKAMempoolMaxDescendantSize * 1000,
+			},
+			CoinTypeChainLimits: cfg.coinTypeChainLimits,
 			MaxVoteAge: func() uint16 {
 				switch chainParams.Net {
 				case wire.MainNet, wire.SimNet, wire.RegNet:
@@ -4067,6 +5139,44 @@ func newServer(ctx context.Context, profiler *profileServer,
 				s.rpcServer.NotifyTSpend(tx)
 			}
 		},
+		OnDoubleSpend: func(ds *mempool.DoubleSpend) {
+			if s.rpcServer != nil {
+				s.rpcServer.NotifyDoubleSpend(ds.Tx, ds.CoinType,
+					ds.Conflicts)
+			}
+			if s.eventSink != nil {
+				conflicts := make([]string, 0, len(ds.Conflicts))
+				for i := range ds.Conflicts {
+					conflicts = append(conflicts, ds.Conflicts[i].String())
+				}
+				s.eventSink.Publish(eventsink.EventDoubleSpend,
+					&eventsink.DoubleSpend{
+						CoinType:  uint8(ds.CoinType),
+						TxHash:    ds.Tx.Hash().String(),
+						Conflicts: conflicts,
+					})
+			}
+		},
+		OnEmissionConflict: func(ec *mempool.EmissionConflict) {
+			if s.eventSink == nil {
+				return
+			}
+			evicted := make([]string, 0, len(ec.Evicted))
+			for i := range ec.Evicted {
+				evicted = append(evicted, ec.Evicted[i].String())
+			}
+			var replacement string
+			if ec.Replacement != nil {
+				replacement = ec.Replacement.String()
+			}
+			s.eventSink.Publish(eventsink.EventSKAEmissionConflict,
+				&eventsink.SKAEmissionConflict{
+					CoinType:    uint8(ec.CoinType),
+					TxHash:      ec.Emission.Hash().String(),
+					Replacement: replacement,
+					Evicted:     evicted,
+				})
+		},
 		IsTreasuryAgendaActive: func() (bool, error) {
 			tipHash := &s.chain.BestSnapshot().Hash
 			return s.chain.IsTreasuryAgendaActive(tipHash)
@@ -4083,9 +5193,14 @@ func newServer(ctx context.Context, profiler *profileServer,
 			tipHash := &s.chain.BestSnapshot().Hash
 			return s.chain.IsSubsidySplitR2AgendaActive(tipHash)
 		},
+		IsInputCoinTypesAgendaActive: func() (bool, error) {
+			tipHash := &s.chain.BestSnapshot().Hash
+			return s.chain.IsInputCoinTypesAgendaActive(tipHash)
+		},
 		// Add SKA emission state checks for mempool protection
-		HasSKAEmissionOccurred: s.chain.HasSKAEmissionOccurred,
-		GetSKAEmissionNonce:    s.chain.GetSKAEmissionNonce,
+		HasSKAEmissionOccurred:     s.chain.HasSKAEmissionOccurred,
+		GetSKAEmissionNonce:        s.chain.GetSKAEmissionNonce,
+		SKAEmissionTranchesEmitted: s.chain.SKAEmissionTranchesEmitted,
 		HasVotePassedAtHeight: func(voteID string, height int64) bool {
 			return s.chain.HasVotePassedAtHeight(voteID, height)
 		},
@@ -4095,6 +5210,13 @@ func newServer(ctx context.Context, profiler *profileServer,
 		},
 	}
 	s.txMemPool = mempool.New(&txC)
+	if !cfg.NoPersistMempool {
+		s.mempoolFile = path.Join(dataDir, mempoolFileName)
+	}
+	s.coinTypePolicy = newCoinTypePeerPolicy()
+	if !cfg.NoPersistPeerPolicy {
+		s.peerPolicyFile = path.Join(dataDir, peerPolicyFileName)
+	}
 
 	mixchain := &mixpoolChain{s.chain, s.txMemPool}
 	s.mixMsgPool = mixpool.NewPool(mixchain)
@@ -4122,6 +5244,20 @@ func newServer(ctx context.Context, profiler *profileServer,
 		return nil, fmt.Errorf("closing after dumping blockchain")
 	}
 
+	// Create the event sink when endpoints are configured.  It must be
+	// created before the block template generator since the generator
+	// reports block space allocation alerts to it.
+	if len(cfg.EventSinkURLs) > 0 {
+		s.eventSink = eventsink.New(&eventsink.Config{
+			Endpoints:  cfg.EventSinkURLs,
+			Secret:     []byte(cfg.EventSinkSecret),
+			MaxRetries: cfg.EventSinkMaxRetries,
+			RetryDelay: eventsink.DefaultRetryDelay,
+		})
+		s.allocationAlerts = eventsink.NewEdgeTrigger()
+		s.feeSpikes = eventsink.NewEdgeTrigger()
+	}
+
 	// Create the background block template generator and CPU miner if the
 	// config has a mining address.
 	if len(cfg.miningAddrs) > 0 {
@@ -4131,9 +5267,11 @@ func newServer(ctx context.Context, profiler *profileServer,
 		// NOTE: The CPU miner relies on the mempool, so the mempool has to be
 		// created before calling the function to create the CPU miner.
 		policy := mining.Policy{
-			BlockMaxSize:     cfg.BlockMaxSize,
-			TxMinFreeFee:     cfg.minRelayTxFee,
-			AggressiveMining: !cfg.NonAggressive,
+			BlockMaxSize:                cfg.BlockMaxSize,
+			TxMinFreeFee:                cfg.minRelayTxFee,
+			AggressiveMining:            !cfg.NonAggressive,
+			TemplateTimeBudget:          cfg.TemplateTimeBudget,
+			SSFeeConsolidationIntervals: cfg.ssfeeConsolidationInts,
 			StandardVerifyFlags: func() (txscript.ScriptFlags, error) {
 				return standardScriptVerifyFlags(s.chain)
 			},
@@ -4146,6 +5284,7 @@ func newServer(ctx context.Context, profiler *profileServer,
 			ChainParams:                s.chainParams,
 			FeeCalculator:              s.feeCalculator, // Use shared fee calculator
 			SSFeeIndex:                 s.ssfeeIndex,    // Enable SSFee UTXO augmentation
+			BlockSpaceAllocated:        s.publishAllocationAlerts,
 			MiningTimeOffset:           cfg.MiningTimeOffset,
 			BestSnapshot:               s.chain.BestSnapshot,
 			BlockByHash:                s.chain.BlockByHash,
@@ -4177,6 +5316,7 @@ func newServer(ctx context.Context, profiler *profileServer,
 			IsSubsidySplitAgendaActive:      s.chain.IsSubsidySplitAgendaActive,
 			IsSubsidySplitR2AgendaActive:    s.chain.IsSubsidySplitR2AgendaActive,
 			MaxTreasuryExpenditure:          s.chain.MaxTreasuryExpenditure,
+			MaxBlockSize:                    s.chain.MaxBlockSize,
 			NewUtxoViewpoint: func() *blockchain.UtxoViewpoint {
 				return blockchain.NewUtxoViewpoint(utxoCache)
 			},
@@ -4208,6 +5348,83 @@ func newServer(ctx context.Context, profiler *profileServer,
 		})
 	}
 
+	// Create the emission rehearsal coordinator when requested.
+	if cfg.EmissionRehearsal {
+		s.emissionCoordinator, err = emission.New(&emission.Config{
+			ChainParams: s.chainParams,
+			Keys:        cfg.emissionRehearsalKeys,
+			Manifests:   cfg.emissionManifests,
+			BestHeight: func() int64 {
+				return s.chain.BestSnapshot().Height
+			},
+			HasSKAEmissionOccurred:     s.chain.HasSKAEmissionOccurred,
+			GetSKAEmissionNonce:        s.chain.GetSKAEmissionNonce,
+			SKAEmissionTranchesEmitted: s.chain.SKAEmissionTranchesEmitted,
+			HasVotePassedAtHeight:      s.chain.HasVotePassedAtHeight,
+			SubmitTx: func(tx *dcrutil.Tx) error {
+				acceptedTxs, err := s.txMemPool.ProcessTransaction(tx, false,
+					false, 0)
+				if err != nil {
+					return err
+				}
+				s.AnnounceNewTransactions(acceptedTxs)
+				iv := wire.NewInvVect(wire.InvTypeTx, tx.Hash())
+				s.AddRebroadcastInventory(iv, tx)
+				return nil
+			},
+			HaveTransaction: s.txMemPool.HaveTransaction,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the emission watchtower when requested.
+	if cfg.EmissionWatch {
+		s.emissionWatchtower = emission.NewWatchtower(&emission.WatchConfig{
+			ChainParams: s.chainParams,
+			LeadBlocks:  cfg.EmissionWatchLead,
+			WebhookURLs: cfg.EmissionWatchWebhooks,
+			BestHeight: func() int64 {
+				return s.chain.BestSnapshot().Height
+			},
+			HasSKAEmissionOccurred:     s.chain.HasSKAEmissionOccurred,
+			SKAEmissionTranchesEmitted: s.chain.SKAEmissionTranchesEmitted,
+			HasVotePassedAtHeight:      s.chain.HasVotePassedAtHeight,
+			PendingSKAEmission:         s.txMemPool.PendingSKAEmission,
+		})
+	}
+
+	// Create the emission checkpoint verifier when requested.
+	if len(cfg.EmissionCheckpoints) > 0 {
+		s.emissionCheckpoints = emission.NewCheckpointVerifier(&emission.CheckpointConfig{
+			ChainParams: s.chainParams,
+			Keys:        cfg.emissionCheckpointKeys,
+			Sources:     cfg.EmissionCheckpoints,
+			BestHeight: func() int64 {
+				return s.chain.BestSnapshot().Height
+			},
+			CheckEmissionCheckpoint: s.chain.CheckEmissionCheckpoint,
+		})
+	}
+
+	// Create the UTXO supply scanner when requested.
+	if cfg.SupplyScan {
+		s.supplyScanner = supplyscan.New(&supplyscan.Config{
+			ChainParams: s.chainParams,
+			Interval:    cfg.SupplyScanInterval,
+			BatchSize:   supplyscan.DefaultBatchSize,
+			BatchDelay:  supplyscan.DefaultBatchDelay,
+			IsCurrent:   s.syncManager.IsCurrent,
+			ScanUtxoSet: func(ctx context.Context, fn func(wire.OutPoint, int64, cointype.CoinType) error) (*blockchain.UtxoScanTip, error) {
+				return s.chain.ScanUtxoSet(ctx, func(outpoint wire.OutPoint, entry *blockchain.UtxoEntry) error {
+					return fn(outpoint, entry.Amount(), entry.CoinType())
+				})
+			},
+			EmissionIndex: s.emissionIndex,
+		})
+	}
+
 	// Only setup a function to return new addresses to connect to when
 	// not running in connect-only mode.  The simulation and regression networks
 	// are always in connect-only mode since they are only intended to connect
@@ -4217,6 +5434,20 @@ func newServer(ctx context.Context, profiler *profileServer,
 	var newAddressFunc func() (net.Addr, error)
 	if !cfg.SimNet && !cfg.RegNet && len(cfg.ConnectPeers) == 0 {
 		newAddressFunc = func() (net.Addr, error) {
+			// Prefer the address of a peer that previously relayed the
+			// transactions of every SKA coin type while there are fewer
+			// outbound peers that do than desired.
+			if len(s.chainParams.SKACoins) > 0 {
+				_, numSKARelayOutbound := s.skaRelayOutboundCounts()
+				if numSKARelayOutbound < wantSKARelayOutbound(s.targetOutbound) {
+					addr := nextSKARelayAddr(s.skaRelayAddrs,
+						s.OutboundGroupCount)
+					if addr != nil {
+						return addrStringToNetAddr(addr.Key())
+					}
+				}
+			}
+
 			for tries := 0; tries < 100; tries++ {
 				// Note that this does not filter by address type.  Unsupported
 				// network address types should be pruned from the address
@@ -4333,11 +5564,13 @@ func newServer(ctx context.Context, profiler *profileServer,
 			CPUMiner:             &rpcCPUMiner{s.cpuMiner},
 			NetInfo:              cfg.generateNetworkInfo(),
 			MinRelayTxFee:        cfg.minRelayTxFee,
+			BlockMaxSize:         cfg.BlockMaxSize,
 			Proxy:                cfg.Proxy,
 			RPCUser:              cfg.RPCUser,
 			RPCPass:              cfg.RPCPass,
 			RPCLimitUser:         cfg.RPCLimitUser,
 			RPCLimitPass:         cfg.RPCLimitPass,
+			RPCAuth:              cfg.rpcAuth,
 			RPCMaxClients:        cfg.RPCMaxClients,
 			RPCMaxConcurrentReqs: cfg.RPCMaxConcurrentReqs,
 			RPCMaxWebsockets:     cfg.RPCMaxWebsockets,
@@ -4359,6 +5592,31 @@ func newServer(ctx context.Context, profiler *profileServer,
 		if s.txIndex != nil {
 			rpcsConfig.TxIndexer = s.txIndex
 		}
+		if s.watchOnlyIndex != nil {
+			rpcsConfig.WatchOnlyIndexer = s.watchOnlyIndex
+		}
+		rpcsConfig.EmissionIndexer = s.emissionIndex
+		rpcsConfig.IndexSyncReporter = s.indexSubscriber
+		if s.emissionCoordinator != nil {
+			rpcsConfig.EmissionRehearser = s.emissionCoordinator
+		}
+		if s.emissionWatchtower != nil {
+			rpcsConfig.EmissionWatcher = s.emissionWatchtower
+		}
+		if s.emissionCheckpoints != nil {
+			rpcsConfig.EmissionCheckpointer = s.emissionCheckpoints
+		}
+		if s.utilizationHistory != nil {
+			rpcsConfig.UtilizationHistorian = s.utilizationHistory
+		}
+		if s.allocDivergence != nil {
+			rpcsConfig.AllocDivergenceMonitor = s.allocDivergence
+		}
+		if s.supplyScanner != nil {
+			rpcsConfig.SupplyScanner = s.supplyScanner
+		}
+		rpcsConfig.EmissionFinalConfs = cfg.EmissionFinalConfs
+		rpcsConfig.EmissionManifests = cfg.emissionManifests
 
 		s.rpcServer, err = rpcserver.New(&rpcsConfig)
 		if err != nil {
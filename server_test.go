@@ -11,6 +11,8 @@ import (
 	"testing"
 
 	"github.com/monetarium/monetarium-node/addrmgr"
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/container/lru"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -102,3 +104,166 @@ func TestHostToNetAddress(t *testing.T) {
 		}
 	}
 }
+
+// TestSKAConfigHashMismatch ensures peers are only considered to advertise a
+// mismatched SKA configuration when they advertise a hash that differs from
+// the local one with a protocol version that includes the hash.
+func TestSKAConfigHashMismatch(t *testing.T) {
+	localHash := chainhash.Hash{0x01}
+	otherHash := chainhash.Hash{0x02}
+	curPver := int32(wire.SKAConfigHashVersion)
+	oldPver := int32(wire.SKAConfigHashVersion - 1)
+
+	tests := []struct {
+		name string
+		pver int32
+		hash chainhash.Hash
+		want bool
+	}{{
+		name: "matching hash",
+		pver: curPver,
+		hash: localHash,
+		want: false,
+	}, {
+		name: "mismatched hash",
+		pver: curPver,
+		hash: otherHash,
+		want: true,
+	}, {
+		name: "absent hash",
+		pver: curPver,
+		hash: chainhash.Hash{},
+		want: false,
+	}, {
+		name: "mismatched hash prior to hash protocol version",
+		pver: oldPver,
+		hash: otherHash,
+		want: false,
+	}}
+
+	for _, test := range tests {
+		msg := &wire.MsgVersion{
+			ProtocolVersion: test.pver,
+			SKAConfigHash:   test.hash,
+		}
+		got := skaConfigHashMismatch(msg, &localHash)
+		if got != test.want {
+			t.Errorf("%q: unexpected result - got %v, want %v", test.name,
+				got, test.want)
+		}
+	}
+}
+
+// TestNeedsMoreSKARelayOutbound ensures outbound peers that do not relay the
+// transactions of every SKA coin type are only rejected when accepting them
+// would not leave room for the minimum desired number of outbound peers that
+// do.
+func TestNeedsMoreSKARelayOutbound(t *testing.T) {
+	tests := []struct {
+		name        string
+		numOutbound uint32
+		numRelay    uint32
+		target      uint32
+		wantRelay   uint32
+		want        bool
+	}{{
+		name:        "no outbound peers with room to spare",
+		numOutbound: 0,
+		numRelay:    0,
+		target:      8,
+		wantRelay:   3,
+		want:        false,
+	}, {
+		name:        "slots remaining equal desired relay peers",
+		numOutbound: 5,
+		numRelay:    0,
+		target:      8,
+		wantRelay:   3,
+		want:        true,
+	}, {
+		name:        "slots remaining exceed missing relay peers",
+		numOutbound: 4,
+		numRelay:    1,
+		target:      8,
+		wantRelay:   3,
+		want:        false,
+	}, {
+		name:        "slots remaining equal missing relay peers",
+		numOutbound: 6,
+		numRelay:    1,
+		target:      8,
+		wantRelay:   3,
+		want:        true,
+	}, {
+		name:        "desired relay peers already connected",
+		numOutbound: 7,
+		numRelay:    3,
+		target:      8,
+		wantRelay:   3,
+		want:        false,
+	}, {
+		name:        "target below desired relay peers",
+		numOutbound: 1,
+		numRelay:    1,
+		target:      2,
+		wantRelay:   2,
+		want:        true,
+	}, {
+		name:        "target below desired relay peers satisfied",
+		numOutbound: 1,
+		numRelay:    2,
+		target:      2,
+		wantRelay:   2,
+		want:        false,
+	}}
+
+	for _, test := range tests {
+		wantRelay := wantSKARelayOutbound(test.target)
+		if wantRelay != test.wantRelay {
+			t.Errorf("%q: unexpected desired relay peers - got %d, want %d",
+				test.name, wantRelay, test.wantRelay)
+		}
+		got := needsMoreSKARelayOutbound(test.numOutbound, test.numRelay,
+			test.target)
+		if got != test.want {
+			t.Errorf("%q: unexpected result - got %v, want %v", test.name,
+				got, test.want)
+		}
+	}
+}
+
+// TestNextSKARelayAddr ensures the remembered addresses of peers that relayed
+// the transactions of every SKA coin type are returned most recent first,
+// skip network groups that already have an outbound peer, and are only
+// returned once.
+func TestNextSKARelayAddr(t *testing.T) {
+	newAddr := func(ip string) *addrmgr.NetAddress {
+		return addrmgr.NewNetAddressFromIPPort(net.ParseIP(ip), 9108,
+			wire.SFNodeNetwork)
+	}
+	addrA := newAddr("12.1.2.3")
+	addrB := newAddr("13.1.2.3")
+	addrC := newAddr("14.1.2.3")
+
+	addrs := lru.NewMap[string, *addrmgr.NetAddress](10)
+	for _, addr := range []*addrmgr.NetAddress{addrA, addrB, addrC} {
+		addrs.Put(addr.Key(), addr)
+	}
+
+	// Simulate an existing outbound peer in the network group of the most
+	// recently remembered address.
+	connectedGroups := map[string]int{addrC.GroupKey(): 1}
+	groupCount := func(key string) int {
+		return connectedGroups[key]
+	}
+
+	for _, want := range []*addrmgr.NetAddress{addrB, addrA, nil} {
+		got := nextSKARelayAddr(addrs, groupCount)
+		if got != want {
+			t.Fatalf("unexpected address - got %v, want %v", got, want)
+		}
+	}
+	if !addrs.Exists(addrC.Key()) {
+		t.Fatal("address in connected network group was removed")
+	}
+}
@@ -27,6 +27,17 @@ const (
 	// index that is greater than or equal to the number of outputs.
 	ErrInvalidSigHashSingleIndex = ErrorKind("ErrInvalidSigHashSingleIndex")
 
+	// ErrInputCoinTypesUnsupported is returned when an attempt is made to
+	// compute a signature hash that commits to the coin type of the output
+	// spent by an input of a transaction whose version does not commit to
+	// the coin types of its inputs.
+	ErrInputCoinTypesUnsupported = ErrorKind("ErrInputCoinTypesUnsupported")
+
+	// ErrInputCoinTypeMismatch is returned when an attempt is made to compute
+	// a signature hash that commits to the coin type of the output spent by an
+	// input that declares a different coin type.
+	ErrInputCoinTypeMismatch = ErrorKind("ErrInputCoinTypeMismatch")
+
 	// ErrUnsupportedScriptVersion is returned when an unsupported script
 	// version is passed to a function which deals with script analysis.
 	ErrUnsupportedScriptVersion = ErrorKind("ErrUnsupportedScriptVersion")
@@ -11,6 +11,12 @@ import (
 	"math"
 
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
+	"github.com/monetarium/monetarium-node/dcrec"
+	"github.com/monetarium/monetarium-node/dcrec/edwards"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1/ecdsa"
+	"github.com/monetarium/monetarium-node/dcrec/secp256k1/schnorr"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -165,7 +171,7 @@ func putUint64LE(buf []byte, val uint64) int {
 // sigHashPrefixSerializeSize returns the number of bytes the passed parameters
 // would take when encoded with the format used by the prefix hash portion of
 // the overall signature hash.
-func sigHashPrefixSerializeSize(hashType SigHashType, txIns []*wire.TxIn, txOuts []*wire.TxOut, signIdx int) int {
+func sigHashPrefixSerializeSize(hashType SigHashType, txVersion uint16, txIns []*wire.TxIn, txOuts []*wire.TxOut, signIdx int) int {
 	// 1) 4 bytes version/serialization type
 	// 2) number of inputs varint
 	// 3) per input:
@@ -173,6 +179,7 @@ func sigHashPrefixSerializeSize(hashType SigHashType, txIns []*wire.TxIn, txOuts
 	//    b) 4 bytes prevout index
 	//    c) 1 byte prevout tree
 	//    d) 4 bytes sequence
+	//    e) 1 byte prevout coin type (only for versions that declare it)
 	// 4) number of outputs varint
 	// 5) per output:
 	//    a) 8 bytes amount
@@ -187,6 +194,9 @@ func sigHashPrefixSerializeSize(hashType SigHashType, txIns []*wire.TxIn, txOuts
 		numTxIns*(chainhash.HashSize+4+1+4) +
 		varIntSerializeSize(uint64(numTxOuts)) +
 		numTxOuts*(8+2) + 4 + 4
+	if txVersion >= wire.TxVersionInputCoinTypes {
+		size += numTxIns
+	}
 	for txOutIdx, txOut := range txOuts {
 		pkScript := txOut.PkScript
 		if hashType&sigHashMask == SigHashSingle && txOutIdx != signIdx {
@@ -284,6 +294,8 @@ func calcSignatureHash(signScript []byte, hashType SigHashType, tx *wire.MsgTx,
 	//    b) prevout index (as little-endian uint32)
 	//    c) prevout tree (as single byte)
 	//    d) sequence (as little-endian uint32)
+	//    e) prevout coin type (as single byte, only for transaction
+	//       versions that declare the coin types of their inputs)
 	// 4) number of outputs (as varint)
 	// 5) per output:
 	//    a) output amount (as little-endian uint64)
@@ -328,7 +340,8 @@ func calcSignatureHash(signScript []byte, hashType SigHashType, tx *wire.MsgTx,
 			// Nothing special here.
 		}
 
-		size := sigHashPrefixSerializeSize(hashType, txIns, txOuts, idx)
+		size := sigHashPrefixSerializeSize(hashType, tx.Version, txIns,
+			txOuts, idx)
 		prefixBuf := make([]byte, size)
 
 		// Commit to the version and hash serialization type.
@@ -355,6 +368,12 @@ func calcSignatureHash(signScript []byte, hashType SigHashType, tx *wire.MsgTx,
 				sequence = 0
 			}
 			offset += putUint32LE(prefixBuf[offset:], sequence)
+
+			// Commit to the declared coin type of the output being
+			// spent for transaction versions that declare it.
+			if tx.Version >= wire.TxVersionInputCoinTypes {
+				offset += putByte(prefixBuf[offset:], byte(txIn.CoinType))
+			}
 		}
 
 		// Commit to the relevant transaction outputs.
@@ -456,3 +475,116 @@ func CalcSignatureHash(script []byte, hashType SigHashType, tx *wire.MsgTx, idx
 
 	return calcSignatureHash(script, hashType, tx, idx, cachedPrefix)
 }
+
+// CalcCoinTypeSignatureHash computes the signature hash for the specified input
+// of the target transaction observing the desired signature hash type while
+// ensuring the resulting hash commits to the provided coin type of the output
+// the input spends.  The cached prefix parameter has the same semantics as it
+// does for CalcSignatureHash.
+//
+// Only transactions with a version of at least wire.TxVersionInputCoinTypes
+// commit to the coin types declared by their inputs, so an error is returned
+// for earlier versions.  An error is also returned when the coin type declared
+// by the input differs from the provided one since the signature would
+// otherwise commit to a coin type other than the one the spent output holds,
+// which consensus rejects.
+//
+// Wallets should prefer this function over CalcSignatureHash when signing
+// inputs that spend SKA outputs so a signature can never be made valid for a
+// transaction that misrepresents the coin type being spent.
+//
+// NOTE: This function is only valid for version 0 scripts.  Since the function
+// does not accept a script version, the results are undefined for other script
+// versions.
+func CalcCoinTypeSignatureHash(script []byte, hashType SigHashType, tx *wire.MsgTx, idx int, coinType cointype.CoinType, cachedPrefix *chainhash.Hash) ([]byte, error) {
+	if idx < 0 || idx >= len(tx.TxIn) {
+		str := fmt.Sprintf("transaction input index %d is negative or >= %d",
+			idx, len(tx.TxIn))
+		return nil, scriptError(ErrInvalidIndex, str)
+	}
+	if tx.Version < wire.TxVersionInputCoinTypes {
+		str := fmt.Sprintf("transaction version %d does not commit to input "+
+			"coin types (min version %d)", tx.Version,
+			wire.TxVersionInputCoinTypes)
+		return nil, scriptError(ErrInputCoinTypesUnsupported, str)
+	}
+	if declared := tx.TxIn[idx].CoinType; declared != coinType {
+		str := fmt.Sprintf("input %d declares coin type %d instead of the "+
+			"spent coin type %d", idx, declared, coinType)
+		return nil, scriptError(ErrInputCoinTypeMismatch, str)
+	}
+
+	return CalcSignatureHash(script, hashType, tx, idx, cachedPrefix)
+}
+
+// VerifyCoinTypeSignature returns whether or not the provided signature, which
+// must have the signature hash type appended to it as produced by the signing
+// functions, is a valid signature of the provided signature type by the given
+// public key for the specified input of the transaction that commits to the
+// provided coin type of the output the input spends.
+//
+// The signature hash is calculated over the provided script as is, so it must
+// be the same script that was provided when signing, which is the public key
+// script of the spent output for standard scripts.
+//
+// An error is returned when the signature hash can't be calculated as
+// described by CalcCoinTypeSignatureHash or when the signature or public key
+// are not valid encodings for the signature type.
+//
+// NOTE: This function is only valid for version 0 scripts.  Since the function
+// does not accept a script version, the results are undefined for other script
+// versions.
+func VerifyCoinTypeSignature(sig, pubKey []byte, sigType dcrec.SignatureType, script []byte, tx *wire.MsgTx, idx int, coinType cointype.CoinType) (bool, error) {
+	if len(sig) == 0 {
+		str := "signature is empty"
+		return false, scriptError(ErrSigTooShort, str)
+	}
+	hashType := SigHashType(sig[len(sig)-1])
+	if err := CheckHashTypeEncoding(hashType); err != nil {
+		return false, err
+	}
+	sig = sig[:len(sig)-1]
+
+	hash, err := CalcCoinTypeSignatureHash(script, hashType, tx, idx,
+		coinType, nil)
+	if err != nil {
+		return false, err
+	}
+
+	switch sigType {
+	case dcrec.STEcdsaSecp256k1:
+		pk, err := secp256k1.ParsePubKey(pubKey)
+		if err != nil {
+			return false, err
+		}
+		signature, err := ecdsa.ParseDERSignature(sig)
+		if err != nil {
+			return false, err
+		}
+		return signature.Verify(hash, pk), nil
+
+	case dcrec.STEd25519:
+		pk, err := edwards.ParsePubKey(pubKey)
+		if err != nil {
+			return false, err
+		}
+		signature, err := edwards.ParseSignature(sig)
+		if err != nil {
+			return false, err
+		}
+		return edwards.Verify(pk, hash, signature.GetR(), signature.GetS()), nil
+
+	case dcrec.STSchnorrSecp256k1:
+		pk, err := schnorr.ParsePubKey(pubKey)
+		if err != nil {
+			return false, err
+		}
+		signature, err := schnorr.ParseSignature(sig)
+		if err != nil {
+			return false, err
+		}
+		return signature.Verify(hash, pk), nil
+	}
+
+	return false, fmt.Errorf("unknown signature type '%v'", sigType)
+}
@@ -7,9 +7,12 @@ package txscript
 
 import (
 	"bytes"
+	"encoding/hex"
+	"errors"
 	"testing"
 
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/wire"
 )
 
@@ -141,3 +144,161 @@ func TestCalcSignatureHash(t *testing.T) {
 			msg1, msg3)
 	}
 }
+
+// TestCalcSignatureHashInputCoinTypes ensures the signature hash only commits
+// to the declared coin types of the inputs for transaction versions that
+// declare them.
+func TestCalcSignatureHashInputCoinTypes(t *testing.T) {
+	newTx := func(version uint16) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.Version = version
+		for i := 0; i < 2; i++ {
+			prevOut := wire.NewOutPoint(&chainhash.Hash{byte(i)}, uint32(i),
+				wire.TxTreeRegular)
+			tx.AddTxIn(wire.NewTxIn(prevOut, 0, nil))
+		}
+		tx.AddTxOut(wire.NewTxOut(0x0000FF00FF00FF00, hexToBytes("51")))
+		return tx
+	}
+
+	script := hexToBytes("51")
+	hashTypes := []SigHashType{SigHashAll, SigHashNone, SigHashSingle,
+		SigHashAll | SigHashAnyOneCanPay}
+	for _, version := range []uint16{wire.TxVersionTreasury,
+		wire.TxVersionInputCoinTypes} {
+
+		for _, hashType := range hashTypes {
+			tx := newTx(version)
+			before, err := CalcSignatureHash(script, hashType, tx, 0, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tx.TxIn[0].CoinType = 1
+			after, err := CalcSignatureHash(script, hashType, tx, 0, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			committed := !bytes.Equal(before, after)
+			wantCommitted := version >= wire.TxVersionInputCoinTypes
+			if committed != wantCommitted {
+				t.Errorf("version %d hash type %v: unexpected input coin "+
+					"type commitment -- got %v, want %v", version, hashType,
+					committed, wantCommitted)
+			}
+		}
+	}
+}
+
+// TestCalcCoinTypeSignatureHash ensures the signature hashes that commit to
+// the coin types of the spent outputs match reference vectors and that
+// attempting to calculate them for transactions that don't commit to input
+// coin types or declare a different coin type is rejected.
+func TestCalcCoinTypeSignatureHash(t *testing.T) {
+	// The reference transaction is a version 4 transaction with two inputs
+	// that spend a SKA-1 output and a VAR output, respectively, and two SKA-1
+	// outputs.
+	const txHex = "040000000201000000000000000000000000000000000000000000000000" +
+		"000000000000000000000000ffffffff0102000000000000000000000000" +
+		"000000000000000000000000000000000000000100000000ffffffff0002" +
+		"00879303000000000100001976a9140102030405060708090a0b0c0d0e0f" +
+		"101112131488acf0326202000000000100001976a9141415161718191a1b" +
+		"1c1d1e1f202122232425262788ac00000000000000000200e1f505000000" +
+		"0000000000ffffffff0000e1f5050000000000000000ffffffff00"
+	script := hexToBytes("76a914b2a3f4cbc1a3f0e5a1b3cf0b2e9b7d5c3e1f0a2b88ac")
+	var refTx wire.MsgTx
+	if err := refTx.FromBytes(hexToBytes(txHex)); err != nil {
+		t.Fatalf("unable to decode reference transaction: %v", err)
+	}
+
+	tests := []struct {
+		name     string            // test description
+		version  uint16            // transaction version override
+		idx      int               // input index to sign
+		hashType SigHashType       // signature hash type
+		coinType cointype.CoinType // coin type of the spent output
+		want     string            // expected signature hash
+		err      error             // expected error
+	}{{
+		name:     "SigHashAll spending SKA-1",
+		idx:      0,
+		hashType: SigHashAll,
+		coinType: 1,
+		want:     "6f7d93be93347550d71fd979351f93c2970af5860fab503125b34f570a949554",
+	}, {
+		name:     "SigHashNone spending SKA-1",
+		idx:      0,
+		hashType: SigHashNone,
+		coinType: 1,
+		want:     "675e3744e535bfc0b12575caa1cda779493b05d1d721d917694d62376b96f01c",
+	}, {
+		name:     "SigHashSingle spending SKA-1",
+		idx:      0,
+		hashType: SigHashSingle,
+		coinType: 1,
+		want:     "96df239c65379c78f598bdef3f99e453a0e4c5abd4685711b395baf01ffd6b48",
+	}, {
+		name:     "SigHashAll|SigHashAnyOneCanPay spending SKA-1",
+		idx:      0,
+		hashType: SigHashAll | SigHashAnyOneCanPay,
+		coinType: 1,
+		want:     "247289f766d2e19746817a3fb6846e5460a6dc09018d4de30f766a2a7796cff8",
+	}, {
+		name:     "SigHashAll spending VAR",
+		idx:      1,
+		hashType: SigHashAll,
+		coinType: cointype.CoinTypeVAR,
+		want:     "d1fbee8ec43cef18a2da198f031ed73a558e0b0e7103e98a2e56b0a13c6997a6",
+	}, {
+		name:     "SigHashSingle|SigHashAnyOneCanPay spending VAR",
+		idx:      1,
+		hashType: SigHashSingle | SigHashAnyOneCanPay,
+		coinType: cointype.CoinTypeVAR,
+		want:     "61e480408f3910f2a8c809e9e098905b721d184fe9e31bf9d18293d168e81b2e",
+	}, {
+		name:     "spent coin type differs from declared coin type",
+		idx:      0,
+		hashType: SigHashAll,
+		coinType: cointype.CoinTypeVAR,
+		err:      ErrInputCoinTypeMismatch,
+	}, {
+		name:     "transaction version without input coin types",
+		version:  wire.TxVersionInputCoinTypes - 1,
+		idx:      0,
+		hashType: SigHashAll,
+		coinType: 1,
+		err:      ErrInputCoinTypesUnsupported,
+	}, {
+		name:     "input index out of range",
+		idx:      2,
+		hashType: SigHashAll,
+		coinType: 1,
+		err:      ErrInvalidIndex,
+	}, {
+		name:     "negative input index",
+		idx:      -1,
+		hashType: SigHashAll,
+		coinType: 1,
+		err:      ErrInvalidIndex,
+	}}
+
+	for _, test := range tests {
+		tx := refTx.Copy()
+		if test.version != 0 {
+			tx.Version = test.version
+		}
+		hash, err := CalcCoinTypeSignatureHash(script, test.hashType, tx,
+			test.idx, test.coinType, nil)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: unexpected error -- got %v, want %v", test.name,
+				err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got := hex.EncodeToString(hash); got != test.want {
+			t.Errorf("%s: unexpected signature hash -- got %s, want %s",
+				test.name, got, test.want)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrec"
 	"github.com/monetarium/monetarium-node/dcrec/edwards"
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
@@ -20,21 +21,12 @@ import (
 	"github.com/monetarium/monetarium-node/wire"
 )
 
-// RawTxInSignature returns the serialized ECDSA signature for the input idx of
-// the given transaction, with hashType appended to it.
-//
-// NOTE: This function is only valid for version 0 scripts.  Since the function
-// does not accept a script version, the results are undefined for other script
-// versions.
-func RawTxInSignature(tx *wire.MsgTx, idx int, subScript []byte,
-	hashType txscript.SigHashType, key []byte,
+// signHash returns the serialized signature of the provided signature type of
+// the given signature hash by the provided private key with hashType appended
+// to it.
+func signHash(hash []byte, hashType txscript.SigHashType, key []byte,
 	sigType dcrec.SignatureType) ([]byte, error) {
 
-	hash, err := txscript.CalcSignatureHash(subScript, hashType, tx, idx, nil)
-	if err != nil {
-		return nil, err
-	}
-
 	var sigBytes []byte
 	switch sigType {
 	case dcrec.STEcdsaSecp256k1:
@@ -65,6 +57,49 @@ func RawTxInSignature(tx *wire.MsgTx, idx int, subScript []byte,
 	return append(sigBytes, byte(hashType)), nil
 }
 
+// RawTxInSignature returns the serialized ECDSA signature for the input idx of
+// the given transaction, with hashType appended to it.
+//
+// NOTE: This function is only valid for version 0 scripts.  Since the function
+// does not accept a script version, the results are undefined for other script
+// versions.
+func RawTxInSignature(tx *wire.MsgTx, idx int, subScript []byte,
+	hashType txscript.SigHashType, key []byte,
+	sigType dcrec.SignatureType) ([]byte, error) {
+
+	hash, err := txscript.CalcSignatureHash(subScript, hashType, tx, idx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return signHash(hash, hashType, key, sigType)
+}
+
+// RawTxInCoinTypeSignature returns the serialized signature for the input idx
+// of the given transaction that commits to the provided coin type of the
+// output the input spends, with hashType appended to it.  It returns an error
+// when the transaction version does not commit to the coin types of its inputs
+// or the input declares a different coin type as described by
+// txscript.CalcCoinTypeSignatureHash.
+//
+// The resulting signature may be checked with txscript.VerifyCoinTypeSignature.
+//
+// NOTE: This function is only valid for version 0 scripts.  Since the function
+// does not accept a script version, the results are undefined for other script
+// versions.
+func RawTxInCoinTypeSignature(tx *wire.MsgTx, idx int, subScript []byte,
+	hashType txscript.SigHashType, coinType cointype.CoinType, key []byte,
+	sigType dcrec.SignatureType) ([]byte, error) {
+
+	hash, err := txscript.CalcCoinTypeSignatureHash(subScript, hashType, tx,
+		idx, coinType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return signHash(hash, hashType, key, sigType)
+}
+
 // SignatureScript creates an input signature script for tx to spend coins sent
 // from a previous output to the owner of privKey. tx must include all
 // transaction inputs and outputs, however txin scripts are allowed to be filled
@@ -6,7 +6,9 @@
 package sign
 
 import (
+	"bytes"
 	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	mrand "math/rand"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/monetarium/monetarium-node/chaincfg"
 	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
+	"github.com/monetarium/monetarium-node/cointype"
 	"github.com/monetarium/monetarium-node/dcrec"
 	"github.com/monetarium/monetarium-node/dcrec/edwards"
 	"github.com/monetarium/monetarium-node/dcrec/secp256k1"
@@ -2747,3 +2750,109 @@ nexttest:
 		}
 	}
 }
+
+// TestRawTxInCoinTypeSignature ensures signatures that commit to the coin type
+// of the spent output are produced deterministically for all signature types,
+// verify against the coin type they commit to, and do not verify against any
+// other coin type.
+func TestRawTxInCoinTypeSignature(t *testing.T) {
+	t.Parallel()
+
+	// newTx returns a version 4 transaction with an input that spends an
+	// output of the provided coin type.
+	newTx := func(coinType cointype.CoinType) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.Version = wire.TxVersionInputCoinTypes
+		prevOut := wire.NewOutPoint(&chainhash.Hash{0x01}, 0,
+			wire.TxTreeRegular)
+		txIn := wire.NewTxIn(prevOut, 100000000, nil)
+		txIn.CoinType = coinType
+		tx.AddTxIn(txIn)
+		tx.AddTxOut(wire.NewTxOutWithCoinType(99990000, coinType,
+			[]byte{txscript.OP_TRUE}))
+		return tx
+	}
+
+	const coinType = cointype.CoinType(1)
+	pkScript := []byte{txscript.OP_DUP, txscript.OP_HASH160, txscript.OP_DATA_20,
+		0xb2, 0xa3, 0xf4, 0xcb, 0xc1, 0xa3, 0xf0, 0xe5, 0xa1, 0xb3, 0xcf, 0x0b,
+		0x2e, 0x9b, 0x7d, 0x5c, 0x3e, 0x1f, 0x0a, 0x2b, txscript.OP_EQUALVERIFY,
+		txscript.OP_CHECKSIG}
+	edKey, _, _, err := edwards.GenerateKey(bytes.NewReader(privKeyD))
+	if err != nil {
+		t.Fatalf("unable to create ed25519 key: %v", err)
+	}
+	_, edPub := edwards.PrivKeyFromBytes(edKey)
+	secpPub := secp256k1.PrivKeyFromBytes(privKeyD).PubKey()
+
+	tests := []struct {
+		name    string
+		sigType dcrec.SignatureType
+		privKey []byte
+		pubKey  []byte
+		want    string
+	}{{
+		name:    "ecdsa-secp256k1",
+		sigType: dcrec.STEcdsaSecp256k1,
+		privKey: privKeyD,
+		pubKey:  secpPub.SerializeCompressed(),
+		want: "304402202f497fb441ec0579d7295ec5906f88917b2a0a66516e0c28" +
+			"f9fefb529b11c4f902200f441b39a3b8c5d7e907fe2a58cd7f929c39" +
+			"0ef3fffe68b5675d146bd08fef9901",
+	}, {
+		name:    "ed25519",
+		sigType: dcrec.STEd25519,
+		privKey: edKey,
+		pubKey:  edPub.Serialize(),
+		want: "67e434531b77a31dc2072a2d0a8685301d6e355b8151b92a1d7535ff" +
+			"843a0ba15e3498cb691e1db367330358e822ba7cc2a518e062a21782" +
+			"3fffb06ac94ed40401",
+	}, {
+		name:    "schnorr-secp256k1",
+		sigType: dcrec.STSchnorrSecp256k1,
+		privKey: privKeyD,
+		pubKey:  secpPub.SerializeCompressed(),
+		want: "2f86528c3cae4b180dc35b4c94a308d370742a431af648bd3593c420" +
+			"8c93dca624a83375e98b1daccc884964a425d6ca887e35a4200b0c23" +
+			"00cf14e6b82b804d01",
+	}}
+
+	for _, test := range tests {
+		tx := newTx(coinType)
+		sig, err := RawTxInCoinTypeSignature(tx, 0, pkScript,
+			txscript.SigHashAll, coinType, test.privKey, test.sigType)
+		if err != nil {
+			t.Errorf("%s: unexpected error signing: %v", test.name, err)
+			continue
+		}
+		if got := hex.EncodeToString(sig); got != test.want {
+			t.Errorf("%s: unexpected signature -- got %s, want %s",
+				test.name, got, test.want)
+		}
+
+		valid, err := txscript.VerifyCoinTypeSignature(sig, test.pubKey,
+			test.sigType, pkScript, tx, 0, coinType)
+		if err != nil || !valid {
+			t.Errorf("%s: signature does not verify (err %v)", test.name, err)
+		}
+
+		// The signature must not verify for the same transaction when it
+		// declares that the input spends a different coin type.
+		otherTx := newTx(coinType + 1)
+		otherTx.TxOut[0].CoinType = coinType
+		valid, err = txscript.VerifyCoinTypeSignature(sig, test.pubKey,
+			test.sigType, pkScript, otherTx, 0, coinType+1)
+		if err != nil || valid {
+			t.Errorf("%s: signature verifies for another coin type (err %v)",
+				test.name, err)
+		}
+
+		// Signing an input with a coin type it does not declare must fail.
+		_, err = RawTxInCoinTypeSignature(tx, 0, pkScript,
+			txscript.SigHashAll, coinType+1, test.privKey, test.sigType)
+		if !errors.Is(err, txscript.ErrInputCoinTypeMismatch) {
+			t.Errorf("%s: unexpected error for mismatched coin type -- got "+
+				"%v, want %v", test.name, err, txscript.ErrInputCoinTypeMismatch)
+		}
+	}
+}
@@ -125,6 +125,11 @@ const (
 	// decentralized treasury features.
 	TxVersionTreasury uint16 = 3
 
+	// TxVersionInputCoinTypes is the transaction version that enables
+	// explicitly declaring the coin type of the output spent by each input
+	// in the transaction prefix.
+	TxVersionInputCoinTypes uint16 = 4
+
 	// MaxTxInSequenceNum is the maximum sequence number the sequence field
 	// of a transaction input can be.
 	MaxTxInSequenceNum uint32 = 0xffffffff
@@ -385,6 +390,11 @@ type TxIn struct {
 	PreviousOutPoint OutPoint
 	Sequence         uint32
 
+	// CoinType is the coin type of the output spent by the input.  It is
+	// only serialized for transactions with a version of at least
+	// TxVersionInputCoinTypes and is ignored otherwise.
+	CoinType cointype.CoinType
+
 	// Witness
 	ValueIn         int64
 	BlockHeight     uint32
@@ -393,13 +403,28 @@ type TxIn struct {
 }
 
 // SerializeSizePrefix returns the number of bytes it would take to serialize
-// the transaction input for a prefix.
+// the transaction input for a prefix of a transaction with a version prior to
+// TxVersionInputCoinTypes.  Use SerializeSizePrefixVersion for transactions
+// that may declare input coin types.
 func (t *TxIn) SerializeSizePrefix() int {
 	// Outpoint Hash 32 bytes + Outpoint Index 4 bytes + Outpoint Tree 1 byte +
 	// Sequence 4 bytes.
 	return 41
 }
 
+// SerializeSizePrefixVersion returns the number of bytes it would take to
+// serialize the transaction input for a prefix of a transaction with the
+// provided version.
+func (t *TxIn) SerializeSizePrefixVersion(version uint16) int {
+	// Outpoint Hash 32 bytes + Outpoint Index 4 bytes + Outpoint Tree 1 byte +
+	// Sequence 4 bytes + CoinType 1 byte when the transaction version
+	// declares input coin types.
+	if version >= TxVersionInputCoinTypes {
+		return 42
+	}
+	return 41
+}
+
 // SerializeSizeWitness returns the number of bytes it would take to serialize the
 // transaction input for a witness.
 func (t *TxIn) SerializeSizeWitness() int {
@@ -622,6 +647,7 @@ func (msg *MsgTx) Copy() *MsgTx {
 		newTxIn := TxIn{
 			PreviousOutPoint: newOutPoint,
 			Sequence:         oldTxIn.Sequence,
+			CoinType:         oldTxIn.CoinType,
 			ValueIn:          oldTxIn.ValueIn,
 			BlockHeight:      oldTxIn.BlockHeight,
 			BlockIndex:       oldTxIn.BlockIndex,
@@ -1139,7 +1165,7 @@ func (msg *MsgTx) SerializeSize() int {
 			VarIntSerializeSize(uint64(len(msg.TxOut)))
 
 		for _, txIn := range msg.TxIn {
-			n += txIn.SerializeSizePrefix()
+			n += txIn.SerializeSizePrefixVersion(msg.Version)
 		}
 		for _, txOut := range msg.TxOut {
 			n += txOut.SerializeSize()
@@ -1164,7 +1190,7 @@ func (msg *MsgTx) SerializeSize() int {
 			VarIntSerializeSize(uint64(len(msg.TxOut)))
 
 		for _, txIn := range msg.TxIn {
-			n += txIn.SerializeSizePrefix()
+			n += txIn.SerializeSizePrefixVersion(msg.Version)
 		}
 		for _, txIn := range msg.TxIn {
 			n += txIn.SerializeSizeWitness()
@@ -1216,7 +1242,7 @@ func (msg *MsgTx) PkScriptLocs() []int {
 	n := 4 + VarIntSerializeSize(uint64(len(msg.TxIn))) +
 		VarIntSerializeSize(uint64(numTxOut))
 	for _, txIn := range msg.TxIn {
-		n += txIn.SerializeSizePrefix()
+		n += txIn.SerializeSizePrefixVersion(msg.Version)
 	}
 
 	// Calculate and set the appropriate offset for each public key script.
@@ -1301,7 +1327,19 @@ func readTxInPrefix(r io.Reader, pver uint32, serType TxSerializeType, version u
 
 	// Sequence.
 	ti.Sequence, err = binarySerializer.Uint32(r, littleEndian)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Coin type of the spent output.
+	if version >= TxVersionInputCoinTypes {
+		coinType, err := binarySerializer.Uint8(r)
+		if err != nil {
+			return err
+		}
+		ti.CoinType = cointype.CoinType(coinType)
+	}
+	return nil
 }
 
 // readTxInWitness reads the next sequence of bytes from r as a transaction input
@@ -1340,7 +1378,16 @@ func writeTxInPrefix(w io.Writer, pver uint32, version uint16, ti *TxIn) error {
 		return err
 	}
 
-	return binarySerializer.PutUint32(w, littleEndian, ti.Sequence)
+	err = binarySerializer.PutUint32(w, littleEndian, ti.Sequence)
+	if err != nil {
+		return err
+	}
+
+	// Coin type of the spent output.
+	if version >= TxVersionInputCoinTypes {
+		return binarySerializer.PutUint8(w, uint8(ti.CoinType))
+	}
+	return nil
 }
 
 // writeTxInWitness encodes ti to the Decred protocol encoding for a transaction
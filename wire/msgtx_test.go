@@ -856,6 +856,87 @@ func TestTxSerializeSize(t *testing.T) {
 	}
 }
 
+// TestTxInputCoinTypes ensures the coin types of transaction inputs are only
+// serialized, and therefore committed to by the transaction hash, for
+// transactions with a version that declares input coin types.
+func TestTxInputCoinTypes(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     uint16
+		wantInSize  int
+		wantSize    int
+		wantDecoded cointype.CoinType
+	}{{
+		name:        "treasury version omits input coin types",
+		version:     TxVersionTreasury,
+		wantInSize:  41,
+		wantSize:    238,
+		wantDecoded: cointype.CoinTypeVAR,
+	}, {
+		name:        "input coin types version includes input coin types",
+		version:     TxVersionInputCoinTypes,
+		wantInSize:  42,
+		wantSize:    239,
+		wantDecoded: 1,
+	}}
+
+	for _, test := range tests {
+		tx := multiTx.Copy()
+		tx.Version = test.version
+		tx.TxIn[0].CoinType = 1
+		inSize := tx.TxIn[0].SerializeSizePrefixVersion(tx.Version)
+		if inSize != test.wantInSize {
+			t.Errorf("%q: unexpected input prefix size -- got %d, want %d",
+				test.name, inSize, test.wantInSize)
+			continue
+		}
+		if test.version < TxVersionInputCoinTypes &&
+			tx.TxIn[0].SerializeSizePrefix() != inSize {
+
+			t.Errorf("%q: unexpected unversioned input prefix size -- got "+
+				"%d, want %d", test.name, tx.TxIn[0].SerializeSizePrefix(),
+				inSize)
+			continue
+		}
+
+		// Ensure the size matches the serialized transaction.
+		serialized, err := tx.Bytes()
+		if err != nil {
+			t.Errorf("%q: unexpected serialize error: %v", test.name, err)
+			continue
+		}
+		if len(serialized) != test.wantSize || tx.SerializeSize() != test.wantSize {
+			t.Errorf("%q: unexpected serialized size -- got %d (%d), want %d",
+				test.name, len(serialized), tx.SerializeSize(), test.wantSize)
+			continue
+		}
+
+		// Ensure the input coin type round trips as expected.
+		var decoded MsgTx
+		if err := decoded.FromBytes(serialized); err != nil {
+			t.Errorf("%q: unexpected deserialize error: %v", test.name, err)
+			continue
+		}
+		if decoded.TxIn[0].CoinType != test.wantDecoded {
+			t.Errorf("%q: unexpected decoded input coin type -- got %d, "+
+				"want %d", test.name, decoded.TxIn[0].CoinType,
+				test.wantDecoded)
+			continue
+		}
+
+		// Ensure the transaction hash only commits to the input coin type
+		// when it is serialized.
+		modified := tx.Copy()
+		modified.TxIn[0].CoinType = 2
+		hashChanged := modified.TxHash() != tx.TxHash()
+		wantChanged := test.version >= TxVersionInputCoinTypes
+		if hashChanged != wantChanged {
+			t.Errorf("%q: unexpected hash commitment -- got changed %v, "+
+				"want %v", test.name, hashChanged, wantChanged)
+		}
+	}
+}
+
 // multiTx is a MsgTx with an input and output and used in various tests.
 var multiTx = &MsgTx{
 	SerType: TxSerializeFull,
@@ -12,6 +12,8 @@ import (
 	"net"
 	"strings"
 	"time"
+
+	"github.com/monetarium/monetarium-node/chaincfg/chainhash"
 )
 
 // MaxUserAgentLen is the maximum allowed length for the user agent field in a
@@ -57,6 +59,11 @@ type MsgVersion struct {
 
 	// Don't announce transactions to peer.
 	DisableRelayTx bool
+
+	// SKAConfigHash commits to the SKA configuration of the network used by
+	// the generator of the version message.  It is only encoded as of
+	// SKAConfigHashVersion and only when it is not the zero hash.
+	SKAConfigHash chainhash.Hash
 }
 
 // HasService returns whether the specified service is supported by the peer
@@ -146,6 +153,16 @@ func (msg *MsgVersion) BtcDecode(r io.Reader, pver uint32) error {
 		msg.DisableRelayTx = !relayTx
 	}
 
+	// Protocol versions >= SKAConfigHashVersion added an optional SKA
+	// configuration hash field.  It is only considered present if there are
+	// bytes remaining in the message.
+	if pver >= SKAConfigHashVersion && buf.Len() > 0 {
+		err = readElement(buf, &msg.SKAConfigHash)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -188,7 +205,15 @@ func (msg *MsgVersion) BtcEncode(w io.Writer, pver uint32) error {
 		return err
 	}
 
-	return writeElement(w, !msg.DisableRelayTx)
+	err = writeElement(w, !msg.DisableRelayTx)
+	if err != nil {
+		return err
+	}
+
+	if pver >= SKAConfigHashVersion && msg.SKAConfigHash != (chainhash.Hash{}) {
+		return writeElement(w, &msg.SKAConfigHash)
+	}
+	return nil
 }
 
 // Command returns the protocol command string for the message.  This is part
@@ -204,8 +229,14 @@ func (msg *MsgVersion) MaxPayloadLength(pver uint32) uint32 {
 	// remote and local net addresses + nonce 8 bytes + length of user
 	// agent (varInt) + max allowed useragent length + last block 4 bytes +
 	// relay transactions flag 1 byte.
-	return 33 + (maxNetAddressPayload(pver) * 2) + MaxVarIntPayload +
+	plen := 33 + (maxNetAddressPayload(pver) * 2) + MaxVarIntPayload +
 		MaxUserAgentLen
+
+	// SKA configuration hash as of SKAConfigHashVersion.
+	if pver >= SKAConfigHashVersion {
+		plen += chainhash.HashSize
+	}
+	return plen
 }
 
 // NewMsgVersion returns a new Decred version message that conforms to the
@@ -112,8 +112,8 @@ func TestVersion(t *testing.T) {
 	// Protocol version 4 bytes + services 8 bytes + timestamp 8 bytes +
 	// remote and local net addresses + nonce 8 bytes + length of user agent
 	// (varInt) + max allowed user agent length + last block 4 bytes +
-	// relay transactions flag 1 byte.
-	wantPayload := uint32(358)
+	// relay transactions flag 1 byte + SKA configuration hash 32 bytes.
+	wantPayload := uint32(390)
 	maxPayload := msg.MaxPayloadLength(pver)
 	if maxPayload != wantPayload {
 		t.Errorf("MaxPayloadLength: wrong max payload length for "+
@@ -121,6 +121,16 @@ func TestVersion(t *testing.T) {
 			maxPayload, wantPayload)
 	}
 
+	// Ensure max payload excludes the SKA configuration hash prior to the
+	// protocol version that added it.
+	oldPver := SKAConfigHashVersion - 1
+	wantOldPayload := uint32(358)
+	if oldPayload := msg.MaxPayloadLength(oldPver); oldPayload != wantOldPayload {
+		t.Errorf("MaxPayloadLength: wrong max payload length for "+
+			"protocol version %d - got %v, want %v", oldPver,
+			oldPayload, wantOldPayload)
+	}
+
 	// Ensure max payload length is not more than MaxMessagePayload.
 	if maxPayload > MaxMessagePayload {
 		t.Fatalf("MaxPayloadLength: payload length (%v) for protocol "+
@@ -190,6 +200,17 @@ func TestVersionWire(t *testing.T) {
 	copy(verRelayTxFalseEncoded, baseVersionBIP0037Encoded)
 	verRelayTxFalseEncoded[len(verRelayTxFalseEncoded)-1] = 0
 
+	// verSKAHash and verSKAHashEncoded is a version message that also
+	// advertises the SKA configuration hash after the relay flag.
+	baseVersionSKAHashCopy := *baseVersionBIP0037
+	verSKAHash := &baseVersionSKAHashCopy
+	for i := range verSKAHash.SKAConfigHash {
+		verSKAHash.SKAConfigHash[i] = byte(i)
+	}
+	verSKAHashEncoded := make([]byte, len(baseVersionBIP0037Encoded))
+	copy(verSKAHashEncoded, baseVersionBIP0037Encoded)
+	verSKAHashEncoded = append(verSKAHashEncoded, verSKAHash.SKAConfigHash[:]...)
+
 	tests := []struct {
 		in   *MsgVersion // Message to encode
 		out  *MsgVersion // Expected decoded message
@@ -209,6 +230,21 @@ func TestVersionWire(t *testing.T) {
 			verRelayTxFalseEncoded,
 			ProtocolVersion,
 		},
+		{
+			verSKAHash,
+			verSKAHash,
+			verSKAHashEncoded,
+			ProtocolVersion,
+		},
+
+		// Protocol version prior to SKAConfigHashVersion does not encode
+		// the SKA configuration hash.
+		{
+			verSKAHash,
+			baseVersionBIP0037,
+			baseVersionBIP0037Encoded,
+			SKAConfigHashVersion - 1,
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -17,7 +17,7 @@ const (
 	InitialProcotolVersion uint32 = 1
 
 	// ProtocolVersion is the latest protocol version this package supports.
-	ProtocolVersion uint32 = 12
+	ProtocolVersion uint32 = 13
 
 	// NodeBloomVersion is the protocol version which added the SFNodeBloom
 	// service flag (unused).
@@ -62,6 +62,10 @@ const (
 	// DualCoinVersion is the protocol version which added dual-coin support
 	// with CoinType field in transaction outputs.
 	DualCoinVersion uint32 = 12
+
+	// SKAConfigHashVersion is the protocol version which adds the SKA
+	// configuration hash to the version message.
+	SKAConfigHashVersion uint32 = 13
 )
 
 // ServiceFlag identifies services supported by a Decred peer.